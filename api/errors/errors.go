@@ -39,6 +39,8 @@ const (
 	Internal
 	// Fault indicates a data fault occurred
 	Fault
+	// OutOfRange indicates an operation would move a value outside its valid range
+	OutOfRange
 )
 
 // TypedError is an typed error
@@ -131,6 +133,11 @@ func NewFault(msg string, args ...interface{}) error {
 	return New(Fault, msg, args...)
 }
 
+// NewOutOfRange returns a new OutOfRange error
+func NewOutOfRange(msg string, args ...interface{}) error {
+	return New(OutOfRange, msg, args...)
+}
+
 // Code returns the error code
 func Code(err error) int {
 	return int(TypeOf(err))
@@ -216,3 +223,8 @@ func IsInternal(err error) bool {
 func IsFault(err error) bool {
 	return IsType(err, Fault)
 }
+
+// IsOutOfRange checks whether the given error is an OutOfRange error
+func IsOutOfRange(err error) bool {
+	return IsType(err, OutOfRange)
+}
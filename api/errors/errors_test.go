@@ -35,6 +35,8 @@ func TestFactories(t *testing.T) {
 	assert.Equal(t, "Timeout", NewTimeout("Timeout").Error())
 	assert.Equal(t, Internal, NewInternal("").(*TypedError).Type)
 	assert.Equal(t, "Internal", NewInternal("Internal").Error())
+	assert.Equal(t, OutOfRange, NewOutOfRange("").(*TypedError).Type)
+	assert.Equal(t, "OutOfRange", NewOutOfRange("OutOfRange").Error())
 }
 
 func TestPredicates(t *testing.T) {
@@ -62,4 +64,6 @@ func TestPredicates(t *testing.T) {
 	assert.True(t, IsTimeout(NewTimeout("Timeout")))
 	assert.False(t, IsInternal(errors.New("Internal")))
 	assert.True(t, IsInternal(NewInternal("Internal")))
+	assert.False(t, IsOutOfRange(errors.New("OutOfRange")))
+	assert.True(t, IsOutOfRange(NewOutOfRange("OutOfRange")))
 }
@@ -0,0 +1,1601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: runtime/barrier/v1/barrier.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/atomix/atomix/api/runtime/v1"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AwaitRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// parties is the number of parties required to trip the barrier
+	Parties uint32         `protobuf:"varint,2,opt,name=parties,proto3" json:"parties,omitempty"`
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+}
+
+func (m *AwaitRequest) Reset()         { *m = AwaitRequest{} }
+func (m *AwaitRequest) String() string { return proto.CompactTextString(m) }
+func (*AwaitRequest) ProtoMessage()    {}
+func (*AwaitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd93b9e6aa9099b1, []int{0}
+}
+func (m *AwaitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AwaitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AwaitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AwaitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AwaitRequest.Merge(m, src)
+}
+func (m *AwaitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AwaitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AwaitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AwaitRequest proto.InternalMessageInfo
+
+func (m *AwaitRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *AwaitRequest) GetParties() uint32 {
+	if m != nil {
+		return m.Parties
+	}
+	return 0
+}
+
+func (m *AwaitRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+type AwaitResponse struct {
+	// generation is the generation of the barrier that was tripped
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	// index is the arrival index of the caller within the generation
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *AwaitResponse) Reset()         { *m = AwaitResponse{} }
+func (m *AwaitResponse) String() string { return proto.CompactTextString(m) }
+func (*AwaitResponse) ProtoMessage()    {}
+func (*AwaitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd93b9e6aa9099b1, []int{1}
+}
+func (m *AwaitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AwaitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AwaitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AwaitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AwaitResponse.Merge(m, src)
+}
+func (m *AwaitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AwaitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AwaitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AwaitResponse proto.InternalMessageInfo
+
+func (m *AwaitResponse) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+func (m *AwaitResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ResetRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *ResetRequest) Reset()         { *m = ResetRequest{} }
+func (m *ResetRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetRequest) ProtoMessage()    {}
+func (*ResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd93b9e6aa9099b1, []int{2}
+}
+func (m *ResetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetRequest.Merge(m, src)
+}
+func (m *ResetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetRequest proto.InternalMessageInfo
+
+func (m *ResetRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+type ResetResponse struct {
+}
+
+func (m *ResetResponse) Reset()         { *m = ResetResponse{} }
+func (m *ResetResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetResponse) ProtoMessage()    {}
+func (*ResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd93b9e6aa9099b1, []int{3}
+}
+func (m *ResetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetResponse.Merge(m, src)
+}
+func (m *ResetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetResponse proto.InternalMessageInfo
+
+type GetBarrierRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *GetBarrierRequest) Reset()         { *m = GetBarrierRequest{} }
+func (m *GetBarrierRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBarrierRequest) ProtoMessage()    {}
+func (*GetBarrierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd93b9e6aa9099b1, []int{4}
+}
+func (m *GetBarrierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBarrierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBarrierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBarrierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBarrierRequest.Merge(m, src)
+}
+func (m *GetBarrierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBarrierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBarrierRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBarrierRequest proto.InternalMessageInfo
+
+func (m *GetBarrierRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+type GetBarrierResponse struct {
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	Parties    uint32 `protobuf:"varint,2,opt,name=parties,proto3" json:"parties,omitempty"`
+	Waiting    uint32 `protobuf:"varint,3,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	Broken     bool   `protobuf:"varint,4,opt,name=broken,proto3" json:"broken,omitempty"`
+}
+
+func (m *GetBarrierResponse) Reset()         { *m = GetBarrierResponse{} }
+func (m *GetBarrierResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBarrierResponse) ProtoMessage()    {}
+func (*GetBarrierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd93b9e6aa9099b1, []int{5}
+}
+func (m *GetBarrierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBarrierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBarrierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBarrierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBarrierResponse.Merge(m, src)
+}
+func (m *GetBarrierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBarrierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBarrierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBarrierResponse proto.InternalMessageInfo
+
+func (m *GetBarrierResponse) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+func (m *GetBarrierResponse) GetParties() uint32 {
+	if m != nil {
+		return m.Parties
+	}
+	return 0
+}
+
+func (m *GetBarrierResponse) GetWaiting() uint32 {
+	if m != nil {
+		return m.Waiting
+	}
+	return 0
+}
+
+func (m *GetBarrierResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AwaitRequest)(nil), "atomix.runtime.barrier.v1.AwaitRequest")
+	proto.RegisterType((*AwaitResponse)(nil), "atomix.runtime.barrier.v1.AwaitResponse")
+	proto.RegisterType((*ResetRequest)(nil), "atomix.runtime.barrier.v1.ResetRequest")
+	proto.RegisterType((*ResetResponse)(nil), "atomix.runtime.barrier.v1.ResetResponse")
+	proto.RegisterType((*GetBarrierRequest)(nil), "atomix.runtime.barrier.v1.GetBarrierRequest")
+	proto.RegisterType((*GetBarrierResponse)(nil), "atomix.runtime.barrier.v1.GetBarrierResponse")
+}
+
+func init() { proto.RegisterFile("runtime/barrier/v1/barrier.proto", fileDescriptor_fd93b9e6aa9099b1) }
+
+var fileDescriptor_fd93b9e6aa9099b1 = []byte{
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd4, 0x4c,
+	0x10, 0xc6, 0x6f, 0x7d, 0xff, 0xde, 0x77, 0xc8, 0x09, 0xb1, 0x8a, 0x90, 0xe3, 0xc2, 0x77, 0xb8,
+	0xc1, 0x48, 0xb0, 0x96, 0x43, 0x05, 0x0d, 0xc2, 0x39, 0x84, 0x42, 0x43, 0xe4, 0x82, 0x22, 0x0d,
+	0xb2, 0x75, 0x83, 0xb5, 0x22, 0xe7, 0x3d, 0xd6, 0x6b, 0x93, 0x92, 0x92, 0x92, 0x92, 0x8e, 0xaf,
+	0x93, 0x32, 0x25, 0x55, 0x40, 0x77, 0x9f, 0x80, 0x6f, 0x80, 0xce, 0xde, 0x15, 0x16, 0xe8, 0x2e,
+	0x57, 0xa4, 0xdb, 0xf1, 0xfe, 0xf6, 0x99, 0x99, 0x67, 0xc6, 0x30, 0x91, 0x65, 0xae, 0xf8, 0x1c,
+	0x83, 0x34, 0x91, 0x92, 0xa3, 0x0c, 0xaa, 0xd0, 0x1c, 0xd9, 0x42, 0x0a, 0x25, 0xe8, 0x41, 0xa2,
+	0xc4, 0x9c, 0x9f, 0x33, 0x0d, 0x32, 0x73, 0x5b, 0x85, 0x8e, 0x9b, 0x09, 0x91, 0x9d, 0x61, 0x50,
+	0x83, 0x69, 0xf9, 0x2e, 0x98, 0x95, 0x32, 0x51, 0x5c, 0xe4, 0xcd, 0x53, 0xc7, 0x36, 0xe2, 0x55,
+	0x18, 0x98, 0xe7, 0xcd, 0xcd, 0xbd, 0xcd, 0x69, 0x0b, 0x8d, 0xec, 0x67, 0x22, 0x13, 0xf5, 0x31,
+	0x58, 0x9f, 0x9a, 0xaf, 0xde, 0x37, 0x02, 0x7b, 0xcf, 0x3f, 0x26, 0x5c, 0xc5, 0xf8, 0xa1, 0xc4,
+	0x42, 0xd1, 0xa7, 0x60, 0xf1, 0x99, 0x4d, 0x26, 0xc4, 0xbf, 0x75, 0xe8, 0xb2, 0xbf, 0x6a, 0xad,
+	0x42, 0x76, 0x22, 0xf9, 0x9c, 0x2b, 0x5e, 0xe1, 0xf1, 0x34, 0x82, 0x8b, 0xab, 0x71, 0x67, 0x79,
+	0x35, 0xb6, 0x8e, 0xa7, 0xb1, 0xc5, 0x67, 0xd4, 0x86, 0xe1, 0x22, 0x91, 0x8a, 0x63, 0x61, 0x5b,
+	0x13, 0xe2, 0x8f, 0x62, 0x13, 0xd2, 0x27, 0x30, 0x5c, 0x0b, 0x88, 0x52, 0xd9, 0xdd, 0x5a, 0xfa,
+	0x80, 0x35, 0xbd, 0x32, 0xd3, 0x2b, 0x9b, 0xea, 0x5e, 0xa3, 0xde, 0xd7, 0x1f, 0x63, 0x12, 0x1b,
+	0xde, 0x7b, 0x01, 0x23, 0x5d, 0x60, 0xb1, 0x10, 0x79, 0x81, 0xd4, 0x05, 0xc8, 0x30, 0xc7, 0x86,
+	0xae, 0x2b, 0xed, 0xc5, 0xad, 0x2f, 0x74, 0x1f, 0xfa, 0x3c, 0x9f, 0xe1, 0xb9, 0xae, 0xa1, 0x09,
+	0xbc, 0x57, 0xb0, 0x17, 0x63, 0x81, 0x37, 0xd1, 0xa7, 0x77, 0x1b, 0x46, 0x5a, 0xab, 0x29, 0xc9,
+	0x7b, 0x0d, 0x77, 0x5e, 0xa2, 0x8a, 0x1a, 0xc3, 0x6f, 0x22, 0xc3, 0x27, 0x02, 0xb4, 0xad, 0xb8,
+	0x63, 0xeb, 0x9b, 0x07, 0x60, 0xc3, 0x70, 0x6d, 0x22, 0xcf, 0xb3, 0x7a, 0x00, 0xa3, 0xd8, 0x84,
+	0xf4, 0x2e, 0x0c, 0x52, 0x29, 0xde, 0x63, 0x6e, 0xf7, 0x26, 0xc4, 0xff, 0x2f, 0xd6, 0xd1, 0xe1,
+	0xaf, 0x2e, 0x0c, 0x75, 0x7e, 0x7a, 0x0a, 0xfd, 0x7a, 0x06, 0xf4, 0x3e, 0xdb, 0xb8, 0xbd, 0xac,
+	0xbd, 0x46, 0x8e, 0x7f, 0x3d, 0xa8, 0x7b, 0x3a, 0x85, 0x7e, 0x6d, 0xe6, 0x56, 0xed, 0xf6, 0xe8,
+	0x1c, 0xff, 0x7a, 0x50, 0x6b, 0x73, 0x80, 0x3f, 0x2e, 0xd2, 0x87, 0x5b, 0xde, 0xfd, 0x33, 0x3e,
+	0xe7, 0xd1, 0x8e, 0xb4, 0x4e, 0x95, 0xc2, 0xe0, 0x48, 0x62, 0xa2, 0x90, 0x6e, 0x2b, 0xaf, 0x41,
+	0x4c, 0x8a, 0x07, 0x3b, 0x90, 0x7a, 0xc3, 0xba, 0x9f, 0x2d, 0x42, 0xdf, 0x42, 0xff, 0xe8, 0x4c,
+	0x14, 0xb8, 0xd5, 0xaa, 0x9a, 0xd8, 0xc5, 0x2a, 0x0d, 0xb6, 0x12, 0x44, 0xcf, 0x2e, 0x96, 0x2e,
+	0xb9, 0x5c, 0xba, 0xe4, 0xe7, 0xd2, 0x25, 0x5f, 0x56, 0x6e, 0xe7, 0x72, 0xe5, 0x76, 0xbe, 0xaf,
+	0xdc, 0x0e, 0xd8, 0x5c, 0x18, 0xa9, 0x64, 0xc1, 0x5b, 0x32, 0xd1, 0xff, 0xda, 0x89, 0x37, 0xe1,
+	0x09, 0x49, 0x07, 0xf5, 0xef, 0xfc, 0xf8, 0xf7, 0x00, 0x85, 0x0c, 0xf4, 0xb3, 0x07, 0x05, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BarrierClient is the client API for Barrier service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BarrierClient interface {
+	// Await waits for all parties to arrive at the barrier
+	Await(ctx context.Context, in *AwaitRequest, opts ...grpc.CallOption) (*AwaitResponse, error)
+	// Reset resets a broken barrier
+	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	// GetBarrier gets the barrier state
+	GetBarrier(ctx context.Context, in *GetBarrierRequest, opts ...grpc.CallOption) (*GetBarrierResponse, error)
+	// Create creates the Barrier
+	// Deprecated: use the Barriers service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the Barrier
+	// Deprecated: use the Barriers service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+}
+
+type barrierClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBarrierClient(cc *grpc.ClientConn) BarrierClient {
+	return &barrierClient{cc}
+}
+
+func (c *barrierClient) Await(ctx context.Context, in *AwaitRequest, opts ...grpc.CallOption) (*AwaitResponse, error) {
+	out := new(AwaitResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.barrier.v1.Barrier/Await", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *barrierClient) Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error) {
+	out := new(ResetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.barrier.v1.Barrier/Reset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *barrierClient) GetBarrier(ctx context.Context, in *GetBarrierRequest, opts ...grpc.CallOption) (*GetBarrierResponse, error) {
+	out := new(GetBarrierResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.barrier.v1.Barrier/GetBarrier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *barrierClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.barrier.v1.Barrier/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *barrierClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.barrier.v1.Barrier/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BarrierServer is the server API for Barrier service.
+type BarrierServer interface {
+	// Await waits for all parties to arrive at the barrier
+	Await(context.Context, *AwaitRequest) (*AwaitResponse, error)
+	// Reset resets a broken barrier
+	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	// GetBarrier gets the barrier state
+	GetBarrier(context.Context, *GetBarrierRequest) (*GetBarrierResponse, error)
+	// Create creates the Barrier
+	// Deprecated: use the Barriers service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the Barrier
+	// Deprecated: use the Barriers service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
+}
+
+// UnimplementedBarrierServer can be embedded to have forward compatible implementations.
+type UnimplementedBarrierServer struct {
+}
+
+func (*UnimplementedBarrierServer) Await(ctx context.Context, req *AwaitRequest) (*AwaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Await not implemented")
+}
+func (*UnimplementedBarrierServer) Reset(ctx context.Context, req *ResetRequest) (*ResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
+func (*UnimplementedBarrierServer) GetBarrier(ctx context.Context, req *GetBarrierRequest) (*GetBarrierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarrier not implemented")
+}
+func (*UnimplementedBarrierServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedBarrierServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+
+func RegisterBarrierServer(s *grpc.Server, srv BarrierServer) {
+	s.RegisterService(&_Barrier_serviceDesc, srv)
+}
+
+func _Barrier_Await_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AwaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BarrierServer).Await(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.barrier.v1.Barrier/Await",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BarrierServer).Await(ctx, req.(*AwaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Barrier_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BarrierServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.barrier.v1.Barrier/Reset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BarrierServer).Reset(ctx, req.(*ResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Barrier_GetBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarrierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BarrierServer).GetBarrier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.barrier.v1.Barrier/GetBarrier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BarrierServer).GetBarrier(ctx, req.(*GetBarrierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Barrier_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BarrierServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.barrier.v1.Barrier/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BarrierServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Barrier_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BarrierServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.barrier.v1.Barrier/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BarrierServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Barrier_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.barrier.v1.Barrier",
+	HandlerType: (*BarrierServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Await",
+			Handler:    _Barrier_Await_Handler,
+		},
+		{
+			MethodName: "Reset",
+			Handler:    _Barrier_Reset_Handler,
+		},
+		{
+			MethodName: "GetBarrier",
+			Handler:    _Barrier_GetBarrier_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Barrier_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _Barrier_Close_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "runtime/barrier/v1/barrier.proto",
+}
+
+func (m *AwaitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AwaitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AwaitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintBarrier(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Parties != 0 {
+		i = encodeVarintBarrier(dAtA, i, uint64(m.Parties))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBarrier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AwaitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AwaitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AwaitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintBarrier(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Generation != 0 {
+		i = encodeVarintBarrier(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBarrier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBarrierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBarrierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBarrierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBarrier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBarrierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Waiting != 0 {
+		i = encodeVarintBarrier(dAtA, i, uint64(m.Waiting))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Parties != 0 {
+		i = encodeVarintBarrier(dAtA, i, uint64(m.Parties))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Generation != 0 {
+		i = encodeVarintBarrier(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBarrier(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBarrier(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AwaitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovBarrier(uint64(l))
+	if m.Parties != 0 {
+		n += 1 + sovBarrier(uint64(m.Parties))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovBarrier(uint64(l))
+	}
+	return n
+}
+
+func (m *AwaitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Generation != 0 {
+		n += 1 + sovBarrier(uint64(m.Generation))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBarrier(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *ResetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovBarrier(uint64(l))
+	return n
+}
+
+func (m *ResetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetBarrierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovBarrier(uint64(l))
+	return n
+}
+
+func (m *GetBarrierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Generation != 0 {
+		n += 1 + sovBarrier(uint64(m.Generation))
+	}
+	if m.Parties != 0 {
+		n += 1 + sovBarrier(uint64(m.Parties))
+	}
+	if m.Waiting != 0 {
+		n += 1 + sovBarrier(uint64(m.Waiting))
+	}
+	if m.Broken {
+		n += 2
+	}
+	return n
+}
+
+func sovBarrier(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBarrier(x uint64) (n int) {
+	return sovBarrier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AwaitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBarrier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwaitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwaitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			m.Parties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parties |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBarrier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AwaitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBarrier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwaitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwaitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBarrier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBarrier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBarrier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBarrier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBarrier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBarrierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBarrier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBarrierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBarrierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBarrier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBarrierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBarrier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBarrierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			m.Parties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parties |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			m.Waiting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Waiting |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBarrier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBarrier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBarrier(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBarrier
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBarrier
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBarrier
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBarrier
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBarrier
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBarrier        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBarrier          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBarrier = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+
+const (
+	Name       = "BloomFilter"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = runtimev1.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
@@ -0,0 +1,1136 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: runtime/bloomfilter/v1/bloomfilter.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/atomix/atomix/api/runtime/v1"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AddRequest struct {
+	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Element string         `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+}
+
+func (m *AddRequest) Reset()         { *m = AddRequest{} }
+func (m *AddRequest) String() string { return proto.CompactTextString(m) }
+func (*AddRequest) ProtoMessage()    {}
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12d6286ba017250, []int{0}
+}
+func (m *AddRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRequest.Merge(m, src)
+}
+func (m *AddRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRequest proto.InternalMessageInfo
+
+func (m *AddRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *AddRequest) GetElement() string {
+	if m != nil {
+		return m.Element
+	}
+	return ""
+}
+
+type AddResponse struct {
+	// added indicates whether the element was definitely not in the filter before it was added
+	Added bool `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+}
+
+func (m *AddResponse) Reset()         { *m = AddResponse{} }
+func (m *AddResponse) String() string { return proto.CompactTextString(m) }
+func (*AddResponse) ProtoMessage()    {}
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12d6286ba017250, []int{1}
+}
+func (m *AddResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddResponse.Merge(m, src)
+}
+func (m *AddResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddResponse proto.InternalMessageInfo
+
+func (m *AddResponse) GetAdded() bool {
+	if m != nil {
+		return m.Added
+	}
+	return false
+}
+
+type MightContainRequest struct {
+	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Element string         `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+}
+
+func (m *MightContainRequest) Reset()         { *m = MightContainRequest{} }
+func (m *MightContainRequest) String() string { return proto.CompactTextString(m) }
+func (*MightContainRequest) ProtoMessage()    {}
+func (*MightContainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12d6286ba017250, []int{2}
+}
+func (m *MightContainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MightContainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MightContainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MightContainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MightContainRequest.Merge(m, src)
+}
+func (m *MightContainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MightContainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MightContainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MightContainRequest proto.InternalMessageInfo
+
+func (m *MightContainRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *MightContainRequest) GetElement() string {
+	if m != nil {
+		return m.Element
+	}
+	return ""
+}
+
+type MightContainResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *MightContainResponse) Reset()         { *m = MightContainResponse{} }
+func (m *MightContainResponse) String() string { return proto.CompactTextString(m) }
+func (*MightContainResponse) ProtoMessage()    {}
+func (*MightContainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12d6286ba017250, []int{3}
+}
+func (m *MightContainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MightContainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MightContainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MightContainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MightContainResponse.Merge(m, src)
+}
+func (m *MightContainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MightContainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MightContainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MightContainResponse proto.InternalMessageInfo
+
+func (m *MightContainResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AddRequest)(nil), "atomix.runtime.bloomfilter.v1.AddRequest")
+	proto.RegisterType((*AddResponse)(nil), "atomix.runtime.bloomfilter.v1.AddResponse")
+	proto.RegisterType((*MightContainRequest)(nil), "atomix.runtime.bloomfilter.v1.MightContainRequest")
+	proto.RegisterType((*MightContainResponse)(nil), "atomix.runtime.bloomfilter.v1.MightContainResponse")
+}
+
+func init() {
+	proto.RegisterFile("runtime/bloomfilter/v1/bloomfilter.proto", fileDescriptor_a12d6286ba017250)
+}
+
+var fileDescriptor_a12d6286ba017250 = []byte{
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0x3b, 0x25, 0xa0, 0x1e, 0x74, 0x33, 0x12, 0xd3, 0x34, 0x5a, 0x48, 0xdd, 0x80, 0xe2,
+	0x34, 0x85, 0x9d, 0x3b, 0x0a, 0xd1, 0xb0, 0x30, 0x21, 0x5d, 0xb8, 0x72, 0x53, 0x32, 0x63, 0x1d,
+	0x6d, 0x3b, 0xd8, 0x0e, 0xd5, 0x47, 0x70, 0xe9, 0xeb, 0xf8, 0x06, 0x2c, 0x59, 0xba, 0x22, 0xa6,
+	0xbc, 0x88, 0xa1, 0x7f, 0x72, 0x7b, 0xc9, 0xcd, 0x2d, 0x77, 0x71, 0x77, 0x73, 0x9a, 0xef, 0x3b,
+	0xbf, 0x2f, 0xdf, 0x49, 0x61, 0x18, 0x6f, 0x23, 0xc9, 0x43, 0x66, 0xad, 0x03, 0x21, 0xc2, 0xcf,
+	0x3c, 0x90, 0x2c, 0xb6, 0x52, 0xbb, 0x3e, 0x92, 0x4d, 0x2c, 0xa4, 0xc0, 0x2f, 0x3c, 0x29, 0x42,
+	0xfe, 0x93, 0x94, 0x06, 0x52, 0x57, 0xa4, 0xb6, 0xae, 0x55, 0x8b, 0x52, 0xdb, 0xaa, 0x24, 0xb9,
+	0x51, 0x1f, 0x35, 0x23, 0x92, 0x52, 0xda, 0xf3, 0x85, 0x2f, 0xf2, 0xa7, 0x75, 0x7a, 0x15, 0x5f,
+	0xcd, 0x35, 0xc0, 0x8c, 0x52, 0x97, 0x7d, 0xdf, 0xb2, 0x44, 0xe2, 0xb7, 0xa0, 0x72, 0xaa, 0xa1,
+	0x01, 0x1a, 0x76, 0x27, 0x06, 0x39, 0x0b, 0x95, 0xda, 0x64, 0x15, 0xf3, 0x90, 0x4b, 0x9e, 0xb2,
+	0xe5, 0xc2, 0x81, 0xdd, 0xa1, 0xaf, 0x64, 0x87, 0xbe, 0xba, 0x5c, 0xb8, 0x2a, 0xa7, 0x58, 0x83,
+	0x07, 0x2c, 0x60, 0x21, 0x8b, 0xa4, 0xa6, 0x0e, 0xd0, 0xf0, 0x91, 0x5b, 0x8d, 0xe6, 0x4b, 0xe8,
+	0xe6, 0x8c, 0x64, 0x23, 0xa2, 0x84, 0xe1, 0x1e, 0xb4, 0x3d, 0x4a, 0x59, 0xc1, 0x79, 0xe8, 0x16,
+	0x83, 0xf9, 0x0d, 0x9e, 0x7e, 0xe0, 0xfe, 0x17, 0x39, 0x17, 0x91, 0xf4, 0x78, 0x74, 0xbf, 0x89,
+	0x08, 0xf4, 0xae, 0xc3, 0xca, 0x68, 0xcf, 0xa0, 0x13, 0xb3, 0x64, 0x1b, 0xc8, 0x32, 0x5b, 0x39,
+	0x4d, 0xfe, 0xb4, 0xa0, 0xeb, 0x9c, 0x2a, 0x7d, 0x97, 0x57, 0x8a, 0x3f, 0x41, 0x6b, 0x46, 0x29,
+	0x1e, 0x91, 0x5b, 0xef, 0x46, 0xae, 0x9a, 0xd5, 0x5f, 0x5d, 0x22, 0x2d, 0x53, 0xfc, 0x80, 0xc7,
+	0xf5, 0x74, 0x78, 0xd2, 0xe0, 0xbd, 0xa1, 0x37, 0x7d, 0x7a, 0x27, 0x4f, 0x09, 0xfe, 0x0a, 0x9d,
+	0x79, 0xcc, 0x3c, 0xc9, 0xf0, 0xb8, 0xc1, 0x5e, 0xc8, 0x2a, 0xd8, 0x9b, 0x0b, 0xd5, 0x05, 0xc6,
+	0x6c, 0xfd, 0x52, 0x11, 0xf6, 0xa1, 0x3d, 0x0f, 0x44, 0xc2, 0xf0, 0xeb, 0x26, 0xf3, 0x49, 0x55,
+	0x91, 0xc6, 0x97, 0x89, 0x6b, 0x20, 0xe7, 0xfd, 0x2e, 0x33, 0xd0, 0x3e, 0x33, 0xd0, 0xbf, 0xcc,
+	0x40, 0xbf, 0x8f, 0x86, 0xb2, 0x3f, 0x1a, 0xca, 0xdf, 0xa3, 0xa1, 0xc0, 0x73, 0x2e, 0xaa, 0x75,
+	0xde, 0x86, 0x9f, 0xad, 0x72, 0x9e, 0xd4, 0x0e, 0xfe, 0xd1, 0x5e, 0xa1, 0x75, 0x27, 0xff, 0x63,
+	0xa6, 0xff, 0x07, 0x00, 0x7a, 0x93, 0xe1, 0x62, 0xd7, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BloomFilterClient is the client API for BloomFilter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BloomFilterClient interface {
+	// Add adds an element to the filter
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	// MightContain checks whether an element might have been added to the filter
+	MightContain(ctx context.Context, in *MightContainRequest, opts ...grpc.CallOption) (*MightContainResponse, error)
+	// Create creates the BloomFilter
+	// Deprecated: use the BloomFilters service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the BloomFilter
+	// Deprecated: use the BloomFilters service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+}
+
+type bloomFilterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBloomFilterClient(cc *grpc.ClientConn) BloomFilterClient {
+	return &bloomFilterClient{cc}
+}
+
+func (c *bloomFilterClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.bloomfilter.v1.BloomFilter/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloomFilterClient) MightContain(ctx context.Context, in *MightContainRequest, opts ...grpc.CallOption) (*MightContainResponse, error) {
+	out := new(MightContainResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.bloomfilter.v1.BloomFilter/MightContain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bloomFilterClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.bloomfilter.v1.BloomFilter/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bloomFilterClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.bloomfilter.v1.BloomFilter/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BloomFilterServer is the server API for BloomFilter service.
+type BloomFilterServer interface {
+	// Add adds an element to the filter
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	// MightContain checks whether an element might have been added to the filter
+	MightContain(context.Context, *MightContainRequest) (*MightContainResponse, error)
+	// Create creates the BloomFilter
+	// Deprecated: use the BloomFilters service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the BloomFilter
+	// Deprecated: use the BloomFilters service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
+}
+
+// UnimplementedBloomFilterServer can be embedded to have forward compatible implementations.
+type UnimplementedBloomFilterServer struct {
+}
+
+func (*UnimplementedBloomFilterServer) Add(ctx context.Context, req *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedBloomFilterServer) MightContain(ctx context.Context, req *MightContainRequest) (*MightContainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MightContain not implemented")
+}
+func (*UnimplementedBloomFilterServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedBloomFilterServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+
+func RegisterBloomFilterServer(s *grpc.Server, srv BloomFilterServer) {
+	s.RegisterService(&_BloomFilter_serviceDesc, srv)
+}
+
+func _BloomFilter_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloomFilterServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.bloomfilter.v1.BloomFilter/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloomFilterServer).Add(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloomFilter_MightContain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MightContainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloomFilterServer).MightContain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.bloomfilter.v1.BloomFilter/MightContain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloomFilterServer).MightContain(ctx, req.(*MightContainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloomFilter_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloomFilterServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.bloomfilter.v1.BloomFilter/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloomFilterServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloomFilter_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloomFilterServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.bloomfilter.v1.BloomFilter/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloomFilterServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BloomFilter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.bloomfilter.v1.BloomFilter",
+	HandlerType: (*BloomFilterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _BloomFilter_Add_Handler,
+		},
+		{
+			MethodName: "MightContain",
+			Handler:    _BloomFilter_MightContain_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _BloomFilter_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _BloomFilter_Close_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "runtime/bloomfilter/v1/bloomfilter.proto",
+}
+
+func (m *AddRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Element) > 0 {
+		i -= len(m.Element)
+		copy(dAtA[i:], m.Element)
+		i = encodeVarintBloomfilter(dAtA, i, uint64(len(m.Element)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AddResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Added {
+		i--
+		if m.Added {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MightContainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MightContainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MightContainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Element) > 0 {
+		i -= len(m.Element)
+		copy(dAtA[i:], m.Element)
+		i = encodeVarintBloomfilter(dAtA, i, uint64(len(m.Element)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MightContainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MightContainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MightContainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBloomfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBloomfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovBloomfilter(uint64(l))
+	l = len(m.Element)
+	if l > 0 {
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *AddResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Added {
+		n += 2
+	}
+	return n
+}
+
+func (m *MightContainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovBloomfilter(uint64(l))
+	l = len(m.Element)
+	if l > 0 {
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *MightContainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func sovBloomfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBloomfilter(x uint64) (n int) {
+	return sovBloomfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Element = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Added = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MightContainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MightContainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MightContainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Element = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MightContainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MightContainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MightContainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBloomfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBloomfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBloomfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBloomfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBloomfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBloomfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBloomfilter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -31,6 +31,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type IncrementRequest struct {
 	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Delta int64          `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// saturate sets the counter to the crossed bound rather than failing with an OutOfRange error
+	// when the increment would cross a bound
+	Saturate bool `protobuf:"varint,3,opt,name=saturate,proto3" json:"saturate,omitempty"`
 }
 
 func (m *IncrementRequest) Reset()         { *m = IncrementRequest{} }
@@ -80,6 +83,13 @@ func (m *IncrementRequest) GetDelta() int64 {
 	return 0
 }
 
+func (m *IncrementRequest) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
+}
+
 type IncrementResponse struct {
 	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -127,6 +137,9 @@ func (m *IncrementResponse) GetValue() int64 {
 type DecrementRequest struct {
 	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Delta int64          `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// saturate sets the counter to the crossed bound rather than failing with an OutOfRange error
+	// when the decrement would cross a bound
+	Saturate bool `protobuf:"varint,3,opt,name=saturate,proto3" json:"saturate,omitempty"`
 }
 
 func (m *DecrementRequest) Reset()         { *m = DecrementRequest{} }
@@ -176,6 +189,13 @@ func (m *DecrementRequest) GetDelta() int64 {
 	return 0
 }
 
+func (m *DecrementRequest) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
+}
+
 type DecrementResponse struct {
 	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -265,7 +285,8 @@ func (m *GetRequest) GetID() v1.PrimitiveID {
 }
 
 type GetResponse struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Value  int64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
 }
 
 func (m *GetResponse) Reset()         { *m = GetResponse{} }
@@ -308,6 +329,13 @@ func (m *GetResponse) GetValue() int64 {
 	return 0
 }
 
+func (m *GetResponse) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
 type Precondition struct {
 	// Types that are valid to be assigned to Precondition:
 	//	*Precondition_Value
@@ -580,6 +608,104 @@ func (m *UpdateResponse) GetValue() int64 {
 	return 0
 }
 
+type SetBoundsRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// bounds are the new bounds of the counter value
+	// A counter value outside the new bounds is moved to the nearest bound
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
+}
+
+func (m *SetBoundsRequest) Reset()         { *m = SetBoundsRequest{} }
+func (m *SetBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsRequest) ProtoMessage()    {}
+func (*SetBoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9412904b15cd4072, []int{11}
+}
+func (m *SetBoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsRequest.Merge(m, src)
+}
+func (m *SetBoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBoundsRequest proto.InternalMessageInfo
+
+func (m *SetBoundsRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *SetBoundsRequest) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+type SetBoundsResponse struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SetBoundsResponse) Reset()         { *m = SetBoundsResponse{} }
+func (m *SetBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsResponse) ProtoMessage()    {}
+func (*SetBoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9412904b15cd4072, []int{12}
+}
+func (m *SetBoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsResponse.Merge(m, src)
+}
+func (m *SetBoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBoundsResponse proto.InternalMessageInfo
+
+func (m *SetBoundsResponse) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IncrementRequest)(nil), "atomix.runtime.counter.v1.IncrementRequest")
 	proto.RegisterType((*IncrementResponse)(nil), "atomix.runtime.counter.v1.IncrementResponse")
@@ -592,44 +718,51 @@ func init() {
 	proto.RegisterType((*SetResponse)(nil), "atomix.runtime.counter.v1.SetResponse")
 	proto.RegisterType((*UpdateRequest)(nil), "atomix.runtime.counter.v1.UpdateRequest")
 	proto.RegisterType((*UpdateResponse)(nil), "atomix.runtime.counter.v1.UpdateResponse")
+	proto.RegisterType((*SetBoundsRequest)(nil), "atomix.runtime.counter.v1.SetBoundsRequest")
+	proto.RegisterType((*SetBoundsResponse)(nil), "atomix.runtime.counter.v1.SetBoundsResponse")
 }
 
 func init() { proto.RegisterFile("runtime/counter/v1/counter.proto", fileDescriptor_9412904b15cd4072) }
 
 var fileDescriptor_9412904b15cd4072 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xfd, 0x47, 0x09, 0xea, 0xa4, 0x54, 0xb0, 0xaa, 0x2a, 0xe3, 0x83, 0x1b, 0x5c, 0x51,
-	0x52, 0x81, 0x1c, 0xb9, 0x48, 0x1c, 0xb8, 0x20, 0x25, 0x91, 0xd2, 0xdc, 0xa2, 0xa4, 0x70, 0x03,
-	0xe4, 0xda, 0x43, 0x59, 0x91, 0x78, 0x8d, 0xbd, 0xb6, 0xe0, 0x0d, 0x38, 0xf2, 0x1a, 0xbc, 0x49,
-	0x8f, 0x3d, 0x72, 0xaa, 0x50, 0xf2, 0x22, 0x28, 0xb6, 0xb7, 0x75, 0x83, 0x6a, 0xfb, 0x10, 0xf5,
-	0xb6, 0xb3, 0xfb, 0xcd, 0x7c, 0x3f, 0xed, 0xee, 0x0c, 0xb4, 0xc3, 0xd8, 0xe7, 0x74, 0x8e, 0x5d,
-	0x97, 0xc5, 0x3e, 0xc7, 0xb0, 0x9b, 0xd8, 0x62, 0x69, 0x05, 0x21, 0xe3, 0x8c, 0x3c, 0x71, 0x38,
-	0x9b, 0xd3, 0xef, 0x56, 0x2e, 0xb4, 0xc4, 0x69, 0x62, 0xeb, 0x9a, 0x48, 0x4e, 0xec, 0xae, 0x38,
-	0x4e, 0x93, 0xf4, 0xa7, 0x77, 0x97, 0x8d, 0x72, 0xc9, 0xee, 0x39, 0x3b, 0x67, 0xe9, 0xb2, 0xbb,
-	0x5a, 0x65, 0xbb, 0xa6, 0x07, 0x8f, 0x46, 0xbe, 0x1b, 0xe2, 0x1c, 0x7d, 0x3e, 0xc1, 0x6f, 0x31,
-	0x46, 0x9c, 0xbc, 0x01, 0x85, 0x7a, 0x9a, 0xdc, 0x96, 0x3b, 0xad, 0x63, 0xc3, 0x5a, 0xc3, 0x49,
-	0x6c, 0x6b, 0x1c, 0xd2, 0x39, 0xe5, 0x34, 0xc1, 0xd1, 0xa0, 0x07, 0x17, 0x57, 0xfb, 0xd2, 0xe2,
-	0x6a, 0x5f, 0x19, 0x0d, 0x26, 0x0a, 0xf5, 0xc8, 0x2e, 0x34, 0x3c, 0x9c, 0x71, 0x47, 0x53, 0xda,
-	0x72, 0x47, 0x9d, 0x64, 0x81, 0x79, 0x04, 0x8f, 0x0b, 0x2e, 0x51, 0xc0, 0xfc, 0x08, 0x57, 0xd2,
-	0xc4, 0x99, 0xc5, 0x98, 0x3a, 0xa9, 0x93, 0x2c, 0x58, 0x01, 0x0d, 0xf0, 0x3e, 0x80, 0x0a, 0x2e,
-	0xa5, 0x40, 0x27, 0x00, 0x43, 0xdc, 0x04, 0x8a, 0x79, 0x00, 0xad, 0xb4, 0x52, 0xa9, 0xdd, 0x6b,
-	0xd8, 0x1e, 0x87, 0xe8, 0x32, 0xdf, 0xa3, 0x9c, 0x32, 0x9f, 0xec, 0xdd, 0x52, 0x9d, 0x48, 0xb9,
-	0xae, 0xb7, 0x03, 0xdb, 0x41, 0x41, 0x67, 0x7e, 0x04, 0x98, 0xe2, 0xa6, 0x6e, 0x2c, 0x73, 0x54,
-	0x8a, 0x5c, 0x07, 0xd0, 0x9a, 0x56, 0xc2, 0xff, 0x80, 0x87, 0xef, 0x02, 0xcf, 0xe1, 0xb8, 0x21,
-	0x0e, 0xf7, 0x0b, 0xba, 0x5f, 0x05, 0x47, 0x1a, 0x90, 0x3d, 0x68, 0xc6, 0xa9, 0x85, 0xa6, 0xa6,
-	0xdb, 0x79, 0x64, 0x1e, 0xc2, 0x8e, 0xb0, 0x2e, 0x43, 0x3c, 0xfe, 0xdd, 0x80, 0x07, 0xfd, 0xac,
-	0x33, 0xc8, 0x29, 0xa8, 0x53, 0xe4, 0xe4, 0x99, 0x75, 0x67, 0xcb, 0x59, 0x37, 0x77, 0xaa, 0x1f,
-	0x56, 0xc9, 0x72, 0xdf, 0x0f, 0xd0, 0xcc, 0x48, 0x48, 0xa7, 0x24, 0xe3, 0xd6, 0x3d, 0xe9, 0x47,
-	0x35, 0x94, 0x79, 0xf9, 0x53, 0x50, 0x87, 0x15, 0xd0, 0xc3, 0x7a, 0xd0, 0xc5, 0xcf, 0xf8, 0x19,
-	0xb6, 0xae, 0x3b, 0x94, 0xbc, 0x28, 0x49, 0x5a, 0x9f, 0x16, 0xfa, 0xcb, 0x7a, 0xe2, 0x1b, 0x9f,
-	0xeb, 0xc6, 0x2b, 0xf5, 0x59, 0x1f, 0x02, 0xa5, 0x3e, 0xff, 0xf7, 0xf2, 0x19, 0x34, 0xfb, 0x21,
-	0x56, 0x3d, 0x42, 0x26, 0xa9, 0xf3, 0x08, 0x42, 0x99, 0x95, 0x37, 0xd5, 0x9f, 0x8a, 0x4c, 0x3e,
-	0x41, 0xa3, 0x3f, 0x63, 0x11, 0x92, 0xe7, 0x65, 0x89, 0x2b, 0x85, 0x70, 0xe8, 0x54, 0x0b, 0x0b,
-	0x06, 0xbd, 0xb7, 0x17, 0x0b, 0x43, 0xbe, 0x5c, 0x18, 0xf2, 0xdf, 0x85, 0x21, 0xff, 0x5a, 0x1a,
-	0xd2, 0xe5, 0xd2, 0x90, 0xfe, 0x2c, 0x0d, 0x09, 0x34, 0xca, 0x44, 0x29, 0x27, 0xa0, 0x85, 0x32,
-	0xbd, 0xad, 0xfc, 0x73, 0xbf, 0xb7, 0xc7, 0xf2, 0x59, 0x33, 0x1d, 0xf2, 0xaf, 0xfe, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xe9, 0xe2, 0xb1, 0xd7, 0x76, 0x06, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x5b, 0x35, 0xcd, 0xa4, 0x54, 0xed, 0xaa, 0xaa, 0x82, 0x0f, 0x6e, 0x6a, 0x44,
+	0x49, 0x44, 0xe5, 0x28, 0x41, 0x42, 0x82, 0x0b, 0x52, 0x62, 0x29, 0xcd, 0x2d, 0x72, 0x0a, 0x37,
+	0x8a, 0x9c, 0x78, 0x5a, 0x2c, 0x12, 0xaf, 0xb1, 0xd7, 0x16, 0xdc, 0x38, 0xc2, 0x0d, 0xde, 0xaa,
+	0xc7, 0x1e, 0x39, 0x55, 0x28, 0x79, 0x11, 0x14, 0xff, 0x49, 0xdd, 0xa0, 0xda, 0x46, 0x44, 0xea,
+	0x6d, 0x27, 0xfe, 0x66, 0xbe, 0xdf, 0xee, 0xcc, 0x6e, 0xa0, 0xe6, 0xfa, 0x36, 0xb3, 0xa6, 0xd8,
+	0x1c, 0x53, 0xdf, 0x66, 0xe8, 0x36, 0x83, 0x56, 0xb2, 0x54, 0x1d, 0x97, 0x32, 0x4a, 0x1e, 0x19,
+	0x8c, 0x4e, 0xad, 0xcf, 0x6a, 0x2c, 0x54, 0x93, 0xaf, 0x41, 0x4b, 0xaa, 0x26, 0xc9, 0x41, 0xab,
+	0x99, 0x7c, 0x0e, 0x93, 0xa4, 0xc3, 0xbb, 0xcb, 0x7a, 0xb1, 0x64, 0xef, 0x82, 0x5e, 0xd0, 0x70,
+	0xd9, 0x5c, 0xac, 0xa2, 0x5f, 0x95, 0xaf, 0x1c, 0xec, 0xf4, 0xed, 0xb1, 0x8b, 0x53, 0xb4, 0x99,
+	0x8e, 0x9f, 0x7c, 0xf4, 0x18, 0x79, 0x05, 0xbc, 0x65, 0x56, 0xb9, 0x1a, 0x57, 0xaf, 0xb4, 0x65,
+	0x75, 0x85, 0x27, 0x68, 0xa9, 0x03, 0xd7, 0x9a, 0x5a, 0xcc, 0x0a, 0xb0, 0xaf, 0x75, 0xe0, 0xf2,
+	0xfa, 0xa0, 0x34, 0xbb, 0x3e, 0xe0, 0xfb, 0x9a, 0xce, 0x5b, 0x26, 0xd9, 0x83, 0x0d, 0x13, 0x27,
+	0xcc, 0xa8, 0xf2, 0x35, 0xae, 0x2e, 0xe8, 0x51, 0x40, 0x24, 0xd8, 0xf4, 0x0c, 0xe6, 0xbb, 0x06,
+	0xc3, 0xaa, 0x50, 0xe3, 0xea, 0x9b, 0xfa, 0x32, 0x56, 0x1a, 0xb0, 0x9b, 0x22, 0xf0, 0x1c, 0x6a,
+	0x7b, 0xb8, 0x28, 0x13, 0x18, 0x13, 0x1f, 0x43, 0x0a, 0x41, 0x8f, 0x82, 0x90, 0x56, 0xc3, 0xfb,
+	0xa6, 0xd5, 0xb0, 0x18, 0xed, 0x09, 0x40, 0x0f, 0xd7, 0x81, 0xa9, 0x9c, 0x41, 0xa5, 0x87, 0x39,
+	0x76, 0xe4, 0x25, 0x88, 0x23, 0xea, 0xdb, 0xa6, 0x17, 0x6e, 0xa6, 0xd2, 0x3e, 0x54, 0xef, 0x9c,
+	0x24, 0xb5, 0x13, 0x0a, 0xf5, 0x38, 0x41, 0x79, 0x01, 0x5b, 0x03, 0x17, 0xc7, 0xd4, 0x36, 0x2d,
+	0x66, 0x51, 0x9b, 0xec, 0xdf, 0x32, 0x38, 0x29, 0xc5, 0x16, 0x9d, 0x6d, 0xd8, 0x72, 0x52, 0x3a,
+	0xe5, 0x0c, 0x60, 0x88, 0xeb, 0x6a, 0x44, 0xe4, 0xc8, 0xa7, 0x4f, 0xf0, 0x31, 0x54, 0x86, 0x79,
+	0xfb, 0x56, 0xbe, 0xc0, 0xc3, 0x37, 0x8e, 0x69, 0x30, 0x5c, 0x13, 0xc7, 0xf8, 0x03, 0x8e, 0x3f,
+	0x26, 0x1c, 0x61, 0x40, 0xf6, 0x41, 0xf4, 0x1d, 0x33, 0x19, 0x07, 0x41, 0x8f, 0x23, 0xe5, 0x08,
+	0xb6, 0x13, 0xeb, 0x4c, 0xc4, 0xef, 0x1c, 0xec, 0x0c, 0x91, 0xc5, 0xa7, 0xbe, 0x06, 0xcc, 0xff,
+	0xe8, 0x75, 0x03, 0x76, 0x53, 0x28, 0x59, 0xd8, 0xed, 0x9f, 0x22, 0x3c, 0xe8, 0x46, 0x85, 0xc8,
+	0x29, 0x08, 0x43, 0x64, 0xe4, 0x49, 0x86, 0xd1, 0xcd, 0x28, 0x48, 0x47, 0x79, 0xb2, 0xd8, 0xf7,
+	0x1d, 0x88, 0xd1, 0x01, 0x92, 0x7a, 0x46, 0xc6, 0xad, 0xf6, 0x4a, 0x8d, 0x02, 0xca, 0xb8, 0xfc,
+	0x29, 0x08, 0xbd, 0x1c, 0xe8, 0x5e, 0x31, 0xe8, 0xf4, 0xf5, 0x3b, 0x87, 0xf2, 0xf2, 0xc1, 0x22,
+	0xcf, 0x32, 0x92, 0x56, 0x1f, 0x56, 0xe9, 0xb8, 0x98, 0xf8, 0xc6, 0x47, 0xc3, 0x22, 0x3e, 0x1a,
+	0xfe, 0x83, 0xcf, 0xdf, 0xaf, 0xd7, 0x39, 0x94, 0x97, 0x13, 0x91, 0xe9, 0xb3, 0x3a, 0xc2, 0xd2,
+	0x71, 0x31, 0x71, 0xec, 0x33, 0x02, 0xb1, 0xeb, 0x62, 0x5e, 0xb3, 0x23, 0x49, 0x91, 0x66, 0x27,
+	0xca, 0xa8, 0xbc, 0x22, 0x7c, 0xe3, 0x39, 0xf2, 0x1e, 0x36, 0xba, 0x13, 0xea, 0x21, 0x79, 0x9a,
+	0x95, 0xb8, 0x50, 0x24, 0x0e, 0xf5, 0x7c, 0x61, 0xca, 0xa0, 0xf3, 0xfa, 0x72, 0x26, 0x73, 0x57,
+	0x33, 0x99, 0xfb, 0x3d, 0x93, 0xb9, 0x1f, 0x73, 0xb9, 0x74, 0x35, 0x97, 0x4b, 0xbf, 0xe6, 0x72,
+	0x09, 0xaa, 0x16, 0x4d, 0x4a, 0x19, 0x8e, 0x95, 0x2a, 0xd3, 0x29, 0xc7, 0x97, 0xe8, 0x6d, 0x6b,
+	0xc0, 0x8d, 0xc4, 0xf0, 0x8f, 0xf7, 0xf9, 0x9f, 0x01, 0x00, 0x79, 0x80, 0xc8, 0x15, 0x0a, 0x08,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -654,6 +787,9 @@ type CounterClient interface {
 	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
 	// Decrement decrements the counter value
 	Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error)
+	// SetBounds sets the lower and upper bounds of the counter value
+	// Updates that would move the value outside the bounds fail with an OutOfRange error
+	SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error)
 	// Create creates the counter
 	// Deprecated: use the Counters service instead
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
@@ -715,6 +851,15 @@ func (c *counterClient) Decrement(ctx context.Context, in *DecrementRequest, opt
 	return out, nil
 }
 
+func (c *counterClient) SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error) {
+	out := new(SetBoundsResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.counter.v1.Counter/SetBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *counterClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
 	out := new(CreateResponse)
@@ -747,6 +892,9 @@ type CounterServer interface {
 	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
 	// Decrement decrements the counter value
 	Decrement(context.Context, *DecrementRequest) (*DecrementResponse, error)
+	// SetBounds sets the lower and upper bounds of the counter value
+	// Updates that would move the value outside the bounds fail with an OutOfRange error
+	SetBounds(context.Context, *SetBoundsRequest) (*SetBoundsResponse, error)
 	// Create creates the counter
 	// Deprecated: use the Counters service instead
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
@@ -774,6 +922,9 @@ func (*UnimplementedCounterServer) Increment(ctx context.Context, req *Increment
 func (*UnimplementedCounterServer) Decrement(ctx context.Context, req *DecrementRequest) (*DecrementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Decrement not implemented")
 }
+func (*UnimplementedCounterServer) SetBounds(ctx context.Context, req *SetBoundsRequest) (*SetBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBounds not implemented")
+}
 func (*UnimplementedCounterServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
@@ -875,6 +1026,24 @@ func _Counter_Decrement_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Counter_SetBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServer).SetBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.counter.v1.Counter/SetBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServer).SetBounds(ctx, req.(*SetBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Counter_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRequest)
 	if err := dec(in); err != nil {
@@ -935,6 +1104,10 @@ var _Counter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Decrement",
 			Handler:    _Counter_Decrement_Handler,
 		},
+		{
+			MethodName: "SetBounds",
+			Handler:    _Counter_SetBounds_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _Counter_Create_Handler,
@@ -968,6 +1141,16 @@ func (m *IncrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Saturate {
+		i--
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Delta != 0 {
 		i = encodeVarintCounter(dAtA, i, uint64(m.Delta))
 		i--
@@ -1034,6 +1217,16 @@ func (m *DecrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Saturate {
+		i--
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Delta != 0 {
 		i = encodeVarintCounter(dAtA, i, uint64(m.Delta))
 		i--
@@ -1133,6 +1326,18 @@ func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Value != 0 {
 		i = encodeVarintCounter(dAtA, i, uint64(m.Value))
 		i--
@@ -1322,6 +1527,79 @@ func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetBoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCounter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCounter(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCounter(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCounter(v)
 	base := offset
@@ -1344,6 +1622,9 @@ func (m *IncrementRequest) Size() (n int) {
 	if m.Delta != 0 {
 		n += 1 + sovCounter(uint64(m.Delta))
 	}
+	if m.Saturate {
+		n += 2
+	}
 	return n
 }
 
@@ -1370,6 +1651,9 @@ func (m *DecrementRequest) Size() (n int) {
 	if m.Delta != 0 {
 		n += 1 + sovCounter(uint64(m.Delta))
 	}
+	if m.Saturate {
+		n += 2
+	}
 	return n
 }
 
@@ -1405,6 +1689,10 @@ func (m *GetResponse) Size() (n int) {
 	if m.Value != 0 {
 		n += 1 + sovCounter(uint64(m.Value))
 	}
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
 	return n
 }
 
@@ -1484,6 +1772,33 @@ func (m *UpdateResponse) Size() (n int) {
 	return n
 }
 
+func (m *SetBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCounter(uint64(l))
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCounter(uint64(m.Value))
+	}
+	return n
+}
+
 func sovCounter(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1571,6 +1886,26 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Saturate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -1742,6 +2077,26 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Saturate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -1963,6 +2318,42 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -2415,6 +2806,194 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetBoundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBoundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCounter(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Config struct {
 	Cache CacheConfig `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache"`
+	// bounds are the initial bounds of the counter value
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -72,6 +74,114 @@ func (m *Config) GetCache() CacheConfig {
 	return CacheConfig{}
 }
 
+func (m *Config) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+// Bounds are the limits of a counter value
+type Bounds struct {
+	// min is the lowest value the counter may hold
+	// The counter is unbounded below when unset
+	Min *Bound `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the highest value the counter may hold
+	// The counter is unbounded above when unset
+	Max *Bound `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *Bounds) Reset()         { *m = Bounds{} }
+func (m *Bounds) String() string { return proto.CompactTextString(m) }
+func (*Bounds) ProtoMessage()    {}
+func (*Bounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0860f25a54d1877, []int{1}
+}
+func (m *Bounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bounds.Merge(m, src)
+}
+func (m *Bounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bounds proto.InternalMessageInfo
+
+func (m *Bounds) GetMin() *Bound {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *Bounds) GetMax() *Bound {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+type Bound struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Bound) Reset()         { *m = Bound{} }
+func (m *Bound) String() string { return proto.CompactTextString(m) }
+func (*Bound) ProtoMessage()    {}
+func (*Bound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0860f25a54d1877, []int{2}
+}
+func (m *Bound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bound.Merge(m, src)
+}
+func (m *Bound) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bound proto.InternalMessageInfo
+
+func (m *Bound) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 type CacheConfig struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
@@ -80,7 +190,7 @@ func (m *CacheConfig) Reset()         { *m = CacheConfig{} }
 func (m *CacheConfig) String() string { return proto.CompactTextString(m) }
 func (*CacheConfig) ProtoMessage()    {}
 func (*CacheConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0860f25a54d1877, []int{1}
+	return fileDescriptor_d0860f25a54d1877, []int{3}
 }
 func (m *CacheConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +235,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0860f25a54d1877, []int{2}
+	return fileDescriptor_d0860f25a54d1877, []int{4}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,7 +286,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0860f25a54d1877, []int{3}
+	return fileDescriptor_d0860f25a54d1877, []int{5}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +330,7 @@ func (m *CloseRequest) Reset()         { *m = CloseRequest{} }
 func (m *CloseRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseRequest) ProtoMessage()    {}
 func (*CloseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0860f25a54d1877, []int{4}
+	return fileDescriptor_d0860f25a54d1877, []int{6}
 }
 func (m *CloseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +373,7 @@ func (m *CloseResponse) Reset()         { *m = CloseResponse{} }
 func (m *CloseResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseResponse) ProtoMessage()    {}
 func (*CloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0860f25a54d1877, []int{5}
+	return fileDescriptor_d0860f25a54d1877, []int{7}
 }
 func (m *CloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,6 +404,8 @@ var xxx_messageInfo_CloseResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Config)(nil), "atomix.runtime.counter.v1.Config")
+	proto.RegisterType((*Bounds)(nil), "atomix.runtime.counter.v1.Bounds")
+	proto.RegisterType((*Bound)(nil), "atomix.runtime.counter.v1.Bound")
 	proto.RegisterType((*CacheConfig)(nil), "atomix.runtime.counter.v1.CacheConfig")
 	proto.RegisterType((*CreateRequest)(nil), "atomix.runtime.counter.v1.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "atomix.runtime.counter.v1.CreateResponse")
@@ -304,31 +416,35 @@ func init() {
 func init() { proto.RegisterFile("runtime/counter/v1/counters.proto", fileDescriptor_d0860f25a54d1877) }
 
 var fileDescriptor_d0860f25a54d1877 = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbf, 0x4e, 0xb3, 0x50,
-	0x14, 0x07, 0xbe, 0x96, 0xaf, 0x9e, 0x5a, 0x4d, 0x6e, 0x1c, 0x90, 0x81, 0xb6, 0x0c, 0x16, 0x17,
-	0x08, 0x75, 0x73, 0xd1, 0x40, 0x97, 0x1a, 0x87, 0xca, 0xe0, 0x60, 0x62, 0x0c, 0xa5, 0x57, 0xbc,
-	0x49, 0xcb, 0xad, 0x70, 0x4b, 0x7c, 0x0c, 0x5f, 0xca, 0xa4, 0x63, 0x47, 0xa7, 0xc6, 0xd0, 0x17,
-	0x31, 0x85, 0x4b, 0x42, 0x4c, 0xac, 0x0e, 0x6e, 0x07, 0xce, 0xef, 0xef, 0x01, 0xe8, 0xc6, 0x8b,
-	0x88, 0x91, 0x19, 0xb6, 0x02, 0xba, 0x88, 0x18, 0x8e, 0xad, 0xd4, 0x2e, 0xc7, 0xc4, 0x9c, 0xc7,
-	0x94, 0x51, 0x74, 0xec, 0x33, 0x3a, 0x23, 0x2f, 0x26, 0x47, 0x9a, 0x7c, 0x6d, 0xa6, 0xb6, 0xaa,
-	0x94, 0xec, 0xd4, 0xb6, 0xca, 0x75, 0x4e, 0x52, 0x8f, 0x42, 0x1a, 0xd2, 0x7c, 0xb4, 0xb6, 0x53,
-	0xf1, 0x56, 0xbf, 0x06, 0xd9, 0xa5, 0xd1, 0x23, 0x09, 0x91, 0x03, 0xf5, 0xc0, 0x0f, 0x9e, 0xb0,
-	0x22, 0x76, 0x44, 0xa3, 0xd9, 0x3f, 0x31, 0xbf, 0x35, 0x31, 0xdd, 0x2d, 0xae, 0xa0, 0x39, 0xb5,
-	0xe5, 0xba, 0x2d, 0x78, 0x05, 0x55, 0xef, 0x41, 0xb3, 0xb2, 0x43, 0x0a, 0xfc, 0xc7, 0x91, 0x3f,
-	0x9e, 0xe2, 0x49, 0x2e, 0xda, 0xf0, 0xca, 0x47, 0xfd, 0x01, 0x5a, 0x6e, 0x8c, 0x7d, 0x86, 0x3d,
-	0xfc, 0xbc, 0xc0, 0x09, 0x43, 0xe7, 0x20, 0x91, 0x09, 0xb7, 0xd6, 0xbe, 0x5a, 0xa7, 0xb6, 0x39,
-	0x8a, 0xc9, 0x8c, 0x30, 0x92, 0xe2, 0xe1, 0xc0, 0x81, 0xad, 0x65, 0xb6, 0x6e, 0x4b, 0xc3, 0x81,
-	0x27, 0x91, 0x09, 0x42, 0x50, 0x63, 0x7e, 0x98, 0x28, 0x52, 0xe7, 0x9f, 0xb1, 0xe7, 0xe5, 0xb3,
-	0x7e, 0x03, 0x07, 0xa5, 0x41, 0x32, 0xa7, 0x51, 0x82, 0xd1, 0x05, 0xc8, 0x41, 0x1e, 0x8b, 0xbb,
-	0x74, 0x77, 0x15, 0xac, 0x76, 0xe3, 0x34, 0xfd, 0x0a, 0xf6, 0xdd, 0x29, 0x4d, 0xfe, 0x22, 0xb2,
-	0x7e, 0x08, 0x2d, 0xae, 0x55, 0xa4, 0xeb, 0xbf, 0x89, 0xd0, 0x70, 0xf9, 0x57, 0x46, 0xf7, 0x20,
-	0x17, 0xe1, 0x91, 0xb1, 0x2b, 0x64, 0xf5, 0x80, 0xea, 0xe9, 0x2f, 0x90, 0xfc, 0x12, 0x77, 0x50,
-	0xcf, 0xcd, 0x51, 0x6f, 0x17, 0xa7, 0x52, 0x55, 0x35, 0x7e, 0x06, 0x16, 0xda, 0xce, 0xe5, 0x32,
-	0xd3, 0xc4, 0x55, 0xa6, 0x89, 0x1f, 0x99, 0x26, 0xbe, 0x6e, 0x34, 0x61, 0xb5, 0xd1, 0x84, 0xf7,
-	0x8d, 0x26, 0x80, 0x42, 0x68, 0xa9, 0xe2, 0xcf, 0x49, 0x45, 0xc1, 0x81, 0xb2, 0xf8, 0xad, 0x3d,
-	0x12, 0xc7, 0x72, 0xfe, 0x63, 0x9e, 0x7d, 0x06, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x79, 0x2c, 0xc4,
-	0x08, 0x03, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x39, 0x8d, 0x29, 0xaf, 0x14, 0xa4, 0x53, 0x07, 0x13, 0x09, 0xb7, 0xbd, 0x81, 0x86,
+	0xc5, 0x91, 0xc3, 0x04, 0x0b, 0xc8, 0xee, 0x52, 0xa6, 0x72, 0x03, 0x03, 0x12, 0x42, 0x97, 0xe4,
+	0x30, 0x27, 0xc5, 0x3e, 0x63, 0x9f, 0xad, 0xfc, 0x03, 0x56, 0xfe, 0x14, 0x52, 0xc7, 0x8e, 0x4c,
+	0x15, 0x72, 0xfe, 0x08, 0xf2, 0xdd, 0x59, 0x32, 0x48, 0x24, 0x19, 0xba, 0xbd, 0x77, 0xf7, 0x7d,
+	0xdf, 0xfb, 0xde, 0x67, 0x1f, 0x9c, 0x17, 0x55, 0xa6, 0x44, 0xca, 0xa7, 0x0b, 0x59, 0x65, 0x8a,
+	0x17, 0xd3, 0x3a, 0xec, 0xca, 0x32, 0xc8, 0x0b, 0xa9, 0x24, 0x7e, 0xca, 0x94, 0x4c, 0xc5, 0x3a,
+	0xb0, 0xc8, 0xc0, 0x5e, 0x07, 0x75, 0x38, 0xf6, 0x3a, 0x76, 0x1d, 0x4e, 0xbb, 0x6b, 0x4d, 0x1a,
+	0x9f, 0x24, 0x32, 0x91, 0xba, 0x9c, 0xb6, 0x95, 0x39, 0x25, 0xdf, 0x11, 0xb8, 0xb1, 0xcc, 0xbe,
+	0x88, 0x04, 0x47, 0x30, 0x5a, 0xb0, 0xc5, 0x57, 0xee, 0xa1, 0x33, 0x34, 0x39, 0x9a, 0x3d, 0x0f,
+	0xfe, 0x3b, 0x25, 0x88, 0x5b, 0x9c, 0xa1, 0x45, 0x07, 0x37, 0x77, 0xa7, 0x03, 0x6a, 0xa8, 0xf8,
+	0x15, 0xb8, 0x73, 0x59, 0x65, 0xcb, 0xd2, 0x73, 0xb4, 0xc8, 0xf9, 0x16, 0x91, 0x48, 0x03, 0xa9,
+	0x25, 0x90, 0x1c, 0x5c, 0x73, 0x82, 0x67, 0x30, 0x4c, 0x45, 0x66, 0x6d, 0x9c, 0xed, 0x52, 0xa0,
+	0x2d, 0x58, 0x73, 0xd8, 0xda, 0x73, 0xf6, 0xe6, 0xb0, 0x35, 0x79, 0x06, 0x23, 0xdd, 0xe1, 0x13,
+	0x18, 0xd5, 0x6c, 0x55, 0x99, 0xcd, 0x87, 0xd4, 0x34, 0xe4, 0x02, 0x8e, 0x7a, 0x7b, 0x62, 0x0f,
+	0x1e, 0xf0, 0x8c, 0xcd, 0x57, 0x7c, 0xa9, 0x61, 0x87, 0xb4, 0x6b, 0xc9, 0x67, 0x38, 0x8e, 0x0b,
+	0xce, 0x14, 0xa7, 0xfc, 0x5b, 0xc5, 0x4b, 0x85, 0x5f, 0x83, 0x23, 0x96, 0xd6, 0xbf, 0xff, 0xaf,
+	0x97, 0x3a, 0x0c, 0xae, 0x0b, 0x91, 0x0a, 0x25, 0x6a, 0x7e, 0x75, 0x19, 0x41, 0x1b, 0x5f, 0x73,
+	0x77, 0xea, 0x5c, 0x5d, 0x52, 0x47, 0x2c, 0x31, 0x86, 0x03, 0xc5, 0x92, 0x36, 0xbf, 0xe1, 0xe4,
+	0x21, 0xd5, 0x35, 0x79, 0x0f, 0x8f, 0xbb, 0x01, 0x65, 0x2e, 0xb3, 0x92, 0xe3, 0x37, 0xe0, 0x2e,
+	0xb4, 0x2d, 0x0f, 0xed, 0xcc, 0xf9, 0xaf, 0xef, 0x64, 0x69, 0xe4, 0x1d, 0x3c, 0x8a, 0x57, 0xb2,
+	0xbc, 0x0f, 0xcb, 0xe4, 0x09, 0x1c, 0x5b, 0x2d, 0xe3, 0x6e, 0xf6, 0x13, 0xc1, 0x61, 0x6c, 0x7f,
+	0x59, 0xfc, 0x09, 0x5c, 0x63, 0x1e, 0x4f, 0xb6, 0x99, 0xec, 0x07, 0x38, 0x7e, 0xb1, 0x07, 0xd2,
+	0x26, 0xf1, 0x11, 0x46, 0x7a, 0x38, 0xbe, 0xd8, 0xc6, 0xe9, 0xad, 0x3a, 0x9e, 0xec, 0x06, 0x1a,
+	0xed, 0xe8, 0xed, 0x4d, 0xe3, 0xa3, 0xdb, 0xc6, 0x47, 0xbf, 0x1b, 0x1f, 0xfd, 0xd8, 0xf8, 0x83,
+	0xdb, 0x8d, 0x3f, 0xf8, 0xb5, 0xf1, 0x07, 0xe0, 0x09, 0xd9, 0xa9, 0xb0, 0x5c, 0xf4, 0x14, 0x22,
+	0xe8, 0x16, 0xff, 0x10, 0x5e, 0xa3, 0xb9, 0xab, 0x5f, 0xd9, 0xcb, 0x3f, 0x03, 0x00, 0x6f, 0x9e,
+	0x84, 0x5a, 0xd5, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -471,6 +587,18 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounters(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -484,6 +612,81 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Bounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounters(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounters(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCounters(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CacheConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -667,6 +870,39 @@ func (m *Config) Size() (n int) {
 	_ = l
 	l = m.Cache.Size()
 	n += 1 + l + sovCounters(uint64(l))
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCounters(uint64(l))
+	}
+	return n
+}
+
+func (m *Bounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovCounters(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovCounters(uint64(l))
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCounters(uint64(m.Value))
+	}
 	return n
 }
 
@@ -798,6 +1034,233 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounters
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounters(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounters
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounters
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounters
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Min == nil {
+				m.Min = &Bound{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounters
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounters
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = &Bound{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounters(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounters
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounters
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounters
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounters(dAtA[iNdEx:])
@@ -436,6 +436,9 @@ type IncrementRequest struct {
 	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Key   string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	Delta int64          `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// saturate sets the counter to the crossed bound rather than failing with a Conflict error
+	// when the increment would cross a bound
+	Saturate bool `protobuf:"varint,4,opt,name=saturate,proto3" json:"saturate,omitempty"`
 }
 
 func (m *IncrementRequest) Reset()         { *m = IncrementRequest{} }
@@ -492,6 +495,13 @@ func (m *IncrementRequest) GetDelta() int64 {
 	return 0
 }
 
+func (m *IncrementRequest) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
+}
+
 type IncrementResponse struct {
 	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
@@ -540,6 +550,9 @@ type DecrementRequest struct {
 	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Key   string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	Delta int64          `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// saturate sets the counter to the crossed bound rather than failing with a Conflict error
+	// when the decrement would cross a bound
+	Saturate bool `protobuf:"varint,4,opt,name=saturate,proto3" json:"saturate,omitempty"`
 }
 
 func (m *DecrementRequest) Reset()         { *m = DecrementRequest{} }
@@ -596,6 +609,13 @@ func (m *DecrementRequest) GetDelta() int64 {
 	return 0
 }
 
+func (m *DecrementRequest) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
+}
+
 type DecrementResponse struct {
 	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
@@ -693,7 +713,8 @@ func (m *GetRequest) GetKey() string {
 }
 
 type GetResponse struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Value  int64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
 }
 
 func (m *GetResponse) Reset()         { *m = GetResponse{} }
@@ -736,24 +757,34 @@ func (m *GetResponse) GetValue() int64 {
 	return 0
 }
 
-type RemoveRequest struct {
-	ID        v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Key       string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	PrevValue int64          `protobuf:"varint,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+func (m *GetResponse) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
 }
 
-func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
-func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveRequest) ProtoMessage()    {}
-func (*RemoveRequest) Descriptor() ([]byte, []int) {
+type SetBoundsRequest struct {
+	ID  v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Key string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// bounds are the new bounds of the counter, overriding the default bounds of the map
+	// A counter value outside the new bounds is moved to the nearest bound. The bounds of a counter
+	// are removed along with the counter.
+	Bounds *Bounds `protobuf:"bytes,3,opt,name=bounds,proto3" json:"bounds,omitempty"`
+}
+
+func (m *SetBoundsRequest) Reset()         { *m = SetBoundsRequest{} }
+func (m *SetBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsRequest) ProtoMessage()    {}
+func (*SetBoundsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{14}
 }
-func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetBoundsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetBoundsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -763,55 +794,54 @@ func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *RemoveRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRequest.Merge(m, src)
+func (m *SetBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsRequest.Merge(m, src)
 }
-func (m *RemoveRequest) XXX_Size() int {
+func (m *SetBoundsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
+func (m *SetBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetBoundsRequest proto.InternalMessageInfo
 
-func (m *RemoveRequest) GetID() v1.PrimitiveID {
+func (m *SetBoundsRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *RemoveRequest) GetKey() string {
+func (m *SetBoundsRequest) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *RemoveRequest) GetPrevValue() int64 {
+func (m *SetBoundsRequest) GetBounds() *Bounds {
 	if m != nil {
-		return m.PrevValue
+		return m.Bounds
 	}
-	return 0
+	return nil
 }
 
-type RemoveResponse struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+type SetBoundsResponse struct {
 }
 
-func (m *RemoveResponse) Reset()         { *m = RemoveResponse{} }
-func (m *RemoveResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveResponse) ProtoMessage()    {}
-func (*RemoveResponse) Descriptor() ([]byte, []int) {
+func (m *SetBoundsResponse) Reset()         { *m = SetBoundsResponse{} }
+func (m *SetBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsResponse) ProtoMessage()    {}
+func (*SetBoundsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{15}
 }
-func (m *RemoveResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetBoundsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetBoundsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -821,41 +851,36 @@ func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *RemoveResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveResponse.Merge(m, src)
+func (m *SetBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsResponse.Merge(m, src)
 }
-func (m *RemoveResponse) XXX_Size() int {
+func (m *SetBoundsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveResponse.DiscardUnknown(m)
+func (m *SetBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveResponse proto.InternalMessageInfo
-
-func (m *RemoveResponse) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
-}
+var xxx_messageInfo_SetBoundsResponse proto.InternalMessageInfo
 
-type ClearRequest struct {
+type TopKRequest struct {
 	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// k is the number of entries to get and must be positive
+	K uint32 `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
 }
 
-func (m *ClearRequest) Reset()         { *m = ClearRequest{} }
-func (m *ClearRequest) String() string { return proto.CompactTextString(m) }
-func (*ClearRequest) ProtoMessage()    {}
-func (*ClearRequest) Descriptor() ([]byte, []int) {
+func (m *TopKRequest) Reset()         { *m = TopKRequest{} }
+func (m *TopKRequest) String() string { return proto.CompactTextString(m) }
+func (*TopKRequest) ProtoMessage()    {}
+func (*TopKRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{16}
 }
-func (m *ClearRequest) XXX_Unmarshal(b []byte) error {
+func (m *TopKRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopKRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopKRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -865,40 +890,48 @@ func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ClearRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearRequest.Merge(m, src)
+func (m *TopKRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopKRequest.Merge(m, src)
 }
-func (m *ClearRequest) XXX_Size() int {
+func (m *TopKRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearRequest.DiscardUnknown(m)
+func (m *TopKRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopKRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearRequest proto.InternalMessageInfo
+var xxx_messageInfo_TopKRequest proto.InternalMessageInfo
 
-func (m *ClearRequest) GetID() v1.PrimitiveID {
+func (m *TopKRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-type ClearResponse struct {
+func (m *TopKRequest) GetK() uint32 {
+	if m != nil {
+		return m.K
+	}
+	return 0
 }
 
-func (m *ClearResponse) Reset()         { *m = ClearResponse{} }
-func (m *ClearResponse) String() string { return proto.CompactTextString(m) }
-func (*ClearResponse) ProtoMessage()    {}
-func (*ClearResponse) Descriptor() ([]byte, []int) {
+type TopKResponse struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *TopKResponse) Reset()         { *m = TopKResponse{} }
+func (m *TopKResponse) String() string { return proto.CompactTextString(m) }
+func (*TopKResponse) ProtoMessage()    {}
+func (*TopKResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{17}
 }
-func (m *ClearResponse) XXX_Unmarshal(b []byte) error {
+func (m *TopKResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopKResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopKResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -908,36 +941,43 @@ func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ClearResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearResponse.Merge(m, src)
+func (m *TopKResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopKResponse.Merge(m, src)
 }
-func (m *ClearResponse) XXX_Size() int {
+func (m *TopKResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearResponse.DiscardUnknown(m)
+func (m *TopKResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopKResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearResponse proto.InternalMessageInfo
+var xxx_messageInfo_TopKResponse proto.InternalMessageInfo
 
-type LockRequest struct {
-	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Keys    []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+func (m *TopKResponse) GetEntries() []Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *LockRequest) Reset()         { *m = LockRequest{} }
-func (m *LockRequest) String() string { return proto.CompactTextString(m) }
-func (*LockRequest) ProtoMessage()    {}
-func (*LockRequest) Descriptor() ([]byte, []int) {
+type BottomKRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// k is the number of entries to get and must be positive
+	K uint32 `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
+}
+
+func (m *BottomKRequest) Reset()         { *m = BottomKRequest{} }
+func (m *BottomKRequest) String() string { return proto.CompactTextString(m) }
+func (*BottomKRequest) ProtoMessage()    {}
+func (*BottomKRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{18}
 }
-func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+func (m *BottomKRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BottomKRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BottomKRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -947,54 +987,48 @@ func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockRequest.Merge(m, src)
+func (m *BottomKRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BottomKRequest.Merge(m, src)
 }
-func (m *LockRequest) XXX_Size() int {
+func (m *BottomKRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockRequest.DiscardUnknown(m)
+func (m *BottomKRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BottomKRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+var xxx_messageInfo_BottomKRequest proto.InternalMessageInfo
 
-func (m *LockRequest) GetID() v1.PrimitiveID {
+func (m *BottomKRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *LockRequest) GetKeys() []string {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
-
-func (m *LockRequest) GetTimeout() *time.Duration {
+func (m *BottomKRequest) GetK() uint32 {
 	if m != nil {
-		return m.Timeout
+		return m.K
 	}
-	return nil
+	return 0
 }
 
-type LockResponse struct {
+type BottomKResponse struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *LockResponse) Reset()         { *m = LockResponse{} }
-func (m *LockResponse) String() string { return proto.CompactTextString(m) }
-func (*LockResponse) ProtoMessage()    {}
-func (*LockResponse) Descriptor() ([]byte, []int) {
+func (m *BottomKResponse) Reset()         { *m = BottomKResponse{} }
+func (m *BottomKResponse) String() string { return proto.CompactTextString(m) }
+func (*BottomKResponse) ProtoMessage()    {}
+func (*BottomKResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{19}
 }
-func (m *LockResponse) XXX_Unmarshal(b []byte) error {
+func (m *BottomKResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BottomKResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BottomKResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1004,35 +1038,45 @@ func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockResponse.Merge(m, src)
+func (m *BottomKResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BottomKResponse.Merge(m, src)
 }
-func (m *LockResponse) XXX_Size() int {
+func (m *BottomKResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockResponse.DiscardUnknown(m)
+func (m *BottomKResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BottomKResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockResponse proto.InternalMessageInfo
+var xxx_messageInfo_BottomKResponse proto.InternalMessageInfo
 
-type UnlockRequest struct {
-	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Keys []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+func (m *BottomKResponse) GetEntries() []Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
-func (m *UnlockRequest) String() string { return proto.CompactTextString(m) }
-func (*UnlockRequest) ProtoMessage()    {}
-func (*UnlockRequest) Descriptor() ([]byte, []int) {
+type RangeByValueRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// bounds are the inclusive bounds of the range; the range is unbounded in the direction of an unset bound
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
+	// limit is the maximum number of entries to return; all entries in the range are returned when unset
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *RangeByValueRequest) Reset()         { *m = RangeByValueRequest{} }
+func (m *RangeByValueRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeByValueRequest) ProtoMessage()    {}
+func (*RangeByValueRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{20}
 }
-func (m *UnlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *RangeByValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RangeByValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RangeByValueRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1042,47 +1086,55 @@ func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UnlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockRequest.Merge(m, src)
+func (m *RangeByValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeByValueRequest.Merge(m, src)
 }
-func (m *UnlockRequest) XXX_Size() int {
+func (m *RangeByValueRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockRequest.DiscardUnknown(m)
+func (m *RangeByValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeByValueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_RangeByValueRequest proto.InternalMessageInfo
 
-func (m *UnlockRequest) GetID() v1.PrimitiveID {
+func (m *RangeByValueRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *UnlockRequest) GetKeys() []string {
+func (m *RangeByValueRequest) GetBounds() *Bounds {
 	if m != nil {
-		return m.Keys
+		return m.Bounds
 	}
 	return nil
 }
 
-type UnlockResponse struct {
+func (m *RangeByValueRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *UnlockResponse) Reset()         { *m = UnlockResponse{} }
-func (m *UnlockResponse) String() string { return proto.CompactTextString(m) }
-func (*UnlockResponse) ProtoMessage()    {}
-func (*UnlockResponse) Descriptor() ([]byte, []int) {
+type RangeByValueResponse struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *RangeByValueResponse) Reset()         { *m = RangeByValueResponse{} }
+func (m *RangeByValueResponse) String() string { return proto.CompactTextString(m) }
+func (*RangeByValueResponse) ProtoMessage()    {}
+func (*RangeByValueResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{21}
 }
-func (m *UnlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *RangeByValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RangeByValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RangeByValueResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1092,35 +1144,43 @@ func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *UnlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockResponse.Merge(m, src)
+func (m *RangeByValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeByValueResponse.Merge(m, src)
 }
-func (m *UnlockResponse) XXX_Size() int {
+func (m *RangeByValueResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockResponse.DiscardUnknown(m)
+func (m *RangeByValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeByValueResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_RangeByValueResponse proto.InternalMessageInfo
 
-type EntriesRequest struct {
-	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Watch bool           `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
+func (m *RangeByValueResponse) GetEntries() []Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
-func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
-func (*EntriesRequest) ProtoMessage()    {}
-func (*EntriesRequest) Descriptor() ([]byte, []int) {
+type RemoveRequest struct {
+	ID        v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Key       string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	PrevValue int64          `protobuf:"varint,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+}
+
+func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
+func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRequest) ProtoMessage()    {}
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{22}
 }
-func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
+func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1130,48 +1190,55 @@ func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EntriesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesRequest.Merge(m, src)
+func (m *RemoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRequest.Merge(m, src)
 }
-func (m *EntriesRequest) XXX_Size() int {
+func (m *RemoveRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
+func (m *RemoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
 
-func (m *EntriesRequest) GetID() v1.PrimitiveID {
+func (m *RemoveRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *EntriesRequest) GetWatch() bool {
+func (m *RemoveRequest) GetKey() string {
 	if m != nil {
-		return m.Watch
+		return m.Key
 	}
-	return false
+	return ""
 }
 
-type EntriesResponse struct {
-	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+func (m *RemoveRequest) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
+	}
+	return 0
 }
 
-func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
-func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
-func (*EntriesResponse) ProtoMessage()    {}
-func (*EntriesResponse) Descriptor() ([]byte, []int) {
+type RemoveResponse struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *RemoveResponse) Reset()         { *m = RemoveResponse{} }
+func (m *RemoveResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveResponse) ProtoMessage()    {}
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{23}
 }
-func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1181,42 +1248,41 @@ func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EntriesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesResponse.Merge(m, src)
+func (m *RemoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveResponse.Merge(m, src)
 }
-func (m *EntriesResponse) XXX_Size() int {
+func (m *RemoveResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
+func (m *RemoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveResponse proto.InternalMessageInfo
 
-func (m *EntriesResponse) GetEntry() Entry {
+func (m *RemoveResponse) GetValue() int64 {
 	if m != nil {
-		return m.Entry
+		return m.Value
 	}
-	return Entry{}
+	return 0
 }
 
-type EventsRequest struct {
-	ID  v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Key string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+type ClearRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 }
 
-func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
-func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
-func (*EventsRequest) ProtoMessage()    {}
-func (*EventsRequest) Descriptor() ([]byte, []int) {
+func (m *ClearRequest) Reset()         { *m = ClearRequest{} }
+func (m *ClearRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearRequest) ProtoMessage()    {}
+func (*ClearRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{24}
 }
-func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+func (m *ClearRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1226,48 +1292,40 @@ func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsRequest.Merge(m, src)
+func (m *ClearRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearRequest.Merge(m, src)
 }
-func (m *EventsRequest) XXX_Size() int {
+func (m *ClearRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+func (m *ClearRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ClearRequest proto.InternalMessageInfo
 
-func (m *EventsRequest) GetID() v1.PrimitiveID {
+func (m *ClearRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *EventsRequest) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
-}
-
-type EventsResponse struct {
-	Event Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event"`
+type ClearResponse struct {
 }
 
-func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
-func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()    {}
-func (*EventsResponse) Descriptor() ([]byte, []int) {
+func (m *ClearResponse) Reset()         { *m = ClearResponse{} }
+func (m *ClearResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearResponse) ProtoMessage()    {}
+func (*ClearResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{25}
 }
-func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+func (m *ClearResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1277,46 +1335,36 @@ func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsResponse.Merge(m, src)
+func (m *ClearResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearResponse.Merge(m, src)
 }
-func (m *EventsResponse) XXX_Size() int {
+func (m *ClearResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+func (m *ClearResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
-
-func (m *EventsResponse) GetEvent() Event {
-	if m != nil {
-		return m.Event
-	}
-	return Event{}
-}
+var xxx_messageInfo_ClearResponse proto.InternalMessageInfo
 
-type Event struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Types that are valid to be assigned to Event:
-	//	*Event_Inserted_
-	//	*Event_Updated_
-	//	*Event_Removed_
-	Event isEvent_Event `protobuf_oneof:"event"`
+type LockRequest struct {
+	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Keys    []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 }
 
-func (m *Event) Reset()         { *m = Event{} }
-func (m *Event) String() string { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()    {}
-func (*Event) Descriptor() ([]byte, []int) {
+func (m *LockRequest) Reset()         { *m = LockRequest{} }
+func (m *LockRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()    {}
+func (*LockRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e659fb9e7cb504fa, []int{26}
 }
-func (m *Event) XXX_Unmarshal(b []byte) error {
+func (m *LockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1326,98 +1374,54 @@ func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(m, src)
+func (m *LockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRequest.Merge(m, src)
 }
-func (m *Event) XXX_Size() int {
+func (m *LockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Event proto.InternalMessageInfo
-
-type isEvent_Event interface {
-	isEvent_Event()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Event_Inserted_ struct {
-	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
-}
-type Event_Updated_ struct {
-	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
-}
-type Event_Removed_ struct {
-	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
+func (m *LockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRequest.DiscardUnknown(m)
 }
 
-func (*Event_Inserted_) isEvent_Event() {}
-func (*Event_Updated_) isEvent_Event()  {}
-func (*Event_Removed_) isEvent_Event()  {}
+var xxx_messageInfo_LockRequest proto.InternalMessageInfo
 
-func (m *Event) GetEvent() isEvent_Event {
+func (m *LockRequest) GetID() v1.PrimitiveID {
 	if m != nil {
-		return m.Event
+		return m.ID
 	}
-	return nil
+	return v1.PrimitiveID{}
 }
 
-func (m *Event) GetKey() string {
+func (m *LockRequest) GetKeys() []string {
 	if m != nil {
-		return m.Key
-	}
-	return ""
-}
-
-func (m *Event) GetInserted() *Event_Inserted {
-	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
-		return x.Inserted
-	}
-	return nil
-}
-
-func (m *Event) GetUpdated() *Event_Updated {
-	if x, ok := m.GetEvent().(*Event_Updated_); ok {
-		return x.Updated
+		return m.Keys
 	}
 	return nil
 }
 
-func (m *Event) GetRemoved() *Event_Removed {
-	if x, ok := m.GetEvent().(*Event_Removed_); ok {
-		return x.Removed
+func (m *LockRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Event) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Event_Inserted_)(nil),
-		(*Event_Updated_)(nil),
-		(*Event_Removed_)(nil),
-	}
-}
-
-type Event_Inserted struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+type LockResponse struct {
 }
 
-func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
-func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
-func (*Event_Inserted) ProtoMessage()    {}
-func (*Event_Inserted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e659fb9e7cb504fa, []int{26, 0}
+func (m *LockResponse) Reset()         { *m = LockResponse{} }
+func (m *LockResponse) String() string { return proto.CompactTextString(m) }
+func (*LockResponse) ProtoMessage()    {}
+func (*LockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{27}
 }
-func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+func (m *LockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1427,42 +1431,35 @@ func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *Event_Inserted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Inserted.Merge(m, src)
+func (m *LockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockResponse.Merge(m, src)
 }
-func (m *Event_Inserted) XXX_Size() int {
+func (m *LockResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Inserted) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
+func (m *LockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
-
-func (m *Event_Inserted) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
-}
+var xxx_messageInfo_LockResponse proto.InternalMessageInfo
 
-type Event_Updated struct {
-	Value     int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	PrevValue int64 `protobuf:"varint,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type UnlockRequest struct {
+	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Keys []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
-func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
-func (*Event_Updated) ProtoMessage()    {}
-func (*Event_Updated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e659fb9e7cb504fa, []int{26, 1}
+func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
+func (m *UnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockRequest) ProtoMessage()    {}
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{28}
 }
-func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+func (m *UnlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1472,48 +1469,47 @@ func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Updated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Updated.Merge(m, src)
+func (m *UnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockRequest.Merge(m, src)
 }
-func (m *Event_Updated) XXX_Size() int {
+func (m *UnlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Updated) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
+func (m *UnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
+var xxx_messageInfo_UnlockRequest proto.InternalMessageInfo
 
-func (m *Event_Updated) GetValue() int64 {
+func (m *UnlockRequest) GetID() v1.PrimitiveID {
 	if m != nil {
-		return m.Value
+		return m.ID
 	}
-	return 0
+	return v1.PrimitiveID{}
 }
 
-func (m *Event_Updated) GetPrevValue() int64 {
+func (m *UnlockRequest) GetKeys() []string {
 	if m != nil {
-		return m.PrevValue
+		return m.Keys
 	}
-	return 0
+	return nil
 }
 
-type Event_Removed struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+type UnlockResponse struct {
 }
 
-func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
-func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
-func (*Event_Removed) ProtoMessage()    {}
-func (*Event_Removed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e659fb9e7cb504fa, []int{26, 2}
+func (m *UnlockResponse) Reset()         { *m = UnlockResponse{} }
+func (m *UnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockResponse) ProtoMessage()    {}
+func (*UnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{29}
 }
-func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+func (m *UnlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnlockResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1523,42 +1519,35 @@ func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Removed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Removed.Merge(m, src)
+func (m *UnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockResponse.Merge(m, src)
 }
-func (m *Event_Removed) XXX_Size() int {
+func (m *UnlockResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Removed) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
+func (m *UnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
-
-func (m *Event_Removed) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
-}
+var xxx_messageInfo_UnlockResponse proto.InternalMessageInfo
 
-type Entry struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+type EntriesRequest struct {
+	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Watch bool           `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
 }
 
-func (m *Entry) Reset()         { *m = Entry{} }
-func (m *Entry) String() string { return proto.CompactTextString(m) }
-func (*Entry) ProtoMessage()    {}
-func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e659fb9e7cb504fa, []int{27}
+func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
+func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*EntriesRequest) ProtoMessage()    {}
+func (*EntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{30}
 }
-func (m *Entry) XXX_Unmarshal(b []byte) error {
+func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1568,1510 +1557,1424 @@ func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Entry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Entry.Merge(m, src)
+func (m *EntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesRequest.Merge(m, src)
 }
-func (m *Entry) XXX_Size() int {
+func (m *EntriesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Entry) XXX_DiscardUnknown() {
-	xxx_messageInfo_Entry.DiscardUnknown(m)
+func (m *EntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Entry proto.InternalMessageInfo
+var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
 
-func (m *Entry) GetKey() string {
+func (m *EntriesRequest) GetID() v1.PrimitiveID {
 	if m != nil {
-		return m.Key
+		return m.ID
 	}
-	return ""
+	return v1.PrimitiveID{}
 }
 
-func (m *Entry) GetValue() int64 {
+func (m *EntriesRequest) GetWatch() bool {
 	if m != nil {
-		return m.Value
+		return m.Watch
 	}
-	return 0
+	return false
 }
 
-func init() {
-	proto.RegisterType((*SizeRequest)(nil), "atomix.runtime.countermap.v1.SizeRequest")
-	proto.RegisterType((*SizeResponse)(nil), "atomix.runtime.countermap.v1.SizeResponse")
-	proto.RegisterType((*SetRequest)(nil), "atomix.runtime.countermap.v1.SetRequest")
-	proto.RegisterType((*SetResponse)(nil), "atomix.runtime.countermap.v1.SetResponse")
-	proto.RegisterType((*InsertRequest)(nil), "atomix.runtime.countermap.v1.InsertRequest")
-	proto.RegisterType((*InsertResponse)(nil), "atomix.runtime.countermap.v1.InsertResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "atomix.runtime.countermap.v1.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "atomix.runtime.countermap.v1.UpdateResponse")
-	proto.RegisterType((*IncrementRequest)(nil), "atomix.runtime.countermap.v1.IncrementRequest")
-	proto.RegisterType((*IncrementResponse)(nil), "atomix.runtime.countermap.v1.IncrementResponse")
-	proto.RegisterType((*DecrementRequest)(nil), "atomix.runtime.countermap.v1.DecrementRequest")
-	proto.RegisterType((*DecrementResponse)(nil), "atomix.runtime.countermap.v1.DecrementResponse")
-	proto.RegisterType((*GetRequest)(nil), "atomix.runtime.countermap.v1.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "atomix.runtime.countermap.v1.GetResponse")
-	proto.RegisterType((*RemoveRequest)(nil), "atomix.runtime.countermap.v1.RemoveRequest")
-	proto.RegisterType((*RemoveResponse)(nil), "atomix.runtime.countermap.v1.RemoveResponse")
-	proto.RegisterType((*ClearRequest)(nil), "atomix.runtime.countermap.v1.ClearRequest")
-	proto.RegisterType((*ClearResponse)(nil), "atomix.runtime.countermap.v1.ClearResponse")
-	proto.RegisterType((*LockRequest)(nil), "atomix.runtime.countermap.v1.LockRequest")
-	proto.RegisterType((*LockResponse)(nil), "atomix.runtime.countermap.v1.LockResponse")
-	proto.RegisterType((*UnlockRequest)(nil), "atomix.runtime.countermap.v1.UnlockRequest")
-	proto.RegisterType((*UnlockResponse)(nil), "atomix.runtime.countermap.v1.UnlockResponse")
-	proto.RegisterType((*EntriesRequest)(nil), "atomix.runtime.countermap.v1.EntriesRequest")
-	proto.RegisterType((*EntriesResponse)(nil), "atomix.runtime.countermap.v1.EntriesResponse")
-	proto.RegisterType((*EventsRequest)(nil), "atomix.runtime.countermap.v1.EventsRequest")
-	proto.RegisterType((*EventsResponse)(nil), "atomix.runtime.countermap.v1.EventsResponse")
-	proto.RegisterType((*Event)(nil), "atomix.runtime.countermap.v1.Event")
-	proto.RegisterType((*Event_Inserted)(nil), "atomix.runtime.countermap.v1.Event.Inserted")
-	proto.RegisterType((*Event_Updated)(nil), "atomix.runtime.countermap.v1.Event.Updated")
-	proto.RegisterType((*Event_Removed)(nil), "atomix.runtime.countermap.v1.Event.Removed")
-	proto.RegisterType((*Entry)(nil), "atomix.runtime.countermap.v1.Entry")
+type EntriesResponse struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
 }
 
-func init() {
-	proto.RegisterFile("runtime/countermap/v1/countermap.proto", fileDescriptor_e659fb9e7cb504fa)
+func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
+func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*EntriesResponse) ProtoMessage()    {}
+func (*EntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{31}
 }
-
-var fileDescriptor_e659fb9e7cb504fa = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe3, 0xa4, 0x69, 0x5f, 0x9a, 0x6c, 0x19, 0xf5, 0x10, 0x0c, 0xa4, 0x91, 0x57, 0x5a,
-	0xb2, 0x6c, 0xb1, 0x49, 0x39, 0xc1, 0x01, 0xa4, 0xb4, 0x55, 0xc8, 0x0a, 0xa4, 0xc5, 0xd5, 0xee,
-	0xa1, 0x52, 0xb5, 0xb8, 0xc9, 0xa3, 0x6b, 0x35, 0xb1, 0x83, 0x3d, 0x31, 0x74, 0xc5, 0x8d, 0x0b,
-	0x47, 0xc4, 0x69, 0x7f, 0xd2, 0x1e, 0xf7, 0xc8, 0x69, 0x41, 0xed, 0x7f, 0xe0, 0x8c, 0x3c, 0x33,
-	0x4e, 0x6c, 0x53, 0x75, 0xe6, 0xd0, 0x74, 0x6f, 0x9e, 0xe4, 0x7b, 0xef, 0xfb, 0x66, 0xe6, 0xbd,
-	0xf9, 0x1e, 0x3c, 0x08, 0xe7, 0x3e, 0xf5, 0xa6, 0x68, 0x8f, 0x82, 0xb9, 0x4f, 0x31, 0x9c, 0xba,
-	0x33, 0x3b, 0xee, 0x65, 0x56, 0xd6, 0x2c, 0x0c, 0x68, 0x40, 0x3e, 0x74, 0x69, 0x30, 0xf5, 0x7e,
-	0xb1, 0x04, 0xdc, 0xca, 0x00, 0xe2, 0x9e, 0xd1, 0x3e, 0x0b, 0x82, 0xb3, 0x09, 0xda, 0x0c, 0x7b,
-	0x3a, 0xff, 0xd1, 0x1e, 0xcf, 0x43, 0x97, 0x7a, 0x81, 0xcf, 0xa3, 0x8d, 0x56, 0xca, 0x12, 0xf7,
-	0xec, 0x34, 0x03, 0xff, 0xe7, 0x63, 0x19, 0x7f, 0x24, 0x80, 0xdb, 0x67, 0xc1, 0x59, 0xc0, 0x3e,
-	0xed, 0xe4, 0x8b, 0xff, 0x6a, 0x0e, 0xa1, 0x7e, 0xe4, 0xbd, 0x44, 0x07, 0x7f, 0x9a, 0x63, 0x44,
-	0xc9, 0x97, 0x50, 0xf6, 0xc6, 0x2d, 0xad, 0xa3, 0x75, 0xeb, 0x7b, 0x6d, 0xab, 0x20, 0x39, 0xee,
-	0x59, 0x4f, 0x42, 0x6f, 0xea, 0x51, 0x2f, 0xc6, 0xe1, 0x41, 0x1f, 0x5e, 0xbf, 0xdd, 0x29, 0x5d,
-	0xbe, 0xdd, 0x29, 0x0f, 0x0f, 0x9c, 0xb2, 0x37, 0x36, 0x4d, 0xd8, 0xe4, 0xa9, 0xa2, 0x59, 0xe0,
-	0x47, 0x48, 0x08, 0x54, 0x22, 0xef, 0x25, 0xb2, 0x6c, 0x0d, 0x87, 0x7d, 0x9b, 0x33, 0x80, 0x23,
-	0xa4, 0xb7, 0xc0, 0x46, 0xb6, 0x40, 0x3f, 0xc7, 0x8b, 0x56, 0xb9, 0xa3, 0x75, 0x37, 0x9c, 0xe4,
-	0x93, 0x6c, 0x43, 0x35, 0x76, 0x27, 0x73, 0x6c, 0xe9, 0x1d, 0xad, 0xab, 0x3b, 0x7c, 0x61, 0xee,
-	0x42, 0x9d, 0x31, 0x0a, 0x51, 0x1f, 0x01, 0xcc, 0x42, 0x8c, 0x9f, 0x73, 0xa4, 0xc6, 0x90, 0x1b,
-	0xc9, 0x2f, 0xcf, 0x18, 0x3a, 0x82, 0xc6, 0xd0, 0x8f, 0x30, 0xbc, 0x53, 0x89, 0x5b, 0xd0, 0x4c,
-	0x49, 0xb9, 0x4a, 0xf3, 0x4f, 0x0d, 0x1a, 0x4f, 0x67, 0x63, 0x97, 0xe2, 0x1d, 0xea, 0x28, 0x9c,
-	0x4d, 0xa5, 0x78, 0x36, 0x36, 0x34, 0x53, 0x4d, 0x6a, 0x87, 0x19, 0xc3, 0xd6, 0xd0, 0x1f, 0x85,
-	0x38, 0x45, 0x7f, 0x75, 0xe7, 0x39, 0xc6, 0x09, 0x75, 0xd3, 0x7d, 0xb0, 0x85, 0xb9, 0x07, 0xef,
-	0x65, 0x78, 0x95, 0xb5, 0x1e, 0xe0, 0xbb, 0xd1, 0x9a, 0xe1, 0x55, 0xd3, 0x7a, 0x0c, 0x30, 0x58,
-	0x51, 0x13, 0x99, 0xf7, 0xa1, 0x3e, 0xc8, 0xb4, 0xcb, 0xa2, 0x50, 0xb4, 0x6c, 0xc1, 0xfe, 0x0a,
-	0x0d, 0x07, 0xa7, 0x41, 0xbc, 0xa2, 0xea, 0xcc, 0x6f, 0x5f, 0x2f, 0x6e, 0xff, 0x01, 0x34, 0x53,
-	0xf6, 0x1b, 0x55, 0x3e, 0x86, 0xcd, 0xfd, 0x09, 0xba, 0xe1, 0x6d, 0xbc, 0x6d, 0xf7, 0xa0, 0x21,
-	0x72, 0x89, 0x0e, 0x7d, 0xa5, 0x41, 0xfd, 0xdb, 0x60, 0x74, 0x7e, 0x1b, 0x27, 0x40, 0xa0, 0x72,
-	0x8e, 0x17, 0x51, 0xab, 0xdc, 0xd1, 0xbb, 0x1b, 0x0e, 0xfb, 0x26, 0x5f, 0x40, 0x2d, 0x09, 0x0d,
-	0xe6, 0x94, 0x1d, 0x40, 0x7d, 0xef, 0x7d, 0x8b, 0x5b, 0x84, 0x95, 0x5a, 0x84, 0x75, 0x20, 0x2c,
-	0xa2, 0x5f, 0x79, 0xf5, 0xf7, 0x8e, 0xe6, 0xa4, 0x78, 0xb3, 0x09, 0x9b, 0x5c, 0x99, 0x90, 0xfa,
-	0x1c, 0x1a, 0x4f, 0xfd, 0xc9, 0xea, 0xb4, 0x26, 0xef, 0x57, 0x4a, 0x20, 0x28, 0x4f, 0xa1, 0x79,
-	0xe8, 0xd3, 0xd0, 0xc3, 0xe8, 0x36, 0x38, 0xb7, 0xa1, 0xfa, 0xb3, 0x4b, 0x47, 0x2f, 0x58, 0x8d,
-	0xac, 0x3b, 0x7c, 0x61, 0x3a, 0x70, 0x6f, 0xc1, 0x21, 0xea, 0xe0, 0x6b, 0xa8, 0xa2, 0x4f, 0xc3,
-	0x0b, 0xc1, 0x73, 0xdf, 0xba, 0xc9, 0x73, 0xad, 0x24, 0xfa, 0xa2, 0x5f, 0x49, 0xc8, 0x1c, 0x1e,
-	0x67, 0x9e, 0x40, 0xe3, 0x30, 0x46, 0x9f, 0x46, 0xab, 0x69, 0xae, 0xef, 0xa1, 0x99, 0xa6, 0xcf,
-	0x28, 0x4e, 0x7e, 0x61, 0x28, 0xb9, 0xe2, 0x04, 0xba, 0x50, 0x9c, 0x2c, 0xcc, 0xdf, 0x74, 0xa8,
-	0xb2, 0x9f, 0x53, 0x3a, 0x6d, 0xd9, 0x47, 0x8f, 0x61, 0xdd, 0x63, 0xbe, 0x82, 0x63, 0x91, 0x7f,
-	0x57, 0x21, 0xbf, 0x35, 0x14, 0x31, 0xdf, 0x94, 0x9c, 0x45, 0x3c, 0x19, 0x40, 0x6d, 0xce, 0x1e,
-	0xff, 0xb1, 0xa8, 0xc7, 0x47, 0x2a, 0xa9, 0xb8, 0x5f, 0x24, 0x99, 0xd2, 0xe8, 0x24, 0x51, 0xc8,
-	0xba, 0x77, 0xcc, 0x1c, 0x46, 0x31, 0x11, 0x6f, 0x78, 0x96, 0x48, 0x44, 0x1b, 0x1d, 0x58, 0x4f,
-	0x95, 0x5e, 0xff, 0x00, 0x18, 0x5f, 0x41, 0x4d, 0x08, 0xb8, 0x1e, 0x50, 0x78, 0x68, 0xca, 0x85,
-	0x87, 0xc6, 0xd8, 0x81, 0x9a, 0xe0, 0xbd, 0x3e, 0xbe, 0x5f, 0x13, 0xb7, 0x67, 0xda, 0x50, 0x65,
-	0xd5, 0x74, 0xcd, 0x25, 0x2c, 0x22, 0xcb, 0x99, 0xc8, 0xbd, 0x7f, 0xeb, 0x00, 0xfb, 0x7c, 0x9f,
-	0xdf, 0xb9, 0x33, 0x72, 0x02, 0x95, 0x64, 0x74, 0x22, 0x0f, 0x6f, 0x3e, 0x8b, 0xcc, 0xa4, 0x66,
-	0x7c, 0xa2, 0x02, 0x15, 0x55, 0x76, 0x0c, 0xfa, 0x11, 0x52, 0xd2, 0x95, 0x84, 0x2c, 0x3c, 0xc5,
-	0x78, 0xa8, 0x80, 0x14, 0xb9, 0x47, 0xb0, 0xc6, 0xaf, 0x81, 0x48, 0x2e, 0x32, 0x37, 0x57, 0x19,
-	0xbb, 0x6a, 0xe0, 0x25, 0x09, 0xbf, 0x49, 0x19, 0x49, 0x6e, 0x68, 0x92, 0x91, 0x14, 0xa6, 0x99,
-	0x09, 0x6c, 0x2c, 0xc6, 0x06, 0x62, 0xc9, 0xf4, 0xe5, 0x67, 0x05, 0xc3, 0x56, 0xc6, 0x2f, 0xd9,
-	0x16, 0xc6, 0x2f, 0x63, 0x2b, 0x4e, 0x26, 0x32, 0xb6, 0xff, 0x4f, 0x14, 0xc7, 0xa0, 0x0f, 0xe4,
-	0x15, 0x30, 0x50, 0xae, 0x80, 0x41, 0xbe, 0x02, 0x78, 0x9b, 0xc8, 0x2e, 0x27, 0x37, 0x33, 0xc8,
-	0x2e, 0xa7, 0x60, 0xf1, 0x3f, 0x40, 0x95, 0x19, 0x30, 0x91, 0xd4, 0x7d, 0xd6, 0xf1, 0x8d, 0x47,
-	0x4a, 0x58, 0xc1, 0x70, 0x02, 0x95, 0xc4, 0x36, 0x65, 0x3d, 0x98, 0x31, 0x7d, 0x59, 0x0f, 0x66,
-	0x5d, 0x98, 0x95, 0x30, 0x33, 0x49, 0x69, 0x09, 0x67, 0xbd, 0x5a, 0x5a, 0xc2, 0x39, 0xdf, 0x25,
-	0x08, 0x6b, 0xdc, 0x60, 0x88, 0xca, 0xab, 0x1a, 0x29, 0x92, 0xe4, 0x3d, 0xeb, 0x33, 0x8d, 0xbc,
-	0x80, 0x9a, 0xb0, 0x5e, 0xb2, 0x2b, 0xf7, 0xd8, 0xe5, 0x14, 0x60, 0x7c, 0xaa, 0x88, 0xce, 0x30,
-	0xad, 0xed, 0x87, 0xa8, 0xd0, 0xf8, 0x1c, 0xa5, 0xb8, 0xa1, 0x14, 0x2c, 0xa6, 0x15, 0xfd, 0xf7,
-	0xb2, 0x46, 0x30, 0x29, 0xb0, 0x20, 0x42, 0x79, 0x81, 0x05, 0x11, 0x2a, 0x17, 0x18, 0xc3, 0x66,
-	0x68, 0xfa, 0x87, 0xaf, 0x2f, 0xdb, 0xda, 0x9b, 0xcb, 0xb6, 0xf6, 0xcf, 0x65, 0x5b, 0xfb, 0xe3,
-	0xaa, 0x5d, 0x7a, 0x73, 0xd5, 0x2e, 0xfd, 0x75, 0xd5, 0x2e, 0xc1, 0x07, 0x5e, 0x90, 0x66, 0x73,
-	0x67, 0x5e, 0x3e, 0x53, 0x7f, 0x73, 0x69, 0x16, 0xcf, 0x7a, 0x4f, 0xb4, 0xd3, 0x35, 0x36, 0x05,
-	0x7e, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0xad, 0xf4, 0x22, 0x7e, 0x10, 0x00, 0x00,
+func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// CounterMapClient is the client API for CounterMap service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CounterMapClient interface {
-	// Size returns the size of the map
-	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
-	// Set sets an entry into the map
-	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
-	// Insert inserts an entry into the map
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Increment increments a counter in the map
-	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
-	// Decrement decrements a counter in the map
-	Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error)
-	// Get gets the entry for a key
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error)
-	// Entries lists all entries in the map
-	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error)
-	// Create creates the counter map session
-	// Deprecated: use the CounterMaps service instead
-	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
-	// Close closes the counter map session
-	// Deprecated: use the CounterMaps service instead
-	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type counterMapClient struct {
-	cc *grpc.ClientConn
+func (m *EntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesResponse.Merge(m, src)
 }
-
-func NewCounterMapClient(cc *grpc.ClientConn) CounterMapClient {
-	return &counterMapClient{cc}
+func (m *EntriesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *counterMapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
-	out := new(SizeResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Size", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
 }
 
-func (c *counterMapClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
-	out := new(SetResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
 
-func (c *counterMapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
-	out := new(InsertResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Insert", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EntriesResponse) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
 	}
-	return out, nil
+	return Entry{}
 }
 
-func (c *counterMapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EventsRequest struct {
+	ID  v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Key string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (c *counterMapClient) Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error) {
-	out := new(IncrementResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Increment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{32}
 }
-
-func (c *counterMapClient) Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error) {
-	out := new(DecrementResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Decrement", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
 }
 
-func (c *counterMapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+func (m *EventsRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
 	}
-	return out, nil
+	return v1.PrimitiveID{}
 }
 
-func (c *counterMapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
-	out := new(RemoveResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return out, nil
+	return ""
 }
 
-func (c *counterMapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
-	out := new(ClearResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Clear", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EventsResponse struct {
+	Event Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event"`
 }
 
-func (c *counterMapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
-	out := new(LockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Lock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
+func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()    {}
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{33}
+}
+func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsResponse.Merge(m, src)
+}
+func (m *EventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
 }
 
-func (c *counterMapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
-	out := new(UnlockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Unlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+
+func (m *EventsResponse) GetEvent() Event {
+	if m != nil {
+		return m.Event
 	}
-	return out, nil
+	return Event{}
 }
 
-func (c *counterMapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[0], "/atomix.runtime.countermap.v1.CounterMap/Events", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &counterMapEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+type Event struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*Event_Inserted_
+	//	*Event_Updated_
+	//	*Event_Removed_
+	Event isEvent_Event `protobuf_oneof:"event"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{34}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-type CounterMap_EventsClient interface {
-	Recv() (*EventsResponse, error)
-	grpc.ClientStream
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+type isEvent_Event interface {
+	isEvent_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type counterMapEventsClient struct {
-	grpc.ClientStream
+type Event_Inserted_ struct {
+	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
+}
+type Event_Updated_ struct {
+	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
+}
+type Event_Removed_ struct {
+	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
 }
 
-func (x *counterMapEventsClient) Recv() (*EventsResponse, error) {
-	m := new(EventsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (*Event_Inserted_) isEvent_Event() {}
+func (*Event_Updated_) isEvent_Event()  {}
+func (*Event_Removed_) isEvent_Event()  {}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	return m, nil
+	return nil
 }
 
-func (c *counterMapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[1], "/atomix.runtime.countermap.v1.CounterMap/Entries", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &counterMapEntriesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Event) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return x, nil
+	return ""
 }
 
-type CounterMap_EntriesClient interface {
-	Recv() (*EntriesResponse, error)
-	grpc.ClientStream
+func (m *Event) GetInserted() *Event_Inserted {
+	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
+		return x.Inserted
+	}
+	return nil
 }
 
-type counterMapEntriesClient struct {
-	grpc.ClientStream
+func (m *Event) GetUpdated() *Event_Updated {
+	if x, ok := m.GetEvent().(*Event_Updated_); ok {
+		return x.Updated
+	}
+	return nil
 }
 
-func (x *counterMapEntriesClient) Recv() (*EntriesResponse, error) {
-	m := new(EntriesResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Event) GetRemoved() *Event_Removed {
+	if x, ok := m.GetEvent().(*Event_Removed_); ok {
+		return x.Removed
 	}
-	return m, nil
+	return nil
 }
 
-// Deprecated: Do not use.
-func (c *counterMapClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
-	out := new(CreateResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_Inserted_)(nil),
+		(*Event_Updated_)(nil),
+		(*Event_Removed_)(nil),
 	}
-	return out, nil
 }
 
-// Deprecated: Do not use.
-func (c *counterMapClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
-	out := new(CloseResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Close", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Event_Inserted struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-// CounterMapServer is the server API for CounterMap service.
-type CounterMapServer interface {
-	// Size returns the size of the map
-	Size(context.Context, *SizeRequest) (*SizeResponse, error)
-	// Set sets an entry into the map
-	Set(context.Context, *SetRequest) (*SetResponse, error)
-	// Insert inserts an entry into the map
-	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Increment increments a counter in the map
-	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
-	// Decrement decrements a counter in the map
-	Decrement(context.Context, *DecrementRequest) (*DecrementResponse, error)
-	// Get gets the entry for a key
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(context.Context, *LockRequest) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(*EventsRequest, CounterMap_EventsServer) error
-	// Entries lists all entries in the map
-	Entries(*EntriesRequest, CounterMap_EntriesServer) error
-	// Create creates the counter map session
-	// Deprecated: use the CounterMaps service instead
-	Create(context.Context, *CreateRequest) (*CreateResponse, error)
-	// Close closes the counter map session
-	// Deprecated: use the CounterMaps service instead
-	Close(context.Context, *CloseRequest) (*CloseResponse, error)
-}
-
-// UnimplementedCounterMapServer can be embedded to have forward compatible implementations.
-type UnimplementedCounterMapServer struct {
-}
-
-func (*UnimplementedCounterMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
-}
-func (*UnimplementedCounterMapServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
+func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
+func (*Event_Inserted) ProtoMessage()    {}
+func (*Event_Inserted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{34, 0}
 }
-func (*UnimplementedCounterMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedCounterMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedCounterMapServer) Increment(ctx context.Context, req *IncrementRequest) (*IncrementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+func (m *Event_Inserted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Inserted.Merge(m, src)
 }
-func (*UnimplementedCounterMapServer) Decrement(ctx context.Context, req *DecrementRequest) (*DecrementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Decrement not implemented")
+func (m *Event_Inserted) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedCounterMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *Event_Inserted) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
 }
-func (*UnimplementedCounterMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+
+var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
+
+func (m *Event_Inserted) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
 }
-func (*UnimplementedCounterMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+
+type Event_Updated struct {
+	Value     int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	PrevValue int64 `protobuf:"varint,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
-func (*UnimplementedCounterMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+
+func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
+func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
+func (*Event_Updated) ProtoMessage()    {}
+func (*Event_Updated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{34, 1}
 }
-func (*UnimplementedCounterMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedCounterMapServer) Events(req *EventsRequest, srv CounterMap_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedCounterMapServer) Entries(req *EntriesRequest, srv CounterMap_EntriesServer) error {
-	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
+func (m *Event_Updated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Updated.Merge(m, src)
 }
-func (*UnimplementedCounterMapServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (m *Event_Updated) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedCounterMapServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+func (m *Event_Updated) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
 }
 
-func RegisterCounterMapServer(s *grpc.Server, srv CounterMapServer) {
-	s.RegisterService(&_CounterMap_serviceDesc, srv)
-}
+var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
 
-func _CounterMap_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Size(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Size",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Size(ctx, req.(*SizeRequest))
+func (m *Event_Updated) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _CounterMap_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Set(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Set",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Set(ctx, req.(*SetRequest))
+func (m *Event_Updated) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _CounterMap_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Insert(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Insert",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Insert(ctx, req.(*InsertRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Event_Removed struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func _CounterMap_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Update(ctx, req.(*UpdateRequest))
+func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
+func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
+func (*Event_Removed) ProtoMessage()    {}
+func (*Event_Removed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{34, 2}
+}
+func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Event_Removed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Removed.Merge(m, src)
+}
+func (m *Event_Removed) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Removed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
 }
 
-func _CounterMap_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncrementRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Increment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Increment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Increment(ctx, req.(*IncrementRequest))
+var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
+
+func (m *Event_Removed) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _CounterMap_Decrement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecrementRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Decrement(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Decrement",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Decrement(ctx, req.(*DecrementRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Entry struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func _CounterMap_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Get(ctx, req.(*GetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Entry) Reset()         { *m = Entry{} }
+func (m *Entry) String() string { return proto.CompactTextString(m) }
+func (*Entry) ProtoMessage()    {}
+func (*Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e659fb9e7cb504fa, []int{35}
 }
-
-func _CounterMap_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Remove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Remove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Remove(ctx, req.(*RemoveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _CounterMap_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Clear(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Clear",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Clear(ctx, req.(*ClearRequest))
+func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _CounterMap_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Lock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Lock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Lock(ctx, req.(*LockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entry.Merge(m, src)
+}
+func (m *Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entry.DiscardUnknown(m)
 }
 
-func _CounterMap_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Unlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Unlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Unlock(ctx, req.(*UnlockRequest))
+var xxx_messageInfo_Entry proto.InternalMessageInfo
+
+func (m *Entry) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _CounterMap_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *Entry) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return srv.(CounterMapServer).Events(m, &counterMapEventsServer{stream})
+	return 0
 }
 
-type CounterMap_EventsServer interface {
-	Send(*EventsResponse) error
-	grpc.ServerStream
+func init() {
+	proto.RegisterType((*SizeRequest)(nil), "atomix.runtime.countermap.v1.SizeRequest")
+	proto.RegisterType((*SizeResponse)(nil), "atomix.runtime.countermap.v1.SizeResponse")
+	proto.RegisterType((*SetRequest)(nil), "atomix.runtime.countermap.v1.SetRequest")
+	proto.RegisterType((*SetResponse)(nil), "atomix.runtime.countermap.v1.SetResponse")
+	proto.RegisterType((*InsertRequest)(nil), "atomix.runtime.countermap.v1.InsertRequest")
+	proto.RegisterType((*InsertResponse)(nil), "atomix.runtime.countermap.v1.InsertResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "atomix.runtime.countermap.v1.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "atomix.runtime.countermap.v1.UpdateResponse")
+	proto.RegisterType((*IncrementRequest)(nil), "atomix.runtime.countermap.v1.IncrementRequest")
+	proto.RegisterType((*IncrementResponse)(nil), "atomix.runtime.countermap.v1.IncrementResponse")
+	proto.RegisterType((*DecrementRequest)(nil), "atomix.runtime.countermap.v1.DecrementRequest")
+	proto.RegisterType((*DecrementResponse)(nil), "atomix.runtime.countermap.v1.DecrementResponse")
+	proto.RegisterType((*GetRequest)(nil), "atomix.runtime.countermap.v1.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "atomix.runtime.countermap.v1.GetResponse")
+	proto.RegisterType((*SetBoundsRequest)(nil), "atomix.runtime.countermap.v1.SetBoundsRequest")
+	proto.RegisterType((*SetBoundsResponse)(nil), "atomix.runtime.countermap.v1.SetBoundsResponse")
+	proto.RegisterType((*TopKRequest)(nil), "atomix.runtime.countermap.v1.TopKRequest")
+	proto.RegisterType((*TopKResponse)(nil), "atomix.runtime.countermap.v1.TopKResponse")
+	proto.RegisterType((*BottomKRequest)(nil), "atomix.runtime.countermap.v1.BottomKRequest")
+	proto.RegisterType((*BottomKResponse)(nil), "atomix.runtime.countermap.v1.BottomKResponse")
+	proto.RegisterType((*RangeByValueRequest)(nil), "atomix.runtime.countermap.v1.RangeByValueRequest")
+	proto.RegisterType((*RangeByValueResponse)(nil), "atomix.runtime.countermap.v1.RangeByValueResponse")
+	proto.RegisterType((*RemoveRequest)(nil), "atomix.runtime.countermap.v1.RemoveRequest")
+	proto.RegisterType((*RemoveResponse)(nil), "atomix.runtime.countermap.v1.RemoveResponse")
+	proto.RegisterType((*ClearRequest)(nil), "atomix.runtime.countermap.v1.ClearRequest")
+	proto.RegisterType((*ClearResponse)(nil), "atomix.runtime.countermap.v1.ClearResponse")
+	proto.RegisterType((*LockRequest)(nil), "atomix.runtime.countermap.v1.LockRequest")
+	proto.RegisterType((*LockResponse)(nil), "atomix.runtime.countermap.v1.LockResponse")
+	proto.RegisterType((*UnlockRequest)(nil), "atomix.runtime.countermap.v1.UnlockRequest")
+	proto.RegisterType((*UnlockResponse)(nil), "atomix.runtime.countermap.v1.UnlockResponse")
+	proto.RegisterType((*EntriesRequest)(nil), "atomix.runtime.countermap.v1.EntriesRequest")
+	proto.RegisterType((*EntriesResponse)(nil), "atomix.runtime.countermap.v1.EntriesResponse")
+	proto.RegisterType((*EventsRequest)(nil), "atomix.runtime.countermap.v1.EventsRequest")
+	proto.RegisterType((*EventsResponse)(nil), "atomix.runtime.countermap.v1.EventsResponse")
+	proto.RegisterType((*Event)(nil), "atomix.runtime.countermap.v1.Event")
+	proto.RegisterType((*Event_Inserted)(nil), "atomix.runtime.countermap.v1.Event.Inserted")
+	proto.RegisterType((*Event_Updated)(nil), "atomix.runtime.countermap.v1.Event.Updated")
+	proto.RegisterType((*Event_Removed)(nil), "atomix.runtime.countermap.v1.Event.Removed")
+	proto.RegisterType((*Entry)(nil), "atomix.runtime.countermap.v1.Entry")
 }
 
-type counterMapEventsServer struct {
-	grpc.ServerStream
+func init() {
+	proto.RegisterFile("runtime/countermap/v1/countermap.proto", fileDescriptor_e659fb9e7cb504fa)
 }
 
-func (x *counterMapEventsServer) Send(m *EventsResponse) error {
-	return x.ServerStream.SendMsg(m)
+var fileDescriptor_e659fb9e7cb504fa = []byte{
+	// 1202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1c, 0xcd, 0xf8, 0x23, 0x4e, 0x7e, 0xfe, 0x48, 0x3a, 0xcd, 0xc1, 0x2c, 0xe0, 0x44, 0x0b, 0x2a,
+	0x6e, 0xea, 0xae, 0xb1, 0x39, 0x81, 0x10, 0x48, 0x8e, 0x23, 0xe3, 0x02, 0x52, 0xd9, 0xd0, 0x20,
+	0x05, 0x45, 0x65, 0x13, 0x4f, 0xd3, 0x55, 0xec, 0x1d, 0xb3, 0x3b, 0x36, 0xa4, 0xe2, 0xc6, 0x85,
+	0x23, 0xea, 0xa9, 0x27, 0x0e, 0x48, 0xfc, 0x2f, 0x3d, 0xf6, 0xc8, 0xa9, 0xa0, 0xe4, 0x1f, 0x41,
+	0xf3, 0xb1, 0xf6, 0xae, 0x1b, 0x65, 0x27, 0x60, 0x57, 0xdc, 0x76, 0xd6, 0xef, 0xf7, 0x7b, 0x6f,
+	0x66, 0xde, 0x8c, 0xdf, 0xc2, 0x2d, 0x7f, 0xe4, 0x31, 0x77, 0x40, 0xea, 0xc7, 0x74, 0xe4, 0x31,
+	0xe2, 0x0f, 0x9c, 0x61, 0x7d, 0xdc, 0x88, 0x8c, 0xac, 0xa1, 0x4f, 0x19, 0xc5, 0x6f, 0x39, 0x8c,
+	0x0e, 0xdc, 0x1f, 0x2d, 0x05, 0xb7, 0x22, 0x80, 0x71, 0xc3, 0xa8, 0x9c, 0x50, 0x7a, 0xd2, 0x27,
+	0x75, 0x81, 0x3d, 0x1a, 0x3d, 0xaa, 0xf7, 0x46, 0xbe, 0xc3, 0x5c, 0xea, 0xc9, 0x6a, 0xa3, 0x1c,
+	0xb2, 0x8c, 0x1b, 0xf5, 0xb0, 0x83, 0xfc, 0xe5, 0xbd, 0x24, 0xfe, 0x40, 0x01, 0x37, 0x4e, 0xe8,
+	0x09, 0x15, 0x8f, 0x75, 0xfe, 0x24, 0xdf, 0x9a, 0x5d, 0xc8, 0xef, 0xb9, 0x4f, 0x88, 0x4d, 0xbe,
+	0x1f, 0x91, 0x80, 0xe1, 0x8f, 0x20, 0xe5, 0xf6, 0xca, 0x68, 0x0b, 0x55, 0xf3, 0xcd, 0x8a, 0x35,
+	0x23, 0x79, 0xdc, 0xb0, 0xee, 0xfb, 0xee, 0xc0, 0x65, 0xee, 0x98, 0x74, 0xdb, 0x2d, 0x78, 0xfe,
+	0x72, 0x73, 0xe9, 0xfc, 0xe5, 0x66, 0xaa, 0xdb, 0xb6, 0x53, 0x6e, 0xcf, 0x34, 0xa1, 0x20, 0x5b,
+	0x05, 0x43, 0xea, 0x05, 0x04, 0x63, 0xc8, 0x04, 0xee, 0x13, 0x22, 0xba, 0x15, 0x6d, 0xf1, 0x6c,
+	0x0e, 0x01, 0xf6, 0x08, 0x9b, 0x03, 0x1b, 0x5e, 0x87, 0xf4, 0x29, 0x39, 0x2b, 0xa7, 0xb6, 0x50,
+	0x75, 0xd5, 0xe6, 0x8f, 0x78, 0x03, 0xb2, 0x63, 0xa7, 0x3f, 0x22, 0xe5, 0xf4, 0x16, 0xaa, 0xa6,
+	0x6d, 0x39, 0x30, 0x6b, 0x90, 0x17, 0x8c, 0x4a, 0xd4, 0xdb, 0x00, 0x43, 0x9f, 0x8c, 0x1f, 0x4a,
+	0x24, 0x12, 0xc8, 0x55, 0xfe, 0x66, 0x5f, 0xa0, 0x03, 0x28, 0x76, 0xbd, 0x80, 0xf8, 0xaf, 0x55,
+	0xe2, 0x3a, 0x94, 0x42, 0x52, 0xa9, 0xd2, 0x7c, 0x8a, 0xa0, 0xf8, 0x60, 0xd8, 0x73, 0x18, 0x79,
+	0x8d, 0x3a, 0x66, 0xd6, 0x26, 0x33, 0xbb, 0x36, 0x75, 0x28, 0x85, 0x9a, 0xf4, 0x16, 0xf3, 0x29,
+	0x82, 0xf5, 0xae, 0x77, 0xec, 0x93, 0x01, 0xf1, 0x16, 0xb7, 0xa0, 0x3d, 0xd2, 0x67, 0x4e, 0x38,
+	0x11, 0x31, 0xc0, 0x06, 0xac, 0x04, 0x0e, 0xe3, 0x27, 0x48, 0x4e, 0x63, 0xc5, 0x9e, 0x8c, 0xcd,
+	0x26, 0xdc, 0x88, 0x68, 0xd2, 0x9f, 0x48, 0x9b, 0xfc, 0xff, 0x26, 0xd2, 0x26, 0xd7, 0x9c, 0xc8,
+	0x01, 0x40, 0x67, 0x41, 0xc7, 0xcf, 0x74, 0x20, 0xdf, 0x89, 0x1c, 0xb4, 0x89, 0xc5, 0x50, 0xd4,
+	0x62, 0x1f, 0xc3, 0xf2, 0x11, 0x1d, 0x79, 0xbd, 0x40, 0x54, 0xe6, 0x9b, 0xef, 0x5a, 0x57, 0x5d,
+	0x8b, 0x56, 0x4b, 0x60, 0x6d, 0x55, 0x63, 0xfe, 0x86, 0x60, 0x7d, 0x8f, 0x30, 0xf5, 0x76, 0x21,
+	0xfb, 0x30, 0x15, 0x98, 0xfe, 0x17, 0x02, 0x6f, 0xc2, 0x8d, 0x88, 0x3e, 0x75, 0x98, 0xbf, 0x81,
+	0xfc, 0xd7, 0x74, 0xf8, 0xf9, 0x3c, 0xf4, 0x16, 0x00, 0x9d, 0x0a, 0xb5, 0x45, 0x1b, 0x9d, 0x9a,
+	0x7b, 0x50, 0x90, 0x8d, 0xd5, 0x92, 0xef, 0x40, 0x8e, 0x78, 0xcc, 0x77, 0x49, 0x50, 0x46, 0x5b,
+	0xe9, 0x6a, 0xbe, 0xf9, 0xce, 0xd5, 0xe2, 0x77, 0x3d, 0xe6, 0x9f, 0xb5, 0x32, 0x9c, 0xc3, 0x0e,
+	0x2b, 0xcd, 0x03, 0x28, 0xb5, 0x28, 0x63, 0x74, 0xb0, 0x00, 0xc1, 0xfb, 0xb0, 0x36, 0xe9, 0x3d,
+	0x4f, 0xcd, 0x7f, 0x20, 0xb8, 0x69, 0x3b, 0xde, 0x09, 0x69, 0x9d, 0x09, 0x9f, 0xcf, 0x43, 0xf9,
+	0x7f, 0x72, 0x2a, 0x77, 0x7f, 0x9f, 0xf7, 0x16, 0x2e, 0x2a, 0xda, 0x72, 0x60, 0x7e, 0x0b, 0x1b,
+	0x71, 0x99, 0xf3, 0x5c, 0x84, 0x9f, 0xa0, 0x68, 0x93, 0x01, 0x1d, 0x2f, 0xe8, 0x2f, 0x23, 0x7e,
+	0xb3, 0xa4, 0x67, 0x6f, 0x96, 0x5b, 0x50, 0x0a, 0xd9, 0xaf, 0xba, 0x00, 0xcc, 0x7b, 0x50, 0xd8,
+	0xe9, 0x13, 0xc7, 0x9f, 0x47, 0xe0, 0x58, 0x83, 0xa2, 0xea, 0xa5, 0x4e, 0xda, 0x33, 0x04, 0xf9,
+	0x2f, 0xe8, 0xf1, 0xe9, 0x3c, 0x56, 0x00, 0x43, 0xe6, 0x94, 0x9c, 0xf1, 0xdd, 0x4f, 0x57, 0x57,
+	0x6d, 0xf1, 0x8c, 0x3f, 0x84, 0x1c, 0x2f, 0xa5, 0x23, 0xa6, 0x6e, 0x87, 0x37, 0x2c, 0x99, 0xdb,
+	0xac, 0x30, 0xb7, 0x59, 0x6d, 0x95, 0xdb, 0x5a, 0x99, 0x67, 0x7f, 0x6d, 0x22, 0x3b, 0xc4, 0x9b,
+	0x25, 0x28, 0x48, 0x65, 0x4a, 0xea, 0x43, 0x28, 0x3e, 0xf0, 0xfa, 0x8b, 0xd3, 0xca, 0x43, 0x45,
+	0x48, 0xa0, 0x28, 0x8f, 0xa0, 0xb4, 0x2b, 0xbd, 0x32, 0x0f, 0xce, 0x0d, 0xc8, 0xfe, 0xe0, 0xb0,
+	0xe3, 0xc7, 0xc2, 0x23, 0x2b, 0xb6, 0x1c, 0x98, 0x36, 0xac, 0x4d, 0x38, 0x94, 0x0f, 0x3e, 0x85,
+	0x2c, 0xb7, 0xe8, 0x99, 0xe2, 0xb9, 0x86, 0xb5, 0x65, 0x9d, 0x79, 0x08, 0xc5, 0xdd, 0x31, 0xf1,
+	0xd8, 0x62, 0x6e, 0x7c, 0xf3, 0x2b, 0x28, 0x85, 0xed, 0x23, 0x8a, 0xf9, 0x9b, 0x72, 0x4a, 0x4b,
+	0x31, 0x87, 0x4e, 0x14, 0xf3, 0x81, 0xf9, 0x73, 0x1a, 0xb2, 0xe2, 0x75, 0x48, 0x87, 0xa6, 0xe7,
+	0xe8, 0x1e, 0xac, 0xb8, 0x22, 0xec, 0x91, 0x9e, 0xea, 0x5f, 0xd3, 0xe8, 0x6f, 0x75, 0x55, 0xcd,
+	0x67, 0x4b, 0xf6, 0xa4, 0x1e, 0x77, 0x20, 0x37, 0x12, 0x89, 0xac, 0xa7, 0xfc, 0x78, 0x47, 0xa7,
+	0x95, 0x0c, 0x71, 0xbc, 0x53, 0x58, 0xcd, 0x1b, 0xf9, 0xe2, 0xf4, 0xf6, 0xca, 0x19, 0xfd, 0x46,
+	0xf2, 0xc0, 0x8b, 0x46, 0xaa, 0xda, 0xd8, 0x82, 0x95, 0x50, 0xe9, 0xe5, 0x17, 0x80, 0xf1, 0x09,
+	0xe4, 0x94, 0x80, 0xcb, 0x01, 0x33, 0x17, 0x4d, 0x6a, 0xe6, 0xa2, 0x31, 0x36, 0x21, 0xa7, 0x78,
+	0x2f, 0xaf, 0x6f, 0xe5, 0xd4, 0xee, 0x99, 0x75, 0xc8, 0x0a, 0x37, 0x5d, 0xb2, 0x09, 0x93, 0xca,
+	0x54, 0xa4, 0xb2, 0xf9, 0xfb, 0x1a, 0xc0, 0x8e, 0x9c, 0xe7, 0x97, 0xce, 0x10, 0x1f, 0x42, 0x86,
+	0x7f, 0xcf, 0xe0, 0xdb, 0x57, 0xaf, 0x45, 0xe4, 0xf3, 0xc9, 0xd8, 0xd6, 0x81, 0x2a, 0x97, 0x1d,
+	0x40, 0x7a, 0x8f, 0x30, 0x5c, 0x4d, 0x28, 0x99, 0xc4, 0x35, 0xe3, 0xb6, 0x06, 0x52, 0xf5, 0x3e,
+	0x86, 0x65, 0xb9, 0x0d, 0x38, 0x61, 0x23, 0x63, 0x1f, 0x3b, 0x46, 0x4d, 0x0f, 0x3c, 0x25, 0x91,
+	0x3b, 0x99, 0x44, 0x12, 0xfb, 0x92, 0x31, 0x6a, 0x7a, 0x60, 0x45, 0xd2, 0x87, 0xd5, 0x49, 0x5c,
+	0xc7, 0x56, 0x92, 0xbe, 0x78, 0x44, 0x37, 0xea, 0xda, 0xf8, 0x29, 0x5b, 0x9b, 0x68, 0xb2, 0xb5,
+	0xc9, 0xf5, 0xd8, 0x5e, 0x0d, 0xeb, 0x7d, 0x58, 0x9d, 0xa4, 0xc5, 0x24, 0xb6, 0xd9, 0xd8, 0x6b,
+	0xd4, 0xb5, 0xf1, 0x53, 0xbf, 0x75, 0x92, 0xfd, 0xd6, 0xd1, 0xf6, 0x5b, 0x27, 0xee, 0x37, 0x79,
+	0x28, 0x93, 0xac, 0x10, 0x4b, 0x28, 0x46, 0x4d, 0x0f, 0xac, 0x48, 0x0e, 0x21, 0xc3, 0xe3, 0x6e,
+	0xd2, 0x79, 0x8c, 0x64, 0x6d, 0x63, 0x5b, 0x07, 0xaa, 0xda, 0x3f, 0x82, 0x9c, 0x0a, 0xa7, 0xb8,
+	0x96, 0x94, 0xf5, 0xa2, 0xf9, 0xd8, 0xb8, 0xab, 0x89, 0x56, 0x3c, 0x23, 0x28, 0x44, 0x43, 0x20,
+	0x6e, 0x24, 0x2c, 0xc2, 0xab, 0xb9, 0xd6, 0x68, 0x5e, 0xa7, 0x44, 0xd1, 0x7e, 0x07, 0x59, 0x11,
+	0x96, 0x70, 0xc2, 0x9a, 0x44, 0xd3, 0x99, 0x71, 0x47, 0x0b, 0x3b, 0xdd, 0x1f, 0x1e, 0x71, 0x92,
+	0xf6, 0x27, 0x12, 0xd0, 0x8c, 0x6d, 0x1d, 0x68, 0xe4, 0xba, 0x11, 0x81, 0x26, 0xf1, 0xba, 0x89,
+	0xe6, 0x2a, 0xa3, 0xa6, 0x07, 0x56, 0x24, 0x04, 0x96, 0x65, 0x18, 0xc0, 0x3a, 0xff, 0x80, 0x81,
+	0x26, 0x49, 0x3c, 0x5f, 0xbc, 0x8f, 0xf0, 0x63, 0xc8, 0xa9, 0x98, 0x94, 0xe4, 0xb5, 0x78, 0x62,
+	0x33, 0xee, 0x6a, 0xa2, 0x23, 0x4c, 0xcb, 0x3b, 0x3e, 0xd1, 0xb8, 0xa4, 0x25, 0x4a, 0x73, 0x42,
+	0x21, 0x58, 0x25, 0xcb, 0xf4, 0x2f, 0x29, 0x84, 0x09, 0x37, 0x18, 0x0d, 0x48, 0xb2, 0xc1, 0x68,
+	0x40, 0xb4, 0x0d, 0x46, 0x83, 0x38, 0x4d, 0x6b, 0xf7, 0xf9, 0x79, 0x05, 0xbd, 0x38, 0xaf, 0xa0,
+	0xbf, 0xcf, 0x2b, 0xe8, 0xd7, 0x8b, 0xca, 0xd2, 0x8b, 0x8b, 0xca, 0xd2, 0x9f, 0x17, 0x95, 0x25,
+	0x78, 0xd3, 0xa5, 0x61, 0x37, 0x67, 0xe8, 0xc6, 0x3b, 0xb5, 0x0a, 0xd3, 0x3f, 0xf6, 0xfd, 0xc6,
+	0x7d, 0x74, 0xb4, 0x2c, 0x12, 0xfb, 0x07, 0xff, 0x0c, 0x00, 0xf8, 0xa3, 0x38, 0x0d, 0xbf, 0x15,
+	0x00, 0x00,
 }
 
-func _CounterMap_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EntriesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(CounterMapServer).Entries(m, &counterMapEntriesServer{stream})
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-type CounterMap_EntriesServer interface {
-	Send(*EntriesResponse) error
-	grpc.ServerStream
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CounterMapClient is the client API for CounterMap service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CounterMapClient interface {
+	// Size returns the size of the map
+	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
+	// Set sets an entry into the map
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	// Insert inserts an entry into the map
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Increment increments a counter in the map
+	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
+	// Decrement decrements a counter in the map
+	Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error)
+	// SetBounds sets the lower and upper bounds of a counter in the map
+	SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error)
+	// Get gets the entry for a key
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	// TopK gets the k entries with the highest values in descending order of value
+	TopK(ctx context.Context, in *TopKRequest, opts ...grpc.CallOption) (*TopKResponse, error)
+	// BottomK gets the k entries with the lowest values in ascending order of value
+	BottomK(ctx context.Context, in *BottomKRequest, opts ...grpc.CallOption) (*BottomKResponse, error)
+	// RangeByValue gets the entries with values within a range in ascending order of value
+	RangeByValue(ctx context.Context, in *RangeByValueRequest, opts ...grpc.CallOption) (*RangeByValueResponse, error)
+	// Clear removes all entries from the map
+	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error)
+	// Entries lists all entries in the map
+	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error)
+	// Create creates the counter map session
+	// Deprecated: use the CounterMaps service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the counter map session
+	// Deprecated: use the CounterMaps service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
 }
 
-type counterMapEntriesServer struct {
-	grpc.ServerStream
+type counterMapClient struct {
+	cc *grpc.ClientConn
 }
 
-func (x *counterMapEntriesServer) Send(m *EntriesResponse) error {
-	return x.ServerStream.SendMsg(m)
+func NewCounterMapClient(cc *grpc.ClientConn) CounterMapClient {
+	return &counterMapClient{cc}
 }
 
-func _CounterMap_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
-	if err := dec(in); err != nil {
+func (c *counterMapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
+	out := new(SizeResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Size", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Create(ctx, req.(*CreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _CounterMap_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseRequest)
-	if err := dec(in); err != nil {
+func (c *counterMapClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Set", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Close(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Close",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Close(ctx, req.(*CloseRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _CounterMap_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomix.runtime.countermap.v1.CounterMap",
-	HandlerType: (*CounterMapServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Size",
-			Handler:    _CounterMap_Size_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _CounterMap_Set_Handler,
-		},
-		{
-			MethodName: "Insert",
-			Handler:    _CounterMap_Insert_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _CounterMap_Update_Handler,
-		},
-		{
-			MethodName: "Increment",
-			Handler:    _CounterMap_Increment_Handler,
-		},
-		{
-			MethodName: "Decrement",
-			Handler:    _CounterMap_Decrement_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _CounterMap_Get_Handler,
-		},
-		{
-			MethodName: "Remove",
-			Handler:    _CounterMap_Remove_Handler,
-		},
-		{
-			MethodName: "Clear",
-			Handler:    _CounterMap_Clear_Handler,
-		},
-		{
-			MethodName: "Lock",
-			Handler:    _CounterMap_Lock_Handler,
-		},
-		{
-			MethodName: "Unlock",
-			Handler:    _CounterMap_Unlock_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _CounterMap_Create_Handler,
-		},
-		{
-			MethodName: "Close",
-			Handler:    _CounterMap_Close_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Events",
-			Handler:       _CounterMap_Events_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Entries",
-			Handler:       _CounterMap_Entries_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "runtime/countermap/v1/countermap.proto",
+	return out, nil
 }
 
-func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
+	out := new(InsertResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Insert", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (c *counterMapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error) {
+	out := new(IncrementResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Increment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error) {
+	out := new(DecrementResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Decrement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x8
+func (c *counterMapClient) SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error) {
+	out := new(SetBoundsResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/SetBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (c *counterMapClient) TopK(ctx context.Context, in *TopKRequest, opts ...grpc.CallOption) (*TopKResponse, error) {
+	out := new(TopKResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/TopK", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) BottomK(ctx context.Context, in *BottomKRequest, opts ...grpc.CallOption) (*BottomKResponse, error) {
+	out := new(BottomKResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/BottomK", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) RangeByValue(ctx context.Context, in *RangeByValueRequest, opts ...grpc.CallOption) (*RangeByValueResponse, error) {
+	out := new(RangeByValueResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/RangeByValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x8
+func (c *counterMapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
+	out := new(ClearResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Clear", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Lock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+func (c *counterMapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[0], "/atomix.runtime.countermap.v1.CounterMap/Events", opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	x := &counterMapEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CounterMap_EventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type counterMapEventsClient struct {
+	grpc.ClientStream
 }
 
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *counterMapEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+func (c *counterMapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[1], "/atomix.runtime.countermap.v1.CounterMap/Entries", opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	x := &counterMapEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CounterMap_EntriesClient interface {
+	Recv() (*EntriesResponse, error)
+	grpc.ClientStream
 }
 
-func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type counterMapEntriesClient struct {
+	grpc.ClientStream
 }
 
-func (m *IncrementRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *counterMapEntriesClient) Recv() (*EntriesResponse, error) {
+	m := new(EntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IncrementRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *IncrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Delta != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+// Deprecated: Do not use.
+func (c *counterMapClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *IncrementResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *counterMapClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.countermap.v1.CounterMap/Close", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *IncrementResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CounterMapServer is the server API for CounterMap service.
+type CounterMapServer interface {
+	// Size returns the size of the map
+	Size(context.Context, *SizeRequest) (*SizeResponse, error)
+	// Set sets an entry into the map
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	// Insert inserts an entry into the map
+	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Increment increments a counter in the map
+	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
+	// Decrement decrements a counter in the map
+	Decrement(context.Context, *DecrementRequest) (*DecrementResponse, error)
+	// SetBounds sets the lower and upper bounds of a counter in the map
+	SetBounds(context.Context, *SetBoundsRequest) (*SetBoundsResponse, error)
+	// Get gets the entry for a key
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+	// TopK gets the k entries with the highest values in descending order of value
+	TopK(context.Context, *TopKRequest) (*TopKResponse, error)
+	// BottomK gets the k entries with the lowest values in ascending order of value
+	BottomK(context.Context, *BottomKRequest) (*BottomKResponse, error)
+	// RangeByValue gets the entries with values within a range in ascending order of value
+	RangeByValue(context.Context, *RangeByValueRequest) (*RangeByValueResponse, error)
+	// Clear removes all entries from the map
+	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(*EventsRequest, CounterMap_EventsServer) error
+	// Entries lists all entries in the map
+	Entries(*EntriesRequest, CounterMap_EntriesServer) error
+	// Create creates the counter map session
+	// Deprecated: use the CounterMaps service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the counter map session
+	// Deprecated: use the CounterMaps service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
 }
 
-func (m *IncrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedCounterMapServer can be embedded to have forward compatible implementations.
+type UnimplementedCounterMapServer struct {
 }
 
-func (m *DecrementRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedCounterMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
+}
+func (*UnimplementedCounterMapServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedCounterMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (*UnimplementedCounterMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedCounterMapServer) Increment(ctx context.Context, req *IncrementRequest) (*IncrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+}
+func (*UnimplementedCounterMapServer) Decrement(ctx context.Context, req *DecrementRequest) (*DecrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decrement not implemented")
+}
+func (*UnimplementedCounterMapServer) SetBounds(ctx context.Context, req *SetBoundsRequest) (*SetBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBounds not implemented")
+}
+func (*UnimplementedCounterMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedCounterMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (*UnimplementedCounterMapServer) TopK(ctx context.Context, req *TopKRequest) (*TopKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopK not implemented")
+}
+func (*UnimplementedCounterMapServer) BottomK(ctx context.Context, req *BottomKRequest) (*BottomKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BottomK not implemented")
+}
+func (*UnimplementedCounterMapServer) RangeByValue(ctx context.Context, req *RangeByValueRequest) (*RangeByValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeByValue not implemented")
+}
+func (*UnimplementedCounterMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+}
+func (*UnimplementedCounterMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedCounterMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedCounterMapServer) Events(req *EventsRequest, srv CounterMap_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (*UnimplementedCounterMapServer) Entries(req *EntriesRequest, srv CounterMap_EntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
+}
+func (*UnimplementedCounterMapServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedCounterMapServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
 
-func (m *DecrementRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterCounterMapServer(s *grpc.Server, srv CounterMapServer) {
+	s.RegisterService(&_CounterMap_serviceDesc, srv)
 }
 
-func (m *DecrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Delta != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
-		i--
-		dAtA[i] = 0x18
+func _CounterMap_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(CounterMapServer).Size(ctx, in)
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Size",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Size(ctx, req.(*SizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DecrementResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DecrementResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DecrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(CounterMapServer).Set(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(CounterMapServer).Insert(ctx, in)
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Insert",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(CounterMapServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+func _CounterMap_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).Increment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Increment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Increment(ctx, req.(*IncrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Decrement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).Decrement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Decrement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Decrement(ctx, req.(*DecrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_SetBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).SetBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/SetBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).SetBounds(ctx, req.(*SetBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x18
+func _CounterMap_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(CounterMapServer).Get(ctx, in)
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Get",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_TopK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).TopK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/TopK",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).TopK(ctx, req.(*TopKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+func _CounterMap_BottomK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BottomKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).BottomK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/BottomK",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).BottomK(ctx, req.(*BottomKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_RangeByValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeByValueRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).RangeByValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/RangeByValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).RangeByValue(ctx, req.(*RangeByValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Clear(ctx, req.(*ClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+func _CounterMap_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CounterMapServer).Events(m, &counterMapEventsServer{stream})
 }
 
-func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type CounterMap_EventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
 }
 
-func (m *LockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type counterMapEventsServer struct {
+	grpc.ServerStream
 }
 
-func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *counterMapEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timeout != nil {
-		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintCountermap(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+func _CounterMap_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return srv.(CounterMapServer).Entries(m, &counterMapEntriesServer{stream})
 }
 
-func (m *LockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type CounterMap_EntriesServer interface {
+	Send(*EntriesResponse) error
+	grpc.ServerStream
 }
 
-func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type counterMapEntriesServer struct {
+	grpc.ServerStream
 }
 
-func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (x *counterMapEntriesServer) Send(m *EntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
+func _CounterMap_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CounterMap_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.countermap.v1.CounterMap/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CounterMap_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.countermap.v1.CounterMap",
+	HandlerType: (*CounterMapServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Size",
+			Handler:    _CounterMap_Size_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _CounterMap_Set_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _CounterMap_Insert_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CounterMap_Update_Handler,
+		},
+		{
+			MethodName: "Increment",
+			Handler:    _CounterMap_Increment_Handler,
+		},
+		{
+			MethodName: "Decrement",
+			Handler:    _CounterMap_Decrement_Handler,
+		},
+		{
+			MethodName: "SetBounds",
+			Handler:    _CounterMap_SetBounds_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CounterMap_Get_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _CounterMap_Remove_Handler,
+		},
+		{
+			MethodName: "TopK",
+			Handler:    _CounterMap_TopK_Handler,
+		},
+		{
+			MethodName: "BottomK",
+			Handler:    _CounterMap_BottomK_Handler,
+		},
+		{
+			MethodName: "RangeByValue",
+			Handler:    _CounterMap_RangeByValue_Handler,
+		},
+		{
+			MethodName: "Clear",
+			Handler:    _CounterMap_Clear_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _CounterMap_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _CounterMap_Unlock_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _CounterMap_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _CounterMap_Close_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _CounterMap_Events_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Entries",
+			Handler:       _CounterMap_Entries_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "runtime/countermap/v1/countermap.proto",
+}
+
+func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3081,25 +2984,16 @@ func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	{
 		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3113,7 +3007,7 @@ func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3123,20 +3017,25 @@ func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3146,25 +3045,27 @@ func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Watch {
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
 		i--
-		if m.Watch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	{
 		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
@@ -3179,7 +3080,7 @@ func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3189,30 +3090,25 @@ func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
+func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3222,16 +3118,21 @@ func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
@@ -3252,7 +3153,7 @@ func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3262,30 +3163,20 @@ func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
 	return len(dAtA) - i, nil
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3295,99 +3186,130 @@ func (m *Event) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
 		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Inserted != nil {
-		{
-			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *IncrementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Removed != nil {
-		{
-			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if m.Saturate {
+		i--
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
+	}
+	if m.Delta != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
+
+func (m *IncrementResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3397,25 +3319,25 @@ func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+func (m *IncrementResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
+func (m *DecrementRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3425,30 +3347,52 @@ func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecrementRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+	if m.Saturate {
 		i--
-		dAtA[i] = 0x10
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.Delta != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+func (m *DecrementResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3458,25 +3402,25 @@ func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecrementResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Entry) Marshal() (dAtA []byte, err error) {
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3486,513 +3430,2404 @@ func (m *Entry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
 		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCountermap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCountermap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *SizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		n += 1 + sovCountermap(uint64(m.Size_))
-	}
-	return n
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetBoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *InsertRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *InsertResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
-	}
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+func (m *SetBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IncrementRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopKRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopKRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopKRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.K != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.K))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Delta != 0 {
-		n += 1 + sovCountermap(uint64(m.Delta))
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *IncrementResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopKResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopKResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopKResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCountermap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DecrementRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BottomKRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BottomKRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BottomKRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.K != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.K))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Delta != 0 {
-		n += 1 + sovCountermap(uint64(m.Delta))
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DecrementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
+func (m *BottomKResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+func (m *BottomKResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BottomKResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCountermap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RangeByValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeByValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeByValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
+	if m.Limit != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *RemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ClearRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RangeByValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClearResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *RangeByValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RangeByValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCountermap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Timeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovCountermap(uint64(l))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UnlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EntriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	if m.Watch {
-		n += 2
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EntriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Entry.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Event.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Event != nil {
-		n += m.Event.Size()
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Event_Inserted_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Inserted != nil {
-		l = m.Inserted.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Timeout != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintCountermap(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *Event_Updated_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *LockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Event_Removed_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Removed != nil {
-		l = m.Removed.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *Event_Inserted) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Updated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
-	}
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Removed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Inserted != nil {
+		{
+			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Removed != nil {
+		{
+			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCountermap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCountermap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	return n
+}
+
+func (m *SizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovCountermap(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *SetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *SetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *InsertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *InsertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *IncrementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovCountermap(uint64(m.Delta))
+	}
+	if m.Saturate {
+		n += 2
+	}
+	return n
+}
+
+func (m *IncrementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *DecrementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovCountermap(uint64(m.Delta))
+	}
+	if m.Saturate {
+		n += 2
+	}
+	return n
+}
+
+func (m *DecrementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TopKRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	if m.K != 0 {
+		n += 1 + sovCountermap(uint64(m.K))
+	}
+	return n
+}
+
+func (m *TopKResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BottomKRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	if m.K != 0 {
+		n += 1 + sovCountermap(uint64(m.K))
+	}
+	return n
+}
+
+func (m *BottomKResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RangeByValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCountermap(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *RangeByValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *RemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ClearRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	return n
+}
+
+func (m *ClearResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	if m.Watch {
+		n += 2
+	}
+	return n
+}
+
+func (m *EntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	return n
+}
+
+func (m *EventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *Event_Inserted_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inserted != nil {
+		l = m.Inserted.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Updated_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Removed_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removed != nil {
+		l = m.Removed.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Inserted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *Event_Updated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *Event_Removed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func sovCountermap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCountermap(x uint64) (n int) {
+	return sovCountermap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			}
+			m.PrevValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			}
+			m.PrevValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			}
+			m.PrevValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Entry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovCountermap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCountermap(x uint64) (n int) {
-	return sovCountermap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4015,10 +5850,10 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncrementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4054,6 +5889,77 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			m.Delta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Saturate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -4075,7 +5981,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4098,17 +6004,17 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncrementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
-			m.Size_ = 0
+			m.PrevValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -4118,7 +6024,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint32(b&0x7F) << shift
+				m.PrevValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4144,7 +6050,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetRequest) Unmarshal(dAtA []byte) error {
+func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4167,10 +6073,10 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecrementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4240,9 +6146,9 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
 			}
-			m.Value = 0
+			m.Delta = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -4252,11 +6158,31 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				m.Delta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Saturate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -4278,7 +6204,7 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetResponse) Unmarshal(dAtA []byte) error {
+func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4301,10 +6227,10 @@ func (m *SetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecrementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4347,7 +6273,7 @@ func (m *SetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4370,10 +6296,10 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4441,25 +6367,6 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -4481,7 +6388,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4494,22 +6401,77 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -4531,7 +6493,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *SetBoundsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4554,10 +6516,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBoundsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4626,10 +6588,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -4639,30 +6601,28 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
 			}
-			m.PrevValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -4684,7 +6644,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *SetBoundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4707,31 +6667,12 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBoundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
-			}
-			m.PrevValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -4753,7 +6694,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
+func (m *TopKRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4776,10 +6717,10 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopKRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopKRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4816,42 +6757,10 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
 			}
-			m.Delta = 0
+			m.K = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -4861,7 +6770,7 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delta |= int64(b&0x7F) << shift
+				m.K |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4887,7 +6796,7 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
+func (m *TopKResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4910,17 +6819,17 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopKResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopKResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.PrevValue = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -4930,11 +6839,26 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -4956,7 +6880,7 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
+func (m *BottomKRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4979,10 +6903,10 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BottomKRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BottomKRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5019,42 +6943,10 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
 			}
-			m.Delta = 0
+			m.K = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -5064,7 +6956,7 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delta |= int64(b&0x7F) << shift
+				m.K |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5090,7 +6982,7 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
+func (m *BottomKResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5113,17 +7005,17 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BottomKResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BottomKResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.PrevValue = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -5133,11 +7025,26 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -5159,7 +7066,7 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeByValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5182,10 +7089,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeByValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeByValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5223,9 +7130,9 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -5235,24 +7142,47 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -5274,7 +7204,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *RangeByValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5297,17 +7227,17 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeByValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeByValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -5317,11 +7247,26 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -30,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Config struct {
 	Cache CacheConfig `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache"`
+	// bounds are the default bounds of counters in the map
+	// Keys with bounds set by SetBounds are not affected by the default bounds
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -72,6 +75,114 @@ func (m *Config) GetCache() CacheConfig {
 	return CacheConfig{}
 }
 
+func (m *Config) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+// Bounds are the limits of a counter value
+type Bounds struct {
+	// min is the lowest value the counter may hold
+	// The counter is unbounded below when unset
+	Min *Bound `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the highest value the counter may hold
+	// The counter is unbounded above when unset
+	Max *Bound `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *Bounds) Reset()         { *m = Bounds{} }
+func (m *Bounds) String() string { return proto.CompactTextString(m) }
+func (*Bounds) ProtoMessage()    {}
+func (*Bounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd2dd875681fecce, []int{1}
+}
+func (m *Bounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bounds.Merge(m, src)
+}
+func (m *Bounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bounds proto.InternalMessageInfo
+
+func (m *Bounds) GetMin() *Bound {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *Bounds) GetMax() *Bound {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+type Bound struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Bound) Reset()         { *m = Bound{} }
+func (m *Bound) String() string { return proto.CompactTextString(m) }
+func (*Bound) ProtoMessage()    {}
+func (*Bound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd2dd875681fecce, []int{2}
+}
+func (m *Bound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bound.Merge(m, src)
+}
+func (m *Bound) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bound proto.InternalMessageInfo
+
+func (m *Bound) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 type CacheConfig struct {
 	Enabled bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	Size_   uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
@@ -81,7 +192,7 @@ func (m *CacheConfig) Reset()         { *m = CacheConfig{} }
 func (m *CacheConfig) String() string { return proto.CompactTextString(m) }
 func (*CacheConfig) ProtoMessage()    {}
 func (*CacheConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd2dd875681fecce, []int{1}
+	return fileDescriptor_fd2dd875681fecce, []int{3}
 }
 func (m *CacheConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,7 +244,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd2dd875681fecce, []int{2}
+	return fileDescriptor_fd2dd875681fecce, []int{4}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,7 +295,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd2dd875681fecce, []int{3}
+	return fileDescriptor_fd2dd875681fecce, []int{5}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +339,7 @@ func (m *CloseRequest) Reset()         { *m = CloseRequest{} }
 func (m *CloseRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseRequest) ProtoMessage()    {}
 func (*CloseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd2dd875681fecce, []int{4}
+	return fileDescriptor_fd2dd875681fecce, []int{6}
 }
 func (m *CloseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +382,7 @@ func (m *CloseResponse) Reset()         { *m = CloseResponse{} }
 func (m *CloseResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseResponse) ProtoMessage()    {}
 func (*CloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd2dd875681fecce, []int{5}
+	return fileDescriptor_fd2dd875681fecce, []int{7}
 }
 func (m *CloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,6 +413,8 @@ var xxx_messageInfo_CloseResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Config)(nil), "atomix.runtime.countermap.v1.Config")
+	proto.RegisterType((*Bounds)(nil), "atomix.runtime.countermap.v1.Bounds")
+	proto.RegisterType((*Bound)(nil), "atomix.runtime.countermap.v1.Bound")
 	proto.RegisterType((*CacheConfig)(nil), "atomix.runtime.countermap.v1.CacheConfig")
 	proto.RegisterType((*CreateRequest)(nil), "atomix.runtime.countermap.v1.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "atomix.runtime.countermap.v1.CreateResponse")
@@ -314,32 +427,36 @@ func init() {
 }
 
 var fileDescriptor_fd2dd875681fecce = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcd, 0xaa, 0xd3, 0x40,
-	0x14, 0xc7, 0x33, 0xb1, 0x8d, 0x7a, 0x6a, 0x15, 0x06, 0x17, 0x21, 0x4a, 0x5a, 0x82, 0x60, 0xb5,
-	0x92, 0x90, 0xba, 0xd3, 0x5d, 0x52, 0x85, 0x0a, 0x62, 0x09, 0xe2, 0x56, 0xa7, 0xe9, 0x18, 0x07,
-	0x9a, 0x4c, 0xcc, 0x4c, 0x83, 0xf8, 0x14, 0x3e, 0x56, 0x97, 0x5d, 0xb8, 0x70, 0x55, 0x24, 0x7d,
-	0x11, 0xe9, 0x24, 0xc1, 0xe8, 0xe2, 0xb6, 0x8b, 0xbb, 0x3b, 0xd3, 0xfe, 0x3f, 0x7e, 0xe7, 0x10,
-	0x78, 0x5c, 0x6c, 0x33, 0xc9, 0x52, 0xea, 0xc5, 0x7c, 0x9b, 0x49, 0x5a, 0xa4, 0x24, 0xf7, 0x4a,
-	0xbf, 0xf3, 0x12, 0x6e, 0x5e, 0x70, 0xc9, 0xf1, 0x43, 0x22, 0x79, 0xca, 0xbe, 0xb9, 0x8d, 0xde,
-	0xfd, 0xab, 0x70, 0x4b, 0xdf, 0x32, 0xdb, 0x98, 0xd2, 0xf7, 0x5a, 0x85, 0xf2, 0x59, 0xf7, 0x13,
-	0x9e, 0x70, 0x35, 0x7a, 0xa7, 0xa9, 0xfe, 0xd5, 0x79, 0x07, 0x46, 0xc8, 0xb3, 0xcf, 0x2c, 0xc1,
-	0xaf, 0xa0, 0x1f, 0x93, 0xf8, 0x0b, 0x35, 0xd1, 0x18, 0x4d, 0x06, 0xb3, 0x27, 0xee, 0x55, 0x3d,
-	0x6e, 0x78, 0x92, 0xd6, 0xce, 0xa0, 0xb7, 0x3b, 0x8c, 0xb4, 0xa8, 0x76, 0x3b, 0x2f, 0x61, 0xd0,
-	0xf9, 0x0f, 0x9b, 0x70, 0x93, 0x66, 0x64, 0xb5, 0xa1, 0x6b, 0x95, 0x7b, 0x2b, 0x6a, 0x9f, 0x18,
-	0x43, 0x4f, 0xb0, 0xef, 0xd4, 0xd4, 0xc7, 0x68, 0xd2, 0x8b, 0xd4, 0xec, 0x7c, 0x84, 0x61, 0x58,
-	0x50, 0x22, 0x69, 0x44, 0xbf, 0x6e, 0xa9, 0x90, 0xf8, 0x05, 0xe8, 0x6c, 0xdd, 0x10, 0xd9, 0xff,
-	0x13, 0x95, 0xbe, 0xbb, 0x2c, 0x58, 0xca, 0x24, 0x2b, 0xe9, 0x62, 0x1e, 0xc0, 0x09, 0xa3, 0x3a,
-	0x8c, 0xf4, 0xc5, 0x3c, 0xd2, 0x99, 0x2a, 0x90, 0x24, 0x11, 0xa6, 0x3e, 0xbe, 0x31, 0xb9, 0x1d,
-	0xa9, 0xd9, 0x79, 0x0f, 0x77, 0xdb, 0x02, 0x91, 0xf3, 0x4c, 0x50, 0x1c, 0x80, 0x11, 0x2b, 0xd4,
-	0xa6, 0xe5, 0xd1, 0x99, 0xbd, 0xbb, 0x2b, 0x37, 0x4e, 0xe7, 0x0d, 0xdc, 0x09, 0x37, 0x5c, 0x5c,
-	0x07, 0xb5, 0x73, 0x0f, 0x86, 0x4d, 0x56, 0x0d, 0x38, 0xfb, 0x89, 0x60, 0x10, 0xd6, 0x0c, 0x6f,
-	0x49, 0x2e, 0x70, 0x0c, 0x46, 0xbd, 0x02, 0x9e, 0x9e, 0x41, 0xed, 0x5e, 0xd2, 0x7a, 0x76, 0x99,
-	0xb8, 0xb9, 0xca, 0x27, 0xe8, 0x2b, 0x0a, 0xfc, 0xf4, 0x8c, 0xad, 0xb3, 0xb6, 0x35, 0xbd, 0x48,
-	0x5b, 0x37, 0x04, 0xaf, 0x77, 0x95, 0x8d, 0xf6, 0x95, 0x8d, 0x7e, 0x57, 0x36, 0xfa, 0x71, 0xb4,
-	0xb5, 0xfd, 0xd1, 0xd6, 0x7e, 0x1d, 0x6d, 0x0d, 0x1e, 0x30, 0xde, 0x06, 0x91, 0x9c, 0xfd, 0x1b,
-	0x12, 0x0c, 0x3b, 0xa7, 0xf8, 0xe0, 0x2f, 0xd1, 0xca, 0x50, 0xdf, 0xf1, 0xf3, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xea, 0xca, 0x2d, 0xd8, 0x40, 0x03, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0xef, 0xa4, 0x6d, 0xd4, 0x57, 0xab, 0x30, 0xec, 0x21, 0x54, 0xcd, 0xca, 0x28, 0xb8, 0xba,
+	0x92, 0x92, 0x15, 0x2f, 0xea, 0x29, 0x59, 0x85, 0x15, 0x84, 0x65, 0x10, 0xaf, 0x3a, 0x4d, 0xc7,
+	0x3a, 0xd0, 0x64, 0x62, 0x66, 0x12, 0x8a, 0xdf, 0x41, 0xf0, 0x63, 0xed, 0x71, 0x0f, 0x1e, 0x3c,
+	0x2d, 0xd2, 0x7e, 0x11, 0xc9, 0x4c, 0x82, 0x59, 0x0f, 0xbb, 0x15, 0xf6, 0xf6, 0x5e, 0xf8, 0xfd,
+	0x7b, 0xbf, 0x21, 0xf0, 0xa8, 0x28, 0x33, 0x2d, 0x52, 0x3e, 0x4d, 0x64, 0x99, 0x69, 0x5e, 0xa4,
+	0x2c, 0x9f, 0x56, 0x61, 0x67, 0x53, 0x41, 0x5e, 0x48, 0x2d, 0xf1, 0x5d, 0xa6, 0x65, 0x2a, 0x56,
+	0x41, 0x83, 0x0f, 0xfe, 0x22, 0x82, 0x2a, 0x9c, 0x78, 0xad, 0x4c, 0x15, 0x4e, 0x5b, 0x84, 0xe1,
+	0x4d, 0x76, 0x16, 0x72, 0x21, 0xcd, 0x38, 0xad, 0x27, 0xfb, 0x95, 0x7c, 0x47, 0xe0, 0xc6, 0x32,
+	0xfb, 0x2c, 0x16, 0xf8, 0x35, 0x0c, 0x13, 0x96, 0x7c, 0xe1, 0x1e, 0xba, 0x8f, 0xf6, 0x46, 0x07,
+	0x8f, 0x83, 0x8b, 0x8c, 0x82, 0xb8, 0x86, 0x5a, 0x66, 0x34, 0x38, 0x39, 0xdb, 0xed, 0x51, 0xcb,
+	0xc6, 0xaf, 0xc0, 0x9d, 0xc9, 0x32, 0x9b, 0x2b, 0xcf, 0x31, 0x3a, 0x0f, 0x2f, 0xd6, 0x89, 0x0c,
+	0x96, 0x36, 0x1c, 0x52, 0x81, 0x6b, 0xbf, 0xe0, 0xe7, 0xd0, 0x4f, 0x45, 0xd6, 0x84, 0x79, 0xb0,
+	0x85, 0x08, 0xad, 0xf1, 0x86, 0xc6, 0x56, 0x9e, 0xf3, 0x3f, 0x34, 0xb6, 0x22, 0xf7, 0x60, 0x68,
+	0x36, 0xbc, 0x03, 0xc3, 0x8a, 0x2d, 0x4b, 0xdb, 0x42, 0x9f, 0xda, 0x85, 0xbc, 0x84, 0x51, 0xe7,
+	0x60, 0xec, 0xc1, 0x35, 0x9e, 0xb1, 0xd9, 0x92, 0xcf, 0x0d, 0xec, 0x3a, 0x6d, 0x57, 0x8c, 0x61,
+	0xa0, 0xc4, 0x37, 0x6e, 0xfc, 0x07, 0xd4, 0xcc, 0xe4, 0x23, 0x8c, 0xe3, 0x82, 0x33, 0xcd, 0x29,
+	0xff, 0x5a, 0x72, 0xa5, 0xf1, 0x0b, 0x70, 0xc4, 0xbc, 0xb9, 0xcc, 0xff, 0x37, 0x62, 0x15, 0x06,
+	0xc7, 0x85, 0x48, 0x85, 0x16, 0x15, 0x3f, 0x3a, 0x8c, 0xa0, 0xee, 0x76, 0x7d, 0xb6, 0xeb, 0x1c,
+	0x1d, 0x52, 0x47, 0x18, 0x03, 0xcd, 0x16, 0x75, 0xb9, 0xfd, 0xbd, 0x1b, 0xd4, 0xcc, 0xe4, 0x3d,
+	0xdc, 0x6a, 0x0d, 0x54, 0x2e, 0x33, 0xc5, 0x71, 0x04, 0x6e, 0x62, 0xa2, 0x7a, 0x68, 0x9b, 0x47,
+	0x38, 0xf7, 0x8e, 0x0d, 0x93, 0xbc, 0x85, 0x9b, 0xf1, 0x52, 0xaa, 0xab, 0x48, 0x4d, 0x6e, 0xc3,
+	0xb8, 0xd1, 0xb2, 0x01, 0x0f, 0x7e, 0x22, 0x18, 0xc5, 0x36, 0xc3, 0x3b, 0x96, 0x2b, 0x9c, 0x80,
+	0x6b, 0x4f, 0xc0, 0xfb, 0x97, 0x44, 0xed, 0x36, 0x39, 0x79, 0xba, 0x1d, 0xb8, 0x69, 0xe5, 0x13,
+	0x0c, 0x4d, 0x0a, 0xfc, 0xe4, 0x12, 0x5a, 0xe7, 0xec, 0xc9, 0xfe, 0x56, 0x58, 0xeb, 0x10, 0xbd,
+	0x39, 0x59, 0xfb, 0xe8, 0x74, 0xed, 0xa3, 0xdf, 0x6b, 0x1f, 0xfd, 0xd8, 0xf8, 0xbd, 0xd3, 0x8d,
+	0xdf, 0xfb, 0xb5, 0xf1, 0x7b, 0x70, 0x47, 0xc8, 0x56, 0x88, 0xe5, 0xe2, 0xbc, 0x48, 0x34, 0xee,
+	0x54, 0xf1, 0x21, 0x3c, 0x46, 0x33, 0xd7, 0xfc, 0x9d, 0xcf, 0xfe, 0x0c, 0x00, 0x64, 0x43, 0xd8,
+	0x8b, 0x16, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -482,6 +599,18 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermaps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -495,6 +624,81 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Bounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermaps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermaps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermaps(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CacheConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -683,6 +887,39 @@ func (m *Config) Size() (n int) {
 	_ = l
 	l = m.Cache.Size()
 	n += 1 + l + sovCountermaps(uint64(l))
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermaps(uint64(l))
+	}
+	return n
+}
+
+func (m *Bounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovCountermaps(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovCountermaps(uint64(l))
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermaps(uint64(m.Value))
+	}
 	return n
 }
 
@@ -817,6 +1054,233 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermaps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermaps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Min == nil {
+				m.Min = &Bound{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = &Bound{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermaps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermaps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermaps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermaps(dAtA[iNdEx:])
@@ -31,6 +31,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type EnterRequest struct {
 	ID        v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Candidate string         `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	// priority is the priority of the candidate
+	// The highest-priority candidate is elected leader when the leader leaves the election. Candidates
+	// with equal priorities are elected in the order in which they entered the election.
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// metadata is arbitrary metadata describing the candidate, included in the election term
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// preempt replaces the current leader if the candidate has a higher priority than the leader
+	Preempt bool `protobuf:"varint,5,opt,name=preempt,proto3" json:"preempt,omitempty"`
 }
 
 func (m *EnterRequest) Reset()         { *m = EnterRequest{} }
@@ -80,6 +88,27 @@ func (m *EnterRequest) GetCandidate() string {
 	return ""
 }
 
+func (m *EnterRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *EnterRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *EnterRequest) GetPreempt() bool {
+	if m != nil {
+		return m.Preempt
+	}
+	return false
+}
+
 type EnterResponse struct {
 	Term Term `protobuf:"bytes,1,opt,name=term,proto3" json:"term"`
 }
@@ -784,6 +813,8 @@ type Term struct {
 	Term       uint64   `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
 	Leader     string   `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`
 	Candidates []string `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// candidate_info is the priority and metadata of the leader followed by each candidate
+	CandidateInfo []Candidate `protobuf:"bytes,4,rep,name=candidate_info,json=candidateInfo,proto3" json:"candidate_info"`
 }
 
 func (m *Term) Reset()         { *m = Term{} }
@@ -840,8 +871,76 @@ func (m *Term) GetCandidates() []string {
 	return nil
 }
 
+func (m *Term) GetCandidateInfo() []Candidate {
+	if m != nil {
+		return m.CandidateInfo
+	}
+	return nil
+}
+
+type Candidate struct {
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Priority int32             `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Candidate) Reset()         { *m = Candidate{} }
+func (m *Candidate) String() string { return proto.CompactTextString(m) }
+func (*Candidate) ProtoMessage()    {}
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82125d8a3de9ca9e, []int{17}
+}
+func (m *Candidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidate.Merge(m, src)
+}
+func (m *Candidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candidate proto.InternalMessageInfo
+
+func (m *Candidate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Candidate) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Candidate) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EnterRequest)(nil), "atomix.runtime.election.v1.EnterRequest")
+	proto.RegisterMapType((map[string]string)(nil), "atomix.runtime.election.v1.EnterRequest.MetadataEntry")
 	proto.RegisterType((*EnterResponse)(nil), "atomix.runtime.election.v1.EnterResponse")
 	proto.RegisterType((*WithdrawRequest)(nil), "atomix.runtime.election.v1.WithdrawRequest")
 	proto.RegisterType((*WithdrawResponse)(nil), "atomix.runtime.election.v1.WithdrawResponse")
@@ -858,6 +957,8 @@ func init() {
 	proto.RegisterType((*WatchRequest)(nil), "atomix.runtime.election.v1.WatchRequest")
 	proto.RegisterType((*WatchResponse)(nil), "atomix.runtime.election.v1.WatchResponse")
 	proto.RegisterType((*Term)(nil), "atomix.runtime.election.v1.Term")
+	proto.RegisterType((*Candidate)(nil), "atomix.runtime.election.v1.Candidate")
+	proto.RegisterMapType((map[string]string)(nil), "atomix.runtime.election.v1.Candidate.MetadataEntry")
 }
 
 func init() {
@@ -865,46 +966,56 @@ func init() {
 }
 
 var fileDescriptor_82125d8a3de9ca9e = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xf3, 0x49, 0x86, 0x26, 0xad, 0x56, 0x08, 0x05, 0x0b, 0xb9, 0x51, 0xb8, 0x04, 0x8a,
-	0x1c, 0x52, 0x6e, 0xbd, 0x91, 0xa6, 0xaa, 0x4a, 0x03, 0x8a, 0x2c, 0x44, 0x2f, 0x08, 0xe4, 0xc4,
-	0xab, 0x66, 0x21, 0xf6, 0x86, 0xf5, 0xd6, 0xf0, 0x13, 0x38, 0xf2, 0xb3, 0x7a, 0xec, 0x91, 0x53,
-	0x85, 0x12, 0x89, 0xdf, 0x81, 0x6c, 0xaf, 0x13, 0x3b, 0x52, 0x37, 0x3e, 0x38, 0xbd, 0x6d, 0x66,
-	0xdf, 0xcc, 0x7b, 0xb3, 0x93, 0x79, 0x09, 0xb4, 0xd8, 0x95, 0xc3, 0x89, 0x8d, 0x3b, 0x78, 0x8a,
-	0xc7, 0x9c, 0x50, 0xa7, 0xe3, 0x75, 0x97, 0x67, 0x7d, 0xc6, 0x28, 0xa7, 0x48, 0x35, 0x39, 0xb5,
-	0xc9, 0x4f, 0x5d, 0x40, 0xf5, 0xe5, 0xb5, 0xd7, 0x55, 0x1b, 0x51, 0xbe, 0xd7, 0xed, 0x44, 0xf7,
-	0x41, 0x96, 0xfa, 0x4c, 0x56, 0xd9, 0x15, 0xa0, 0x47, 0x97, 0xf4, 0x92, 0x06, 0xc7, 0x8e, 0x7f,
-	0x0a, 0xa3, 0xad, 0x09, 0xec, 0x9c, 0x38, 0x1c, 0x33, 0x03, 0x7f, 0xbf, 0xc2, 0x2e, 0x47, 0x47,
-	0x90, 0x27, 0x56, 0x43, 0x69, 0x2a, 0xed, 0x87, 0x87, 0x9a, 0xbe, 0xa6, 0xc6, 0xeb, 0xea, 0x43,
-	0x46, 0x6c, 0xc2, 0x89, 0x87, 0xcf, 0xfa, 0x3d, 0xb8, 0xbe, 0xdd, 0xcf, 0xcd, 0x6f, 0xf7, 0xf3,
-	0x67, 0x7d, 0x23, 0x4f, 0x2c, 0xf4, 0x14, 0xaa, 0x63, 0xd3, 0xb1, 0x88, 0x65, 0x72, 0xdc, 0xc8,
-	0x37, 0x95, 0x76, 0xd5, 0x58, 0x05, 0x5a, 0xe7, 0x50, 0x13, 0x4c, 0xee, 0x8c, 0x3a, 0x2e, 0x46,
-	0x47, 0x50, 0xe4, 0x98, 0xd9, 0x82, 0xac, 0xa9, 0xdf, 0xdd, 0xba, 0xfe, 0x01, 0x33, 0xbb, 0x57,
-	0xf4, 0xe9, 0x8c, 0x20, 0xa7, 0xf5, 0x0d, 0x76, 0x2f, 0x08, 0x9f, 0x58, 0xcc, 0xfc, 0xb1, 0x7d,
-	0xe5, 0xef, 0x61, 0x6f, 0x45, 0x96, 0x81, 0x78, 0x02, 0xb5, 0x37, 0x0e, 0x25, 0x0e, 0xdf, 0xbe,
-	0xf4, 0x01, 0xd4, 0x23, 0xaa, 0x0c, 0x84, 0x7f, 0x85, 0xfa, 0x90, 0x51, 0x9b, 0x72, 0xbc, 0x7d,
-	0xe5, 0xef, 0x60, 0x77, 0xc9, 0x95, 0xcd, 0x9b, 0xf7, 0xf1, 0xfd, 0x28, 0x1f, 0x40, 0x3d, 0xa2,
-	0xca, 0x40, 0xb8, 0xbf, 0xa0, 0x1e, 0x19, 0xf3, 0xfb, 0x59, 0xd0, 0x90, 0x29, 0x03, 0xd9, 0x03,
-	0xa8, 0x9f, 0x62, 0xee, 0x87, 0x33, 0x10, 0xee, 0x7f, 0x19, 0x96, 0xd5, 0x32, 0x10, 0xf7, 0x16,
-	0x76, 0x2e, 0x4c, 0x3e, 0x9e, 0x64, 0x21, 0xed, 0x1c, 0x6a, 0xa2, 0x56, 0x06, 0xc2, 0x0c, 0x28,
-	0xfa, 0x31, 0x84, 0x62, 0x35, 0x8a, 0xe1, 0x1d, 0x7a, 0x0c, 0xe5, 0x29, 0x36, 0x2d, 0xcc, 0xc4,
-	0xe4, 0xc4, 0x27, 0xa4, 0x01, 0x2c, 0x67, 0xe8, 0x36, 0x0a, 0xcd, 0x42, 0xbb, 0x6a, 0xc4, 0x22,
-	0x87, 0xff, 0x2a, 0x50, 0x1f, 0x04, 0xd0, 0x13, 0x41, 0x8d, 0x3e, 0x41, 0x29, 0xb0, 0x62, 0xd4,
-	0x96, 0xa9, 0x8b, 0xff, 0x2e, 0xa8, 0xcf, 0x53, 0x20, 0xc5, 0x03, 0x60, 0x78, 0x10, 0xd9, 0x25,
-	0x3a, 0x90, 0xa5, 0xad, 0x39, 0xb8, 0xfa, 0x32, 0x1d, 0x58, 0xd0, 0x7c, 0x81, 0x72, 0x68, 0x6d,
-	0x48, 0xaa, 0x2d, 0xe1, 0xb4, 0xea, 0x8b, 0x34, 0x50, 0x41, 0x30, 0x82, 0x8a, 0x70, 0x20, 0x24,
-	0x4d, 0x4b, 0x5a, 0xa2, 0x7a, 0x90, 0x0a, 0xbb, 0x6a, 0x22, 0xf4, 0x0a, 0x79, 0x13, 0x09, 0xeb,
-	0x92, 0x37, 0xb1, 0x66, 0x3d, 0xfe, 0xa8, 0xfd, 0xa5, 0xde, 0x30, 0xea, 0x98, 0xc3, 0x6c, 0x18,
-	0x75, 0xc2, 0x21, 0x46, 0x50, 0x11, 0x7b, 0x29, 0x7f, 0xa2, 0xa4, 0x15, 0xc8, 0x9f, 0x68, 0x7d,
-	0xd1, 0x3f, 0x43, 0x29, 0x58, 0x30, 0x79, 0x07, 0xf1, 0x7d, 0x96, 0x77, 0x90, 0xd8, 0xd6, 0x57,
-	0x0a, 0xb2, 0xa0, 0x7c, 0xcc, 0xb0, 0xb9, 0x69, 0x04, 0x21, 0x26, 0xd5, 0x08, 0x22, 0x68, 0x48,
-	0xd1, 0x2a, 0xfc, 0xca, 0x2b, 0xc8, 0x84, 0xd2, 0xf1, 0x94, 0xba, 0x58, 0xde, 0x45, 0x00, 0x49,
-	0xd5, 0x85, 0x40, 0xc6, 0x28, 0x7a, 0xa7, 0xd7, 0x73, 0x4d, 0xb9, 0x99, 0x6b, 0xca, 0xdf, 0xb9,
-	0xa6, 0xfc, 0x5e, 0x68, 0xb9, 0x9b, 0x85, 0x96, 0xfb, 0xb3, 0xd0, 0x72, 0xf0, 0x84, 0xd0, 0xa8,
-	0x96, 0x39, 0x23, 0xf1, 0x3a, 0xbd, 0xbd, 0xa4, 0x35, 0x7c, 0xec, 0x0e, 0x95, 0x51, 0x39, 0xf8,
-	0x6b, 0xf8, 0xfa, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x48, 0x57, 0x29, 0xb1, 0x0a, 0x00,
-	0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x9d, 0x1f, 0x92, 0x03, 0x09, 0x68, 0x84, 0xae, 0x7c, 0xad, 0x2b, 0x13, 0xe5, 0xaa,
+	0x52, 0x80, 0xca, 0x69, 0x40, 0xaa, 0x2a, 0xba, 0x6a, 0x48, 0x84, 0x28, 0xa1, 0x45, 0x56, 0x55,
+	0x36, 0x55, 0x91, 0x89, 0x07, 0x98, 0x12, 0x7b, 0xd2, 0xc9, 0x90, 0x96, 0x37, 0xe8, 0xb2, 0x4f,
+	0xd1, 0x6d, 0x5f, 0x83, 0xee, 0x58, 0x76, 0x85, 0xaa, 0x20, 0xf5, 0x39, 0x2a, 0xdb, 0x63, 0xc7,
+	0x41, 0xc2, 0x58, 0xaa, 0x61, 0x37, 0x33, 0xfe, 0xce, 0xf9, 0xbe, 0x39, 0x67, 0x72, 0x3e, 0x05,
+	0x6a, 0xec, 0xcc, 0xe1, 0xc4, 0xc6, 0x0d, 0xdc, 0xc7, 0x3d, 0x4e, 0xa8, 0xd3, 0x18, 0x35, 0xc3,
+	0xb5, 0x3e, 0x60, 0x94, 0x53, 0xa4, 0x9a, 0x9c, 0xda, 0xe4, 0xb3, 0x2e, 0xa0, 0x7a, 0xf8, 0x79,
+	0xd4, 0x54, 0x95, 0x20, 0x7e, 0xd4, 0x6c, 0x04, 0xdf, 0xbd, 0x28, 0xf5, 0xff, 0xb8, 0xcc, 0x43,
+	0x01, 0x5a, 0x3c, 0xa6, 0xc7, 0xd4, 0x5b, 0x36, 0xdc, 0x95, 0x7f, 0x5a, 0xfb, 0x2e, 0xc3, 0x5c,
+	0xc7, 0xe1, 0x98, 0x19, 0xf8, 0xe3, 0x19, 0x1e, 0x72, 0xb4, 0x01, 0x32, 0xb1, 0x14, 0xa9, 0x2a,
+	0xd5, 0x67, 0xd7, 0x34, 0xfd, 0x86, 0x9c, 0x51, 0x53, 0xdf, 0x63, 0xc4, 0x26, 0x9c, 0x8c, 0xf0,
+	0x76, 0xbb, 0x05, 0x17, 0x57, 0x4b, 0x99, 0xf1, 0xd5, 0x92, 0xbc, 0xdd, 0x36, 0x64, 0x62, 0xa1,
+	0xff, 0xa0, 0xd4, 0x33, 0x1d, 0x8b, 0x58, 0x26, 0xc7, 0x8a, 0x5c, 0x95, 0xea, 0x25, 0x63, 0x72,
+	0x80, 0x54, 0x28, 0x0e, 0x18, 0xa1, 0x8c, 0xf0, 0x73, 0x25, 0x5b, 0x95, 0xea, 0x79, 0x23, 0xdc,
+	0x23, 0x03, 0x8a, 0x36, 0xe6, 0xa6, 0x65, 0x72, 0x53, 0xc9, 0x55, 0xb3, 0xf5, 0xd9, 0xb5, 0xa7,
+	0xfa, 0xed, 0xa5, 0xd0, 0xa3, 0x8a, 0xf5, 0x5d, 0x11, 0xd8, 0x71, 0x38, 0x3b, 0x37, 0xc2, 0x3c,
+	0x48, 0x81, 0x99, 0x01, 0xc3, 0xd8, 0x1e, 0x70, 0x25, 0x5f, 0x95, 0xea, 0x45, 0x23, 0xd8, 0xaa,
+	0xcf, 0xa1, 0x3c, 0x15, 0x84, 0x16, 0x20, 0x7b, 0x8a, 0xcf, 0xbd, 0x5b, 0x97, 0x0c, 0x77, 0x89,
+	0x16, 0x21, 0x3f, 0x32, 0xfb, 0x67, 0xc1, 0x35, 0xfc, 0xcd, 0x86, 0xfc, 0x4c, 0xaa, 0xed, 0x40,
+	0x59, 0xd0, 0x0f, 0x07, 0xd4, 0x19, 0x62, 0xb4, 0x01, 0x39, 0x8e, 0x99, 0x2d, 0x6a, 0x56, 0x8d,
+	0xd3, 0xfd, 0x06, 0x33, 0xbb, 0x95, 0x73, 0xab, 0x66, 0x78, 0x31, 0xb5, 0x53, 0x98, 0xdf, 0x27,
+	0xfc, 0xc4, 0x62, 0xe6, 0xa7, 0x7b, 0x6f, 0x40, 0xed, 0x15, 0x2c, 0x4c, 0xc8, 0x52, 0x10, 0x4f,
+	0xa0, 0xfc, 0xc2, 0xa1, 0xc4, 0xe1, 0xf7, 0x2f, 0xbd, 0x0b, 0x95, 0x80, 0x2a, 0x05, 0xe1, 0x1f,
+	0xa0, 0xb2, 0xc7, 0xa8, 0x4d, 0x39, 0xbe, 0x7f, 0xe5, 0xbb, 0x30, 0x1f, 0x72, 0xa5, 0x53, 0xf3,
+	0x36, 0x7e, 0x18, 0xe5, 0x5d, 0xa8, 0xb4, 0x71, 0x6a, 0xc2, 0x4f, 0x60, 0xae, 0x33, 0x22, 0xbd,
+	0x07, 0x78, 0x2b, 0xee, 0x0f, 0xd4, 0x67, 0x4a, 0x41, 0x76, 0x17, 0x2a, 0x5b, 0x98, 0xbb, 0xc7,
+	0x29, 0x08, 0x77, 0x1f, 0x43, 0x98, 0x2d, 0x05, 0x71, 0x2f, 0x61, 0x6e, 0xdf, 0xe4, 0xbd, 0x93,
+	0x34, 0xa4, 0xed, 0x40, 0x59, 0xe4, 0x4a, 0x41, 0xd8, 0x37, 0x09, 0x72, 0xee, 0x21, 0x42, 0x91,
+	0x24, 0x39, 0xff, 0x23, 0xfa, 0x07, 0x0a, 0x7d, 0x6c, 0x5a, 0x98, 0x89, 0xd6, 0x89, 0x1d, 0xd2,
+	0x00, 0xc2, 0x26, 0x0e, 0x95, 0x6c, 0x35, 0x5b, 0x2f, 0x19, 0x91, 0x13, 0x64, 0x40, 0x25, 0xdc,
+	0x1d, 0x10, 0xe7, 0x88, 0x0a, 0xa7, 0x78, 0x14, 0x27, 0x6d, 0x33, 0x88, 0x10, 0xfa, 0xca, 0x61,
+	0x8a, 0x6d, 0xe7, 0x88, 0xd6, 0x7e, 0x48, 0x50, 0x0a, 0x21, 0xae, 0x5a, 0xc7, 0xb4, 0xb1, 0xf0,
+	0x01, 0x6f, 0x3d, 0xe5, 0x5a, 0xf2, 0x0d, 0xd7, 0x7a, 0x1d, 0x71, 0xad, 0xac, 0xa7, 0x65, 0x3d,
+	0x91, 0x96, 0xdb, 0x2c, 0xeb, 0xaf, 0x8c, 0x69, 0xed, 0xf7, 0x0c, 0x54, 0xba, 0x5e, 0x29, 0x3b,
+	0x82, 0x14, 0xbd, 0x83, 0xbc, 0xe7, 0x55, 0xa8, 0x9e, 0xd4, 0x4d, 0xd5, 0xe5, 0x04, 0x48, 0xf1,
+	0x42, 0x30, 0x14, 0x03, 0x3f, 0x41, 0xab, 0x71, 0x61, 0x37, 0x2c, 0x4e, 0x7d, 0x9c, 0x0c, 0x2c,
+	0x68, 0x0e, 0xa0, 0xe0, 0xcf, 0x7e, 0x14, 0xab, 0x6d, 0xca, 0x8a, 0xd4, 0x95, 0x24, 0x50, 0x41,
+	0x70, 0x08, 0x33, 0x62, 0x44, 0xa3, 0xd8, 0xb0, 0x69, 0xcf, 0x50, 0x57, 0x13, 0x61, 0x27, 0x97,
+	0xf0, 0x87, 0x69, 0xfc, 0x25, 0xa6, 0x66, 0xbb, 0xba, 0x92, 0x04, 0x2a, 0x08, 0xdc, 0x56, 0xbb,
+	0x53, 0xef, 0x8e, 0x56, 0x47, 0x46, 0xb0, 0xba, 0x9c, 0x00, 0x39, 0x29, 0x91, 0x18, 0x5c, 0xf1,
+	0x25, 0x9a, 0x9e, 0x95, 0xea, 0x6a, 0x22, 0xac, 0xe0, 0x78, 0x0f, 0x79, 0x6f, 0x02, 0xc5, 0xdf,
+	0x20, 0x3a, 0xf0, 0xd4, 0xe5, 0x04, 0x48, 0x3f, 0xfb, 0x13, 0x09, 0x59, 0x50, 0xd8, 0x64, 0xd8,
+	0xbc, 0xab, 0x05, 0x3e, 0x26, 0x51, 0x0b, 0x02, 0xa8, 0x4f, 0x51, 0xcb, 0x7e, 0x91, 0x25, 0x64,
+	0x42, 0x7e, 0xb3, 0x4f, 0x87, 0x38, 0xfe, 0x16, 0x1e, 0x24, 0xd1, 0x2d, 0x04, 0x32, 0x42, 0xd1,
+	0xda, 0xba, 0x18, 0x6b, 0xd2, 0xe5, 0x58, 0x93, 0x7e, 0x8d, 0x35, 0xe9, 0xeb, 0xb5, 0x96, 0xb9,
+	0xbc, 0xd6, 0x32, 0x3f, 0xaf, 0xb5, 0x0c, 0xfc, 0x4b, 0x68, 0x90, 0xcb, 0x1c, 0x90, 0x68, 0x9e,
+	0xd6, 0xc2, 0xf4, 0x68, 0x78, 0xdb, 0xdc, 0x93, 0x0e, 0x0b, 0xde, 0x7f, 0x80, 0xf5, 0x3f, 0x03,
+	0x00, 0x72, 0x62, 0x12, 0x99, 0x9a, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1385,6 +1496,40 @@ func (m *EnterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Preempt {
+		i--
+		if m.Preempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintElection(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintElection(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintElection(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Candidate) > 0 {
 		i -= len(m.Candidate)
 		copy(dAtA[i:], m.Candidate)
@@ -1955,6 +2100,20 @@ func (m *Term) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CandidateInfo) > 0 {
+		for iNdEx := len(m.CandidateInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandidateInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintElection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Candidates) > 0 {
 		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Candidates[iNdEx])
@@ -1979,6 +2138,60 @@ func (m *Term) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Candidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintElection(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintElection(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintElection(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintElection(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintElection(dAtA []byte, offset int, v uint64) int {
 	offset -= sovElection(v)
 	base := offset
@@ -2002,6 +2215,20 @@ func (m *EnterRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovElection(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovElection(uint64(m.Priority))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovElection(uint64(len(k))) + 1 + len(v) + sovElection(uint64(len(v)))
+			n += mapEntrySize + 1 + sovElection(uint64(mapEntrySize))
+		}
+	}
+	if m.Preempt {
+		n += 2
+	}
 	return n
 }
 
@@ -2209,6 +2436,36 @@ func (m *Term) Size() (n int) {
 			n += 1 + l + sovElection(uint64(l))
 		}
 	}
+	if len(m.CandidateInfo) > 0 {
+		for _, e := range m.CandidateInfo {
+			l = e.Size()
+			n += 1 + l + sovElection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Candidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovElection(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovElection(uint64(m.Priority))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovElection(uint64(len(k))) + 1 + len(v) + sovElection(uint64(len(v)))
+			n += mapEntrySize + 1 + sovElection(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2312,6 +2569,172 @@ func (m *EnterRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Candidate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowElection
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipElection(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthElection
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preempt = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipElection(dAtA[iNdEx:])
@@ -3850,6 +4273,268 @@ func (m *Term) Unmarshal(dAtA []byte) error {
 			}
 			m.Candidates = append(m.Candidates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandidateInfo = append(m.CandidateInfo, Candidate{})
+			if err := m.CandidateInfo[len(m.CandidateInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowElection
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipElection(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthElection
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipElection(dAtA[iNdEx:])
@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: runtime/idgenerator/v1/idgenerator.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/atomix/atomix/api/runtime/v1"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type NextIDRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *NextIDRequest) Reset()         { *m = NextIDRequest{} }
+func (m *NextIDRequest) String() string { return proto.CompactTextString(m) }
+func (*NextIDRequest) ProtoMessage()    {}
+func (*NextIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d366d7adfc8787d, []int{0}
+}
+func (m *NextIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextIDRequest.Merge(m, src)
+}
+func (m *NextIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextIDRequest proto.InternalMessageInfo
+
+func (m *NextIDRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+type NextIDResponse struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *NextIDResponse) Reset()         { *m = NextIDResponse{} }
+func (m *NextIDResponse) String() string { return proto.CompactTextString(m) }
+func (*NextIDResponse) ProtoMessage()    {}
+func (*NextIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d366d7adfc8787d, []int{1}
+}
+func (m *NextIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextIDResponse.Merge(m, src)
+}
+func (m *NextIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextIDResponse proto.InternalMessageInfo
+
+func (m *NextIDResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*NextIDRequest)(nil), "atomix.runtime.idgenerator.v1.NextIDRequest")
+	proto.RegisterType((*NextIDResponse)(nil), "atomix.runtime.idgenerator.v1.NextIDResponse")
+}
+
+func init() {
+	proto.RegisterFile("runtime/idgenerator/v1/idgenerator.proto", fileDescriptor_3d366d7adfc8787d)
+}
+
+var fileDescriptor_3d366d7adfc8787d = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4b, 0xfb, 0x30,
+	0x18, 0xc7, 0x9b, 0xfe, 0x7e, 0xf6, 0x90, 0x31, 0x0f, 0x41, 0x64, 0x0c, 0xcd, 0x64, 0xa7, 0x89,
+	0x33, 0xa5, 0xf3, 0xe6, 0xb1, 0x2b, 0x8c, 0x22, 0xc8, 0xd8, 0xc1, 0x7b, 0x65, 0x0f, 0x25, 0xe2,
+	0x96, 0x9a, 0x66, 0x65, 0x2f, 0xc1, 0xa3, 0x2f, 0x6b, 0xde, 0x76, 0xf4, 0x34, 0xa4, 0x7d, 0x23,
+	0xb2, 0xb6, 0xd1, 0xba, 0x83, 0xed, 0xed, 0x49, 0xf8, 0x7c, 0xff, 0x90, 0x3c, 0x78, 0x20, 0x57,
+	0x4b, 0xc5, 0x17, 0x60, 0xf3, 0x79, 0x08, 0x4b, 0x90, 0x81, 0x12, 0xd2, 0x4e, 0x9c, 0xea, 0x91,
+	0x45, 0x52, 0x28, 0x41, 0xce, 0x03, 0x25, 0x16, 0x7c, 0xcd, 0x4a, 0x01, 0xab, 0x12, 0x89, 0xd3,
+	0xed, 0x68, 0xa3, 0xc4, 0xb1, 0x35, 0x92, 0x0b, 0xbb, 0x97, 0xf5, 0x11, 0x71, 0x89, 0x9e, 0x84,
+	0x22, 0x14, 0xf9, 0x68, 0xef, 0xa7, 0xe2, 0xb6, 0x7f, 0x87, 0xdb, 0xf7, 0xb0, 0x56, 0xbe, 0x37,
+	0x83, 0x97, 0x15, 0xc4, 0x8a, 0xdc, 0x62, 0x93, 0xcf, 0x3b, 0xe8, 0x02, 0x0d, 0x5a, 0x23, 0xca,
+	0x0e, 0x7a, 0x25, 0x0e, 0x9b, 0x4a, 0xbe, 0xe0, 0x8a, 0x27, 0xe0, 0x7b, 0x2e, 0xde, 0xec, 0x7a,
+	0x46, 0xba, 0xeb, 0x99, 0xbe, 0x37, 0x33, 0xf9, 0xbc, 0x3f, 0xc0, 0xc7, 0xda, 0x2c, 0x8e, 0xc4,
+	0x32, 0x06, 0x72, 0xfa, 0xed, 0xf6, 0xdf, 0xb5, 0x7e, 0xc8, 0xd1, 0xbb, 0x89, 0x5b, 0xbe, 0x37,
+	0xd1, 0x1d, 0x09, 0x60, 0xab, 0x50, 0x92, 0x21, 0xfb, 0xf3, 0x2d, 0xd8, 0xaf, 0xb6, 0xdd, 0xeb,
+	0x86, 0x74, 0x59, 0xe7, 0x09, 0x5b, 0x63, 0x09, 0x81, 0x82, 0xda, 0x98, 0x02, 0x6b, 0x1a, 0xa3,
+	0xe9, 0x22, 0xa6, 0xff, 0xef, 0xd5, 0x44, 0x24, 0xc4, 0x47, 0xe3, 0x67, 0x11, 0x03, 0xb9, 0xaa,
+	0x13, 0xef, 0x29, 0x9d, 0x34, 0x6c, 0x06, 0x57, 0x82, 0xdc, 0xc9, 0x26, 0xa5, 0x68, 0x9b, 0x52,
+	0xf4, 0x99, 0x52, 0xf4, 0x96, 0x51, 0x63, 0x9b, 0x51, 0xe3, 0x23, 0xa3, 0x06, 0x3e, 0xe3, 0x42,
+	0xdb, 0x05, 0x11, 0x3f, 0xb0, 0x72, 0xdb, 0x95, 0x0f, 0x78, 0x70, 0xa6, 0xe8, 0xd1, 0xca, 0x57,
+	0xe2, 0xe6, 0x6b, 0x00, 0xb6, 0x24, 0xc9, 0x1b, 0xb8, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IDGeneratorClient is the client API for IDGenerator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IDGeneratorClient interface {
+	// NextID gets the next unique ID
+	NextID(ctx context.Context, in *NextIDRequest, opts ...grpc.CallOption) (*NextIDResponse, error)
+	// Create creates the IDGenerator
+	// Deprecated: use the IDGenerators service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the IDGenerator
+	// Deprecated: use the IDGenerators service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+}
+
+type iDGeneratorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIDGeneratorClient(cc *grpc.ClientConn) IDGeneratorClient {
+	return &iDGeneratorClient{cc}
+}
+
+func (c *iDGeneratorClient) NextID(ctx context.Context, in *NextIDRequest, opts ...grpc.CallOption) (*NextIDResponse, error) {
+	out := new(NextIDResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.idgenerator.v1.IDGenerator/NextID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *iDGeneratorClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.idgenerator.v1.IDGenerator/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *iDGeneratorClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.idgenerator.v1.IDGenerator/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IDGeneratorServer is the server API for IDGenerator service.
+type IDGeneratorServer interface {
+	// NextID gets the next unique ID
+	NextID(context.Context, *NextIDRequest) (*NextIDResponse, error)
+	// Create creates the IDGenerator
+	// Deprecated: use the IDGenerators service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the IDGenerator
+	// Deprecated: use the IDGenerators service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
+}
+
+// UnimplementedIDGeneratorServer can be embedded to have forward compatible implementations.
+type UnimplementedIDGeneratorServer struct {
+}
+
+func (*UnimplementedIDGeneratorServer) NextID(ctx context.Context, req *NextIDRequest) (*NextIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextID not implemented")
+}
+func (*UnimplementedIDGeneratorServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedIDGeneratorServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+
+func RegisterIDGeneratorServer(s *grpc.Server, srv IDGeneratorServer) {
+	s.RegisterService(&_IDGenerator_serviceDesc, srv)
+}
+
+func _IDGenerator_NextID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDGeneratorServer).NextID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.idgenerator.v1.IDGenerator/NextID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDGeneratorServer).NextID(ctx, req.(*NextIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IDGenerator_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDGeneratorServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.idgenerator.v1.IDGenerator/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDGeneratorServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IDGenerator_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDGeneratorServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.idgenerator.v1.IDGenerator/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDGeneratorServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _IDGenerator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.idgenerator.v1.IDGenerator",
+	HandlerType: (*IDGeneratorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NextID",
+			Handler:    _IDGenerator_NextID_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _IDGenerator_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _IDGenerator_Close_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "runtime/idgenerator/v1/idgenerator.proto",
+}
+
+func (m *NextIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIdgenerator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NextIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintIdgenerator(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIdgenerator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIdgenerator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NextIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovIdgenerator(uint64(l))
+	return n
+}
+
+func (m *NextIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovIdgenerator(uint64(m.ID))
+	}
+	return n
+}
+
+func sovIdgenerator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIdgenerator(x uint64) (n int) {
+	return sovIdgenerator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NextIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIdgenerator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIdgenerator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIdgenerator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIdgenerator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIdgenerator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIdgenerator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIdgenerator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1413 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: runtime/latch/v1/latch.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/atomix/atomix/api/runtime/v1"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CountDownRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *CountDownRequest) Reset()         { *m = CountDownRequest{} }
+func (m *CountDownRequest) String() string { return proto.CompactTextString(m) }
+func (*CountDownRequest) ProtoMessage()    {}
+func (*CountDownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79e21188e4a3270, []int{0}
+}
+func (m *CountDownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountDownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountDownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CountDownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountDownRequest.Merge(m, src)
+}
+func (m *CountDownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountDownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountDownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountDownRequest proto.InternalMessageInfo
+
+func (m *CountDownRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+type CountDownResponse struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *CountDownResponse) Reset()         { *m = CountDownResponse{} }
+func (m *CountDownResponse) String() string { return proto.CompactTextString(m) }
+func (*CountDownResponse) ProtoMessage()    {}
+func (*CountDownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79e21188e4a3270, []int{1}
+}
+func (m *CountDownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountDownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountDownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CountDownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountDownResponse.Merge(m, src)
+}
+func (m *CountDownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountDownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountDownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountDownResponse proto.InternalMessageInfo
+
+func (m *CountDownResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type AwaitRequest struct {
+	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Timeout *time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+}
+
+func (m *AwaitRequest) Reset()         { *m = AwaitRequest{} }
+func (m *AwaitRequest) String() string { return proto.CompactTextString(m) }
+func (*AwaitRequest) ProtoMessage()    {}
+func (*AwaitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79e21188e4a3270, []int{2}
+}
+func (m *AwaitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AwaitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AwaitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AwaitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AwaitRequest.Merge(m, src)
+}
+func (m *AwaitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AwaitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AwaitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AwaitRequest proto.InternalMessageInfo
+
+func (m *AwaitRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *AwaitRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+type AwaitResponse struct {
+}
+
+func (m *AwaitResponse) Reset()         { *m = AwaitResponse{} }
+func (m *AwaitResponse) String() string { return proto.CompactTextString(m) }
+func (*AwaitResponse) ProtoMessage()    {}
+func (*AwaitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79e21188e4a3270, []int{3}
+}
+func (m *AwaitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AwaitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AwaitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AwaitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AwaitResponse.Merge(m, src)
+}
+func (m *AwaitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AwaitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AwaitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AwaitResponse proto.InternalMessageInfo
+
+type GetCountRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *GetCountRequest) Reset()         { *m = GetCountRequest{} }
+func (m *GetCountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCountRequest) ProtoMessage()    {}
+func (*GetCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79e21188e4a3270, []int{4}
+}
+func (m *GetCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCountRequest.Merge(m, src)
+}
+func (m *GetCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCountRequest proto.InternalMessageInfo
+
+func (m *GetCountRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+type GetCountResponse struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GetCountResponse) Reset()         { *m = GetCountResponse{} }
+func (m *GetCountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCountResponse) ProtoMessage()    {}
+func (*GetCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79e21188e4a3270, []int{5}
+}
+func (m *GetCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCountResponse.Merge(m, src)
+}
+func (m *GetCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCountResponse proto.InternalMessageInfo
+
+func (m *GetCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CountDownRequest)(nil), "atomix.runtime.latch.v1.CountDownRequest")
+	proto.RegisterType((*CountDownResponse)(nil), "atomix.runtime.latch.v1.CountDownResponse")
+	proto.RegisterType((*AwaitRequest)(nil), "atomix.runtime.latch.v1.AwaitRequest")
+	proto.RegisterType((*AwaitResponse)(nil), "atomix.runtime.latch.v1.AwaitResponse")
+	proto.RegisterType((*GetCountRequest)(nil), "atomix.runtime.latch.v1.GetCountRequest")
+	proto.RegisterType((*GetCountResponse)(nil), "atomix.runtime.latch.v1.GetCountResponse")
+}
+
+func init() { proto.RegisterFile("runtime/latch/v1/latch.proto", fileDescriptor_c79e21188e4a3270) }
+
+var fileDescriptor_c79e21188e4a3270 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xba, 0x75, 0x0b, 0x03, 0xa8, 0x65, 0x55, 0x41, 0xb0, 0xd0, 0x06, 0x59, 0x02, 0x12,
+	0x0e, 0x6b, 0xb9, 0x9c, 0x80, 0x13, 0x8e, 0x25, 0x54, 0x09, 0x50, 0xe5, 0x43, 0x2f, 0x48, 0x20,
+	0xa7, 0x59, 0xcc, 0x4a, 0x89, 0x37, 0xd8, 0x6b, 0x97, 0x1f, 0x40, 0xe2, 0xc8, 0x91, 0x4f, 0xea,
+	0xb1, 0x47, 0x4e, 0x05, 0x9c, 0x1f, 0x41, 0xde, 0x5d, 0x97, 0x28, 0xc2, 0x4d, 0x0f, 0xb9, 0xcd,
+	0xee, 0xbc, 0x79, 0xf3, 0xe6, 0xcd, 0xc0, 0xfd, 0xac, 0x48, 0x25, 0x9f, 0x32, 0x6f, 0x12, 0xcb,
+	0xe3, 0x4f, 0x5e, 0xe9, 0xeb, 0x80, 0xce, 0x32, 0x21, 0x05, 0xbe, 0x1b, 0x4b, 0x31, 0xe5, 0x5f,
+	0xa8, 0x01, 0x51, 0x9d, 0x2b, 0x7d, 0x87, 0x24, 0x42, 0x24, 0x13, 0xe6, 0x29, 0xd8, 0xa8, 0xf8,
+	0xe8, 0x8d, 0x8b, 0x2c, 0x96, 0x5c, 0xa4, 0xba, 0xd0, 0xe9, 0x36, 0xb4, 0xa5, 0xef, 0x35, 0xc5,
+	0x3a, 0x43, 0xfe, 0xdf, 0x90, 0xe5, 0x26, 0xbf, 0x97, 0x88, 0x44, 0xa8, 0xd0, 0xab, 0x23, 0xfd,
+	0xeb, 0xbe, 0x85, 0xdd, 0xa1, 0x28, 0x52, 0x19, 0x8a, 0x93, 0x34, 0x62, 0x9f, 0x0b, 0x96, 0x4b,
+	0xfc, 0x1c, 0x2c, 0x3e, 0xee, 0xa2, 0x07, 0xa8, 0x7f, 0x63, 0x9f, 0xd0, 0x25, 0xa5, 0xa5, 0x4f,
+	0x0f, 0x33, 0x3e, 0xe5, 0x92, 0x97, 0xec, 0x20, 0x0c, 0xe0, 0xf4, 0xbc, 0xd7, 0xa9, 0xce, 0x7b,
+	0xd6, 0x41, 0x18, 0x59, 0x7c, 0xec, 0x0e, 0xe0, 0xf6, 0x02, 0x5f, 0x3e, 0x13, 0x69, 0xce, 0xf0,
+	0x1e, 0xd8, 0xc7, 0xf5, 0xa7, 0xe2, 0xdc, 0x8c, 0xf4, 0xc3, 0xfd, 0x8a, 0xe0, 0xe6, 0xcb, 0x93,
+	0x98, 0xcb, 0x35, 0xf4, 0xc5, 0xcf, 0x60, 0xbb, 0x86, 0x89, 0x42, 0x76, 0x2d, 0x45, 0x70, 0x8f,
+	0x6a, 0x27, 0x69, 0xe3, 0x24, 0x0d, 0x8d, 0x93, 0xc1, 0xe6, 0x8f, 0x5f, 0x3d, 0x14, 0x35, 0x78,
+	0x77, 0x07, 0x6e, 0x19, 0x19, 0x5a, 0xae, 0xfb, 0x06, 0x76, 0x5e, 0x31, 0xa9, 0xc6, 0x58, 0x87,
+	0x25, 0x7d, 0xd8, 0xfd, 0x47, 0x77, 0x99, 0x23, 0xfb, 0x7f, 0x36, 0xc0, 0x7e, 0x5d, 0x2f, 0x0d,
+	0x8f, 0xe0, 0xfa, 0x85, 0x8d, 0x78, 0x40, 0x5b, 0xae, 0x85, 0x2e, 0xaf, 0xce, 0x79, 0x72, 0x15,
+	0xa8, 0xd1, 0x70, 0x04, 0xb6, 0x9a, 0x1b, 0x3f, 0x6c, 0x2d, 0x5a, 0x5c, 0x8f, 0xf3, 0x68, 0x15,
+	0xcc, 0xf0, 0x7e, 0x80, 0x6b, 0xcd, 0xbc, 0xb8, 0xdf, 0x5a, 0xb3, 0xe4, 0xb0, 0x33, 0xb8, 0x02,
+	0xd2, 0x34, 0x78, 0x0f, 0x5b, 0xc3, 0x8c, 0xc5, 0x92, 0xe1, 0x76, 0x49, 0x1a, 0xd0, 0x90, 0x3f,
+	0x5e, 0x89, 0x33, 0xab, 0xdf, 0xf8, 0x66, 0x21, 0xfc, 0x0e, 0xec, 0xe1, 0x44, 0xe4, 0xec, 0x12,
+	0x63, 0x54, 0x7e, 0xb5, 0x31, 0x06, 0xb6, 0x40, 0x1e, 0xbc, 0x38, 0xad, 0x08, 0x3a, 0xab, 0x08,
+	0xfa, 0x5d, 0x11, 0xf4, 0x7d, 0x4e, 0x3a, 0x67, 0x73, 0xd2, 0xf9, 0x39, 0x27, 0x1d, 0xb8, 0xc3,
+	0x45, 0x43, 0x14, 0xcf, 0xf8, 0x05, 0x49, 0xb0, 0xad, 0x4e, 0xe2, 0xc8, 0x3f, 0x44, 0xa3, 0x2d,
+	0x75, 0xcc, 0x4f, 0xff, 0x0e, 0x00, 0x31, 0xb7, 0x1f, 0xdb, 0x5d, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LatchClient is the client API for Latch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LatchClient interface {
+	// CountDown decrements the latch count
+	CountDown(ctx context.Context, in *CountDownRequest, opts ...grpc.CallOption) (*CountDownResponse, error)
+	// Await waits for the latch count to reach zero
+	Await(ctx context.Context, in *AwaitRequest, opts ...grpc.CallOption) (*AwaitResponse, error)
+	// GetCount gets the current latch count
+	GetCount(ctx context.Context, in *GetCountRequest, opts ...grpc.CallOption) (*GetCountResponse, error)
+	// Create creates the Latch
+	// Deprecated: use the Latches service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the Latch
+	// Deprecated: use the Latches service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+}
+
+type latchClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLatchClient(cc *grpc.ClientConn) LatchClient {
+	return &latchClient{cc}
+}
+
+func (c *latchClient) CountDown(ctx context.Context, in *CountDownRequest, opts ...grpc.CallOption) (*CountDownResponse, error) {
+	out := new(CountDownResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.latch.v1.Latch/CountDown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *latchClient) Await(ctx context.Context, in *AwaitRequest, opts ...grpc.CallOption) (*AwaitResponse, error) {
+	out := new(AwaitResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.latch.v1.Latch/Await", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *latchClient) GetCount(ctx context.Context, in *GetCountRequest, opts ...grpc.CallOption) (*GetCountResponse, error) {
+	out := new(GetCountResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.latch.v1.Latch/GetCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *latchClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.latch.v1.Latch/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *latchClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.latch.v1.Latch/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LatchServer is the server API for Latch service.
+type LatchServer interface {
+	// CountDown decrements the latch count
+	CountDown(context.Context, *CountDownRequest) (*CountDownResponse, error)
+	// Await waits for the latch count to reach zero
+	Await(context.Context, *AwaitRequest) (*AwaitResponse, error)
+	// GetCount gets the current latch count
+	GetCount(context.Context, *GetCountRequest) (*GetCountResponse, error)
+	// Create creates the Latch
+	// Deprecated: use the Latches service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the Latch
+	// Deprecated: use the Latches service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
+}
+
+// UnimplementedLatchServer can be embedded to have forward compatible implementations.
+type UnimplementedLatchServer struct {
+}
+
+func (*UnimplementedLatchServer) CountDown(ctx context.Context, req *CountDownRequest) (*CountDownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountDown not implemented")
+}
+func (*UnimplementedLatchServer) Await(ctx context.Context, req *AwaitRequest) (*AwaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Await not implemented")
+}
+func (*UnimplementedLatchServer) GetCount(ctx context.Context, req *GetCountRequest) (*GetCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCount not implemented")
+}
+func (*UnimplementedLatchServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedLatchServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+
+func RegisterLatchServer(s *grpc.Server, srv LatchServer) {
+	s.RegisterService(&_Latch_serviceDesc, srv)
+}
+
+func _Latch_CountDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountDownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LatchServer).CountDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.latch.v1.Latch/CountDown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LatchServer).CountDown(ctx, req.(*CountDownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Latch_Await_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AwaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LatchServer).Await(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.latch.v1.Latch/Await",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LatchServer).Await(ctx, req.(*AwaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Latch_GetCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LatchServer).GetCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.latch.v1.Latch/GetCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LatchServer).GetCount(ctx, req.(*GetCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Latch_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LatchServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.latch.v1.Latch/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LatchServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Latch_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LatchServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.latch.v1.Latch/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LatchServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Latch_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.latch.v1.Latch",
+	HandlerType: (*LatchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CountDown",
+			Handler:    _Latch_CountDown_Handler,
+		},
+		{
+			MethodName: "Await",
+			Handler:    _Latch_Await_Handler,
+		},
+		{
+			MethodName: "GetCount",
+			Handler:    _Latch_GetCount_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Latch_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _Latch_Close_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "runtime/latch/v1/latch.proto",
+}
+
+func (m *CountDownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountDownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountDownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CountDownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountDownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountDownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintLatch(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AwaitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AwaitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AwaitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintLatch(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AwaitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AwaitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AwaitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintLatch(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CountDownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovLatch(uint64(l))
+	return n
+}
+
+func (m *CountDownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovLatch(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *AwaitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovLatch(uint64(l))
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovLatch(uint64(l))
+	}
+	return n
+}
+
+func (m *AwaitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovLatch(uint64(l))
+	return n
+}
+
+func (m *GetCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovLatch(uint64(m.Count))
+	}
+	return n
+}
+
+func sovLatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLatch(x uint64) (n int) {
+	return sovLatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CountDownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountDownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountDownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CountDownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountDownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountDownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AwaitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwaitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwaitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AwaitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwaitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwaitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLatch = fmt.Errorf("proto: unexpected end of group")
+)
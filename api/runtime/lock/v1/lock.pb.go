@@ -35,6 +35,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type LockRequest struct {
 	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Timeout *time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// label is a user-defined label identifying the holder, returned by GetLock
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// reentrant allows a holder that already holds the lock to acquire it again
+	// The lock is released once it has been unlocked as many times as it was acquired
+	Reentrant bool `protobuf:"varint,4,opt,name=reentrant,proto3" json:"reentrant,omitempty"`
 }
 
 func (m *LockRequest) Reset()         { *m = LockRequest{} }
@@ -84,8 +89,24 @@ func (m *LockRequest) GetTimeout() *time.Duration {
 	return nil
 }
 
+func (m *LockRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *LockRequest) GetReentrant() bool {
+	if m != nil {
+		return m.Reentrant
+	}
+	return false
+}
+
 type LockResponse struct {
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// holds is the number of times the holder has acquired the lock
+	Holds uint32 `protobuf:"varint,2,opt,name=holds,proto3" json:"holds,omitempty"`
 }
 
 func (m *LockResponse) Reset()         { *m = LockResponse{} }
@@ -128,6 +149,13 @@ func (m *LockResponse) GetVersion() uint64 {
 	return 0
 }
 
+func (m *LockResponse) GetHolds() uint32 {
+	if m != nil {
+		return m.Holds
+	}
+	return 0
+}
+
 type UnlockRequest struct {
 	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 }
@@ -254,6 +282,14 @@ func (m *GetLockRequest) GetID() v1.PrimitiveID {
 
 type GetLockResponse struct {
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// holder is the current holder of the lock
+	Holder GetLockResponse_Holder `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder"`
+	// acquired is the time at which the lock was acquired by the holder, if known
+	Acquired *time.Time `protobuf:"bytes,3,opt,name=acquired,proto3,stdtime" json:"acquired,omitempty"`
+	// holds is the number of times the holder has acquired the lock
+	Holds uint32 `protobuf:"varint,4,opt,name=holds,proto3" json:"holds,omitempty"`
+	// waiters is the number of clients waiting to acquire the lock
+	Waiters uint32 `protobuf:"varint,5,opt,name=waiters,proto3" json:"waiters,omitempty"`
 }
 
 func (m *GetLockResponse) Reset()         { *m = GetLockResponse{} }
@@ -296,6 +332,88 @@ func (m *GetLockResponse) GetVersion() uint64 {
 	return 0
 }
 
+func (m *GetLockResponse) GetHolder() GetLockResponse_Holder {
+	if m != nil {
+		return m.Holder
+	}
+	return GetLockResponse_Holder{}
+}
+
+func (m *GetLockResponse) GetAcquired() *time.Time {
+	if m != nil {
+		return m.Acquired
+	}
+	return nil
+}
+
+func (m *GetLockResponse) GetHolds() uint32 {
+	if m != nil {
+		return m.Holds
+	}
+	return 0
+}
+
+func (m *GetLockResponse) GetWaiters() uint32 {
+	if m != nil {
+		return m.Waiters
+	}
+	return 0
+}
+
+type GetLockResponse_Holder struct {
+	// id is the identifier of the client session holding the lock
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// label is the label provided by the holder when acquiring the lock
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *GetLockResponse_Holder) Reset()         { *m = GetLockResponse_Holder{} }
+func (m *GetLockResponse_Holder) String() string { return proto.CompactTextString(m) }
+func (*GetLockResponse_Holder) ProtoMessage()    {}
+func (*GetLockResponse_Holder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab41f7fc42eb9a7, []int{5, 0}
+}
+func (m *GetLockResponse_Holder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLockResponse_Holder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLockResponse_Holder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLockResponse_Holder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLockResponse_Holder.Merge(m, src)
+}
+func (m *GetLockResponse_Holder) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLockResponse_Holder) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLockResponse_Holder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLockResponse_Holder proto.InternalMessageInfo
+
+func (m *GetLockResponse_Holder) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *GetLockResponse_Holder) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LockRequest)(nil), "atomix.runtime.lock.v1.LockRequest")
 	proto.RegisterType((*LockResponse)(nil), "atomix.runtime.lock.v1.LockResponse")
@@ -303,40 +421,50 @@ func init() {
 	proto.RegisterType((*UnlockResponse)(nil), "atomix.runtime.lock.v1.UnlockResponse")
 	proto.RegisterType((*GetLockRequest)(nil), "atomix.runtime.lock.v1.GetLockRequest")
 	proto.RegisterType((*GetLockResponse)(nil), "atomix.runtime.lock.v1.GetLockResponse")
+	proto.RegisterType((*GetLockResponse_Holder)(nil), "atomix.runtime.lock.v1.GetLockResponse.Holder")
 }
 
 func init() { proto.RegisterFile("runtime/lock/v1/lock.proto", fileDescriptor_5ab41f7fc42eb9a7) }
 
 var fileDescriptor_5ab41f7fc42eb9a7 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x77, 0xd2, 0x35, 0x0b, 0x6f, 0xb5, 0xca, 0xa0, 0x12, 0x23, 0xcc, 0x4a, 0xb4, 0x5a,
-	0x10, 0x26, 0xa4, 0x9e, 0xea, 0x31, 0x5d, 0x90, 0x62, 0x0f, 0x35, 0xa0, 0xa2, 0x78, 0x49, 0xbb,
-	0x63, 0x18, 0x9a, 0xcd, 0xac, 0xc9, 0x24, 0xf8, 0x01, 0x3c, 0x78, 0xf4, 0xe8, 0x37, 0xb2, 0xc7,
-	0x1e, 0x3d, 0x55, 0xc9, 0x7e, 0x11, 0xc9, 0xfc, 0x91, 0x56, 0x1a, 0x73, 0xe9, 0x69, 0x66, 0xf7,
-	0xfd, 0xbd, 0xcf, 0xfb, 0x3c, 0x6f, 0x12, 0xf0, 0xcb, 0xba, 0x90, 0x7c, 0xc1, 0xc2, 0x5c, 0x1c,
-	0x1d, 0x87, 0x4d, 0xa4, 0x4e, 0xba, 0x2c, 0x85, 0x14, 0xf8, 0x6e, 0x2a, 0xc5, 0x82, 0x7f, 0xa6,
-	0x06, 0xa1, 0xaa, 0xd4, 0x44, 0x3e, 0xc9, 0x84, 0xc8, 0x72, 0x16, 0x2a, 0xea, 0xb0, 0xfe, 0x18,
-	0xce, 0xeb, 0x32, 0x95, 0x5c, 0x14, 0xba, 0xcf, 0xf7, 0xac, 0x66, 0x13, 0x85, 0xb6, 0x57, 0x57,
-	0xee, 0x5f, 0x36, 0xad, 0x32, 0xc5, 0xdb, 0x99, 0xc8, 0x84, 0xba, 0x86, 0xdd, 0x4d, 0xff, 0x1b,
-	0x7c, 0x41, 0xb0, 0xbe, 0x2f, 0x8e, 0x8e, 0x13, 0xf6, 0xa9, 0x66, 0x95, 0xc4, 0xcf, 0xc1, 0xe1,
-	0x73, 0x0f, 0x3d, 0x40, 0x5b, 0xeb, 0xdb, 0x84, 0xfe, 0xe3, 0xb0, 0x89, 0xe8, 0x41, 0xc9, 0x17,
-	0x5c, 0xf2, 0x86, 0xed, 0xcd, 0x62, 0x38, 0x39, 0x9b, 0x8e, 0xda, 0xb3, 0xa9, 0xb3, 0x37, 0x4b,
-	0x1c, 0x3e, 0xc7, 0x3b, 0x30, 0xe9, 0x30, 0x51, 0x4b, 0xcf, 0x51, 0x02, 0xf7, 0xa8, 0x8e, 0x42,
-	0x6d, 0x14, 0x3a, 0x33, 0x51, 0xe2, 0xf1, 0xf7, 0x5f, 0x53, 0x94, 0x58, 0x3e, 0xd8, 0x82, 0xeb,
-	0xda, 0x45, 0xb5, 0x14, 0x45, 0xc5, 0xb0, 0x07, 0x93, 0x86, 0x95, 0x15, 0x17, 0x85, 0xf2, 0x32,
-	0x4e, 0xec, 0xcf, 0xe0, 0x25, 0xdc, 0x78, 0x5d, 0xe4, 0x57, 0xe3, 0x38, 0xb8, 0x05, 0x1b, 0x56,
-	0x4c, 0x0f, 0x0e, 0xf6, 0x61, 0xe3, 0x05, 0x93, 0x57, 0xb4, 0x91, 0xe0, 0x29, 0xdc, 0xfc, 0xab,
-	0x36, 0x94, 0x6c, 0xfb, 0xc7, 0x1a, 0x8c, 0x3b, 0x14, 0xbf, 0x32, 0xe7, 0x43, 0x7a, 0xf9, 0x1b,
-	0x42, 0xcf, 0xd9, 0xf3, 0x1f, 0xfd, 0x1f, 0x32, 0x53, 0xdf, 0x82, 0xab, 0x83, 0xe2, 0xcd, 0x3e,
-	0xfe, 0xc2, 0x56, 0xfd, 0xc7, 0x43, 0x98, 0x11, 0x7e, 0x0f, 0x13, 0x93, 0x10, 0xf7, 0xb6, 0x5c,
-	0x5c, 0xa8, 0xff, 0x64, 0x90, 0x33, 0xda, 0x1f, 0xc0, 0xdd, 0x2d, 0x59, 0x2a, 0x59, 0xbf, 0x69,
-	0x5d, 0x1f, 0x34, 0x6d, 0x31, 0xf3, 0x90, 0xd7, 0xbe, 0x3a, 0x08, 0xbf, 0x83, 0x6b, 0xbb, 0xb9,
-	0xa8, 0x18, 0xee, 0xdd, 0xa0, 0x2a, 0x5b, 0xed, 0xcd, 0x01, 0xea, 0x9c, 0x74, 0xbc, 0x73, 0xd2,
-	0x12, 0x74, 0xda, 0x12, 0xf4, 0xbb, 0x25, 0xe8, 0xdb, 0x8a, 0x8c, 0x4e, 0x57, 0x64, 0xf4, 0x73,
-	0x45, 0x46, 0x70, 0x87, 0x0b, 0xab, 0x93, 0x2e, 0xb9, 0xd5, 0x88, 0xdd, 0x2e, 0xf7, 0x9b, 0xe8,
-	0x00, 0x1d, 0xba, 0xea, 0x53, 0x79, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x05, 0xb1, 0xba, 0x02,
-	0x39, 0x04, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x5d, 0xc7, 0x69, 0x26, 0x5f, 0xfb, 0xa1, 0x51, 0xa9, 0x8c, 0x41, 0x4e, 0x64, 0x68,
+	0xc9, 0x6a, 0xac, 0x04, 0x09, 0xa9, 0x08, 0xb1, 0x70, 0x23, 0x41, 0x45, 0x16, 0xc5, 0xe2, 0x47,
+	0x20, 0x36, 0x4e, 0x32, 0x84, 0x51, 0x1d, 0x4f, 0x6a, 0x8f, 0x0d, 0x8f, 0xc0, 0xb2, 0x4b, 0x76,
+	0xbc, 0x06, 0x6f, 0x40, 0x97, 0x5d, 0xb2, 0x2a, 0x28, 0x79, 0x11, 0xe4, 0xf9, 0x49, 0x43, 0x68,
+	0x08, 0x8b, 0xae, 0xec, 0x99, 0x7b, 0xee, 0x99, 0x73, 0xee, 0xb9, 0xc0, 0x4e, 0xb2, 0x98, 0x91,
+	0x11, 0xf6, 0x22, 0xda, 0x3f, 0xf2, 0xf2, 0x16, 0xff, 0xa2, 0x71, 0x42, 0x19, 0x85, 0xdb, 0x21,
+	0xa3, 0x23, 0xf2, 0x11, 0x49, 0x08, 0xe2, 0xa5, 0xbc, 0x65, 0x3b, 0x43, 0x4a, 0x87, 0x11, 0xf6,
+	0x38, 0xaa, 0x97, 0xbd, 0xf3, 0x06, 0x59, 0x12, 0x32, 0x42, 0x63, 0xd1, 0x67, 0xd7, 0x17, 0xeb,
+	0x45, 0x77, 0xca, 0xc2, 0xd1, 0x58, 0x02, 0x2c, 0xf5, 0x68, 0xde, 0xf2, 0x14, 0xb9, 0xa8, 0xdc,
+	0xbc, 0x4c, 0x4e, 0x2a, 0x8b, 0x5b, 0x43, 0x3a, 0xa4, 0xfc, 0xd7, 0x2b, 0xfe, 0xc4, 0xad, 0xfb,
+	0x55, 0x03, 0xb5, 0x2e, 0xed, 0x1f, 0x05, 0xf8, 0x38, 0xc3, 0x29, 0x83, 0x0f, 0x80, 0x4e, 0x06,
+	0x96, 0xd6, 0xd0, 0x9a, 0xb5, 0xb6, 0x83, 0x16, 0x2c, 0xe4, 0x2d, 0x74, 0x98, 0x90, 0x11, 0x61,
+	0x24, 0xc7, 0x07, 0x1d, 0x1f, 0x9c, 0x9e, 0xd7, 0x4b, 0x93, 0xf3, 0xba, 0x7e, 0xd0, 0x09, 0x74,
+	0x32, 0x80, 0x7b, 0xa0, 0x52, 0xc0, 0x68, 0xc6, 0x2c, 0x9d, 0x13, 0xdc, 0x40, 0xc2, 0x0b, 0x52,
+	0x5e, 0x50, 0x47, 0x7a, 0xf5, 0x8d, 0xcf, 0x3f, 0xea, 0x5a, 0xa0, 0xf0, 0x70, 0x0b, 0x94, 0xa3,
+	0xb0, 0x87, 0x23, 0x6b, 0xad, 0xa1, 0x35, 0xab, 0x81, 0x38, 0xc0, 0x5b, 0xa0, 0x9a, 0x60, 0x1c,
+	0xb3, 0x24, 0x8c, 0x99, 0x65, 0x34, 0xb4, 0xe6, 0x7a, 0x70, 0x71, 0xe1, 0x3e, 0x02, 0xff, 0x09,
+	0xe5, 0xe9, 0x98, 0xc6, 0x29, 0x86, 0x16, 0xa8, 0xe4, 0x38, 0x49, 0x09, 0x8d, 0xb9, 0x7e, 0x23,
+	0x50, 0xc7, 0x82, 0xfd, 0x3d, 0x8d, 0x06, 0x29, 0x97, 0xb5, 0x11, 0x88, 0x83, 0xfb, 0x14, 0x6c,
+	0xbc, 0x88, 0xa3, 0xab, 0xf1, 0xee, 0x5e, 0x03, 0x9b, 0x8a, 0x4c, 0xc8, 0x71, 0xbb, 0x60, 0xf3,
+	0x31, 0x66, 0x57, 0x34, 0x5b, 0xf7, 0x8b, 0x0e, 0xfe, 0x9f, 0xd1, 0xad, 0x34, 0xdc, 0x05, 0x66,
+	0xe1, 0x11, 0x27, 0x32, 0x08, 0x84, 0x2e, 0x5f, 0x46, 0xb4, 0x40, 0x89, 0x9e, 0xf0, 0x2e, 0xdf,
+	0x28, 0x5e, 0x0f, 0x24, 0x07, 0x7c, 0x08, 0xd6, 0xc3, 0xfe, 0x71, 0x46, 0x12, 0x3c, 0xe0, 0xf9,
+	0xd4, 0xda, 0xf6, 0x1f, 0xc1, 0x3e, 0x57, 0x4b, 0xea, 0x1b, 0x27, 0x45, 0xb2, 0xb3, 0x8e, 0x8b,
+	0xe1, 0x1b, 0x73, 0xc3, 0x2f, 0xb4, 0x7f, 0x08, 0x09, 0xc3, 0x49, 0x6a, 0x95, 0xf9, 0xbd, 0x3a,
+	0xda, 0xf7, 0x81, 0x29, 0x54, 0xc0, 0xed, 0xd9, 0xbc, 0xaa, 0xbe, 0x39, 0xb7, 0x67, 0xb3, 0x65,
+	0xd1, 0xe7, 0x96, 0xa5, 0xfd, 0x6d, 0x0d, 0x18, 0x85, 0x17, 0xf8, 0x4c, 0x7e, 0x6f, 0x2f, 0x33,
+	0x3d, 0x97, 0x89, 0x7d, 0xe7, 0xef, 0x20, 0x39, 0xe9, 0x57, 0xc0, 0x14, 0xe9, 0xc2, 0x9d, 0x65,
+	0xf8, 0xdf, 0x56, 0xc9, 0xde, 0x5d, 0x05, 0x93, 0xc4, 0x6f, 0x40, 0x45, 0x46, 0x00, 0x77, 0x57,
+	0x66, 0x24, 0xa8, 0xef, 0xfe, 0x63, 0x96, 0xf0, 0x2d, 0x30, 0xf7, 0x13, 0x1c, 0x32, 0xbc, 0x5c,
+	0xb4, 0xa8, 0xaf, 0x14, 0xad, 0x60, 0x72, 0xb3, 0xd7, 0x3e, 0xe9, 0x1a, 0x7c, 0x0d, 0xca, 0xfb,
+	0x11, 0x4d, 0x31, 0x5c, 0x3a, 0x41, 0x5e, 0x56, 0xdc, 0x3b, 0x2b, 0x50, 0x73, 0xd4, 0xfe, 0xde,
+	0xe9, 0xc4, 0xd1, 0xce, 0x26, 0x8e, 0xf6, 0x73, 0xe2, 0x68, 0x27, 0x53, 0xa7, 0x74, 0x36, 0x75,
+	0x4a, 0xdf, 0xa7, 0x4e, 0x09, 0x5c, 0x27, 0x54, 0xf1, 0x84, 0x63, 0xa2, 0x38, 0x7c, 0xb3, 0xf0,
+	0xfd, 0xb2, 0x75, 0xa8, 0xf5, 0x4c, 0xbe, 0x90, 0xf7, 0x7e, 0x0d, 0x00, 0xc7, 0x89, 0x57, 0xd6,
+	0x99, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -599,6 +727,23 @@ func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Reentrant {
+		i--
+		if m.Reentrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Timeout != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
 		if err1 != nil {
@@ -642,6 +787,11 @@ func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Holds != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Holds))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Version != 0 {
 		i = encodeVarintLock(dAtA, i, uint64(m.Version))
 		i--
@@ -759,6 +909,36 @@ func (m *GetLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Waiters != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Waiters))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Holds != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Holds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Acquired != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Acquired, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Acquired):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintLock(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Holder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.Version != 0 {
 		i = encodeVarintLock(dAtA, i, uint64(m.Version))
 		i--
@@ -767,6 +947,43 @@ func (m *GetLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetLockResponse_Holder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLockResponse_Holder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLockResponse_Holder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLock(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLock(v)
 	base := offset
@@ -790,6 +1007,13 @@ func (m *LockRequest) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
 		n += 1 + l + sovLock(uint64(l))
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if m.Reentrant {
+		n += 2
+	}
 	return n
 }
 
@@ -802,6 +1026,9 @@ func (m *LockResponse) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovLock(uint64(m.Version))
 	}
+	if m.Holds != 0 {
+		n += 1 + sovLock(uint64(m.Holds))
+	}
 	return n
 }
 
@@ -845,6 +1072,35 @@ func (m *GetLockResponse) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovLock(uint64(m.Version))
 	}
+	l = m.Holder.Size()
+	n += 1 + l + sovLock(uint64(l))
+	if m.Acquired != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Acquired)
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if m.Holds != 0 {
+		n += 1 + sovLock(uint64(m.Holds))
+	}
+	if m.Waiters != 0 {
+		n += 1 + sovLock(uint64(m.Waiters))
+	}
+	return n
+}
+
+func (m *GetLockResponse_Holder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
 	return n
 }
 
@@ -952,6 +1208,58 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reentrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reentrant = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -1021,6 +1329,25 @@ func (m *LockResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			m.Holds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -1306,6 +1633,227 @@ func (m *GetLockResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Holder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acquired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acquired == nil {
+				m.Acquired = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Acquired, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			m.Holds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiters", wireType)
+			}
+			m.Waiters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Waiters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLockResponse_Holder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Holder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Holder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -32,6 +32,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type EventsRequest_EventType int32
+
+const (
+	EventsRequest_NONE     EventsRequest_EventType = 0
+	EventsRequest_INSERTED EventsRequest_EventType = 1
+	EventsRequest_UPDATED  EventsRequest_EventType = 2
+	EventsRequest_REMOVED  EventsRequest_EventType = 3
+)
+
+var EventsRequest_EventType_name = map[int32]string{
+	0: "NONE",
+	1: "INSERTED",
+	2: "UPDATED",
+	3: "REMOVED",
+}
+
+var EventsRequest_EventType_value = map[string]int32{
+	"NONE":     0,
+	"INSERTED": 1,
+	"UPDATED":  2,
+	"REMOVED":  3,
+}
+
+func (x EventsRequest_EventType) String() string {
+	return proto.EnumName(EventsRequest_EventType_name, int32(x))
+}
+
+func (EventsRequest_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{32, 0}
+}
+
 type SizeRequest struct {
 	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 }
@@ -126,6 +157,13 @@ type PutRequest struct {
 	Value       []byte         `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	TTL         *time.Duration `protobuf:"bytes,4,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
 	PrevVersion uint64         `protobuf:"varint,5,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+	// ephemeral binds the entry to the client's session; the entry is removed when the session is closed or expires
+	Ephemeral bool `protobuf:"varint,6,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	// fence rejects the write with a Conflict error if a newer token has been issued by the fencing primitive
+	Fence *Fence `protobuf:"bytes,7,opt,name=fence,proto3" json:"fence,omitempty"`
+	// patch updates the JSON document stored at the key instead of replacing it with the value
+	// A missing entry is patched as an empty object, and the value must be empty when a patch is set.
+	Patch *Patch `protobuf:"bytes,8,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
 func (m *PutRequest) Reset()         { *m = PutRequest{} }
@@ -196,6 +234,27 @@ func (m *PutRequest) GetPrevVersion() uint64 {
 	return 0
 }
 
+func (m *PutRequest) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+func (m *PutRequest) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
+	}
+	return nil
+}
+
+func (m *PutRequest) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 type PutResponse struct {
 	Version   uint64          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	PrevValue *VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
@@ -253,6 +312,8 @@ type InsertRequest struct {
 	Key   string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	Value []byte         `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	TTL   *time.Duration `protobuf:"bytes,4,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	// ephemeral binds the entry to the client's session; the entry is removed when the session is closed or expires
+	Ephemeral bool `protobuf:"varint,5,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
 }
 
 func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
@@ -316,6 +377,13 @@ func (m *InsertRequest) GetTTL() *time.Duration {
 	return nil
 }
 
+func (m *InsertRequest) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
 type InsertResponse struct {
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
@@ -366,6 +434,8 @@ type UpdateRequest struct {
 	Value       []byte         `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	TTL         *time.Duration `protobuf:"bytes,4,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
 	PrevVersion uint64         `protobuf:"varint,5,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+	// fence rejects the write with a Conflict error if a newer token has been issued by the fencing primitive
+	Fence *Fence `protobuf:"bytes,6,opt,name=fence,proto3" json:"fence,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
@@ -436,6 +506,13 @@ func (m *UpdateRequest) GetPrevVersion() uint64 {
 	return 0
 }
 
+func (m *UpdateRequest) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
+	}
+	return nil
+}
+
 type UpdateResponse struct {
 	Version   uint64         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	PrevValue VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
@@ -491,6 +568,8 @@ func (m *UpdateResponse) GetPrevValue() VersionedValue {
 type GetRequest struct {
 	ID  v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Key string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// paths projects a JSON document value to the values at the given JSON Pointers (RFC 6901)
+	Paths []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
 }
 
 func (m *GetRequest) Reset()         { *m = GetRequest{} }
@@ -540,6 +619,13 @@ func (m *GetRequest) GetKey() string {
 	return ""
 }
 
+func (m *GetRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
 type GetResponse struct {
 	Value VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
 }
@@ -588,6 +674,8 @@ type RemoveRequest struct {
 	ID          v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Key         string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	PrevVersion uint64         `protobuf:"varint,3,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+	// fence rejects the write with a Conflict error if a newer token has been issued by the fencing primitive
+	Fence *Fence `protobuf:"bytes,4,opt,name=fence,proto3" json:"fence,omitempty"`
 }
 
 func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
@@ -644,6 +732,13 @@ func (m *RemoveRequest) GetPrevVersion() uint64 {
 	return 0
 }
 
+func (m *RemoveRequest) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
+	}
+	return nil
+}
+
 type RemoveResponse struct {
 	Value VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
 }
@@ -688,22 +783,23 @@ func (m *RemoveResponse) GetValue() VersionedValue {
 	return VersionedValue{}
 }
 
-type ClearRequest struct {
-	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+type GetAllRequest struct {
+	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Keys []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (m *ClearRequest) Reset()         { *m = ClearRequest{} }
-func (m *ClearRequest) String() string { return proto.CompactTextString(m) }
-func (*ClearRequest) ProtoMessage()    {}
-func (*ClearRequest) Descriptor() ([]byte, []int) {
+func (m *GetAllRequest) Reset()         { *m = GetAllRequest{} }
+func (m *GetAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAllRequest) ProtoMessage()    {}
+func (*GetAllRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7498de5246e2fda8, []int{12}
 }
-func (m *ClearRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -713,40 +809,49 @@ func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ClearRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearRequest.Merge(m, src)
+func (m *GetAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllRequest.Merge(m, src)
 }
-func (m *ClearRequest) XXX_Size() int {
+func (m *GetAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearRequest.DiscardUnknown(m)
+func (m *GetAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetAllRequest proto.InternalMessageInfo
 
-func (m *ClearRequest) GetID() v1.PrimitiveID {
+func (m *GetAllRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-type ClearResponse struct {
+func (m *GetAllRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
 }
 
-func (m *ClearResponse) Reset()         { *m = ClearResponse{} }
-func (m *ClearResponse) String() string { return proto.CompactTextString(m) }
-func (*ClearResponse) ProtoMessage()    {}
-func (*ClearResponse) Descriptor() ([]byte, []int) {
+type GetAllResponse struct {
+	// entries is the entries for the requested keys; keys not present in the map are omitted
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *GetAllResponse) Reset()         { *m = GetAllResponse{} }
+func (m *GetAllResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAllResponse) ProtoMessage()    {}
+func (*GetAllResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7498de5246e2fda8, []int{13}
 }
-func (m *ClearResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -756,36 +861,42 @@ func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ClearResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearResponse.Merge(m, src)
+func (m *GetAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllResponse.Merge(m, src)
 }
-func (m *ClearResponse) XXX_Size() int {
+func (m *GetAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearResponse.DiscardUnknown(m)
+func (m *GetAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAllResponse proto.InternalMessageInfo
 
-type LockRequest struct {
-	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Keys    []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+func (m *GetAllResponse) GetEntries() []Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *LockRequest) Reset()         { *m = LockRequest{} }
-func (m *LockRequest) String() string { return proto.CompactTextString(m) }
-func (*LockRequest) ProtoMessage()    {}
-func (*LockRequest) Descriptor() ([]byte, []int) {
+type PutAllRequest struct {
+	ID      v1.PrimitiveID        `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Entries []PutAllRequest_Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *PutAllRequest) Reset()         { *m = PutAllRequest{} }
+func (m *PutAllRequest) String() string { return proto.CompactTextString(m) }
+func (*PutAllRequest) ProtoMessage()    {}
+func (*PutAllRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7498de5246e2fda8, []int{14}
 }
-func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+func (m *PutAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -795,54 +906,50 @@ func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockRequest.Merge(m, src)
+func (m *PutAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllRequest.Merge(m, src)
 }
-func (m *LockRequest) XXX_Size() int {
+func (m *PutAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockRequest.DiscardUnknown(m)
+func (m *PutAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+var xxx_messageInfo_PutAllRequest proto.InternalMessageInfo
 
-func (m *LockRequest) GetID() v1.PrimitiveID {
+func (m *PutAllRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *LockRequest) GetKeys() []string {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
-
-func (m *LockRequest) GetTimeout() *time.Duration {
+func (m *PutAllRequest) GetEntries() []PutAllRequest_Entry {
 	if m != nil {
-		return m.Timeout
+		return m.Entries
 	}
 	return nil
 }
 
-type LockResponse struct {
+type PutAllRequest_Entry struct {
+	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL   *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
 }
 
-func (m *LockResponse) Reset()         { *m = LockResponse{} }
-func (m *LockResponse) String() string { return proto.CompactTextString(m) }
-func (*LockResponse) ProtoMessage()    {}
-func (*LockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{15}
+func (m *PutAllRequest_Entry) Reset()         { *m = PutAllRequest_Entry{} }
+func (m *PutAllRequest_Entry) String() string { return proto.CompactTextString(m) }
+func (*PutAllRequest_Entry) ProtoMessage()    {}
+func (*PutAllRequest_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{14, 0}
 }
-func (m *LockResponse) XXX_Unmarshal(b []byte) error {
+func (m *PutAllRequest_Entry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutAllRequest_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutAllRequest_Entry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -852,35 +959,55 @@ func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockResponse.Merge(m, src)
+func (m *PutAllRequest_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllRequest_Entry.Merge(m, src)
 }
-func (m *LockResponse) XXX_Size() int {
+func (m *PutAllRequest_Entry) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockResponse.DiscardUnknown(m)
+func (m *PutAllRequest_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllRequest_Entry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockResponse proto.InternalMessageInfo
+var xxx_messageInfo_PutAllRequest_Entry proto.InternalMessageInfo
 
-type UnlockRequest struct {
-	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Keys []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+func (m *PutAllRequest_Entry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
-func (m *UnlockRequest) String() string { return proto.CompactTextString(m) }
-func (*UnlockRequest) ProtoMessage()    {}
-func (*UnlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{16}
+func (m *PutAllRequest_Entry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
-func (m *UnlockRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *PutAllRequest_Entry) GetTTL() *time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
+}
+
+type PutAllResponse struct {
+	Results []PutAllResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *PutAllResponse) Reset()         { *m = PutAllResponse{} }
+func (m *PutAllResponse) String() string { return proto.CompactTextString(m) }
+func (*PutAllResponse) ProtoMessage()    {}
+func (*PutAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{15}
+}
+func (m *PutAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -890,47 +1017,43 @@ func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UnlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockRequest.Merge(m, src)
+func (m *PutAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllResponse.Merge(m, src)
 }
-func (m *UnlockRequest) XXX_Size() int {
+func (m *PutAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockRequest.DiscardUnknown(m)
+func (m *PutAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockRequest proto.InternalMessageInfo
-
-func (m *UnlockRequest) GetID() v1.PrimitiveID {
-	if m != nil {
-		return m.ID
-	}
-	return v1.PrimitiveID{}
-}
+var xxx_messageInfo_PutAllResponse proto.InternalMessageInfo
 
-func (m *UnlockRequest) GetKeys() []string {
+func (m *PutAllResponse) GetResults() []PutAllResponse_Result {
 	if m != nil {
-		return m.Keys
+		return m.Results
 	}
 	return nil
 }
 
-type UnlockResponse struct {
+type PutAllResponse_Result struct {
+	Key       string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version   uint64          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	PrevValue *VersionedValue `protobuf:"bytes,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
 
-func (m *UnlockResponse) Reset()         { *m = UnlockResponse{} }
-func (m *UnlockResponse) String() string { return proto.CompactTextString(m) }
-func (*UnlockResponse) ProtoMessage()    {}
-func (*UnlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{17}
+func (m *PutAllResponse_Result) Reset()         { *m = PutAllResponse_Result{} }
+func (m *PutAllResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*PutAllResponse_Result) ProtoMessage()    {}
+func (*PutAllResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{15, 0}
 }
-func (m *UnlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *PutAllResponse_Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutAllResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutAllResponse_Result.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -940,35 +1063,56 @@ func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *UnlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockResponse.Merge(m, src)
+func (m *PutAllResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllResponse_Result.Merge(m, src)
 }
-func (m *UnlockResponse) XXX_Size() int {
+func (m *PutAllResponse_Result) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockResponse.DiscardUnknown(m)
+func (m *PutAllResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllResponse_Result.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_PutAllResponse_Result proto.InternalMessageInfo
 
-type EntriesRequest struct {
-	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Watch bool           `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
+func (m *PutAllResponse_Result) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
-func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
-func (*EntriesRequest) ProtoMessage()    {}
-func (*EntriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{18}
+func (m *PutAllResponse_Result) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
-func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *PutAllResponse_Result) GetPrevValue() *VersionedValue {
+	if m != nil {
+		return m.PrevValue
+	}
+	return nil
+}
+
+type RemoveAllRequest struct {
+	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Keys []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *RemoveAllRequest) Reset()         { *m = RemoveAllRequest{} }
+func (m *RemoveAllRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveAllRequest) ProtoMessage()    {}
+func (*RemoveAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{16}
+}
+func (m *RemoveAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -978,48 +1122,49 @@ func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EntriesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesRequest.Merge(m, src)
+func (m *RemoveAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAllRequest.Merge(m, src)
 }
-func (m *EntriesRequest) XXX_Size() int {
+func (m *RemoveAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
+func (m *RemoveAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveAllRequest proto.InternalMessageInfo
 
-func (m *EntriesRequest) GetID() v1.PrimitiveID {
+func (m *RemoveAllRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *EntriesRequest) GetWatch() bool {
+func (m *RemoveAllRequest) GetKeys() []string {
 	if m != nil {
-		return m.Watch
+		return m.Keys
 	}
-	return false
+	return nil
 }
 
-type EntriesResponse struct {
-	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+type RemoveAllResponse struct {
+	// entries is the removed entries; keys not present in the map are omitted
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
-func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
-func (*EntriesResponse) ProtoMessage()    {}
-func (*EntriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{19}
+func (m *RemoveAllResponse) Reset()         { *m = RemoveAllResponse{} }
+func (m *RemoveAllResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveAllResponse) ProtoMessage()    {}
+func (*RemoveAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{17}
 }
-func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1029,42 +1174,41 @@ func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EntriesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesResponse.Merge(m, src)
+func (m *RemoveAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAllResponse.Merge(m, src)
 }
-func (m *EntriesResponse) XXX_Size() int {
+func (m *RemoveAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
+func (m *RemoveAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveAllResponse proto.InternalMessageInfo
 
-func (m *EntriesResponse) GetEntry() Entry {
+func (m *RemoveAllResponse) GetEntries() []Entry {
 	if m != nil {
-		return m.Entry
+		return m.Entries
 	}
-	return Entry{}
+	return nil
 }
 
-type CommitRequest struct {
-	ID         v1.PrimitiveID            `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Operations []CommitRequest_Operation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations"`
+type ClearRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 }
 
-func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
-func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
-func (*CommitRequest) ProtoMessage()    {}
-func (*CommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{20}
+func (m *ClearRequest) Reset()         { *m = ClearRequest{} }
+func (m *ClearRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearRequest) ProtoMessage()    {}
+func (*ClearRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{18}
 }
-func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
+func (m *ClearRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1074,53 +1218,40 @@ func (m *CommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *CommitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitRequest.Merge(m, src)
+func (m *ClearRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearRequest.Merge(m, src)
 }
-func (m *CommitRequest) XXX_Size() int {
+func (m *ClearRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitRequest.DiscardUnknown(m)
+func (m *ClearRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitRequest proto.InternalMessageInfo
+var xxx_messageInfo_ClearRequest proto.InternalMessageInfo
 
-func (m *CommitRequest) GetID() v1.PrimitiveID {
+func (m *ClearRequest) GetID() v1.PrimitiveID {
 	if m != nil {
 		return m.ID
 	}
 	return v1.PrimitiveID{}
 }
 
-func (m *CommitRequest) GetOperations() []CommitRequest_Operation {
-	if m != nil {
-		return m.Operations
-	}
-	return nil
-}
-
-type CommitRequest_Operation struct {
-	// Types that are valid to be assigned to Operation:
-	//	*CommitRequest_Operation_Put
-	//	*CommitRequest_Operation_Insert
-	//	*CommitRequest_Operation_Update
-	//	*CommitRequest_Operation_Remove
-	Operation isCommitRequest_Operation_Operation `protobuf_oneof:"operation"`
+type ClearResponse struct {
 }
 
-func (m *CommitRequest_Operation) Reset()         { *m = CommitRequest_Operation{} }
-func (m *CommitRequest_Operation) String() string { return proto.CompactTextString(m) }
-func (*CommitRequest_Operation) ProtoMessage()    {}
-func (*CommitRequest_Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{20, 0}
+func (m *ClearResponse) Reset()         { *m = ClearResponse{} }
+func (m *ClearResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearResponse) ProtoMessage()    {}
+func (*ClearResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{19}
 }
-func (m *CommitRequest_Operation) XXX_Unmarshal(b []byte) error {
+func (m *ClearResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitRequest_Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitRequest_Operation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1130,106 +1261,131 @@ func (m *CommitRequest_Operation) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *CommitRequest_Operation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitRequest_Operation.Merge(m, src)
+func (m *ClearResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearResponse.Merge(m, src)
 }
-func (m *CommitRequest_Operation) XXX_Size() int {
+func (m *ClearResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitRequest_Operation) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitRequest_Operation.DiscardUnknown(m)
+func (m *ClearResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitRequest_Operation proto.InternalMessageInfo
+var xxx_messageInfo_ClearResponse proto.InternalMessageInfo
 
-type isCommitRequest_Operation_Operation interface {
-	isCommitRequest_Operation_Operation()
-	MarshalTo([]byte) (int, error)
-	Size() int
+type LockRequest struct {
+	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Keys    []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 }
 
-type CommitRequest_Operation_Put struct {
-	Put *CommitRequest_Put `protobuf:"bytes,1,opt,name=put,proto3,oneof" json:"put,omitempty"`
+func (m *LockRequest) Reset()         { *m = LockRequest{} }
+func (m *LockRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()    {}
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{20}
 }
-type CommitRequest_Operation_Insert struct {
-	Insert *CommitRequest_Insert `protobuf:"bytes,2,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
+func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type CommitRequest_Operation_Update struct {
-	Update *CommitRequest_Update `protobuf:"bytes,3,opt,name=update,proto3,oneof" json:"update,omitempty"`
+func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type CommitRequest_Operation_Remove struct {
-	Remove *CommitRequest_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+func (m *LockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRequest.Merge(m, src)
+}
+func (m *LockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRequest.DiscardUnknown(m)
 }
 
-func (*CommitRequest_Operation_Put) isCommitRequest_Operation_Operation()    {}
-func (*CommitRequest_Operation_Insert) isCommitRequest_Operation_Operation() {}
-func (*CommitRequest_Operation_Update) isCommitRequest_Operation_Operation() {}
-func (*CommitRequest_Operation_Remove) isCommitRequest_Operation_Operation() {}
+var xxx_messageInfo_LockRequest proto.InternalMessageInfo
 
-func (m *CommitRequest_Operation) GetOperation() isCommitRequest_Operation_Operation {
+func (m *LockRequest) GetID() v1.PrimitiveID {
 	if m != nil {
-		return m.Operation
+		return m.ID
 	}
-	return nil
+	return v1.PrimitiveID{}
 }
 
-func (m *CommitRequest_Operation) GetPut() *CommitRequest_Put {
-	if x, ok := m.GetOperation().(*CommitRequest_Operation_Put); ok {
-		return x.Put
+func (m *LockRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
 	return nil
 }
 
-func (m *CommitRequest_Operation) GetInsert() *CommitRequest_Insert {
-	if x, ok := m.GetOperation().(*CommitRequest_Operation_Insert); ok {
-		return x.Insert
+func (m *LockRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
 	return nil
 }
 
-func (m *CommitRequest_Operation) GetUpdate() *CommitRequest_Update {
-	if x, ok := m.GetOperation().(*CommitRequest_Operation_Update); ok {
-		return x.Update
-	}
-	return nil
+type LockResponse struct {
 }
 
-func (m *CommitRequest_Operation) GetRemove() *CommitRequest_Remove {
-	if x, ok := m.GetOperation().(*CommitRequest_Operation_Remove); ok {
-		return x.Remove
-	}
-	return nil
+func (m *LockResponse) Reset()         { *m = LockResponse{} }
+func (m *LockResponse) String() string { return proto.CompactTextString(m) }
+func (*LockResponse) ProtoMessage()    {}
+func (*LockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{21}
 }
-
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CommitRequest_Operation) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CommitRequest_Operation_Put)(nil),
-		(*CommitRequest_Operation_Insert)(nil),
-		(*CommitRequest_Operation_Update)(nil),
-		(*CommitRequest_Operation_Remove)(nil),
+func (m *LockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
+func (m *LockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockResponse.Merge(m, src)
+}
+func (m *LockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockResponse.DiscardUnknown(m)
+}
 
-type CommitRequest_Put struct {
-	Key         string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value       []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	TTL         *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
-	PrevVersion uint64         `protobuf:"varint,4,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+var xxx_messageInfo_LockResponse proto.InternalMessageInfo
+
+type UnlockRequest struct {
+	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Keys []string       `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (m *CommitRequest_Put) Reset()         { *m = CommitRequest_Put{} }
-func (m *CommitRequest_Put) String() string { return proto.CompactTextString(m) }
-func (*CommitRequest_Put) ProtoMessage()    {}
-func (*CommitRequest_Put) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{20, 1}
+func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
+func (m *UnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockRequest) ProtoMessage()    {}
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{22}
 }
-func (m *CommitRequest_Put) XXX_Unmarshal(b []byte) error {
+func (m *UnlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitRequest_Put) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitRequest_Put.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1239,64 +1395,47 @@ func (m *CommitRequest_Put) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *CommitRequest_Put) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitRequest_Put.Merge(m, src)
+func (m *UnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockRequest.Merge(m, src)
 }
-func (m *CommitRequest_Put) XXX_Size() int {
+func (m *UnlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitRequest_Put) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitRequest_Put.DiscardUnknown(m)
+func (m *UnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitRequest_Put proto.InternalMessageInfo
+var xxx_messageInfo_UnlockRequest proto.InternalMessageInfo
 
-func (m *CommitRequest_Put) GetKey() string {
+func (m *UnlockRequest) GetID() v1.PrimitiveID {
 	if m != nil {
-		return m.Key
+		return m.ID
 	}
-	return ""
+	return v1.PrimitiveID{}
 }
 
-func (m *CommitRequest_Put) GetValue() []byte {
+func (m *UnlockRequest) GetKeys() []string {
 	if m != nil {
-		return m.Value
+		return m.Keys
 	}
 	return nil
 }
 
-func (m *CommitRequest_Put) GetTTL() *time.Duration {
-	if m != nil {
-		return m.TTL
-	}
-	return nil
+type UnlockResponse struct {
 }
 
-func (m *CommitRequest_Put) GetPrevVersion() uint64 {
-	if m != nil {
-		return m.PrevVersion
-	}
-	return 0
+func (m *UnlockResponse) Reset()         { *m = UnlockResponse{} }
+func (m *UnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockResponse) ProtoMessage()    {}
+func (*UnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{23}
 }
-
-type CommitRequest_Insert struct {
-	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	TTL   *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
-}
-
-func (m *CommitRequest_Insert) Reset()         { *m = CommitRequest_Insert{} }
-func (m *CommitRequest_Insert) String() string { return proto.CompactTextString(m) }
-func (*CommitRequest_Insert) ProtoMessage()    {}
-func (*CommitRequest_Insert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{20, 2}
-}
-func (m *CommitRequest_Insert) XXX_Unmarshal(b []byte) error {
+func (m *UnlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitRequest_Insert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitRequest_Insert.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnlockResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1306,58 +1445,46 @@ func (m *CommitRequest_Insert) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *CommitRequest_Insert) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitRequest_Insert.Merge(m, src)
+func (m *UnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockResponse.Merge(m, src)
 }
-func (m *CommitRequest_Insert) XXX_Size() int {
+func (m *UnlockResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitRequest_Insert) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitRequest_Insert.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CommitRequest_Insert proto.InternalMessageInfo
-
-func (m *CommitRequest_Insert) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
-}
-
-func (m *CommitRequest_Insert) GetValue() []byte {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+func (m *UnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockResponse.DiscardUnknown(m)
 }
 
-func (m *CommitRequest_Insert) GetTTL() *time.Duration {
-	if m != nil {
-		return m.TTL
-	}
-	return nil
-}
+var xxx_messageInfo_UnlockResponse proto.InternalMessageInfo
 
-type CommitRequest_Update struct {
-	Key         string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value       []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	TTL         *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
-	PrevVersion uint64         `protobuf:"varint,4,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+type EntriesRequest struct {
+	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Watch bool           `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
+	// prefix limits the entries to keys beginning with the prefix
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// start_key is the inclusive lower bound of the keys to list
+	StartKey string `protobuf:"bytes,4,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	// end_key is the exclusive upper bound of the keys to list
+	EndKey string `protobuf:"bytes,5,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// limit is the maximum number of entries to list; zero indicates no limit
+	// The limit cannot be combined with watch
+	Limit uint32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continuation is a token returned with a previous response from which to resume listing
+	Continuation string `protobuf:"bytes,7,opt,name=continuation,proto3" json:"continuation,omitempty"`
 }
 
-func (m *CommitRequest_Update) Reset()         { *m = CommitRequest_Update{} }
-func (m *CommitRequest_Update) String() string { return proto.CompactTextString(m) }
-func (*CommitRequest_Update) ProtoMessage()    {}
-func (*CommitRequest_Update) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{20, 3}
+func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
+func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*EntriesRequest) ProtoMessage()    {}
+func (*EntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{24}
 }
-func (m *CommitRequest_Update) XXX_Unmarshal(b []byte) error {
+func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitRequest_Update) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitRequest_Update.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1367,63 +1494,86 @@ func (m *CommitRequest_Update) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *CommitRequest_Update) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitRequest_Update.Merge(m, src)
+func (m *EntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesRequest.Merge(m, src)
 }
-func (m *CommitRequest_Update) XXX_Size() int {
+func (m *EntriesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitRequest_Update) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitRequest_Update.DiscardUnknown(m)
+func (m *EntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitRequest_Update proto.InternalMessageInfo
+var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
 
-func (m *CommitRequest_Update) GetKey() string {
+func (m *EntriesRequest) GetID() v1.PrimitiveID {
 	if m != nil {
-		return m.Key
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *EntriesRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
+	}
+	return false
+}
+
+func (m *EntriesRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
 	return ""
 }
 
-func (m *CommitRequest_Update) GetValue() []byte {
+func (m *EntriesRequest) GetStartKey() string {
 	if m != nil {
-		return m.Value
+		return m.StartKey
 	}
-	return nil
+	return ""
 }
 
-func (m *CommitRequest_Update) GetTTL() *time.Duration {
+func (m *EntriesRequest) GetEndKey() string {
 	if m != nil {
-		return m.TTL
+		return m.EndKey
 	}
-	return nil
+	return ""
 }
 
-func (m *CommitRequest_Update) GetPrevVersion() uint64 {
+func (m *EntriesRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.PrevVersion
+		return m.Limit
 	}
 	return 0
 }
 
-type CommitRequest_Remove struct {
-	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	PrevVersion uint64 `protobuf:"varint,2,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+func (m *EntriesRequest) GetContinuation() string {
+	if m != nil {
+		return m.Continuation
+	}
+	return ""
 }
 
-func (m *CommitRequest_Remove) Reset()         { *m = CommitRequest_Remove{} }
-func (m *CommitRequest_Remove) String() string { return proto.CompactTextString(m) }
-func (*CommitRequest_Remove) ProtoMessage()    {}
-func (*CommitRequest_Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{20, 4}
+type EntriesResponse struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	// continuation is an opaque token which can be passed in a subsequent request
+	// to resume listing after this entry
+	Continuation string `protobuf:"bytes,2,opt,name=continuation,proto3" json:"continuation,omitempty"`
 }
-func (m *CommitRequest_Remove) XXX_Unmarshal(b []byte) error {
+
+func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
+func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*EntriesResponse) ProtoMessage()    {}
+func (*EntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{25}
+}
+func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitRequest_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitRequest_Remove.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1433,48 +1583,51 @@ func (m *CommitRequest_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *CommitRequest_Remove) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitRequest_Remove.Merge(m, src)
+func (m *EntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesResponse.Merge(m, src)
 }
-func (m *CommitRequest_Remove) XXX_Size() int {
+func (m *EntriesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitRequest_Remove) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitRequest_Remove.DiscardUnknown(m)
+func (m *EntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitRequest_Remove proto.InternalMessageInfo
+var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
 
-func (m *CommitRequest_Remove) GetKey() string {
+func (m *EntriesResponse) GetEntry() Entry {
 	if m != nil {
-		return m.Key
+		return m.Entry
 	}
-	return ""
+	return Entry{}
 }
 
-func (m *CommitRequest_Remove) GetPrevVersion() uint64 {
+func (m *EntriesResponse) GetContinuation() string {
 	if m != nil {
-		return m.PrevVersion
+		return m.Continuation
 	}
-	return 0
+	return ""
 }
 
-type CommitResponse struct {
-	Results []CommitResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+type CommitRequest struct {
+	ID         v1.PrimitiveID            `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Operations []CommitRequest_Operation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations"`
+	// fence rejects the write with a Conflict error if a newer token has been issued by the fencing primitive
+	Fence *Fence `protobuf:"bytes,3,opt,name=fence,proto3" json:"fence,omitempty"`
 }
 
-func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
-func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
-func (*CommitResponse) ProtoMessage()    {}
-func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{21}
+func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
+func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitRequest) ProtoMessage()    {}
+func (*CommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{26}
 }
-func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
+func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1484,46 +1637,60 @@ func (m *CommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *CommitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitResponse.Merge(m, src)
+func (m *CommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRequest.Merge(m, src)
 }
-func (m *CommitResponse) XXX_Size() int {
+func (m *CommitRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitResponse.DiscardUnknown(m)
+func (m *CommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitResponse proto.InternalMessageInfo
+var xxx_messageInfo_CommitRequest proto.InternalMessageInfo
 
-func (m *CommitResponse) GetResults() []CommitResponse_Result {
+func (m *CommitRequest) GetID() v1.PrimitiveID {
 	if m != nil {
-		return m.Results
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *CommitRequest) GetOperations() []CommitRequest_Operation {
+	if m != nil {
+		return m.Operations
 	}
 	return nil
 }
 
-type CommitResponse_Result struct {
-	// Types that are valid to be assigned to Result:
-	//	*CommitResponse_Result_Put
-	//	*CommitResponse_Result_Insert
-	//	*CommitResponse_Result_Update
-	//	*CommitResponse_Result_Remove
-	Result isCommitResponse_Result_Result `protobuf_oneof:"result"`
+func (m *CommitRequest) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
+	}
+	return nil
 }
 
-func (m *CommitResponse_Result) Reset()         { *m = CommitResponse_Result{} }
-func (m *CommitResponse_Result) String() string { return proto.CompactTextString(m) }
-func (*CommitResponse_Result) ProtoMessage()    {}
-func (*CommitResponse_Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{21, 0}
+type CommitRequest_Operation struct {
+	// Types that are valid to be assigned to Operation:
+	//	*CommitRequest_Operation_Put
+	//	*CommitRequest_Operation_Insert
+	//	*CommitRequest_Operation_Update
+	//	*CommitRequest_Operation_Remove
+	Operation isCommitRequest_Operation_Operation `protobuf_oneof:"operation"`
 }
-func (m *CommitResponse_Result) XXX_Unmarshal(b []byte) error {
+
+func (m *CommitRequest_Operation) Reset()         { *m = CommitRequest_Operation{} }
+func (m *CommitRequest_Operation) String() string { return proto.CompactTextString(m) }
+func (*CommitRequest_Operation) ProtoMessage()    {}
+func (*CommitRequest_Operation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{26, 0}
+}
+func (m *CommitRequest_Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitRequest_Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitResponse_Result.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitRequest_Operation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1533,104 +1700,106 @@ func (m *CommitResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *CommitResponse_Result) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitResponse_Result.Merge(m, src)
+func (m *CommitRequest_Operation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRequest_Operation.Merge(m, src)
 }
-func (m *CommitResponse_Result) XXX_Size() int {
+func (m *CommitRequest_Operation) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitResponse_Result) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitResponse_Result.DiscardUnknown(m)
+func (m *CommitRequest_Operation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRequest_Operation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitResponse_Result proto.InternalMessageInfo
+var xxx_messageInfo_CommitRequest_Operation proto.InternalMessageInfo
 
-type isCommitResponse_Result_Result interface {
-	isCommitResponse_Result_Result()
+type isCommitRequest_Operation_Operation interface {
+	isCommitRequest_Operation_Operation()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type CommitResponse_Result_Put struct {
-	Put *CommitResponse_Put `protobuf:"bytes,1,opt,name=put,proto3,oneof" json:"put,omitempty"`
+type CommitRequest_Operation_Put struct {
+	Put *CommitRequest_Put `protobuf:"bytes,1,opt,name=put,proto3,oneof" json:"put,omitempty"`
 }
-type CommitResponse_Result_Insert struct {
-	Insert *CommitResponse_Insert `protobuf:"bytes,2,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
+type CommitRequest_Operation_Insert struct {
+	Insert *CommitRequest_Insert `protobuf:"bytes,2,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
 }
-type CommitResponse_Result_Update struct {
-	Update *CommitResponse_Update `protobuf:"bytes,3,opt,name=update,proto3,oneof" json:"update,omitempty"`
+type CommitRequest_Operation_Update struct {
+	Update *CommitRequest_Update `protobuf:"bytes,3,opt,name=update,proto3,oneof" json:"update,omitempty"`
 }
-type CommitResponse_Result_Remove struct {
-	Remove *CommitResponse_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+type CommitRequest_Operation_Remove struct {
+	Remove *CommitRequest_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
 }
 
-func (*CommitResponse_Result_Put) isCommitResponse_Result_Result()    {}
-func (*CommitResponse_Result_Insert) isCommitResponse_Result_Result() {}
-func (*CommitResponse_Result_Update) isCommitResponse_Result_Result() {}
-func (*CommitResponse_Result_Remove) isCommitResponse_Result_Result() {}
+func (*CommitRequest_Operation_Put) isCommitRequest_Operation_Operation()    {}
+func (*CommitRequest_Operation_Insert) isCommitRequest_Operation_Operation() {}
+func (*CommitRequest_Operation_Update) isCommitRequest_Operation_Operation() {}
+func (*CommitRequest_Operation_Remove) isCommitRequest_Operation_Operation() {}
 
-func (m *CommitResponse_Result) GetResult() isCommitResponse_Result_Result {
+func (m *CommitRequest_Operation) GetOperation() isCommitRequest_Operation_Operation {
 	if m != nil {
-		return m.Result
+		return m.Operation
 	}
 	return nil
 }
 
-func (m *CommitResponse_Result) GetPut() *CommitResponse_Put {
-	if x, ok := m.GetResult().(*CommitResponse_Result_Put); ok {
+func (m *CommitRequest_Operation) GetPut() *CommitRequest_Put {
+	if x, ok := m.GetOperation().(*CommitRequest_Operation_Put); ok {
 		return x.Put
 	}
 	return nil
 }
 
-func (m *CommitResponse_Result) GetInsert() *CommitResponse_Insert {
-	if x, ok := m.GetResult().(*CommitResponse_Result_Insert); ok {
+func (m *CommitRequest_Operation) GetInsert() *CommitRequest_Insert {
+	if x, ok := m.GetOperation().(*CommitRequest_Operation_Insert); ok {
 		return x.Insert
 	}
 	return nil
 }
 
-func (m *CommitResponse_Result) GetUpdate() *CommitResponse_Update {
-	if x, ok := m.GetResult().(*CommitResponse_Result_Update); ok {
+func (m *CommitRequest_Operation) GetUpdate() *CommitRequest_Update {
+	if x, ok := m.GetOperation().(*CommitRequest_Operation_Update); ok {
 		return x.Update
 	}
 	return nil
 }
 
-func (m *CommitResponse_Result) GetRemove() *CommitResponse_Remove {
-	if x, ok := m.GetResult().(*CommitResponse_Result_Remove); ok {
+func (m *CommitRequest_Operation) GetRemove() *CommitRequest_Remove {
+	if x, ok := m.GetOperation().(*CommitRequest_Operation_Remove); ok {
 		return x.Remove
 	}
 	return nil
 }
 
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*CommitResponse_Result) XXX_OneofWrappers() []interface{} {
+func (*CommitRequest_Operation) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*CommitResponse_Result_Put)(nil),
-		(*CommitResponse_Result_Insert)(nil),
-		(*CommitResponse_Result_Update)(nil),
-		(*CommitResponse_Result_Remove)(nil),
+		(*CommitRequest_Operation_Put)(nil),
+		(*CommitRequest_Operation_Insert)(nil),
+		(*CommitRequest_Operation_Update)(nil),
+		(*CommitRequest_Operation_Remove)(nil),
 	}
 }
 
-type CommitResponse_Put struct {
-	Version   uint64          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	PrevValue *VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type CommitRequest_Put struct {
+	Key         string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value       []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL         *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	PrevVersion uint64         `protobuf:"varint,4,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
 }
 
-func (m *CommitResponse_Put) Reset()         { *m = CommitResponse_Put{} }
-func (m *CommitResponse_Put) String() string { return proto.CompactTextString(m) }
-func (*CommitResponse_Put) ProtoMessage()    {}
-func (*CommitResponse_Put) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{21, 1}
+func (m *CommitRequest_Put) Reset()         { *m = CommitRequest_Put{} }
+func (m *CommitRequest_Put) String() string { return proto.CompactTextString(m) }
+func (*CommitRequest_Put) ProtoMessage()    {}
+func (*CommitRequest_Put) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{26, 1}
 }
-func (m *CommitResponse_Put) XXX_Unmarshal(b []byte) error {
+func (m *CommitRequest_Put) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitResponse_Put) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitRequest_Put) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitResponse_Put.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitRequest_Put.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1640,48 +1809,64 @@ func (m *CommitResponse_Put) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CommitResponse_Put) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitResponse_Put.Merge(m, src)
+func (m *CommitRequest_Put) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRequest_Put.Merge(m, src)
 }
-func (m *CommitResponse_Put) XXX_Size() int {
+func (m *CommitRequest_Put) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitResponse_Put) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitResponse_Put.DiscardUnknown(m)
+func (m *CommitRequest_Put) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRequest_Put.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitResponse_Put proto.InternalMessageInfo
+var xxx_messageInfo_CommitRequest_Put proto.InternalMessageInfo
 
-func (m *CommitResponse_Put) GetVersion() uint64 {
+func (m *CommitRequest_Put) GetKey() string {
 	if m != nil {
-		return m.Version
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *CommitResponse_Put) GetPrevValue() *VersionedValue {
+func (m *CommitRequest_Put) GetValue() []byte {
 	if m != nil {
-		return m.PrevValue
+		return m.Value
 	}
 	return nil
 }
 
-type CommitResponse_Insert struct {
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+func (m *CommitRequest_Put) GetTTL() *time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
 }
 
-func (m *CommitResponse_Insert) Reset()         { *m = CommitResponse_Insert{} }
-func (m *CommitResponse_Insert) String() string { return proto.CompactTextString(m) }
-func (*CommitResponse_Insert) ProtoMessage()    {}
-func (*CommitResponse_Insert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{21, 2}
+func (m *CommitRequest_Put) GetPrevVersion() uint64 {
+	if m != nil {
+		return m.PrevVersion
+	}
+	return 0
 }
-func (m *CommitResponse_Insert) XXX_Unmarshal(b []byte) error {
+
+type CommitRequest_Insert struct {
+	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL   *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+}
+
+func (m *CommitRequest_Insert) Reset()         { *m = CommitRequest_Insert{} }
+func (m *CommitRequest_Insert) String() string { return proto.CompactTextString(m) }
+func (*CommitRequest_Insert) ProtoMessage()    {}
+func (*CommitRequest_Insert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{26, 2}
+}
+func (m *CommitRequest_Insert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitResponse_Insert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitRequest_Insert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitResponse_Insert.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitRequest_Insert.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1691,42 +1876,58 @@ func (m *CommitResponse_Insert) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *CommitResponse_Insert) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitResponse_Insert.Merge(m, src)
+func (m *CommitRequest_Insert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRequest_Insert.Merge(m, src)
 }
-func (m *CommitResponse_Insert) XXX_Size() int {
+func (m *CommitRequest_Insert) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitResponse_Insert) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitResponse_Insert.DiscardUnknown(m)
+func (m *CommitRequest_Insert) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRequest_Insert.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitResponse_Insert proto.InternalMessageInfo
+var xxx_messageInfo_CommitRequest_Insert proto.InternalMessageInfo
 
-func (m *CommitResponse_Insert) GetVersion() uint64 {
+func (m *CommitRequest_Insert) GetKey() string {
 	if m != nil {
-		return m.Version
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-type CommitResponse_Update struct {
-	Version   uint64         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	PrevValue VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
+func (m *CommitRequest_Insert) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *CommitResponse_Update) Reset()         { *m = CommitResponse_Update{} }
-func (m *CommitResponse_Update) String() string { return proto.CompactTextString(m) }
-func (*CommitResponse_Update) ProtoMessage()    {}
-func (*CommitResponse_Update) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{21, 3}
+func (m *CommitRequest_Insert) GetTTL() *time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
 }
-func (m *CommitResponse_Update) XXX_Unmarshal(b []byte) error {
+
+type CommitRequest_Update struct {
+	Key         string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value       []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL         *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	PrevVersion uint64         `protobuf:"varint,4,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+}
+
+func (m *CommitRequest_Update) Reset()         { *m = CommitRequest_Update{} }
+func (m *CommitRequest_Update) String() string { return proto.CompactTextString(m) }
+func (*CommitRequest_Update) ProtoMessage()    {}
+func (*CommitRequest_Update) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{26, 3}
+}
+func (m *CommitRequest_Update) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitResponse_Update) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitRequest_Update) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitResponse_Update.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitRequest_Update.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1736,48 +1937,63 @@ func (m *CommitResponse_Update) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *CommitResponse_Update) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitResponse_Update.Merge(m, src)
+func (m *CommitRequest_Update) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRequest_Update.Merge(m, src)
 }
-func (m *CommitResponse_Update) XXX_Size() int {
+func (m *CommitRequest_Update) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitResponse_Update) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitResponse_Update.DiscardUnknown(m)
+func (m *CommitRequest_Update) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRequest_Update.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitResponse_Update proto.InternalMessageInfo
+var xxx_messageInfo_CommitRequest_Update proto.InternalMessageInfo
 
-func (m *CommitResponse_Update) GetVersion() uint64 {
+func (m *CommitRequest_Update) GetKey() string {
 	if m != nil {
-		return m.Version
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *CommitResponse_Update) GetPrevValue() VersionedValue {
+func (m *CommitRequest_Update) GetValue() []byte {
 	if m != nil {
-		return m.PrevValue
+		return m.Value
 	}
-	return VersionedValue{}
+	return nil
 }
 
-type CommitResponse_Remove struct {
-	Value VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+func (m *CommitRequest_Update) GetTTL() *time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
 }
 
-func (m *CommitResponse_Remove) Reset()         { *m = CommitResponse_Remove{} }
-func (m *CommitResponse_Remove) String() string { return proto.CompactTextString(m) }
-func (*CommitResponse_Remove) ProtoMessage()    {}
-func (*CommitResponse_Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{21, 4}
+func (m *CommitRequest_Update) GetPrevVersion() uint64 {
+	if m != nil {
+		return m.PrevVersion
+	}
+	return 0
 }
-func (m *CommitResponse_Remove) XXX_Unmarshal(b []byte) error {
+
+type CommitRequest_Remove struct {
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PrevVersion uint64 `protobuf:"varint,2,opt,name=prev_version,json=prevVersion,proto3" json:"prev_version,omitempty"`
+}
+
+func (m *CommitRequest_Remove) Reset()         { *m = CommitRequest_Remove{} }
+func (m *CommitRequest_Remove) String() string { return proto.CompactTextString(m) }
+func (*CommitRequest_Remove) ProtoMessage()    {}
+func (*CommitRequest_Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{26, 4}
+}
+func (m *CommitRequest_Remove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitResponse_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitRequest_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitResponse_Remove.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitRequest_Remove.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1787,93 +2003,48 @@ func (m *CommitResponse_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *CommitResponse_Remove) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitResponse_Remove.Merge(m, src)
+func (m *CommitRequest_Remove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRequest_Remove.Merge(m, src)
 }
-func (m *CommitResponse_Remove) XXX_Size() int {
+func (m *CommitRequest_Remove) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitResponse_Remove) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitResponse_Remove.DiscardUnknown(m)
+func (m *CommitRequest_Remove) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRequest_Remove.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitResponse_Remove proto.InternalMessageInfo
+var xxx_messageInfo_CommitRequest_Remove proto.InternalMessageInfo
 
-func (m *CommitResponse_Remove) GetValue() VersionedValue {
+func (m *CommitRequest_Remove) GetKey() string {
 	if m != nil {
-		return m.Value
+		return m.Key
 	}
-	return VersionedValue{}
+	return ""
 }
 
-type EventsRequest struct {
-	ID  v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Key string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-}
-
-func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
-func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
-func (*EventsRequest) ProtoMessage()    {}
-func (*EventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{22}
-}
-func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *EventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsRequest.Merge(m, src)
-}
-func (m *EventsRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
-
-func (m *EventsRequest) GetID() v1.PrimitiveID {
-	if m != nil {
-		return m.ID
-	}
-	return v1.PrimitiveID{}
-}
-
-func (m *EventsRequest) GetKey() string {
+func (m *CommitRequest_Remove) GetPrevVersion() uint64 {
 	if m != nil {
-		return m.Key
+		return m.PrevVersion
 	}
-	return ""
+	return 0
 }
 
-type EventsResponse struct {
-	Event Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event"`
+type CommitResponse struct {
+	Results []CommitResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
-func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()    {}
-func (*EventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{23}
+func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
+func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse) ProtoMessage()    {}
+func (*CommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{27}
 }
-func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1883,46 +2054,46 @@ func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsResponse.Merge(m, src)
+func (m *CommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse.Merge(m, src)
 }
-func (m *EventsResponse) XXX_Size() int {
+func (m *CommitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+func (m *CommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CommitResponse proto.InternalMessageInfo
 
-func (m *EventsResponse) GetEvent() Event {
+func (m *CommitResponse) GetResults() []CommitResponse_Result {
 	if m != nil {
-		return m.Event
+		return m.Results
 	}
-	return Event{}
+	return nil
 }
 
-type Event struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Types that are valid to be assigned to Event:
-	//	*Event_Inserted_
-	//	*Event_Updated_
-	//	*Event_Removed_
-	Event isEvent_Event `protobuf_oneof:"event"`
+type CommitResponse_Result struct {
+	// Types that are valid to be assigned to Result:
+	//	*CommitResponse_Result_Put
+	//	*CommitResponse_Result_Insert
+	//	*CommitResponse_Result_Update
+	//	*CommitResponse_Result_Remove
+	Result isCommitResponse_Result_Result `protobuf_oneof:"result"`
 }
 
-func (m *Event) Reset()         { *m = Event{} }
-func (m *Event) String() string { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()    {}
-func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{24}
+func (m *CommitResponse_Result) Reset()         { *m = CommitResponse_Result{} }
+func (m *CommitResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse_Result) ProtoMessage()    {}
+func (*CommitResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{27, 0}
 }
-func (m *Event) XXX_Unmarshal(b []byte) error {
+func (m *CommitResponse_Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitResponse_Result.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1932,98 +2103,104 @@ func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(m, src)
+func (m *CommitResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse_Result.Merge(m, src)
 }
-func (m *Event) XXX_Size() int {
+func (m *CommitResponse_Result) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event.DiscardUnknown(m)
+func (m *CommitResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse_Result.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event proto.InternalMessageInfo
+var xxx_messageInfo_CommitResponse_Result proto.InternalMessageInfo
 
-type isEvent_Event interface {
-	isEvent_Event()
+type isCommitResponse_Result_Result interface {
+	isCommitResponse_Result_Result()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type Event_Inserted_ struct {
-	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
+type CommitResponse_Result_Put struct {
+	Put *CommitResponse_Put `protobuf:"bytes,1,opt,name=put,proto3,oneof" json:"put,omitempty"`
 }
-type Event_Updated_ struct {
-	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
+type CommitResponse_Result_Insert struct {
+	Insert *CommitResponse_Insert `protobuf:"bytes,2,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
 }
-type Event_Removed_ struct {
-	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
+type CommitResponse_Result_Update struct {
+	Update *CommitResponse_Update `protobuf:"bytes,3,opt,name=update,proto3,oneof" json:"update,omitempty"`
+}
+type CommitResponse_Result_Remove struct {
+	Remove *CommitResponse_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
 }
 
-func (*Event_Inserted_) isEvent_Event() {}
-func (*Event_Updated_) isEvent_Event()  {}
-func (*Event_Removed_) isEvent_Event()  {}
+func (*CommitResponse_Result_Put) isCommitResponse_Result_Result()    {}
+func (*CommitResponse_Result_Insert) isCommitResponse_Result_Result() {}
+func (*CommitResponse_Result_Update) isCommitResponse_Result_Result() {}
+func (*CommitResponse_Result_Remove) isCommitResponse_Result_Result() {}
 
-func (m *Event) GetEvent() isEvent_Event {
+func (m *CommitResponse_Result) GetResult() isCommitResponse_Result_Result {
 	if m != nil {
-		return m.Event
+		return m.Result
 	}
 	return nil
 }
 
-func (m *Event) GetKey() string {
-	if m != nil {
-		return m.Key
+func (m *CommitResponse_Result) GetPut() *CommitResponse_Put {
+	if x, ok := m.GetResult().(*CommitResponse_Result_Put); ok {
+		return x.Put
 	}
-	return ""
+	return nil
 }
 
-func (m *Event) GetInserted() *Event_Inserted {
-	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
-		return x.Inserted
+func (m *CommitResponse_Result) GetInsert() *CommitResponse_Insert {
+	if x, ok := m.GetResult().(*CommitResponse_Result_Insert); ok {
+		return x.Insert
 	}
 	return nil
 }
 
-func (m *Event) GetUpdated() *Event_Updated {
-	if x, ok := m.GetEvent().(*Event_Updated_); ok {
-		return x.Updated
+func (m *CommitResponse_Result) GetUpdate() *CommitResponse_Update {
+	if x, ok := m.GetResult().(*CommitResponse_Result_Update); ok {
+		return x.Update
 	}
 	return nil
 }
 
-func (m *Event) GetRemoved() *Event_Removed {
-	if x, ok := m.GetEvent().(*Event_Removed_); ok {
-		return x.Removed
+func (m *CommitResponse_Result) GetRemove() *CommitResponse_Remove {
+	if x, ok := m.GetResult().(*CommitResponse_Result_Remove); ok {
+		return x.Remove
 	}
 	return nil
 }
 
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*Event) XXX_OneofWrappers() []interface{} {
+func (*CommitResponse_Result) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*Event_Inserted_)(nil),
-		(*Event_Updated_)(nil),
-		(*Event_Removed_)(nil),
+		(*CommitResponse_Result_Put)(nil),
+		(*CommitResponse_Result_Insert)(nil),
+		(*CommitResponse_Result_Update)(nil),
+		(*CommitResponse_Result_Remove)(nil),
 	}
 }
 
-type Event_Inserted struct {
-	Value VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+type CommitResponse_Put struct {
+	Version   uint64          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	PrevValue *VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
 
-func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
-func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
-func (*Event_Inserted) ProtoMessage()    {}
-func (*Event_Inserted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{24, 0}
+func (m *CommitResponse_Put) Reset()         { *m = CommitResponse_Put{} }
+func (m *CommitResponse_Put) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse_Put) ProtoMessage()    {}
+func (*CommitResponse_Put) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{27, 1}
 }
-func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+func (m *CommitResponse_Put) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitResponse_Put) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitResponse_Put.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2033,42 +2210,48 @@ func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *Event_Inserted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Inserted.Merge(m, src)
+func (m *CommitResponse_Put) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse_Put.Merge(m, src)
 }
-func (m *Event_Inserted) XXX_Size() int {
+func (m *CommitResponse_Put) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Inserted) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
+func (m *CommitResponse_Put) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse_Put.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
+var xxx_messageInfo_CommitResponse_Put proto.InternalMessageInfo
 
-func (m *Event_Inserted) GetValue() VersionedValue {
+func (m *CommitResponse_Put) GetVersion() uint64 {
 	if m != nil {
-		return m.Value
+		return m.Version
 	}
-	return VersionedValue{}
+	return 0
 }
 
-type Event_Updated struct {
-	Value     VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
-	PrevValue VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
+func (m *CommitResponse_Put) GetPrevValue() *VersionedValue {
+	if m != nil {
+		return m.PrevValue
+	}
+	return nil
 }
 
-func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
-func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
-func (*Event_Updated) ProtoMessage()    {}
-func (*Event_Updated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{24, 1}
+type CommitResponse_Insert struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
-func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+
+func (m *CommitResponse_Insert) Reset()         { *m = CommitResponse_Insert{} }
+func (m *CommitResponse_Insert) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse_Insert) ProtoMessage()    {}
+func (*CommitResponse_Insert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{27, 2}
+}
+func (m *CommitResponse_Insert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitResponse_Insert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitResponse_Insert.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2078,49 +2261,93 @@ func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Updated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Updated.Merge(m, src)
+func (m *CommitResponse_Insert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse_Insert.Merge(m, src)
 }
-func (m *Event_Updated) XXX_Size() int {
+func (m *CommitResponse_Insert) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Updated) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
+func (m *CommitResponse_Insert) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse_Insert.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
+var xxx_messageInfo_CommitResponse_Insert proto.InternalMessageInfo
 
-func (m *Event_Updated) GetValue() VersionedValue {
+func (m *CommitResponse_Insert) GetVersion() uint64 {
 	if m != nil {
-		return m.Value
+		return m.Version
 	}
-	return VersionedValue{}
+	return 0
 }
 
-func (m *Event_Updated) GetPrevValue() VersionedValue {
+type CommitResponse_Update struct {
+	Version   uint64         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	PrevValue VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
+}
+
+func (m *CommitResponse_Update) Reset()         { *m = CommitResponse_Update{} }
+func (m *CommitResponse_Update) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse_Update) ProtoMessage()    {}
+func (*CommitResponse_Update) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{27, 3}
+}
+func (m *CommitResponse_Update) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitResponse_Update) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitResponse_Update.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitResponse_Update) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse_Update.Merge(m, src)
+}
+func (m *CommitResponse_Update) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitResponse_Update) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse_Update.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitResponse_Update proto.InternalMessageInfo
+
+func (m *CommitResponse_Update) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CommitResponse_Update) GetPrevValue() VersionedValue {
 	if m != nil {
 		return m.PrevValue
 	}
 	return VersionedValue{}
 }
 
-type Event_Removed struct {
-	Value   VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
-	Expired bool           `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+type CommitResponse_Remove struct {
+	Value VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
 }
 
-func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
-func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
-func (*Event_Removed) ProtoMessage()    {}
-func (*Event_Removed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{24, 2}
+func (m *CommitResponse_Remove) Reset()         { *m = CommitResponse_Remove{} }
+func (m *CommitResponse_Remove) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse_Remove) ProtoMessage()    {}
+func (*CommitResponse_Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{27, 4}
 }
-func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+func (m *CommitResponse_Remove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitResponse_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitResponse_Remove.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2130,49 +2357,157 @@ func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Removed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Removed.Merge(m, src)
+func (m *CommitResponse_Remove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse_Remove.Merge(m, src)
 }
-func (m *Event_Removed) XXX_Size() int {
+func (m *CommitResponse_Remove) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Removed) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
+func (m *CommitResponse_Remove) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse_Remove.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
+var xxx_messageInfo_CommitResponse_Remove proto.InternalMessageInfo
 
-func (m *Event_Removed) GetValue() VersionedValue {
+func (m *CommitResponse_Remove) GetValue() VersionedValue {
 	if m != nil {
 		return m.Value
 	}
 	return VersionedValue{}
 }
 
-func (m *Event_Removed) GetExpired() bool {
+type QueryRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// index is the name of the secondary index to query
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Values of json_path indexes are queried with JSON values, e.g. "pending" or 42; all other indexes are
+	// queried with raw bytes. All indexed entries are returned when no query is set.
+	//
+	// Types that are valid to be assigned to Query:
+	//	*QueryRequest_Equals
+	//	*QueryRequest_Range
+	Query isQueryRequest_Query `protobuf_oneof:"query"`
+	// limit is the maximum number of entries to return; all matching entries are returned when unset
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{28}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+type isQueryRequest_Query interface {
+	isQueryRequest_Query()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type QueryRequest_Equals struct {
+	Equals []byte `protobuf:"bytes,3,opt,name=equals,proto3,oneof" json:"equals,omitempty"`
+}
+type QueryRequest_Range struct {
+	Range *Range `protobuf:"bytes,4,opt,name=range,proto3,oneof" json:"range,omitempty"`
+}
+
+func (*QueryRequest_Equals) isQueryRequest_Query() {}
+func (*QueryRequest_Range) isQueryRequest_Query()  {}
+
+func (m *QueryRequest) GetQuery() isQueryRequest_Query {
 	if m != nil {
-		return m.Expired
+		return m.Query
 	}
-	return false
+	return nil
 }
 
-type Entry struct {
-	Key   string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value *VersionedValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *QueryRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
 }
 
-func (m *Entry) Reset()         { *m = Entry{} }
-func (m *Entry) String() string { return proto.CompactTextString(m) }
-func (*Entry) ProtoMessage()    {}
-func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{25}
+func (m *QueryRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
-func (m *Entry) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryRequest) GetEquals() []byte {
+	if x, ok := m.GetQuery().(*QueryRequest_Equals); ok {
+		return x.Equals
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetRange() *Range {
+	if x, ok := m.GetQuery().(*QueryRequest_Range); ok {
+		return x.Range
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QueryRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QueryRequest_Equals)(nil),
+		(*QueryRequest_Range)(nil),
+	}
+}
+
+// QueryResponse returns the matching entries in ascending order of indexed value
+// JSON values are ordered null, false, true, numbers and then strings. Entries with equal indexed values are ordered by key.
+type QueryResponse struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
+func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResponse) ProtoMessage()    {}
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{29}
+}
+func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2182,49 +2517,43 @@ func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Entry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Entry.Merge(m, src)
+func (m *QueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResponse.Merge(m, src)
 }
-func (m *Entry) XXX_Size() int {
+func (m *QueryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Entry) XXX_DiscardUnknown() {
-	xxx_messageInfo_Entry.DiscardUnknown(m)
+func (m *QueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Entry proto.InternalMessageInfo
-
-func (m *Entry) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
-}
+var xxx_messageInfo_QueryResponse proto.InternalMessageInfo
 
-func (m *Entry) GetValue() *VersionedValue {
+func (m *QueryResponse) GetEntries() []Entry {
 	if m != nil {
-		return m.Value
+		return m.Entries
 	}
 	return nil
 }
 
-type VersionedValue struct {
-	Value   []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+// Range is an inclusive range of indexed values; the range is unbounded in the direction of an unset bound
+type Range struct {
+	Min *Bound `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max *Bound `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
 }
 
-func (m *VersionedValue) Reset()         { *m = VersionedValue{} }
-func (m *VersionedValue) String() string { return proto.CompactTextString(m) }
-func (*VersionedValue) ProtoMessage()    {}
-func (*VersionedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7498de5246e2fda8, []int{26}
+func (m *Range) Reset()         { *m = Range{} }
+func (m *Range) String() string { return proto.CompactTextString(m) }
+func (*Range) ProtoMessage()    {}
+func (*Range) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{30}
 }
-func (m *VersionedValue) XXX_Unmarshal(b []byte) error {
+func (m *Range) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VersionedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Range) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VersionedValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Range.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2234,1736 +2563,1733 @@ func (m *VersionedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *VersionedValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VersionedValue.Merge(m, src)
+func (m *Range) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Range.Merge(m, src)
 }
-func (m *VersionedValue) XXX_Size() int {
+func (m *Range) XXX_Size() int {
 	return m.Size()
 }
-func (m *VersionedValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_VersionedValue.DiscardUnknown(m)
+func (m *Range) XXX_DiscardUnknown() {
+	xxx_messageInfo_Range.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VersionedValue proto.InternalMessageInfo
+var xxx_messageInfo_Range proto.InternalMessageInfo
 
-func (m *VersionedValue) GetValue() []byte {
+func (m *Range) GetMin() *Bound {
 	if m != nil {
-		return m.Value
+		return m.Min
 	}
 	return nil
 }
 
-func (m *VersionedValue) GetVersion() uint64 {
+func (m *Range) GetMax() *Bound {
 	if m != nil {
-		return m.Version
+		return m.Max
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*SizeRequest)(nil), "atomix.runtime.map.v1.SizeRequest")
-	proto.RegisterType((*SizeResponse)(nil), "atomix.runtime.map.v1.SizeResponse")
-	proto.RegisterType((*PutRequest)(nil), "atomix.runtime.map.v1.PutRequest")
-	proto.RegisterType((*PutResponse)(nil), "atomix.runtime.map.v1.PutResponse")
-	proto.RegisterType((*InsertRequest)(nil), "atomix.runtime.map.v1.InsertRequest")
-	proto.RegisterType((*InsertResponse)(nil), "atomix.runtime.map.v1.InsertResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "atomix.runtime.map.v1.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "atomix.runtime.map.v1.UpdateResponse")
-	proto.RegisterType((*GetRequest)(nil), "atomix.runtime.map.v1.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "atomix.runtime.map.v1.GetResponse")
-	proto.RegisterType((*RemoveRequest)(nil), "atomix.runtime.map.v1.RemoveRequest")
-	proto.RegisterType((*RemoveResponse)(nil), "atomix.runtime.map.v1.RemoveResponse")
-	proto.RegisterType((*ClearRequest)(nil), "atomix.runtime.map.v1.ClearRequest")
-	proto.RegisterType((*ClearResponse)(nil), "atomix.runtime.map.v1.ClearResponse")
-	proto.RegisterType((*LockRequest)(nil), "atomix.runtime.map.v1.LockRequest")
-	proto.RegisterType((*LockResponse)(nil), "atomix.runtime.map.v1.LockResponse")
-	proto.RegisterType((*UnlockRequest)(nil), "atomix.runtime.map.v1.UnlockRequest")
-	proto.RegisterType((*UnlockResponse)(nil), "atomix.runtime.map.v1.UnlockResponse")
-	proto.RegisterType((*EntriesRequest)(nil), "atomix.runtime.map.v1.EntriesRequest")
-	proto.RegisterType((*EntriesResponse)(nil), "atomix.runtime.map.v1.EntriesResponse")
-	proto.RegisterType((*CommitRequest)(nil), "atomix.runtime.map.v1.CommitRequest")
-	proto.RegisterType((*CommitRequest_Operation)(nil), "atomix.runtime.map.v1.CommitRequest.Operation")
-	proto.RegisterType((*CommitRequest_Put)(nil), "atomix.runtime.map.v1.CommitRequest.Put")
-	proto.RegisterType((*CommitRequest_Insert)(nil), "atomix.runtime.map.v1.CommitRequest.Insert")
-	proto.RegisterType((*CommitRequest_Update)(nil), "atomix.runtime.map.v1.CommitRequest.Update")
-	proto.RegisterType((*CommitRequest_Remove)(nil), "atomix.runtime.map.v1.CommitRequest.Remove")
-	proto.RegisterType((*CommitResponse)(nil), "atomix.runtime.map.v1.CommitResponse")
-	proto.RegisterType((*CommitResponse_Result)(nil), "atomix.runtime.map.v1.CommitResponse.Result")
-	proto.RegisterType((*CommitResponse_Put)(nil), "atomix.runtime.map.v1.CommitResponse.Put")
-	proto.RegisterType((*CommitResponse_Insert)(nil), "atomix.runtime.map.v1.CommitResponse.Insert")
-	proto.RegisterType((*CommitResponse_Update)(nil), "atomix.runtime.map.v1.CommitResponse.Update")
-	proto.RegisterType((*CommitResponse_Remove)(nil), "atomix.runtime.map.v1.CommitResponse.Remove")
-	proto.RegisterType((*EventsRequest)(nil), "atomix.runtime.map.v1.EventsRequest")
-	proto.RegisterType((*EventsResponse)(nil), "atomix.runtime.map.v1.EventsResponse")
-	proto.RegisterType((*Event)(nil), "atomix.runtime.map.v1.Event")
-	proto.RegisterType((*Event_Inserted)(nil), "atomix.runtime.map.v1.Event.Inserted")
-	proto.RegisterType((*Event_Updated)(nil), "atomix.runtime.map.v1.Event.Updated")
-	proto.RegisterType((*Event_Removed)(nil), "atomix.runtime.map.v1.Event.Removed")
-	proto.RegisterType((*Entry)(nil), "atomix.runtime.map.v1.Entry")
-	proto.RegisterType((*VersionedValue)(nil), "atomix.runtime.map.v1.VersionedValue")
+type Bound struct {
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func init() { proto.RegisterFile("runtime/map/v1/map.proto", fileDescriptor_7498de5246e2fda8) }
-
-var fileDescriptor_7498de5246e2fda8 = []byte{
-	// 1323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x6f, 0x1b, 0xd5,
-	0x17, 0xf7, 0x9d, 0xf1, 0x23, 0x3e, 0x8e, 0xdd, 0xea, 0x2a, 0x7f, 0xc9, 0x1d, 0xfd, 0xe5, 0xa4,
-	0x43, 0x8b, 0xcc, 0x43, 0x63, 0x52, 0x16, 0x94, 0x47, 0xa5, 0xe2, 0x3c, 0x9a, 0xa4, 0x89, 0x62,
-	0x4d, 0x1e, 0x95, 0x22, 0xa1, 0xca, 0xa9, 0x6f, 0xc3, 0x10, 0xdb, 0x33, 0xcc, 0x8b, 0x26, 0x2b,
-	0x96, 0x08, 0x09, 0x89, 0x1d, 0xf9, 0x0a, 0x08, 0xb1, 0x63, 0xcb, 0xbe, 0x1b, 0xa4, 0x88, 0x15,
-	0xab, 0x80, 0x92, 0x2f, 0x82, 0xee, 0x63, 0x26, 0xe3, 0xc7, 0xd8, 0x06, 0xc6, 0x08, 0x58, 0xf9,
-	0xce, 0xcc, 0x39, 0xbf, 0x7b, 0xee, 0x39, 0xbf, 0x73, 0xce, 0x3d, 0x86, 0xb2, 0xed, 0x75, 0x5d,
-	0xa3, 0x43, 0x6a, 0x9d, 0xa6, 0x55, 0xf3, 0x17, 0xe9, 0x8f, 0x66, 0xd9, 0xa6, 0x6b, 0xe2, 0xff,
-	0x35, 0x5d, 0xb3, 0x63, 0xbc, 0xd0, 0x84, 0x80, 0x46, 0xbf, 0xf8, 0x8b, 0x4a, 0xe5, 0xc8, 0x34,
-	0x8f, 0xda, 0xa4, 0xc6, 0x84, 0x0e, 0xbd, 0xe7, 0xb5, 0x96, 0x67, 0x37, 0x5d, 0xc3, 0xec, 0x72,
-	0x35, 0x25, 0x04, 0xf4, 0x17, 0x6b, 0x81, 0x2a, 0xff, 0x72, 0x6b, 0x70, 0x2b, 0x47, 0x7c, 0x9a,
-	0x3b, 0x32, 0x8f, 0x4c, 0xb6, 0xac, 0xd1, 0x15, 0x7f, 0xab, 0xae, 0x43, 0x61, 0xc7, 0x38, 0x25,
-	0x3a, 0xf9, 0xd4, 0x23, 0x8e, 0x8b, 0xdf, 0x03, 0xc9, 0x68, 0x95, 0xd1, 0x02, 0xaa, 0x16, 0xee,
-	0x55, 0xb4, 0x3e, 0xeb, 0xfc, 0x45, 0xad, 0x61, 0x1b, 0x1d, 0xc3, 0x35, 0x7c, 0xb2, 0xbe, 0x5c,
-	0x87, 0x97, 0x17, 0xf3, 0xa9, 0xcb, 0x8b, 0x79, 0x69, 0x7d, 0x59, 0x97, 0x8c, 0x96, 0xaa, 0xc2,
-	0x2c, 0x87, 0x72, 0x2c, 0xb3, 0xeb, 0x10, 0x8c, 0x21, 0xed, 0x18, 0xa7, 0x84, 0xa1, 0x15, 0x75,
-	0xb6, 0x56, 0x7f, 0x42, 0x00, 0x0d, 0xcf, 0x4d, 0x60, 0x3b, 0x7c, 0x13, 0xe4, 0x63, 0x72, 0x52,
-	0x96, 0x16, 0x50, 0x35, 0xaf, 0xd3, 0x25, 0x9e, 0x83, 0x8c, 0xdf, 0x6c, 0x7b, 0xa4, 0x2c, 0x2f,
-	0xa0, 0xea, 0xac, 0xce, 0x1f, 0xf0, 0x7d, 0x90, 0x5d, 0xb7, 0x5d, 0x4e, 0xb3, 0x4d, 0x6e, 0x69,
-	0xdc, 0xb5, 0x5a, 0xe0, 0x5a, 0x6d, 0x59, 0xb8, 0xb6, 0x5e, 0xb8, 0xbc, 0x98, 0x97, 0x77, 0x77,
-	0x37, 0xcf, 0x7e, 0x9d, 0x47, 0x3a, 0x55, 0xc1, 0xb7, 0x61, 0xd6, 0xb2, 0x89, 0xff, 0xd4, 0x27,
-	0xb6, 0x63, 0x98, 0xdd, 0x72, 0x66, 0x01, 0x55, 0xd3, 0x7a, 0x81, 0xbe, 0xdb, 0xe7, 0xaf, 0xd4,
-	0x0e, 0x14, 0xd8, 0x71, 0xc4, 0x91, 0xcb, 0x90, 0x0b, 0x84, 0x11, 0x13, 0x0e, 0x1e, 0xf1, 0x32,
-	0x00, 0xc7, 0x62, 0x06, 0x4a, 0xcc, 0x98, 0xbb, 0xda, 0xd0, 0xf0, 0x6b, 0x02, 0x9c, 0xb4, 0xf6,
-	0xa9, 0xb0, 0x9e, 0x67, 0x1b, 0xd2, 0xa5, 0xfa, 0x3d, 0x82, 0xe2, 0x7a, 0xd7, 0x21, 0xf6, 0xbf,
-	0xc3, 0x83, 0xea, 0xeb, 0x50, 0x0a, 0xcc, 0x1d, 0xe7, 0x21, 0xf5, 0x1c, 0x41, 0x71, 0xcf, 0x6a,
-	0x35, 0x5d, 0xf2, 0x9f, 0x61, 0x87, 0x0f, 0xa5, 0xe0, 0x44, 0x63, 0x09, 0xb2, 0xf1, 0xa7, 0x09,
-	0x52, 0x4f, 0xd3, 0xb3, 0x47, 0x69, 0x72, 0x00, 0xf0, 0x88, 0x4c, 0x87, 0x22, 0x6a, 0x03, 0x0a,
-	0x0c, 0x5b, 0x1c, 0xe8, 0xc3, 0xc0, 0xab, 0xe8, 0x8f, 0x5b, 0xcc, 0x35, 0xd5, 0xcf, 0x11, 0x14,
-	0x75, 0xd2, 0x31, 0xfd, 0x29, 0x05, 0xbe, 0x3f, 0x50, 0xf2, 0x60, 0xa0, 0x76, 0xa0, 0x14, 0x58,
-	0x90, 0xdc, 0xb9, 0x36, 0x60, 0x76, 0xa9, 0x4d, 0x9a, 0x76, 0x12, 0xb5, 0xf5, 0x06, 0x14, 0x05,
-	0x16, 0xb7, 0x4f, 0x3d, 0x43, 0x50, 0xd8, 0x34, 0x9f, 0x1d, 0x27, 0xe1, 0x32, 0x0c, 0xe9, 0x63,
-	0x72, 0xe2, 0x94, 0xa5, 0x05, 0xb9, 0x9a, 0xd7, 0xd9, 0x1a, 0xbf, 0x0b, 0x39, 0xaa, 0x6a, 0x7a,
-	0x2e, 0xf3, 0xd7, 0xc8, 0xdc, 0x48, 0xb3, 0xa4, 0x08, 0xe4, 0xd5, 0x12, 0xcc, 0x72, 0xcb, 0x84,
-	0xa9, 0x4f, 0xa1, 0xb8, 0xd7, 0x6d, 0x4f, 0xcf, 0x56, 0xf5, 0x26, 0x94, 0x82, 0x0d, 0xc4, 0x96,
-	0x87, 0x50, 0x5a, 0xe9, 0xba, 0xb6, 0x41, 0x9c, 0x24, 0xf6, 0x9c, 0x83, 0xcc, 0x67, 0x4d, 0xf7,
-	0xd9, 0xc7, 0x8c, 0x54, 0x33, 0x3a, 0x7f, 0x50, 0x1f, 0xc3, 0x8d, 0x70, 0x0f, 0x41, 0x9a, 0xfb,
-	0x90, 0x21, 0x5d, 0xd7, 0x3e, 0x11, 0xfb, 0xfc, 0x3f, 0x86, 0x34, 0x54, 0xed, 0x24, 0xe0, 0x0a,
-	0x53, 0x50, 0xbf, 0xc9, 0x41, 0x71, 0xc9, 0xec, 0x74, 0x8c, 0x44, 0xb2, 0x76, 0x17, 0xc0, 0xb4,
-	0x08, 0x8f, 0x0e, 0x77, 0x55, 0xe1, 0x9e, 0x16, 0x63, 0x4c, 0xcf, 0xae, 0xda, 0x76, 0xa0, 0x26,
-	0xcc, 0x8b, 0xe0, 0x28, 0xdf, 0x49, 0x90, 0x0f, 0xbf, 0xe3, 0x0f, 0x40, 0xb6, 0x3c, 0x57, 0x18,
-	0x58, 0x9d, 0x08, 0xbc, 0xe1, 0xb9, 0x6b, 0x29, 0x9d, 0xaa, 0xe1, 0x15, 0xc8, 0x1a, 0xac, 0x31,
-	0x88, 0x4a, 0xf7, 0xc6, 0x44, 0x00, 0xbc, 0x97, 0xac, 0xa5, 0x74, 0xa1, 0x4c, 0x61, 0x3c, 0x56,
-	0x60, 0x05, 0x49, 0x27, 0x83, 0xe1, 0x35, 0x99, 0xc2, 0x70, 0x65, 0x0a, 0x63, 0xb3, 0xf4, 0x17,
-	0x7d, 0x60, 0x32, 0x18, 0x5e, 0x31, 0x28, 0x0c, 0x57, 0xae, 0x17, 0x20, 0x1f, 0xba, 0x4b, 0xf9,
-	0x12, 0x81, 0xdc, 0xf0, 0xdc, 0xa0, 0x1e, 0xa1, 0x21, 0x8d, 0x48, 0x1a, 0xd2, 0x88, 0xe4, 0xbf,
-	0xde, 0x88, 0xd2, 0x03, 0xf5, 0x4d, 0xf9, 0x04, 0xb2, 0xdc, 0x77, 0xd3, 0x37, 0x47, 0xf9, 0x0a,
-	0x41, 0x96, 0x7b, 0xf8, 0x9f, 0x71, 0xf6, 0x07, 0x90, 0xe5, 0x91, 0x1a, 0x62, 0x4e, 0xbf, 0xba,
-	0x34, 0xd8, 0x1a, 0x7e, 0xce, 0x40, 0x29, 0x88, 0xbb, 0x48, 0xf3, 0x4d, 0xc8, 0xd9, 0xc4, 0xf1,
-	0xda, 0xae, 0x53, 0x46, 0x2c, 0xb7, 0xde, 0x1c, 0xc3, 0x17, 0xae, 0xa7, 0xe9, 0x4c, 0x49, 0x64,
-	0x56, 0x00, 0xa1, 0x7c, 0x2b, 0x51, 0x03, 0xe9, 0x1a, 0x3f, 0x88, 0xe6, 0xd4, 0x6b, 0x93, 0x81,
-	0x46, 0x92, 0x6a, 0xb5, 0x2f, 0xa9, 0x26, 0x34, 0x6b, 0x20, 0xab, 0x56, 0xfb, 0xb2, 0x6a, 0x42,
-	0x9c, 0x81, 0xb4, 0x5a, 0xed, 0x4b, 0xab, 0x89, 0xdd, 0xd4, 0x97, 0x57, 0x33, 0x14, 0x87, 0x3a,
-	0x48, 0x21, 0x3c, 0xa7, 0xa6, 0x7c, 0xcd, 0x56, 0xd4, 0x30, 0x5d, 0x62, 0x77, 0x52, 0xba, 0x21,
-	0xcb, 0xff, 0x96, 0x3b, 0x9d, 0xf2, 0x38, 0xa4, 0x71, 0x02, 0x57, 0x93, 0x8f, 0xa0, 0xb8, 0xe2,
-	0x93, 0xae, 0xeb, 0x4c, 0xe7, 0x8e, 0xb8, 0x01, 0xa5, 0x00, 0x3e, 0xd2, 0x19, 0xe9, 0x1b, 0xe1,
-	0x84, 0xd8, 0xce, 0x48, 0x65, 0xc2, 0xce, 0x48, 0x1f, 0xd4, 0x1f, 0xd3, 0x90, 0x61, 0xaf, 0x87,
-	0xa4, 0xef, 0x12, 0xcc, 0x70, 0xca, 0x92, 0xd6, 0x18, 0xef, 0x32, 0x04, 0xc1, 0x74, 0xd2, 0x5a,
-	0x4b, 0xe9, 0xa1, 0x22, 0x7e, 0x08, 0x39, 0xce, 0xd7, 0x96, 0xa0, 0xfb, 0x9d, 0x91, 0x18, 0x3c,
-	0xe8, 0x14, 0x22, 0x50, 0xa3, 0x08, 0x9c, 0xa9, 0x2d, 0x41, 0xf4, 0xd1, 0x08, 0x3c, 0x8c, 0x0c,
-	0x41, 0xa8, 0x29, 0x5b, 0x30, 0x13, 0xd8, 0x96, 0x40, 0x78, 0x95, 0x33, 0x04, 0x39, 0x61, 0x67,
-	0x02, 0x70, 0x89, 0xd2, 0xf8, 0x39, 0xe4, 0xc4, 0xf9, 0x93, 0xb0, 0xac, 0x0c, 0x39, 0xf2, 0xc2,
-	0x32, 0x6c, 0x11, 0xff, 0x19, 0x3d, 0x78, 0xac, 0xe7, 0x04, 0xe1, 0xd4, 0x7d, 0xc8, 0xb0, 0xfb,
-	0xd6, 0x10, 0xfa, 0xbc, 0x1f, 0x6d, 0x46, 0x13, 0xd7, 0x09, 0x91, 0x42, 0x0f, 0xa1, 0xd4, 0xfb,
-	0xe1, 0xba, 0xb7, 0xa1, 0x68, 0x6f, 0x8b, 0x54, 0x07, 0xa9, 0xa7, 0x3a, 0xdc, 0xfb, 0x21, 0x0f,
-	0xf2, 0x56, 0xd3, 0xc2, 0xdb, 0x90, 0xde, 0x31, 0x4e, 0x09, 0x56, 0x63, 0xf6, 0x8f, 0xfc, 0x3f,
-	0xa3, 0xbc, 0x32, 0x52, 0x26, 0xec, 0x4f, 0xac, 0x4a, 0xde, 0x8e, 0x91, 0xbd, 0xfe, 0xff, 0x45,
-	0x51, 0x47, 0x89, 0x08, 0xb4, 0xbd, 0xb0, 0x18, 0xc6, 0xd1, 0xba, 0xe7, 0x1f, 0x09, 0xe5, 0xee,
-	0x18, 0xa9, 0x6b, 0x58, 0x51, 0x3f, 0xe3, 0x60, 0x7b, 0xfe, 0x0c, 0x88, 0x85, 0xed, 0x1b, 0xb0,
-	0x37, 0x41, 0x7e, 0x44, 0xe2, 0xcf, 0x7e, 0x3d, 0x16, 0xc7, 0x9e, 0x3d, 0x3a, 0xdd, 0xee, 0x85,
-	0x45, 0x37, 0xce, 0xc8, 0x9e, 0xc1, 0x35, 0xd6, 0xc8, 0xbe, 0xe1, 0x52, 0x87, 0x0c, 0x9b, 0xe6,
-	0x70, 0x5c, 0x38, 0xa3, 0x73, 0xa3, 0x72, 0x67, 0xb4, 0x90, 0xc0, 0xdc, 0x86, 0x34, 0x9d, 0xba,
-	0x62, 0x59, 0x14, 0x19, 0x16, 0x63, 0x59, 0x14, 0x1d, 0xdb, 0x58, 0x80, 0xd8, 0x54, 0x15, 0x1f,
-	0xa0, 0xe8, 0x54, 0x17, 0x1f, 0xa0, 0x9e, 0xd1, 0x0c, 0x3f, 0x81, 0x2c, 0xef, 0x0d, 0x78, 0x64,
-	0x95, 0x74, 0xc6, 0xc1, 0xf6, 0x36, 0x98, 0xb7, 0x10, 0x3e, 0x80, 0x9c, 0x98, 0xc7, 0xf0, 0xdd,
-	0x11, 0x83, 0xd7, 0xf5, 0x4c, 0xa8, 0xbc, 0x3a, 0x4e, 0x2c, 0xc4, 0xde, 0x83, 0x2c, 0xbf, 0xa4,
-	0xc4, 0x1a, 0xdd, 0x33, 0x1a, 0xc4, 0x1a, 0xdd, 0x77, 0x91, 0x3c, 0x80, 0xec, 0x92, 0x4d, 0x46,
-	0xe5, 0x00, 0xff, 0x3c, 0x16, 0x56, 0x48, 0x89, 0xe9, 0x57, 0xfe, 0x42, 0x42, 0xf8, 0x09, 0xe5,
-	0x98, 0xe9, 0x90, 0x11, 0x1c, 0x33, 0x1d, 0x32, 0x9e, 0x63, 0x4c, 0x28, 0x02, 0x5c, 0x7f, 0xe7,
-	0xe5, 0x65, 0x05, 0x9d, 0x5f, 0x56, 0xd0, 0x6f, 0x97, 0x15, 0xf4, 0xf5, 0x55, 0x25, 0x75, 0x7e,
-	0x55, 0x49, 0xfd, 0x72, 0x55, 0x49, 0xc1, 0x9c, 0x61, 0x06, 0x30, 0x4d, 0xcb, 0x10, 0x10, 0xf5,
-	0xcc, 0x56, 0xd3, 0xda, 0x5f, 0x6c, 0xa0, 0xc3, 0x2c, 0xbb, 0xd0, 0xbf, 0xfd, 0x7b, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x24, 0xb5, 0x74, 0xb9, 0x0f, 0x17, 0x00, 0x00,
+func (m *Bound) Reset()         { *m = Bound{} }
+func (m *Bound) String() string { return proto.CompactTextString(m) }
+func (*Bound) ProtoMessage()    {}
+func (*Bound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{31}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MapClient is the client API for Map service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MapClient interface {
-	// Size returns the size of the map
-	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
-	// Put puts an entry into the map
-	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
-	// Insert inserts an entry into the map
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Get gets the entry for a key
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error)
-	// Entries lists all entries in the map
-	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error)
-	// Commit commits a transactional change to the map
-	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
-	// Create creates the Map
-	// Deprecated: use the Maps service instead
-	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
-	// Close closes the Map
-	// Deprecated: use the Maps service instead
-	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+func (m *Bound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type mapClient struct {
-	cc *grpc.ClientConn
+func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMapClient(cc *grpc.ClientConn) MapClient {
-	return &mapClient{cc}
+func (m *Bound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bound.Merge(m, src)
 }
-
-func (c *mapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
-	out := new(SizeResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Size", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Bound) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bound.DiscardUnknown(m)
 }
 
-func (c *mapClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
-	out := new(PutResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Bound proto.InternalMessageInfo
+
+func (m *Bound) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return nil
 }
 
-func (c *mapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
-	out := new(InsertResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Insert", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EventsRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// All filters must match for an event to be delivered; unset filters match all events
+	// key limits events to a single key
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// prefix limits events to keys beginning with the prefix
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// pattern limits events to keys matching the glob pattern
+	// Patterns use the syntax of Go's path.Match, so '*' does not match '/'
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// keys limits events to the given set of keys
+	Keys []string `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	// types limits events to the given event types
+	Types []EventsRequest_EventType `protobuf:"varint,6,rep,packed,name=types,proto3,enum=atomix.runtime.map.v1.EventsRequest_EventType" json:"types,omitempty"`
 }
 
-func (c *mapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{32}
+}
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
 }
 
-func (c *mapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+func (m *EventsRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
 	}
-	return out, nil
+	return v1.PrimitiveID{}
 }
 
-func (c *mapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
-	out := new(RemoveResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return out, nil
+	return ""
 }
 
-func (c *mapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
-	out := new(ClearResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Clear", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return out, nil
+	return ""
 }
 
-func (c *mapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
-	out := new(LockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Lock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	return out, nil
+	return ""
 }
 
-func (c *mapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
-	out := new(UnlockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Unlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EventsRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *mapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[0], "/atomix.runtime.map.v1.Map/Events", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &mapEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *EventsRequest) GetTypes() []EventsRequest_EventType {
+	if m != nil {
+		return m.Types
 	}
-	return x, nil
+	return nil
 }
 
-type Map_EventsClient interface {
-	Recv() (*EventsResponse, error)
-	grpc.ClientStream
+type EventsResponse struct {
+	Event Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event"`
 }
 
-type mapEventsClient struct {
-	grpc.ClientStream
+func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
+func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()    {}
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{33}
 }
-
-func (x *mapEventsClient) Recv() (*EventsResponse, error) {
-	m := new(EventsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *mapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[1], "/atomix.runtime.map.v1.Map/Entries", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &mapEntriesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type Map_EntriesClient interface {
-	Recv() (*EntriesResponse, error)
-	grpc.ClientStream
+func (m *EventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsResponse.Merge(m, src)
 }
-
-type mapEntriesClient struct {
-	grpc.ClientStream
+func (m *EventsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *mapEntriesClient) Recv() (*EntriesResponse, error) {
-	m := new(EntriesResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *EventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
 }
 
-func (c *mapClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
-	out := new(CommitResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Commit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+
+func (m *EventsResponse) GetEvent() Event {
+	if m != nil {
+		return m.Event
 	}
-	return out, nil
+	return Event{}
 }
 
-// Deprecated: Do not use.
-func (c *mapClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
-	out := new(CreateResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// Deprecated: Do not use.
-func (c *mapClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
-	out := new(CloseResponse)
-	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Close", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// MapServer is the server API for Map service.
-type MapServer interface {
-	// Size returns the size of the map
-	Size(context.Context, *SizeRequest) (*SizeResponse, error)
-	// Put puts an entry into the map
-	Put(context.Context, *PutRequest) (*PutResponse, error)
-	// Insert inserts an entry into the map
-	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Get gets the entry for a key
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(context.Context, *LockRequest) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(*EventsRequest, Map_EventsServer) error
-	// Entries lists all entries in the map
-	Entries(*EntriesRequest, Map_EntriesServer) error
-	// Commit commits a transactional change to the map
-	Commit(context.Context, *CommitRequest) (*CommitResponse, error)
-	// Create creates the Map
-	// Deprecated: use the Maps service instead
-	Create(context.Context, *CreateRequest) (*CreateResponse, error)
-	// Close closes the Map
-	// Deprecated: use the Maps service instead
-	Close(context.Context, *CloseRequest) (*CloseResponse, error)
-}
-
-// UnimplementedMapServer can be embedded to have forward compatible implementations.
-type UnimplementedMapServer struct {
+type Event struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*Event_Inserted_
+	//	*Event_Updated_
+	//	*Event_Removed_
+	Event isEvent_Event `protobuf_oneof:"event"`
+	// patch is the patch applied to produce an inserted or updated value; unset if the value was replaced
+	Patch *Patch `protobuf:"bytes,5,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
-func (*UnimplementedMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
-}
-func (*UnimplementedMapServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
-}
-func (*UnimplementedMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
-}
-func (*UnimplementedMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
-}
-func (*UnimplementedMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{34}
 }
-func (*UnimplementedMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
 }
-func (*UnimplementedMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+func (m *Event) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMapServer) Events(req *EventsRequest, srv Map_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
-func (*UnimplementedMapServer) Entries(req *EntriesRequest, srv Map_EntriesServer) error {
-	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+type isEvent_Event interface {
+	isEvent_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
-func (*UnimplementedMapServer) Commit(ctx context.Context, req *CommitRequest) (*CommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+
+type Event_Inserted_ struct {
+	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
 }
-func (*UnimplementedMapServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+type Event_Updated_ struct {
+	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
 }
-func (*UnimplementedMapServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+type Event_Removed_ struct {
+	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
 }
 
-func RegisterMapServer(s *grpc.Server, srv MapServer) {
-	s.RegisterService(&_Map_serviceDesc, srv)
-}
+func (*Event_Inserted_) isEvent_Event() {}
+func (*Event_Updated_) isEvent_Event()  {}
+func (*Event_Removed_) isEvent_Event()  {}
 
-func _Map_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Size(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Size",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Size(ctx, req.(*SizeRequest))
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Put(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Put",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Put(ctx, req.(*PutRequest))
+func (m *Event) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Map_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Insert(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Insert",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Insert(ctx, req.(*InsertRequest))
+func (m *Event) GetInserted() *Event_Inserted {
+	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
+		return x.Inserted
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Update(ctx, req.(*UpdateRequest))
+func (m *Event) GetUpdated() *Event_Updated {
+	if x, ok := m.GetEvent().(*Event_Updated_); ok {
+		return x.Updated
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Get(ctx, req.(*GetRequest))
+func (m *Event) GetRemoved() *Event_Removed {
+	if x, ok := m.GetEvent().(*Event_Removed_); ok {
+		return x.Removed
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Remove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Remove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Remove(ctx, req.(*RemoveRequest))
+func (m *Event) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_Inserted_)(nil),
+		(*Event_Updated_)(nil),
+		(*Event_Removed_)(nil),
 	}
-	if interceptor == nil {
-		return srv.(MapServer).Clear(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Clear",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Clear(ctx, req.(*ClearRequest))
-	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Map_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Lock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Lock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Lock(ctx, req.(*LockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Event_Inserted struct {
+	Value VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
 }
 
-func _Map_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Unlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Unlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Unlock(ctx, req.(*UnlockRequest))
+func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
+func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
+func (*Event_Inserted) ProtoMessage()    {}
+func (*Event_Inserted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{34, 0}
+}
+func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Event_Inserted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Inserted.Merge(m, src)
+}
+func (m *Event_Inserted) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Inserted) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
 }
 
-func _Map_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
+
+func (m *Event_Inserted) GetValue() VersionedValue {
+	if m != nil {
+		return m.Value
 	}
-	return srv.(MapServer).Events(m, &mapEventsServer{stream})
+	return VersionedValue{}
 }
 
-type Map_EventsServer interface {
-	Send(*EventsResponse) error
-	grpc.ServerStream
+type Event_Updated struct {
+	Value     VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+	PrevValue VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
 }
 
-type mapEventsServer struct {
-	grpc.ServerStream
+func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
+func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
+func (*Event_Updated) ProtoMessage()    {}
+func (*Event_Updated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{34, 1}
 }
-
-func (x *mapEventsServer) Send(m *EventsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Map_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EntriesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(MapServer).Entries(m, &mapEntriesServer{stream})
+}
+func (m *Event_Updated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Updated.Merge(m, src)
+}
+func (m *Event_Updated) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Updated) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
 }
 
-type Map_EntriesServer interface {
-	Send(*EntriesResponse) error
-	grpc.ServerStream
+var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
+
+func (m *Event_Updated) GetValue() VersionedValue {
+	if m != nil {
+		return m.Value
+	}
+	return VersionedValue{}
 }
 
-type mapEntriesServer struct {
-	grpc.ServerStream
+func (m *Event_Updated) GetPrevValue() VersionedValue {
+	if m != nil {
+		return m.PrevValue
+	}
+	return VersionedValue{}
 }
 
-func (x *mapEntriesServer) Send(m *EntriesResponse) error {
-	return x.ServerStream.SendMsg(m)
+type Event_Removed struct {
+	Value   VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+	Expired bool           `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
-func _Map_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Commit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Commit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Commit(ctx, req.(*CommitRequest))
+func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
+func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
+func (*Event_Removed) ProtoMessage()    {}
+func (*Event_Removed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{34, 2}
+}
+func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Event_Removed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Removed.Merge(m, src)
+}
+func (m *Event_Removed) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Removed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
 }
 
-func _Map_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Create(ctx, req.(*CreateRequest))
+var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
+
+func (m *Event_Removed) GetValue() VersionedValue {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return VersionedValue{}
 }
 
-func _Map_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Close(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.runtime.map.v1.Map/Close",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Close(ctx, req.(*CloseRequest))
+func (m *Event_Removed) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Map_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomix.runtime.map.v1.Map",
-	HandlerType: (*MapServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Size",
-			Handler:    _Map_Size_Handler,
-		},
-		{
-			MethodName: "Put",
-			Handler:    _Map_Put_Handler,
-		},
-		{
-			MethodName: "Insert",
-			Handler:    _Map_Insert_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _Map_Update_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _Map_Get_Handler,
-		},
-		{
-			MethodName: "Remove",
-			Handler:    _Map_Remove_Handler,
-		},
-		{
-			MethodName: "Clear",
-			Handler:    _Map_Clear_Handler,
-		},
-		{
-			MethodName: "Lock",
-			Handler:    _Map_Lock_Handler,
-		},
-		{
-			MethodName: "Unlock",
-			Handler:    _Map_Unlock_Handler,
-		},
-		{
-			MethodName: "Commit",
-			Handler:    _Map_Commit_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _Map_Create_Handler,
-		},
-		{
-			MethodName: "Close",
-			Handler:    _Map_Close_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Events",
-			Handler:       _Map_Events_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Entries",
-			Handler:       _Map_Entries_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "runtime/map/v1/map.proto",
+type Entry struct {
+	Key   string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *VersionedValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Entry) Reset()         { *m = Entry{} }
+func (m *Entry) String() string { return proto.CompactTextString(m) }
+func (*Entry) ProtoMessage()    {}
+func (*Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{35}
 }
-
-func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entry.Merge(m, src)
 }
-
-func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entry.DiscardUnknown(m)
 }
 
-func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_Entry proto.InternalMessageInfo
+
+func (m *Entry) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Entry) GetValue() *VersionedValue {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Fence references a fencing token issued by a Lock or LeaderElection primitive
+// The fencing primitive must be stored in the same partition as the keys being written
+type Fence struct {
+	// name is the name of the Lock or LeaderElection primitive that issued the token
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// token is the lock version or election term held by the writer
+	Token uint64 `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevVersion != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.TTL != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err2 != nil {
-			return 0, err2
+func (m *Fence) Reset()         { *m = Fence{} }
+func (m *Fence) String() string { return proto.CompactTextString(m) }
+func (*Fence) ProtoMessage()    {}
+func (*Fence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{36}
+}
+func (m *Fence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n2
-		i = encodeVarintMap(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Fence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fence.Merge(m, src)
+}
+func (m *Fence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fence proto.InternalMessageInfo
+
+func (m *Fence) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Fence) GetToken() uint64 {
+	if m != nil {
+		return m.Token
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// Patch is a partial update of a JSON document value
+type Patch struct {
+	// Types that are valid to be assigned to Patch:
+	//	*Patch_JsonPatch
+	//	*Patch_MergePatch
+	Patch isPatch_Patch `protobuf_oneof:"patch"`
+}
+
+func (m *Patch) Reset()         { *m = Patch{} }
+func (m *Patch) String() string { return proto.CompactTextString(m) }
+func (*Patch) ProtoMessage()    {}
+func (*Patch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{37}
+}
+func (m *Patch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Patch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Patch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Patch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Patch.Merge(m, src)
+}
+func (m *Patch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Patch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Patch.DiscardUnknown(m)
 }
 
-func (m *PutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_Patch proto.InternalMessageInfo
+
+type isPatch_Patch interface {
+	isPatch_Patch()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Patch_JsonPatch struct {
+	JsonPatch []byte `protobuf:"bytes,1,opt,name=json_patch,json=jsonPatch,proto3,oneof" json:"json_patch,omitempty"`
+}
+type Patch_MergePatch struct {
+	MergePatch []byte `protobuf:"bytes,2,opt,name=merge_patch,json=mergePatch,proto3,oneof" json:"merge_patch,omitempty"`
 }
 
-func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != nil {
-		{
-			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Version != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+func (*Patch_JsonPatch) isPatch_Patch()  {}
+func (*Patch_MergePatch) isPatch_Patch() {}
+
+func (m *Patch) GetPatch() isPatch_Patch {
+	if m != nil {
+		return m.Patch
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Patch) GetJsonPatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_JsonPatch); ok {
+		return x.JsonPatch
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Patch) GetMergePatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_MergePatch); ok {
+		return x.MergePatch
+	}
+	return nil
 }
 
-func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TTL != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err5 != nil {
-			return 0, err5
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Patch) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Patch_JsonPatch)(nil),
+		(*Patch_MergePatch)(nil),
+	}
+}
+
+type VersionedValue struct {
+	Value   []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *VersionedValue) Reset()         { *m = VersionedValue{} }
+func (m *VersionedValue) String() string { return proto.CompactTextString(m) }
+func (*VersionedValue) ProtoMessage()    {}
+func (*VersionedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7498de5246e2fda8, []int{38}
+}
+func (m *VersionedValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionedValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n5
-		i = encodeVarintMap(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *VersionedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionedValue.Merge(m, src)
+}
+func (m *VersionedValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionedValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionedValue proto.InternalMessageInfo
+
+func (m *VersionedValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *VersionedValue) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("atomix.runtime.map.v1.EventsRequest_EventType", EventsRequest_EventType_name, EventsRequest_EventType_value)
+	proto.RegisterType((*SizeRequest)(nil), "atomix.runtime.map.v1.SizeRequest")
+	proto.RegisterType((*SizeResponse)(nil), "atomix.runtime.map.v1.SizeResponse")
+	proto.RegisterType((*PutRequest)(nil), "atomix.runtime.map.v1.PutRequest")
+	proto.RegisterType((*PutResponse)(nil), "atomix.runtime.map.v1.PutResponse")
+	proto.RegisterType((*InsertRequest)(nil), "atomix.runtime.map.v1.InsertRequest")
+	proto.RegisterType((*InsertResponse)(nil), "atomix.runtime.map.v1.InsertResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "atomix.runtime.map.v1.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "atomix.runtime.map.v1.UpdateResponse")
+	proto.RegisterType((*GetRequest)(nil), "atomix.runtime.map.v1.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "atomix.runtime.map.v1.GetResponse")
+	proto.RegisterType((*RemoveRequest)(nil), "atomix.runtime.map.v1.RemoveRequest")
+	proto.RegisterType((*RemoveResponse)(nil), "atomix.runtime.map.v1.RemoveResponse")
+	proto.RegisterType((*GetAllRequest)(nil), "atomix.runtime.map.v1.GetAllRequest")
+	proto.RegisterType((*GetAllResponse)(nil), "atomix.runtime.map.v1.GetAllResponse")
+	proto.RegisterType((*PutAllRequest)(nil), "atomix.runtime.map.v1.PutAllRequest")
+	proto.RegisterType((*PutAllRequest_Entry)(nil), "atomix.runtime.map.v1.PutAllRequest.Entry")
+	proto.RegisterType((*PutAllResponse)(nil), "atomix.runtime.map.v1.PutAllResponse")
+	proto.RegisterType((*PutAllResponse_Result)(nil), "atomix.runtime.map.v1.PutAllResponse.Result")
+	proto.RegisterType((*RemoveAllRequest)(nil), "atomix.runtime.map.v1.RemoveAllRequest")
+	proto.RegisterType((*RemoveAllResponse)(nil), "atomix.runtime.map.v1.RemoveAllResponse")
+	proto.RegisterType((*ClearRequest)(nil), "atomix.runtime.map.v1.ClearRequest")
+	proto.RegisterType((*ClearResponse)(nil), "atomix.runtime.map.v1.ClearResponse")
+	proto.RegisterType((*LockRequest)(nil), "atomix.runtime.map.v1.LockRequest")
+	proto.RegisterType((*LockResponse)(nil), "atomix.runtime.map.v1.LockResponse")
+	proto.RegisterType((*UnlockRequest)(nil), "atomix.runtime.map.v1.UnlockRequest")
+	proto.RegisterType((*UnlockResponse)(nil), "atomix.runtime.map.v1.UnlockResponse")
+	proto.RegisterType((*EntriesRequest)(nil), "atomix.runtime.map.v1.EntriesRequest")
+	proto.RegisterType((*EntriesResponse)(nil), "atomix.runtime.map.v1.EntriesResponse")
+	proto.RegisterType((*CommitRequest)(nil), "atomix.runtime.map.v1.CommitRequest")
+	proto.RegisterType((*CommitRequest_Operation)(nil), "atomix.runtime.map.v1.CommitRequest.Operation")
+	proto.RegisterType((*CommitRequest_Put)(nil), "atomix.runtime.map.v1.CommitRequest.Put")
+	proto.RegisterType((*CommitRequest_Insert)(nil), "atomix.runtime.map.v1.CommitRequest.Insert")
+	proto.RegisterType((*CommitRequest_Update)(nil), "atomix.runtime.map.v1.CommitRequest.Update")
+	proto.RegisterType((*CommitRequest_Remove)(nil), "atomix.runtime.map.v1.CommitRequest.Remove")
+	proto.RegisterType((*CommitResponse)(nil), "atomix.runtime.map.v1.CommitResponse")
+	proto.RegisterType((*CommitResponse_Result)(nil), "atomix.runtime.map.v1.CommitResponse.Result")
+	proto.RegisterType((*CommitResponse_Put)(nil), "atomix.runtime.map.v1.CommitResponse.Put")
+	proto.RegisterType((*CommitResponse_Insert)(nil), "atomix.runtime.map.v1.CommitResponse.Insert")
+	proto.RegisterType((*CommitResponse_Update)(nil), "atomix.runtime.map.v1.CommitResponse.Update")
+	proto.RegisterType((*CommitResponse_Remove)(nil), "atomix.runtime.map.v1.CommitResponse.Remove")
+	proto.RegisterType((*QueryRequest)(nil), "atomix.runtime.map.v1.QueryRequest")
+	proto.RegisterType((*QueryResponse)(nil), "atomix.runtime.map.v1.QueryResponse")
+	proto.RegisterType((*Range)(nil), "atomix.runtime.map.v1.Range")
+	proto.RegisterType((*Bound)(nil), "atomix.runtime.map.v1.Bound")
+	proto.RegisterType((*EventsRequest)(nil), "atomix.runtime.map.v1.EventsRequest")
+	proto.RegisterType((*EventsResponse)(nil), "atomix.runtime.map.v1.EventsResponse")
+	proto.RegisterType((*Event)(nil), "atomix.runtime.map.v1.Event")
+	proto.RegisterType((*Event_Inserted)(nil), "atomix.runtime.map.v1.Event.Inserted")
+	proto.RegisterType((*Event_Updated)(nil), "atomix.runtime.map.v1.Event.Updated")
+	proto.RegisterType((*Event_Removed)(nil), "atomix.runtime.map.v1.Event.Removed")
+	proto.RegisterType((*Entry)(nil), "atomix.runtime.map.v1.Entry")
+	proto.RegisterType((*Fence)(nil), "atomix.runtime.map.v1.Fence")
+	proto.RegisterType((*Patch)(nil), "atomix.runtime.map.v1.Patch")
+	proto.RegisterType((*VersionedValue)(nil), "atomix.runtime.map.v1.VersionedValue")
+}
+
+func init() { proto.RegisterFile("runtime/map/v1/map.proto", fileDescriptor_7498de5246e2fda8) }
+
+var fileDescriptor_7498de5246e2fda8 = []byte{
+	// 1907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x8f, 0xdb, 0x58,
+	0x15, 0x8f, 0xed, 0x38, 0x1f, 0x27, 0x1f, 0x3b, 0x5c, 0x15, 0xf0, 0x9a, 0x65, 0x26, 0xf5, 0x36,
+	0x10, 0x16, 0x94, 0x61, 0x0a, 0x12, 0x0b, 0x6c, 0xa5, 0x6d, 0x9a, 0xb4, 0x9d, 0xb6, 0xd3, 0xc9,
+	0xba, 0x33, 0xb3, 0xd2, 0x3e, 0x30, 0x72, 0x9b, 0xdb, 0xa9, 0x77, 0x12, 0xdb, 0xf5, 0x47, 0x98,
+	0xf4, 0x11, 0xf1, 0x80, 0x90, 0x90, 0x78, 0x42, 0xfd, 0x13, 0x40, 0xbc, 0xf2, 0x47, 0xac, 0xb4,
+	0x0f, 0xac, 0xe0, 0x05, 0xf1, 0x50, 0xd0, 0xf4, 0x8d, 0x37, 0xfe, 0x03, 0x74, 0x3f, 0xec, 0x38,
+	0x99, 0x38, 0xf6, 0x54, 0x09, 0x82, 0x7d, 0x8a, 0xef, 0xf5, 0x39, 0xbf, 0x7b, 0xce, 0xb9, 0xe7,
+	0x9e, 0xfb, 0xf3, 0x09, 0x28, 0x6e, 0x60, 0xf9, 0xe6, 0x08, 0x6f, 0x8f, 0x0c, 0x67, 0x7b, 0xbc,
+	0x43, 0x7e, 0xda, 0x8e, 0x6b, 0xfb, 0x36, 0xfa, 0xaa, 0xe1, 0xdb, 0x23, 0xf3, 0xac, 0xcd, 0x05,
+	0xda, 0xe4, 0xcd, 0x78, 0x47, 0xdd, 0x3c, 0xb1, 0xed, 0x93, 0x21, 0xde, 0xa6, 0x42, 0x8f, 0x83,
+	0xa7, 0xdb, 0x83, 0xc0, 0x35, 0x7c, 0xd3, 0xb6, 0x98, 0x9a, 0x1a, 0x01, 0x8e, 0x77, 0xb6, 0x43,
+	0x55, 0xf6, 0xe6, 0xed, 0x8b, 0x4b, 0x79, 0xfc, 0xd5, 0x95, 0x13, 0xfb, 0xc4, 0xa6, 0x8f, 0xdb,
+	0xe4, 0x89, 0xcd, 0x6a, 0xbb, 0x50, 0x79, 0x64, 0xbe, 0xc0, 0x3a, 0x7e, 0x1e, 0x60, 0xcf, 0x47,
+	0x3f, 0x01, 0xd1, 0x1c, 0x28, 0x42, 0x43, 0x68, 0x55, 0xae, 0x6f, 0xb6, 0xe7, 0xac, 0x1b, 0xef,
+	0xb4, 0xfb, 0xae, 0x39, 0x32, 0x7d, 0x73, 0x8c, 0x77, 0xbb, 0x1d, 0xf8, 0xec, 0xd5, 0x56, 0xee,
+	0xfc, 0xd5, 0x96, 0xb8, 0xdb, 0xd5, 0x45, 0x73, 0xa0, 0x69, 0x50, 0x65, 0x50, 0x9e, 0x63, 0x5b,
+	0x1e, 0x46, 0x08, 0xf2, 0x9e, 0xf9, 0x02, 0x53, 0xb4, 0x9a, 0x4e, 0x9f, 0xb5, 0xbf, 0x8a, 0x00,
+	0xfd, 0xc0, 0x5f, 0xc1, 0x72, 0x68, 0x03, 0xa4, 0x53, 0x3c, 0x51, 0xc4, 0x86, 0xd0, 0x2a, 0xeb,
+	0xe4, 0x11, 0x5d, 0x01, 0x79, 0x6c, 0x0c, 0x03, 0xac, 0x48, 0x0d, 0xa1, 0x55, 0xd5, 0xd9, 0x00,
+	0xbd, 0x0f, 0x92, 0xef, 0x0f, 0x95, 0x3c, 0x5d, 0xe4, 0xed, 0x36, 0x0b, 0x6d, 0x3b, 0x0c, 0x6d,
+	0xbb, 0xcb, 0x43, 0xdb, 0xa9, 0x9c, 0xbf, 0xda, 0x92, 0x0e, 0x0e, 0x1e, 0xbc, 0xfc, 0xc7, 0x96,
+	0xa0, 0x13, 0x15, 0x74, 0x15, 0xaa, 0x8e, 0x8b, 0xc7, 0xc7, 0x63, 0xec, 0x7a, 0xa6, 0x6d, 0x29,
+	0x72, 0x43, 0x68, 0xe5, 0xf5, 0x0a, 0x99, 0x3b, 0x62, 0x53, 0xe8, 0x1d, 0x28, 0x63, 0xe7, 0x19,
+	0x1e, 0x61, 0xd7, 0x18, 0x2a, 0x85, 0x86, 0xd0, 0x2a, 0xe9, 0xd3, 0x09, 0x74, 0x1d, 0xe4, 0xa7,
+	0xd8, 0x7a, 0x82, 0x95, 0x22, 0x5d, 0xfc, 0x9d, 0xf6, 0xc2, 0xed, 0x6e, 0xdf, 0x26, 0x32, 0x3a,
+	0x13, 0x25, 0x3a, 0x8e, 0xe1, 0x3f, 0x79, 0xa6, 0x94, 0x96, 0xea, 0xf4, 0x89, 0x8c, 0xce, 0x44,
+	0xb5, 0x11, 0x54, 0x68, 0x50, 0x79, 0xe0, 0x15, 0x28, 0x86, 0x26, 0x0b, 0xd4, 0xe4, 0x70, 0x88,
+	0xba, 0x00, 0xcc, 0x23, 0x1a, 0x26, 0x91, 0xae, 0xd0, 0x4c, 0x58, 0x81, 0xbb, 0x88, 0x07, 0x47,
+	0x44, 0x58, 0x2f, 0x53, 0xb7, 0xc9, 0xa3, 0xf6, 0xb9, 0x00, 0xb5, 0x5d, 0xcb, 0xc3, 0xee, 0xff,
+	0xc9, 0x3e, 0xce, 0x6c, 0x92, 0x3c, 0xb7, 0x49, 0xda, 0x7b, 0x50, 0x0f, 0x9d, 0x49, 0x8b, 0x9f,
+	0xf6, 0x4b, 0x11, 0x6a, 0x87, 0xce, 0xc0, 0xf0, 0xf1, 0x97, 0x26, 0x83, 0xa3, 0x1c, 0x2d, 0x64,
+	0xce, 0x51, 0x6d, 0x0c, 0xf5, 0x30, 0x0a, 0xa9, 0x29, 0x77, 0xef, 0x8d, 0x53, 0xae, 0x93, 0x27,
+	0xf1, 0x8a, 0x27, 0x9e, 0x03, 0x70, 0x07, 0xaf, 0x2f, 0xe9, 0x1c, 0xc3, 0x7f, 0xe6, 0x29, 0x52,
+	0x43, 0x6a, 0x95, 0x75, 0x36, 0xd0, 0xfa, 0x50, 0xa1, 0x2b, 0x72, 0x37, 0x6f, 0x86, 0xfb, 0x23,
+	0x5c, 0xde, 0x0f, 0xa6, 0xa9, 0xfd, 0x49, 0x80, 0x9a, 0x8e, 0x47, 0xf6, 0x78, 0x4d, 0x29, 0x34,
+	0xbf, 0xe5, 0xd2, 0x92, 0x2d, 0xcf, 0x67, 0xdf, 0xf2, 0x47, 0x50, 0x0f, 0xad, 0x5e, 0x5d, 0x2c,
+	0x8e, 0xa1, 0x76, 0x07, 0xfb, 0x37, 0x87, 0xc3, 0x55, 0x84, 0x02, 0x41, 0xfe, 0x14, 0x4f, 0x3c,
+	0x45, 0xa4, 0xfb, 0x47, 0x9f, 0xb5, 0x87, 0x50, 0x0f, 0x17, 0xe0, 0x56, 0x7f, 0x00, 0x45, 0x6c,
+	0xf9, 0xae, 0x89, 0x3d, 0x45, 0x68, 0x48, 0x4b, 0xbc, 0xef, 0x59, 0xbe, 0x3b, 0xe1, 0xe6, 0x86,
+	0x2a, 0xda, 0x2f, 0x44, 0xa8, 0xf5, 0x83, 0x55, 0x59, 0x7c, 0x6f, 0x6a, 0x8b, 0x48, 0x6d, 0x79,
+	0x2f, 0xa9, 0xd8, 0xc7, 0x97, 0x5c, 0x68, 0x99, 0x6a, 0x82, 0x4c, 0xe7, 0xc3, 0x8c, 0x10, 0x16,
+	0x14, 0x15, 0x71, 0x41, 0x51, 0x91, 0x2e, 0x5d, 0x54, 0xb4, 0xbf, 0x0b, 0x50, 0xef, 0x07, 0x33,
+	0x51, 0x7d, 0x00, 0x45, 0x17, 0x7b, 0xc1, 0xd0, 0x0f, 0xa3, 0xfa, 0xbd, 0x14, 0x4f, 0x98, 0x5e,
+	0x5b, 0xa7, 0x4a, 0xa1, 0x2f, 0x1c, 0x42, 0x7d, 0x01, 0x05, 0xf6, 0x62, 0x81, 0x33, 0xb1, 0x42,
+	0x23, 0x2e, 0xbb, 0xdb, 0xa4, 0x37, 0xbc, 0xdb, 0x1e, 0xc3, 0x06, 0xcb, 0xf3, 0x35, 0x66, 0xe5,
+	0x47, 0xf0, 0x95, 0xd8, 0x1a, 0x2b, 0x49, 0xcc, 0x7b, 0x50, 0xbd, 0x35, 0xc4, 0x86, 0xbb, 0x0a,
+	0x1e, 0xf7, 0x16, 0xd4, 0x38, 0x16, 0x33, 0x4d, 0x7b, 0x29, 0x40, 0xe5, 0x81, 0xfd, 0xe4, 0x74,
+	0x4d, 0xf1, 0x40, 0x3f, 0x86, 0x22, 0x51, 0xb5, 0x03, 0x3f, 0x3d, 0x1d, 0xf3, 0x34, 0x0f, 0x43,
+	0x79, 0xad, 0x0e, 0x55, 0x66, 0x19, 0x37, 0xf5, 0x18, 0x6a, 0x87, 0xd6, 0x70, 0x7d, 0xb6, 0x6a,
+	0x1b, 0x50, 0x0f, 0x17, 0xe0, 0x4b, 0xfe, 0x4b, 0x80, 0x7a, 0x8f, 0x6d, 0xc3, 0x2a, 0x16, 0xbd,
+	0x02, 0xf2, 0xcf, 0x29, 0xff, 0x13, 0x29, 0x51, 0x61, 0x03, 0xf4, 0x35, 0x28, 0x38, 0x2e, 0x7e,
+	0x6a, 0x9e, 0xd1, 0x08, 0x95, 0x75, 0x3e, 0x42, 0xdf, 0x80, 0xb2, 0xe7, 0x1b, 0xae, 0x7f, 0x4c,
+	0x8e, 0x49, 0x9e, 0xbe, 0x2a, 0xd1, 0x89, 0xfb, 0x78, 0x82, 0xbe, 0x4e, 0x52, 0x6a, 0x40, 0x5f,
+	0xc9, 0x4c, 0x0b, 0x5b, 0x83, 0xfb, 0xac, 0x22, 0x0c, 0x89, 0x05, 0xf4, 0xce, 0xaf, 0xe9, 0x6c,
+	0x80, 0x34, 0xa8, 0x3e, 0xb1, 0x2d, 0xdf, 0xb4, 0x02, 0x1a, 0x6a, 0x4a, 0x5a, 0xcb, 0xfa, 0xcc,
+	0x9c, 0x66, 0xc3, 0x5b, 0x91, 0xaf, 0x3c, 0x71, 0xdf, 0x07, 0x99, 0x64, 0xe1, 0x84, 0xfb, 0x9b,
+	0x25, 0x6d, 0x99, 0xc2, 0x85, 0x05, 0xc5, 0x05, 0x0b, 0x7e, 0x5e, 0x84, 0xda, 0x2d, 0x7b, 0x34,
+	0x32, 0x57, 0x72, 0xed, 0x1f, 0x00, 0xd8, 0x0e, 0x66, 0xa9, 0x14, 0x16, 0xdd, 0x76, 0x82, 0xc1,
+	0x33, 0xab, 0xb6, 0xf7, 0x43, 0x35, 0xee, 0x42, 0x0c, 0x67, 0x7a, 0x9f, 0x4a, 0x99, 0xef, 0x53,
+	0xf5, 0x8f, 0x22, 0x94, 0x23, 0x4c, 0xf4, 0x01, 0x48, 0x4e, 0xe0, 0x73, 0xa7, 0x5a, 0x99, 0x0c,
+	0xea, 0x07, 0xfe, 0xdd, 0x9c, 0x4e, 0xd4, 0x50, 0x0f, 0x0a, 0x26, 0x65, 0xb0, 0x9c, 0x5e, 0x7d,
+	0x37, 0x13, 0x00, 0x23, 0xbd, 0x77, 0x73, 0x3a, 0x57, 0x26, 0x30, 0x01, 0x65, 0x75, 0x8a, 0x74,
+	0x09, 0x18, 0x46, 0x04, 0x09, 0x0c, 0x53, 0x26, 0x30, 0x2e, 0xad, 0x6e, 0x4a, 0xfe, 0x12, 0x30,
+	0xac, 0x20, 0x12, 0x18, 0xa6, 0xdc, 0xa9, 0x40, 0x39, 0x0a, 0xb1, 0xfa, 0x6b, 0x01, 0xa4, 0x7e,
+	0xe0, 0xaf, 0xff, 0x72, 0xbb, 0x40, 0x9f, 0xf2, 0x17, 0xe8, 0x93, 0xfa, 0x29, 0x14, 0x58, 0xec,
+	0xd6, 0x6f, 0x8e, 0xfa, 0x1b, 0x01, 0x0a, 0x2c, 0xc2, 0xff, 0x1b, 0xbe, 0xdf, 0x20, 0x57, 0x33,
+	0xd9, 0x9f, 0x05, 0xe6, 0xcc, 0xab, 0x8b, 0x17, 0xd4, 0xb5, 0xbf, 0xc8, 0x50, 0x0f, 0xf7, 0xfd,
+	0xb2, 0xd4, 0x61, 0x56, 0x2f, 0x81, 0x3a, 0xfc, 0x41, 0x8c, 0xb8, 0xc3, 0x8d, 0xf8, 0x99, 0xfa,
+	0x4e, 0x36, 0xd0, 0xd8, 0xa1, 0xba, 0x3d, 0x77, 0xa8, 0x32, 0x9a, 0x75, 0xe1, 0x54, 0xdd, 0x9e,
+	0x3b, 0x55, 0x19, 0x71, 0x2e, 0x1c, 0xab, 0xdb, 0x73, 0xc7, 0x2a, 0x73, 0x98, 0xe6, 0xce, 0x55,
+	0x89, 0xe0, 0x90, 0x00, 0xa9, 0x98, 0x9d, 0xa9, 0x35, 0x77, 0x0b, 0x54, 0x2d, 0x3a, 0x2e, 0x89,
+	0x2b, 0xa9, 0x56, 0x94, 0xe5, 0xff, 0x95, 0x0f, 0x49, 0xf5, 0x7e, 0x94, 0xc6, 0x2b, 0xf8, 0x8a,
+	0xf9, 0xb3, 0x00, 0xd5, 0x8f, 0x02, 0xec, 0x4e, 0x56, 0x74, 0xfd, 0x9b, 0xd6, 0x00, 0x9f, 0xf1,
+	0xcb, 0x90, 0x0d, 0x90, 0x02, 0x05, 0xfc, 0x3c, 0x30, 0x86, 0x1e, 0x6b, 0x0c, 0x90, 0xed, 0x64,
+	0x63, 0xf4, 0x43, 0x90, 0x5d, 0xc3, 0x3a, 0x49, 0xfb, 0x96, 0xd3, 0x89, 0xcc, 0xdd, 0x9c, 0xce,
+	0x84, 0xa7, 0x04, 0x40, 0x8e, 0x11, 0x80, 0x4e, 0x11, 0xe4, 0xe7, 0xc4, 0x0f, 0x6d, 0x0f, 0x6a,
+	0xdc, 0xa1, 0x95, 0x90, 0xd3, 0x13, 0x90, 0xe9, 0xfa, 0xa8, 0x0d, 0xd2, 0xc8, 0xb4, 0x52, 0x88,
+	0x42, 0xc7, 0x0e, 0xac, 0x81, 0x4e, 0x04, 0xa9, 0xbc, 0x71, 0xa6, 0x88, 0x99, 0xe4, 0x8d, 0x33,
+	0xed, 0x9b, 0x20, 0xd3, 0xd1, 0xb4, 0x32, 0x0a, 0xb1, 0xca, 0xa8, 0xfd, 0x5e, 0x84, 0x5a, 0x6f,
+	0x8c, 0x2d, 0xdf, 0x5b, 0xcf, 0xa7, 0x77, 0x12, 0x49, 0x53, 0xa0, 0xe8, 0x18, 0xbe, 0x8f, 0x5d,
+	0x8b, 0x53, 0xb4, 0x70, 0x18, 0x31, 0x4c, 0x39, 0xc6, 0x86, 0xbb, 0x20, 0xfb, 0x13, 0x07, 0x7b,
+	0x4a, 0xa1, 0x21, 0xb5, 0xea, 0x89, 0xf4, 0x64, 0xc6, 0x11, 0x36, 0x3a, 0x98, 0x38, 0x58, 0x67,
+	0xca, 0xda, 0x0d, 0x28, 0x47, 0x73, 0xa8, 0x04, 0xf9, 0x87, 0xfb, 0x0f, 0x7b, 0x1b, 0x39, 0x54,
+	0x85, 0xd2, 0xee, 0xc3, 0x47, 0x3d, 0xfd, 0xa0, 0xd7, 0xdd, 0x10, 0x50, 0x05, 0x8a, 0x87, 0xfd,
+	0xee, 0x4d, 0x32, 0x10, 0xc9, 0x40, 0xef, 0xed, 0xed, 0x1f, 0xf5, 0xba, 0x1b, 0x92, 0x76, 0x0f,
+	0xea, 0xe1, 0x02, 0x31, 0x9a, 0x47, 0x66, 0x52, 0x76, 0x83, 0x6a, 0x45, 0x34, 0x8f, 0x0c, 0xb4,
+	0x7f, 0xe7, 0x41, 0xa6, 0xd3, 0x0b, 0xee, 0x8c, 0x5b, 0x50, 0x62, 0x75, 0x12, 0x0f, 0x52, 0x8e,
+	0x34, 0x45, 0xe0, 0xe5, 0x15, 0x0f, 0xee, 0xe6, 0xf4, 0x48, 0x11, 0x7d, 0x08, 0x45, 0x56, 0x24,
+	0x07, 0xbc, 0xc6, 0x5e, 0x5b, 0x8a, 0xc1, 0x2a, 0x0d, 0x81, 0x08, 0xd5, 0x08, 0x02, 0x2b, 0x8f,
+	0x03, 0x25, 0x9f, 0x01, 0x81, 0xd5, 0x0e, 0x8a, 0xc0, 0xd5, 0xa6, 0x6d, 0x5b, 0x39, 0x73, 0xdb,
+	0x56, 0xdd, 0x83, 0x52, 0xe8, 0xcf, 0x0a, 0xea, 0x90, 0xfa, 0x52, 0x80, 0x22, 0xf7, 0x6d, 0x05,
+	0x70, 0x2b, 0xad, 0xb7, 0x4f, 0xa1, 0xc8, 0x63, 0xb6, 0x0a, 0xcb, 0x14, 0x28, 0xe2, 0x33, 0xc7,
+	0x74, 0x79, 0xce, 0x94, 0xf4, 0x70, 0x48, 0x2a, 0x18, 0xcb, 0xb9, 0xa3, 0xe4, 0x76, 0xc8, 0x4f,
+	0xe3, 0xac, 0x29, 0xf3, 0x85, 0xc6, 0x4b, 0xc8, 0x0e, 0xc8, 0x94, 0xc6, 0x93, 0x93, 0x6b, 0x19,
+	0x23, 0xcc, 0x81, 0xe9, 0x33, 0xa9, 0x3a, 0xbe, 0x7d, 0x8a, 0x43, 0xe6, 0xc3, 0x06, 0xda, 0x01,
+	0xc8, 0x74, 0xd7, 0xd1, 0x16, 0xc0, 0xa7, 0x9e, 0x6d, 0x1d, 0xb3, 0x3c, 0x11, 0x78, 0x21, 0x2f,
+	0x93, 0x39, 0x26, 0x70, 0x15, 0x2a, 0x23, 0xec, 0x9e, 0x60, 0x2e, 0x21, 0x72, 0x09, 0xa0, 0x93,
+	0x54, 0x84, 0x38, 0x48, 0x5f, 0x6a, 0x1f, 0x42, 0x7d, 0xd6, 0xc2, 0xc5, 0x35, 0x2f, 0xb9, 0x5f,
+	0x72, 0xfd, 0x77, 0x55, 0x90, 0xf6, 0x0c, 0x07, 0xed, 0x43, 0x9e, 0xfc, 0x6d, 0x83, 0xb4, 0x84,
+	0x40, 0xc4, 0xfe, 0x1e, 0x52, 0xdf, 0x5d, 0x2a, 0x13, 0x31, 0x3a, 0xca, 0x2b, 0xae, 0x26, 0xb7,
+	0x80, 0x42, 0x38, 0x6d, 0x99, 0x08, 0x47, 0x3b, 0x8c, 0xe8, 0x43, 0xd2, 0x99, 0x9c, 0xf9, 0x2b,
+	0x42, 0x6d, 0xa6, 0x48, 0x4d, 0x61, 0x39, 0xe3, 0x48, 0x82, 0x9d, 0xe9, 0xf3, 0xab, 0xcd, 0x14,
+	0xa9, 0xa9, 0xef, 0x77, 0x70, 0xb2, 0xef, 0x77, 0x70, 0xaa, 0xef, 0xf1, 0x76, 0xf3, 0x61, 0x44,
+	0x53, 0x92, 0x8c, 0x9c, 0xe9, 0x24, 0xab, 0xcd, 0x14, 0xa9, 0x29, 0x2c, 0xeb, 0x8a, 0x26, 0xc2,
+	0xce, 0x74, 0x65, 0xd5, 0x66, 0x8a, 0xd4, 0x14, 0xb6, 0x1f, 0x2c, 0x85, 0xed, 0x07, 0x59, 0x60,
+	0xe7, 0x7a, 0x8b, 0x3f, 0x83, 0x72, 0xd4, 0x2d, 0x43, 0xdf, 0x5e, 0xea, 0x61, 0x0c, 0xbc, 0x95,
+	0x2e, 0xc8, 0xf1, 0x75, 0x90, 0x69, 0xbb, 0x0b, 0x25, 0x25, 0x77, 0xbc, 0xb1, 0xa6, 0x5e, 0x5b,
+	0x2e, 0xc4, 0x31, 0xf7, 0x21, 0x4f, 0xda, 0x52, 0x89, 0x67, 0x2a, 0xd6, 0x4d, 0x53, 0xdf, 0x5d,
+	0x2a, 0x13, 0x4b, 0x57, 0xda, 0x76, 0x4a, 0x4e, 0xd7, 0x78, 0xdb, 0x4b, 0x6d, 0xa6, 0x48, 0x71,
+	0xd8, 0x8f, 0xa1, 0xc0, 0xae, 0x79, 0x74, 0x2d, 0x0b, 0xcd, 0x50, 0x9b, 0x29, 0x52, 0x0c, 0xf6,
+	0xfb, 0x02, 0xfa, 0x04, 0x8a, 0xbc, 0x4f, 0x84, 0x9a, 0x4b, 0xa8, 0xe2, 0xb4, 0x67, 0xa6, 0x7e,
+	0x2b, 0x4d, 0x2c, 0xc2, 0x3e, 0x84, 0x02, 0xfb, 0xc8, 0x49, 0x34, 0x7a, 0xa6, 0xb5, 0xa0, 0x36,
+	0x53, 0xa4, 0xa6, 0x79, 0x40, 0x49, 0x6f, 0x62, 0x1e, 0xc4, 0x39, 0xbe, 0x7a, 0x6d, 0xb9, 0x10,
+	0xc7, 0xfc, 0x04, 0x0a, 0xb7, 0x5c, 0xbc, 0xac, 0xca, 0xb0, 0xd7, 0xa9, 0xa6, 0x72, 0x29, 0xde,
+	0x72, 0x94, 0x7e, 0x25, 0x0a, 0xe8, 0x63, 0x92, 0xb7, 0xb6, 0x87, 0x97, 0xe4, 0xad, 0xed, 0xe1,
+	0xf4, 0xbc, 0xb5, 0xbd, 0x59, 0xe0, 0xce, 0x8f, 0x3e, 0x3b, 0xdf, 0x14, 0xbe, 0x38, 0xdf, 0x14,
+	0xfe, 0x79, 0xbe, 0x29, 0xfc, 0xf6, 0xf5, 0x66, 0xee, 0x8b, 0xd7, 0x9b, 0xb9, 0xbf, 0xbd, 0xde,
+	0xcc, 0xc1, 0x15, 0xd3, 0x0e, 0x61, 0x0c, 0xc7, 0xe4, 0x10, 0x1d, 0x79, 0xcf, 0x70, 0x8e, 0x76,
+	0xfa, 0xc2, 0xe3, 0x02, 0x6d, 0x32, 0xfc, 0xe0, 0x3f, 0x03, 0x00, 0xb7, 0xfd, 0x77, 0x60, 0xf0,
+	0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MapClient is the client API for Map service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MapClient interface {
+	// Size returns the size of the map
+	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
+	// Put puts an entry into the map
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	// Insert inserts an entry into the map
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Get gets the entry for a key
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	// GetAll gets the entries for a set of keys
+	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
+	// PutAll puts a set of entries into the map
+	// Entries in different partitions are not updated atomically, and a key may only be put once per request
+	PutAll(ctx context.Context, in *PutAllRequest, opts ...grpc.CallOption) (*PutAllResponse, error)
+	// RemoveAll removes a set of keys from the map
+	// Keys in different partitions are not removed atomically
+	RemoveAll(ctx context.Context, in *RemoveAllRequest, opts ...grpc.CallOption) (*RemoveAllResponse, error)
+	// Clear removes all entries from the map
+	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error)
+	// Entries lists entries in the map
+	// Unless watch is enabled, entries are returned in key order
+	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error)
+	// Commit commits a transactional change to the map
+	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
+	// Query gets the entries whose values match a query on a secondary index
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// Create creates the Map
+	// Deprecated: use the Maps service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the Map
+	// Deprecated: use the Maps service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+}
+
+type mapClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMapClient(cc *grpc.ClientConn) MapClient {
+	return &mapClient{cc}
+}
+
+func (c *mapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
+	out := new(SizeResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Size", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Put", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
+	out := new(InsertResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+func (c *mapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevVersion != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
-		i--
-		dAtA[i] = 0x28
+func (c *mapClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
+	out := new(GetAllResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/GetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TTL != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintMap(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) PutAll(ctx context.Context, in *PutAllRequest, opts ...grpc.CallOption) (*PutAllResponse, error) {
+	out := new(PutAllResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/PutAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Version != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+func (c *mapClient) RemoveAll(ctx context.Context, in *RemoveAllRequest, opts ...grpc.CallOption) (*RemoveAllResponse, error) {
+	out := new(RemoveAllResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/RemoveAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
+	out := new(ClearResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Clear", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+func (c *mapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[0], "/atomix.runtime.map.v1.Map/Events", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &mapEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Map_EventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+type mapEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mapEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[1], "/atomix.runtime.map.v1.Map/Entries", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &mapEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Map_EntriesClient interface {
+	Recv() (*EntriesResponse, error)
+	grpc.ClientStream
 }
 
-func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevVersion != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type mapEntriesClient struct {
+	grpc.ClientStream
 }
 
-func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *mapEntriesClient) Recv() (*EntriesResponse, error) {
+	m := new(EntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
+	out := new(CommitResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+func (c *mapClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *mapClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *mapClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.map.v1.Map/Close", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MapServer is the server API for Map service.
+type MapServer interface {
+	// Size returns the size of the map
+	Size(context.Context, *SizeRequest) (*SizeResponse, error)
+	// Put puts an entry into the map
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	// Insert inserts an entry into the map
+	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Get gets the entry for a key
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+	// GetAll gets the entries for a set of keys
+	GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error)
+	// PutAll puts a set of entries into the map
+	// Entries in different partitions are not updated atomically, and a key may only be put once per request
+	PutAll(context.Context, *PutAllRequest) (*PutAllResponse, error)
+	// RemoveAll removes a set of keys from the map
+	// Keys in different partitions are not removed atomically
+	RemoveAll(context.Context, *RemoveAllRequest) (*RemoveAllResponse, error)
+	// Clear removes all entries from the map
+	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(*EventsRequest, Map_EventsServer) error
+	// Entries lists entries in the map
+	// Unless watch is enabled, entries are returned in key order
+	Entries(*EntriesRequest, Map_EntriesServer) error
+	// Commit commits a transactional change to the map
+	Commit(context.Context, *CommitRequest) (*CommitResponse, error)
+	// Query gets the entries whose values match a query on a secondary index
+	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// Create creates the Map
+	// Deprecated: use the Maps service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the Map
+	// Deprecated: use the Maps service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
 }
 
-func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMapServer can be embedded to have forward compatible implementations.
+type UnimplementedMapServer struct {
 }
 
-func (m *LockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
+}
+func (*UnimplementedMapServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (*UnimplementedMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (*UnimplementedMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (*UnimplementedMapServer) GetAll(ctx context.Context, req *GetAllRequest) (*GetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (*UnimplementedMapServer) PutAll(ctx context.Context, req *PutAllRequest) (*PutAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutAll not implemented")
+}
+func (*UnimplementedMapServer) RemoveAll(ctx context.Context, req *RemoveAllRequest) (*RemoveAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAll not implemented")
+}
+func (*UnimplementedMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+}
+func (*UnimplementedMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedMapServer) Events(req *EventsRequest, srv Map_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (*UnimplementedMapServer) Entries(req *EntriesRequest, srv Map_EntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
+}
+func (*UnimplementedMapServer) Commit(ctx context.Context, req *CommitRequest) (*CommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (*UnimplementedMapServer) Query(ctx context.Context, req *QueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (*UnimplementedMapServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedMapServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
 
-func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMapServer(s *grpc.Server, srv MapServer) {
+	s.RegisterService(&_Map_serviceDesc, srv)
 }
 
-func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timeout != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
-		if err15 != nil {
-			return 0, err15
-		}
-		i -= n15
-		i = encodeVarintMap(dAtA, i, uint64(n15))
-		i--
-		dAtA[i] = 0x1a
+func _Map_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MapServer).Size(ctx, in)
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Size",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Size(ctx, req.(*SizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Map_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MapServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Put(ctx, req.(*PutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Map_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Insert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Map_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Map_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MapServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func _Map_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MapServer).Remove(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Map_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MapServer).GetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/GetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).GetAll(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Map_PutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MapServer).PutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/PutAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).PutAll(ctx, req.(*PutAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Map_RemoveAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).RemoveAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/RemoveAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).RemoveAll(ctx, req.(*RemoveAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Watch {
-		i--
-		if m.Watch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func _Map_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MapServer).Clear(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Clear(ctx, req.(*ClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Map_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MapServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Map_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+func _Map_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return srv.(MapServer).Events(m, &mapEventsServer{stream})
 }
 
-func (m *CommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Map_EventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
 }
 
-func (m *CommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type mapEventsServer struct {
+	grpc.ServerStream
 }
 
-func (m *CommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMap(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (x *mapEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *CommitRequest_Operation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Map_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(MapServer).Entries(m, &mapEntriesServer{stream})
 }
 
-func (m *CommitRequest_Operation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Map_EntriesServer interface {
+	Send(*EntriesResponse) error
+	grpc.ServerStream
 }
 
-func (m *CommitRequest_Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Operation != nil {
-		{
-			size := m.Operation.Size()
-			i -= size
-			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+type mapEntriesServer struct {
+	grpc.ServerStream
 }
 
-func (m *CommitRequest_Operation_Put) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *mapEntriesServer) Send(m *EntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *CommitRequest_Operation_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Put != nil {
-		{
-			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Map_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MapServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Commit(ctx, req.(*CommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *CommitRequest_Operation_Insert) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func _Map_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitRequest_Operation_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Insert != nil {
-		{
-			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Map_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MapServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *CommitRequest_Operation_Update) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func _Map_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.map.v1.Map/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitRequest_Operation_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Update != nil {
+var _Map_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.map.v1.Map",
+	HandlerType: (*MapServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+			MethodName: "Size",
+			Handler:    _Map_Size_Handler,
+		},
+		{
+			MethodName: "Put",
+			Handler:    _Map_Put_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _Map_Insert_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Map_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Map_Get_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _Map_Remove_Handler,
+		},
+		{
+			MethodName: "GetAll",
+			Handler:    _Map_GetAll_Handler,
+		},
+		{
+			MethodName: "PutAll",
+			Handler:    _Map_PutAll_Handler,
+		},
+		{
+			MethodName: "RemoveAll",
+			Handler:    _Map_RemoveAll_Handler,
+		},
+		{
+			MethodName: "Clear",
+			Handler:    _Map_Clear_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Map_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Map_Unlock_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _Map_Commit_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _Map_Query_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Map_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _Map_Close_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _Map_Events_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Entries",
+			Handler:       _Map_Entries_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "runtime/map/v1/map.proto",
+}
+
+func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *CommitRequest_Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitRequest_Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x22
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *CommitRequest_Put) Marshal() (dAtA []byte, err error) {
+
+func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3973,49 +4299,121 @@ func (m *CommitRequest_Put) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitRequest_Put) MarshalTo(dAtA []byte) (int, error) {
+func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitRequest_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevVersion != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+	if m.Size_ != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Size_))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x8
 	}
-	if m.TTL != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err25 != nil {
-			return 0, err25
-		}
-		i -= n25
-		i = encodeVarintMap(dAtA, i, uint64(n25))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *PutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Ephemeral {
+		i--
+		if m.Ephemeral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PrevVersion != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TTL != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMap(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
 		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
 		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitRequest_Insert) Marshal() (dAtA []byte, err error) {
+func (m *PutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4025,44 +4423,37 @@ func (m *CommitRequest_Insert) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitRequest_Insert) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitRequest_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TTL != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err26 != nil {
-			return 0, err26
+	if m.PrevValue != nil {
+		{
+			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= n26
-		i = encodeVarintMap(dAtA, i, uint64(n26))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.Version != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitRequest_Update) Marshal() (dAtA []byte, err error) {
+func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4072,49 +4463,64 @@ func (m *CommitRequest_Update) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitRequest_Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitRequest_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevVersion != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+	if m.Ephemeral {
 		i--
-		dAtA[i] = 0x20
+		if m.Ephemeral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.TTL != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err27 != nil {
-			return 0, err27
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n27
-		i = encodeVarintMap(dAtA, i, uint64(n27))
+		i -= n7
+		i = encodeVarintMap(dAtA, i, uint64(n7))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
 		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
 		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitRequest_Remove) Marshal() (dAtA []byte, err error) {
+func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4124,32 +4530,25 @@ func (m *CommitRequest_Remove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitRequest_Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitRequest_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevVersion != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.Version != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4159,34 +4558,71 @@ func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMap(dAtA, i, uint64(size))
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PrevVersion != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TTL != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMap(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Result) Marshal() (dAtA []byte, err error) {
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4196,113 +4632,84 @@ func (m *CommitResponse_Result) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size := m.Result.Size()
-			i -= size
-			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	{
+		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Result_Put) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse_Result_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Put != nil {
-		{
-			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CommitResponse_Result_Insert) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CommitResponse_Result_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Insert != nil {
-		{
-			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CommitResponse_Result_Update) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CommitResponse_Result_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Update != nil {
-		{
-			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *CommitResponse_Result_Remove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *CommitResponse_Result_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
-}
-func (m *CommitResponse_Put) Marshal() (dAtA []byte, err error) {
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4312,37 +4719,30 @@ func (m *CommitResponse_Put) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitResponse_Put) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != nil {
-		{
-			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Version != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Insert) Marshal() (dAtA []byte, err error) {
+func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4352,25 +4752,54 @@ func (m *CommitResponse_Insert) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitResponse_Insert) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Version))
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
+	}
+	if m.PrevVersion != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Update) Marshal() (dAtA []byte, err error) {
+func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4380,18 +4809,18 @@ func (m *CommitResponse_Update) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitResponse_Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4399,16 +4828,11 @@ func (m *CommitResponse_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.Version != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Remove) Marshal() (dAtA []byte, err error) {
+func (m *GetAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4418,18 +4842,27 @@ func (m *CommitResponse_Remove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitResponse_Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4441,7 +4874,7 @@ func (m *CommitResponse_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4451,37 +4884,34 @@ func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PutAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4491,18 +4921,32 @@ func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4510,11 +4954,11 @@ func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
+func (m *PutAllRequest_Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4524,24 +4968,32 @@ func (m *Event) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutAllRequest_Entry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutAllRequest_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if m.TTL != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err20 != nil {
+			return 0, err20
 		}
+		i -= n20
+		i = encodeVarintMap(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
@@ -4553,58 +5005,66 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Inserted != nil {
-		{
-			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *PutAllResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *PutAllResponse_Result) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutAllResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Removed != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.PrevValue != nil {
 		{
-			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4612,11 +5072,24 @@ func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
+
+func (m *RemoveAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4626,18 +5099,27 @@ func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4649,7 +5131,7 @@ func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
+func (m *RemoveAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4659,28 +5141,55 @@ func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4692,7 +5201,7 @@ func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4702,28 +5211,60 @@ func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Expired {
-		i--
-		if m.Expired {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+
+func (m *LockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err24 != nil {
+			return 0, err24
 		}
+		i -= n24
+		i = encodeVarintMap(dAtA, i, uint64(n24))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4735,7 +5276,7 @@ func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Entry) Marshal() (dAtA []byte, err error) {
+func (m *LockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4745,39 +5286,20 @@ func (m *Entry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VersionedValue) Marshal() (dAtA []byte, err error) {
+func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4787,784 +5309,4322 @@ func (m *VersionedValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VersionedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VersionedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+
+func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Size_ != 0 {
-		n += 1 + sovMap(uint64(m.Size_))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Continuation) > 0 {
+		i -= len(m.Continuation)
+		copy(dAtA[i:], m.Continuation)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Continuation)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PrevVersion != 0 {
-		n += 1 + sovMap(uint64(m.PrevVersion))
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *PutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovMap(uint64(m.Version))
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PrevValue != nil {
-		l = m.PrevValue.Size()
-		n += 1 + l + sovMap(uint64(l))
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *InsertRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Continuation) > 0 {
+		i -= len(m.Continuation)
+		copy(dAtA[i:], m.Continuation)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Continuation)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *InsertResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovMap(uint64(m.Version))
+func (m *CommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.PrevVersion != 0 {
-		n += 1 + sovMap(uint64(m.PrevVersion))
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovMap(uint64(m.Version))
+func (m *CommitRequest_Operation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PrevValue.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *CommitRequest_Operation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitRequest_Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.PrevVersion != 0 {
-		n += 1 + sovMap(uint64(m.PrevVersion))
-	}
-	return n
+func (m *CommitRequest_Operation_Put) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitRequest_Operation_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Put != nil {
+		{
+			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CommitRequest_Operation_Insert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitRequest_Operation_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Insert != nil {
+		{
+			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CommitRequest_Operation_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitRequest_Operation_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CommitRequest_Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovMap(uint64(l))
+func (m *CommitRequest_Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
+		{
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Timeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
-		n += 1 + l + sovMap(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *CommitRequest_Put) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *CommitRequest_Put) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitRequest_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovMap(uint64(l))
+	if m.PrevVersion != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TTL != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err35 != nil {
+			return 0, err35
 		}
+		i -= n35
+		i = encodeVarintMap(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *UnlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EntriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	if m.Watch {
-		n += 2
+func (m *CommitRequest_Insert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EntriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Entry.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+func (m *CommitRequest_Insert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitRequest_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovMap(uint64(l))
+	if m.TTL != nil {
+		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err36 != nil {
+			return 0, err36
 		}
+		i -= n36
+		i = encodeVarintMap(dAtA, i, uint64(n36))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *CommitRequest_Operation) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Operation != nil {
-		n += m.Operation.Size()
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitRequest_Operation_Put) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
-}
-func (m *CommitRequest_Operation_Insert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Insert != nil {
-		l = m.Insert.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
-}
-func (m *CommitRequest_Operation_Update) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *CommitRequest_Update) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *CommitRequest_Operation_Remove) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+
+func (m *CommitRequest_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *CommitRequest_Put) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CommitRequest_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	if m.PrevVersion != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err37 != nil {
+			return 0, err37
+		}
+		i -= n37
+		i = encodeVarintMap(dAtA, i, uint64(n37))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PrevVersion != 0 {
-		n += 1 + sovMap(uint64(m.PrevVersion))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitRequest_Insert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
+func (m *CommitRequest_Remove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitRequest_Update) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.PrevVersion != 0 {
-		n += 1 + sovMap(uint64(m.PrevVersion))
-	}
-	return n
+func (m *CommitRequest_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitRequest_Remove) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitRequest_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
 	if m.PrevVersion != 0 {
-		n += 1 + sovMap(uint64(m.PrevVersion))
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevVersion))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovMap(uint64(l))
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Result) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Result != nil {
-		n += m.Result.Size()
+		{
+			size := m.Result.Size()
+			i -= size
+			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Result_Put) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *CommitResponse_Result_Put) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *CommitResponse_Result_Insert) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CommitResponse_Result_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Put != nil {
+		{
+			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
+	return len(dAtA) - i, nil
+}
+func (m *CommitResponse_Result_Insert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Result_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Insert != nil {
-		l = m.Insert.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CommitResponse_Result_Update) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *CommitResponse_Result_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Result_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CommitResponse_Result_Remove) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *CommitResponse_Result_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Result_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CommitResponse_Put) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovMap(uint64(m.Version))
-	}
-	if m.PrevValue != nil {
-		l = m.PrevValue.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *CommitResponse_Put) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitResponse_Insert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitResponse_Put) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.PrevValue != nil {
+		{
+			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Version != 0 {
-		n += 1 + sovMap(uint64(m.Version))
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Update) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitResponse_Insert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitResponse_Insert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Version != 0 {
-		n += 1 + sovMap(uint64(m.Version))
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.PrevValue.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitResponse_Remove) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitResponse_Update) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitResponse_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	{
+		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitResponse_Remove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Event.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CommitResponse_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitResponse_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.Event != nil {
-		n += m.Event.Size()
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Inserted_) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Inserted != nil {
-		l = m.Inserted.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-func (m *Event_Updated_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Query != nil {
+		{
+			size := m.Query.Size()
+			i -= size
+			if _, err := m.Query.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	var l int
-	_ = l
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *Event_Removed_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryRequest_Equals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest_Equals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Equals != nil {
+		i -= len(m.Equals)
+		copy(dAtA[i:], m.Equals)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Equals)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Removed != nil {
-		l = m.Removed.Size()
-		n += 1 + l + sovMap(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *QueryRequest_Range) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest_Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Range != nil {
+		{
+			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Event_Inserted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Updated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Range) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Range) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = m.PrevValue.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Removed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	if m.Expired {
-		n += 2
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Entry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Types) > 0 {
+		dAtA50 := make([]byte, len(m.Types)*10)
+		var j49 int
+		for _, num := range m.Types {
+			for num >= 1<<7 {
+				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j49++
+			}
+			dAtA50[j49] = uint8(num)
+			j49++
+		}
+		i -= j49
+		copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintMap(dAtA, i, uint64(j49))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Inserted != nil {
+		{
+			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Removed != nil {
+		{
+			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Patch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Patch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Patch != nil {
+		{
+			size := m.Patch.Size()
+			i -= size
+			if _, err := m.Patch.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Patch_JsonPatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_JsonPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonPatch != nil {
+		i -= len(m.JsonPatch)
+		copy(dAtA[i:], m.JsonPatch)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.JsonPatch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Patch_MergePatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_MergePatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MergePatch != nil {
+		i -= len(m.MergePatch)
+		copy(dAtA[i:], m.MergePatch)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.MergePatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *VersionedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *SizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovMap(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *PutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevVersion != 0 {
+		n += 1 + sovMap(uint64(m.PrevVersion))
+	}
+	if m.Ephemeral {
+		n += 2
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	if m.PrevValue != nil {
+		l = m.PrevValue.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Ephemeral {
+		n += 2
+	}
+	return n
+}
+
+func (m *InsertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevVersion != 0 {
+		n += 1 + sovMap(uint64(m.PrevVersion))
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	l = m.PrevValue.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *RemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevVersion != 0 {
+		n += 1 + sovMap(uint64(m.PrevVersion))
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *GetAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutAllRequest_Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PutAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutAllResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	if m.PrevValue != nil {
+		l = m.PrevValue.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClearRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *ClearResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if m.Watch {
+		n += 2
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMap(uint64(m.Limit))
+	}
+	l = len(m.Continuation)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Continuation)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitRequest_Operation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	return n
+}
+
+func (m *CommitRequest_Operation_Put) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitRequest_Operation_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Insert != nil {
+		l = m.Insert.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitRequest_Operation_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitRequest_Operation_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitRequest_Put) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevVersion != 0 {
+		n += 1 + sovMap(uint64(m.PrevVersion))
+	}
+	return n
+}
+
+func (m *CommitRequest_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitRequest_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevVersion != 0 {
+		n += 1 + sovMap(uint64(m.PrevVersion))
+	}
+	return n
+}
+
+func (m *CommitRequest_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevVersion != 0 {
+		n += 1 + sovMap(uint64(m.PrevVersion))
+	}
+	return n
+}
+
+func (m *CommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommitResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		n += m.Result.Size()
+	}
+	return n
+}
+
+func (m *CommitResponse_Result_Put) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitResponse_Result_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Insert != nil {
+		l = m.Insert.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitResponse_Result_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitResponse_Result_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *CommitResponse_Put) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	if m.PrevValue != nil {
+		l = m.PrevValue.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitResponse_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *CommitResponse_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	l = m.PrevValue.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *CommitResponse_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Query != nil {
+		n += m.Query.Size()
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMap(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryRequest_Equals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Equals != nil {
+		l = len(m.Equals)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *QueryRequest_Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *QueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovMap(uint64(e))
+		}
+		n += 1 + sovMap(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *Event_Inserted_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inserted != nil {
+		l = m.Inserted.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Updated_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Removed_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removed != nil {
+		l = m.Removed.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Inserted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *Event_Updated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = m.PrevValue.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *Event_Removed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *Fence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovMap(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *Patch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Patch != nil {
+		n += m.Patch.Size()
+	}
+	return n
+}
+
+func (m *Patch_JsonPatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonPatch != nil {
+		l = len(m.JsonPatch)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Patch_MergePatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergePatch != nil {
+		l = len(m.MergePatch)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *VersionedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMap(uint64(m.Version))
+	}
+	return n
+}
+
+func sovMap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMap(x uint64) (n int) {
+	return sovMap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
+			}
+			m.PrevVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ephemeral", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ephemeral = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fence == nil {
+				m.Fence = &Fence{}
+			}
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevValue == nil {
+				m.PrevValue = &VersionedValue{}
+			}
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ephemeral", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ephemeral = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
+			}
+			m.PrevVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fence == nil {
+				m.Fence = &Fence{}
+			}
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
+			}
+			m.PrevVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fence == nil {
+				m.Fence = &Fence{}
+			}
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *VersionedValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovMap(uint64(m.Version))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMap(x uint64) (n int) {
-	return sovMap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5587,10 +9647,10 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5626,6 +9686,38 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -5647,7 +9739,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5670,17 +9762,17 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.Size_ = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -5690,11 +9782,26 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -5716,7 +9823,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutRequest) Unmarshal(dAtA []byte) error {
+func (m *PutAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5739,10 +9846,10 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5779,6 +9886,90 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PutAllRequest_Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutAllRequest_Entry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
@@ -5810,7 +10001,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -5844,9 +10035,95 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5873,32 +10150,11 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, PutAllResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
-			}
-			m.PrevVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -5920,7 +10176,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutResponse) Unmarshal(dAtA []byte) error {
+func (m *PutAllResponse_Result) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5943,13 +10199,45 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
@@ -5968,7 +10256,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
@@ -6025,7 +10313,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6048,10 +10336,10 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6089,7 +10377,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6117,45 +10405,61 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6182,10 +10486,8 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6210,7 +10512,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6233,17 +10535,17 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -6253,11 +10555,25 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -6279,7 +10595,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ClearResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6302,50 +10618,67 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -6355,29 +10688,30 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -6387,29 +10721,27 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6436,32 +10768,13 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
-			}
-			m.PrevVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -6483,7 +10796,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *LockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6506,64 +10819,12 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -6585,7 +10846,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6608,10 +10869,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6649,7 +10910,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6677,7 +10938,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6700,7 +10961,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6723,45 +10984,12 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -6783,7 +11011,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6806,17 +11034,102 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Watch = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -6826,28 +11139,27 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StartKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6875,13 +11187,13 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.EndKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.PrevVersion = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -6891,66 +11203,16 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevVersion |= uint64(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Continuation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -6960,24 +11222,23 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Continuation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7000,7 +11261,7 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearRequest) Unmarshal(dAtA []byte) error {
+func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7023,15 +11284,15 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7058,60 +11319,42 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continuation", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClearResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClearResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Continuation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -7133,7 +11376,7 @@ func (m *ClearResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7156,10 +11399,10 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7197,9 +11440,9 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7209,27 +11452,29 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.Operations = append(m.Operations, CommitRequest_Operation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7256,10 +11501,10 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timeout == nil {
-				m.Timeout = new(time.Duration)
+			if m.Fence == nil {
+				m.Fence = &Fence{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7284,7 +11529,7 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7307,65 +11552,50 @@ func (m *LockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &CommitRequest_Put{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Operation = &CommitRequest_Operation_Put{v}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7392,15 +11622,17 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CommitRequest_Insert{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Operation = &CommitRequest_Operation_Insert{v}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7410,74 +11642,62 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
+			v := &CommitRequest_Update{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			m.Operation = &CommitRequest_Operation_Update{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &CommitRequest_Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Operation = &CommitRequest_Operation_Remove{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -7499,7 +11719,7 @@ func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitRequest_Put) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7522,17 +11742,17 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Put: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Put: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7542,30 +11762,29 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7575,65 +11794,29 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Watch = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7660,10 +11843,32 @@ func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
+			}
+			m.PrevVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -7685,7 +11890,7 @@ func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitRequest_Insert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7708,17 +11913,49 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Insert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Insert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7728,28 +11965,29 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7776,8 +12014,10 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operations = append(m.Operations, CommitRequest_Operation{})
-			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7802,7 +12042,7 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
+func (m *CommitRequest_Update) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7825,17 +12065,17 @@ func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7845,32 +12085,29 @@ func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitRequest_Put{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Operation = &CommitRequest_Operation_Put{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7880,30 +12117,29 @@ func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitRequest_Insert{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Operation = &CommitRequest_Operation_Insert{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7930,17 +12166,18 @@ func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitRequest_Update{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Operation = &CommitRequest_Operation_Update{v}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
 			}
-			var msglen int
+			m.PrevVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -7950,27 +12187,11 @@ func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CommitRequest_Remove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Operation = &CommitRequest_Operation_Remove{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -7992,7 +12213,7 @@ func (m *CommitRequest_Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest_Put) Unmarshal(dAtA []byte) error {
+func (m *CommitRequest_Remove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8015,10 +12236,10 @@ func (m *CommitRequest_Put) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Put: wiretype end group for non-group")
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Put: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8054,10 +12275,10 @@ func (m *CommitRequest_Put) Unmarshal(dAtA []byte) error {
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
 			}
-			var byteLen int
+			m.PrevVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8067,29 +12288,64 @@ func (m *CommitRequest_Put) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PrevVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8116,32 +12372,11 @@ func (m *CommitRequest_Put) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, CommitResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
-			}
-			m.PrevVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -8163,7 +12398,7 @@ func (m *CommitRequest_Put) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest_Insert) Unmarshal(dAtA []byte) error {
+func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8186,17 +12421,17 @@ func (m *CommitRequest_Insert) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Insert: wiretype end group for non-group")
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Insert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8206,29 +12441,67 @@ func (m *CommitRequest_Insert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			v := &CommitResponse_Put{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &CommitResponse_Result_Put{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CommitResponse_Insert{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &CommitResponse_Result_Insert{v}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8238,29 +12511,30 @@ func (m *CommitRequest_Insert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			v := &CommitResponse_Update{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Result = &CommitResponse_Result_Update{v}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8287,12 +12561,11 @@ func (m *CommitRequest_Insert) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			v := &CommitResponse_Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Result = &CommitResponse_Result_Remove{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8315,7 +12588,7 @@ func (m *CommitRequest_Insert) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest_Update) Unmarshal(dAtA []byte) error {
+func (m *CommitResponse_Put) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8338,17 +12611,17 @@ func (m *CommitRequest_Update) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+			return fmt.Errorf("proto: Put: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Put: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8358,29 +12631,16 @@ func (m *CommitRequest_Update) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8390,67 +12650,83 @@ func (m *CommitRequest_Update) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			if m.PrevValue == nil {
+				m.PrevValue = &VersionedValue{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitResponse_Insert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Insert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Insert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.PrevVersion = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8460,7 +12736,7 @@ func (m *CommitRequest_Update) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevVersion |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8486,7 +12762,7 @@ func (m *CommitRequest_Update) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest_Remove) Unmarshal(dAtA []byte) error {
+func (m *CommitResponse_Update) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8509,17 +12785,17 @@ func (m *CommitRequest_Remove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8529,29 +12805,16 @@ func (m *CommitRequest_Remove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
-			m.PrevVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8561,11 +12824,25 @@ func (m *CommitRequest_Remove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevVersion |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -8587,7 +12864,7 @@ func (m *CommitRequest_Remove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitResponse_Remove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8610,15 +12887,15 @@ func (m *CommitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8645,8 +12922,7 @@ func (m *CommitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, CommitResponse_Result{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8671,7 +12947,7 @@ func (m *CommitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8694,15 +12970,15 @@ func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8729,17 +13005,47 @@ func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitResponse_Put{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Result = &CommitResponse_Result_Put{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8749,30 +13055,28 @@ func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitResponse_Insert{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Result = &CommitResponse_Result_Insert{v}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Query = &QueryRequest_Equals{v}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8799,17 +13103,17 @@ func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitResponse_Update{}
+			v := &Range{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Result = &CommitResponse_Result_Update{v}
+			m.Query = &QueryRequest_Range{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -8819,27 +13123,11 @@ func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CommitResponse_Remove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Result = &CommitResponse_Result_Remove{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -8861,7 +13149,7 @@ func (m *CommitResponse_Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitResponse_Put) Unmarshal(dAtA []byte) error {
+func (m *QueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8884,34 +13172,15 @@ func (m *CommitResponse_Put) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Put: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Put: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8938,10 +13207,8 @@ func (m *CommitResponse_Put) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevValue == nil {
-				m.PrevValue = &VersionedValue{}
-			}
-			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8966,7 +13233,7 @@ func (m *CommitResponse_Put) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitResponse_Insert) Unmarshal(dAtA []byte) error {
+func (m *Range) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8989,17 +13256,17 @@ func (m *CommitResponse_Insert) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Insert: wiretype end group for non-group")
+			return fmt.Errorf("proto: Range: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Insert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -9009,83 +13276,31 @@ func (m *CommitResponse_Insert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitResponse_Update) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Update: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if m.Min == nil {
+				m.Min = &Bound{}
 			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9112,7 +13327,10 @@ func (m *CommitResponse_Update) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Max == nil {
+				m.Max = &Bound{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9137,7 +13355,7 @@ func (m *CommitResponse_Update) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitResponse_Remove) Unmarshal(dAtA []byte) error {
+func (m *Bound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9160,17 +13378,17 @@ func (m *CommitResponse_Remove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -9180,23 +13398,24 @@ func (m *CommitResponse_Remove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9251,9 +13470,106 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -9263,28 +13579,27 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9312,8 +13627,77 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v EventsRequest_EventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EventsRequest_EventType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Types = append(m.Types, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Types) == 0 {
+					m.Types = make([]EventsRequest_EventType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EventsRequest_EventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EventsRequest_EventType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Types = append(m.Types, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -9584,6 +13968,42 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &Event_Removed_{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -10025,6 +14445,223 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Fence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			m.Token = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Token |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Patch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Patch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Patch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_JsonPatch{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_MergePatch{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *VersionedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Config struct {
 	Cache CacheConfig `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache"`
+	// indexes are the secondary indexes maintained on the values in the map
+	Indexes []IndexConfig `protobuf:"bytes,2,rep,name=indexes,proto3" json:"indexes"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -72,6 +74,13 @@ func (m *Config) GetCache() CacheConfig {
 	return CacheConfig{}
 }
 
+func (m *Config) GetIndexes() []IndexConfig {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
 type CacheConfig struct {
 	Enabled bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	Size_   uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
@@ -124,6 +133,102 @@ func (m *CacheConfig) GetSize_() uint64 {
 	return 0
 }
 
+// IndexConfig declares a secondary index on the values in the map
+// Values from which the extractor cannot extract an indexed value are not indexed.
+type IndexConfig struct {
+	// name is the name by which the index is queried
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Extractor:
+	//	*IndexConfig_JsonPath
+	//	*IndexConfig_PrefixLength
+	Extractor isIndexConfig_Extractor `protobuf_oneof:"extractor"`
+}
+
+func (m *IndexConfig) Reset()         { *m = IndexConfig{} }
+func (m *IndexConfig) String() string { return proto.CompactTextString(m) }
+func (*IndexConfig) ProtoMessage()    {}
+func (*IndexConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dc6c084a686856c, []int{2}
+}
+func (m *IndexConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexConfig.Merge(m, src)
+}
+func (m *IndexConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexConfig proto.InternalMessageInfo
+
+type isIndexConfig_Extractor interface {
+	isIndexConfig_Extractor()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type IndexConfig_JsonPath struct {
+	JsonPath string `protobuf:"bytes,2,opt,name=json_path,json=jsonPath,proto3,oneof" json:"json_path,omitempty"`
+}
+type IndexConfig_PrefixLength struct {
+	PrefixLength uint32 `protobuf:"varint,3,opt,name=prefix_length,json=prefixLength,proto3,oneof" json:"prefix_length,omitempty"`
+}
+
+func (*IndexConfig_JsonPath) isIndexConfig_Extractor()     {}
+func (*IndexConfig_PrefixLength) isIndexConfig_Extractor() {}
+
+func (m *IndexConfig) GetExtractor() isIndexConfig_Extractor {
+	if m != nil {
+		return m.Extractor
+	}
+	return nil
+}
+
+func (m *IndexConfig) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IndexConfig) GetJsonPath() string {
+	if x, ok := m.GetExtractor().(*IndexConfig_JsonPath); ok {
+		return x.JsonPath
+	}
+	return ""
+}
+
+func (m *IndexConfig) GetPrefixLength() uint32 {
+	if x, ok := m.GetExtractor().(*IndexConfig_PrefixLength); ok {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IndexConfig) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IndexConfig_JsonPath)(nil),
+		(*IndexConfig_PrefixLength)(nil),
+	}
+}
+
 type CreateRequest struct {
 	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Tags []string       `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
@@ -133,7 +238,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dc6c084a686856c, []int{2}
+	return fileDescriptor_5dc6c084a686856c, []int{3}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,7 +289,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dc6c084a686856c, []int{3}
+	return fileDescriptor_5dc6c084a686856c, []int{4}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +333,7 @@ func (m *CloseRequest) Reset()         { *m = CloseRequest{} }
 func (m *CloseRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseRequest) ProtoMessage()    {}
 func (*CloseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dc6c084a686856c, []int{4}
+	return fileDescriptor_5dc6c084a686856c, []int{5}
 }
 func (m *CloseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +376,7 @@ func (m *CloseResponse) Reset()         { *m = CloseResponse{} }
 func (m *CloseResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseResponse) ProtoMessage()    {}
 func (*CloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dc6c084a686856c, []int{5}
+	return fileDescriptor_5dc6c084a686856c, []int{6}
 }
 func (m *CloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,6 +408,7 @@ var xxx_messageInfo_CloseResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*Config)(nil), "atomix.runtime.map.v1.Config")
 	proto.RegisterType((*CacheConfig)(nil), "atomix.runtime.map.v1.CacheConfig")
+	proto.RegisterType((*IndexConfig)(nil), "atomix.runtime.map.v1.IndexConfig")
 	proto.RegisterType((*CreateRequest)(nil), "atomix.runtime.map.v1.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "atomix.runtime.map.v1.CreateResponse")
 	proto.RegisterType((*CloseRequest)(nil), "atomix.runtime.map.v1.CloseRequest")
@@ -312,32 +418,37 @@ func init() {
 func init() { proto.RegisterFile("runtime/map/v1/maps.proto", fileDescriptor_5dc6c084a686856c) }
 
 var fileDescriptor_5dc6c084a686856c = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
-	0x18, 0x85, 0x33, 0x31, 0x8d, 0xfa, 0xd7, 0x2a, 0x0c, 0x15, 0x62, 0xc0, 0xb4, 0xc4, 0x0a, 0x5d,
-	0x25, 0xa4, 0x6e, 0xc4, 0x82, 0x8b, 0xa4, 0x0b, 0x2b, 0x14, 0x4a, 0x40, 0xb7, 0x32, 0x6d, 0xc7,
-	0x38, 0xd0, 0x64, 0x62, 0x26, 0x0d, 0xe2, 0x53, 0xf8, 0x2a, 0xbe, 0x45, 0x97, 0x5d, 0xba, 0x2a,
-	0x92, 0xbe, 0x88, 0x64, 0x92, 0x40, 0x90, 0xdb, 0x7b, 0x37, 0x77, 0x95, 0x3f, 0x99, 0x73, 0xfe,
-	0xf3, 0x9d, 0x30, 0xf0, 0x22, 0x3b, 0x24, 0x39, 0x8b, 0xa9, 0x1b, 0x93, 0xd4, 0x2d, 0xbc, 0xea,
-	0x21, 0x9c, 0x34, 0xe3, 0x39, 0xc7, 0xcf, 0x49, 0xce, 0x63, 0xf6, 0xc3, 0x69, 0x14, 0x4e, 0x4c,
-	0x52, 0xa7, 0xf0, 0x4c, 0xa3, 0x75, 0x14, 0x9e, 0xdb, 0x1e, 0x49, 0x83, 0x39, 0x8c, 0x78, 0xc4,
-	0xe5, 0xe8, 0x56, 0x53, 0xfd, 0xd5, 0xfe, 0x00, 0x7a, 0xc0, 0x93, 0xaf, 0x2c, 0xc2, 0xef, 0xa1,
-	0xb7, 0x25, 0xdb, 0x6f, 0xd4, 0x40, 0x63, 0x34, 0xed, 0xcf, 0x6c, 0xe7, 0xc6, 0x00, 0x27, 0xa8,
-	0x34, 0xb5, 0xc5, 0xd7, 0x8e, 0xe7, 0x91, 0x12, 0xd6, 0x36, 0x7b, 0x0e, 0xfd, 0xce, 0x19, 0x36,
-	0xe0, 0x21, 0x4d, 0xc8, 0x66, 0x4f, 0x77, 0x72, 0xe1, 0xa3, 0xb0, 0x7d, 0xc5, 0x18, 0x34, 0xc1,
-	0x7e, 0x52, 0x43, 0x1d, 0xa3, 0xa9, 0x16, 0xca, 0xd9, 0xfe, 0x02, 0x83, 0x20, 0xa3, 0x24, 0xa7,
-	0x21, 0xfd, 0x7e, 0xa0, 0x22, 0xc7, 0xef, 0x40, 0x65, 0xbb, 0x06, 0xc5, 0xfa, 0x1f, 0xa5, 0xf0,
-	0x9c, 0x75, 0xc6, 0x62, 0x96, 0xb3, 0x82, 0x2e, 0x17, 0x3e, 0x54, 0x18, 0xe5, 0x79, 0xa4, 0x2e,
-	0x17, 0xa1, 0xca, 0x64, 0x40, 0x4e, 0x22, 0x61, 0xa8, 0xe3, 0x07, 0xd3, 0xc7, 0xa1, 0x9c, 0xed,
-	0x15, 0x3c, 0x6d, 0x03, 0x44, 0xca, 0x13, 0x41, 0xf1, 0x1c, 0xf4, 0xad, 0x44, 0x6d, 0x52, 0x5e,
-	0x5e, 0x2b, 0xdc, 0xed, 0xda, 0x58, 0xec, 0x8f, 0xf0, 0x24, 0xd8, 0x73, 0x71, 0x1f, 0xb8, 0xf6,
-	0x33, 0x18, 0x34, 0xbb, 0x6a, 0xb2, 0xd9, 0x6f, 0x04, 0xda, 0x8a, 0xa4, 0x02, 0x7f, 0x02, 0xbd,
-	0x86, 0xc6, 0x93, 0x6b, 0x70, 0xdd, 0x9f, 0x66, 0xbe, 0xbe, 0x43, 0xd5, 0x34, 0x0f, 0xa1, 0x27,
-	0x03, 0xf1, 0xab, 0x6b, 0xfa, 0x4e, 0x35, 0x73, 0x72, 0xbb, 0xa8, 0xde, 0xe9, 0xbf, 0x3d, 0x96,
-	0x16, 0x3a, 0x95, 0x16, 0xfa, 0x5b, 0x5a, 0xe8, 0xd7, 0xc5, 0x52, 0x4e, 0x17, 0x4b, 0xf9, 0x73,
-	0xb1, 0x14, 0x18, 0x32, 0xde, 0x6e, 0x20, 0x29, 0x6b, 0xdc, 0xbe, 0x5e, 0x15, 0xfc, 0xec, 0xad,
-	0xd1, 0x46, 0x97, 0x17, 0xf1, 0xcd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xa0, 0xae, 0xac,
-	0xec, 0x02, 0x00, 0x00,
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xc1, 0x6a, 0xdb, 0x40,
+	0x14, 0xd4, 0x3a, 0x8e, 0x12, 0x3d, 0xc7, 0x2d, 0x2c, 0x29, 0xa8, 0x86, 0x28, 0x46, 0x4d, 0xc0,
+	0x27, 0x19, 0xa5, 0x97, 0xd2, 0x40, 0x0f, 0x72, 0x0e, 0x71, 0x69, 0xc0, 0x2c, 0xb4, 0x57, 0xb3,
+	0xb1, 0x37, 0xf2, 0x16, 0x4b, 0xab, 0x6a, 0x37, 0xc2, 0xf4, 0x1b, 0x7a, 0xe8, 0xaf, 0xf4, 0x2f,
+	0x72, 0xcc, 0xb1, 0xa7, 0x50, 0xec, 0x1f, 0x29, 0xda, 0x95, 0x40, 0x2d, 0x75, 0x7a, 0xc9, 0xc9,
+	0xcf, 0x4f, 0x33, 0xf3, 0x66, 0x46, 0x08, 0x5e, 0xe6, 0xb7, 0xa9, 0xe2, 0x09, 0x1b, 0x26, 0x34,
+	0x1b, 0x16, 0x61, 0xf9, 0x23, 0x83, 0x2c, 0x17, 0x4a, 0xe0, 0x17, 0x54, 0x89, 0x84, 0xaf, 0x82,
+	0x0a, 0x11, 0x24, 0x34, 0x0b, 0x8a, 0xb0, 0xe7, 0xd6, 0x8c, 0x22, 0x1c, 0xd6, 0x8f, 0x34, 0xa1,
+	0x77, 0x18, 0x8b, 0x58, 0xe8, 0x71, 0x58, 0x4e, 0x66, 0xeb, 0x7f, 0x43, 0x60, 0x8f, 0x44, 0x7a,
+	0xc3, 0x63, 0xfc, 0x0e, 0x76, 0x67, 0x74, 0xb6, 0x60, 0x2e, 0xea, 0xa3, 0x41, 0xe7, 0xcc, 0x0f,
+	0xfe, 0x79, 0x21, 0x18, 0x95, 0x18, 0x43, 0x89, 0xda, 0x77, 0x0f, 0xc7, 0x16, 0x31, 0x34, 0x1c,
+	0xc1, 0x1e, 0x4f, 0xe7, 0x6c, 0xc5, 0xa4, 0xdb, 0xea, 0xef, 0x3c, 0xa2, 0x30, 0x2e, 0x51, 0x7f,
+	0x28, 0xd4, 0x44, 0xff, 0x1c, 0x3a, 0x0d, 0x7d, 0xec, 0xc2, 0x1e, 0x4b, 0xe9, 0xf5, 0x92, 0xcd,
+	0xb5, 0xa9, 0x7d, 0x52, 0xff, 0xc5, 0x18, 0xda, 0x92, 0x7f, 0x65, 0x6e, 0xab, 0x8f, 0x06, 0x6d,
+	0xa2, 0x67, 0x5f, 0x41, 0xa7, 0x21, 0x5d, 0x42, 0x52, 0x9a, 0x98, 0x38, 0x0e, 0xd1, 0x33, 0x3e,
+	0x02, 0xe7, 0xb3, 0x14, 0xe9, 0x34, 0xa3, 0x6a, 0xa1, 0xb9, 0xce, 0xa5, 0x45, 0xf6, 0xcb, 0xd5,
+	0x84, 0xaa, 0x05, 0x3e, 0x85, 0x6e, 0x96, 0xb3, 0x1b, 0xbe, 0x9a, 0x2e, 0x59, 0x1a, 0xab, 0x85,
+	0xbb, 0xd3, 0x47, 0x83, 0xee, 0xa5, 0x45, 0x0e, 0xcc, 0xfa, 0x83, 0xde, 0x46, 0x1d, 0x70, 0xd8,
+	0x4a, 0xe5, 0x74, 0xa6, 0x44, 0xee, 0x4f, 0xa1, 0x3b, 0xca, 0x19, 0x55, 0x8c, 0xb0, 0x2f, 0xb7,
+	0x4c, 0x2a, 0xfc, 0x16, 0x5a, 0x7c, 0x5e, 0x95, 0xe8, 0xfd, 0x5d, 0x41, 0x11, 0x06, 0x93, 0x9c,
+	0x27, 0x5c, 0xf1, 0x82, 0x8d, 0x2f, 0x22, 0x28, 0xe3, 0xaf, 0x1f, 0x8e, 0x5b, 0xe3, 0x0b, 0xd2,
+	0xe2, 0x3a, 0x96, 0xa2, 0xb1, 0x29, 0xd0, 0x21, 0x7a, 0xf6, 0xaf, 0xe0, 0x59, 0x7d, 0x40, 0x66,
+	0x22, 0x95, 0x0c, 0x9f, 0x83, 0x3d, 0xd3, 0x19, 0xab, 0x2b, 0x47, 0xdb, 0x5e, 0x55, 0xb3, 0xe3,
+	0x8a, 0xe2, 0xbf, 0x87, 0x83, 0xd1, 0x52, 0xc8, 0xa7, 0xb0, 0xeb, 0x3f, 0x87, 0x6e, 0xa5, 0x65,
+	0x9c, 0x9d, 0xfd, 0x40, 0xd0, 0xbe, 0xa2, 0x99, 0xc4, 0x1f, 0xc1, 0x36, 0xa6, 0xf1, 0xc9, 0x36,
+	0x73, 0xcd, 0xd2, 0x7a, 0xa7, 0xff, 0x41, 0x55, 0xc9, 0x09, 0xec, 0xea, 0x83, 0xf8, 0xd5, 0x36,
+	0x7c, 0x23, 0x5a, 0xef, 0xe4, 0x71, 0x90, 0xd1, 0x8c, 0xde, 0xdc, 0xad, 0x3d, 0x74, 0xbf, 0xf6,
+	0xd0, 0xaf, 0xb5, 0x87, 0xbe, 0x6f, 0x3c, 0xeb, 0x7e, 0xe3, 0x59, 0x3f, 0x37, 0x9e, 0x05, 0x87,
+	0x5c, 0xd4, 0x0a, 0x34, 0xe3, 0x15, 0x3b, 0xb2, 0xcb, 0x80, 0x9f, 0xc2, 0x09, 0xba, 0xb6, 0xf5,
+	0x37, 0xf4, 0xfa, 0xf7, 0x00, 0x8d, 0xdb, 0xe0, 0x68, 0xa7, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -480,6 +591,20 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -531,6 +656,71 @@ func (m *CacheConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IndexConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Extractor != nil {
+		{
+			size := m.Extractor.Size()
+			i -= size
+			if _, err := m.Extractor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMaps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexConfig_JsonPath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexConfig_JsonPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonPath)
+	copy(dAtA[i:], m.JsonPath)
+	i = encodeVarintMaps(dAtA, i, uint64(len(m.JsonPath)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *IndexConfig_PrefixLength) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexConfig_PrefixLength) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintMaps(dAtA, i, uint64(m.PrefixLength))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
 func (m *CreateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -681,6 +871,12 @@ func (m *Config) Size() (n int) {
 	_ = l
 	l = m.Cache.Size()
 	n += 1 + l + sovMaps(uint64(l))
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovMaps(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -699,6 +895,41 @@ func (m *CacheConfig) Size() (n int) {
 	return n
 }
 
+func (m *IndexConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMaps(uint64(l))
+	}
+	if m.Extractor != nil {
+		n += m.Extractor.Size()
+	}
+	return n
+}
+
+func (m *IndexConfig_JsonPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPath)
+	n += 1 + l + sovMaps(uint64(l))
+	return n
+}
+func (m *IndexConfig_PrefixLength) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMaps(uint64(m.PrefixLength))
+	return n
+}
 func (m *CreateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -815,6 +1046,40 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, IndexConfig{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaps(dAtA[iNdEx:])
@@ -925,6 +1190,140 @@ func (m *CacheConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IndexConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extractor = &IndexConfig_JsonPath{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extractor = &IndexConfig_PrefixLength{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
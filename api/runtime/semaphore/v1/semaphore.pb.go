@@ -0,0 +1,1529 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: runtime/semaphore/v1/semaphore.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/atomix/atomix/api/runtime/v1"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AcquireRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// permits is the number of permits to acquire
+	// Defaults to a single permit when unset or 0
+	Permits uint64         `protobuf:"varint,2,opt,name=permits,proto3" json:"permits,omitempty"`
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+}
+
+func (m *AcquireRequest) Reset()         { *m = AcquireRequest{} }
+func (m *AcquireRequest) String() string { return proto.CompactTextString(m) }
+func (*AcquireRequest) ProtoMessage()    {}
+func (*AcquireRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0a26e12a56775db, []int{0}
+}
+func (m *AcquireRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquireRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquireRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcquireRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquireRequest.Merge(m, src)
+}
+func (m *AcquireRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcquireRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquireRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcquireRequest proto.InternalMessageInfo
+
+func (m *AcquireRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *AcquireRequest) GetPermits() uint64 {
+	if m != nil {
+		return m.Permits
+	}
+	return 0
+}
+
+func (m *AcquireRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+type AcquireResponse struct {
+}
+
+func (m *AcquireResponse) Reset()         { *m = AcquireResponse{} }
+func (m *AcquireResponse) String() string { return proto.CompactTextString(m) }
+func (*AcquireResponse) ProtoMessage()    {}
+func (*AcquireResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0a26e12a56775db, []int{1}
+}
+func (m *AcquireResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquireResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquireResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcquireResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquireResponse.Merge(m, src)
+}
+func (m *AcquireResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcquireResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquireResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcquireResponse proto.InternalMessageInfo
+
+type ReleaseRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// permits is the number of permits to release
+	// Defaults to a single permit when unset or 0
+	Permits uint64 `protobuf:"varint,2,opt,name=permits,proto3" json:"permits,omitempty"`
+}
+
+func (m *ReleaseRequest) Reset()         { *m = ReleaseRequest{} }
+func (m *ReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRequest) ProtoMessage()    {}
+func (*ReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0a26e12a56775db, []int{2}
+}
+func (m *ReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRequest.Merge(m, src)
+}
+func (m *ReleaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseRequest proto.InternalMessageInfo
+
+func (m *ReleaseRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *ReleaseRequest) GetPermits() uint64 {
+	if m != nil {
+		return m.Permits
+	}
+	return 0
+}
+
+type ReleaseResponse struct {
+}
+
+func (m *ReleaseResponse) Reset()         { *m = ReleaseResponse{} }
+func (m *ReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseResponse) ProtoMessage()    {}
+func (*ReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0a26e12a56775db, []int{3}
+}
+func (m *ReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseResponse.Merge(m, src)
+}
+func (m *ReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseResponse proto.InternalMessageInfo
+
+type GetSemaphoreRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *GetSemaphoreRequest) Reset()         { *m = GetSemaphoreRequest{} }
+func (m *GetSemaphoreRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSemaphoreRequest) ProtoMessage()    {}
+func (*GetSemaphoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0a26e12a56775db, []int{4}
+}
+func (m *GetSemaphoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSemaphoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSemaphoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSemaphoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSemaphoreRequest.Merge(m, src)
+}
+func (m *GetSemaphoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSemaphoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSemaphoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSemaphoreRequest proto.InternalMessageInfo
+
+func (m *GetSemaphoreRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+type GetSemaphoreResponse struct {
+	// permits is the total number of permits
+	Permits uint64 `protobuf:"varint,1,opt,name=permits,proto3" json:"permits,omitempty"`
+	// available is the number of permits available to be acquired
+	Available uint64 `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// waiters is the number of acquire requests queued for permits
+	Waiters uint32 `protobuf:"varint,3,opt,name=waiters,proto3" json:"waiters,omitempty"`
+}
+
+func (m *GetSemaphoreResponse) Reset()         { *m = GetSemaphoreResponse{} }
+func (m *GetSemaphoreResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSemaphoreResponse) ProtoMessage()    {}
+func (*GetSemaphoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0a26e12a56775db, []int{5}
+}
+func (m *GetSemaphoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSemaphoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSemaphoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSemaphoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSemaphoreResponse.Merge(m, src)
+}
+func (m *GetSemaphoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSemaphoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSemaphoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSemaphoreResponse proto.InternalMessageInfo
+
+func (m *GetSemaphoreResponse) GetPermits() uint64 {
+	if m != nil {
+		return m.Permits
+	}
+	return 0
+}
+
+func (m *GetSemaphoreResponse) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *GetSemaphoreResponse) GetWaiters() uint32 {
+	if m != nil {
+		return m.Waiters
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AcquireRequest)(nil), "atomix.runtime.semaphore.v1.AcquireRequest")
+	proto.RegisterType((*AcquireResponse)(nil), "atomix.runtime.semaphore.v1.AcquireResponse")
+	proto.RegisterType((*ReleaseRequest)(nil), "atomix.runtime.semaphore.v1.ReleaseRequest")
+	proto.RegisterType((*ReleaseResponse)(nil), "atomix.runtime.semaphore.v1.ReleaseResponse")
+	proto.RegisterType((*GetSemaphoreRequest)(nil), "atomix.runtime.semaphore.v1.GetSemaphoreRequest")
+	proto.RegisterType((*GetSemaphoreResponse)(nil), "atomix.runtime.semaphore.v1.GetSemaphoreResponse")
+}
+
+func init() {
+	proto.RegisterFile("runtime/semaphore/v1/semaphore.proto", fileDescriptor_b0a26e12a56775db)
+}
+
+var fileDescriptor_b0a26e12a56775db = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xac, 0xac, 0x9a, 0x07, 0x43, 0x98, 0x1d, 0x42, 0x40, 0xe9, 0x54, 0x81, 0x34,
+	0xb6, 0xc9, 0x21, 0xe3, 0x04, 0x37, 0xda, 0x4a, 0x68, 0xb7, 0x11, 0x24, 0xee, 0xee, 0xf2, 0xda,
+	0x59, 0x4a, 0xea, 0x2c, 0x76, 0x02, 0x1f, 0x81, 0x23, 0x47, 0x38, 0xf2, 0x6d, 0x76, 0xdc, 0x91,
+	0x53, 0x41, 0xed, 0x17, 0x41, 0x49, 0xec, 0xb6, 0x41, 0x28, 0x14, 0x09, 0x6e, 0xb1, 0xfd, 0x7f,
+	0xff, 0xdf, 0x7b, 0x7f, 0x3b, 0xf8, 0x71, 0x9a, 0x4d, 0x15, 0x8f, 0xc1, 0x93, 0x10, 0xb3, 0xe4,
+	0x52, 0xa4, 0xe0, 0xe5, 0xfe, 0x6a, 0x41, 0x93, 0x54, 0x28, 0x41, 0x1e, 0x32, 0x25, 0x62, 0xfe,
+	0x81, 0x6a, 0x31, 0x5d, 0x9d, 0xe7, 0xbe, 0xe3, 0x4e, 0x84, 0x98, 0x44, 0xe0, 0x95, 0xd2, 0x51,
+	0x36, 0xf6, 0xc2, 0x2c, 0x65, 0x8a, 0x8b, 0x69, 0x55, 0xec, 0xd8, 0x06, 0x91, 0xfb, 0x9e, 0x31,
+	0xa8, 0x4e, 0x9e, 0x34, 0xc3, 0xa5, 0x96, 0xed, 0x4f, 0xc4, 0x44, 0x94, 0x9f, 0x5e, 0xf1, 0x55,
+	0xed, 0xf6, 0xbe, 0x22, 0xbc, 0xf7, 0xea, 0xe2, 0x2a, 0xe3, 0x29, 0x04, 0x70, 0x95, 0x81, 0x54,
+	0xe4, 0x25, 0xb6, 0x78, 0x68, 0xa3, 0x03, 0x74, 0xb8, 0x7b, 0xea, 0xd2, 0x5f, 0x7a, 0xce, 0x7d,
+	0x7a, 0x9e, 0xf2, 0x98, 0x2b, 0x9e, 0xc3, 0xd9, 0xb0, 0x8f, 0xaf, 0x67, 0xdd, 0xd6, 0x7c, 0xd6,
+	0xb5, 0xce, 0x86, 0x81, 0xc5, 0x43, 0x62, 0xe3, 0x4e, 0x02, 0x69, 0xcc, 0x95, 0xb4, 0xad, 0x03,
+	0x74, 0xd8, 0x0e, 0xcc, 0x92, 0xbc, 0xc0, 0x9d, 0xc2, 0x40, 0x64, 0xca, 0xde, 0x2a, 0xad, 0x1f,
+	0xd0, 0x6a, 0x62, 0x6a, 0x26, 0xa6, 0x43, 0x3d, 0x71, 0xbf, 0xfd, 0xf9, 0x7b, 0x17, 0x05, 0x46,
+	0xdf, 0xbb, 0x87, 0xef, 0x2e, 0x5b, 0x94, 0x89, 0x98, 0x4a, 0xe8, 0x8d, 0xf1, 0x5e, 0x00, 0x11,
+	0x30, 0xf9, 0x7f, 0xbb, 0x2e, 0xd0, 0x4b, 0x8e, 0x46, 0xbf, 0xc1, 0xf7, 0x5f, 0x83, 0x7a, 0x6b,
+	0xe2, 0xfd, 0x07, 0xfc, 0xde, 0x25, 0xde, 0xaf, 0x5b, 0x56, 0xa8, 0xf5, 0xbe, 0x50, 0x3d, 0xcd,
+	0x47, 0x78, 0x87, 0xe5, 0x8c, 0x47, 0x6c, 0x14, 0x81, 0xee, 0x79, 0xb5, 0x51, 0xd4, 0xbd, 0x67,
+	0x5c, 0x41, 0x2a, 0xcb, 0xac, 0xef, 0x04, 0x66, 0x79, 0xfa, 0xa5, 0x8d, 0x77, 0x96, 0x1c, 0x12,
+	0xe2, 0x8e, 0x0e, 0x96, 0x1c, 0xd3, 0x86, 0xc7, 0x49, 0xeb, 0x2f, 0xc4, 0x39, 0xd9, 0x4c, 0xac,
+	0xa7, 0x08, 0x71, 0x47, 0x67, 0xf8, 0x07, 0x4a, 0xfd, 0x46, 0x9d, 0x93, 0xcd, 0xc4, 0x9a, 0x22,
+	0xf1, 0xed, 0xf5, 0x0c, 0xc9, 0xb3, 0xc6, 0xea, 0xdf, 0xdc, 0xa0, 0xe3, 0xff, 0x45, 0x85, 0x86,
+	0x8e, 0xf1, 0xf6, 0x20, 0x05, 0xa6, 0x80, 0x1c, 0x35, 0x16, 0x57, 0x22, 0x03, 0x3a, 0xde, 0x48,
+	0xab, 0x9f, 0xdb, 0xd6, 0x47, 0x0b, 0x91, 0x0b, 0x7c, 0x6b, 0x10, 0x09, 0x09, 0xe4, 0x69, 0x73,
+	0x69, 0xa1, 0x31, 0x94, 0xa3, 0x4d, 0xa4, 0x6b, 0x90, 0xfe, 0xe0, 0x7a, 0xee, 0xa2, 0x9b, 0xb9,
+	0x8b, 0x7e, 0xcc, 0x5d, 0xf4, 0x69, 0xe1, 0xb6, 0x6e, 0x16, 0x6e, 0xeb, 0xdb, 0xc2, 0x6d, 0x61,
+	0x87, 0x0b, 0x63, 0xc6, 0x12, 0x5e, 0x33, 0xea, 0xef, 0x2e, 0x53, 0x79, 0xe7, 0x9f, 0xa3, 0xd1,
+	0x76, 0xf9, 0x37, 0x3f, 0xff, 0x39, 0x00, 0xe2, 0x7b, 0xcc, 0xcd, 0x12, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SemaphoreClient is the client API for Semaphore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SemaphoreClient interface {
+	// Acquire attempts to acquire permits from the semaphore
+	Acquire(ctx context.Context, in *AcquireRequest, opts ...grpc.CallOption) (*AcquireResponse, error)
+	// Release releases permits to the semaphore
+	Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error)
+	// GetSemaphore gets the semaphore state
+	GetSemaphore(ctx context.Context, in *GetSemaphoreRequest, opts ...grpc.CallOption) (*GetSemaphoreResponse, error)
+	// Create creates the Semaphore
+	// Deprecated: use the Semaphores service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the Semaphore
+	// Deprecated: use the Semaphores service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+}
+
+type semaphoreClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSemaphoreClient(cc *grpc.ClientConn) SemaphoreClient {
+	return &semaphoreClient{cc}
+}
+
+func (c *semaphoreClient) Acquire(ctx context.Context, in *AcquireRequest, opts ...grpc.CallOption) (*AcquireResponse, error) {
+	out := new(AcquireResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.semaphore.v1.Semaphore/Acquire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *semaphoreClient) Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error) {
+	out := new(ReleaseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.semaphore.v1.Semaphore/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *semaphoreClient) GetSemaphore(ctx context.Context, in *GetSemaphoreRequest, opts ...grpc.CallOption) (*GetSemaphoreResponse, error) {
+	out := new(GetSemaphoreResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.semaphore.v1.Semaphore/GetSemaphore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *semaphoreClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.semaphore.v1.Semaphore/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *semaphoreClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.semaphore.v1.Semaphore/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SemaphoreServer is the server API for Semaphore service.
+type SemaphoreServer interface {
+	// Acquire attempts to acquire permits from the semaphore
+	Acquire(context.Context, *AcquireRequest) (*AcquireResponse, error)
+	// Release releases permits to the semaphore
+	Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error)
+	// GetSemaphore gets the semaphore state
+	GetSemaphore(context.Context, *GetSemaphoreRequest) (*GetSemaphoreResponse, error)
+	// Create creates the Semaphore
+	// Deprecated: use the Semaphores service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the Semaphore
+	// Deprecated: use the Semaphores service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
+}
+
+// UnimplementedSemaphoreServer can be embedded to have forward compatible implementations.
+type UnimplementedSemaphoreServer struct {
+}
+
+func (*UnimplementedSemaphoreServer) Acquire(ctx context.Context, req *AcquireRequest) (*AcquireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acquire not implemented")
+}
+func (*UnimplementedSemaphoreServer) Release(ctx context.Context, req *ReleaseRequest) (*ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (*UnimplementedSemaphoreServer) GetSemaphore(ctx context.Context, req *GetSemaphoreRequest) (*GetSemaphoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemaphore not implemented")
+}
+func (*UnimplementedSemaphoreServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedSemaphoreServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+
+func RegisterSemaphoreServer(s *grpc.Server, srv SemaphoreServer) {
+	s.RegisterService(&_Semaphore_serviceDesc, srv)
+}
+
+func _Semaphore_Acquire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemaphoreServer).Acquire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.semaphore.v1.Semaphore/Acquire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemaphoreServer).Acquire(ctx, req.(*AcquireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Semaphore_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemaphoreServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.semaphore.v1.Semaphore/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemaphoreServer).Release(ctx, req.(*ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Semaphore_GetSemaphore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemaphoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemaphoreServer).GetSemaphore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.semaphore.v1.Semaphore/GetSemaphore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemaphoreServer).GetSemaphore(ctx, req.(*GetSemaphoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Semaphore_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemaphoreServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.semaphore.v1.Semaphore/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemaphoreServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Semaphore_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SemaphoreServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.semaphore.v1.Semaphore/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SemaphoreServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Semaphore_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.semaphore.v1.Semaphore",
+	HandlerType: (*SemaphoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Acquire",
+			Handler:    _Semaphore_Acquire_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Semaphore_Release_Handler,
+		},
+		{
+			MethodName: "GetSemaphore",
+			Handler:    _Semaphore_GetSemaphore_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Semaphore_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _Semaphore_Close_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "runtime/semaphore/v1/semaphore.proto",
+}
+
+func (m *AcquireRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcquireRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquireRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintSemaphore(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Permits != 0 {
+		i = encodeVarintSemaphore(dAtA, i, uint64(m.Permits))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSemaphore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AcquireResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcquireResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquireResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permits != 0 {
+		i = encodeVarintSemaphore(dAtA, i, uint64(m.Permits))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSemaphore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSemaphoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSemaphoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSemaphoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSemaphore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSemaphoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSemaphoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSemaphoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Waiters != 0 {
+		i = encodeVarintSemaphore(dAtA, i, uint64(m.Waiters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Available != 0 {
+		i = encodeVarintSemaphore(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Permits != 0 {
+		i = encodeVarintSemaphore(dAtA, i, uint64(m.Permits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSemaphore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSemaphore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AcquireRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovSemaphore(uint64(l))
+	if m.Permits != 0 {
+		n += 1 + sovSemaphore(uint64(m.Permits))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovSemaphore(uint64(l))
+	}
+	return n
+}
+
+func (m *AcquireResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReleaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovSemaphore(uint64(l))
+	if m.Permits != 0 {
+		n += 1 + sovSemaphore(uint64(m.Permits))
+	}
+	return n
+}
+
+func (m *ReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSemaphoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovSemaphore(uint64(l))
+	return n
+}
+
+func (m *GetSemaphoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permits != 0 {
+		n += 1 + sovSemaphore(uint64(m.Permits))
+	}
+	if m.Available != 0 {
+		n += 1 + sovSemaphore(uint64(m.Available))
+	}
+	if m.Waiters != 0 {
+		n += 1 + sovSemaphore(uint64(m.Waiters))
+	}
+	return n
+}
+
+func sovSemaphore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSemaphore(x uint64) (n int) {
+	return sovSemaphore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AcquireRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSemaphore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcquireRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcquireRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permits", wireType)
+			}
+			m.Permits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSemaphore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcquireResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSemaphore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcquireResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcquireResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSemaphore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSemaphore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permits", wireType)
+			}
+			m.Permits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSemaphore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSemaphore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSemaphore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSemaphoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSemaphore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSemaphoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSemaphoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSemaphore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSemaphoreResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSemaphore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSemaphoreResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSemaphoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permits", wireType)
+			}
+			m.Permits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiters", wireType)
+			}
+			m.Waiters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Waiters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSemaphore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSemaphore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSemaphore(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSemaphore
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSemaphore
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSemaphore
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSemaphore
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSemaphore
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSemaphore        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSemaphore          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSemaphore = fmt.Errorf("proto: unexpected end of group")
+)
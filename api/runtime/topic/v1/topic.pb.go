@@ -32,9 +32,48 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SubscribeRequest_Position int32
+
+const (
+	// LATEST delivers only messages published after the subscription is created
+	SubscribeRequest_LATEST SubscribeRequest_Position = 0
+	// EARLIEST replays all retained messages
+	SubscribeRequest_EARLIEST SubscribeRequest_Position = 1
+	// OFFSET replays retained messages starting at the requested offset
+	SubscribeRequest_OFFSET SubscribeRequest_Position = 2
+	// TIMESTAMP replays retained messages published at or after the requested timestamp
+	SubscribeRequest_TIMESTAMP SubscribeRequest_Position = 3
+)
+
+var SubscribeRequest_Position_name = map[int32]string{
+	0: "LATEST",
+	1: "EARLIEST",
+	2: "OFFSET",
+	3: "TIMESTAMP",
+}
+
+var SubscribeRequest_Position_value = map[string]int32{
+	"LATEST":    0,
+	"EARLIEST":  1,
+	"OFFSET":    2,
+	"TIMESTAMP": 3,
+}
+
+func (x SubscribeRequest_Position) String() string {
+	return proto.EnumName(SubscribeRequest_Position_name, int32(x))
+}
+
+func (SubscribeRequest_Position) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_16c36814ce7e5c5b, []int{2, 0}
+}
+
 type PublishRequest struct {
 	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Payload []byte         `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// key determines the partition to which the message is published
+	// Messages with the same key are published to the same partition; messages without a key
+	// are spread across partitions in round-robin order
+	Key []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 }
 
 func (m *PublishRequest) Reset()         { *m = PublishRequest{} }
@@ -84,7 +123,20 @@ func (m *PublishRequest) GetPayload() []byte {
 	return nil
 }
 
+func (m *PublishRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 type PublishResponse struct {
+	// offset is the offset of the published message
+	// Offsets are assigned in a single total order across all the topic's partitions
+	Offset    uint64     `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Timestamp *time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
+	// partition is the partition to which the message was published
+	Partition uint32 `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
 }
 
 func (m *PublishResponse) Reset()         { *m = PublishResponse{} }
@@ -120,8 +172,41 @@ func (m *PublishResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PublishResponse proto.InternalMessageInfo
 
+func (m *PublishResponse) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PublishResponse) GetTimestamp() *time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *PublishResponse) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
 type SubscribeRequest struct {
 	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// position is the position in the topic from which to start the subscription
+	Position SubscribeRequest_Position `protobuf:"varint,2,opt,name=position,proto3,enum=atomix.runtime.topic.v1.SubscribeRequest_Position" json:"position,omitempty"`
+	// offset is the first offset to deliver when the position is OFFSET
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// timestamp is the earliest publish time to deliver when the position is TIMESTAMP
+	Timestamp *time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
+	// group is the name of the consumer group to join
+	// The topic's partitions are shared among the members of a group, each partition being assigned
+	// to a single member at a time. Partitions are reassigned when members join or leave the group,
+	// and uncommitted messages are redelivered to the partition's new member. The position only
+	// determines the starting offset when the group is first created
+	Group string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
@@ -164,17 +249,249 @@ func (m *SubscribeRequest) GetID() v1.PrimitiveID {
 	return v1.PrimitiveID{}
 }
 
+func (m *SubscribeRequest) GetPosition() SubscribeRequest_Position {
+	if m != nil {
+		return m.Position
+	}
+	return SubscribeRequest_LATEST
+}
+
+func (m *SubscribeRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetTimestamp() *time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+type CommitOffsetRequest struct {
+	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Group string         `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// offset is the offset of a message delivered to the caller
+	// Only the group member to which the message's partition is assigned may commit the offset
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *CommitOffsetRequest) Reset()         { *m = CommitOffsetRequest{} }
+func (m *CommitOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitOffsetRequest) ProtoMessage()    {}
+func (*CommitOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16c36814ce7e5c5b, []int{3}
+}
+func (m *CommitOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitOffsetRequest.Merge(m, src)
+}
+func (m *CommitOffsetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitOffsetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitOffsetRequest proto.InternalMessageInfo
+
+func (m *CommitOffsetRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *CommitOffsetRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *CommitOffsetRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type CommitOffsetResponse struct {
+}
+
+func (m *CommitOffsetResponse) Reset()         { *m = CommitOffsetResponse{} }
+func (m *CommitOffsetResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitOffsetResponse) ProtoMessage()    {}
+func (*CommitOffsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16c36814ce7e5c5b, []int{4}
+}
+func (m *CommitOffsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitOffsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitOffsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitOffsetResponse.Merge(m, src)
+}
+func (m *CommitOffsetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitOffsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitOffsetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitOffsetResponse proto.InternalMessageInfo
+
+type FetchOffsetRequest struct {
+	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Group string         `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// partition is the partition for which to get the offset
+	Partition uint32 `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+}
+
+func (m *FetchOffsetRequest) Reset()         { *m = FetchOffsetRequest{} }
+func (m *FetchOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchOffsetRequest) ProtoMessage()    {}
+func (*FetchOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16c36814ce7e5c5b, []int{5}
+}
+func (m *FetchOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchOffsetRequest.Merge(m, src)
+}
+func (m *FetchOffsetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchOffsetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchOffsetRequest proto.InternalMessageInfo
+
+func (m *FetchOffsetRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *FetchOffsetRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *FetchOffsetRequest) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+type FetchOffsetResponse struct {
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *FetchOffsetResponse) Reset()         { *m = FetchOffsetResponse{} }
+func (m *FetchOffsetResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchOffsetResponse) ProtoMessage()    {}
+func (*FetchOffsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16c36814ce7e5c5b, []int{6}
+}
+func (m *FetchOffsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchOffsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchOffsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchOffsetResponse.Merge(m, src)
+}
+func (m *FetchOffsetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchOffsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchOffsetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchOffsetResponse proto.InternalMessageInfo
+
+func (m *FetchOffsetResponse) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type SubscribeResponse struct {
 	Offset    uint64     `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
 	Timestamp *time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
 	Payload   []byte     `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Partition uint32     `protobuf:"varint,4,opt,name=partition,proto3" json:"partition,omitempty"`
 }
 
 func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()    {}
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16c36814ce7e5c5b, []int{3}
+	return fileDescriptor_16c36814ce7e5c5b, []int{7}
 }
 func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,45 +541,71 @@ func (m *SubscribeResponse) GetPayload() []byte {
 	return nil
 }
 
+func (m *SubscribeResponse) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("atomix.runtime.topic.v1.SubscribeRequest_Position", SubscribeRequest_Position_name, SubscribeRequest_Position_value)
 	proto.RegisterType((*PublishRequest)(nil), "atomix.runtime.topic.v1.PublishRequest")
 	proto.RegisterType((*PublishResponse)(nil), "atomix.runtime.topic.v1.PublishResponse")
 	proto.RegisterType((*SubscribeRequest)(nil), "atomix.runtime.topic.v1.SubscribeRequest")
+	proto.RegisterType((*CommitOffsetRequest)(nil), "atomix.runtime.topic.v1.CommitOffsetRequest")
+	proto.RegisterType((*CommitOffsetResponse)(nil), "atomix.runtime.topic.v1.CommitOffsetResponse")
+	proto.RegisterType((*FetchOffsetRequest)(nil), "atomix.runtime.topic.v1.FetchOffsetRequest")
+	proto.RegisterType((*FetchOffsetResponse)(nil), "atomix.runtime.topic.v1.FetchOffsetResponse")
 	proto.RegisterType((*SubscribeResponse)(nil), "atomix.runtime.topic.v1.SubscribeResponse")
 }
 
 func init() { proto.RegisterFile("runtime/topic/v1/topic.proto", fileDescriptor_16c36814ce7e5c5b) }
 
 var fileDescriptor_16c36814ce7e5c5b = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x3d, 0x6f, 0xd4, 0x40,
-	0x10, 0xbd, 0xf5, 0x25, 0x77, 0xca, 0x04, 0x01, 0x59, 0xa1, 0x70, 0xb2, 0xc0, 0x17, 0x59, 0x22,
-	0x39, 0x28, 0xd6, 0xf8, 0xe8, 0x40, 0xa2, 0x70, 0xd2, 0xa4, 0x41, 0x27, 0x13, 0xd1, 0x80, 0x90,
-	0xec, 0x78, 0x6d, 0x56, 0xb2, 0xb3, 0xc6, 0xbb, 0xb6, 0xe0, 0x07, 0x20, 0xa5, 0xcc, 0xcf, 0x4a,
-	0x99, 0x92, 0x2a, 0x20, 0xdf, 0x1f, 0x41, 0xfe, 0x58, 0x73, 0x89, 0xb0, 0x8e, 0x22, 0xdd, 0x8c,
-	0xe7, 0xbd, 0x37, 0x7e, 0x6f, 0x16, 0x9e, 0x64, 0xf9, 0x99, 0x64, 0x09, 0xb5, 0x24, 0x4f, 0xd9,
-	0xa9, 0x55, 0xd8, 0x4d, 0x41, 0xd2, 0x8c, 0x4b, 0x8e, 0x1f, 0x7b, 0x92, 0x27, 0xec, 0x1b, 0x69,
-	0x41, 0xa4, 0x99, 0x15, 0xb6, 0x3e, 0x51, 0xb4, 0xc2, 0xb6, 0xd4, 0xb0, 0xa6, 0xe8, 0x4f, 0xff,
-	0x2d, 0x28, 0xda, 0xf1, 0xa3, 0x88, 0x47, 0xbc, 0x2e, 0xad, 0xaa, 0x6a, 0xbf, 0x4e, 0x23, 0xce,
-	0xa3, 0x98, 0x5a, 0x75, 0xe7, 0xe7, 0xa1, 0x55, 0x29, 0x08, 0xe9, 0x25, 0x69, 0x03, 0x30, 0x43,
-	0xb8, 0xbf, 0xc8, 0xfd, 0x98, 0x89, 0x2f, 0x2e, 0xfd, 0x9a, 0x53, 0x21, 0xf1, 0x6b, 0xd0, 0x58,
-	0x30, 0x41, 0x7b, 0x68, 0xb6, 0x3d, 0x37, 0xc8, 0xad, 0xff, 0x2c, 0x6c, 0xb2, 0xc8, 0x58, 0xc2,
-	0x24, 0x2b, 0xe8, 0xf1, 0x91, 0x03, 0x97, 0xd7, 0xd3, 0x41, 0x79, 0x3d, 0xd5, 0x8e, 0x8f, 0x5c,
-	0x8d, 0x05, 0x78, 0x02, 0xe3, 0xd4, 0xfb, 0x1e, 0x73, 0x2f, 0x98, 0x68, 0x7b, 0x68, 0x76, 0xcf,
-	0x55, 0xad, 0xb9, 0x03, 0x0f, 0xba, 0x3d, 0x22, 0xe5, 0x67, 0x82, 0x9a, 0xef, 0xe0, 0xe1, 0xfb,
-	0xdc, 0x17, 0xa7, 0x19, 0xf3, 0xe9, 0x1d, 0x2c, 0x37, 0x7f, 0x20, 0xd8, 0x59, 0x11, 0x6c, 0xb6,
-	0xe0, 0x5d, 0x18, 0xf1, 0x30, 0x14, 0x54, 0xd6, 0xaa, 0x1b, 0x6e, 0xdb, 0xe1, 0xb7, 0xb0, 0xd5,
-	0x65, 0x51, 0xff, 0xec, 0xf6, 0x5c, 0x27, 0x4d, 0x5a, 0x44, 0xa5, 0x45, 0x4e, 0x14, 0xc2, 0xd9,
-	0xb8, 0xf8, 0x35, 0x45, 0xee, 0x5f, 0xca, 0xaa, 0xd5, 0xe1, 0x0d, 0xab, 0xf3, 0xf3, 0x21, 0x6c,
-	0x9e, 0x54, 0xa7, 0xc1, 0x9f, 0x60, 0xdc, 0x9a, 0xc6, 0x07, 0xa4, 0xe7, 0xe2, 0xe4, 0x66, 0xfc,
-	0xfa, 0x6c, 0x3d, 0xb0, 0x75, 0x16, 0xc0, 0x56, 0x67, 0x17, 0x3f, 0xef, 0xa5, 0xdd, 0xce, 0x58,
-	0x7f, 0xf1, 0x3f, 0xd0, 0x66, 0xc7, 0x4b, 0x84, 0x3f, 0xc3, 0xe8, 0x30, 0xa3, 0x9e, 0xa4, 0x78,
-	0xbf, 0x97, 0xd7, 0x00, 0x94, 0xfe, 0xc1, 0x5a, 0x5c, 0xfb, 0x00, 0x86, 0xe7, 0x1a, 0xc2, 0x1f,
-	0x61, 0xf3, 0x30, 0xe6, 0x82, 0xe2, 0x67, 0xfd, 0xb4, 0x6a, 0xae, 0xd4, 0xf7, 0xd7, 0xc1, 0x56,
-	0xc4, 0x9d, 0x37, 0x97, 0xa5, 0x81, 0xae, 0x4a, 0x03, 0xfd, 0x2e, 0x0d, 0x74, 0xb1, 0x34, 0x06,
-	0x57, 0x4b, 0x63, 0xf0, 0x73, 0x69, 0x0c, 0x60, 0x97, 0x71, 0x25, 0xe4, 0xa5, 0xac, 0x13, 0x71,
-	0xc6, 0xf5, 0xe5, 0x3e, 0xd8, 0x0b, 0xe4, 0x8f, 0xea, 0x67, 0xf0, 0xea, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0xf4, 0xdf, 0xb4, 0xca, 0x03, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x4f, 0xdb, 0x4c,
+	0x10, 0xce, 0x3a, 0x1f, 0x90, 0xe1, 0xe3, 0xcd, 0xbb, 0x20, 0x1a, 0x59, 0xd4, 0x41, 0x96, 0x0a,
+	0x69, 0x0b, 0x4e, 0x93, 0xde, 0x5a, 0xa9, 0x15, 0x81, 0x20, 0x45, 0x82, 0x12, 0x39, 0x56, 0x2f,
+	0xad, 0x2a, 0x39, 0x64, 0x09, 0x2b, 0x12, 0xd6, 0xb5, 0xd7, 0x11, 0x9c, 0x7a, 0xa9, 0xd4, 0x1e,
+	0xf9, 0x0f, 0xfd, 0x33, 0x5c, 0x2a, 0x71, 0xec, 0x09, 0xaa, 0xf0, 0x47, 0x2a, 0x7f, 0xe2, 0x50,
+	0x4c, 0x52, 0x09, 0x6e, 0x3b, 0x9e, 0x67, 0x9e, 0x79, 0x66, 0xf2, 0x4c, 0x60, 0xd1, 0xb4, 0x8f,
+	0x38, 0xed, 0x91, 0x12, 0x67, 0x06, 0xdd, 0x2b, 0xf5, 0xcb, 0xde, 0x43, 0x31, 0x4c, 0xc6, 0x19,
+	0x7e, 0xa4, 0x73, 0xd6, 0xa3, 0xc7, 0x8a, 0x0f, 0x52, 0xbc, 0x5c, 0xbf, 0x2c, 0xe6, 0x83, 0xb2,
+	0x7e, 0xb9, 0x14, 0x24, 0xdd, 0x12, 0xf1, 0xf1, 0xed, 0x84, 0x96, 0x9f, 0x9e, 0xef, 0xb0, 0x0e,
+	0x73, 0x9f, 0x25, 0xe7, 0xe5, 0x7f, 0x2d, 0x74, 0x18, 0xeb, 0x74, 0x49, 0xc9, 0x8d, 0x5a, 0xf6,
+	0x7e, 0xc9, 0x61, 0xb0, 0xb8, 0xde, 0x33, 0x3c, 0x80, 0x7c, 0x0c, 0xb3, 0x0d, 0xbb, 0xd5, 0xa5,
+	0xd6, 0x81, 0x4a, 0x3e, 0xdb, 0xc4, 0xe2, 0xf8, 0x15, 0x08, 0xb4, 0x9d, 0x47, 0x4b, 0xa8, 0x38,
+	0x55, 0x91, 0x94, 0x1b, 0x3a, 0xfb, 0x65, 0xa5, 0x61, 0xd2, 0x1e, 0xe5, 0xb4, 0x4f, 0xea, 0x9b,
+	0x55, 0x38, 0xbb, 0x28, 0x24, 0x06, 0x17, 0x05, 0xa1, 0xbe, 0xa9, 0x0a, 0xb4, 0x8d, 0xf3, 0x30,
+	0x61, 0xe8, 0x27, 0x5d, 0xa6, 0xb7, 0xf3, 0xc2, 0x12, 0x2a, 0x4e, 0xab, 0x41, 0x88, 0x73, 0x90,
+	0x3c, 0x24, 0x27, 0xf9, 0xa4, 0xfb, 0xd5, 0x79, 0xca, 0xdf, 0x10, 0xfc, 0x17, 0xb6, 0xb6, 0x0c,
+	0x76, 0x64, 0x11, 0xbc, 0x00, 0x19, 0xb6, 0xbf, 0x6f, 0x11, 0xee, 0xf6, 0x4f, 0xa9, 0x7e, 0x84,
+	0xdf, 0x40, 0x36, 0x14, 0xee, 0x32, 0x4f, 0x55, 0x44, 0xc5, 0x1b, 0x4d, 0x09, 0x46, 0x53, 0xb4,
+	0x00, 0x51, 0x4d, 0x9d, 0x5e, 0x16, 0x90, 0x7a, 0x5d, 0x82, 0x17, 0x21, 0x6b, 0xe8, 0x26, 0xa7,
+	0x9c, 0xb2, 0x23, 0x57, 0xc3, 0x8c, 0x7a, 0xfd, 0x41, 0xfe, 0x29, 0x40, 0xae, 0x69, 0xb7, 0xac,
+	0x3d, 0x93, 0xb6, 0xc8, 0x7d, 0xac, 0xe1, 0x1d, 0x4c, 0x1a, 0xcc, 0xf2, 0xba, 0x39, 0x6a, 0x67,
+	0x2b, 0x15, 0x25, 0xe6, 0x07, 0x57, 0x6e, 0x36, 0x56, 0x1a, 0x7e, 0xa5, 0x1a, 0x72, 0x44, 0xd6,
+	0x92, 0x8c, 0x5f, 0x4b, 0xea, 0xdf, 0xd7, 0x32, 0x0f, 0xe9, 0x8e, 0xc9, 0x6c, 0x23, 0x9f, 0x5e,
+	0x42, 0xc5, 0xac, 0xea, 0x05, 0xf2, 0x5b, 0x98, 0x0c, 0x34, 0x60, 0x80, 0xcc, 0xf6, 0xba, 0x56,
+	0x6b, 0x6a, 0xb9, 0x04, 0x9e, 0x86, 0xc9, 0xda, 0xba, 0xba, 0x5d, 0x77, 0x22, 0xe4, 0x64, 0x76,
+	0xb7, 0xb6, 0x9a, 0x35, 0x2d, 0x27, 0xe0, 0x19, 0xc8, 0x6a, 0xf5, 0x9d, 0x5a, 0x53, 0x5b, 0xdf,
+	0x69, 0xe4, 0x92, 0xf2, 0x17, 0x98, 0xdb, 0x60, 0xbd, 0x1e, 0xe5, 0xbb, 0xae, 0xcc, 0xfb, 0xd8,
+	0x68, 0xa8, 0x54, 0x88, 0x28, 0x8d, 0xdb, 0x8b, 0xbc, 0x00, 0xf3, 0xc3, 0x02, 0x3c, 0x7b, 0xc9,
+	0x5f, 0x11, 0xe0, 0x2d, 0xc2, 0xf7, 0x0e, 0x1e, 0x5a, 0xd8, 0xdd, 0x7e, 0x5b, 0x83, 0xb9, 0x21,
+	0x15, 0x77, 0x9b, 0x5f, 0xfe, 0x81, 0xe0, 0xff, 0x88, 0x4b, 0x1e, 0xf8, 0x54, 0x22, 0x27, 0x9c,
+	0x1c, 0x3e, 0xe1, 0xa1, 0xa1, 0x52, 0x37, 0x86, 0xaa, 0x5c, 0xa6, 0x20, 0xad, 0x39, 0xa6, 0xc6,
+	0x1f, 0x61, 0xc2, 0xbf, 0x6b, 0xbc, 0x12, 0x6b, 0xfb, 0xe1, 0x3f, 0x1d, 0xb1, 0x38, 0x1a, 0xe8,
+	0xcf, 0xdd, 0x86, 0x6c, 0xb8, 0x0c, 0xfc, 0x74, 0xec, 0xb3, 0x12, 0x9f, 0x8d, 0x03, 0xf5, 0x7a,
+	0xbc, 0x40, 0xf8, 0x10, 0xa6, 0xa3, 0x0e, 0xc2, 0xab, 0xb1, 0xd5, 0xb7, 0x38, 0x5d, 0x5c, 0x1b,
+	0x13, 0xed, 0x8f, 0x74, 0x00, 0x53, 0x11, 0x3f, 0xe0, 0xe7, 0xb1, 0xd5, 0x7f, 0x7b, 0x57, 0x5c,
+	0x1d, 0x0f, 0xec, 0x77, 0xfa, 0x04, 0x99, 0x0d, 0x93, 0xe8, 0x9c, 0xe0, 0xe5, 0x78, 0x89, 0x2e,
+	0x20, 0xe0, 0x5f, 0x19, 0x89, 0xf3, 0x6f, 0x2b, 0xf9, 0x5d, 0x40, 0xf8, 0x03, 0xa4, 0x37, 0xba,
+	0xcc, 0x22, 0xf8, 0x49, 0x7c, 0x99, 0x93, 0x0f, 0xd8, 0x97, 0x47, 0xc1, 0x22, 0xe4, 0xd5, 0xd7,
+	0x67, 0x03, 0x09, 0x9d, 0x0f, 0x24, 0xf4, 0x7b, 0x20, 0xa1, 0xd3, 0x2b, 0x29, 0x71, 0x7e, 0x25,
+	0x25, 0x7e, 0x5d, 0x49, 0x09, 0x58, 0xa0, 0x2c, 0x20, 0xd2, 0x0d, 0x1a, 0x92, 0x54, 0x27, 0x5c,
+	0x43, 0xbe, 0x2f, 0x37, 0x50, 0x2b, 0xe3, 0x7a, 0xff, 0xe5, 0x9f, 0x01, 0x00, 0x4c, 0xc2, 0x9b,
+	0x32, 0x97, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -281,6 +624,10 @@ type TopicClient interface {
 	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
 	// Subscribe subscribes to receive messages from the topic
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Topic_SubscribeClient, error)
+	// CommitOffset commits a message offset consumed by a consumer group
+	CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error)
+	// FetchOffset gets the offset from which a consumer group resumes consumption
+	FetchOffset(ctx context.Context, in *FetchOffsetRequest, opts ...grpc.CallOption) (*FetchOffsetResponse, error)
 	// Create creates the Topic
 	// Deprecated: use the Topics service instead
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
@@ -338,6 +685,24 @@ func (x *topicSubscribeClient) Recv() (*SubscribeResponse, error) {
 	return m, nil
 }
 
+func (c *topicClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error) {
+	out := new(CommitOffsetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.topic.v1.Topic/CommitOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *topicClient) FetchOffset(ctx context.Context, in *FetchOffsetRequest, opts ...grpc.CallOption) (*FetchOffsetResponse, error) {
+	out := new(FetchOffsetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.topic.v1.Topic/FetchOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *topicClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
 	out := new(CreateResponse)
@@ -364,6 +729,10 @@ type TopicServer interface {
 	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
 	// Subscribe subscribes to receive messages from the topic
 	Subscribe(*SubscribeRequest, Topic_SubscribeServer) error
+	// CommitOffset commits a message offset consumed by a consumer group
+	CommitOffset(context.Context, *CommitOffsetRequest) (*CommitOffsetResponse, error)
+	// FetchOffset gets the offset from which a consumer group resumes consumption
+	FetchOffset(context.Context, *FetchOffsetRequest) (*FetchOffsetResponse, error)
 	// Create creates the Topic
 	// Deprecated: use the Topics service instead
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
@@ -382,6 +751,12 @@ func (*UnimplementedTopicServer) Publish(ctx context.Context, req *PublishReques
 func (*UnimplementedTopicServer) Subscribe(req *SubscribeRequest, srv Topic_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (*UnimplementedTopicServer) CommitOffset(ctx context.Context, req *CommitOffsetRequest) (*CommitOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOffset not implemented")
+}
+func (*UnimplementedTopicServer) FetchOffset(ctx context.Context, req *FetchOffsetRequest) (*FetchOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchOffset not implemented")
+}
 func (*UnimplementedTopicServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
@@ -432,6 +807,42 @@ func (x *topicSubscribeServer) Send(m *SubscribeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Topic_CommitOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopicServer).CommitOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.topic.v1.Topic/CommitOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopicServer).CommitOffset(ctx, req.(*CommitOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Topic_FetchOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TopicServer).FetchOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.topic.v1.Topic/FetchOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TopicServer).FetchOffset(ctx, req.(*FetchOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Topic_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRequest)
 	if err := dec(in); err != nil {
@@ -476,6 +887,14 @@ var _Topic_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Publish",
 			Handler:    _Topic_Publish_Handler,
 		},
+		{
+			MethodName: "CommitOffset",
+			Handler:    _Topic_CommitOffset_Handler,
+		},
+		{
+			MethodName: "FetchOffset",
+			Handler:    _Topic_FetchOffset_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _Topic_Create_Handler,
@@ -515,6 +934,13 @@ func (m *PublishRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Payload) > 0 {
 		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
@@ -555,6 +981,26 @@ func (m *PublishResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Partition != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timestamp != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTopic(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Offset != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -578,19 +1024,187 @@ func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= size
-		i = encodeVarintTopic(dAtA, i, uint64(size))
+		i -= n3
+		i = encodeVarintTopic(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Offset != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitOffsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitOffsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitOffsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopic(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *FetchOffsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchOffsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchOffsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -611,6 +1225,11 @@ func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Partition != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Payload) > 0 {
 		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
@@ -619,96 +1238,648 @@ func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if m.Timestamp != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
-		if err3 != nil {
-			return 0, err3
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTopic(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Offset != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PublishRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
+func (m *PublishResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovTopic(uint64(m.Offset))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovTopic(uint64(m.Partition))
+	}
+	return n
+}
+
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.Position != 0 {
+		n += 1 + sovTopic(uint64(m.Position))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovTopic(uint64(m.Offset))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitOffsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovTopic(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *CommitOffsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FetchOffsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovTopic(uint64(m.Partition))
+	}
+	return n
+}
+
+func (m *FetchOffsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovTopic(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *SubscribeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovTopic(uint64(m.Offset))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovTopic(uint64(m.Partition))
+	}
+	return n
+}
+
+func sovTopic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTopic(x uint64) (n int) {
+	return sovTopic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PublishRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= SubscribeRequest_Position(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= n3
-		i = encodeVarintTopic(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Offset != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTopic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *PublishRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovTopic(uint64(l))
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
-	}
-	return n
-}
-
-func (m *PublishResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *SubscribeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ID.Size()
-	n += 1 + l + sovTopic(uint64(l))
-	return n
-}
 
-func (m *SubscribeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		n += 1 + sovTopic(uint64(m.Offset))
-	}
-	if m.Timestamp != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
-		n += 1 + l + sovTopic(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTopic(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTopic(x uint64) (n int) {
-	return sovTopic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PublishRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitOffsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -731,10 +1902,10 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitOffsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -772,9 +1943,9 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -784,26 +1955,43 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTopic
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopic
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
@@ -825,7 +2013,7 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitOffsetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -848,10 +2036,10 @@ func (m *PublishResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitOffsetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitOffsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -875,7 +2063,7 @@ func (m *PublishResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+func (m *FetchOffsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -898,10 +2086,10 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchOffsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -937,6 +2125,126 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchOffsetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchOffsetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchOffsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
@@ -1076,6 +2384,25 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
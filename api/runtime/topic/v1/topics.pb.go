@@ -9,18 +9,22 @@ import (
 	v1 "github.com/atomix/atomix/api/runtime/v1"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +33,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Config struct {
+	Retention RetentionConfig `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention"`
+	// partitions is the number of partitions across which messages are published
+	// The number of partitions is fixed when the topic is first created; defaults to a single partition
+	Partitions uint32 `protobuf:"varint,2,opt,name=partitions,proto3" json:"partitions,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -64,6 +72,82 @@ func (m *Config) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Config proto.InternalMessageInfo
 
+func (m *Config) GetRetention() RetentionConfig {
+	if m != nil {
+		return m.Retention
+	}
+	return RetentionConfig{}
+}
+
+func (m *Config) GetPartitions() uint32 {
+	if m != nil {
+		return m.Partitions
+	}
+	return 0
+}
+
+// RetentionConfig bounds the messages retained by the topic for replay
+// A zero value for any of the limits disables that limit
+type RetentionConfig struct {
+	MaxMessages uint64        `protobuf:"varint,1,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	MaxBytes    uint64        `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxAge      time.Duration `protobuf:"bytes,3,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age"`
+}
+
+func (m *RetentionConfig) Reset()         { *m = RetentionConfig{} }
+func (m *RetentionConfig) String() string { return proto.CompactTextString(m) }
+func (*RetentionConfig) ProtoMessage()    {}
+func (*RetentionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60ce08217c9ac879, []int{1}
+}
+func (m *RetentionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetentionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetentionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetentionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionConfig.Merge(m, src)
+}
+func (m *RetentionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetentionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionConfig proto.InternalMessageInfo
+
+func (m *RetentionConfig) GetMaxMessages() uint64 {
+	if m != nil {
+		return m.MaxMessages
+	}
+	return 0
+}
+
+func (m *RetentionConfig) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *RetentionConfig) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
 type CreateRequest struct {
 	ID   v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Tags []string       `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
@@ -73,7 +157,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60ce08217c9ac879, []int{1}
+	return fileDescriptor_60ce08217c9ac879, []int{2}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -124,7 +208,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60ce08217c9ac879, []int{2}
+	return fileDescriptor_60ce08217c9ac879, []int{3}
 }
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +252,7 @@ func (m *CloseRequest) Reset()         { *m = CloseRequest{} }
 func (m *CloseRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseRequest) ProtoMessage()    {}
 func (*CloseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60ce08217c9ac879, []int{3}
+	return fileDescriptor_60ce08217c9ac879, []int{4}
 }
 func (m *CloseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,7 +295,7 @@ func (m *CloseResponse) Reset()         { *m = CloseResponse{} }
 func (m *CloseResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseResponse) ProtoMessage()    {}
 func (*CloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60ce08217c9ac879, []int{4}
+	return fileDescriptor_60ce08217c9ac879, []int{5}
 }
 func (m *CloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,6 +326,7 @@ var xxx_messageInfo_CloseResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Config)(nil), "atomix.runtime.topic.v1.Config")
+	proto.RegisterType((*RetentionConfig)(nil), "atomix.runtime.topic.v1.RetentionConfig")
 	proto.RegisterType((*CreateRequest)(nil), "atomix.runtime.topic.v1.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "atomix.runtime.topic.v1.CreateResponse")
 	proto.RegisterType((*CloseRequest)(nil), "atomix.runtime.topic.v1.CloseRequest")
@@ -251,29 +336,37 @@ func init() {
 func init() { proto.RegisterFile("runtime/topic/v1/topics.proto", fileDescriptor_60ce08217c9ac879) }
 
 var fileDescriptor_60ce08217c9ac879 = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x2a, 0xcd, 0x2b,
-	0xc9, 0xcc, 0x4d, 0xd5, 0x2f, 0xc9, 0x2f, 0xc8, 0x4c, 0xd6, 0x2f, 0x33, 0x84, 0x30, 0x8a, 0xf5,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0x13, 0x4b, 0xf2, 0x73, 0x33, 0x2b, 0xf4, 0xa0, 0xaa,
-	0xf4, 0xc0, 0x92, 0x7a, 0x65, 0x86, 0x52, 0x12, 0x30, 0x7d, 0x65, 0x86, 0xfa, 0x30, 0x49, 0xb0,
-	0x16, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0x71, 0x70,
-	0xb1, 0x39, 0xe7, 0xe7, 0xa5, 0x65, 0xa6, 0x2b, 0xc5, 0x73, 0xf1, 0x3a, 0x17, 0xa5, 0x26, 0x96,
-	0xa4, 0x06, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x08, 0x59, 0x71, 0x31, 0x65, 0xa6, 0x48, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe9, 0xa1, 0x59, 0x58, 0x66, 0xa8, 0x17, 0x50, 0x94, 0x99,
-	0x9b, 0x59, 0x92, 0x59, 0x96, 0xea, 0xe9, 0xe2, 0xc4, 0x75, 0xe2, 0x9e, 0x3c, 0xc3, 0xa3, 0x7b,
-	0xf2, 0x4c, 0x9e, 0x2e, 0x41, 0x4c, 0x99, 0x29, 0x42, 0x42, 0x5c, 0x2c, 0x25, 0x89, 0xe9, 0xc5,
-	0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x41, 0x60, 0xb6, 0x92, 0x3f, 0x17, 0x1f, 0xcc, 0x82, 0xe2,
-	0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x5b, 0x2e, 0xb6, 0x64, 0xb0, 0xe5, 0x50, 0x5b, 0xe4, 0xf5,
-	0x70, 0x78, 0x4b, 0x0f, 0xe2, 0x46, 0x27, 0x16, 0x90, 0x35, 0x41, 0x50, 0x4d, 0x4a, 0x5e, 0x5c,
-	0x3c, 0xce, 0x39, 0xf9, 0xc5, 0xd4, 0x70, 0xb0, 0x12, 0x3f, 0x17, 0x2f, 0xd4, 0x2c, 0x88, 0xdb,
-	0x8c, 0x76, 0x33, 0x72, 0xb1, 0x85, 0x80, 0x83, 0x5c, 0x28, 0x92, 0x8b, 0x0d, 0xe2, 0x70, 0x21,
-	0x35, 0xdc, 0x0e, 0x44, 0x0e, 0x3a, 0x29, 0x75, 0x82, 0xea, 0xa0, 0x21, 0x10, 0xc6, 0xc5, 0x0a,
-	0xb6, 0x56, 0x48, 0x15, 0xb7, 0x0e, 0x24, 0x2f, 0x4a, 0xa9, 0x11, 0x52, 0x06, 0x31, 0xd7, 0xc9,
-	0xe6, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xb8, 0xc4, 0x32, 0xf3, 0x61, 0x66,
-	0x24, 0x16, 0x64, 0xc2, 0xf5, 0x3b, 0x71, 0x40, 0x3c, 0x1b, 0x66, 0x18, 0xc0, 0x98, 0xc4, 0x06,
-	0x4e, 0x1b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0x09, 0xeb, 0x43, 0x85, 0x02, 0x00,
-	0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0xa6, 0xc1, 0x24, 0xd3, 0x86, 0x4a, 0x2b, 0x04, 0x21, 0x08, 0xa7, 0x58, 0xa2, 0xe4,
+	0xb4, 0x96, 0xcb, 0x0d, 0x95, 0x03, 0x4e, 0x2e, 0x45, 0x20, 0x2a, 0x0b, 0x55, 0xe2, 0x54, 0x6d,
+	0x9a, 0xad, 0xb5, 0x52, 0xed, 0x35, 0xde, 0x8d, 0x65, 0x7e, 0x05, 0x1c, 0xf9, 0x2f, 0xfc, 0x81,
+	0x1e, 0x7b, 0xe4, 0x54, 0x50, 0xf2, 0x47, 0xd0, 0x7e, 0x18, 0x42, 0xa5, 0xa8, 0x17, 0x6e, 0xb3,
+	0x33, 0xf3, 0xde, 0xbc, 0xf7, 0xb4, 0xf0, 0xa4, 0x5c, 0xe4, 0x8a, 0x67, 0x2c, 0x54, 0xa2, 0xe0,
+	0x67, 0x61, 0x15, 0xd9, 0x42, 0x92, 0xa2, 0x14, 0x4a, 0xe0, 0x87, 0x54, 0x89, 0x8c, 0xd7, 0xc4,
+	0x6d, 0x11, 0x33, 0x24, 0x55, 0x34, 0x1c, 0x34, 0xb8, 0x2a, 0x0a, 0x9b, 0xa1, 0x81, 0x0c, 0xef,
+	0xa7, 0x22, 0x15, 0xa6, 0x0c, 0x75, 0xe5, 0xba, 0x7e, 0x2a, 0x44, 0x7a, 0xc1, 0x42, 0xf3, 0x9a,
+	0x2d, 0xce, 0xc3, 0xf9, 0xa2, 0xa4, 0x8a, 0x8b, 0xdc, 0xce, 0x83, 0x0a, 0xbc, 0x89, 0xc8, 0xcf,
+	0x79, 0x8a, 0xdf, 0x42, 0xaf, 0x64, 0x8a, 0xe5, 0x7a, 0x38, 0x40, 0x7b, 0x68, 0xbc, 0x7d, 0x30,
+	0x26, 0x1b, 0x64, 0x90, 0xa4, 0xd9, 0xb4, 0xe0, 0xb8, 0x73, 0x79, 0x3d, 0x6a, 0x25, 0x7f, 0x09,
+	0xb0, 0x0f, 0x50, 0xd0, 0x52, 0x71, 0xfd, 0x90, 0x83, 0xf6, 0x1e, 0x1a, 0xf7, 0x93, 0xb5, 0x4e,
+	0xf0, 0x05, 0xc1, 0xee, 0x0d, 0x12, 0xfc, 0x14, 0x76, 0x32, 0x5a, 0x9f, 0x66, 0x4c, 0x4a, 0x9a,
+	0x32, 0x69, 0x44, 0x74, 0x92, 0xed, 0x8c, 0xd6, 0xef, 0x5c, 0x0b, 0x3f, 0x86, 0x9e, 0x5e, 0x99,
+	0x7d, 0x56, 0xcc, 0xb2, 0x76, 0x92, 0x6e, 0x46, 0xeb, 0x58, 0xbf, 0xf1, 0x21, 0xdc, 0xd5, 0x43,
+	0x9a, 0xb2, 0xc1, 0x96, 0xd1, 0xff, 0x88, 0x58, 0xf7, 0xa4, 0x71, 0x4f, 0xa6, 0xce, 0x7d, 0xdc,
+	0xd5, 0x82, 0xbf, 0xfd, 0x1c, 0xa1, 0xc4, 0xcb, 0x68, 0xfd, 0x3a, 0x65, 0xc1, 0x29, 0xf4, 0x27,
+	0x25, 0xa3, 0x8a, 0x25, 0xec, 0xd3, 0x82, 0x49, 0x85, 0x5f, 0x42, 0x9b, 0xcf, 0x5d, 0x12, 0xfe,
+	0xcd, 0x24, 0xaa, 0x88, 0x1c, 0x97, 0x3c, 0xe3, 0x8a, 0x57, 0xec, 0x68, 0x1a, 0x83, 0xa6, 0x5b,
+	0x5e, 0x8f, 0xda, 0x47, 0xd3, 0xa4, 0xcd, 0xe7, 0x18, 0x43, 0x47, 0xd1, 0x54, 0x4b, 0xdc, 0x1a,
+	0xf7, 0x12, 0x53, 0x07, 0xef, 0xe1, 0x5e, 0x73, 0x40, 0x16, 0x22, 0x97, 0x0c, 0xbf, 0x02, 0xef,
+	0xcc, 0x58, 0x77, 0x57, 0x46, 0x1b, 0xf3, 0xfe, 0x27, 0x66, 0x07, 0x0a, 0xde, 0xc0, 0xce, 0xe4,
+	0x42, 0xc8, 0xff, 0x21, 0x38, 0xd8, 0x85, 0xbe, 0xe3, 0xb2, 0xda, 0x0e, 0xbe, 0x23, 0xf0, 0x3e,
+	0x98, 0x2f, 0x89, 0x3f, 0x82, 0x67, 0x85, 0xe3, 0xfd, 0xcd, 0x02, 0xd7, 0xa3, 0x1b, 0x3e, 0xbf,
+	0x75, 0xcf, 0x25, 0x70, 0x02, 0x77, 0xcc, 0x59, 0xfc, 0x6c, 0x33, 0x62, 0xcd, 0xe2, 0x70, 0xff,
+	0xb6, 0x35, 0xcb, 0x1b, 0x1f, 0x5e, 0x2e, 0x7d, 0x74, 0xb5, 0xf4, 0xd1, 0xaf, 0xa5, 0x8f, 0xbe,
+	0xae, 0xfc, 0xd6, 0xd5, 0xca, 0x6f, 0xfd, 0x58, 0xf9, 0x2d, 0x78, 0xc0, 0x45, 0xc3, 0x41, 0x0b,
+	0xfe, 0x07, 0x1f, 0x77, 0xad, 0xd9, 0x93, 0xe8, 0x18, 0xcd, 0x3c, 0xf3, 0x5f, 0x5e, 0xfc, 0x1e,
+	0x00, 0x17, 0xe1, 0x97, 0x46, 0xa5, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -416,6 +509,62 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Partitions != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.Partitions))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTopics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RetentionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetentionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetentionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTopics(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxBytes != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMessages != 0 {
+		i = encodeVarintTopics(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -567,6 +716,28 @@ func (m *Config) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.Retention.Size()
+	n += 1 + l + sovTopics(uint64(l))
+	if m.Partitions != 0 {
+		n += 1 + sovTopics(uint64(m.Partitions))
+	}
+	return n
+}
+
+func (m *RetentionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMessages != 0 {
+		n += 1 + sovTopics(uint64(m.MaxMessages))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovTopics(uint64(m.MaxBytes))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovTopics(uint64(l))
 	return n
 }
 
@@ -653,6 +824,179 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			m.Partitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partitions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetentionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopics(dAtA[iNdEx:])
@@ -34,6 +34,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetRequest struct {
 	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// paths projects a JSON document value to the values at the given JSON Pointers (RFC 6901)
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
 }
 
 func (m *GetRequest) Reset()         { *m = GetRequest{} }
@@ -76,6 +78,13 @@ func (m *GetRequest) GetID() v1.PrimitiveID {
 	return v1.PrimitiveID{}
 }
 
+func (m *GetRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
 type GetResponse struct {
 	Value *VersionedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -124,6 +133,9 @@ type SetRequest struct {
 	ID    v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Value []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	TTL   *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	// patch updates the JSON document stored in the value instead of replacing it
+	// A missing value is patched as an empty object, and the value must be empty when a patch is set.
+	Patch *Patch `protobuf:"bytes,4,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
 func (m *SetRequest) Reset()         { *m = SetRequest{} }
@@ -180,6 +192,13 @@ func (m *SetRequest) GetTTL() *time.Duration {
 	return nil
 }
 
+func (m *SetRequest) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 type SetResponse struct {
 	Version   uint64          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	PrevValue *VersionedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
@@ -728,6 +747,92 @@ func (m *EventsResponse) GetEvent() Event {
 	return Event{}
 }
 
+// Patch is a partial update of a JSON document value
+type Patch struct {
+	// Types that are valid to be assigned to Patch:
+	//	*Patch_JsonPatch
+	//	*Patch_MergePatch
+	Patch isPatch_Patch `protobuf_oneof:"patch"`
+}
+
+func (m *Patch) Reset()         { *m = Patch{} }
+func (m *Patch) String() string { return proto.CompactTextString(m) }
+func (*Patch) ProtoMessage()    {}
+func (*Patch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a27f517440219f96, []int{14}
+}
+func (m *Patch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Patch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Patch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Patch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Patch.Merge(m, src)
+}
+func (m *Patch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Patch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Patch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Patch proto.InternalMessageInfo
+
+type isPatch_Patch interface {
+	isPatch_Patch()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Patch_JsonPatch struct {
+	JsonPatch []byte `protobuf:"bytes,1,opt,name=json_patch,json=jsonPatch,proto3,oneof" json:"json_patch,omitempty"`
+}
+type Patch_MergePatch struct {
+	MergePatch []byte `protobuf:"bytes,2,opt,name=merge_patch,json=mergePatch,proto3,oneof" json:"merge_patch,omitempty"`
+}
+
+func (*Patch_JsonPatch) isPatch_Patch()  {}
+func (*Patch_MergePatch) isPatch_Patch() {}
+
+func (m *Patch) GetPatch() isPatch_Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
+func (m *Patch) GetJsonPatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_JsonPatch); ok {
+		return x.JsonPatch
+	}
+	return nil
+}
+
+func (m *Patch) GetMergePatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_MergePatch); ok {
+		return x.MergePatch
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Patch) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Patch_JsonPatch)(nil),
+		(*Patch_MergePatch)(nil),
+	}
+}
+
 type VersionedValue struct {
 	Value   []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
@@ -737,7 +842,7 @@ func (m *VersionedValue) Reset()         { *m = VersionedValue{} }
 func (m *VersionedValue) String() string { return proto.CompactTextString(m) }
 func (*VersionedValue) ProtoMessage()    {}
 func (*VersionedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a27f517440219f96, []int{14}
+	return fileDescriptor_a27f517440219f96, []int{15}
 }
 func (m *VersionedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,13 +891,15 @@ type Event struct {
 	//	*Event_Updated_
 	//	*Event_Deleted_
 	Event isEvent_Event `protobuf_oneof:"event"`
+	// patch is the patch applied to produce a created or updated value; unset if the value was replaced
+	Patch *Patch `protobuf:"bytes,4,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
 func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a27f517440219f96, []int{15}
+	return fileDescriptor_a27f517440219f96, []int{16}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,6 +976,13 @@ func (m *Event) GetDeleted() *Event_Deleted {
 	return nil
 }
 
+func (m *Event) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -886,7 +1000,7 @@ func (m *Event_Created) Reset()         { *m = Event_Created{} }
 func (m *Event_Created) String() string { return proto.CompactTextString(m) }
 func (*Event_Created) ProtoMessage()    {}
 func (*Event_Created) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a27f517440219f96, []int{15, 0}
+	return fileDescriptor_a27f517440219f96, []int{16, 0}
 }
 func (m *Event_Created) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,7 +1045,7 @@ func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
 func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
 func (*Event_Updated) ProtoMessage()    {}
 func (*Event_Updated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a27f517440219f96, []int{15, 1}
+	return fileDescriptor_a27f517440219f96, []int{16, 1}
 }
 func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -983,7 +1097,7 @@ func (m *Event_Deleted) Reset()         { *m = Event_Deleted{} }
 func (m *Event_Deleted) String() string { return proto.CompactTextString(m) }
 func (*Event_Deleted) ProtoMessage()    {}
 func (*Event_Deleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a27f517440219f96, []int{15, 2}
+	return fileDescriptor_a27f517440219f96, []int{16, 2}
 }
 func (m *Event_Deleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1041,6 +1155,7 @@ func init() {
 	proto.RegisterType((*WatchResponse)(nil), "atomix.runtime.value.v1.WatchResponse")
 	proto.RegisterType((*EventsRequest)(nil), "atomix.runtime.value.v1.EventsRequest")
 	proto.RegisterType((*EventsResponse)(nil), "atomix.runtime.value.v1.EventsResponse")
+	proto.RegisterType((*Patch)(nil), "atomix.runtime.value.v1.Patch")
 	proto.RegisterType((*VersionedValue)(nil), "atomix.runtime.value.v1.VersionedValue")
 	proto.RegisterType((*Event)(nil), "atomix.runtime.value.v1.Event")
 	proto.RegisterType((*Event_Created)(nil), "atomix.runtime.value.v1.Event.Created")
@@ -1051,57 +1166,62 @@ func init() {
 func init() { proto.RegisterFile("runtime/value/v1/value.proto", fileDescriptor_a27f517440219f96) }
 
 var fileDescriptor_a27f517440219f96 = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
+	// 867 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xce, 0xe4, 0xcf, 0xf7, 0x9e, 0x34, 0x59, 0x8c, 0xaa, 0x7b, 0x73, 0xad, 0x8b, 0x53, 0x0c,
-	0xb4, 0x15, 0x0b, 0xa7, 0x29, 0x1b, 0x54, 0x84, 0x84, 0xd2, 0x40, 0x5a, 0xa8, 0x4a, 0x94, 0x96,
-	0x02, 0x42, 0x02, 0xa5, 0xf5, 0x90, 0x5a, 0x4a, 0xed, 0x60, 0x4f, 0xac, 0x3e, 0x02, 0x4b, 0x96,
-	0x95, 0xe0, 0x21, 0xd8, 0xb0, 0x65, 0xdd, 0x65, 0x97, 0xac, 0x0a, 0x4a, 0x5f, 0x04, 0x79, 0x7e,
-	0x9a, 0x38, 0x10, 0x9b, 0x82, 0x91, 0xd8, 0x8d, 0x67, 0xce, 0xf9, 0xe6, 0x3b, 0xdf, 0xf9, 0x19,
-	0xc3, 0xff, 0xee, 0xc0, 0xa6, 0xd6, 0x01, 0xa9, 0xfa, 0x9d, 0xde, 0x80, 0x54, 0xfd, 0x1a, 0x5f,
-	0x18, 0x7d, 0xd7, 0xa1, 0x0e, 0xfe, 0xb7, 0x43, 0x9d, 0x03, 0xeb, 0xd0, 0x10, 0x46, 0x06, 0x3f,
-	0xf3, 0x6b, 0x6a, 0xf9, 0xdc, 0xad, 0x56, 0x95, 0x87, 0xcc, 0x45, 0xbd, 0xf4, 0x7d, 0x40, 0x4f,
-	0x1c, 0x6b, 0x5d, 0xc7, 0xe9, 0xf6, 0x48, 0x95, 0x7d, 0xed, 0x0e, 0x5e, 0x56, 0xcd, 0x81, 0xdb,
-	0xa1, 0x96, 0x63, 0x8b, 0xf3, 0xd9, 0xae, 0xd3, 0x75, 0xd8, 0xb2, 0x1a, 0xac, 0xf8, 0xae, 0xbe,
-	0x06, 0xd0, 0x24, 0xb4, 0x4d, 0x5e, 0x0d, 0x88, 0x47, 0xf1, 0x0a, 0xa4, 0x2d, 0xb3, 0x8c, 0xe6,
-	0xd0, 0x62, 0x61, 0x59, 0x33, 0x26, 0x29, 0xd6, 0x8c, 0x96, 0x6b, 0x1d, 0x58, 0xd4, 0xf2, 0xc9,
-	0x7a, 0xa3, 0x0e, 0xc7, 0xa7, 0x95, 0xd4, 0xf0, 0xb4, 0x92, 0x5e, 0x6f, 0xb4, 0xd3, 0x96, 0xa9,
-	0x6f, 0x40, 0x81, 0x21, 0x79, 0x7d, 0xc7, 0xf6, 0x08, 0xbe, 0x0d, 0x39, 0x46, 0x4f, 0xa0, 0x2d,
-	0x18, 0x53, 0x02, 0x36, 0x76, 0x88, 0xeb, 0x59, 0x8e, 0x4d, 0xcc, 0x9d, 0x60, 0xa7, 0xcd, 0xbd,
-	0xf4, 0x23, 0x04, 0xb0, 0x95, 0x08, 0x31, 0x3c, 0x2b, 0x99, 0xa4, 0xe7, 0xd0, 0xe2, 0x8c, 0xb8,
-	0x00, 0xdf, 0x84, 0x0c, 0xa5, 0xbd, 0x72, 0x86, 0x41, 0xfe, 0x67, 0x70, 0xf1, 0x0c, 0x29, 0x9e,
-	0xd1, 0x10, 0xe2, 0xd5, 0x0b, 0xc3, 0xd3, 0x4a, 0x66, 0x7b, 0x7b, 0xe3, 0xe8, 0x73, 0x05, 0xb5,
-	0x03, 0x17, 0xdd, 0x81, 0xc2, 0xd6, 0x58, 0xa0, 0x65, 0x50, 0x7c, 0x1e, 0x02, 0xe3, 0x97, 0x6d,
-	0xcb, 0x4f, 0x7c, 0x0f, 0xa0, 0xef, 0x12, 0xff, 0xc5, 0xe8, 0xf6, 0x0b, 0xe8, 0xf0, 0x77, 0xe0,
-	0xca, 0x96, 0xfa, 0x5b, 0x04, 0xc5, 0x75, 0xdb, 0x23, 0xee, 0x1f, 0x29, 0xc7, 0x75, 0x28, 0x49,
-	0x72, 0x71, 0x8a, 0xe8, 0x1f, 0x11, 0x14, 0x1f, 0xf5, 0xcd, 0x0e, 0x25, 0xbf, 0x2f, 0x92, 0xcb,
-	0x30, 0xc3, 0x55, 0x17, 0x14, 0x32, 0x8c, 0x42, 0x81, 0xc9, 0x29, 0x12, 0x23, 0x82, 0xcd, 0x5e,
-	0x3c, 0xd8, 0x43, 0x28, 0x49, 0xfe, 0xb1, 0xe9, 0xdf, 0xf8, 0x85, 0xf4, 0xd7, 0xb3, 0x41, 0xac,
-	0xe3, 0x45, 0x60, 0x43, 0xb1, 0x41, 0x7a, 0x24, 0x19, 0xe5, 0x26, 0x35, 0x4a, 0x7f, 0xa3, 0x91,
-	0xfe, 0x10, 0x4a, 0xf2, 0xbe, 0x64, 0x3a, 0xfa, 0x3e, 0xcc, 0x3c, 0xee, 0xd0, 0xbd, 0xfd, 0x24,
-	0x66, 0xcd, 0x26, 0x14, 0x05, 0x56, 0x32, 0xdc, 0x1e, 0x40, 0xf1, 0xae, 0x4f, 0x6c, 0xea, 0x25,
-	0x33, 0x08, 0x4b, 0x12, 0x4c, 0xb0, 0x5b, 0x81, 0x1c, 0x09, 0x76, 0xa6, 0x02, 0x4a, 0x76, 0xcc,
-	0x4f, 0xe4, 0x9e, 0xbb, 0xe8, 0x77, 0xa0, 0x14, 0xe6, 0x3c, 0x2a, 0x7b, 0x34, 0x5e, 0xf6, 0x63,
-	0x75, 0x98, 0x0e, 0x37, 0xdd, 0x87, 0x2c, 0xe4, 0x18, 0x30, 0xae, 0x83, 0xb2, 0xe7, 0x92, 0x0e,
-	0x25, 0x32, 0xb4, 0xf9, 0x68, 0x26, 0xc6, 0x2a, 0xb7, 0x5e, 0x4b, 0xb5, 0xa5, 0x63, 0x80, 0x31,
-	0x60, 0x1d, 0x60, 0x8a, 0x92, 0x8e, 0xc3, 0xe0, 0xfd, 0xc2, 0x30, 0x84, 0x63, 0x80, 0x61, 0xb2,
-	0xda, 0x32, 0xc5, 0xc0, 0x89, 0xc3, 0xe0, 0x95, 0xc8, 0x30, 0x84, 0xa3, 0xba, 0x09, 0x8a, 0x60,
-	0x87, 0x57, 0x7f, 0x2e, 0xf9, 0x52, 0x67, 0x76, 0xac, 0xbe, 0x43, 0xa0, 0x08, 0xaa, 0x89, 0x00,
-	0x26, 0xdb, 0xfe, 0xea, 0x3e, 0x28, 0x42, 0x84, 0x64, 0xd8, 0x95, 0x41, 0x21, 0x87, 0x7d, 0xcb,
-	0x15, 0x69, 0xfc, 0xab, 0x2d, 0x3f, 0xeb, 0x8a, 0x28, 0xd6, 0xe5, 0xf7, 0x79, 0xc8, 0xf1, 0x8a,
-	0x6b, 0x41, 0x66, 0x8b, 0x50, 0x7c, 0x65, 0xea, 0x4d, 0xa3, 0x97, 0x5a, 0xbd, 0x1a, 0x6d, 0x24,
-	0x3a, 0xe2, 0x29, 0xe4, 0xf9, 0xa3, 0x81, 0xa7, 0xa7, 0x3e, 0xf4, 0xe4, 0xa9, 0x0b, 0xb1, 0x76,
-	0x23, 0x68, 0x9e, 0xc7, 0x08, 0xe8, 0xd0, 0x1b, 0x14, 0x01, 0x3d, 0x31, 0xeb, 0x5b, 0x90, 0x69,
-	0x46, 0xea, 0xd0, 0xfc, 0x11, 0x1d, 0x9a, 0x61, 0x1d, 0x78, 0x5a, 0x23, 0xc8, 0x86, 0xc6, 0x7e,
-	0x04, 0xd9, 0x89, 0x71, 0xfd, 0x04, 0x72, 0x6c, 0x46, 0xe2, 0x6b, 0x53, 0x3d, 0xc6, 0xe7, 0xb1,
-	0x3a, 0x1f, 0x67, 0xc6, 0x71, 0x97, 0x10, 0x7e, 0x06, 0x79, 0x3e, 0xe0, 0x70, 0x4c, 0xdf, 0x7a,
-	0xf1, 0xa4, 0xc3, 0x93, 0x72, 0x09, 0xe1, 0xe7, 0x90, 0xe7, 0x7d, 0x1d, 0x01, 0xce, 0x0d, 0xe2,
-	0xc1, 0xa5, 0x1d, 0x07, 0xd7, 0x33, 0xaf, 0xd3, 0x01, 0xf9, 0xdc, 0x6a, 0xcf, 0xf1, 0x48, 0x84,
-	0x2c, 0xec, 0x3c, 0x5e, 0x16, 0x61, 0x36, 0x06, 0x5e, 0xbf, 0x75, 0x3c, 0xd4, 0xd0, 0xc9, 0x50,
-	0x43, 0x5f, 0x86, 0x1a, 0x7a, 0x73, 0xa6, 0xa5, 0x4e, 0xce, 0xb4, 0xd4, 0xa7, 0x33, 0x2d, 0x05,
-	0xff, 0x58, 0x8e, 0x04, 0xea, 0xf4, 0xad, 0x73, 0x90, 0xba, 0xc2, 0x3a, 0x6c, 0xa7, 0xd6, 0x42,
-	0xbb, 0x79, 0xf6, 0x03, 0x72, 0xe3, 0x6b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0x08, 0xe1, 0x04,
-	0x39, 0x0c, 0x00, 0x00,
+	0x14, 0x8e, 0xed, 0x38, 0xbe, 0x3d, 0xf9, 0x59, 0x8c, 0xaa, 0x7b, 0x7d, 0x2d, 0x70, 0x5a, 0x03,
+	0x4d, 0xc5, 0xc2, 0x69, 0x0a, 0x0b, 0x54, 0x84, 0x84, 0xd2, 0x40, 0x5a, 0x88, 0x4a, 0xe4, 0x96,
+	0x02, 0x42, 0x6a, 0x95, 0xd6, 0x43, 0x6a, 0x94, 0xd8, 0xc1, 0x76, 0xa2, 0x3e, 0x02, 0x4b, 0x96,
+	0x48, 0xf0, 0x10, 0x3c, 0x01, 0xeb, 0xae, 0x50, 0x97, 0xac, 0x0a, 0x4a, 0x97, 0xbc, 0x04, 0xf2,
+	0xcc, 0xb8, 0x49, 0x0a, 0x89, 0xfb, 0x63, 0x24, 0x76, 0xf6, 0xcc, 0x77, 0x3e, 0x7f, 0xe7, 0x9b,
+	0x73, 0xce, 0x18, 0xae, 0xb8, 0x5d, 0xdb, 0xb7, 0xda, 0xb8, 0xd8, 0x6b, 0xb4, 0xba, 0xb8, 0xd8,
+	0x2b, 0xd1, 0x07, 0xbd, 0xe3, 0x3a, 0xbe, 0x83, 0xfe, 0x6b, 0xf8, 0x4e, 0xdb, 0xda, 0xd7, 0x19,
+	0x48, 0xa7, 0x7b, 0xbd, 0x92, 0x22, 0x9f, 0x84, 0x95, 0x8a, 0xe1, 0x26, 0x09, 0x51, 0xae, 0xfe,
+	0x9e, 0xd0, 0x63, 0xdb, 0x6a, 0xd3, 0x71, 0x9a, 0x2d, 0x5c, 0x24, 0x6f, 0x3b, 0xdd, 0x57, 0x45,
+	0xb3, 0xeb, 0x36, 0x7c, 0xcb, 0xb1, 0xd9, 0xfe, 0x74, 0xd3, 0x69, 0x3a, 0xe4, 0xb1, 0x18, 0x3c,
+	0xd1, 0x55, 0x6d, 0x0b, 0xa0, 0x8a, 0x7d, 0x03, 0xbf, 0xe9, 0x62, 0xcf, 0x47, 0x4b, 0xc0, 0x5b,
+	0xa6, 0xcc, 0xcd, 0x70, 0xf3, 0xe9, 0x45, 0x55, 0x3f, 0x2d, 0xb1, 0xa4, 0xd7, 0x5d, 0xab, 0x6d,
+	0xf9, 0x56, 0x0f, 0xaf, 0x56, 0xca, 0x70, 0x70, 0x94, 0x4f, 0xf4, 0x8f, 0xf2, 0xfc, 0x6a, 0xc5,
+	0xe0, 0x2d, 0x13, 0x4d, 0x83, 0xd8, 0x69, 0xf8, 0x7b, 0x9e, 0xcc, 0xcf, 0x08, 0xf3, 0x53, 0x06,
+	0x7d, 0xd1, 0x6a, 0x90, 0x26, 0xfc, 0x5e, 0xc7, 0xb1, 0x3d, 0x8c, 0xee, 0x81, 0x48, 0x44, 0xb3,
+	0x6f, 0x14, 0xf4, 0x31, 0x36, 0xe8, 0x9b, 0xd8, 0xf5, 0x2c, 0xc7, 0xc6, 0xe6, 0x66, 0xb0, 0x62,
+	0xd0, 0x28, 0xed, 0x0b, 0x07, 0xb0, 0x1e, 0x9b, 0x5c, 0xaa, 0x84, 0x9f, 0xe1, 0xe6, 0x33, 0xec,
+	0x03, 0xe8, 0x0e, 0x08, 0xbe, 0xdf, 0x92, 0x05, 0x42, 0xf9, 0xbf, 0x4e, 0x2d, 0xd5, 0x43, 0x4b,
+	0xf5, 0x0a, 0xb3, 0xb4, 0x9c, 0xee, 0x1f, 0xe5, 0x85, 0x8d, 0x8d, 0xda, 0xfb, 0x6f, 0x79, 0xce,
+	0x08, 0x42, 0xd0, 0x6d, 0x92, 0xfe, 0xee, 0x9e, 0x9c, 0x1c, 0x23, 0x27, 0xcc, 0xac, 0x1e, 0xa0,
+	0x0c, 0x0a, 0xd6, 0x1c, 0x48, 0xaf, 0x0f, 0xd9, 0x23, 0x83, 0xd4, 0xa3, 0x89, 0x93, 0xac, 0x92,
+	0x46, 0xf8, 0x8a, 0x1e, 0x02, 0x74, 0x5c, 0xdc, 0xdb, 0x1e, 0x68, 0x3e, 0x87, 0x7b, 0x53, 0x41,
+	0x28, 0x79, 0xd4, 0x3e, 0x70, 0x90, 0x5d, 0xb5, 0x3d, 0xec, 0xfe, 0x8d, 0x26, 0x6a, 0x37, 0x21,
+	0x17, 0x8a, 0x8b, 0x72, 0x44, 0xfb, 0xcc, 0x41, 0xf6, 0x69, 0xc7, 0x6c, 0xf8, 0xf8, 0xcf, 0x65,
+	0x32, 0x0b, 0x19, 0xea, 0x3a, 0x93, 0x20, 0x10, 0x09, 0x69, 0x62, 0x27, 0x3b, 0x18, 0x96, 0x6c,
+	0xf2, 0xfc, 0xc9, 0xee, 0x43, 0x2e, 0xd4, 0x1f, 0x79, 0xfc, 0xb5, 0x4b, 0x1c, 0x7f, 0x39, 0x19,
+	0xe4, 0x3a, 0x5c, 0x04, 0x36, 0x64, 0x2b, 0xb8, 0x85, 0xe3, 0x71, 0xee, 0xb4, 0x47, 0xfc, 0x2f,
+	0x1e, 0x69, 0x4f, 0x20, 0x17, 0x7e, 0x2f, 0x9e, 0x39, 0xf0, 0x08, 0x32, 0xcf, 0x48, 0x1b, 0x5d,
+	0x5e, 0xbf, 0xb6, 0x06, 0x59, 0xc6, 0x15, 0x8f, 0xb6, 0xc7, 0x90, 0x7d, 0xd0, 0xc3, 0xb6, 0xef,
+	0xc5, 0x21, 0xae, 0x06, 0xb9, 0x90, 0x8c, 0xa9, 0x5b, 0x02, 0x11, 0x07, 0x2b, 0x63, 0x09, 0x43,
+	0x75, 0x24, 0x8e, 0x9d, 0x3d, 0x0d, 0xd1, 0x36, 0x40, 0x24, 0xd3, 0x07, 0xe5, 0x01, 0x5e, 0x7b,
+	0x8e, 0xbd, 0x4d, 0x27, 0x56, 0xc0, 0x94, 0x59, 0x49, 0x18, 0x53, 0xc1, 0x1a, 0x05, 0xcc, 0x42,
+	0xba, 0x8d, 0xdd, 0x26, 0x66, 0x08, 0x9e, 0x21, 0x80, 0x2c, 0x12, 0x48, 0x59, 0x62, 0x03, 0x4f,
+	0xbb, 0x0f, 0xb9, 0x51, 0x27, 0x06, 0xcd, 0xc4, 0x0d, 0x37, 0xd3, 0x50, 0x75, 0xf3, 0xa3, 0xad,
+	0xfc, 0x23, 0x09, 0x22, 0x91, 0x8b, 0xca, 0x20, 0xed, 0xba, 0xb8, 0xe1, 0xe3, 0xd0, 0xb0, 0xb9,
+	0xc9, 0xf9, 0xe9, 0xcb, 0x14, 0xbd, 0x92, 0x30, 0xc2, 0xc0, 0x80, 0xa3, 0x4b, 0xfa, 0xca, 0x94,
+	0xf9, 0x33, 0x71, 0xd0, 0x2e, 0x24, 0x1c, 0x2c, 0x30, 0xe0, 0x30, 0x49, 0xc5, 0x9a, 0xb2, 0x70,
+	0x26, 0x0e, 0x5a, 0xdf, 0x84, 0x83, 0x05, 0x5e, 0xec, 0x46, 0x50, 0xd6, 0x40, 0x62, 0x39, 0xa1,
+	0xe5, 0x8b, 0x15, 0x62, 0x78, 0xe6, 0x64, 0x5b, 0xf9, 0xc8, 0x81, 0xc4, 0x12, 0x8c, 0x85, 0x30,
+	0xde, 0x51, 0xa4, 0xec, 0x81, 0xc4, 0xac, 0x8b, 0x47, 0x9d, 0x0c, 0x12, 0xde, 0xef, 0x58, 0x2e,
+	0x3b, 0xfc, 0x7f, 0x8c, 0xf0, 0x35, 0xa8, 0x57, 0xd2, 0x05, 0x8b, 0x9f, 0x52, 0x20, 0xd2, 0x3a,
+	0xad, 0x83, 0xb0, 0x8e, 0x7d, 0x74, 0x6d, 0xec, 0x97, 0x06, 0xff, 0x1a, 0xca, 0xf5, 0xc9, 0x20,
+	0xd6, 0x9d, 0x2f, 0x20, 0x45, 0x2f, 0x30, 0x34, 0xbe, 0x60, 0x46, 0xae, 0x5f, 0xa5, 0x10, 0x89,
+	0x1b, 0x50, 0xd3, 0x73, 0x9c, 0x40, 0x3d, 0x72, 0x1f, 0x2a, 0x85, 0x48, 0x1c, 0xa3, 0xae, 0x83,
+	0x50, 0x9d, 0xe8, 0x43, 0xf5, 0x2c, 0x3e, 0x54, 0x47, 0x7d, 0xa0, 0xc7, 0x3a, 0x41, 0xec, 0xc8,
+	0x15, 0xa4, 0x14, 0x22, 0x71, 0x8c, 0xfa, 0x39, 0x88, 0x64, 0x5e, 0xa3, 0x1b, 0x63, 0x23, 0x86,
+	0xef, 0x06, 0x65, 0x2e, 0x0a, 0x46, 0x79, 0x17, 0x38, 0xf4, 0x12, 0x52, 0x74, 0xd8, 0xa2, 0x88,
+	0x6e, 0xf7, 0xa2, 0x45, 0x8f, 0x4e, 0xed, 0x05, 0x0e, 0x6d, 0x41, 0x8a, 0xf6, 0xf5, 0x04, 0x72,
+	0x0a, 0x88, 0x26, 0x0f, 0x71, 0x94, 0x5c, 0x13, 0xde, 0xf2, 0x81, 0x78, 0x71, 0xb9, 0xe5, 0x78,
+	0x78, 0x82, 0x2d, 0x64, 0x3f, 0xda, 0x16, 0x06, 0x1b, 0x22, 0x2f, 0xdf, 0x3d, 0xe8, 0xab, 0xdc,
+	0x61, 0x5f, 0xe5, 0xbe, 0xf7, 0x55, 0xee, 0xdd, 0xb1, 0x9a, 0x38, 0x3c, 0x56, 0x13, 0x5f, 0x8f,
+	0xd5, 0x04, 0xfc, 0x6b, 0x39, 0x21, 0x51, 0xa3, 0x63, 0x9d, 0x90, 0x94, 0x25, 0xd2, 0x61, 0x9b,
+	0xa5, 0x3a, 0xb7, 0x93, 0x22, 0x3f, 0x43, 0xb7, 0x7e, 0x0e, 0x00, 0xfd, 0x9a, 0x6e, 0x02, 0x11,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1571,6 +1691,15 @@ func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintValue(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1639,13 +1768,25 @@ func (m *SetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TTL != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintValue(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintValue(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1730,12 +1871,12 @@ func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TTL != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintValue(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintValue(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1808,12 +1949,12 @@ func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TTL != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintValue(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintValue(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2087,6 +2228,70 @@ func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Patch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Patch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Patch != nil {
+		{
+			size := m.Patch.Size()
+			i -= size
+			if _, err := m.Patch.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Patch_JsonPatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_JsonPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonPatch != nil {
+		i -= len(m.JsonPatch)
+		copy(dAtA[i:], m.JsonPatch)
+		i = encodeVarintValue(dAtA, i, uint64(len(m.JsonPatch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Patch_MergePatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_MergePatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MergePatch != nil {
+		i -= len(m.MergePatch)
+		copy(dAtA[i:], m.MergePatch)
+		i = encodeVarintValue(dAtA, i, uint64(len(m.MergePatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func (m *VersionedValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2142,6 +2347,18 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Event != nil {
 		{
 			size := m.Event.Size()
@@ -2355,6 +2572,12 @@ func (m *GetRequest) Size() (n int) {
 	_ = l
 	l = m.ID.Size()
 	n += 1 + l + sovValue(uint64(l))
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovValue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2387,6 +2610,10 @@ func (m *SetRequest) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
 		n += 1 + l + sovValue(uint64(l))
 	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovValue(uint64(l))
+	}
 	return n
 }
 
@@ -2546,6 +2773,42 @@ func (m *EventsResponse) Size() (n int) {
 	return n
 }
 
+func (m *Patch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Patch != nil {
+		n += m.Patch.Size()
+	}
+	return n
+}
+
+func (m *Patch_JsonPatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonPatch != nil {
+		l = len(m.JsonPatch)
+		n += 1 + l + sovValue(uint64(l))
+	}
+	return n
+}
+func (m *Patch_MergePatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergePatch != nil {
+		l = len(m.MergePatch)
+		n += 1 + l + sovValue(uint64(l))
+	}
+	return n
+}
 func (m *VersionedValue) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2571,6 +2834,10 @@ func (m *Event) Size() (n int) {
 	if m.Event != nil {
 		n += m.Event.Size()
 	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovValue(uint64(l))
+	}
 	return n
 }
 
@@ -2716,6 +2983,38 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValue(dAtA[iNdEx:])
@@ -2955,6 +3254,42 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValue(dAtA[iNdEx:])
@@ -4100,6 +4435,122 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Patch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Patch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Patch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_JsonPatch{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_MergePatch{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *VersionedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4337,6 +4788,42 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &Event_Deleted_{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValue(dAtA[iNdEx:])
@@ -0,0 +1,2722 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: runtime/workqueue/v1/workqueue.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	v1 "github.com/atomix/atomix/api/runtime/v1"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EnqueueRequest struct {
+	ID      v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Payload []byte         `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *EnqueueRequest) Reset()         { *m = EnqueueRequest{} }
+func (m *EnqueueRequest) String() string { return proto.CompactTextString(m) }
+func (*EnqueueRequest) ProtoMessage()    {}
+func (*EnqueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{0}
+}
+func (m *EnqueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnqueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnqueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnqueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnqueueRequest.Merge(m, src)
+}
+func (m *EnqueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnqueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnqueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnqueueRequest proto.InternalMessageInfo
+
+func (m *EnqueueRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *EnqueueRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type EnqueueResponse struct {
+	TaskID uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *EnqueueResponse) Reset()         { *m = EnqueueResponse{} }
+func (m *EnqueueResponse) String() string { return proto.CompactTextString(m) }
+func (*EnqueueResponse) ProtoMessage()    {}
+func (*EnqueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{1}
+}
+func (m *EnqueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnqueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnqueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnqueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnqueueResponse.Merge(m, src)
+}
+func (m *EnqueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnqueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnqueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnqueueResponse proto.InternalMessageInfo
+
+func (m *EnqueueResponse) GetTaskID() uint64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type DequeueRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// lease is the duration for which the task is hidden from other consumers
+	// The task is redelivered if it's not acknowledged before the lease expires
+	Lease *time.Duration `protobuf:"bytes,2,opt,name=lease,proto3,stdduration" json:"lease,omitempty"`
+	// timeout is the maximum duration to wait for a task to become available
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+}
+
+func (m *DequeueRequest) Reset()         { *m = DequeueRequest{} }
+func (m *DequeueRequest) String() string { return proto.CompactTextString(m) }
+func (*DequeueRequest) ProtoMessage()    {}
+func (*DequeueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{2}
+}
+func (m *DequeueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DequeueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DequeueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DequeueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DequeueRequest.Merge(m, src)
+}
+func (m *DequeueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DequeueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DequeueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DequeueRequest proto.InternalMessageInfo
+
+func (m *DequeueRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *DequeueRequest) GetLease() *time.Duration {
+	if m != nil {
+		return m.Lease
+	}
+	return nil
+}
+
+func (m *DequeueRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+type DequeueResponse struct {
+	Task Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task"`
+}
+
+func (m *DequeueResponse) Reset()         { *m = DequeueResponse{} }
+func (m *DequeueResponse) String() string { return proto.CompactTextString(m) }
+func (*DequeueResponse) ProtoMessage()    {}
+func (*DequeueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{3}
+}
+func (m *DequeueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DequeueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DequeueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DequeueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DequeueResponse.Merge(m, src)
+}
+func (m *DequeueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DequeueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DequeueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DequeueResponse proto.InternalMessageInfo
+
+func (m *DequeueResponse) GetTask() Task {
+	if m != nil {
+		return m.Task
+	}
+	return Task{}
+}
+
+type AckRequest struct {
+	ID     v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	TaskID uint64         `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// delivery_id is the delivery of the task returned by Dequeue
+	// Only the consumer to which the task was delivered can acknowledge it while the task is leased.
+	DeliveryID uint64 `protobuf:"varint,3,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+}
+
+func (m *AckRequest) Reset()         { *m = AckRequest{} }
+func (m *AckRequest) String() string { return proto.CompactTextString(m) }
+func (*AckRequest) ProtoMessage()    {}
+func (*AckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{4}
+}
+func (m *AckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckRequest.Merge(m, src)
+}
+func (m *AckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckRequest proto.InternalMessageInfo
+
+func (m *AckRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *AckRequest) GetTaskID() uint64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *AckRequest) GetDeliveryID() uint64 {
+	if m != nil {
+		return m.DeliveryID
+	}
+	return 0
+}
+
+type AckResponse struct {
+}
+
+func (m *AckResponse) Reset()         { *m = AckResponse{} }
+func (m *AckResponse) String() string { return proto.CompactTextString(m) }
+func (*AckResponse) ProtoMessage()    {}
+func (*AckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{5}
+}
+func (m *AckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckResponse.Merge(m, src)
+}
+func (m *AckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AckResponse proto.InternalMessageInfo
+
+type NackRequest struct {
+	ID     v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	TaskID uint64         `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// delivery_id is the delivery of the task returned by Dequeue
+	// Only the consumer to which the task was delivered can return it while the task is leased.
+	DeliveryID uint64 `protobuf:"varint,3,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+}
+
+func (m *NackRequest) Reset()         { *m = NackRequest{} }
+func (m *NackRequest) String() string { return proto.CompactTextString(m) }
+func (*NackRequest) ProtoMessage()    {}
+func (*NackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{6}
+}
+func (m *NackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NackRequest.Merge(m, src)
+}
+func (m *NackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NackRequest proto.InternalMessageInfo
+
+func (m *NackRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+func (m *NackRequest) GetTaskID() uint64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *NackRequest) GetDeliveryID() uint64 {
+	if m != nil {
+		return m.DeliveryID
+	}
+	return 0
+}
+
+type NackResponse struct {
+}
+
+func (m *NackResponse) Reset()         { *m = NackResponse{} }
+func (m *NackResponse) String() string { return proto.CompactTextString(m) }
+func (*NackResponse) ProtoMessage()    {}
+func (*NackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{7}
+}
+func (m *NackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NackResponse.Merge(m, src)
+}
+func (m *NackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NackResponse proto.InternalMessageInfo
+
+type DeadLettersRequest struct {
+	ID v1.PrimitiveID `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+}
+
+func (m *DeadLettersRequest) Reset()         { *m = DeadLettersRequest{} }
+func (m *DeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*DeadLettersRequest) ProtoMessage()    {}
+func (*DeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{8}
+}
+func (m *DeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadLettersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLettersRequest.Merge(m, src)
+}
+func (m *DeadLettersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLettersRequest proto.InternalMessageInfo
+
+func (m *DeadLettersRequest) GetID() v1.PrimitiveID {
+	if m != nil {
+		return m.ID
+	}
+	return v1.PrimitiveID{}
+}
+
+type DeadLettersResponse struct {
+	Task Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task"`
+}
+
+func (m *DeadLettersResponse) Reset()         { *m = DeadLettersResponse{} }
+func (m *DeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*DeadLettersResponse) ProtoMessage()    {}
+func (*DeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{9}
+}
+func (m *DeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadLettersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLettersResponse.Merge(m, src)
+}
+func (m *DeadLettersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLettersResponse proto.InternalMessageInfo
+
+func (m *DeadLettersResponse) GetTask() Task {
+	if m != nil {
+		return m.Task
+	}
+	return Task{}
+}
+
+type Task struct {
+	ID      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// deliveries is the number of times the task has been delivered
+	Deliveries uint32 `protobuf:"varint,3,opt,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// delivery_id identifies the most recent delivery of the task
+	DeliveryID uint64 `protobuf:"varint,4,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+}
+
+func (m *Task) Reset()         { *m = Task{} }
+func (m *Task) String() string { return proto.CompactTextString(m) }
+func (*Task) ProtoMessage()    {}
+func (*Task) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa39064426610965, []int{10}
+}
+func (m *Task) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Task) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Task.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Task) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Task.Merge(m, src)
+}
+func (m *Task) XXX_Size() int {
+	return m.Size()
+}
+func (m *Task) XXX_DiscardUnknown() {
+	xxx_messageInfo_Task.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Task proto.InternalMessageInfo
+
+func (m *Task) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Task) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Task) GetDeliveries() uint32 {
+	if m != nil {
+		return m.Deliveries
+	}
+	return 0
+}
+
+func (m *Task) GetDeliveryID() uint64 {
+	if m != nil {
+		return m.DeliveryID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EnqueueRequest)(nil), "atomix.runtime.workqueue.v1.EnqueueRequest")
+	proto.RegisterType((*EnqueueResponse)(nil), "atomix.runtime.workqueue.v1.EnqueueResponse")
+	proto.RegisterType((*DequeueRequest)(nil), "atomix.runtime.workqueue.v1.DequeueRequest")
+	proto.RegisterType((*DequeueResponse)(nil), "atomix.runtime.workqueue.v1.DequeueResponse")
+	proto.RegisterType((*AckRequest)(nil), "atomix.runtime.workqueue.v1.AckRequest")
+	proto.RegisterType((*AckResponse)(nil), "atomix.runtime.workqueue.v1.AckResponse")
+	proto.RegisterType((*NackRequest)(nil), "atomix.runtime.workqueue.v1.NackRequest")
+	proto.RegisterType((*NackResponse)(nil), "atomix.runtime.workqueue.v1.NackResponse")
+	proto.RegisterType((*DeadLettersRequest)(nil), "atomix.runtime.workqueue.v1.DeadLettersRequest")
+	proto.RegisterType((*DeadLettersResponse)(nil), "atomix.runtime.workqueue.v1.DeadLettersResponse")
+	proto.RegisterType((*Task)(nil), "atomix.runtime.workqueue.v1.Task")
+}
+
+func init() {
+	proto.RegisterFile("runtime/workqueue/v1/workqueue.proto", fileDescriptor_aa39064426610965)
+}
+
+var fileDescriptor_aa39064426610965 = []byte{
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xce, 0x38, 0x6e, 0xa2, 0xff, 0xb8, 0x4d, 0xa5, 0xf9, 0x11, 0x0a, 0x46, 0x72, 0x8a, 0x01,
+	0x51, 0x5a, 0x64, 0x37, 0x45, 0x20, 0x01, 0xab, 0xba, 0x66, 0x61, 0x09, 0x55, 0xc5, 0x42, 0x80,
+	0xc4, 0x02, 0x4d, 0xeb, 0x69, 0x34, 0x72, 0x9a, 0x49, 0x7d, 0x83, 0xbe, 0x01, 0xec, 0x58, 0xb2,
+	0x82, 0x37, 0x61, 0xdd, 0x1d, 0x5d, 0xb2, 0x0a, 0xc8, 0x7d, 0x11, 0xe4, 0x5b, 0xeb, 0x44, 0xc2,
+	0x35, 0x52, 0x16, 0xec, 0xec, 0x99, 0xef, 0x9c, 0xef, 0xe2, 0x73, 0x12, 0xb8, 0xe5, 0x85, 0xa3,
+	0x80, 0x1d, 0x52, 0xfd, 0x1d, 0xf7, 0xdc, 0xa3, 0x90, 0x86, 0x54, 0x8f, 0xfa, 0x17, 0x2f, 0xda,
+	0xd8, 0xe3, 0x01, 0xc7, 0xd7, 0x49, 0xc0, 0x0f, 0xd9, 0x7b, 0x2d, 0x07, 0x6b, 0x17, 0xf7, 0x51,
+	0x5f, 0x56, 0x06, 0x9c, 0x0f, 0x86, 0x54, 0x4f, 0xa1, 0x7b, 0xe1, 0x81, 0xee, 0x84, 0x1e, 0x09,
+	0x18, 0x1f, 0x65, 0xc5, 0x72, 0xb7, 0xa0, 0x88, 0xfa, 0x7a, 0xd1, 0x20, 0xbb, 0xb9, 0x5d, 0x4d,
+	0xee, 0xe7, 0xb0, 0x2b, 0x03, 0x3e, 0xe0, 0xe9, 0xa3, 0x9e, 0x3c, 0x65, 0xa7, 0xea, 0x01, 0x74,
+	0x9e, 0x8e, 0x52, 0x9c, 0x4d, 0x8f, 0x42, 0xea, 0x07, 0xf8, 0x31, 0x08, 0xcc, 0xe9, 0xa2, 0x15,
+	0xb4, 0x2a, 0x6d, 0x2a, 0xda, 0x8c, 0xe4, 0xa8, 0xaf, 0xed, 0x7a, 0xec, 0x90, 0x05, 0x2c, 0xa2,
+	0x96, 0x69, 0xc0, 0xc9, 0xa4, 0xd7, 0x88, 0x27, 0x3d, 0xc1, 0x32, 0x6d, 0x81, 0x39, 0xb8, 0x0b,
+	0xed, 0x31, 0x39, 0x1e, 0x72, 0xe2, 0x74, 0x85, 0x15, 0xb4, 0xba, 0x68, 0x17, 0xaf, 0xea, 0x43,
+	0x58, 0x3e, 0xe7, 0xf1, 0xc7, 0x7c, 0xe4, 0x53, 0x7c, 0x13, 0xda, 0x01, 0xf1, 0xdd, 0xb7, 0x39,
+	0x9b, 0x68, 0x40, 0x3c, 0xe9, 0xb5, 0x5e, 0x10, 0xdf, 0xb5, 0x4c, 0xbb, 0x95, 0x5c, 0x59, 0x8e,
+	0xfa, 0x0d, 0x41, 0xc7, 0xa4, 0x73, 0x13, 0xf8, 0x00, 0x16, 0x86, 0x94, 0xf8, 0x34, 0x95, 0x27,
+	0x6d, 0x5e, 0xd3, 0xb2, 0xd4, 0xb5, 0x22, 0x75, 0xcd, 0xcc, 0x53, 0x37, 0xc4, 0xcf, 0x3f, 0x7b,
+	0xc8, 0xce, 0xd0, 0xf8, 0x11, 0xb4, 0x93, 0xee, 0x3c, 0x0c, 0xba, 0xcd, 0x7a, 0x85, 0x05, 0x5e,
+	0xdd, 0x81, 0x65, 0x93, 0x4e, 0x1b, 0x7f, 0x02, 0x62, 0xe2, 0x2e, 0xb7, 0x70, 0x43, 0xab, 0x18,
+	0x0b, 0x2d, 0x89, 0xc3, 0x10, 0x13, 0x17, 0x76, 0x5a, 0xa4, 0x7e, 0x41, 0x00, 0x5b, 0xfb, 0xee,
+	0x3c, 0xc2, 0x28, 0x7d, 0x00, 0xe1, 0x4f, 0x1f, 0x00, 0xeb, 0x20, 0x39, 0x74, 0xc8, 0x22, 0xea,
+	0x1d, 0x27, 0xc0, 0x66, 0x0a, 0xec, 0xc4, 0x93, 0x1e, 0x98, 0xf9, 0xb1, 0x65, 0xda, 0x50, 0x40,
+	0x2c, 0x47, 0x5d, 0x02, 0x29, 0xd5, 0x97, 0x99, 0x55, 0xbf, 0x22, 0x90, 0x76, 0xc8, 0xbf, 0x2c,
+	0xb8, 0x03, 0x8b, 0x3b, 0xa4, 0xa4, 0x78, 0x17, 0xb0, 0x49, 0x89, 0xf3, 0x8c, 0x06, 0x01, 0xf5,
+	0xfc, 0x39, 0xe8, 0x56, 0x6d, 0xf8, 0x7f, 0xaa, 0xe3, 0x3c, 0xe6, 0xe0, 0x23, 0x02, 0x31, 0x39,
+	0xc4, 0x57, 0xcf, 0x85, 0x89, 0x46, 0xab, 0xce, 0x2e, 0x62, 0x05, 0x0a, 0xfb, 0x8c, 0xfa, 0x69,
+	0x40, 0x4b, 0x76, 0xe9, 0x64, 0x36, 0x41, 0xf1, 0xb2, 0x04, 0x37, 0xbf, 0x2f, 0xc0, 0x7f, 0xaf,
+	0xb8, 0xe7, 0x3e, 0x4f, 0xd4, 0x62, 0x07, 0xda, 0xf9, 0xaa, 0xe3, 0xf5, 0x4a, 0x4f, 0xd3, 0x3f,
+	0x3c, 0xf2, 0xbd, 0x7a, 0xe0, 0x3c, 0x3c, 0x07, 0xda, 0x26, 0xad, 0xc3, 0x62, 0xd2, 0xbf, 0x60,
+	0x99, 0x5d, 0xd5, 0xd7, 0xd0, 0xdc, 0xda, 0x77, 0xf1, 0x9d, 0xca, 0xa2, 0x8b, 0x75, 0x94, 0x57,
+	0x2f, 0x07, 0xe6, 0x9d, 0xdf, 0x80, 0x98, 0x4c, 0x1d, 0xae, 0xae, 0x28, 0x6d, 0x8e, 0x7c, 0xb7,
+	0x06, 0x32, 0x6f, 0xee, 0x81, 0x54, 0x1a, 0x38, 0xac, 0x5f, 0xe2, 0x79, 0x76, 0xd8, 0xe5, 0x8d,
+	0xfa, 0x05, 0x19, 0xe3, 0x06, 0xc2, 0x07, 0xd0, 0xda, 0xf6, 0x28, 0x09, 0x28, 0x5e, 0xab, 0xac,
+	0xce, 0x40, 0x05, 0xd3, 0x7a, 0x2d, 0x6c, 0xbe, 0x99, 0xcd, 0x0f, 0x02, 0xc2, 0xfb, 0xb0, 0xb0,
+	0x3d, 0xe4, 0x3e, 0xc5, 0xd5, 0x79, 0xa4, 0x98, 0x82, 0x65, 0xad, 0x0e, 0xb4, 0x44, 0x62, 0x6c,
+	0x9f, 0xc4, 0x0a, 0x3a, 0x8d, 0x15, 0xf4, 0x2b, 0x56, 0xd0, 0xa7, 0x33, 0xa5, 0x71, 0x7a, 0xa6,
+	0x34, 0x7e, 0x9c, 0x29, 0x0d, 0x90, 0x19, 0x2f, 0x9a, 0x91, 0x31, 0x9b, 0x6a, 0x64, 0x48, 0xe7,
+	0x4b, 0xf0, 0xb2, 0xbf, 0x8b, 0xf6, 0x5a, 0xe9, 0x9f, 0xc3, 0xfd, 0xdf, 0x03, 0x00, 0x95, 0xb3,
+	0x9f, 0x0f, 0x1e, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkQueueClient is the client API for WorkQueue service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkQueueClient interface {
+	// Enqueue adds a task to the queue
+	Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (*EnqueueResponse, error)
+	// Dequeue leases the next task from the queue
+	Dequeue(ctx context.Context, in *DequeueRequest, opts ...grpc.CallOption) (*DequeueResponse, error)
+	// Ack acknowledges completion of a leased task
+	Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	// Nack returns a leased task to the queue for redelivery
+	Nack(ctx context.Context, in *NackRequest, opts ...grpc.CallOption) (*NackResponse, error)
+	// DeadLetters lists the tasks in the dead-letter queue
+	DeadLetters(ctx context.Context, in *DeadLettersRequest, opts ...grpc.CallOption) (WorkQueue_DeadLettersClient, error)
+	// Create creates the WorkQueue
+	// Deprecated: use the WorkQueues service instead
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Close closes the WorkQueue
+	// Deprecated: use the WorkQueues service instead
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error)
+}
+
+type workQueueClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkQueueClient(cc *grpc.ClientConn) WorkQueueClient {
+	return &workQueueClient{cc}
+}
+
+func (c *workQueueClient) Enqueue(ctx context.Context, in *EnqueueRequest, opts ...grpc.CallOption) (*EnqueueResponse, error) {
+	out := new(EnqueueResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.workqueue.v1.WorkQueue/Enqueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workQueueClient) Dequeue(ctx context.Context, in *DequeueRequest, opts ...grpc.CallOption) (*DequeueResponse, error) {
+	out := new(DequeueResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.workqueue.v1.WorkQueue/Dequeue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workQueueClient) Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.workqueue.v1.WorkQueue/Ack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workQueueClient) Nack(ctx context.Context, in *NackRequest, opts ...grpc.CallOption) (*NackResponse, error) {
+	out := new(NackResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.workqueue.v1.WorkQueue/Nack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workQueueClient) DeadLetters(ctx context.Context, in *DeadLettersRequest, opts ...grpc.CallOption) (WorkQueue_DeadLettersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkQueue_serviceDesc.Streams[0], "/atomix.runtime.workqueue.v1.WorkQueue/DeadLetters", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workQueueDeadLettersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkQueue_DeadLettersClient interface {
+	Recv() (*DeadLettersResponse, error)
+	grpc.ClientStream
+}
+
+type workQueueDeadLettersClient struct {
+	grpc.ClientStream
+}
+
+func (x *workQueueDeadLettersClient) Recv() (*DeadLettersResponse, error) {
+	m := new(DeadLettersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Deprecated: Do not use.
+func (c *workQueueClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.workqueue.v1.WorkQueue/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *workQueueClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseResponse, error) {
+	out := new(CloseResponse)
+	err := c.cc.Invoke(ctx, "/atomix.runtime.workqueue.v1.WorkQueue/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkQueueServer is the server API for WorkQueue service.
+type WorkQueueServer interface {
+	// Enqueue adds a task to the queue
+	Enqueue(context.Context, *EnqueueRequest) (*EnqueueResponse, error)
+	// Dequeue leases the next task from the queue
+	Dequeue(context.Context, *DequeueRequest) (*DequeueResponse, error)
+	// Ack acknowledges completion of a leased task
+	Ack(context.Context, *AckRequest) (*AckResponse, error)
+	// Nack returns a leased task to the queue for redelivery
+	Nack(context.Context, *NackRequest) (*NackResponse, error)
+	// DeadLetters lists the tasks in the dead-letter queue
+	DeadLetters(*DeadLettersRequest, WorkQueue_DeadLettersServer) error
+	// Create creates the WorkQueue
+	// Deprecated: use the WorkQueues service instead
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Close closes the WorkQueue
+	// Deprecated: use the WorkQueues service instead
+	Close(context.Context, *CloseRequest) (*CloseResponse, error)
+}
+
+// UnimplementedWorkQueueServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkQueueServer struct {
+}
+
+func (*UnimplementedWorkQueueServer) Enqueue(ctx context.Context, req *EnqueueRequest) (*EnqueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enqueue not implemented")
+}
+func (*UnimplementedWorkQueueServer) Dequeue(ctx context.Context, req *DequeueRequest) (*DequeueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dequeue not implemented")
+}
+func (*UnimplementedWorkQueueServer) Ack(ctx context.Context, req *AckRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ack not implemented")
+}
+func (*UnimplementedWorkQueueServer) Nack(ctx context.Context, req *NackRequest) (*NackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nack not implemented")
+}
+func (*UnimplementedWorkQueueServer) DeadLetters(req *DeadLettersRequest, srv WorkQueue_DeadLettersServer) error {
+	return status.Errorf(codes.Unimplemented, "method DeadLetters not implemented")
+}
+func (*UnimplementedWorkQueueServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedWorkQueueServer) Close(ctx context.Context, req *CloseRequest) (*CloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+
+func RegisterWorkQueueServer(s *grpc.Server, srv WorkQueueServer) {
+	s.RegisterService(&_WorkQueue_serviceDesc, srv)
+}
+
+func _WorkQueue_Enqueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkQueueServer).Enqueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.workqueue.v1.WorkQueue/Enqueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkQueueServer).Enqueue(ctx, req.(*EnqueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkQueue_Dequeue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DequeueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkQueueServer).Dequeue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.workqueue.v1.WorkQueue/Dequeue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkQueueServer).Dequeue(ctx, req.(*DequeueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkQueue_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkQueueServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.workqueue.v1.WorkQueue/Ack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkQueueServer).Ack(ctx, req.(*AckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkQueue_Nack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkQueueServer).Nack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.workqueue.v1.WorkQueue/Nack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkQueueServer).Nack(ctx, req.(*NackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkQueue_DeadLetters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeadLettersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkQueueServer).DeadLetters(m, &workQueueDeadLettersServer{stream})
+}
+
+type WorkQueue_DeadLettersServer interface {
+	Send(*DeadLettersResponse) error
+	grpc.ServerStream
+}
+
+type workQueueDeadLettersServer struct {
+	grpc.ServerStream
+}
+
+func (x *workQueueDeadLettersServer) Send(m *DeadLettersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkQueue_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkQueueServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.workqueue.v1.WorkQueue/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkQueueServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkQueue_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkQueueServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.runtime.workqueue.v1.WorkQueue/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkQueueServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WorkQueue_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.runtime.workqueue.v1.WorkQueue",
+	HandlerType: (*WorkQueueServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Enqueue",
+			Handler:    _WorkQueue_Enqueue_Handler,
+		},
+		{
+			MethodName: "Dequeue",
+			Handler:    _WorkQueue_Dequeue_Handler,
+		},
+		{
+			MethodName: "Ack",
+			Handler:    _WorkQueue_Ack_Handler,
+		},
+		{
+			MethodName: "Nack",
+			Handler:    _WorkQueue_Nack_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _WorkQueue_Create_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _WorkQueue_Close_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DeadLetters",
+			Handler:       _WorkQueue_DeadLetters_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "runtime/workqueue/v1/workqueue.proto",
+}
+
+func (m *EnqueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnqueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnqueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintWorkqueue(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWorkqueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EnqueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnqueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnqueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskID != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.TaskID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DequeueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DequeueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DequeueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintWorkqueue(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Lease != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Lease, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Lease):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintWorkqueue(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWorkqueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DequeueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DequeueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DequeueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWorkqueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeliveryID != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.DeliveryID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskID != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.TaskID))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWorkqueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeliveryID != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.DeliveryID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskID != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.TaskID))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWorkqueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeadLettersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLettersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLettersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWorkqueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeadLettersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLettersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLettersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWorkqueue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeliveryID != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.DeliveryID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deliveries != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.Deliveries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintWorkqueue(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintWorkqueue(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkqueue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkqueue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EnqueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovWorkqueue(uint64(l))
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovWorkqueue(uint64(l))
+	}
+	return n
+}
+
+func (m *EnqueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskID != 0 {
+		n += 1 + sovWorkqueue(uint64(m.TaskID))
+	}
+	return n
+}
+
+func (m *DequeueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovWorkqueue(uint64(l))
+	if m.Lease != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Lease)
+		n += 1 + l + sovWorkqueue(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovWorkqueue(uint64(l))
+	}
+	return n
+}
+
+func (m *DequeueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Task.Size()
+	n += 1 + l + sovWorkqueue(uint64(l))
+	return n
+}
+
+func (m *AckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovWorkqueue(uint64(l))
+	if m.TaskID != 0 {
+		n += 1 + sovWorkqueue(uint64(m.TaskID))
+	}
+	if m.DeliveryID != 0 {
+		n += 1 + sovWorkqueue(uint64(m.DeliveryID))
+	}
+	return n
+}
+
+func (m *AckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovWorkqueue(uint64(l))
+	if m.TaskID != 0 {
+		n += 1 + sovWorkqueue(uint64(m.TaskID))
+	}
+	if m.DeliveryID != 0 {
+		n += 1 + sovWorkqueue(uint64(m.DeliveryID))
+	}
+	return n
+}
+
+func (m *NackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeadLettersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovWorkqueue(uint64(l))
+	return n
+}
+
+func (m *DeadLettersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Task.Size()
+	n += 1 + l + sovWorkqueue(uint64(l))
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovWorkqueue(uint64(m.ID))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovWorkqueue(uint64(l))
+	}
+	if m.Deliveries != 0 {
+		n += 1 + sovWorkqueue(uint64(m.Deliveries))
+	}
+	if m.DeliveryID != 0 {
+		n += 1 + sovWorkqueue(uint64(m.DeliveryID))
+	}
+	return n
+}
+
+func sovWorkqueue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkqueue(x uint64) (n int) {
+	return sovWorkqueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EnqueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnqueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnqueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnqueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnqueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnqueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			m.TaskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DequeueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DequeueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DequeueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lease == nil {
+				m.Lease = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Lease, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DequeueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DequeueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DequeueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			m.TaskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryID", wireType)
+			}
+			m.DeliveryID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			m.TaskID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryID", wireType)
+			}
+			m.DeliveryID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadLettersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLettersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLettersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadLettersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLettersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLettersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
+			}
+			m.Deliveries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deliveries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryID", wireType)
+			}
+			m.DeliveryID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkqueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkqueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWorkqueue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWorkqueue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWorkqueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWorkqueue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWorkqueue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWorkqueue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWorkqueue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWorkqueue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWorkqueue = fmt.Errorf("proto: unexpected end of group")
+)
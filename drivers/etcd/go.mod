@@ -4,7 +4,7 @@ go 1.19
 
 require (
 	github.com/atomix/atomix/api v1.1.0
-	github.com/atomix/atomix/runtime v1.1.1
+	github.com/vpascoalr/atomix/runtime v0.0.0-00010101000000-000000000000
 	go.etcd.io/etcd/api/v3 v3.5.6
 	go.etcd.io/etcd/client/v3 v3.5.6
 )
@@ -27,3 +27,7 @@ require (
 	google.golang.org/protobuf v1.28.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/atomix/atomix/api => ../../api
+
+replace github.com/vpascoalr/atomix/runtime => ../../runtime
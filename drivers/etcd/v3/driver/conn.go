@@ -6,12 +6,13 @@ package driver
 
 import (
 	"context"
+	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
 	etcdlockv1 "github.com/atomix/atomix/drivers/etcd/v3/driver/lock/v1"
 	etcdmapv1 "github.com/atomix/atomix/drivers/etcd/v3/driver/map/v1"
-	"github.com/atomix/atomix/runtime/pkg/driver"
-	runtimelockv1 "github.com/atomix/atomix/runtime/pkg/runtime/lock/v1"
-	runtimemapv1 "github.com/atomix/atomix/runtime/pkg/runtime/map/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	runtimelockv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/lock/v1"
+	runtimemapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/map/v1"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
 )
@@ -34,8 +35,8 @@ func (c *etcdConn) NewLockV1(ctx context.Context, id runtimev1.PrimitiveID) (run
 	return etcdlockv1.NewLock(c.session, id)
 }
 
-func (c *etcdConn) NewMapV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimemapv1.MapProxy, error) {
-	return etcdmapv1.NewMap(c.session, id)
+func (c *etcdConn) NewMapV1(ctx context.Context, id runtimev1.PrimitiveID, config *mapv1.Config) (runtimemapv1.MapProxy, error) {
+	return etcdmapv1.NewMap(c.session, id, config)
 }
 
 func (c *etcdConn) Close(ctx context.Context) error {
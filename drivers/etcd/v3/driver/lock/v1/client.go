@@ -7,41 +7,114 @@ package v1
 import (
 	"context"
 	"fmt"
+	"github.com/atomix/atomix/api/errors"
 	lockv1 "github.com/atomix/atomix/api/runtime/lock/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
-	runtimelockv1 "github.com/atomix/atomix/runtime/pkg/runtime/lock/v1"
+	runtimelockv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/lock/v1"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
+	"strings"
+	"sync"
 )
 
 func NewLock(session *concurrency.Session, id runtimev1.PrimitiveID) (runtimelockv1.LockProxy, error) {
+	prefix := fmt.Sprintf("%s/", id.Name)
 	return &etcdLock{
-		mutex: concurrency.NewMutex(session, fmt.Sprintf("%s/", id.Name)),
+		session: session,
+		prefix:  prefix,
+		mutex:   concurrency.NewMutex(session, prefix),
 	}, nil
 }
 
 type etcdLock struct {
-	mutex *concurrency.Mutex
+	session *concurrency.Session
+	prefix  string
+	mutex   *concurrency.Mutex
+	// holds is the number of times the lock has been acquired through this client
+	holds   uint32
+	version uint64
+	mu      sync.Mutex
 }
 
 func (s *etcdLock) Lock(ctx context.Context, request *lockv1.LockRequest) (*lockv1.LockResponse, error) {
+	if request.Reentrant {
+		s.mu.Lock()
+		if s.holds > 0 {
+			s.holds++
+			response := &lockv1.LockResponse{
+				Version: s.version,
+				Holds:   s.holds,
+			}
+			s.mu.Unlock()
+			return response, nil
+		}
+		s.mu.Unlock()
+	}
+
 	if err := s.mutex.Lock(ctx); err != nil {
 		return nil, err
 	}
+
+	// The holder's key stores the label so it can be returned by GetLock. Overwriting the value
+	// changes the key's mod revision but not its create revision, which orders the waiters.
+	response, err := s.session.Client().Put(ctx, s.mutex.Key(), request.Label, clientv3.WithIgnoreLease(), clientv3.WithPrevKV())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.holds = 1
+	s.version = uint64(response.PrevKv.CreateRevision)
 	return &lockv1.LockResponse{
-		Version: uint64(s.mutex.Header().Revision),
+		Version: s.version,
+		Holds:   s.holds,
 	}, nil
 }
 
 func (s *etcdLock) Unlock(ctx context.Context, request *lockv1.UnlockRequest) (*lockv1.UnlockResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.holds > 1 {
+		s.holds--
+		return &lockv1.UnlockResponse{}, nil
+	}
 	if err := s.mutex.Unlock(ctx); err != nil {
 		return nil, err
 	}
+	s.holds = 0
+	s.version = 0
 	return &lockv1.UnlockResponse{}, nil
 }
 
 func (s *etcdLock) GetLock(ctx context.Context, request *lockv1.GetLockRequest) (*lockv1.GetLockResponse, error) {
+	// The holder is the waiter with the lowest create revision
+	response, err := s.session.Client().Get(ctx, s.prefix, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortAscend))
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Kvs) == 0 {
+		return nil, errors.NewNotFound("lock not held")
+	}
+
+	holder := response.Kvs[0]
+	key := string(holder.Key)
+	holds := uint32(1)
+	s.mu.Lock()
+	if key == s.mutex.Key() && s.holds > 0 {
+		holds = s.holds
+	}
+	s.mu.Unlock()
+
 	return &lockv1.GetLockResponse{
-		Version: uint64(s.mutex.Header().Revision),
+		Version: uint64(holder.CreateRevision),
+		Holder: lockv1.GetLockResponse_Holder{
+			// Waiter keys are suffixed with the hex-encoded lease ID of the session
+			ID:    strings.TrimPrefix(key, s.prefix),
+			Label: string(holder.Value),
+		},
+		Holds:   holds,
+		Waiters: uint32(len(response.Kvs) - 1),
 	}, nil
 }
 
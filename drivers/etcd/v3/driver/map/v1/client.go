@@ -6,20 +6,26 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"github.com/atomix/atomix/api/errors"
 	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
-	runtimemapv1 "github.com/atomix/atomix/runtime/pkg/runtime/map/v1"
+	runtimemapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/map/v1"
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
 	"go.etcd.io/etcd/client/v3/namespace"
+	"path"
+	"strings"
 	"sync/atomic"
 )
 
-func NewMap(session *concurrency.Session, id runtimev1.PrimitiveID) (runtimemapv1.MapProxy, error) {
+func NewMap(session *concurrency.Session, id runtimev1.PrimitiveID, config *mapv1.Config) (runtimemapv1.MapProxy, error) {
+	if config != nil && len(config.Indexes) > 0 {
+		return nil, errors.NewNotSupported("indexes not supported by etcd driver")
+	}
 	prefix := fmt.Sprintf("%s/", id.Name)
 	return &etcdMap{
 		session:  session,
@@ -57,9 +63,21 @@ func (c *etcdMap) Size(ctx context.Context, request *mapv1.SizeRequest) (*mapv1.
 }
 
 func (c *etcdMap) Put(ctx context.Context, request *mapv1.PutRequest) (*mapv1.PutResponse, error) {
+	if request.Fence != nil {
+		return nil, errors.NewNotSupported("fence not supported by etcd driver")
+	}
+	if request.Patch != nil {
+		return nil, errors.NewNotSupported("patch not supported by etcd driver")
+	}
 	var opts []clientv3.OpOption
 	opts = append(opts, clientv3.WithPrevKV())
-	if request.TTL != nil {
+	if request.Ephemeral {
+		if request.TTL != nil {
+			return nil, errors.NewInvalid("ephemeral entries cannot have a TTL")
+		}
+		// Ephemeral entries are attached to the session lease, so they're deleted when the session expires
+		opts = append(opts, clientv3.WithLease(c.session.Lease()))
+	} else if request.TTL != nil {
 		response, err := c.lease.Grant(ctx, int64(request.TTL.Seconds()))
 		if err != nil {
 			return nil, convertError(err)
@@ -115,7 +133,13 @@ func (c *etcdMap) Put(ctx context.Context, request *mapv1.PutRequest) (*mapv1.Pu
 func (c *etcdMap) Insert(ctx context.Context, request *mapv1.InsertRequest) (*mapv1.InsertResponse, error) {
 	var opts []clientv3.OpOption
 	opts = append(opts, clientv3.WithPrevKV())
-	if request.TTL != nil {
+	if request.Ephemeral {
+		if request.TTL != nil {
+			return nil, errors.NewInvalid("ephemeral entries cannot have a TTL")
+		}
+		// Ephemeral entries are attached to the session lease, so they're deleted when the session expires
+		opts = append(opts, clientv3.WithLease(c.session.Lease()))
+	} else if request.TTL != nil {
 		response, err := c.lease.Grant(ctx, int64(request.TTL.Seconds()))
 		if err != nil {
 			return nil, convertError(err)
@@ -143,6 +167,9 @@ func (c *etcdMap) Insert(ctx context.Context, request *mapv1.InsertRequest) (*ma
 }
 
 func (c *etcdMap) Update(ctx context.Context, request *mapv1.UpdateRequest) (*mapv1.UpdateResponse, error) {
+	if request.Fence != nil {
+		return nil, errors.NewNotSupported("fence not supported by etcd driver")
+	}
 	txn := c.kv.Txn(ctx)
 	if request.PrevVersion == 0 {
 		txn = txn.If(clientv3.Compare(clientv3.CreateRevision(request.Key), ">", int64(0)))
@@ -181,6 +208,9 @@ func (c *etcdMap) Update(ctx context.Context, request *mapv1.UpdateRequest) (*ma
 }
 
 func (c *etcdMap) Get(ctx context.Context, request *mapv1.GetRequest) (*mapv1.GetResponse, error) {
+	if len(request.Paths) > 0 {
+		return nil, errors.NewNotSupported("paths not supported by etcd driver")
+	}
 	response, err := c.kv.Get(ctx, request.Key, clientv3.WithRev(c.revision.Get()))
 	if err != nil {
 		return nil, err
@@ -201,6 +231,9 @@ func (c *etcdMap) Get(ctx context.Context, request *mapv1.GetRequest) (*mapv1.Ge
 }
 
 func (c *etcdMap) Remove(ctx context.Context, request *mapv1.RemoveRequest) (*mapv1.RemoveResponse, error) {
+	if request.Fence != nil {
+		return nil, errors.NewNotSupported("fence not supported by etcd driver")
+	}
 	if request.PrevVersion == 0 {
 		response, err := c.kv.Delete(ctx, request.Key)
 		if err != nil {
@@ -242,6 +275,153 @@ func (c *etcdMap) Remove(ctx context.Context, request *mapv1.RemoveRequest) (*ma
 	}, nil
 }
 
+func (c *etcdMap) GetAll(ctx context.Context, request *mapv1.GetAllRequest) (*mapv1.GetAllResponse, error) {
+	keys := uniqueKeys(request.Keys)
+	entries := make([]mapv1.Entry, 0, len(keys))
+
+	// All batches are read at the same revision to return a consistent view of the map
+	revision := c.revision.Get()
+	for _, batch := range batches(keys) {
+		ops := make([]clientv3.Op, 0, len(batch))
+		for _, key := range batch {
+			ops = append(ops, clientv3.OpGet(key, clientv3.WithRev(revision)))
+		}
+		response, err := c.kv.Txn(ctx).Then(ops...).Commit()
+		if err != nil {
+			return nil, convertError(err)
+		}
+		c.revision.Update(response.Header.Revision)
+		if revision == 0 {
+			revision = response.Header.Revision
+		}
+
+		for _, op := range response.Responses {
+			for _, kv := range op.GetResponseRange().Kvs {
+				entries = append(entries, mapv1.Entry{
+					Key: string(kv.Key),
+					Value: &mapv1.VersionedValue{
+						Value:   kv.Value,
+						Version: uint64(kv.ModRevision),
+					},
+				})
+			}
+		}
+	}
+	return &mapv1.GetAllResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (c *etcdMap) PutAll(ctx context.Context, request *mapv1.PutAllRequest) (*mapv1.PutAllResponse, error) {
+	// etcd rejects transactions that put the same key more than once, so only the last entry for each key is put
+	entries := make([]mapv1.PutAllRequest_Entry, 0, len(request.Entries))
+	indexes := make(map[string]int)
+	for _, entry := range request.Entries {
+		if i, ok := indexes[entry.Key]; ok {
+			entries[i] = entry
+		} else {
+			indexes[entry.Key] = len(entries)
+			entries = append(entries, entry)
+		}
+	}
+
+	// Entries in different batches are not updated atomically
+	results := make([]mapv1.PutAllResponse_Result, 0, len(entries))
+	for _, batch := range batches(entries) {
+		batchResults, err := c.putAll(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, batchResults...)
+	}
+	return &mapv1.PutAllResponse{
+		Results: results,
+	}, nil
+}
+
+// putAll puts a batch of entries in a single transaction
+// The leases granted for entries with a TTL are revoked if the batch is not committed.
+func (c *etcdMap) putAll(ctx context.Context, entries []mapv1.PutAllRequest_Entry) ([]mapv1.PutAllResponse_Result, error) {
+	var leases []clientv3.LeaseID
+	revoke := func() {
+		for _, lease := range leases {
+			_, _ = c.lease.Revoke(context.Background(), lease)
+		}
+	}
+
+	ops := make([]clientv3.Op, 0, len(entries))
+	for _, entry := range entries {
+		var opts []clientv3.OpOption
+		opts = append(opts, clientv3.WithPrevKV())
+		if entry.TTL != nil {
+			response, err := c.lease.Grant(ctx, int64(entry.TTL.Seconds()))
+			if err != nil {
+				revoke()
+				return nil, convertError(err)
+			}
+			leases = append(leases, response.ID)
+			opts = append(opts, clientv3.WithLease(response.ID))
+			c.revision.Update(response.Revision)
+		}
+		ops = append(ops, clientv3.OpPut(entry.Key, string(entry.Value), opts...))
+	}
+	response, err := c.kv.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		revoke()
+		return nil, convertError(err)
+	}
+	c.revision.Update(response.Header.Revision)
+
+	results := make([]mapv1.PutAllResponse_Result, 0, len(entries))
+	for i, op := range response.Responses {
+		result := mapv1.PutAllResponse_Result{
+			Key:     entries[i].Key,
+			Version: uint64(response.Header.Revision),
+		}
+		if prev := op.GetResponsePut().PrevKv; prev != nil {
+			result.PrevValue = &mapv1.VersionedValue{
+				Value:   prev.Value,
+				Version: uint64(prev.ModRevision),
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (c *etcdMap) RemoveAll(ctx context.Context, request *mapv1.RemoveAllRequest) (*mapv1.RemoveAllResponse, error) {
+	keys := uniqueKeys(request.Keys)
+	entries := make([]mapv1.Entry, 0, len(keys))
+
+	// Keys in different batches are not removed atomically
+	for _, batch := range batches(keys) {
+		ops := make([]clientv3.Op, 0, len(batch))
+		for _, key := range batch {
+			ops = append(ops, clientv3.OpDelete(key, clientv3.WithPrevKV()))
+		}
+		response, err := c.kv.Txn(ctx).Then(ops...).Commit()
+		if err != nil {
+			return nil, convertError(err)
+		}
+		c.revision.Update(response.Header.Revision)
+
+		for _, op := range response.Responses {
+			for _, prev := range op.GetResponseDeleteRange().PrevKvs {
+				entries = append(entries, mapv1.Entry{
+					Key: string(prev.Key),
+					Value: &mapv1.VersionedValue{
+						Value:   prev.Value,
+						Version: uint64(prev.ModRevision),
+					},
+				})
+			}
+		}
+	}
+	return &mapv1.RemoveAllResponse{
+		Entries: entries,
+	}, nil
+}
+
 func (c *etcdMap) Clear(ctx context.Context, request *mapv1.ClearRequest) (*mapv1.ClearResponse, error) {
 	response, err := c.kv.Delete(ctx, "", clientv3.WithPrefix())
 	if err != nil {
@@ -255,6 +435,10 @@ func (c *etcdMap) Commit(ctx context.Context, request *mapv1.CommitRequest) (*ma
 	return nil, errors.NewNotSupported("Commit not supported by etcd driver")
 }
 
+func (c *etcdMap) Query(ctx context.Context, request *mapv1.QueryRequest) (*mapv1.QueryResponse, error) {
+	return nil, errors.NewNotSupported("Query not supported by etcd driver")
+}
+
 func (c *etcdMap) Lock(ctx context.Context, request *mapv1.LockRequest) (*mapv1.LockResponse, error) {
 	return nil, errors.NewNotSupported("Lock not supported by etcd driver")
 }
@@ -264,7 +448,20 @@ func (c *etcdMap) Unlock(ctx context.Context, request *mapv1.UnlockRequest) (*ma
 }
 
 func (c *etcdMap) Events(request *mapv1.EventsRequest, server mapv1.Map_EventsServer) error {
-	ch := c.watcher.Watch(server.Context(), request.Key)
+	if request.Pattern != "" {
+		if _, err := path.Match(request.Pattern, ""); err != nil {
+			return errors.NewInvalid("invalid key pattern '%s'", request.Pattern)
+		}
+	}
+
+	// Watch the prefix in etcd and apply the remaining filters to the events received
+	key := request.Key
+	var opts []clientv3.OpOption
+	if key == "" {
+		key = request.Prefix
+		opts = append(opts, clientv3.WithPrefix())
+	}
+	ch := c.watcher.Watch(server.Context(), key, opts...)
 	for response := range ch {
 		c.revision.Update(response.Header.Revision)
 		for _, event := range response.Events {
@@ -315,6 +512,10 @@ func (c *etcdMap) Events(request *mapv1.EventsRequest, server mapv1.Map_EventsSe
 				}
 			}
 
+			if !matches(request, &mapEvent) {
+				continue
+			}
+
 			mapResponse := &mapv1.EventsResponse{
 				Event: mapEvent,
 			}
@@ -331,7 +532,41 @@ func (c *etcdMap) Entries(request *mapv1.EntriesRequest, server mapv1.Map_Entrie
 		return errors.NewNotSupported("watch flag not supported by etcd driver")
 	}
 
-	response, err := c.kv.Get(server.Context(), "", clientv3.WithPrefix())
+	after, err := decodeContinuation(request.Continuation)
+	if err != nil {
+		return err
+	}
+
+	// Compute the range of keys to list from the prefix, bounds and continuation
+	key := request.Prefix
+	if request.StartKey > key {
+		key = request.StartKey
+	}
+	if after != "" && after >= key {
+		key = after + "\x00"
+	}
+	var end string
+	if request.Prefix != "" {
+		end = clientv3.GetPrefixRangeEnd(request.Prefix)
+	}
+	if request.EndKey != "" && (end == "" || request.EndKey < end) {
+		end = request.EndKey
+	}
+
+	var opts []clientv3.OpOption
+	if end != "" {
+		if key >= end {
+			return nil
+		}
+		opts = append(opts, clientv3.WithRange(end))
+	} else {
+		opts = append(opts, clientv3.WithFromKey())
+	}
+	if request.Limit > 0 {
+		opts = append(opts, clientv3.WithLimit(int64(request.Limit)))
+	}
+
+	response, err := c.kv.Get(server.Context(), key, opts...)
 	if err != nil {
 		return convertError(err)
 	}
@@ -345,6 +580,7 @@ func (c *etcdMap) Entries(request *mapv1.EntriesRequest, server mapv1.Map_Entrie
 					Version: uint64(kv.ModRevision),
 				},
 			},
+			Continuation: encodeContinuation(string(kv.Key)),
 		})
 		if err != nil {
 			return err
@@ -357,6 +593,98 @@ func (c *etcdMap) Close(ctx context.Context) error {
 	return nil
 }
 
+// matches returns whether the given event passes all the request's filters
+func matches(request *mapv1.EventsRequest, event *mapv1.Event) bool {
+	if !strings.HasPrefix(event.Key, request.Prefix) {
+		return false
+	}
+	if request.Pattern != "" {
+		if ok, err := path.Match(request.Pattern, event.Key); !ok || err != nil {
+			return false
+		}
+	}
+	if len(request.Keys) > 0 {
+		found := false
+		for _, key := range request.Keys {
+			if key == event.Key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(request.Types) > 0 {
+		var eventType mapv1.EventsRequest_EventType
+		switch event.Event.(type) {
+		case *mapv1.Event_Inserted_:
+			eventType = mapv1.EventsRequest_INSERTED
+		case *mapv1.Event_Updated_:
+			eventType = mapv1.EventsRequest_UPDATED
+		case *mapv1.Event_Removed_:
+			eventType = mapv1.EventsRequest_REMOVED
+		}
+		found := false
+		for _, t := range request.Types {
+			if t == eventType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeContinuation encodes the continuation token used to resume listing after the given key
+// maxTxnOps is the default limit on the number of operations in an etcd transaction
+const maxTxnOps = 128
+
+// batches splits the given items into batches that fit in a single transaction
+func batches[T any](items []T) [][]T {
+	var batches [][]T
+	for len(items) > maxTxnOps {
+		batches = append(batches, items[:maxTxnOps])
+		items = items[maxTxnOps:]
+	}
+	if len(items) > 0 {
+		batches = append(batches, items)
+	}
+	return batches
+}
+
+// uniqueKeys returns the given keys in order without duplicates, which etcd rejects within a transaction
+func uniqueKeys(keys []string) []string {
+	unique := make([]string, 0, len(keys))
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
+func encodeContinuation(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// decodeContinuation decodes the key after which to resume listing from the given continuation token
+func decodeContinuation(continuation string) (string, error) {
+	if continuation == "" {
+		return "", nil
+	}
+	key, err := base64.RawURLEncoding.DecodeString(continuation)
+	if err != nil {
+		return "", errors.NewInvalid("invalid continuation token")
+	}
+	return string(key), nil
+}
+
 func convertError(err error) error {
 	if err == nil {
 		return nil
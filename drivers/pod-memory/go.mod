@@ -4,20 +4,27 @@ go 1.19
 
 require (
 	github.com/atomix/atomix/api v1.1.0
-	github.com/atomix/atomix/protocols/rsm v1.1.1
-	github.com/atomix/atomix/runtime v1.1.1
+	github.com/atomix/atomix/runtime v1.1.0
+	github.com/stretchr/testify v1.8.0
+	github.com/vpascoalr/atomix/protocols/rsm v0.0.0-00010101000000-000000000000
+	github.com/vpascoalr/atomix/runtime v0.0.0-00010101000000-000000000000
+	google.golang.org/grpc v1.46.0
 )
 
 require (
 	github.com/bits-and-blooms/bitset v1.3.1 // indirect
 	github.com/bits-and-blooms/bloom/v3 v3.3.1 // indirect
 	github.com/cenkalti/backoff v2.2.1+incompatible // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/evanphx/json-patch v5.6.0+incompatible // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/mock v1.6.0 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/google/btree v1.0.1 // indirect
 	github.com/google/uuid v1.1.2 // indirect
-	github.com/kr/text v0.1.0 // indirect
 	github.com/mitchellh/go-homedir v1.1.0 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
 	go.uber.org/zap v1.24.0 // indirect
@@ -25,7 +32,12 @@ require (
 	golang.org/x/sys v0.0.0-20220503163025-988cb79eb6c6 // indirect
 	golang.org/x/text v0.3.7 // indirect
 	google.golang.org/genproto v0.0.0-20220407144326-9054f6ed7bac // indirect
-	google.golang.org/grpc v1.46.0 // indirect
 	google.golang.org/protobuf v1.28.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/atomix/atomix/api => ../../api
+
+replace github.com/vpascoalr/atomix/protocols/rsm => ../../protocols/rsm
+
+replace github.com/vpascoalr/atomix/runtime => ../../runtime
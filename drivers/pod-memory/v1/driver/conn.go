@@ -7,29 +7,67 @@ package driver
 import (
 	"context"
 	"fmt"
+	bloomfilterv1 "github.com/atomix/atomix/api/runtime/bloomfilter/v1"
+	cardinalityv1 "github.com/atomix/atomix/api/runtime/cardinality/v1"
+	counterv1 "github.com/atomix/atomix/api/runtime/counter/v1"
+	countermapv1 "github.com/atomix/atomix/api/runtime/countermap/v1"
+	idgeneratorv1 "github.com/atomix/atomix/api/runtime/idgenerator/v1"
+	latchv1 "github.com/atomix/atomix/api/runtime/latch/v1"
+	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
+	ratelimiterv1 "github.com/atomix/atomix/api/runtime/ratelimiter/v1"
+	rwlockv1 "github.com/atomix/atomix/api/runtime/rwlock/v1"
+	schedulerv1 "github.com/atomix/atomix/api/runtime/scheduler/v1"
+	semaphorev1 "github.com/atomix/atomix/api/runtime/semaphore/v1"
+	shardassignmentv1 "github.com/atomix/atomix/api/runtime/shardassignment/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
-	rsmapiv1 "github.com/atomix/atomix/protocols/rsm/api/v1"
-	"github.com/atomix/atomix/protocols/rsm/pkg/client"
-	counterclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/counter/v1"
-	countermapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/countermap/v1"
-	electionclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/election/v1"
-	indexedmapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/indexedmap/v1"
-	lockclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/lock/v1"
-	mapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/map/v1"
-	multimapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/multimap/v1"
-	setclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/set/v1"
-	valueclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/value/v1"
-	"github.com/atomix/atomix/protocols/rsm/pkg/node"
-	"github.com/atomix/atomix/runtime/pkg/network"
-	runtimecounterv1 "github.com/atomix/atomix/runtime/pkg/runtime/counter/v1"
-	runtimecountermapv1 "github.com/atomix/atomix/runtime/pkg/runtime/countermap/v1"
-	runtimeelectionv1 "github.com/atomix/atomix/runtime/pkg/runtime/election/v1"
-	runtimeindexedmapv1 "github.com/atomix/atomix/runtime/pkg/runtime/indexedmap/v1"
-	runtimelockv1 "github.com/atomix/atomix/runtime/pkg/runtime/lock/v1"
-	runtimemapv1 "github.com/atomix/atomix/runtime/pkg/runtime/map/v1"
-	runtimemultimapv1 "github.com/atomix/atomix/runtime/pkg/runtime/multimap/v1"
-	runtimesetv1 "github.com/atomix/atomix/runtime/pkg/runtime/set/v1"
-	runtimevaluev1 "github.com/atomix/atomix/runtime/pkg/runtime/value/v1"
+	rsmapiv1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	barrierclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/barrier/v1"
+	bloomfilterclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/bloomfilter/v1"
+	cardinalityclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/cardinality/v1"
+	counterclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/counter/v1"
+	countermapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/countermap/v1"
+	electionclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/election/v1"
+	idgeneratorclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/idgenerator/v1"
+	indexedmapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/indexedmap/v1"
+	latchclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/latch/v1"
+	listclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/list/v1"
+	lockclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/lock/v1"
+	mapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/map/v1"
+	membershipclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/membership/v1"
+	multimapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/multimap/v1"
+	ratelimiterclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/ratelimiter/v1"
+	rwlockclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/rwlock/v1"
+	schedulerclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/scheduler/v1"
+	semaphoreclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/semaphore/v1"
+	setclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/set/v1"
+	shardassignmentclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/shardassignment/v1"
+	treeclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/tree/v1"
+	valueclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/value/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"github.com/vpascoalr/atomix/runtime/pkg/network"
+	runtimebarrierv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/barrier/v1"
+	runtimebloomfilterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/bloomfilter/v1"
+	runtimecardinalityv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/cardinality/v1"
+	runtimecounterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/counter/v1"
+	runtimecountermapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/countermap/v1"
+	runtimeelectionv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/election/v1"
+	runtimeidgeneratorv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/idgenerator/v1"
+	runtimeindexedmapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/indexedmap/v1"
+	runtimelatchv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/latch/v1"
+	runtimelistv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/list/v1"
+	runtimelockv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/lock/v1"
+	runtimemapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/map/v1"
+	runtimemembershipv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/membership/v1"
+	runtimemultimapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/multimap/v1"
+	runtimeratelimiterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/ratelimiter/v1"
+	runtimerwlockv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/rwlock/v1"
+	runtimeschedulerv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/scheduler/v1"
+	runtimesemaphorev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/semaphore/v1"
+	runtimesetv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/set/v1"
+	runtimeshardassignmentv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/shardassignment/v1"
+	runtimetreev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/tree/v1"
+	runtimevaluev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/value/v1"
 	"sync"
 )
 
@@ -69,16 +107,24 @@ func (c *podMemoryConn) Connect(ctx context.Context) error {
 	return c.ProtocolClient.Connect(ctx, config)
 }
 
-func (c *podMemoryConn) NewCounterV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimecounterv1.CounterProxy, error) {
-	proxy := counterclientv1.NewCounter(c.Protocol, id)
+func (c *podMemoryConn) NewBarrierV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimebarrierv1.BarrierProxy, error) {
+	proxy := barrierclientv1.NewBarrier(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
 		return nil, err
 	}
 	return proxy, nil
 }
 
-func (c *podMemoryConn) NewCounterMapV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimecountermapv1.CounterMapProxy, error) {
-	proxy := countermapclientv1.NewCounterMap(c.Protocol, id)
+func (c *podMemoryConn) NewCounterV1(ctx context.Context, id runtimev1.PrimitiveID, config *counterv1.Config) (runtimecounterv1.CounterProxy, error) {
+	proxy := counterclientv1.NewCounter(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewCounterMapV1(ctx context.Context, id runtimev1.PrimitiveID, config *countermapv1.Config) (runtimecountermapv1.CounterMapProxy, error) {
+	proxy := countermapclientv1.NewCounterMap(c.Protocol, id, config)
 	if err := proxy.Open(ctx); err != nil {
 		return nil, err
 	}
@@ -93,6 +139,14 @@ func (c *podMemoryConn) NewLeaderElectionV1(ctx context.Context, id runtimev1.Pr
 	return proxy, nil
 }
 
+func (c *podMemoryConn) NewIDGeneratorV1(ctx context.Context, id runtimev1.PrimitiveID, config *idgeneratorv1.Config) (runtimeidgeneratorv1.IDGeneratorProxy, error) {
+	proxy := idgeneratorclientv1.NewIDGenerator(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *podMemoryConn) NewIndexedMapV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimeindexedmapv1.IndexedMapProxy, error) {
 	proxy := indexedmapclientv1.NewIndexedMap(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -101,6 +155,22 @@ func (c *podMemoryConn) NewIndexedMapV1(ctx context.Context, id runtimev1.Primit
 	return proxy, nil
 }
 
+func (c *podMemoryConn) NewLatchV1(ctx context.Context, id runtimev1.PrimitiveID, config *latchv1.Config) (runtimelatchv1.LatchProxy, error) {
+	proxy := latchclientv1.NewLatch(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewListV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimelistv1.ListProxy, error) {
+	proxy := listclientv1.NewList(c.Protocol, id)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *podMemoryConn) NewLockV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimelockv1.LockProxy, error) {
 	proxy := lockclientv1.NewLock(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -109,8 +179,16 @@ func (c *podMemoryConn) NewLockV1(ctx context.Context, id runtimev1.PrimitiveID)
 	return proxy, nil
 }
 
-func (c *podMemoryConn) NewMapV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimemapv1.MapProxy, error) {
-	proxy := mapclientv1.NewMap(c.Protocol, id)
+func (c *podMemoryConn) NewMembershipV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimemembershipv1.MembershipProxy, error) {
+	proxy := membershipclientv1.NewMembership(c.Protocol, id)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewMapV1(ctx context.Context, id runtimev1.PrimitiveID, config *mapv1.Config) (runtimemapv1.MapProxy, error) {
+	proxy := mapclientv1.NewMap(c.Protocol, id, config)
 	if err := proxy.Open(ctx); err != nil {
 		return nil, err
 	}
@@ -125,6 +203,62 @@ func (c *podMemoryConn) NewMultiMapV1(ctx context.Context, id runtimev1.Primitiv
 	return proxy, nil
 }
 
+func (c *podMemoryConn) NewRWLockV1(ctx context.Context, id runtimev1.PrimitiveID, config *rwlockv1.Config) (runtimerwlockv1.RWLockProxy, error) {
+	proxy := rwlockclientv1.NewRWLock(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewShardAssignmentV1(ctx context.Context, id runtimev1.PrimitiveID, config *shardassignmentv1.Config) (runtimeshardassignmentv1.ShardAssignmentProxy, error) {
+	proxy := shardassignmentclientv1.NewShardAssignment(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewRateLimiterV1(ctx context.Context, id runtimev1.PrimitiveID, config *ratelimiterv1.Config) (runtimeratelimiterv1.RateLimiterProxy, error) {
+	proxy := ratelimiterclientv1.NewRateLimiter(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewSchedulerV1(ctx context.Context, id runtimev1.PrimitiveID, config *schedulerv1.Config) (runtimeschedulerv1.SchedulerProxy, error) {
+	proxy := schedulerclientv1.NewScheduler(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewCardinalityV1(ctx context.Context, id runtimev1.PrimitiveID, config *cardinalityv1.Config) (runtimecardinalityv1.CardinalityProxy, error) {
+	proxy := cardinalityclientv1.NewCardinality(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewBloomFilterV1(ctx context.Context, id runtimev1.PrimitiveID, config *bloomfilterv1.Config) (runtimebloomfilterv1.BloomFilterProxy, error) {
+	proxy := bloomfilterclientv1.NewBloomFilter(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *podMemoryConn) NewSemaphoreV1(ctx context.Context, id runtimev1.PrimitiveID, config *semaphorev1.Config) (runtimesemaphorev1.SemaphoreProxy, error) {
+	proxy := semaphoreclientv1.NewSemaphore(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *podMemoryConn) NewSetV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimesetv1.SetProxy, error) {
 	proxy := setclientv1.NewSet(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -133,6 +267,14 @@ func (c *podMemoryConn) NewSetV1(ctx context.Context, id runtimev1.PrimitiveID)
 	return proxy, nil
 }
 
+func (c *podMemoryConn) NewTreeV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimetreev1.TreeProxy, error) {
+	proxy := treeclientv1.NewTree(c.Protocol, id)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *podMemoryConn) NewValueV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimevaluev1.ValueProxy, error) {
 	proxy := valueclientv1.NewValue(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -141,12 +283,25 @@ func (c *podMemoryConn) NewValueV1(ctx context.Context, id runtimev1.PrimitiveID
 	return proxy, nil
 }
 
+var _ runtimebarrierv1.BarrierProvider = (*podMemoryConn)(nil)
 var _ runtimecounterv1.CounterProvider = (*podMemoryConn)(nil)
 var _ runtimecountermapv1.CounterMapProvider = (*podMemoryConn)(nil)
 var _ runtimeelectionv1.LeaderElectionProvider = (*podMemoryConn)(nil)
+var _ runtimeidgeneratorv1.IDGeneratorProvider = (*podMemoryConn)(nil)
 var _ runtimeindexedmapv1.IndexedMapProvider = (*podMemoryConn)(nil)
+var _ runtimelatchv1.LatchProvider = (*podMemoryConn)(nil)
+var _ runtimelistv1.ListProvider = (*podMemoryConn)(nil)
 var _ runtimelockv1.LockProvider = (*podMemoryConn)(nil)
+var _ runtimemembershipv1.MembershipProvider = (*podMemoryConn)(nil)
 var _ runtimemapv1.MapProvider = (*podMemoryConn)(nil)
 var _ runtimemultimapv1.MultiMapProvider = (*podMemoryConn)(nil)
+var _ runtimerwlockv1.RWLockProvider = (*podMemoryConn)(nil)
+var _ runtimeshardassignmentv1.ShardAssignmentProvider = (*podMemoryConn)(nil)
+var _ runtimeratelimiterv1.RateLimiterProvider = (*podMemoryConn)(nil)
+var _ runtimeschedulerv1.SchedulerProvider = (*podMemoryConn)(nil)
+var _ runtimecardinalityv1.CardinalityProvider = (*podMemoryConn)(nil)
+var _ runtimebloomfilterv1.BloomFilterProvider = (*podMemoryConn)(nil)
+var _ runtimesemaphorev1.SemaphoreProvider = (*podMemoryConn)(nil)
 var _ runtimesetv1.SetProvider = (*podMemoryConn)(nil)
+var _ runtimetreev1.TreeProvider = (*podMemoryConn)(nil)
 var _ runtimevaluev1.ValueProvider = (*podMemoryConn)(nil)
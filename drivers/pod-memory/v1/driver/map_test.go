@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/atomix/atomix/api/errors"
+	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/network"
+	"github.com/stretchr/testify/assert"
+	rsmapiv1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	mapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/map/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+func TestEphemeralEntries(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	network := network.NewLocalDriver()
+	node := newNode(network, node.WithHost("localhost"), node.WithPort(5678))
+	assert.NoError(t, node.Start())
+	defer node.Stop()
+
+	config := rsmapiv1.ProtocolConfig{
+		Partitions: []rsmapiv1.PartitionConfig{
+			{
+				PartitionID: 1,
+				Leader:      "localhost:5678",
+			},
+		},
+	}
+
+	// Each client opens its own session with the partition
+	client1 := client.NewClient(network)
+	assert.NoError(t, client1.Connect(ctx, config))
+	client2 := client.NewClient(network)
+	assert.NoError(t, client2.Connect(ctx, config))
+
+	id := runtimev1.PrimitiveID{
+		Name: "test",
+	}
+	map1 := mapclientv1.NewMap(client1.Protocol, id, &mapv1.Config{})
+	assert.NoError(t, map1.Open(ctx))
+	map2 := mapclientv1.NewMap(client2.Protocol, id, &mapv1.Config{})
+	assert.NoError(t, map2.Open(ctx))
+
+	events := newTestEventsServer(ctx)
+	go func() {
+		_ = map2.Events(&mapv1.EventsRequest{
+			ID: id,
+		}, events)
+	}()
+
+	// Write to the map from the second client until its events stream is listening
+	for listening := false; !listening; {
+		_, err := map2.Put(ctx, &mapv1.PutRequest{
+			ID:    id,
+			Key:   "bar",
+			Value: []byte("baz"),
+		})
+		assert.NoError(t, err)
+		select {
+		case <-events.ch:
+			listening = true
+		case <-time.After(10 * time.Millisecond):
+		case <-ctx.Done():
+			t.FailNow()
+		}
+	}
+
+	_, err := map1.Put(ctx, &mapv1.PutRequest{
+		ID:        id,
+		Key:       "foo",
+		Value:     []byte("bar"),
+		Ephemeral: true,
+	})
+	assert.NoError(t, err)
+
+	getResponse, err := map2.Get(ctx, &mapv1.GetRequest{
+		ID:  id,
+		Key: "foo",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("bar"), getResponse.Value.Value)
+
+	// Closing the first client closes the session that owns the ephemeral entry
+	assert.NoError(t, client1.Close(ctx))
+
+	for removed := false; !removed; {
+		select {
+		case response := <-events.ch:
+			if response.Event.Key == "foo" {
+				_, removed = response.Event.Event.(*mapv1.Event_Removed_)
+			}
+		case <-ctx.Done():
+			t.FailNow()
+		}
+	}
+
+	_, err = map2.Get(ctx, &mapv1.GetRequest{
+		ID:  id,
+		Key: "foo",
+	})
+	assert.True(t, errors.IsNotFound(err))
+
+	getResponse, err = map2.Get(ctx, &mapv1.GetRequest{
+		ID:  id,
+		Key: "bar",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("baz"), getResponse.Value.Value)
+}
+
+func newTestEventsServer(ctx context.Context) *testEventsServer {
+	return &testEventsServer{
+		ctx: ctx,
+		ch:  make(chan *mapv1.EventsResponse),
+	}
+}
+
+type testEventsServer struct {
+	grpc.ServerStream
+	ctx context.Context
+	ch  chan *mapv1.EventsResponse
+}
+
+func (s *testEventsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testEventsServer) Send(response *mapv1.EventsResponse) error {
+	select {
+	case s.ch <- response:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
@@ -6,42 +6,81 @@ package driver
 
 import (
 	"context"
-	rsmapiv1 "github.com/atomix/atomix/protocols/rsm/api/v1"
-	"github.com/atomix/atomix/protocols/rsm/pkg/node"
-	counternodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/counter/v1"
-	countermapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/countermap/v1"
-	electionnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/election/v1"
-	indexedmapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/indexedmap/v1"
-	locknodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/lock/v1"
-	mapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/map/v1"
-	multimapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/multimap/v1"
-	setnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/set/v1"
-	valuenodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/value/v1"
-	"github.com/atomix/atomix/protocols/rsm/pkg/statemachine"
-	countersmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/counter/v1"
-	countermapsmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/countermap/v1"
-	electionsmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/election/v1"
-	indexedmapsmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/indexedmap/v1"
-	locksmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/lock/v1"
-	mapsmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/map/v1"
-	multimapsmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/multimap/v1"
-	setsmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/set/v1"
-	valuesmv1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/value/v1"
 	"github.com/atomix/atomix/runtime/pkg/network"
 	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	rsmapiv1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	barriernodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/barrier/v1"
+	bloomfilternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/bloomfilter/v1"
+	cardinalitynodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/cardinality/v1"
+	counternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/counter/v1"
+	countermapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/countermap/v1"
+	electionnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/election/v1"
+	idgeneratornodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/idgenerator/v1"
+	indexedmapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/indexedmap/v1"
+	latchnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/latch/v1"
+	listnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/list/v1"
+	locknodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/lock/v1"
+	mapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/map/v1"
+	membershipnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/membership/v1"
+	multimapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/multimap/v1"
+	ratelimiternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/ratelimiter/v1"
+	rwlocknodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/rwlock/v1"
+	schedulernodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/scheduler/v1"
+	semaphorenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/semaphore/v1"
+	setnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/set/v1"
+	shardassignmentnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/shardassignment/v1"
+	treenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/tree/v1"
+	valuenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/value/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+	barriersmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/barrier/v1"
+	bloomfiltersmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/bloomfilter/v1"
+	cardinalitysmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/cardinality/v1"
+	countersmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/counter/v1"
+	countermapsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/countermap/v1"
+	electionsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/election/v1"
+	idgeneratorsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/idgenerator/v1"
+	indexedmapsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/indexedmap/v1"
+	latchsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/latch/v1"
+	listsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/list/v1"
+	locksmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/lock/v1"
+	mapsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/map/v1"
+	membershipsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/membership/v1"
+	multimapsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/multimap/v1"
+	ratelimitersmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/ratelimiter/v1"
+	rwlocksmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/rwlock/v1"
+	schedulersmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/scheduler/v1"
+	semaphoresmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/semaphore/v1"
+	setsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/set/v1"
+	shardassignmentsmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/shardassignment/v1"
+	treesmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/tree/v1"
+	valuesmv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/value/v1"
 	"sync"
 )
 
 func newNode(network network.Driver, opts ...node.Option) *node.Node {
 	node := node.NewNode(network, newProtocol(), opts...)
+	barriernodev1.RegisterServer(node)
 	counternodev1.RegisterServer(node)
 	countermapnodev1.RegisterServer(node)
 	electionnodev1.RegisterServer(node)
+	idgeneratornodev1.RegisterServer(node)
 	indexedmapnodev1.RegisterServer(node)
+	latchnodev1.RegisterServer(node)
+	listnodev1.RegisterServer(node)
 	locknodev1.RegisterServer(node)
+	membershipnodev1.RegisterServer(node)
 	mapnodev1.RegisterServer(node)
 	multimapnodev1.RegisterServer(node)
+	rwlocknodev1.RegisterServer(node)
+	shardassignmentnodev1.RegisterServer(node)
+	ratelimiternodev1.RegisterServer(node)
+	schedulernodev1.RegisterServer(node)
+	cardinalitynodev1.RegisterServer(node)
+	bloomfilternodev1.RegisterServer(node)
+	semaphorenodev1.RegisterServer(node)
 	setnodev1.RegisterServer(node)
+	treenodev1.RegisterServer(node)
 	valuenodev1.RegisterServer(node)
 	return node
 }
@@ -73,14 +112,27 @@ func (p *podMemoryProtocol) Partition(partitionID rsmapiv1.PartitionID) (node.Pa
 
 func newExecutor() node.Executor {
 	registry := statemachine.NewPrimitiveTypeRegistry()
+	barriersmv1.RegisterStateMachine(registry)
 	countersmv1.RegisterStateMachine(registry)
 	countermapsmv1.RegisterStateMachine(registry)
 	electionsmv1.RegisterStateMachine(registry)
+	idgeneratorsmv1.RegisterStateMachine(registry)
 	indexedmapsmv1.RegisterStateMachine(registry)
+	latchsmv1.RegisterStateMachine(registry)
+	listsmv1.RegisterStateMachine(registry)
 	locksmv1.RegisterStateMachine(registry)
+	membershipsmv1.RegisterStateMachine(registry)
 	mapsmv1.RegisterStateMachine(registry)
 	multimapsmv1.RegisterStateMachine(registry)
+	rwlocksmv1.RegisterStateMachine(registry)
+	shardassignmentsmv1.RegisterStateMachine(registry)
+	ratelimitersmv1.RegisterStateMachine(registry)
+	schedulersmv1.RegisterStateMachine(registry)
+	cardinalitysmv1.RegisterStateMachine(registry)
+	bloomfiltersmv1.RegisterStateMachine(registry)
+	semaphoresmv1.RegisterStateMachine(registry)
 	setsmv1.RegisterStateMachine(registry)
+	treesmv1.RegisterStateMachine(registry)
 	valuesmv1.RegisterStateMachine(registry)
 	return &podMemoryExecutor{
 		sm: statemachine.NewStateMachine(registry),
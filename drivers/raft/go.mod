@@ -4,8 +4,8 @@ go 1.19
 
 require (
 	github.com/atomix/atomix/api v1.1.0
-	github.com/vpascoalr/atomix/protocols/rsm v0.0.0-20230912233300-3ba5593ae2b6
-	github.com/vpascoalr/atomix/runtime v0.0.0-20230912233300-3ba5593ae2b6
+	github.com/vpascoalr/atomix/protocols/rsm v0.0.0-00010101000000-000000000000
+	github.com/vpascoalr/atomix/runtime v0.0.0-00010101000000-000000000000
 )
 
 require (
@@ -28,3 +28,9 @@ require (
 	google.golang.org/protobuf v1.28.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/atomix/atomix/api => ../../api
+
+replace github.com/vpascoalr/atomix/protocols/rsm => ../../protocols/rsm
+
+replace github.com/vpascoalr/atomix/runtime => ../../runtime
@@ -21,14 +21,82 @@ type RaftTestSuite struct {
 	*raftv1beta3.RaftV1beta3Client
 }
 
+func (s *RaftTestSuite) TestBarrier() {
+	s.RunSuite(new(tests.BarrierTestSuite))
+}
+
 func (s *RaftTestSuite) TestCounter() {
 	s.RunSuite(new(tests.CounterTestSuite))
 }
 
+func (s *RaftTestSuite) TestIDGenerator() {
+	s.RunSuite(new(tests.IDGeneratorTestSuite))
+}
+
+func (s *RaftTestSuite) TestLatch() {
+	s.RunSuite(new(tests.LatchTestSuite))
+}
+
+func (s *RaftTestSuite) TestLeaderElection() {
+	s.RunSuite(new(tests.LeaderElectionTestSuite))
+}
+
+func (s *RaftTestSuite) TestList() {
+	s.RunSuite(new(tests.ListTestSuite))
+}
+
+func (s *RaftTestSuite) TestLock() {
+	s.RunSuite(new(tests.LockTestSuite))
+}
+
+func (s *RaftTestSuite) TestMembership() {
+	s.RunSuite(new(tests.MembershipTestSuite))
+}
+
 func (s *RaftTestSuite) TestMap() {
 	s.RunSuite(new(tests.MapTestSuite))
 }
 
+func (s *RaftTestSuite) TestRWLock() {
+	s.RunSuite(new(tests.RWLockTestSuite))
+}
+
+func (s *RaftTestSuite) TestShardAssignment() {
+	s.RunSuite(new(tests.ShardAssignmentTestSuite))
+}
+
+func (s *RaftTestSuite) TestRateLimiter() {
+	s.RunSuite(new(tests.RateLimiterTestSuite))
+}
+
+func (s *RaftTestSuite) TestScheduler() {
+	s.RunSuite(new(tests.SchedulerTestSuite))
+}
+
+func (s *RaftTestSuite) TestCardinality() {
+	s.RunSuite(new(tests.CardinalityTestSuite))
+}
+
+func (s *RaftTestSuite) TestBloomFilter() {
+	s.RunSuite(new(tests.BloomFilterTestSuite))
+}
+
+func (s *RaftTestSuite) TestSemaphore() {
+	s.RunSuite(new(tests.SemaphoreTestSuite))
+}
+
+func (s *RaftTestSuite) TestTopic() {
+	s.RunSuite(new(tests.TopicTestSuite))
+}
+
+func (s *RaftTestSuite) TestTree() {
+	s.RunSuite(new(tests.TreeTestSuite))
+}
+
+func (s *RaftTestSuite) TestWorkQueue() {
+	s.RunSuite(new(tests.WorkQueueTestSuite))
+}
+
 func (s *RaftTestSuite) SetupSuite() {
 	atomixV3beta4Client, err := atomixv3beta4.NewForConfig(s.Config())
 	s.NoError(err)
@@ -8,9 +8,9 @@ import (
 	"context"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
 	redissetv1 "github.com/atomix/atomix/drivers/redis/v8/driver/set/v1"
-	"github.com/atomix/atomix/runtime/pkg/driver"
-	runtimesetv1 "github.com/atomix/atomix/runtime/pkg/runtime/set/v1"
 	"github.com/go-redis/redis/v8"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	runtimesetv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/set/v1"
 )
 
 func newConn(client *redis.Client) driver.Conn {
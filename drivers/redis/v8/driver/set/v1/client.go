@@ -8,8 +8,8 @@ import (
 	"context"
 	"github.com/atomix/atomix/api/errors"
 	setv1 "github.com/atomix/atomix/api/runtime/set/v1"
-	runtimesetv1 "github.com/atomix/atomix/runtime/pkg/runtime/set/v1"
 	"github.com/go-redis/redis/v8"
+	runtimesetv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/set/v1"
 )
 
 func NewSet(client *redis.Client) runtimesetv1.SetProxy {
@@ -8,8 +8,8 @@ import (
 	"context"
 	setv1 "github.com/atomix/atomix/api/runtime/set/v1"
 	redissetv1 "github.com/atomix/atomix/drivers/redis/v9/driver/set/v1"
-	"github.com/atomix/atomix/runtime/pkg/driver"
 	"github.com/go-redis/redis/v9"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
 )
 
 func newConn(client *redis.Client) driver.Conn {
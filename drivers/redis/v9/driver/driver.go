@@ -6,8 +6,8 @@ package driver
 
 import (
 	"context"
-	"github.com/atomix/atomix/runtime/pkg/driver"
 	"github.com/go-redis/redis/v9"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
 )
 
 func New() driver.Driver {
@@ -42,6 +42,10 @@ func (c *redisMap) Size(ctx context.Context, request *mapv1.SizeRequest) (*mapv1
 }
 
 func (c *redisMap) Put(ctx context.Context, request *mapv1.PutRequest) (*mapv1.PutResponse, error) {
+	if request.Fence != nil {
+		return nil, errors.NewNotSupported("fence not supported by Redis/v9 driver")
+	}
+
 	// TODO: Support prev_version for puts
 	if request.PrevVersion != 0 {
 		return nil, errors.NewNotSupported("prev_version not supported by Redis/v9 driver")
@@ -52,6 +56,10 @@ func (c *redisMap) Put(ctx context.Context, request *mapv1.PutRequest) (*mapv1.P
 		return nil, errors.NewNotSupported("ttl not supported by Redis/v9 driver")
 	}
 
+	if request.Ephemeral {
+		return nil, errors.NewNotSupported("ephemeral not supported by Redis/v9 driver")
+	}
+
 	// TODO: Use Lua scripting to increment version
 	bytes, err := c.client.GetSet(ctx, key(request.ID, request.Key), request.Value).Bytes()
 	if err != nil {
@@ -92,6 +100,10 @@ func (c *redisMap) Get(ctx context.Context, request *mapv1.GetRequest) (*mapv1.G
 }
 
 func (c *redisMap) Remove(ctx context.Context, request *mapv1.RemoveRequest) (*mapv1.RemoveResponse, error) {
+	if request.Fence != nil {
+		return nil, errors.NewNotSupported("fence not supported by Redis/v9 driver")
+	}
+
 	// TODO: Support prev_version for removes
 	if request.PrevVersion != 0 {
 		return nil, errors.NewNotSupported("prev_version not supported by Redis/v9 driver")
@@ -110,10 +122,26 @@ func (c *redisMap) Remove(ctx context.Context, request *mapv1.RemoveRequest) (*m
 	}, nil
 }
 
+func (c *redisMap) GetAll(ctx context.Context, request *mapv1.GetAllRequest) (*mapv1.GetAllResponse, error) {
+	return nil, errors.NewNotSupported("GetAll not supported")
+}
+
+func (c *redisMap) PutAll(ctx context.Context, request *mapv1.PutAllRequest) (*mapv1.PutAllResponse, error) {
+	return nil, errors.NewNotSupported("PutAll not supported")
+}
+
+func (c *redisMap) RemoveAll(ctx context.Context, request *mapv1.RemoveAllRequest) (*mapv1.RemoveAllResponse, error) {
+	return nil, errors.NewNotSupported("RemoveAll not supported")
+}
+
 func (c *redisMap) Clear(ctx context.Context, request *mapv1.ClearRequest) (*mapv1.ClearResponse, error) {
 	return nil, errors.NewNotSupported("Clear not supported")
 }
 
+func (c *redisMap) Query(ctx context.Context, request *mapv1.QueryRequest) (*mapv1.QueryResponse, error) {
+	return nil, errors.NewNotSupported("Query not supported")
+}
+
 func (c *redisMap) Lock(ctx context.Context, request *mapv1.LockRequest) (*mapv1.LockResponse, error) {
 	return nil, errors.NewNotSupported("Lock not supported")
 }
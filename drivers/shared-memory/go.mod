@@ -4,18 +4,18 @@ go 1.19
 
 require (
 	github.com/atomix/atomix/api v1.1.0
-	github.com/atomix/atomix/protocols/rsm v1.1.1
-	github.com/atomix/atomix/runtime v1.1.1
+	github.com/vpascoalr/atomix/protocols/rsm v0.0.0-00010101000000-000000000000
+	github.com/vpascoalr/atomix/runtime v0.0.0-00010101000000-000000000000
 )
 
 require (
+	github.com/atomix/atomix/runtime v1.1.0 // indirect
 	github.com/bits-and-blooms/bitset v1.3.1 // indirect
 	github.com/bits-and-blooms/bloom/v3 v3.3.1 // indirect
 	github.com/cenkalti/backoff v2.2.1+incompatible // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/mock v1.6.0 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
-	github.com/kr/text v0.1.0 // indirect
 	github.com/mitchellh/go-homedir v1.1.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
@@ -28,3 +28,9 @@ require (
 	google.golang.org/protobuf v1.28.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/atomix/atomix/api => ../../api
+
+replace github.com/vpascoalr/atomix/protocols/rsm => ../../protocols/rsm
+
+replace github.com/vpascoalr/atomix/runtime => ../../runtime
@@ -19,7 +19,6 @@ require (
 	github.com/Masterminds/squirrel v1.5.3 // indirect
 	github.com/asaskevich/govalidator v0.0.0-20200428143746-21a406dcc535 // indirect
 	github.com/atomix/atomix/api v1.1.0 // indirect
-	github.com/atomix/atomix/runtime v1.1.0 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cenkalti/backoff v2.2.1+incompatible // indirect
 	github.com/cespare/xxhash/v2 v2.1.2 // indirect
@@ -100,6 +99,7 @@ require (
 	github.com/spf13/cast v1.5.0 // indirect
 	github.com/spf13/cobra v1.6.1 // indirect
 	github.com/stretchr/testify v1.8.1 // indirect
+	github.com/vpascoalr/atomix/runtime v0.0.0-20230912233300-3ba5593ae2b6 // indirect
 	github.com/xeipuuv/gojsonpointer v0.0.0-20180127040702-4e3ac2762d5f // indirect
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
 	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
@@ -149,3 +149,15 @@ require (
 	google.golang.org/protobuf v1.28.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/atomix/atomix/api => ../../../api
+
+replace github.com/atomix/atomix/controller => ../../../controller
+
+replace github.com/atomix/atomix/stores/shared-memory => ../../../stores/shared-memory
+
+replace github.com/atomix/atomix/testing => ../../../testing
+
+replace github.com/vpascoalr/atomix/protocols/rsm => ../../../protocols/rsm
+
+replace github.com/vpascoalr/atomix/runtime => ../../../runtime
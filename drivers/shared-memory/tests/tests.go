@@ -21,14 +21,82 @@ type PodMemoryTestSuite struct {
 	*sharedmemoryv1beta2.SharedmemoryV1beta2Client
 }
 
+func (s *PodMemoryTestSuite) TestBarrier() {
+	s.RunSuite(new(tests.BarrierTestSuite))
+}
+
 func (s *PodMemoryTestSuite) TestCounter() {
 	s.RunSuite(new(tests.CounterTestSuite))
 }
 
+func (s *PodMemoryTestSuite) TestIDGenerator() {
+	s.RunSuite(new(tests.IDGeneratorTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestLatch() {
+	s.RunSuite(new(tests.LatchTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestLeaderElection() {
+	s.RunSuite(new(tests.LeaderElectionTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestList() {
+	s.RunSuite(new(tests.ListTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestLock() {
+	s.RunSuite(new(tests.LockTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestMembership() {
+	s.RunSuite(new(tests.MembershipTestSuite))
+}
+
 func (s *PodMemoryTestSuite) TestMap() {
 	s.RunSuite(new(tests.MapTestSuite))
 }
 
+func (s *PodMemoryTestSuite) TestRWLock() {
+	s.RunSuite(new(tests.RWLockTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestShardAssignment() {
+	s.RunSuite(new(tests.ShardAssignmentTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestRateLimiter() {
+	s.RunSuite(new(tests.RateLimiterTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestScheduler() {
+	s.RunSuite(new(tests.SchedulerTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestCardinality() {
+	s.RunSuite(new(tests.CardinalityTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestBloomFilter() {
+	s.RunSuite(new(tests.BloomFilterTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestSemaphore() {
+	s.RunSuite(new(tests.SemaphoreTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestTopic() {
+	s.RunSuite(new(tests.TopicTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestTree() {
+	s.RunSuite(new(tests.TreeTestSuite))
+}
+
+func (s *PodMemoryTestSuite) TestWorkQueue() {
+	s.RunSuite(new(tests.WorkQueueTestSuite))
+}
+
 func (s *PodMemoryTestSuite) SetupSuite() {
 	atomixV3beta4Client, err := atomixv3beta4.NewForConfig(s.Config())
 	s.NoError(err)
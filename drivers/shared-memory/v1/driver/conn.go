@@ -6,28 +6,72 @@ package driver
 
 import (
 	"context"
+	bloomfilterv1 "github.com/atomix/atomix/api/runtime/bloomfilter/v1"
+	cardinalityv1 "github.com/atomix/atomix/api/runtime/cardinality/v1"
+	counterv1 "github.com/atomix/atomix/api/runtime/counter/v1"
+	countermapv1 "github.com/atomix/atomix/api/runtime/countermap/v1"
+	idgeneratorv1 "github.com/atomix/atomix/api/runtime/idgenerator/v1"
+	latchv1 "github.com/atomix/atomix/api/runtime/latch/v1"
+	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
+	ratelimiterv1 "github.com/atomix/atomix/api/runtime/ratelimiter/v1"
+	rwlockv1 "github.com/atomix/atomix/api/runtime/rwlock/v1"
+	schedulerv1 "github.com/atomix/atomix/api/runtime/scheduler/v1"
+	semaphorev1 "github.com/atomix/atomix/api/runtime/semaphore/v1"
+	shardassignmentv1 "github.com/atomix/atomix/api/runtime/shardassignment/v1"
+	topicv1 "github.com/atomix/atomix/api/runtime/topic/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
-	rsmv1 "github.com/atomix/atomix/protocols/rsm/api/v1"
-	"github.com/atomix/atomix/protocols/rsm/pkg/client"
-	counterclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/counter/v1"
-	countermapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/countermap/v1"
-	electionclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/election/v1"
-	indexedmapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/indexedmap/v1"
-	lockclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/lock/v1"
-	mapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/map/v1"
-	multimapclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/multimap/v1"
-	setclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/set/v1"
-	valueclientv1 "github.com/atomix/atomix/protocols/rsm/pkg/client/value/v1"
-	"github.com/atomix/atomix/runtime/pkg/network"
-	runtimecounterv1 "github.com/atomix/atomix/runtime/pkg/runtime/counter/v1"
-	runtimecountermapv1 "github.com/atomix/atomix/runtime/pkg/runtime/countermap/v1"
-	runtimeelectionv1 "github.com/atomix/atomix/runtime/pkg/runtime/election/v1"
-	runtimeindexedmapv1 "github.com/atomix/atomix/runtime/pkg/runtime/indexedmap/v1"
-	runtimelockv1 "github.com/atomix/atomix/runtime/pkg/runtime/lock/v1"
-	runtimemapv1 "github.com/atomix/atomix/runtime/pkg/runtime/map/v1"
-	runtimemultimapv1 "github.com/atomix/atomix/runtime/pkg/runtime/multimap/v1"
-	runtimesetv1 "github.com/atomix/atomix/runtime/pkg/runtime/set/v1"
-	runtimevaluev1 "github.com/atomix/atomix/runtime/pkg/runtime/value/v1"
+	workqueuev1 "github.com/atomix/atomix/api/runtime/workqueue/v1"
+	rsmv1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	barrierclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/barrier/v1"
+	bloomfilterclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/bloomfilter/v1"
+	cardinalityclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/cardinality/v1"
+	counterclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/counter/v1"
+	countermapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/countermap/v1"
+	electionclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/election/v1"
+	idgeneratorclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/idgenerator/v1"
+	indexedmapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/indexedmap/v1"
+	latchclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/latch/v1"
+	listclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/list/v1"
+	lockclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/lock/v1"
+	mapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/map/v1"
+	membershipclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/membership/v1"
+	multimapclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/multimap/v1"
+	ratelimiterclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/ratelimiter/v1"
+	rwlockclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/rwlock/v1"
+	schedulerclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/scheduler/v1"
+	semaphoreclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/semaphore/v1"
+	setclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/set/v1"
+	shardassignmentclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/shardassignment/v1"
+	topicclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/topic/v1"
+	treeclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/tree/v1"
+	valueclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/value/v1"
+	workqueueclientv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/client/workqueue/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/network"
+	runtimebarrierv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/barrier/v1"
+	runtimebloomfilterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/bloomfilter/v1"
+	runtimecardinalityv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/cardinality/v1"
+	runtimecounterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/counter/v1"
+	runtimecountermapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/countermap/v1"
+	runtimeelectionv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/election/v1"
+	runtimeidgeneratorv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/idgenerator/v1"
+	runtimeindexedmapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/indexedmap/v1"
+	runtimelatchv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/latch/v1"
+	runtimelistv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/list/v1"
+	runtimelockv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/lock/v1"
+	runtimemapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/map/v1"
+	runtimemembershipv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/membership/v1"
+	runtimemultimapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/multimap/v1"
+	runtimeratelimiterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/ratelimiter/v1"
+	runtimerwlockv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/rwlock/v1"
+	runtimeschedulerv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/scheduler/v1"
+	runtimesemaphorev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/semaphore/v1"
+	runtimesetv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/set/v1"
+	runtimeshardassignmentv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/shardassignment/v1"
+	runtimetopicv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/topic/v1"
+	runtimetreev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/tree/v1"
+	runtimevaluev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/value/v1"
+	runtimeworkqueuev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/workqueue/v1"
 )
 
 func newConn(network network.Driver) *sharedMemoryConn {
@@ -48,16 +92,24 @@ func (c *sharedMemoryConn) Configure(ctx context.Context, config *rsmv1.Protocol
 	return c.ProtocolClient.Configure(ctx, *config)
 }
 
-func (c *sharedMemoryConn) NewCounterV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimecounterv1.CounterProxy, error) {
-	proxy := counterclientv1.NewCounter(c.Protocol, id)
+func (c *sharedMemoryConn) NewBarrierV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimebarrierv1.BarrierProxy, error) {
+	proxy := barrierclientv1.NewBarrier(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
 		return nil, err
 	}
 	return proxy, nil
 }
 
-func (c *sharedMemoryConn) NewCounterMapV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimecountermapv1.CounterMapProxy, error) {
-	proxy := countermapclientv1.NewCounterMap(c.Protocol, id)
+func (c *sharedMemoryConn) NewCounterV1(ctx context.Context, id runtimev1.PrimitiveID, config *counterv1.Config) (runtimecounterv1.CounterProxy, error) {
+	proxy := counterclientv1.NewCounter(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewCounterMapV1(ctx context.Context, id runtimev1.PrimitiveID, config *countermapv1.Config) (runtimecountermapv1.CounterMapProxy, error) {
+	proxy := countermapclientv1.NewCounterMap(c.Protocol, id, config)
 	if err := proxy.Open(ctx); err != nil {
 		return nil, err
 	}
@@ -72,6 +124,14 @@ func (c *sharedMemoryConn) NewLeaderElectionV1(ctx context.Context, id runtimev1
 	return proxy, nil
 }
 
+func (c *sharedMemoryConn) NewIDGeneratorV1(ctx context.Context, id runtimev1.PrimitiveID, config *idgeneratorv1.Config) (runtimeidgeneratorv1.IDGeneratorProxy, error) {
+	proxy := idgeneratorclientv1.NewIDGenerator(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *sharedMemoryConn) NewIndexedMapV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimeindexedmapv1.IndexedMapProxy, error) {
 	proxy := indexedmapclientv1.NewIndexedMap(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -80,6 +140,22 @@ func (c *sharedMemoryConn) NewIndexedMapV1(ctx context.Context, id runtimev1.Pri
 	return proxy, nil
 }
 
+func (c *sharedMemoryConn) NewLatchV1(ctx context.Context, id runtimev1.PrimitiveID, config *latchv1.Config) (runtimelatchv1.LatchProxy, error) {
+	proxy := latchclientv1.NewLatch(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewListV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimelistv1.ListProxy, error) {
+	proxy := listclientv1.NewList(c.Protocol, id)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *sharedMemoryConn) NewLockV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimelockv1.LockProxy, error) {
 	proxy := lockclientv1.NewLock(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -88,8 +164,16 @@ func (c *sharedMemoryConn) NewLockV1(ctx context.Context, id runtimev1.Primitive
 	return proxy, nil
 }
 
-func (c *sharedMemoryConn) NewMapV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimemapv1.MapProxy, error) {
-	proxy := mapclientv1.NewMap(c.Protocol, id)
+func (c *sharedMemoryConn) NewMembershipV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimemembershipv1.MembershipProxy, error) {
+	proxy := membershipclientv1.NewMembership(c.Protocol, id)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewMapV1(ctx context.Context, id runtimev1.PrimitiveID, config *mapv1.Config) (runtimemapv1.MapProxy, error) {
+	proxy := mapclientv1.NewMap(c.Protocol, id, config)
 	if err := proxy.Open(ctx); err != nil {
 		return nil, err
 	}
@@ -104,6 +188,62 @@ func (c *sharedMemoryConn) NewMultiMapV1(ctx context.Context, id runtimev1.Primi
 	return proxy, nil
 }
 
+func (c *sharedMemoryConn) NewRWLockV1(ctx context.Context, id runtimev1.PrimitiveID, config *rwlockv1.Config) (runtimerwlockv1.RWLockProxy, error) {
+	proxy := rwlockclientv1.NewRWLock(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewShardAssignmentV1(ctx context.Context, id runtimev1.PrimitiveID, config *shardassignmentv1.Config) (runtimeshardassignmentv1.ShardAssignmentProxy, error) {
+	proxy := shardassignmentclientv1.NewShardAssignment(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewRateLimiterV1(ctx context.Context, id runtimev1.PrimitiveID, config *ratelimiterv1.Config) (runtimeratelimiterv1.RateLimiterProxy, error) {
+	proxy := ratelimiterclientv1.NewRateLimiter(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewSchedulerV1(ctx context.Context, id runtimev1.PrimitiveID, config *schedulerv1.Config) (runtimeschedulerv1.SchedulerProxy, error) {
+	proxy := schedulerclientv1.NewScheduler(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewCardinalityV1(ctx context.Context, id runtimev1.PrimitiveID, config *cardinalityv1.Config) (runtimecardinalityv1.CardinalityProxy, error) {
+	proxy := cardinalityclientv1.NewCardinality(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewBloomFilterV1(ctx context.Context, id runtimev1.PrimitiveID, config *bloomfilterv1.Config) (runtimebloomfilterv1.BloomFilterProxy, error) {
+	proxy := bloomfilterclientv1.NewBloomFilter(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewSemaphoreV1(ctx context.Context, id runtimev1.PrimitiveID, config *semaphorev1.Config) (runtimesemaphorev1.SemaphoreProxy, error) {
+	proxy := semaphoreclientv1.NewSemaphore(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *sharedMemoryConn) NewSetV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimesetv1.SetProxy, error) {
 	proxy := setclientv1.NewSet(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -112,6 +252,22 @@ func (c *sharedMemoryConn) NewSetV1(ctx context.Context, id runtimev1.PrimitiveI
 	return proxy, nil
 }
 
+func (c *sharedMemoryConn) NewTopicV1(ctx context.Context, id runtimev1.PrimitiveID, config *topicv1.Config) (runtimetopicv1.TopicProxy, error) {
+	proxy := topicclientv1.NewTopic(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+func (c *sharedMemoryConn) NewTreeV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimetreev1.TreeProxy, error) {
+	proxy := treeclientv1.NewTree(c.Protocol, id)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
 func (c *sharedMemoryConn) NewValueV1(ctx context.Context, id runtimev1.PrimitiveID) (runtimevaluev1.ValueProxy, error) {
 	proxy := valueclientv1.NewValue(c.Protocol, id)
 	if err := proxy.Open(ctx); err != nil {
@@ -120,12 +276,35 @@ func (c *sharedMemoryConn) NewValueV1(ctx context.Context, id runtimev1.Primitiv
 	return proxy, nil
 }
 
+func (c *sharedMemoryConn) NewWorkQueueV1(ctx context.Context, id runtimev1.PrimitiveID, config *workqueuev1.Config) (runtimeworkqueuev1.WorkQueueProxy, error) {
+	proxy := workqueueclientv1.NewWorkQueue(c.Protocol, id, config)
+	if err := proxy.Open(ctx); err != nil {
+		return nil, err
+	}
+	return proxy, nil
+}
+
+var _ runtimebarrierv1.BarrierProvider = (*sharedMemoryConn)(nil)
 var _ runtimecounterv1.CounterProvider = (*sharedMemoryConn)(nil)
 var _ runtimecountermapv1.CounterMapProvider = (*sharedMemoryConn)(nil)
 var _ runtimeelectionv1.LeaderElectionProvider = (*sharedMemoryConn)(nil)
+var _ runtimeidgeneratorv1.IDGeneratorProvider = (*sharedMemoryConn)(nil)
 var _ runtimeindexedmapv1.IndexedMapProvider = (*sharedMemoryConn)(nil)
+var _ runtimelatchv1.LatchProvider = (*sharedMemoryConn)(nil)
+var _ runtimelistv1.ListProvider = (*sharedMemoryConn)(nil)
 var _ runtimelockv1.LockProvider = (*sharedMemoryConn)(nil)
+var _ runtimemembershipv1.MembershipProvider = (*sharedMemoryConn)(nil)
 var _ runtimemapv1.MapProvider = (*sharedMemoryConn)(nil)
 var _ runtimemultimapv1.MultiMapProvider = (*sharedMemoryConn)(nil)
+var _ runtimerwlockv1.RWLockProvider = (*sharedMemoryConn)(nil)
+var _ runtimeshardassignmentv1.ShardAssignmentProvider = (*sharedMemoryConn)(nil)
+var _ runtimeratelimiterv1.RateLimiterProvider = (*sharedMemoryConn)(nil)
+var _ runtimeschedulerv1.SchedulerProvider = (*sharedMemoryConn)(nil)
+var _ runtimecardinalityv1.CardinalityProvider = (*sharedMemoryConn)(nil)
+var _ runtimebloomfilterv1.BloomFilterProvider = (*sharedMemoryConn)(nil)
+var _ runtimesemaphorev1.SemaphoreProvider = (*sharedMemoryConn)(nil)
 var _ runtimesetv1.SetProvider = (*sharedMemoryConn)(nil)
+var _ runtimetopicv1.TopicProvider = (*sharedMemoryConn)(nil)
+var _ runtimetreev1.TreeProvider = (*sharedMemoryConn)(nil)
 var _ runtimevaluev1.ValueProvider = (*sharedMemoryConn)(nil)
+var _ runtimeworkqueuev1.WorkQueueProvider = (*sharedMemoryConn)(nil)
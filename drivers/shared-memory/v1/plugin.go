@@ -6,7 +6,7 @@ package main
 
 import (
 	"github.com/atomix/atomix/drivers/shared-memory/v1/driver"
-	"github.com/atomix/atomix/runtime/pkg/network"
+	"github.com/vpascoalr/atomix/runtime/pkg/network"
 )
 
 var Plugin = driver.New(network.NewDefaultDriver())
@@ -0,0 +1,3495 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bloomfilter/v1/bloomfilter.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ConfigureRequest struct {
+	Headers         *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ConfigureInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+}
+
+func (m *ConfigureRequest) Reset()         { *m = ConfigureRequest{} }
+func (m *ConfigureRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfigureRequest) ProtoMessage()    {}
+func (*ConfigureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{0}
+}
+func (m *ConfigureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureRequest.Merge(m, src)
+}
+func (m *ConfigureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureRequest proto.InternalMessageInfo
+
+func (m *ConfigureRequest) GetHeaders() *v1.ProposalRequestHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type ConfigureResponse struct {
+	Headers          *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ConfigureOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+}
+
+func (m *ConfigureResponse) Reset()         { *m = ConfigureResponse{} }
+func (m *ConfigureResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfigureResponse) ProtoMessage()    {}
+func (*ConfigureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{1}
+}
+func (m *ConfigureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureResponse.Merge(m, src)
+}
+func (m *ConfigureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureResponse proto.InternalMessageInfo
+
+func (m *ConfigureResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type AddRequest struct {
+	Headers   *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*AddInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+}
+
+func (m *AddRequest) Reset()         { *m = AddRequest{} }
+func (m *AddRequest) String() string { return proto.CompactTextString(m) }
+func (*AddRequest) ProtoMessage()    {}
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{2}
+}
+func (m *AddRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRequest.Merge(m, src)
+}
+func (m *AddRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRequest proto.InternalMessageInfo
+
+func (m *AddRequest) GetHeaders() *v1.ProposalRequestHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type AddResponse struct {
+	Headers    *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*AddOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+}
+
+func (m *AddResponse) Reset()         { *m = AddResponse{} }
+func (m *AddResponse) String() string { return proto.CompactTextString(m) }
+func (*AddResponse) ProtoMessage()    {}
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{3}
+}
+func (m *AddResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddResponse.Merge(m, src)
+}
+func (m *AddResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddResponse proto.InternalMessageInfo
+
+func (m *AddResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type MightContainRequest struct {
+	Headers            *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*MightContainInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+}
+
+func (m *MightContainRequest) Reset()         { *m = MightContainRequest{} }
+func (m *MightContainRequest) String() string { return proto.CompactTextString(m) }
+func (*MightContainRequest) ProtoMessage()    {}
+func (*MightContainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{4}
+}
+func (m *MightContainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MightContainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MightContainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MightContainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MightContainRequest.Merge(m, src)
+}
+func (m *MightContainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MightContainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MightContainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MightContainRequest proto.InternalMessageInfo
+
+func (m *MightContainRequest) GetHeaders() *v1.QueryRequestHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type MightContainResponse struct {
+	Headers             *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*MightContainOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+}
+
+func (m *MightContainResponse) Reset()         { *m = MightContainResponse{} }
+func (m *MightContainResponse) String() string { return proto.CompactTextString(m) }
+func (*MightContainResponse) ProtoMessage()    {}
+func (*MightContainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{5}
+}
+func (m *MightContainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MightContainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MightContainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MightContainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MightContainResponse.Merge(m, src)
+}
+func (m *MightContainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MightContainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MightContainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MightContainResponse proto.InternalMessageInfo
+
+func (m *MightContainResponse) GetHeaders() *v1.QueryResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type BloomFilterInput struct {
+	// Types that are valid to be assigned to Input:
+	//	*BloomFilterInput_Configure
+	//	*BloomFilterInput_Add
+	//	*BloomFilterInput_MightContain
+	Input isBloomFilterInput_Input `protobuf_oneof:"input"`
+}
+
+func (m *BloomFilterInput) Reset()         { *m = BloomFilterInput{} }
+func (m *BloomFilterInput) String() string { return proto.CompactTextString(m) }
+func (*BloomFilterInput) ProtoMessage()    {}
+func (*BloomFilterInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{6}
+}
+func (m *BloomFilterInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BloomFilterInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BloomFilterInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BloomFilterInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BloomFilterInput.Merge(m, src)
+}
+func (m *BloomFilterInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *BloomFilterInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BloomFilterInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BloomFilterInput proto.InternalMessageInfo
+
+type isBloomFilterInput_Input interface {
+	isBloomFilterInput_Input()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BloomFilterInput_Configure struct {
+	Configure *ConfigureInput `protobuf:"bytes,1,opt,name=configure,proto3,oneof" json:"configure,omitempty"`
+}
+type BloomFilterInput_Add struct {
+	Add *AddInput `protobuf:"bytes,2,opt,name=add,proto3,oneof" json:"add,omitempty"`
+}
+type BloomFilterInput_MightContain struct {
+	MightContain *MightContainInput `protobuf:"bytes,3,opt,name=might_contain,json=mightContain,proto3,oneof" json:"might_contain,omitempty"`
+}
+
+func (*BloomFilterInput_Configure) isBloomFilterInput_Input()    {}
+func (*BloomFilterInput_Add) isBloomFilterInput_Input()          {}
+func (*BloomFilterInput_MightContain) isBloomFilterInput_Input() {}
+
+func (m *BloomFilterInput) GetInput() isBloomFilterInput_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *BloomFilterInput) GetConfigure() *ConfigureInput {
+	if x, ok := m.GetInput().(*BloomFilterInput_Configure); ok {
+		return x.Configure
+	}
+	return nil
+}
+
+func (m *BloomFilterInput) GetAdd() *AddInput {
+	if x, ok := m.GetInput().(*BloomFilterInput_Add); ok {
+		return x.Add
+	}
+	return nil
+}
+
+func (m *BloomFilterInput) GetMightContain() *MightContainInput {
+	if x, ok := m.GetInput().(*BloomFilterInput_MightContain); ok {
+		return x.MightContain
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BloomFilterInput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BloomFilterInput_Configure)(nil),
+		(*BloomFilterInput_Add)(nil),
+		(*BloomFilterInput_MightContain)(nil),
+	}
+}
+
+type BloomFilterOutput struct {
+	// Types that are valid to be assigned to Output:
+	//	*BloomFilterOutput_Configure
+	//	*BloomFilterOutput_Add
+	//	*BloomFilterOutput_MightContain
+	Output isBloomFilterOutput_Output `protobuf_oneof:"output"`
+}
+
+func (m *BloomFilterOutput) Reset()         { *m = BloomFilterOutput{} }
+func (m *BloomFilterOutput) String() string { return proto.CompactTextString(m) }
+func (*BloomFilterOutput) ProtoMessage()    {}
+func (*BloomFilterOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{7}
+}
+func (m *BloomFilterOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BloomFilterOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BloomFilterOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BloomFilterOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BloomFilterOutput.Merge(m, src)
+}
+func (m *BloomFilterOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *BloomFilterOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BloomFilterOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BloomFilterOutput proto.InternalMessageInfo
+
+type isBloomFilterOutput_Output interface {
+	isBloomFilterOutput_Output()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BloomFilterOutput_Configure struct {
+	Configure *ConfigureOutput `protobuf:"bytes,1,opt,name=configure,proto3,oneof" json:"configure,omitempty"`
+}
+type BloomFilterOutput_Add struct {
+	Add *AddOutput `protobuf:"bytes,2,opt,name=add,proto3,oneof" json:"add,omitempty"`
+}
+type BloomFilterOutput_MightContain struct {
+	MightContain *MightContainOutput `protobuf:"bytes,3,opt,name=might_contain,json=mightContain,proto3,oneof" json:"might_contain,omitempty"`
+}
+
+func (*BloomFilterOutput_Configure) isBloomFilterOutput_Output()    {}
+func (*BloomFilterOutput_Add) isBloomFilterOutput_Output()          {}
+func (*BloomFilterOutput_MightContain) isBloomFilterOutput_Output() {}
+
+func (m *BloomFilterOutput) GetOutput() isBloomFilterOutput_Output {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *BloomFilterOutput) GetConfigure() *ConfigureOutput {
+	if x, ok := m.GetOutput().(*BloomFilterOutput_Configure); ok {
+		return x.Configure
+	}
+	return nil
+}
+
+func (m *BloomFilterOutput) GetAdd() *AddOutput {
+	if x, ok := m.GetOutput().(*BloomFilterOutput_Add); ok {
+		return x.Add
+	}
+	return nil
+}
+
+func (m *BloomFilterOutput) GetMightContain() *MightContainOutput {
+	if x, ok := m.GetOutput().(*BloomFilterOutput_MightContain); ok {
+		return x.MightContain
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BloomFilterOutput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BloomFilterOutput_Configure)(nil),
+		(*BloomFilterOutput_Add)(nil),
+		(*BloomFilterOutput_MightContain)(nil),
+	}
+}
+
+type ConfigureInput struct {
+	// bits is the number of bits in the filter
+	Bits uint64 `protobuf:"varint,1,opt,name=bits,proto3" json:"bits,omitempty"`
+	// hashes is the number of bits set for each element
+	Hashes uint32 `protobuf:"varint,2,opt,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (m *ConfigureInput) Reset()         { *m = ConfigureInput{} }
+func (m *ConfigureInput) String() string { return proto.CompactTextString(m) }
+func (*ConfigureInput) ProtoMessage()    {}
+func (*ConfigureInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{8}
+}
+func (m *ConfigureInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigureInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigureInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigureInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureInput.Merge(m, src)
+}
+func (m *ConfigureInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigureInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureInput proto.InternalMessageInfo
+
+func (m *ConfigureInput) GetBits() uint64 {
+	if m != nil {
+		return m.Bits
+	}
+	return 0
+}
+
+func (m *ConfigureInput) GetHashes() uint32 {
+	if m != nil {
+		return m.Hashes
+	}
+	return 0
+}
+
+type ConfigureOutput struct {
+}
+
+func (m *ConfigureOutput) Reset()         { *m = ConfigureOutput{} }
+func (m *ConfigureOutput) String() string { return proto.CompactTextString(m) }
+func (*ConfigureOutput) ProtoMessage()    {}
+func (*ConfigureOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{9}
+}
+func (m *ConfigureOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigureOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigureOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigureOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureOutput.Merge(m, src)
+}
+func (m *ConfigureOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigureOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureOutput proto.InternalMessageInfo
+
+type AddInput struct {
+	Element string `protobuf:"bytes,1,opt,name=element,proto3" json:"element,omitempty"`
+}
+
+func (m *AddInput) Reset()         { *m = AddInput{} }
+func (m *AddInput) String() string { return proto.CompactTextString(m) }
+func (*AddInput) ProtoMessage()    {}
+func (*AddInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{10}
+}
+func (m *AddInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInput.Merge(m, src)
+}
+func (m *AddInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddInput proto.InternalMessageInfo
+
+func (m *AddInput) GetElement() string {
+	if m != nil {
+		return m.Element
+	}
+	return ""
+}
+
+type AddOutput struct {
+	Added bool `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+}
+
+func (m *AddOutput) Reset()         { *m = AddOutput{} }
+func (m *AddOutput) String() string { return proto.CompactTextString(m) }
+func (*AddOutput) ProtoMessage()    {}
+func (*AddOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{11}
+}
+func (m *AddOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOutput.Merge(m, src)
+}
+func (m *AddOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddOutput proto.InternalMessageInfo
+
+func (m *AddOutput) GetAdded() bool {
+	if m != nil {
+		return m.Added
+	}
+	return false
+}
+
+type MightContainInput struct {
+	Element string `protobuf:"bytes,1,opt,name=element,proto3" json:"element,omitempty"`
+}
+
+func (m *MightContainInput) Reset()         { *m = MightContainInput{} }
+func (m *MightContainInput) String() string { return proto.CompactTextString(m) }
+func (*MightContainInput) ProtoMessage()    {}
+func (*MightContainInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{12}
+}
+func (m *MightContainInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MightContainInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MightContainInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MightContainInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MightContainInput.Merge(m, src)
+}
+func (m *MightContainInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *MightContainInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_MightContainInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MightContainInput proto.InternalMessageInfo
+
+func (m *MightContainInput) GetElement() string {
+	if m != nil {
+		return m.Element
+	}
+	return ""
+}
+
+type MightContainOutput struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *MightContainOutput) Reset()         { *m = MightContainOutput{} }
+func (m *MightContainOutput) String() string { return proto.CompactTextString(m) }
+func (*MightContainOutput) ProtoMessage()    {}
+func (*MightContainOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc44e053b098242, []int{13}
+}
+func (m *MightContainOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MightContainOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MightContainOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MightContainOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MightContainOutput.Merge(m, src)
+}
+func (m *MightContainOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *MightContainOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_MightContainOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MightContainOutput proto.InternalMessageInfo
+
+func (m *MightContainOutput) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ConfigureRequest)(nil), "atomix.protocols.rsm.bloomfilter.v1.ConfigureRequest")
+	proto.RegisterType((*ConfigureResponse)(nil), "atomix.protocols.rsm.bloomfilter.v1.ConfigureResponse")
+	proto.RegisterType((*AddRequest)(nil), "atomix.protocols.rsm.bloomfilter.v1.AddRequest")
+	proto.RegisterType((*AddResponse)(nil), "atomix.protocols.rsm.bloomfilter.v1.AddResponse")
+	proto.RegisterType((*MightContainRequest)(nil), "atomix.protocols.rsm.bloomfilter.v1.MightContainRequest")
+	proto.RegisterType((*MightContainResponse)(nil), "atomix.protocols.rsm.bloomfilter.v1.MightContainResponse")
+	proto.RegisterType((*BloomFilterInput)(nil), "atomix.protocols.rsm.bloomfilter.v1.BloomFilterInput")
+	proto.RegisterType((*BloomFilterOutput)(nil), "atomix.protocols.rsm.bloomfilter.v1.BloomFilterOutput")
+	proto.RegisterType((*ConfigureInput)(nil), "atomix.protocols.rsm.bloomfilter.v1.ConfigureInput")
+	proto.RegisterType((*ConfigureOutput)(nil), "atomix.protocols.rsm.bloomfilter.v1.ConfigureOutput")
+	proto.RegisterType((*AddInput)(nil), "atomix.protocols.rsm.bloomfilter.v1.AddInput")
+	proto.RegisterType((*AddOutput)(nil), "atomix.protocols.rsm.bloomfilter.v1.AddOutput")
+	proto.RegisterType((*MightContainInput)(nil), "atomix.protocols.rsm.bloomfilter.v1.MightContainInput")
+	proto.RegisterType((*MightContainOutput)(nil), "atomix.protocols.rsm.bloomfilter.v1.MightContainOutput")
+}
+
+func init() { proto.RegisterFile("bloomfilter/v1/bloomfilter.proto", fileDescriptor_8cc44e053b098242) }
+
+var fileDescriptor_8cc44e053b098242 = []byte{
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x7d, 0xe9, 0x6b, 0x9e, 0xb6, 0xd0, 0x1c, 0x15, 0xb2, 0x3a, 0x98, 0x62, 0x18, 0x18,
+	0x5a, 0xbb, 0x69, 0xa1, 0x80, 0xc4, 0x92, 0x54, 0x2a, 0x29, 0x02, 0x15, 0x0e, 0x18, 0x01, 0xb9,
+	0xbd, 0x6b, 0x62, 0xc9, 0xf6, 0x05, 0xfb, 0x1c, 0x01, 0x2b, 0x5f, 0x00, 0xb1, 0xb0, 0xb3, 0xb0,
+	0x21, 0x21, 0x24, 0x3e, 0x03, 0x63, 0x47, 0x26, 0x84, 0xda, 0x4f, 0xc1, 0x86, 0xfc, 0xd6, 0x38,
+	0x8e, 0x03, 0x76, 0x50, 0x37, 0x9f, 0xe5, 0xff, 0x73, 0xbf, 0xe7, 0x77, 0x8f, 0x0f, 0x56, 0xf6,
+	0x2d, 0xce, 0xed, 0x43, 0xd3, 0x12, 0xcc, 0xd5, 0x7b, 0x75, 0x3d, 0xb5, 0xd4, 0xba, 0x2e, 0x17,
+	0x1c, 0x5f, 0x31, 0x04, 0xb7, 0xcd, 0x57, 0xd1, 0xea, 0x80, 0x5b, 0x9e, 0xe6, 0x7a, 0xb6, 0x96,
+	0xfe, 0xae, 0x57, 0x5f, 0x5e, 0xec, 0xd5, 0xf5, 0x0e, 0x33, 0x28, 0x73, 0xbd, 0xe8, 0xc3, 0xe5,
+	0xa5, 0x36, 0x6f, 0xf3, 0xf0, 0x51, 0x0f, 0x9e, 0xa2, 0xb7, 0xea, 0x67, 0x04, 0x8b, 0xdb, 0xdc,
+	0x39, 0x34, 0xdb, 0xbe, 0xcb, 0x08, 0x7b, 0xe9, 0x33, 0x4f, 0xe0, 0x5d, 0x98, 0x89, 0xb3, 0x32,
+	0x5a, 0x41, 0xd7, 0xe6, 0x36, 0x74, 0x2d, 0x77, 0xcf, 0x5e, 0x5d, 0x7b, 0xe8, 0xf2, 0x2e, 0xf7,
+	0x0c, 0x2b, 0x8e, 0xb6, 0xa2, 0x18, 0x49, 0xf2, 0x78, 0x0f, 0xa6, 0x4c, 0xa7, 0xeb, 0x0b, 0xb9,
+	0x12, 0x16, 0xda, 0xd4, 0x0a, 0xc0, 0x6b, 0xa7, 0x40, 0xbb, 0x41, 0xb4, 0x39, 0x79, 0xf4, 0xf3,
+	0x12, 0x22, 0x51, 0x1d, 0xf5, 0x2b, 0x82, 0x5a, 0x0a, 0xd8, 0xeb, 0x72, 0xc7, 0x63, 0xf8, 0x5e,
+	0x96, 0x78, 0xbd, 0x00, 0x71, 0x94, 0x1d, 0x42, 0x26, 0x30, 0xcd, 0x7d, 0xd1, 0x67, 0xbe, 0x5e,
+	0x8e, 0x79, 0xcf, 0x17, 0x7d, 0xe8, 0xb8, 0x92, 0xfa, 0x11, 0x01, 0x34, 0x28, 0x3d, 0x03, 0xc1,
+	0xbb, 0x83, 0x82, 0xd7, 0x0a, 0xc1, 0x36, 0x28, 0xcd, 0x51, 0xfb, 0x09, 0xc1, 0x5c, 0x08, 0x79,
+	0x06, 0x52, 0xef, 0x67, 0xa4, 0x6a, 0x45, 0x39, 0x73, 0x75, 0x7e, 0x41, 0x70, 0xe1, 0x81, 0xd9,
+	0xee, 0x88, 0x6d, 0xee, 0x08, 0xc3, 0x74, 0x12, 0xaf, 0x3b, 0x59, 0xe2, 0xd5, 0x91, 0xc4, 0x8f,
+	0x7c, 0xe6, 0xbe, 0x1e, 0x25, 0x95, 0x0c, 0x4a, 0xdd, 0x2a, 0x04, 0x9b, 0x06, 0xca, 0xb1, 0xfb,
+	0x0d, 0xc1, 0xd2, 0x20, 0x73, 0xac, 0xf9, 0x6e, 0x16, 0x7a, 0xed, 0x5f, 0xd0, 0x23, 0x1c, 0x3f,
+	0xcd, 0x38, 0xbe, 0x59, 0x1a, 0x3b, 0x57, 0xf6, 0xfb, 0x0a, 0x2c, 0x36, 0x83, 0xcc, 0x4e, 0x98,
+	0x09, 0x5b, 0xc3, 0x8f, 0xa1, 0x7a, 0x90, 0x4c, 0xbc, 0x8c, 0xc6, 0xfe, 0xb7, 0x5b, 0x12, 0xe9,
+	0xd7, 0xc1, 0x0d, 0x98, 0x30, 0x28, 0x1d, 0x6b, 0x92, 0x5b, 0x12, 0x09, 0xb2, 0xf8, 0x19, 0x2c,
+	0xd8, 0x41, 0x43, 0x2f, 0x0e, 0xa2, 0x8e, 0xe4, 0x89, 0xff, 0x39, 0xc1, 0x96, 0x44, 0xe6, 0xed,
+	0xd4, 0xcb, 0xe6, 0x4c, 0x3c, 0x18, 0xea, 0x87, 0x0a, 0xd4, 0x52, 0x52, 0x22, 0x71, 0xf8, 0xc9,
+	0xb0, 0x95, 0xb1, 0x6e, 0x8f, 0x41, 0x2d, 0xcd, 0xb4, 0x96, 0x92, 0x3f, 0x4e, 0xe2, 0xe5, 0x79,
+	0xbe, 0x97, 0x71, 0x47, 0x64, 0x48, 0xcc, 0x6c, 0x32, 0x7b, 0xea, 0x1d, 0x38, 0x37, 0x78, 0xc6,
+	0x18, 0xc3, 0xe4, 0xbe, 0x29, 0xa2, 0xe9, 0x9e, 0x24, 0xe1, 0x33, 0xbe, 0x08, 0xd3, 0x1d, 0xc3,
+	0xeb, 0x30, 0x2f, 0x6c, 0x6b, 0x81, 0xc4, 0x2b, 0xb5, 0x06, 0xe7, 0x33, 0x2e, 0xd4, 0xab, 0x30,
+	0x9b, 0x9c, 0x32, 0x96, 0x61, 0x86, 0x59, 0xcc, 0x66, 0x8e, 0x08, 0xab, 0x55, 0x49, 0xb2, 0x54,
+	0x2f, 0x43, 0xf5, 0xb4, 0x69, 0xbc, 0x04, 0x53, 0x06, 0xa5, 0x8c, 0x86, 0x1f, 0xcd, 0x92, 0x68,
+	0xa1, 0xae, 0x41, 0x6d, 0xe8, 0x84, 0xff, 0x52, 0x71, 0x15, 0xf0, 0x70, 0xe3, 0x01, 0xb8, 0xcb,
+	0x3c, 0xdf, 0x12, 0x71, 0xed, 0x78, 0xb5, 0xf1, 0xbb, 0x02, 0x73, 0xa9, 0x81, 0xc0, 0x6f, 0xa0,
+	0x7a, 0xda, 0x08, 0xbe, 0x51, 0x6e, 0x08, 0xe2, 0x6b, 0x69, 0x79, 0xab, 0x6c, 0x2c, 0xbe, 0x51,
+	0x3a, 0x30, 0xd1, 0xa0, 0x14, 0xeb, 0x45, 0x47, 0x25, 0xd9, 0x6f, 0xbd, 0x78, 0x20, 0xde, 0xe9,
+	0x2d, 0x82, 0xf9, 0xb4, 0x24, 0x7c, 0xab, 0xf4, 0x40, 0x25, 0x9b, 0xdf, 0x1e, 0x23, 0x19, 0x51,
+	0x34, 0xe5, 0xef, 0xc7, 0x0a, 0x3a, 0x3a, 0x56, 0xd0, 0xaf, 0x63, 0x05, 0xbd, 0x3b, 0x51, 0xa4,
+	0xa3, 0x13, 0x45, 0xfa, 0x71, 0xa2, 0x48, 0xfb, 0xd3, 0x61, 0xb1, 0xcd, 0x3f, 0x03, 0x00, 0x05,
+	0x9d, 0xf6, 0x77, 0x56, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BloomFilterClient is the client API for BloomFilter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BloomFilterClient interface {
+	// Configure configures the size of the filter
+	Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error)
+	// Add adds an element to the filter
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	// MightContain checks whether an element might have been added to the filter
+	MightContain(ctx context.Context, in *MightContainRequest, opts ...grpc.CallOption) (*MightContainResponse, error)
+}
+
+type bloomFilterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBloomFilterClient(cc *grpc.ClientConn) BloomFilterClient {
+	return &bloomFilterClient{cc}
+}
+
+func (c *bloomFilterClient) Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error) {
+	out := new(ConfigureResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.bloomfilter.v1.BloomFilter/Configure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloomFilterClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.bloomfilter.v1.BloomFilter/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloomFilterClient) MightContain(ctx context.Context, in *MightContainRequest, opts ...grpc.CallOption) (*MightContainResponse, error) {
+	out := new(MightContainResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.bloomfilter.v1.BloomFilter/MightContain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BloomFilterServer is the server API for BloomFilter service.
+type BloomFilterServer interface {
+	// Configure configures the size of the filter
+	Configure(context.Context, *ConfigureRequest) (*ConfigureResponse, error)
+	// Add adds an element to the filter
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	// MightContain checks whether an element might have been added to the filter
+	MightContain(context.Context, *MightContainRequest) (*MightContainResponse, error)
+}
+
+// UnimplementedBloomFilterServer can be embedded to have forward compatible implementations.
+type UnimplementedBloomFilterServer struct {
+}
+
+func (*UnimplementedBloomFilterServer) Configure(ctx context.Context, req *ConfigureRequest) (*ConfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
+}
+func (*UnimplementedBloomFilterServer) Add(ctx context.Context, req *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedBloomFilterServer) MightContain(ctx context.Context, req *MightContainRequest) (*MightContainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MightContain not implemented")
+}
+
+func RegisterBloomFilterServer(s *grpc.Server, srv BloomFilterServer) {
+	s.RegisterService(&_BloomFilter_serviceDesc, srv)
+}
+
+func _BloomFilter_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloomFilterServer).Configure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.bloomfilter.v1.BloomFilter/Configure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloomFilterServer).Configure(ctx, req.(*ConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloomFilter_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloomFilterServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.bloomfilter.v1.BloomFilter/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloomFilterServer).Add(ctx, req.(*AddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloomFilter_MightContain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MightContainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloomFilterServer).MightContain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.bloomfilter.v1.BloomFilter/MightContain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloomFilterServer).MightContain(ctx, req.(*MightContainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BloomFilter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.protocols.rsm.bloomfilter.v1.BloomFilter",
+	HandlerType: (*BloomFilterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Configure",
+			Handler:    _BloomFilter_Configure_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _BloomFilter_Add_Handler,
+		},
+		{
+			MethodName: "MightContain",
+			Handler:    _BloomFilter_MightContain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bloomfilter/v1/bloomfilter.proto",
+}
+
+func (m *ConfigureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigureInput != nil {
+		{
+			size, err := m.ConfigureInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigureOutput != nil {
+		{
+			size, err := m.ConfigureOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddInput != nil {
+		{
+			size, err := m.AddInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddOutput != nil {
+		{
+			size, err := m.AddOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MightContainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MightContainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MightContainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MightContainInput != nil {
+		{
+			size, err := m.MightContainInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MightContainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MightContainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MightContainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MightContainOutput != nil {
+		{
+			size, err := m.MightContainOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BloomFilterInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BloomFilterInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BloomFilterInput_Configure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterInput_Configure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Configure != nil {
+		{
+			size, err := m.Configure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BloomFilterInput_Add) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterInput_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Add != nil {
+		{
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BloomFilterInput_MightContain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterInput_MightContain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MightContain != nil {
+		{
+			size, err := m.MightContain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BloomFilterOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BloomFilterOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size := m.Output.Size()
+			i -= size
+			if _, err := m.Output.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BloomFilterOutput_Configure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterOutput_Configure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Configure != nil {
+		{
+			size, err := m.Configure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BloomFilterOutput_Add) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterOutput_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Add != nil {
+		{
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BloomFilterOutput_MightContain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BloomFilterOutput_MightContain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MightContain != nil {
+		{
+			size, err := m.MightContain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBloomfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConfigureInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigureInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigureInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hashes != 0 {
+		i = encodeVarintBloomfilter(dAtA, i, uint64(m.Hashes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bits != 0 {
+		i = encodeVarintBloomfilter(dAtA, i, uint64(m.Bits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigureOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigureOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigureOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Element) > 0 {
+		i -= len(m.Element)
+		copy(dAtA[i:], m.Element)
+		i = encodeVarintBloomfilter(dAtA, i, uint64(len(m.Element)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Added {
+		i--
+		if m.Added {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MightContainInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MightContainInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MightContainInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Element) > 0 {
+		i -= len(m.Element)
+		copy(dAtA[i:], m.Element)
+		i = encodeVarintBloomfilter(dAtA, i, uint64(len(m.Element)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MightContainOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MightContainOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MightContainOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBloomfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBloomfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConfigureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	if m.ConfigureInput != nil {
+		l = m.ConfigureInput.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	if m.ConfigureOutput != nil {
+		l = m.ConfigureOutput.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *AddRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	if m.AddInput != nil {
+		l = m.AddInput.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *AddResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	if m.AddOutput != nil {
+		l = m.AddOutput.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *MightContainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	if m.MightContainInput != nil {
+		l = m.MightContainInput.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *MightContainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	if m.MightContainOutput != nil {
+		l = m.MightContainOutput.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *BloomFilterInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *BloomFilterInput_Configure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configure != nil {
+		l = m.Configure.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+func (m *BloomFilterInput_Add) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Add != nil {
+		l = m.Add.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+func (m *BloomFilterInput_MightContain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MightContain != nil {
+		l = m.MightContain.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+func (m *BloomFilterOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		n += m.Output.Size()
+	}
+	return n
+}
+
+func (m *BloomFilterOutput_Configure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configure != nil {
+		l = m.Configure.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+func (m *BloomFilterOutput_Add) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Add != nil {
+		l = m.Add.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+func (m *BloomFilterOutput_MightContain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MightContain != nil {
+		l = m.MightContain.Size()
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+func (m *ConfigureInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bits != 0 {
+		n += 1 + sovBloomfilter(uint64(m.Bits))
+	}
+	if m.Hashes != 0 {
+		n += 1 + sovBloomfilter(uint64(m.Hashes))
+	}
+	return n
+}
+
+func (m *ConfigureOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Element)
+	if l > 0 {
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *AddOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Added {
+		n += 2
+	}
+	return n
+}
+
+func (m *MightContainInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Element)
+	if l > 0 {
+		n += 1 + l + sovBloomfilter(uint64(l))
+	}
+	return n
+}
+
+func (m *MightContainOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func sovBloomfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBloomfilter(x uint64) (n int) {
+	return sovBloomfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConfigureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigureInput == nil {
+				m.ConfigureInput = &ConfigureInput{}
+			}
+			if err := m.ConfigureInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigureOutput == nil {
+				m.ConfigureOutput = &ConfigureOutput{}
+			}
+			if err := m.ConfigureOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddInput == nil {
+				m.AddInput = &AddInput{}
+			}
+			if err := m.AddInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddOutput == nil {
+				m.AddOutput = &AddOutput{}
+			}
+			if err := m.AddOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MightContainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MightContainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MightContainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MightContainInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MightContainInput == nil {
+				m.MightContainInput = &MightContainInput{}
+			}
+			if err := m.MightContainInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MightContainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MightContainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MightContainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MightContainOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MightContainOutput == nil {
+				m.MightContainOutput = &MightContainOutput{}
+			}
+			if err := m.MightContainOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BloomFilterInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BloomFilterInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BloomFilterInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConfigureInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &BloomFilterInput_Configure{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AddInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &BloomFilterInput_Add{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MightContain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MightContainInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &BloomFilterInput_MightContain{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BloomFilterOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BloomFilterOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BloomFilterOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConfigureOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &BloomFilterOutput_Configure{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AddOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &BloomFilterOutput_Add{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MightContain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MightContainOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &BloomFilterOutput_MightContain{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigureInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigureInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigureInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
+			}
+			m.Bits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			m.Hashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hashes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigureOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigureOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigureOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Element = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Added = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MightContainInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MightContainInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MightContainInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Element", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Element = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MightContainOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MightContainOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MightContainOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloomfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBloomfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBloomfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBloomfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBloomfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBloomfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBloomfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBloomfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBloomfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBloomfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBloomfilter = fmt.Errorf("proto: unexpected end of group")
+)
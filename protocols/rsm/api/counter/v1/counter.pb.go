@@ -6,9 +6,9 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -478,6 +478,96 @@ func (m *UpdateResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	return nil
 }
 
+type SetBoundsRequest struct {
+	Headers         *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*SetBoundsInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+}
+
+func (m *SetBoundsRequest) Reset()         { *m = SetBoundsRequest{} }
+func (m *SetBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsRequest) ProtoMessage()    {}
+func (*SetBoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5cdcacbb356412, []int{10}
+}
+func (m *SetBoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsRequest.Merge(m, src)
+}
+func (m *SetBoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBoundsRequest proto.InternalMessageInfo
+
+func (m *SetBoundsRequest) GetHeaders() *v1.ProposalRequestHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type SetBoundsResponse struct {
+	Headers          *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*SetBoundsOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+}
+
+func (m *SetBoundsResponse) Reset()         { *m = SetBoundsResponse{} }
+func (m *SetBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsResponse) ProtoMessage()    {}
+func (*SetBoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5cdcacbb356412, []int{11}
+}
+func (m *SetBoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsResponse.Merge(m, src)
+}
+func (m *SetBoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBoundsResponse proto.InternalMessageInfo
+
+func (m *SetBoundsResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 type CounterInput struct {
 	// Types that are valid to be assigned to Input:
 	//	*CounterInput_Increment
@@ -485,6 +575,7 @@ type CounterInput struct {
 	//	*CounterInput_Get
 	//	*CounterInput_Set
 	//	*CounterInput_Update
+	//	*CounterInput_SetBounds
 	Input isCounterInput_Input `protobuf_oneof:"input"`
 }
 
@@ -492,7 +583,7 @@ func (m *CounterInput) Reset()         { *m = CounterInput{} }
 func (m *CounterInput) String() string { return proto.CompactTextString(m) }
 func (*CounterInput) ProtoMessage()    {}
 func (*CounterInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{10}
+	return fileDescriptor_4f5cdcacbb356412, []int{12}
 }
 func (m *CounterInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,12 +633,16 @@ type CounterInput_Set struct {
 type CounterInput_Update struct {
 	Update *UpdateInput `protobuf:"bytes,5,opt,name=update,proto3,oneof" json:"update,omitempty"`
 }
+type CounterInput_SetBounds struct {
+	SetBounds *SetBoundsInput `protobuf:"bytes,6,opt,name=set_bounds,json=setBounds,proto3,oneof" json:"set_bounds,omitempty"`
+}
 
 func (*CounterInput_Increment) isCounterInput_Input() {}
 func (*CounterInput_Decrement) isCounterInput_Input() {}
 func (*CounterInput_Get) isCounterInput_Input()       {}
 func (*CounterInput_Set) isCounterInput_Input()       {}
 func (*CounterInput_Update) isCounterInput_Input()    {}
+func (*CounterInput_SetBounds) isCounterInput_Input() {}
 
 func (m *CounterInput) GetInput() isCounterInput_Input {
 	if m != nil {
@@ -591,6 +686,13 @@ func (m *CounterInput) GetUpdate() *UpdateInput {
 	return nil
 }
 
+func (m *CounterInput) GetSetBounds() *SetBoundsInput {
+	if x, ok := m.GetInput().(*CounterInput_SetBounds); ok {
+		return x.SetBounds
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*CounterInput) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -599,6 +701,7 @@ func (*CounterInput) XXX_OneofWrappers() []interface{} {
 		(*CounterInput_Get)(nil),
 		(*CounterInput_Set)(nil),
 		(*CounterInput_Update)(nil),
+		(*CounterInput_SetBounds)(nil),
 	}
 }
 
@@ -609,6 +712,7 @@ type CounterOutput struct {
 	//	*CounterOutput_Get
 	//	*CounterOutput_Set
 	//	*CounterOutput_Update
+	//	*CounterOutput_SetBounds
 	Output isCounterOutput_Output `protobuf_oneof:"output"`
 }
 
@@ -616,7 +720,7 @@ func (m *CounterOutput) Reset()         { *m = CounterOutput{} }
 func (m *CounterOutput) String() string { return proto.CompactTextString(m) }
 func (*CounterOutput) ProtoMessage()    {}
 func (*CounterOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{11}
+	return fileDescriptor_4f5cdcacbb356412, []int{13}
 }
 func (m *CounterOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,12 +770,16 @@ type CounterOutput_Set struct {
 type CounterOutput_Update struct {
 	Update *UpdateOutput `protobuf:"bytes,5,opt,name=update,proto3,oneof" json:"update,omitempty"`
 }
+type CounterOutput_SetBounds struct {
+	SetBounds *SetBoundsOutput `protobuf:"bytes,6,opt,name=set_bounds,json=setBounds,proto3,oneof" json:"set_bounds,omitempty"`
+}
 
 func (*CounterOutput_Increment) isCounterOutput_Output() {}
 func (*CounterOutput_Decrement) isCounterOutput_Output() {}
 func (*CounterOutput_Get) isCounterOutput_Output()       {}
 func (*CounterOutput_Set) isCounterOutput_Output()       {}
 func (*CounterOutput_Update) isCounterOutput_Output()    {}
+func (*CounterOutput_SetBounds) isCounterOutput_Output() {}
 
 func (m *CounterOutput) GetOutput() isCounterOutput_Output {
 	if m != nil {
@@ -715,6 +823,13 @@ func (m *CounterOutput) GetUpdate() *UpdateOutput {
 	return nil
 }
 
+func (m *CounterOutput) GetSetBounds() *SetBoundsOutput {
+	if x, ok := m.GetOutput().(*CounterOutput_SetBounds); ok {
+		return x.SetBounds
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*CounterOutput) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -723,18 +838,20 @@ func (*CounterOutput) XXX_OneofWrappers() []interface{} {
 		(*CounterOutput_Get)(nil),
 		(*CounterOutput_Set)(nil),
 		(*CounterOutput_Update)(nil),
+		(*CounterOutput_SetBounds)(nil),
 	}
 }
 
 type IncrementInput struct {
-	Delta int64 `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Delta    int64 `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Saturate bool  `protobuf:"varint,2,opt,name=saturate,proto3" json:"saturate,omitempty"`
 }
 
 func (m *IncrementInput) Reset()         { *m = IncrementInput{} }
 func (m *IncrementInput) String() string { return proto.CompactTextString(m) }
 func (*IncrementInput) ProtoMessage()    {}
 func (*IncrementInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{12}
+	return fileDescriptor_4f5cdcacbb356412, []int{14}
 }
 func (m *IncrementInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,6 +887,13 @@ func (m *IncrementInput) GetDelta() int64 {
 	return 0
 }
 
+func (m *IncrementInput) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
+}
+
 type IncrementOutput struct {
 	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -778,7 +902,7 @@ func (m *IncrementOutput) Reset()         { *m = IncrementOutput{} }
 func (m *IncrementOutput) String() string { return proto.CompactTextString(m) }
 func (*IncrementOutput) ProtoMessage()    {}
 func (*IncrementOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{13}
+	return fileDescriptor_4f5cdcacbb356412, []int{15}
 }
 func (m *IncrementOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,14 +939,15 @@ func (m *IncrementOutput) GetValue() int64 {
 }
 
 type DecrementInput struct {
-	Delta int64 `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Delta    int64 `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Saturate bool  `protobuf:"varint,2,opt,name=saturate,proto3" json:"saturate,omitempty"`
 }
 
 func (m *DecrementInput) Reset()         { *m = DecrementInput{} }
 func (m *DecrementInput) String() string { return proto.CompactTextString(m) }
 func (*DecrementInput) ProtoMessage()    {}
 func (*DecrementInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{14}
+	return fileDescriptor_4f5cdcacbb356412, []int{16}
 }
 func (m *DecrementInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,6 +983,13 @@ func (m *DecrementInput) GetDelta() int64 {
 	return 0
 }
 
+func (m *DecrementInput) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
+}
+
 type DecrementOutput struct {
 	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -866,7 +998,7 @@ func (m *DecrementOutput) Reset()         { *m = DecrementOutput{} }
 func (m *DecrementOutput) String() string { return proto.CompactTextString(m) }
 func (*DecrementOutput) ProtoMessage()    {}
 func (*DecrementOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{15}
+	return fileDescriptor_4f5cdcacbb356412, []int{17}
 }
 func (m *DecrementOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +1041,7 @@ func (m *GetInput) Reset()         { *m = GetInput{} }
 func (m *GetInput) String() string { return proto.CompactTextString(m) }
 func (*GetInput) ProtoMessage()    {}
 func (*GetInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{16}
+	return fileDescriptor_4f5cdcacbb356412, []int{18}
 }
 func (m *GetInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,14 +1071,15 @@ func (m *GetInput) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetInput proto.InternalMessageInfo
 
 type GetOutput struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Value  int64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
 }
 
 func (m *GetOutput) Reset()         { *m = GetOutput{} }
 func (m *GetOutput) String() string { return proto.CompactTextString(m) }
 func (*GetOutput) ProtoMessage()    {}
 func (*GetOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{17}
+	return fileDescriptor_4f5cdcacbb356412, []int{19}
 }
 func (m *GetOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,6 +1115,13 @@ func (m *GetOutput) GetValue() int64 {
 	return 0
 }
 
+func (m *GetOutput) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
 type SetInput struct {
 	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -990,7 +1130,7 @@ func (m *SetInput) Reset()         { *m = SetInput{} }
 func (m *SetInput) String() string { return proto.CompactTextString(m) }
 func (*SetInput) ProtoMessage()    {}
 func (*SetInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{18}
+	return fileDescriptor_4f5cdcacbb356412, []int{20}
 }
 func (m *SetInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1174,7 @@ func (m *SetOutput) Reset()         { *m = SetOutput{} }
 func (m *SetOutput) String() string { return proto.CompactTextString(m) }
 func (*SetOutput) ProtoMessage()    {}
 func (*SetOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{19}
+	return fileDescriptor_4f5cdcacbb356412, []int{21}
 }
 func (m *SetOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,7 +1219,7 @@ func (m *UpdateInput) Reset()         { *m = UpdateInput{} }
 func (m *UpdateInput) String() string { return proto.CompactTextString(m) }
 func (*UpdateInput) ProtoMessage()    {}
 func (*UpdateInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{20}
+	return fileDescriptor_4f5cdcacbb356412, []int{22}
 }
 func (m *UpdateInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,7 +1270,7 @@ func (m *UpdateOutput) Reset()         { *m = UpdateOutput{} }
 func (m *UpdateOutput) String() string { return proto.CompactTextString(m) }
 func (*UpdateOutput) ProtoMessage()    {}
 func (*UpdateOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5cdcacbb356412, []int{21}
+	return fileDescriptor_4f5cdcacbb356412, []int{23}
 }
 func (m *UpdateOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,6 +1306,199 @@ func (m *UpdateOutput) GetValue() int64 {
 	return 0
 }
 
+type SetBoundsInput struct {
+	Bounds *Bounds `protobuf:"bytes,1,opt,name=bounds,proto3" json:"bounds,omitempty"`
+	// init sets the bounds only if the bounds of the counter have never been set
+	Init bool `protobuf:"varint,2,opt,name=init,proto3" json:"init,omitempty"`
+}
+
+func (m *SetBoundsInput) Reset()         { *m = SetBoundsInput{} }
+func (m *SetBoundsInput) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsInput) ProtoMessage()    {}
+func (*SetBoundsInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5cdcacbb356412, []int{24}
+}
+func (m *SetBoundsInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBoundsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBoundsInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBoundsInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsInput.Merge(m, src)
+}
+func (m *SetBoundsInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBoundsInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBoundsInput proto.InternalMessageInfo
+
+func (m *SetBoundsInput) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+func (m *SetBoundsInput) GetInit() bool {
+	if m != nil {
+		return m.Init
+	}
+	return false
+}
+
+type SetBoundsOutput struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SetBoundsOutput) Reset()         { *m = SetBoundsOutput{} }
+func (m *SetBoundsOutput) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsOutput) ProtoMessage()    {}
+func (*SetBoundsOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5cdcacbb356412, []int{25}
+}
+func (m *SetBoundsOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBoundsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBoundsOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBoundsOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsOutput.Merge(m, src)
+}
+func (m *SetBoundsOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBoundsOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBoundsOutput proto.InternalMessageInfo
+
+func (m *SetBoundsOutput) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type Bounds struct {
+	Min *Bound `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max *Bound `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *Bounds) Reset()         { *m = Bounds{} }
+func (m *Bounds) String() string { return proto.CompactTextString(m) }
+func (*Bounds) ProtoMessage()    {}
+func (*Bounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5cdcacbb356412, []int{26}
+}
+func (m *Bounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bounds.Merge(m, src)
+}
+func (m *Bounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bounds proto.InternalMessageInfo
+
+func (m *Bounds) GetMin() *Bound {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *Bounds) GetMax() *Bound {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+type Bound struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Bound) Reset()         { *m = Bound{} }
+func (m *Bound) String() string { return proto.CompactTextString(m) }
+func (*Bound) ProtoMessage()    {}
+func (*Bound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5cdcacbb356412, []int{27}
+}
+func (m *Bound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bound.Merge(m, src)
+}
+func (m *Bound) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bound proto.InternalMessageInfo
+
+func (m *Bound) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IncrementRequest)(nil), "atomix.protocols.rsm.counter.v1.IncrementRequest")
 	proto.RegisterType((*IncrementResponse)(nil), "atomix.protocols.rsm.counter.v1.IncrementResponse")
@@ -1177,6 +1510,8 @@ func init() {
 	proto.RegisterType((*SetResponse)(nil), "atomix.protocols.rsm.counter.v1.SetResponse")
 	proto.RegisterType((*UpdateRequest)(nil), "atomix.protocols.rsm.counter.v1.UpdateRequest")
 	proto.RegisterType((*UpdateResponse)(nil), "atomix.protocols.rsm.counter.v1.UpdateResponse")
+	proto.RegisterType((*SetBoundsRequest)(nil), "atomix.protocols.rsm.counter.v1.SetBoundsRequest")
+	proto.RegisterType((*SetBoundsResponse)(nil), "atomix.protocols.rsm.counter.v1.SetBoundsResponse")
 	proto.RegisterType((*CounterInput)(nil), "atomix.protocols.rsm.counter.v1.CounterInput")
 	proto.RegisterType((*CounterOutput)(nil), "atomix.protocols.rsm.counter.v1.CounterOutput")
 	proto.RegisterType((*IncrementInput)(nil), "atomix.protocols.rsm.counter.v1.IncrementInput")
@@ -1189,59 +1524,73 @@ func init() {
 	proto.RegisterType((*SetOutput)(nil), "atomix.protocols.rsm.counter.v1.SetOutput")
 	proto.RegisterType((*UpdateInput)(nil), "atomix.protocols.rsm.counter.v1.UpdateInput")
 	proto.RegisterType((*UpdateOutput)(nil), "atomix.protocols.rsm.counter.v1.UpdateOutput")
+	proto.RegisterType((*SetBoundsInput)(nil), "atomix.protocols.rsm.counter.v1.SetBoundsInput")
+	proto.RegisterType((*SetBoundsOutput)(nil), "atomix.protocols.rsm.counter.v1.SetBoundsOutput")
+	proto.RegisterType((*Bounds)(nil), "atomix.protocols.rsm.counter.v1.Bounds")
+	proto.RegisterType((*Bound)(nil), "atomix.protocols.rsm.counter.v1.Bound")
 }
 
 func init() { proto.RegisterFile("counter/v1/counter.proto", fileDescriptor_4f5cdcacbb356412) }
 
 var fileDescriptor_4f5cdcacbb356412 = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x33, 0x4d, 0x9b, 0x34, 0x27, 0x6d, 0x29, 0xa3, 0x0a, 0x59, 0x5d, 0xa4, 0xad, 0x85,
-	0xb8, 0xb6, 0x4e, 0x53, 0xd6, 0x14, 0xa9, 0x5c, 0x92, 0x52, 0x89, 0x16, 0x47, 0xec, 0x6b, 0x92,
-	0x51, 0x89, 0x94, 0x64, 0x82, 0x3d, 0x8e, 0xe0, 0x05, 0x58, 0xb3, 0x47, 0x42, 0x95, 0x60, 0x03,
-	0x1b, 0x5e, 0x83, 0x65, 0x97, 0xac, 0x10, 0x6a, 0x1f, 0x04, 0x64, 0x7b, 0xc6, 0xb1, 0x1d, 0xbb,
-	0x99, 0x41, 0x8a, 0xd4, 0x9d, 0x27, 0x39, 0x97, 0xdf, 0xdf, 0x7f, 0x7c, 0x6c, 0xd0, 0x5a, 0xd4,
-	0xed, 0x33, 0x62, 0x57, 0x87, 0xb5, 0x2a, 0xbf, 0x34, 0x06, 0x36, 0x65, 0x14, 0xaf, 0x59, 0x8c,
-	0xf6, 0x3a, 0xef, 0x82, 0x53, 0x8b, 0x76, 0x1d, 0xc3, 0x76, 0x7a, 0x86, 0x88, 0x19, 0xd6, 0x56,
-	0x97, 0x87, 0xb5, 0xea, 0x1b, 0x62, 0xb5, 0x89, 0xed, 0x04, 0x41, 0xab, 0x2b, 0x27, 0xf4, 0x84,
-	0xfa, 0x97, 0x55, 0xef, 0x2a, 0xf8, 0x55, 0xff, 0x8e, 0x60, 0x79, 0xbf, 0xdf, 0xb2, 0x49, 0x8f,
-	0xf4, 0x99, 0x49, 0xde, 0xba, 0xc4, 0x61, 0x78, 0x1f, 0x8a, 0x3c, 0x57, 0x43, 0xeb, 0xe8, 0x4e,
-	0x79, 0xa7, 0x6a, 0xa4, 0xf6, 0x1b, 0xd6, 0x8c, 0x23, 0x9b, 0x0e, 0xa8, 0x63, 0x75, 0x79, 0x6a,
-	0x23, 0x48, 0x33, 0x45, 0x3e, 0x3e, 0x80, 0xb9, 0x4e, 0x7f, 0xe0, 0x32, 0x6d, 0xe6, 0xb2, 0x42,
-	0x23, 0xe1, 0x46, 0x28, 0x66, 0xdf, 0x4b, 0xdb, 0x9b, 0x3d, 0xfb, 0xbd, 0x86, 0xcc, 0xa0, 0x86,
-	0xfe, 0x03, 0xc1, 0xf5, 0x88, 0x58, 0x67, 0x40, 0xfb, 0x0e, 0xc1, 0xcf, 0x93, 0x6a, 0xb7, 0x25,
-	0xd4, 0x06, 0xb9, 0x63, 0x72, 0x5f, 0x40, 0x81, 0xba, 0x6c, 0xa4, 0x77, 0x5b, 0x5e, 0xef, 0xa1,
-	0x9f, 0xc7, 0x05, 0xf3, 0x2a, 0x3e, 0xde, 0x27, 0xe4, 0x0a, 0xe1, 0x0d, 0xc5, 0x64, 0xe0, 0x8d,
-	0x88, 0xbd, 0x12, 0x78, 0x43, 0x3d, 0xa9, 0x78, 0x3f, 0x21, 0x80, 0x3a, 0x09, 0xc1, 0x3e, 0x4b,
-	0x4a, 0xdd, 0xcc, 0x94, 0xfa, 0xd2, 0x25, 0xf6, 0xfb, 0x2c, 0xaa, 0x4f, 0xe3, 0x54, 0xef, 0x4e,
-	0x54, 0x59, 0x27, 0x69, 0x3c, 0x4f, 0x11, 0x94, 0x7d, 0x75, 0x9c, 0x64, 0x3d, 0x29, 0x6f, 0x6b,
-	0x92, 0xbc, 0x0c, 0x8c, 0x8d, 0x04, 0xc6, 0x7b, 0x32, 0x02, 0x53, 0x01, 0x7e, 0x46, 0x00, 0x4d,
-	0x32, 0x8d, 0xc9, 0x54, 0x66, 0xd8, 0x4c, 0x65, 0xf8, 0x05, 0x41, 0xb9, 0x49, 0xa6, 0x33, 0x8d,
-	0xea, 0x18, 0x9b, 0x19, 0x18, 0xbf, 0x22, 0x58, 0x7c, 0x35, 0x68, 0x5b, 0x8c, 0x4c, 0x81, 0x64,
-	0x23, 0x4e, 0x72, 0x73, 0xa2, 0xca, 0x40, 0x49, 0x0a, 0xcc, 0x6f, 0x08, 0x96, 0x84, 0xcc, 0x29,
-	0xf0, 0x3c, 0x48, 0xf0, 0xdc, 0x92, 0x54, 0x9a, 0x8a, 0xf4, 0xef, 0x0c, 0x2c, 0x3c, 0x0e, 0x22,
-	0xfd, 0x3b, 0xc1, 0x87, 0x50, 0xea, 0x88, 0x5d, 0x7b, 0x39, 0xd3, 0xcc, 0xb7, 0x49, 0x23, 0x67,
-	0x8e, 0x6a, 0x78, 0x05, 0xdb, 0x62, 0xbb, 0xfc, 0xe7, 0xfe, 0xf4, 0x0a, 0x86, 0x35, 0xf0, 0x43,
-	0xc8, 0x9f, 0x10, 0xa6, 0xe5, 0x15, 0x97, 0x46, 0x23, 0x67, 0x7a, 0x79, 0x5e, 0xba, 0x43, 0x98,
-	0x36, 0xab, 0xf8, 0xbc, 0x78, 0xe9, 0x0e, 0xf1, 0x96, 0x5f, 0xc1, 0xf5, 0x71, 0x6a, 0x73, 0xea,
-	0x73, 0xd2, 0xc8, 0x99, 0x3c, 0x7b, 0xaf, 0xc8, 0xc7, 0x4d, 0xff, 0x90, 0x87, 0x45, 0xee, 0x40,
-	0xe0, 0x10, 0x3e, 0x1a, 0xb7, 0x40, 0xf9, 0x05, 0x19, 0xf7, 0xe0, 0x68, 0xdc, 0x03, 0xe5, 0x77,
-	0x42, 0xdc, 0x84, 0xdd, 0xa8, 0x09, 0x0a, 0x8b, 0x51, 0xb8, 0xb0, 0x1b, 0x75, 0x41, 0x61, 0x23,
-	0x08, 0x1b, 0xea, 0x09, 0x1b, 0xd4, 0x1e, 0x82, 0x88, 0x0f, 0xf3, 0xe2, 0x69, 0xd2, 0x6f, 0xc1,
-	0x52, 0x7c, 0x8e, 0xf1, 0x0a, 0xcc, 0xb5, 0x49, 0x97, 0x59, 0xbe, 0x09, 0x79, 0x33, 0x38, 0xe8,
-	0xb7, 0xe1, 0x5a, 0x02, 0xb6, 0x17, 0x38, 0xb4, 0xba, 0x2e, 0x11, 0x81, 0xfe, 0xc1, 0x2b, 0x18,
-	0x9f, 0xe3, 0xec, 0x82, 0x09, 0xd6, 0x19, 0x05, 0x01, 0xe6, 0xc5, 0x34, 0xeb, 0x1b, 0x50, 0x0a,
-	0xa1, 0x66, 0x84, 0xaf, 0xc3, 0xbc, 0x98, 0xde, 0x8c, 0x88, 0x0d, 0x28, 0x35, 0x27, 0x14, 0x79,
-	0x04, 0xe5, 0xc8, 0x00, 0x63, 0x0d, 0x8a, 0x2d, 0xda, 0x1b, 0x58, 0xb6, 0x08, 0x13, 0x47, 0x7c,
-	0x23, 0x74, 0x64, 0xc6, 0xff, 0x83, 0x9f, 0xf4, 0x9b, 0xb0, 0x10, 0x45, 0x9f, 0xde, 0x66, 0xe7,
-	0x74, 0x16, 0x8a, 0xfc, 0x29, 0xc0, 0xc7, 0x90, 0x6f, 0x12, 0x86, 0xef, 0xcb, 0x4c, 0x05, 0xdf,
-	0xe6, 0xab, 0x9b, 0x72, 0xc1, 0x7c, 0x1d, 0x77, 0xa0, 0x10, 0x68, 0xc2, 0x86, 0xe4, 0xdc, 0x88,
-	0x3e, 0x55, 0xe9, 0x78, 0xde, 0xea, 0x18, 0xf2, 0x75, 0xa9, 0x9b, 0xa9, 0xab, 0xdc, 0x4c, 0xf4,
-	0x7b, 0xc7, 0x86, 0x52, 0x38, 0x8f, 0xb8, 0x26, 0xbf, 0x28, 0x44, 0xb7, 0x1d, 0x95, 0x94, 0x51,
-	0xcf, 0x70, 0x64, 0x25, 0x7a, 0x26, 0xbf, 0xcd, 0x25, 0x7a, 0x8e, 0x7d, 0x21, 0xef, 0x69, 0x3f,
-	0xcf, 0x2b, 0xe8, 0xec, 0xbc, 0x82, 0xfe, 0x9c, 0x57, 0xd0, 0xc7, 0x8b, 0x4a, 0xee, 0xec, 0xa2,
-	0x92, 0xfb, 0x75, 0x51, 0xc9, 0xbd, 0x2e, 0xf8, 0x55, 0x1e, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x1c, 0x8e, 0xce, 0x0a, 0xc9, 0x0d, 0x00, 0x00,
+	// 899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5d, 0x6b, 0xd3, 0x50,
+	0x18, 0x6e, 0x96, 0xf5, 0xeb, 0xed, 0x36, 0xe7, 0x61, 0x48, 0x28, 0xd8, 0xcd, 0x20, 0xce, 0x8f,
+	0xad, 0x5d, 0xe7, 0x8d, 0x37, 0x6e, 0x50, 0xab, 0xed, 0x1c, 0xb8, 0x2e, 0xc5, 0x6b, 0x97, 0xb5,
+	0x87, 0x59, 0x68, 0x93, 0x9a, 0x9c, 0x94, 0x09, 0xfe, 0x08, 0x2f, 0xbc, 0x13, 0x44, 0xd0, 0x1b,
+	0xbd, 0xf1, 0x6f, 0x78, 0x39, 0xef, 0xf4, 0x46, 0x64, 0xfb, 0x23, 0x92, 0xe4, 0x9c, 0x34, 0x49,
+	0x93, 0x36, 0x29, 0x14, 0x7a, 0x97, 0xd3, 0xbe, 0x1f, 0xcf, 0x79, 0x9e, 0xf7, 0x23, 0x01, 0xa1,
+	0xa5, 0x1a, 0x0a, 0xc1, 0x5a, 0x69, 0x50, 0x2e, 0xd1, 0xc7, 0x62, 0x5f, 0x53, 0x89, 0x8a, 0xd6,
+	0x65, 0xa2, 0xf6, 0x3a, 0xe7, 0xf6, 0xa9, 0xa5, 0x76, 0xf5, 0xa2, 0xa6, 0xf7, 0x8a, 0xcc, 0x66,
+	0x50, 0xce, 0xaf, 0x0e, 0xca, 0xa5, 0xd7, 0x58, 0x6e, 0x63, 0x4d, 0xb7, 0x8d, 0xf2, 0x6b, 0x67,
+	0xea, 0x99, 0x6a, 0x3d, 0x96, 0xcc, 0x27, 0xfb, 0x57, 0xf1, 0x3b, 0x07, 0xab, 0x07, 0x4a, 0x4b,
+	0xc3, 0x3d, 0xac, 0x10, 0x09, 0xbf, 0x31, 0xb0, 0x4e, 0xd0, 0x01, 0xa4, 0xa9, 0xaf, 0xc0, 0x6d,
+	0x70, 0x77, 0x73, 0xbb, 0xa5, 0x62, 0x60, 0xbe, 0x41, 0xb9, 0xd8, 0xd0, 0xd4, 0xbe, 0xaa, 0xcb,
+	0x5d, 0xea, 0x5a, 0xb7, 0xdd, 0x24, 0xe6, 0x8f, 0x0e, 0x21, 0xd9, 0x51, 0xfa, 0x06, 0x11, 0x16,
+	0xc6, 0x05, 0x1a, 0x02, 0x2f, 0x3a, 0x60, 0x0e, 0x4c, 0xb7, 0xca, 0xe2, 0xc5, 0xdf, 0x75, 0x4e,
+	0xb2, 0x63, 0x88, 0x3f, 0x38, 0xb8, 0xee, 0x02, 0xab, 0xf7, 0x55, 0x45, 0xc7, 0xe8, 0xb9, 0x1f,
+	0xed, 0x4e, 0x04, 0xb4, 0xb6, 0xef, 0x08, 0xdc, 0x17, 0x90, 0x52, 0x0d, 0x32, 0xc4, 0xbb, 0x13,
+	0x1d, 0xef, 0x91, 0x41, 0x86, 0x80, 0x69, 0x14, 0x8b, 0xde, 0x2a, 0x9e, 0x23, 0x7a, 0xab, 0x78,
+	0x3c, 0xbd, 0x2e, 0xb0, 0x73, 0x41, 0x6f, 0x15, 0x8f, 0xa3, 0xf7, 0x23, 0x07, 0x50, 0xc3, 0x0e,
+	0xb1, 0xcf, 0xfc, 0x50, 0xb7, 0x42, 0xa1, 0x1e, 0x1b, 0x58, 0x7b, 0x1b, 0xc6, 0xea, 0x53, 0x2f,
+	0xab, 0xf7, 0x26, 0xa2, 0xac, 0xe1, 0x20, 0x3e, 0x3f, 0x73, 0x90, 0xb3, 0xd0, 0x51, 0x26, 0x6b,
+	0x7e, 0x78, 0xdb, 0x93, 0xe0, 0x85, 0xd0, 0x58, 0xf7, 0xd1, 0x78, 0x3f, 0x0a, 0xc0, 0x40, 0x02,
+	0x3f, 0x71, 0x00, 0x4d, 0x3c, 0x8b, 0xca, 0x8c, 0xcd, 0x61, 0x33, 0x90, 0xc3, 0x2f, 0x1c, 0xe4,
+	0x9a, 0x78, 0x36, 0xd5, 0x18, 0x9f, 0xc6, 0x66, 0x08, 0x8d, 0x5f, 0x39, 0x58, 0x7e, 0xd9, 0x6f,
+	0xcb, 0x04, 0xcf, 0x80, 0xc9, 0xba, 0x97, 0xc9, 0xad, 0x89, 0x28, 0x6d, 0x24, 0x01, 0x64, 0x7e,
+	0xe3, 0x60, 0x85, 0xc1, 0x9c, 0x01, 0x9f, 0x87, 0x3e, 0x3e, 0xb7, 0x23, 0x22, 0x0d, 0x9d, 0x9c,
+	0x4d, 0x4c, 0x2a, 0xaa, 0xa1, 0xb4, 0xf5, 0x79, 0x98, 0x9c, 0x0e, 0x98, 0x90, 0xc9, 0xe9, 0x02,
+	0x3b, 0x17, 0x93, 0xd3, 0xc1, 0x13, 0x48, 0xef, 0x2f, 0x1e, 0x96, 0x9e, 0xd8, 0xc6, 0xd6, 0x7d,
+	0xd0, 0x11, 0x64, 0x3b, 0x6c, 0x95, 0x8d, 0x27, 0x37, 0x74, 0x59, 0xd7, 0x13, 0xd2, 0x30, 0x86,
+	0x19, 0xb0, 0xcd, 0x86, 0xf7, 0x94, 0xeb, 0xc9, 0x0c, 0xe8, 0xc4, 0x40, 0x8f, 0x81, 0x3f, 0xc3,
+	0x44, 0xe0, 0x63, 0xce, 0xe4, 0x7a, 0x42, 0x32, 0xfd, 0x4c, 0x77, 0x1d, 0x13, 0x61, 0x31, 0xe6,
+	0x38, 0x32, 0xdd, 0x75, 0x6c, 0xee, 0x96, 0x94, 0x61, 0x55, 0xab, 0x90, 0x8c, 0xdf, 0x86, 0xf5,
+	0x84, 0x44, 0xbd, 0x51, 0x03, 0x40, 0xc7, 0xe4, 0xd5, 0xa9, 0x25, 0x8d, 0x90, 0x9a, 0xaa, 0xf8,
+	0x4c, 0x5e, 0x74, 0xf6, 0x4b, 0x25, 0x4d, 0x2b, 0x59, 0xfc, 0xc3, 0xc3, 0x32, 0xd5, 0xd4, 0xd6,
+	0x1c, 0x35, 0x46, 0x45, 0x8d, 0xfd, 0x46, 0xe3, 0x55, 0xb5, 0x31, 0xaa, 0x6a, 0xec, 0x25, 0xee,
+	0x95, 0x75, 0xcf, 0x2d, 0x6b, 0x8c, 0x4d, 0xc6, 0x74, 0xdd, 0x73, 0xeb, 0x1a, 0x63, 0x84, 0x33,
+	0x61, 0x6b, 0x3e, 0x61, 0xe3, 0x4d, 0x2d, 0x97, 0xb2, 0xc7, 0x01, 0xca, 0xc6, 0x6e, 0x53, 0xaf,
+	0xb4, 0x19, 0xd6, 0xf5, 0x62, 0x05, 0x56, 0xbc, 0xcd, 0x86, 0xd6, 0x20, 0xd9, 0xc6, 0x5d, 0x22,
+	0x5b, 0xba, 0xf2, 0x92, 0x7d, 0x40, 0x79, 0xc8, 0xe8, 0x32, 0x31, 0x34, 0xf3, 0x3e, 0xa6, 0x3c,
+	0x19, 0xc9, 0x39, 0x8b, 0x9b, 0x70, 0xcd, 0xa7, 0xad, 0x19, 0x64, 0x20, 0x77, 0x0d, 0xcc, 0x82,
+	0x58, 0x07, 0x33, 0x99, 0xb7, 0x11, 0xa7, 0x4b, 0xe6, 0x93, 0x3d, 0x24, 0x19, 0x40, 0x86, 0xb5,
+	0xaa, 0x78, 0x0a, 0xd9, 0x1a, 0x1e, 0x6b, 0x8e, 0xf6, 0x21, 0x45, 0x19, 0xb6, 0xab, 0x6f, 0x73,
+	0x22, 0xc3, 0x74, 0x2a, 0x53, 0x37, 0x71, 0x03, 0x32, 0xac, 0xb7, 0x43, 0x10, 0xdd, 0x82, 0x6c,
+	0x73, 0x3c, 0x0a, 0x71, 0x1f, 0x72, 0xae, 0xf6, 0x46, 0x02, 0xa4, 0x5b, 0x6a, 0xaf, 0x2f, 0x6b,
+	0xcc, 0x8c, 0x1d, 0xd1, 0x0d, 0xa7, 0xba, 0x16, 0xac, 0x3f, 0xe8, 0x49, 0xbc, 0x0d, 0x4b, 0xee,
+	0x32, 0x0a, 0x49, 0x83, 0x61, 0xc5, 0xdb, 0xf9, 0xae, 0xeb, 0x73, 0x53, 0x5d, 0x1f, 0x21, 0x58,
+	0xec, 0x28, 0x1d, 0x42, 0xf5, 0xb2, 0x9e, 0x4d, 0xad, 0x7c, 0x65, 0x18, 0x82, 0xe7, 0x1d, 0xa4,
+	0x6c, 0x2b, 0xf4, 0x08, 0xf8, 0x5e, 0x47, 0xa1, 0x20, 0xee, 0x44, 0x03, 0x21, 0x99, 0x2e, 0x96,
+	0xa7, 0x7c, 0x2e, 0x2c, 0xc4, 0xf4, 0x94, 0xcf, 0xc5, 0x9b, 0x90, 0xb4, 0x4e, 0xc1, 0xe0, 0x76,
+	0x3f, 0x24, 0x21, 0x4d, 0xc7, 0x1f, 0x3a, 0x01, 0xbe, 0x89, 0x09, 0x7a, 0x10, 0xa5, 0xfd, 0xe8,
+	0x1b, 0x42, 0x7e, 0x2b, 0x9a, 0x31, 0x5d, 0xee, 0x1d, 0x48, 0xd9, 0x02, 0xa2, 0x62, 0xc4, 0x81,
+	0xc1, 0xf2, 0x94, 0x22, 0xdb, 0xd3, 0x54, 0x27, 0xc0, 0xd7, 0x22, 0x5d, 0xa6, 0x16, 0xe7, 0x32,
+	0xee, 0x2f, 0x13, 0x0d, 0xb2, 0xce, 0x64, 0x40, 0xe5, 0xe8, 0x1b, 0x82, 0x65, 0xdb, 0x8d, 0xe3,
+	0x32, 0xcc, 0x59, 0xc5, 0xd1, 0x73, 0x56, 0x71, 0xec, 0x9c, 0xa3, 0xdf, 0xb2, 0x9a, 0xd5, 0xd9,
+	0xb4, 0x84, 0xcb, 0xd1, 0x67, 0x73, 0xf4, 0x9c, 0x23, 0x6f, 0x81, 0x15, 0xe1, 0xe7, 0x65, 0x81,
+	0xbb, 0xb8, 0x2c, 0x70, 0xff, 0x2e, 0x0b, 0xdc, 0xfb, 0xab, 0x42, 0xe2, 0xe2, 0xaa, 0x90, 0xf8,
+	0x7d, 0x55, 0x48, 0x9c, 0xa6, 0xac, 0x28, 0x0f, 0xff, 0x0f, 0x00, 0x32, 0xed, 0xf9, 0xaf, 0xe7,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1266,6 +1615,8 @@ type CounterClient interface {
 	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
 	// Decrement decrements the counter value
 	Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error)
+	// SetBounds sets the bounds of the counter value
+	SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error)
 }
 
 type counterClient struct {
@@ -1321,6 +1672,15 @@ func (c *counterClient) Decrement(ctx context.Context, in *DecrementRequest, opt
 	return out, nil
 }
 
+func (c *counterClient) SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error) {
+	out := new(SetBoundsResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.counter.v1.Counter/SetBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CounterServer is the server API for Counter service.
 type CounterServer interface {
 	// Set sets the counter value
@@ -1333,6 +1693,8 @@ type CounterServer interface {
 	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
 	// Decrement decrements the counter value
 	Decrement(context.Context, *DecrementRequest) (*DecrementResponse, error)
+	// SetBounds sets the bounds of the counter value
+	SetBounds(context.Context, *SetBoundsRequest) (*SetBoundsResponse, error)
 }
 
 // UnimplementedCounterServer can be embedded to have forward compatible implementations.
@@ -1354,6 +1716,9 @@ func (*UnimplementedCounterServer) Increment(ctx context.Context, req *Increment
 func (*UnimplementedCounterServer) Decrement(ctx context.Context, req *DecrementRequest) (*DecrementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Decrement not implemented")
 }
+func (*UnimplementedCounterServer) SetBounds(ctx context.Context, req *SetBoundsRequest) (*SetBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBounds not implemented")
+}
 
 func RegisterCounterServer(s *grpc.Server, srv CounterServer) {
 	s.RegisterService(&_Counter_serviceDesc, srv)
@@ -1449,6 +1814,24 @@ func _Counter_Decrement_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Counter_SetBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServer).SetBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.counter.v1.Counter/SetBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServer).SetBounds(ctx, req.(*SetBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Counter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "atomix.protocols.rsm.counter.v1.Counter",
 	HandlerType: (*CounterServer)(nil),
@@ -1473,6 +1856,10 @@ var _Counter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Decrement",
 			Handler:    _Counter_Decrement_Handler,
 		},
+		{
+			MethodName: "SetBounds",
+			Handler:    _Counter_SetBounds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "counter/v1/counter.proto",
@@ -1948,7 +2335,7 @@ func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CounterInput) Marshal() (dAtA []byte, err error) {
+func (m *SetBoundsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1958,25 +2345,119 @@ func (m *CounterInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CounterInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Input != nil {
+	if m.SetBoundsInput != nil {
 		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.SetBoundsInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
 		}
-	}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SetBoundsOutput != nil {
+		{
+			size, err := m.SetBoundsOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CounterInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CounterInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -2085,6 +2566,27 @@ func (m *CounterInput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *CounterInput_SetBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterInput_SetBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetBounds != nil {
+		{
+			size, err := m.SetBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *CounterOutput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2222,6 +2724,27 @@ func (m *CounterOutput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *CounterOutput_SetBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterOutput_SetBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetBounds != nil {
+		{
+			size, err := m.SetBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *IncrementInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2242,6 +2765,16 @@ func (m *IncrementInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Saturate {
+		i--
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Delta != 0 {
 		i = encodeVarintCounter(dAtA, i, uint64(m.Delta))
 		i--
@@ -2298,6 +2831,16 @@ func (m *DecrementInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Saturate {
+		i--
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Delta != 0 {
 		i = encodeVarintCounter(dAtA, i, uint64(m.Delta))
 		i--
@@ -2377,6 +2920,18 @@ func (m *GetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Value != 0 {
 		i = encodeVarintCounter(dAtA, i, uint64(m.Value))
 		i--
@@ -2502,93 +3057,241 @@ func (m *UpdateOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCounter(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCounter(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetBoundsInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IncrementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCounter(uint64(l))
-	}
-	if m.IncrementInput != nil {
-		l = m.IncrementInput.Size()
-		n += 1 + l + sovCounter(uint64(l))
-	}
-	return n
+
+func (m *SetBoundsInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncrementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBoundsInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCounter(uint64(l))
+	if m.Init {
+		i--
+		if m.Init {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.IncrementOutput != nil {
-		l = m.IncrementOutput.Size()
-		n += 1 + l + sovCounter(uint64(l))
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DecrementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCounter(uint64(l))
-	}
-	if m.DecrementInput != nil {
-		l = m.DecrementInput.Size()
-		n += 1 + l + sovCounter(uint64(l))
+func (m *SetBoundsOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DecrementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBoundsOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBoundsOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCounter(uint64(l))
-	}
-	if m.DecrementOutput != nil {
-		l = m.DecrementOutput.Size()
-		n += 1 + l + sovCounter(uint64(l))
+	if m.Value != 0 {
+		i = encodeVarintCounter(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Bounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
+	return dAtA[:n], nil
+}
+
+func (m *Bounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCounter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCounter(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCounter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCounter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IncrementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.IncrementInput != nil {
+		l = m.IncrementInput.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
+func (m *IncrementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.IncrementOutput != nil {
+		l = m.IncrementOutput.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
+func (m *DecrementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.DecrementInput != nil {
+		l = m.DecrementInput.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
+func (m *DecrementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.DecrementOutput != nil {
+		l = m.DecrementOutput.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
 		n += 1 + l + sovCounter(uint64(l))
 	}
 	if m.GetInput != nil {
@@ -2683,6 +3386,40 @@ func (m *UpdateResponse) Size() (n int) {
 	return n
 }
 
+func (m *SetBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.SetBoundsInput != nil {
+		l = m.SetBoundsInput.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.SetBoundsOutput != nil {
+		l = m.SetBoundsOutput.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
 func (m *CounterInput) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2755,6 +3492,18 @@ func (m *CounterInput_Update) Size() (n int) {
 	}
 	return n
 }
+func (m *CounterInput_SetBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetBounds != nil {
+		l = m.SetBounds.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
 func (m *CounterOutput) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2827,6 +3576,18 @@ func (m *CounterOutput_Update) Size() (n int) {
 	}
 	return n
 }
+func (m *CounterOutput_SetBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetBounds != nil {
+		l = m.SetBounds.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
 func (m *IncrementInput) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2836,6 +3597,9 @@ func (m *IncrementInput) Size() (n int) {
 	if m.Delta != 0 {
 		n += 1 + sovCounter(uint64(m.Delta))
 	}
+	if m.Saturate {
+		n += 2
+	}
 	return n
 }
 
@@ -2860,6 +3624,9 @@ func (m *DecrementInput) Size() (n int) {
 	if m.Delta != 0 {
 		n += 1 + sovCounter(uint64(m.Delta))
 	}
+	if m.Saturate {
+		n += 2
+	}
 	return n
 }
 
@@ -2893,6 +3660,10 @@ func (m *GetOutput) Size() (n int) {
 	if m.Value != 0 {
 		n += 1 + sovCounter(uint64(m.Value))
 	}
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
 	return n
 }
 
@@ -2947,6 +3718,63 @@ func (m *UpdateOutput) Size() (n int) {
 	return n
 }
 
+func (m *SetBoundsInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.Init {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetBoundsOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCounter(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *Bounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovCounter(uint64(l))
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCounter(uint64(m.Value))
+	}
+	return n
+}
+
 func sovCounter(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4173,7 +5001,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CounterInput) Unmarshal(dAtA []byte) error {
+func (m *SetBoundsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4196,15 +5024,15 @@ func (m *CounterInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CounterInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBoundsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CounterInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4231,15 +5059,16 @@ func (m *CounterInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IncrementInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterInput_Increment{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBoundsInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4266,50 +5095,66 @@ func (m *CounterInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DecrementInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SetBoundsInput == nil {
+				m.SetBoundsInput = &SetBoundsInput{}
+			}
+			if err := m.SetBoundsInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterInput_Decrement{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCounter
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCounter
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCounter
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GetInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Input = &CounterInput_Get{v}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4336,15 +5181,16 @@ func (m *CounterInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SetInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterInput_Set{v}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBoundsOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4371,11 +5217,12 @@ func (m *CounterInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UpdateInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SetBoundsOutput == nil {
+				m.SetBoundsOutput = &SetBoundsOutput{}
+			}
+			if err := m.SetBoundsOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterInput_Update{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4398,7 +5245,7 @@ func (m *CounterInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CounterOutput) Unmarshal(dAtA []byte) error {
+func (m *CounterInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4421,10 +5268,10 @@ func (m *CounterOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CounterOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: CounterInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CounterOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CounterInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4456,11 +5303,11 @@ func (m *CounterOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IncrementOutput{}
+			v := &IncrementInput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &CounterOutput_Increment{v}
+			m.Input = &CounterInput_Increment{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4491,11 +5338,11 @@ func (m *CounterOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DecrementOutput{}
+			v := &DecrementInput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &CounterOutput_Decrement{v}
+			m.Input = &CounterInput_Decrement{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -4526,11 +5373,11 @@ func (m *CounterOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GetOutput{}
+			v := &GetInput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &CounterOutput_Get{v}
+			m.Input = &CounterInput_Get{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -4561,17 +5408,644 @@ func (m *CounterOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SetOutput{}
+			v := &SetInput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &CounterOutput_Set{v}
+			m.Input = &CounterInput_Set{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &CounterInput_Update{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SetBoundsInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &CounterInput_SetBounds{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CounterOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IncrementOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &CounterOutput_Increment{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecrementOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &CounterOutput_Decrement{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &CounterOutput_Get{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SetOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &CounterOutput_Set{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &CounterOutput_Update{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SetBoundsOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &CounterOutput_SetBounds{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrementInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			m.Delta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Saturate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecrementInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecrementInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			m.Delta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Saturate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecrementOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCounter
@@ -4581,27 +6055,11 @@ func (m *CounterOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCounter
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCounter
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &UpdateOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterOutput_Update{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -4623,7 +6081,7 @@ func (m *CounterOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementInput) Unmarshal(dAtA []byte) error {
+func (m *GetInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4646,31 +6104,12 @@ func (m *IncrementInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
-			}
-			m.Delta = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCounter
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Delta |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -4692,7 +6131,7 @@ func (m *IncrementInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
+func (m *GetOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4715,10 +6154,10 @@ func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4740,6 +6179,42 @@ func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -4761,7 +6236,7 @@ func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementInput) Unmarshal(dAtA []byte) error {
+func (m *SetInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4784,17 +6259,17 @@ func (m *DecrementInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Delta = 0
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCounter
@@ -4804,7 +6279,7 @@ func (m *DecrementInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delta |= int64(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4830,7 +6305,7 @@ func (m *DecrementInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
+func (m *SetOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4853,10 +6328,10 @@ func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4899,7 +6374,7 @@ func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetInput) Unmarshal(dAtA []byte) error {
+func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4922,12 +6397,50 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compare", wireType)
+			}
+			m.Compare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compare |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			m.Update = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Update |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -4949,7 +6462,7 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOutput) Unmarshal(dAtA []byte) error {
+func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4972,10 +6485,10 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5018,7 +6531,7 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetInput) Unmarshal(dAtA []byte) error {
+func (m *SetBoundsInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5041,17 +6554,53 @@ func (m *SetInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBoundsInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBoundsInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
-			m.Value = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCounter
@@ -5061,11 +6610,12 @@ func (m *SetInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Init = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -5087,7 +6637,7 @@ func (m *SetInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetOutput) Unmarshal(dAtA []byte) error {
+func (m *SetBoundsOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5110,10 +6660,10 @@ func (m *SetOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBoundsOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBoundsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5156,7 +6706,7 @@ func (m *SetOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateInput) Unmarshal(dAtA []byte) error {
+func (m *Bounds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5179,17 +6729,17 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bounds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bounds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compare", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			m.Compare = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCounter
@@ -5199,16 +6749,33 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Compare |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Min == nil {
+				m.Min = &Bound{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			m.Update = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCounter
@@ -5218,11 +6785,28 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Update |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCounter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = &Bound{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCounter(dAtA[iNdEx:])
@@ -5244,7 +6828,7 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
+func (m *Bound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5267,10 +6851,10 @@ func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
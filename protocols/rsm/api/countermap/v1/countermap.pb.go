@@ -6,12 +6,12 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
-	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -573,23 +573,23 @@ func (m *DecrementResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	return nil
 }
 
-type GetRequest struct {
-	Headers   *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*GetInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+type ConfigureRequest struct {
+	Headers         *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ConfigureInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *GetRequest) Reset()         { *m = GetRequest{} }
-func (m *GetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()    {}
-func (*GetRequest) Descriptor() ([]byte, []int) {
+func (m *ConfigureRequest) Reset()         { *m = ConfigureRequest{} }
+func (m *ConfigureRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfigureRequest) ProtoMessage()    {}
+func (*ConfigureRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{12}
 }
-func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+func (m *ConfigureRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConfigureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConfigureRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -599,42 +599,42 @@ func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRequest.Merge(m, src)
+func (m *ConfigureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureRequest.Merge(m, src)
 }
-func (m *GetRequest) XXX_Size() int {
+func (m *ConfigureRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+func (m *ConfigureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConfigureRequest proto.InternalMessageInfo
 
-func (m *GetRequest) GetHeaders() *v1.QueryRequestHeaders {
+func (m *ConfigureRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type GetResponse struct {
-	Headers    *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*GetOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+type ConfigureResponse struct {
+	Headers          *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ConfigureOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *GetResponse) Reset()         { *m = GetResponse{} }
-func (m *GetResponse) String() string { return proto.CompactTextString(m) }
-func (*GetResponse) ProtoMessage()    {}
-func (*GetResponse) Descriptor() ([]byte, []int) {
+func (m *ConfigureResponse) Reset()         { *m = ConfigureResponse{} }
+func (m *ConfigureResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfigureResponse) ProtoMessage()    {}
+func (*ConfigureResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{13}
 }
-func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+func (m *ConfigureResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConfigureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConfigureResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -644,42 +644,42 @@ func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetResponse.Merge(m, src)
+func (m *ConfigureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureResponse.Merge(m, src)
 }
-func (m *GetResponse) XXX_Size() int {
+func (m *ConfigureResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+func (m *ConfigureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConfigureResponse proto.InternalMessageInfo
 
-func (m *GetResponse) GetHeaders() *v1.QueryResponseHeaders {
+func (m *ConfigureResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type RemoveRequest struct {
-	Headers      *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*RemoveInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+type SetBoundsRequest struct {
+	Headers         *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*SetBoundsInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
-func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveRequest) ProtoMessage()    {}
-func (*RemoveRequest) Descriptor() ([]byte, []int) {
+func (m *SetBoundsRequest) Reset()         { *m = SetBoundsRequest{} }
+func (m *SetBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsRequest) ProtoMessage()    {}
+func (*SetBoundsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{14}
 }
-func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetBoundsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetBoundsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -689,42 +689,42 @@ func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *RemoveRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRequest.Merge(m, src)
+func (m *SetBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsRequest.Merge(m, src)
 }
-func (m *RemoveRequest) XXX_Size() int {
+func (m *SetBoundsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
+func (m *SetBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetBoundsRequest proto.InternalMessageInfo
 
-func (m *RemoveRequest) GetHeaders() *v1.ProposalRequestHeaders {
+func (m *SetBoundsRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type RemoveResponse struct {
-	Headers       *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*RemoveOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+type SetBoundsResponse struct {
+	Headers          *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*SetBoundsOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *RemoveResponse) Reset()         { *m = RemoveResponse{} }
-func (m *RemoveResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveResponse) ProtoMessage()    {}
-func (*RemoveResponse) Descriptor() ([]byte, []int) {
+func (m *SetBoundsResponse) Reset()         { *m = SetBoundsResponse{} }
+func (m *SetBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsResponse) ProtoMessage()    {}
+func (*SetBoundsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{15}
 }
-func (m *RemoveResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetBoundsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetBoundsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -734,42 +734,42 @@ func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *RemoveResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveResponse.Merge(m, src)
+func (m *SetBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsResponse.Merge(m, src)
 }
-func (m *RemoveResponse) XXX_Size() int {
+func (m *SetBoundsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveResponse.DiscardUnknown(m)
+func (m *SetBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetBoundsResponse proto.InternalMessageInfo
 
-func (m *RemoveResponse) GetHeaders() *v1.ProposalResponseHeaders {
+func (m *SetBoundsResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type ClearRequest struct {
-	Headers     *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*ClearInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+type GetRequest struct {
+	Headers   *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*GetInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *ClearRequest) Reset()         { *m = ClearRequest{} }
-func (m *ClearRequest) String() string { return proto.CompactTextString(m) }
-func (*ClearRequest) ProtoMessage()    {}
-func (*ClearRequest) Descriptor() ([]byte, []int) {
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{16}
 }
-func (m *ClearRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -779,42 +779,42 @@ func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ClearRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearRequest.Merge(m, src)
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
 }
-func (m *ClearRequest) XXX_Size() int {
+func (m *GetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearRequest.DiscardUnknown(m)
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
 
-func (m *ClearRequest) GetHeaders() *v1.ProposalRequestHeaders {
+func (m *GetRequest) GetHeaders() *v1.QueryRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type ClearResponse struct {
-	Headers      *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*ClearOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+type GetResponse struct {
+	Headers    *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*GetOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *ClearResponse) Reset()         { *m = ClearResponse{} }
-func (m *ClearResponse) String() string { return proto.CompactTextString(m) }
-func (*ClearResponse) ProtoMessage()    {}
-func (*ClearResponse) Descriptor() ([]byte, []int) {
+func (m *GetResponse) Reset()         { *m = GetResponse{} }
+func (m *GetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()    {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{17}
 }
-func (m *ClearResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -824,42 +824,42 @@ func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ClearResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearResponse.Merge(m, src)
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
 }
-func (m *ClearResponse) XXX_Size() int {
+func (m *GetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearResponse.DiscardUnknown(m)
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
 
-func (m *ClearResponse) GetHeaders() *v1.ProposalResponseHeaders {
+func (m *GetResponse) GetHeaders() *v1.QueryResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type LockRequest struct {
-	Headers    *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*LockInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+type TopKRequest struct {
+	Headers    *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*TopKInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *LockRequest) Reset()         { *m = LockRequest{} }
-func (m *LockRequest) String() string { return proto.CompactTextString(m) }
-func (*LockRequest) ProtoMessage()    {}
-func (*LockRequest) Descriptor() ([]byte, []int) {
+func (m *TopKRequest) Reset()         { *m = TopKRequest{} }
+func (m *TopKRequest) String() string { return proto.CompactTextString(m) }
+func (*TopKRequest) ProtoMessage()    {}
+func (*TopKRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{18}
 }
-func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+func (m *TopKRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopKRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopKRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -869,42 +869,42 @@ func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockRequest.Merge(m, src)
+func (m *TopKRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopKRequest.Merge(m, src)
 }
-func (m *LockRequest) XXX_Size() int {
+func (m *TopKRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockRequest.DiscardUnknown(m)
+func (m *TopKRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopKRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+var xxx_messageInfo_TopKRequest proto.InternalMessageInfo
 
-func (m *LockRequest) GetHeaders() *v1.ProposalRequestHeaders {
+func (m *TopKRequest) GetHeaders() *v1.QueryRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type LockResponse struct {
-	Headers     *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*LockOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+type TopKResponse struct {
+	Headers     *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*TopKOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *LockResponse) Reset()         { *m = LockResponse{} }
-func (m *LockResponse) String() string { return proto.CompactTextString(m) }
-func (*LockResponse) ProtoMessage()    {}
-func (*LockResponse) Descriptor() ([]byte, []int) {
+func (m *TopKResponse) Reset()         { *m = TopKResponse{} }
+func (m *TopKResponse) String() string { return proto.CompactTextString(m) }
+func (*TopKResponse) ProtoMessage()    {}
+func (*TopKResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{19}
 }
-func (m *LockResponse) XXX_Unmarshal(b []byte) error {
+func (m *TopKResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopKResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopKResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -914,42 +914,42 @@ func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockResponse.Merge(m, src)
+func (m *TopKResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopKResponse.Merge(m, src)
 }
-func (m *LockResponse) XXX_Size() int {
+func (m *TopKResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockResponse.DiscardUnknown(m)
+func (m *TopKResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopKResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockResponse proto.InternalMessageInfo
+var xxx_messageInfo_TopKResponse proto.InternalMessageInfo
 
-func (m *LockResponse) GetHeaders() *v1.ProposalResponseHeaders {
+func (m *TopKResponse) GetHeaders() *v1.QueryResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type UnlockRequest struct {
-	Headers      *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*UnlockInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+type BottomKRequest struct {
+	Headers       *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*BottomKInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
-func (m *UnlockRequest) String() string { return proto.CompactTextString(m) }
-func (*UnlockRequest) ProtoMessage()    {}
-func (*UnlockRequest) Descriptor() ([]byte, []int) {
+func (m *BottomKRequest) Reset()         { *m = BottomKRequest{} }
+func (m *BottomKRequest) String() string { return proto.CompactTextString(m) }
+func (*BottomKRequest) ProtoMessage()    {}
+func (*BottomKRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{20}
 }
-func (m *UnlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *BottomKRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BottomKRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BottomKRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -959,42 +959,42 @@ func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UnlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockRequest.Merge(m, src)
+func (m *BottomKRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BottomKRequest.Merge(m, src)
 }
-func (m *UnlockRequest) XXX_Size() int {
+func (m *BottomKRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockRequest.DiscardUnknown(m)
+func (m *BottomKRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BottomKRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_BottomKRequest proto.InternalMessageInfo
 
-func (m *UnlockRequest) GetHeaders() *v1.ProposalRequestHeaders {
+func (m *BottomKRequest) GetHeaders() *v1.QueryRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type UnlockResponse struct {
-	Headers       *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*UnlockOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+type BottomKResponse struct {
+	Headers        *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*BottomKOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *UnlockResponse) Reset()         { *m = UnlockResponse{} }
-func (m *UnlockResponse) String() string { return proto.CompactTextString(m) }
-func (*UnlockResponse) ProtoMessage()    {}
-func (*UnlockResponse) Descriptor() ([]byte, []int) {
+func (m *BottomKResponse) Reset()         { *m = BottomKResponse{} }
+func (m *BottomKResponse) String() string { return proto.CompactTextString(m) }
+func (*BottomKResponse) ProtoMessage()    {}
+func (*BottomKResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{21}
 }
-func (m *UnlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *BottomKResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BottomKResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BottomKResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1004,42 +1004,42 @@ func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *UnlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockResponse.Merge(m, src)
+func (m *BottomKResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BottomKResponse.Merge(m, src)
 }
-func (m *UnlockResponse) XXX_Size() int {
+func (m *BottomKResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockResponse.DiscardUnknown(m)
+func (m *BottomKResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BottomKResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_BottomKResponse proto.InternalMessageInfo
 
-func (m *UnlockResponse) GetHeaders() *v1.ProposalResponseHeaders {
+func (m *BottomKResponse) GetHeaders() *v1.QueryResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type EntriesRequest struct {
-	Headers       *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EntriesInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+type RangeByValueRequest struct {
+	Headers            *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*RangeByValueInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
-func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
-func (*EntriesRequest) ProtoMessage()    {}
-func (*EntriesRequest) Descriptor() ([]byte, []int) {
+func (m *RangeByValueRequest) Reset()         { *m = RangeByValueRequest{} }
+func (m *RangeByValueRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeByValueRequest) ProtoMessage()    {}
+func (*RangeByValueRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{22}
 }
-func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
+func (m *RangeByValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RangeByValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RangeByValueRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1049,42 +1049,42 @@ func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EntriesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesRequest.Merge(m, src)
+func (m *RangeByValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeByValueRequest.Merge(m, src)
 }
-func (m *EntriesRequest) XXX_Size() int {
+func (m *RangeByValueRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
+func (m *RangeByValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeByValueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
+var xxx_messageInfo_RangeByValueRequest proto.InternalMessageInfo
 
-func (m *EntriesRequest) GetHeaders() *v1.QueryRequestHeaders {
+func (m *RangeByValueRequest) GetHeaders() *v1.QueryRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type EntriesResponse struct {
-	Headers        *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EntriesOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+type RangeByValueResponse struct {
+	Headers             *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*RangeByValueOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
-func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
-func (*EntriesResponse) ProtoMessage()    {}
-func (*EntriesResponse) Descriptor() ([]byte, []int) {
+func (m *RangeByValueResponse) Reset()         { *m = RangeByValueResponse{} }
+func (m *RangeByValueResponse) String() string { return proto.CompactTextString(m) }
+func (*RangeByValueResponse) ProtoMessage()    {}
+func (*RangeByValueResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{23}
 }
-func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
+func (m *RangeByValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RangeByValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RangeByValueResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1094,42 +1094,42 @@ func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EntriesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesResponse.Merge(m, src)
+func (m *RangeByValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeByValueResponse.Merge(m, src)
 }
-func (m *EntriesResponse) XXX_Size() int {
+func (m *RangeByValueResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
+func (m *RangeByValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeByValueResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
+var xxx_messageInfo_RangeByValueResponse proto.InternalMessageInfo
 
-func (m *EntriesResponse) GetHeaders() *v1.QueryResponseHeaders {
+func (m *RangeByValueResponse) GetHeaders() *v1.QueryResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type EventsRequest struct {
+type RemoveRequest struct {
 	Headers      *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EventsInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+	*RemoveInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
-func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
-func (*EventsRequest) ProtoMessage()    {}
-func (*EventsRequest) Descriptor() ([]byte, []int) {
+func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
+func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRequest) ProtoMessage()    {}
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{24}
 }
-func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1139,42 +1139,42 @@ func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsRequest.Merge(m, src)
+func (m *RemoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRequest.Merge(m, src)
 }
-func (m *EventsRequest) XXX_Size() int {
+func (m *RemoveRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+func (m *RemoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
 
-func (m *EventsRequest) GetHeaders() *v1.ProposalRequestHeaders {
+func (m *RemoveRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type EventsResponse struct {
+type RemoveResponse struct {
 	Headers       *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EventsOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+	*RemoveOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
-func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()    {}
-func (*EventsResponse) Descriptor() ([]byte, []int) {
+func (m *RemoveResponse) Reset()         { *m = RemoveResponse{} }
+func (m *RemoveResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveResponse) ProtoMessage()    {}
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{25}
 }
-func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+func (m *RemoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1184,42 +1184,42 @@ func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsResponse.Merge(m, src)
+func (m *RemoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveResponse.Merge(m, src)
 }
-func (m *EventsResponse) XXX_Size() int {
+func (m *RemoveResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+func (m *RemoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_RemoveResponse proto.InternalMessageInfo
 
-func (m *EventsResponse) GetHeaders() *v1.ProposalResponseHeaders {
+func (m *RemoveResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type CounterMapListener struct {
-	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
-	Key   string                                              `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+type ClearRequest struct {
+	Headers     *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ClearInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *CounterMapListener) Reset()         { *m = CounterMapListener{} }
-func (m *CounterMapListener) String() string { return proto.CompactTextString(m) }
-func (*CounterMapListener) ProtoMessage()    {}
-func (*CounterMapListener) Descriptor() ([]byte, []int) {
+func (m *ClearRequest) Reset()         { *m = ClearRequest{} }
+func (m *ClearRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearRequest) ProtoMessage()    {}
+func (*ClearRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{26}
 }
-func (m *CounterMapListener) XXX_Unmarshal(b []byte) error {
+func (m *ClearRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CounterMapListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CounterMapListener.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1229,62 +1229,42 @@ func (m *CounterMapListener) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CounterMapListener) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CounterMapListener.Merge(m, src)
+func (m *ClearRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearRequest.Merge(m, src)
 }
-func (m *CounterMapListener) XXX_Size() int {
+func (m *ClearRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CounterMapListener) XXX_DiscardUnknown() {
-	xxx_messageInfo_CounterMapListener.DiscardUnknown(m)
+func (m *ClearRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CounterMapListener proto.InternalMessageInfo
-
-func (m *CounterMapListener) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.Index
-	}
-	return 0
-}
+var xxx_messageInfo_ClearRequest proto.InternalMessageInfo
 
-func (m *CounterMapListener) GetKey() string {
+func (m *ClearRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
-		return m.Key
+		return m.Headers
 	}
-	return ""
+	return nil
 }
 
-type CounterMapInput struct {
-	// Types that are valid to be assigned to Input:
-	//	*CounterMapInput_Size_
-	//	*CounterMapInput_Set
-	//	*CounterMapInput_Insert
-	//	*CounterMapInput_Update
-	//	*CounterMapInput_Increment
-	//	*CounterMapInput_Decrement
-	//	*CounterMapInput_Get
-	//	*CounterMapInput_Remove
-	//	*CounterMapInput_Clear
-	//	*CounterMapInput_Lock
-	//	*CounterMapInput_Unlock
-	//	*CounterMapInput_Entries
-	//	*CounterMapInput_Events
-	Input isCounterMapInput_Input `protobuf_oneof:"input"`
+type ClearResponse struct {
+	Headers      *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ClearOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *CounterMapInput) Reset()         { *m = CounterMapInput{} }
-func (m *CounterMapInput) String() string { return proto.CompactTextString(m) }
-func (*CounterMapInput) ProtoMessage()    {}
-func (*CounterMapInput) Descriptor() ([]byte, []int) {
+func (m *ClearResponse) Reset()         { *m = ClearResponse{} }
+func (m *ClearResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearResponse) ProtoMessage()    {}
+func (*ClearResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{27}
 }
-func (m *CounterMapInput) XXX_Unmarshal(b []byte) error {
+func (m *ClearResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CounterMapInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CounterMapInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1294,225 +1274,267 @@ func (m *CounterMapInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *CounterMapInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CounterMapInput.Merge(m, src)
+func (m *ClearResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearResponse.Merge(m, src)
 }
-func (m *CounterMapInput) XXX_Size() int {
+func (m *ClearResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CounterMapInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_CounterMapInput.DiscardUnknown(m)
+func (m *ClearResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CounterMapInput proto.InternalMessageInfo
+var xxx_messageInfo_ClearResponse proto.InternalMessageInfo
 
-type isCounterMapInput_Input interface {
-	isCounterMapInput_Input()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *ClearResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
 
-type CounterMapInput_Size_ struct {
-	Size_ *SizeInput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
+type LockRequest struct {
+	Headers    *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*LockInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
-type CounterMapInput_Set struct {
-	Set *SetInput `protobuf:"bytes,2,opt,name=set,proto3,oneof" json:"set,omitempty"`
+
+func (m *LockRequest) Reset()         { *m = LockRequest{} }
+func (m *LockRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()    {}
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{28}
 }
-type CounterMapInput_Insert struct {
-	Insert *InsertInput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
+func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type CounterMapInput_Update struct {
-	Update *UpdateInput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
+func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type CounterMapInput_Increment struct {
-	Increment *IncrementInput `protobuf:"bytes,5,opt,name=increment,proto3,oneof" json:"increment,omitempty"`
+func (m *LockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRequest.Merge(m, src)
 }
-type CounterMapInput_Decrement struct {
-	Decrement *DecrementInput `protobuf:"bytes,6,opt,name=decrement,proto3,oneof" json:"decrement,omitempty"`
+func (m *LockRequest) XXX_Size() int {
+	return m.Size()
 }
-type CounterMapInput_Get struct {
-	Get *GetInput `protobuf:"bytes,7,opt,name=get,proto3,oneof" json:"get,omitempty"`
+func (m *LockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRequest.DiscardUnknown(m)
 }
-type CounterMapInput_Remove struct {
-	Remove *RemoveInput `protobuf:"bytes,8,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+
+var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+
+func (m *LockRequest) GetHeaders() *v1.ProposalRequestHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
-type CounterMapInput_Clear struct {
-	Clear *ClearInput `protobuf:"bytes,9,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
+
+type LockResponse struct {
+	Headers     *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*LockOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
-type CounterMapInput_Lock struct {
-	Lock *LockInput `protobuf:"bytes,10,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
+
+func (m *LockResponse) Reset()         { *m = LockResponse{} }
+func (m *LockResponse) String() string { return proto.CompactTextString(m) }
+func (*LockResponse) ProtoMessage()    {}
+func (*LockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{29}
 }
-type CounterMapInput_Unlock struct {
-	Unlock *UnlockInput `protobuf:"bytes,11,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
+func (m *LockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type CounterMapInput_Entries struct {
-	Entries *EntriesInput `protobuf:"bytes,12,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+func (m *LockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type CounterMapInput_Events struct {
-	Events *EventsInput `protobuf:"bytes,13,opt,name=events,proto3,oneof" json:"events,omitempty"`
+func (m *LockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockResponse.Merge(m, src)
+}
+func (m *LockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockResponse.DiscardUnknown(m)
 }
 
-func (*CounterMapInput_Size_) isCounterMapInput_Input()     {}
-func (*CounterMapInput_Set) isCounterMapInput_Input()       {}
-func (*CounterMapInput_Insert) isCounterMapInput_Input()    {}
-func (*CounterMapInput_Update) isCounterMapInput_Input()    {}
-func (*CounterMapInput_Increment) isCounterMapInput_Input() {}
-func (*CounterMapInput_Decrement) isCounterMapInput_Input() {}
-func (*CounterMapInput_Get) isCounterMapInput_Input()       {}
-func (*CounterMapInput_Remove) isCounterMapInput_Input()    {}
-func (*CounterMapInput_Clear) isCounterMapInput_Input()     {}
-func (*CounterMapInput_Lock) isCounterMapInput_Input()      {}
-func (*CounterMapInput_Unlock) isCounterMapInput_Input()    {}
-func (*CounterMapInput_Entries) isCounterMapInput_Input()   {}
-func (*CounterMapInput_Events) isCounterMapInput_Input()    {}
+var xxx_messageInfo_LockResponse proto.InternalMessageInfo
 
-func (m *CounterMapInput) GetInput() isCounterMapInput_Input {
+func (m *LockResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	if m != nil {
-		return m.Input
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *CounterMapInput) GetSize_() *SizeInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Size_); ok {
-		return x.Size_
-	}
-	return nil
+type UnlockRequest struct {
+	Headers      *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*UnlockInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *CounterMapInput) GetSet() *SetInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Set); ok {
-		return x.Set
-	}
-	return nil
+func (m *UnlockRequest) Reset()         { *m = UnlockRequest{} }
+func (m *UnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockRequest) ProtoMessage()    {}
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{30}
 }
-
-func (m *CounterMapInput) GetInsert() *InsertInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Insert); ok {
-		return x.Insert
-	}
-	return nil
+func (m *UnlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *CounterMapInput) GetUpdate() *UpdateInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Update); ok {
-		return x.Update
+func (m *UnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *CounterMapInput) GetIncrement() *IncrementInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Increment); ok {
-		return x.Increment
-	}
-	return nil
+func (m *UnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockRequest.Merge(m, src)
 }
-
-func (m *CounterMapInput) GetDecrement() *DecrementInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Decrement); ok {
-		return x.Decrement
-	}
-	return nil
+func (m *UnlockRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *CounterMapInput) GetGet() *GetInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Get); ok {
-		return x.Get
-	}
-	return nil
+func (m *UnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockRequest.DiscardUnknown(m)
 }
 
-func (m *CounterMapInput) GetRemove() *RemoveInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Remove); ok {
-		return x.Remove
+var xxx_messageInfo_UnlockRequest proto.InternalMessageInfo
+
+func (m *UnlockRequest) GetHeaders() *v1.ProposalRequestHeaders {
+	if m != nil {
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *CounterMapInput) GetClear() *ClearInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Clear); ok {
-		return x.Clear
-	}
-	return nil
+type UnlockResponse struct {
+	Headers       *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*UnlockOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *CounterMapInput) GetLock() *LockInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Lock); ok {
-		return x.Lock
+func (m *UnlockResponse) Reset()         { *m = UnlockResponse{} }
+func (m *UnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockResponse) ProtoMessage()    {}
+func (*UnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{31}
+}
+func (m *UnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *UnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockResponse.Merge(m, src)
+}
+func (m *UnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockResponse.DiscardUnknown(m)
 }
 
-func (m *CounterMapInput) GetUnlock() *UnlockInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Unlock); ok {
-		return x.Unlock
+var xxx_messageInfo_UnlockResponse proto.InternalMessageInfo
+
+func (m *UnlockResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *CounterMapInput) GetEntries() *EntriesInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Entries); ok {
-		return x.Entries
-	}
-	return nil
+type EntriesRequest struct {
+	Headers       *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EntriesInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *CounterMapInput) GetEvents() *EventsInput {
-	if x, ok := m.GetInput().(*CounterMapInput_Events); ok {
-		return x.Events
+func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
+func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*EntriesRequest) ProtoMessage()    {}
+func (*EntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{32}
+}
+func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *EntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesRequest.Merge(m, src)
+}
+func (m *EntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CounterMapInput) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CounterMapInput_Size_)(nil),
-		(*CounterMapInput_Set)(nil),
-		(*CounterMapInput_Insert)(nil),
-		(*CounterMapInput_Update)(nil),
-		(*CounterMapInput_Increment)(nil),
-		(*CounterMapInput_Decrement)(nil),
-		(*CounterMapInput_Get)(nil),
-		(*CounterMapInput_Remove)(nil),
-		(*CounterMapInput_Clear)(nil),
-		(*CounterMapInput_Lock)(nil),
-		(*CounterMapInput_Unlock)(nil),
-		(*CounterMapInput_Entries)(nil),
-		(*CounterMapInput_Events)(nil),
+var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
+
+func (m *EntriesRequest) GetHeaders() *v1.QueryRequestHeaders {
+	if m != nil {
+		return m.Headers
 	}
+	return nil
 }
 
-type CounterMapOutput struct {
-	// Types that are valid to be assigned to Output:
-	//	*CounterMapOutput_Size_
-	//	*CounterMapOutput_Set
-	//	*CounterMapOutput_Insert
-	//	*CounterMapOutput_Update
-	//	*CounterMapOutput_Increment
-	//	*CounterMapOutput_Decrement
-	//	*CounterMapOutput_Get
-	//	*CounterMapOutput_Remove
-	//	*CounterMapOutput_Clear
-	//	*CounterMapOutput_Lock
-	//	*CounterMapOutput_Unlock
-	//	*CounterMapOutput_Entries
-	//	*CounterMapOutput_Events
-	Output isCounterMapOutput_Output `protobuf_oneof:"output"`
+type EntriesResponse struct {
+	Headers        *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EntriesOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *CounterMapOutput) Reset()         { *m = CounterMapOutput{} }
-func (m *CounterMapOutput) String() string { return proto.CompactTextString(m) }
-func (*CounterMapOutput) ProtoMessage()    {}
-func (*CounterMapOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{28}
+func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
+func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*EntriesResponse) ProtoMessage()    {}
+func (*EntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{33}
 }
-func (m *CounterMapOutput) XXX_Unmarshal(b []byte) error {
+func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CounterMapOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CounterMapOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1522,210 +1544,132 @@ func (m *CounterMapOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *CounterMapOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CounterMapOutput.Merge(m, src)
+func (m *EntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesResponse.Merge(m, src)
 }
-func (m *CounterMapOutput) XXX_Size() int {
+func (m *EntriesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CounterMapOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_CounterMapOutput.DiscardUnknown(m)
+func (m *EntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CounterMapOutput proto.InternalMessageInfo
+var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
 
-type isCounterMapOutput_Output interface {
-	isCounterMapOutput_Output()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *EntriesResponse) GetHeaders() *v1.QueryResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
 
-type CounterMapOutput_Size_ struct {
-	Size_ *SizeOutput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
+type EventsRequest struct {
+	Headers      *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EventsInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
-type CounterMapOutput_Set struct {
-	Set *SetOutput `protobuf:"bytes,2,opt,name=set,proto3,oneof" json:"set,omitempty"`
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{34}
 }
-type CounterMapOutput_Insert struct {
-	Insert *InsertOutput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type CounterMapOutput_Update struct {
-	Update *UpdateOutput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
-}
-type CounterMapOutput_Increment struct {
-	Increment *IncrementOutput `protobuf:"bytes,5,opt,name=increment,proto3,oneof" json:"increment,omitempty"`
-}
-type CounterMapOutput_Decrement struct {
-	Decrement *DecrementOutput `protobuf:"bytes,6,opt,name=decrement,proto3,oneof" json:"decrement,omitempty"`
-}
-type CounterMapOutput_Get struct {
-	Get *GetOutput `protobuf:"bytes,7,opt,name=get,proto3,oneof" json:"get,omitempty"`
-}
-type CounterMapOutput_Remove struct {
-	Remove *RemoveOutput `protobuf:"bytes,8,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
-}
-type CounterMapOutput_Clear struct {
-	Clear *ClearOutput `protobuf:"bytes,9,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
-}
-type CounterMapOutput_Lock struct {
-	Lock *LockOutput `protobuf:"bytes,10,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type CounterMapOutput_Unlock struct {
-	Unlock *UnlockOutput `protobuf:"bytes,11,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
 }
-type CounterMapOutput_Entries struct {
-	Entries *EntriesOutput `protobuf:"bytes,12,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+func (m *EventsRequest) XXX_Size() int {
+	return m.Size()
 }
-type CounterMapOutput_Events struct {
-	Events *EventsOutput `protobuf:"bytes,13,opt,name=events,proto3,oneof" json:"events,omitempty"`
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
 }
 
-func (*CounterMapOutput_Size_) isCounterMapOutput_Output()     {}
-func (*CounterMapOutput_Set) isCounterMapOutput_Output()       {}
-func (*CounterMapOutput_Insert) isCounterMapOutput_Output()    {}
-func (*CounterMapOutput_Update) isCounterMapOutput_Output()    {}
-func (*CounterMapOutput_Increment) isCounterMapOutput_Output() {}
-func (*CounterMapOutput_Decrement) isCounterMapOutput_Output() {}
-func (*CounterMapOutput_Get) isCounterMapOutput_Output()       {}
-func (*CounterMapOutput_Remove) isCounterMapOutput_Output()    {}
-func (*CounterMapOutput_Clear) isCounterMapOutput_Output()     {}
-func (*CounterMapOutput_Lock) isCounterMapOutput_Output()      {}
-func (*CounterMapOutput_Unlock) isCounterMapOutput_Output()    {}
-func (*CounterMapOutput_Entries) isCounterMapOutput_Output()   {}
-func (*CounterMapOutput_Events) isCounterMapOutput_Output()    {}
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
 
-func (m *CounterMapOutput) GetOutput() isCounterMapOutput_Output {
+func (m *EventsRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
-		return m.Output
-	}
-	return nil
-}
-
-func (m *CounterMapOutput) GetSize_() *SizeOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Size_); ok {
-		return x.Size_
-	}
-	return nil
-}
-
-func (m *CounterMapOutput) GetSet() *SetOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Set); ok {
-		return x.Set
-	}
-	return nil
-}
-
-func (m *CounterMapOutput) GetInsert() *InsertOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Insert); ok {
-		return x.Insert
-	}
-	return nil
-}
-
-func (m *CounterMapOutput) GetUpdate() *UpdateOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Update); ok {
-		return x.Update
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *CounterMapOutput) GetIncrement() *IncrementOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Increment); ok {
-		return x.Increment
-	}
-	return nil
+type EventsResponse struct {
+	Headers       *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EventsOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *CounterMapOutput) GetDecrement() *DecrementOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Decrement); ok {
-		return x.Decrement
-	}
-	return nil
+func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
+func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()    {}
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{35}
 }
-
-func (m *CounterMapOutput) GetGet() *GetOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Get); ok {
-		return x.Get
-	}
-	return nil
+func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *CounterMapOutput) GetRemove() *RemoveOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Remove); ok {
-		return x.Remove
+func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *CounterMapOutput) GetClear() *ClearOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Clear); ok {
-		return x.Clear
-	}
-	return nil
+func (m *EventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsResponse.Merge(m, src)
 }
-
-func (m *CounterMapOutput) GetLock() *LockOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Lock); ok {
-		return x.Lock
-	}
-	return nil
+func (m *EventsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *CounterMapOutput) GetUnlock() *UnlockOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Unlock); ok {
-		return x.Unlock
-	}
-	return nil
+func (m *EventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
 }
 
-func (m *CounterMapOutput) GetEntries() *EntriesOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Entries); ok {
-		return x.Entries
-	}
-	return nil
-}
+var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
 
-func (m *CounterMapOutput) GetEvents() *EventsOutput {
-	if x, ok := m.GetOutput().(*CounterMapOutput_Events); ok {
-		return x.Events
+func (m *EventsResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CounterMapOutput) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CounterMapOutput_Size_)(nil),
-		(*CounterMapOutput_Set)(nil),
-		(*CounterMapOutput_Insert)(nil),
-		(*CounterMapOutput_Update)(nil),
-		(*CounterMapOutput_Increment)(nil),
-		(*CounterMapOutput_Decrement)(nil),
-		(*CounterMapOutput_Get)(nil),
-		(*CounterMapOutput_Remove)(nil),
-		(*CounterMapOutput_Clear)(nil),
-		(*CounterMapOutput_Lock)(nil),
-		(*CounterMapOutput_Unlock)(nil),
-		(*CounterMapOutput_Entries)(nil),
-		(*CounterMapOutput_Events)(nil),
-	}
-}
-
-type SizeInput struct {
+type CounterMapListener struct {
+	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	Key   string                                              `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *SizeInput) Reset()         { *m = SizeInput{} }
-func (m *SizeInput) String() string { return proto.CompactTextString(m) }
-func (*SizeInput) ProtoMessage()    {}
-func (*SizeInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{29}
+func (m *CounterMapListener) Reset()         { *m = CounterMapListener{} }
+func (m *CounterMapListener) String() string { return proto.CompactTextString(m) }
+func (*CounterMapListener) ProtoMessage()    {}
+func (*CounterMapListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{36}
 }
-func (m *SizeInput) XXX_Unmarshal(b []byte) error {
+func (m *CounterMapListener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SizeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CounterMapListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SizeInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CounterMapListener.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1735,34 +1679,67 @@ func (m *SizeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SizeInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SizeInput.Merge(m, src)
+func (m *CounterMapListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterMapListener.Merge(m, src)
 }
-func (m *SizeInput) XXX_Size() int {
+func (m *CounterMapListener) XXX_Size() int {
 	return m.Size()
 }
-func (m *SizeInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_SizeInput.DiscardUnknown(m)
+func (m *CounterMapListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterMapListener.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SizeInput proto.InternalMessageInfo
+var xxx_messageInfo_CounterMapListener proto.InternalMessageInfo
 
-type SizeOutput struct {
-	Size_ uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+func (m *CounterMapListener) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *SizeOutput) Reset()         { *m = SizeOutput{} }
-func (m *SizeOutput) String() string { return proto.CompactTextString(m) }
-func (*SizeOutput) ProtoMessage()    {}
-func (*SizeOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{30}
+func (m *CounterMapListener) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
-func (m *SizeOutput) XXX_Unmarshal(b []byte) error {
+
+type CounterMapInput struct {
+	// Types that are valid to be assigned to Input:
+	//	*CounterMapInput_Size_
+	//	*CounterMapInput_Set
+	//	*CounterMapInput_Insert
+	//	*CounterMapInput_Update
+	//	*CounterMapInput_Increment
+	//	*CounterMapInput_Decrement
+	//	*CounterMapInput_Get
+	//	*CounterMapInput_Remove
+	//	*CounterMapInput_Clear
+	//	*CounterMapInput_Lock
+	//	*CounterMapInput_Unlock
+	//	*CounterMapInput_Entries
+	//	*CounterMapInput_Events
+	//	*CounterMapInput_Configure
+	//	*CounterMapInput_SetBounds
+	//	*CounterMapInput_TopK
+	//	*CounterMapInput_BottomK
+	//	*CounterMapInput_RangeByValue
+	Input isCounterMapInput_Input `protobuf_oneof:"input"`
+}
+
+func (m *CounterMapInput) Reset()         { *m = CounterMapInput{} }
+func (m *CounterMapInput) String() string { return proto.CompactTextString(m) }
+func (*CounterMapInput) ProtoMessage()    {}
+func (*CounterMapInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{37}
+}
+func (m *CounterMapInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SizeOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CounterMapInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SizeOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CounterMapInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1772,227 +1749,290 @@ func (m *SizeOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SizeOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SizeOutput.Merge(m, src)
+func (m *CounterMapInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterMapInput.Merge(m, src)
 }
-func (m *SizeOutput) XXX_Size() int {
+func (m *CounterMapInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *SizeOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_SizeOutput.DiscardUnknown(m)
+func (m *CounterMapInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterMapInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SizeOutput proto.InternalMessageInfo
+var xxx_messageInfo_CounterMapInput proto.InternalMessageInfo
 
-func (m *SizeOutput) GetSize_() uint32 {
-	if m != nil {
-		return m.Size_
-	}
-	return 0
+type isCounterMapInput_Input interface {
+	isCounterMapInput_Input()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type SetInput struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+type CounterMapInput_Size_ struct {
+	Size_ *SizeInput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
 }
-
-func (m *SetInput) Reset()         { *m = SetInput{} }
-func (m *SetInput) String() string { return proto.CompactTextString(m) }
-func (*SetInput) ProtoMessage()    {}
-func (*SetInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{31}
+type CounterMapInput_Set struct {
+	Set *SetInput `protobuf:"bytes,2,opt,name=set,proto3,oneof" json:"set,omitempty"`
 }
-func (m *SetInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type CounterMapInput_Insert struct {
+	Insert *InsertInput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
 }
-func (m *SetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SetInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+type CounterMapInput_Update struct {
+	Update *UpdateInput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
 }
-func (m *SetInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetInput.Merge(m, src)
+type CounterMapInput_Increment struct {
+	Increment *IncrementInput `protobuf:"bytes,5,opt,name=increment,proto3,oneof" json:"increment,omitempty"`
 }
-func (m *SetInput) XXX_Size() int {
-	return m.Size()
+type CounterMapInput_Decrement struct {
+	Decrement *DecrementInput `protobuf:"bytes,6,opt,name=decrement,proto3,oneof" json:"decrement,omitempty"`
 }
-func (m *SetInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetInput.DiscardUnknown(m)
+type CounterMapInput_Get struct {
+	Get *GetInput `protobuf:"bytes,7,opt,name=get,proto3,oneof" json:"get,omitempty"`
 }
+type CounterMapInput_Remove struct {
+	Remove *RemoveInput `protobuf:"bytes,8,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+}
+type CounterMapInput_Clear struct {
+	Clear *ClearInput `protobuf:"bytes,9,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
+}
+type CounterMapInput_Lock struct {
+	Lock *LockInput `protobuf:"bytes,10,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
+}
+type CounterMapInput_Unlock struct {
+	Unlock *UnlockInput `protobuf:"bytes,11,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
+}
+type CounterMapInput_Entries struct {
+	Entries *EntriesInput `protobuf:"bytes,12,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+}
+type CounterMapInput_Events struct {
+	Events *EventsInput `protobuf:"bytes,13,opt,name=events,proto3,oneof" json:"events,omitempty"`
+}
+type CounterMapInput_Configure struct {
+	Configure *ConfigureInput `protobuf:"bytes,14,opt,name=configure,proto3,oneof" json:"configure,omitempty"`
+}
+type CounterMapInput_SetBounds struct {
+	SetBounds *SetBoundsInput `protobuf:"bytes,15,opt,name=set_bounds,json=setBounds,proto3,oneof" json:"set_bounds,omitempty"`
+}
+type CounterMapInput_TopK struct {
+	TopK *TopKInput `protobuf:"bytes,16,opt,name=top_k,json=topK,proto3,oneof" json:"top_k,omitempty"`
+}
+type CounterMapInput_BottomK struct {
+	BottomK *BottomKInput `protobuf:"bytes,17,opt,name=bottom_k,json=bottomK,proto3,oneof" json:"bottom_k,omitempty"`
+}
+type CounterMapInput_RangeByValue struct {
+	RangeByValue *RangeByValueInput `protobuf:"bytes,18,opt,name=range_by_value,json=rangeByValue,proto3,oneof" json:"range_by_value,omitempty"`
+}
+
+func (*CounterMapInput_Size_) isCounterMapInput_Input()        {}
+func (*CounterMapInput_Set) isCounterMapInput_Input()          {}
+func (*CounterMapInput_Insert) isCounterMapInput_Input()       {}
+func (*CounterMapInput_Update) isCounterMapInput_Input()       {}
+func (*CounterMapInput_Increment) isCounterMapInput_Input()    {}
+func (*CounterMapInput_Decrement) isCounterMapInput_Input()    {}
+func (*CounterMapInput_Get) isCounterMapInput_Input()          {}
+func (*CounterMapInput_Remove) isCounterMapInput_Input()       {}
+func (*CounterMapInput_Clear) isCounterMapInput_Input()        {}
+func (*CounterMapInput_Lock) isCounterMapInput_Input()         {}
+func (*CounterMapInput_Unlock) isCounterMapInput_Input()       {}
+func (*CounterMapInput_Entries) isCounterMapInput_Input()      {}
+func (*CounterMapInput_Events) isCounterMapInput_Input()       {}
+func (*CounterMapInput_Configure) isCounterMapInput_Input()    {}
+func (*CounterMapInput_SetBounds) isCounterMapInput_Input()    {}
+func (*CounterMapInput_TopK) isCounterMapInput_Input()         {}
+func (*CounterMapInput_BottomK) isCounterMapInput_Input()      {}
+func (*CounterMapInput_RangeByValue) isCounterMapInput_Input() {}
 
-var xxx_messageInfo_SetInput proto.InternalMessageInfo
-
-func (m *SetInput) GetKey() string {
+func (m *CounterMapInput) GetInput() isCounterMapInput_Input {
 	if m != nil {
-		return m.Key
+		return m.Input
 	}
-	return ""
+	return nil
 }
 
-func (m *SetInput) GetValue() int64 {
-	if m != nil {
-		return m.Value
+func (m *CounterMapInput) GetSize_() *SizeInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Size_); ok {
+		return x.Size_
 	}
-	return 0
+	return nil
 }
 
-type SetOutput struct {
-	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+func (m *CounterMapInput) GetSet() *SetInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Set); ok {
+		return x.Set
+	}
+	return nil
 }
 
-func (m *SetOutput) Reset()         { *m = SetOutput{} }
-func (m *SetOutput) String() string { return proto.CompactTextString(m) }
-func (*SetOutput) ProtoMessage()    {}
-func (*SetOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{32}
-}
-func (m *SetOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SetOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SetOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *CounterMapInput) GetInsert() *InsertInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Insert); ok {
+		return x.Insert
 	}
-}
-func (m *SetOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetOutput.Merge(m, src)
-}
-func (m *SetOutput) XXX_Size() int {
-	return m.Size()
-}
-func (m *SetOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetOutput.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_SetOutput proto.InternalMessageInfo
-
-func (m *SetOutput) GetPrevValue() int64 {
-	if m != nil {
-		return m.PrevValue
+func (m *CounterMapInput) GetUpdate() *UpdateInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Update); ok {
+		return x.Update
 	}
-	return 0
+	return nil
 }
 
-type InsertInput struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *CounterMapInput) GetIncrement() *IncrementInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Increment); ok {
+		return x.Increment
+	}
+	return nil
 }
 
-func (m *InsertInput) Reset()         { *m = InsertInput{} }
-func (m *InsertInput) String() string { return proto.CompactTextString(m) }
-func (*InsertInput) ProtoMessage()    {}
-func (*InsertInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{33}
+func (m *CounterMapInput) GetDecrement() *DecrementInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Decrement); ok {
+		return x.Decrement
+	}
+	return nil
 }
-func (m *InsertInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *CounterMapInput) GetGet() *GetInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Get); ok {
+		return x.Get
+	}
+	return nil
 }
-func (m *InsertInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InsertInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *CounterMapInput) GetRemove() *RemoveInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Remove); ok {
+		return x.Remove
 	}
+	return nil
 }
-func (m *InsertInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertInput.Merge(m, src)
+
+func (m *CounterMapInput) GetClear() *ClearInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Clear); ok {
+		return x.Clear
+	}
+	return nil
 }
-func (m *InsertInput) XXX_Size() int {
-	return m.Size()
+
+func (m *CounterMapInput) GetLock() *LockInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Lock); ok {
+		return x.Lock
+	}
+	return nil
 }
-func (m *InsertInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertInput.DiscardUnknown(m)
+
+func (m *CounterMapInput) GetUnlock() *UnlockInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Unlock); ok {
+		return x.Unlock
+	}
+	return nil
 }
 
-var xxx_messageInfo_InsertInput proto.InternalMessageInfo
+func (m *CounterMapInput) GetEntries() *EntriesInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Entries); ok {
+		return x.Entries
+	}
+	return nil
+}
 
-func (m *InsertInput) GetKey() string {
-	if m != nil {
-		return m.Key
+func (m *CounterMapInput) GetEvents() *EventsInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Events); ok {
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-func (m *InsertInput) GetValue() int64 {
-	if m != nil {
-		return m.Value
+func (m *CounterMapInput) GetConfigure() *ConfigureInput {
+	if x, ok := m.GetInput().(*CounterMapInput_Configure); ok {
+		return x.Configure
 	}
-	return 0
+	return nil
 }
 
-type InsertOutput struct {
+func (m *CounterMapInput) GetSetBounds() *SetBoundsInput {
+	if x, ok := m.GetInput().(*CounterMapInput_SetBounds); ok {
+		return x.SetBounds
+	}
+	return nil
 }
 
-func (m *InsertOutput) Reset()         { *m = InsertOutput{} }
-func (m *InsertOutput) String() string { return proto.CompactTextString(m) }
-func (*InsertOutput) ProtoMessage()    {}
-func (*InsertOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{34}
+func (m *CounterMapInput) GetTopK() *TopKInput {
+	if x, ok := m.GetInput().(*CounterMapInput_TopK); ok {
+		return x.TopK
+	}
+	return nil
 }
-func (m *InsertOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *CounterMapInput) GetBottomK() *BottomKInput {
+	if x, ok := m.GetInput().(*CounterMapInput_BottomK); ok {
+		return x.BottomK
+	}
+	return nil
 }
-func (m *InsertOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InsertOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *CounterMapInput) GetRangeByValue() *RangeByValueInput {
+	if x, ok := m.GetInput().(*CounterMapInput_RangeByValue); ok {
+		return x.RangeByValue
 	}
-}
-func (m *InsertOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertOutput.Merge(m, src)
-}
-func (m *InsertOutput) XXX_Size() int {
-	return m.Size()
-}
-func (m *InsertOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertOutput.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_InsertOutput proto.InternalMessageInfo
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CounterMapInput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CounterMapInput_Size_)(nil),
+		(*CounterMapInput_Set)(nil),
+		(*CounterMapInput_Insert)(nil),
+		(*CounterMapInput_Update)(nil),
+		(*CounterMapInput_Increment)(nil),
+		(*CounterMapInput_Decrement)(nil),
+		(*CounterMapInput_Get)(nil),
+		(*CounterMapInput_Remove)(nil),
+		(*CounterMapInput_Clear)(nil),
+		(*CounterMapInput_Lock)(nil),
+		(*CounterMapInput_Unlock)(nil),
+		(*CounterMapInput_Entries)(nil),
+		(*CounterMapInput_Events)(nil),
+		(*CounterMapInput_Configure)(nil),
+		(*CounterMapInput_SetBounds)(nil),
+		(*CounterMapInput_TopK)(nil),
+		(*CounterMapInput_BottomK)(nil),
+		(*CounterMapInput_RangeByValue)(nil),
+	}
+}
 
-type UpdateInput struct {
-	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value     int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	PrevValue int64  `protobuf:"varint,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type CounterMapOutput struct {
+	// Types that are valid to be assigned to Output:
+	//	*CounterMapOutput_Size_
+	//	*CounterMapOutput_Set
+	//	*CounterMapOutput_Insert
+	//	*CounterMapOutput_Update
+	//	*CounterMapOutput_Increment
+	//	*CounterMapOutput_Decrement
+	//	*CounterMapOutput_Get
+	//	*CounterMapOutput_Remove
+	//	*CounterMapOutput_Clear
+	//	*CounterMapOutput_Lock
+	//	*CounterMapOutput_Unlock
+	//	*CounterMapOutput_Entries
+	//	*CounterMapOutput_Events
+	//	*CounterMapOutput_Configure
+	//	*CounterMapOutput_SetBounds
+	//	*CounterMapOutput_TopK
+	//	*CounterMapOutput_BottomK
+	//	*CounterMapOutput_RangeByValue
+	Output isCounterMapOutput_Output `protobuf_oneof:"output"`
 }
 
-func (m *UpdateInput) Reset()         { *m = UpdateInput{} }
-func (m *UpdateInput) String() string { return proto.CompactTextString(m) }
-func (*UpdateInput) ProtoMessage()    {}
-func (*UpdateInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{35}
+func (m *CounterMapOutput) Reset()         { *m = CounterMapOutput{} }
+func (m *CounterMapOutput) String() string { return proto.CompactTextString(m) }
+func (*CounterMapOutput) ProtoMessage()    {}
+func (*CounterMapOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{38}
 }
-func (m *UpdateInput) XXX_Unmarshal(b []byte) error {
+func (m *CounterMapOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CounterMapOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CounterMapOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2002,196 +2042,270 @@ func (m *UpdateInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UpdateInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateInput.Merge(m, src)
+func (m *CounterMapOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterMapOutput.Merge(m, src)
 }
-func (m *UpdateInput) XXX_Size() int {
+func (m *CounterMapOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateInput.DiscardUnknown(m)
+func (m *CounterMapOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterMapOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateInput proto.InternalMessageInfo
+var xxx_messageInfo_CounterMapOutput proto.InternalMessageInfo
 
-func (m *UpdateInput) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+type isCounterMapOutput_Output interface {
+	isCounterMapOutput_Output()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *UpdateInput) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
+type CounterMapOutput_Size_ struct {
+	Size_ *SizeOutput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
 }
-
-func (m *UpdateInput) GetPrevValue() int64 {
-	if m != nil {
-		return m.PrevValue
-	}
-	return 0
+type CounterMapOutput_Set struct {
+	Set *SetOutput `protobuf:"bytes,2,opt,name=set,proto3,oneof" json:"set,omitempty"`
 }
-
-type UpdateOutput struct {
-	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type CounterMapOutput_Insert struct {
+	Insert *InsertOutput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
 }
-
-func (m *UpdateOutput) Reset()         { *m = UpdateOutput{} }
-func (m *UpdateOutput) String() string { return proto.CompactTextString(m) }
-func (*UpdateOutput) ProtoMessage()    {}
-func (*UpdateOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{36}
+type CounterMapOutput_Update struct {
+	Update *UpdateOutput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
 }
-func (m *UpdateOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type CounterMapOutput_Increment struct {
+	Increment *IncrementOutput `protobuf:"bytes,5,opt,name=increment,proto3,oneof" json:"increment,omitempty"`
 }
-func (m *UpdateOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UpdateOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+type CounterMapOutput_Decrement struct {
+	Decrement *DecrementOutput `protobuf:"bytes,6,opt,name=decrement,proto3,oneof" json:"decrement,omitempty"`
 }
-func (m *UpdateOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateOutput.Merge(m, src)
+type CounterMapOutput_Get struct {
+	Get *GetOutput `protobuf:"bytes,7,opt,name=get,proto3,oneof" json:"get,omitempty"`
 }
-func (m *UpdateOutput) XXX_Size() int {
-	return m.Size()
+type CounterMapOutput_Remove struct {
+	Remove *RemoveOutput `protobuf:"bytes,8,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
 }
-func (m *UpdateOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateOutput.DiscardUnknown(m)
+type CounterMapOutput_Clear struct {
+	Clear *ClearOutput `protobuf:"bytes,9,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
 }
+type CounterMapOutput_Lock struct {
+	Lock *LockOutput `protobuf:"bytes,10,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
+}
+type CounterMapOutput_Unlock struct {
+	Unlock *UnlockOutput `protobuf:"bytes,11,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
+}
+type CounterMapOutput_Entries struct {
+	Entries *EntriesOutput `protobuf:"bytes,12,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+}
+type CounterMapOutput_Events struct {
+	Events *EventsOutput `protobuf:"bytes,13,opt,name=events,proto3,oneof" json:"events,omitempty"`
+}
+type CounterMapOutput_Configure struct {
+	Configure *ConfigureOutput `protobuf:"bytes,14,opt,name=configure,proto3,oneof" json:"configure,omitempty"`
+}
+type CounterMapOutput_SetBounds struct {
+	SetBounds *SetBoundsOutput `protobuf:"bytes,15,opt,name=set_bounds,json=setBounds,proto3,oneof" json:"set_bounds,omitempty"`
+}
+type CounterMapOutput_TopK struct {
+	TopK *TopKOutput `protobuf:"bytes,16,opt,name=top_k,json=topK,proto3,oneof" json:"top_k,omitempty"`
+}
+type CounterMapOutput_BottomK struct {
+	BottomK *BottomKOutput `protobuf:"bytes,17,opt,name=bottom_k,json=bottomK,proto3,oneof" json:"bottom_k,omitempty"`
+}
+type CounterMapOutput_RangeByValue struct {
+	RangeByValue *RangeByValueOutput `protobuf:"bytes,18,opt,name=range_by_value,json=rangeByValue,proto3,oneof" json:"range_by_value,omitempty"`
+}
+
+func (*CounterMapOutput_Size_) isCounterMapOutput_Output()        {}
+func (*CounterMapOutput_Set) isCounterMapOutput_Output()          {}
+func (*CounterMapOutput_Insert) isCounterMapOutput_Output()       {}
+func (*CounterMapOutput_Update) isCounterMapOutput_Output()       {}
+func (*CounterMapOutput_Increment) isCounterMapOutput_Output()    {}
+func (*CounterMapOutput_Decrement) isCounterMapOutput_Output()    {}
+func (*CounterMapOutput_Get) isCounterMapOutput_Output()          {}
+func (*CounterMapOutput_Remove) isCounterMapOutput_Output()       {}
+func (*CounterMapOutput_Clear) isCounterMapOutput_Output()        {}
+func (*CounterMapOutput_Lock) isCounterMapOutput_Output()         {}
+func (*CounterMapOutput_Unlock) isCounterMapOutput_Output()       {}
+func (*CounterMapOutput_Entries) isCounterMapOutput_Output()      {}
+func (*CounterMapOutput_Events) isCounterMapOutput_Output()       {}
+func (*CounterMapOutput_Configure) isCounterMapOutput_Output()    {}
+func (*CounterMapOutput_SetBounds) isCounterMapOutput_Output()    {}
+func (*CounterMapOutput_TopK) isCounterMapOutput_Output()         {}
+func (*CounterMapOutput_BottomK) isCounterMapOutput_Output()      {}
+func (*CounterMapOutput_RangeByValue) isCounterMapOutput_Output() {}
 
-var xxx_messageInfo_UpdateOutput proto.InternalMessageInfo
-
-func (m *UpdateOutput) GetPrevValue() int64 {
+func (m *CounterMapOutput) GetOutput() isCounterMapOutput_Output {
 	if m != nil {
-		return m.PrevValue
+		return m.Output
 	}
-	return 0
+	return nil
 }
 
-type IncrementInput struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Delta int64  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+func (m *CounterMapOutput) GetSize_() *SizeOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Size_); ok {
+		return x.Size_
+	}
+	return nil
 }
 
-func (m *IncrementInput) Reset()         { *m = IncrementInput{} }
-func (m *IncrementInput) String() string { return proto.CompactTextString(m) }
-func (*IncrementInput) ProtoMessage()    {}
-func (*IncrementInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{37}
+func (m *CounterMapOutput) GetSet() *SetOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Set); ok {
+		return x.Set
+	}
+	return nil
 }
-func (m *IncrementInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *CounterMapOutput) GetInsert() *InsertOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Insert); ok {
+		return x.Insert
+	}
+	return nil
 }
-func (m *IncrementInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_IncrementInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *CounterMapOutput) GetUpdate() *UpdateOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Update); ok {
+		return x.Update
 	}
+	return nil
 }
-func (m *IncrementInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncrementInput.Merge(m, src)
+
+func (m *CounterMapOutput) GetIncrement() *IncrementOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Increment); ok {
+		return x.Increment
+	}
+	return nil
 }
-func (m *IncrementInput) XXX_Size() int {
-	return m.Size()
+
+func (m *CounterMapOutput) GetDecrement() *DecrementOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Decrement); ok {
+		return x.Decrement
+	}
+	return nil
 }
-func (m *IncrementInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncrementInput.DiscardUnknown(m)
+
+func (m *CounterMapOutput) GetGet() *GetOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Get); ok {
+		return x.Get
+	}
+	return nil
 }
 
-var xxx_messageInfo_IncrementInput proto.InternalMessageInfo
+func (m *CounterMapOutput) GetRemove() *RemoveOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Remove); ok {
+		return x.Remove
+	}
+	return nil
+}
 
-func (m *IncrementInput) GetKey() string {
-	if m != nil {
-		return m.Key
+func (m *CounterMapOutput) GetClear() *ClearOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Clear); ok {
+		return x.Clear
 	}
-	return ""
+	return nil
 }
 
-func (m *IncrementInput) GetDelta() int64 {
-	if m != nil {
-		return m.Delta
+func (m *CounterMapOutput) GetLock() *LockOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Lock); ok {
+		return x.Lock
 	}
-	return 0
+	return nil
 }
 
-type IncrementOutput struct {
-	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+func (m *CounterMapOutput) GetUnlock() *UnlockOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Unlock); ok {
+		return x.Unlock
+	}
+	return nil
 }
 
-func (m *IncrementOutput) Reset()         { *m = IncrementOutput{} }
-func (m *IncrementOutput) String() string { return proto.CompactTextString(m) }
-func (*IncrementOutput) ProtoMessage()    {}
-func (*IncrementOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{38}
+func (m *CounterMapOutput) GetEntries() *EntriesOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Entries); ok {
+		return x.Entries
+	}
+	return nil
 }
-func (m *IncrementOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *CounterMapOutput) GetEvents() *EventsOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Events); ok {
+		return x.Events
+	}
+	return nil
 }
-func (m *IncrementOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_IncrementOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *CounterMapOutput) GetConfigure() *ConfigureOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_Configure); ok {
+		return x.Configure
 	}
+	return nil
 }
-func (m *IncrementOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncrementOutput.Merge(m, src)
+
+func (m *CounterMapOutput) GetSetBounds() *SetBoundsOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_SetBounds); ok {
+		return x.SetBounds
+	}
+	return nil
 }
-func (m *IncrementOutput) XXX_Size() int {
-	return m.Size()
+
+func (m *CounterMapOutput) GetTopK() *TopKOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_TopK); ok {
+		return x.TopK
+	}
+	return nil
 }
-func (m *IncrementOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncrementOutput.DiscardUnknown(m)
+
+func (m *CounterMapOutput) GetBottomK() *BottomKOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_BottomK); ok {
+		return x.BottomK
+	}
+	return nil
 }
 
-var xxx_messageInfo_IncrementOutput proto.InternalMessageInfo
+func (m *CounterMapOutput) GetRangeByValue() *RangeByValueOutput {
+	if x, ok := m.GetOutput().(*CounterMapOutput_RangeByValue); ok {
+		return x.RangeByValue
+	}
+	return nil
+}
 
-func (m *IncrementOutput) GetPrevValue() int64 {
-	if m != nil {
-		return m.PrevValue
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CounterMapOutput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CounterMapOutput_Size_)(nil),
+		(*CounterMapOutput_Set)(nil),
+		(*CounterMapOutput_Insert)(nil),
+		(*CounterMapOutput_Update)(nil),
+		(*CounterMapOutput_Increment)(nil),
+		(*CounterMapOutput_Decrement)(nil),
+		(*CounterMapOutput_Get)(nil),
+		(*CounterMapOutput_Remove)(nil),
+		(*CounterMapOutput_Clear)(nil),
+		(*CounterMapOutput_Lock)(nil),
+		(*CounterMapOutput_Unlock)(nil),
+		(*CounterMapOutput_Entries)(nil),
+		(*CounterMapOutput_Events)(nil),
+		(*CounterMapOutput_Configure)(nil),
+		(*CounterMapOutput_SetBounds)(nil),
+		(*CounterMapOutput_TopK)(nil),
+		(*CounterMapOutput_BottomK)(nil),
+		(*CounterMapOutput_RangeByValue)(nil),
 	}
-	return 0
 }
 
-type DecrementInput struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Delta int64  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+type SizeInput struct {
 }
 
-func (m *DecrementInput) Reset()         { *m = DecrementInput{} }
-func (m *DecrementInput) String() string { return proto.CompactTextString(m) }
-func (*DecrementInput) ProtoMessage()    {}
-func (*DecrementInput) Descriptor() ([]byte, []int) {
+func (m *SizeInput) Reset()         { *m = SizeInput{} }
+func (m *SizeInput) String() string { return proto.CompactTextString(m) }
+func (*SizeInput) ProtoMessage()    {}
+func (*SizeInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{39}
 }
-func (m *DecrementInput) XXX_Unmarshal(b []byte) error {
+func (m *SizeInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DecrementInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SizeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DecrementInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SizeInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2201,48 +2315,34 @@ func (m *DecrementInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DecrementInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DecrementInput.Merge(m, src)
+func (m *SizeInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SizeInput.Merge(m, src)
 }
-func (m *DecrementInput) XXX_Size() int {
+func (m *SizeInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *DecrementInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_DecrementInput.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DecrementInput proto.InternalMessageInfo
-
-func (m *DecrementInput) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+func (m *SizeInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SizeInput.DiscardUnknown(m)
 }
 
-func (m *DecrementInput) GetDelta() int64 {
-	if m != nil {
-		return m.Delta
-	}
-	return 0
-}
+var xxx_messageInfo_SizeInput proto.InternalMessageInfo
 
-type DecrementOutput struct {
-	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type SizeOutput struct {
+	Size_ uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *DecrementOutput) Reset()         { *m = DecrementOutput{} }
-func (m *DecrementOutput) String() string { return proto.CompactTextString(m) }
-func (*DecrementOutput) ProtoMessage()    {}
-func (*DecrementOutput) Descriptor() ([]byte, []int) {
+func (m *SizeOutput) Reset()         { *m = SizeOutput{} }
+func (m *SizeOutput) String() string { return proto.CompactTextString(m) }
+func (*SizeOutput) ProtoMessage()    {}
+func (*SizeOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{40}
 }
-func (m *DecrementOutput) XXX_Unmarshal(b []byte) error {
+func (m *SizeOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DecrementOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SizeOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DecrementOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SizeOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2252,41 +2352,42 @@ func (m *DecrementOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DecrementOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DecrementOutput.Merge(m, src)
+func (m *SizeOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SizeOutput.Merge(m, src)
 }
-func (m *DecrementOutput) XXX_Size() int {
+func (m *SizeOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *DecrementOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_DecrementOutput.DiscardUnknown(m)
+func (m *SizeOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SizeOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DecrementOutput proto.InternalMessageInfo
+var xxx_messageInfo_SizeOutput proto.InternalMessageInfo
 
-func (m *DecrementOutput) GetPrevValue() int64 {
+func (m *SizeOutput) GetSize_() uint32 {
 	if m != nil {
-		return m.PrevValue
+		return m.Size_
 	}
 	return 0
 }
 
-type GetInput struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type SetInput struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *GetInput) Reset()         { *m = GetInput{} }
-func (m *GetInput) String() string { return proto.CompactTextString(m) }
-func (*GetInput) ProtoMessage()    {}
-func (*GetInput) Descriptor() ([]byte, []int) {
+func (m *SetInput) Reset()         { *m = SetInput{} }
+func (m *SetInput) String() string { return proto.CompactTextString(m) }
+func (*SetInput) ProtoMessage()    {}
+func (*SetInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{41}
 }
-func (m *GetInput) XXX_Unmarshal(b []byte) error {
+func (m *SetInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2296,41 +2397,48 @@ func (m *GetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GetInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInput.Merge(m, src)
+func (m *SetInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetInput.Merge(m, src)
 }
-func (m *GetInput) XXX_Size() int {
+func (m *SetInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInput.DiscardUnknown(m)
+func (m *SetInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetInput proto.InternalMessageInfo
+var xxx_messageInfo_SetInput proto.InternalMessageInfo
 
-func (m *GetInput) GetKey() string {
+func (m *SetInput) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-type GetOutput struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+func (m *SetInput) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
 }
 
-func (m *GetOutput) Reset()         { *m = GetOutput{} }
-func (m *GetOutput) String() string { return proto.CompactTextString(m) }
-func (*GetOutput) ProtoMessage()    {}
-func (*GetOutput) Descriptor() ([]byte, []int) {
+type SetOutput struct {
+	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+}
+
+func (m *SetOutput) Reset()         { *m = SetOutput{} }
+func (m *SetOutput) String() string { return proto.CompactTextString(m) }
+func (*SetOutput) ProtoMessage()    {}
+func (*SetOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{42}
 }
-func (m *GetOutput) XXX_Unmarshal(b []byte) error {
+func (m *SetOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2340,42 +2448,42 @@ func (m *GetOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GetOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOutput.Merge(m, src)
+func (m *SetOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetOutput.Merge(m, src)
 }
-func (m *GetOutput) XXX_Size() int {
+func (m *SetOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOutput.DiscardUnknown(m)
+func (m *SetOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOutput proto.InternalMessageInfo
+var xxx_messageInfo_SetOutput proto.InternalMessageInfo
 
-func (m *GetOutput) GetValue() int64 {
+func (m *SetOutput) GetPrevValue() int64 {
 	if m != nil {
-		return m.Value
+		return m.PrevValue
 	}
 	return 0
 }
 
-type RemoveInput struct {
-	Key       string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	PrevValue int64  `protobuf:"varint,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type InsertInput struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *RemoveInput) Reset()         { *m = RemoveInput{} }
-func (m *RemoveInput) String() string { return proto.CompactTextString(m) }
-func (*RemoveInput) ProtoMessage()    {}
-func (*RemoveInput) Descriptor() ([]byte, []int) {
+func (m *InsertInput) Reset()         { *m = InsertInput{} }
+func (m *InsertInput) String() string { return proto.CompactTextString(m) }
+func (*InsertInput) ProtoMessage()    {}
+func (*InsertInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{43}
 }
-func (m *RemoveInput) XXX_Unmarshal(b []byte) error {
+func (m *InsertInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2385,48 +2493,47 @@ func (m *RemoveInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RemoveInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveInput.Merge(m, src)
+func (m *InsertInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertInput.Merge(m, src)
 }
-func (m *RemoveInput) XXX_Size() int {
+func (m *InsertInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveInput.DiscardUnknown(m)
+func (m *InsertInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveInput proto.InternalMessageInfo
+var xxx_messageInfo_InsertInput proto.InternalMessageInfo
 
-func (m *RemoveInput) GetKey() string {
+func (m *InsertInput) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *RemoveInput) GetPrevValue() int64 {
+func (m *InsertInput) GetValue() int64 {
 	if m != nil {
-		return m.PrevValue
+		return m.Value
 	}
 	return 0
 }
 
-type RemoveOutput struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+type InsertOutput struct {
 }
 
-func (m *RemoveOutput) Reset()         { *m = RemoveOutput{} }
-func (m *RemoveOutput) String() string { return proto.CompactTextString(m) }
-func (*RemoveOutput) ProtoMessage()    {}
-func (*RemoveOutput) Descriptor() ([]byte, []int) {
+func (m *InsertOutput) Reset()         { *m = InsertOutput{} }
+func (m *InsertOutput) String() string { return proto.CompactTextString(m) }
+func (*InsertOutput) ProtoMessage()    {}
+func (*InsertOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{44}
 }
-func (m *RemoveOutput) XXX_Unmarshal(b []byte) error {
+func (m *InsertOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2436,40 +2543,36 @@ func (m *RemoveOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RemoveOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveOutput.Merge(m, src)
+func (m *InsertOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertOutput.Merge(m, src)
 }
-func (m *RemoveOutput) XXX_Size() int {
+func (m *InsertOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveOutput.DiscardUnknown(m)
+func (m *InsertOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveOutput proto.InternalMessageInfo
-
-func (m *RemoveOutput) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
-}
+var xxx_messageInfo_InsertOutput proto.InternalMessageInfo
 
-type ClearInput struct {
+type UpdateInput struct {
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	PrevValue int64  `protobuf:"varint,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
 
-func (m *ClearInput) Reset()         { *m = ClearInput{} }
-func (m *ClearInput) String() string { return proto.CompactTextString(m) }
-func (*ClearInput) ProtoMessage()    {}
-func (*ClearInput) Descriptor() ([]byte, []int) {
+func (m *UpdateInput) Reset()         { *m = UpdateInput{} }
+func (m *UpdateInput) String() string { return proto.CompactTextString(m) }
+func (*UpdateInput) ProtoMessage()    {}
+func (*UpdateInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{45}
 }
-func (m *ClearInput) XXX_Unmarshal(b []byte) error {
+func (m *UpdateInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2479,33 +2582,55 @@ func (m *ClearInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ClearInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearInput.Merge(m, src)
+func (m *UpdateInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInput.Merge(m, src)
 }
-func (m *ClearInput) XXX_Size() int {
+func (m *UpdateInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearInput.DiscardUnknown(m)
+func (m *UpdateInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearInput proto.InternalMessageInfo
+var xxx_messageInfo_UpdateInput proto.InternalMessageInfo
 
-type ClearOutput struct {
+func (m *UpdateInput) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *ClearOutput) Reset()         { *m = ClearOutput{} }
-func (m *ClearOutput) String() string { return proto.CompactTextString(m) }
-func (*ClearOutput) ProtoMessage()    {}
-func (*ClearOutput) Descriptor() ([]byte, []int) {
+func (m *UpdateInput) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *UpdateInput) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
+	}
+	return 0
+}
+
+type UpdateOutput struct {
+	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+}
+
+func (m *UpdateOutput) Reset()         { *m = UpdateOutput{} }
+func (m *UpdateOutput) String() string { return proto.CompactTextString(m) }
+func (*UpdateOutput) ProtoMessage()    {}
+func (*UpdateOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{46}
 }
-func (m *ClearOutput) XXX_Unmarshal(b []byte) error {
+func (m *UpdateOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2515,35 +2640,43 @@ func (m *ClearOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ClearOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearOutput.Merge(m, src)
+func (m *UpdateOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOutput.Merge(m, src)
 }
-func (m *ClearOutput) XXX_Size() int {
+func (m *UpdateOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearOutput.DiscardUnknown(m)
+func (m *UpdateOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearOutput proto.InternalMessageInfo
+var xxx_messageInfo_UpdateOutput proto.InternalMessageInfo
 
-type LockInput struct {
-	Keys    []string       `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	Timeout *time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+func (m *UpdateOutput) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
+	}
+	return 0
 }
 
-func (m *LockInput) Reset()         { *m = LockInput{} }
-func (m *LockInput) String() string { return proto.CompactTextString(m) }
-func (*LockInput) ProtoMessage()    {}
-func (*LockInput) Descriptor() ([]byte, []int) {
+type IncrementInput struct {
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Delta    int64  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Saturate bool   `protobuf:"varint,3,opt,name=saturate,proto3" json:"saturate,omitempty"`
+}
+
+func (m *IncrementInput) Reset()         { *m = IncrementInput{} }
+func (m *IncrementInput) String() string { return proto.CompactTextString(m) }
+func (*IncrementInput) ProtoMessage()    {}
+func (*IncrementInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{47}
 }
-func (m *LockInput) XXX_Unmarshal(b []byte) error {
+func (m *IncrementInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IncrementInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IncrementInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2553,47 +2686,55 @@ func (m *LockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LockInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockInput.Merge(m, src)
+func (m *IncrementInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementInput.Merge(m, src)
 }
-func (m *LockInput) XXX_Size() int {
+func (m *IncrementInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockInput.DiscardUnknown(m)
+func (m *IncrementInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockInput proto.InternalMessageInfo
+var xxx_messageInfo_IncrementInput proto.InternalMessageInfo
 
-func (m *LockInput) GetKeys() []string {
+func (m *IncrementInput) GetKey() string {
 	if m != nil {
-		return m.Keys
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *LockInput) GetTimeout() *time.Duration {
+func (m *IncrementInput) GetDelta() int64 {
 	if m != nil {
-		return m.Timeout
+		return m.Delta
 	}
-	return nil
+	return 0
 }
 
-type LockOutput struct {
+func (m *IncrementInput) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
 }
 
-func (m *LockOutput) Reset()         { *m = LockOutput{} }
-func (m *LockOutput) String() string { return proto.CompactTextString(m) }
-func (*LockOutput) ProtoMessage()    {}
-func (*LockOutput) Descriptor() ([]byte, []int) {
+type IncrementOutput struct {
+	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+}
+
+func (m *IncrementOutput) Reset()         { *m = IncrementOutput{} }
+func (m *IncrementOutput) String() string { return proto.CompactTextString(m) }
+func (*IncrementOutput) ProtoMessage()    {}
+func (*IncrementOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{48}
 }
-func (m *LockOutput) XXX_Unmarshal(b []byte) error {
+func (m *IncrementOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IncrementOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IncrementOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2603,34 +2744,43 @@ func (m *LockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LockOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockOutput.Merge(m, src)
+func (m *IncrementOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementOutput.Merge(m, src)
 }
-func (m *LockOutput) XXX_Size() int {
+func (m *IncrementOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockOutput.DiscardUnknown(m)
+func (m *IncrementOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockOutput proto.InternalMessageInfo
+var xxx_messageInfo_IncrementOutput proto.InternalMessageInfo
 
-type UnlockInput struct {
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+func (m *IncrementOutput) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
+	}
+	return 0
 }
 
-func (m *UnlockInput) Reset()         { *m = UnlockInput{} }
-func (m *UnlockInput) String() string { return proto.CompactTextString(m) }
-func (*UnlockInput) ProtoMessage()    {}
-func (*UnlockInput) Descriptor() ([]byte, []int) {
+type DecrementInput struct {
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Delta    int64  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Saturate bool   `protobuf:"varint,3,opt,name=saturate,proto3" json:"saturate,omitempty"`
+}
+
+func (m *DecrementInput) Reset()         { *m = DecrementInput{} }
+func (m *DecrementInput) String() string { return proto.CompactTextString(m) }
+func (*DecrementInput) ProtoMessage()    {}
+func (*DecrementInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{49}
 }
-func (m *UnlockInput) XXX_Unmarshal(b []byte) error {
+func (m *DecrementInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DecrementInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DecrementInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2640,40 +2790,55 @@ func (m *UnlockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UnlockInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockInput.Merge(m, src)
+func (m *DecrementInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecrementInput.Merge(m, src)
 }
-func (m *UnlockInput) XXX_Size() int {
+func (m *DecrementInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockInput.DiscardUnknown(m)
+func (m *DecrementInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecrementInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockInput proto.InternalMessageInfo
+var xxx_messageInfo_DecrementInput proto.InternalMessageInfo
 
-func (m *UnlockInput) GetKeys() []string {
+func (m *DecrementInput) GetKey() string {
 	if m != nil {
-		return m.Keys
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-type UnlockOutput struct {
+func (m *DecrementInput) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
 }
 
-func (m *UnlockOutput) Reset()         { *m = UnlockOutput{} }
-func (m *UnlockOutput) String() string { return proto.CompactTextString(m) }
-func (*UnlockOutput) ProtoMessage()    {}
-func (*UnlockOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{50}
+func (m *DecrementInput) GetSaturate() bool {
+	if m != nil {
+		return m.Saturate
+	}
+	return false
 }
-func (m *UnlockOutput) XXX_Unmarshal(b []byte) error {
+
+type DecrementOutput struct {
+	PrevValue int64 `protobuf:"varint,1,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+}
+
+func (m *DecrementOutput) Reset()         { *m = DecrementOutput{} }
+func (m *DecrementOutput) String() string { return proto.CompactTextString(m) }
+func (*DecrementOutput) ProtoMessage()    {}
+func (*DecrementOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{50}
+}
+func (m *DecrementOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DecrementOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DecrementOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2683,34 +2848,41 @@ func (m *UnlockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UnlockOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockOutput.Merge(m, src)
+func (m *DecrementOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecrementOutput.Merge(m, src)
 }
-func (m *UnlockOutput) XXX_Size() int {
+func (m *DecrementOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockOutput.DiscardUnknown(m)
+func (m *DecrementOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecrementOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockOutput proto.InternalMessageInfo
+var xxx_messageInfo_DecrementOutput proto.InternalMessageInfo
 
-type EntriesInput struct {
-	Watch bool `protobuf:"varint,1,opt,name=watch,proto3" json:"watch,omitempty"`
+func (m *DecrementOutput) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
+	}
+	return 0
 }
 
-func (m *EntriesInput) Reset()         { *m = EntriesInput{} }
-func (m *EntriesInput) String() string { return proto.CompactTextString(m) }
-func (*EntriesInput) ProtoMessage()    {}
-func (*EntriesInput) Descriptor() ([]byte, []int) {
+type GetInput struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *GetInput) Reset()         { *m = GetInput{} }
+func (m *GetInput) String() string { return proto.CompactTextString(m) }
+func (*GetInput) ProtoMessage()    {}
+func (*GetInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{51}
 }
-func (m *EntriesInput) XXX_Unmarshal(b []byte) error {
+func (m *GetInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2720,41 +2892,42 @@ func (m *EntriesInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EntriesInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesInput.Merge(m, src)
+func (m *GetInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInput.Merge(m, src)
 }
-func (m *EntriesInput) XXX_Size() int {
+func (m *GetInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesInput.DiscardUnknown(m)
+func (m *GetInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesInput proto.InternalMessageInfo
+var xxx_messageInfo_GetInput proto.InternalMessageInfo
 
-func (m *EntriesInput) GetWatch() bool {
+func (m *GetInput) GetKey() string {
 	if m != nil {
-		return m.Watch
+		return m.Key
 	}
-	return false
+	return ""
 }
 
-type EntriesOutput struct {
-	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+type GetOutput struct {
+	Value  int64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
 }
 
-func (m *EntriesOutput) Reset()         { *m = EntriesOutput{} }
-func (m *EntriesOutput) String() string { return proto.CompactTextString(m) }
-func (*EntriesOutput) ProtoMessage()    {}
-func (*EntriesOutput) Descriptor() ([]byte, []int) {
+func (m *GetOutput) Reset()         { *m = GetOutput{} }
+func (m *GetOutput) String() string { return proto.CompactTextString(m) }
+func (*GetOutput) ProtoMessage()    {}
+func (*GetOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{52}
 }
-func (m *EntriesOutput) XXX_Unmarshal(b []byte) error {
+func (m *GetOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2764,41 +2937,48 @@ func (m *EntriesOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EntriesOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesOutput.Merge(m, src)
+func (m *GetOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOutput.Merge(m, src)
 }
-func (m *EntriesOutput) XXX_Size() int {
+func (m *GetOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesOutput.DiscardUnknown(m)
+func (m *GetOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesOutput proto.InternalMessageInfo
+var xxx_messageInfo_GetOutput proto.InternalMessageInfo
 
-func (m *EntriesOutput) GetEntry() Entry {
+func (m *GetOutput) GetValue() int64 {
 	if m != nil {
-		return m.Entry
+		return m.Value
 	}
-	return Entry{}
+	return 0
 }
 
-type EventsInput struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *GetOutput) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
 }
 
-func (m *EventsInput) Reset()         { *m = EventsInput{} }
-func (m *EventsInput) String() string { return proto.CompactTextString(m) }
-func (*EventsInput) ProtoMessage()    {}
-func (*EventsInput) Descriptor() ([]byte, []int) {
+type ConfigureInput struct {
+	Bounds *Bounds `protobuf:"bytes,1,opt,name=bounds,proto3" json:"bounds,omitempty"`
+}
+
+func (m *ConfigureInput) Reset()         { *m = ConfigureInput{} }
+func (m *ConfigureInput) String() string { return proto.CompactTextString(m) }
+func (*ConfigureInput) ProtoMessage()    {}
+func (*ConfigureInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{53}
 }
-func (m *EventsInput) XXX_Unmarshal(b []byte) error {
+func (m *ConfigureInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConfigureInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConfigureInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2808,41 +2988,40 @@ func (m *EventsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventsInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsInput.Merge(m, src)
+func (m *ConfigureInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureInput.Merge(m, src)
 }
-func (m *EventsInput) XXX_Size() int {
+func (m *ConfigureInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsInput.DiscardUnknown(m)
+func (m *ConfigureInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsInput proto.InternalMessageInfo
+var xxx_messageInfo_ConfigureInput proto.InternalMessageInfo
 
-func (m *EventsInput) GetKey() string {
+func (m *ConfigureInput) GetBounds() *Bounds {
 	if m != nil {
-		return m.Key
+		return m.Bounds
 	}
-	return ""
+	return nil
 }
 
-type EventsOutput struct {
-	Event Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+type ConfigureOutput struct {
 }
 
-func (m *EventsOutput) Reset()         { *m = EventsOutput{} }
-func (m *EventsOutput) String() string { return proto.CompactTextString(m) }
-func (*EventsOutput) ProtoMessage()    {}
-func (*EventsOutput) Descriptor() ([]byte, []int) {
+func (m *ConfigureOutput) Reset()         { *m = ConfigureOutput{} }
+func (m *ConfigureOutput) String() string { return proto.CompactTextString(m) }
+func (*ConfigureOutput) ProtoMessage()    {}
+func (*ConfigureOutput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{54}
 }
-func (m *EventsOutput) XXX_Unmarshal(b []byte) error {
+func (m *ConfigureOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConfigureOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConfigureOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2852,46 +3031,35 @@ func (m *EventsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventsOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsOutput.Merge(m, src)
+func (m *ConfigureOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureOutput.Merge(m, src)
 }
-func (m *EventsOutput) XXX_Size() int {
+func (m *ConfigureOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsOutput.DiscardUnknown(m)
+func (m *ConfigureOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsOutput proto.InternalMessageInfo
-
-func (m *EventsOutput) GetEvent() Event {
-	if m != nil {
-		return m.Event
-	}
-	return Event{}
-}
+var xxx_messageInfo_ConfigureOutput proto.InternalMessageInfo
 
-type Event struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Types that are valid to be assigned to Event:
-	//	*Event_Inserted_
-	//	*Event_Updated_
-	//	*Event_Removed_
-	Event isEvent_Event `protobuf_oneof:"event"`
+type SetBoundsInput struct {
+	Key    string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Bounds *Bounds `protobuf:"bytes,2,opt,name=bounds,proto3" json:"bounds,omitempty"`
 }
 
-func (m *Event) Reset()         { *m = Event{} }
-func (m *Event) String() string { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()    {}
-func (*Event) Descriptor() ([]byte, []int) {
+func (m *SetBoundsInput) Reset()         { *m = SetBoundsInput{} }
+func (m *SetBoundsInput) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsInput) ProtoMessage()    {}
+func (*SetBoundsInput) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9f8d4ceae3bab45a, []int{55}
 }
-func (m *Event) XXX_Unmarshal(b []byte) error {
+func (m *SetBoundsInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetBoundsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetBoundsInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2901,98 +3069,84 @@ func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(m, src)
+func (m *SetBoundsInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsInput.Merge(m, src)
 }
-func (m *Event) XXX_Size() int {
+func (m *SetBoundsInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Event proto.InternalMessageInfo
-
-type isEvent_Event interface {
-	isEvent_Event()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Event_Inserted_ struct {
-	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
-}
-type Event_Updated_ struct {
-	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
-}
-type Event_Removed_ struct {
-	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
+func (m *SetBoundsInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsInput.DiscardUnknown(m)
 }
 
-func (*Event_Inserted_) isEvent_Event() {}
-func (*Event_Updated_) isEvent_Event()  {}
-func (*Event_Removed_) isEvent_Event()  {}
-
-func (m *Event) GetEvent() isEvent_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
-}
+var xxx_messageInfo_SetBoundsInput proto.InternalMessageInfo
 
-func (m *Event) GetKey() string {
+func (m *SetBoundsInput) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *Event) GetInserted() *Event_Inserted {
-	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
-		return x.Inserted
+func (m *SetBoundsInput) GetBounds() *Bounds {
+	if m != nil {
+		return m.Bounds
 	}
 	return nil
 }
 
-func (m *Event) GetUpdated() *Event_Updated {
-	if x, ok := m.GetEvent().(*Event_Updated_); ok {
-		return x.Updated
-	}
-	return nil
+type SetBoundsOutput struct {
 }
 
-func (m *Event) GetRemoved() *Event_Removed {
-	if x, ok := m.GetEvent().(*Event_Removed_); ok {
-		return x.Removed
-	}
-	return nil
+func (m *SetBoundsOutput) Reset()         { *m = SetBoundsOutput{} }
+func (m *SetBoundsOutput) String() string { return proto.CompactTextString(m) }
+func (*SetBoundsOutput) ProtoMessage()    {}
+func (*SetBoundsOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{56}
 }
-
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Event) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Event_Inserted_)(nil),
-		(*Event_Updated_)(nil),
-		(*Event_Removed_)(nil),
+func (m *SetBoundsOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBoundsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBoundsOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
+func (m *SetBoundsOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBoundsOutput.Merge(m, src)
+}
+func (m *SetBoundsOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBoundsOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBoundsOutput.DiscardUnknown(m)
+}
 
-type Event_Inserted struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+var xxx_messageInfo_SetBoundsOutput proto.InternalMessageInfo
+
+type TopKInput struct {
+	K uint32 `protobuf:"varint,1,opt,name=k,proto3" json:"k,omitempty"`
 }
 
-func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
-func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
-func (*Event_Inserted) ProtoMessage()    {}
-func (*Event_Inserted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{55, 0}
+func (m *TopKInput) Reset()         { *m = TopKInput{} }
+func (m *TopKInput) String() string { return proto.CompactTextString(m) }
+func (*TopKInput) ProtoMessage()    {}
+func (*TopKInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{57}
 }
-func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+func (m *TopKInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopKInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopKInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3002,42 +3156,41 @@ func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *Event_Inserted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Inserted.Merge(m, src)
+func (m *TopKInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopKInput.Merge(m, src)
 }
-func (m *Event_Inserted) XXX_Size() int {
+func (m *TopKInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Inserted) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
+func (m *TopKInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopKInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
+var xxx_messageInfo_TopKInput proto.InternalMessageInfo
 
-func (m *Event_Inserted) GetValue() int64 {
+func (m *TopKInput) GetK() uint32 {
 	if m != nil {
-		return m.Value
+		return m.K
 	}
 	return 0
 }
 
-type Event_Updated struct {
-	Value     int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	PrevValue int64 `protobuf:"varint,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type TopKOutput struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
-func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
-func (*Event_Updated) ProtoMessage()    {}
-func (*Event_Updated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{55, 1}
+func (m *TopKOutput) Reset()         { *m = TopKOutput{} }
+func (m *TopKOutput) String() string { return proto.CompactTextString(m) }
+func (*TopKOutput) ProtoMessage()    {}
+func (*TopKOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{58}
 }
-func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+func (m *TopKOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopKOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopKOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3047,48 +3200,85 @@ func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Updated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Updated.Merge(m, src)
+func (m *TopKOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopKOutput.Merge(m, src)
 }
-func (m *Event_Updated) XXX_Size() int {
+func (m *TopKOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Updated) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
+func (m *TopKOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopKOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
+var xxx_messageInfo_TopKOutput proto.InternalMessageInfo
 
-func (m *Event_Updated) GetValue() int64 {
+func (m *TopKOutput) GetEntries() []Entry {
 	if m != nil {
-		return m.Value
+		return m.Entries
 	}
-	return 0
+	return nil
 }
 
-func (m *Event_Updated) GetPrevValue() int64 {
+type BottomKInput struct {
+	K uint32 `protobuf:"varint,1,opt,name=k,proto3" json:"k,omitempty"`
+}
+
+func (m *BottomKInput) Reset()         { *m = BottomKInput{} }
+func (m *BottomKInput) String() string { return proto.CompactTextString(m) }
+func (*BottomKInput) ProtoMessage()    {}
+func (*BottomKInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{59}
+}
+func (m *BottomKInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BottomKInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BottomKInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BottomKInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BottomKInput.Merge(m, src)
+}
+func (m *BottomKInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *BottomKInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BottomKInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BottomKInput proto.InternalMessageInfo
+
+func (m *BottomKInput) GetK() uint32 {
 	if m != nil {
-		return m.PrevValue
+		return m.K
 	}
 	return 0
 }
 
-type Event_Removed struct {
-	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+type BottomKOutput struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
-func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
-func (*Event_Removed) ProtoMessage()    {}
-func (*Event_Removed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{55, 2}
+func (m *BottomKOutput) Reset()         { *m = BottomKOutput{} }
+func (m *BottomKOutput) String() string { return proto.CompactTextString(m) }
+func (*BottomKOutput) ProtoMessage()    {}
+func (*BottomKOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{60}
 }
-func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+func (m *BottomKOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BottomKOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BottomKOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3098,42 +3288,42 @@ func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Removed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Removed.Merge(m, src)
+func (m *BottomKOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BottomKOutput.Merge(m, src)
 }
-func (m *Event_Removed) XXX_Size() int {
+func (m *BottomKOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Removed) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
+func (m *BottomKOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BottomKOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
+var xxx_messageInfo_BottomKOutput proto.InternalMessageInfo
 
-func (m *Event_Removed) GetValue() int64 {
+func (m *BottomKOutput) GetEntries() []Entry {
 	if m != nil {
-		return m.Value
+		return m.Entries
 	}
-	return 0
+	return nil
 }
 
-type Entry struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+type RangeByValueInput struct {
+	Bounds *Bounds `protobuf:"bytes,1,opt,name=bounds,proto3" json:"bounds,omitempty"`
+	Limit  uint32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *Entry) Reset()         { *m = Entry{} }
-func (m *Entry) String() string { return proto.CompactTextString(m) }
-func (*Entry) ProtoMessage()    {}
-func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f8d4ceae3bab45a, []int{56}
+func (m *RangeByValueInput) Reset()         { *m = RangeByValueInput{} }
+func (m *RangeByValueInput) String() string { return proto.CompactTextString(m) }
+func (*RangeByValueInput) ProtoMessage()    {}
+func (*RangeByValueInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{61}
 }
-func (m *Entry) XXX_Unmarshal(b []byte) error {
+func (m *RangeByValueInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RangeByValueInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RangeByValueInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3143,2121 +3333,2022 @@ func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Entry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Entry.Merge(m, src)
+func (m *RangeByValueInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeByValueInput.Merge(m, src)
 }
-func (m *Entry) XXX_Size() int {
+func (m *RangeByValueInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Entry) XXX_DiscardUnknown() {
-	xxx_messageInfo_Entry.DiscardUnknown(m)
+func (m *RangeByValueInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeByValueInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Entry proto.InternalMessageInfo
+var xxx_messageInfo_RangeByValueInput proto.InternalMessageInfo
 
-func (m *Entry) GetKey() string {
+func (m *RangeByValueInput) GetBounds() *Bounds {
 	if m != nil {
-		return m.Key
+		return m.Bounds
 	}
-	return ""
+	return nil
 }
 
-func (m *Entry) GetValue() int64 {
+func (m *RangeByValueInput) GetLimit() uint32 {
 	if m != nil {
-		return m.Value
+		return m.Limit
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*SizeRequest)(nil), "atomix.protocols.rsm.countermap.v1.SizeRequest")
-	proto.RegisterType((*SizeResponse)(nil), "atomix.protocols.rsm.countermap.v1.SizeResponse")
-	proto.RegisterType((*SetRequest)(nil), "atomix.protocols.rsm.countermap.v1.SetRequest")
-	proto.RegisterType((*SetResponse)(nil), "atomix.protocols.rsm.countermap.v1.SetResponse")
-	proto.RegisterType((*InsertRequest)(nil), "atomix.protocols.rsm.countermap.v1.InsertRequest")
-	proto.RegisterType((*InsertResponse)(nil), "atomix.protocols.rsm.countermap.v1.InsertResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "atomix.protocols.rsm.countermap.v1.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "atomix.protocols.rsm.countermap.v1.UpdateResponse")
-	proto.RegisterType((*IncrementRequest)(nil), "atomix.protocols.rsm.countermap.v1.IncrementRequest")
-	proto.RegisterType((*IncrementResponse)(nil), "atomix.protocols.rsm.countermap.v1.IncrementResponse")
-	proto.RegisterType((*DecrementRequest)(nil), "atomix.protocols.rsm.countermap.v1.DecrementRequest")
-	proto.RegisterType((*DecrementResponse)(nil), "atomix.protocols.rsm.countermap.v1.DecrementResponse")
-	proto.RegisterType((*GetRequest)(nil), "atomix.protocols.rsm.countermap.v1.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "atomix.protocols.rsm.countermap.v1.GetResponse")
-	proto.RegisterType((*RemoveRequest)(nil), "atomix.protocols.rsm.countermap.v1.RemoveRequest")
-	proto.RegisterType((*RemoveResponse)(nil), "atomix.protocols.rsm.countermap.v1.RemoveResponse")
-	proto.RegisterType((*ClearRequest)(nil), "atomix.protocols.rsm.countermap.v1.ClearRequest")
-	proto.RegisterType((*ClearResponse)(nil), "atomix.protocols.rsm.countermap.v1.ClearResponse")
-	proto.RegisterType((*LockRequest)(nil), "atomix.protocols.rsm.countermap.v1.LockRequest")
-	proto.RegisterType((*LockResponse)(nil), "atomix.protocols.rsm.countermap.v1.LockResponse")
-	proto.RegisterType((*UnlockRequest)(nil), "atomix.protocols.rsm.countermap.v1.UnlockRequest")
-	proto.RegisterType((*UnlockResponse)(nil), "atomix.protocols.rsm.countermap.v1.UnlockResponse")
-	proto.RegisterType((*EntriesRequest)(nil), "atomix.protocols.rsm.countermap.v1.EntriesRequest")
-	proto.RegisterType((*EntriesResponse)(nil), "atomix.protocols.rsm.countermap.v1.EntriesResponse")
-	proto.RegisterType((*EventsRequest)(nil), "atomix.protocols.rsm.countermap.v1.EventsRequest")
-	proto.RegisterType((*EventsResponse)(nil), "atomix.protocols.rsm.countermap.v1.EventsResponse")
-	proto.RegisterType((*CounterMapListener)(nil), "atomix.protocols.rsm.countermap.v1.CounterMapListener")
-	proto.RegisterType((*CounterMapInput)(nil), "atomix.protocols.rsm.countermap.v1.CounterMapInput")
-	proto.RegisterType((*CounterMapOutput)(nil), "atomix.protocols.rsm.countermap.v1.CounterMapOutput")
-	proto.RegisterType((*SizeInput)(nil), "atomix.protocols.rsm.countermap.v1.SizeInput")
-	proto.RegisterType((*SizeOutput)(nil), "atomix.protocols.rsm.countermap.v1.SizeOutput")
-	proto.RegisterType((*SetInput)(nil), "atomix.protocols.rsm.countermap.v1.SetInput")
-	proto.RegisterType((*SetOutput)(nil), "atomix.protocols.rsm.countermap.v1.SetOutput")
-	proto.RegisterType((*InsertInput)(nil), "atomix.protocols.rsm.countermap.v1.InsertInput")
-	proto.RegisterType((*InsertOutput)(nil), "atomix.protocols.rsm.countermap.v1.InsertOutput")
-	proto.RegisterType((*UpdateInput)(nil), "atomix.protocols.rsm.countermap.v1.UpdateInput")
-	proto.RegisterType((*UpdateOutput)(nil), "atomix.protocols.rsm.countermap.v1.UpdateOutput")
-	proto.RegisterType((*IncrementInput)(nil), "atomix.protocols.rsm.countermap.v1.IncrementInput")
-	proto.RegisterType((*IncrementOutput)(nil), "atomix.protocols.rsm.countermap.v1.IncrementOutput")
-	proto.RegisterType((*DecrementInput)(nil), "atomix.protocols.rsm.countermap.v1.DecrementInput")
-	proto.RegisterType((*DecrementOutput)(nil), "atomix.protocols.rsm.countermap.v1.DecrementOutput")
-	proto.RegisterType((*GetInput)(nil), "atomix.protocols.rsm.countermap.v1.GetInput")
-	proto.RegisterType((*GetOutput)(nil), "atomix.protocols.rsm.countermap.v1.GetOutput")
-	proto.RegisterType((*RemoveInput)(nil), "atomix.protocols.rsm.countermap.v1.RemoveInput")
-	proto.RegisterType((*RemoveOutput)(nil), "atomix.protocols.rsm.countermap.v1.RemoveOutput")
-	proto.RegisterType((*ClearInput)(nil), "atomix.protocols.rsm.countermap.v1.ClearInput")
-	proto.RegisterType((*ClearOutput)(nil), "atomix.protocols.rsm.countermap.v1.ClearOutput")
-	proto.RegisterType((*LockInput)(nil), "atomix.protocols.rsm.countermap.v1.LockInput")
-	proto.RegisterType((*LockOutput)(nil), "atomix.protocols.rsm.countermap.v1.LockOutput")
-	proto.RegisterType((*UnlockInput)(nil), "atomix.protocols.rsm.countermap.v1.UnlockInput")
-	proto.RegisterType((*UnlockOutput)(nil), "atomix.protocols.rsm.countermap.v1.UnlockOutput")
-	proto.RegisterType((*EntriesInput)(nil), "atomix.protocols.rsm.countermap.v1.EntriesInput")
-	proto.RegisterType((*EntriesOutput)(nil), "atomix.protocols.rsm.countermap.v1.EntriesOutput")
-	proto.RegisterType((*EventsInput)(nil), "atomix.protocols.rsm.countermap.v1.EventsInput")
-	proto.RegisterType((*EventsOutput)(nil), "atomix.protocols.rsm.countermap.v1.EventsOutput")
-	proto.RegisterType((*Event)(nil), "atomix.protocols.rsm.countermap.v1.Event")
-	proto.RegisterType((*Event_Inserted)(nil), "atomix.protocols.rsm.countermap.v1.Event.Inserted")
-	proto.RegisterType((*Event_Updated)(nil), "atomix.protocols.rsm.countermap.v1.Event.Updated")
-	proto.RegisterType((*Event_Removed)(nil), "atomix.protocols.rsm.countermap.v1.Event.Removed")
-	proto.RegisterType((*Entry)(nil), "atomix.protocols.rsm.countermap.v1.Entry")
+type RangeByValueOutput struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func init() { proto.RegisterFile("countermap/v1/countermap.proto", fileDescriptor_9f8d4ceae3bab45a) }
-
-var fileDescriptor_9f8d4ceae3bab45a = []byte{
-	// 1702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6e, 0xdb, 0x46,
-	0x17, 0x15, 0x23, 0xc9, 0xb2, 0xae, 0x64, 0xc7, 0xdf, 0x20, 0x0b, 0x7e, 0x46, 0x2b, 0x27, 0x44,
-	0x17, 0x69, 0x11, 0x8b, 0x96, 0xdd, 0xa0, 0xed, 0x26, 0x68, 0x1c, 0x27, 0x92, 0x15, 0x3b, 0x3f,
-	0x74, 0x93, 0x45, 0x37, 0x85, 0x22, 0x4d, 0x14, 0x22, 0x92, 0xa8, 0x90, 0x94, 0x6a, 0xe7, 0x29,
-	0xba, 0x29, 0xd0, 0x55, 0xd1, 0xa2, 0x68, 0x5a, 0xf4, 0x67, 0xd3, 0x37, 0xe8, 0x2e, 0xcb, 0x2c,
-	0xbb, 0x69, 0x5a, 0x24, 0xdb, 0x3e, 0x41, 0x57, 0xc5, 0xcc, 0xf0, 0x67, 0x48, 0x93, 0xf1, 0x0c,
-	0x61, 0x01, 0x5a, 0x45, 0x74, 0x38, 0xf7, 0x1e, 0x1e, 0xde, 0x73, 0xef, 0x99, 0x21, 0xd4, 0xba,
-	0xd6, 0x64, 0xe4, 0x62, 0x7b, 0xd8, 0x19, 0xeb, 0xd3, 0x86, 0x1e, 0x5e, 0xd5, 0xc7, 0xb6, 0xe5,
-	0x5a, 0x48, 0xeb, 0xb8, 0xd6, 0xd0, 0x3c, 0x64, 0x57, 0x5d, 0x6b, 0xe0, 0xd4, 0x6d, 0x67, 0x58,
-	0xe7, 0x6e, 0x9b, 0x36, 0x56, 0x57, 0xa6, 0x0d, 0xfd, 0x11, 0xee, 0xf4, 0xb0, 0xed, 0xb0, 0xfb,
-	0x56, 0x6b, 0x7d, 0xcb, 0xea, 0x0f, 0xb0, 0x4e, 0xaf, 0x1e, 0x4c, 0x1e, 0xea, 0xbd, 0x89, 0xdd,
-	0x71, 0x4d, 0x6b, 0xe4, 0xfd, 0xff, 0xb9, 0xbe, 0xd5, 0xb7, 0xe8, 0x4f, 0x9d, 0xfc, 0x62, 0x7f,
-	0xd5, 0xbe, 0x51, 0xa0, 0x72, 0x60, 0x3e, 0xc5, 0x06, 0x7e, 0x32, 0xc1, 0x8e, 0x8b, 0x6e, 0x40,
-	0xc9, 0x0b, 0xab, 0x2a, 0xe7, 0x95, 0x8b, 0x95, 0xcd, 0x4b, 0xf5, 0x44, 0x34, 0xd3, 0x46, 0xfd,
-	0xee, 0x04, 0xdb, 0x47, 0xde, 0xba, 0x16, 0x5b, 0x63, 0xf8, 0x8b, 0xd1, 0x2e, 0x14, 0xcd, 0xd1,
-	0x78, 0xe2, 0xaa, 0x67, 0x68, 0x94, 0xf5, 0xfa, 0xc9, 0xcf, 0x54, 0x27, 0x38, 0x76, 0xc9, 0xa2,
-	0xed, 0xc2, 0x8b, 0x97, 0x6b, 0x8a, 0xc1, 0x22, 0x68, 0xdf, 0x2b, 0x50, 0x65, 0x10, 0x9d, 0xb1,
-	0x35, 0x72, 0x30, 0x6a, 0xc6, 0x31, 0xae, 0x9f, 0x84, 0x91, 0x2d, 0x3c, 0x06, 0x72, 0x0f, 0x16,
-	0xac, 0x89, 0x1b, 0xa2, 0xac, 0x8b, 0xa2, 0xbc, 0x4d, 0x57, 0x79, 0x30, 0xbd, 0x18, 0xda, 0xb7,
-	0x0a, 0xc0, 0x01, 0x76, 0x7d, 0x26, 0x77, 0xe3, 0x28, 0xf5, 0x54, 0x94, 0x77, 0x6c, 0x6b, 0x6c,
-	0x39, 0x9d, 0x41, 0x1a, 0x99, 0xad, 0x28, 0x99, 0x97, 0x84, 0x60, 0x62, 0x37, 0x81, 0xcb, 0x67,
-	0xe4, 0x75, 0x13, 0x8c, 0x1e, 0x95, 0xed, 0x38, 0xc8, 0x0d, 0x01, 0x90, 0x29, 0x6c, 0xde, 0x8c,
-	0xb1, 0xb9, 0x2e, 0x08, 0x33, 0x91, 0xcc, 0x1f, 0x14, 0x58, 0xda, 0x1d, 0x39, 0xd8, 0x9e, 0x05,
-	0x9f, 0x37, 0xa3, 0x7c, 0xea, 0x22, 0x40, 0x19, 0x98, 0x04, 0x4a, 0x7f, 0x51, 0x60, 0xd9, 0x47,
-	0x3a, 0x03, 0x56, 0x6f, 0xc5, 0x58, 0xdd, 0x10, 0x07, 0x9b, 0x4a, 0xec, 0xbd, 0x71, 0xaf, 0xe3,
-	0xe2, 0x39, 0x21, 0x96, 0x81, 0x49, 0x21, 0xd6, 0x47, 0x3a, 0x2f, 0xc4, 0x32, 0x3c, 0x89, 0xc4,
-	0xfe, 0xaa, 0xc0, 0xca, 0xee, 0xa8, 0x6b, 0xe3, 0x21, 0x1e, 0xcd, 0xa2, 0x68, 0x6f, 0x45, 0xb9,
-	0xdd, 0x14, 0xab, 0x03, 0x0f, 0x4f, 0x02, 0xbd, 0xbf, 0x29, 0xf0, 0x3f, 0x0e, 0xef, 0x0c, 0x18,
-	0xbe, 0x1b, 0x63, 0x78, 0x4b, 0x0a, 0x72, 0x2a, 0xc9, 0x3b, 0x78, 0xbe, 0x48, 0x0e, 0xf0, 0xa4,
-	0x90, 0xcc, 0xe1, 0x9d, 0x17, 0x92, 0x03, 0x48, 0x89, 0x24, 0x7f, 0xad, 0x00, 0x34, 0xc3, 0x41,
-	0x76, 0x5a, 0x96, 0x20, 0xcb, 0x14, 0x6b, 0x26, 0x4e, 0xb1, 0xef, 0x14, 0xa8, 0x34, 0xb9, 0x29,
-	0x76, 0x6a, 0x86, 0x20, 0xd3, 0x08, 0x6b, 0xbe, 0x61, 0x84, 0x19, 0x78, 0x68, 0x4d, 0xe7, 0xa5,
-	0xd3, 0x32, 0x30, 0x29, 0x9d, 0xd6, 0x47, 0x3a, 0x2f, 0x9d, 0x96, 0xe1, 0x49, 0x24, 0x96, 0x18,
-	0xc2, 0x6b, 0x03, 0xdc, 0xb1, 0x67, 0xc0, 0x6b, 0x3b, 0xca, 0xab, 0x90, 0x23, 0xa4, 0x58, 0x12,
-	0x68, 0xfd, 0x49, 0x81, 0x25, 0x0f, 0xe7, 0x0c, 0x58, 0xdd, 0x8f, 0xb1, 0xaa, 0x0b, 0x43, 0x4d,
-	0x24, 0x95, 0x68, 0x6a, 0xcf, 0xea, 0x3e, 0x9e, 0x01, 0xa7, 0x59, 0xf6, 0x02, 0x04, 0x4a, 0x02,
-	0xa5, 0x3f, 0x2a, 0x50, 0x65, 0x28, 0x67, 0xc0, 0x68, 0xa6, 0xed, 0x00, 0x41, 0x93, 0x6e, 0xb4,
-	0x46, 0x83, 0xd9, 0x50, 0x9a, 0xc9, 0x68, 0x51, 0x30, 0x69, 0x46, 0xcb, 0x43, 0x3a, 0x37, 0x46,
-	0x8b, 0xe2, 0x49, 0x24, 0xf6, 0x99, 0x02, 0xcb, 0xd7, 0x47, 0xae, 0x6d, 0x62, 0xe7, 0xb4, 0x47,
-	0xd4, 0x5e, 0x94, 0x56, 0x21, 0xa4, 0x1e, 0x94, 0x04, 0x5e, 0x7f, 0x56, 0xe0, 0x6c, 0x00, 0xf4,
-	0xb4, 0x47, 0xd5, 0xed, 0x18, 0xab, 0x0d, 0x09, 0xac, 0xa9, 0xf5, 0x7a, 0x7d, 0x8a, 0x47, 0xae,
-	0x33, 0x27, 0xf5, 0xca, 0xc0, 0xa4, 0xd4, 0xab, 0x8f, 0x74, 0x5e, 0xea, 0x95, 0xe1, 0x49, 0x24,
-	0x76, 0x02, 0xe8, 0x1a, 0xbb, 0x77, 0xbf, 0x33, 0xde, 0x33, 0x1d, 0x17, 0x8f, 0xb0, 0x8d, 0xf6,
-	0x09, 0x23, 0x3d, 0x7c, 0x48, 0xf1, 0x16, 0xb6, 0x3f, 0xf8, 0xf7, 0xe5, 0xda, 0x56, 0xdf, 0x74,
-	0x1f, 0x4d, 0x1e, 0xd4, 0xbb, 0xd6, 0x50, 0x67, 0x29, 0xfd, 0x7f, 0x82, 0xcc, 0xba, 0xed, 0x0c,
-	0xf5, 0xce, 0xd8, 0xd4, 0xa9, 0x53, 0xee, 0xe1, 0x43, 0x83, 0x45, 0x41, 0x2b, 0x90, 0x7f, 0x8c,
-	0x8f, 0x28, 0xe2, 0xb2, 0x41, 0x7e, 0x6a, 0x7f, 0x96, 0xe0, 0x6c, 0x98, 0x97, 0xd2, 0x88, 0xae,
-	0x41, 0xc1, 0x31, 0x9f, 0xe2, 0x37, 0x97, 0x5e, 0xca, 0xa1, 0x4c, 0x2b, 0x67, 0xd0, 0xc5, 0xe8,
-	0x63, 0xc8, 0x3b, 0x38, 0xd3, 0x59, 0x44, 0x2b, 0x67, 0x90, 0xa5, 0x68, 0x17, 0x16, 0x4c, 0xba,
-	0x43, 0x55, 0xf3, 0x99, 0x36, 0xe0, 0xad, 0x9c, 0xe1, 0x05, 0x20, 0xa1, 0x26, 0x74, 0x4f, 0xa6,
-	0x16, 0x32, 0x6d, 0x39, 0x49, 0x28, 0x16, 0x00, 0x19, 0x50, 0x36, 0xfd, 0xcd, 0x87, 0x5a, 0xcc,
-	0xba, 0xc9, 0x6a, 0xe5, 0x8c, 0x30, 0x0c, 0x89, 0xd9, 0xf3, 0xbd, 0xb6, 0xba, 0x90, 0x75, 0x4f,
-	0x41, 0x62, 0x06, 0x61, 0x08, 0xff, 0x7d, 0xec, 0xaa, 0x25, 0x79, 0x17, 0x4d, 0xf8, 0xef, 0x33,
-	0xfe, 0x6d, 0x6a, 0xaf, 0xd4, 0xc5, 0x4c, 0xee, 0x91, 0x90, 0xc6, 0x02, 0xa0, 0x1b, 0x50, 0xec,
-	0x12, 0x4f, 0xa1, 0x96, 0xb3, 0xf8, 0xa5, 0x56, 0xce, 0x60, 0xcb, 0x49, 0x65, 0x92, 0x86, 0xaf,
-	0x42, 0x06, 0x8b, 0x40, 0x2a, 0x93, 0x2c, 0xa6, 0xc5, 0x40, 0xe7, 0x86, 0x5a, 0xc9, 0x34, 0x16,
-	0x69, 0x31, 0xd0, 0x4b, 0xb4, 0x07, 0x25, 0xcc, 0x9a, 0xa5, 0x5a, 0xcd, 0x36, 0x0b, 0x5a, 0x39,
-	0xc3, 0x0f, 0x41, 0x80, 0x61, 0xda, 0x20, 0xd4, 0xa5, 0x4c, 0xfd, 0x8f, 0x00, 0x63, 0x01, 0xb6,
-	0x4b, 0x5e, 0x27, 0xd5, 0xfe, 0x29, 0xc1, 0x4a, 0xa8, 0x6f, 0xd6, 0x79, 0xd0, 0x4e, 0x44, 0xe0,
-	0x92, 0xe7, 0x99, 0x81, 0xc2, 0xaf, 0xf2, 0x0a, 0x97, 0x3b, 0xc6, 0xf3, 0x25, 0xde, 0x8e, 0x49,
-	0x5c, 0xfa, 0xd8, 0x8a, 0xd3, 0x78, 0x3b, 0xa6, 0x71, 0xe9, 0x93, 0x1a, 0x4e, 0xe4, 0x07, 0xc7,
-	0x45, 0x9e, 0xe5, 0x58, 0x22, 0xaa, 0xf2, 0x83, 0xe3, 0x2a, 0xcf, 0xb2, 0x0d, 0x8f, 0xca, 0xfc,
-	0x2a, 0x2f, 0x73, 0xb9, 0x8d, 0xa8, 0xaf, 0xf3, 0x76, 0x4c, 0xe7, 0xd2, 0x1b, 0x2f, 0x4e, 0xe8,
-	0xcd, 0xa8, 0xd0, 0x65, 0x77, 0x1b, 0xa1, 0xd2, 0x77, 0x22, 0x4a, 0x97, 0xf4, 0xd8, 0x81, 0xd4,
-	0xdb, 0x31, 0xa9, 0x4b, 0x9b, 0x4a, 0x4e, 0xeb, 0xfb, 0x71, 0xad, 0xcb, 0x7b, 0x29, 0x5e, 0xec,
-	0xed, 0x98, 0xd8, 0xa5, 0xfd, 0x03, 0xa7, 0xf6, 0x45, 0xdf, 0x8b, 0x68, 0x15, 0x28, 0x07, 0xa3,
-	0x58, 0x3b, 0x0f, 0x10, 0xca, 0x16, 0x21, 0x4e, 0xf4, 0x4b, 0x4c, 0xc2, 0xda, 0x26, 0x2c, 0xfa,
-	0x53, 0xd7, 0xf7, 0x06, 0x4a, 0xe0, 0x0d, 0xd0, 0x39, 0x28, 0x4e, 0x3b, 0x83, 0x09, 0xa6, 0x12,
-	0xcf, 0x1b, 0xec, 0x42, 0x7b, 0x0f, 0xca, 0x81, 0x8e, 0xd1, 0xdb, 0x00, 0x63, 0x1b, 0x4f, 0x3f,
-	0x63, 0xf7, 0x29, 0xf4, 0xbe, 0x32, 0xf9, 0xcb, 0x7d, 0x7a, 0xef, 0x65, 0xa8, 0x70, 0x03, 0x59,
-	0x38, 0xc5, 0x32, 0x54, 0x79, 0x91, 0x6b, 0x9f, 0x40, 0x85, 0x1b, 0xc6, 0xa2, 0x61, 0x62, 0xe0,
-	0xf2, 0x71, 0x70, 0xeb, 0x50, 0xe5, 0xe5, 0x7f, 0xd2, 0xb3, 0x7c, 0x08, 0xcb, 0xd1, 0x19, 0x9e,
-	0x8c, 0xa3, 0x87, 0x07, 0x6e, 0xc7, 0xc7, 0x41, 0x2f, 0xb4, 0x0d, 0x38, 0x1b, 0x6b, 0x0c, 0x02,
-	0xb9, 0xa2, 0xb3, 0x5d, 0x26, 0x57, 0xac, 0x5f, 0x9c, 0x94, 0xeb, 0x2d, 0x58, 0x6c, 0xa6, 0xd6,
-	0x80, 0x76, 0x01, 0xca, 0x41, 0xc3, 0x08, 0x69, 0x56, 0xf8, 0xb7, 0x75, 0x05, 0x2a, 0xdc, 0xd4,
-	0x3f, 0xee, 0x31, 0x4f, 0x7a, 0x0f, 0xef, 0x40, 0x95, 0xef, 0x26, 0x29, 0x59, 0xaa, 0x00, 0xa1,
-	0x23, 0xd0, 0x96, 0xa0, 0xc2, 0xb5, 0x0d, 0xed, 0x53, 0x28, 0x07, 0x73, 0x9e, 0x14, 0xfa, 0x63,
-	0x7c, 0x44, 0x2c, 0x7e, 0xfe, 0x62, 0xd9, 0xa0, 0xbf, 0xd1, 0x47, 0x50, 0x72, 0xcd, 0x21, 0xb6,
-	0x02, 0xbb, 0xfe, 0xff, 0x3a, 0xfb, 0x10, 0x5a, 0xf7, 0x3f, 0x84, 0xd6, 0x77, 0xbc, 0x0f, 0xa1,
-	0xdb, 0x85, 0xaf, 0xfe, 0x5a, 0x53, 0x0c, 0xff, 0x7e, 0x92, 0x38, 0xec, 0x2c, 0xda, 0x05, 0xa8,
-	0x70, 0x56, 0x20, 0x29, 0x17, 0xa9, 0x5e, 0xbe, 0x85, 0x90, 0xe7, 0xe3, 0x27, 0x3e, 0x79, 0xbe,
-	0xcf, 0x3b, 0x6e, 0xf7, 0x11, 0x7d, 0xbe, 0x45, 0x83, 0x5d, 0x68, 0xf7, 0x61, 0x29, 0xd2, 0x2b,
-	0xd0, 0x75, 0x28, 0x92, 0x5e, 0x71, 0xe4, 0x4d, 0xe9, 0x77, 0x45, 0xbb, 0xcd, 0xd1, 0x76, 0xe1,
-	0xf9, 0xcb, 0xb5, 0x9c, 0xc1, 0x56, 0x6b, 0x6b, 0x50, 0xe1, 0x2c, 0x42, 0xc2, 0x1b, 0xbe, 0x07,
-	0x55, 0xbe, 0xad, 0xd0, 0xbc, 0xe4, 0x5a, 0x2a, 0x2f, 0x59, 0x10, 0xe4, 0x25, 0x17, 0xda, 0x97,
-	0x79, 0x28, 0xd2, 0x3f, 0x27, 0x94, 0xee, 0x1d, 0x58, 0x64, 0x43, 0x1b, 0xf7, 0x64, 0x8e, 0xd0,
-	0x69, 0x38, 0x6f, 0xfc, 0xe3, 0x5e, 0x2b, 0x67, 0x04, 0x51, 0x48, 0x73, 0x66, 0xa3, 0xbb, 0xe7,
-	0x39, 0x89, 0x86, 0x78, 0x40, 0xd6, 0x04, 0x48, 0x3c, 0x3f, 0x06, 0x09, 0xc7, 0x06, 0x5a, 0xcf,
-	0x33, 0x13, 0x12, 0xe1, 0x58, 0x2d, 0xd3, 0x70, 0x5e, 0x8c, 0xd5, 0xf3, 0xb0, 0xe8, 0xa3, 0x4e,
-	0xae, 0xee, 0xd5, 0x2b, 0x50, 0xf2, 0x60, 0x24, 0xdf, 0x10, 0xd3, 0xd0, 0x99, 0x98, 0x86, 0x56,
-	0xd7, 0xa0, 0xe4, 0xe5, 0x4d, 0x5e, 0x4f, 0x0c, 0x21, 0x7b, 0x2f, 0x3a, 0x14, 0x69, 0x95, 0x88,
-	0x76, 0xd1, 0xcd, 0xdf, 0x2b, 0x00, 0xa1, 0x83, 0x44, 0x26, 0x14, 0xc8, 0x50, 0x41, 0xba, 0xa8,
-	0x6b, 0xf4, 0x36, 0xfb, 0xab, 0x1b, 0xe2, 0x0b, 0xbc, 0xed, 0xfa, 0x43, 0xc8, 0x1f, 0x60, 0x17,
-	0xd5, 0x05, 0xad, 0xa5, 0x9f, 0x48, 0x17, 0xbe, 0xdf, 0xcb, 0x63, 0xc1, 0x02, 0x7b, 0x3d, 0xa8,
-	0x21, 0xee, 0x3f, 0xfd, 0x6c, 0x9b, 0x32, 0x4b, 0xc2, 0x84, 0xec, 0x6d, 0x8b, 0x25, 0x8c, 0x7c,
-	0x88, 0x15, 0x4b, 0x18, 0xfb, 0x22, 0x7a, 0x08, 0xe5, 0x60, 0x02, 0xa1, 0xf7, 0xa5, 0x9c, 0xac,
-	0x9f, 0xf6, 0xb2, 0xe4, 0xaa, 0x30, 0x73, 0x30, 0x8f, 0xc4, 0x32, 0xc7, 0x3f, 0xdc, 0x89, 0x65,
-	0x3e, 0xfe, 0xf9, 0xec, 0x21, 0xe4, 0x9b, 0xa2, 0xd5, 0xd3, 0x94, 0xac, 0x9e, 0x66, 0xb4, 0x7a,
-	0x98, 0xf4, 0xc4, 0x5e, 0x66, 0xe4, 0x5b, 0x8f, 0xd8, 0xcb, 0x8c, 0x7d, 0x74, 0x19, 0x40, 0x91,
-	0xce, 0x3e, 0xb4, 0x21, 0xec, 0xae, 0xfd, 0x74, 0x0d, 0x89, 0x15, 0x5e, 0x36, 0x13, 0x0a, 0x64,
-	0xfc, 0x89, 0xe9, 0x9d, 0xfb, 0x34, 0x20, 0xa6, 0xf7, 0xc8, 0x29, 0x3d, 0x91, 0x05, 0xf3, 0xda,
-	0x0d, 0x71, 0x9f, 0x2e, 0x27, 0x8b, 0xe8, 0xf9, 0xf5, 0x13, 0x58, 0x60, 0xa3, 0x0f, 0x89, 0xf7,
-	0x77, 0x47, 0x2a, 0x61, 0xf4, 0x00, 0x72, 0x43, 0x41, 0x2e, 0x94, 0xbc, 0x31, 0x8f, 0x36, 0x25,
-	0xf6, 0x0f, 0x7e, 0xd2, 0x2d, 0xa9, 0x35, 0x7e, 0xd6, 0x6d, 0xf5, 0xf9, 0xab, 0x9a, 0xf2, 0xe2,
-	0x55, 0x4d, 0xf9, 0xfb, 0x55, 0x4d, 0xf9, 0xe2, 0x75, 0x2d, 0xf7, 0xe2, 0x75, 0x2d, 0xf7, 0xc7,
-	0xeb, 0x5a, 0xee, 0xc1, 0x02, 0x0d, 0xb4, 0xf5, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x07,
-	0xe0, 0xae, 0x6e, 0x26, 0x00, 0x00,
+func (m *RangeByValueOutput) Reset()         { *m = RangeByValueOutput{} }
+func (m *RangeByValueOutput) String() string { return proto.CompactTextString(m) }
+func (*RangeByValueOutput) ProtoMessage()    {}
+func (*RangeByValueOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{62}
+}
+func (m *RangeByValueOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeByValueOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeByValueOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeByValueOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeByValueOutput.Merge(m, src)
+}
+func (m *RangeByValueOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeByValueOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeByValueOutput.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_RangeByValueOutput proto.InternalMessageInfo
 
-// CounterMapClient is the client API for CounterMap service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CounterMapClient interface {
-	// Size returns the size of the map
-	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
-	// Set sets an entry into the map
-	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
-	// Insert inserts an entry into the map
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Increment increments a counter in the map
-	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
-	// Decrement decrements a counter in the map
-	Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error)
-	// Get gets the entry for a key
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error)
-	// Entries lists all entries in the map
-	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error)
+func (m *RangeByValueOutput) GetEntries() []Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-type counterMapClient struct {
-	cc *grpc.ClientConn
+type RemoveInput struct {
+	Key       string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	PrevValue int64  `protobuf:"varint,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
 
-func NewCounterMapClient(cc *grpc.ClientConn) CounterMapClient {
-	return &counterMapClient{cc}
+func (m *RemoveInput) Reset()         { *m = RemoveInput{} }
+func (m *RemoveInput) String() string { return proto.CompactTextString(m) }
+func (*RemoveInput) ProtoMessage()    {}
+func (*RemoveInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{63}
 }
-
-func (c *counterMapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
-	out := new(SizeResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Size", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RemoveInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveInput.Merge(m, src)
+}
+func (m *RemoveInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveInput.DiscardUnknown(m)
 }
 
-func (c *counterMapClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
-	out := new(SetResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveInput proto.InternalMessageInfo
+
+func (m *RemoveInput) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return out, nil
+	return ""
 }
 
-func (c *counterMapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
-	out := new(InsertResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Insert", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveInput) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
 	}
-	return out, nil
+	return 0
 }
 
-func (c *counterMapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RemoveOutput struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (c *counterMapClient) Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error) {
-	out := new(IncrementResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Increment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveOutput) Reset()         { *m = RemoveOutput{} }
+func (m *RemoveOutput) String() string { return proto.CompactTextString(m) }
+func (*RemoveOutput) ProtoMessage()    {}
+func (*RemoveOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{64}
+}
+func (m *RemoveOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RemoveOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveOutput.Merge(m, src)
+}
+func (m *RemoveOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveOutput.DiscardUnknown(m)
 }
 
-func (c *counterMapClient) Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error) {
-	out := new(DecrementResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Decrement", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveOutput proto.InternalMessageInfo
+
+func (m *RemoveOutput) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return 0
 }
 
-func (c *counterMapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ClearInput struct {
 }
 
-func (c *counterMapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
-	out := new(RemoveResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClearInput) Reset()         { *m = ClearInput{} }
+func (m *ClearInput) String() string { return proto.CompactTextString(m) }
+func (*ClearInput) ProtoMessage()    {}
+func (*ClearInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{65}
+}
+func (m *ClearInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClearInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClearInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ClearInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearInput.Merge(m, src)
+}
+func (m *ClearInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClearInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearInput.DiscardUnknown(m)
 }
 
-func (c *counterMapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
-	out := new(ClearResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Clear", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_ClearInput proto.InternalMessageInfo
+
+type ClearOutput struct {
 }
 
-func (c *counterMapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
-	out := new(LockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Lock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClearOutput) Reset()         { *m = ClearOutput{} }
+func (m *ClearOutput) String() string { return proto.CompactTextString(m) }
+func (*ClearOutput) ProtoMessage()    {}
+func (*ClearOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{66}
+}
+func (m *ClearOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClearOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClearOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ClearOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearOutput.Merge(m, src)
+}
+func (m *ClearOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClearOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearOutput.DiscardUnknown(m)
 }
 
-func (c *counterMapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
-	out := new(UnlockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Unlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_ClearOutput proto.InternalMessageInfo
+
+type LockInput struct {
+	Keys    []string       `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timeout *time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 }
 
-func (c *counterMapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[0], "/atomix.protocols.rsm.countermap.v1.CounterMap/Events", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &counterMapEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *LockInput) Reset()         { *m = LockInput{} }
+func (m *LockInput) String() string { return proto.CompactTextString(m) }
+func (*LockInput) ProtoMessage()    {}
+func (*LockInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{67}
+}
+func (m *LockInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type CounterMap_EventsClient interface {
-	Recv() (*EventsResponse, error)
-	grpc.ClientStream
+func (m *LockInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockInput.Merge(m, src)
 }
-
-type counterMapEventsClient struct {
-	grpc.ClientStream
+func (m *LockInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockInput.DiscardUnknown(m)
 }
 
-func (x *counterMapEventsClient) Recv() (*EventsResponse, error) {
-	m := new(EventsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_LockInput proto.InternalMessageInfo
+
+func (m *LockInput) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return m, nil
+	return nil
 }
 
-func (c *counterMapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[1], "/atomix.protocols.rsm.countermap.v1.CounterMap/Entries", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &counterMapEntriesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *LockInput) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	return x, nil
+	return nil
 }
 
-type CounterMap_EntriesClient interface {
-	Recv() (*EntriesResponse, error)
-	grpc.ClientStream
+type LockOutput struct {
 }
 
-type counterMapEntriesClient struct {
-	grpc.ClientStream
+func (m *LockOutput) Reset()         { *m = LockOutput{} }
+func (m *LockOutput) String() string { return proto.CompactTextString(m) }
+func (*LockOutput) ProtoMessage()    {}
+func (*LockOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{68}
 }
-
-func (x *counterMapEntriesClient) Recv() (*EntriesResponse, error) {
-	m := new(EntriesResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *LockOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// CounterMapServer is the server API for CounterMap service.
-type CounterMapServer interface {
-	// Size returns the size of the map
-	Size(context.Context, *SizeRequest) (*SizeResponse, error)
-	// Set sets an entry into the map
-	Set(context.Context, *SetRequest) (*SetResponse, error)
-	// Insert inserts an entry into the map
-	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Increment increments a counter in the map
-	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
-	// Decrement decrements a counter in the map
-	Decrement(context.Context, *DecrementRequest) (*DecrementResponse, error)
-	// Get gets the entry for a key
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(context.Context, *LockRequest) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(*EventsRequest, CounterMap_EventsServer) error
-	// Entries lists all entries in the map
-	Entries(*EntriesRequest, CounterMap_EntriesServer) error
+func (m *LockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockOutput.Merge(m, src)
+}
+func (m *LockOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockOutput.DiscardUnknown(m)
 }
 
-// UnimplementedCounterMapServer can be embedded to have forward compatible implementations.
-type UnimplementedCounterMapServer struct {
+var xxx_messageInfo_LockOutput proto.InternalMessageInfo
+
+type UnlockInput struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (*UnimplementedCounterMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
+func (m *UnlockInput) Reset()         { *m = UnlockInput{} }
+func (m *UnlockInput) String() string { return proto.CompactTextString(m) }
+func (*UnlockInput) ProtoMessage()    {}
+func (*UnlockInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{69}
 }
-func (*UnimplementedCounterMapServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (m *UnlockInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedCounterMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+func (m *UnlockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedCounterMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (m *UnlockInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockInput.Merge(m, src)
 }
-func (*UnimplementedCounterMapServer) Increment(ctx context.Context, req *IncrementRequest) (*IncrementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+func (m *UnlockInput) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedCounterMapServer) Decrement(ctx context.Context, req *DecrementRequest) (*DecrementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Decrement not implemented")
+func (m *UnlockInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockInput.DiscardUnknown(m)
 }
-func (*UnimplementedCounterMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+var xxx_messageInfo_UnlockInput proto.InternalMessageInfo
+
+func (m *UnlockInput) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
 }
-func (*UnimplementedCounterMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+
+type UnlockOutput struct {
 }
-func (*UnimplementedCounterMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+
+func (m *UnlockOutput) Reset()         { *m = UnlockOutput{} }
+func (m *UnlockOutput) String() string { return proto.CompactTextString(m) }
+func (*UnlockOutput) ProtoMessage()    {}
+func (*UnlockOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{70}
 }
-func (*UnimplementedCounterMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+func (m *UnlockOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedCounterMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+func (m *UnlockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedCounterMapServer) Events(req *EventsRequest, srv CounterMap_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+func (m *UnlockOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockOutput.Merge(m, src)
 }
-func (*UnimplementedCounterMapServer) Entries(req *EntriesRequest, srv CounterMap_EntriesServer) error {
-	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
+func (m *UnlockOutput) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterCounterMapServer(s *grpc.Server, srv CounterMapServer) {
-	s.RegisterService(&_CounterMap_serviceDesc, srv)
+func (m *UnlockOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockOutput.DiscardUnknown(m)
 }
 
-func _CounterMap_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Size(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Size",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Size(ctx, req.(*SizeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_UnlockOutput proto.InternalMessageInfo
 
-func _CounterMap_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Set(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Set",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Set(ctx, req.(*SetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EntriesInput struct {
+	Watch bool `protobuf:"varint,1,opt,name=watch,proto3" json:"watch,omitempty"`
 }
 
-func _CounterMap_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Insert(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Insert",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Insert(ctx, req.(*InsertRequest))
+func (m *EntriesInput) Reset()         { *m = EntriesInput{} }
+func (m *EntriesInput) String() string { return proto.CompactTextString(m) }
+func (*EntriesInput) ProtoMessage()    {}
+func (*EntriesInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{71}
+}
+func (m *EntriesInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntriesInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntriesInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EntriesInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesInput.Merge(m, src)
+}
+func (m *EntriesInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntriesInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesInput.DiscardUnknown(m)
 }
 
-func _CounterMap_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Update(ctx, req.(*UpdateRequest))
+var xxx_messageInfo_EntriesInput proto.InternalMessageInfo
+
+func (m *EntriesInput) GetWatch() bool {
+	if m != nil {
+		return m.Watch
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _CounterMap_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncrementRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Increment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Increment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Increment(ctx, req.(*IncrementRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EntriesOutput struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
 }
 
-func _CounterMap_Decrement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecrementRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Decrement(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Decrement",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Decrement(ctx, req.(*DecrementRequest))
+func (m *EntriesOutput) Reset()         { *m = EntriesOutput{} }
+func (m *EntriesOutput) String() string { return proto.CompactTextString(m) }
+func (*EntriesOutput) ProtoMessage()    {}
+func (*EntriesOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{72}
+}
+func (m *EntriesOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntriesOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntriesOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EntriesOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesOutput.Merge(m, src)
+}
+func (m *EntriesOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntriesOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesOutput.DiscardUnknown(m)
 }
 
-func _CounterMap_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Get(ctx, req.(*GetRequest))
+var xxx_messageInfo_EntriesOutput proto.InternalMessageInfo
+
+func (m *EntriesOutput) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
 	}
-	return interceptor(ctx, in, info, handler)
+	return Entry{}
 }
 
-func _CounterMap_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Remove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Remove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Remove(ctx, req.(*RemoveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EventsInput struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func _CounterMap_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Clear(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Clear",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Clear(ctx, req.(*ClearRequest))
+func (m *EventsInput) Reset()         { *m = EventsInput{} }
+func (m *EventsInput) String() string { return proto.CompactTextString(m) }
+func (*EventsInput) ProtoMessage()    {}
+func (*EventsInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{73}
+}
+func (m *EventsInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EventsInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsInput.Merge(m, src)
+}
+func (m *EventsInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsInput.DiscardUnknown(m)
 }
 
-func _CounterMap_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Lock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Lock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Lock(ctx, req.(*LockRequest))
+var xxx_messageInfo_EventsInput proto.InternalMessageInfo
+
+func (m *EventsInput) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _CounterMap_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CounterMapServer).Unlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Unlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CounterMapServer).Unlock(ctx, req.(*UnlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EventsOutput struct {
+	Event Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
 }
 
-func _CounterMap_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *EventsOutput) Reset()         { *m = EventsOutput{} }
+func (m *EventsOutput) String() string { return proto.CompactTextString(m) }
+func (*EventsOutput) ProtoMessage()    {}
+func (*EventsOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{74}
+}
+func (m *EventsOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(CounterMapServer).Events(m, &counterMapEventsServer{stream})
 }
-
-type CounterMap_EventsServer interface {
-	Send(*EventsResponse) error
-	grpc.ServerStream
+func (m *EventsOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsOutput.Merge(m, src)
 }
-
-type counterMapEventsServer struct {
-	grpc.ServerStream
+func (m *EventsOutput) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *counterMapEventsServer) Send(m *EventsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *EventsOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsOutput.DiscardUnknown(m)
 }
 
-func _CounterMap_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EntriesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_EventsOutput proto.InternalMessageInfo
+
+func (m *EventsOutput) GetEvent() Event {
+	if m != nil {
+		return m.Event
 	}
-	return srv.(CounterMapServer).Entries(m, &counterMapEntriesServer{stream})
+	return Event{}
 }
 
-type CounterMap_EntriesServer interface {
-	Send(*EntriesResponse) error
-	grpc.ServerStream
+type Event struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*Event_Inserted_
+	//	*Event_Updated_
+	//	*Event_Removed_
+	Event isEvent_Event `protobuf_oneof:"event"`
 }
 
-type counterMapEntriesServer struct {
-	grpc.ServerStream
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{75}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-func (x *counterMapEntriesServer) Send(m *EntriesResponse) error {
-	return x.ServerStream.SendMsg(m)
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+type isEvent_Event interface {
+	isEvent_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-var _CounterMap_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomix.protocols.rsm.countermap.v1.CounterMap",
-	HandlerType: (*CounterMapServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Size",
-			Handler:    _CounterMap_Size_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _CounterMap_Set_Handler,
-		},
-		{
-			MethodName: "Insert",
-			Handler:    _CounterMap_Insert_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _CounterMap_Update_Handler,
-		},
-		{
-			MethodName: "Increment",
-			Handler:    _CounterMap_Increment_Handler,
-		},
-		{
-			MethodName: "Decrement",
-			Handler:    _CounterMap_Decrement_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _CounterMap_Get_Handler,
-		},
-		{
-			MethodName: "Remove",
-			Handler:    _CounterMap_Remove_Handler,
-		},
-		{
-			MethodName: "Clear",
-			Handler:    _CounterMap_Clear_Handler,
-		},
-		{
-			MethodName: "Lock",
-			Handler:    _CounterMap_Lock_Handler,
-		},
-		{
-			MethodName: "Unlock",
-			Handler:    _CounterMap_Unlock_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Events",
-			Handler:       _CounterMap_Events_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Entries",
-			Handler:       _CounterMap_Entries_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "countermap/v1/countermap.proto",
+type Event_Inserted_ struct {
+	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
 }
-
-func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Event_Updated_ struct {
+	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
 }
-
-func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Removed_ struct {
+	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
 }
 
-func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SizeInput != nil {
-		{
-			size, err := m.SizeInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (*Event_Inserted_) isEvent_Event() {}
+func (*Event_Updated_) isEvent_Event()  {}
+func (*Event_Removed_) isEvent_Event()  {}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Event) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Event) GetInserted() *Event_Inserted {
+	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
+		return x.Inserted
+	}
+	return nil
 }
 
-func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SizeOutput != nil {
-		{
-			size, err := m.SizeOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *Event) GetUpdated() *Event_Updated {
+	if x, ok := m.GetEvent().(*Event_Updated_); ok {
+		return x.Updated
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Event) GetRemoved() *Event_Removed {
+	if x, ok := m.GetEvent().(*Event_Removed_); ok {
+		return x.Removed
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_Inserted_)(nil),
+		(*Event_Updated_)(nil),
+		(*Event_Removed_)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *SetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Inserted struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *SetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SetInput != nil {
-		{
-			size, err := m.SetInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
+func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
+func (*Event_Inserted) ProtoMessage()    {}
+func (*Event_Inserted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{75, 0}
+}
+func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Event_Inserted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Inserted.Merge(m, src)
 }
-
-func (m *SetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Event_Inserted) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *SetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SetOutput != nil {
-		{
-			size, err := m.SetOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *Event_Inserted) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
 }
 
-func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
+
+func (m *Event_Inserted) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Updated struct {
+	Value     int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	PrevValue int64 `protobuf:"varint,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
 }
 
-func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InsertInput != nil {
-		{
-			size, err := m.InsertInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
+func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
+func (*Event_Updated) ProtoMessage()    {}
+func (*Event_Updated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{75, 1}
+}
+func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Event_Updated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Updated.Merge(m, src)
 }
-
-func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Event_Updated) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Updated) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
 }
 
-func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InsertOutput != nil {
-		{
-			size, err := m.InsertOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
+
+func (m *Event_Updated) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Event_Updated) GetPrevValue() int64 {
+	if m != nil {
+		return m.PrevValue
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Removed struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateInput != nil {
-		{
-			size, err := m.UpdateInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
+func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
+func (*Event_Removed) ProtoMessage()    {}
+func (*Event_Removed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{75, 2}
+}
+func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Event_Removed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Removed.Merge(m, src)
+}
+func (m *Event_Removed) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Removed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
 }
 
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
+
+func (m *Event_Removed) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Entry struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateOutput != nil {
-		{
-			size, err := m.UpdateOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (m *Entry) Reset()         { *m = Entry{} }
+func (m *Entry) String() string { return proto.CompactTextString(m) }
+func (*Entry) ProtoMessage()    {}
+func (*Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{76}
+}
+func (m *Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *IncrementRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entry.Merge(m, src)
 }
-
-func (m *IncrementRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entry.DiscardUnknown(m)
 }
 
-func (m *IncrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncrementInput != nil {
-		{
-			size, err := m.IncrementInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_Entry proto.InternalMessageInfo
+
+func (m *Entry) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IncrementResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Entry) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *IncrementResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Bounds struct {
+	Min *Bound `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max *Bound `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
 }
 
-func (m *IncrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncrementOutput != nil {
-		{
-			size, err := m.IncrementOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (m *Bounds) Reset()         { *m = Bounds{} }
+func (m *Bounds) String() string { return proto.CompactTextString(m) }
+func (*Bounds) ProtoMessage()    {}
+func (*Bounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{77}
+}
+func (m *Bounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DecrementRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Bounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bounds.Merge(m, src)
 }
-
-func (m *DecrementRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Bounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bounds.DiscardUnknown(m)
 }
 
-func (m *DecrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DecrementInput != nil {
-		{
-			size, err := m.DecrementInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_Bounds proto.InternalMessageInfo
+
+func (m *Bounds) GetMin() *Bound {
+	if m != nil {
+		return m.Min
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DecrementResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Bounds) GetMax() *Bound {
+	if m != nil {
+		return m.Max
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DecrementResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Bound struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *DecrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DecrementOutput != nil {
-		{
-			size, err := m.DecrementOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
+func (m *Bound) Reset()         { *m = Bound{} }
+func (m *Bound) String() string { return proto.CompactTextString(m) }
+func (*Bound) ProtoMessage()    {}
+func (*Bound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f8d4ceae3bab45a, []int{78}
+}
+func (m *Bound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Bound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bound.Merge(m, src)
+}
+func (m *Bound) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bound.DiscardUnknown(m)
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Bound proto.InternalMessageInfo
+
+func (m *Bound) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GetInput != nil {
-		{
-			size, err := m.GetInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*SizeRequest)(nil), "atomix.protocols.rsm.countermap.v1.SizeRequest")
+	proto.RegisterType((*SizeResponse)(nil), "atomix.protocols.rsm.countermap.v1.SizeResponse")
+	proto.RegisterType((*SetRequest)(nil), "atomix.protocols.rsm.countermap.v1.SetRequest")
+	proto.RegisterType((*SetResponse)(nil), "atomix.protocols.rsm.countermap.v1.SetResponse")
+	proto.RegisterType((*InsertRequest)(nil), "atomix.protocols.rsm.countermap.v1.InsertRequest")
+	proto.RegisterType((*InsertResponse)(nil), "atomix.protocols.rsm.countermap.v1.InsertResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "atomix.protocols.rsm.countermap.v1.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "atomix.protocols.rsm.countermap.v1.UpdateResponse")
+	proto.RegisterType((*IncrementRequest)(nil), "atomix.protocols.rsm.countermap.v1.IncrementRequest")
+	proto.RegisterType((*IncrementResponse)(nil), "atomix.protocols.rsm.countermap.v1.IncrementResponse")
+	proto.RegisterType((*DecrementRequest)(nil), "atomix.protocols.rsm.countermap.v1.DecrementRequest")
+	proto.RegisterType((*DecrementResponse)(nil), "atomix.protocols.rsm.countermap.v1.DecrementResponse")
+	proto.RegisterType((*ConfigureRequest)(nil), "atomix.protocols.rsm.countermap.v1.ConfigureRequest")
+	proto.RegisterType((*ConfigureResponse)(nil), "atomix.protocols.rsm.countermap.v1.ConfigureResponse")
+	proto.RegisterType((*SetBoundsRequest)(nil), "atomix.protocols.rsm.countermap.v1.SetBoundsRequest")
+	proto.RegisterType((*SetBoundsResponse)(nil), "atomix.protocols.rsm.countermap.v1.SetBoundsResponse")
+	proto.RegisterType((*GetRequest)(nil), "atomix.protocols.rsm.countermap.v1.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "atomix.protocols.rsm.countermap.v1.GetResponse")
+	proto.RegisterType((*TopKRequest)(nil), "atomix.protocols.rsm.countermap.v1.TopKRequest")
+	proto.RegisterType((*TopKResponse)(nil), "atomix.protocols.rsm.countermap.v1.TopKResponse")
+	proto.RegisterType((*BottomKRequest)(nil), "atomix.protocols.rsm.countermap.v1.BottomKRequest")
+	proto.RegisterType((*BottomKResponse)(nil), "atomix.protocols.rsm.countermap.v1.BottomKResponse")
+	proto.RegisterType((*RangeByValueRequest)(nil), "atomix.protocols.rsm.countermap.v1.RangeByValueRequest")
+	proto.RegisterType((*RangeByValueResponse)(nil), "atomix.protocols.rsm.countermap.v1.RangeByValueResponse")
+	proto.RegisterType((*RemoveRequest)(nil), "atomix.protocols.rsm.countermap.v1.RemoveRequest")
+	proto.RegisterType((*RemoveResponse)(nil), "atomix.protocols.rsm.countermap.v1.RemoveResponse")
+	proto.RegisterType((*ClearRequest)(nil), "atomix.protocols.rsm.countermap.v1.ClearRequest")
+	proto.RegisterType((*ClearResponse)(nil), "atomix.protocols.rsm.countermap.v1.ClearResponse")
+	proto.RegisterType((*LockRequest)(nil), "atomix.protocols.rsm.countermap.v1.LockRequest")
+	proto.RegisterType((*LockResponse)(nil), "atomix.protocols.rsm.countermap.v1.LockResponse")
+	proto.RegisterType((*UnlockRequest)(nil), "atomix.protocols.rsm.countermap.v1.UnlockRequest")
+	proto.RegisterType((*UnlockResponse)(nil), "atomix.protocols.rsm.countermap.v1.UnlockResponse")
+	proto.RegisterType((*EntriesRequest)(nil), "atomix.protocols.rsm.countermap.v1.EntriesRequest")
+	proto.RegisterType((*EntriesResponse)(nil), "atomix.protocols.rsm.countermap.v1.EntriesResponse")
+	proto.RegisterType((*EventsRequest)(nil), "atomix.protocols.rsm.countermap.v1.EventsRequest")
+	proto.RegisterType((*EventsResponse)(nil), "atomix.protocols.rsm.countermap.v1.EventsResponse")
+	proto.RegisterType((*CounterMapListener)(nil), "atomix.protocols.rsm.countermap.v1.CounterMapListener")
+	proto.RegisterType((*CounterMapInput)(nil), "atomix.protocols.rsm.countermap.v1.CounterMapInput")
+	proto.RegisterType((*CounterMapOutput)(nil), "atomix.protocols.rsm.countermap.v1.CounterMapOutput")
+	proto.RegisterType((*SizeInput)(nil), "atomix.protocols.rsm.countermap.v1.SizeInput")
+	proto.RegisterType((*SizeOutput)(nil), "atomix.protocols.rsm.countermap.v1.SizeOutput")
+	proto.RegisterType((*SetInput)(nil), "atomix.protocols.rsm.countermap.v1.SetInput")
+	proto.RegisterType((*SetOutput)(nil), "atomix.protocols.rsm.countermap.v1.SetOutput")
+	proto.RegisterType((*InsertInput)(nil), "atomix.protocols.rsm.countermap.v1.InsertInput")
+	proto.RegisterType((*InsertOutput)(nil), "atomix.protocols.rsm.countermap.v1.InsertOutput")
+	proto.RegisterType((*UpdateInput)(nil), "atomix.protocols.rsm.countermap.v1.UpdateInput")
+	proto.RegisterType((*UpdateOutput)(nil), "atomix.protocols.rsm.countermap.v1.UpdateOutput")
+	proto.RegisterType((*IncrementInput)(nil), "atomix.protocols.rsm.countermap.v1.IncrementInput")
+	proto.RegisterType((*IncrementOutput)(nil), "atomix.protocols.rsm.countermap.v1.IncrementOutput")
+	proto.RegisterType((*DecrementInput)(nil), "atomix.protocols.rsm.countermap.v1.DecrementInput")
+	proto.RegisterType((*DecrementOutput)(nil), "atomix.protocols.rsm.countermap.v1.DecrementOutput")
+	proto.RegisterType((*GetInput)(nil), "atomix.protocols.rsm.countermap.v1.GetInput")
+	proto.RegisterType((*GetOutput)(nil), "atomix.protocols.rsm.countermap.v1.GetOutput")
+	proto.RegisterType((*ConfigureInput)(nil), "atomix.protocols.rsm.countermap.v1.ConfigureInput")
+	proto.RegisterType((*ConfigureOutput)(nil), "atomix.protocols.rsm.countermap.v1.ConfigureOutput")
+	proto.RegisterType((*SetBoundsInput)(nil), "atomix.protocols.rsm.countermap.v1.SetBoundsInput")
+	proto.RegisterType((*SetBoundsOutput)(nil), "atomix.protocols.rsm.countermap.v1.SetBoundsOutput")
+	proto.RegisterType((*TopKInput)(nil), "atomix.protocols.rsm.countermap.v1.TopKInput")
+	proto.RegisterType((*TopKOutput)(nil), "atomix.protocols.rsm.countermap.v1.TopKOutput")
+	proto.RegisterType((*BottomKInput)(nil), "atomix.protocols.rsm.countermap.v1.BottomKInput")
+	proto.RegisterType((*BottomKOutput)(nil), "atomix.protocols.rsm.countermap.v1.BottomKOutput")
+	proto.RegisterType((*RangeByValueInput)(nil), "atomix.protocols.rsm.countermap.v1.RangeByValueInput")
+	proto.RegisterType((*RangeByValueOutput)(nil), "atomix.protocols.rsm.countermap.v1.RangeByValueOutput")
+	proto.RegisterType((*RemoveInput)(nil), "atomix.protocols.rsm.countermap.v1.RemoveInput")
+	proto.RegisterType((*RemoveOutput)(nil), "atomix.protocols.rsm.countermap.v1.RemoveOutput")
+	proto.RegisterType((*ClearInput)(nil), "atomix.protocols.rsm.countermap.v1.ClearInput")
+	proto.RegisterType((*ClearOutput)(nil), "atomix.protocols.rsm.countermap.v1.ClearOutput")
+	proto.RegisterType((*LockInput)(nil), "atomix.protocols.rsm.countermap.v1.LockInput")
+	proto.RegisterType((*LockOutput)(nil), "atomix.protocols.rsm.countermap.v1.LockOutput")
+	proto.RegisterType((*UnlockInput)(nil), "atomix.protocols.rsm.countermap.v1.UnlockInput")
+	proto.RegisterType((*UnlockOutput)(nil), "atomix.protocols.rsm.countermap.v1.UnlockOutput")
+	proto.RegisterType((*EntriesInput)(nil), "atomix.protocols.rsm.countermap.v1.EntriesInput")
+	proto.RegisterType((*EntriesOutput)(nil), "atomix.protocols.rsm.countermap.v1.EntriesOutput")
+	proto.RegisterType((*EventsInput)(nil), "atomix.protocols.rsm.countermap.v1.EventsInput")
+	proto.RegisterType((*EventsOutput)(nil), "atomix.protocols.rsm.countermap.v1.EventsOutput")
+	proto.RegisterType((*Event)(nil), "atomix.protocols.rsm.countermap.v1.Event")
+	proto.RegisterType((*Event_Inserted)(nil), "atomix.protocols.rsm.countermap.v1.Event.Inserted")
+	proto.RegisterType((*Event_Updated)(nil), "atomix.protocols.rsm.countermap.v1.Event.Updated")
+	proto.RegisterType((*Event_Removed)(nil), "atomix.protocols.rsm.countermap.v1.Event.Removed")
+	proto.RegisterType((*Entry)(nil), "atomix.protocols.rsm.countermap.v1.Entry")
+	proto.RegisterType((*Bounds)(nil), "atomix.protocols.rsm.countermap.v1.Bounds")
+	proto.RegisterType((*Bound)(nil), "atomix.protocols.rsm.countermap.v1.Bound")
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("countermap/v1/countermap.proto", fileDescriptor_9f8d4ceae3bab45a) }
+
+var fileDescriptor_9f8d4ceae3bab45a = []byte{
+	// 2242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x8f, 0xdb, 0xd6,
+	0x15, 0x16, 0x2d, 0x69, 0x24, 0x1d, 0x69, 0x5e, 0xb7, 0x5e, 0x30, 0x42, 0x32, 0xe3, 0x12, 0x5d,
+	0xa4, 0x41, 0x2c, 0xce, 0xc8, 0x9d, 0xf4, 0x05, 0x04, 0x8d, 0x2c, 0x47, 0x9a, 0x87, 0x1d, 0x9b,
+	0x33, 0x49, 0x81, 0x00, 0xed, 0x80, 0x23, 0x5d, 0xcb, 0xc4, 0x48, 0xa2, 0x42, 0x52, 0xea, 0x4c,
+	0x76, 0xed, 0x0f, 0x28, 0xba, 0x29, 0x90, 0x55, 0xd1, 0xa2, 0x68, 0x5a, 0xf4, 0x01, 0x14, 0x05,
+	0xfa, 0x1f, 0xb2, 0xf4, 0xb2, 0x2b, 0xb7, 0xb0, 0xff, 0x45, 0x56, 0xc5, 0xe5, 0xe5, 0xe3, 0x92,
+	0x22, 0x3d, 0xf7, 0x32, 0x12, 0xa0, 0xdd, 0x50, 0xa3, 0x73, 0xce, 0xc7, 0x8f, 0x97, 0xdf, 0xfd,
+	0xce, 0xd1, 0x85, 0x9d, 0x9e, 0x39, 0x1d, 0x3b, 0xd8, 0x1a, 0xe9, 0x13, 0x75, 0xb6, 0xaf, 0x86,
+	0x57, 0x8d, 0x89, 0x65, 0x3a, 0x26, 0x52, 0x74, 0xc7, 0x1c, 0x19, 0x57, 0xf4, 0xaa, 0x67, 0x0e,
+	0xed, 0x86, 0x65, 0x8f, 0x1a, 0xcc, 0xd7, 0x66, 0xfb, 0xf5, 0xad, 0xd9, 0xbe, 0xfa, 0x0c, 0xeb,
+	0x7d, 0x6c, 0xd9, 0xf4, 0x7b, 0xf5, 0x9d, 0x81, 0x69, 0x0e, 0x86, 0x58, 0x75, 0xaf, 0x2e, 0xa6,
+	0x4f, 0xd5, 0xfe, 0xd4, 0xd2, 0x1d, 0xc3, 0x1c, 0x7b, 0xff, 0xbf, 0x3d, 0x30, 0x07, 0xa6, 0xfb,
+	0xa7, 0x4a, 0xfe, 0xa2, 0x9f, 0x2a, 0xbf, 0x97, 0xa0, 0x7a, 0x6a, 0x7c, 0x8e, 0x35, 0xfc, 0xd9,
+	0x14, 0xdb, 0x0e, 0xfa, 0x10, 0x4a, 0x5e, 0x5a, 0x59, 0xba, 0x23, 0xbd, 0x5d, 0x6d, 0xbe, 0xdb,
+	0x48, 0x44, 0x33, 0xdb, 0x6f, 0x3c, 0x99, 0x62, 0xeb, 0xda, 0x8b, 0xeb, 0xd2, 0x18, 0xcd, 0x0f,
+	0x46, 0x87, 0x50, 0x34, 0xc6, 0x93, 0xa9, 0x23, 0xdf, 0x72, 0xb3, 0xdc, 0x6d, 0xdc, 0x7c, 0x4f,
+	0x0d, 0x82, 0xe3, 0x90, 0x04, 0xb5, 0x0a, 0xcf, 0x5f, 0xec, 0x4a, 0x1a, 0xcd, 0xa0, 0xfc, 0x49,
+	0x82, 0x1a, 0x85, 0x68, 0x4f, 0xcc, 0xb1, 0x8d, 0x51, 0x27, 0x8e, 0xf1, 0xee, 0x4d, 0x18, 0x69,
+	0xe0, 0x1c, 0xc8, 0x13, 0x58, 0x33, 0xa7, 0x4e, 0x88, 0xb2, 0xc1, 0x8b, 0xf2, 0xa3, 0xa9, 0x13,
+	0xc2, 0xf4, 0x72, 0x28, 0x7f, 0x90, 0x00, 0x4e, 0xb1, 0xe3, 0x33, 0x79, 0x18, 0x47, 0xa9, 0xa6,
+	0xa2, 0x7c, 0x6c, 0x99, 0x13, 0xd3, 0xd6, 0x87, 0x69, 0x64, 0x76, 0xa3, 0x64, 0xbe, 0xcb, 0x05,
+	0x13, 0x3b, 0x09, 0x5c, 0x7e, 0x49, 0x1e, 0x37, 0x76, 0x7c, 0x46, 0xd0, 0x51, 0x1c, 0xe4, 0x1e,
+	0x07, 0xc8, 0x14, 0x36, 0x8f, 0x63, 0x6c, 0xde, 0xe5, 0x84, 0x99, 0x48, 0xe6, 0x9f, 0x25, 0x58,
+	0x3f, 0x1c, 0xdb, 0xd8, 0x5a, 0x06, 0x9f, 0xc7, 0x51, 0x3e, 0x55, 0x1e, 0xa0, 0x14, 0x4c, 0x02,
+	0xa5, 0x7f, 0x97, 0x60, 0xc3, 0x47, 0xba, 0x04, 0x56, 0x1f, 0xc5, 0x58, 0xdd, 0xe3, 0x07, 0x9b,
+	0x4a, 0xec, 0xc7, 0x93, 0xbe, 0xee, 0xe0, 0x15, 0x21, 0x96, 0x82, 0x49, 0x21, 0xd6, 0x47, 0xba,
+	0x2a, 0xc4, 0x52, 0x3c, 0x89, 0xc4, 0xfe, 0x43, 0x82, 0xad, 0xc3, 0x71, 0xcf, 0xc2, 0x23, 0x3c,
+	0x5e, 0xc6, 0xa2, 0x7d, 0x14, 0xe5, 0xb6, 0xc9, 0xb7, 0x0e, 0x3c, 0x3c, 0x09, 0xf4, 0xfe, 0x4b,
+	0x82, 0x6d, 0x06, 0xef, 0x12, 0x18, 0x7e, 0x12, 0x63, 0xf8, 0x9e, 0x10, 0xe4, 0x54, 0x92, 0xdb,
+	0x78, 0xb5, 0x48, 0x6e, 0xe3, 0xd7, 0x93, 0xcc, 0xe0, 0x5d, 0x15, 0x92, 0xdb, 0xf8, 0x26, 0x92,
+	0xef, 0x9b, 0xe3, 0xa7, 0xc6, 0x60, 0x6a, 0xe1, 0x15, 0x21, 0x39, 0xc0, 0x93, 0x42, 0x32, 0x83,
+	0x77, 0x55, 0x48, 0x0e, 0x20, 0xa5, 0x92, 0x7c, 0x8a, 0x9d, 0x96, 0x39, 0x1d, 0xf7, 0xed, 0x15,
+	0x21, 0x39, 0xc0, 0x93, 0x42, 0x32, 0x83, 0x77, 0x55, 0x48, 0x0e, 0x20, 0x25, 0x92, 0xfc, 0x3b,
+	0x09, 0xa0, 0x83, 0x9d, 0x45, 0x9b, 0xdb, 0x2c, 0x7e, 0xac, 0x93, 0xe8, 0xc7, 0xfe, 0x28, 0x41,
+	0xb5, 0x83, 0x9d, 0xc5, 0x5b, 0xdb, 0x4c, 0x66, 0xac, 0x93, 0x62, 0xc6, 0x48, 0x93, 0x70, 0x66,
+	0x4e, 0x8e, 0x57, 0xa1, 0x49, 0x20, 0x38, 0x52, 0x9a, 0x04, 0x0a, 0x71, 0x25, 0x9a, 0x04, 0x02,
+	0x25, 0x91, 0xca, 0x2f, 0x25, 0xd8, 0x68, 0x99, 0x8e, 0x63, 0x8e, 0x16, 0xce, 0xe6, 0x49, 0x94,
+	0x4d, 0x2e, 0x3f, 0xe3, 0x41, 0x49, 0x20, 0xf4, 0x6f, 0x12, 0x6c, 0x06, 0x40, 0x17, 0xcd, 0xe9,
+	0x47, 0x31, 0x4e, 0xf7, 0x05, 0xb0, 0x26, 0xd2, 0xfa, 0x4f, 0x09, 0xbe, 0xa5, 0xe9, 0xe3, 0x01,
+	0x6e, 0x5d, 0x7f, 0xa2, 0x0f, 0xa7, 0x0b, 0x6f, 0x67, 0x9f, 0x44, 0xb9, 0x3d, 0xe0, 0xc1, 0xcb,
+	0xe2, 0x49, 0x20, 0xf8, 0xdf, 0x12, 0xdc, 0x8e, 0x42, 0x5e, 0x34, 0xcb, 0x67, 0x31, 0x96, 0xdf,
+	0x13, 0x45, 0x9d, 0xda, 0x40, 0x68, 0x78, 0x64, 0xce, 0x56, 0xa5, 0x81, 0xa0, 0x60, 0x52, 0x1a,
+	0x08, 0x1f, 0xe9, 0xaa, 0x34, 0x10, 0x14, 0x4f, 0x22, 0xb1, 0x44, 0xc2, 0xee, 0x0f, 0xb1, 0x6e,
+	0x2d, 0x81, 0xd7, 0xa3, 0x28, 0xaf, 0x5c, 0x1a, 0xe6, 0x62, 0x49, 0xa0, 0xf5, 0xaf, 0x12, 0xac,
+	0x7b, 0x38, 0x97, 0xc0, 0xea, 0xc3, 0x18, 0xab, 0x2a, 0x37, 0xd4, 0x44, 0x52, 0xc9, 0x06, 0x7b,
+	0x62, 0xf6, 0x2e, 0x97, 0xc0, 0x69, 0x96, 0xdd, 0x8b, 0x40, 0x49, 0xa0, 0xf4, 0x2f, 0x12, 0xd4,
+	0x28, 0xca, 0x25, 0x30, 0x9a, 0x69, 0x03, 0x23, 0x68, 0xd2, 0xe7, 0x07, 0xe3, 0xe1, 0x72, 0x28,
+	0xcd, 0x34, 0x3f, 0x18, 0x0f, 0x93, 0x49, 0x75, 0xe7, 0x07, 0x1e, 0xd2, 0x95, 0x99, 0x1f, 0x8c,
+	0x87, 0x69, 0xc4, 0x12, 0x67, 0xf0, 0x60, 0xec, 0x58, 0x06, 0xb6, 0x57, 0xc1, 0x19, 0x78, 0x50,
+	0x52, 0x9c, 0x41, 0x00, 0x74, 0x25, 0x9c, 0x81, 0x87, 0x26, 0x75, 0xbd, 0x3e, 0x98, 0xe1, 0xb1,
+	0x63, 0xaf, 0xc8, 0x7a, 0xa5, 0x60, 0x52, 0xd6, 0xab, 0x8f, 0x74, 0x55, 0xd6, 0x2b, 0xc5, 0x93,
+	0x48, 0xec, 0x15, 0xa0, 0xfb, 0xf4, 0xbb, 0x0f, 0xf5, 0xc9, 0x89, 0x61, 0x3b, 0x78, 0x8c, 0x2d,
+	0xf4, 0x98, 0x30, 0xd2, 0xc7, 0x57, 0x2e, 0xde, 0x42, 0xeb, 0x47, 0x5f, 0xbf, 0xd8, 0x7d, 0x6f,
+	0x60, 0x38, 0xcf, 0xa6, 0x17, 0x8d, 0x9e, 0x39, 0x52, 0x67, 0x13, 0xdd, 0xee, 0x99, 0xfa, 0xd0,
+	0x52, 0x69, 0x71, 0x35, 0x28, 0xae, 0x5a, 0xf6, 0x48, 0xd5, 0x27, 0x86, 0xea, 0xce, 0x80, 0xfa,
+	0xf8, 0x4a, 0xa3, 0x89, 0xd0, 0x16, 0xe4, 0x2f, 0xf1, 0xb5, 0x0b, 0xba, 0xa2, 0x91, 0x3f, 0x95,
+	0xaf, 0x01, 0x36, 0xc3, 0xd2, 0x2e, 0x93, 0xe8, 0x3e, 0x14, 0x6c, 0xe3, 0x73, 0xfc, 0xfa, 0xd5,
+	0x97, 0xf2, 0x73, 0x43, 0x37, 0xa7, 0xb9, 0xc1, 0xe8, 0x27, 0x90, 0xb7, 0x71, 0xa6, 0x29, 0x7b,
+	0x37, 0xa7, 0x91, 0x50, 0x74, 0x08, 0x6b, 0x86, 0x3b, 0x7b, 0x95, 0xf3, 0x99, 0x46, 0xcb, 0xdd,
+	0x9c, 0xe6, 0x25, 0x20, 0xa9, 0xa6, 0xee, 0xb4, 0x51, 0x2e, 0x64, 0x1a, 0xa6, 0x92, 0x54, 0x34,
+	0x01, 0xd2, 0xa0, 0x62, 0xf8, 0x63, 0x35, 0xb9, 0x98, 0x75, 0x7c, 0xd8, 0xcd, 0x69, 0x61, 0x1a,
+	0x92, 0xb3, 0xef, 0x4f, 0x91, 0xe4, 0xb5, 0xac, 0xd3, 0x32, 0x92, 0x33, 0x48, 0x43, 0xf8, 0x1f,
+	0x60, 0x47, 0x2e, 0x89, 0x77, 0xd5, 0x84, 0xff, 0x01, 0xe5, 0xdf, 0x72, 0x1d, 0x96, 0x5c, 0xce,
+	0x64, 0x20, 0x09, 0x69, 0x34, 0x01, 0xfa, 0x10, 0x8a, 0x3d, 0x62, 0x2b, 0xe4, 0x4a, 0x16, 0xcb,
+	0xd4, 0xcd, 0x69, 0x34, 0x9c, 0xac, 0x4c, 0xa2, 0xf9, 0x32, 0x64, 0x70, 0x09, 0x64, 0x65, 0x92,
+	0x60, 0x77, 0x31, 0xb8, 0x5b, 0x87, 0x5c, 0xcd, 0xb4, 0x33, 0xba, 0x8b, 0xc1, 0xbd, 0x44, 0x27,
+	0x50, 0xc2, 0x54, 0x2f, 0xe5, 0x5a, 0xb6, 0xed, 0xa0, 0x9b, 0xd3, 0xfc, 0x14, 0x04, 0x18, 0x76,
+	0x35, 0x42, 0x5e, 0xcf, 0x24, 0x81, 0x04, 0x18, 0x4d, 0x40, 0x56, 0x54, 0xcf, 0x1f, 0x99, 0xc9,
+	0x1b, 0x59, 0x47, 0x83, 0x64, 0x45, 0x05, 0x69, 0xd0, 0x29, 0x80, 0x8d, 0x9d, 0xf3, 0x0b, 0x77,
+	0x44, 0x24, 0x6f, 0x66, 0x1d, 0x85, 0x91, 0xa4, 0xb6, 0xff, 0x09, 0x6a, 0x43, 0xd1, 0x31, 0x27,
+	0xe7, 0x97, 0xf2, 0x56, 0x86, 0xb1, 0x05, 0x79, 0xa4, 0x8e, 0x39, 0x39, 0x46, 0x0f, 0xa1, 0x7c,
+	0xe1, 0x76, 0xb4, 0xe7, 0x97, 0xf2, 0x76, 0xb6, 0x8e, 0x9d, 0x3c, 0x08, 0x9a, 0xe3, 0x18, 0xfd,
+	0x0c, 0x36, 0x2c, 0xd2, 0xba, 0x9d, 0x5f, 0x5c, 0x9f, 0xcf, 0x48, 0xf3, 0x26, 0xa3, 0x6f, 0xd0,
+	0xaa, 0x76, 0x73, 0x5a, 0xcd, 0x62, 0x3e, 0x6c, 0x95, 0xbc, 0x9d, 0x4e, 0xf9, 0x75, 0x95, 0x0c,
+	0x87, 0x7d, 0xf1, 0xa5, 0x3b, 0x03, 0x6a, 0x47, 0xd4, 0x57, 0xf0, 0x67, 0xd4, 0x40, 0x7e, 0x3f,
+	0x60, 0xe5, 0x57, 0xec, 0xd7, 0x43, 0x5f, 0x7f, 0x8f, 0x62, 0xfa, 0x2b, 0xfc, 0x6b, 0x19, 0x23,
+	0xc0, 0x47, 0x31, 0x01, 0x16, 0xfe, 0x81, 0x88, 0x51, 0xe0, 0xd3, 0x79, 0x05, 0xce, 0xf2, 0x6b,
+	0x48, 0x54, 0x82, 0x4f, 0xe7, 0x25, 0x38, 0xcb, 0xf4, 0x3f, 0xaa, 0xc1, 0x1f, 0xb0, 0x1a, 0x2c,
+	0x36, 0x35, 0xf4, 0x45, 0xf8, 0x28, 0x26, 0xc2, 0xc2, 0x8d, 0x31, 0xa3, 0xc2, 0x9d, 0xa8, 0x0a,
+	0x8b, 0x76, 0x83, 0xa1, 0x0c, 0xb7, 0x23, 0x32, 0x2c, 0xd8, 0x03, 0x05, 0x3a, 0x7c, 0x14, 0xd3,
+	0x61, 0x61, 0xd3, 0xcf, 0x08, 0xf1, 0xc3, 0xb8, 0x10, 0x8b, 0x7b, 0x5d, 0x56, 0x89, 0x8f, 0x62,
+	0x4a, 0x2c, 0xec, 0xef, 0x18, 0x29, 0x3e, 0x9d, 0x97, 0xe2, 0x2c, 0x3f, 0x79, 0x44, 0xb5, 0xf8,
+	0x2c, 0x41, 0x8b, 0xb3, 0xcc, 0xf8, 0xa3, 0x62, 0xfc, 0x20, 0x2a, 0xc6, 0x82, 0xd3, 0xd9, 0x40,
+	0x8d, 0x1f, 0xcd, 0xa9, 0xb1, 0xf8, 0x4c, 0x92, 0x95, 0xe3, 0x9f, 0xa7, 0xc8, 0x71, 0xc6, 0x19,
+	0xdc, 0x9c, 0x1e, 0x97, 0x7d, 0x37, 0xaf, 0x54, 0xa1, 0x12, 0x38, 0x59, 0xe5, 0x0e, 0x40, 0x28,
+	0xac, 0x08, 0x31, 0xb2, 0xbc, 0x4e, 0x45, 0x56, 0x69, 0x42, 0xd9, 0x37, 0xad, 0xbe, 0xb5, 0x96,
+	0x02, 0x6b, 0x8d, 0x6e, 0x43, 0x91, 0xa2, 0x25, 0x22, 0x9c, 0xd7, 0xe8, 0x85, 0xf2, 0x0e, 0x54,
+	0x02, 0xa5, 0x45, 0x6f, 0x01, 0x4c, 0x2c, 0x3c, 0xf3, 0xee, 0x4a, 0x72, 0xbf, 0x57, 0x21, 0x9f,
+	0xb8, 0xc0, 0x94, 0x03, 0xa8, 0x32, 0x7e, 0x96, 0xbb, 0xc4, 0x06, 0xd4, 0x58, 0x19, 0x56, 0xce,
+	0xa0, 0xca, 0x78, 0x59, 0xde, 0x34, 0x31, 0x70, 0xf9, 0x38, 0xb8, 0xbb, 0x50, 0x63, 0x05, 0xfa,
+	0xa6, 0x7b, 0x39, 0x23, 0x27, 0x3b, 0x58, 0xbb, 0x9a, 0x8c, 0xa3, 0x8f, 0x87, 0x8e, 0xee, 0xe3,
+	0x70, 0x2f, 0x50, 0x1d, 0xca, 0xb6, 0xee, 0x4c, 0x2d, 0xdd, 0xa1, 0x28, 0xca, 0x5a, 0x70, 0xad,
+	0xec, 0xc1, 0x66, 0x4c, 0xd6, 0x39, 0x70, 0xb4, 0xf1, 0x32, 0x70, 0xb4, 0xb1, 0x10, 0x8e, 0x37,
+	0xa1, 0xdc, 0x49, 0x5d, 0x3b, 0x0a, 0x86, 0x4a, 0xb0, 0x15, 0x84, 0x8f, 0x47, 0x62, 0x1f, 0x4f,
+	0x0b, 0xd6, 0xbc, 0xd7, 0x9f, 0x6e, 0xf2, 0xef, 0xf0, 0xbd, 0x63, 0x24, 0x42, 0xf3, 0x22, 0x09,
+	0x19, 0x51, 0xc7, 0xc7, 0x64, 0x95, 0x32, 0x67, 0xdd, 0x86, 0xcd, 0x20, 0xab, 0xb7, 0x04, 0x9f,
+	0xc2, 0x46, 0xd4, 0x05, 0x26, 0xb0, 0xbe, 0x88, 0x1b, 0xda, 0x86, 0xcd, 0x98, 0xc2, 0x29, 0x6f,
+	0x40, 0x25, 0x30, 0x8c, 0xa8, 0x06, 0xd2, 0xa5, 0xf7, 0x0a, 0x4b, 0x97, 0xca, 0x4f, 0x01, 0x42,
+	0xf9, 0x22, 0xb3, 0x0c, 0x7f, 0x0f, 0x91, 0xee, 0xe4, 0xdf, 0xae, 0x36, 0xbf, 0xcb, 0xbb, 0x87,
+	0x5c, 0xb7, 0x0a, 0x5f, 0xbd, 0xd8, 0x0d, 0xf7, 0x0f, 0xe5, 0x4d, 0xa8, 0xb1, 0xde, 0x32, 0x56,
+	0xf6, 0x53, 0x58, 0x8f, 0x68, 0xdd, 0x22, 0x2b, 0x8f, 0x60, 0x7b, 0xce, 0x80, 0x2e, 0xe2, 0xa1,
+	0x92, 0x45, 0x38, 0x34, 0x46, 0x06, 0xb5, 0x94, 0xeb, 0x1a, 0xbd, 0x50, 0xce, 0x01, 0xcd, 0x0b,
+	0xec, 0x22, 0xef, 0xe7, 0x7d, 0xa8, 0x32, 0x2d, 0xe5, 0xfc, 0x00, 0xe3, 0x26, 0x95, 0xfa, 0x0e,
+	0xd4, 0x58, 0x37, 0x94, 0xfc, 0x2e, 0x29, 0x35, 0x80, 0xb0, 0xdd, 0x54, 0xd6, 0xa1, 0xca, 0xd8,
+	0x1e, 0xe5, 0x53, 0xa8, 0x04, 0x4d, 0x24, 0xd9, 0x06, 0x2e, 0xf1, 0x35, 0xbd, 0xaf, 0x8a, 0xe6,
+	0xfe, 0x8d, 0x7e, 0x08, 0x25, 0xc7, 0x18, 0x61, 0x33, 0x18, 0x07, 0xbd, 0xd1, 0xa0, 0xe7, 0x47,
+	0x1b, 0xfe, 0xf9, 0xd1, 0x46, 0xdb, 0x3b, 0x3f, 0xda, 0x2a, 0x7c, 0xf1, 0xdf, 0x5d, 0x49, 0xf3,
+	0xbf, 0x4f, 0x0a, 0x87, 0xce, 0x48, 0xf9, 0x36, 0x54, 0x99, 0x3e, 0x33, 0xa9, 0x16, 0xd1, 0x76,
+	0xd6, 0x02, 0x91, 0xfb, 0x63, 0xdb, 0x49, 0x72, 0x7f, 0xbf, 0xd0, 0x9d, 0xde, 0x33, 0xf7, 0xfe,
+	0xca, 0x1a, 0xbd, 0x50, 0x3e, 0x81, 0xf5, 0x88, 0xd7, 0x21, 0x3b, 0x3d, 0x61, 0xf8, 0xda, 0x5b,
+	0x10, 0xc2, 0xcf, 0x87, 0x46, 0x2b, 0xbb, 0x50, 0x65, 0xfa, 0xcf, 0x04, 0x1d, 0xfb, 0x18, 0x6a,
+	0xac, 0x2d, 0x72, 0xeb, 0x92, 0x6b, 0xa1, 0xba, 0x24, 0x20, 0xa8, 0x4b, 0x2e, 0x94, 0xdf, 0xe6,
+	0xa1, 0xe8, 0x7e, 0x9c, 0x20, 0x23, 0x8f, 0xa1, 0x4c, 0x9b, 0x0e, 0xdc, 0x17, 0x39, 0xaf, 0xe1,
+	0xa6, 0xf3, 0xda, 0x17, 0xdc, 0xef, 0xe6, 0xb4, 0x20, 0x0b, 0x31, 0x97, 0xb4, 0xf5, 0xe8, 0xcb,
+	0x79, 0x7e, 0x3b, 0x43, 0x13, 0xd2, 0x2d, 0x92, 0xe4, 0xf3, 0x73, 0x90, 0x74, 0xd4, 0x90, 0xf7,
+	0xe5, 0x82, 0x68, 0x3a, 0xba, 0x96, 0xdd, 0x74, 0x5e, 0x8e, 0xfa, 0x1d, 0x28, 0xfb, 0xa8, 0x93,
+	0x57, 0x77, 0xfd, 0x7d, 0x28, 0x79, 0x30, 0x52, 0xb6, 0x92, 0xe8, 0x3b, 0x74, 0x2b, 0xf6, 0x0e,
+	0xd5, 0x77, 0xa1, 0xe4, 0xd5, 0x4d, 0x8e, 0x27, 0x0d, 0x2d, 0x7d, 0x2e, 0x2a, 0x14, 0xdd, 0x55,
+	0xc2, 0x6d, 0x55, 0x7e, 0x25, 0xc1, 0x9a, 0x67, 0x3e, 0x7f, 0x0c, 0xf9, 0x91, 0x31, 0x16, 0x59,
+	0x18, 0x6e, 0xa0, 0x46, 0xa2, 0xdc, 0x60, 0xfd, 0x4a, 0xbe, 0x25, 0x1e, 0xac, 0x5f, 0x29, 0x6f,
+	0x41, 0xd1, 0xbd, 0x4a, 0xbe, 0xbb, 0xe6, 0x17, 0x5b, 0x00, 0x61, 0x97, 0x8e, 0x0c, 0x28, 0x10,
+	0x5b, 0x88, 0x54, 0xde, 0xce, 0xdc, 0x1b, 0x78, 0xd7, 0xf7, 0xf8, 0x03, 0xbc, 0x91, 0xf5, 0x53,
+	0xc8, 0x9f, 0x62, 0x07, 0x35, 0x38, 0x8d, 0xbd, 0x5f, 0x48, 0xe5, 0xfe, 0xbe, 0x57, 0xc7, 0x84,
+	0x35, 0xba, 0x84, 0xd0, 0x3e, 0x7f, 0x8f, 0xef, 0x57, 0x6b, 0x8a, 0x84, 0x84, 0x05, 0xe9, 0x8a,
+	0xe4, 0x2b, 0x18, 0x39, 0x63, 0x5b, 0x6f, 0x8a, 0x84, 0x78, 0x05, 0xaf, 0xa0, 0x12, 0xf8, 0x44,
+	0xf4, 0x3d, 0xa1, 0x69, 0x81, 0x5f, 0xf6, 0x40, 0x30, 0x2a, 0xac, 0xdc, 0xc6, 0x42, 0x95, 0xdb,
+	0x38, 0x4b, 0xe5, 0xf9, 0x93, 0x91, 0x57, 0x50, 0x09, 0x6c, 0x18, 0x5f, 0xe5, 0xf8, 0x41, 0xc5,
+	0xfa, 0x81, 0x60, 0x54, 0x58, 0x39, 0x70, 0x61, 0x7c, 0x95, 0xe3, 0xa7, 0xf7, 0xea, 0x07, 0x82,
+	0x51, 0xe1, 0x1b, 0xd3, 0xe1, 0x7d, 0x63, 0x3a, 0x82, 0x6f, 0x4c, 0x27, 0xfa, 0xc6, 0x50, 0x49,
+	0xe4, 0x5b, 0xc0, 0x91, 0x33, 0x1e, 0xf5, 0xa6, 0x48, 0x88, 0x57, 0xd0, 0x80, 0x02, 0xb1, 0xaa,
+	0x7c, 0xaa, 0xc3, 0x9c, 0x2f, 0xab, 0xef, 0xf1, 0x07, 0x78, 0xa5, 0x2c, 0x28, 0x79, 0xf6, 0x14,
+	0x35, 0x05, 0xfa, 0x76, 0xbf, 0xe0, 0x3d, 0xa1, 0x18, 0xaf, 0xe6, 0x2f, 0x25, 0xa8, 0xb1, 0x46,
+	0x12, 0x7d, 0x5f, 0xb4, 0xb7, 0xf7, 0xcb, 0xff, 0x40, 0x3c, 0xd0, 0xc3, 0x30, 0x84, 0xa2, 0x6b,
+	0xfb, 0xd0, 0x1e, 0xf7, 0x60, 0xcc, 0x2f, 0xba, 0x2f, 0x10, 0x11, 0x3e, 0x50, 0xe2, 0xfc, 0xf8,
+	0x1e, 0x28, 0x73, 0xea, 0xa2, 0xbe, 0xc7, 0x1f, 0xc0, 0xa8, 0x2d, 0x1d, 0x93, 0xed, 0xf3, 0x8f,
+	0xd8, 0xc4, 0xd4, 0x36, 0x7a, 0x34, 0xe0, 0x33, 0x58, 0xa3, 0xae, 0x0f, 0xf1, 0x5b, 0x1b, 0x5b,
+	0xa8, 0x60, 0xf4, 0xb7, 0xdd, 0x3d, 0x09, 0x39, 0x50, 0xf2, 0x1c, 0x2e, 0xdf, 0xa2, 0x8d, 0x9e,
+	0x0e, 0xa8, 0xdf, 0x13, 0x8a, 0xf1, 0xab, 0xb6, 0xe4, 0xaf, 0x5e, 0xee, 0x48, 0xcf, 0x5f, 0xee,
+	0x48, 0xff, 0x7b, 0xb9, 0x23, 0xfd, 0xe6, 0xd5, 0x4e, 0xee, 0xf9, 0xab, 0x9d, 0xdc, 0x7f, 0x5e,
+	0xed, 0xe4, 0x2e, 0xd6, 0xdc, 0x44, 0xf7, 0xfe, 0x3f, 0x00, 0x32, 0x13, 0xa2, 0x71, 0xa0, 0x36,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CounterMapClient is the client API for CounterMap service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CounterMapClient interface {
+	// Size returns the size of the map
+	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
+	// Set sets an entry into the map
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	// Insert inserts an entry into the map
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Increment increments a counter in the map
+	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
+	// Decrement decrements a counter in the map
+	Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error)
+	// Configure sets the default bounds of counters in the map when the map is created
+	// The map is configured only once; subsequent configurations are ignored.
+	Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error)
+	// SetBounds sets the bounds of a counter in the map
+	SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error)
+	// Get gets the entry for a key
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	// TopK gets the k entries with the highest values
+	TopK(ctx context.Context, in *TopKRequest, opts ...grpc.CallOption) (*TopKResponse, error)
+	// BottomK gets the k entries with the lowest values
+	BottomK(ctx context.Context, in *BottomKRequest, opts ...grpc.CallOption) (*BottomKResponse, error)
+	// RangeByValue gets the entries with values within a range
+	RangeByValue(ctx context.Context, in *RangeByValueRequest, opts ...grpc.CallOption) (*RangeByValueResponse, error)
+	// Clear removes all entries from the map
+	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error)
+	// Entries lists all entries in the map
+	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error)
+}
+
+type counterMapClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCounterMapClient(cc *grpc.ClientConn) CounterMapClient {
+	return &counterMapClient{cc}
+}
+
+func (c *counterMapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
+	out := new(SizeResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Size", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GetOutput != nil {
-		{
-			size, err := m.GetOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *counterMapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
+	out := new(InsertResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Update", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error) {
+	out := new(IncrementResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Increment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RemoveInput != nil {
-		{
-			size, err := m.RemoveInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *counterMapClient) Decrement(ctx context.Context, in *DecrementRequest, opts ...grpc.CallOption) (*DecrementResponse, error) {
+	out := new(DecrementResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Decrement", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error) {
+	out := new(ConfigureResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Configure", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RemoveOutput != nil {
-		{
-			size, err := m.RemoveOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *counterMapClient) SetBounds(ctx context.Context, in *SetBoundsRequest, opts ...grpc.CallOption) (*SetBoundsResponse, error) {
+	out := new(SetBoundsResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/SetBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClearInput != nil {
-		{
-			size, err := m.ClearInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *counterMapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) TopK(ctx context.Context, in *TopKRequest, opts ...grpc.CallOption) (*TopKResponse, error) {
+	out := new(TopKResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/TopK", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) BottomK(ctx context.Context, in *BottomKRequest, opts ...grpc.CallOption) (*BottomKResponse, error) {
+	out := new(BottomKResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/BottomK", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClearOutput != nil {
-		{
-			size, err := m.ClearOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *counterMapClient) RangeByValue(ctx context.Context, in *RangeByValueRequest, opts ...grpc.CallOption) (*RangeByValueResponse, error) {
+	out := new(RangeByValueResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/RangeByValue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
+	out := new(ClearResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Clear", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockInput != nil {
-		{
-			size, err := m.LockInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *counterMapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.countermap.v1.CounterMap/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *counterMapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (CounterMap_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[0], "/atomix.protocols.rsm.countermap.v1.CounterMap/Events", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &counterMapEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CounterMap_EventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockOutput != nil {
-		{
-			size, err := m.LockOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type counterMapEventsClient struct {
+	grpc.ClientStream
 }
 
-func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *counterMapEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockInput != nil {
-		{
-			size, err := m.UnlockInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *counterMapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (CounterMap_EntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CounterMap_serviceDesc.Streams[1], "/atomix.protocols.rsm.countermap.v1.CounterMap/Entries", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	x := &counterMapEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CounterMap_EntriesClient interface {
+	Recv() (*EntriesResponse, error)
+	grpc.ClientStream
 }
 
-func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockOutput != nil {
-		{
-			size, err := m.UnlockOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type counterMapEntriesClient struct {
+	grpc.ClientStream
 }
 
-func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *counterMapEntriesClient) Recv() (*EntriesResponse, error) {
+	m := new(EntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CounterMapServer is the server API for CounterMap service.
+type CounterMapServer interface {
+	// Size returns the size of the map
+	Size(context.Context, *SizeRequest) (*SizeResponse, error)
+	// Set sets an entry into the map
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	// Insert inserts an entry into the map
+	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Increment increments a counter in the map
+	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
+	// Decrement decrements a counter in the map
+	Decrement(context.Context, *DecrementRequest) (*DecrementResponse, error)
+	// Configure sets the default bounds of counters in the map when the map is created
+	// The map is configured only once; subsequent configurations are ignored.
+	Configure(context.Context, *ConfigureRequest) (*ConfigureResponse, error)
+	// SetBounds sets the bounds of a counter in the map
+	SetBounds(context.Context, *SetBoundsRequest) (*SetBoundsResponse, error)
+	// Get gets the entry for a key
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+	// TopK gets the k entries with the highest values
+	TopK(context.Context, *TopKRequest) (*TopKResponse, error)
+	// BottomK gets the k entries with the lowest values
+	BottomK(context.Context, *BottomKRequest) (*BottomKResponse, error)
+	// RangeByValue gets the entries with values within a range
+	RangeByValue(context.Context, *RangeByValueRequest) (*RangeByValueResponse, error)
+	// Clear removes all entries from the map
+	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(*EventsRequest, CounterMap_EventsServer) error
+	// Entries lists all entries in the map
+	Entries(*EntriesRequest, CounterMap_EntriesServer) error
 }
 
-func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EntriesInput != nil {
-		{
-			size, err := m.EntriesInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedCounterMapServer can be embedded to have forward compatible implementations.
+type UnimplementedCounterMapServer struct {
 }
 
-func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedCounterMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
+}
+func (*UnimplementedCounterMapServer) Set(ctx context.Context, req *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedCounterMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (*UnimplementedCounterMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedCounterMapServer) Increment(ctx context.Context, req *IncrementRequest) (*IncrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+}
+func (*UnimplementedCounterMapServer) Decrement(ctx context.Context, req *DecrementRequest) (*DecrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decrement not implemented")
+}
+func (*UnimplementedCounterMapServer) Configure(ctx context.Context, req *ConfigureRequest) (*ConfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
+}
+func (*UnimplementedCounterMapServer) SetBounds(ctx context.Context, req *SetBoundsRequest) (*SetBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBounds not implemented")
+}
+func (*UnimplementedCounterMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedCounterMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (*UnimplementedCounterMapServer) TopK(ctx context.Context, req *TopKRequest) (*TopKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopK not implemented")
+}
+func (*UnimplementedCounterMapServer) BottomK(ctx context.Context, req *BottomKRequest) (*BottomKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BottomK not implemented")
+}
+func (*UnimplementedCounterMapServer) RangeByValue(ctx context.Context, req *RangeByValueRequest) (*RangeByValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeByValue not implemented")
+}
+func (*UnimplementedCounterMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+}
+func (*UnimplementedCounterMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedCounterMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedCounterMapServer) Events(req *EventsRequest, srv CounterMap_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (*UnimplementedCounterMapServer) Entries(req *EntriesRequest, srv CounterMap_EntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
 }
 
-func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterCounterMapServer(s *grpc.Server, srv CounterMapServer) {
+	s.RegisterService(&_CounterMap_serviceDesc, srv)
 }
 
-func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EntriesOutput != nil {
-		{
-			size, err := m.EntriesOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _CounterMap_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(CounterMapServer).Size(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Size",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Size(ctx, req.(*SizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Insert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventsInput != nil {
-		{
-			size, err := m.EventsInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _CounterMap_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(CounterMapServer).Update(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).Increment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Increment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Increment(ctx, req.(*IncrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_Decrement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Decrement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Decrement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Decrement(ctx, req.(*DecrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventsOutput != nil {
-		{
-			size, err := m.EventsOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _CounterMap_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(CounterMapServer).Configure(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Configure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Configure(ctx, req.(*ConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapListener) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_SetBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoundsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).SetBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/SetBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).SetBounds(ctx, req.(*SetBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapListener) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapListener) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+func _CounterMap_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(CounterMapServer).Remove(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _CounterMap_TopK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopKRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).TopK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/TopK",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).TopK(ctx, req.(*TopKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_BottomK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BottomKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).BottomK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/BottomK",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).BottomK(ctx, req.(*BottomKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func _CounterMap_RangeByValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeByValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(CounterMapServer).RangeByValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/RangeByValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).RangeByValue(ctx, req.(*RangeByValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapInput_Size_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _CounterMap_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Clear(ctx, req.(*ClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CounterMapInput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Size_ != nil {
+func _CounterMap_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CounterMap_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterMapServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.countermap.v1.CounterMap/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterMapServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CounterMap_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CounterMapServer).Events(m, &counterMapEventsServer{stream})
+}
+
+type CounterMap_EventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
+}
+
+type counterMapEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *counterMapEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CounterMap_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CounterMapServer).Entries(m, &counterMapEntriesServer{stream})
+}
+
+type CounterMap_EntriesServer interface {
+	Send(*EntriesResponse) error
+	grpc.ServerStream
+}
+
+type counterMapEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *counterMapEntriesServer) Send(m *EntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _CounterMap_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.protocols.rsm.countermap.v1.CounterMap",
+	HandlerType: (*CounterMapServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCountermap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+			MethodName: "Size",
+			Handler:    _CounterMap_Size_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _CounterMap_Set_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _CounterMap_Insert_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CounterMap_Update_Handler,
+		},
+		{
+			MethodName: "Increment",
+			Handler:    _CounterMap_Increment_Handler,
+		},
+		{
+			MethodName: "Decrement",
+			Handler:    _CounterMap_Decrement_Handler,
+		},
+		{
+			MethodName: "Configure",
+			Handler:    _CounterMap_Configure_Handler,
+		},
+		{
+			MethodName: "SetBounds",
+			Handler:    _CounterMap_SetBounds_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CounterMap_Get_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _CounterMap_Remove_Handler,
+		},
+		{
+			MethodName: "TopK",
+			Handler:    _CounterMap_TopK_Handler,
+		},
+		{
+			MethodName: "BottomK",
+			Handler:    _CounterMap_BottomK_Handler,
+		},
+		{
+			MethodName: "RangeByValue",
+			Handler:    _CounterMap_RangeByValue_Handler,
+		},
+		{
+			MethodName: "Clear",
+			Handler:    _CounterMap_Clear_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _CounterMap_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _CounterMap_Unlock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _CounterMap_Events_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Entries",
+			Handler:       _CounterMap_Entries_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "countermap/v1/countermap.proto",
+}
+
+func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *CounterMapInput_Set) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapInput_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Set != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.SizeInput != nil {
 		{
-			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SizeInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5267,18 +5358,9 @@ func (m *CounterMapInput_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapInput_Insert) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapInput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Insert != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5286,20 +5368,34 @@ func (m *CounterMapInput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Update) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapInput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Update != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.SizeOutput != nil {
 		{
-			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SizeOutput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5307,20 +5403,11 @@ func (m *CounterMapInput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapInput_Increment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapInput_Increment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Increment != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Increment.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5328,20 +5415,34 @@ func (m *CounterMapInput_Increment) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Decrement) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapInput_Decrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Decrement != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.SetInput != nil {
 		{
-			size, err := m.Decrement.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SetInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5349,20 +5450,11 @@ func (m *CounterMapInput_Decrement) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapInput_Get) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapInput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Get != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5370,20 +5462,34 @@ func (m *CounterMapInput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Remove) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapInput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Remove != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.SetOutput != nil {
 		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SetOutput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5391,20 +5497,11 @@ func (m *CounterMapInput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapInput_Clear) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapInput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Clear != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5412,20 +5509,34 @@ func (m *CounterMapInput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Lock) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapInput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Lock != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.InsertInput != nil {
 		{
-			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.InsertInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5433,20 +5544,11 @@ func (m *CounterMapInput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapInput_Unlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapInput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Unlock != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5454,20 +5556,34 @@ func (m *CounterMapInput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Entries) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapInput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Entries != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.InsertOutput != nil {
 		{
-			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.InsertOutput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5475,20 +5591,11 @@ func (m *CounterMapInput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapInput_Events) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapInput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Events != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5496,11 +5603,12 @@ func (m *CounterMapInput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput) Marshal() (dAtA []byte, err error) {
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5510,38 +5618,31 @@ func (m *CounterMapOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CounterMapOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Output != nil {
+	if m.UpdateInput != nil {
 		{
-			size := m.Output.Size()
-			i -= size
-			if _, err := m.Output.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.UpdateInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CounterMapOutput_Size_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapOutput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Size_ != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5553,16 +5654,30 @@ func (m *CounterMapOutput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Set) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapOutput_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Set != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateOutput != nil {
 		{
-			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UpdateOutput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5572,18 +5687,9 @@ func (m *CounterMapOutput_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapOutput_Insert) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapOutput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Insert != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5591,20 +5697,34 @@ func (m *CounterMapOutput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Update) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *IncrementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapOutput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Update != nil {
-		{
-			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+	_ = i
+	var l int
+	_ = l
+	if m.IncrementInput != nil {
+		{
+			size, err := m.IncrementInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5612,20 +5732,11 @@ func (m *CounterMapOutput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapOutput_Increment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapOutput_Increment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Increment != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Increment.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5633,20 +5744,34 @@ func (m *CounterMapOutput_Increment) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Decrement) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *IncrementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapOutput_Decrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Decrement != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.IncrementOutput != nil {
 		{
-			size, err := m.Decrement.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.IncrementOutput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5654,20 +5779,11 @@ func (m *CounterMapOutput_Decrement) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapOutput_Get) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapOutput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Get != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5675,20 +5791,34 @@ func (m *CounterMapOutput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Remove) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *DecrementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecrementRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapOutput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecrementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Remove != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.DecrementInput != nil {
 		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DecrementInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5696,20 +5826,11 @@ func (m *CounterMapOutput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapOutput_Clear) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapOutput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Clear != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5717,20 +5838,34 @@ func (m *CounterMapOutput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Lock) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *DecrementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecrementResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapOutput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecrementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Lock != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.DecrementOutput != nil {
 		{
-			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DecrementOutput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5738,20 +5873,11 @@ func (m *CounterMapOutput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapOutput_Unlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapOutput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Unlock != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5759,20 +5885,34 @@ func (m *CounterMapOutput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Entries) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *ConfigureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigureRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapOutput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Entries != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigureInput != nil {
 		{
-			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ConfigureInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5780,20 +5920,11 @@ func (m *CounterMapOutput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *CounterMapOutput_Events) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CounterMapOutput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Events != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5801,11 +5932,12 @@ func (m *CounterMapOutput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SizeInput) Marshal() (dAtA []byte, err error) {
+
+func (m *ConfigureResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5815,20 +5947,44 @@ func (m *SizeInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SizeInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigureResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ConfigureOutput != nil {
+		{
+			size, err := m.ConfigureOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SizeOutput) Marshal() (dAtA []byte, err error) {
+func (m *SetBoundsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5838,25 +5994,44 @@ func (m *SizeOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SizeOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Size_))
+	if m.SetBoundsInput != nil {
+		{
+			size, err := m.SetBoundsInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetInput) Marshal() (dAtA []byte, err error) {
+func (m *SetBoundsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5866,32 +6041,44 @@ func (m *SetInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.SetBoundsOutput != nil {
+		{
+			size, err := m.SetBoundsOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetOutput) Marshal() (dAtA []byte, err error) {
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5901,25 +6088,44 @@ func (m *SetOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+	if m.GetInput != nil {
+		{
+			size, err := m.GetInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InsertInput) Marshal() (dAtA []byte, err error) {
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5929,32 +6135,44 @@ func (m *InsertInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InsertInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.GetOutput != nil {
+		{
+			size, err := m.GetOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InsertOutput) Marshal() (dAtA []byte, err error) {
+func (m *TopKRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5964,20 +6182,44 @@ func (m *InsertOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InsertOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopKRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopKRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TopKInput != nil {
+		{
+			size, err := m.TopKInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateInput) Marshal() (dAtA []byte, err error) {
+func (m *TopKResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5987,37 +6229,44 @@ func (m *UpdateInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopKResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopKResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.TopKOutput != nil {
+		{
+			size, err := m.TopKOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateOutput) Marshal() (dAtA []byte, err error) {
+func (m *BottomKRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6027,25 +6276,44 @@ func (m *UpdateOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *BottomKRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BottomKRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+	if m.BottomKInput != nil {
+		{
+			size, err := m.BottomKInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IncrementInput) Marshal() (dAtA []byte, err error) {
+func (m *BottomKResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6055,32 +6323,44 @@ func (m *IncrementInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncrementInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *BottomKResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncrementInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BottomKResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Delta != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
+	if m.BottomKOutput != nil {
+		{
+			size, err := m.BottomKOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IncrementOutput) Marshal() (dAtA []byte, err error) {
+func (m *RangeByValueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6090,25 +6370,44 @@ func (m *IncrementOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncrementOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *RangeByValueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncrementOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RangeByValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+	if m.RangeByValueInput != nil {
+		{
+			size, err := m.RangeByValueInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DecrementInput) Marshal() (dAtA []byte, err error) {
+func (m *RangeByValueResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6118,32 +6417,44 @@ func (m *DecrementInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DecrementInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *RangeByValueResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecrementInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RangeByValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Delta != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
+	if m.RangeByValueOutput != nil {
+		{
+			size, err := m.RangeByValueOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DecrementOutput) Marshal() (dAtA []byte, err error) {
+func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6153,25 +6464,44 @@ func (m *DecrementOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DecrementOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecrementOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+	if m.RemoveInput != nil {
+		{
+			size, err := m.RemoveInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetInput) Marshal() (dAtA []byte, err error) {
+func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6181,27 +6511,44 @@ func (m *GetInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.RemoveOutput != nil {
+		{
+			size, err := m.RemoveOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetOutput) Marshal() (dAtA []byte, err error) {
+func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6211,25 +6558,44 @@ func (m *GetOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.ClearInput != nil {
+		{
+			size, err := m.ClearInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveInput) Marshal() (dAtA []byte, err error) {
+func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6239,32 +6605,44 @@ func (m *RemoveInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+	if m.ClearOutput != nil {
+		{
+			size, err := m.ClearOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveOutput) Marshal() (dAtA []byte, err error) {
+func (m *LockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6274,25 +6652,44 @@ func (m *RemoveOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.LockInput != nil {
+		{
+			size, err := m.LockInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClearInput) Marshal() (dAtA []byte, err error) {
+func (m *LockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6302,20 +6699,44 @@ func (m *ClearInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClearInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.LockOutput != nil {
+		{
+			size, err := m.LockOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClearOutput) Marshal() (dAtA []byte, err error) {
+func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6325,20 +6746,44 @@ func (m *ClearOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClearOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UnlockInput != nil {
+		{
+			size, err := m.UnlockInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LockInput) Marshal() (dAtA []byte, err error) {
+func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6348,39 +6793,44 @@ func (m *LockInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LockInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timeout != nil {
-		n79, err79 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
-		if err79 != nil {
-			return 0, err79
+	if m.UnlockOutput != nil {
+		{
+			size, err := m.UnlockOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
-		i -= n79
-		i = encodeVarintCountermap(dAtA, i, uint64(n79))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LockOutput) Marshal() (dAtA []byte, err error) {
+func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6390,20 +6840,44 @@ func (m *LockOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LockOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EntriesInput != nil {
+		{
+			size, err := m.EntriesInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnlockInput) Marshal() (dAtA []byte, err error) {
+func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6413,52 +6887,44 @@ func (m *UnlockInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnlockInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.EntriesOutput != nil {
+		{
+			size, err := m.EntriesOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UnlockOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnlockOutput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnlockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *EntriesInput) Marshal() (dAtA []byte, err error) {
+func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6468,30 +6934,44 @@ func (m *EntriesInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EntriesInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Watch {
+	if m.EventsInput != nil {
+		{
+			size, err := m.EventsInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Watch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EntriesOutput) Marshal() (dAtA []byte, err error) {
+func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6501,30 +6981,44 @@ func (m *EntriesOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EntriesOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.EventsOutput != nil {
+		{
+			size, err := m.EventsOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsInput) Marshal() (dAtA []byte, err error) {
+func (m *CounterMapListener) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6534,12 +7028,12 @@ func (m *EventsInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapListener) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapListener) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6549,12 +7043,17 @@ func (m *EventsInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Key)
 		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsOutput) Marshal() (dAtA []byte, err error) {
+func (m *CounterMapInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6564,78 +7063,59 @@ func (m *EventsOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i -= size
-		i = encodeVarintCountermap(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Size_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Event != nil {
+	if m.Size_ != nil {
 		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Set) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Inserted != nil {
+	if m.Set != nil {
 		{
-			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6647,16 +7127,16 @@ func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Insert) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Updated != nil {
+	if m.Insert != nil {
 		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6668,16 +7148,16 @@ func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Update) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Removed != nil {
+	if m.Update != nil {
 		{
-			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6689,1380 +7169,6441 @@ func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Increment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Increment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.Increment != nil {
+		{
+			size, err := m.Increment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Decrement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Decrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.Decrement != nil {
+		{
+			size, err := m.Decrement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+func (m *CounterMapInput_Get) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Get != nil {
+		{
+			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapInput_Remove) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+	if m.Remove != nil {
+		{
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Entry) Marshal() (dAtA []byte, err error) {
+func (m *CounterMapInput_Clear) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Clear != nil {
+		{
+			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapInput_Lock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CounterMapInput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+	if m.Lock != nil {
+		{
+			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
 	}
 	return len(dAtA) - i, nil
 }
+func (m *CounterMapInput_Unlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func encodeVarintCountermap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCountermap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CounterMapInput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Unlock != nil {
+		{
+			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *SizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.SizeInput != nil {
-		l = m.SizeInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+func (m *CounterMapInput_Entries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.SizeOutput != nil {
-		l = m.SizeOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapInput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Entries != nil {
+		{
+			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapInput_Events) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.SetInput != nil {
-		l = m.SetInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapInput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapInput_Configure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.SetOutput != nil {
-		l = m.SetOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapInput_Configure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Configure != nil {
+		{
+			size, err := m.Configure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapInput_SetBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.InsertInput != nil {
-		l = m.InsertInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapInput_SetBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetBounds != nil {
+		{
+			size, err := m.SetBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *InsertResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.InsertOutput != nil {
-		l = m.InsertOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+func (m *CounterMapInput_TopK) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.UpdateInput != nil {
-		l = m.UpdateInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapInput_TopK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TopK != nil {
+		{
+			size, err := m.TopK.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapInput_BottomK) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.UpdateOutput != nil {
-		l = m.UpdateOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapInput_BottomK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BottomK != nil {
+		{
+			size, err := m.BottomK.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapInput_RangeByValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncrementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapInput_RangeByValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RangeByValue != nil {
+		{
+			size, err := m.RangeByValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.IncrementInput != nil {
-		l = m.IncrementInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IncrementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.IncrementOutput != nil {
-		l = m.IncrementOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+func (m *CounterMapOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecrementRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CounterMapOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.DecrementInput != nil {
-		l = m.DecrementInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Output != nil {
+		{
+			size := m.Output.Size()
+			i -= size
+			if _, err := m.Output.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DecrementResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.DecrementOutput != nil {
-		l = m.DecrementOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+func (m *CounterMapOutput_Size_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.GetInput != nil {
-		l = m.GetInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Size_ != nil {
+		{
+			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Set) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.GetOutput != nil {
-		l = m.GetOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Set != nil {
+		{
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Insert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.RemoveInput != nil {
-		l = m.RemoveInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Insert != nil {
+		{
+			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.RemoveOutput != nil {
-		l = m.RemoveOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Increment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.ClearInput != nil {
-		l = m.ClearInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Increment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Increment != nil {
+		{
+			size, err := m.Increment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Decrement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.ClearOutput != nil {
-		l = m.ClearOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Decrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Decrement != nil {
+		{
+			size, err := m.Decrement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Get) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.LockInput != nil {
-		l = m.LockInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Get != nil {
+		{
+			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.LockOutput != nil {
-		l = m.LockOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
+		{
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Clear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.UnlockInput != nil {
-		l = m.UnlockInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Clear != nil {
+		{
+			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.UnlockOutput != nil {
-		l = m.UnlockOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Lock != nil {
+		{
+			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Unlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.EntriesInput != nil {
-		l = m.EntriesInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Unlock != nil {
+		{
+			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Entries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.EntriesOutput != nil {
-		l = m.EntriesOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Entries != nil {
+		{
+			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Events) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.EventsInput != nil {
-		l = m.EventsInput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+func (m *CounterMapOutput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_Configure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CounterMapOutput_Configure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Configure != nil {
+		{
+			size, err := m.Configure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_SetBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterMapOutput_SetBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetBounds != nil {
+		{
+			size, err := m.SetBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.EventsOutput != nil {
-		l = m.EventsOutput.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_TopK) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterMapOutput_TopK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TopK != nil {
+		{
+			size, err := m.TopK.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_BottomK) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CounterMapListener) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CounterMapOutput_BottomK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BottomK != nil {
+		{
+			size, err := m.BottomK.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovCountermap(uint64(m.Index))
+	return len(dAtA) - i, nil
+}
+func (m *CounterMapOutput_RangeByValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterMapOutput_RangeByValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RangeByValue != nil {
+		{
+			size, err := m.RangeByValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *SizeInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CounterMapInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SizeInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SizeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CounterMapInput_Size_) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SizeOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SizeOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SizeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Size_ != nil {
-		l = m.Size_.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Size_ != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Set) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SetInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Set != nil {
-		l = m.Set.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
-}
-func (m *CounterMapInput_Insert) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Insert != nil {
-		l = m.Insert.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Update) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+
+func (m *SetOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *CounterMapInput_Increment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Increment != nil {
-		l = m.Increment.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+
+func (m *SetOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *CounterMapInput_Decrement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Decrement != nil {
-		l = m.Decrement.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Get) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+
+func (m *InsertInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *CounterMapInput_Remove) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+
+func (m *InsertInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *CounterMapInput_Clear) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *InsertInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Clear != nil {
-		l = m.Clear.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Lock) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *InsertOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Lock != nil {
-		l = m.Lock.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Unlock) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UpdateInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Unlock != nil {
-		l = m.Unlock.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *CounterMapInput_Entries) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Entries != nil {
-		l = m.Entries.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapInput_Events) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UpdateOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		n += m.Output.Size()
+
+func (m *IncrementInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CounterMapOutput_Size_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Size_ != nil {
-		l = m.Size_.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+func (m *IncrementInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *CounterMapOutput_Set) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *IncrementInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Set != nil {
-		l = m.Set.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Saturate {
+		i--
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *CounterMapOutput_Insert) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Delta != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Insert != nil {
-		l = m.Insert.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Update) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *IncrementOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncrementOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Increment) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DecrementInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DecrementInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecrementInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Increment != nil {
-		l = m.Increment.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Saturate {
+		i--
+		if m.Saturate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *CounterMapOutput_Decrement) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Delta != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Delta))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Decrement != nil {
-		l = m.Decrement.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Get) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DecrementOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DecrementOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecrementOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Remove) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Clear) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Clear != nil {
-		l = m.Clear.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-func (m *CounterMapOutput_Lock) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	var l int
-	_ = l
-	if m.Lock != nil {
-		l = m.Lock.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigureInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *CounterMapOutput_Unlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Unlock != nil {
-		l = m.Unlock.Size()
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+
+func (m *ConfigureInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *CounterMapOutput_Entries) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ConfigureInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Entries != nil {
-		l = m.Entries.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CounterMapOutput_Events) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+
+func (m *ConfigureOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *SizeInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *ConfigureOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConfigureOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Size_ != 0 {
-		n += 1 + sovCountermap(uint64(m.Size_))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SetInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+func (m *SetBoundsInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+func (m *SetBoundsInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBoundsInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InsertOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetBoundsOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
-	}
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+func (m *SetBoundsOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBoundsOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IncrementInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Delta != 0 {
-		n += 1 + sovCountermap(uint64(m.Delta))
+func (m *TopKInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IncrementOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+func (m *TopKInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecrementInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TopKInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Delta != 0 {
-		n += 1 + sovCountermap(uint64(m.Delta))
+	if m.K != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.K))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DecrementOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
+func (m *TopKOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TopKOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopKOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCountermap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+func (m *BottomKInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RemoveInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
-	}
-	return n
+func (m *BottomKInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BottomKInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if m.K != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.K))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClearInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BottomKOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClearOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *BottomKOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BottomKOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovCountermap(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCountermap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Timeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LockOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RangeByValueInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnlockInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RangeByValueInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeByValueInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovCountermap(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bounds != nil {
+		{
+			size, err := m.Bounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnlockOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RangeByValueOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EntriesInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RangeByValueOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeByValueOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Watch {
-		n += 2
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCountermap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EntriesOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Entry.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventsInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RemoveInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventsOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Event.Size()
-	n += 1 + l + sovCountermap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RemoveOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Event != nil {
-		n += m.Event.Size()
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Inserted_) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClearInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Inserted != nil {
-		l = m.Inserted.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Event_Updated_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ClearOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LockInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LockInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	if m.Timeout != nil {
+		n113, err113 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err113 != nil {
+			return 0, err113
+		}
+		i -= n113
+		i = encodeVarintCountermap(dAtA, i, uint64(n113))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Event_Removed_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *LockOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LockOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Removed != nil {
-		l = m.Removed.Size()
-		n += 1 + l + sovCountermap(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Event_Inserted) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UnlockInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintCountermap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	return len(dAtA) - i, nil
+}
+
+func (m *EntriesInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntriesOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCountermap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Inserted != nil {
+		{
+			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Removed != nil {
+		{
+			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.PrevValue))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCountermap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCountermap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintCountermap(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCountermap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCountermap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.SizeInput != nil {
+		l = m.SizeInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.SizeOutput != nil {
+		l = m.SizeOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.SetInput != nil {
+		l = m.SetInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.SetOutput != nil {
+		l = m.SetOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.InsertInput != nil {
+		l = m.InsertInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.InsertOutput != nil {
+		l = m.InsertOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.UpdateInput != nil {
+		l = m.UpdateInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.UpdateOutput != nil {
+		l = m.UpdateOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *IncrementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.IncrementInput != nil {
+		l = m.IncrementInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *IncrementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.IncrementOutput != nil {
+		l = m.IncrementOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *DecrementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.DecrementInput != nil {
+		l = m.DecrementInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *DecrementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.DecrementOutput != nil {
+		l = m.DecrementOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.ConfigureInput != nil {
+		l = m.ConfigureInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.ConfigureOutput != nil {
+		l = m.ConfigureOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.SetBoundsInput != nil {
+		l = m.SetBoundsInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.SetBoundsOutput != nil {
+		l = m.SetBoundsOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.GetInput != nil {
+		l = m.GetInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.GetOutput != nil {
+		l = m.GetOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *TopKRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.TopKInput != nil {
+		l = m.TopKInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *TopKResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.TopKOutput != nil {
+		l = m.TopKOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *BottomKRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.BottomKInput != nil {
+		l = m.BottomKInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *BottomKResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.BottomKOutput != nil {
+		l = m.BottomKOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *RangeByValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.RangeByValueInput != nil {
+		l = m.RangeByValueInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *RangeByValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.RangeByValueOutput != nil {
+		l = m.RangeByValueOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.RemoveInput != nil {
+		l = m.RemoveInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.RemoveOutput != nil {
+		l = m.RemoveOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.ClearInput != nil {
+		l = m.ClearInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.ClearOutput != nil {
+		l = m.ClearOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.LockInput != nil {
+		l = m.LockInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.LockOutput != nil {
+		l = m.LockOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *UnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.UnlockInput != nil {
+		l = m.UnlockInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *UnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.UnlockOutput != nil {
+		l = m.UnlockOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *EntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.EntriesInput != nil {
+		l = m.EntriesInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *EntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.EntriesOutput != nil {
+		l = m.EntriesOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.EventsInput != nil {
+		l = m.EventsInput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.EventsOutput != nil {
+		l = m.EventsOutput.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *CounterMapListener) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovCountermap(uint64(m.Index))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *CounterMapInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *CounterMapInput_Size_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != nil {
+		l = m.Size_.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Set) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Insert != nil {
+		l = m.Insert.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Increment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Increment != nil {
+		l = m.Increment.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Decrement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decrement != nil {
+		l = m.Decrement.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Get) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Clear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clear != nil {
+		l = m.Clear.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lock != nil {
+		l = m.Lock.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Unlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unlock != nil {
+		l = m.Unlock.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Entries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entries != nil {
+		l = m.Entries.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Events) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_Configure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configure != nil {
+		l = m.Configure.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_SetBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetBounds != nil {
+		l = m.SetBounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_TopK) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopK != nil {
+		l = m.TopK.Size()
+		n += 2 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_BottomK) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BottomK != nil {
+		l = m.BottomK.Size()
+		n += 2 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapInput_RangeByValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RangeByValue != nil {
+		l = m.RangeByValue.Size()
+		n += 2 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		n += m.Output.Size()
+	}
+	return n
+}
+
+func (m *CounterMapOutput_Size_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != nil {
+		l = m.Size_.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Set) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Insert != nil {
+		l = m.Insert.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Increment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Increment != nil {
+		l = m.Increment.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Decrement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decrement != nil {
+		l = m.Decrement.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Get) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Clear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clear != nil {
+		l = m.Clear.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lock != nil {
+		l = m.Lock.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Unlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unlock != nil {
+		l = m.Unlock.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Entries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entries != nil {
+		l = m.Entries.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Events) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_Configure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configure != nil {
+		l = m.Configure.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_SetBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetBounds != nil {
+		l = m.SetBounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_TopK) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopK != nil {
+		l = m.TopK.Size()
+		n += 2 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_BottomK) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BottomK != nil {
+		l = m.BottomK.Size()
+		n += 2 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *CounterMapOutput_RangeByValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RangeByValue != nil {
+		l = m.RangeByValue.Size()
+		n += 2 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *SizeInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SizeOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovCountermap(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *SetInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *SetOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *InsertInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *InsertOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *UpdateOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *IncrementInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovCountermap(uint64(m.Delta))
+	}
+	if m.Saturate {
+		n += 2
+	}
+	return n
+}
+
+func (m *IncrementOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *DecrementInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovCountermap(uint64(m.Delta))
+	}
+	if m.Saturate {
+		n += 2
+	}
+	return n
+}
+
+func (m *DecrementOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *GetInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigureInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigureOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetBoundsInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBoundsOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TopKInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.K != 0 {
+		n += 1 + sovCountermap(uint64(m.K))
+	}
+	return n
+}
+
+func (m *TopKOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BottomKInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.K != 0 {
+		n += 1 + sovCountermap(uint64(m.K))
+	}
+	return n
+}
+
+func (m *BottomKOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RangeByValueInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bounds != nil {
+		l = m.Bounds.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCountermap(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *RangeByValueOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *RemoveOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *ClearInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ClearOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LockInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnlockInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovCountermap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EntriesInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Watch {
+		n += 2
+	}
+	return n
+}
+
+func (m *EntriesOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	return n
+}
+
+func (m *EventsInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovCountermap(uint64(l))
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *Event_Inserted_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inserted != nil {
+		l = m.Inserted.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Updated_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Removed_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removed != nil {
+		l = m.Removed.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Inserted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *Event_Updated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	if m.PrevValue != 0 {
+		n += 1 + sovCountermap(uint64(m.PrevValue))
+	}
+	return n
+}
+
+func (m *Event_Removed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *Bounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovCountermap(uint64(l))
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovCountermap(uint64(m.Value))
+	}
+	return n
+}
+
+func sovCountermap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCountermap(x uint64) (n int) {
+	return sovCountermap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SizeInput == nil {
+				m.SizeInput = &SizeInput{}
+			}
+			if err := m.SizeInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SizeOutput == nil {
+				m.SizeOutput = &SizeOutput{}
+			}
+			if err := m.SizeOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetInput == nil {
+				m.SetInput = &SetInput{}
+			}
+			if err := m.SetInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetOutput == nil {
+				m.SetOutput = &SetOutput{}
+			}
+			if err := m.SetOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsertInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsertInput == nil {
+				m.InsertInput = &InsertInput{}
+			}
+			if err := m.InsertInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsertOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsertOutput == nil {
+				m.InsertOutput = &InsertOutput{}
+			}
+			if err := m.InsertOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateInput == nil {
+				m.UpdateInput = &UpdateInput{}
+			}
+			if err := m.UpdateInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateOutput == nil {
+				m.UpdateOutput = &UpdateOutput{}
+			}
+			if err := m.UpdateOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncrementInput == nil {
+				m.IncrementInput = &IncrementInput{}
+			}
+			if err := m.IncrementInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncrementOutput == nil {
+				m.IncrementOutput = &IncrementOutput{}
+			}
+			if err := m.IncrementOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecrementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecrementInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecrementInput == nil {
+				m.DecrementInput = &DecrementInput{}
+			}
+			if err := m.DecrementInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecrementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecrementOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecrementOutput == nil {
+				m.DecrementOutput = &DecrementOutput{}
+			}
+			if err := m.DecrementOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigureInput == nil {
+				m.ConfigureInput = &ConfigureInput{}
+			}
+			if err := m.ConfigureInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigureOutput == nil {
+				m.ConfigureOutput = &ConfigureOutput{}
+			}
+			if err := m.ConfigureOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBoundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBoundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBoundsInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetBoundsInput == nil {
+				m.SetBoundsInput = &SetBoundsInput{}
+			}
+			if err := m.SetBoundsInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBoundsOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetBoundsOutput == nil {
+				m.SetBoundsOutput = &SetBoundsOutput{}
+			}
+			if err := m.SetBoundsOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetInput == nil {
+				m.GetInput = &GetInput{}
+			}
+			if err := m.GetInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetOutput == nil {
+				m.GetOutput = &GetOutput{}
+			}
+			if err := m.GetOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopKRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopKRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopKRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopKInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopKInput == nil {
+				m.TopKInput = &TopKInput{}
+			}
+			if err := m.TopKInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopKResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopKResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopKResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopKOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopKOutput == nil {
+				m.TopKOutput = &TopKOutput{}
+			}
+			if err := m.TopKOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Event_Updated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
-	}
-	if m.PrevValue != 0 {
-		n += 1 + sovCountermap(uint64(m.PrevValue))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *Event_Removed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+func (m *BottomKRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BottomKRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BottomKRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BottomKInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BottomKInput == nil {
+				m.BottomKInput = &BottomKInput{}
+			}
+			if err := m.BottomKInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Entry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovCountermap(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovCountermap(uint64(m.Value))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovCountermap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCountermap(x uint64) (n int) {
-	return sovCountermap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+func (m *BottomKResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8085,10 +13626,10 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BottomKResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BottomKResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8121,7 +13662,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.QueryRequestHeaders{}
+				m.Headers = &v1.QueryResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8129,7 +13670,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BottomKOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8156,10 +13697,10 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SizeInput == nil {
-				m.SizeInput = &SizeInput{}
+			if m.BottomKOutput == nil {
+				m.BottomKOutput = &BottomKOutput{}
 			}
-			if err := m.SizeInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BottomKOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8184,7 +13725,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+func (m *RangeByValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8207,10 +13748,10 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeByValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeByValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8243,7 +13784,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.QueryResponseHeaders{}
+				m.Headers = &v1.QueryRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8251,7 +13792,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeByValueInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8278,10 +13819,10 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SizeOutput == nil {
-				m.SizeOutput = &SizeOutput{}
+			if m.RangeByValueInput == nil {
+				m.RangeByValueInput = &RangeByValueInput{}
 			}
-			if err := m.SizeOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RangeByValueInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8306,7 +13847,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeByValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8329,10 +13870,10 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeByValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeByValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8365,7 +13906,7 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.QueryResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8373,7 +13914,7 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeByValueOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8400,10 +13941,10 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetInput == nil {
-				m.SetInput = &SetInput{}
+			if m.RangeByValueOutput == nil {
+				m.RangeByValueOutput = &RangeByValueOutput{}
 			}
-			if err := m.SetInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RangeByValueOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8428,7 +13969,7 @@ func (m *SetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8451,10 +13992,10 @@ func (m *SetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8487,7 +14028,7 @@ func (m *SetResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.ProposalRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8495,7 +14036,7 @@ func (m *SetResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8522,10 +14063,10 @@ func (m *SetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetOutput == nil {
-				m.SetOutput = &SetOutput{}
+			if m.RemoveInput == nil {
+				m.RemoveInput = &RemoveInput{}
 			}
-			if err := m.SetOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemoveInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8550,7 +14091,7 @@ func (m *SetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8573,10 +14114,10 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8609,7 +14150,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8617,7 +14158,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsertInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8644,10 +14185,10 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsertInput == nil {
-				m.InsertInput = &InsertInput{}
+			if m.RemoveOutput == nil {
+				m.RemoveOutput = &RemoveOutput{}
 			}
-			if err := m.InsertInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemoveOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8672,7 +14213,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8695,10 +14236,10 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8731,7 +14272,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.ProposalRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8739,7 +14280,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsertOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8766,10 +14307,10 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsertOutput == nil {
-				m.InsertOutput = &InsertOutput{}
+			if m.ClearInput == nil {
+				m.ClearInput = &ClearInput{}
 			}
-			if err := m.InsertOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClearInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8794,7 +14335,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ClearResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8817,10 +14358,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8853,7 +14394,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8861,7 +14402,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8888,10 +14429,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateInput == nil {
-				m.UpdateInput = &UpdateInput{}
+			if m.ClearOutput == nil {
+				m.ClearOutput = &ClearOutput{}
 			}
-			if err := m.UpdateInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClearOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8916,7 +14457,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *LockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8939,10 +14480,10 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8975,7 +14516,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.ProposalRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8983,7 +14524,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9010,10 +14551,10 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateOutput == nil {
-				m.UpdateOutput = &UpdateOutput{}
+			if m.LockInput == nil {
+				m.LockInput = &LockInput{}
 			}
-			if err := m.UpdateOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9038,7 +14579,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
+func (m *LockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9061,10 +14602,10 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9097,7 +14638,7 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9105,7 +14646,7 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9132,10 +14673,10 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IncrementInput == nil {
-				m.IncrementInput = &IncrementInput{}
+			if m.LockOutput == nil {
+				m.LockOutput = &LockOutput{}
 			}
-			if err := m.IncrementInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9160,7 +14701,7 @@ func (m *IncrementRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
+func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9183,10 +14724,10 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9219,7 +14760,7 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.ProposalRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9227,7 +14768,7 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9254,10 +14795,10 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IncrementOutput == nil {
-				m.IncrementOutput = &IncrementOutput{}
+			if m.UnlockInput == nil {
+				m.UnlockInput = &UnlockInput{}
 			}
-			if err := m.IncrementOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnlockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9282,7 +14823,7 @@ func (m *IncrementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
+func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9305,10 +14846,10 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9341,7 +14882,7 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9349,7 +14890,7 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecrementInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9376,10 +14917,10 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecrementInput == nil {
-				m.DecrementInput = &DecrementInput{}
+			if m.UnlockOutput == nil {
+				m.UnlockOutput = &UnlockOutput{}
 			}
-			if err := m.DecrementInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnlockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9404,7 +14945,7 @@ func (m *DecrementRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
+func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9427,10 +14968,10 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9463,7 +15004,7 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.QueryRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9471,7 +15012,7 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecrementOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntriesInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9498,10 +15039,10 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecrementOutput == nil {
-				m.DecrementOutput = &DecrementOutput{}
+			if m.EntriesInput == nil {
+				m.EntriesInput = &EntriesInput{}
 			}
-			if err := m.DecrementOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EntriesInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9526,7 +15067,7 @@ func (m *DecrementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9549,10 +15090,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9585,7 +15126,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.QueryRequestHeaders{}
+				m.Headers = &v1.QueryResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9593,7 +15134,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntriesOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9620,10 +15161,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GetInput == nil {
-				m.GetInput = &GetInput{}
+			if m.EntriesOutput == nil {
+				m.EntriesOutput = &EntriesOutput{}
 			}
-			if err := m.GetInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EntriesOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9648,7 +15189,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9671,10 +15212,10 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9707,7 +15248,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.QueryResponseHeaders{}
+				m.Headers = &v1.ProposalRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9715,7 +15256,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9742,10 +15283,10 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GetOutput == nil {
-				m.GetOutput = &GetOutput{}
+			if m.EventsInput == nil {
+				m.EventsInput = &EventsInput{}
 			}
-			if err := m.GetOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventsInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9770,7 +15311,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9793,10 +15334,10 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9829,7 +15370,7 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9837,7 +15378,7 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9864,10 +15405,10 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemoveInput == nil {
-				m.RemoveInput = &RemoveInput{}
+			if m.EventsOutput == nil {
+				m.EventsOutput = &EventsOutput{}
 			}
-			if err := m.RemoveInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventsOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9892,7 +15433,7 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *CounterMapListener) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9915,17 +15456,17 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CounterMapListener: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CounterMapListener: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -9935,33 +15476,16 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -9971,27 +15495,23 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemoveOutput == nil {
-				m.RemoveOutput = &RemoveOutput{}
-			}
-			if err := m.RemoveOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10014,7 +15534,7 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearRequest) Unmarshal(dAtA []byte) error {
+func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10037,15 +15557,15 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CounterMapInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CounterMapInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10072,16 +15592,15 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &SizeInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Size_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10108,66 +15627,15 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClearInput == nil {
-				m.ClearInput = &ClearInput{}
-			}
-			if err := m.ClearInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &SetInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Set{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClearResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClearResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10194,16 +15662,15 @@ func (m *ClearResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &InsertInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Insert{v}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10230,66 +15697,50 @@ func (m *ClearResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClearOutput == nil {
-				m.ClearOutput = &ClearOutput{}
-			}
-			if err := m.ClearOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &UpdateInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Update{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &IncrementInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Input = &CounterMapInput_Increment{v}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10316,16 +15767,15 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DecrementInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Decrement{v}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10352,66 +15802,50 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LockInput == nil {
-				m.LockInput = &LockInput{}
-			}
-			if err := m.LockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &GetInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Get{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RemoveInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &CounterMapInput_Remove{v}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10438,16 +15872,15 @@ func (m *LockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ClearInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Clear{v}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10474,66 +15907,15 @@ func (m *LockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LockOutput == nil {
-				m.LockOutput = &LockOutput{}
-			}
-			if err := m.LockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &LockInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Lock{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10560,16 +15942,15 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &UnlockInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Unlock{v}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10596,66 +15977,50 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UnlockInput == nil {
-				m.UnlockInput = &UnlockInput{}
-			}
-			if err := m.UnlockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &EntriesInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Entries{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &EventsInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Input = &CounterMapInput_Events{v}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10682,16 +16047,15 @@ func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ConfigureInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_Configure{v}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBounds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10718,66 +16082,50 @@ func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UnlockOutput == nil {
-				m.UnlockOutput = &UnlockOutput{}
-			}
-			if err := m.UnlockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &SetBoundsInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_SetBounds{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopK", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TopKInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &CounterMapInput_TopK{v}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BottomK", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10804,16 +16152,15 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.QueryRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &BottomKInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_BottomK{v}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntriesInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeByValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10840,12 +16187,11 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EntriesInput == nil {
-				m.EntriesInput = &EntriesInput{}
-			}
-			if err := m.EntriesInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &RangeByValueInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &CounterMapInput_RangeByValue{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10868,7 +16214,7 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
+func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10891,15 +16237,15 @@ func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CounterMapOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CounterMapOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10926,16 +16272,15 @@ func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.QueryResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &SizeOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &CounterMapOutput_Size_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntriesOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10962,66 +16307,15 @@ func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EntriesOutput == nil {
-				m.EntriesOutput = &EntriesOutput{}
-			}
-			if err := m.EntriesOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &SetOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &CounterMapOutput_Set{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11048,16 +16342,15 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &InsertOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &CounterMapOutput_Insert{v}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventsInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11084,66 +16377,15 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EventsInput == nil {
-				m.EventsInput = &EventsInput{}
-			}
-			if err := m.EventsInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &UpdateOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &CounterMapOutput_Update{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11170,16 +16412,15 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &IncrementOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &CounterMapOutput_Increment{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventsOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11206,68 +16447,17 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EventsOutput == nil {
-				m.EventsOutput = &EventsOutput{}
-			}
-			if err := m.EventsOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DecrementOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &CounterMapOutput_Decrement{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CounterMapListener) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CounterMapListener: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CounterMapListener: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11277,16 +16467,32 @@ func (m *CounterMapListener) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &CounterMapOutput_Get{v}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11296,77 +16502,30 @@ func (m *CounterMapListener) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCountermap(dAtA[iNdEx:])
-			if err != nil {
+			v := &RemoveOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCountermap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CounterMapInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CounterMapInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Output = &CounterMapOutput_Remove{v}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11393,15 +16552,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SizeInput{}
+			v := &ClearOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Size_{v}
+			m.Output = &CounterMapOutput_Clear{v}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11428,15 +16587,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SetInput{}
+			v := &LockOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Set{v}
+			m.Output = &CounterMapOutput_Lock{v}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11463,15 +16622,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InsertInput{}
+			v := &UnlockOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Insert{v}
+			m.Output = &CounterMapOutput_Unlock{v}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11498,15 +16657,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UpdateInput{}
+			v := &EntriesOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Update{v}
+			m.Output = &CounterMapOutput_Entries{v}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11533,15 +16692,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IncrementInput{}
+			v := &EventsOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Increment{v}
+			m.Output = &CounterMapOutput_Events{v}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11568,15 +16727,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DecrementInput{}
+			v := &ConfigureOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Decrement{v}
+			m.Output = &CounterMapOutput_Configure{v}
 			iNdEx = postIndex
-		case 7:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBounds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11603,15 +16762,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GetInput{}
+			v := &SetBoundsOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Get{v}
+			m.Output = &CounterMapOutput_SetBounds{v}
 			iNdEx = postIndex
-		case 8:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopK", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11638,15 +16797,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RemoveInput{}
+			v := &TopKOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Remove{v}
+			m.Output = &CounterMapOutput_TopK{v}
 			iNdEx = postIndex
-		case 9:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BottomK", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11673,15 +16832,15 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClearInput{}
+			v := &BottomKOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Clear{v}
+			m.Output = &CounterMapOutput_BottomK{v}
 			iNdEx = postIndex
-		case 10:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeByValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11708,17 +16867,117 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LockInput{}
+			v := &RangeByValueOutput{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CounterMapInput_Lock{v}
+			m.Output = &CounterMapOutput_RangeByValue{v}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SizeInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SizeOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11728,32 +16987,66 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Size_ |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UnlockInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Input = &CounterMapInput_Unlock{v}
-			iNdEx = postIndex
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11763,32 +17056,29 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EntriesInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &CounterMapInput_Entries{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11798,27 +17088,11 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &EventsInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &CounterMapInput_Events{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -11840,7 +17114,7 @@ func (m *CounterMapInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
+func (m *SetOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11863,17 +17137,17 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CounterMapOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CounterMapOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
-			var msglen int
+			m.PrevValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11883,32 +17157,66 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SizeOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
 			}
-			m.Output = &CounterMapOutput_Size_{v}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11918,32 +17226,29 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SetOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterMapOutput_Set{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -11953,67 +17258,116 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InsertOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
 			}
-			m.Output = &CounterMapOutput_Insert{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UpdateOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Output = &CounterMapOutput_Update{v}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12023,32 +17377,29 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IncrementOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterMapOutput_Increment{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12058,32 +17409,16 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DecrementOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterMapOutput_Decrement{v}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
-			var msglen int
+			m.PrevValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12093,67 +17428,66 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GetOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterMapOutput_Get{v}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RemoveOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Output = &CounterMapOutput_Remove{v}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
-			var msglen int
+			m.PrevValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12163,32 +17497,66 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClearOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrementInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
 			}
-			m.Output = &CounterMapOutput_Clear{v}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12198,32 +17566,29 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LockOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterMapOutput_Lock{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
 			}
-			var msglen int
+			m.Delta = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12233,32 +17598,16 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Delta |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &UnlockOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterMapOutput_Unlock{v}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12268,32 +17617,67 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
+			m.Saturate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EntriesOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
 			}
-			m.Output = &CounterMapOutput_Entries{v}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			}
+			m.PrevValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12303,27 +17687,11 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &EventsOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &CounterMapOutput_Events{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -12345,7 +17713,7 @@ func (m *CounterMapOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeInput) Unmarshal(dAtA []byte) error {
+func (m *DecrementInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12368,12 +17736,83 @@ func (m *SizeInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecrementInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			m.Delta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saturate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Saturate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -12395,7 +17834,7 @@ func (m *SizeInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeOutput) Unmarshal(dAtA []byte) error {
+func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12418,17 +17857,17 @@ func (m *SizeOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecrementOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
-			m.Size_ = 0
+			m.PrevValue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12438,7 +17877,7 @@ func (m *SizeOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint32(b&0x7F) << shift
+				m.PrevValue |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12464,7 +17903,7 @@ func (m *SizeOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetInput) Unmarshal(dAtA []byte) error {
+func (m *GetInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12487,10 +17926,10 @@ func (m *SetInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12512,38 +17951,19 @@ func (m *SetInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -12565,7 +17985,7 @@ func (m *SetInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetOutput) Unmarshal(dAtA []byte) error {
+func (m *GetOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12588,17 +18008,17 @@ func (m *SetOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.PrevValue = 0
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12608,11 +18028,47 @@ func (m *SetOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -12634,7 +18090,7 @@ func (m *SetOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertInput) Unmarshal(dAtA []byte) error {
+func (m *ConfigureInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12657,17 +18113,17 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigureInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigureInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12677,43 +18133,28 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
 			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -12735,7 +18176,7 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertOutput) Unmarshal(dAtA []byte) error {
+func (m *ConfigureOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12758,10 +18199,10 @@ func (m *InsertOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigureOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigureOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12785,7 +18226,7 @@ func (m *InsertOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateInput) Unmarshal(dAtA []byte) error {
+func (m *SetBoundsInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12808,10 +18249,10 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBoundsInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBoundsInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12847,10 +18288,10 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -12860,30 +18301,28 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
 			}
-			m.PrevValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -12905,7 +18344,7 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
+func (m *SetBoundsOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12928,31 +18367,12 @@ func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBoundsOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBoundsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
-			}
-			m.PrevValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -12974,7 +18394,7 @@ func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementInput) Unmarshal(dAtA []byte) error {
+func (m *TopKInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12997,49 +18417,17 @@ func (m *IncrementInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopKInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopKInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
 			}
-			m.Delta = 0
+			m.K = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -13049,7 +18437,7 @@ func (m *IncrementInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delta |= int64(b&0x7F) << shift
+				m.K |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13075,7 +18463,7 @@ func (m *IncrementInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
+func (m *TopKOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13098,17 +18486,17 @@ func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncrementOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopKOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopKOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.PrevValue = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -13118,11 +18506,26 @@ func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -13144,7 +18547,7 @@ func (m *IncrementOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementInput) Unmarshal(dAtA []byte) error {
+func (m *BottomKInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13167,49 +18570,17 @@ func (m *DecrementInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: BottomKInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BottomKInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCountermap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCountermap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
 			}
-			m.Delta = 0
+			m.K = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -13219,7 +18590,7 @@ func (m *DecrementInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Delta |= int64(b&0x7F) << shift
+				m.K |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13245,7 +18616,7 @@ func (m *DecrementInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
+func (m *BottomKOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13268,17 +18639,17 @@ func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecrementOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: BottomKOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecrementOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BottomKOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.PrevValue = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -13288,11 +18659,26 @@ func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevValue |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -13314,7 +18700,7 @@ func (m *DecrementOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetInput) Unmarshal(dAtA []byte) error {
+func (m *RangeByValueInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13337,17 +18723,17 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeByValueInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeByValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -13357,24 +18743,47 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCountermap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCountermap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Bounds == nil {
+				m.Bounds = &Bounds{}
+			}
+			if err := m.Bounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -13396,7 +18805,7 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOutput) Unmarshal(dAtA []byte) error {
+func (m *RangeByValueOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13419,17 +18828,17 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeByValueOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeByValueOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCountermap
@@ -13439,11 +18848,26 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCountermap(dAtA[iNdEx:])
@@ -14867,6 +20291,197 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Bounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Min == nil {
+				m.Min = &Bound{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = &Bound{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCountermap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCountermap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCountermap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCountermap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCountermap(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
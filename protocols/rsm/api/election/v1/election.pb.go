@@ -6,10 +6,10 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
-	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -812,6 +812,8 @@ func (m *LeaderElectionSnapshot) GetCandidates() []LeaderElectionCandidate {
 type LeaderElectionCandidate struct {
 	Name      string                                                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	SessionID github_com_atomix_atomix_protocols_rsm_api_v1.SessionID `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SessionID" json:"session_id,omitempty"`
+	Priority  int32                                                   `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metadata  map[string]string                                       `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *LeaderElectionCandidate) Reset()         { *m = LeaderElectionCandidate{} }
@@ -861,6 +863,20 @@ func (m *LeaderElectionCandidate) GetSessionID() github_com_atomix_atomix_protoc
 	return 0
 }
 
+func (m *LeaderElectionCandidate) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *LeaderElectionCandidate) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type LeaderElectionInput struct {
 	// Types that are valid to be assigned to Input:
 	//	*LeaderElectionInput_Enter
@@ -1188,7 +1204,10 @@ func (*LeaderElectionOutput) XXX_OneofWrappers() []interface{} {
 }
 
 type EnterInput struct {
-	Candidate string `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Candidate string            `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Priority  int32             `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metadata  map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Preempt   bool              `protobuf:"varint,4,opt,name=preempt,proto3" json:"preempt,omitempty"`
 }
 
 func (m *EnterInput) Reset()         { *m = EnterInput{} }
@@ -1231,6 +1250,27 @@ func (m *EnterInput) GetCandidate() string {
 	return ""
 }
 
+func (m *EnterInput) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *EnterInput) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *EnterInput) GetPreempt() bool {
+	if m != nil {
+		return m.Preempt
+	}
+	return false
+}
+
 type EnterOutput struct {
 	Term Term `protobuf:"bytes,1,opt,name=term,proto3" json:"term"`
 }
@@ -1876,9 +1916,10 @@ func (m *WatchOutput) GetTerm() Term {
 }
 
 type Term struct {
-	Leader     string                                              `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
-	Candidates []string                                            `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
-	Index      github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,3,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	Leader        string                                              `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+	Candidates    []string                                            `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Index         github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,3,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	CandidateInfo []Candidate                                         `protobuf:"bytes,4,rep,name=candidate_info,json=candidateInfo,proto3" json:"candidate_info"`
 }
 
 func (m *Term) Reset()         { *m = Term{} }
@@ -1935,6 +1976,73 @@ func (m *Term) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
 	return 0
 }
 
+func (m *Term) GetCandidateInfo() []Candidate {
+	if m != nil {
+		return m.CandidateInfo
+	}
+	return nil
+}
+
+type Candidate struct {
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Priority int32             `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Candidate) Reset()         { *m = Candidate{} }
+func (m *Candidate) String() string { return proto.CompactTextString(m) }
+func (*Candidate) ProtoMessage()    {}
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2de2df50622c3d, []int{37}
+}
+func (m *Candidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidate.Merge(m, src)
+}
+func (m *Candidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candidate proto.InternalMessageInfo
+
+func (m *Candidate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Candidate) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Candidate) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EnterRequest)(nil), "atomix.protocols.rsm.election.v1.EnterRequest")
 	proto.RegisterType((*EnterResponse)(nil), "atomix.protocols.rsm.election.v1.EnterResponse")
@@ -1954,9 +2062,11 @@ func init() {
 	proto.RegisterType((*WatchResponse)(nil), "atomix.protocols.rsm.election.v1.WatchResponse")
 	proto.RegisterType((*LeaderElectionSnapshot)(nil), "atomix.protocols.rsm.election.v1.LeaderElectionSnapshot")
 	proto.RegisterType((*LeaderElectionCandidate)(nil), "atomix.protocols.rsm.election.v1.LeaderElectionCandidate")
+	proto.RegisterMapType((map[string]string)(nil), "atomix.protocols.rsm.election.v1.LeaderElectionCandidate.MetadataEntry")
 	proto.RegisterType((*LeaderElectionInput)(nil), "atomix.protocols.rsm.election.v1.LeaderElectionInput")
 	proto.RegisterType((*LeaderElectionOutput)(nil), "atomix.protocols.rsm.election.v1.LeaderElectionOutput")
 	proto.RegisterType((*EnterInput)(nil), "atomix.protocols.rsm.election.v1.EnterInput")
+	proto.RegisterMapType((map[string]string)(nil), "atomix.protocols.rsm.election.v1.EnterInput.MetadataEntry")
 	proto.RegisterType((*EnterOutput)(nil), "atomix.protocols.rsm.election.v1.EnterOutput")
 	proto.RegisterType((*WithdrawInput)(nil), "atomix.protocols.rsm.election.v1.WithdrawInput")
 	proto.RegisterType((*WithdrawOutput)(nil), "atomix.protocols.rsm.election.v1.WithdrawOutput")
@@ -1973,88 +2083,101 @@ func init() {
 	proto.RegisterType((*WatchInput)(nil), "atomix.protocols.rsm.election.v1.WatchInput")
 	proto.RegisterType((*WatchOutput)(nil), "atomix.protocols.rsm.election.v1.WatchOutput")
 	proto.RegisterType((*Term)(nil), "atomix.protocols.rsm.election.v1.Term")
+	proto.RegisterType((*Candidate)(nil), "atomix.protocols.rsm.election.v1.Candidate")
+	proto.RegisterMapType((map[string]string)(nil), "atomix.protocols.rsm.election.v1.Candidate.MetadataEntry")
 }
 
 func init() { proto.RegisterFile("election/v1/election.proto", fileDescriptor_1e2de2df50622c3d) }
 
 var fileDescriptor_1e2de2df50622c3d = []byte{
-	// 1213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xe3, 0x54,
-	0x14, 0x8d, 0x5b, 0x37, 0x6d, 0x6f, 0xd3, 0xcc, 0xe8, 0x31, 0x1a, 0xa2, 0x0a, 0xa5, 0x55, 0x16,
-	0x68, 0x04, 0x53, 0xbb, 0xe9, 0x2c, 0x46, 0xec, 0x20, 0xb4, 0x34, 0x99, 0xb4, 0x33, 0xad, 0x07,
-	0x69, 0x96, 0x95, 0x27, 0x79, 0x4a, 0x0c, 0x8d, 0x9d, 0xb1, 0x9d, 0xb4, 0xfc, 0x08, 0x24, 0x56,
-	0x48, 0xec, 0x90, 0x00, 0xf1, 0x21, 0xc4, 0xef, 0x98, 0x65, 0x97, 0xac, 0x22, 0xd4, 0xee, 0x58,
-	0xb3, 0x1a, 0x09, 0x09, 0xbd, 0x0f, 0xc7, 0x7e, 0xae, 0x43, 0x9e, 0x33, 0x8e, 0xd4, 0x55, 0x1c,
-	0xcb, 0xf7, 0xde, 0xf3, 0x8e, 0xce, 0x3d, 0xf7, 0xda, 0xb0, 0x81, 0xcf, 0x70, 0xcb, 0xb7, 0x1c,
-	0x5b, 0x1f, 0x56, 0xf5, 0xe0, 0x5a, 0xeb, 0xbb, 0x8e, 0xef, 0xa0, 0x2d, 0xd3, 0x77, 0x7a, 0xd6,
-	0x05, 0xfb, 0xd7, 0x72, 0xce, 0x3c, 0xcd, 0xf5, 0x7a, 0xda, 0xf8, 0xa1, 0x61, 0x75, 0xe3, 0xee,
-	0xb0, 0xaa, 0x77, 0xb1, 0xd9, 0xc6, 0xae, 0xc7, 0x9e, 0xda, 0xb8, 0xd7, 0x71, 0x3a, 0x0e, 0xbd,
-	0xd4, 0xc9, 0x15, 0xbb, 0x5b, 0xf9, 0x41, 0x81, 0xc2, 0xbe, 0xed, 0x63, 0xd7, 0xc0, 0xaf, 0x06,
-	0xd8, 0xf3, 0x51, 0x03, 0x96, 0x79, 0x5c, 0x49, 0xd9, 0x52, 0x1e, 0xac, 0xed, 0xea, 0x5a, 0x62,
-	0xb1, 0x61, 0x55, 0x3b, 0x76, 0x9d, 0xbe, 0xe3, 0x99, 0x67, 0x3c, 0xb4, 0xce, 0xc2, 0x8c, 0x20,
-	0x1e, 0xd5, 0x61, 0xc9, 0xb2, 0xfb, 0x03, 0xbf, 0xb4, 0x40, 0x13, 0x3d, 0xd4, 0xa6, 0xa1, 0xd6,
-	0x28, 0x92, 0x06, 0x89, 0xa9, 0xa9, 0x97, 0xa3, 0x4d, 0xc5, 0x60, 0x09, 0x2a, 0xbf, 0x28, 0xb0,
-	0xce, 0x51, 0x7a, 0x7d, 0xc7, 0xf6, 0x30, 0x7a, 0x12, 0x87, 0xb9, 0x23, 0x01, 0x93, 0xc5, 0xde,
-	0xc0, 0xd9, 0x84, 0xbc, 0x33, 0xf0, 0x43, 0xa0, 0xdb, 0x92, 0x40, 0x9f, 0xd1, 0x20, 0x8e, 0x94,
-	0xa7, 0xa8, 0xfc, 0xaa, 0xc0, 0x9d, 0x17, 0x96, 0xdf, 0x6d, 0xbb, 0xe6, 0xf9, 0x1c, 0x38, 0x6d,
-	0x8a, 0x9c, 0xea, 0xd3, 0xa1, 0x06, 0x60, 0x12, 0x68, 0xfd, 0x43, 0x81, 0xbb, 0x21, 0xd6, 0x39,
-	0x30, 0xfb, 0x34, 0xc6, 0xec, 0x8e, 0x3c, 0xdc, 0x44, 0x72, 0x7f, 0x52, 0x60, 0xfd, 0x13, 0xdb,
-	0xb1, 0x6c, 0x7f, 0x0e, 0xd4, 0x36, 0x44, 0x6a, 0x25, 0x54, 0xc0, 0xa0, 0x24, 0x10, 0xfb, 0x9b,
-	0x02, 0xc5, 0x00, 0xe7, 0x1c, 0x68, 0x3d, 0x8c, 0xd1, 0xaa, 0xc9, 0x42, 0x4d, 0x24, 0xf5, 0x67,
-	0x05, 0x8a, 0xc7, 0xae, 0xd3, 0x73, 0x7c, 0x3c, 0x07, 0x56, 0x9f, 0x88, 0xac, 0x4a, 0x40, 0xe5,
-	0x58, 0x12, 0x68, 0xfd, 0x5d, 0x81, 0x3b, 0x63, 0xa4, 0x73, 0xe0, 0xf5, 0x28, 0xc6, 0xab, 0x2e,
-	0x0d, 0x76, 0xa2, 0x5a, 0xf7, 0xf0, 0x9c, 0x78, 0x4d, 0xaf, 0x56, 0x06, 0x65, 0x82, 0x5a, 0x03,
-	0x9c, 0xb7, 0x43, 0xad, 0x0c, 0x4d, 0x22, 0xa9, 0x74, 0x60, 0x0d, 0xad, 0x96, 0x7f, 0x3b, 0x06,
-	0x16, 0x41, 0x32, 0x69, 0x60, 0x31, 0x94, 0xb7, 0x64, 0x60, 0x11, 0x30, 0x89, 0x84, 0xfe, 0xa8,
-	0x40, 0xf1, 0x00, 0xfb, 0x9f, 0x63, 0xb7, 0x17, 0x50, 0xfa, 0x59, 0x1c, 0xeb, 0xc3, 0x89, 0x58,
-	0x4f, 0x06, 0xd8, 0xfd, 0x2a, 0xbb, 0xde, 0xe7, 0x40, 0x12, 0x18, 0x25, 0x73, 0x75, 0x0c, 0x93,
-	0x73, 0x7a, 0x10, 0xc7, 0xb9, 0x3d, 0x0d, 0x67, 0x76, 0x8d, 0xcf, 0xb1, 0x24, 0x52, 0xfa, 0xbd,
-	0x02, 0x85, 0x17, 0xa6, 0xdf, 0xea, 0x66, 0x4d, 0x68, 0x7a, 0x81, 0x52, 0x18, 0x09, 0x74, 0x12,
-	0x6f, 0xe2, 0x10, 0xb3, 0x26, 0x73, 0x06, 0x75, 0x52, 0x24, 0x89, 0x54, 0x7e, 0xb7, 0x00, 0xf7,
-	0x0f, 0x69, 0xe2, 0x7d, 0xfe, 0xfc, 0x73, 0xdb, 0xec, 0x7b, 0x5d, 0xc7, 0x47, 0x4d, 0x50, 0x7d,
-	0xec, 0xf6, 0x28, 0x5a, 0xb5, 0xf6, 0xf8, 0xcd, 0x68, 0xf3, 0x51, 0xc7, 0xf2, 0xbb, 0x83, 0x97,
-	0x5a, 0xcb, 0xe9, 0xe9, 0xac, 0x66, 0xf0, 0x33, 0x2e, 0xad, 0xbb, 0x5e, 0x4f, 0x37, 0xfb, 0x96,
-	0x3e, 0xac, 0x6a, 0x0d, 0xbb, 0x8d, 0x2f, 0x0c, 0x9a, 0x04, 0x9d, 0x40, 0xfe, 0x8c, 0x96, 0xe1,
-	0xa0, 0x3f, 0x9a, 0x0e, 0x5a, 0x84, 0xf5, 0xa9, 0x69, 0xb7, 0xad, 0xb6, 0xe9, 0x63, 0x83, 0x27,
-	0x42, 0xa7, 0x00, 0xad, 0xe0, 0xa6, 0x57, 0x5a, 0xdc, 0x5a, 0x7c, 0xab, 0xb4, 0x35, 0xf5, 0xf5,
-	0x68, 0x33, 0x67, 0x44, 0x52, 0x56, 0xbe, 0x55, 0xe0, 0xdd, 0x09, 0x4f, 0x23, 0x04, 0xaa, 0x6d,
-	0xf6, 0x30, 0x25, 0x67, 0xd5, 0xa0, 0xd7, 0xa8, 0x03, 0xe0, 0x61, 0xcf, 0xb3, 0x1c, 0xfb, 0xd4,
-	0x6a, 0xd3, 0x73, 0xaa, 0xb5, 0xfa, 0xd5, 0x68, 0x73, 0xf5, 0x39, 0xbb, 0xdb, 0xd8, 0x7b, 0x33,
-	0xda, 0x7c, 0x9c, 0x8e, 0xc3, 0x71, 0xa8, 0xb1, 0xca, 0x73, 0x37, 0xda, 0x95, 0xbf, 0x55, 0x78,
-	0x47, 0x04, 0x46, 0x15, 0x88, 0xf6, 0x60, 0x09, 0x93, 0xc5, 0xf9, 0xff, 0x9b, 0x20, 0xf9, 0x85,
-	0xa0, 0x9e, 0x33, 0x58, 0x30, 0x3a, 0x82, 0x95, 0x73, 0xbe, 0x24, 0xce, 0xb8, 0x05, 0xd7, 0x73,
-	0xc6, 0x38, 0x05, 0x3a, 0x80, 0xbc, 0x49, 0x97, 0xa3, 0xd2, 0xe2, 0x0c, 0x7b, 0x5f, 0x3d, 0x67,
-	0xf0, 0x70, 0xe2, 0xf0, 0x7d, 0xb6, 0x0d, 0x94, 0xd4, 0x59, 0x76, 0x9d, 0x7a, 0xce, 0x08, 0x12,
-	0x10, 0x50, 0x6d, 0x3a, 0x03, 0x4b, 0x2b, 0x33, 0x8c, 0x77, 0x02, 0x8a, 0x85, 0x53, 0xca, 0x89,
-	0xf5, 0x97, 0x96, 0xd2, 0x8f, 0x34, 0x4a, 0x39, 0xf9, 0x87, 0x9a, 0xb0, 0xd2, 0xc1, 0xfe, 0x29,
-	0x6d, 0xb7, 0xfc, 0x2c, 0x5e, 0x4e, 0xce, 0xd6, 0x61, 0xff, 0x09, 0xa4, 0x73, 0xd2, 0xef, 0xa5,
-	0xe5, 0xf4, 0x26, 0x46, 0x20, 0xd1, 0xe0, 0xda, 0x32, 0xb7, 0xc2, 0xca, 0x3f, 0x2a, 0xdc, 0x13,
-	0xc5, 0xc6, 0x8c, 0x04, 0xed, 0x8b, 0x6a, 0x4b, 0xf7, 0x56, 0x17, 0xca, 0xed, 0xe9, 0x0d, 0xb9,
-	0xa5, 0x7e, 0x8b, 0x11, 0xf4, 0x56, 0x8f, 0xe9, 0x2d, 0xe5, 0xf2, 0x1e, 0x11, 0x5c, 0x33, 0x2e,
-	0xb8, 0xb4, 0xfb, 0x6a, 0x54, 0x71, 0xf5, 0x98, 0xe2, 0x52, 0x6e, 0x69, 0x11, 0xc9, 0xed, 0x8b,
-	0x92, 0x4b, 0xb7, 0x9c, 0x84, 0x9a, 0x3b, 0xbc, 0xa1, 0xb9, 0xb4, 0x53, 0x39, 0x2a, 0xba, 0x7d,
-	0x51, 0x74, 0xe9, 0x66, 0x52, 0xa8, 0xba, 0x95, 0x60, 0xb6, 0x55, 0x3e, 0x00, 0x08, 0xcd, 0x09,
-	0xbd, 0x07, 0xab, 0x63, 0x63, 0xe6, 0x9e, 0x1b, 0xde, 0xa8, 0x3c, 0x83, 0xb5, 0x88, 0xb4, 0xd0,
-	0xc7, 0x91, 0xc1, 0xb5, 0xb6, 0xfb, 0xfe, 0x74, 0x28, 0xe4, 0x04, 0xdc, 0xff, 0x69, 0x64, 0x65,
-	0x1b, 0xd6, 0x05, 0x43, 0x9b, 0x52, 0xdf, 0x80, 0xa2, 0x28, 0xc8, 0x0c, 0x20, 0x7c, 0x08, 0x6b,
-	0x11, 0x1b, 0x9c, 0x02, 0xe0, 0x18, 0x0a, 0x51, 0x0d, 0x67, 0x50, 0xfe, 0x21, 0x14, 0xa2, 0xde,
-	0x29, 0xd6, 0x5f, 0x88, 0xd7, 0x3f, 0x81, 0x75, 0x41, 0xf8, 0xd9, 0x9c, 0x3f, 0xe2, 0xb8, 0x53,
-	0xea, 0x1f, 0x43, 0x21, 0xda, 0x2c, 0x19, 0x94, 0x27, 0xf2, 0x1b, 0x1b, 0xb5, 0x84, 0xfc, 0xc2,
-	0x0e, 0xcb, 0xa0, 0x78, 0x11, 0x0a, 0x51, 0x73, 0x27, 0xf4, 0x0a, 0x8d, 0x97, 0x41, 0x89, 0x02,
-	0x40, 0xe8, 0xfa, 0xe4, 0x04, 0x91, 0x76, 0xcc, 0x20, 0xfd, 0xd7, 0x0a, 0xa8, 0xd4, 0x17, 0xee,
-	0x8f, 0xf7, 0x3e, 0x46, 0x5b, 0xb0, 0xbc, 0x95, 0x85, 0xe5, 0x6d, 0x61, 0x6b, 0xf1, 0xc1, 0x6a,
-	0x74, 0xf7, 0x42, 0x47, 0x64, 0xfc, 0xb4, 0xf1, 0x05, 0x35, 0xf1, 0xb7, 0xd8, 0x3e, 0x59, 0x96,
-	0xdd, 0x7f, 0xf3, 0x50, 0x14, 0x87, 0x18, 0xea, 0xc2, 0x12, 0x35, 0x0d, 0xa4, 0x49, 0x0e, 0x2e,
-	0xfe, 0xd2, 0xb0, 0xa1, 0x4b, 0x3f, 0xcf, 0x37, 0xff, 0x57, 0xb0, 0x12, 0xd8, 0x03, 0xaa, 0xca,
-	0xcf, 0xb6, 0xa0, 0xde, 0x6e, 0x9a, 0x10, 0x5e, 0xf2, 0x4b, 0xc8, 0x33, 0x43, 0x40, 0xba, 0xec,
-	0xf8, 0x0b, 0xca, 0xed, 0xc8, 0x07, 0xf0, 0x62, 0x36, 0x2c, 0xf3, 0xee, 0x47, 0x3b, 0xd2, 0x13,
-	0x32, 0x28, 0x57, 0x4d, 0x11, 0x11, 0x1e, 0x8e, 0x75, 0xbb, 0xcc, 0xe1, 0x84, 0x0f, 0x44, 0x32,
-	0x87, 0x8b, 0x7d, 0xa9, 0x21, 0x32, 0xa1, 0xf3, 0x52, 0x93, 0x9c, 0xb3, 0x69, 0x64, 0x22, 0x7c,
-	0xc1, 0xb0, 0x61, 0x99, 0x77, 0xb9, 0x0c, 0x8d, 0xe2, 0x27, 0x05, 0x19, 0x1a, 0xe3, 0x6f, 0xf7,
-	0x5f, 0xc0, 0x12, 0x6d, 0x7a, 0x99, 0x93, 0x45, 0xdf, 0xb6, 0x65, 0x4e, 0x26, 0xbc, 0xfa, 0xee,
-	0x28, 0xb5, 0xd2, 0xeb, 0xab, 0xb2, 0x72, 0x79, 0x55, 0x56, 0xfe, 0xba, 0x2a, 0x2b, 0xdf, 0x5c,
-	0x97, 0x73, 0x97, 0xd7, 0xe5, 0xdc, 0x9f, 0xd7, 0xe5, 0xdc, 0xcb, 0x3c, 0x4d, 0xf2, 0xe8, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0xd7, 0x1f, 0x2a, 0x8f, 0x19, 0x00, 0x00,
+	// 1384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x17, 0x15, 0xf5, 0xb2, 0x74, 0x2d, 0x2b, 0xc1, 0x7c, 0x41, 0x3e, 0x42, 0x28, 0x64, 0x43, 0x8b,
+	0x22, 0x68, 0x12, 0x52, 0x72, 0x81, 0xa2, 0x71, 0x37, 0xad, 0x2a, 0xd5, 0x52, 0x9c, 0x87, 0xc3,
+	0xb4, 0x4d, 0x77, 0x06, 0x23, 0x4d, 0x2c, 0x26, 0x12, 0xa9, 0x90, 0x23, 0x25, 0xfe, 0x17, 0xfd,
+	0x01, 0x5d, 0x14, 0x68, 0x8b, 0x3e, 0x50, 0xf4, 0x77, 0x64, 0xd7, 0xec, 0xda, 0x95, 0x51, 0x38,
+	0xbb, 0xac, 0xdb, 0x4d, 0x80, 0x02, 0xc5, 0x0c, 0x87, 0x4f, 0x53, 0x16, 0xa9, 0x48, 0x80, 0x77,
+	0x1c, 0x9a, 0xf7, 0xde, 0x33, 0xc7, 0x67, 0xce, 0xbd, 0x23, 0xa8, 0xe0, 0x21, 0xee, 0x11, 0xcd,
+	0xd0, 0xe5, 0x69, 0x43, 0x76, 0x9e, 0xa5, 0xb1, 0x69, 0x10, 0x03, 0x6d, 0xa9, 0xc4, 0x18, 0x69,
+	0xcf, 0xed, 0x55, 0xcf, 0x18, 0x5a, 0x92, 0x69, 0x8d, 0x24, 0xf7, 0xa3, 0x69, 0xa3, 0x72, 0x71,
+	0xda, 0x90, 0x07, 0x58, 0xed, 0x63, 0xd3, 0xb2, 0xbf, 0xaa, 0x5c, 0x3a, 0x34, 0x0e, 0x0d, 0xf6,
+	0x28, 0xd3, 0x27, 0xfb, 0x6d, 0xed, 0x3b, 0x01, 0x4a, 0x6d, 0x9d, 0x60, 0x53, 0xc1, 0x4f, 0x27,
+	0xd8, 0x22, 0xa8, 0x0b, 0x6b, 0x3c, 0x4e, 0x14, 0xb6, 0x84, 0x2b, 0xeb, 0xdb, 0xb2, 0x14, 0x59,
+	0x6c, 0xda, 0x90, 0xf6, 0x4d, 0x63, 0x6c, 0x58, 0xea, 0x90, 0x87, 0x76, 0xec, 0x30, 0xc5, 0x89,
+	0x47, 0x1d, 0xc8, 0x69, 0xfa, 0x78, 0x42, 0xc4, 0x34, 0x4b, 0x74, 0x4d, 0x9a, 0x87, 0x5a, 0x62,
+	0x48, 0xba, 0x34, 0xa6, 0x99, 0x7d, 0x79, 0xbc, 0x29, 0x28, 0x76, 0x82, 0xda, 0x4f, 0x02, 0x6c,
+	0x70, 0x94, 0xd6, 0xd8, 0xd0, 0x2d, 0x8c, 0x6e, 0x86, 0x61, 0xd6, 0x63, 0xc0, 0xb4, 0x63, 0x4f,
+	0xe1, 0xdc, 0x83, 0xbc, 0x31, 0x21, 0x1e, 0xd0, 0xeb, 0x31, 0x81, 0xde, 0x9d, 0x10, 0x0f, 0x29,
+	0x4f, 0x51, 0xfb, 0x59, 0x80, 0x0b, 0x0f, 0x34, 0x32, 0xe8, 0x9b, 0xea, 0xb3, 0x15, 0x70, 0xba,
+	0x17, 0xe4, 0x54, 0x9e, 0x0f, 0xd5, 0x01, 0x13, 0x41, 0xeb, 0x6f, 0x02, 0x5c, 0xf4, 0xb0, 0xae,
+	0x80, 0xd9, 0x3b, 0x21, 0x66, 0xeb, 0xf1, 0xe1, 0x46, 0x92, 0xfb, 0x83, 0x00, 0x1b, 0x9f, 0xe8,
+	0x86, 0xa6, 0x93, 0x15, 0x50, 0xdb, 0x0d, 0x52, 0x1b, 0x43, 0x05, 0x36, 0x94, 0x08, 0x62, 0x7f,
+	0x11, 0xa0, 0xec, 0xe0, 0x5c, 0x01, 0xad, 0xb7, 0x42, 0xb4, 0x4a, 0x71, 0xa1, 0x46, 0x92, 0xfa,
+	0xa3, 0x00, 0xe5, 0x7d, 0xd3, 0x18, 0x19, 0x04, 0xaf, 0x80, 0xd5, 0x9b, 0x41, 0x56, 0x63, 0x40,
+	0xe5, 0x58, 0x22, 0x68, 0xfd, 0x55, 0x80, 0x0b, 0x2e, 0xd2, 0x15, 0xf0, 0x7a, 0x3b, 0xc4, 0xab,
+	0x1c, 0x1b, 0xec, 0x4c, 0xb5, 0xb6, 0xf0, 0x8a, 0x78, 0x4d, 0xae, 0xd6, 0x16, 0x9e, 0x41, 0x2b,
+	0x55, 0xab, 0x83, 0xf3, 0x7c, 0xa8, 0xb5, 0x85, 0x67, 0x92, 0xca, 0x1a, 0xd6, 0x54, 0xeb, 0x91,
+	0xf3, 0xd1, 0xb0, 0x28, 0x92, 0x59, 0x0d, 0xcb, 0x46, 0x79, 0x4e, 0x1a, 0x16, 0x05, 0x13, 0x49,
+	0xe8, 0xf7, 0x02, 0x94, 0x77, 0x31, 0xf9, 0x1c, 0x9b, 0x23, 0x87, 0xd2, 0xcf, 0xc2, 0x58, 0xaf,
+	0xcd, 0xc4, 0x7a, 0x6f, 0x82, 0xcd, 0xa3, 0xe5, 0x9d, 0x7d, 0x0e, 0x24, 0x82, 0x51, 0xda, 0x57,
+	0x5d, 0x98, 0x9c, 0xd3, 0xdd, 0x30, 0xce, 0xeb, 0xf3, 0x70, 0x2e, 0xef, 0xe0, 0x73, 0x2c, 0x91,
+	0x94, 0x7e, 0x2b, 0x40, 0xe9, 0x81, 0x4a, 0x7a, 0x83, 0x65, 0x13, 0x9a, 0x5c, 0xa0, 0x0c, 0x46,
+	0x04, 0x9d, 0xd4, 0x9b, 0x38, 0xc4, 0x65, 0x93, 0xb9, 0x80, 0x3a, 0x19, 0x92, 0x48, 0x2a, 0xbf,
+	0x49, 0xc3, 0xe5, 0x5b, 0x2c, 0x71, 0x9b, 0x7f, 0x7f, 0x5f, 0x57, 0xc7, 0xd6, 0xc0, 0x20, 0xe8,
+	0x0e, 0x64, 0x09, 0x36, 0x47, 0x0c, 0x6d, 0xb6, 0xb9, 0xf3, 0xe6, 0x78, 0xf3, 0x83, 0x43, 0x8d,
+	0x0c, 0x26, 0x0f, 0xa5, 0x9e, 0x31, 0x92, 0xa7, 0x63, 0xd5, 0xea, 0x19, 0xea, 0xd0, 0x94, 0xed,
+	0xea, 0xb2, 0x5b, 0x5d, 0x36, 0xad, 0x91, 0xac, 0x8e, 0x35, 0x79, 0xda, 0x90, 0xba, 0x7a, 0x1f,
+	0x3f, 0x57, 0x58, 0x1e, 0x74, 0x0f, 0xf2, 0x43, 0x56, 0x89, 0xe3, 0xbe, 0x31, 0x1f, 0x77, 0x10,
+	0xd9, 0xa7, 0xaa, 0xde, 0xd7, 0xfa, 0x2a, 0xc1, 0x0a, 0x4f, 0x84, 0x0e, 0x00, 0x7a, 0xce, 0x4b,
+	0x4b, 0xcc, 0x6c, 0x65, 0xde, 0x2a, 0x6d, 0x33, 0xfb, 0xe2, 0x78, 0x33, 0xa5, 0xf8, 0x52, 0xd6,
+	0xfe, 0x48, 0xc3, 0xff, 0x67, 0x7c, 0x8d, 0x10, 0x64, 0x75, 0x75, 0x84, 0x19, 0x3f, 0x45, 0x85,
+	0x3d, 0xa3, 0xc7, 0x00, 0x16, 0xb6, 0x2c, 0xcd, 0xd0, 0x0f, 0xb4, 0x3e, 0xdb, 0x67, 0xb6, 0xb9,
+	0x77, 0x72, 0xbc, 0x59, 0xbc, 0x6f, 0xbf, 0xed, 0xb6, 0xde, 0x1c, 0x6f, 0xee, 0x24, 0xa6, 0xd1,
+	0x8d, 0x56, 0x8a, 0x3c, 0x7d, 0xb7, 0x8f, 0x2a, 0x50, 0x18, 0x9b, 0x9a, 0x61, 0x6a, 0xe4, 0x48,
+	0xcc, 0x6c, 0x09, 0x57, 0x72, 0x8a, 0xbb, 0x46, 0x3d, 0x28, 0x8c, 0x30, 0x51, 0xfb, 0x2a, 0x51,
+	0xc5, 0x2c, 0xa3, 0x65, 0x77, 0x61, 0x5a, 0xa4, 0xdb, 0x3c, 0x53, 0x5b, 0x27, 0xe6, 0x91, 0xe2,
+	0x26, 0xae, 0x7c, 0x04, 0x1b, 0x81, 0x3f, 0xa1, 0x8b, 0x90, 0x79, 0x82, 0x8f, 0x38, 0x21, 0xf4,
+	0x11, 0x5d, 0x82, 0xdc, 0x54, 0x1d, 0x4e, 0x30, 0xa3, 0xa2, 0xa8, 0xd8, 0x8b, 0x9d, 0xf4, 0x87,
+	0x42, 0xed, 0x75, 0x16, 0xfe, 0x17, 0x2c, 0xc8, 0x4e, 0x11, 0x6a, 0x41, 0x0e, 0xeb, 0x04, 0x9b,
+	0x67, 0x1f, 0xe4, 0xe8, 0x4b, 0x4d, 0x27, 0xa5, 0xd8, 0xc1, 0xe8, 0x36, 0x14, 0x9e, 0xf1, 0x41,
+	0x77, 0xc1, 0x49, 0xbe, 0x93, 0x52, 0xdc, 0x14, 0x68, 0x17, 0xf2, 0x2a, 0x1b, 0xf0, 0xc4, 0x4c,
+	0xdc, 0x23, 0xe7, 0x9b, 0x5d, 0x3b, 0x29, 0x85, 0x87, 0xd3, 0x2e, 0x35, 0xb6, 0x27, 0x1a, 0x31,
+	0xbb, 0xc8, 0xbc, 0xd6, 0x49, 0x29, 0x4e, 0x02, 0x0a, 0xaa, 0xcf, 0xfa, 0xb8, 0x58, 0x58, 0x60,
+	0x44, 0xa1, 0xa0, 0xec, 0x70, 0x46, 0x39, 0x6d, 0x5f, 0x62, 0x2e, 0x79, 0x5b, 0x66, 0x94, 0xd3,
+	0x15, 0xda, 0x83, 0xc2, 0x21, 0x26, 0x07, 0xcc, 0x32, 0xf2, 0x8b, 0xf4, 0x23, 0xba, 0xb7, 0x43,
+	0x7b, 0x4d, 0x21, 0x3d, 0xa3, 0x9e, 0x25, 0xae, 0x25, 0x37, 0x62, 0x0a, 0x89, 0x05, 0x37, 0xd7,
+	0xb8, 0x9d, 0xd7, 0xfe, 0xce, 0xc2, 0xa5, 0xa0, 0xd8, 0x6c, 0x33, 0x44, 0xed, 0xa0, 0xda, 0x92,
+	0xdd, 0x4c, 0x3d, 0xb9, 0xdd, 0x39, 0x25, 0xb7, 0xc4, 0x37, 0xb1, 0x80, 0xde, 0x3a, 0x21, 0xbd,
+	0x25, 0xbc, 0x80, 0xf8, 0x04, 0xb7, 0x17, 0x16, 0x5c, 0xd2, 0x99, 0xdb, 0xaf, 0xb8, 0x4e, 0x48,
+	0x71, 0x09, 0x27, 0x4d, 0x9f, 0xe4, 0xda, 0x41, 0xc9, 0x25, 0x1b, 0xb0, 0x3c, 0xcd, 0xdd, 0x3a,
+	0xa5, 0xb9, 0xa4, 0x93, 0x85, 0x5f, 0x74, 0xed, 0xa0, 0xe8, 0x92, 0xf5, 0x55, 0x4f, 0x75, 0x05,
+	0xa7, 0x3f, 0xd7, 0xfe, 0x11, 0x00, 0x3c, 0x77, 0x42, 0xef, 0x40, 0xd1, 0x6d, 0x2d, 0xdc, 0x24,
+	0xbd, 0x17, 0x01, 0x3b, 0x4f, 0x87, 0xec, 0xfc, 0x4b, 0x9f, 0x9d, 0xdb, 0x5d, 0x6e, 0x27, 0x89,
+	0x2f, 0xce, 0x72, 0x70, 0x24, 0x52, 0x75, 0x60, 0x3c, 0x1a, 0x13, 0xa6, 0x8e, 0x82, 0xe2, 0x2c,
+	0xdf, 0xce, 0xdb, 0xef, 0xc2, 0xba, 0xef, 0x98, 0xa0, 0x8f, 0x7d, 0x83, 0xc4, 0xfa, 0xf6, 0xbb,
+	0xf3, 0x91, 0xd3, 0xff, 0x06, 0x6f, 0xc6, 0x2c, 0xb2, 0x76, 0x1d, 0x36, 0x02, 0xe6, 0x7c, 0x36,
+	0x95, 0x35, 0x05, 0xca, 0xc1, 0xc3, 0xb5, 0x04, 0x08, 0x57, 0x61, 0xdd, 0x67, 0xe9, 0x73, 0x00,
+	0xec, 0x43, 0xc9, 0x7f, 0x1e, 0x97, 0x50, 0xfe, 0x1a, 0x94, 0xfc, 0x7d, 0x20, 0x58, 0x3f, 0x1d,
+	0xae, 0x7f, 0x0f, 0x36, 0x02, 0x87, 0x78, 0x39, 0xfb, 0x6f, 0xe1, 0xb8, 0xf5, 0xf7, 0xa1, 0xd4,
+	0xc2, 0x4b, 0x2d, 0xff, 0x1e, 0x80, 0xd7, 0x74, 0xe6, 0xb0, 0x4f, 0xe5, 0xe7, 0xb9, 0xc5, 0x12,
+	0x8a, 0x97, 0xa1, 0xe4, 0x6f, 0x54, 0x94, 0xde, 0x80, 0x89, 0x2c, 0xa1, 0x44, 0x09, 0xc0, 0xeb,
+	0x60, 0x74, 0x07, 0x3e, 0x6b, 0x59, 0x42, 0xfa, 0xd7, 0x02, 0x64, 0xe9, 0x4b, 0x74, 0xd9, 0x1d,
+	0xc2, 0x6d, 0xda, 0xf8, 0x0a, 0x55, 0x03, 0x93, 0x74, 0x7a, 0x2b, 0x73, 0xa5, 0xe8, 0x1f, 0x84,
+	0xd1, 0x3e, 0x6d, 0xa5, 0x7d, 0xfc, 0x5c, 0xcc, 0xbc, 0xf5, 0x6d, 0xc0, 0x4e, 0x84, 0xbe, 0x82,
+	0xb2, 0x9b, 0xff, 0x40, 0xd3, 0x1f, 0x19, 0x7c, 0x50, 0xbd, 0x3a, 0x7f, 0x7b, 0xe1, 0x89, 0x7d,
+	0xc3, 0x4d, 0xd4, 0xd5, 0x1f, 0x19, 0xb5, 0xdf, 0x05, 0x28, 0x9e, 0x3d, 0xa6, 0x9f, 0xe5, 0xb5,
+	0x5f, 0x9c, 0xf2, 0xda, 0x1b, 0x09, 0x10, 0xad, 0x64, 0x58, 0xde, 0xfe, 0x37, 0x0f, 0xe5, 0xe0,
+	0xfc, 0x82, 0x06, 0x90, 0x63, 0x1e, 0x8b, 0xa4, 0x98, 0x9d, 0x80, 0xdf, 0x79, 0x2b, 0x72, 0xec,
+	0xef, 0xf9, 0xc5, 0xf5, 0x29, 0x14, 0x1c, 0x37, 0x45, 0x8d, 0xf8, 0x63, 0x8d, 0x53, 0x6f, 0x3b,
+	0x49, 0x08, 0x2f, 0xf9, 0x04, 0xf2, 0xb6, 0x7f, 0x22, 0x39, 0xee, 0xe4, 0xe3, 0x94, 0xab, 0xc7,
+	0x0f, 0xe0, 0xc5, 0x74, 0x58, 0xe3, 0x66, 0x89, 0xea, 0xb1, 0x87, 0x23, 0xa7, 0x5c, 0x23, 0x41,
+	0x84, 0xb7, 0x39, 0xdb, 0x1c, 0xe3, 0x6c, 0x2e, 0xf0, 0xfb, 0x66, 0xa5, 0x1e, 0x3f, 0x80, 0x17,
+	0xa3, 0x32, 0x61, 0xa3, 0x92, 0x14, 0x73, 0xc4, 0x4a, 0x22, 0x93, 0xc0, 0x0f, 0x70, 0x3a, 0xac,
+	0x71, 0x53, 0x8c, 0x43, 0x63, 0xf0, 0x17, 0xb1, 0x4a, 0x23, 0x41, 0x04, 0xaf, 0xf7, 0x18, 0x72,
+	0xcc, 0x23, 0xe3, 0xec, 0xcc, 0xff, 0x63, 0x51, 0x45, 0x8e, 0xfd, 0xbd, 0x5d, 0xa9, 0x2e, 0x34,
+	0xc5, 0x17, 0x27, 0x55, 0xe1, 0xe5, 0x49, 0x55, 0xf8, 0xeb, 0xa4, 0x2a, 0x7c, 0xfd, 0xaa, 0x9a,
+	0x7a, 0xf9, 0xaa, 0x9a, 0xfa, 0xf3, 0x55, 0x35, 0xf5, 0x30, 0xcf, 0x92, 0xbc, 0xff, 0xdf, 0x00,
+	0xea, 0x8b, 0x0e, 0x89, 0x4e, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3259,6 +3382,30 @@ func (m *LeaderElectionCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintElection(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintElection(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintElection(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.SessionID != 0 {
 		i = encodeVarintElection(dAtA, i, uint64(m.SessionID))
 		i--
@@ -3694,6 +3841,40 @@ func (m *EnterInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Preempt {
+		i--
+		if m.Preempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintElection(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintElection(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintElection(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Candidate) > 0 {
 		i -= len(m.Candidate)
 		copy(dAtA[i:], m.Candidate)
@@ -4184,6 +4365,20 @@ func (m *Term) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CandidateInfo) > 0 {
+		for iNdEx := len(m.CandidateInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandidateInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintElection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Index != 0 {
 		i = encodeVarintElection(dAtA, i, uint64(m.Index))
 		i--
@@ -4208,6 +4403,60 @@ func (m *Term) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Candidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintElection(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintElection(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintElection(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintElection(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintElection(dAtA []byte, offset int, v uint64) int {
 	offset -= sovElection(v)
 	base := offset
@@ -4526,6 +4775,17 @@ func (m *LeaderElectionCandidate) Size() (n int) {
 	if m.SessionID != 0 {
 		n += 1 + sovElection(uint64(m.SessionID))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovElection(uint64(m.Priority))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovElection(uint64(len(k))) + 1 + len(v) + sovElection(uint64(len(v)))
+			n += mapEntrySize + 1 + sovElection(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -4755,6 +5015,20 @@ func (m *EnterInput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovElection(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovElection(uint64(m.Priority))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovElection(uint64(len(k))) + 1 + len(v) + sovElection(uint64(len(v)))
+			n += mapEntrySize + 1 + sovElection(uint64(mapEntrySize))
+		}
+	}
+	if m.Preempt {
+		n += 2
+	}
 	return n
 }
 
@@ -4948,6 +5222,36 @@ func (m *Term) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovElection(uint64(m.Index))
 	}
+	if len(m.CandidateInfo) > 0 {
+		for _, e := range m.CandidateInfo {
+			l = e.Size()
+			n += 1 + l + sovElection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Candidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovElection(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovElection(uint64(m.Priority))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovElection(uint64(len(k))) + 1 + len(v) + sovElection(uint64(len(v)))
+			n += mapEntrySize + 1 + sovElection(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -7128,6 +7432,152 @@ func (m *LeaderElectionCandidate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowElection
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipElection(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthElection
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipElection(dAtA[iNdEx:])
@@ -7870,6 +8320,172 @@ func (m *EnterInput) Unmarshal(dAtA []byte) error {
 			}
 			m.Candidate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowElection
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipElection(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthElection
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preempt = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipElection(dAtA[iNdEx:])
@@ -9177,6 +9793,268 @@ func (m *Term) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandidateInfo = append(m.CandidateInfo, Candidate{})
+			if err := m.CandidateInfo[len(m.CandidateInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowElection
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowElection
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthElection
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipElection(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthElection
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipElection(dAtA[iNdEx:])
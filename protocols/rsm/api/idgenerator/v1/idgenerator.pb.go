@@ -0,0 +1,1527 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: idgenerator/v1/idgenerator.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AllocateRequest struct {
+	Headers        *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*AllocateInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+}
+
+func (m *AllocateRequest) Reset()         { *m = AllocateRequest{} }
+func (m *AllocateRequest) String() string { return proto.CompactTextString(m) }
+func (*AllocateRequest) ProtoMessage()    {}
+func (*AllocateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b979131e88afabc1, []int{0}
+}
+func (m *AllocateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocateRequest.Merge(m, src)
+}
+func (m *AllocateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocateRequest proto.InternalMessageInfo
+
+func (m *AllocateRequest) GetHeaders() *v1.ProposalRequestHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type AllocateResponse struct {
+	Headers         *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*AllocateOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+}
+
+func (m *AllocateResponse) Reset()         { *m = AllocateResponse{} }
+func (m *AllocateResponse) String() string { return proto.CompactTextString(m) }
+func (*AllocateResponse) ProtoMessage()    {}
+func (*AllocateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b979131e88afabc1, []int{1}
+}
+func (m *AllocateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocateResponse.Merge(m, src)
+}
+func (m *AllocateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocateResponse proto.InternalMessageInfo
+
+func (m *AllocateResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type IDGeneratorInput struct {
+	// Types that are valid to be assigned to Input:
+	//	*IDGeneratorInput_Allocate
+	Input isIDGeneratorInput_Input `protobuf_oneof:"input"`
+}
+
+func (m *IDGeneratorInput) Reset()         { *m = IDGeneratorInput{} }
+func (m *IDGeneratorInput) String() string { return proto.CompactTextString(m) }
+func (*IDGeneratorInput) ProtoMessage()    {}
+func (*IDGeneratorInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b979131e88afabc1, []int{2}
+}
+func (m *IDGeneratorInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IDGeneratorInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IDGeneratorInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IDGeneratorInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDGeneratorInput.Merge(m, src)
+}
+func (m *IDGeneratorInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *IDGeneratorInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDGeneratorInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDGeneratorInput proto.InternalMessageInfo
+
+type isIDGeneratorInput_Input interface {
+	isIDGeneratorInput_Input()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type IDGeneratorInput_Allocate struct {
+	Allocate *AllocateInput `protobuf:"bytes,1,opt,name=allocate,proto3,oneof" json:"allocate,omitempty"`
+}
+
+func (*IDGeneratorInput_Allocate) isIDGeneratorInput_Input() {}
+
+func (m *IDGeneratorInput) GetInput() isIDGeneratorInput_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *IDGeneratorInput) GetAllocate() *AllocateInput {
+	if x, ok := m.GetInput().(*IDGeneratorInput_Allocate); ok {
+		return x.Allocate
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IDGeneratorInput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IDGeneratorInput_Allocate)(nil),
+	}
+}
+
+type IDGeneratorOutput struct {
+	// Types that are valid to be assigned to Output:
+	//	*IDGeneratorOutput_Allocate
+	Output isIDGeneratorOutput_Output `protobuf_oneof:"output"`
+}
+
+func (m *IDGeneratorOutput) Reset()         { *m = IDGeneratorOutput{} }
+func (m *IDGeneratorOutput) String() string { return proto.CompactTextString(m) }
+func (*IDGeneratorOutput) ProtoMessage()    {}
+func (*IDGeneratorOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b979131e88afabc1, []int{3}
+}
+func (m *IDGeneratorOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IDGeneratorOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IDGeneratorOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IDGeneratorOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDGeneratorOutput.Merge(m, src)
+}
+func (m *IDGeneratorOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *IDGeneratorOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDGeneratorOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDGeneratorOutput proto.InternalMessageInfo
+
+type isIDGeneratorOutput_Output interface {
+	isIDGeneratorOutput_Output()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type IDGeneratorOutput_Allocate struct {
+	Allocate *AllocateOutput `protobuf:"bytes,1,opt,name=allocate,proto3,oneof" json:"allocate,omitempty"`
+}
+
+func (*IDGeneratorOutput_Allocate) isIDGeneratorOutput_Output() {}
+
+func (m *IDGeneratorOutput) GetOutput() isIDGeneratorOutput_Output {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *IDGeneratorOutput) GetAllocate() *AllocateOutput {
+	if x, ok := m.GetOutput().(*IDGeneratorOutput_Allocate); ok {
+		return x.Allocate
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IDGeneratorOutput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IDGeneratorOutput_Allocate)(nil),
+	}
+}
+
+type AllocateInput struct {
+	// size is the number of IDs to allocate
+	Size_ uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *AllocateInput) Reset()         { *m = AllocateInput{} }
+func (m *AllocateInput) String() string { return proto.CompactTextString(m) }
+func (*AllocateInput) ProtoMessage()    {}
+func (*AllocateInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b979131e88afabc1, []int{4}
+}
+func (m *AllocateInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocateInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocateInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocateInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocateInput.Merge(m, src)
+}
+func (m *AllocateInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocateInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocateInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocateInput proto.InternalMessageInfo
+
+func (m *AllocateInput) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+type AllocateOutput struct {
+	// start is the first ID in the allocated block
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the ID following the last ID in the allocated block
+	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *AllocateOutput) Reset()         { *m = AllocateOutput{} }
+func (m *AllocateOutput) String() string { return proto.CompactTextString(m) }
+func (*AllocateOutput) ProtoMessage()    {}
+func (*AllocateOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b979131e88afabc1, []int{5}
+}
+func (m *AllocateOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocateOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocateOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocateOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocateOutput.Merge(m, src)
+}
+func (m *AllocateOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocateOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocateOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocateOutput proto.InternalMessageInfo
+
+func (m *AllocateOutput) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *AllocateOutput) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AllocateRequest)(nil), "atomix.protocols.rsm.idgenerator.v1.AllocateRequest")
+	proto.RegisterType((*AllocateResponse)(nil), "atomix.protocols.rsm.idgenerator.v1.AllocateResponse")
+	proto.RegisterType((*IDGeneratorInput)(nil), "atomix.protocols.rsm.idgenerator.v1.IDGeneratorInput")
+	proto.RegisterType((*IDGeneratorOutput)(nil), "atomix.protocols.rsm.idgenerator.v1.IDGeneratorOutput")
+	proto.RegisterType((*AllocateInput)(nil), "atomix.protocols.rsm.idgenerator.v1.AllocateInput")
+	proto.RegisterType((*AllocateOutput)(nil), "atomix.protocols.rsm.idgenerator.v1.AllocateOutput")
+}
+
+func init() { proto.RegisterFile("idgenerator/v1/idgenerator.proto", fileDescriptor_b979131e88afabc1) }
+
+var fileDescriptor_b979131e88afabc1 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x4e, 0xc2, 0x40,
+	0x10, 0xc6, 0xbb, 0x5a, 0xfe, 0x64, 0x88, 0x5a, 0x37, 0x1c, 0x1a, 0x0e, 0x95, 0x94, 0x8b, 0xa7,
+	0xd6, 0x82, 0x26, 0x5e, 0x25, 0x26, 0x82, 0x07, 0x85, 0xbe, 0x41, 0x85, 0x0d, 0x36, 0x29, 0xdd,
+	0xda, 0xdd, 0x56, 0xe3, 0x03, 0x78, 0xf6, 0x41, 0xbc, 0xf8, 0x16, 0x1e, 0x39, 0x7a, 0x32, 0x06,
+	0x5e, 0xc4, 0xd0, 0x6d, 0xa5, 0x88, 0x07, 0xe0, 0x36, 0xb3, 0x99, 0xef, 0xfb, 0x7e, 0x33, 0x59,
+	0xa8, 0xbb, 0xc3, 0x11, 0xf1, 0x49, 0xe8, 0x70, 0x1a, 0x9a, 0xb1, 0x65, 0xe6, 0x5a, 0x23, 0x08,
+	0x29, 0xa7, 0xb8, 0xe1, 0x70, 0x3a, 0x76, 0x9f, 0x44, 0x37, 0xa0, 0x1e, 0x33, 0x42, 0x36, 0x36,
+	0xf2, 0x73, 0xb1, 0x55, 0x53, 0x62, 0xcb, 0xbc, 0x27, 0xce, 0x90, 0x84, 0x4c, 0x0c, 0xd6, 0xaa,
+	0x23, 0x3a, 0xa2, 0x49, 0x69, 0xce, 0x2b, 0xf1, 0xaa, 0xbf, 0x21, 0x38, 0xb8, 0xf0, 0x3c, 0x3a,
+	0x70, 0x38, 0xb1, 0xc9, 0x43, 0x44, 0x18, 0xc7, 0x5d, 0x28, 0xa5, 0x52, 0x15, 0xd5, 0xd1, 0x71,
+	0xa5, 0x69, 0x1a, 0xff, 0x46, 0xc6, 0x96, 0xd1, 0x0b, 0x69, 0x40, 0x99, 0xe3, 0xa5, 0xd2, 0x8e,
+	0x90, 0xd9, 0x99, 0x1e, 0xdf, 0x40, 0xc1, 0xf5, 0x83, 0x88, 0xab, 0x3b, 0x89, 0x51, 0xd3, 0x58,
+	0x83, 0xdd, 0xc8, 0x78, 0xba, 0x73, 0x65, 0x5b, 0x9e, 0x7c, 0x1d, 0x21, 0x5b, 0xd8, 0xe8, 0xef,
+	0x08, 0x94, 0x05, 0x2e, 0x0b, 0xa8, 0xcf, 0x08, 0xbe, 0xfe, 0xcb, 0x7b, 0xb2, 0x06, 0xaf, 0xd0,
+	0xae, 0x00, 0xf7, 0xa1, 0x48, 0x23, 0xbe, 0x20, 0x6e, 0x6d, 0x44, 0x7c, 0x1b, 0xf1, 0x05, 0x72,
+	0x6a, 0xa4, 0x8f, 0x41, 0xe9, 0x5e, 0x5e, 0x65, 0xe3, 0xc9, 0x52, 0xb8, 0x07, 0x65, 0x27, 0xd5,
+	0xa8, 0x68, 0xdb, 0xd3, 0x74, 0x24, 0xfb, 0xd7, 0xa5, 0x5d, 0x4a, 0x2f, 0xad, 0x07, 0x70, 0x98,
+	0x8b, 0x13, 0x44, 0xb8, 0xbf, 0x92, 0xb7, 0xcd, 0x62, 0x4b, 0x81, 0xe5, 0xec, 0x52, 0x7a, 0x03,
+	0xf6, 0x96, 0xb8, 0x30, 0x06, 0x99, 0xb9, 0xcf, 0x22, 0x49, 0xb6, 0x93, 0x5a, 0x3f, 0x87, 0xfd,
+	0x65, 0x33, 0x5c, 0x85, 0x02, 0xe3, 0x4e, 0xc8, 0xd3, 0x31, 0xd1, 0x60, 0x05, 0x76, 0x89, 0x3f,
+	0x4c, 0xae, 0x2f, 0xdb, 0xf3, 0xb2, 0xf9, 0x82, 0xa0, 0x92, 0xdb, 0x08, 0x3f, 0x42, 0x39, 0x73,
+	0xc2, 0xa7, 0x1b, 0x6d, 0x91, 0xfe, 0xd2, 0xda, 0xd9, 0x86, 0x2a, 0xf1, 0x57, 0xda, 0xea, 0xc7,
+	0x54, 0x43, 0x93, 0xa9, 0x86, 0xbe, 0xa7, 0x1a, 0x7a, 0x9d, 0x69, 0xd2, 0x64, 0xa6, 0x49, 0x9f,
+	0x33, 0x4d, 0xba, 0x2b, 0x26, 0x46, 0xad, 0x9f, 0x01, 0x00, 0x13, 0x6b, 0x41, 0x15, 0xbd, 0x03,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IDGeneratorClient is the client API for IDGenerator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IDGeneratorClient interface {
+	// Allocate allocates a contiguous block of IDs
+	Allocate(ctx context.Context, in *AllocateRequest, opts ...grpc.CallOption) (*AllocateResponse, error)
+}
+
+type iDGeneratorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIDGeneratorClient(cc *grpc.ClientConn) IDGeneratorClient {
+	return &iDGeneratorClient{cc}
+}
+
+func (c *iDGeneratorClient) Allocate(ctx context.Context, in *AllocateRequest, opts ...grpc.CallOption) (*AllocateResponse, error) {
+	out := new(AllocateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.idgenerator.v1.IDGenerator/Allocate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IDGeneratorServer is the server API for IDGenerator service.
+type IDGeneratorServer interface {
+	// Allocate allocates a contiguous block of IDs
+	Allocate(context.Context, *AllocateRequest) (*AllocateResponse, error)
+}
+
+// UnimplementedIDGeneratorServer can be embedded to have forward compatible implementations.
+type UnimplementedIDGeneratorServer struct {
+}
+
+func (*UnimplementedIDGeneratorServer) Allocate(ctx context.Context, req *AllocateRequest) (*AllocateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allocate not implemented")
+}
+
+func RegisterIDGeneratorServer(s *grpc.Server, srv IDGeneratorServer) {
+	s.RegisterService(&_IDGenerator_serviceDesc, srv)
+}
+
+func _IDGenerator_Allocate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDGeneratorServer).Allocate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.idgenerator.v1.IDGenerator/Allocate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDGeneratorServer).Allocate(ctx, req.(*AllocateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _IDGenerator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.protocols.rsm.idgenerator.v1.IDGenerator",
+	HandlerType: (*IDGeneratorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Allocate",
+			Handler:    _IDGenerator_Allocate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idgenerator/v1/idgenerator.proto",
+}
+
+func (m *AllocateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllocateInput != nil {
+		{
+			size, err := m.AllocateInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIdgenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIdgenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllocateOutput != nil {
+		{
+			size, err := m.AllocateOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIdgenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIdgenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IDGeneratorInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IDGeneratorInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDGeneratorInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IDGeneratorInput_Allocate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDGeneratorInput_Allocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Allocate != nil {
+		{
+			size, err := m.Allocate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIdgenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IDGeneratorOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IDGeneratorOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDGeneratorOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Output != nil {
+		{
+			size := m.Output.Size()
+			i -= size
+			if _, err := m.Output.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IDGeneratorOutput_Allocate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDGeneratorOutput_Allocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Allocate != nil {
+		{
+			size, err := m.Allocate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIdgenerator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AllocateInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocateInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocateInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintIdgenerator(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocateOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocateOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocateOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintIdgenerator(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintIdgenerator(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIdgenerator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIdgenerator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllocateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovIdgenerator(uint64(l))
+	}
+	if m.AllocateInput != nil {
+		l = m.AllocateInput.Size()
+		n += 1 + l + sovIdgenerator(uint64(l))
+	}
+	return n
+}
+
+func (m *AllocateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovIdgenerator(uint64(l))
+	}
+	if m.AllocateOutput != nil {
+		l = m.AllocateOutput.Size()
+		n += 1 + l + sovIdgenerator(uint64(l))
+	}
+	return n
+}
+
+func (m *IDGeneratorInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *IDGeneratorInput_Allocate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allocate != nil {
+		l = m.Allocate.Size()
+		n += 1 + l + sovIdgenerator(uint64(l))
+	}
+	return n
+}
+func (m *IDGeneratorOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		n += m.Output.Size()
+	}
+	return n
+}
+
+func (m *IDGeneratorOutput_Allocate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allocate != nil {
+		l = m.Allocate.Size()
+		n += 1 + l + sovIdgenerator(uint64(l))
+	}
+	return n
+}
+func (m *AllocateInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovIdgenerator(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *AllocateOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovIdgenerator(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovIdgenerator(uint64(m.End))
+	}
+	return n
+}
+
+func sovIdgenerator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIdgenerator(x uint64) (n int) {
+	return sovIdgenerator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllocateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocateInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocateInput == nil {
+				m.AllocateInput = &AllocateInput{}
+			}
+			if err := m.AllocateInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocateOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocateOutput == nil {
+				m.AllocateOutput = &AllocateOutput{}
+			}
+			if err := m.AllocateOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDGeneratorInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDGeneratorInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDGeneratorInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AllocateInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &IDGeneratorInput_Allocate{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDGeneratorOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDGeneratorOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDGeneratorOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AllocateOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &IDGeneratorOutput_Allocate{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocateInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocateInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocateOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocateOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocateOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdgenerator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdgenerator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIdgenerator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIdgenerator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIdgenerator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIdgenerator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIdgenerator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIdgenerator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIdgenerator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIdgenerator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIdgenerator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,12 +6,12 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
-	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -500,7 +500,9 @@ func (*LockOutput) XXX_OneofWrappers() []interface{} {
 }
 
 type AcquireInput struct {
-	Timeout *time.Duration `protobuf:"bytes,1,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	Timeout   *time.Duration `protobuf:"bytes,1,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	Label     string         `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Reentrant bool           `protobuf:"varint,3,opt,name=reentrant,proto3" json:"reentrant,omitempty"`
 }
 
 func (m *AcquireInput) Reset()         { *m = AcquireInput{} }
@@ -543,8 +545,23 @@ func (m *AcquireInput) GetTimeout() *time.Duration {
 	return nil
 }
 
+func (m *AcquireInput) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *AcquireInput) GetReentrant() bool {
+	if m != nil {
+		return m.Reentrant
+	}
+	return false
+}
+
 type AcquireOutput struct {
 	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	Holds uint32                                              `protobuf:"varint,2,opt,name=holds,proto3" json:"holds,omitempty"`
 }
 
 func (m *AcquireOutput) Reset()         { *m = AcquireOutput{} }
@@ -587,6 +604,13 @@ func (m *AcquireOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1
 	return 0
 }
 
+func (m *AcquireOutput) GetHolds() uint32 {
+	if m != nil {
+		return m.Holds
+	}
+	return 0
+}
+
 type ReleaseInput struct {
 }
 
@@ -696,7 +720,12 @@ func (m *GetInput) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetInput proto.InternalMessageInfo
 
 type GetOutput struct {
-	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	Index     github_com_atomix_atomix_protocols_rsm_api_v1.Index     `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	SessionID github_com_atomix_atomix_protocols_rsm_api_v1.SessionID `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SessionID" json:"session_id,omitempty"`
+	Label     string                                                  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Acquired  *time.Time                                              `protobuf:"bytes,4,opt,name=acquired,proto3,stdtime" json:"acquired,omitempty"`
+	Holds     uint32                                                  `protobuf:"varint,5,opt,name=holds,proto3" json:"holds,omitempty"`
+	Waiters   uint32                                                  `protobuf:"varint,6,opt,name=waiters,proto3" json:"waiters,omitempty"`
 }
 
 func (m *GetOutput) Reset()         { *m = GetOutput{} }
@@ -739,6 +768,41 @@ func (m *GetOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Ind
 	return 0
 }
 
+func (m *GetOutput) GetSessionID() github_com_atomix_atomix_protocols_rsm_api_v1.SessionID {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
+func (m *GetOutput) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *GetOutput) GetAcquired() *time.Time {
+	if m != nil {
+		return m.Acquired
+	}
+	return nil
+}
+
+func (m *GetOutput) GetHolds() uint32 {
+	if m != nil {
+		return m.Holds
+	}
+	return 0
+}
+
+func (m *GetOutput) GetWaiters() uint32 {
+	if m != nil {
+		return m.Waiters
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AcquireRequest)(nil), "atomix.protocols.rsm.lock.v1.AcquireRequest")
 	proto.RegisterType((*AcquireResponse)(nil), "atomix.protocols.rsm.lock.v1.AcquireResponse")
@@ -759,47 +823,57 @@ func init() {
 func init() { proto.RegisterFile("lock/v1/lock.proto", fileDescriptor_dafddc83229435f8) }
 
 var fileDescriptor_dafddc83229435f8 = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x6d, 0x7e, 0x4d, 0xfb, 0xf4, 0xdf, 0x4f, 0x27, 0x86, 0x10, 0x21, 0x07, 0x79,
-	0x80, 0x02, 0xed, 0x1d, 0x69, 0x07, 0x04, 0x4c, 0x58, 0xd0, 0x24, 0x08, 0x04, 0x78, 0xec, 0x80,
-	0xe4, 0x24, 0x17, 0xd7, 0x6a, 0x92, 0x4b, 0xed, 0x73, 0x54, 0xde, 0x05, 0x63, 0x17, 0x26, 0x16,
-	0x66, 0x5e, 0x05, 0x63, 0x46, 0xa6, 0x52, 0x25, 0xe2, 0x4d, 0x30, 0xa1, 0xf3, 0x9d, 0x43, 0x62,
-	0x92, 0xc6, 0x91, 0x90, 0x3a, 0xd9, 0x4e, 0xee, 0xfb, 0xf8, 0xf3, 0xfd, 0x3e, 0xcf, 0x9d, 0x01,
-	0xb7, 0x78, 0xfd, 0x84, 0xf6, 0x4a, 0x54, 0x5e, 0x49, 0xd7, 0xe7, 0x82, 0xe3, 0x5b, 0x8e, 0xe0,
-	0x6d, 0xef, 0x4c, 0x3d, 0xd5, 0x79, 0x2b, 0x20, 0x7e, 0xd0, 0x26, 0xd1, 0x82, 0x5e, 0xa9, 0xf0,
-	0x7f, 0xaf, 0x44, 0x8f, 0x99, 0xd3, 0x60, 0x7e, 0xa0, 0x56, 0x14, 0x0c, 0x97, 0x73, 0xb7, 0xc5,
-	0x68, 0xf4, 0x54, 0x0b, 0x9b, 0xb4, 0x11, 0xfa, 0x8e, 0xf0, 0x78, 0x47, 0xff, 0x7f, 0xc3, 0xe5,
-	0x2e, 0x8f, 0x6e, 0xa9, 0xbc, 0x53, 0xbf, 0x9a, 0x9f, 0x11, 0x6c, 0x3d, 0xab, 0x9f, 0x86, 0x9e,
-	0xcf, 0x6c, 0x76, 0x1a, 0xb2, 0x40, 0xe0, 0x2a, 0xe4, 0x74, 0xe5, 0x3c, 0xba, 0x8d, 0x76, 0xd6,
-	0xf7, 0x29, 0x99, 0x8a, 0xd2, 0x2b, 0x91, 0xb7, 0x3e, 0xef, 0xf2, 0xc0, 0x69, 0x69, 0x69, 0x45,
-	0xc9, 0xec, 0x58, 0x8f, 0x0f, 0xe1, 0x3f, 0xaf, 0xd3, 0x0d, 0x45, 0x7e, 0x29, 0x2a, 0x74, 0x9f,
-	0x5c, 0xe5, 0x89, 0x68, 0x8e, 0xaa, 0x54, 0x58, 0xd9, 0xfe, 0x45, 0x11, 0xd9, 0x4a, 0x6e, 0x7e,
-	0x41, 0xb0, 0x3d, 0xa2, 0x0c, 0xba, 0xbc, 0x13, 0x30, 0xfc, 0x32, 0x89, 0xf9, 0x30, 0x05, 0xa6,
-	0xd2, 0xfe, 0xc5, 0x59, 0x85, 0x15, 0x1e, 0x8a, 0x3f, 0xa0, 0x0f, 0x52, 0x81, 0xbe, 0x89, 0x24,
-	0x9a, 0x54, 0x17, 0x88, 0x02, 0xb5, 0x59, 0x8b, 0x39, 0xc1, 0xf5, 0x07, 0xaa, 0x39, 0x66, 0x04,
-	0x3a, 0xa2, 0xbc, 0xfe, 0x40, 0x35, 0xca, 0xd4, 0x40, 0xcf, 0x11, 0x40, 0x99, 0x89, 0x38, 0xcc,
-	0xc3, 0x24, 0xe5, 0xee, 0x4c, 0xca, 0x77, 0x21, 0xf3, 0x3f, 0xcc, 0x4a, 0xd2, 0x9a, 0x4c, 0xf2,
-	0xce, 0xd5, 0x80, 0x65, 0x26, 0xa6, 0xa4, 0xf8, 0x09, 0xc1, 0x7a, 0x84, 0xa6, 0x13, 0x2c, 0x27,
-	0xd9, 0xf6, 0xe6, 0xb1, 0xcd, 0x88, 0xef, 0x45, 0x22, 0xbe, 0xbb, 0x73, 0xe9, 0xa6, 0x46, 0x77,
-	0x89, 0x60, 0xed, 0x15, 0xaf, 0x9f, 0x44, 0xe8, 0x32, 0x39, 0x47, 0x0d, 0xae, 0xa6, 0x5b, 0x60,
-	0x3b, 0x56, 0x32, 0x76, 0x2c, 0x96, 0x75, 0x7c, 0xd5, 0xaf, 0xc5, 0xa7, 0x50, 0xd6, 0xd1, 0x62,
-	0xfc, 0x04, 0x96, 0x5d, 0x26, 0xf2, 0xcb, 0x8b, 0xe4, 0x5f, 0xc9, 0xd8, 0x52, 0x64, 0xe5, 0x74,
-	0xf7, 0xcc, 0x9f, 0x08, 0x40, 0x5a, 0x54, 0xfe, 0x65, 0x07, 0x26, 0x3d, 0x2e, 0xb2, 0x93, 0xc7,
-	0x4d, 0x96, 0x93, 0x26, 0x17, 0x99, 0xe0, 0x71, 0x97, 0x4f, 0xc7, 0x5d, 0xa6, 0xed, 0x63, 0x6c,
-	0x73, 0x35, 0x9e, 0x03, 0xb3, 0x0a, 0x1b, 0xe3, 0xfd, 0xc0, 0x8f, 0x21, 0x27, 0xbc, 0x36, 0xe3,
-	0xa1, 0xd0, 0x46, 0x6f, 0x12, 0x75, 0xfe, 0x93, 0xf8, 0xfc, 0x27, 0xcf, 0xf5, 0xf9, 0x6f, 0x65,
-	0xcf, 0x7f, 0x14, 0x91, 0x1d, 0xaf, 0x37, 0xdf, 0xc3, 0xe6, 0x84, 0x6d, 0xfc, 0x5a, 0x86, 0xd9,
-	0x60, 0x67, 0x51, 0xa5, 0xac, 0xf5, 0xe8, 0xd7, 0x45, 0xf1, 0xc0, 0xf5, 0xc4, 0x71, 0x58, 0x23,
-	0x75, 0xde, 0xa6, 0x0a, 0x39, 0xbe, 0x8c, 0xc8, 0xa9, 0x1f, 0xb4, 0xa9, 0xd3, 0xf5, 0x68, 0xaf,
-	0x44, 0xaa, 0x52, 0x6e, 0xab, 0x2a, 0xe6, 0x16, 0x6c, 0x8c, 0xb7, 0xdc, 0xdc, 0x86, 0xcd, 0x89,
-	0x74, 0x4c, 0x80, 0xd5, 0xb8, 0x9f, 0xe6, 0x11, 0xac, 0x8d, 0x5c, 0xff, 0x63, 0x90, 0xfd, 0xaf,
-	0x4b, 0x90, 0x95, 0xb3, 0x81, 0x9b, 0x90, 0xd3, 0x8e, 0xf1, 0x6e, 0xaa, 0x79, 0xd0, 0x87, 0x46,
-	0x61, 0x2f, 0xe5, 0x6a, 0xbd, 0xff, 0x9b, 0x90, 0xd3, 0x4e, 0xe7, 0xbd, 0x67, 0xf2, 0x0b, 0x31,
-	0xef, 0x3d, 0xc9, 0x93, 0xfa, 0x08, 0x96, 0xcb, 0x4c, 0xe0, 0x9d, 0xb9, 0xd3, 0x14, 0xd7, 0xbf,
-	0x97, 0x62, 0xa5, 0xaa, 0x6d, 0xe5, 0xbf, 0x0d, 0x0c, 0xd4, 0x1f, 0x18, 0xe8, 0x72, 0x60, 0xa0,
-	0x8f, 0x43, 0x23, 0xd3, 0x1f, 0x1a, 0x99, 0xef, 0x43, 0x23, 0x53, 0x5b, 0x89, 0xc4, 0x07, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x89, 0x29, 0x91, 0xad, 0x08, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xbd, 0x6e, 0x13, 0x4b,
+	0x14, 0xc7, 0xbd, 0xb1, 0x13, 0xdb, 0x27, 0x5f, 0x57, 0xab, 0x14, 0xbe, 0x56, 0x64, 0x5f, 0x6d,
+	0x71, 0x6f, 0x2e, 0x24, 0xbb, 0x38, 0x48, 0x48, 0x04, 0x1a, 0x56, 0x21, 0x8e, 0x01, 0x89, 0xb0,
+	0x50, 0xd1, 0xa0, 0xb5, 0x3d, 0x71, 0x96, 0xac, 0x3d, 0x9b, 0x9d, 0x59, 0x27, 0x14, 0xbc, 0x43,
+	0xca, 0x34, 0x54, 0x34, 0xd4, 0x3c, 0x05, 0x0d, 0x52, 0x4a, 0x2a, 0x13, 0x39, 0xe2, 0x25, 0x52,
+	0xa1, 0xf9, 0x58, 0x7b, 0xed, 0xd8, 0xf1, 0xba, 0x40, 0xa9, 0xec, 0x99, 0x9d, 0x73, 0xe6, 0x77,
+	0xfe, 0xff, 0x33, 0x33, 0xa0, 0xba, 0xb8, 0x76, 0x68, 0xb4, 0x4b, 0x06, 0xfb, 0xd5, 0x3d, 0x1f,
+	0x53, 0xac, 0xae, 0xda, 0x14, 0x37, 0x9d, 0x13, 0x31, 0xaa, 0x61, 0x97, 0xe8, 0x3e, 0x69, 0xea,
+	0x7c, 0x41, 0xbb, 0x94, 0xff, 0xab, 0x5d, 0x32, 0x0e, 0x90, 0x5d, 0x47, 0x3e, 0x11, 0x2b, 0xf2,
+	0x85, 0x06, 0xc6, 0x0d, 0x17, 0x19, 0x7c, 0x54, 0x0d, 0xf6, 0x8d, 0x7a, 0xe0, 0xdb, 0xd4, 0xc1,
+	0x2d, 0xf9, 0xbd, 0x38, 0xfc, 0x9d, 0x3a, 0x4d, 0x44, 0xa8, 0xdd, 0xf4, 0xe4, 0x82, 0x95, 0x06,
+	0x6e, 0x60, 0xfe, 0xd7, 0x60, 0xff, 0xc4, 0xac, 0xf6, 0x59, 0x81, 0xa5, 0x27, 0xb5, 0xa3, 0xc0,
+	0xf1, 0x91, 0x85, 0x8e, 0x02, 0x44, 0xa8, 0x5a, 0x81, 0xb4, 0xdc, 0x3a, 0xa7, 0xfc, 0xa3, 0xac,
+	0xcd, 0x6f, 0x1a, 0xfa, 0x48, 0xd6, 0x76, 0x49, 0xdf, 0xf3, 0xb1, 0x87, 0x89, 0xed, 0xca, 0xd0,
+	0x5d, 0x11, 0x66, 0x85, 0xf1, 0xea, 0x0e, 0xcc, 0x3a, 0x2d, 0x2f, 0xa0, 0xb9, 0x19, 0x9e, 0xe8,
+	0x8e, 0x7e, 0x53, 0xd1, 0xba, 0xe4, 0xa8, 0xb0, 0x08, 0x33, 0x75, 0xde, 0x29, 0x2a, 0x96, 0x08,
+	0xd7, 0xbe, 0x28, 0xb0, 0xdc, 0xa3, 0x24, 0x1e, 0x6e, 0x11, 0xa4, 0x3e, 0x1b, 0xc6, 0xbc, 0x17,
+	0x03, 0x53, 0xc4, 0x5e, 0xe3, 0xac, 0xc0, 0x1c, 0x0e, 0x68, 0x1f, 0xf4, 0x6e, 0x2c, 0xd0, 0x97,
+	0x01, 0xed, 0x93, 0xca, 0x04, 0x5c, 0x50, 0x0b, 0xb9, 0xc8, 0x26, 0xb7, 0x2f, 0xa8, 0xe4, 0x18,
+	0x23, 0x68, 0x8f, 0xf2, 0xf6, 0x05, 0x95, 0x28, 0x23, 0x05, 0x3d, 0x53, 0x00, 0xca, 0x88, 0x86,
+	0x62, 0xee, 0x0c, 0x53, 0xae, 0x8f, 0xa5, 0x7c, 0x15, 0x20, 0xff, 0xc3, 0x38, 0x25, 0xcd, 0x41,
+	0x25, 0xff, 0xbd, 0x19, 0xb0, 0x8c, 0xe8, 0x08, 0x15, 0x3f, 0x29, 0x30, 0xcf, 0xd1, 0xa4, 0x82,
+	0xe5, 0x61, 0xb6, 0x8d, 0x49, 0x6c, 0x63, 0xe4, 0x7b, 0x3a, 0x24, 0xdf, 0x7f, 0x13, 0xe9, 0x46,
+	0x4a, 0x77, 0xa1, 0x40, 0xf6, 0x05, 0xae, 0x1d, 0x72, 0x74, 0xa6, 0x9c, 0x2d, 0x1a, 0x37, 0xa7,
+	0xc4, 0xe9, 0x9e, 0xe8, 0x71, 0xdc, 0x4d, 0x58, 0x61, 0x30, 0xcb, 0xe3, 0x0b, 0xbf, 0xa6, 0xef,
+	0x42, 0x96, 0x47, 0x06, 0xab, 0x5b, 0x90, 0x6c, 0x20, 0x9a, 0x4b, 0x4e, 0xa3, 0xff, 0x6e, 0xc2,
+	0x62, 0x41, 0x66, 0x5a, 0xba, 0xa7, 0xfd, 0x52, 0x00, 0x58, 0x89, 0xa2, 0x7e, 0xe6, 0xc0, 0x60,
+	0x8d, 0xd3, 0x9c, 0xe4, 0x68, 0x91, 0xe5, 0xe1, 0x22, 0xa7, 0xe9, 0xe0, 0x68, 0x95, 0x8f, 0xa2,
+	0x55, 0xc6, 0xf5, 0x31, 0x2c, 0x33, 0x13, 0xf6, 0x81, 0xf6, 0x11, 0x16, 0xa2, 0x7e, 0xa8, 0x0f,
+	0x21, 0xcd, 0x2e, 0x78, 0x1c, 0x50, 0x59, 0xe8, 0xdf, 0xba, 0x78, 0x00, 0xf4, 0xf0, 0x01, 0xd0,
+	0xb7, 0xe5, 0x03, 0x61, 0xa6, 0xce, 0x7e, 0x16, 0x15, 0x2b, 0x5c, 0xaf, 0xae, 0xc0, 0xac, 0x6b,
+	0x57, 0x91, 0xcb, 0x0b, 0xcb, 0x5a, 0x62, 0xa0, 0xae, 0x42, 0xd6, 0x47, 0xa8, 0x45, 0x7d, 0xbb,
+	0x25, 0x68, 0x33, 0x56, 0x7f, 0x42, 0x3b, 0x86, 0xc5, 0x01, 0xa9, 0xd4, 0x3d, 0x66, 0x40, 0x1d,
+	0x9d, 0xf0, 0xdd, 0x53, 0xe6, 0xd6, 0x55, 0xa7, 0xf8, 0xa0, 0xe1, 0xd0, 0x83, 0xa0, 0xaa, 0xd7,
+	0x70, 0xd3, 0x68, 0x7b, 0x36, 0xa9, 0x61, 0xdb, 0xf5, 0x0d, 0x51, 0xb0, 0xd1, 0x2b, 0xd8, 0xf0,
+	0x49, 0xd3, 0xb0, 0x3d, 0xc7, 0x68, 0x97, 0xf4, 0x0a, 0xcb, 0x60, 0x89, 0x44, 0x0c, 0xeb, 0x00,
+	0xbb, 0x75, 0xc2, 0xb1, 0x16, 0x2d, 0x31, 0xd0, 0x96, 0x60, 0x21, 0xda, 0x3f, 0xda, 0x32, 0x2c,
+	0x0e, 0x48, 0xad, 0x01, 0x64, 0xc2, 0xe6, 0xd0, 0xbe, 0xcf, 0x40, 0xb6, 0xa7, 0xe1, 0x1f, 0x40,
+	0x7c, 0x0f, 0x40, 0x10, 0x21, 0x0e, 0x6e, 0xbd, 0x73, 0xea, 0x9c, 0x33, 0x65, 0x3e, 0xef, 0x76,
+	0x8a, 0xd9, 0xd7, 0x62, 0xb6, 0xb2, 0x7d, 0xd5, 0x29, 0x6e, 0x4d, 0xbd, 0x47, 0x2f, 0xda, 0xca,
+	0xca, 0xf4, 0x95, 0x7a, 0xdf, 0xa5, 0x64, 0xd4, 0xa5, 0xc7, 0x90, 0x91, 0x1d, 0x5a, 0xcf, 0xa5,
+	0xb8, 0xef, 0xf9, 0x6b, 0xbe, 0xbf, 0x09, 0x1f, 0x7e, 0x33, 0x75, 0xca, 0x8c, 0xef, 0x45, 0xf4,
+	0x25, 0x9e, 0x8d, 0x48, 0xac, 0xe6, 0x20, 0x7d, 0x6c, 0x3b, 0x94, 0xdd, 0x5a, 0x73, 0x7c, 0x3e,
+	0x1c, 0x6e, 0x7e, 0x9d, 0x81, 0x14, 0x3b, 0x5c, 0xea, 0x3e, 0xa4, 0xa5, 0xfd, 0xea, 0x7a, 0xac,
+	0x03, 0x25, 0x6f, 0xdd, 0xfc, 0x46, 0xcc, 0xd5, 0xf2, 0x02, 0xdd, 0x87, 0xb4, 0x74, 0x77, 0xd2,
+	0x3e, 0x83, 0x4f, 0x6c, 0x7e, 0x23, 0xe6, 0x6a, 0xb9, 0xcf, 0x5b, 0x48, 0x96, 0x11, 0x55, 0xd7,
+	0x26, 0x1e, 0xc7, 0x30, 0xff, 0xff, 0x31, 0x56, 0x8a, 0xdc, 0x66, 0xee, 0x5b, 0xb7, 0xa0, 0x9c,
+	0x77, 0x0b, 0xca, 0x45, 0xb7, 0xa0, 0x9c, 0x5e, 0x16, 0x12, 0xe7, 0x97, 0x85, 0xc4, 0x8f, 0xcb,
+	0x42, 0xa2, 0x3a, 0xc7, 0x83, 0xef, 0xff, 0x1e, 0x00, 0x08, 0x54, 0x6e, 0x94, 0x0f, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1452,6 +1526,23 @@ func (m *AcquireInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Reentrant {
+		i--
+		if m.Reentrant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Timeout != nil {
 		n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
 		if err19 != nil {
@@ -1485,6 +1576,11 @@ func (m *AcquireOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Holds != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Holds))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Index != 0 {
 		i = encodeVarintLock(dAtA, i, uint64(m.Index))
 		i--
@@ -1582,6 +1678,38 @@ func (m *GetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Waiters != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Waiters))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Holds != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Holds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Acquired != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Acquired, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Acquired):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintLock(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SessionID != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Index != 0 {
 		i = encodeVarintLock(dAtA, i, uint64(m.Index))
 		i--
@@ -1809,6 +1937,13 @@ func (m *AcquireInput) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
 		n += 1 + l + sovLock(uint64(l))
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if m.Reentrant {
+		n += 2
+	}
 	return n
 }
 
@@ -1821,6 +1956,9 @@ func (m *AcquireOutput) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovLock(uint64(m.Index))
 	}
+	if m.Holds != 0 {
+		n += 1 + sovLock(uint64(m.Holds))
+	}
 	return n
 }
 
@@ -1860,6 +1998,23 @@ func (m *GetOutput) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovLock(uint64(m.Index))
 	}
+	if m.SessionID != 0 {
+		n += 1 + sovLock(uint64(m.SessionID))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if m.Acquired != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Acquired)
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if m.Holds != 0 {
+		n += 1 + sovLock(uint64(m.Holds))
+	}
+	if m.Waiters != 0 {
+		n += 1 + sovLock(uint64(m.Waiters))
+	}
 	return n
 }
 
@@ -2976,6 +3131,58 @@ func (m *AcquireInput) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reentrant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reentrant = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -3045,6 +3252,25 @@ func (m *AcquireOutput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			m.Holds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -3264,6 +3490,131 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= github_com_atomix_atomix_protocols_rsm_api_v1.SessionID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acquired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acquired == nil {
+				m.Acquired = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Acquired, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			m.Holds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiters", wireType)
+			}
+			m.Waiters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Waiters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
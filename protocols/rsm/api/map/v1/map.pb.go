@@ -6,12 +6,12 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
-	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -33,6 +33,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type EventsInput_EventType int32
+
+const (
+	EventsInput_NONE     EventsInput_EventType = 0
+	EventsInput_INSERTED EventsInput_EventType = 1
+	EventsInput_UPDATED  EventsInput_EventType = 2
+	EventsInput_REMOVED  EventsInput_EventType = 3
+)
+
+var EventsInput_EventType_name = map[int32]string{
+	0: "NONE",
+	1: "INSERTED",
+	2: "UPDATED",
+	3: "REMOVED",
+}
+
+var EventsInput_EventType_value = map[string]int32{
+	"NONE":     0,
+	"INSERTED": 1,
+	"UPDATED":  2,
+	"REMOVED":  3,
+}
+
+func (x EventsInput_EventType) String() string {
+	return proto.EnumName(EventsInput_EventType_name, int32(x))
+}
+
+func (EventsInput_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{90, 0}
+}
+
 type SizeRequest struct {
 	Headers    *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
 	*SizeInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
@@ -1203,23 +1234,23 @@ func (m *ApplyResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	return nil
 }
 
-type EntriesRequest struct {
-	Headers       *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EntriesInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+type GetAllRequest struct {
+	Headers      *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*GetAllInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
-func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
-func (*EntriesRequest) ProtoMessage()    {}
-func (*EntriesRequest) Descriptor() ([]byte, []int) {
+func (m *GetAllRequest) Reset()         { *m = GetAllRequest{} }
+func (m *GetAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAllRequest) ProtoMessage()    {}
+func (*GetAllRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0dba96ee3ff6a058, []int{26}
 }
-func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1229,42 +1260,42 @@ func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EntriesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesRequest.Merge(m, src)
+func (m *GetAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllRequest.Merge(m, src)
 }
-func (m *EntriesRequest) XXX_Size() int {
+func (m *GetAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
+func (m *GetAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetAllRequest proto.InternalMessageInfo
 
-func (m *EntriesRequest) GetHeaders() *v1.QueryRequestHeaders {
+func (m *GetAllRequest) GetHeaders() *v1.QueryRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type EntriesResponse struct {
-	Headers        *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EntriesOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+type GetAllResponse struct {
+	Headers       *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*GetAllOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
-func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
-func (*EntriesResponse) ProtoMessage()    {}
-func (*EntriesResponse) Descriptor() ([]byte, []int) {
+func (m *GetAllResponse) Reset()         { *m = GetAllResponse{} }
+func (m *GetAllResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAllResponse) ProtoMessage()    {}
+func (*GetAllResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0dba96ee3ff6a058, []int{27}
 }
-func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1274,42 +1305,42 @@ func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EntriesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesResponse.Merge(m, src)
+func (m *GetAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllResponse.Merge(m, src)
 }
-func (m *EntriesResponse) XXX_Size() int {
+func (m *GetAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
+func (m *GetAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAllResponse proto.InternalMessageInfo
 
-func (m *EntriesResponse) GetHeaders() *v1.QueryResponseHeaders {
+func (m *GetAllResponse) GetHeaders() *v1.QueryResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type EventsRequest struct {
+type PutAllRequest struct {
 	Headers      *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EventsInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
+	*PutAllInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
-func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
-func (*EventsRequest) ProtoMessage()    {}
-func (*EventsRequest) Descriptor() ([]byte, []int) {
+func (m *PutAllRequest) Reset()         { *m = PutAllRequest{} }
+func (m *PutAllRequest) String() string { return proto.CompactTextString(m) }
+func (*PutAllRequest) ProtoMessage()    {}
+func (*PutAllRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0dba96ee3ff6a058, []int{28}
 }
-func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+func (m *PutAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1319,42 +1350,42 @@ func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsRequest.Merge(m, src)
+func (m *PutAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllRequest.Merge(m, src)
 }
-func (m *EventsRequest) XXX_Size() int {
+func (m *PutAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+func (m *PutAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_PutAllRequest proto.InternalMessageInfo
 
-func (m *EventsRequest) GetHeaders() *v1.ProposalRequestHeaders {
+func (m *PutAllRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type EventsResponse struct {
+type PutAllResponse struct {
 	Headers       *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
-	*EventsOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+	*PutAllOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
-func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()    {}
-func (*EventsResponse) Descriptor() ([]byte, []int) {
+func (m *PutAllResponse) Reset()         { *m = PutAllResponse{} }
+func (m *PutAllResponse) String() string { return proto.CompactTextString(m) }
+func (*PutAllResponse) ProtoMessage()    {}
+func (*PutAllResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0dba96ee3ff6a058, []int{29}
 }
-func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+func (m *PutAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1364,42 +1395,42 @@ func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsResponse.Merge(m, src)
+func (m *PutAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllResponse.Merge(m, src)
 }
-func (m *EventsResponse) XXX_Size() int {
+func (m *PutAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
+func (m *PutAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_PutAllResponse proto.InternalMessageInfo
 
-func (m *EventsResponse) GetHeaders() *v1.ProposalResponseHeaders {
+func (m *PutAllResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	if m != nil {
 		return m.Headers
 	}
 	return nil
 }
 
-type MapListener struct {
-	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
-	Key   string                                              `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+type RemoveAllRequest struct {
+	Headers         *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*RemoveAllInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *MapListener) Reset()         { *m = MapListener{} }
-func (m *MapListener) String() string { return proto.CompactTextString(m) }
-func (*MapListener) ProtoMessage()    {}
-func (*MapListener) Descriptor() ([]byte, []int) {
+func (m *RemoveAllRequest) Reset()         { *m = RemoveAllRequest{} }
+func (m *RemoveAllRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveAllRequest) ProtoMessage()    {}
+func (*RemoveAllRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0dba96ee3ff6a058, []int{30}
 }
-func (m *MapListener) XXX_Unmarshal(b []byte) error {
+func (m *RemoveAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MapListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MapListener.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveAllRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1409,49 +1440,42 @@ func (m *MapListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MapListener) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapListener.Merge(m, src)
+func (m *RemoveAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAllRequest.Merge(m, src)
 }
-func (m *MapListener) XXX_Size() int {
+func (m *RemoveAllRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MapListener) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapListener.DiscardUnknown(m)
+func (m *RemoveAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAllRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapListener proto.InternalMessageInfo
-
-func (m *MapListener) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.Index
-	}
-	return 0
-}
+var xxx_messageInfo_RemoveAllRequest proto.InternalMessageInfo
 
-func (m *MapListener) GetKey() string {
+func (m *RemoveAllRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
-		return m.Key
+		return m.Headers
 	}
-	return ""
+	return nil
 }
 
-type MapEntry struct {
-	Key   string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value *MapValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type RemoveAllResponse struct {
+	Headers          *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*RemoveAllOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *MapEntry) Reset()         { *m = MapEntry{} }
-func (m *MapEntry) String() string { return proto.CompactTextString(m) }
-func (*MapEntry) ProtoMessage()    {}
-func (*MapEntry) Descriptor() ([]byte, []int) {
+func (m *RemoveAllResponse) Reset()         { *m = RemoveAllResponse{} }
+func (m *RemoveAllResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveAllResponse) ProtoMessage()    {}
+func (*RemoveAllResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0dba96ee3ff6a058, []int{31}
 }
-func (m *MapEntry) XXX_Unmarshal(b []byte) error {
+func (m *RemoveAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MapEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveAllResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1461,50 +1485,42 @@ func (m *MapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MapEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapEntry.Merge(m, src)
+func (m *RemoveAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAllResponse.Merge(m, src)
 }
-func (m *MapEntry) XXX_Size() int {
+func (m *RemoveAllResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MapEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapEntry.DiscardUnknown(m)
+func (m *RemoveAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAllResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapEntry proto.InternalMessageInfo
-
-func (m *MapEntry) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
-}
+var xxx_messageInfo_RemoveAllResponse proto.InternalMessageInfo
 
-func (m *MapEntry) GetValue() *MapValue {
+func (m *RemoveAllResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	if m != nil {
-		return m.Value
+		return m.Headers
 	}
 	return nil
 }
 
-type MapValue struct {
-	Value  []byte                                              `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Index  github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
-	Expire *time.Time                                          `protobuf:"bytes,3,opt,name=expire,proto3,stdtime" json:"expire,omitempty"`
+type ConfigureRequest struct {
+	Headers         *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ConfigureInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *MapValue) Reset()         { *m = MapValue{} }
-func (m *MapValue) String() string { return proto.CompactTextString(m) }
-func (*MapValue) ProtoMessage()    {}
-func (*MapValue) Descriptor() ([]byte, []int) {
+func (m *ConfigureRequest) Reset()         { *m = ConfigureRequest{} }
+func (m *ConfigureRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfigureRequest) ProtoMessage()    {}
+func (*ConfigureRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0dba96ee3ff6a058, []int{32}
 }
-func (m *MapValue) XXX_Unmarshal(b []byte) error {
+func (m *ConfigureRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MapValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConfigureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MapValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConfigureRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1514,71 +1530,87 @@ func (m *MapValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MapValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapValue.Merge(m, src)
+func (m *ConfigureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureRequest.Merge(m, src)
 }
-func (m *MapValue) XXX_Size() int {
+func (m *ConfigureRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MapValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapValue.DiscardUnknown(m)
+func (m *ConfigureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapValue proto.InternalMessageInfo
+var xxx_messageInfo_ConfigureRequest proto.InternalMessageInfo
 
-func (m *MapValue) GetValue() []byte {
+func (m *ConfigureRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
-		return m.Value
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *MapValue) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.Index
+type ConfigureResponse struct {
+	Headers          *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ConfigureOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
+}
+
+func (m *ConfigureResponse) Reset()         { *m = ConfigureResponse{} }
+func (m *ConfigureResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfigureResponse) ProtoMessage()    {}
+func (*ConfigureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{33}
+}
+func (m *ConfigureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *ConfigureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureResponse.Merge(m, src)
+}
+func (m *ConfigureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureResponse.DiscardUnknown(m)
 }
 
-func (m *MapValue) GetExpire() *time.Time {
+var xxx_messageInfo_ConfigureResponse proto.InternalMessageInfo
+
+func (m *ConfigureResponse) GetHeaders() *v1.ProposalResponseHeaders {
 	if m != nil {
-		return m.Expire
+		return m.Headers
 	}
 	return nil
 }
 
-type MapInput struct {
-	// Types that are valid to be assigned to Input:
-	//	*MapInput_Size_
-	//	*MapInput_Put
-	//	*MapInput_Insert
-	//	*MapInput_Update
-	//	*MapInput_Get
-	//	*MapInput_Remove
-	//	*MapInput_Clear
-	//	*MapInput_Lock
-	//	*MapInput_Unlock
-	//	*MapInput_Entries
-	//	*MapInput_Events
-	//	*MapInput_Prepare
-	//	*MapInput_Commit
-	//	*MapInput_Abort
-	//	*MapInput_Apply
-	Input isMapInput_Input `protobuf_oneof:"input"`
+type QueryRequest struct {
+	Headers     *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*QueryInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *MapInput) Reset()         { *m = MapInput{} }
-func (m *MapInput) String() string { return proto.CompactTextString(m) }
-func (*MapInput) ProtoMessage()    {}
-func (*MapInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{33}
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{34}
 }
-func (m *MapInput) XXX_Unmarshal(b []byte) error {
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MapInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MapInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1588,251 +1620,222 @@ func (m *MapInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MapInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapInput.Merge(m, src)
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
 }
-func (m *MapInput) XXX_Size() int {
+func (m *QueryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MapInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapInput.DiscardUnknown(m)
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapInput proto.InternalMessageInfo
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
 
-type isMapInput_Input interface {
-	isMapInput_Input()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *QueryRequest) GetHeaders() *v1.QueryRequestHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
 
-type MapInput_Size_ struct {
-	Size_ *SizeInput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
+type QueryResponse struct {
+	Headers      *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*QueryOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
-type MapInput_Put struct {
-	Put *PutInput `protobuf:"bytes,2,opt,name=put,proto3,oneof" json:"put,omitempty"`
+
+func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
+func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResponse) ProtoMessage()    {}
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{35}
 }
-type MapInput_Insert struct {
-	Insert *InsertInput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
+func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type MapInput_Update struct {
-	Update *UpdateInput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
+func (m *QueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type MapInput_Get struct {
-	Get *GetInput `protobuf:"bytes,5,opt,name=get,proto3,oneof" json:"get,omitempty"`
+func (m *QueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResponse.Merge(m, src)
 }
-type MapInput_Remove struct {
-	Remove *RemoveInput `protobuf:"bytes,6,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+func (m *QueryResponse) XXX_Size() int {
+	return m.Size()
 }
-type MapInput_Clear struct {
-	Clear *ClearInput `protobuf:"bytes,7,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
+func (m *QueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResponse.DiscardUnknown(m)
 }
-type MapInput_Lock struct {
-	Lock *LockInput `protobuf:"bytes,8,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
+
+var xxx_messageInfo_QueryResponse proto.InternalMessageInfo
+
+func (m *QueryResponse) GetHeaders() *v1.QueryResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
-type MapInput_Unlock struct {
-	Unlock *UnlockInput `protobuf:"bytes,9,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
+
+type ValidateFenceRequest struct {
+	Headers             *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ValidateFenceInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
-type MapInput_Entries struct {
-	Entries *EntriesInput `protobuf:"bytes,10,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+
+func (m *ValidateFenceRequest) Reset()         { *m = ValidateFenceRequest{} }
+func (m *ValidateFenceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateFenceRequest) ProtoMessage()    {}
+func (*ValidateFenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{36}
 }
-type MapInput_Events struct {
-	Events *EventsInput `protobuf:"bytes,11,opt,name=events,proto3,oneof" json:"events,omitempty"`
+func (m *ValidateFenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type MapInput_Prepare struct {
-	Prepare *PrepareInput `protobuf:"bytes,12,opt,name=prepare,proto3,oneof" json:"prepare,omitempty"`
+func (m *ValidateFenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateFenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type MapInput_Commit struct {
-	Commit *CommitInput `protobuf:"bytes,13,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+func (m *ValidateFenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateFenceRequest.Merge(m, src)
 }
-type MapInput_Abort struct {
-	Abort *AbortInput `protobuf:"bytes,14,opt,name=abort,proto3,oneof" json:"abort,omitempty"`
+func (m *ValidateFenceRequest) XXX_Size() int {
+	return m.Size()
 }
-type MapInput_Apply struct {
-	Apply *ApplyInput `protobuf:"bytes,15,opt,name=apply,proto3,oneof" json:"apply,omitempty"`
+func (m *ValidateFenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateFenceRequest.DiscardUnknown(m)
 }
 
-func (*MapInput_Size_) isMapInput_Input()   {}
-func (*MapInput_Put) isMapInput_Input()     {}
-func (*MapInput_Insert) isMapInput_Input()  {}
-func (*MapInput_Update) isMapInput_Input()  {}
-func (*MapInput_Get) isMapInput_Input()     {}
-func (*MapInput_Remove) isMapInput_Input()  {}
-func (*MapInput_Clear) isMapInput_Input()   {}
-func (*MapInput_Lock) isMapInput_Input()    {}
-func (*MapInput_Unlock) isMapInput_Input()  {}
-func (*MapInput_Entries) isMapInput_Input() {}
-func (*MapInput_Events) isMapInput_Input()  {}
-func (*MapInput_Prepare) isMapInput_Input() {}
-func (*MapInput_Commit) isMapInput_Input()  {}
-func (*MapInput_Abort) isMapInput_Input()   {}
-func (*MapInput_Apply) isMapInput_Input()   {}
+var xxx_messageInfo_ValidateFenceRequest proto.InternalMessageInfo
 
-func (m *MapInput) GetInput() isMapInput_Input {
+func (m *ValidateFenceRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
-		return m.Input
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *MapInput) GetSize_() *SizeInput {
-	if x, ok := m.GetInput().(*MapInput_Size_); ok {
-		return x.Size_
-	}
-	return nil
+type ValidateFenceResponse struct {
+	Headers              *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*ValidateFenceOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *MapInput) GetPut() *PutInput {
-	if x, ok := m.GetInput().(*MapInput_Put); ok {
-		return x.Put
-	}
-	return nil
+func (m *ValidateFenceResponse) Reset()         { *m = ValidateFenceResponse{} }
+func (m *ValidateFenceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateFenceResponse) ProtoMessage()    {}
+func (*ValidateFenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{37}
 }
-
-func (m *MapInput) GetInsert() *InsertInput {
-	if x, ok := m.GetInput().(*MapInput_Insert); ok {
-		return x.Insert
-	}
-	return nil
+func (m *ValidateFenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MapInput) GetUpdate() *UpdateInput {
-	if x, ok := m.GetInput().(*MapInput_Update); ok {
-		return x.Update
+func (m *ValidateFenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateFenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *MapInput) GetGet() *GetInput {
-	if x, ok := m.GetInput().(*MapInput_Get); ok {
-		return x.Get
-	}
-	return nil
+func (m *ValidateFenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateFenceResponse.Merge(m, src)
 }
-
-func (m *MapInput) GetRemove() *RemoveInput {
-	if x, ok := m.GetInput().(*MapInput_Remove); ok {
-		return x.Remove
-	}
-	return nil
+func (m *ValidateFenceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MapInput) GetClear() *ClearInput {
-	if x, ok := m.GetInput().(*MapInput_Clear); ok {
-		return x.Clear
-	}
-	return nil
+func (m *ValidateFenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateFenceResponse.DiscardUnknown(m)
 }
 
-func (m *MapInput) GetLock() *LockInput {
-	if x, ok := m.GetInput().(*MapInput_Lock); ok {
-		return x.Lock
-	}
-	return nil
-}
+var xxx_messageInfo_ValidateFenceResponse proto.InternalMessageInfo
 
-func (m *MapInput) GetUnlock() *UnlockInput {
-	if x, ok := m.GetInput().(*MapInput_Unlock); ok {
-		return x.Unlock
+func (m *ValidateFenceResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *MapInput) GetEntries() *EntriesInput {
-	if x, ok := m.GetInput().(*MapInput_Entries); ok {
-		return x.Entries
-	}
-	return nil
+type EntriesRequest struct {
+	Headers       *v1.QueryRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EntriesInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
 
-func (m *MapInput) GetEvents() *EventsInput {
-	if x, ok := m.GetInput().(*MapInput_Events); ok {
-		return x.Events
-	}
-	return nil
+func (m *EntriesRequest) Reset()         { *m = EntriesRequest{} }
+func (m *EntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*EntriesRequest) ProtoMessage()    {}
+func (*EntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{38}
 }
-
-func (m *MapInput) GetPrepare() *PrepareInput {
-	if x, ok := m.GetInput().(*MapInput_Prepare); ok {
-		return x.Prepare
-	}
-	return nil
+func (m *EntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MapInput) GetCommit() *CommitInput {
-	if x, ok := m.GetInput().(*MapInput_Commit); ok {
-		return x.Commit
+func (m *EntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *MapInput) GetAbort() *AbortInput {
-	if x, ok := m.GetInput().(*MapInput_Abort); ok {
-		return x.Abort
-	}
-	return nil
+func (m *EntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesRequest.Merge(m, src)
 }
-
-func (m *MapInput) GetApply() *ApplyInput {
-	if x, ok := m.GetInput().(*MapInput_Apply); ok {
-		return x.Apply
-	}
-	return nil
+func (m *EntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesRequest.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*MapInput) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*MapInput_Size_)(nil),
-		(*MapInput_Put)(nil),
-		(*MapInput_Insert)(nil),
-		(*MapInput_Update)(nil),
-		(*MapInput_Get)(nil),
-		(*MapInput_Remove)(nil),
-		(*MapInput_Clear)(nil),
-		(*MapInput_Lock)(nil),
-		(*MapInput_Unlock)(nil),
-		(*MapInput_Entries)(nil),
-		(*MapInput_Events)(nil),
-		(*MapInput_Prepare)(nil),
-		(*MapInput_Commit)(nil),
-		(*MapInput_Abort)(nil),
-		(*MapInput_Apply)(nil),
+var xxx_messageInfo_EntriesRequest proto.InternalMessageInfo
+
+func (m *EntriesRequest) GetHeaders() *v1.QueryRequestHeaders {
+	if m != nil {
+		return m.Headers
 	}
+	return nil
 }
 
-type MapOutput struct {
-	// Types that are valid to be assigned to Output:
-	//	*MapOutput_Size_
-	//	*MapOutput_Put
-	//	*MapOutput_Insert
-	//	*MapOutput_Update
-	//	*MapOutput_Get
-	//	*MapOutput_Remove
-	//	*MapOutput_Clear
-	//	*MapOutput_Lock
-	//	*MapOutput_Unlock
-	//	*MapOutput_Entries
-	//	*MapOutput_Events
-	//	*MapOutput_Prepare
-	//	*MapOutput_Commit
-	//	*MapOutput_Abort
-	//	*MapOutput_Apply
-	Output isMapOutput_Output `protobuf_oneof:"output"`
+type EntriesResponse struct {
+	Headers        *v1.QueryResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EntriesOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *MapOutput) Reset()         { *m = MapOutput{} }
-func (m *MapOutput) String() string { return proto.CompactTextString(m) }
-func (*MapOutput) ProtoMessage()    {}
-func (*MapOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{34}
+func (m *EntriesResponse) Reset()         { *m = EntriesResponse{} }
+func (m *EntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*EntriesResponse) ProtoMessage()    {}
+func (*EntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{39}
 }
-func (m *MapOutput) XXX_Unmarshal(b []byte) error {
+func (m *EntriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MapOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MapOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EntriesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1842,234 +1845,216 @@ func (m *MapOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MapOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MapOutput.Merge(m, src)
+func (m *EntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesResponse.Merge(m, src)
 }
-func (m *MapOutput) XXX_Size() int {
+func (m *EntriesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MapOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_MapOutput.DiscardUnknown(m)
+func (m *EntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MapOutput proto.InternalMessageInfo
+var xxx_messageInfo_EntriesResponse proto.InternalMessageInfo
 
-type isMapOutput_Output interface {
-	isMapOutput_Output()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *EntriesResponse) GetHeaders() *v1.QueryResponseHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
 
-type MapOutput_Size_ struct {
-	Size_ *SizeOutput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
-}
-type MapOutput_Put struct {
-	Put *PutOutput `protobuf:"bytes,2,opt,name=put,proto3,oneof" json:"put,omitempty"`
+type EventsRequest struct {
+	Headers      *v1.ProposalRequestHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EventsInput `protobuf:"bytes,2,opt,name=input,proto3,embedded=input" json:"input,omitempty"`
 }
-type MapOutput_Insert struct {
-	Insert *InsertOutput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{40}
 }
-type MapOutput_Update struct {
-	Update *UpdateOutput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type MapOutput_Get struct {
-	Get *GetOutput `protobuf:"bytes,5,opt,name=get,proto3,oneof" json:"get,omitempty"`
-}
-type MapOutput_Remove struct {
-	Remove *RemoveOutput `protobuf:"bytes,6,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
-}
-type MapOutput_Clear struct {
-	Clear *ClearOutput `protobuf:"bytes,7,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
-}
-type MapOutput_Lock struct {
-	Lock *LockOutput `protobuf:"bytes,8,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
-}
-type MapOutput_Unlock struct {
-	Unlock *UnlockOutput `protobuf:"bytes,9,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
-}
-type MapOutput_Entries struct {
-	Entries *EntriesOutput `protobuf:"bytes,10,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
-}
-type MapOutput_Events struct {
-	Events *EventsOutput `protobuf:"bytes,11,opt,name=events,proto3,oneof" json:"events,omitempty"`
-}
-type MapOutput_Prepare struct {
-	Prepare *PrepareOutput `protobuf:"bytes,12,opt,name=prepare,proto3,oneof" json:"prepare,omitempty"`
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type MapOutput_Commit struct {
-	Commit *CommitOutput `protobuf:"bytes,13,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
 }
-type MapOutput_Abort struct {
-	Abort *AbortOutput `protobuf:"bytes,14,opt,name=abort,proto3,oneof" json:"abort,omitempty"`
+func (m *EventsRequest) XXX_Size() int {
+	return m.Size()
 }
-type MapOutput_Apply struct {
-	Apply *ApplyOutput `protobuf:"bytes,15,opt,name=apply,proto3,oneof" json:"apply,omitempty"`
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
 }
 
-func (*MapOutput_Size_) isMapOutput_Output()   {}
-func (*MapOutput_Put) isMapOutput_Output()     {}
-func (*MapOutput_Insert) isMapOutput_Output()  {}
-func (*MapOutput_Update) isMapOutput_Output()  {}
-func (*MapOutput_Get) isMapOutput_Output()     {}
-func (*MapOutput_Remove) isMapOutput_Output()  {}
-func (*MapOutput_Clear) isMapOutput_Output()   {}
-func (*MapOutput_Lock) isMapOutput_Output()    {}
-func (*MapOutput_Unlock) isMapOutput_Output()  {}
-func (*MapOutput_Entries) isMapOutput_Output() {}
-func (*MapOutput_Events) isMapOutput_Output()  {}
-func (*MapOutput_Prepare) isMapOutput_Output() {}
-func (*MapOutput_Commit) isMapOutput_Output()  {}
-func (*MapOutput_Abort) isMapOutput_Output()   {}
-func (*MapOutput_Apply) isMapOutput_Output()   {}
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
 
-func (m *MapOutput) GetOutput() isMapOutput_Output {
+func (m *EventsRequest) GetHeaders() *v1.ProposalRequestHeaders {
 	if m != nil {
-		return m.Output
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *MapOutput) GetSize_() *SizeOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Size_); ok {
-		return x.Size_
-	}
-	return nil
+type EventsResponse struct {
+	Headers       *v1.ProposalResponseHeaders `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	*EventsOutput `protobuf:"bytes,2,opt,name=output,proto3,embedded=output" json:"output,omitempty"`
 }
 
-func (m *MapOutput) GetPut() *PutOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Put); ok {
-		return x.Put
-	}
-	return nil
+func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
+func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()    {}
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{41}
 }
-
-func (m *MapOutput) GetInsert() *InsertOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Insert); ok {
-		return x.Insert
-	}
-	return nil
+func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MapOutput) GetUpdate() *UpdateOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Update); ok {
-		return x.Update
+func (m *EventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *MapOutput) GetGet() *GetOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Get); ok {
-		return x.Get
-	}
-	return nil
+func (m *EventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsResponse.Merge(m, src)
 }
-
-func (m *MapOutput) GetRemove() *RemoveOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Remove); ok {
-		return x.Remove
-	}
-	return nil
+func (m *EventsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MapOutput) GetClear() *ClearOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Clear); ok {
-		return x.Clear
-	}
-	return nil
+func (m *EventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsResponse.DiscardUnknown(m)
 }
 
-func (m *MapOutput) GetLock() *LockOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Lock); ok {
-		return x.Lock
+var xxx_messageInfo_EventsResponse proto.InternalMessageInfo
+
+func (m *EventsResponse) GetHeaders() *v1.ProposalResponseHeaders {
+	if m != nil {
+		return m.Headers
 	}
 	return nil
 }
 
-func (m *MapOutput) GetUnlock() *UnlockOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Unlock); ok {
-		return x.Unlock
-	}
-	return nil
+type MapListener struct {
+	Index   github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	Key     string                                              `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Prefix  string                                              `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Pattern string                                              `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Keys    []string                                            `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	Types   []EventsInput_EventType                             `protobuf:"varint,6,rep,packed,name=types,proto3,enum=atomix.protocols.rsm.map.v1.EventsInput_EventType" json:"types,omitempty"`
 }
 
-func (m *MapOutput) GetEntries() *EntriesOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Entries); ok {
-		return x.Entries
+func (m *MapListener) Reset()         { *m = MapListener{} }
+func (m *MapListener) String() string { return proto.CompactTextString(m) }
+func (*MapListener) ProtoMessage()    {}
+func (*MapListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{42}
+}
+func (m *MapListener) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MapListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MapListener.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MapListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapListener.Merge(m, src)
+}
+func (m *MapListener) XXX_Size() int {
+	return m.Size()
+}
+func (m *MapListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapListener.DiscardUnknown(m)
 }
 
-func (m *MapOutput) GetEvents() *EventsOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Events); ok {
-		return x.Events
+var xxx_messageInfo_MapListener proto.InternalMessageInfo
+
+func (m *MapListener) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
+	if m != nil {
+		return m.Index
 	}
-	return nil
+	return 0
 }
 
-func (m *MapOutput) GetPrepare() *PrepareOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Prepare); ok {
-		return x.Prepare
+func (m *MapListener) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *MapOutput) GetCommit() *CommitOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Commit); ok {
-		return x.Commit
+func (m *MapListener) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return nil
+	return ""
 }
 
-func (m *MapOutput) GetAbort() *AbortOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Abort); ok {
-		return x.Abort
+func (m *MapListener) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	return nil
+	return ""
 }
 
-func (m *MapOutput) GetApply() *ApplyOutput {
-	if x, ok := m.GetOutput().(*MapOutput_Apply); ok {
-		return x.Apply
+func (m *MapListener) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*MapOutput) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*MapOutput_Size_)(nil),
-		(*MapOutput_Put)(nil),
-		(*MapOutput_Insert)(nil),
-		(*MapOutput_Update)(nil),
-		(*MapOutput_Get)(nil),
-		(*MapOutput_Remove)(nil),
-		(*MapOutput_Clear)(nil),
-		(*MapOutput_Lock)(nil),
-		(*MapOutput_Unlock)(nil),
-		(*MapOutput_Entries)(nil),
-		(*MapOutput_Events)(nil),
-		(*MapOutput_Prepare)(nil),
-		(*MapOutput_Commit)(nil),
-		(*MapOutput_Abort)(nil),
-		(*MapOutput_Apply)(nil),
+func (m *MapListener) GetTypes() []EventsInput_EventType {
+	if m != nil {
+		return m.Types
 	}
+	return nil
 }
 
-type SizeInput struct {
+type MapEntry struct {
+	Key   string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *MapValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *SizeInput) Reset()         { *m = SizeInput{} }
-func (m *SizeInput) String() string { return proto.CompactTextString(m) }
-func (*SizeInput) ProtoMessage()    {}
-func (*SizeInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{35}
+func (m *MapEntry) Reset()         { *m = MapEntry{} }
+func (m *MapEntry) String() string { return proto.CompactTextString(m) }
+func (*MapEntry) ProtoMessage()    {}
+func (*MapEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{43}
 }
-func (m *SizeInput) XXX_Unmarshal(b []byte) error {
+func (m *MapEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SizeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MapEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SizeInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MapEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2079,81 +2064,52 @@ func (m *SizeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SizeInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SizeInput.Merge(m, src)
+func (m *MapEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapEntry.Merge(m, src)
 }
-func (m *SizeInput) XXX_Size() int {
+func (m *MapEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *SizeInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_SizeInput.DiscardUnknown(m)
+func (m *MapEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SizeInput proto.InternalMessageInfo
+var xxx_messageInfo_MapEntry proto.InternalMessageInfo
 
-type SizeOutput struct {
-	Size_ uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+func (m *MapEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *SizeOutput) Reset()         { *m = SizeOutput{} }
-func (m *SizeOutput) String() string { return proto.CompactTextString(m) }
-func (*SizeOutput) ProtoMessage()    {}
-func (*SizeOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{36}
-}
-func (m *SizeOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SizeOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SizeOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *SizeOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SizeOutput.Merge(m, src)
-}
-func (m *SizeOutput) XXX_Size() int {
-	return m.Size()
-}
-func (m *SizeOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_SizeOutput.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SizeOutput proto.InternalMessageInfo
-
-func (m *SizeOutput) GetSize_() uint32 {
+func (m *MapEntry) GetValue() *MapValue {
 	if m != nil {
-		return m.Size_
+		return m.Value
 	}
-	return 0
+	return nil
 }
 
-type PutInput struct {
-	Key       string                                              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value     []byte                                              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	TTL       *time.Duration                                      `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
-	PrevIndex github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,4,opt,name=prev_index,json=prevIndex,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"prev_index,omitempty"`
+type MapValue struct {
+	Value  []byte                                              `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Index  github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	Expire *time.Time                                          `protobuf:"bytes,3,opt,name=expire,proto3,stdtime" json:"expire,omitempty"`
+	// session_id is the session that owns an ephemeral value; zero if the value is not ephemeral
+	SessionID github_com_atomix_atomix_protocols_rsm_api_v1.SessionID `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SessionID" json:"session_id,omitempty"`
 }
 
-func (m *PutInput) Reset()         { *m = PutInput{} }
-func (m *PutInput) String() string { return proto.CompactTextString(m) }
-func (*PutInput) ProtoMessage()    {}
-func (*PutInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{37}
+func (m *MapValue) Reset()         { *m = MapValue{} }
+func (m *MapValue) String() string { return proto.CompactTextString(m) }
+func (*MapValue) ProtoMessage()    {}
+func (*MapValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{44}
 }
-func (m *PutInput) XXX_Unmarshal(b []byte) error {
+func (m *MapValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MapValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MapValue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2163,63 +2119,84 @@ func (m *PutInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PutInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutInput.Merge(m, src)
+func (m *MapValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapValue.Merge(m, src)
 }
-func (m *PutInput) XXX_Size() int {
+func (m *MapValue) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutInput.DiscardUnknown(m)
+func (m *MapValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutInput proto.InternalMessageInfo
+var xxx_messageInfo_MapValue proto.InternalMessageInfo
 
-func (m *PutInput) GetKey() string {
+func (m *MapValue) GetValue() []byte {
 	if m != nil {
-		return m.Key
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-func (m *PutInput) GetValue() []byte {
+func (m *MapValue) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
 	if m != nil {
-		return m.Value
+		return m.Index
 	}
-	return nil
+	return 0
 }
 
-func (m *PutInput) GetTTL() *time.Duration {
+func (m *MapValue) GetExpire() *time.Time {
 	if m != nil {
-		return m.TTL
+		return m.Expire
 	}
 	return nil
 }
 
-func (m *PutInput) GetPrevIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
+func (m *MapValue) GetSessionID() github_com_atomix_atomix_protocols_rsm_api_v1.SessionID {
 	if m != nil {
-		return m.PrevIndex
+		return m.SessionID
 	}
 	return 0
 }
 
-type PutOutput struct {
-	Index     github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
-	PrevValue *IndexedValue                                       `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+type MapInput struct {
+	// Types that are valid to be assigned to Input:
+	//	*MapInput_Size_
+	//	*MapInput_Put
+	//	*MapInput_Insert
+	//	*MapInput_Update
+	//	*MapInput_Get
+	//	*MapInput_Remove
+	//	*MapInput_Clear
+	//	*MapInput_Lock
+	//	*MapInput_Unlock
+	//	*MapInput_Entries
+	//	*MapInput_Events
+	//	*MapInput_Prepare
+	//	*MapInput_Commit
+	//	*MapInput_Abort
+	//	*MapInput_Apply
+	//	*MapInput_GetAll
+	//	*MapInput_PutAll
+	//	*MapInput_RemoveAll
+	//	*MapInput_Configure
+	//	*MapInput_Query
+	//	*MapInput_ValidateFence
+	Input isMapInput_Input `protobuf_oneof:"input"`
 }
 
-func (m *PutOutput) Reset()         { *m = PutOutput{} }
-func (m *PutOutput) String() string { return proto.CompactTextString(m) }
-func (*PutOutput) ProtoMessage()    {}
-func (*PutOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{38}
+func (m *MapInput) Reset()         { *m = MapInput{} }
+func (m *MapInput) String() string { return proto.CompactTextString(m) }
+func (*MapInput) ProtoMessage()    {}
+func (*MapInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{45}
 }
-func (m *PutOutput) XXX_Unmarshal(b []byte) error {
+func (m *MapInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MapInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MapInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2229,272 +2206,329 @@ func (m *PutOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PutOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutOutput.Merge(m, src)
+func (m *MapInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapInput.Merge(m, src)
 }
-func (m *PutOutput) XXX_Size() int {
+func (m *MapInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutOutput.DiscardUnknown(m)
+func (m *MapInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutOutput proto.InternalMessageInfo
+var xxx_messageInfo_MapInput proto.InternalMessageInfo
 
-func (m *PutOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.Index
-	}
-	return 0
+type isMapInput_Input interface {
+	isMapInput_Input()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *PutOutput) GetPrevValue() *IndexedValue {
-	if m != nil {
-		return m.PrevValue
-	}
-	return nil
+type MapInput_Size_ struct {
+	Size_ *SizeInput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
 }
-
-type InsertInput struct {
-	Key   string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	TTL   *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+type MapInput_Put struct {
+	Put *PutInput `protobuf:"bytes,2,opt,name=put,proto3,oneof" json:"put,omitempty"`
 }
-
-func (m *InsertInput) Reset()         { *m = InsertInput{} }
-func (m *InsertInput) String() string { return proto.CompactTextString(m) }
-func (*InsertInput) ProtoMessage()    {}
-func (*InsertInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{39}
+type MapInput_Insert struct {
+	Insert *InsertInput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
 }
-func (m *InsertInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type MapInput_Update struct {
+	Update *UpdateInput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
 }
-func (m *InsertInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InsertInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+type MapInput_Get struct {
+	Get *GetInput `protobuf:"bytes,5,opt,name=get,proto3,oneof" json:"get,omitempty"`
 }
-func (m *InsertInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertInput.Merge(m, src)
+type MapInput_Remove struct {
+	Remove *RemoveInput `protobuf:"bytes,6,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
 }
-func (m *InsertInput) XXX_Size() int {
-	return m.Size()
+type MapInput_Clear struct {
+	Clear *ClearInput `protobuf:"bytes,7,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
 }
-func (m *InsertInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertInput.DiscardUnknown(m)
+type MapInput_Lock struct {
+	Lock *LockInput `protobuf:"bytes,8,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
 }
+type MapInput_Unlock struct {
+	Unlock *UnlockInput `protobuf:"bytes,9,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
+}
+type MapInput_Entries struct {
+	Entries *EntriesInput `protobuf:"bytes,10,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+}
+type MapInput_Events struct {
+	Events *EventsInput `protobuf:"bytes,11,opt,name=events,proto3,oneof" json:"events,omitempty"`
+}
+type MapInput_Prepare struct {
+	Prepare *PrepareInput `protobuf:"bytes,12,opt,name=prepare,proto3,oneof" json:"prepare,omitempty"`
+}
+type MapInput_Commit struct {
+	Commit *CommitInput `protobuf:"bytes,13,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+type MapInput_Abort struct {
+	Abort *AbortInput `protobuf:"bytes,14,opt,name=abort,proto3,oneof" json:"abort,omitempty"`
+}
+type MapInput_Apply struct {
+	Apply *ApplyInput `protobuf:"bytes,15,opt,name=apply,proto3,oneof" json:"apply,omitempty"`
+}
+type MapInput_GetAll struct {
+	GetAll *GetAllInput `protobuf:"bytes,16,opt,name=get_all,json=getAll,proto3,oneof" json:"get_all,omitempty"`
+}
+type MapInput_PutAll struct {
+	PutAll *PutAllInput `protobuf:"bytes,17,opt,name=put_all,json=putAll,proto3,oneof" json:"put_all,omitempty"`
+}
+type MapInput_RemoveAll struct {
+	RemoveAll *RemoveAllInput `protobuf:"bytes,18,opt,name=remove_all,json=removeAll,proto3,oneof" json:"remove_all,omitempty"`
+}
+type MapInput_Configure struct {
+	Configure *ConfigureInput `protobuf:"bytes,19,opt,name=configure,proto3,oneof" json:"configure,omitempty"`
+}
+type MapInput_Query struct {
+	Query *QueryInput `protobuf:"bytes,20,opt,name=query,proto3,oneof" json:"query,omitempty"`
+}
+type MapInput_ValidateFence struct {
+	ValidateFence *ValidateFenceInput `protobuf:"bytes,21,opt,name=validate_fence,json=validateFence,proto3,oneof" json:"validate_fence,omitempty"`
+}
+
+func (*MapInput_Size_) isMapInput_Input()         {}
+func (*MapInput_Put) isMapInput_Input()           {}
+func (*MapInput_Insert) isMapInput_Input()        {}
+func (*MapInput_Update) isMapInput_Input()        {}
+func (*MapInput_Get) isMapInput_Input()           {}
+func (*MapInput_Remove) isMapInput_Input()        {}
+func (*MapInput_Clear) isMapInput_Input()         {}
+func (*MapInput_Lock) isMapInput_Input()          {}
+func (*MapInput_Unlock) isMapInput_Input()        {}
+func (*MapInput_Entries) isMapInput_Input()       {}
+func (*MapInput_Events) isMapInput_Input()        {}
+func (*MapInput_Prepare) isMapInput_Input()       {}
+func (*MapInput_Commit) isMapInput_Input()        {}
+func (*MapInput_Abort) isMapInput_Input()         {}
+func (*MapInput_Apply) isMapInput_Input()         {}
+func (*MapInput_GetAll) isMapInput_Input()        {}
+func (*MapInput_PutAll) isMapInput_Input()        {}
+func (*MapInput_RemoveAll) isMapInput_Input()     {}
+func (*MapInput_Configure) isMapInput_Input()     {}
+func (*MapInput_Query) isMapInput_Input()         {}
+func (*MapInput_ValidateFence) isMapInput_Input() {}
 
-var xxx_messageInfo_InsertInput proto.InternalMessageInfo
-
-func (m *InsertInput) GetKey() string {
+func (m *MapInput) GetInput() isMapInput_Input {
 	if m != nil {
-		return m.Key
+		return m.Input
 	}
-	return ""
+	return nil
 }
 
-func (m *InsertInput) GetValue() []byte {
-	if m != nil {
-		return m.Value
+func (m *MapInput) GetSize_() *SizeInput {
+	if x, ok := m.GetInput().(*MapInput_Size_); ok {
+		return x.Size_
 	}
 	return nil
 }
 
-func (m *InsertInput) GetTTL() *time.Duration {
-	if m != nil {
-		return m.TTL
+func (m *MapInput) GetPut() *PutInput {
+	if x, ok := m.GetInput().(*MapInput_Put); ok {
+		return x.Put
 	}
 	return nil
 }
 
-type InsertOutput struct {
-	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+func (m *MapInput) GetInsert() *InsertInput {
+	if x, ok := m.GetInput().(*MapInput_Insert); ok {
+		return x.Insert
+	}
+	return nil
 }
 
-func (m *InsertOutput) Reset()         { *m = InsertOutput{} }
-func (m *InsertOutput) String() string { return proto.CompactTextString(m) }
-func (*InsertOutput) ProtoMessage()    {}
-func (*InsertOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{40}
+func (m *MapInput) GetUpdate() *UpdateInput {
+	if x, ok := m.GetInput().(*MapInput_Update); ok {
+		return x.Update
+	}
+	return nil
 }
-func (m *InsertOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InsertOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InsertOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *MapInput) GetGet() *GetInput {
+	if x, ok := m.GetInput().(*MapInput_Get); ok {
+		return x.Get
 	}
+	return nil
 }
-func (m *InsertOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertOutput.Merge(m, src)
-}
-func (m *InsertOutput) XXX_Size() int {
-	return m.Size()
-}
-func (m *InsertOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertOutput.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InsertOutput proto.InternalMessageInfo
 
-func (m *InsertOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.Index
+func (m *MapInput) GetRemove() *RemoveInput {
+	if x, ok := m.GetInput().(*MapInput_Remove); ok {
+		return x.Remove
 	}
-	return 0
+	return nil
 }
 
-type UpdateInput struct {
-	Key       string                                              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value     []byte                                              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	TTL       *time.Duration                                      `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
-	PrevIndex github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,4,opt,name=prev_index,json=prevIndex,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"prev_index,omitempty"`
+func (m *MapInput) GetClear() *ClearInput {
+	if x, ok := m.GetInput().(*MapInput_Clear); ok {
+		return x.Clear
+	}
+	return nil
 }
 
-func (m *UpdateInput) Reset()         { *m = UpdateInput{} }
-func (m *UpdateInput) String() string { return proto.CompactTextString(m) }
-func (*UpdateInput) ProtoMessage()    {}
-func (*UpdateInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{41}
-}
-func (m *UpdateInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *UpdateInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UpdateInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MapInput) GetLock() *LockInput {
+	if x, ok := m.GetInput().(*MapInput_Lock); ok {
+		return x.Lock
 	}
+	return nil
 }
-func (m *UpdateInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateInput.Merge(m, src)
-}
-func (m *UpdateInput) XXX_Size() int {
-	return m.Size()
-}
-func (m *UpdateInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateInput.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UpdateInput proto.InternalMessageInfo
 
-func (m *UpdateInput) GetKey() string {
-	if m != nil {
-		return m.Key
+func (m *MapInput) GetUnlock() *UnlockInput {
+	if x, ok := m.GetInput().(*MapInput_Unlock); ok {
+		return x.Unlock
 	}
-	return ""
+	return nil
 }
 
-func (m *UpdateInput) GetValue() []byte {
-	if m != nil {
-		return m.Value
+func (m *MapInput) GetEntries() *EntriesInput {
+	if x, ok := m.GetInput().(*MapInput_Entries); ok {
+		return x.Entries
 	}
 	return nil
 }
 
-func (m *UpdateInput) GetTTL() *time.Duration {
-	if m != nil {
-		return m.TTL
+func (m *MapInput) GetEvents() *EventsInput {
+	if x, ok := m.GetInput().(*MapInput_Events); ok {
+		return x.Events
 	}
 	return nil
 }
 
-func (m *UpdateInput) GetPrevIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.PrevIndex
+func (m *MapInput) GetPrepare() *PrepareInput {
+	if x, ok := m.GetInput().(*MapInput_Prepare); ok {
+		return x.Prepare
 	}
-	return 0
+	return nil
 }
 
-type UpdateOutput struct {
-	Index     github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
-	PrevValue IndexedValue                                        `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
+func (m *MapInput) GetCommit() *CommitInput {
+	if x, ok := m.GetInput().(*MapInput_Commit); ok {
+		return x.Commit
+	}
+	return nil
 }
 
-func (m *UpdateOutput) Reset()         { *m = UpdateOutput{} }
-func (m *UpdateOutput) String() string { return proto.CompactTextString(m) }
-func (*UpdateOutput) ProtoMessage()    {}
-func (*UpdateOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{42}
+func (m *MapInput) GetAbort() *AbortInput {
+	if x, ok := m.GetInput().(*MapInput_Abort); ok {
+		return x.Abort
+	}
+	return nil
 }
-func (m *UpdateOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *MapInput) GetApply() *ApplyInput {
+	if x, ok := m.GetInput().(*MapInput_Apply); ok {
+		return x.Apply
+	}
+	return nil
 }
-func (m *UpdateOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UpdateOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *MapInput) GetGetAll() *GetAllInput {
+	if x, ok := m.GetInput().(*MapInput_GetAll); ok {
+		return x.GetAll
 	}
+	return nil
 }
-func (m *UpdateOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateOutput.Merge(m, src)
+
+func (m *MapInput) GetPutAll() *PutAllInput {
+	if x, ok := m.GetInput().(*MapInput_PutAll); ok {
+		return x.PutAll
+	}
+	return nil
 }
-func (m *UpdateOutput) XXX_Size() int {
-	return m.Size()
+
+func (m *MapInput) GetRemoveAll() *RemoveAllInput {
+	if x, ok := m.GetInput().(*MapInput_RemoveAll); ok {
+		return x.RemoveAll
+	}
+	return nil
 }
-func (m *UpdateOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateOutput.DiscardUnknown(m)
+
+func (m *MapInput) GetConfigure() *ConfigureInput {
+	if x, ok := m.GetInput().(*MapInput_Configure); ok {
+		return x.Configure
+	}
+	return nil
 }
 
-var xxx_messageInfo_UpdateOutput proto.InternalMessageInfo
+func (m *MapInput) GetQuery() *QueryInput {
+	if x, ok := m.GetInput().(*MapInput_Query); ok {
+		return x.Query
+	}
+	return nil
+}
 
-func (m *UpdateOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.Index
+func (m *MapInput) GetValidateFence() *ValidateFenceInput {
+	if x, ok := m.GetInput().(*MapInput_ValidateFence); ok {
+		return x.ValidateFence
 	}
-	return 0
+	return nil
 }
 
-func (m *UpdateOutput) GetPrevValue() IndexedValue {
-	if m != nil {
-		return m.PrevValue
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MapInput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MapInput_Size_)(nil),
+		(*MapInput_Put)(nil),
+		(*MapInput_Insert)(nil),
+		(*MapInput_Update)(nil),
+		(*MapInput_Get)(nil),
+		(*MapInput_Remove)(nil),
+		(*MapInput_Clear)(nil),
+		(*MapInput_Lock)(nil),
+		(*MapInput_Unlock)(nil),
+		(*MapInput_Entries)(nil),
+		(*MapInput_Events)(nil),
+		(*MapInput_Prepare)(nil),
+		(*MapInput_Commit)(nil),
+		(*MapInput_Abort)(nil),
+		(*MapInput_Apply)(nil),
+		(*MapInput_GetAll)(nil),
+		(*MapInput_PutAll)(nil),
+		(*MapInput_RemoveAll)(nil),
+		(*MapInput_Configure)(nil),
+		(*MapInput_Query)(nil),
+		(*MapInput_ValidateFence)(nil),
 	}
-	return IndexedValue{}
 }
 
-type GetInput struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type MapOutput struct {
+	// Types that are valid to be assigned to Output:
+	//	*MapOutput_Size_
+	//	*MapOutput_Put
+	//	*MapOutput_Insert
+	//	*MapOutput_Update
+	//	*MapOutput_Get
+	//	*MapOutput_Remove
+	//	*MapOutput_Clear
+	//	*MapOutput_Lock
+	//	*MapOutput_Unlock
+	//	*MapOutput_Entries
+	//	*MapOutput_Events
+	//	*MapOutput_Prepare
+	//	*MapOutput_Commit
+	//	*MapOutput_Abort
+	//	*MapOutput_Apply
+	//	*MapOutput_GetAll
+	//	*MapOutput_PutAll
+	//	*MapOutput_RemoveAll
+	//	*MapOutput_Configure
+	//	*MapOutput_Query
+	//	*MapOutput_ValidateFence
+	Output isMapOutput_Output `protobuf_oneof:"output"`
 }
 
-func (m *GetInput) Reset()         { *m = GetInput{} }
-func (m *GetInput) String() string { return proto.CompactTextString(m) }
-func (*GetInput) ProtoMessage()    {}
-func (*GetInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{43}
+func (m *MapOutput) Reset()         { *m = MapOutput{} }
+func (m *MapOutput) String() string { return proto.CompactTextString(m) }
+func (*MapOutput) ProtoMessage()    {}
+func (*MapOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{46}
 }
-func (m *GetInput) XXX_Unmarshal(b []byte) error {
+func (m *MapOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MapOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MapOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2504,216 +2538,306 @@ func (m *GetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GetInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInput.Merge(m, src)
+func (m *MapOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MapOutput.Merge(m, src)
 }
-func (m *GetInput) XXX_Size() int {
+func (m *MapOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInput.DiscardUnknown(m)
+func (m *MapOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_MapOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetInput proto.InternalMessageInfo
+var xxx_messageInfo_MapOutput proto.InternalMessageInfo
 
-func (m *GetInput) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+type isMapOutput_Output interface {
+	isMapOutput_Output()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type GetOutput struct {
-	Value IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+type MapOutput_Size_ struct {
+	Size_ *SizeOutput `protobuf:"bytes,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
 }
-
-func (m *GetOutput) Reset()         { *m = GetOutput{} }
-func (m *GetOutput) String() string { return proto.CompactTextString(m) }
-func (*GetOutput) ProtoMessage()    {}
-func (*GetOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{44}
+type MapOutput_Put struct {
+	Put *PutOutput `protobuf:"bytes,2,opt,name=put,proto3,oneof" json:"put,omitempty"`
 }
-func (m *GetOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type MapOutput_Insert struct {
+	Insert *InsertOutput `protobuf:"bytes,3,opt,name=insert,proto3,oneof" json:"insert,omitempty"`
 }
-func (m *GetOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+type MapOutput_Update struct {
+	Update *UpdateOutput `protobuf:"bytes,4,opt,name=update,proto3,oneof" json:"update,omitempty"`
 }
-func (m *GetOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOutput.Merge(m, src)
+type MapOutput_Get struct {
+	Get *GetOutput `protobuf:"bytes,5,opt,name=get,proto3,oneof" json:"get,omitempty"`
 }
-func (m *GetOutput) XXX_Size() int {
-	return m.Size()
+type MapOutput_Remove struct {
+	Remove *RemoveOutput `protobuf:"bytes,6,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
 }
-func (m *GetOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOutput.DiscardUnknown(m)
+type MapOutput_Clear struct {
+	Clear *ClearOutput `protobuf:"bytes,7,opt,name=clear,proto3,oneof" json:"clear,omitempty"`
 }
-
-var xxx_messageInfo_GetOutput proto.InternalMessageInfo
-
-func (m *GetOutput) GetValue() IndexedValue {
-	if m != nil {
-		return m.Value
-	}
-	return IndexedValue{}
+type MapOutput_Lock struct {
+	Lock *LockOutput `protobuf:"bytes,8,opt,name=lock,proto3,oneof" json:"lock,omitempty"`
 }
-
-type RemoveInput struct {
-	Key       string                                              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	PrevIndex github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,2,opt,name=prev_index,json=prevIndex,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"prev_index,omitempty"`
+type MapOutput_Unlock struct {
+	Unlock *UnlockOutput `protobuf:"bytes,9,opt,name=unlock,proto3,oneof" json:"unlock,omitempty"`
 }
-
-func (m *RemoveInput) Reset()         { *m = RemoveInput{} }
-func (m *RemoveInput) String() string { return proto.CompactTextString(m) }
-func (*RemoveInput) ProtoMessage()    {}
-func (*RemoveInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{45}
+type MapOutput_Entries struct {
+	Entries *EntriesOutput `protobuf:"bytes,10,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
 }
-func (m *RemoveInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type MapOutput_Events struct {
+	Events *EventsOutput `protobuf:"bytes,11,opt,name=events,proto3,oneof" json:"events,omitempty"`
 }
-func (m *RemoveInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RemoveInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+type MapOutput_Prepare struct {
+	Prepare *PrepareOutput `protobuf:"bytes,12,opt,name=prepare,proto3,oneof" json:"prepare,omitempty"`
 }
-func (m *RemoveInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveInput.Merge(m, src)
+type MapOutput_Commit struct {
+	Commit *CommitOutput `protobuf:"bytes,13,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
 }
-func (m *RemoveInput) XXX_Size() int {
-	return m.Size()
+type MapOutput_Abort struct {
+	Abort *AbortOutput `protobuf:"bytes,14,opt,name=abort,proto3,oneof" json:"abort,omitempty"`
 }
-func (m *RemoveInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveInput.DiscardUnknown(m)
+type MapOutput_Apply struct {
+	Apply *ApplyOutput `protobuf:"bytes,15,opt,name=apply,proto3,oneof" json:"apply,omitempty"`
 }
+type MapOutput_GetAll struct {
+	GetAll *GetAllOutput `protobuf:"bytes,16,opt,name=get_all,json=getAll,proto3,oneof" json:"get_all,omitempty"`
+}
+type MapOutput_PutAll struct {
+	PutAll *PutAllOutput `protobuf:"bytes,17,opt,name=put_all,json=putAll,proto3,oneof" json:"put_all,omitempty"`
+}
+type MapOutput_RemoveAll struct {
+	RemoveAll *RemoveAllOutput `protobuf:"bytes,18,opt,name=remove_all,json=removeAll,proto3,oneof" json:"remove_all,omitempty"`
+}
+type MapOutput_Configure struct {
+	Configure *ConfigureOutput `protobuf:"bytes,19,opt,name=configure,proto3,oneof" json:"configure,omitempty"`
+}
+type MapOutput_Query struct {
+	Query *QueryOutput `protobuf:"bytes,20,opt,name=query,proto3,oneof" json:"query,omitempty"`
+}
+type MapOutput_ValidateFence struct {
+	ValidateFence *ValidateFenceOutput `protobuf:"bytes,21,opt,name=validate_fence,json=validateFence,proto3,oneof" json:"validate_fence,omitempty"`
+}
+
+func (*MapOutput_Size_) isMapOutput_Output()         {}
+func (*MapOutput_Put) isMapOutput_Output()           {}
+func (*MapOutput_Insert) isMapOutput_Output()        {}
+func (*MapOutput_Update) isMapOutput_Output()        {}
+func (*MapOutput_Get) isMapOutput_Output()           {}
+func (*MapOutput_Remove) isMapOutput_Output()        {}
+func (*MapOutput_Clear) isMapOutput_Output()         {}
+func (*MapOutput_Lock) isMapOutput_Output()          {}
+func (*MapOutput_Unlock) isMapOutput_Output()        {}
+func (*MapOutput_Entries) isMapOutput_Output()       {}
+func (*MapOutput_Events) isMapOutput_Output()        {}
+func (*MapOutput_Prepare) isMapOutput_Output()       {}
+func (*MapOutput_Commit) isMapOutput_Output()        {}
+func (*MapOutput_Abort) isMapOutput_Output()         {}
+func (*MapOutput_Apply) isMapOutput_Output()         {}
+func (*MapOutput_GetAll) isMapOutput_Output()        {}
+func (*MapOutput_PutAll) isMapOutput_Output()        {}
+func (*MapOutput_RemoveAll) isMapOutput_Output()     {}
+func (*MapOutput_Configure) isMapOutput_Output()     {}
+func (*MapOutput_Query) isMapOutput_Output()         {}
+func (*MapOutput_ValidateFence) isMapOutput_Output() {}
 
-var xxx_messageInfo_RemoveInput proto.InternalMessageInfo
-
-func (m *RemoveInput) GetKey() string {
+func (m *MapOutput) GetOutput() isMapOutput_Output {
 	if m != nil {
-		return m.Key
+		return m.Output
 	}
-	return ""
+	return nil
 }
 
-func (m *RemoveInput) GetPrevIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.PrevIndex
+func (m *MapOutput) GetSize_() *SizeOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Size_); ok {
+		return x.Size_
 	}
-	return 0
+	return nil
 }
 
-type RemoveOutput struct {
-	Value IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+func (m *MapOutput) GetPut() *PutOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Put); ok {
+		return x.Put
+	}
+	return nil
 }
 
-func (m *RemoveOutput) Reset()         { *m = RemoveOutput{} }
-func (m *RemoveOutput) String() string { return proto.CompactTextString(m) }
-func (*RemoveOutput) ProtoMessage()    {}
-func (*RemoveOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{46}
+func (m *MapOutput) GetInsert() *InsertOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Insert); ok {
+		return x.Insert
+	}
+	return nil
 }
-func (m *RemoveOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *MapOutput) GetUpdate() *UpdateOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Update); ok {
+		return x.Update
+	}
+	return nil
 }
-func (m *RemoveOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RemoveOutput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *MapOutput) GetGet() *GetOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Get); ok {
+		return x.Get
 	}
+	return nil
 }
-func (m *RemoveOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveOutput.Merge(m, src)
+
+func (m *MapOutput) GetRemove() *RemoveOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Remove); ok {
+		return x.Remove
+	}
+	return nil
 }
-func (m *RemoveOutput) XXX_Size() int {
-	return m.Size()
+
+func (m *MapOutput) GetClear() *ClearOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Clear); ok {
+		return x.Clear
+	}
+	return nil
 }
-func (m *RemoveOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveOutput.DiscardUnknown(m)
+
+func (m *MapOutput) GetLock() *LockOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Lock); ok {
+		return x.Lock
+	}
+	return nil
 }
 
-var xxx_messageInfo_RemoveOutput proto.InternalMessageInfo
+func (m *MapOutput) GetUnlock() *UnlockOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Unlock); ok {
+		return x.Unlock
+	}
+	return nil
+}
 
-func (m *RemoveOutput) GetValue() IndexedValue {
-	if m != nil {
-		return m.Value
+func (m *MapOutput) GetEntries() *EntriesOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Entries); ok {
+		return x.Entries
 	}
-	return IndexedValue{}
+	return nil
 }
 
-type ClearInput struct {
+func (m *MapOutput) GetEvents() *EventsOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Events); ok {
+		return x.Events
+	}
+	return nil
 }
 
-func (m *ClearInput) Reset()         { *m = ClearInput{} }
-func (m *ClearInput) String() string { return proto.CompactTextString(m) }
-func (*ClearInput) ProtoMessage()    {}
-func (*ClearInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{47}
+func (m *MapOutput) GetPrepare() *PrepareOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Prepare); ok {
+		return x.Prepare
+	}
+	return nil
 }
-func (m *ClearInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *MapOutput) GetCommit() *CommitOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Commit); ok {
+		return x.Commit
+	}
+	return nil
 }
-func (m *ClearInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ClearInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *MapOutput) GetAbort() *AbortOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Abort); ok {
+		return x.Abort
 	}
+	return nil
 }
-func (m *ClearInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearInput.Merge(m, src)
+
+func (m *MapOutput) GetApply() *ApplyOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Apply); ok {
+		return x.Apply
+	}
+	return nil
 }
-func (m *ClearInput) XXX_Size() int {
-	return m.Size()
+
+func (m *MapOutput) GetGetAll() *GetAllOutput {
+	if x, ok := m.GetOutput().(*MapOutput_GetAll); ok {
+		return x.GetAll
+	}
+	return nil
 }
-func (m *ClearInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearInput.DiscardUnknown(m)
+
+func (m *MapOutput) GetPutAll() *PutAllOutput {
+	if x, ok := m.GetOutput().(*MapOutput_PutAll); ok {
+		return x.PutAll
+	}
+	return nil
 }
 
-var xxx_messageInfo_ClearInput proto.InternalMessageInfo
+func (m *MapOutput) GetRemoveAll() *RemoveAllOutput {
+	if x, ok := m.GetOutput().(*MapOutput_RemoveAll); ok {
+		return x.RemoveAll
+	}
+	return nil
+}
 
-type ClearOutput struct {
+func (m *MapOutput) GetConfigure() *ConfigureOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Configure); ok {
+		return x.Configure
+	}
+	return nil
 }
 
-func (m *ClearOutput) Reset()         { *m = ClearOutput{} }
-func (m *ClearOutput) String() string { return proto.CompactTextString(m) }
-func (*ClearOutput) ProtoMessage()    {}
-func (*ClearOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{48}
+func (m *MapOutput) GetQuery() *QueryOutput {
+	if x, ok := m.GetOutput().(*MapOutput_Query); ok {
+		return x.Query
+	}
+	return nil
 }
-func (m *ClearOutput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *MapOutput) GetValidateFence() *ValidateFenceOutput {
+	if x, ok := m.GetOutput().(*MapOutput_ValidateFence); ok {
+		return x.ValidateFence
+	}
+	return nil
 }
-func (m *ClearOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MapOutput) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MapOutput_Size_)(nil),
+		(*MapOutput_Put)(nil),
+		(*MapOutput_Insert)(nil),
+		(*MapOutput_Update)(nil),
+		(*MapOutput_Get)(nil),
+		(*MapOutput_Remove)(nil),
+		(*MapOutput_Clear)(nil),
+		(*MapOutput_Lock)(nil),
+		(*MapOutput_Unlock)(nil),
+		(*MapOutput_Entries)(nil),
+		(*MapOutput_Events)(nil),
+		(*MapOutput_Prepare)(nil),
+		(*MapOutput_Commit)(nil),
+		(*MapOutput_Abort)(nil),
+		(*MapOutput_Apply)(nil),
+		(*MapOutput_GetAll)(nil),
+		(*MapOutput_PutAll)(nil),
+		(*MapOutput_RemoveAll)(nil),
+		(*MapOutput_Configure)(nil),
+		(*MapOutput_Query)(nil),
+		(*MapOutput_ValidateFence)(nil),
+	}
+}
+
+type SizeInput struct {
+}
+
+func (m *SizeInput) Reset()         { *m = SizeInput{} }
+func (m *SizeInput) String() string { return proto.CompactTextString(m) }
+func (*SizeInput) ProtoMessage()    {}
+func (*SizeInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{47}
+}
+func (m *SizeInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SizeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SizeInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2723,35 +2847,34 @@ func (m *ClearOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ClearOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearOutput.Merge(m, src)
+func (m *SizeInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SizeInput.Merge(m, src)
 }
-func (m *ClearOutput) XXX_Size() int {
+func (m *SizeInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearOutput.DiscardUnknown(m)
+func (m *SizeInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SizeInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearOutput proto.InternalMessageInfo
+var xxx_messageInfo_SizeInput proto.InternalMessageInfo
 
-type LockInput struct {
-	Keys    []string       `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	Timeout *time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+type SizeOutput struct {
+	Size_ uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *LockInput) Reset()         { *m = LockInput{} }
-func (m *LockInput) String() string { return proto.CompactTextString(m) }
-func (*LockInput) ProtoMessage()    {}
-func (*LockInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{49}
+func (m *SizeOutput) Reset()         { *m = SizeOutput{} }
+func (m *SizeOutput) String() string { return proto.CompactTextString(m) }
+func (*SizeOutput) ProtoMessage()    {}
+func (*SizeOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{48}
 }
-func (m *LockInput) XXX_Unmarshal(b []byte) error {
+func (m *SizeOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SizeOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SizeOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2761,47 +2884,47 @@ func (m *LockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LockInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockInput.Merge(m, src)
+func (m *SizeOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SizeOutput.Merge(m, src)
 }
-func (m *LockInput) XXX_Size() int {
+func (m *SizeOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockInput.DiscardUnknown(m)
+func (m *SizeOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SizeOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockInput proto.InternalMessageInfo
-
-func (m *LockInput) GetKeys() []string {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
+var xxx_messageInfo_SizeOutput proto.InternalMessageInfo
 
-func (m *LockInput) GetTimeout() *time.Duration {
+func (m *SizeOutput) GetSize_() uint32 {
 	if m != nil {
-		return m.Timeout
+		return m.Size_
 	}
-	return nil
+	return 0
 }
 
-type LockOutput struct {
+type PutInput struct {
+	Key       string                                              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte                                              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL       *time.Duration                                      `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	PrevIndex github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,4,opt,name=prev_index,json=prevIndex,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"prev_index,omitempty"`
+	Ephemeral bool                                                `protobuf:"varint,5,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	Fence     *Fence                                              `protobuf:"bytes,6,opt,name=fence,proto3" json:"fence,omitempty"`
+	Patch     *Patch                                              `protobuf:"bytes,7,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
-func (m *LockOutput) Reset()         { *m = LockOutput{} }
-func (m *LockOutput) String() string { return proto.CompactTextString(m) }
-func (*LockOutput) ProtoMessage()    {}
-func (*LockOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{50}
+func (m *PutInput) Reset()         { *m = PutInput{} }
+func (m *PutInput) String() string { return proto.CompactTextString(m) }
+func (*PutInput) ProtoMessage()    {}
+func (*PutInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{49}
 }
-func (m *LockOutput) XXX_Unmarshal(b []byte) error {
+func (m *PutInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LockOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2811,34 +2934,84 @@ func (m *LockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LockOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockOutput.Merge(m, src)
+func (m *PutInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutInput.Merge(m, src)
 }
-func (m *LockOutput) XXX_Size() int {
+func (m *PutInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *LockOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockOutput.DiscardUnknown(m)
+func (m *PutInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockOutput proto.InternalMessageInfo
+var xxx_messageInfo_PutInput proto.InternalMessageInfo
 
-type UnlockInput struct {
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+func (m *PutInput) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *UnlockInput) Reset()         { *m = UnlockInput{} }
-func (m *UnlockInput) String() string { return proto.CompactTextString(m) }
-func (*UnlockInput) ProtoMessage()    {}
-func (*UnlockInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{51}
+func (m *PutInput) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
-func (m *UnlockInput) XXX_Unmarshal(b []byte) error {
+
+func (m *PutInput) GetTTL() *time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
+}
+
+func (m *PutInput) GetPrevIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
+	if m != nil {
+		return m.PrevIndex
+	}
+	return 0
+}
+
+func (m *PutInput) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+func (m *PutInput) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
+	}
+	return nil
+}
+
+func (m *PutInput) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
+type PutOutput struct {
+	Index     github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	PrevValue *IndexedValue                                       `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+}
+
+func (m *PutOutput) Reset()         { *m = PutOutput{} }
+func (m *PutOutput) String() string { return proto.CompactTextString(m) }
+func (*PutOutput) ProtoMessage()    {}
+func (*PutOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{50}
+}
+func (m *PutOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2848,40 +3021,51 @@ func (m *UnlockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UnlockInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockInput.Merge(m, src)
+func (m *PutOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutOutput.Merge(m, src)
 }
-func (m *UnlockInput) XXX_Size() int {
+func (m *PutOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockInput.DiscardUnknown(m)
+func (m *PutOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockInput proto.InternalMessageInfo
+var xxx_messageInfo_PutOutput proto.InternalMessageInfo
 
-func (m *UnlockInput) GetKeys() []string {
+func (m *PutOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
 	if m != nil {
-		return m.Keys
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PutOutput) GetPrevValue() *IndexedValue {
+	if m != nil {
+		return m.PrevValue
 	}
 	return nil
 }
 
-type UnlockOutput struct {
+type InsertInput struct {
+	Key       string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL       *time.Duration `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	Ephemeral bool           `protobuf:"varint,4,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
 }
 
-func (m *UnlockOutput) Reset()         { *m = UnlockOutput{} }
-func (m *UnlockOutput) String() string { return proto.CompactTextString(m) }
-func (*UnlockOutput) ProtoMessage()    {}
-func (*UnlockOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{52}
+func (m *InsertInput) Reset()         { *m = InsertInput{} }
+func (m *InsertInput) String() string { return proto.CompactTextString(m) }
+func (*InsertInput) ProtoMessage()    {}
+func (*InsertInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{51}
 }
-func (m *UnlockOutput) XXX_Unmarshal(b []byte) error {
+func (m *InsertInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UnlockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UnlockOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2891,35 +3075,62 @@ func (m *UnlockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UnlockOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockOutput.Merge(m, src)
+func (m *InsertInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertInput.Merge(m, src)
 }
-func (m *UnlockOutput) XXX_Size() int {
+func (m *InsertInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *UnlockOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockOutput.DiscardUnknown(m)
+func (m *InsertInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockOutput proto.InternalMessageInfo
-
-type PrepareInput struct {
-	SequenceNum github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum `protobuf:"varint,1,opt,name=sequence_num,json=sequenceNum,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SequenceNum" json:"sequence_num,omitempty"`
-	Inputs      []MapInput                                                `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs"`
-}
+var xxx_messageInfo_InsertInput proto.InternalMessageInfo
 
-func (m *PrepareInput) Reset()         { *m = PrepareInput{} }
-func (m *PrepareInput) String() string { return proto.CompactTextString(m) }
-func (*PrepareInput) ProtoMessage()    {}
-func (*PrepareInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{53}
+func (m *InsertInput) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
-func (m *PrepareInput) XXX_Unmarshal(b []byte) error {
+
+func (m *InsertInput) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *InsertInput) GetTTL() *time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
+}
+
+func (m *InsertInput) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+type InsertOutput struct {
+	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+}
+
+func (m *InsertOutput) Reset()         { *m = InsertOutput{} }
+func (m *InsertOutput) String() string { return proto.CompactTextString(m) }
+func (*InsertOutput) ProtoMessage()    {}
+func (*InsertOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{52}
+}
+func (m *InsertOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PrepareInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PrepareInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2929,47 +3140,45 @@ func (m *PrepareInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PrepareInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepareInput.Merge(m, src)
+func (m *InsertOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertOutput.Merge(m, src)
 }
-func (m *PrepareInput) XXX_Size() int {
+func (m *InsertOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *PrepareInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepareInput.DiscardUnknown(m)
+func (m *InsertOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrepareInput proto.InternalMessageInfo
+var xxx_messageInfo_InsertOutput proto.InternalMessageInfo
 
-func (m *PrepareInput) GetSequenceNum() github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum {
+func (m *InsertOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
 	if m != nil {
-		return m.SequenceNum
+		return m.Index
 	}
 	return 0
 }
 
-func (m *PrepareInput) GetInputs() []MapInput {
-	if m != nil {
-		return m.Inputs
-	}
-	return nil
-}
-
-type PrepareOutput struct {
+type UpdateInput struct {
+	Key       string                                              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte                                              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL       *time.Duration                                      `protobuf:"bytes,3,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	PrevIndex github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,4,opt,name=prev_index,json=prevIndex,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"prev_index,omitempty"`
+	Fence     *Fence                                              `protobuf:"bytes,5,opt,name=fence,proto3" json:"fence,omitempty"`
 }
 
-func (m *PrepareOutput) Reset()         { *m = PrepareOutput{} }
-func (m *PrepareOutput) String() string { return proto.CompactTextString(m) }
-func (*PrepareOutput) ProtoMessage()    {}
-func (*PrepareOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{54}
+func (m *UpdateInput) Reset()         { *m = UpdateInput{} }
+func (m *UpdateInput) String() string { return proto.CompactTextString(m) }
+func (*UpdateInput) ProtoMessage()    {}
+func (*UpdateInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{53}
 }
-func (m *PrepareOutput) XXX_Unmarshal(b []byte) error {
+func (m *UpdateInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PrepareOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PrepareOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2979,34 +3188,70 @@ func (m *PrepareOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *PrepareOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepareOutput.Merge(m, src)
+func (m *UpdateInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInput.Merge(m, src)
 }
-func (m *PrepareOutput) XXX_Size() int {
+func (m *UpdateInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *PrepareOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepareOutput.DiscardUnknown(m)
+func (m *UpdateInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrepareOutput proto.InternalMessageInfo
+var xxx_messageInfo_UpdateInput proto.InternalMessageInfo
 
-type CommitInput struct {
-	SequenceNum github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum `protobuf:"varint,1,opt,name=sequence_num,json=sequenceNum,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SequenceNum" json:"sequence_num,omitempty"`
+func (m *UpdateInput) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *CommitInput) Reset()         { *m = CommitInput{} }
-func (m *CommitInput) String() string { return proto.CompactTextString(m) }
-func (*CommitInput) ProtoMessage()    {}
-func (*CommitInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{55}
+func (m *UpdateInput) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
-func (m *CommitInput) XXX_Unmarshal(b []byte) error {
+
+func (m *UpdateInput) GetTTL() *time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
+}
+
+func (m *UpdateInput) GetPrevIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
+	if m != nil {
+		return m.PrevIndex
+	}
+	return 0
+}
+
+func (m *UpdateInput) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
+	}
+	return nil
+}
+
+type UpdateOutput struct {
+	Index     github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+	PrevValue IndexedValue                                        `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
+}
+
+func (m *UpdateOutput) Reset()         { *m = UpdateOutput{} }
+func (m *UpdateOutput) String() string { return proto.CompactTextString(m) }
+func (*UpdateOutput) ProtoMessage()    {}
+func (*UpdateOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{54}
+}
+func (m *UpdateOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3016,41 +3261,49 @@ func (m *CommitInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CommitInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitInput.Merge(m, src)
+func (m *UpdateOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOutput.Merge(m, src)
 }
-func (m *CommitInput) XXX_Size() int {
+func (m *UpdateOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitInput.DiscardUnknown(m)
+func (m *UpdateOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitInput proto.InternalMessageInfo
+var xxx_messageInfo_UpdateOutput proto.InternalMessageInfo
 
-func (m *CommitInput) GetSequenceNum() github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum {
+func (m *UpdateOutput) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
 	if m != nil {
-		return m.SequenceNum
+		return m.Index
 	}
 	return 0
 }
 
-type CommitOutput struct {
-	Outputs []MapOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs"`
+func (m *UpdateOutput) GetPrevValue() IndexedValue {
+	if m != nil {
+		return m.PrevValue
+	}
+	return IndexedValue{}
 }
 
-func (m *CommitOutput) Reset()         { *m = CommitOutput{} }
-func (m *CommitOutput) String() string { return proto.CompactTextString(m) }
-func (*CommitOutput) ProtoMessage()    {}
-func (*CommitOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{56}
+type GetInput struct {
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
 }
-func (m *CommitOutput) XXX_Unmarshal(b []byte) error {
+
+func (m *GetInput) Reset()         { *m = GetInput{} }
+func (m *GetInput) String() string { return proto.CompactTextString(m) }
+func (*GetInput) ProtoMessage()    {}
+func (*GetInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{55}
+}
+func (m *GetInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3060,41 +3313,48 @@ func (m *CommitOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CommitOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitOutput.Merge(m, src)
+func (m *GetInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInput.Merge(m, src)
 }
-func (m *CommitOutput) XXX_Size() int {
+func (m *GetInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitOutput.DiscardUnknown(m)
+func (m *GetInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitOutput proto.InternalMessageInfo
+var xxx_messageInfo_GetInput proto.InternalMessageInfo
 
-func (m *CommitOutput) GetOutputs() []MapOutput {
+func (m *GetInput) GetKey() string {
 	if m != nil {
-		return m.Outputs
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetInput) GetPaths() []string {
+	if m != nil {
+		return m.Paths
 	}
 	return nil
 }
 
-type AbortInput struct {
-	SequenceNum github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum `protobuf:"varint,1,opt,name=sequence_num,json=sequenceNum,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SequenceNum" json:"sequence_num,omitempty"`
+type GetOutput struct {
+	Value IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
 }
 
-func (m *AbortInput) Reset()         { *m = AbortInput{} }
-func (m *AbortInput) String() string { return proto.CompactTextString(m) }
-func (*AbortInput) ProtoMessage()    {}
-func (*AbortInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{57}
+func (m *GetOutput) Reset()         { *m = GetOutput{} }
+func (m *GetOutput) String() string { return proto.CompactTextString(m) }
+func (*GetOutput) ProtoMessage()    {}
+func (*GetOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{56}
 }
-func (m *AbortInput) XXX_Unmarshal(b []byte) error {
+func (m *GetOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AbortInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AbortInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3104,40 +3364,43 @@ func (m *AbortInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *AbortInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbortInput.Merge(m, src)
+func (m *GetOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOutput.Merge(m, src)
 }
-func (m *AbortInput) XXX_Size() int {
+func (m *GetOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *AbortInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbortInput.DiscardUnknown(m)
+func (m *GetOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbortInput proto.InternalMessageInfo
+var xxx_messageInfo_GetOutput proto.InternalMessageInfo
 
-func (m *AbortInput) GetSequenceNum() github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum {
+func (m *GetOutput) GetValue() IndexedValue {
 	if m != nil {
-		return m.SequenceNum
+		return m.Value
 	}
-	return 0
+	return IndexedValue{}
 }
 
-type AbortOutput struct {
+type RemoveInput struct {
+	Key       string                                              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PrevIndex github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,2,opt,name=prev_index,json=prevIndex,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"prev_index,omitempty"`
+	Fence     *Fence                                              `protobuf:"bytes,3,opt,name=fence,proto3" json:"fence,omitempty"`
 }
 
-func (m *AbortOutput) Reset()         { *m = AbortOutput{} }
-func (m *AbortOutput) String() string { return proto.CompactTextString(m) }
-func (*AbortOutput) ProtoMessage()    {}
-func (*AbortOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{58}
+func (m *RemoveInput) Reset()         { *m = RemoveInput{} }
+func (m *RemoveInput) String() string { return proto.CompactTextString(m) }
+func (*RemoveInput) ProtoMessage()    {}
+func (*RemoveInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{57}
 }
-func (m *AbortOutput) XXX_Unmarshal(b []byte) error {
+func (m *RemoveInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AbortOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AbortOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3147,78 +3410,55 @@ func (m *AbortOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AbortOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbortOutput.Merge(m, src)
+func (m *RemoveInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveInput.Merge(m, src)
 }
-func (m *AbortOutput) XXX_Size() int {
+func (m *RemoveInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *AbortOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbortOutput.DiscardUnknown(m)
+func (m *RemoveInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbortOutput proto.InternalMessageInfo
+var xxx_messageInfo_RemoveInput proto.InternalMessageInfo
 
-type ApplyInput struct {
-	Inputs []MapInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs"`
+func (m *RemoveInput) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *ApplyInput) Reset()         { *m = ApplyInput{} }
-func (m *ApplyInput) String() string { return proto.CompactTextString(m) }
-func (*ApplyInput) ProtoMessage()    {}
-func (*ApplyInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{59}
-}
-func (m *ApplyInput) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ApplyInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ApplyInput.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RemoveInput) GetPrevIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
+	if m != nil {
+		return m.PrevIndex
 	}
-}
-func (m *ApplyInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplyInput.Merge(m, src)
-}
-func (m *ApplyInput) XXX_Size() int {
-	return m.Size()
-}
-func (m *ApplyInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplyInput.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_ApplyInput proto.InternalMessageInfo
-
-func (m *ApplyInput) GetInputs() []MapInput {
+func (m *RemoveInput) GetFence() *Fence {
 	if m != nil {
-		return m.Inputs
+		return m.Fence
 	}
 	return nil
 }
 
-type ApplyOutput struct {
-	Outputs []MapOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs"`
+type RemoveOutput struct {
+	Value IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
 }
 
-func (m *ApplyOutput) Reset()         { *m = ApplyOutput{} }
-func (m *ApplyOutput) String() string { return proto.CompactTextString(m) }
-func (*ApplyOutput) ProtoMessage()    {}
-func (*ApplyOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{60}
+func (m *RemoveOutput) Reset()         { *m = RemoveOutput{} }
+func (m *RemoveOutput) String() string { return proto.CompactTextString(m) }
+func (*RemoveOutput) ProtoMessage()    {}
+func (*RemoveOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{58}
 }
-func (m *ApplyOutput) XXX_Unmarshal(b []byte) error {
+func (m *RemoveOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplyOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplyOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3228,41 +3468,40 @@ func (m *ApplyOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ApplyOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplyOutput.Merge(m, src)
+func (m *RemoveOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveOutput.Merge(m, src)
 }
-func (m *ApplyOutput) XXX_Size() int {
+func (m *RemoveOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplyOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplyOutput.DiscardUnknown(m)
+func (m *RemoveOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplyOutput proto.InternalMessageInfo
+var xxx_messageInfo_RemoveOutput proto.InternalMessageInfo
 
-func (m *ApplyOutput) GetOutputs() []MapOutput {
+func (m *RemoveOutput) GetValue() IndexedValue {
 	if m != nil {
-		return m.Outputs
+		return m.Value
 	}
-	return nil
+	return IndexedValue{}
 }
 
-type EntriesInput struct {
-	Watch bool `protobuf:"varint,1,opt,name=watch,proto3" json:"watch,omitempty"`
+type ClearInput struct {
 }
 
-func (m *EntriesInput) Reset()         { *m = EntriesInput{} }
-func (m *EntriesInput) String() string { return proto.CompactTextString(m) }
-func (*EntriesInput) ProtoMessage()    {}
-func (*EntriesInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{61}
+func (m *ClearInput) Reset()         { *m = ClearInput{} }
+func (m *ClearInput) String() string { return proto.CompactTextString(m) }
+func (*ClearInput) ProtoMessage()    {}
+func (*ClearInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{59}
 }
-func (m *EntriesInput) XXX_Unmarshal(b []byte) error {
+func (m *ClearInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3272,41 +3511,33 @@ func (m *EntriesInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EntriesInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesInput.Merge(m, src)
+func (m *ClearInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearInput.Merge(m, src)
 }
-func (m *EntriesInput) XXX_Size() int {
+func (m *ClearInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesInput.DiscardUnknown(m)
+func (m *ClearInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesInput proto.InternalMessageInfo
-
-func (m *EntriesInput) GetWatch() bool {
-	if m != nil {
-		return m.Watch
-	}
-	return false
-}
+var xxx_messageInfo_ClearInput proto.InternalMessageInfo
 
-type EntriesOutput struct {
-	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+type ClearOutput struct {
 }
 
-func (m *EntriesOutput) Reset()         { *m = EntriesOutput{} }
-func (m *EntriesOutput) String() string { return proto.CompactTextString(m) }
-func (*EntriesOutput) ProtoMessage()    {}
-func (*EntriesOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{62}
+func (m *ClearOutput) Reset()         { *m = ClearOutput{} }
+func (m *ClearOutput) String() string { return proto.CompactTextString(m) }
+func (*ClearOutput) ProtoMessage()    {}
+func (*ClearOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{60}
 }
-func (m *EntriesOutput) XXX_Unmarshal(b []byte) error {
+func (m *ClearOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EntriesOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EntriesOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3316,41 +3547,35 @@ func (m *EntriesOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EntriesOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntriesOutput.Merge(m, src)
+func (m *ClearOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearOutput.Merge(m, src)
 }
-func (m *EntriesOutput) XXX_Size() int {
+func (m *ClearOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EntriesOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntriesOutput.DiscardUnknown(m)
+func (m *ClearOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntriesOutput proto.InternalMessageInfo
-
-func (m *EntriesOutput) GetEntry() Entry {
-	if m != nil {
-		return m.Entry
-	}
-	return Entry{}
-}
+var xxx_messageInfo_ClearOutput proto.InternalMessageInfo
 
-type EventsInput struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type LockInput struct {
+	Keys    []string       `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timeout *time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 }
 
-func (m *EventsInput) Reset()         { *m = EventsInput{} }
-func (m *EventsInput) String() string { return proto.CompactTextString(m) }
-func (*EventsInput) ProtoMessage()    {}
-func (*EventsInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{63}
+func (m *LockInput) Reset()         { *m = LockInput{} }
+func (m *LockInput) String() string { return proto.CompactTextString(m) }
+func (*LockInput) ProtoMessage()    {}
+func (*LockInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{61}
 }
-func (m *EventsInput) XXX_Unmarshal(b []byte) error {
+func (m *LockInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LockInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3360,41 +3585,47 @@ func (m *EventsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventsInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsInput.Merge(m, src)
+func (m *LockInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockInput.Merge(m, src)
 }
-func (m *EventsInput) XXX_Size() int {
+func (m *LockInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsInput.DiscardUnknown(m)
+func (m *LockInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsInput proto.InternalMessageInfo
+var xxx_messageInfo_LockInput proto.InternalMessageInfo
 
-func (m *EventsInput) GetKey() string {
+func (m *LockInput) GetKeys() []string {
 	if m != nil {
-		return m.Key
+		return m.Keys
 	}
-	return ""
+	return nil
 }
 
-type EventsOutput struct {
-	Event Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+func (m *LockInput) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
 }
 
-func (m *EventsOutput) Reset()         { *m = EventsOutput{} }
-func (m *EventsOutput) String() string { return proto.CompactTextString(m) }
-func (*EventsOutput) ProtoMessage()    {}
-func (*EventsOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{64}
+type LockOutput struct {
 }
-func (m *EventsOutput) XXX_Unmarshal(b []byte) error {
+
+func (m *LockOutput) Reset()         { *m = LockOutput{} }
+func (m *LockOutput) String() string { return proto.CompactTextString(m) }
+func (*LockOutput) ProtoMessage()    {}
+func (*LockOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{62}
+}
+func (m *LockOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventsOutput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LockOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3404,46 +3635,34 @@ func (m *EventsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventsOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventsOutput.Merge(m, src)
+func (m *LockOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockOutput.Merge(m, src)
 }
-func (m *EventsOutput) XXX_Size() int {
+func (m *LockOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventsOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventsOutput.DiscardUnknown(m)
+func (m *LockOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventsOutput proto.InternalMessageInfo
-
-func (m *EventsOutput) GetEvent() Event {
-	if m != nil {
-		return m.Event
-	}
-	return Event{}
-}
+var xxx_messageInfo_LockOutput proto.InternalMessageInfo
 
-type Event struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Types that are valid to be assigned to Event:
-	//	*Event_Inserted_
-	//	*Event_Updated_
-	//	*Event_Removed_
-	Event isEvent_Event `protobuf_oneof:"event"`
+type UnlockInput struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (m *Event) Reset()         { *m = Event{} }
-func (m *Event) String() string { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()    {}
-func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{65}
+func (m *UnlockInput) Reset()         { *m = UnlockInput{} }
+func (m *UnlockInput) String() string { return proto.CompactTextString(m) }
+func (*UnlockInput) ProtoMessage()    {}
+func (*UnlockInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{63}
 }
-func (m *Event) XXX_Unmarshal(b []byte) error {
+func (m *UnlockInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnlockInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnlockInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3453,98 +3672,40 @@ func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(m, src)
+func (m *UnlockInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockInput.Merge(m, src)
 }
-func (m *Event) XXX_Size() int {
+func (m *UnlockInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Event proto.InternalMessageInfo
-
-type isEvent_Event interface {
-	isEvent_Event()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Event_Inserted_ struct {
-	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
-}
-type Event_Updated_ struct {
-	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
-}
-type Event_Removed_ struct {
-	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
+func (m *UnlockInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockInput.DiscardUnknown(m)
 }
 
-func (*Event_Inserted_) isEvent_Event() {}
-func (*Event_Updated_) isEvent_Event()  {}
-func (*Event_Removed_) isEvent_Event()  {}
-
-func (m *Event) GetEvent() isEvent_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
-}
+var xxx_messageInfo_UnlockInput proto.InternalMessageInfo
 
-func (m *Event) GetKey() string {
+func (m *UnlockInput) GetKeys() []string {
 	if m != nil {
-		return m.Key
-	}
-	return ""
-}
-
-func (m *Event) GetInserted() *Event_Inserted {
-	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
-		return x.Inserted
-	}
-	return nil
-}
-
-func (m *Event) GetUpdated() *Event_Updated {
-	if x, ok := m.GetEvent().(*Event_Updated_); ok {
-		return x.Updated
-	}
-	return nil
-}
-
-func (m *Event) GetRemoved() *Event_Removed {
-	if x, ok := m.GetEvent().(*Event_Removed_); ok {
-		return x.Removed
+		return m.Keys
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Event) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Event_Inserted_)(nil),
-		(*Event_Updated_)(nil),
-		(*Event_Removed_)(nil),
-	}
-}
-
-type Event_Inserted struct {
-	Value IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+type UnlockOutput struct {
 }
 
-func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
-func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
-func (*Event_Inserted) ProtoMessage()    {}
-func (*Event_Inserted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{65, 0}
+func (m *UnlockOutput) Reset()         { *m = UnlockOutput{} }
+func (m *UnlockOutput) String() string { return proto.CompactTextString(m) }
+func (*UnlockOutput) ProtoMessage()    {}
+func (*UnlockOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{64}
 }
-func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+func (m *UnlockOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnlockOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnlockOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3554,42 +3715,36 @@ func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *Event_Inserted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Inserted.Merge(m, src)
+func (m *UnlockOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockOutput.Merge(m, src)
 }
-func (m *Event_Inserted) XXX_Size() int {
+func (m *UnlockOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Inserted) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
+func (m *UnlockOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
-
-func (m *Event_Inserted) GetValue() IndexedValue {
-	if m != nil {
-		return m.Value
-	}
-	return IndexedValue{}
-}
+var xxx_messageInfo_UnlockOutput proto.InternalMessageInfo
 
-type Event_Updated struct {
-	Value     IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
-	PrevValue IndexedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
+type PrepareInput struct {
+	SequenceNum github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum `protobuf:"varint,1,opt,name=sequence_num,json=sequenceNum,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SequenceNum" json:"sequence_num,omitempty"`
+	Inputs      []MapInput                                                `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs"`
+	Fence       *Fence                                                    `protobuf:"bytes,3,opt,name=fence,proto3" json:"fence,omitempty"`
 }
 
-func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
-func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
-func (*Event_Updated) ProtoMessage()    {}
-func (*Event_Updated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{65, 1}
+func (m *PrepareInput) Reset()         { *m = PrepareInput{} }
+func (m *PrepareInput) String() string { return proto.CompactTextString(m) }
+func (*PrepareInput) ProtoMessage()    {}
+func (*PrepareInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{65}
 }
-func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+func (m *PrepareInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PrepareInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PrepareInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3599,49 +3754,54 @@ func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Updated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Updated.Merge(m, src)
+func (m *PrepareInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareInput.Merge(m, src)
 }
-func (m *Event_Updated) XXX_Size() int {
+func (m *PrepareInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Updated) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
+func (m *PrepareInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
+var xxx_messageInfo_PrepareInput proto.InternalMessageInfo
 
-func (m *Event_Updated) GetValue() IndexedValue {
+func (m *PrepareInput) GetSequenceNum() github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum {
 	if m != nil {
-		return m.Value
+		return m.SequenceNum
 	}
-	return IndexedValue{}
+	return 0
 }
 
-func (m *Event_Updated) GetPrevValue() IndexedValue {
+func (m *PrepareInput) GetInputs() []MapInput {
 	if m != nil {
-		return m.PrevValue
+		return m.Inputs
 	}
-	return IndexedValue{}
+	return nil
 }
 
-type Event_Removed struct {
-	Value   IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
-	Expired bool         `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+func (m *PrepareInput) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
+	}
+	return nil
 }
 
-func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
-func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
-func (*Event_Removed) ProtoMessage()    {}
-func (*Event_Removed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{65, 2}
+type PrepareOutput struct {
 }
-func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+
+func (m *PrepareOutput) Reset()         { *m = PrepareOutput{} }
+func (m *PrepareOutput) String() string { return proto.CompactTextString(m) }
+func (*PrepareOutput) ProtoMessage()    {}
+func (*PrepareOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{66}
+}
+func (m *PrepareOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PrepareOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PrepareOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3651,49 +3811,34 @@ func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Event_Removed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event_Removed.Merge(m, src)
+func (m *PrepareOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareOutput.Merge(m, src)
 }
-func (m *Event_Removed) XXX_Size() int {
+func (m *PrepareOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Event_Removed) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
+func (m *PrepareOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
-
-func (m *Event_Removed) GetValue() IndexedValue {
-	if m != nil {
-		return m.Value
-	}
-	return IndexedValue{}
-}
+var xxx_messageInfo_PrepareOutput proto.InternalMessageInfo
 
-func (m *Event_Removed) GetExpired() bool {
-	if m != nil {
-		return m.Expired
-	}
-	return false
+type CommitInput struct {
+	SequenceNum github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum `protobuf:"varint,1,opt,name=sequence_num,json=sequenceNum,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SequenceNum" json:"sequence_num,omitempty"`
 }
 
-type Entry struct {
-	Key   string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value *IndexedValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *CommitInput) Reset()         { *m = CommitInput{} }
+func (m *CommitInput) String() string { return proto.CompactTextString(m) }
+func (*CommitInput) ProtoMessage()    {}
+func (*CommitInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{67}
 }
-
-func (m *Entry) Reset()         { *m = Entry{} }
-func (m *Entry) String() string { return proto.CompactTextString(m) }
-func (*Entry) ProtoMessage()    {}
-func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{66}
-}
-func (m *Entry) XXX_Unmarshal(b []byte) error {
+func (m *CommitInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitInput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3703,49 +3848,41 @@ func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Entry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Entry.Merge(m, src)
+func (m *CommitInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitInput.Merge(m, src)
 }
-func (m *Entry) XXX_Size() int {
+func (m *CommitInput) XXX_Size() int {
 	return m.Size()
 }
-func (m *Entry) XXX_DiscardUnknown() {
-	xxx_messageInfo_Entry.DiscardUnknown(m)
+func (m *CommitInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitInput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Entry proto.InternalMessageInfo
-
-func (m *Entry) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
-}
+var xxx_messageInfo_CommitInput proto.InternalMessageInfo
 
-func (m *Entry) GetValue() *IndexedValue {
+func (m *CommitInput) GetSequenceNum() github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum {
 	if m != nil {
-		return m.Value
+		return m.SequenceNum
 	}
-	return nil
+	return 0
 }
 
-type IndexedValue struct {
-	Value []byte                                              `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
+type CommitOutput struct {
+	Outputs []MapOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs"`
 }
 
-func (m *IndexedValue) Reset()         { *m = IndexedValue{} }
-func (m *IndexedValue) String() string { return proto.CompactTextString(m) }
-func (*IndexedValue) ProtoMessage()    {}
-func (*IndexedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0dba96ee3ff6a058, []int{67}
+func (m *CommitOutput) Reset()         { *m = CommitOutput{} }
+func (m *CommitOutput) String() string { return proto.CompactTextString(m) }
+func (*CommitOutput) ProtoMessage()    {}
+func (*CommitOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{68}
 }
-func (m *IndexedValue) XXX_Unmarshal(b []byte) error {
+func (m *CommitOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IndexedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IndexedValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitOutput.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3755,3162 +3892,3027 @@ func (m *IndexedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *IndexedValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexedValue.Merge(m, src)
+func (m *CommitOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitOutput.Merge(m, src)
 }
-func (m *IndexedValue) XXX_Size() int {
+func (m *CommitOutput) XXX_Size() int {
 	return m.Size()
 }
-func (m *IndexedValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexedValue.DiscardUnknown(m)
+func (m *CommitOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexedValue proto.InternalMessageInfo
+var xxx_messageInfo_CommitOutput proto.InternalMessageInfo
 
-func (m *IndexedValue) GetValue() []byte {
+func (m *CommitOutput) GetOutputs() []MapOutput {
 	if m != nil {
-		return m.Value
+		return m.Outputs
 	}
 	return nil
 }
 
-func (m *IndexedValue) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
-	if m != nil {
-		return m.Index
-	}
-	return 0
+type AbortInput struct {
+	SequenceNum github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum `protobuf:"varint,1,opt,name=sequence_num,json=sequenceNum,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.SequenceNum" json:"sequence_num,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*SizeRequest)(nil), "atomix.protocols.rsm.map.v1.SizeRequest")
-	proto.RegisterType((*SizeResponse)(nil), "atomix.protocols.rsm.map.v1.SizeResponse")
-	proto.RegisterType((*PutRequest)(nil), "atomix.protocols.rsm.map.v1.PutRequest")
-	proto.RegisterType((*PutResponse)(nil), "atomix.protocols.rsm.map.v1.PutResponse")
-	proto.RegisterType((*InsertRequest)(nil), "atomix.protocols.rsm.map.v1.InsertRequest")
-	proto.RegisterType((*InsertResponse)(nil), "atomix.protocols.rsm.map.v1.InsertResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "atomix.protocols.rsm.map.v1.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "atomix.protocols.rsm.map.v1.UpdateResponse")
-	proto.RegisterType((*GetRequest)(nil), "atomix.protocols.rsm.map.v1.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "atomix.protocols.rsm.map.v1.GetResponse")
-	proto.RegisterType((*RemoveRequest)(nil), "atomix.protocols.rsm.map.v1.RemoveRequest")
-	proto.RegisterType((*RemoveResponse)(nil), "atomix.protocols.rsm.map.v1.RemoveResponse")
-	proto.RegisterType((*ClearRequest)(nil), "atomix.protocols.rsm.map.v1.ClearRequest")
-	proto.RegisterType((*ClearResponse)(nil), "atomix.protocols.rsm.map.v1.ClearResponse")
-	proto.RegisterType((*LockRequest)(nil), "atomix.protocols.rsm.map.v1.LockRequest")
-	proto.RegisterType((*LockResponse)(nil), "atomix.protocols.rsm.map.v1.LockResponse")
-	proto.RegisterType((*UnlockRequest)(nil), "atomix.protocols.rsm.map.v1.UnlockRequest")
-	proto.RegisterType((*UnlockResponse)(nil), "atomix.protocols.rsm.map.v1.UnlockResponse")
-	proto.RegisterType((*PrepareRequest)(nil), "atomix.protocols.rsm.map.v1.PrepareRequest")
-	proto.RegisterType((*PrepareResponse)(nil), "atomix.protocols.rsm.map.v1.PrepareResponse")
-	proto.RegisterType((*CommitRequest)(nil), "atomix.protocols.rsm.map.v1.CommitRequest")
-	proto.RegisterType((*CommitResponse)(nil), "atomix.protocols.rsm.map.v1.CommitResponse")
-	proto.RegisterType((*AbortRequest)(nil), "atomix.protocols.rsm.map.v1.AbortRequest")
-	proto.RegisterType((*AbortResponse)(nil), "atomix.protocols.rsm.map.v1.AbortResponse")
-	proto.RegisterType((*ApplyRequest)(nil), "atomix.protocols.rsm.map.v1.ApplyRequest")
-	proto.RegisterType((*ApplyResponse)(nil), "atomix.protocols.rsm.map.v1.ApplyResponse")
-	proto.RegisterType((*EntriesRequest)(nil), "atomix.protocols.rsm.map.v1.EntriesRequest")
-	proto.RegisterType((*EntriesResponse)(nil), "atomix.protocols.rsm.map.v1.EntriesResponse")
-	proto.RegisterType((*EventsRequest)(nil), "atomix.protocols.rsm.map.v1.EventsRequest")
-	proto.RegisterType((*EventsResponse)(nil), "atomix.protocols.rsm.map.v1.EventsResponse")
-	proto.RegisterType((*MapListener)(nil), "atomix.protocols.rsm.map.v1.MapListener")
-	proto.RegisterType((*MapEntry)(nil), "atomix.protocols.rsm.map.v1.MapEntry")
-	proto.RegisterType((*MapValue)(nil), "atomix.protocols.rsm.map.v1.MapValue")
-	proto.RegisterType((*MapInput)(nil), "atomix.protocols.rsm.map.v1.MapInput")
-	proto.RegisterType((*MapOutput)(nil), "atomix.protocols.rsm.map.v1.MapOutput")
-	proto.RegisterType((*SizeInput)(nil), "atomix.protocols.rsm.map.v1.SizeInput")
-	proto.RegisterType((*SizeOutput)(nil), "atomix.protocols.rsm.map.v1.SizeOutput")
-	proto.RegisterType((*PutInput)(nil), "atomix.protocols.rsm.map.v1.PutInput")
-	proto.RegisterType((*PutOutput)(nil), "atomix.protocols.rsm.map.v1.PutOutput")
-	proto.RegisterType((*InsertInput)(nil), "atomix.protocols.rsm.map.v1.InsertInput")
-	proto.RegisterType((*InsertOutput)(nil), "atomix.protocols.rsm.map.v1.InsertOutput")
-	proto.RegisterType((*UpdateInput)(nil), "atomix.protocols.rsm.map.v1.UpdateInput")
-	proto.RegisterType((*UpdateOutput)(nil), "atomix.protocols.rsm.map.v1.UpdateOutput")
-	proto.RegisterType((*GetInput)(nil), "atomix.protocols.rsm.map.v1.GetInput")
-	proto.RegisterType((*GetOutput)(nil), "atomix.protocols.rsm.map.v1.GetOutput")
-	proto.RegisterType((*RemoveInput)(nil), "atomix.protocols.rsm.map.v1.RemoveInput")
-	proto.RegisterType((*RemoveOutput)(nil), "atomix.protocols.rsm.map.v1.RemoveOutput")
-	proto.RegisterType((*ClearInput)(nil), "atomix.protocols.rsm.map.v1.ClearInput")
-	proto.RegisterType((*ClearOutput)(nil), "atomix.protocols.rsm.map.v1.ClearOutput")
-	proto.RegisterType((*LockInput)(nil), "atomix.protocols.rsm.map.v1.LockInput")
-	proto.RegisterType((*LockOutput)(nil), "atomix.protocols.rsm.map.v1.LockOutput")
-	proto.RegisterType((*UnlockInput)(nil), "atomix.protocols.rsm.map.v1.UnlockInput")
-	proto.RegisterType((*UnlockOutput)(nil), "atomix.protocols.rsm.map.v1.UnlockOutput")
-	proto.RegisterType((*PrepareInput)(nil), "atomix.protocols.rsm.map.v1.PrepareInput")
-	proto.RegisterType((*PrepareOutput)(nil), "atomix.protocols.rsm.map.v1.PrepareOutput")
-	proto.RegisterType((*CommitInput)(nil), "atomix.protocols.rsm.map.v1.CommitInput")
-	proto.RegisterType((*CommitOutput)(nil), "atomix.protocols.rsm.map.v1.CommitOutput")
-	proto.RegisterType((*AbortInput)(nil), "atomix.protocols.rsm.map.v1.AbortInput")
-	proto.RegisterType((*AbortOutput)(nil), "atomix.protocols.rsm.map.v1.AbortOutput")
-	proto.RegisterType((*ApplyInput)(nil), "atomix.protocols.rsm.map.v1.ApplyInput")
-	proto.RegisterType((*ApplyOutput)(nil), "atomix.protocols.rsm.map.v1.ApplyOutput")
-	proto.RegisterType((*EntriesInput)(nil), "atomix.protocols.rsm.map.v1.EntriesInput")
-	proto.RegisterType((*EntriesOutput)(nil), "atomix.protocols.rsm.map.v1.EntriesOutput")
-	proto.RegisterType((*EventsInput)(nil), "atomix.protocols.rsm.map.v1.EventsInput")
-	proto.RegisterType((*EventsOutput)(nil), "atomix.protocols.rsm.map.v1.EventsOutput")
-	proto.RegisterType((*Event)(nil), "atomix.protocols.rsm.map.v1.Event")
-	proto.RegisterType((*Event_Inserted)(nil), "atomix.protocols.rsm.map.v1.Event.Inserted")
-	proto.RegisterType((*Event_Updated)(nil), "atomix.protocols.rsm.map.v1.Event.Updated")
-	proto.RegisterType((*Event_Removed)(nil), "atomix.protocols.rsm.map.v1.Event.Removed")
-	proto.RegisterType((*Entry)(nil), "atomix.protocols.rsm.map.v1.Entry")
-	proto.RegisterType((*IndexedValue)(nil), "atomix.protocols.rsm.map.v1.IndexedValue")
+func (m *AbortInput) Reset()         { *m = AbortInput{} }
+func (m *AbortInput) String() string { return proto.CompactTextString(m) }
+func (*AbortInput) ProtoMessage()    {}
+func (*AbortInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{69}
 }
-
-func init() { proto.RegisterFile("map/v1/map.proto", fileDescriptor_0dba96ee3ff6a058) }
-
-var fileDescriptor_0dba96ee3ff6a058 = []byte{
-	// 2086 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4b, 0x73, 0xdb, 0xd6,
-	0x15, 0x26, 0xc4, 0xf7, 0x01, 0x29, 0x79, 0x30, 0x5e, 0xa0, 0x6c, 0x47, 0x72, 0x31, 0x6d, 0x22,
-	0xcb, 0x29, 0x19, 0x25, 0x8b, 0xc6, 0x69, 0xdd, 0x38, 0xa4, 0x25, 0x51, 0x1d, 0xcb, 0x91, 0x11,
-	0xdb, 0x93, 0x26, 0xd3, 0xa4, 0x10, 0x79, 0x23, 0xb3, 0x26, 0x09, 0x04, 0x0f, 0x46, 0xca, 0xaf,
-	0xf0, 0xae, 0x8f, 0xe9, 0xd4, 0xee, 0x6b, 0x3a, 0x5d, 0x74, 0xe9, 0x7f, 0xd0, 0x99, 0x7a, 0xe9,
-	0x65, 0x57, 0x72, 0x47, 0xde, 0xf7, 0x07, 0x78, 0xd3, 0xce, 0x7d, 0x00, 0xbc, 0x80, 0x01, 0xe1,
-	0xc2, 0x26, 0xcd, 0xe9, 0x4a, 0x84, 0x78, 0xcf, 0xbd, 0x1f, 0xbe, 0x7b, 0xcf, 0x39, 0xdf, 0x39,
-	0xbc, 0x70, 0x6e, 0x64, 0x58, 0xad, 0xc9, 0x66, 0x6b, 0x64, 0x58, 0x4d, 0xcb, 0x36, 0x5d, 0x53,
-	0xf9, 0xb6, 0xe1, 0x9a, 0xa3, 0xc1, 0x11, 0x7d, 0xea, 0x99, 0x43, 0xa7, 0x69, 0x3b, 0xa3, 0x26,
-	0xfe, 0x7e, 0xb2, 0xd9, 0x38, 0x37, 0xd9, 0x6c, 0xdd, 0x45, 0x46, 0x1f, 0xd9, 0x0e, 0x1d, 0xd0,
-	0x58, 0x3d, 0x34, 0xcd, 0xc3, 0x21, 0x6a, 0x91, 0xa7, 0x03, 0xef, 0xcb, 0x56, 0xdf, 0xb3, 0x0d,
-	0x77, 0x60, 0x8e, 0xd9, 0xf7, 0x6b, 0xd1, 0xef, 0xdd, 0xc1, 0x08, 0x39, 0xae, 0x31, 0x62, 0xeb,
-	0x35, 0xce, 0x1f, 0x9a, 0x87, 0x26, 0xf9, 0xd8, 0xc2, 0x9f, 0xe8, 0x7f, 0xb5, 0xdf, 0x48, 0x20,
-	0x7f, 0x3c, 0xf8, 0x06, 0xe9, 0xe8, 0x2b, 0x0f, 0x39, 0xae, 0xb2, 0x0d, 0x65, 0xb6, 0xae, 0x2a,
-	0x5d, 0x90, 0xd6, 0xe5, 0x77, 0xde, 0x6a, 0xc6, 0xe2, 0x9c, 0x6c, 0x36, 0x6f, 0x7a, 0xc8, 0x3e,
-	0x66, 0x76, 0x5d, 0x6a, 0xa3, 0xfb, 0xc6, 0x4a, 0x1b, 0x8a, 0x83, 0xb1, 0xe5, 0xb9, 0xea, 0x12,
-	0x99, 0xe5, 0x8d, 0xe6, 0x19, 0x6f, 0xdb, 0xc4, 0x00, 0x76, 0xf1, 0xe8, 0x76, 0xe1, 0xc9, 0xc9,
-	0x9a, 0xa4, 0x53, 0x53, 0xed, 0xf7, 0x12, 0xd4, 0x28, 0x36, 0xc7, 0x32, 0xc7, 0x0e, 0x52, 0x76,
-	0xa2, 0xe0, 0x7e, 0x90, 0x06, 0x8e, 0x1a, 0xbe, 0x80, 0x6e, 0x0b, 0x4a, 0xa6, 0xe7, 0x4e, 0xe1,
-	0xbd, 0x99, 0x0a, 0xef, 0x23, 0x32, 0x9c, 0xe1, 0x63, 0xc6, 0xda, 0x6f, 0x25, 0x80, 0x7d, 0xcf,
-	0xf5, 0xb9, 0xdb, 0x8d, 0xc2, 0x6b, 0x25, 0xc2, 0xdb, 0xb7, 0x4d, 0xcb, 0x74, 0x8c, 0x61, 0x12,
-	0x7d, 0x1f, 0x86, 0xe9, 0xfb, 0xfe, 0x99, 0xf8, 0xf6, 0x3d, 0x37, 0x86, 0xbd, 0x07, 0x12, 0xc8,
-	0x04, 0x1c, 0x23, 0xef, 0xa7, 0x51, 0x74, 0x6f, 0x0b, 0xa0, 0x4b, 0xe0, 0xef, 0x5a, 0x84, 0xbf,
-	0x37, 0xd2, 0xf0, 0xc5, 0xd2, 0xf7, 0x50, 0x82, 0xfa, 0xee, 0xd8, 0x41, 0xf6, 0x3c, 0x18, 0xbc,
-	0x16, 0x66, 0x70, 0xfd, 0x4c, 0x84, 0x14, 0x45, 0x0c, 0x89, 0x7f, 0x91, 0x60, 0xd9, 0x87, 0x38,
-	0x07, 0x1e, 0x77, 0x22, 0x3c, 0x5e, 0x14, 0x40, 0x99, 0x48, 0xe5, 0x6d, 0xab, 0x6f, 0xb8, 0x68,
-	0xd1, 0x54, 0x52, 0x14, 0x09, 0x54, 0xfa, 0x10, 0x17, 0x4e, 0x25, 0x05, 0x12, 0x4b, 0xe5, 0xaf,
-	0x24, 0x80, 0x1d, 0xe4, 0xce, 0x3a, 0x20, 0x66, 0xf2, 0xe8, 0x1d, 0x14, 0x77, 0x18, 0x7f, 0x27,
-	0x81, 0x4c, 0x90, 0xcd, 0x3a, 0x1c, 0x66, 0x73, 0xe7, 0x1d, 0x94, 0x7c, 0x06, 0x75, 0x34, 0x32,
-	0x27, 0x0b, 0x3f, 0x83, 0x14, 0x45, 0xc2, 0x19, 0xf4, 0x21, 0x2e, 0xfc, 0x0c, 0x52, 0x20, 0xb1,
-	0x54, 0xe2, 0xcc, 0xd7, 0x19, 0x22, 0xc3, 0x9e, 0x03, 0x93, 0x9d, 0x30, 0x93, 0x67, 0xa7, 0x3e,
-	0x02, 0x22, 0x86, 0xc8, 0x3f, 0x49, 0x50, 0x67, 0x00, 0xe7, 0xc0, 0xe3, 0x76, 0x84, 0xc7, 0xf5,
-	0x74, 0x8c, 0xb1, 0x34, 0x62, 0x87, 0xb9, 0x6e, 0xf6, 0xee, 0xcd, 0x81, 0xc5, 0x4c, 0xfa, 0x06,
-	0x63, 0x88, 0x21, 0xf1, 0x0f, 0x12, 0xd4, 0x28, 0xbc, 0x39, 0x70, 0x98, 0x4d, 0xe2, 0x60, 0x18,
-	0xc9, 0x89, 0x65, 0x3c, 0x9c, 0x0f, 0x89, 0xd9, 0x12, 0x0b, 0x41, 0x91, 0x94, 0x58, 0x18, 0xc4,
-	0xc5, 0x27, 0x16, 0x02, 0x24, 0x96, 0xca, 0x3f, 0x4a, 0xb0, 0xbc, 0x6f, 0x23, 0xcb, 0xb0, 0xe7,
-	0x11, 0x20, 0xb7, 0xc2, 0x5c, 0x9e, 0x8d, 0x92, 0xc1, 0x88, 0x21, 0xf3, 0xaf, 0x12, 0xac, 0x04,
-	0x20, 0xe7, 0xc0, 0x66, 0x37, 0xc2, 0xe6, 0x86, 0x08, 0xce, 0xc4, 0x93, 0xd9, 0x31, 0x47, 0xa3,
-	0xc1, 0xc2, 0xd5, 0x23, 0x45, 0x91, 0x70, 0x32, 0x7d, 0x88, 0x0b, 0x3f, 0x99, 0x14, 0x48, 0x62,
-	0xba, 0xf9, 0xf0, 0xc0, 0x9c, 0x8b, 0x0e, 0xcf, 0x94, 0x6e, 0x08, 0x88, 0x84, 0x74, 0xc3, 0x00,
-	0x2e, 0x3c, 0xdd, 0x10, 0x1c, 0xc9, 0x34, 0x5a, 0xd6, 0xf0, 0x78, 0xe1, 0x34, 0x62, 0x10, 0x49,
-	0x34, 0x52, 0x80, 0x8b, 0xa7, 0x11, 0xe3, 0x88, 0xa5, 0xf1, 0x81, 0x04, 0xcb, 0x5b, 0x63, 0xd7,
-	0x1e, 0x20, 0x67, 0xd6, 0x22, 0x3c, 0x53, 0x90, 0x64, 0x18, 0x62, 0x78, 0xfc, 0xb3, 0x04, 0x2b,
-	0x01, 0xc2, 0x59, 0x8b, 0xf1, 0x6c, 0x11, 0x92, 0xc1, 0x48, 0x8c, 0x90, 0x5b, 0x13, 0x34, 0x76,
-	0x9d, 0x45, 0x47, 0x48, 0x8a, 0x22, 0x21, 0x42, 0xfa, 0x10, 0x17, 0x1e, 0x21, 0x29, 0x90, 0x58,
-	0x2a, 0xc7, 0x20, 0xef, 0x19, 0xd6, 0xf5, 0x81, 0xe3, 0xa2, 0x31, 0xb2, 0x95, 0x3d, 0xfc, 0xf2,
-	0x7d, 0x74, 0x44, 0x10, 0x16, 0xda, 0x3f, 0x7c, 0x7e, 0xb2, 0xf6, 0xee, 0xe1, 0xc0, 0xbd, 0xeb,
-	0x1d, 0x34, 0x7b, 0xe6, 0xa8, 0x45, 0x17, 0xf1, 0xff, 0x04, 0x6b, 0xb5, 0x6c, 0x67, 0xd4, 0x32,
-	0xac, 0x41, 0x8b, 0xd4, 0xf2, 0x7d, 0x74, 0xa4, 0xd3, 0x59, 0x94, 0x73, 0x90, 0xbf, 0x87, 0x8e,
-	0x09, 0xc6, 0xaa, 0x8e, 0x3f, 0x6a, 0x3f, 0x83, 0xca, 0x9e, 0x61, 0xe1, 0xcd, 0x3d, 0xf6, 0xbf,
-	0x95, 0x82, 0x6f, 0x95, 0x1f, 0x41, 0x71, 0x62, 0x0c, 0x3d, 0x24, 0x54, 0x4b, 0xee, 0x19, 0xd6,
-	0x1d, 0x3c, 0x58, 0xa7, 0x36, 0xda, 0xdf, 0x24, 0x32, 0x37, 0xf9, 0x9f, 0x72, 0xde, 0x9f, 0x09,
-	0xcf, 0x5e, 0x63, 0x43, 0xa6, 0xaf, 0xb7, 0x34, 0x93, 0xd7, 0x7b, 0x0f, 0x4a, 0xe8, 0xc8, 0x1a,
-	0xd8, 0x48, 0xcd, 0x13, 0xbc, 0x8d, 0x26, 0xed, 0x55, 0x36, 0xfd, 0x5e, 0x65, 0xf3, 0x96, 0xdf,
-	0xab, 0x6c, 0x17, 0xee, 0x3f, 0xc5, 0xb4, 0xd3, 0xf1, 0xda, 0x7f, 0xca, 0x04, 0x2b, 0x39, 0x38,
-	0xca, 0x8f, 0xa1, 0xe0, 0x0c, 0xbe, 0x41, 0xec, 0x54, 0x08, 0x76, 0x14, 0xbb, 0x39, 0x9d, 0x58,
-	0x29, 0x97, 0x21, 0x9f, 0xb5, 0x9f, 0xd6, 0xcd, 0xe9, 0xd8, 0x46, 0x69, 0x43, 0x69, 0x40, 0x5a,
-	0x2f, 0x0c, 0xbf, 0x70, 0x2f, 0xa9, 0x9b, 0xd3, 0x99, 0x25, 0x9e, 0xc3, 0x23, 0x3d, 0x07, 0xb5,
-	0x90, 0xad, 0x89, 0x82, 0xe7, 0xa0, 0x96, 0xf8, 0x15, 0x0e, 0x91, 0xab, 0x16, 0x33, 0x34, 0x10,
-	0xf0, 0x2b, 0x1c, 0x22, 0xb2, 0xbc, 0x4d, 0xca, 0x4d, 0xb5, 0x94, 0xad, 0x7e, 0xc6, 0xcb, 0x53,
-	0x4b, 0xe5, 0x03, 0x28, 0xf6, 0x70, 0xa9, 0xa5, 0x96, 0x33, 0x15, 0x8e, 0xdd, 0x9c, 0x4e, 0xed,
-	0xf0, 0x06, 0x62, 0x71, 0xac, 0x56, 0xb2, 0x94, 0x4c, 0x78, 0x03, 0xb1, 0x15, 0x61, 0x90, 0x88,
-	0x6b, 0xb5, 0x9a, 0xad, 0x5a, 0x20, 0x0c, 0x92, 0x47, 0x65, 0x0b, 0xca, 0x88, 0x06, 0x4c, 0x15,
-	0x32, 0x66, 0x80, 0x6e, 0x4e, 0xf7, 0x6d, 0x31, 0x14, 0x44, 0x62, 0x85, 0x2a, 0x67, 0x0b, 0x7e,
-	0x18, 0x0a, 0xb5, 0xc4, 0x50, 0x2c, 0xaa, 0x6e, 0xd5, 0x5a, 0x46, 0xc5, 0x8e, 0xa1, 0x30, 0x5b,
-	0x0c, 0xa5, 0x47, 0x84, 0x9d, 0x5a, 0xcf, 0xa6, 0x54, 0x31, 0x14, 0x6a, 0x89, 0x37, 0xd6, 0xc0,
-	0xa2, 0x46, 0x5d, 0xce, 0x24, 0xd1, 0xf0, 0xc6, 0x12, 0x3b, 0x32, 0x01, 0x4e, 0xe7, 0xea, 0x4a,
-	0x26, 0x71, 0x42, 0x26, 0xc0, 0x4f, 0xed, 0x32, 0x4b, 0x26, 0xda, 0x7f, 0xcb, 0x50, 0xdd, 0x33,
-	0x2c, 0x1a, 0x83, 0x95, 0x2b, 0x21, 0x8f, 0x17, 0x6d, 0xd2, 0x07, 0x2e, 0xff, 0x3e, 0xef, 0xf2,
-	0x82, 0x2d, 0x6a, 0xdf, 0xe7, 0x3b, 0x11, 0x9f, 0x17, 0xef, 0xcc, 0x72, 0x4e, 0xdf, 0x89, 0x38,
-	0xbd, 0x78, 0x4f, 0x92, 0xf3, 0xfa, 0xf7, 0x79, 0xaf, 0x17, 0xec, 0xcc, 0xf9, 0x6e, 0xdf, 0x89,
-	0xb8, 0xbd, 0x78, 0x43, 0x8a, 0xf3, 0xfb, 0xab, 0x61, 0xbf, 0x17, 0x6e, 0xc6, 0x4c, 0x1d, 0xff,
-	0x4a, 0xc8, 0xf1, 0x45, 0x3b, 0x11, 0x81, 0xe7, 0x77, 0x22, 0x9e, 0x2f, 0x5e, 0x81, 0x73, 0xae,
-	0xbf, 0x1d, 0x75, 0xfd, 0x0c, 0xba, 0x8a, 0xf7, 0xfd, 0x4e, 0xc4, 0xf7, 0xc5, 0x25, 0x05, 0xe7,
-	0xfc, 0xdb, 0x51, 0xe7, 0xcf, 0x50, 0x06, 0xf3, 0xde, 0xdf, 0x89, 0x78, 0xbf, 0x78, 0x05, 0xc8,
-	0xb9, 0xff, 0xd5, 0xb0, 0xfb, 0x0b, 0x57, 0x3f, 0x53, 0xff, 0xbf, 0x1a, 0xf6, 0x7f, 0x61, 0xe1,
-	0x3f, 0x0d, 0x00, 0x15, 0x5f, 0xa8, 0x69, 0x32, 0x54, 0x83, 0xe4, 0xad, 0x5d, 0x00, 0x98, 0xfa,
-	0xb5, 0xa2, 0x70, 0xe1, 0xa0, 0x4e, 0x7d, 0x5c, 0xfb, 0x87, 0x04, 0x15, 0x3f, 0x5f, 0xc7, 0x28,
-	0xa5, 0xf3, 0xbc, 0x52, 0x0a, 0xf4, 0xcd, 0x7b, 0x90, 0x77, 0xdd, 0x21, 0xf3, 0xec, 0x6f, 0xbd,
-	0xa0, 0x46, 0xae, 0xb1, 0x5f, 0x56, 0xdb, 0xf2, 0xe9, 0xc9, 0x5a, 0xfe, 0xd6, 0xad, 0xeb, 0xbf,
-	0xc6, 0x9a, 0x04, 0x9b, 0x28, 0x77, 0x00, 0x2c, 0x1b, 0x4d, 0xbe, 0xa0, 0xf2, 0xa8, 0xf0, 0x6a,
-	0xf2, 0xa8, 0x8a, 0xa7, 0x22, 0x1f, 0x71, 0x45, 0x51, 0x0d, 0x62, 0xd0, 0xac, 0xe5, 0x65, 0x97,
-	0x81, 0xe6, 0x35, 0x63, 0x5a, 0x3c, 0xeb, 0xa3, 0x23, 0xd4, 0xa7, 0xba, 0x91, 0xc0, 0x24, 0x1f,
-	0x35, 0x13, 0x64, 0x4e, 0xde, 0xcc, 0x9f, 0x6f, 0xed, 0xe7, 0x50, 0xe3, 0x63, 0xeb, 0x8c, 0x99,
-	0xd1, 0xfe, 0x29, 0x81, 0xcc, 0x69, 0xad, 0xff, 0xe3, 0x03, 0xf4, 0x77, 0x09, 0x6a, 0x7c, 0x02,
-	0x99, 0xf5, 0x19, 0xba, 0xf1, 0x4a, 0x67, 0xa8, 0x5d, 0x78, 0x7c, 0xb2, 0x96, 0xe3, 0x4f, 0xd2,
-	0x77, 0xa0, 0xe2, 0x6b, 0xd4, 0x17, 0x59, 0xd7, 0x74, 0xa8, 0x06, 0xb9, 0x0c, 0x17, 0xed, 0xd3,
-	0x1a, 0xe5, 0x25, 0x56, 0x65, 0x75, 0xcf, 0xd7, 0x20, 0x73, 0xba, 0x36, 0x66, 0xab, 0xc3, 0x5b,
-	0xb3, 0x34, 0xb3, 0xad, 0xb9, 0x0d, 0x35, 0x3e, 0xb3, 0xce, 0xea, 0x7d, 0x6a, 0x00, 0x53, 0x91,
-	0xad, 0xd5, 0x41, 0xe6, 0x52, 0xaf, 0xf6, 0x29, 0x54, 0x03, 0x05, 0x8d, 0xe3, 0xe6, 0x3d, 0x74,
-	0x8c, 0xcb, 0xe9, 0xfc, 0x7a, 0x55, 0x27, 0x9f, 0x95, 0xcb, 0x50, 0x76, 0x07, 0x23, 0x64, 0x06,
-	0xfa, 0xe8, 0x8c, 0x53, 0x5c, 0x20, 0xc7, 0xd7, 0x1f, 0x8f, 0x17, 0x9e, 0x26, 0x69, 0xed, 0xbb,
-	0x20, 0x73, 0x5a, 0x3b, 0x6e, 0x2d, 0x6d, 0x19, 0x6a, 0x7c, 0x52, 0xd6, 0x1e, 0x49, 0x50, 0xe3,
-	0xf5, 0xac, 0xf2, 0x0b, 0xa8, 0x39, 0xe8, 0x2b, 0x0f, 0x8d, 0x7b, 0xe8, 0x8b, 0xb1, 0x37, 0x62,
-	0x47, 0xf6, 0xca, 0xf3, 0x93, 0xb5, 0xcb, 0xd9, 0xb8, 0xff, 0x98, 0xcd, 0x72, 0xc3, 0x1b, 0xe9,
-	0xb2, 0x33, 0x7d, 0xa0, 0x72, 0xce, 0xf2, 0x5c, 0x47, 0x5d, 0xba, 0x90, 0x17, 0x29, 0x99, 0x59,
-	0xaf, 0x82, 0x10, 0xce, 0x4c, 0xb5, 0x15, 0xa8, 0x87, 0x32, 0x31, 0x0e, 0x87, 0x9c, 0xa2, 0x9e,
-	0xff, 0x6b, 0x68, 0x77, 0xa0, 0xc6, 0x27, 0x71, 0xac, 0x23, 0x68, 0xda, 0xa4, 0x84, 0xa7, 0xe9,
-	0xc3, 0x40, 0x59, 0xb3, 0x17, 0xf3, 0x8d, 0xb5, 0x31, 0xc0, 0x54, 0xd7, 0xbf, 0x86, 0xf7, 0xa8,
-	0x83, 0xcc, 0x09, 0x09, 0xed, 0x26, 0xc0, 0xb4, 0x2a, 0xe0, 0xf6, 0x4a, 0x7a, 0xf9, 0xbd, 0xba,
-	0x0d, 0x32, 0x27, 0x34, 0x66, 0x46, 0xd4, 0xf7, 0xa0, 0xc6, 0x17, 0x85, 0x38, 0x3d, 0x7c, 0x6d,
-	0xb8, 0xbd, 0xbb, 0x84, 0xa3, 0x8a, 0x4e, 0x1f, 0xb4, 0x8f, 0xa0, 0x1e, 0xd2, 0x8f, 0xca, 0x4f,
-	0xa0, 0x88, 0xf5, 0xe3, 0x31, 0x73, 0x79, 0x2d, 0x55, 0x7a, 0x1e, 0xfb, 0xbe, 0x4e, 0xcc, 0xb4,
-	0x35, 0x90, 0xb9, 0x2a, 0x32, 0x26, 0x60, 0xde, 0x80, 0x1a, 0x2f, 0x35, 0xc9, 0x82, 0xf8, 0x59,
-	0x6c, 0x41, 0x3c, 0x32, 0x58, 0x10, 0x3f, 0x68, 0x4f, 0x0b, 0x50, 0x24, 0xff, 0x8e, 0x89, 0x93,
-	0xbb, 0x50, 0xa1, 0xf5, 0x0d, 0xea, 0xb3, 0xd8, 0x71, 0x29, 0x7d, 0x7a, 0x56, 0x22, 0xa1, 0x7e,
-	0x37, 0xa7, 0x07, 0xe6, 0x78, 0x5b, 0x68, 0x95, 0xd3, 0x67, 0xb9, 0x74, 0x43, 0x60, 0x26, 0x9a,
-	0xe6, 0xf0, 0x44, 0xbe, 0x31, 0x9e, 0x87, 0x16, 0x2b, 0x7d, 0x56, 0x69, 0x89, 0xcc, 0x43, 0x83,
-	0x32, 0x99, 0x87, 0x19, 0x37, 0x6e, 0x42, 0xc5, 0xc7, 0x39, 0xa3, 0x30, 0xdd, 0x78, 0x28, 0x41,
-	0x99, 0x21, 0x9e, 0xd1, 0x94, 0xb3, 0xce, 0xc5, 0x8d, 0x5f, 0x42, 0x99, 0x71, 0x31, 0x2b, 0x84,
-	0x2a, 0x94, 0x69, 0x07, 0x8f, 0x9e, 0x90, 0x8a, 0xee, 0x3f, 0xe2, 0x4a, 0x9f, 0x9e, 0xb0, 0x4f,
-	0xa1, 0x98, 0xd4, 0xde, 0xfc, 0x20, 0xdc, 0xde, 0xcc, 0x20, 0x55, 0x59, 0x6a, 0x74, 0xb0, 0x6a,
-	0x9c, 0xfe, 0xfb, 0xb5, 0x74, 0x39, 0xdf, 0x79, 0x24, 0x43, 0x7e, 0xcf, 0xb0, 0x94, 0xcf, 0xa0,
-	0x80, 0x6b, 0x16, 0x65, 0x3d, 0xb5, 0x5d, 0xc1, 0x5a, 0xeb, 0x8d, 0x8b, 0x02, 0x23, 0x59, 0x73,
-	0xfc, 0x13, 0xc8, 0xef, 0x7b, 0xae, 0xf2, 0x66, 0x5a, 0x33, 0xc3, 0x9f, 0x7a, 0x3d, 0x7d, 0x20,
-	0x9b, 0xd9, 0x80, 0x12, 0x3d, 0xfa, 0xca, 0x86, 0x40, 0xab, 0xc3, 0x9f, 0xff, 0x92, 0xd0, 0xd8,
-	0xe9, 0x12, 0xd4, 0x13, 0x52, 0x96, 0x08, 0x5d, 0x64, 0x4b, 0x59, 0x22, 0x72, 0xa3, 0xec, 0x13,
-	0xc8, 0xef, 0xa0, 0x34, 0x7e, 0xa6, 0xb7, 0xbb, 0x52, 0xf8, 0xe1, 0x2f, 0x5b, 0x19, 0x50, 0xa2,
-	0x4e, 0x92, 0x02, 0x3e, 0x74, 0x03, 0x2a, 0x05, 0x7c, 0xe4, 0x2a, 0xd2, 0xe7, 0x50, 0x24, 0x1a,
-	0x4e, 0xb9, 0x98, 0xde, 0x62, 0xf1, 0x17, 0xd8, 0x10, 0x19, 0xca, 0xe6, 0xff, 0x0c, 0x0a, 0x58,
-	0xb8, 0xa5, 0x9c, 0x4c, 0xee, 0xc2, 0x4c, 0xca, 0xc9, 0x0c, 0xdd, 0x5d, 0xc1, 0x9b, 0x4b, 0x3b,
-	0x2d, 0x1b, 0x02, 0xed, 0x19, 0xc1, 0xcd, 0x0d, 0xdf, 0xea, 0xe8, 0x41, 0x89, 0x26, 0x39, 0x45,
-	0x20, 0xbc, 0x3b, 0x62, 0x4b, 0x84, 0x7f, 0x7c, 0x7a, 0x5b, 0x52, 0xbe, 0x84, 0x32, 0xcb, 0xdd,
-	0xca, 0x25, 0x91, 0x0e, 0x91, 0xbf, 0xcc, 0x5b, 0x62, 0x83, 0x83, 0x75, 0xfa, 0x50, 0x66, 0x62,
-	0x32, 0x65, 0x9d, 0xf0, 0x95, 0x91, 0x94, 0x75, 0xa2, 0x57, 0x37, 0x0c, 0x28, 0x51, 0xc1, 0x98,
-	0x42, 0x59, 0xe8, 0x22, 0x45, 0x0a, 0x65, 0x91, 0x1b, 0x0d, 0x9f, 0x43, 0x91, 0x68, 0xb9, 0x94,
-	0x53, 0xcb, 0xdf, 0x2f, 0x48, 0x39, 0xb5, 0xe1, 0x5f, 0xfa, 0xf1, 0xfc, 0x58, 0xc9, 0xa5, 0xcd,
-	0xcf, 0xfd, 0xf0, 0x9e, 0x36, 0x3f, 0xff, 0x13, 0x78, 0x5b, 0x7d, 0x7c, 0xba, 0x2a, 0x3d, 0x39,
-	0x5d, 0x95, 0xfe, 0x7d, 0xba, 0x2a, 0xdd, 0x7f, 0xb6, 0x9a, 0x7b, 0xf2, 0x6c, 0x35, 0xf7, 0xaf,
-	0x67, 0xab, 0xb9, 0x83, 0x12, 0xb1, 0x7e, 0xf7, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x15,
-	0xe9, 0xc1, 0xbc, 0x2f, 0x00, 0x00,
+func (m *AbortInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MapClient is the client API for Map service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MapClient interface {
-	// Size returns the size of the map
-	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
-	// Put puts an entry into the map
-	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
-	// Insert inserts an entry into the map
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Get gets the entry for a key
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error)
-	// Entries lists all entries in the map
-	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error)
-	// Prepare prepares a transaction
-	Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (*PrepareResponse, error)
-	// Commit commits a transaction
-	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
-	// Abort aborts a transaction
-	Abort(ctx context.Context, in *AbortRequest, opts ...grpc.CallOption) (*AbortResponse, error)
-	// Apply prepares and commits a transaction in a single request
-	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
+func (m *AbortInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbortInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type mapClient struct {
-	cc *grpc.ClientConn
+func (m *AbortInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortInput.Merge(m, src)
 }
-
-func NewMapClient(cc *grpc.ClientConn) MapClient {
-	return &mapClient{cc}
+func (m *AbortInput) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *mapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
-	out := new(SizeResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Size", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AbortInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortInput.DiscardUnknown(m)
 }
 
-func (c *mapClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
-	out := new(PutResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_AbortInput proto.InternalMessageInfo
 
-func (c *mapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
-	out := new(InsertResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Insert", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AbortInput) GetSequenceNum() github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum {
+	if m != nil {
+		return m.SequenceNum
 	}
-	return out, nil
+	return 0
 }
 
-func (c *mapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AbortOutput struct {
 }
 
-func (c *mapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AbortOutput) Reset()         { *m = AbortOutput{} }
+func (m *AbortOutput) String() string { return proto.CompactTextString(m) }
+func (*AbortOutput) ProtoMessage()    {}
+func (*AbortOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{70}
 }
-
-func (c *mapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
-	out := new(RemoveResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AbortOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbortOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbortOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AbortOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortOutput.Merge(m, src)
+}
+func (m *AbortOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbortOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortOutput.DiscardUnknown(m)
 }
 
-func (c *mapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
-	out := new(ClearResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Clear", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_AbortOutput proto.InternalMessageInfo
+
+type ApplyInput struct {
+	Inputs []MapInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs"`
+	Fence  *Fence     `protobuf:"bytes,2,opt,name=fence,proto3" json:"fence,omitempty"`
 }
 
-func (c *mapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
-	out := new(LockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Lock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplyInput) Reset()         { *m = ApplyInput{} }
+func (m *ApplyInput) String() string { return proto.CompactTextString(m) }
+func (*ApplyInput) ProtoMessage()    {}
+func (*ApplyInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{71}
+}
+func (m *ApplyInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ApplyInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyInput.Merge(m, src)
+}
+func (m *ApplyInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyInput.DiscardUnknown(m)
 }
 
-func (c *mapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
-	out := new(UnlockResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Unlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplyInput proto.InternalMessageInfo
+
+func (m *ApplyInput) GetInputs() []MapInput {
+	if m != nil {
+		return m.Inputs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *mapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[0], "/atomix.protocols.rsm.map.v1.Map/Events", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &mapEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ApplyInput) GetFence() *Fence {
+	if m != nil {
+		return m.Fence
 	}
-	return x, nil
+	return nil
 }
 
-type Map_EventsClient interface {
-	Recv() (*EventsResponse, error)
-	grpc.ClientStream
+type ApplyOutput struct {
+	Outputs []MapOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs"`
 }
 
-type mapEventsClient struct {
-	grpc.ClientStream
+func (m *ApplyOutput) Reset()         { *m = ApplyOutput{} }
+func (m *ApplyOutput) String() string { return proto.CompactTextString(m) }
+func (*ApplyOutput) ProtoMessage()    {}
+func (*ApplyOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{72}
 }
-
-func (x *mapEventsClient) Recv() (*EventsResponse, error) {
-	m := new(EventsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ApplyOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *ApplyOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyOutput.Merge(m, src)
+}
+func (m *ApplyOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyOutput.DiscardUnknown(m)
 }
 
-func (c *mapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[1], "/atomix.protocols.rsm.map.v1.Map/Entries", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &mapEntriesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ApplyOutput proto.InternalMessageInfo
+
+func (m *ApplyOutput) GetOutputs() []MapOutput {
+	if m != nil {
+		return m.Outputs
 	}
-	return x, nil
+	return nil
 }
 
-type Map_EntriesClient interface {
-	Recv() (*EntriesResponse, error)
-	grpc.ClientStream
+type GetAllInput struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-type mapEntriesClient struct {
-	grpc.ClientStream
+func (m *GetAllInput) Reset()         { *m = GetAllInput{} }
+func (m *GetAllInput) String() string { return proto.CompactTextString(m) }
+func (*GetAllInput) ProtoMessage()    {}
+func (*GetAllInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{73}
 }
-
-func (x *mapEntriesClient) Recv() (*EntriesResponse, error) {
-	m := new(EntriesResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetAllInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *GetAllInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllInput.Merge(m, src)
+}
+func (m *GetAllInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllInput.DiscardUnknown(m)
 }
 
-func (c *mapClient) Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (*PrepareResponse, error) {
-	out := new(PrepareResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Prepare", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetAllInput proto.InternalMessageInfo
+
+func (m *GetAllInput) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *mapClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
-	out := new(CommitResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Commit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetAllOutput struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (c *mapClient) Abort(ctx context.Context, in *AbortRequest, opts ...grpc.CallOption) (*AbortResponse, error) {
-	out := new(AbortResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Abort", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAllOutput) Reset()         { *m = GetAllOutput{} }
+func (m *GetAllOutput) String() string { return proto.CompactTextString(m) }
+func (*GetAllOutput) ProtoMessage()    {}
+func (*GetAllOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{74}
+}
+func (m *GetAllOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetAllOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllOutput.Merge(m, src)
+}
+func (m *GetAllOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllOutput.DiscardUnknown(m)
 }
 
-func (c *mapClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
-	out := new(ApplyResponse)
-	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Apply", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetAllOutput proto.InternalMessageInfo
+
+func (m *GetAllOutput) GetEntries() []Entry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-// MapServer is the server API for Map service.
-type MapServer interface {
-	// Size returns the size of the map
-	Size(context.Context, *SizeRequest) (*SizeResponse, error)
-	// Put puts an entry into the map
-	Put(context.Context, *PutRequest) (*PutResponse, error)
-	// Insert inserts an entry into the map
-	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
-	// Update updates an entry in the map
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Get gets the entry for a key
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// Remove removes an entry from the map
-	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
-	// Clear removes all entries from the map
-	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
-	// Lock locks a key in the map
-	Lock(context.Context, *LockRequest) (*LockResponse, error)
-	// Unlock unlocks a key in the map
-	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
-	// Events listens for change events
-	Events(*EventsRequest, Map_EventsServer) error
-	// Entries lists all entries in the map
-	Entries(*EntriesRequest, Map_EntriesServer) error
-	// Prepare prepares a transaction
-	Prepare(context.Context, *PrepareRequest) (*PrepareResponse, error)
-	// Commit commits a transaction
-	Commit(context.Context, *CommitRequest) (*CommitResponse, error)
-	// Abort aborts a transaction
-	Abort(context.Context, *AbortRequest) (*AbortResponse, error)
-	// Apply prepares and commits a transaction in a single request
-	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
+type PutAllInput struct {
+	Inputs []PutInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs"`
 }
 
-// UnimplementedMapServer can be embedded to have forward compatible implementations.
-type UnimplementedMapServer struct {
-}
-
-func (*UnimplementedMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
-}
-func (*UnimplementedMapServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+func (m *PutAllInput) Reset()         { *m = PutAllInput{} }
+func (m *PutAllInput) String() string { return proto.CompactTextString(m) }
+func (*PutAllInput) ProtoMessage()    {}
+func (*PutAllInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{75}
 }
-func (*UnimplementedMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+func (m *PutAllInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (m *PutAllInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutAllInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *PutAllInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllInput.Merge(m, src)
 }
-func (*UnimplementedMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+func (m *PutAllInput) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+func (m *PutAllInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllInput.DiscardUnknown(m)
 }
-func (*UnimplementedMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+
+var xxx_messageInfo_PutAllInput proto.InternalMessageInfo
+
+func (m *PutAllInput) GetInputs() []PutInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
 }
-func (*UnimplementedMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+
+type PutAllOutput struct {
+	Outputs []PutOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs"`
 }
-func (*UnimplementedMapServer) Events(req *EventsRequest, srv Map_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+
+func (m *PutAllOutput) Reset()         { *m = PutAllOutput{} }
+func (m *PutAllOutput) String() string { return proto.CompactTextString(m) }
+func (*PutAllOutput) ProtoMessage()    {}
+func (*PutAllOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{76}
 }
-func (*UnimplementedMapServer) Entries(req *EntriesRequest, srv Map_EntriesServer) error {
-	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
+func (m *PutAllOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMapServer) Prepare(ctx context.Context, req *PrepareRequest) (*PrepareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prepare not implemented")
+func (m *PutAllOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutAllOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMapServer) Commit(ctx context.Context, req *CommitRequest) (*CommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+func (m *PutAllOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutAllOutput.Merge(m, src)
 }
-func (*UnimplementedMapServer) Abort(ctx context.Context, req *AbortRequest) (*AbortResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Abort not implemented")
+func (m *PutAllOutput) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMapServer) Apply(ctx context.Context, req *ApplyRequest) (*ApplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+func (m *PutAllOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutAllOutput.DiscardUnknown(m)
 }
 
-func RegisterMapServer(s *grpc.Server, srv MapServer) {
-	s.RegisterService(&_Map_serviceDesc, srv)
-}
+var xxx_messageInfo_PutAllOutput proto.InternalMessageInfo
 
-func _Map_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Size(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Size",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Size(ctx, req.(*SizeRequest))
+func (m *PutAllOutput) GetOutputs() []PutOutput {
+	if m != nil {
+		return m.Outputs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Put(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Put",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Put(ctx, req.(*PutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type RemoveAllInput struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func _Map_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Insert(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Insert",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Insert(ctx, req.(*InsertRequest))
+func (m *RemoveAllInput) Reset()         { *m = RemoveAllInput{} }
+func (m *RemoveAllInput) String() string { return proto.CompactTextString(m) }
+func (*RemoveAllInput) ProtoMessage()    {}
+func (*RemoveAllInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{77}
+}
+func (m *RemoveAllInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveAllInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveAllInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemoveAllInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAllInput.Merge(m, src)
+}
+func (m *RemoveAllInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveAllInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAllInput.DiscardUnknown(m)
 }
 
-func _Map_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Update(ctx, req.(*UpdateRequest))
+var xxx_messageInfo_RemoveAllInput proto.InternalMessageInfo
+
+func (m *RemoveAllInput) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Get(ctx, req.(*GetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type RemoveAllOutput struct {
+	Entries []Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func _Map_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Remove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Remove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Remove(ctx, req.(*RemoveRequest))
+func (m *RemoveAllOutput) Reset()         { *m = RemoveAllOutput{} }
+func (m *RemoveAllOutput) String() string { return proto.CompactTextString(m) }
+func (*RemoveAllOutput) ProtoMessage()    {}
+func (*RemoveAllOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{78}
+}
+func (m *RemoveAllOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveAllOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveAllOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemoveAllOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAllOutput.Merge(m, src)
+}
+func (m *RemoveAllOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveAllOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAllOutput.DiscardUnknown(m)
 }
 
-func _Map_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Clear(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Clear",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Clear(ctx, req.(*ClearRequest))
+var xxx_messageInfo_RemoveAllOutput proto.InternalMessageInfo
+
+func (m *RemoveAllOutput) GetEntries() []Entry {
+	if m != nil {
+		return m.Entries
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Lock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Lock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Lock(ctx, req.(*LockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ConfigureInput struct {
+	Indexes []Index `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes"`
 }
 
-func _Map_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MapServer).Unlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Unlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Unlock(ctx, req.(*UnlockRequest))
+func (m *ConfigureInput) Reset()         { *m = ConfigureInput{} }
+func (m *ConfigureInput) String() string { return proto.CompactTextString(m) }
+func (*ConfigureInput) ProtoMessage()    {}
+func (*ConfigureInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{79}
+}
+func (m *ConfigureInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigureInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigureInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ConfigureInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureInput.Merge(m, src)
+}
+func (m *ConfigureInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigureInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureInput.DiscardUnknown(m)
 }
 
-func _Map_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_ConfigureInput proto.InternalMessageInfo
+
+func (m *ConfigureInput) GetIndexes() []Index {
+	if m != nil {
+		return m.Indexes
 	}
-	return srv.(MapServer).Events(m, &mapEventsServer{stream})
+	return nil
 }
 
-type Map_EventsServer interface {
-	Send(*EventsResponse) error
-	grpc.ServerStream
+type ConfigureOutput struct {
 }
 
-type mapEventsServer struct {
-	grpc.ServerStream
+func (m *ConfigureOutput) Reset()         { *m = ConfigureOutput{} }
+func (m *ConfigureOutput) String() string { return proto.CompactTextString(m) }
+func (*ConfigureOutput) ProtoMessage()    {}
+func (*ConfigureOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{80}
+}
+func (m *ConfigureOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigureOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigureOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigureOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureOutput.Merge(m, src)
+}
+func (m *ConfigureOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigureOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureOutput.DiscardUnknown(m)
 }
 
-func (x *mapEventsServer) Send(m *EventsResponse) error {
-	return x.ServerStream.SendMsg(m)
+var xxx_messageInfo_ConfigureOutput proto.InternalMessageInfo
+
+type Index struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Extractor:
+	//	*Index_JsonPath
+	//	*Index_PrefixLength
+	Extractor isIndex_Extractor `protobuf_oneof:"extractor"`
 }
 
-func _Map_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EntriesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *Index) Reset()         { *m = Index{} }
+func (m *Index) String() string { return proto.CompactTextString(m) }
+func (*Index) ProtoMessage()    {}
+func (*Index) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{81}
+}
+func (m *Index) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Index) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Index.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(MapServer).Entries(m, &mapEntriesServer{stream})
 }
-
-type Map_EntriesServer interface {
-	Send(*EntriesResponse) error
-	grpc.ServerStream
+func (m *Index) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Index.Merge(m, src)
+}
+func (m *Index) XXX_Size() int {
+	return m.Size()
+}
+func (m *Index) XXX_DiscardUnknown() {
+	xxx_messageInfo_Index.DiscardUnknown(m)
 }
 
-type mapEntriesServer struct {
-	grpc.ServerStream
+var xxx_messageInfo_Index proto.InternalMessageInfo
+
+type isIndex_Extractor interface {
+	isIndex_Extractor()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (x *mapEntriesServer) Send(m *EntriesResponse) error {
-	return x.ServerStream.SendMsg(m)
+type Index_JsonPath struct {
+	JsonPath string `protobuf:"bytes,2,opt,name=json_path,json=jsonPath,proto3,oneof" json:"json_path,omitempty"`
+}
+type Index_PrefixLength struct {
+	PrefixLength uint32 `protobuf:"varint,3,opt,name=prefix_length,json=prefixLength,proto3,oneof" json:"prefix_length,omitempty"`
 }
 
-func _Map_Prepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PrepareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (*Index_JsonPath) isIndex_Extractor()     {}
+func (*Index_PrefixLength) isIndex_Extractor() {}
+
+func (m *Index) GetExtractor() isIndex_Extractor {
+	if m != nil {
+		return m.Extractor
 	}
-	if interceptor == nil {
-		return srv.(MapServer).Prepare(ctx, in)
+	return nil
+}
+
+func (m *Index) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Prepare",
+	return ""
+}
+
+func (m *Index) GetJsonPath() string {
+	if x, ok := m.GetExtractor().(*Index_JsonPath); ok {
+		return x.JsonPath
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Prepare(ctx, req.(*PrepareRequest))
+	return ""
+}
+
+func (m *Index) GetPrefixLength() uint32 {
+	if x, ok := m.GetExtractor().(*Index_PrefixLength); ok {
+		return x.PrefixLength
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Map_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Index) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Index_JsonPath)(nil),
+		(*Index_PrefixLength)(nil),
 	}
-	if interceptor == nil {
-		return srv.(MapServer).Commit(ctx, in)
+}
+
+type QueryInput struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// query is wrapped in a separate message since the input is embedded in the request
+	Query IndexQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query"`
+	Limit uint32     `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryInput) Reset()         { *m = QueryInput{} }
+func (m *QueryInput) String() string { return proto.CompactTextString(m) }
+func (*QueryInput) ProtoMessage()    {}
+func (*QueryInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{82}
+}
+func (m *QueryInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Commit",
+}
+func (m *QueryInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInput.Merge(m, src)
+}
+func (m *QueryInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInput proto.InternalMessageInfo
+
+func (m *QueryInput) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Commit(ctx, req.(*CommitRequest))
+	return ""
+}
+
+func (m *QueryInput) GetQuery() IndexQuery {
+	if m != nil {
+		return m.Query
 	}
-	return interceptor(ctx, in, info, handler)
+	return IndexQuery{}
 }
 
-func _Map_Abort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AbortRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryInput) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	if interceptor == nil {
-		return srv.(MapServer).Abort(ctx, in)
+	return 0
+}
+
+type IndexQuery struct {
+	// Types that are valid to be assigned to Query:
+	//	*IndexQuery_Equals
+	//	*IndexQuery_Range
+	Query isIndexQuery_Query `protobuf_oneof:"query"`
+}
+
+func (m *IndexQuery) Reset()         { *m = IndexQuery{} }
+func (m *IndexQuery) String() string { return proto.CompactTextString(m) }
+func (*IndexQuery) ProtoMessage()    {}
+func (*IndexQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{83}
+}
+func (m *IndexQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Abort",
+}
+func (m *IndexQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexQuery.Merge(m, src)
+}
+func (m *IndexQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexQuery proto.InternalMessageInfo
+
+type isIndexQuery_Query interface {
+	isIndexQuery_Query()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type IndexQuery_Equals struct {
+	Equals []byte `protobuf:"bytes,1,opt,name=equals,proto3,oneof" json:"equals,omitempty"`
+}
+type IndexQuery_Range struct {
+	Range *Range `protobuf:"bytes,2,opt,name=range,proto3,oneof" json:"range,omitempty"`
+}
+
+func (*IndexQuery_Equals) isIndexQuery_Query() {}
+func (*IndexQuery_Range) isIndexQuery_Query()  {}
+
+func (m *IndexQuery) GetQuery() isIndexQuery_Query {
+	if m != nil {
+		return m.Query
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Abort(ctx, req.(*AbortRequest))
+	return nil
+}
+
+func (m *IndexQuery) GetEquals() []byte {
+	if x, ok := m.GetQuery().(*IndexQuery_Equals); ok {
+		return x.Equals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Map_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *IndexQuery) GetRange() *Range {
+	if x, ok := m.GetQuery().(*IndexQuery_Range); ok {
+		return x.Range
 	}
-	if interceptor == nil {
-		return srv.(MapServer).Apply(ctx, in)
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IndexQuery) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IndexQuery_Equals)(nil),
+		(*IndexQuery_Range)(nil),
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Apply",
+}
+
+type QueryOutput struct {
+	Results []QueryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryOutput) Reset()         { *m = QueryOutput{} }
+func (m *QueryOutput) String() string { return proto.CompactTextString(m) }
+func (*QueryOutput) ProtoMessage()    {}
+func (*QueryOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{84}
+}
+func (m *QueryOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MapServer).Apply(ctx, req.(*ApplyRequest))
+}
+func (m *QueryOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutput.Merge(m, src)
+}
+func (m *QueryOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutput proto.InternalMessageInfo
+
+func (m *QueryOutput) GetResults() []QueryResult {
+	if m != nil {
+		return m.Results
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Map_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomix.protocols.rsm.map.v1.Map",
-	HandlerType: (*MapServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Size",
-			Handler:    _Map_Size_Handler,
-		},
-		{
-			MethodName: "Put",
-			Handler:    _Map_Put_Handler,
-		},
-		{
-			MethodName: "Insert",
-			Handler:    _Map_Insert_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _Map_Update_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _Map_Get_Handler,
-		},
-		{
-			MethodName: "Remove",
-			Handler:    _Map_Remove_Handler,
-		},
-		{
-			MethodName: "Clear",
-			Handler:    _Map_Clear_Handler,
-		},
-		{
-			MethodName: "Lock",
-			Handler:    _Map_Lock_Handler,
-		},
-		{
-			MethodName: "Unlock",
-			Handler:    _Map_Unlock_Handler,
-		},
-		{
-			MethodName: "Prepare",
-			Handler:    _Map_Prepare_Handler,
-		},
-		{
-			MethodName: "Commit",
-			Handler:    _Map_Commit_Handler,
-		},
-		{
-			MethodName: "Abort",
-			Handler:    _Map_Abort_Handler,
-		},
-		{
-			MethodName: "Apply",
-			Handler:    _Map_Apply_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Events",
-			Handler:       _Map_Events_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Entries",
-			Handler:       _Map_Entries_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "map/v1/map.proto",
+type QueryResult struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	// index_key is the encoded indexed value by which results are ordered
+	IndexKey []byte `protobuf:"bytes,2,opt,name=index_key,json=indexKey,proto3" json:"index_key,omitempty"`
 }
 
-func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryResult) Reset()         { *m = QueryResult{} }
+func (m *QueryResult) String() string { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()    {}
+func (*QueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{85}
 }
-
-func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SizeInput != nil {
-		{
-			size, err := m.SizeInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResult.Merge(m, src)
+}
+func (m *QueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResult proto.InternalMessageInfo
+
+func (m *QueryResult) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
 	}
-	return len(dAtA) - i, nil
+	return Entry{}
 }
 
-func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResult) GetIndexKey() []byte {
+	if m != nil {
+		return m.IndexKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Range struct {
+	Min *Bound `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max *Bound `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
 }
 
-func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SizeOutput != nil {
-		{
-			size, err := m.SizeOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Range) Reset()         { *m = Range{} }
+func (m *Range) String() string { return proto.CompactTextString(m) }
+func (*Range) ProtoMessage()    {}
+func (*Range) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{86}
+}
+func (m *Range) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Range) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Range.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Range) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Range.Merge(m, src)
+}
+func (m *Range) XXX_Size() int {
+	return m.Size()
+}
+func (m *Range) XXX_DiscardUnknown() {
+	xxx_messageInfo_Range.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Range proto.InternalMessageInfo
+
+func (m *Range) GetMin() *Bound {
+	if m != nil {
+		return m.Min
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Range) GetMax() *Bound {
+	if m != nil {
+		return m.Max
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Bound struct {
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PutInput != nil {
-		{
-			size, err := m.PutInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Bound) Reset()         { *m = Bound{} }
+func (m *Bound) String() string { return proto.CompactTextString(m) }
+func (*Bound) ProtoMessage()    {}
+func (*Bound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{87}
+}
+func (m *Bound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Bound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bound.Merge(m, src)
+}
+func (m *Bound) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bound.DiscardUnknown(m)
 }
 
-func (m *PutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Bound proto.InternalMessageInfo
+
+func (m *Bound) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EntriesInput struct {
+	Watch bool `protobuf:"varint,1,opt,name=watch,proto3" json:"watch,omitempty"`
+	// prefix limits the entries to keys beginning with the prefix
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// start_key is the inclusive lower bound of the keys to list
+	StartKey string `protobuf:"bytes,3,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	// end_key is the exclusive upper bound of the keys to list
+	EndKey string `protobuf:"bytes,4,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// limit is the maximum number of entries to list; zero indicates no limit
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// after is the key after which to resume listing
+	After string `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
 }
 
-func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PutOutput != nil {
-		{
-			size, err := m.PutOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *EntriesInput) Reset()         { *m = EntriesInput{} }
+func (m *EntriesInput) String() string { return proto.CompactTextString(m) }
+func (*EntriesInput) ProtoMessage()    {}
+func (*EntriesInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{88}
+}
+func (m *EntriesInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntriesInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntriesInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EntriesInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesInput.Merge(m, src)
+}
+func (m *EntriesInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntriesInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntriesInput proto.InternalMessageInfo
+
+func (m *EntriesInput) GetWatch() bool {
+	if m != nil {
+		return m.Watch
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EntriesInput) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EntriesInput) GetStartKey() string {
+	if m != nil {
+		return m.StartKey
+	}
+	return ""
 }
 
-func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InsertInput != nil {
-		{
-			size, err := m.InsertInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *EntriesInput) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EntriesInput) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EntriesInput) GetAfter() string {
+	if m != nil {
+		return m.After
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EntriesOutput struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
 }
 
-func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InsertOutput != nil {
-		{
-			size, err := m.InsertOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *EntriesOutput) Reset()         { *m = EntriesOutput{} }
+func (m *EntriesOutput) String() string { return proto.CompactTextString(m) }
+func (*EntriesOutput) ProtoMessage()    {}
+func (*EntriesOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{89}
+}
+func (m *EntriesOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntriesOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntriesOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EntriesOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntriesOutput.Merge(m, src)
+}
+func (m *EntriesOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntriesOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntriesOutput.DiscardUnknown(m)
 }
 
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EntriesOutput proto.InternalMessageInfo
+
+func (m *EntriesOutput) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
 	}
-	return dAtA[:n], nil
+	return Entry{}
 }
 
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventsInput struct {
+	Key     string                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Prefix  string                  `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Pattern string                  `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Keys    []string                `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	Types   []EventsInput_EventType `protobuf:"varint,5,rep,packed,name=types,proto3,enum=atomix.protocols.rsm.map.v1.EventsInput_EventType" json:"types,omitempty"`
 }
 
-func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateInput != nil {
-		{
-			size, err := m.UpdateInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *EventsInput) Reset()         { *m = EventsInput{} }
+func (m *EventsInput) String() string { return proto.CompactTextString(m) }
+func (*EventsInput) ProtoMessage()    {}
+func (*EventsInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{90}
+}
+func (m *EventsInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventsInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsInput.Merge(m, src)
 }
-
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventsInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsInput.DiscardUnknown(m)
 }
 
-func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateOutput != nil {
-		{
-			size, err := m.UpdateOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventsInput proto.InternalMessageInfo
+
+func (m *EventsInput) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventsInput) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventsInput) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
 }
 
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GetInput != nil {
-		{
-			size, err := m.GetInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *EventsInput) GetKeys() []string {
+	if m != nil {
+		return m.Keys
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventsInput) GetTypes() []EventsInput_EventType {
+	if m != nil {
+		return m.Types
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventsOutput struct {
+	Event Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
 }
 
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GetOutput != nil {
-		{
-			size, err := m.GetOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *EventsOutput) Reset()         { *m = EventsOutput{} }
+func (m *EventsOutput) String() string { return proto.CompactTextString(m) }
+func (*EventsOutput) ProtoMessage()    {}
+func (*EventsOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{91}
+}
+func (m *EventsOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventsOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventsOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsOutput.Merge(m, src)
+}
+func (m *EventsOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsOutput.DiscardUnknown(m)
 }
 
-func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventsOutput proto.InternalMessageInfo
+
+func (m *EventsOutput) GetEvent() Event {
+	if m != nil {
+		return m.Event
 	}
-	return dAtA[:n], nil
+	return Event{}
 }
 
-func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*Event_Inserted_
+	//	*Event_Updated_
+	//	*Event_Removed_
+	Event isEvent_Event `protobuf_oneof:"event"`
+	Patch *Patch        `protobuf:"bytes,5,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
-func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RemoveInput != nil {
-		{
-			size, err := m.RemoveInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{92}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+type isEvent_Event interface {
+	isEvent_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Inserted_ struct {
+	Inserted *Event_Inserted `protobuf:"bytes,2,opt,name=inserted,proto3,oneof" json:"inserted,omitempty"`
+}
+type Event_Updated_ struct {
+	Updated *Event_Updated `protobuf:"bytes,3,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
+}
+type Event_Removed_ struct {
+	Removed *Event_Removed `protobuf:"bytes,4,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
 }
 
-func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RemoveOutput != nil {
-		{
-			size, err := m.RemoveOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (*Event_Inserted_) isEvent_Event() {}
+func (*Event_Updated_) isEvent_Event()  {}
+func (*Event_Removed_) isEvent_Event()  {}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Event) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Event) GetInserted() *Event_Inserted {
+	if x, ok := m.GetEvent().(*Event_Inserted_); ok {
+		return x.Inserted
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Event) GetUpdated() *Event_Updated {
+	if x, ok := m.GetEvent().(*Event_Updated_); ok {
+		return x.Updated
+	}
+	return nil
 }
 
-func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClearInput != nil {
-		{
-			size, err := m.ClearInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *Event) GetRemoved() *Event_Removed {
+	if x, ok := m.GetEvent().(*Event_Removed_); ok {
+		return x.Removed
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Event) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_Inserted_)(nil),
+		(*Event_Updated_)(nil),
+		(*Event_Removed_)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Inserted struct {
+	Value IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
 }
 
-func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClearOutput != nil {
-		{
-			size, err := m.ClearOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Event_Inserted) Reset()         { *m = Event_Inserted{} }
+func (m *Event_Inserted) String() string { return proto.CompactTextString(m) }
+func (*Event_Inserted) ProtoMessage()    {}
+func (*Event_Inserted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{92, 0}
+}
+func (m *Event_Inserted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Inserted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Inserted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Event_Inserted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Inserted.Merge(m, src)
+}
+func (m *Event_Inserted) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Inserted) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Inserted.DiscardUnknown(m)
 }
 
-func (m *LockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Event_Inserted proto.InternalMessageInfo
+
+func (m *Event_Inserted) GetValue() IndexedValue {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return IndexedValue{}
 }
 
-func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Updated struct {
+	Value     IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+	PrevValue IndexedValue `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value"`
 }
 
-func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockInput != nil {
-		{
-			size, err := m.LockInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Event_Updated) Reset()         { *m = Event_Updated{} }
+func (m *Event_Updated) String() string { return proto.CompactTextString(m) }
+func (*Event_Updated) ProtoMessage()    {}
+func (*Event_Updated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{92, 1}
+}
+func (m *Event_Updated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Updated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Updated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Event_Updated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Updated.Merge(m, src)
+}
+func (m *Event_Updated) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Updated) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Updated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event_Updated proto.InternalMessageInfo
+
+func (m *Event_Updated) GetValue() IndexedValue {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return IndexedValue{}
 }
 
-func (m *LockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Event_Updated) GetPrevValue() IndexedValue {
+	if m != nil {
+		return m.PrevValue
 	}
-	return dAtA[:n], nil
+	return IndexedValue{}
 }
 
-func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_Removed struct {
+	Value   IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+	Expired bool         `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
-func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockOutput != nil {
-		{
-			size, err := m.LockOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Event_Removed) Reset()         { *m = Event_Removed{} }
+func (m *Event_Removed) String() string { return proto.CompactTextString(m) }
+func (*Event_Removed) ProtoMessage()    {}
+func (*Event_Removed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{92, 2}
+}
+func (m *Event_Removed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event_Removed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event_Removed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Event_Removed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event_Removed.Merge(m, src)
 }
-
-func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Event_Removed) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event_Removed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event_Removed.DiscardUnknown(m)
 }
 
-func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockInput != nil {
-		{
-			size, err := m.UnlockInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_Event_Removed proto.InternalMessageInfo
+
+func (m *Event_Removed) GetValue() IndexedValue {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return IndexedValue{}
 }
 
-func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Event_Removed) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Entry struct {
+	Key   string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *IndexedValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockOutput != nil {
-		{
-			size, err := m.UnlockOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Entry) Reset()         { *m = Entry{} }
+func (m *Entry) String() string { return proto.CompactTextString(m) }
+func (*Entry) ProtoMessage()    {}
+func (*Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{93}
+}
+func (m *Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entry.Merge(m, src)
+}
+func (m *Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Entry proto.InternalMessageInfo
+
+func (m *Entry) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PrepareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Entry) GetValue() *IndexedValue {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PrepareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Fence struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Token uint64 `protobuf:"varint,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *PrepareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrepareInput != nil {
-		{
-			size, err := m.PrepareInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Fence) Reset()         { *m = Fence{} }
+func (m *Fence) String() string { return proto.CompactTextString(m) }
+func (*Fence) ProtoMessage()    {}
+func (*Fence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{94}
+}
+func (m *Fence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Fence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fence.Merge(m, src)
+}
+func (m *Fence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fence proto.InternalMessageInfo
+
+func (m *Fence) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PrepareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Fence) GetToken() uint64 {
+	if m != nil {
+		return m.Token
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PrepareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidateFenceInput struct {
+	Fence Fence `protobuf:"bytes,1,opt,name=fence,proto3" json:"fence"`
 }
 
-func (m *PrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrepareOutput != nil {
-		{
-			size, err := m.PrepareOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *ValidateFenceInput) Reset()         { *m = ValidateFenceInput{} }
+func (m *ValidateFenceInput) String() string { return proto.CompactTextString(m) }
+func (*ValidateFenceInput) ProtoMessage()    {}
+func (*ValidateFenceInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{95}
+}
+func (m *ValidateFenceInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateFenceInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateFenceInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidateFenceInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateFenceInput.Merge(m, src)
+}
+func (m *ValidateFenceInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateFenceInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateFenceInput.DiscardUnknown(m)
 }
 
-func (m *CommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidateFenceInput proto.InternalMessageInfo
+
+func (m *ValidateFenceInput) GetFence() Fence {
+	if m != nil {
+		return m.Fence
 	}
-	return dAtA[:n], nil
+	return Fence{}
 }
 
-func (m *CommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidateFenceOutput struct {
 }
 
-func (m *CommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CommitInput != nil {
-		{
-			size, err := m.CommitInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *ValidateFenceOutput) Reset()         { *m = ValidateFenceOutput{} }
+func (m *ValidateFenceOutput) String() string { return proto.CompactTextString(m) }
+func (*ValidateFenceOutput) ProtoMessage()    {}
+func (*ValidateFenceOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{96}
+}
+func (m *ValidateFenceOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateFenceOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateFenceOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidateFenceOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateFenceOutput.Merge(m, src)
+}
+func (m *ValidateFenceOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateFenceOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateFenceOutput.DiscardUnknown(m)
 }
 
-func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidateFenceOutput proto.InternalMessageInfo
+
+type Patch struct {
+	// Types that are valid to be assigned to Patch:
+	//	*Patch_JsonPatch
+	//	*Patch_MergePatch
+	Patch isPatch_Patch `protobuf_oneof:"patch"`
 }
 
-func (m *CommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CommitOutput != nil {
-		{
-			size, err := m.CommitOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *Patch) Reset()         { *m = Patch{} }
+func (m *Patch) String() string { return proto.CompactTextString(m) }
+func (*Patch) ProtoMessage()    {}
+func (*Patch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{97}
+}
+func (m *Patch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Patch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Patch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AbortRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Patch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Patch.Merge(m, src)
+}
+func (m *Patch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Patch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Patch.DiscardUnknown(m)
 }
 
-func (m *AbortRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Patch proto.InternalMessageInfo
+
+type isPatch_Patch interface {
+	isPatch_Patch()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *AbortRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AbortInput != nil {
-		{
-			size, err := m.AbortInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type Patch_JsonPatch struct {
+	JsonPatch []byte `protobuf:"bytes,1,opt,name=json_patch,json=jsonPatch,proto3,oneof" json:"json_patch,omitempty"`
+}
+type Patch_MergePatch struct {
+	MergePatch []byte `protobuf:"bytes,2,opt,name=merge_patch,json=mergePatch,proto3,oneof" json:"merge_patch,omitempty"`
 }
 
-func (m *AbortResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (*Patch_JsonPatch) isPatch_Patch()  {}
+func (*Patch_MergePatch) isPatch_Patch() {}
+
+func (m *Patch) GetPatch() isPatch_Patch {
+	if m != nil {
+		return m.Patch
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AbortResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Patch) GetJsonPatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_JsonPatch); ok {
+		return x.JsonPatch
+	}
+	return nil
 }
 
-func (m *AbortResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AbortOutput != nil {
-		{
-			size, err := m.AbortOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *Patch) GetMergePatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_MergePatch); ok {
+		return x.MergePatch
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ApplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Patch) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Patch_JsonPatch)(nil),
+		(*Patch_MergePatch)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *ApplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IndexedValue struct {
+	Value []byte                                              `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Index github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
 }
 
-func (m *ApplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApplyInput != nil {
-		{
-			size, err := m.ApplyInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
+func (m *IndexedValue) Reset()         { *m = IndexedValue{} }
+func (m *IndexedValue) String() string { return proto.CompactTextString(m) }
+func (*IndexedValue) ProtoMessage()    {}
+func (*IndexedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0dba96ee3ff6a058, []int{98}
+}
+func (m *IndexedValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *IndexedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedValue.Merge(m, src)
+}
+func (m *IndexedValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedValue proto.InternalMessageInfo
+
+func (m *IndexedValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ApplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IndexedValue) GetIndex() github_com_atomix_atomix_protocols_rsm_api_v1.Index {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ApplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("atomix.protocols.rsm.map.v1.EventsInput_EventType", EventsInput_EventType_name, EventsInput_EventType_value)
+	proto.RegisterType((*SizeRequest)(nil), "atomix.protocols.rsm.map.v1.SizeRequest")
+	proto.RegisterType((*SizeResponse)(nil), "atomix.protocols.rsm.map.v1.SizeResponse")
+	proto.RegisterType((*PutRequest)(nil), "atomix.protocols.rsm.map.v1.PutRequest")
+	proto.RegisterType((*PutResponse)(nil), "atomix.protocols.rsm.map.v1.PutResponse")
+	proto.RegisterType((*InsertRequest)(nil), "atomix.protocols.rsm.map.v1.InsertRequest")
+	proto.RegisterType((*InsertResponse)(nil), "atomix.protocols.rsm.map.v1.InsertResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "atomix.protocols.rsm.map.v1.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "atomix.protocols.rsm.map.v1.UpdateResponse")
+	proto.RegisterType((*GetRequest)(nil), "atomix.protocols.rsm.map.v1.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "atomix.protocols.rsm.map.v1.GetResponse")
+	proto.RegisterType((*RemoveRequest)(nil), "atomix.protocols.rsm.map.v1.RemoveRequest")
+	proto.RegisterType((*RemoveResponse)(nil), "atomix.protocols.rsm.map.v1.RemoveResponse")
+	proto.RegisterType((*ClearRequest)(nil), "atomix.protocols.rsm.map.v1.ClearRequest")
+	proto.RegisterType((*ClearResponse)(nil), "atomix.protocols.rsm.map.v1.ClearResponse")
+	proto.RegisterType((*LockRequest)(nil), "atomix.protocols.rsm.map.v1.LockRequest")
+	proto.RegisterType((*LockResponse)(nil), "atomix.protocols.rsm.map.v1.LockResponse")
+	proto.RegisterType((*UnlockRequest)(nil), "atomix.protocols.rsm.map.v1.UnlockRequest")
+	proto.RegisterType((*UnlockResponse)(nil), "atomix.protocols.rsm.map.v1.UnlockResponse")
+	proto.RegisterType((*PrepareRequest)(nil), "atomix.protocols.rsm.map.v1.PrepareRequest")
+	proto.RegisterType((*PrepareResponse)(nil), "atomix.protocols.rsm.map.v1.PrepareResponse")
+	proto.RegisterType((*CommitRequest)(nil), "atomix.protocols.rsm.map.v1.CommitRequest")
+	proto.RegisterType((*CommitResponse)(nil), "atomix.protocols.rsm.map.v1.CommitResponse")
+	proto.RegisterType((*AbortRequest)(nil), "atomix.protocols.rsm.map.v1.AbortRequest")
+	proto.RegisterType((*AbortResponse)(nil), "atomix.protocols.rsm.map.v1.AbortResponse")
+	proto.RegisterType((*ApplyRequest)(nil), "atomix.protocols.rsm.map.v1.ApplyRequest")
+	proto.RegisterType((*ApplyResponse)(nil), "atomix.protocols.rsm.map.v1.ApplyResponse")
+	proto.RegisterType((*GetAllRequest)(nil), "atomix.protocols.rsm.map.v1.GetAllRequest")
+	proto.RegisterType((*GetAllResponse)(nil), "atomix.protocols.rsm.map.v1.GetAllResponse")
+	proto.RegisterType((*PutAllRequest)(nil), "atomix.protocols.rsm.map.v1.PutAllRequest")
+	proto.RegisterType((*PutAllResponse)(nil), "atomix.protocols.rsm.map.v1.PutAllResponse")
+	proto.RegisterType((*RemoveAllRequest)(nil), "atomix.protocols.rsm.map.v1.RemoveAllRequest")
+	proto.RegisterType((*RemoveAllResponse)(nil), "atomix.protocols.rsm.map.v1.RemoveAllResponse")
+	proto.RegisterType((*ConfigureRequest)(nil), "atomix.protocols.rsm.map.v1.ConfigureRequest")
+	proto.RegisterType((*ConfigureResponse)(nil), "atomix.protocols.rsm.map.v1.ConfigureResponse")
+	proto.RegisterType((*QueryRequest)(nil), "atomix.protocols.rsm.map.v1.QueryRequest")
+	proto.RegisterType((*QueryResponse)(nil), "atomix.protocols.rsm.map.v1.QueryResponse")
+	proto.RegisterType((*ValidateFenceRequest)(nil), "atomix.protocols.rsm.map.v1.ValidateFenceRequest")
+	proto.RegisterType((*ValidateFenceResponse)(nil), "atomix.protocols.rsm.map.v1.ValidateFenceResponse")
+	proto.RegisterType((*EntriesRequest)(nil), "atomix.protocols.rsm.map.v1.EntriesRequest")
+	proto.RegisterType((*EntriesResponse)(nil), "atomix.protocols.rsm.map.v1.EntriesResponse")
+	proto.RegisterType((*EventsRequest)(nil), "atomix.protocols.rsm.map.v1.EventsRequest")
+	proto.RegisterType((*EventsResponse)(nil), "atomix.protocols.rsm.map.v1.EventsResponse")
+	proto.RegisterType((*MapListener)(nil), "atomix.protocols.rsm.map.v1.MapListener")
+	proto.RegisterType((*MapEntry)(nil), "atomix.protocols.rsm.map.v1.MapEntry")
+	proto.RegisterType((*MapValue)(nil), "atomix.protocols.rsm.map.v1.MapValue")
+	proto.RegisterType((*MapInput)(nil), "atomix.protocols.rsm.map.v1.MapInput")
+	proto.RegisterType((*MapOutput)(nil), "atomix.protocols.rsm.map.v1.MapOutput")
+	proto.RegisterType((*SizeInput)(nil), "atomix.protocols.rsm.map.v1.SizeInput")
+	proto.RegisterType((*SizeOutput)(nil), "atomix.protocols.rsm.map.v1.SizeOutput")
+	proto.RegisterType((*PutInput)(nil), "atomix.protocols.rsm.map.v1.PutInput")
+	proto.RegisterType((*PutOutput)(nil), "atomix.protocols.rsm.map.v1.PutOutput")
+	proto.RegisterType((*InsertInput)(nil), "atomix.protocols.rsm.map.v1.InsertInput")
+	proto.RegisterType((*InsertOutput)(nil), "atomix.protocols.rsm.map.v1.InsertOutput")
+	proto.RegisterType((*UpdateInput)(nil), "atomix.protocols.rsm.map.v1.UpdateInput")
+	proto.RegisterType((*UpdateOutput)(nil), "atomix.protocols.rsm.map.v1.UpdateOutput")
+	proto.RegisterType((*GetInput)(nil), "atomix.protocols.rsm.map.v1.GetInput")
+	proto.RegisterType((*GetOutput)(nil), "atomix.protocols.rsm.map.v1.GetOutput")
+	proto.RegisterType((*RemoveInput)(nil), "atomix.protocols.rsm.map.v1.RemoveInput")
+	proto.RegisterType((*RemoveOutput)(nil), "atomix.protocols.rsm.map.v1.RemoveOutput")
+	proto.RegisterType((*ClearInput)(nil), "atomix.protocols.rsm.map.v1.ClearInput")
+	proto.RegisterType((*ClearOutput)(nil), "atomix.protocols.rsm.map.v1.ClearOutput")
+	proto.RegisterType((*LockInput)(nil), "atomix.protocols.rsm.map.v1.LockInput")
+	proto.RegisterType((*LockOutput)(nil), "atomix.protocols.rsm.map.v1.LockOutput")
+	proto.RegisterType((*UnlockInput)(nil), "atomix.protocols.rsm.map.v1.UnlockInput")
+	proto.RegisterType((*UnlockOutput)(nil), "atomix.protocols.rsm.map.v1.UnlockOutput")
+	proto.RegisterType((*PrepareInput)(nil), "atomix.protocols.rsm.map.v1.PrepareInput")
+	proto.RegisterType((*PrepareOutput)(nil), "atomix.protocols.rsm.map.v1.PrepareOutput")
+	proto.RegisterType((*CommitInput)(nil), "atomix.protocols.rsm.map.v1.CommitInput")
+	proto.RegisterType((*CommitOutput)(nil), "atomix.protocols.rsm.map.v1.CommitOutput")
+	proto.RegisterType((*AbortInput)(nil), "atomix.protocols.rsm.map.v1.AbortInput")
+	proto.RegisterType((*AbortOutput)(nil), "atomix.protocols.rsm.map.v1.AbortOutput")
+	proto.RegisterType((*ApplyInput)(nil), "atomix.protocols.rsm.map.v1.ApplyInput")
+	proto.RegisterType((*ApplyOutput)(nil), "atomix.protocols.rsm.map.v1.ApplyOutput")
+	proto.RegisterType((*GetAllInput)(nil), "atomix.protocols.rsm.map.v1.GetAllInput")
+	proto.RegisterType((*GetAllOutput)(nil), "atomix.protocols.rsm.map.v1.GetAllOutput")
+	proto.RegisterType((*PutAllInput)(nil), "atomix.protocols.rsm.map.v1.PutAllInput")
+	proto.RegisterType((*PutAllOutput)(nil), "atomix.protocols.rsm.map.v1.PutAllOutput")
+	proto.RegisterType((*RemoveAllInput)(nil), "atomix.protocols.rsm.map.v1.RemoveAllInput")
+	proto.RegisterType((*RemoveAllOutput)(nil), "atomix.protocols.rsm.map.v1.RemoveAllOutput")
+	proto.RegisterType((*ConfigureInput)(nil), "atomix.protocols.rsm.map.v1.ConfigureInput")
+	proto.RegisterType((*ConfigureOutput)(nil), "atomix.protocols.rsm.map.v1.ConfigureOutput")
+	proto.RegisterType((*Index)(nil), "atomix.protocols.rsm.map.v1.Index")
+	proto.RegisterType((*QueryInput)(nil), "atomix.protocols.rsm.map.v1.QueryInput")
+	proto.RegisterType((*IndexQuery)(nil), "atomix.protocols.rsm.map.v1.IndexQuery")
+	proto.RegisterType((*QueryOutput)(nil), "atomix.protocols.rsm.map.v1.QueryOutput")
+	proto.RegisterType((*QueryResult)(nil), "atomix.protocols.rsm.map.v1.QueryResult")
+	proto.RegisterType((*Range)(nil), "atomix.protocols.rsm.map.v1.Range")
+	proto.RegisterType((*Bound)(nil), "atomix.protocols.rsm.map.v1.Bound")
+	proto.RegisterType((*EntriesInput)(nil), "atomix.protocols.rsm.map.v1.EntriesInput")
+	proto.RegisterType((*EntriesOutput)(nil), "atomix.protocols.rsm.map.v1.EntriesOutput")
+	proto.RegisterType((*EventsInput)(nil), "atomix.protocols.rsm.map.v1.EventsInput")
+	proto.RegisterType((*EventsOutput)(nil), "atomix.protocols.rsm.map.v1.EventsOutput")
+	proto.RegisterType((*Event)(nil), "atomix.protocols.rsm.map.v1.Event")
+	proto.RegisterType((*Event_Inserted)(nil), "atomix.protocols.rsm.map.v1.Event.Inserted")
+	proto.RegisterType((*Event_Updated)(nil), "atomix.protocols.rsm.map.v1.Event.Updated")
+	proto.RegisterType((*Event_Removed)(nil), "atomix.protocols.rsm.map.v1.Event.Removed")
+	proto.RegisterType((*Entry)(nil), "atomix.protocols.rsm.map.v1.Entry")
+	proto.RegisterType((*Fence)(nil), "atomix.protocols.rsm.map.v1.Fence")
+	proto.RegisterType((*ValidateFenceInput)(nil), "atomix.protocols.rsm.map.v1.ValidateFenceInput")
+	proto.RegisterType((*ValidateFenceOutput)(nil), "atomix.protocols.rsm.map.v1.ValidateFenceOutput")
+	proto.RegisterType((*Patch)(nil), "atomix.protocols.rsm.map.v1.Patch")
+	proto.RegisterType((*IndexedValue)(nil), "atomix.protocols.rsm.map.v1.IndexedValue")
 }
 
-func (m *ApplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApplyOutput != nil {
-		{
-			size, err := m.ApplyOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func init() { proto.RegisterFile("map/v1/map.proto", fileDescriptor_0dba96ee3ff6a058) }
+
+var fileDescriptor_0dba96ee3ff6a058 = []byte{
+	// 3251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xe7, 0x8a, 0xa4, 0x48, 0x7e, 0x4b, 0xc9, 0xca, 0xc4, 0x69, 0xb7, 0x4c, 0x23, 0x39, 0x8b,
+	0x26, 0x91, 0x2d, 0x47, 0x8c, 0xd5, 0xa2, 0x48, 0xdc, 0xa4, 0xb1, 0x29, 0xc9, 0xa2, 0x62, 0x4b,
+	0x66, 0x36, 0xb2, 0x9b, 0x07, 0x50, 0x75, 0x4d, 0x8e, 0x29, 0xda, 0x24, 0x77, 0xbd, 0x0f, 0x56,
+	0x0a, 0xd0, 0x43, 0x81, 0xde, 0x8a, 0x02, 0xb9, 0x35, 0xe9, 0x2b, 0xe9, 0xfb, 0x10, 0x20, 0x28,
+	0xd0, 0xfe, 0x0b, 0x05, 0x72, 0xcc, 0xb1, 0xbd, 0xa8, 0x85, 0x73, 0xef, 0xa1, 0xc7, 0x9c, 0x8a,
+	0x99, 0xd9, 0xc7, 0xec, 0x7a, 0x29, 0xce, 0xc6, 0xa4, 0x89, 0xde, 0x38, 0xcb, 0xf9, 0xbe, 0xf9,
+	0xed, 0x6f, 0xbe, 0xfd, 0xe6, 0x7b, 0xec, 0xc2, 0x42, 0x4f, 0x37, 0xab, 0x83, 0x0b, 0xd5, 0x9e,
+	0x6e, 0xae, 0x9a, 0x96, 0xe1, 0x18, 0xe8, 0x49, 0xdd, 0x31, 0x7a, 0x9d, 0x43, 0x36, 0x6a, 0x1a,
+	0x5d, 0x7b, 0xd5, 0xb2, 0x7b, 0xab, 0xe4, 0xff, 0xc1, 0x85, 0xca, 0xc2, 0xe0, 0x42, 0xf5, 0x00,
+	0xeb, 0x2d, 0x6c, 0xd9, 0x6c, 0x42, 0x65, 0xb1, 0x6d, 0x18, 0xed, 0x2e, 0xae, 0xd2, 0xd1, 0x2d,
+	0xf7, 0x76, 0xb5, 0xe5, 0x5a, 0xba, 0xd3, 0x31, 0xfa, 0xde, 0xff, 0x4b, 0xf1, 0xff, 0x9d, 0x4e,
+	0x0f, 0xdb, 0x8e, 0xde, 0xf3, 0xd6, 0xab, 0x9c, 0x6e, 0x1b, 0x6d, 0x83, 0xfe, 0xac, 0x92, 0x5f,
+	0xec, 0xaa, 0xfa, 0x81, 0x04, 0xf2, 0x1b, 0x9d, 0x77, 0xb1, 0x86, 0xef, 0xb9, 0xd8, 0x76, 0xd0,
+	0x15, 0x28, 0x78, 0xeb, 0x2a, 0xd2, 0x19, 0x69, 0x59, 0x5e, 0x3b, 0xbf, 0x9a, 0x88, 0x73, 0x70,
+	0x61, 0xf5, 0x75, 0x17, 0x5b, 0x47, 0x9e, 0x5c, 0x9d, 0xc9, 0x68, 0xbe, 0x30, 0xaa, 0x41, 0xbe,
+	0xd3, 0x37, 0x5d, 0x47, 0x99, 0xa1, 0x5a, 0x9e, 0x5d, 0x3d, 0xe1, 0x6e, 0x57, 0x09, 0x80, 0x6d,
+	0x32, 0xbb, 0x96, 0xfb, 0xec, 0x78, 0x49, 0xd2, 0x98, 0xa8, 0xfa, 0x1b, 0x09, 0xca, 0x0c, 0x9b,
+	0x6d, 0x1a, 0x7d, 0x1b, 0xa3, 0xad, 0x38, 0xb8, 0xe7, 0x47, 0x81, 0x63, 0x82, 0x0f, 0xa0, 0xdb,
+	0x84, 0x59, 0xc3, 0x75, 0x42, 0x78, 0xcf, 0x8d, 0x84, 0x77, 0xdd, 0x75, 0x42, 0x7c, 0x9e, 0xb0,
+	0xfa, 0x0b, 0x09, 0xa0, 0xe1, 0x3a, 0x3e, 0x77, 0xdb, 0x71, 0x78, 0xd5, 0xa1, 0xf0, 0x1a, 0x96,
+	0x61, 0x1a, 0xb6, 0xde, 0x1d, 0x46, 0xdf, 0xe5, 0x28, 0x7d, 0xcf, 0x9c, 0x88, 0xaf, 0xe1, 0x3a,
+	0x09, 0xec, 0x7d, 0x28, 0x81, 0x4c, 0xc1, 0x79, 0xe4, 0xbd, 0x16, 0x47, 0xf7, 0x82, 0x00, 0xba,
+	0x21, 0xfc, 0x6d, 0xc4, 0xf8, 0x7b, 0x76, 0x14, 0xbe, 0x44, 0xfa, 0x3e, 0x92, 0x60, 0x6e, 0xbb,
+	0x6f, 0x63, 0x6b, 0x12, 0x0c, 0x6e, 0x44, 0x19, 0x5c, 0x3e, 0x11, 0x21, 0x43, 0x91, 0x40, 0xe2,
+	0x1f, 0x25, 0x98, 0xf7, 0x21, 0x4e, 0x80, 0xc7, 0xad, 0x18, 0x8f, 0x67, 0x05, 0x50, 0x0e, 0xa5,
+	0xf2, 0x86, 0xd9, 0xd2, 0x1d, 0x3c, 0x6d, 0x2a, 0x19, 0x8a, 0x21, 0x54, 0xfa, 0x10, 0xa7, 0x4e,
+	0x25, 0x03, 0x92, 0x48, 0xe5, 0xcf, 0x25, 0x80, 0x2d, 0xec, 0x8c, 0xdb, 0x21, 0xa6, 0x7a, 0xa2,
+	0xb7, 0x70, 0x92, 0x31, 0xfe, 0x4a, 0x02, 0x99, 0x22, 0x1b, 0xb7, 0x3b, 0x4c, 0xf7, 0x38, 0x6f,
+	0xe1, 0xe1, 0x36, 0xa8, 0xe1, 0x9e, 0x31, 0x98, 0xba, 0x0d, 0x32, 0x14, 0x43, 0x6c, 0xd0, 0x87,
+	0x38, 0x75, 0x1b, 0x64, 0x40, 0x12, 0xa9, 0x24, 0x27, 0xdf, 0x7a, 0x17, 0xeb, 0xd6, 0x04, 0x98,
+	0x5c, 0x8f, 0x32, 0x79, 0xf2, 0xd1, 0x47, 0x41, 0x24, 0x10, 0xf9, 0x7b, 0x09, 0xe6, 0x3c, 0x80,
+	0x13, 0xe0, 0xf1, 0x4a, 0x8c, 0xc7, 0xe5, 0xd1, 0x18, 0x13, 0x69, 0x24, 0x0f, 0xcc, 0x35, 0xa3,
+	0x79, 0x77, 0x02, 0x2c, 0xa6, 0x8a, 0x6f, 0x08, 0x86, 0x04, 0x12, 0x7f, 0x2b, 0x41, 0x99, 0xc1,
+	0x9b, 0x00, 0x87, 0xe9, 0x42, 0x1c, 0x02, 0x63, 0xf8, 0xc1, 0xd2, 0xef, 0x4e, 0x86, 0xc4, 0x74,
+	0x07, 0x4b, 0xbf, 0x9b, 0x4c, 0x23, 0x3d, 0x58, 0x3c, 0x88, 0xd3, 0x3f, 0x58, 0xfa, 0xdd, 0x61,
+	0x54, 0xfe, 0x4e, 0x82, 0xf9, 0x86, 0x85, 0x4d, 0xdd, 0x9a, 0x84, 0x83, 0xdc, 0x8c, 0x72, 0x79,
+	0x32, 0x4a, 0x0f, 0x46, 0x02, 0x99, 0x7f, 0x96, 0xe0, 0x54, 0x00, 0x72, 0x02, 0x6c, 0xd6, 0x63,
+	0x6c, 0x9e, 0x13, 0xc1, 0x39, 0xd4, 0x32, 0xd7, 0x8d, 0x5e, 0xaf, 0x33, 0xf5, 0xe8, 0x91, 0xa1,
+	0x18, 0x62, 0x99, 0x3e, 0xc4, 0xa9, 0x5b, 0x26, 0x03, 0x32, 0xf4, 0xb8, 0xb9, 0x7c, 0xcb, 0xb0,
+	0x9c, 0x69, 0x1f, 0x37, 0x14, 0xc4, 0x90, 0xe3, 0xc6, 0x03, 0x38, 0xf5, 0xe3, 0x86, 0xe2, 0x18,
+	0x4e, 0xa3, 0x69, 0x76, 0x8f, 0xa6, 0x4e, 0x23, 0x01, 0x31, 0x8c, 0x46, 0x06, 0x70, 0xfa, 0x34,
+	0x12, 0x1c, 0x89, 0x34, 0xfe, 0x5a, 0x82, 0xb9, 0x2d, 0xec, 0x5c, 0xee, 0x76, 0xc7, 0x1d, 0x83,
+	0xa7, 0x7a, 0xaa, 0x19, 0x84, 0x04, 0x16, 0x89, 0x1f, 0xf7, 0xf1, 0x8d, 0x3b, 0x12, 0x4f, 0xf7,
+	0x48, 0x33, 0x14, 0x43, 0xbd, 0x63, 0xc3, 0xe5, 0x49, 0x9c, 0x96, 0x77, 0x6c, 0xb8, 0x43, 0x78,
+	0x24, 0xde, 0xd1, 0x87, 0x38, 0x75, 0xef, 0xd8, 0x70, 0x87, 0x52, 0xf9, 0x27, 0x09, 0x16, 0x58,
+	0xac, 0x3e, 0x19, 0x36, 0xb7, 0xa2, 0x6c, 0xae, 0x08, 0x24, 0x0d, 0xc9, 0x84, 0x7e, 0x2c, 0xc1,
+	0x63, 0x1c, 0xd0, 0x09, 0x70, 0xfa, 0x5a, 0x8c, 0xd3, 0xf3, 0x62, 0x58, 0x87, 0xd2, 0xba, 0x6e,
+	0xf4, 0x6f, 0x77, 0xda, 0xae, 0x85, 0xa7, 0x4d, 0x6b, 0x00, 0x64, 0x08, 0xad, 0x1c, 0xd0, 0xa9,
+	0xd3, 0x1a, 0x60, 0x49, 0xa4, 0xf5, 0x97, 0x12, 0x94, 0x79, 0x27, 0x38, 0x36, 0xe7, 0x99, 0xea,
+	0x04, 0xa2, 0x9a, 0x12, 0xb8, 0x24, 0x6e, 0x29, 0xe2, 0x01, 0xc7, 0xe7, 0x3a, 0xd3, 0x1d, 0x3f,
+	0x54, 0x57, 0x22, 0x81, 0x9f, 0x48, 0x70, 0xfa, 0xa6, 0xde, 0xed, 0x90, 0x02, 0xd1, 0x15, 0xdc,
+	0x6f, 0x4e, 0xc2, 0x36, 0xaf, 0x46, 0xb9, 0xac, 0x9e, 0x08, 0x35, 0x02, 0x26, 0x81, 0xd3, 0xbf,
+	0x4a, 0xf0, 0x44, 0x0c, 0xf0, 0x04, 0x6c, 0x74, 0x37, 0x46, 0xef, 0x0b, 0xe2, 0x98, 0x13, 0x69,
+	0xfe, 0x50, 0x82, 0xf9, 0xcd, 0xbe, 0x63, 0x75, 0xb0, 0x3d, 0x6e, 0x4b, 0x4d, 0x95, 0x0a, 0x79,
+	0x18, 0x12, 0x78, 0xfd, 0x83, 0x04, 0xa7, 0x02, 0x84, 0xe3, 0xb6, 0xd6, 0x74, 0x79, 0x90, 0x07,
+	0x63, 0xe8, 0x49, 0xbf, 0x39, 0xc0, 0x7d, 0xc7, 0x9e, 0xf6, 0x49, 0xcf, 0x50, 0x0c, 0x39, 0xe9,
+	0x7d, 0x88, 0x53, 0x3f, 0xe9, 0x19, 0x90, 0x44, 0x2a, 0x7f, 0x3c, 0x03, 0xf2, 0x8e, 0x6e, 0x5e,
+	0xeb, 0xd8, 0x0e, 0xee, 0x63, 0x0b, 0x35, 0xc8, 0xdd, 0xb7, 0xf0, 0x21, 0x85, 0x98, 0xab, 0x5d,
+	0xfc, 0xe2, 0x78, 0xe9, 0xdb, 0xed, 0x8e, 0x73, 0xe0, 0xde, 0x5a, 0x6d, 0x1a, 0xbd, 0xea, 0xc0,
+	0xd4, 0xed, 0xa6, 0xa1, 0x77, 0xad, 0x2a, 0x5b, 0xaf, 0x1a, 0xac, 0x57, 0xb5, 0xec, 0x5e, 0x55,
+	0x37, 0x3b, 0x55, 0x5a, 0xb5, 0x6f, 0xe1, 0x43, 0x8d, 0x29, 0x42, 0x0b, 0x90, 0xbd, 0x8b, 0x8f,
+	0x28, 0xce, 0x92, 0x46, 0x7e, 0xa2, 0xaf, 0xc0, 0xac, 0x69, 0xe1, 0xdb, 0x9d, 0x43, 0x25, 0x4b,
+	0x2f, 0x7a, 0x23, 0xa4, 0x40, 0xc1, 0xd4, 0x1d, 0x07, 0x5b, 0x7d, 0x25, 0x47, 0xff, 0xf0, 0x87,
+	0x08, 0x41, 0xee, 0x2e, 0x3e, 0xb2, 0x95, 0xfc, 0x99, 0xec, 0x72, 0x49, 0xa3, 0xbf, 0x51, 0x1d,
+	0xf2, 0xce, 0x91, 0x89, 0x6d, 0x65, 0xf6, 0x4c, 0x76, 0x79, 0x7e, 0x6d, 0x4d, 0x74, 0x9f, 0xd8,
+	0xef, 0xbd, 0x23, 0x13, 0x6b, 0x4c, 0x81, 0xfa, 0x16, 0x14, 0x77, 0x74, 0x93, 0x18, 0xdc, 0x91,
+	0x8f, 0x56, 0x0a, 0xd1, 0x7e, 0x07, 0xf2, 0x03, 0xbd, 0xeb, 0x62, 0xa1, 0x2a, 0xf6, 0x8e, 0x6e,
+	0xde, 0x24, 0x93, 0x35, 0x26, 0xa3, 0xbe, 0x3f, 0x03, 0x45, 0xff, 0x1a, 0x3a, 0xed, 0x6b, 0x22,
+	0xda, 0xcb, 0xde, 0x94, 0x90, 0xf1, 0x99, 0x71, 0x31, 0xfe, 0x22, 0xcc, 0xe2, 0x43, 0xb3, 0x63,
+	0x61, 0xca, 0xaf, 0xbc, 0x56, 0x59, 0x65, 0x8d, 0xd2, 0x55, 0xbf, 0x51, 0xba, 0xba, 0xe7, 0x37,
+	0x4a, 0x6b, 0xb9, 0xf7, 0xfe, 0x45, 0xac, 0x81, 0xcd, 0x47, 0x77, 0x00, 0x6c, 0x6c, 0xdb, 0x1d,
+	0xa3, 0xbf, 0xdf, 0x69, 0xd1, 0x4d, 0xc8, 0xd5, 0xae, 0xde, 0x3f, 0x5e, 0x2a, 0xbd, 0xc1, 0xae,
+	0x6e, 0x6f, 0x7c, 0x71, 0xbc, 0x74, 0x31, 0x35, 0xba, 0x40, 0x5a, 0x2b, 0x79, 0xea, 0xb7, 0x5b,
+	0xea, 0x27, 0x32, 0xa5, 0x86, 0xee, 0x09, 0x7a, 0x19, 0x72, 0x76, 0xe7, 0x5d, 0xac, 0x48, 0x02,
+	0xa5, 0xc5, 0xa0, 0x75, 0x5a, 0xcf, 0x68, 0x54, 0x0a, 0xbd, 0x04, 0xd9, 0xb4, 0x8d, 0xc3, 0x7a,
+	0x46, 0x23, 0x32, 0xa8, 0x06, 0xb3, 0x1d, 0xda, 0x63, 0x52, 0xb2, 0x02, 0x8f, 0x3b, 0xd7, 0x34,
+	0xab, 0x67, 0x34, 0x4f, 0x92, 0xe8, 0x70, 0x69, 0x73, 0x45, 0xc9, 0x09, 0xe8, 0xe0, 0xba, 0x45,
+	0x44, 0x07, 0x93, 0x24, 0xb7, 0xd0, 0xc6, 0x8e, 0x92, 0x4f, 0xd1, 0x29, 0x21, 0xb7, 0xd0, 0xc6,
+	0x74, 0x79, 0x8b, 0x86, 0x9d, 0xca, 0x6c, 0xba, 0x46, 0x01, 0x59, 0x9e, 0x49, 0xa2, 0x57, 0x21,
+	0xdf, 0xec, 0x62, 0xdd, 0x52, 0x0a, 0xa9, 0x2a, 0xe4, 0xf5, 0x8c, 0xc6, 0xe4, 0xc8, 0x06, 0x92,
+	0x2a, 0xa0, 0x52, 0x4c, 0x53, 0x1b, 0x26, 0x1b, 0x48, 0xa4, 0x28, 0x83, 0xb4, 0x8a, 0xa8, 0x94,
+	0xd2, 0x95, 0x45, 0x29, 0x83, 0x74, 0x88, 0x36, 0xa1, 0x80, 0xd9, 0x99, 0xa1, 0x40, 0xca, 0x43,
+	0xb0, 0x9e, 0xd1, 0x7c, 0x59, 0x02, 0x05, 0x53, 0x67, 0xa1, 0xc8, 0xe9, 0xfc, 0x3f, 0x81, 0xc2,
+	0x24, 0x09, 0x14, 0x93, 0x95, 0xf1, 0x94, 0x72, 0xca, 0xd2, 0x24, 0x81, 0xe2, 0xc9, 0x12, 0x28,
+	0x4d, 0x5a, 0xc1, 0x52, 0xe6, 0xd2, 0x95, 0xe4, 0x08, 0x14, 0x26, 0x49, 0x36, 0x56, 0x27, 0xd5,
+	0x1b, 0x65, 0x3e, 0x55, 0x2d, 0x8a, 0x6c, 0x2c, 0x95, 0xa3, 0x0a, 0x48, 0xdd, 0x42, 0x39, 0x95,
+	0xaa, 0x0a, 0x43, 0x15, 0x90, 0x11, 0x5a, 0x87, 0x42, 0x1b, 0x3b, 0xfb, 0x7a, 0xb7, 0xab, 0x2c,
+	0xa4, 0xab, 0x41, 0x90, 0xdb, 0x68, 0xd3, 0x21, 0x51, 0x62, 0xba, 0x4c, 0xc9, 0x63, 0xe9, 0x12,
+	0x70, 0xa2, 0xc4, 0xa4, 0x43, 0x74, 0x0d, 0x80, 0x99, 0x3b, 0xd5, 0x83, 0x52, 0xa7, 0x9e, 0xf5,
+	0x8c, 0x56, 0xb2, 0xfc, 0x2b, 0xe8, 0x2a, 0x94, 0x9a, 0x7e, 0x5a, 0xa2, 0x3c, 0x9e, 0x3a, 0xe1,
+	0x22, 0xca, 0x02, 0x79, 0xc2, 0xf2, 0x3d, 0x12, 0x3c, 0x29, 0xa7, 0x53, 0x65, 0x1a, 0x84, 0x65,
+	0x2a, 0x87, 0xde, 0x84, 0xf9, 0x81, 0x17, 0x80, 0xee, 0xdf, 0x26, 0x11, 0xa8, 0xf2, 0xc4, 0x97,
+	0x8a, 0xb3, 0xeb, 0x19, 0x6d, 0x6e, 0xc0, 0x5f, 0xad, 0x15, 0xbc, 0x78, 0x48, 0xfd, 0xbb, 0x0c,
+	0xa5, 0x1d, 0xdd, 0x64, 0x61, 0x04, 0x7a, 0x25, 0xe2, 0xb1, 0x45, 0xdf, 0x26, 0x09, 0x5c, 0xf6,
+	0x45, 0xde, 0x65, 0x0b, 0xbe, 0x4b, 0xe1, 0xfb, 0xec, 0xf5, 0x98, 0xcf, 0x16, 0x7f, 0x85, 0x80,
+	0x73, 0xda, 0xeb, 0x31, 0xa7, 0x2d, 0xde, 0x3c, 0xe7, 0xbc, 0xf6, 0x45, 0xde, 0x6b, 0x0b, 0xb6,
+	0x90, 0x7d, 0xb7, 0xbd, 0x1e, 0x73, 0xdb, 0xe2, 0x9d, 0x53, 0xce, 0x6f, 0x5f, 0x8a, 0xfa, 0x6d,
+	0xe1, 0xae, 0x61, 0xe8, 0xb8, 0x5f, 0x89, 0x38, 0x6e, 0xd1, 0x96, 0x59, 0xe0, 0xb9, 0xd7, 0x63,
+	0x9e, 0x5b, 0xbc, 0x55, 0xc4, 0xb9, 0xee, 0x2b, 0x71, 0xd7, 0x9d, 0x22, 0x35, 0xe0, 0x7d, 0xf7,
+	0x7a, 0xcc, 0x77, 0x8b, 0x47, 0xc5, 0x9c, 0xf3, 0xbe, 0x12, 0x77, 0xde, 0x29, 0xfa, 0x35, 0xbc,
+	0xf7, 0x5e, 0x8f, 0x79, 0x6f, 0xf1, 0x56, 0x05, 0xe7, 0xbe, 0x2f, 0x45, 0xdd, 0xb7, 0x70, 0x99,
+	0x3e, 0xf4, 0xdf, 0x97, 0xa2, 0xfe, 0x5b, 0xb8, 0x42, 0x1d, 0x3a, 0xf0, 0x8d, 0xb8, 0x03, 0x17,
+	0xaf, 0xd0, 0x72, 0x1e, 0x7c, 0x23, 0xee, 0xc1, 0xc5, 0x8b, 0x93, 0x9c, 0x0b, 0xdf, 0x49, 0x70,
+	0xe1, 0xa9, 0x2a, 0x72, 0x51, 0x1f, 0x7e, 0xed, 0x41, 0x1f, 0x9e, 0xaa, 0x10, 0x15, 0x75, 0xe2,
+	0x97, 0xa2, 0x4e, 0x5c, 0xb8, 0x1a, 0x13, 0x7a, 0xf1, 0xb7, 0x86, 0x78, 0xf1, 0xd4, 0x95, 0x87,
+	0x07, 0xdd, 0x78, 0xd1, 0xcf, 0x18, 0x55, 0x19, 0x4a, 0x41, 0x08, 0xad, 0x9e, 0x01, 0x08, 0xbd,
+	0x33, 0xc9, 0xb3, 0x02, 0xa7, 0x3e, 0xc7, 0x3c, 0xb5, 0xfa, 0xcf, 0x19, 0x28, 0xfa, 0x51, 0x73,
+	0x42, 0x7a, 0x74, 0x9a, 0x4f, 0x8f, 0x82, 0xa4, 0xe6, 0x45, 0xc8, 0x3a, 0x4e, 0xd7, 0xf3, 0xcf,
+	0x5f, 0x7b, 0x20, 0xff, 0xd8, 0xf0, 0x5e, 0xe4, 0xac, 0xc9, 0xf7, 0x8f, 0x97, 0xb2, 0x7b, 0x7b,
+	0xd7, 0xde, 0x27, 0x59, 0x08, 0x11, 0x41, 0x6f, 0x01, 0x98, 0x16, 0x1e, 0xec, 0xb3, 0x9c, 0x28,
+	0xf7, 0xd0, 0x39, 0x51, 0x89, 0x68, 0xa3, 0x3f, 0xd1, 0xd7, 0xa1, 0x84, 0xcd, 0x03, 0xdc, 0xc3,
+	0x96, 0xde, 0xa5, 0x3e, 0xbb, 0xa8, 0x85, 0x17, 0xd0, 0x8b, 0x90, 0x67, 0x94, 0x33, 0x77, 0xac,
+	0x9e, 0x48, 0x39, 0x2b, 0x3a, 0x31, 0x01, 0x22, 0x69, 0xea, 0x4e, 0xf3, 0x40, 0x29, 0x08, 0x48,
+	0x36, 0xc8, 0x4c, 0x8d, 0x09, 0x90, 0xd6, 0x73, 0x29, 0x38, 0xde, 0x26, 0x90, 0x7b, 0xd7, 0x3d,
+	0x32, 0xf9, 0x04, 0x76, 0xd4, 0x69, 0xd9, 0xc2, 0x87, 0xb8, 0xc5, 0x92, 0x58, 0xca, 0x1d, 0xfd,
+	0xa9, 0xfe, 0x4c, 0x02, 0x99, 0xcb, 0x7e, 0x1e, 0x81, 0x21, 0x44, 0x76, 0x2b, 0x17, 0xdb, 0x2d,
+	0xf5, 0x07, 0x50, 0xe6, 0x0f, 0xf6, 0xf1, 0x73, 0xa7, 0xfe, 0x64, 0x06, 0x64, 0x2e, 0x57, 0xfb,
+	0xff, 0x36, 0xfd, 0xc0, 0xb8, 0xf3, 0x29, 0x8d, 0x5b, 0xfd, 0x8b, 0x04, 0x65, 0x3e, 0xfa, 0x99,
+	0x80, 0x95, 0xee, 0x3e, 0x94, 0x95, 0xd6, 0x72, 0x9f, 0x1e, 0x2f, 0x65, 0x78, 0x5b, 0x5d, 0x83,
+	0xa2, 0x9f, 0x23, 0x27, 0xef, 0x9a, 0xa9, 0x3b, 0x07, 0xb6, 0x32, 0x43, 0x8b, 0x49, 0x6c, 0xa0,
+	0x6a, 0x50, 0x0a, 0x22, 0x34, 0x52, 0x4d, 0x0d, 0x0b, 0x35, 0x5f, 0x02, 0x0b, 0x93, 0x56, 0xff,
+	0x26, 0x81, 0xcc, 0xa5, 0xdb, 0x09, 0x58, 0xa2, 0x3b, 0x3e, 0x33, 0x91, 0x1d, 0xcf, 0xa6, 0xdd,
+	0xf1, 0x1b, 0x50, 0xe6, 0xa3, 0xcd, 0x71, 0xb1, 0x51, 0x06, 0x08, 0x0b, 0x07, 0xea, 0x1c, 0xc8,
+	0x5c, 0x38, 0xaa, 0xbe, 0x0d, 0xa5, 0xa0, 0x2a, 0x10, 0x54, 0xfb, 0x24, 0xae, 0xda, 0xf7, 0x12,
+	0x14, 0x9c, 0x4e, 0x0f, 0x1b, 0x41, 0xce, 0x70, 0xc2, 0x93, 0x95, 0xa3, 0x8f, 0x94, 0x3f, 0x9f,
+	0x2c, 0x1c, 0x06, 0xae, 0xea, 0xd3, 0x20, 0x73, 0xf5, 0x83, 0xa4, 0xb5, 0xd4, 0x79, 0x28, 0xf3,
+	0x81, 0xaa, 0xfa, 0x5f, 0x09, 0xca, 0x7c, 0x8e, 0x8e, 0x9a, 0x50, 0xb6, 0x49, 0xf5, 0xb8, 0xdf,
+	0xc4, 0xfb, 0x7d, 0xb7, 0xe7, 0x3d, 0x09, 0x97, 0xbe, 0x38, 0x5e, 0x7a, 0xf9, 0x4b, 0xd4, 0xc6,
+	0x98, 0xa2, 0x5d, 0xb7, 0xa7, 0xc9, 0x76, 0x38, 0x60, 0x59, 0x8e, 0xe9, 0x3a, 0xcc, 0x50, 0x05,
+	0x0a, 0x8f, 0x5e, 0x15, 0x9a, 0x72, 0xee, 0x89, 0x3e, 0x84, 0x15, 0x9c, 0x82, 0xb9, 0x48, 0x68,
+	0xab, 0x5a, 0x20, 0x73, 0x25, 0x86, 0x47, 0xc2, 0x81, 0x7a, 0x13, 0xca, 0x7c, 0x60, 0x4c, 0x62,
+	0x73, 0x16, 0xc4, 0xb0, 0x0d, 0x1b, 0x95, 0x73, 0x05, 0xd9, 0xaa, 0xc7, 0x8a, 0x2f, 0xac, 0xde,
+	0x03, 0x08, 0x6b, 0x1d, 0x8f, 0xe6, 0x56, 0xe6, 0x40, 0xe6, 0xe2, 0x73, 0xf5, 0xa7, 0x12, 0x40,
+	0x58, 0x2d, 0xe1, 0x36, 0x5b, 0x1a, 0xc3, 0x66, 0xcf, 0xa4, 0x7f, 0xe4, 0x65, 0x2e, 0xf4, 0x1f,
+	0x1b, 0xcd, 0x4f, 0xd3, 0x97, 0xb7, 0xfd, 0xf2, 0x49, 0xe2, 0xb3, 0xa6, 0x41, 0x99, 0x4f, 0x18,
+	0x50, 0x2d, 0x4c, 0x05, 0xd9, 0xd2, 0xea, 0xc8, 0x54, 0xf0, 0xc8, 0x5f, 0xd6, 0x13, 0x54, 0x35,
+	0xfa, 0x15, 0x48, 0xb0, 0x6c, 0x3a, 0x6e, 0xc3, 0x2f, 0x4b, 0x78, 0x6e, 0x89, 0x25, 0xf2, 0x29,
+	0x49, 0x5a, 0x8a, 0xb8, 0xef, 0x41, 0xa2, 0x14, 0x7d, 0xc3, 0x7f, 0x3b, 0xfb, 0x44, 0x96, 0x6e,
+	0xc0, 0xa9, 0x58, 0x1e, 0x33, 0x16, 0xa2, 0xf6, 0xc8, 0xbb, 0x7a, 0x7c, 0x51, 0x8a, 0x68, 0xa5,
+	0x67, 0x91, 0xa0, 0x56, 0xea, 0xed, 0x7d, 0xad, 0x9e, 0xa0, 0xfa, 0x18, 0x9c, 0x8a, 0xa5, 0x49,
+	0x6a, 0x1f, 0xf2, 0xec, 0x54, 0x42, 0x90, 0xeb, 0xeb, 0x3d, 0xec, 0x9d, 0x81, 0xf4, 0x37, 0x7a,
+	0x0a, 0x4a, 0x77, 0x6c, 0xa3, 0xbf, 0x4f, 0x0e, 0x62, 0xd6, 0x26, 0xaa, 0x67, 0xb4, 0x22, 0xb9,
+	0xd4, 0xd0, 0x9d, 0x03, 0xf4, 0x0c, 0xcc, 0xb1, 0xfe, 0xd0, 0x7e, 0x17, 0xf7, 0xdb, 0xce, 0x01,
+	0x75, 0x65, 0x73, 0xf5, 0x8c, 0x56, 0x66, 0x97, 0xaf, 0xd1, 0xab, 0x35, 0x19, 0x4a, 0xf8, 0xd0,
+	0xb1, 0xf4, 0xa6, 0x63, 0x58, 0xea, 0x8f, 0x00, 0xc2, 0x22, 0x19, 0x39, 0xf1, 0xc3, 0x88, 0xa5,
+	0xe4, 0x47, 0x1d, 0xeb, 0x7e, 0xb6, 0x26, 0xd2, 0xdc, 0xa7, 0xe8, 0xa9, 0x4a, 0xff, 0x50, 0xa3,
+	0xb2, 0x44, 0x75, 0xb7, 0x43, 0x72, 0x7c, 0x0a, 0x4a, 0x63, 0x03, 0xf5, 0x2e, 0x40, 0x28, 0x80,
+	0x14, 0x98, 0xc5, 0xf7, 0x5c, 0xbd, 0xcb, 0xda, 0x7e, 0x65, 0x5a, 0x6a, 0xa0, 0x63, 0x74, 0x11,
+	0xf2, 0x96, 0xde, 0x6f, 0x8b, 0x3d, 0xb0, 0x1a, 0x99, 0x49, 0x52, 0x45, 0x2a, 0x42, 0xca, 0x72,
+	0x14, 0x82, 0xfa, 0x3d, 0x90, 0xb9, 0x5c, 0x12, 0xd5, 0xa1, 0x60, 0x61, 0xdb, 0xed, 0x06, 0x86,
+	0x29, 0x90, 0x86, 0x6a, 0x54, 0xc0, 0xdf, 0x47, 0x4f, 0x5c, 0xbd, 0x03, 0x32, 0xf7, 0x2f, 0xfa,
+	0x2e, 0xe4, 0x89, 0xdd, 0x1c, 0x29, 0x92, 0x00, 0x58, 0xde, 0xdc, 0x98, 0x18, 0x7a, 0x12, 0x4a,
+	0x94, 0xf8, 0x7d, 0xbf, 0x1b, 0x58, 0xd6, 0x8a, 0xf4, 0xc2, 0x55, 0x7c, 0xa4, 0xda, 0x90, 0xa7,
+	0xf7, 0x87, 0xbe, 0x05, 0xd9, 0x5e, 0xa7, 0x2f, 0xb4, 0x46, 0xcd, 0x70, 0xfb, 0x2d, 0x8d, 0x4c,
+	0xa7, 0x52, 0xfa, 0xa1, 0x32, 0x93, 0x42, 0x4a, 0x3f, 0x54, 0x9f, 0x82, 0x3c, 0x1d, 0x25, 0x37,
+	0xe6, 0xe8, 0xbb, 0x8d, 0x7c, 0x97, 0x80, 0x4c, 0xfb, 0x21, 0xcd, 0xf3, 0x24, 0x9a, 0x8d, 0xb0,
+	0x01, 0xd7, 0xcd, 0x9c, 0x89, 0x74, 0x33, 0x9f, 0x84, 0x92, 0xed, 0xe8, 0x96, 0x43, 0xef, 0x97,
+	0x35, 0x3a, 0x8b, 0xf4, 0xc2, 0x55, 0x7c, 0x84, 0xbe, 0x4a, 0x9e, 0xde, 0x16, 0xfd, 0x8b, 0xb5,
+	0x3a, 0x67, 0x71, 0xbf, 0x75, 0x15, 0x73, 0x06, 0x95, 0xe7, 0x0c, 0x8a, 0x5c, 0xd5, 0x6f, 0x3b,
+	0xd8, 0xa2, 0xb9, 0x69, 0x49, 0x63, 0x03, 0xf5, 0x3a, 0xcc, 0x45, 0x4a, 0x61, 0x0f, 0xbb, 0x45,
+	0xea, 0x7f, 0x24, 0x90, 0xb9, 0x8e, 0x46, 0x42, 0xc0, 0x3a, 0xec, 0x66, 0xb9, 0xd6, 0x6d, 0x36,
+	0xb9, 0x75, 0x9b, 0x4b, 0x6a, 0xdd, 0xe6, 0x1f, 0xb6, 0x75, 0xfb, 0x0a, 0x94, 0x82, 0x6b, 0xa8,
+	0x08, 0xb9, 0xdd, 0xeb, 0xbb, 0x9b, 0x0b, 0x19, 0x54, 0x86, 0xe2, 0xf6, 0xee, 0x1b, 0x9b, 0xda,
+	0xde, 0xe6, 0xc6, 0x82, 0x84, 0x64, 0x28, 0xdc, 0x68, 0x6c, 0x5c, 0x26, 0x83, 0x19, 0x32, 0xd0,
+	0x36, 0x77, 0xae, 0xdf, 0xdc, 0xdc, 0x58, 0xc8, 0xaa, 0xbb, 0x50, 0xe6, 0xab, 0x80, 0x94, 0x40,
+	0x32, 0x16, 0x23, 0x90, 0xcc, 0x0c, 0x08, 0x24, 0x03, 0xf5, 0x83, 0x3c, 0xe4, 0xe9, 0xe5, 0x04,
+	0xea, 0xb6, 0xa1, 0xc8, 0x4a, 0xcf, 0xb8, 0x25, 0xf4, 0x7e, 0x16, 0xd5, 0xe3, 0x55, 0xaf, 0x71,
+	0x8b, 0xb8, 0x44, 0x5f, 0x9c, 0x1c, 0x3e, 0xac, 0x00, 0xdd, 0x52, 0xb2, 0x02, 0x25, 0x4a, 0xa6,
+	0x89, 0x25, 0x71, 0x44, 0x91, 0x2f, 0x4c, 0xf4, 0xb0, 0x5a, 0x58, 0x4b, 0xc9, 0x09, 0xeb, 0x61,
+	0x07, 0x11, 0xd5, 0xe3, 0x09, 0x87, 0x05, 0x90, 0x7c, 0xca, 0x02, 0x48, 0xe5, 0x75, 0x28, 0xfa,
+	0x77, 0x38, 0xa6, 0x3c, 0xa3, 0xf2, 0x91, 0x04, 0x05, 0xef, 0x5e, 0xc7, 0xa4, 0x72, 0xdc, 0x09,
+	0x6a, 0xe5, 0x0e, 0x14, 0x3c, 0x16, 0xc7, 0x85, 0x50, 0x81, 0x02, 0x6b, 0xe1, 0x33, 0xdb, 0x2a,
+	0x6a, 0xfe, 0x90, 0x9c, 0x13, 0xcc, 0x36, 0xdf, 0x86, 0xfc, 0xb0, 0x57, 0x1c, 0x5e, 0x8d, 0xbe,
+	0xe2, 0x90, 0xa2, 0x42, 0xe4, 0xb9, 0xca, 0x0b, 0x90, 0xa7, 0xf1, 0x64, 0xe2, 0xf9, 0x7e, 0x1a,
+	0xf2, 0x8e, 0x71, 0x17, 0xf7, 0x59, 0x7e, 0xab, 0xb1, 0x81, 0xba, 0x07, 0xe8, 0xc1, 0xee, 0x13,
+	0x79, 0x00, 0x59, 0x08, 0x2b, 0x89, 0x86, 0xb0, 0x3e, 0x0f, 0x2c, 0x90, 0x7d, 0x02, 0x1e, 0x4f,
+	0xa8, 0x86, 0xaa, 0x7b, 0x90, 0xa7, 0x66, 0x87, 0x96, 0x00, 0xfc, 0x58, 0xa3, 0x79, 0x10, 0x9c,
+	0xc7, 0x25, 0x2f, 0xd8, 0x68, 0x1e, 0xa0, 0xa7, 0x41, 0xee, 0x61, 0xab, 0x8d, 0xbd, 0x19, 0x33,
+	0xde, 0x0c, 0xa0, 0x17, 0xe9, 0x14, 0xc2, 0x28, 0xfd, 0x53, 0x1d, 0x40, 0x99, 0x27, 0xe3, 0x51,
+	0xbd, 0xdf, 0xb1, 0xf6, 0xf1, 0x02, 0x64, 0x77, 0x74, 0x13, 0xbd, 0x03, 0x39, 0x52, 0xbb, 0x45,
+	0xcb, 0x23, 0x9b, 0x6f, 0xde, 0xbb, 0x4e, 0x95, 0xb3, 0x02, 0x33, 0xbd, 0xb7, 0x95, 0xde, 0x84,
+	0x6c, 0xc3, 0x75, 0xd0, 0x73, 0xa3, 0xc2, 0x5a, 0x5f, 0xf5, 0xf2, 0xe8, 0x89, 0x9e, 0x66, 0x1d,
+	0x66, 0xd9, 0x33, 0x8f, 0xce, 0x09, 0x34, 0xee, 0x7c, 0xfd, 0x2b, 0x42, 0x73, 0xc3, 0x25, 0x98,
+	0x0b, 0x18, 0xb1, 0x44, 0xe4, 0xfb, 0xe1, 0xca, 0x8a, 0xd0, 0xdc, 0x90, 0x9f, 0x2d, 0x3c, 0x8a,
+	0x9f, 0x2d, 0x2c, 0xc8, 0x0f, 0xff, 0x8d, 0xab, 0x0e, 0xb3, 0xcc, 0x3b, 0x8c, 0x00, 0x1f, 0xf9,
+	0xf0, 0xb4, 0xb2, 0x22, 0x34, 0xd7, 0x5b, 0xe2, 0xfb, 0x90, 0xa7, 0xd5, 0x17, 0x74, 0x76, 0x74,
+	0xc3, 0xd0, 0x5f, 0xe0, 0x9c, 0xc8, 0x54, 0x4f, 0xff, 0x3b, 0x90, 0x23, 0x25, 0x97, 0x11, 0x96,
+	0xc9, 0x7d, 0xa7, 0x58, 0x39, 0x2b, 0x30, 0x93, 0xdb, 0x5c, 0xd6, 0x37, 0x3c, 0x27, 0xd0, 0x6c,
+	0x14, 0xdc, 0xdc, 0xe8, 0xc7, 0x74, 0x4d, 0x98, 0x65, 0x71, 0x01, 0x12, 0x38, 0x11, 0x6d, 0xb1,
+	0x25, 0xa2, 0x6f, 0x03, 0xbe, 0x20, 0xa1, 0xdb, 0x50, 0xf0, 0xc2, 0x37, 0xb4, 0x22, 0xd2, 0xef,
+	0xf4, 0x97, 0x39, 0x2f, 0x36, 0x39, 0x58, 0xa7, 0x05, 0x05, 0xaf, 0x92, 0x33, 0x62, 0x9d, 0xe8,
+	0x97, 0x7a, 0x95, 0xf3, 0x62, 0x93, 0xc3, 0x5d, 0x61, 0xa5, 0x9a, 0x11, 0x94, 0x45, 0xbe, 0x5f,
+	0xab, 0xac, 0x08, 0xcd, 0x0d, 0xad, 0x96, 0x96, 0x50, 0x46, 0x58, 0x2d, 0xff, 0x59, 0x57, 0xe5,
+	0x9c, 0xc8, 0x54, 0x4e, 0x3f, 0xed, 0x78, 0x9e, 0x1d, 0xdd, 0x24, 0x15, 0xd4, 0x1f, 0xf9, 0xf2,
+	0x48, 0x87, 0x59, 0x56, 0xeb, 0x18, 0x41, 0x51, 0xe4, 0x4b, 0xa0, 0xca, 0x8a, 0xd0, 0xdc, 0x70,
+	0x89, 0x86, 0x2b, 0xb0, 0x44, 0xc3, 0x15, 0x5f, 0x22, 0xf6, 0xc1, 0xca, 0x1d, 0x28, 0x05, 0xb5,
+	0x08, 0xf4, 0xbc, 0x58, 0xef, 0xd5, 0x5f, 0x68, 0x55, 0x74, 0x7a, 0xb8, 0x56, 0x50, 0x4a, 0x18,
+	0xb1, 0x56, 0xfc, 0xbb, 0x8a, 0xca, 0xaa, 0xe8, 0xf4, 0x70, 0xf7, 0x59, 0xbe, 0x7e, 0x56, 0x24,
+	0x61, 0x16, 0xd9, 0xfd, 0xe8, 0x5b, 0xff, 0x03, 0x98, 0x8b, 0x84, 0x26, 0xe8, 0x82, 0x78, 0x53,
+	0xd7, 0x5f, 0x6f, 0x2d, 0x8d, 0x08, 0x5b, 0xb7, 0xa6, 0x7c, 0x7a, 0x7f, 0x51, 0xfa, 0xec, 0xfe,
+	0xa2, 0xf4, 0xef, 0xfb, 0x8b, 0xd2, 0x7b, 0x9f, 0x2f, 0x66, 0x3e, 0xfb, 0x7c, 0x31, 0xf3, 0x8f,
+	0xcf, 0x17, 0x33, 0xb7, 0x66, 0xa9, 0x96, 0x6f, 0xfe, 0x6f, 0x00, 0x73, 0x67, 0xb4, 0xc0, 0xa9,
+	0x47, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MapClient is the client API for Map service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MapClient interface {
+	// Size returns the size of the map
+	Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error)
+	// Put puts an entry into the map
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	// Insert inserts an entry into the map
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Get gets the entry for a key
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	// Clear removes all entries from the map
+	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error)
+	// Entries lists all entries in the map
+	Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error)
+	// Prepare prepares a transaction
+	Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (*PrepareResponse, error)
+	// Commit commits a transaction
+	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
+	// Abort aborts a transaction
+	Abort(ctx context.Context, in *AbortRequest, opts ...grpc.CallOption) (*AbortResponse, error)
+	// Apply prepares and commits a transaction in a single request
+	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
+	// GetAll gets the entries for a set of keys
+	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
+	// PutAll puts a set of entries into the map
+	PutAll(ctx context.Context, in *PutAllRequest, opts ...grpc.CallOption) (*PutAllResponse, error)
+	// RemoveAll removes a set of keys from the map
+	RemoveAll(ctx context.Context, in *RemoveAllRequest, opts ...grpc.CallOption) (*RemoveAllResponse, error)
+	// Configure configures the secondary indexes of the map
+	Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error)
+	// Query gets the entries whose values match a query on a secondary index
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// ValidateFence validates a fencing token in the partition of the fencing primitive
+	ValidateFence(ctx context.Context, in *ValidateFenceRequest, opts ...grpc.CallOption) (*ValidateFenceResponse, error)
+}
+
+type mapClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMapClient(cc *grpc.ClientConn) MapClient {
+	return &mapClient{cc}
+}
+
+func (c *mapClient) Size(ctx context.Context, in *SizeRequest, opts ...grpc.CallOption) (*SizeResponse, error) {
+	out := new(SizeResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Size", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Put", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
+	out := new(InsertResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EntriesInput != nil {
-		{
-			size, err := m.EntriesInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *mapClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EntriesOutput != nil {
-		{
-			size, err := m.EntriesOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *mapClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error) {
+	out := new(ClearResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Clear", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventsInput != nil {
-		{
-			size, err := m.EventsInput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *mapClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Map_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[0], "/atomix.protocols.rsm.map.v1.Map/Events", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &mapEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Map_EventsClient interface {
+	Recv() (*EventsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventsOutput != nil {
-		{
-			size, err := m.EventsOutput.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+type mapEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mapEventsClient) Recv() (*EventsResponse, error) {
+	m := new(EventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *MapListener) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Entries(ctx context.Context, in *EntriesRequest, opts ...grpc.CallOption) (Map_EntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Map_serviceDesc.Streams[1], "/atomix.protocols.rsm.map.v1.Map/Entries", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &mapEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *MapListener) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Map_EntriesClient interface {
+	Recv() (*EntriesResponse, error)
+	grpc.ClientStream
 }
 
-func (m *MapListener) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Index != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+type mapEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *mapEntriesClient) Recv() (*EntriesResponse, error) {
+	m := new(EntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *MapEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (*PrepareResponse, error) {
+	out := new(PrepareResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Prepare", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MapEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (c *mapClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error) {
+	out := new(CommitResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MapValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Abort(ctx context.Context, in *AbortRequest, opts ...grpc.CallOption) (*AbortResponse, error) {
+	out := new(AbortResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Abort", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MapValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
+	out := new(ApplyResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Apply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MapValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expire != nil {
-		n62, err62 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expire, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expire):])
-		if err62 != nil {
-			return 0, err62
-		}
-		i -= n62
-		i = encodeVarintMap(dAtA, i, uint64(n62))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Index != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (c *mapClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
+	out := new(GetAllResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/GetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MapInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) PutAll(ctx context.Context, in *PutAllRequest, opts ...grpc.CallOption) (*PutAllResponse, error) {
+	out := new(PutAllResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/PutAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MapInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) RemoveAll(ctx context.Context, in *RemoveAllRequest, opts ...grpc.CallOption) (*RemoveAllResponse, error) {
+	out := new(RemoveAllResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/RemoveAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MapInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (c *mapClient) Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error) {
+	out := new(ConfigureResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Configure", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MapInput_Size_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *mapClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MapInput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Size_ != nil {
-		{
-			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *mapClient) ValidateFence(ctx context.Context, in *ValidateFenceRequest, opts ...grpc.CallOption) (*ValidateFenceResponse, error) {
+	out := new(ValidateFenceResponse)
+	err := c.cc.Invoke(ctx, "/atomix.protocols.rsm.map.v1.Map/ValidateFence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapInput_Put) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MapInput_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Put != nil {
-		{
-			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+// MapServer is the server API for Map service.
+type MapServer interface {
+	// Size returns the size of the map
+	Size(context.Context, *SizeRequest) (*SizeResponse, error)
+	// Put puts an entry into the map
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	// Insert inserts an entry into the map
+	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
+	// Update updates an entry in the map
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Get gets the entry for a key
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// Remove removes an entry from the map
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+	// Clear removes all entries from the map
+	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
+	// Lock locks a key in the map
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// Unlock unlocks a key in the map
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Events listens for change events
+	Events(*EventsRequest, Map_EventsServer) error
+	// Entries lists all entries in the map
+	Entries(*EntriesRequest, Map_EntriesServer) error
+	// Prepare prepares a transaction
+	Prepare(context.Context, *PrepareRequest) (*PrepareResponse, error)
+	// Commit commits a transaction
+	Commit(context.Context, *CommitRequest) (*CommitResponse, error)
+	// Abort aborts a transaction
+	Abort(context.Context, *AbortRequest) (*AbortResponse, error)
+	// Apply prepares and commits a transaction in a single request
+	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
+	// GetAll gets the entries for a set of keys
+	GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error)
+	// PutAll puts a set of entries into the map
+	PutAll(context.Context, *PutAllRequest) (*PutAllResponse, error)
+	// RemoveAll removes a set of keys from the map
+	RemoveAll(context.Context, *RemoveAllRequest) (*RemoveAllResponse, error)
+	// Configure configures the secondary indexes of the map
+	Configure(context.Context, *ConfigureRequest) (*ConfigureResponse, error)
+	// Query gets the entries whose values match a query on a secondary index
+	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// ValidateFence validates a fencing token in the partition of the fencing primitive
+	ValidateFence(context.Context, *ValidateFenceRequest) (*ValidateFenceResponse, error)
 }
-func (m *MapInput_Insert) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// UnimplementedMapServer can be embedded to have forward compatible implementations.
+type UnimplementedMapServer struct {
 }
 
-func (m *MapInput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Insert != nil {
-		{
-			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Size(ctx context.Context, req *SizeRequest) (*SizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Size not implemented")
 }
-func (m *MapInput_Update) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Put(ctx context.Context, req *PutRequest) (*PutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
 }
-
-func (m *MapInput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Update != nil {
-		{
-			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Insert(ctx context.Context, req *InsertRequest) (*InsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
 }
-func (m *MapInput_Get) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-
-func (m *MapInput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Get != nil {
-		{
-			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (m *MapInput_Remove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
 }
-
-func (m *MapInput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Clear(ctx context.Context, req *ClearRequest) (*ClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
 }
-func (m *MapInput_Clear) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Lock(ctx context.Context, req *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
 }
-
-func (m *MapInput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Clear != nil {
-		{
-			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Unlock(ctx context.Context, req *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
 }
-func (m *MapInput_Lock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Events(req *EventsRequest, srv Map_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
 }
-
-func (m *MapInput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Lock != nil {
-		{
-			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Entries(req *EntriesRequest, srv Map_EntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Entries not implemented")
 }
-func (m *MapInput_Unlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Prepare(ctx context.Context, req *PrepareRequest) (*PrepareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prepare not implemented")
 }
-
-func (m *MapInput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Unlock != nil {
-		{
-			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Commit(ctx context.Context, req *CommitRequest) (*CommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
 }
-func (m *MapInput_Entries) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Abort(ctx context.Context, req *AbortRequest) (*AbortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Abort not implemented")
 }
-
-func (m *MapInput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Entries != nil {
-		{
-			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Apply(ctx context.Context, req *ApplyRequest) (*ApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
 }
-func (m *MapInput_Events) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) GetAll(ctx context.Context, req *GetAllRequest) (*GetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
-
-func (m *MapInput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) PutAll(ctx context.Context, req *PutAllRequest) (*PutAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutAll not implemented")
 }
-func (m *MapInput_Prepare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) RemoveAll(ctx context.Context, req *RemoveAllRequest) (*RemoveAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAll not implemented")
 }
-
-func (m *MapInput_Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Prepare != nil {
-		{
-			size, err := m.Prepare.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) Configure(ctx context.Context, req *ConfigureRequest) (*ConfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
 }
-func (m *MapInput_Commit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMapServer) Query(ctx context.Context, req *QueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
-
-func (m *MapInput_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMapServer) ValidateFence(ctx context.Context, req *ValidateFenceRequest) (*ValidateFenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFence not implemented")
 }
-func (m *MapInput_Abort) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func RegisterMapServer(s *grpc.Server, srv MapServer) {
+	s.RegisterService(&_Map_serviceDesc, srv)
 }
 
-func (m *MapInput_Abort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Abort != nil {
-		{
-			size, err := m.Abort.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+func _Map_Size_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapInput_Apply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MapServer).Size(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Size",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Size(ctx, req.(*SizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapInput_Apply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Apply != nil {
-		{
-			size, err := m.Apply.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
+func _Map_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MapServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Put(ctx, req.(*PutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MapOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+
+func _Map_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MapServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Insert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Map_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Output != nil {
-		{
-			size := m.Output.Size()
-			i -= size
-			if _, err := m.Output.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func _Map_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MapServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Size_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Map_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Size_ != nil {
-		{
-			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Map_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Put) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MapServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Clear(ctx, req.(*ClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Put != nil {
-		{
-			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Map_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MapServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MapOutput_Insert) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func _Map_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Insert != nil {
-		{
-			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Map_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(MapServer).Events(m, &mapEventsServer{stream})
 }
-func (m *MapOutput_Update) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type Map_EventsServer interface {
+	Send(*EventsResponse) error
+	grpc.ServerStream
 }
 
-func (m *MapOutput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Update != nil {
-		{
-			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+type mapEventsServer struct {
+	grpc.ServerStream
 }
-func (m *MapOutput_Get) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (x *mapEventsServer) Send(m *EventsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *MapOutput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Get != nil {
-		{
-			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func _Map_Entries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(MapServer).Entries(m, &mapEntriesServer{stream})
 }
-func (m *MapOutput_Remove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type Map_EntriesServer interface {
+	Send(*EntriesResponse) error
+	grpc.ServerStream
 }
 
-func (m *MapOutput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+type mapEntriesServer struct {
+	grpc.ServerStream
 }
-func (m *MapOutput_Clear) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (x *mapEntriesServer) Send(m *EntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *MapOutput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Clear != nil {
-		{
-			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func _Map_Prepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Lock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MapOutput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Lock != nil {
-		{
-			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	if interceptor == nil {
+		return srv.(MapServer).Prepare(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Unlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MapOutput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Unlock != nil {
-		{
-			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Prepare",
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Entries) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Prepare(ctx, req.(*PrepareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Entries != nil {
-		{
-			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+func _Map_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Events) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MapServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Commit(ctx, req.(*CommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+func _Map_Abort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Prepare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MapServer).Abort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Abort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Abort(ctx, req.(*AbortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Prepare != nil {
-		{
-			size, err := m.Prepare.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+func _Map_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Commit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MapServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Apply(ctx, req.(*ApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+func _Map_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Abort) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MapServer).GetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/GetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).GetAll(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Abort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Abort != nil {
-		{
-			size, err := m.Abort.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+func _Map_PutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MapOutput_Apply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MapServer).PutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/PutAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).PutAll(ctx, req.(*PutAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MapOutput_Apply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Apply != nil {
-		{
-			size, err := m.Apply.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMap(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
+func _Map_RemoveAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MapServer).RemoveAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/RemoveAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).RemoveAll(ctx, req.(*RemoveAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *SizeInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+
+func _Map_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MapServer).Configure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Configure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Configure(ctx, req.(*ConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SizeInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Map_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SizeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Map_ValidateFence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServer).ValidateFence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomix.protocols.rsm.map.v1.Map/ValidateFence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServer).ValidateFence(ctx, req.(*ValidateFenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SizeOutput) Marshal() (dAtA []byte, err error) {
+var _Map_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomix.protocols.rsm.map.v1.Map",
+	HandlerType: (*MapServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Size",
+			Handler:    _Map_Size_Handler,
+		},
+		{
+			MethodName: "Put",
+			Handler:    _Map_Put_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _Map_Insert_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Map_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Map_Get_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _Map_Remove_Handler,
+		},
+		{
+			MethodName: "Clear",
+			Handler:    _Map_Clear_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Map_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Map_Unlock_Handler,
+		},
+		{
+			MethodName: "Prepare",
+			Handler:    _Map_Prepare_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _Map_Commit_Handler,
+		},
+		{
+			MethodName: "Abort",
+			Handler:    _Map_Abort_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _Map_Apply_Handler,
+		},
+		{
+			MethodName: "GetAll",
+			Handler:    _Map_GetAll_Handler,
+		},
+		{
+			MethodName: "PutAll",
+			Handler:    _Map_PutAll_Handler,
+		},
+		{
+			MethodName: "RemoveAll",
+			Handler:    _Map_RemoveAll_Handler,
+		},
+		{
+			MethodName: "Configure",
+			Handler:    _Map_Configure_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _Map_Query_Handler,
+		},
+		{
+			MethodName: "ValidateFence",
+			Handler:    _Map_ValidateFence_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _Map_Events_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Entries",
+			Handler:       _Map_Entries_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "map/v1/map.proto",
+}
+
+func (m *SizeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6920,25 +6922,44 @@ func (m *SizeOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SizeOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *SizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Size_))
+	if m.SizeInput != nil {
+		{
+			size, err := m.SizeInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PutInput) Marshal() (dAtA []byte, err error) {
+func (m *SizeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6948,49 +6969,44 @@ func (m *PutInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *SizeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PutInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevIndex != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TTL != nil {
-		n93, err93 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err93 != nil {
-			return 0, err93
+	if m.SizeOutput != nil {
+		{
+			size, err := m.SizeOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= n93
-		i = encodeVarintMap(dAtA, i, uint64(n93))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PutOutput) Marshal() (dAtA []byte, err error) {
+func (m *PutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7000,19 +7016,19 @@ func (m *PutOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PutOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevValue != nil {
+	if m.PutInput != nil {
 		{
-			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PutInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7022,15 +7038,22 @@ func (m *PutOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Index != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InsertInput) Marshal() (dAtA []byte, err error) {
+func (m *PutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7040,44 +7063,44 @@ func (m *InsertInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InsertInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TTL != nil {
-		n95, err95 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err95 != nil {
-			return 0, err95
+	if m.PutOutput != nil {
+		{
+			size, err := m.PutOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= n95
-		i = encodeVarintMap(dAtA, i, uint64(n95))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InsertOutput) Marshal() (dAtA []byte, err error) {
+func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7087,25 +7110,44 @@ func (m *InsertOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InsertOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	if m.InsertInput != nil {
+		{
+			size, err := m.InsertInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateInput) Marshal() (dAtA []byte, err error) {
+func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7115,49 +7157,44 @@ func (m *UpdateInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InsertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevIndex != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TTL != nil {
-		n96, err96 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err96 != nil {
-			return 0, err96
+	if m.InsertOutput != nil {
+		{
+			size, err := m.InsertOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= n96
-		i = encodeVarintMap(dAtA, i, uint64(n96))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateOutput) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7167,35 +7204,44 @@ func (m *UpdateOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.UpdateInput != nil {
+		{
+			size, err := m.UpdateInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Index != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetInput) Marshal() (dAtA []byte, err error) {
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7205,27 +7251,44 @@ func (m *GetInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.UpdateOutput != nil {
+		{
+			size, err := m.UpdateOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetOutput) Marshal() (dAtA []byte, err error) {
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7235,30 +7298,44 @@ func (m *GetOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.GetInput != nil {
+		{
+			size, err := m.GetInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveInput) Marshal() (dAtA []byte, err error) {
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7268,32 +7345,44 @@ func (m *RemoveInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PrevIndex != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.PrevIndex))
+	if m.GetOutput != nil {
+		{
+			size, err := m.GetOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveOutput) Marshal() (dAtA []byte, err error) {
+func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7303,30 +7392,44 @@ func (m *RemoveOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.RemoveInput != nil {
+		{
+			size, err := m.RemoveInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ClearInput) Marshal() (dAtA []byte, err error) {
+func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7336,43 +7439,44 @@ func (m *ClearInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClearInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *ClearOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ClearOutput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ClearOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RemoveOutput != nil {
+		{
+			size, err := m.RemoveOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LockInput) Marshal() (dAtA []byte, err error) {
+func (m *ClearRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7382,39 +7486,44 @@ func (m *LockInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LockInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClearRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timeout != nil {
-		n100, err100 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
-		if err100 != nil {
-			return 0, err100
+	if m.ClearInput != nil {
+		{
+			size, err := m.ClearInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= n100
-		i = encodeVarintMap(dAtA, i, uint64(n100))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LockOutput) Marshal() (dAtA []byte, err error) {
+func (m *ClearResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7424,20 +7533,44 @@ func (m *LockOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LockOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClearResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ClearOutput != nil {
+		{
+			size, err := m.ClearOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnlockInput) Marshal() (dAtA []byte, err error) {
+func (m *LockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7447,29 +7580,44 @@ func (m *UnlockInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnlockInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.LockInput != nil {
+		{
+			size, err := m.LockInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnlockOutput) Marshal() (dAtA []byte, err error) {
+func (m *LockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7479,20 +7627,44 @@ func (m *UnlockOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnlockOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.LockOutput != nil {
+		{
+			size, err := m.LockOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PrepareInput) Marshal() (dAtA []byte, err error) {
+func (m *UnlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7502,39 +7674,44 @@ func (m *PrepareInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrepareInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrepareInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMap(dAtA, i, uint64(size))
+	if m.UnlockInput != nil {
+		{
+			size, err := m.UnlockInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SequenceNum != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.SequenceNum))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PrepareOutput) Marshal() (dAtA []byte, err error) {
+func (m *UnlockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7544,20 +7721,44 @@ func (m *PrepareOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrepareOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnlockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrepareOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UnlockOutput != nil {
+		{
+			size, err := m.UnlockOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommitInput) Marshal() (dAtA []byte, err error) {
+func (m *PrepareRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7567,62 +7768,44 @@ func (m *CommitInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommitInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrepareRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrepareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SequenceNum != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.SequenceNum))
+	if m.PrepareInput != nil {
+		{
+			size, err := m.PrepareInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CommitOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CommitOutput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CommitOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMap(dAtA, i, uint64(size))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AbortInput) Marshal() (dAtA []byte, err error) {
+func (m *PrepareResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7632,25 +7815,44 @@ func (m *AbortInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AbortInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrepareResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AbortInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrepareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SequenceNum != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.SequenceNum))
+	if m.PrepareOutput != nil {
+		{
+			size, err := m.PrepareOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AbortOutput) Marshal() (dAtA []byte, err error) {
+func (m *CommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7660,20 +7862,44 @@ func (m *AbortOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AbortOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AbortOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CommitInput != nil {
+		{
+			size, err := m.CommitInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplyInput) Marshal() (dAtA []byte, err error) {
+func (m *CommitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7683,34 +7909,44 @@ func (m *ApplyInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplyInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplyInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMap(dAtA, i, uint64(size))
+	if m.CommitOutput != nil {
+		{
+			size, err := m.CommitOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplyOutput) Marshal() (dAtA []byte, err error) {
+func (m *AbortRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7720,34 +7956,44 @@ func (m *ApplyOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplyOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *AbortRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplyOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AbortRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMap(dAtA, i, uint64(size))
+	if m.AbortInput != nil {
+		{
+			size, err := m.AbortInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EntriesInput) Marshal() (dAtA []byte, err error) {
+func (m *AbortResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7757,30 +8003,44 @@ func (m *EntriesInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EntriesInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *AbortResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AbortResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Watch {
+	if m.AbortOutput != nil {
+		{
+			size, err := m.AbortOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Watch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EntriesOutput) Marshal() (dAtA []byte, err error) {
+func (m *ApplyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7790,30 +8050,44 @@ func (m *EntriesOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EntriesOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ApplyInput != nil {
+		{
+			size, err := m.ApplyInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventsInput) Marshal() (dAtA []byte, err error) {
+func (m *ApplyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7823,27 +8097,44 @@ func (m *EventsInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+	if m.ApplyOutput != nil {
+		{
+			size, err := m.ApplyOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *EventsOutput) Marshal() (dAtA []byte, err error) {
+func (m *GetAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7853,30 +8144,44 @@ func (m *EventsOutput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventsOutput) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.GetAllInput != nil {
+		{
+			size, err := m.GetAllInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
+func (m *GetAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7886,45 +8191,31 @@ func (m *Event) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
+	if m.GetAllOutput != nil {
 		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.GetAllOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Inserted != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7932,20 +8223,34 @@ func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *PutAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Updated != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.PutAllInput != nil {
 		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PutAllInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7953,20 +8258,11 @@ func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Removed != nil {
+	if m.Headers != nil {
 		{
-			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7974,11 +8270,12 @@ func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
+
+func (m *PutAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7988,30 +8285,44 @@ func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PutAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.PutAllOutput != nil {
+		{
+			size, err := m.PutAllOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
+func (m *RemoveAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8021,40 +8332,44 @@ func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.RemoveAllInput != nil {
+		{
+			size, err := m.RemoveAllInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+func (m *RemoveAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8064,40 +8379,44 @@ func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Expired {
-		i--
-		if m.Expired {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.RemoveAllOutput != nil {
+		{
+			size, err := m.RemoveAllOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintMap(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Entry) Marshal() (dAtA []byte, err error) {
+func (m *ConfigureRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8107,19 +8426,19 @@ func (m *Entry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigureRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
+	if m.ConfigureInput != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ConfigureInput.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8129,17 +8448,22 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IndexedValue) Marshal() (dAtA []byte, err error) {
+func (m *ConfigureResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8149,1524 +8473,10097 @@ func (m *IndexedValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IndexedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigureResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IndexedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		i = encodeVarintMap(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+	if m.ConfigureOutput != nil {
+		{
+			size, err := m.ConfigureOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.SizeInput != nil {
-		l = m.SizeInput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.QueryInput != nil {
+		{
+			size, err := m.QueryInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SizeOutput != nil {
-		l = m.SizeOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.PutInput != nil {
-		l = m.PutInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *QueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.QueryOutput != nil {
+		{
+			size, err := m.QueryOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PutOutput != nil {
-		l = m.PutOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InsertRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidateFenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateFenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateFenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.ValidateFenceInput != nil {
+		{
+			size, err := m.ValidateFenceInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.InsertInput != nil {
-		l = m.InsertInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InsertResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidateFenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateFenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateFenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.ValidateFenceOutput != nil {
+		{
+			size, err := m.ValidateFenceOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.InsertOutput != nil {
-		l = m.InsertOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.EntriesInput != nil {
+		{
+			size, err := m.EntriesInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UpdateInput != nil {
-		l = m.UpdateInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.UpdateOutput != nil {
-		l = m.UpdateOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *EntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.GetInput != nil {
-		l = m.GetInput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *EntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.EntriesOutput != nil {
+		{
+			size, err := m.EntriesOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GetOutput != nil {
-		l = m.GetOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.RemoveInput != nil {
-		l = m.RemoveInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *EventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.RemoveOutput != nil {
-		l = m.RemoveOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *EventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.EventsInput != nil {
+		{
+			size, err := m.EventsInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ClearInput != nil {
-		l = m.ClearInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClearResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.ClearOutput != nil {
-		l = m.ClearOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *EventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.EventsOutput != nil {
+		{
+			size, err := m.EventsOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LockInput != nil {
-		l = m.LockInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Headers != nil {
+		{
+			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MapListener) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.LockOutput != nil {
-		l = m.LockOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.UnlockInput != nil {
-		l = m.UnlockInput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapListener) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MapListener) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Types) > 0 {
+		dAtA86 := make([]byte, len(m.Types)*10)
+		var j85 int
+		for _, num := range m.Types {
+			for num >= 1<<7 {
+				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j85++
+			}
+			dAtA86[j85] = uint8(num)
+			j85++
+		}
+		i -= j85
+		copy(dAtA[i:], dAtA86[:j85])
+		i = encodeVarintMap(dAtA, i, uint64(j85))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.UnlockOutput != nil {
-		l = m.UnlockOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
-}
-
-func (m *PrepareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PrepareInput != nil {
-		l = m.PrepareInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Index != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PrepareResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.PrepareOutput != nil {
-		l = m.PrepareOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.CommitInput != nil {
-		l = m.CommitInput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MapEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CommitOutput != nil {
-		l = m.CommitOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AbortRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.AbortInput != nil {
-		l = m.AbortInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AbortResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.AbortOutput != nil {
-		l = m.AbortOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MapValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.SessionID != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ApplyInput != nil {
-		l = m.ApplyInput.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Expire != nil {
+		n88, err88 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expire, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expire):])
+		if err88 != nil {
+			return 0, err88
+		}
+		i -= n88
+		i = encodeVarintMap(dAtA, i, uint64(n88))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Index != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MapInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MapInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.ApplyOutput != nil {
-		l = m.ApplyOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EntriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.EntriesInput != nil {
-		l = m.EntriesInput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapInput_Size_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.EntriesOutput != nil {
-		l = m.EntriesOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Size_ != nil {
+		{
+			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *EventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.EventsInput != nil {
-		l = m.EventsInput.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapInput_Put) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.EventsOutput != nil {
-		l = m.EventsOutput.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Put != nil {
+		{
+			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapInput_Insert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapListener) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovMap(uint64(m.Index))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Insert != nil {
+		{
+			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapInput_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapInput_Get) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovMap(uint64(m.Index))
-	}
-	if m.Expire != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expire)
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Get != nil {
+		{
+			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapInput_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
+func (m *MapInput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
+		{
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapInput_Clear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapInput_Size_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Size_ != nil {
-		l = m.Size_.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Clear != nil {
+		{
+			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Put) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
-}
-func (m *MapInput_Insert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Insert != nil {
-		l = m.Insert.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
-}
-func (m *MapInput_Update) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
-}
-func (m *MapInput_Get) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
-}
-func (m *MapInput_Remove) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
-}
-func (m *MapInput_Clear) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Clear != nil {
-		l = m.Clear.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapInput_Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MapInput_Lock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *MapInput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Lock != nil {
-		l = m.Lock.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Unlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MapInput_Unlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Unlock != nil {
-		l = m.Unlock.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Entries) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MapInput_Entries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Entries != nil {
-		l = m.Entries.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Events) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MapInput_Events) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Prepare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MapInput_Prepare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Prepare != nil {
-		l = m.Prepare.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Prepare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Commit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MapInput_Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Abort) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MapInput_Abort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_Abort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Abort != nil {
-		l = m.Abort.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Abort.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapInput_Apply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MapInput_Apply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_Apply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Apply != nil {
-		l = m.Apply.Size()
-		n += 1 + l + sovMap(uint64(l))
+		{
+			size, err := m.Apply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		n += m.Output.Size()
-	}
-	return n
+func (m *MapInput_GetAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapOutput_Size_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Size_ != nil {
-		l = m.Size_.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_GetAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GetAll != nil {
+		{
+			size, err := m.GetAll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Put) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapInput_PutAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MapOutput_Insert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Insert != nil {
-		l = m.Insert.Size()
-		n += 1 + l + sovMap(uint64(l))
+
+func (m *MapInput_PutAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PutAll != nil {
+		{
+			size, err := m.PutAll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Update) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapInput_RemoveAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MapOutput_Get) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovMap(uint64(l))
+
+func (m *MapInput_RemoveAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveAll != nil {
+		{
+			size, err := m.RemoveAll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Remove) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapInput_Configure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MapOutput_Clear) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Clear != nil {
-		l = m.Clear.Size()
-		n += 1 + l + sovMap(uint64(l))
+
+func (m *MapInput_Configure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Configure != nil {
+		{
+			size, err := m.Configure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Lock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Lock != nil {
-		l = m.Lock.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapInput_Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MapOutput_Unlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Unlock != nil {
-		l = m.Unlock.Size()
-		n += 1 + l + sovMap(uint64(l))
+
+func (m *MapInput_Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Entries) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Entries != nil {
-		l = m.Entries.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapInput_ValidateFence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapInput_ValidateFence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ValidateFence != nil {
+		{
+			size, err := m.ValidateFence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Events) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MapOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MapOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MapOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Output != nil {
+		{
+			size := m.Output.Size()
+			i -= size
+			if _, err := m.Output.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Prepare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Prepare != nil {
-		l = m.Prepare.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+
+func (m *MapOutput_Size_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MapOutput_Commit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovMap(uint64(l))
+
+func (m *MapOutput_Size_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Size_ != nil {
+		{
+			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MapOutput_Abort) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Abort != nil {
-		l = m.Abort.Size()
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+func (m *MapOutput_Put) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MapOutput_Apply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Apply != nil {
-		l = m.Apply.Size()
-		n += 1 + l + sovMap(uint64(l))
+
+func (m *MapOutput_Put) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Put != nil {
+		{
+			size, err := m.Put.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SizeInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MapOutput_Insert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SizeOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		n += 1 + sovMap(uint64(m.Size_))
+func (m *MapOutput_Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Insert != nil {
+		{
+			size, err := m.Insert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PutInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.PrevIndex != 0 {
-		n += 1 + sovMap(uint64(m.PrevIndex))
+func (m *MapOutput_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_Get) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PutOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovMap(uint64(m.Index))
-	}
-	if m.PrevValue != nil {
-		l = m.PrevValue.Size()
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Get) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Get != nil {
+		{
+			size, err := m.Get.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
+		{
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_Clear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InsertOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovMap(uint64(m.Index))
+func (m *MapOutput_Clear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Clear != nil {
+		{
+			size, err := m.Clear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.TTL != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.PrevIndex != 0 {
-		n += 1 + sovMap(uint64(m.PrevIndex))
+func (m *MapOutput_Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Lock != nil {
+		{
+			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *UpdateOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovMap(uint64(m.Index))
-	}
-	l = m.PrevValue.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+func (m *MapOutput_Unlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Unlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Unlock != nil {
+		{
+			size, err := m.Unlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *GetOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+func (m *MapOutput_Entries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.PrevIndex != 0 {
-		n += 1 + sovMap(uint64(m.PrevIndex))
+func (m *MapOutput_Entries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Entries != nil {
+		{
+			size, err := m.Entries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *RemoveOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+func (m *MapOutput_Events) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MapOutput_Events) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *ClearOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MapOutput_Prepare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Prepare != nil {
+		{
+			size, err := m.Prepare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.Timeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *LockOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MapOutput_Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnlockInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *UnlockOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MapOutput_Abort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrepareInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SequenceNum != 0 {
-		n += 1 + sovMap(uint64(m.SequenceNum))
-	}
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Abort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Abort != nil {
+		{
+			size, err := m.Abort.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x72
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *PrepareOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MapOutput_Apply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SequenceNum != 0 {
-		n += 1 + sovMap(uint64(m.SequenceNum))
+func (m *MapOutput_Apply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Apply != nil {
+		{
+			size, err := m.Apply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_GetAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommitOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_GetAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GetAll != nil {
+		{
+			size, err := m.GetAll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_PutAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AbortInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SequenceNum != 0 {
-		n += 1 + sovMap(uint64(m.SequenceNum))
+func (m *MapOutput_PutAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PutAll != nil {
+		{
+			size, err := m.PutAll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_RemoveAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AbortOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MapOutput_RemoveAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveAll != nil {
+		{
+			size, err := m.RemoveAll.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_Configure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplyInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Configure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Configure != nil {
+		{
+			size, err := m.Configure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplyOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovMap(uint64(l))
+func (m *MapOutput_Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MapOutput_ValidateFence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EntriesInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MapOutput_ValidateFence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ValidateFence != nil {
+		{
+			size, err := m.ValidateFence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	var l int
-	_ = l
-	if m.Watch {
-		n += 2
+	return len(dAtA) - i, nil
+}
+func (m *SizeInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EntriesOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Entry.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+func (m *SizeInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventsInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SizeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventsOutput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SizeOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Event.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.Event != nil {
-		n += m.Event.Size()
-	}
-	return n
+func (m *SizeOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event_Inserted_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SizeOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Inserted != nil {
-		l = m.Inserted.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Size_ != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Event_Updated_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovMap(uint64(l))
+
+func (m *PutInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Event_Removed_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PutInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Removed != nil {
-		l = m.Removed.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Ephemeral {
+		i--
+		if m.Ephemeral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PrevIndex != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TTL != nil {
+		n133, err133 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err133 != nil {
+			return 0, err133
+		}
+		i -= n133
+		i = encodeVarintMap(dAtA, i, uint64(n133))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Event_Inserted) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PutOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PutOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	if m.PrevValue != nil {
+		{
+			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Updated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InsertInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	l = m.PrevValue.Size()
-	n += 1 + l + sovMap(uint64(l))
-	return n
+	if m.Ephemeral {
+		i--
+		if m.Ephemeral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TTL != nil {
+		n135, err135 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err135 != nil {
+			return 0, err135
+		}
+		i -= n135
+		i = encodeVarintMap(dAtA, i, uint64(n135))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Event_Removed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InsertOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Value.Size()
-	n += 1 + l + sovMap(uint64(l))
-	if m.Expired {
-		n += 2
+	if m.Index != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Entry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovMap(uint64(l))
+	if m.PrevIndex != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TTL != nil {
+		n137, err137 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err137 != nil {
+			return 0, err137
+		}
+		i -= n137
+		i = encodeVarintMap(dAtA, i, uint64(n137))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrevIndex != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.PrevIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LockInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		n142, err142 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err142 != nil {
+			return 0, err142
+		}
+		i -= n142
+		i = encodeVarintMap(dAtA, i, uint64(n142))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepareInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepareInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SequenceNum != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepareOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepareOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SequenceNum != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbortInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbortInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbortInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SequenceNum != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbortOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbortOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbortOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fence != nil {
+		{
+			size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutAllInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutAllInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutAllInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutAllOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutAllOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutAllOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveAllInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveAllInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveAllInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveAllOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveAllOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveAllOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigureInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigureInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigureInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigureOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigureOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigureOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Index) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Index) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Index) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Extractor != nil {
+		{
+			size := m.Extractor.Size()
+			i -= size
+			if _, err := m.Extractor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Index_JsonPath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Index_JsonPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonPath)
+	copy(dAtA[i:], m.JsonPath)
+	i = encodeVarintMap(dAtA, i, uint64(len(m.JsonPath)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *Index_PrefixLength) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Index_PrefixLength) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintMap(dAtA, i, uint64(m.PrefixLength))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *QueryInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Query != nil {
+		{
+			size := m.Query.Size()
+			i -= size
+			if _, err := m.Query.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexQuery_Equals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexQuery_Equals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Equals != nil {
+		i -= len(m.Equals)
+		copy(dAtA[i:], m.Equals)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Equals)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IndexQuery_Range) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexQuery_Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Range != nil {
+		{
+			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueryOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IndexKey) > 0 {
+		i -= len(m.IndexKey)
+		copy(dAtA[i:], m.IndexKey)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.IndexKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Range) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Range) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Range) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntriesInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Limit != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntriesOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntriesOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntriesOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		dAtA152 := make([]byte, len(m.Types)*10)
+		var j151 int
+		for _, num := range m.Types {
+			for num >= 1<<7 {
+				dAtA152[j151] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j151++
+			}
+			dAtA152[j151] = uint8(num)
+			j151++
+		}
+		i -= j151
+		copy(dAtA[i:], dAtA152[:j151])
+		i = encodeVarintMap(dAtA, i, uint64(j151))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMap(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Inserted_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Inserted != nil {
+		{
+			size, err := m.Inserted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Updated_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Removed_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Removed != nil {
+		{
+			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Inserted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Inserted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Inserted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Updated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Updated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Removed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event_Removed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Token))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateFenceInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateFenceInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateFenceInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateFenceOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateFenceOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateFenceOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Patch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Patch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Patch != nil {
+		{
+			size := m.Patch.Size()
+			i -= size
+			if _, err := m.Patch.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Patch_JsonPatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_JsonPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonPatch != nil {
+		i -= len(m.JsonPatch)
+		copy(dAtA[i:], m.JsonPatch)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.JsonPatch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Patch_MergePatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_MergePatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MergePatch != nil {
+		i -= len(m.MergePatch)
+		copy(dAtA[i:], m.MergePatch)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.MergePatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IndexedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintMap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMap(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.SizeInput != nil {
+		l = m.SizeInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *SizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.SizeOutput != nil {
+		l = m.SizeOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PutInput != nil {
+		l = m.PutInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PutOutput != nil {
+		l = m.PutOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.InsertInput != nil {
+		l = m.InsertInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.InsertOutput != nil {
+		l = m.InsertOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.UpdateInput != nil {
+		l = m.UpdateInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.UpdateOutput != nil {
+		l = m.UpdateOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.GetInput != nil {
+		l = m.GetInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.GetOutput != nil {
+		l = m.GetOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.RemoveInput != nil {
+		l = m.RemoveInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.RemoveOutput != nil {
+		l = m.RemoveOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ClearInput != nil {
+		l = m.ClearInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ClearOutput != nil {
+		l = m.ClearOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.LockInput != nil {
+		l = m.LockInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.LockOutput != nil {
+		l = m.LockOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *UnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.UnlockInput != nil {
+		l = m.UnlockInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *UnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.UnlockOutput != nil {
+		l = m.UnlockOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PrepareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrepareInput != nil {
+		l = m.PrepareInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PrepareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrepareOutput != nil {
+		l = m.PrepareOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.CommitInput != nil {
+		l = m.CommitInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.CommitOutput != nil {
+		l = m.CommitOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *AbortRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.AbortInput != nil {
+		l = m.AbortInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *AbortResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.AbortOutput != nil {
+		l = m.AbortOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ApplyInput != nil {
+		l = m.ApplyInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ApplyOutput != nil {
+		l = m.ApplyOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.GetAllInput != nil {
+		l = m.GetAllInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.GetAllOutput != nil {
+		l = m.GetAllOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PutAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PutAllInput != nil {
+		l = m.PutAllInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PutAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PutAllOutput != nil {
+		l = m.PutAllOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.RemoveAllInput != nil {
+		l = m.RemoveAllInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.RemoveAllOutput != nil {
+		l = m.RemoveAllOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ConfigureInput != nil {
+		l = m.ConfigureInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ConfigureOutput != nil {
+		l = m.ConfigureOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.QueryInput != nil {
+		l = m.QueryInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.QueryOutput != nil {
+		l = m.QueryOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateFenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ValidateFenceInput != nil {
+		l = m.ValidateFenceInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateFenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.ValidateFenceOutput != nil {
+		l = m.ValidateFenceOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.EntriesInput != nil {
+		l = m.EntriesInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.EntriesOutput != nil {
+		l = m.EntriesOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.EventsInput != nil {
+		l = m.EventsInput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.EventsOutput != nil {
+		l = m.EventsOutput.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *MapListener) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovMap(uint64(m.Index))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovMap(uint64(e))
+		}
+		n += 1 + sovMap(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MapEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *MapValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovMap(uint64(m.Index))
+	}
+	if m.Expire != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expire)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.SessionID != 0 {
+		n += 1 + sovMap(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *MapInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *MapInput_Size_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != nil {
+		l = m.Size_.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Put) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Insert != nil {
+		l = m.Insert.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Get) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Clear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clear != nil {
+		l = m.Clear.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lock != nil {
+		l = m.Lock.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Unlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unlock != nil {
+		l = m.Unlock.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Entries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entries != nil {
+		l = m.Entries.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Events) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Prepare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prepare != nil {
+		l = m.Prepare.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Abort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Abort != nil {
+		l = m.Abort.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Apply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Apply != nil {
+		l = m.Apply.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_GetAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetAll != nil {
+		l = m.GetAll.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_PutAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PutAll != nil {
+		l = m.PutAll.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_RemoveAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoveAll != nil {
+		l = m.RemoveAll.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Configure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configure != nil {
+		l = m.Configure.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_Query) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapInput_ValidateFence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidateFence != nil {
+		l = m.ValidateFence.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		n += m.Output.Size()
+	}
+	return n
+}
+
+func (m *MapOutput_Size_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != nil {
+		l = m.Size_.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Put) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Insert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Insert != nil {
+		l = m.Insert.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Get) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Clear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clear != nil {
+		l = m.Clear.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lock != nil {
+		l = m.Lock.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Unlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unlock != nil {
+		l = m.Unlock.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Entries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entries != nil {
+		l = m.Entries.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Events) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Prepare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prepare != nil {
+		l = m.Prepare.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Abort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Abort != nil {
+		l = m.Abort.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Apply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Apply != nil {
+		l = m.Apply.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_GetAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetAll != nil {
+		l = m.GetAll.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_PutAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PutAll != nil {
+		l = m.PutAll.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_RemoveAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoveAll != nil {
+		l = m.RemoveAll.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Configure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configure != nil {
+		l = m.Configure.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_Query) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *MapOutput_ValidateFence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidateFence != nil {
+		l = m.ValidateFence.Size()
+		n += 2 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *SizeInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SizeOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovMap(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *PutInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevIndex != 0 {
+		n += 1 + sovMap(uint64(m.PrevIndex))
+	}
+	if m.Ephemeral {
+		n += 2
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PutOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovMap(uint64(m.Index))
+	}
+	if m.PrevValue != nil {
+		l = m.PrevValue.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Ephemeral {
+		n += 2
+	}
+	return n
+}
+
+func (m *InsertOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovMap(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *UpdateInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.TTL != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevIndex != 0 {
+		n += 1 + sovMap(uint64(m.PrevIndex))
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovMap(uint64(m.Index))
+	}
+	l = m.PrevValue.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *GetInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *RemoveInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.PrevIndex != 0 {
+		n += 1 + sovMap(uint64(m.PrevIndex))
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *ClearInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ClearOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LockInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *LockOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnlockInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PrepareInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SequenceNum != 0 {
+		n += 1 + sovMap(uint64(m.SequenceNum))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *PrepareOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommitInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SequenceNum != 0 {
+		n += 1 + sovMap(uint64(m.SequenceNum))
+	}
+	return n
+}
+
+func (m *CommitOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AbortInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SequenceNum != 0 {
+		n += 1 + sovMap(uint64(m.SequenceNum))
+	}
+	return n
+}
+
+func (m *AbortOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ApplyInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if m.Fence != nil {
+		l = m.Fence.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetAllInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetAllOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutAllInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutAllOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveAllInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveAllOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigureInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigureOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Index) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Extractor != nil {
+		n += m.Extractor.Size()
+	}
+	return n
+}
+
+func (m *Index_JsonPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPath)
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+func (m *Index_PrefixLength) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovMap(uint64(m.PrefixLength))
+	return n
+}
+func (m *QueryInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = m.Query.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovMap(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *IndexQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		n += m.Query.Size()
+	}
+	return n
+}
+
+func (m *IndexQuery_Equals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Equals != nil {
+		l = len(m.Equals)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *IndexQuery_Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *QueryOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = len(m.IndexKey)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *Bound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EntriesInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Watch {
+		n += 2
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMap(uint64(m.Limit))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *EntriesOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *EventsInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMap(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sovMap(uint64(e))
+		}
+		n += 1 + sovMap(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventsOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *Event_Inserted_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inserted != nil {
+		l = m.Inserted.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Updated_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Removed_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removed != nil {
+		l = m.Removed.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Event_Inserted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *Event_Updated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	l = m.PrevValue.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *Event_Removed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMap(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+
+func (m *Fence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Token != 0 {
+		n += 1 + sovMap(uint64(m.Token))
+	}
+	return n
+}
+
+func (m *ValidateFenceInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fence.Size()
+	n += 1 + l + sovMap(uint64(l))
+	return n
+}
+
+func (m *ValidateFenceOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Patch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Patch != nil {
+		n += m.Patch.Size()
+	}
+	return n
+}
+
+func (m *Patch_JsonPatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonPatch != nil {
+		l = len(m.JsonPatch)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *Patch_MergePatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergePatch != nil {
+		l = len(m.MergePatch)
+		n += 1 + l + sovMap(uint64(l))
+	}
+	return n
+}
+func (m *IndexedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMap(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovMap(uint64(m.Index))
+	}
+	return n
+}
+
+func sovMap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMap(x uint64) (n int) {
+	return sovMap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SizeInput == nil {
+				m.SizeInput = &SizeInput{}
+			}
+			if err := m.SizeInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SizeOutput == nil {
+				m.SizeOutput = &SizeOutput{}
+			}
+			if err := m.SizeOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PutInput == nil {
+				m.PutInput = &PutInput{}
+			}
+			if err := m.PutInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PutOutput == nil {
+				m.PutOutput = &PutOutput{}
+			}
+			if err := m.PutOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsertInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsertInput == nil {
+				m.InsertInput = &InsertInput{}
+			}
+			if err := m.InsertInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsertOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsertOutput == nil {
+				m.InsertOutput = &InsertOutput{}
+			}
+			if err := m.InsertOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateInput == nil {
+				m.UpdateInput = &UpdateInput{}
+			}
+			if err := m.UpdateInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateOutput == nil {
+				m.UpdateOutput = &UpdateOutput{}
+			}
+			if err := m.UpdateOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetInput == nil {
+				m.GetInput = &GetInput{}
+			}
+			if err := m.GetInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetOutput == nil {
+				m.GetOutput = &GetOutput{}
+			}
+			if err := m.GetOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoveInput == nil {
+				m.RemoveInput = &RemoveInput{}
+			}
+			if err := m.RemoveInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoveOutput == nil {
+				m.RemoveOutput = &RemoveOutput{}
+			}
+			if err := m.RemoveOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClearInput == nil {
+				m.ClearInput = &ClearInput{}
+			}
+			if err := m.ClearInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClearOutput == nil {
+				m.ClearOutput = &ClearOutput{}
+			}
+			if err := m.ClearOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockInput == nil {
+				m.LockInput = &LockInput{}
+			}
+			if err := m.LockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockOutput == nil {
+				m.LockOutput = &LockOutput{}
+			}
+			if err := m.LockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnlockInput == nil {
+				m.UnlockInput = &UnlockInput{}
+			}
+			if err := m.UnlockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnlockOutput == nil {
+				m.UnlockOutput = &UnlockOutput{}
+			}
+			if err := m.UnlockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrepareInput == nil {
+				m.PrepareInput = &PrepareInput{}
+			}
+			if err := m.PrepareInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrepareOutput == nil {
+				m.PrepareOutput = &PrepareOutput{}
+			}
+			if err := m.PrepareOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitInput == nil {
+				m.CommitInput = &CommitInput{}
+			}
+			if err := m.CommitInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitOutput == nil {
+				m.CommitOutput = &CommitOutput{}
+			}
+			if err := m.CommitOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbortRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbortRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbortRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AbortInput == nil {
+				m.AbortInput = &AbortInput{}
+			}
+			if err := m.AbortInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbortResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbortResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbortResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AbortOutput == nil {
+				m.AbortOutput = &AbortOutput{}
+			}
+			if err := m.AbortOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplyInput == nil {
+				m.ApplyInput = &ApplyInput{}
+			}
+			if err := m.ApplyInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplyOutput == nil {
+				m.ApplyOutput = &ApplyOutput{}
+			}
+			if err := m.ApplyOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAllInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetAllInput == nil {
+				m.GetAllInput = &GetAllInput{}
+			}
+			if err := m.GetAllInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.QueryResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAllOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetAllOutput == nil {
+				m.GetAllOutput = &GetAllOutput{}
+			}
+			if err := m.GetAllOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutAllInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PutAllInput == nil {
+				m.PutAllInput = &PutAllInput{}
+			}
+			if err := m.PutAllInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutAllOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PutAllOutput == nil {
+				m.PutAllOutput = &PutAllOutput{}
+			}
+			if err := m.PutAllOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAllInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoveAllInput == nil {
+				m.RemoveAllInput = &RemoveAllInput{}
+			}
+			if err := m.RemoveAllInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalResponseHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAllOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoveAllOutput == nil {
+				m.RemoveAllOutput = &RemoveAllOutput{}
+			}
+			if err := m.RemoveAllOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &v1.ProposalRequestHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigureInput == nil {
+				m.ConfigureInput = &ConfigureInput{}
+			}
+			if err := m.ConfigureInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *IndexedValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMap(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovMap(uint64(m.Index))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMap(x uint64) (n int) {
-	return sovMap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SizeRequest) Unmarshal(dAtA []byte) error {
+func (m *ConfigureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9689,10 +18586,10 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9725,7 +18622,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.QueryRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9733,7 +18630,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigureOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9760,10 +18657,10 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SizeInput == nil {
-				m.SizeInput = &SizeInput{}
+			if m.ConfigureOutput == nil {
+				m.ConfigureOutput = &ConfigureOutput{}
 			}
-			if err := m.SizeInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigureOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9788,7 +18685,7 @@ func (m *SizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9811,10 +18708,10 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9847,7 +18744,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.QueryResponseHeaders{}
+				m.Headers = &v1.QueryRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9855,7 +18752,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9882,10 +18779,10 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SizeOutput == nil {
-				m.SizeOutput = &SizeOutput{}
+			if m.QueryInput == nil {
+				m.QueryInput = &QueryInput{}
 			}
-			if err := m.SizeOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9910,7 +18807,7 @@ func (m *SizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9933,10 +18830,10 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9969,7 +18866,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.QueryResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9977,7 +18874,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10004,10 +18901,10 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PutInput == nil {
-				m.PutInput = &PutInput{}
+			if m.QueryOutput == nil {
+				m.QueryOutput = &QueryOutput{}
 			}
-			if err := m.PutInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10032,7 +18929,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidateFenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10055,10 +18952,10 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateFenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateFenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10091,7 +18988,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.ProposalRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10099,7 +18996,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateFenceInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10126,10 +19023,10 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PutOutput == nil {
-				m.PutOutput = &PutOutput{}
+			if m.ValidateFenceInput == nil {
+				m.ValidateFenceInput = &ValidateFenceInput{}
 			}
-			if err := m.PutOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidateFenceInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10154,7 +19051,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidateFenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10177,10 +19074,10 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateFenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateFenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10213,7 +19110,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10221,7 +19118,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsertInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateFenceOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10248,10 +19145,10 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsertInput == nil {
-				m.InsertInput = &InsertInput{}
+			if m.ValidateFenceOutput == nil {
+				m.ValidateFenceOutput = &ValidateFenceOutput{}
 			}
-			if err := m.InsertInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidateFenceOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10276,7 +19173,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10299,10 +19196,10 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10335,7 +19232,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.QueryRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10343,7 +19240,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsertOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntriesInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10370,10 +19267,10 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsertOutput == nil {
-				m.InsertOutput = &InsertOutput{}
+			if m.EntriesInput == nil {
+				m.EntriesInput = &EntriesInput{}
 			}
-			if err := m.InsertOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EntriesInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10398,7 +19295,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10421,10 +19318,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10457,7 +19354,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
+				m.Headers = &v1.QueryResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10465,7 +19362,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntriesOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10492,10 +19389,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateInput == nil {
-				m.UpdateInput = &UpdateInput{}
+			if m.EntriesOutput == nil {
+				m.EntriesOutput = &EntriesOutput{}
 			}
-			if err := m.UpdateInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EntriesOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10520,7 +19417,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10543,10 +19440,10 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10579,7 +19476,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
+				m.Headers = &v1.ProposalRequestHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10587,7 +19484,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10614,10 +19511,10 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateOutput == nil {
-				m.UpdateOutput = &UpdateOutput{}
+			if m.EventsInput == nil {
+				m.EventsInput = &EventsInput{}
 			}
-			if err := m.UpdateOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventsInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10642,7 +19539,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10665,10 +19562,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10701,7 +19598,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Headers == nil {
-				m.Headers = &v1.QueryRequestHeaders{}
+				m.Headers = &v1.ProposalResponseHeaders{}
 			}
 			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10709,7 +19606,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsOutput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10736,10 +19633,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GetInput == nil {
-				m.GetInput = &GetInput{}
+			if m.EventsOutput == nil {
+				m.EventsOutput = &EventsOutput{}
 			}
-			if err := m.GetInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventsOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10764,7 +19661,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *MapListener) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10774,30 +19671,113 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowMap
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MapListener: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MapListener: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -10807,33 +19787,29 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.QueryResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -10843,28 +19819,93 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GetOutput == nil {
-				m.GetOutput = &GetOutput{}
-			}
-			if err := m.GetOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v EventsInput_EventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EventsInput_EventType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Types = append(m.Types, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Types) == 0 {
+					m.Types = make([]EventsInput_EventType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EventsInput_EventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EventsInput_EventType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Types = append(m.Types, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -10886,7 +19927,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10909,17 +19950,17 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -10929,31 +19970,27 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10980,10 +20017,10 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemoveInput == nil {
-				m.RemoveInput = &RemoveInput{}
+			if m.Value == nil {
+				m.Value = &MapValue{}
 			}
-			if err := m.RemoveInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11008,7 +20045,7 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *MapValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11031,17 +20068,17 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -11051,31 +20088,48 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11102,13 +20156,32 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemoveOutput == nil {
-				m.RemoveOutput = &RemoveOutput{}
+			if m.Expire == nil {
+				m.Expire = new(time.Time)
 			}
-			if err := m.RemoveOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expire, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= github_com_atomix_atomix_protocols_rsm_api_v1.SessionID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -11130,7 +20203,7 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearRequest) Unmarshal(dAtA []byte) error {
+func (m *MapInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11153,15 +20226,15 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11188,16 +20261,15 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &SizeInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Size_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11224,66 +20296,50 @@ func (m *ClearRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClearInput == nil {
-				m.ClearInput = &ClearInput{}
-			}
-			if err := m.ClearInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PutInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Put{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClearResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &InsertInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClearResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Input = &MapInput_Insert{v}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11310,16 +20366,15 @@ func (m *ClearResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &UpdateInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Update{v}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11346,66 +20401,15 @@ func (m *ClearResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClearOutput == nil {
-				m.ClearOutput = &ClearOutput{}
-			}
-			if err := m.ClearOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &GetInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Get{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11432,16 +20436,15 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &RemoveInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Remove{v}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11468,66 +20471,50 @@ func (m *LockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LockInput == nil {
-				m.LockInput = &LockInput{}
-			}
-			if err := m.LockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ClearInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Clear{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &LockInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Input = &MapInput_Lock{v}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11554,16 +20541,15 @@ func (m *LockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &UnlockInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Unlock{v}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11590,66 +20576,50 @@ func (m *LockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LockOutput == nil {
-				m.LockOutput = &LockOutput{}
-			}
-			if err := m.LockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &EntriesInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Entries{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &EventsInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Input = &MapInput_Events{v}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11676,16 +20646,15 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PrepareInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Prepare{v}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11712,66 +20681,15 @@ func (m *UnlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UnlockInput == nil {
-				m.UnlockInput = &UnlockInput{}
-			}
-			if err := m.UnlockInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CommitInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Commit{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11798,16 +20716,15 @@ func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AbortInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Abort{v}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11834,66 +20751,50 @@ func (m *UnlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UnlockOutput == nil {
-				m.UnlockOutput = &UnlockOutput{}
-			}
-			if err := m.UnlockOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ApplyInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Apply{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAll", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PrepareRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &GetAllInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PrepareRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrepareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Input = &MapInput_GetAll{v}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutAll", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11920,16 +20821,15 @@ func (m *PrepareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PutAllInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_PutAll{v}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAll", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11956,66 +20856,50 @@ func (m *PrepareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrepareInput == nil {
-				m.PrepareInput = &PrepareInput{}
-			}
-			if err := m.PrepareInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &RemoveAllInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_RemoveAll{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configure", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PrepareResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PrepareResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrepareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			v := &ConfigureInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &MapInput_Configure{v}
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12042,16 +20926,15 @@ func (m *PrepareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &QueryInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_Query{v}
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateFence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12078,12 +20961,11 @@ func (m *PrepareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrepareOutput == nil {
-				m.PrepareOutput = &PrepareOutput{}
-			}
-			if err := m.PrepareOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ValidateFenceInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &MapInput_ValidateFence{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12106,7 +20988,7 @@ func (m *PrepareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRequest) Unmarshal(dAtA []byte) error {
+func (m *MapOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12129,15 +21011,15 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12164,16 +21046,15 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &SizeOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Size_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12200,66 +21081,15 @@ func (m *CommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitInput == nil {
-				m.CommitInput = &CommitInput{}
-			}
-			if err := m.CommitInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PutOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Put{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12286,16 +21116,15 @@ func (m *CommitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &InsertOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Insert{v}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12322,66 +21151,15 @@ func (m *CommitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitOutput == nil {
-				m.CommitOutput = &CommitOutput{}
-			}
-			if err := m.CommitOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &UpdateOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Update{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AbortRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AbortRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbortRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12408,16 +21186,15 @@ func (m *AbortRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &GetOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Get{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12444,66 +21221,50 @@ func (m *AbortRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AbortInput == nil {
-				m.AbortInput = &AbortInput{}
-			}
-			if err := m.AbortInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &RemoveOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Remove{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AbortResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &ClearOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AbortResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbortResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Output = &MapOutput_Clear{v}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12530,16 +21291,15 @@ func (m *AbortResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &LockOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Lock{v}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12566,66 +21326,15 @@ func (m *AbortResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AbortOutput == nil {
-				m.AbortOutput = &AbortOutput{}
-			}
-			if err := m.AbortOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &UnlockOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Unlock{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12652,16 +21361,15 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &EntriesOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Entries{v}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12688,66 +21396,50 @@ func (m *ApplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplyInput == nil {
-				m.ApplyInput = &ApplyInput{}
-			}
-			if err := m.ApplyInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &EventsOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Events{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &PrepareOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Output = &MapOutput_Prepare{v}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12774,16 +21466,15 @@ func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CommitOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Commit{v}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12810,66 +21501,50 @@ func (m *ApplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplyOutput == nil {
-				m.ApplyOutput = &ApplyOutput{}
-			}
-			if err := m.ApplyOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AbortOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Abort{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplyOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Output = &MapOutput_Apply{v}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAll", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12896,16 +21571,15 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.QueryRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &GetAllOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_GetAll{v}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntriesInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutAll", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12932,66 +21606,15 @@ func (m *EntriesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EntriesInput == nil {
-				m.EntriesInput = &EntriesInput{}
-			}
-			if err := m.EntriesInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &PutAllOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_PutAll{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAll", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13018,16 +21641,15 @@ func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.QueryResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &RemoveAllOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_RemoveAll{v}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntriesOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13054,66 +21676,15 @@ func (m *EntriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EntriesOutput == nil {
-				m.EntriesOutput = &EntriesOutput{}
-			}
-			if err := m.EntriesOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ConfigureOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Configure{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13140,16 +21711,15 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalRequestHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &QueryOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_Query{v}
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventsInput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateFence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13176,12 +21746,11 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EventsInput == nil {
-				m.EventsInput = &EventsInput{}
-			}
-			if err := m.EventsInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ValidateFenceOutput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Output = &MapOutput_ValidateFence{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13204,7 +21773,7 @@ func (m *EventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventsResponse) Unmarshal(dAtA []byte) error {
+func (m *SizeInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13227,84 +21796,12 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SizeInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SizeInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Headers == nil {
-				m.Headers = &v1.ProposalResponseHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventsOutput", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EventsOutput == nil {
-				m.EventsOutput = &EventsOutput{}
-			}
-			if err := m.EventsOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -13326,7 +21823,7 @@ func (m *EventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapListener) Unmarshal(dAtA []byte) error {
+func (m *SizeOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13349,36 +21846,17 @@ func (m *MapListener) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapListener: wiretype end group for non-group")
+			return fmt.Errorf("proto: SizeOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapListener: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SizeOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var stringLen uint64
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13388,24 +21866,11 @@ func (m *MapListener) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Size_ |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -13427,7 +21892,7 @@ func (m *MapListener) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapEntry) Unmarshal(dAtA []byte) error {
+func (m *PutInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13450,10 +21915,10 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13492,7 +21957,7 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13502,83 +21967,31 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
 			if m.Value == nil {
-				m.Value = &MapValue{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MapValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MapValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13588,31 +22001,33 @@ func (m *MapValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevIndex", wireType)
 			}
-			m.Index = 0
+			m.PrevIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13622,14 +22037,34 @@ func (m *MapValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
+				m.PrevIndex |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ephemeral", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ephemeral = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13656,10 +22091,46 @@ func (m *MapValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expire == nil {
-				m.Expire = new(time.Time)
+			if m.Fence == nil {
+				m.Fence = &Fence{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expire, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13684,7 +22155,7 @@ func (m *MapValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapInput) Unmarshal(dAtA []byte) error {
+func (m *PutOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13707,17 +22178,17 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13727,30 +22198,14 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &SizeInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &MapInput_Size_{v}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13777,17 +22232,68 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PutInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PrevValue == nil {
+				m.PrevValue = &IndexedValue{}
+			}
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &MapInput_Put{v}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13797,32 +22303,29 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InsertInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &MapInput_Insert{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13832,30 +22335,29 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UpdateInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Input = &MapInput_Update{v}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13882,17 +22384,18 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GetInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &MapInput_Get{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ephemeral", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13902,32 +22405,67 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
+			m.Ephemeral = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RemoveInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
 			}
-			m.Input = &MapInput_Remove{v}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13937,32 +22475,66 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClearInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
 			}
-			m.Input = &MapInput_Clear{v}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -13972,32 +22544,29 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LockInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &MapInput_Lock{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14007,30 +22576,29 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UnlockInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Input = &MapInput_Unlock{v}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14057,17 +22625,18 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EntriesInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TTL == nil {
+				m.TTL = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &MapInput_Entries{v}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevIndex", wireType)
 			}
-			var msglen int
+			m.PrevIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14077,30 +22646,14 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevIndex |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &EventsInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &MapInput_Events{v}
-			iNdEx = postIndex
-		case 12:
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14127,15 +22680,85 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PrepareInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Fence == nil {
+				m.Fence = &Fence{}
+			}
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &MapInput_Prepare{v}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14162,17 +22785,65 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &MapInput_Commit{v}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14182,32 +22853,29 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AbortInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &MapInput_Abort{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14217,26 +22885,23 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ApplyInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &MapInput_Apply{v}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14259,7 +22924,7 @@ func (m *MapInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapOutput) Unmarshal(dAtA []byte) error {
+func (m *GetOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14282,15 +22947,15 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14317,52 +22982,65 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SizeOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Size_{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PutOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Output = &MapOutput_Put{v}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14372,32 +23050,29 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InsertOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &MapOutput_Insert{v}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevIndex", wireType)
 			}
-			var msglen int
+			m.PrevIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14407,30 +23082,14 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrevIndex |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &UpdateOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &MapOutput_Update{v}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14457,15 +23116,66 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GetOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Fence == nil {
+				m.Fence = &Fence{}
+			}
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Get{v}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14492,17 +23202,165 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RemoveOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Remove{v}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14512,30 +23370,27 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClearOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &MapOutput_Clear{v}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14562,17 +23417,118 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LockOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Lock{v}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14582,32 +23538,129 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UnlockOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Unlock{v}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepareInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepareInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepareInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+			}
+			m.SequenceNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14617,30 +23670,14 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SequenceNum |= github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &EntriesOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Output = &MapOutput_Entries{v}
-			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14667,15 +23704,14 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventsOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, MapInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Events{v}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14702,52 +23738,118 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PrepareOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Fence == nil {
+				m.Fence = &Fence{}
+			}
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Prepare{v}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepareOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CommitOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepareOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepareOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Commit{v}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+			}
+			m.SequenceNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -14757,30 +23859,64 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SequenceNum |= github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AbortOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
 			}
-			m.Output = &MapOutput_Abort{v}
-			iNdEx = postIndex
-		case 15:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14807,11 +23943,10 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ApplyOutput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outputs = append(m.Outputs, MapOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Output = &MapOutput_Apply{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14834,7 +23969,7 @@ func (m *MapOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeInput) Unmarshal(dAtA []byte) error {
+func (m *AbortInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14853,16 +23988,35 @@ func (m *SizeInput) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SizeInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbortInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbortInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+			}
+			m.SequenceNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNum |= github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -14884,7 +24038,7 @@ func (m *SizeInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SizeOutput) Unmarshal(dAtA []byte) error {
+func (m *AbortOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14907,31 +24061,12 @@ func (m *SizeOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SizeOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbortOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SizeOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbortOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Size_ |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -14953,7 +24088,7 @@ func (m *SizeOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutInput) Unmarshal(dAtA []byte) error {
+func (m *ApplyInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14976,49 +24111,17 @@ func (m *PutInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -15028,29 +24131,29 @@ func (m *PutInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Inputs = append(m.Inputs, MapInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15077,32 +24180,13 @@ func (m *PutInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
+			if m.Fence == nil {
+				m.Fence = &Fence{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevIndex", wireType)
-			}
-			m.PrevIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevIndex |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -15124,7 +24208,7 @@ func (m *PutInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutOutput) Unmarshal(dAtA []byte) error {
+func (m *ApplyOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15147,34 +24231,15 @@ func (m *PutOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15201,10 +24266,8 @@ func (m *PutOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevValue == nil {
-				m.PrevValue = &IndexedValue{}
-			}
-			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outputs = append(m.Outputs, MapOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15229,7 +24292,7 @@ func (m *PutOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertInput) Unmarshal(dAtA []byte) error {
+func (m *GetAllInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15252,15 +24315,15 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15288,13 +24351,63 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -15304,29 +24417,79 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutAllInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutAllInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutAllInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15353,10 +24516,8 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, PutInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15381,7 +24542,7 @@ func (m *InsertInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertOutput) Unmarshal(dAtA []byte) error {
+func (m *PutAllOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15404,17 +24565,17 @@ func (m *InsertOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutAllOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutAllOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -15424,11 +24585,26 @@ func (m *InsertOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, PutOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -15450,7 +24626,7 @@ func (m *InsertOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateInput) Unmarshal(dAtA []byte) error {
+func (m *RemoveAllInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15473,15 +24649,15 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveAllInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveAllInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15509,45 +24685,61 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveAllOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveAllOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveAllOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15574,32 +24766,11 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevIndex", wireType)
-			}
-			m.PrevIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevIndex |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -15621,7 +24792,7 @@ func (m *UpdateInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
+func (m *ConfigureInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15644,34 +24815,15 @@ func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigureInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigureInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15698,7 +24850,8 @@ func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Indexes = append(m.Indexes, Index{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15723,7 +24876,7 @@ func (m *UpdateOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetInput) Unmarshal(dAtA []byte) error {
+func (m *ConfigureOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15746,44 +24899,12 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigureOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigureOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -15805,7 +24926,7 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOutput) Unmarshal(dAtA []byte) error {
+func (m *Index) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15828,17 +24949,17 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Index: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Index: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -15848,78 +24969,27 @@ func (m *GetOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15947,13 +25017,13 @@ func (m *RemoveInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Extractor = &Index_JsonPath{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
 			}
-			m.PrevIndex = 0
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -15963,11 +25033,12 @@ func (m *RemoveInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevIndex |= github_com_atomix_atomix_protocols_rsm_api_v1.Index(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Extractor = &Index_PrefixLength{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -15989,7 +25060,7 @@ func (m *RemoveInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveOutput) Unmarshal(dAtA []byte) error {
+func (m *QueryInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16012,17 +25083,17 @@ func (m *RemoveOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16032,125 +25103,76 @@ func (m *RemoveOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClearInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClearInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthMap
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClearOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClearOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -16172,7 +25194,7 @@ func (m *ClearOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockInput) Unmarshal(dAtA []byte) error {
+func (m *IndexQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16195,17 +25217,17 @@ func (m *LockInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16215,27 +25237,28 @@ func (m *LockInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Query = &IndexQuery_Equals{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16262,12 +25285,11 @@ func (m *LockInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timeout == nil {
-				m.Timeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+			v := &Range{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Query = &IndexQuery_Range{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16290,7 +25312,7 @@ func (m *LockInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockOutput) Unmarshal(dAtA []byte) error {
+func (m *QueryOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16313,12 +25335,46 @@ func (m *LockOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, QueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -16340,7 +25396,7 @@ func (m *LockOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnlockInput) Unmarshal(dAtA []byte) error {
+func (m *QueryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16363,17 +25419,17 @@ func (m *UnlockInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16383,74 +25439,59 @@ func (m *UnlockInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnlockOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IndexKey = append(m.IndexKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IndexKey == nil {
+				m.IndexKey = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -16472,7 +25513,7 @@ func (m *UnlockOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrepareInput) Unmarshal(dAtA []byte) error {
+func (m *Range) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16495,17 +25536,17 @@ func (m *PrepareInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrepareInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Range: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrepareInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			m.SequenceNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16515,14 +25556,31 @@ func (m *PrepareInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNum |= github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Min == nil {
+				m.Min = &Bound{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16549,8 +25607,10 @@ func (m *PrepareInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, MapInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Max == nil {
+				m.Max = &Bound{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16575,7 +25635,7 @@ func (m *PrepareInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrepareOutput) Unmarshal(dAtA []byte) error {
+func (m *Bound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16598,12 +25658,46 @@ func (m *PrepareOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrepareOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrepareOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -16625,7 +25719,7 @@ func (m *PrepareOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInput) Unmarshal(dAtA []byte) error {
+func (m *EntriesInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16648,17 +25742,17 @@ func (m *CommitInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
 			}
-			m.SequenceNum = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16668,66 +25762,132 @@ func (m *CommitInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNum |= github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Watch = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StartKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16737,25 +25897,23 @@ func (m *CommitOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, MapOutput{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.After = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16778,7 +25936,7 @@ func (m *CommitOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AbortInput) Unmarshal(dAtA []byte) error {
+func (m *EntriesOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16801,17 +25959,17 @@ func (m *AbortInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbortInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntriesOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbortInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntriesOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
-			m.SequenceNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16821,61 +25979,25 @@ func (m *AbortInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNum |= github_com_atomix_atomix_protocols_rsm_api_v1.SequenceNum(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AbortOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AbortOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbortOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -16897,7 +26019,7 @@ func (m *AbortOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplyInput) Unmarshal(dAtA []byte) error {
+func (m *EventsInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16920,17 +26042,17 @@ func (m *ApplyInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -16940,81 +26062,29 @@ func (m *ApplyInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, MapInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplyOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17024,81 +26094,61 @@ func (m *ApplyOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, MapOutput{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMap
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EntriesInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17108,12 +26158,93 @@ func (m *EntriesInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Watch = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v EventsInput_EventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EventsInput_EventType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Types = append(m.Types, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Types) == 0 {
+					m.Types = make([]EventsInput_EventType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EventsInput_EventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EventsInput_EventType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Types = append(m.Types, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -17135,7 +26266,7 @@ func (m *EntriesInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EntriesOutput) Unmarshal(dAtA []byte) error {
+func (m *EventsOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17158,15 +26289,15 @@ func (m *EntriesOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EntriesOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventsOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntriesOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17193,7 +26324,7 @@ func (m *EntriesOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17218,7 +26349,7 @@ func (m *EntriesOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventsInput) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17241,10 +26372,10 @@ func (m *EventsInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventsInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17279,59 +26410,114 @@ func (m *EventsInput) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMap(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inserted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Event_Inserted{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.Event = &Event_Inserted_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventsOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMap
+			v := &Event_Updated{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			m.Event = &Event_Updated_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &Event_Removed{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventsOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Event = &Event_Removed_{v}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17358,7 +26544,10 @@ func (m *EventsOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17383,7 +26572,7 @@ func (m *EventsOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *Event_Inserted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17406,17 +26595,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inserted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inserted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17426,62 +26615,78 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inserted", wireType)
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMap
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event_Updated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Event_Inserted{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Event = &Event_Inserted_{v}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Updated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Updated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17508,15 +26713,13 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Event_Updated{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &Event_Updated_{v}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17543,11 +26746,9 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Event_Removed{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &Event_Removed_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17570,7 +26771,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event_Inserted) Unmarshal(dAtA []byte) error {
+func (m *Event_Removed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17593,10 +26794,10 @@ func (m *Event_Inserted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Inserted: wiretype end group for non-group")
+			return fmt.Errorf("proto: Removed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inserted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Removed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17632,6 +26833,26 @@ func (m *Event_Inserted) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -17653,7 +26874,7 @@ func (m *Event_Inserted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event_Updated) Unmarshal(dAtA []byte) error {
+func (m *Entry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17676,17 +26897,17 @@ func (m *Event_Updated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Updated: wiretype end group for non-group")
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Updated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17696,28 +26917,27 @@ func (m *Event_Updated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17744,7 +26964,10 @@ func (m *Event_Updated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Value == nil {
+				m.Value = &IndexedValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17769,7 +26992,7 @@ func (m *Event_Updated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event_Removed) Unmarshal(dAtA []byte) error {
+func (m *Fence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17792,17 +27015,17 @@ func (m *Event_Removed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Removed: wiretype end group for non-group")
+			return fmt.Errorf("proto: Fence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Removed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Fence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17812,30 +27035,29 @@ func (m *Event_Removed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var v int
+			m.Token = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17845,12 +27067,11 @@ func (m *Event_Removed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Token |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Expired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMap(dAtA[iNdEx:])
@@ -17872,7 +27093,7 @@ func (m *Event_Removed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Entry) Unmarshal(dAtA []byte) error {
+func (m *ValidateFenceInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17895,17 +27116,17 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateFenceInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateFenceInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17915,29 +27136,130 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.Fence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateFenceOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateFenceOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateFenceOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Patch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Patch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Patch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPatch", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMap
@@ -17947,27 +27269,57 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &IndexedValue{}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_JsonPatch{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePatch", wireType)
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_MergePatch{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41,6 +41,7 @@ const (
 	CallResponseHeaders_TIMEOUT        CallResponseHeaders_Status = 12
 	CallResponseHeaders_INTERNAL       CallResponseHeaders_Status = 13
 	CallResponseHeaders_FAULT          CallResponseHeaders_Status = 14
+	CallResponseHeaders_OUT_OF_RANGE   CallResponseHeaders_Status = 15
 )
 
 var CallResponseHeaders_Status_name = map[int32]string{
@@ -59,6 +60,7 @@ var CallResponseHeaders_Status_name = map[int32]string{
 	12: "TIMEOUT",
 	13: "INTERNAL",
 	14: "FAULT",
+	15: "OUT_OF_RANGE",
 }
 
 var CallResponseHeaders_Status_value = map[string]int32{
@@ -77,6 +79,7 @@ var CallResponseHeaders_Status_value = map[string]int32{
 	"TIMEOUT":        12,
 	"INTERNAL":       13,
 	"FAULT":          14,
+	"OUT_OF_RANGE":   15,
 }
 
 func (x CallResponseHeaders_Status) String() string {
@@ -628,56 +631,57 @@ func init() {
 func init() { proto.RegisterFile("v1/headers.proto", fileDescriptor_cf11571d5467f3d4) }
 
 var fileDescriptor_cf11571d5467f3d4 = []byte{
-	// 775 bytes of a gzipped FileDescriptorProto
+	// 787 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xe2, 0x56,
-	0x14, 0xc6, 0x61, 0x80, 0xf8, 0x18, 0x32, 0x77, 0x6e, 0xa6, 0x13, 0x34, 0x0b, 0x18, 0x79, 0x35,
-	0x52, 0x2b, 0x52, 0x32, 0x8b, 0xaa, 0xea, 0xa2, 0x32, 0xd8, 0x28, 0x56, 0x5c, 0x9b, 0x5e, 0xec,
-	0xfc, 0x54, 0x95, 0x90, 0x1b, 0xae, 0x52, 0x4b, 0x18, 0x53, 0x5f, 0x83, 0xe8, 0x5b, 0xf4, 0x15,
-	0xba, 0xef, 0xa2, 0x9b, 0xbe, 0x43, 0x96, 0xd9, 0xb5, 0x2b, 0x5a, 0x91, 0xb7, 0xc8, 0xaa, 0xf2,
-	0x2f, 0x3f, 0x81, 0x26, 0xdd, 0x54, 0xdd, 0x71, 0x0e, 0xe7, 0x7c, 0xdf, 0x77, 0xce, 0xfd, 0x8e,
-	0x0c, 0x68, 0xda, 0x3c, 0xfe, 0x9e, 0xda, 0x03, 0xea, 0xb3, 0xc6, 0xd8, 0xf7, 0x02, 0x0f, 0x1f,
-	0xd9, 0x81, 0xe7, 0x3a, 0xb3, 0x38, 0xba, 0xf6, 0x86, 0xac, 0xe1, 0x33, 0xb7, 0x31, 0x6d, 0xbe,
-	0x7d, 0x7d, 0xe3, 0xdd, 0x78, 0x51, 0xf6, 0x38, 0xfc, 0x15, 0x17, 0x88, 0xdf, 0xc2, 0x51, 0xd7,
-	0xf6, 0x03, 0x27, 0x70, 0xbc, 0x11, 0xa1, 0x3f, 0x4c, 0x28, 0x0b, 0x4e, 0x63, 0x3c, 0x2c, 0x41,
-	0x79, 0x9c, 0xfe, 0xd5, 0x77, 0x06, 0x55, 0xee, 0x1d, 0xf7, 0xbe, 0xd2, 0xaa, 0x2d, 0xe6, 0x75,
-	0x21, 0x6b, 0x51, 0xe5, 0x87, 0xf5, 0x90, 0x08, 0x59, 0x8f, 0x3a, 0x10, 0xbf, 0x80, 0xea, 0x0a,
-	0x3a, 0x1b, 0x7b, 0x23, 0x46, 0x53, 0xf8, 0x3a, 0x14, 0x9c, 0xd1, 0x80, 0xce, 0x22, 0xdc, 0x17,
-	0x2d, 0xfe, 0x61, 0x5e, 0x2f, 0xa8, 0x61, 0x82, 0xc4, 0x79, 0xf1, 0x17, 0x0e, 0x3e, 0xea, 0x51,
-	0xc6, 0x1e, 0x2b, 0xbb, 0x04, 0x3e, 0x63, 0x89, 0xda, 0x85, 0x93, 0x4f, 0x1b, 0x3b, 0xe6, 0x6e,
-	0xec, 0x18, 0xaf, 0xb5, 0x7f, 0x3b, 0xaf, 0xe7, 0xee, 0xe6, 0x75, 0x8e, 0x2c, 0xc1, 0xf0, 0xe7,
-	0x00, 0x2c, 0xa6, 0x0c, 0x27, 0xde, 0x8b, 0x94, 0xbd, 0x5d, 0xcc, 0xeb, 0x7c, 0x22, 0x24, 0x9a,
-	0x77, 0x19, 0x10, 0x3e, 0xa9, 0x56, 0x07, 0x22, 0x83, 0x37, 0x99, 0xda, 0xf5, 0x49, 0xaf, 0x1e,
-	0xcb, 0x6d, 0x3e, 0x47, 0xee, 0x1a, 0xca, 0x56, 0xbd, 0xe2, 0xaf, 0x1c, 0x1c, 0x75, 0x7d, 0xc7,
-	0x75, 0x02, 0x67, 0x4a, 0x37, 0xb6, 0x44, 0xa0, 0x94, 0xa8, 0x4b, 0x48, 0x1b, 0x3b, 0x49, 0xb7,
-	0xae, 0x79, 0x85, 0x31, 0x05, 0x8a, 0x3c, 0x91, 0xd2, 0x2d, 0x37, 0x14, 0x7b, 0x22, 0xcd, 0x27,
-	0x9e, 0x58, 0x86, 0x44, 0xc8, 0x7a, 0xa2, 0x3d, 0x55, 0x57, 0x14, 0xaf, 0x6f, 0xea, 0x02, 0xf8,
-	0xac, 0x34, 0x11, 0x7d, 0xfc, 0xb4, 0xe8, 0xdd, 0x7b, 0x4a, 0xb1, 0xc4, 0x11, 0xe0, 0xb6, 0x3d,
-	0x1c, 0x6e, 0xf1, 0xd1, 0x06, 0xdd, 0x3f, 0xf8, 0x68, 0xfb, 0x9a, 0xb7, 0xf3, 0xfd, 0x96, 0x87,
-	0xc3, 0x98, 0x70, 0x7d, 0x40, 0x6b, 0xf3, 0x4d, 0x9a, 0xcf, 0xe1, 0xdb, 0x35, 0x60, 0xf6, 0x2c,
-	0x67, 0x50, 0x64, 0x81, 0x1d, 0x4c, 0x58, 0xf4, 0x20, 0x07, 0x27, 0x1f, 0x76, 0xa2, 0x6e, 0x11,
-	0xd5, 0xe8, 0x45, 0xad, 0x24, 0x81, 0xc0, 0x55, 0x28, 0xb9, 0x94, 0x31, 0xfb, 0x86, 0x56, 0xf3,
-	0xef, 0xb8, 0xf7, 0x3c, 0x49, 0x43, 0xf1, 0x4f, 0x0e, 0x8a, 0x71, 0x31, 0x2e, 0xc2, 0x9e, 0x71,
-	0x86, 0x72, 0x98, 0x87, 0x82, 0x42, 0x88, 0x41, 0x10, 0x87, 0x05, 0x28, 0x59, 0xfa, 0x99, 0x6e,
-	0x5c, 0xe8, 0x68, 0x0f, 0x97, 0x61, 0xbf, 0x2d, 0xe9, 0x6d, 0x45, 0x53, 0x64, 0x94, 0xc7, 0x15,
-	0xe0, 0x75, 0xc3, 0xec, 0x77, 0x0c, 0x4b, 0x97, 0xd1, 0x0b, 0x8c, 0xe1, 0x40, 0xd2, 0x88, 0x22,
-	0xc9, 0x57, 0x7d, 0xe5, 0x52, 0xed, 0x99, 0x3d, 0x54, 0xc0, 0x08, 0xca, 0x96, 0x2e, 0x59, 0xe6,
-	0xa9, 0x41, 0xd4, 0x6f, 0x14, 0x19, 0x15, 0xc3, 0xa6, 0x8e, 0x41, 0x5a, 0xaa, 0x2c, 0x2b, 0x3a,
-	0x2a, 0x45, 0x88, 0x86, 0xde, 0xd1, 0xd4, 0xb6, 0x89, 0xf6, 0x43, 0x32, 0x55, 0x3f, 0x97, 0x34,
-	0x55, 0x46, 0x3c, 0x7e, 0x09, 0x82, 0xa5, 0x4b, 0xe7, 0x92, 0xaa, 0x49, 0x2d, 0x4d, 0x41, 0x80,
-	0x5f, 0x41, 0x25, 0xe4, 0xeb, 0x59, 0xdd, 0xae, 0x41, 0x4c, 0x45, 0x46, 0x42, 0xd8, 0x60, 0xaa,
-	0x5f, 0x29, 0x86, 0x65, 0xa2, 0x72, 0x88, 0xa5, 0xea, 0xa6, 0x42, 0x74, 0x49, 0x43, 0x95, 0x70,
-	0x86, 0x8e, 0x64, 0x69, 0x26, 0x3a, 0x10, 0x7f, 0xe6, 0xe0, 0x4d, 0xd7, 0xf7, 0xc6, 0x1e, 0xb3,
-	0x37, 0xcd, 0xd2, 0x03, 0xde, 0x1b, 0x53, 0xdf, 0x5e, 0xb9, 0xe2, 0x8f, 0x9f, 0x58, 0xf3, 0x2e,
-	0x9f, 0x64, 0x38, 0xf8, 0x04, 0xca, 0x2c, 0x2c, 0x1b, 0x5d, 0xd3, 0xfe, 0x68, 0xe2, 0x26, 0xf7,
-	0xf4, 0x32, 0x3c, 0xa0, 0x5e, 0x92, 0xd7, 0x27, 0x2e, 0x11, 0xd8, 0x32, 0x48, 0x6e, 0x3e, 0xd5,
-	0xb8, 0xee, 0x2f, 0xf3, 0xb1, 0xc8, 0x4f, 0xfe, 0x8d, 0x17, 0xb6, 0xab, 0xfc, 0x12, 0x0e, 0xbd,
-	0x49, 0x30, 0x9e, 0x04, 0xfd, 0xe7, 0x88, 0x7d, 0x15, 0xd7, 0xae, 0xa4, 0xc4, 0xdf, 0x39, 0x38,
-	0xfc, 0x7a, 0x42, 0xfd, 0x1f, 0xff, 0xa7, 0x3b, 0xc5, 0x9f, 0x01, 0x76, 0xed, 0x59, 0xdf, 0xa7,
-	0xd7, 0xd4, 0x99, 0xd2, 0x41, 0x3f, 0xfe, 0x32, 0xe5, 0x37, 0xbf, 0x4c, 0xc8, 0xb5, 0x67, 0x24,
-	0xa9, 0x89, 0x32, 0xe2, 0x10, 0x5e, 0x27, 0x83, 0xfd, 0x07, 0x0f, 0xd1, 0xaa, 0xde, 0x2e, 0x6a,
-	0xdc, 0xdd, 0xa2, 0xc6, 0xfd, 0xb5, 0xa8, 0x71, 0x3f, 0xdd, 0xd7, 0x72, 0x77, 0xf7, 0xb5, 0xdc,
-	0x1f, 0xf7, 0xb5, 0xdc, 0x77, 0xc5, 0x08, 0xf4, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xce,
-	0x7d, 0x20, 0x1b, 0x11, 0x08, 0x00, 0x00,
+	0x14, 0xc6, 0x61, 0x80, 0xf8, 0x18, 0x92, 0x3b, 0x37, 0xd3, 0x09, 0x9a, 0x05, 0x8c, 0xbc, 0x1a,
+	0xa9, 0x15, 0x29, 0x99, 0x45, 0x55, 0x75, 0x51, 0x19, 0x6c, 0x3a, 0x56, 0x5c, 0x9b, 0x5e, 0xec,
+	0xf9, 0xa9, 0x2a, 0x59, 0x6e, 0xb8, 0x4a, 0x2d, 0x61, 0x4c, 0x7d, 0x0d, 0xa2, 0x6f, 0xd1, 0x57,
+	0xe8, 0xbe, 0x8b, 0xbe, 0x45, 0x67, 0x99, 0x5d, 0xbb, 0x42, 0x15, 0x79, 0x82, 0x6e, 0xb3, 0xaa,
+	0xfc, 0xcb, 0x4f, 0xa0, 0x49, 0x37, 0xd5, 0xec, 0x38, 0x87, 0x73, 0xbe, 0xef, 0x3b, 0xe7, 0x7e,
+	0xd7, 0x17, 0xd0, 0xac, 0x7d, 0xf6, 0x03, 0x75, 0x86, 0x34, 0x60, 0xad, 0x49, 0xe0, 0x87, 0x3e,
+	0x3e, 0x75, 0x42, 0xdf, 0x73, 0xe7, 0x49, 0x74, 0xe9, 0x8f, 0x58, 0x2b, 0x60, 0x5e, 0x6b, 0xd6,
+	0x7e, 0xf6, 0xe4, 0xca, 0xbf, 0xf2, 0xe3, 0xec, 0x59, 0xf4, 0x2b, 0x29, 0x10, 0xbf, 0x83, 0xd3,
+	0xbe, 0x13, 0x84, 0x6e, 0xe8, 0xfa, 0x63, 0x42, 0x7f, 0x9c, 0x52, 0x16, 0xbe, 0x4a, 0xf0, 0xb0,
+	0x04, 0xd5, 0x49, 0xf6, 0x97, 0xed, 0x0e, 0xeb, 0xdc, 0x73, 0xee, 0x45, 0xad, 0xd3, 0x58, 0x2e,
+	0x9a, 0x42, 0xde, 0xa2, 0xca, 0xb7, 0x9b, 0x21, 0x11, 0xf2, 0x1e, 0x75, 0x28, 0x7e, 0x01, 0xf5,
+	0x35, 0x74, 0x36, 0xf1, 0xc7, 0x8c, 0x66, 0xf0, 0x4d, 0x28, 0xb9, 0xe3, 0x21, 0x9d, 0xc7, 0xb8,
+	0x8f, 0x3a, 0xfc, 0xed, 0xa2, 0x59, 0x52, 0xa3, 0x04, 0x49, 0xf2, 0xe2, 0xaf, 0x1c, 0x7c, 0x34,
+	0xa0, 0x8c, 0xdd, 0x55, 0xf6, 0x16, 0xf8, 0x9c, 0x25, 0x6e, 0x17, 0xce, 0x3f, 0x6d, 0xed, 0x99,
+	0xbb, 0xb5, 0x67, 0xbc, 0xce, 0xe1, 0xfb, 0x45, 0xb3, 0x70, 0xbd, 0x68, 0x72, 0x64, 0x05, 0x86,
+	0x3f, 0x07, 0x60, 0x09, 0x65, 0x34, 0xf1, 0x41, 0xac, 0xec, 0xd9, 0x72, 0xd1, 0xe4, 0x53, 0x21,
+	0xf1, 0xbc, 0xab, 0x80, 0xf0, 0x69, 0xb5, 0x3a, 0x14, 0x19, 0x3c, 0xcd, 0xd5, 0x6e, 0x4e, 0xfa,
+	0xee, 0xae, 0xdc, 0xf6, 0x43, 0xe4, 0x6e, 0xa0, 0xec, 0xd4, 0x2b, 0xfe, 0xc6, 0xc1, 0x69, 0x3f,
+	0x70, 0x3d, 0x37, 0x74, 0x67, 0x74, 0x6b, 0x4b, 0x04, 0x2a, 0xa9, 0xba, 0x94, 0xb4, 0xb5, 0x97,
+	0x74, 0xe7, 0x9a, 0xd7, 0x18, 0x33, 0xa0, 0xd8, 0x13, 0x19, 0xdd, 0x6a, 0x43, 0x89, 0x27, 0xb2,
+	0x7c, 0xea, 0x89, 0x55, 0x48, 0x84, 0xbc, 0x27, 0xde, 0x53, 0x7d, 0x4d, 0xf1, 0xe6, 0xa6, 0xde,
+	0x00, 0x9f, 0x97, 0xa6, 0xa2, 0xcf, 0xee, 0x17, 0xbd, 0x7f, 0x4f, 0x19, 0x96, 0x38, 0x06, 0xdc,
+	0x75, 0x46, 0xa3, 0x1d, 0x3e, 0xda, 0xa2, 0xfb, 0x17, 0x1f, 0xed, 0x5e, 0xf3, 0x6e, 0xbe, 0xdf,
+	0x8b, 0x70, 0x92, 0x10, 0x6e, 0x0e, 0x68, 0x6d, 0x9f, 0x49, 0xfb, 0x21, 0x7c, 0xfb, 0x06, 0xcc,
+	0x8f, 0xe5, 0x02, 0xca, 0x2c, 0x74, 0xc2, 0x29, 0x8b, 0x0f, 0xe4, 0xe8, 0xfc, 0xe5, 0x5e, 0xd4,
+	0x1d, 0xa2, 0x5a, 0x83, 0xb8, 0x95, 0xa4, 0x10, 0xb8, 0x0e, 0x15, 0x8f, 0x32, 0xe6, 0x5c, 0xd1,
+	0x7a, 0xf1, 0x39, 0xf7, 0x82, 0x27, 0x59, 0x28, 0xfe, 0xcd, 0x41, 0x39, 0x29, 0xc6, 0x65, 0x38,
+	0x30, 0x2e, 0x50, 0x01, 0xf3, 0x50, 0x52, 0x08, 0x31, 0x08, 0xe2, 0xb0, 0x00, 0x15, 0x4b, 0xbf,
+	0xd0, 0x8d, 0x37, 0x3a, 0x3a, 0xc0, 0x55, 0x38, 0xec, 0x4a, 0x7a, 0x57, 0xd1, 0x14, 0x19, 0x15,
+	0x71, 0x0d, 0x78, 0xdd, 0x30, 0xed, 0x9e, 0x61, 0xe9, 0x32, 0x7a, 0x84, 0x31, 0x1c, 0x49, 0x1a,
+	0x51, 0x24, 0xf9, 0x9d, 0xad, 0xbc, 0x55, 0x07, 0xe6, 0x00, 0x95, 0x30, 0x82, 0xaa, 0xa5, 0x4b,
+	0x96, 0xf9, 0xca, 0x20, 0xea, 0xb7, 0x8a, 0x8c, 0xca, 0x51, 0x53, 0xcf, 0x20, 0x1d, 0x55, 0x96,
+	0x15, 0x1d, 0x55, 0x62, 0x44, 0x43, 0xef, 0x69, 0x6a, 0xd7, 0x44, 0x87, 0x11, 0x99, 0xaa, 0xbf,
+	0x96, 0x34, 0x55, 0x46, 0x3c, 0x3e, 0x06, 0xc1, 0xd2, 0xa5, 0xd7, 0x92, 0xaa, 0x49, 0x1d, 0x4d,
+	0x41, 0x80, 0x1f, 0x43, 0x2d, 0xe2, 0x1b, 0x58, 0xfd, 0xbe, 0x41, 0x4c, 0x45, 0x46, 0x42, 0xd4,
+	0x60, 0xaa, 0x5f, 0x2b, 0x86, 0x65, 0xa2, 0x6a, 0x84, 0xa5, 0xea, 0xa6, 0x42, 0x74, 0x49, 0x43,
+	0xb5, 0x68, 0x86, 0x9e, 0x64, 0x69, 0x26, 0x3a, 0x8a, 0x54, 0x18, 0x96, 0x69, 0x1b, 0x3d, 0x9b,
+	0x48, 0xfa, 0x57, 0x0a, 0x3a, 0x16, 0x7f, 0xe1, 0xe0, 0x69, 0x3f, 0xf0, 0x27, 0x3e, 0x73, 0xb6,
+	0xed, 0x33, 0x00, 0xde, 0x9f, 0xd0, 0xc0, 0x59, 0xbb, 0xd7, 0x1f, 0xdf, 0xb3, 0xf8, 0x7d, 0xce,
+	0xc9, 0x71, 0xf0, 0x39, 0x54, 0x59, 0x54, 0x36, 0xbe, 0xa4, 0xf6, 0x78, 0xea, 0xa5, 0x37, 0xec,
+	0x38, 0xba, 0x52, 0x83, 0x34, 0xaf, 0x4f, 0x3d, 0x22, 0xb0, 0x55, 0x90, 0x7e, 0x05, 0x32, 0x8d,
+	0x9b, 0x8e, 0x33, 0xef, 0x8a, 0xfc, 0xe4, 0xbf, 0xb8, 0x63, 0xb7, 0xca, 0x2f, 0xe1, 0xc4, 0x9f,
+	0x86, 0x93, 0x69, 0x68, 0x3f, 0x44, 0xec, 0xe3, 0xa4, 0x76, 0x2d, 0x25, 0xfe, 0xc1, 0xc1, 0xc9,
+	0x37, 0x53, 0x1a, 0xfc, 0xf4, 0x81, 0xee, 0x14, 0x7f, 0x06, 0xd8, 0x73, 0xe6, 0x76, 0x40, 0x2f,
+	0xa9, 0x3b, 0xa3, 0x43, 0x3b, 0x79, 0xab, 0x8a, 0xdb, 0x6f, 0x15, 0xf2, 0x9c, 0x39, 0x49, 0x6b,
+	0xe2, 0x8c, 0x38, 0x82, 0x27, 0xe9, 0x60, 0xff, 0xc3, 0x41, 0x74, 0xea, 0xef, 0x97, 0x0d, 0xee,
+	0x7a, 0xd9, 0xe0, 0xfe, 0x5a, 0x36, 0xb8, 0x9f, 0x6f, 0x1a, 0x85, 0xeb, 0x9b, 0x46, 0xe1, 0xcf,
+	0x9b, 0x46, 0xe1, 0xfb, 0x72, 0x0c, 0xfa, 0xf2, 0x9f, 0x01, 0x00, 0x14, 0xc7, 0xb2, 0xec, 0x23,
+	0x08, 0x00, 0x00,
 }
 
 func (m *PartitionRequestHeaders) Marshal() (dAtA []byte, err error) {
@@ -41,6 +41,7 @@ const (
 	Failure_TIMEOUT        Failure_Status = 11
 	Failure_FAULT          Failure_Status = 12
 	Failure_INTERNAL       Failure_Status = 13
+	Failure_OUT_OF_RANGE   Failure_Status = 14
 )
 
 var Failure_Status_name = map[int32]string{
@@ -58,6 +59,7 @@ var Failure_Status_name = map[int32]string{
 	11: "TIMEOUT",
 	12: "FAULT",
 	13: "INTERNAL",
+	14: "OUT_OF_RANGE",
 }
 
 var Failure_Status_value = map[string]int32{
@@ -75,6 +77,7 @@ var Failure_Status_value = map[string]int32{
 	"TIMEOUT":        11,
 	"FAULT":          12,
 	"INTERNAL":       13,
+	"OUT_OF_RANGE":   14,
 }
 
 func (x Failure_Status) String() string {
@@ -711,51 +714,52 @@ func init() {
 func init() { proto.RegisterFile("v1/primitive.proto", fileDescriptor_a084f7f80381e1dd) }
 
 var fileDescriptor_a084f7f80381e1dd = []byte{
-	// 701 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0xda, 0x48,
-	0x14, 0xc7, 0x2c, 0x81, 0xf0, 0x80, 0xac, 0x33, 0x61, 0x13, 0x14, 0xad, 0x4c, 0xe4, 0xc3, 0x6e,
-	0x4e, 0xb0, 0x49, 0x6e, 0x7b, 0xd9, 0x35, 0xd8, 0xb4, 0x56, 0x5d, 0x9b, 0x0e, 0x76, 0x9a, 0x44,
-	0x95, 0x22, 0x07, 0xa6, 0xd4, 0x12, 0x30, 0x96, 0xff, 0x44, 0xe5, 0x5b, 0xf4, 0xd8, 0x4b, 0xa5,
-	0x7e, 0x92, 0x9e, 0x73, 0xcc, 0xad, 0x3d, 0xa1, 0x8a, 0x5c, 0xfa, 0x19, 0x7a, 0xaa, 0xc6, 0x18,
-	0x03, 0xa9, 0xa2, 0xa6, 0x52, 0xd4, 0xdb, 0xbc, 0x37, 0xef, 0xf7, 0xe7, 0x79, 0xfc, 0x1e, 0xa0,
-	0xcb, 0x83, 0xba, 0xeb, 0x39, 0x43, 0x27, 0x70, 0x2e, 0x49, 0xcd, 0xf5, 0x68, 0x40, 0xd1, 0x8e,
-	0x1d, 0xd0, 0xa1, 0xf3, 0x7a, 0x16, 0x75, 0xe9, 0xc0, 0xaf, 0x79, 0xfe, 0xb0, 0x76, 0x79, 0xb0,
-	0x2b, 0xf4, 0x29, 0xed, 0x0f, 0x48, 0x3d, 0xba, 0xb8, 0x08, 0x5f, 0xd6, 0x7b, 0xa1, 0x67, 0x07,
-	0x0e, 0x1d, 0xcd, 0x4a, 0x77, 0xab, 0xb7, 0xef, 0x03, 0x67, 0x48, 0xfc, 0xc0, 0x1e, 0xba, 0x71,
-	0x41, 0xb9, 0x4f, 0xfb, 0x34, 0x3a, 0xd6, 0xd9, 0x69, 0x96, 0x15, 0x5f, 0x40, 0xb9, 0xe9, 0x11,
-	0x3b, 0x20, 0xed, 0xb9, 0x11, 0x75, 0xe4, 0x86, 0x01, 0x92, 0x21, 0xe3, 0xbb, 0xa4, 0x5b, 0xe1,
-	0xf6, 0xb8, 0xfd, 0xc2, 0xe1, 0x5f, 0xb5, 0x3b, 0x6c, 0xd5, 0x12, 0x58, 0xc7, 0x25, 0xdd, 0xc6,
-	0xfa, 0xd5, 0xa4, 0x9a, 0xba, 0x9e, 0x54, 0x39, 0x1c, 0xa1, 0xc5, 0x33, 0xf8, 0xe3, 0x16, 0xbb,
-	0x11, 0x06, 0x8c, 0x5e, 0x82, 0x62, 0xd2, 0xf9, 0xb9, 0xd3, 0x8b, 0x64, 0x32, 0x0d, 0x61, 0x3a,
-	0xa9, 0x16, 0x16, 0x46, 0xe4, 0xaf, 0xab, 0x21, 0x2e, 0x24, 0x18, 0xb5, 0x27, 0x9e, 0xc0, 0x56,
-	0x73, 0x40, 0xfd, 0xdb, 0xc6, 0x1f, 0x80, 0x79, 0x1b, 0xca, 0xab, 0xcc, 0x33, 0xd3, 0x62, 0x08,
-	0xdb, 0x49, 0xaa, 0xed, 0x51, 0x97, 0xfa, 0xf6, 0xe0, 0xa1, 0x44, 0x51, 0x05, 0x72, 0xae, 0x3d,
-	0x1e, 0x50, 0xbb, 0x57, 0x49, 0xef, 0x71, 0xfb, 0x45, 0x3c, 0x0f, 0xc5, 0x23, 0xd8, 0xf9, 0x4e,
-	0x36, 0xfe, 0x8c, 0x4b, 0x20, 0x6e, 0x15, 0xe4, 0xc1, 0x56, 0x02, 0x7a, 0x16, 0x12, 0x6f, 0xfc,
-	0x0b, 0x8c, 0xfe, 0x03, 0xe5, 0x55, 0xcd, 0x1f, 0xba, 0xfc, 0x90, 0x86, 0x5c, 0xcb, 0x76, 0x06,
-	0xa1, 0x47, 0xd0, 0x7f, 0x90, 0xf5, 0x03, 0x3b, 0x08, 0xfd, 0xa8, 0x68, 0xe3, 0xf0, 0xef, 0x3b,
-	0xff, 0xb9, 0x18, 0x51, 0xeb, 0x44, 0xe5, 0x38, 0x86, 0x31, 0x99, 0x21, 0xf1, 0x7d, 0xbb, 0x4f,
-	0x22, 0x63, 0x79, 0x3c, 0x0f, 0xc5, 0x8f, 0x1c, 0x64, 0x67, 0xc5, 0xa8, 0x00, 0x39, 0x4b, 0x7f,
-	0xa2, 0x1b, 0xcf, 0x75, 0x3e, 0x85, 0xf2, 0xb0, 0xa6, 0x60, 0x6c, 0x60, 0x9e, 0x43, 0x45, 0x58,
-	0x6f, 0x4a, 0x7a, 0x53, 0xd1, 0x14, 0x99, 0x4f, 0xa3, 0x12, 0xe4, 0x75, 0xc3, 0x3c, 0x6f, 0x19,
-	0x96, 0x2e, 0xf3, 0xbf, 0x21, 0x04, 0x1b, 0x92, 0x86, 0x15, 0x49, 0x3e, 0x3d, 0x57, 0x4e, 0xd4,
-	0x8e, 0xd9, 0xe1, 0x33, 0x88, 0x87, 0xa2, 0xa5, 0x4b, 0x96, 0xf9, 0xd8, 0xc0, 0xea, 0x99, 0x22,
-	0xf3, 0x6b, 0x0c, 0xd4, 0x32, 0x70, 0x43, 0x95, 0x65, 0x45, 0xe7, 0xb3, 0x11, 0xa3, 0xa1, 0xb7,
-	0x34, 0xb5, 0x69, 0xf2, 0x39, 0xa6, 0xab, 0xea, 0xc7, 0x92, 0xa6, 0xca, 0xfc, 0x3a, 0xfa, 0x1d,
-	0x0a, 0x96, 0x2e, 0x1d, 0x4b, 0xaa, 0x26, 0x35, 0x34, 0x85, 0xcf, 0xa3, 0x4d, 0x28, 0x31, 0xbd,
-	0x8e, 0xd5, 0x6e, 0x1b, 0xd8, 0x54, 0x64, 0x1e, 0x18, 0xc0, 0x54, 0x9f, 0x2a, 0x86, 0x65, 0xf2,
-	0x05, 0x66, 0xb4, 0x25, 0x59, 0x9a, 0xc9, 0x17, 0x19, 0xad, 0xaa, 0x9b, 0x0a, 0xd6, 0x25, 0x8d,
-	0x2f, 0x89, 0x6f, 0x39, 0xd8, 0x5c, 0x8c, 0xe0, 0xc8, 0x76, 0xfd, 0x57, 0xf4, 0x41, 0x5e, 0xf9,
-	0xff, 0x78, 0xfe, 0xd3, 0x3f, 0x35, 0xff, 0x19, 0x36, 0xff, 0xf1, 0xec, 0x9f, 0x42, 0x29, 0xb9,
-	0x34, 0xc7, 0x2e, 0x41, 0x08, 0x32, 0x23, 0x7b, 0x48, 0x22, 0x37, 0x79, 0x1c, 0x9d, 0x51, 0x0d,
-	0xc0, 0x76, 0x9d, 0x63, 0xe2, 0xf9, 0x0e, 0x1d, 0xcd, 0x9e, 0xad, 0xb1, 0x31, 0x9d, 0x54, 0x41,
-	0x6a, 0xab, 0x71, 0x16, 0x2f, 0x55, 0xfc, 0x9b, 0xf9, 0xf2, 0xbe, 0xca, 0x89, 0x8f, 0x96, 0xa8,
-	0x75, 0x46, 0xf3, 0x27, 0xe4, 0x19, 0x9d, 0xef, 0xda, 0xdd, 0x39, 0xff, 0x22, 0x91, 0x08, 0xa7,
-	0x17, 0xc2, 0x31, 0xd1, 0x3b, 0x6e, 0x89, 0x89, 0x75, 0xc0, 0xfa, 0x0e, 0xc6, 0x2e, 0xb9, 0xff,
-	0xde, 0x63, 0xad, 0xcd, 0xfb, 0x66, 0x48, 0xb6, 0x39, 0x13, 0xb5, 0x7b, 0x31, 0xb0, 0x0e, 0x96,
-	0x37, 0xe7, 0xc2, 0x5f, 0xa3, 0x72, 0x35, 0x15, 0xb8, 0xeb, 0xa9, 0xc0, 0x7d, 0x9e, 0x0a, 0xdc,
-	0x9b, 0x1b, 0x21, 0x75, 0x7d, 0x23, 0xa4, 0x3e, 0xdd, 0x08, 0xa9, 0x8b, 0x6c, 0xc4, 0x77, 0xf4,
-	0x2d, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xe1, 0x78, 0x36, 0x44, 0x06, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
+	0x14, 0xc6, 0x5c, 0x02, 0xe1, 0xf0, 0x73, 0x27, 0x13, 0x6e, 0x82, 0xa2, 0x2b, 0x13, 0x79, 0x71,
+	0x6f, 0x56, 0xd0, 0x24, 0xbb, 0x6e, 0x5a, 0x83, 0x4d, 0x6a, 0xd5, 0xb5, 0xe9, 0x60, 0xa7, 0x49,
+	0x54, 0x09, 0x39, 0xe0, 0x52, 0x4b, 0x80, 0x2d, 0xff, 0x44, 0xe5, 0x2d, 0xba, 0xec, 0xa6, 0x52,
+	0x1f, 0x27, 0x4b, 0x96, 0x5d, 0xa1, 0x8a, 0x6c, 0xda, 0x57, 0xe8, 0xaa, 0x1a, 0x63, 0x0c, 0xa4,
+	0x8a, 0x9a, 0x4a, 0x51, 0x77, 0x73, 0xce, 0x9c, 0xef, 0xe7, 0x30, 0xf8, 0x03, 0x7c, 0x75, 0x58,
+	0x73, 0x5c, 0x6b, 0x68, 0xf9, 0xd6, 0x95, 0x59, 0x75, 0x5c, 0xdb, 0xb7, 0xf1, 0xae, 0xe1, 0xdb,
+	0x43, 0xeb, 0xdd, 0xbc, 0xea, 0xda, 0x03, 0xaf, 0xea, 0x7a, 0xc3, 0xea, 0xd5, 0xe1, 0x1e, 0xdb,
+	0xb7, 0xed, 0xfe, 0xc0, 0xac, 0x85, 0x17, 0x97, 0xc1, 0x9b, 0x5a, 0x2f, 0x70, 0x0d, 0xdf, 0xb2,
+	0x47, 0xf3, 0xd1, 0xbd, 0xca, 0xed, 0x7b, 0xdf, 0x1a, 0x9a, 0x9e, 0x6f, 0x0c, 0x9d, 0x68, 0xa0,
+	0xd4, 0xb7, 0xfb, 0x76, 0x78, 0xac, 0xd1, 0xd3, 0xbc, 0xcb, 0xbd, 0x86, 0x52, 0xc3, 0x35, 0x0d,
+	0xdf, 0x6c, 0x2d, 0x8c, 0x48, 0x23, 0x27, 0xf0, 0xb1, 0x00, 0x29, 0xcf, 0x31, 0xbb, 0x65, 0x66,
+	0x9f, 0x39, 0xc8, 0x1d, 0xfd, 0x57, 0xbd, 0xc3, 0x56, 0x35, 0x86, 0xb5, 0x1d, 0xb3, 0x5b, 0xdf,
+	0xbc, 0x9e, 0x56, 0x12, 0x93, 0x69, 0x85, 0x21, 0x21, 0x9a, 0xbb, 0x80, 0x7f, 0x6e, 0xb1, 0xab,
+	0x81, 0x4f, 0xe9, 0x79, 0xc8, 0xc7, 0x9b, 0x77, 0xac, 0x5e, 0x28, 0x93, 0xaa, 0xb3, 0xb3, 0x69,
+	0x25, 0xb7, 0x34, 0x22, 0x7c, 0x5f, 0x2f, 0x49, 0x2e, 0xc6, 0x48, 0x3d, 0xee, 0x0c, 0xb6, 0x1b,
+	0x03, 0xdb, 0xbb, 0x6d, 0xfc, 0x01, 0x98, 0x77, 0xa0, 0xb4, 0xce, 0x3c, 0x37, 0xcd, 0x05, 0xb0,
+	0x13, 0xb7, 0x5a, 0xae, 0xed, 0xd8, 0x9e, 0x31, 0x78, 0x28, 0x51, 0x5c, 0x86, 0x8c, 0x63, 0x8c,
+	0x07, 0xb6, 0xd1, 0x2b, 0x27, 0xf7, 0x99, 0x83, 0x3c, 0x59, 0x94, 0xdc, 0x31, 0xec, 0xfe, 0x24,
+	0x1b, 0xfd, 0x8c, 0x2b, 0x20, 0x66, 0x1d, 0xe4, 0xc2, 0x76, 0x0c, 0x7a, 0x19, 0x98, 0xee, 0xf8,
+	0x0f, 0x18, 0x7d, 0x04, 0xa5, 0x75, 0xcd, 0x5f, 0xba, 0x9c, 0x24, 0x21, 0xd3, 0x34, 0xac, 0x41,
+	0xe0, 0x9a, 0xf8, 0x09, 0xa4, 0x3d, 0xdf, 0xf0, 0x03, 0x2f, 0x1c, 0x2a, 0x1e, 0xfd, 0x7f, 0xe7,
+	0x7f, 0x2e, 0x42, 0x54, 0xdb, 0xe1, 0x38, 0x89, 0x60, 0x54, 0x66, 0x68, 0x7a, 0x9e, 0xd1, 0x37,
+	0x43, 0x63, 0x59, 0xb2, 0x28, 0xb9, 0x6f, 0x0c, 0xa4, 0xe7, 0xc3, 0x38, 0x07, 0x19, 0x5d, 0x79,
+	0xae, 0xa8, 0xaf, 0x14, 0x94, 0xc0, 0x59, 0xd8, 0x10, 0x09, 0x51, 0x09, 0x62, 0x70, 0x1e, 0x36,
+	0x1b, 0xbc, 0xd2, 0x10, 0x65, 0x51, 0x40, 0x49, 0x5c, 0x80, 0xac, 0xa2, 0x6a, 0x9d, 0xa6, 0xaa,
+	0x2b, 0x02, 0xfa, 0x0b, 0x63, 0x28, 0xf2, 0x32, 0x11, 0x79, 0xe1, 0xbc, 0x23, 0x9e, 0x49, 0x6d,
+	0xad, 0x8d, 0x52, 0x18, 0x41, 0x5e, 0x57, 0x78, 0x5d, 0x7b, 0xa6, 0x12, 0xe9, 0x42, 0x14, 0xd0,
+	0x06, 0x05, 0x35, 0x55, 0x52, 0x97, 0x04, 0x41, 0x54, 0x50, 0x3a, 0x64, 0x54, 0x95, 0xa6, 0x2c,
+	0x35, 0x34, 0x94, 0xa1, 0xba, 0x92, 0x72, 0xca, 0xcb, 0x92, 0x80, 0x36, 0xf1, 0xdf, 0x90, 0xd3,
+	0x15, 0xfe, 0x94, 0x97, 0x64, 0xbe, 0x2e, 0x8b, 0x28, 0x8b, 0xb7, 0xa0, 0x40, 0xf5, 0xda, 0x7a,
+	0xab, 0xa5, 0x12, 0x4d, 0x14, 0x10, 0x50, 0x80, 0x26, 0xbd, 0x10, 0x55, 0x5d, 0x43, 0x39, 0x6a,
+	0xb4, 0xc9, 0xeb, 0xb2, 0x86, 0xf2, 0x94, 0x56, 0x52, 0x34, 0x91, 0x28, 0xbc, 0x8c, 0x0a, 0xd4,
+	0x85, 0xaa, 0x6b, 0x1d, 0xb5, 0xd9, 0x21, 0xbc, 0x72, 0x22, 0xa2, 0x22, 0xf7, 0x81, 0x81, 0xad,
+	0xe5, 0x47, 0x39, 0x32, 0x1c, 0xef, 0xad, 0xfd, 0x20, 0xef, 0xfe, 0x34, 0x4a, 0x84, 0xe4, 0x6f,
+	0x25, 0x42, 0x8a, 0x26, 0x42, 0x94, 0x06, 0xe7, 0x50, 0x88, 0x2f, 0xb5, 0xb1, 0x63, 0x62, 0x0c,
+	0xa9, 0x91, 0x31, 0x34, 0x43, 0x37, 0x59, 0x12, 0x9e, 0x71, 0x15, 0xc0, 0x70, 0xac, 0x53, 0xd3,
+	0xf5, 0x2c, 0x7b, 0x34, 0x7f, 0xc8, 0x7a, 0x71, 0x36, 0xad, 0x00, 0xdf, 0x92, 0xa2, 0x2e, 0x59,
+	0x99, 0x78, 0x9c, 0xfa, 0xfa, 0xa9, 0xc2, 0x70, 0x27, 0x2b, 0xd4, 0x0a, 0xa5, 0xf9, 0x17, 0xb2,
+	0x94, 0xce, 0x73, 0x8c, 0xee, 0x82, 0x7f, 0xd9, 0x88, 0x85, 0x93, 0x4b, 0xe1, 0x88, 0xe8, 0x23,
+	0x03, 0x85, 0xb5, 0x0d, 0xe8, 0xde, 0xfe, 0xd8, 0x31, 0xef, 0x9f, 0x84, 0x74, 0xb5, 0xc5, 0xde,
+	0x14, 0x49, 0xb3, 0x34, 0x56, 0xbb, 0x17, 0x03, 0xdd, 0x60, 0x35, 0x4b, 0x97, 0xfe, 0xea, 0xe5,
+	0xeb, 0x19, 0xcb, 0x4c, 0x66, 0x2c, 0xf3, 0x65, 0xc6, 0x32, 0xef, 0x6f, 0xd8, 0xc4, 0xe4, 0x86,
+	0x4d, 0x7c, 0xbe, 0x61, 0x13, 0x97, 0xe9, 0x90, 0xef, 0xf8, 0xc7, 0x00, 0x37, 0xe2, 0x05, 0x55,
+	0x56, 0x06, 0x00, 0x00,
 }
 
 func (this *PrimitiveType) Equal(that interface{}) bool {
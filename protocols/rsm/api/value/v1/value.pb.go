@@ -6,12 +6,12 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
-	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	github_com_atomix_atomix_protocols_rsm_api_v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	v1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -1016,6 +1016,7 @@ func (*ValueOutput) XXX_OneofWrappers() []interface{} {
 }
 
 type GetInput struct {
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
 }
 
 func (m *GetInput) Reset()         { *m = GetInput{} }
@@ -1051,6 +1052,13 @@ func (m *GetInput) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetInput proto.InternalMessageInfo
 
+func (m *GetInput) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
 type GetOutput struct {
 	Value *IndexedValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -1098,6 +1106,7 @@ func (m *GetOutput) GetValue() *IndexedValue {
 type SetInput struct {
 	Value []byte         `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	TTL   *time.Duration `protobuf:"bytes,2,opt,name=ttl,proto3,stdduration" json:"ttl,omitempty"`
+	Patch *Patch         `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
 func (m *SetInput) Reset()         { *m = SetInput{} }
@@ -1147,6 +1156,13 @@ func (m *SetInput) GetTTL() *time.Duration {
 	return nil
 }
 
+func (m *SetInput) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 type SetOutput struct {
 	Index     github_com_atomix_atomix_protocols_rsm_api_v1.Index `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/vpascoalr/atomix/protocols/rsm/api/v1.Index" json:"index,omitempty"`
 	PrevValue *IndexedValue                                       `protobuf:"bytes,2,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
@@ -1713,6 +1729,7 @@ type Event struct {
 	//	*Event_Updated_
 	//	*Event_Deleted_
 	Event isEvent_Event `protobuf_oneof:"event"`
+	Patch *Patch        `protobuf:"bytes,4,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
 func (m *Event) Reset()         { *m = Event{} }
@@ -1796,6 +1813,13 @@ func (m *Event) GetDeleted() *Event_Deleted {
 	return nil
 }
 
+func (m *Event) GetPatch() *Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1953,6 +1977,91 @@ func (m *Event_Deleted) GetExpired() bool {
 	return false
 }
 
+type Patch struct {
+	// Types that are valid to be assigned to Patch:
+	//	*Patch_JsonPatch
+	//	*Patch_MergePatch
+	Patch isPatch_Patch `protobuf_oneof:"patch"`
+}
+
+func (m *Patch) Reset()         { *m = Patch{} }
+func (m *Patch) String() string { return proto.CompactTextString(m) }
+func (*Patch) ProtoMessage()    {}
+func (*Patch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b6ee9b1f99fa846, []int{33}
+}
+func (m *Patch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Patch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Patch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Patch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Patch.Merge(m, src)
+}
+func (m *Patch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Patch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Patch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Patch proto.InternalMessageInfo
+
+type isPatch_Patch interface {
+	isPatch_Patch()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Patch_JsonPatch struct {
+	JsonPatch []byte `protobuf:"bytes,1,opt,name=json_patch,json=jsonPatch,proto3,oneof" json:"json_patch,omitempty"`
+}
+type Patch_MergePatch struct {
+	MergePatch []byte `protobuf:"bytes,2,opt,name=merge_patch,json=mergePatch,proto3,oneof" json:"merge_patch,omitempty"`
+}
+
+func (*Patch_JsonPatch) isPatch_Patch()  {}
+func (*Patch_MergePatch) isPatch_Patch() {}
+
+func (m *Patch) GetPatch() isPatch_Patch {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
+func (m *Patch) GetJsonPatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_JsonPatch); ok {
+		return x.JsonPatch
+	}
+	return nil
+}
+
+func (m *Patch) GetMergePatch() []byte {
+	if x, ok := m.GetPatch().(*Patch_MergePatch); ok {
+		return x.MergePatch
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Patch) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Patch_JsonPatch)(nil),
+		(*Patch_MergePatch)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "atomix.protocols.rsm.value.v1.GetRequest")
 	proto.RegisterType((*GetResponse)(nil), "atomix.protocols.rsm.value.v1.GetResponse")
@@ -1990,88 +2099,94 @@ func init() {
 	proto.RegisterType((*Event_Created)(nil), "atomix.protocols.rsm.value.v1.Event.Created")
 	proto.RegisterType((*Event_Updated)(nil), "atomix.protocols.rsm.value.v1.Event.Updated")
 	proto.RegisterType((*Event_Deleted)(nil), "atomix.protocols.rsm.value.v1.Event.Deleted")
+	proto.RegisterType((*Patch)(nil), "atomix.protocols.rsm.value.v1.Patch")
 }
 
 func init() { proto.RegisterFile("value/v1/value.proto", fileDescriptor_8b6ee9b1f99fa846) }
 
 var fileDescriptor_8b6ee9b1f99fa846 = []byte{
-	// 1209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc4, 0xbf, 0x92, 0x67, 0x17, 0xa1, 0x55, 0x0e, 0xc6, 0x12, 0x0e, 0x5a, 0x21, 0xd1,
-	0xa6, 0xe9, 0x6e, 0xd2, 0x1e, 0x40, 0xa2, 0x07, 0x70, 0xe3, 0xd8, 0xa9, 0xf8, 0x11, 0xd6, 0xa1,
-	0x95, 0x50, 0x2b, 0xb4, 0xc9, 0x0e, 0x8e, 0x25, 0xdb, 0x6b, 0x76, 0xc7, 0x26, 0xfc, 0x09, 0xdc,
-	0x7a, 0x04, 0x09, 0x90, 0x40, 0x08, 0x8e, 0x9c, 0xf8, 0x1b, 0xe8, 0x31, 0x37, 0x38, 0x05, 0x94,
-	0xdc, 0xb8, 0x72, 0xe3, 0x84, 0x66, 0xde, 0x4c, 0xbc, 0xde, 0x7a, 0xd9, 0x5d, 0xba, 0x55, 0x73,
-	0xca, 0x6e, 0x3c, 0xef, 0xcd, 0x37, 0xdf, 0x7b, 0xef, 0x7b, 0x6f, 0x16, 0x56, 0xa7, 0xf6, 0x60,
-	0x42, 0xcd, 0xe9, 0x96, 0x29, 0x1e, 0x8c, 0xb1, 0xe7, 0x32, 0x57, 0x7b, 0xd9, 0x66, 0xee, 0xb0,
-	0x7f, 0x8c, 0x6f, 0x87, 0xee, 0xc0, 0x37, 0x3c, 0x7f, 0x68, 0xe0, 0x8a, 0xe9, 0x56, 0xfd, 0xc5,
-	0xe9, 0x96, 0x79, 0x44, 0x6d, 0x87, 0x7a, 0x3e, 0x2e, 0xa9, 0x37, 0x7a, 0xae, 0xdb, 0x1b, 0x50,
-	0x53, 0xbc, 0x1d, 0x4c, 0x3e, 0x31, 0x9d, 0x89, 0x67, 0xb3, 0xbe, 0x3b, 0x92, 0xbf, 0xaf, 0x85,
-	0x7f, 0x67, 0xfd, 0x21, 0xf5, 0x99, 0x3d, 0x1c, 0xcb, 0x05, 0xab, 0x3d, 0xb7, 0xe7, 0x8a, 0x47,
-	0x93, 0x3f, 0xe1, 0x7f, 0xf5, 0xaf, 0x08, 0x40, 0x9b, 0x32, 0x8b, 0x7e, 0x3a, 0xa1, 0x3e, 0xd3,
-	0x76, 0xa0, 0x2c, 0xb7, 0xad, 0x91, 0x57, 0xc8, 0xd5, 0xca, 0xcd, 0x0d, 0x63, 0x31, 0xd0, 0x2d,
-	0xe3, 0x83, 0x09, 0xf5, 0x3e, 0x97, 0x76, 0x1d, 0xb4, 0xb1, 0x94, 0xb1, 0x76, 0x07, 0x8a, 0xfd,
-	0xd1, 0x78, 0xc2, 0x6a, 0x4b, 0xc2, 0xcb, 0x6b, 0xc6, 0x7f, 0x1e, 0xd7, 0x68, 0x53, 0xb6, 0xcb,
-	0x97, 0x37, 0x0b, 0x27, 0xa7, 0x6b, 0xc4, 0x42, 0x5b, 0xfd, 0x5b, 0x02, 0x15, 0x81, 0xcd, 0x1f,
-	0xbb, 0x23, 0x9f, 0x6a, 0xed, 0x30, 0xb8, 0x1b, 0x71, 0xe0, 0xd0, 0xf0, 0x09, 0x74, 0x3b, 0x50,
-	0x72, 0x27, 0x6c, 0x06, 0xef, 0x6a, 0x3c, 0xbc, 0xf7, 0xc5, 0x7a, 0x89, 0x4f, 0x5a, 0xeb, 0x5f,
-	0x13, 0x80, 0xee, 0x8c, 0xbc, 0xdd, 0x30, 0x3e, 0x33, 0x12, 0xdf, 0x9e, 0xe7, 0x8e, 0x5d, 0xdf,
-	0x1e, 0x64, 0xc4, 0x5f, 0x77, 0x21, 0x7f, 0xdf, 0x11, 0xa8, 0x74, 0x03, 0xfc, 0xdd, 0x0d, 0xe3,
-	0xdb, 0x4c, 0x80, 0x2f, 0x23, 0x0a, 0xbb, 0x11, 0x14, 0x7e, 0x4f, 0xe0, 0xca, 0xee, 0xc8, 0xa7,
-	0xde, 0xb3, 0x60, 0x71, 0x67, 0x9e, 0xc5, 0xf5, 0x18, 0x8c, 0x88, 0x63, 0x01, 0x91, 0x3f, 0x11,
-	0x78, 0x41, 0x81, 0x7c, 0x06, 0x5c, 0xee, 0x86, 0xb8, 0xbc, 0x9e, 0x08, 0x67, 0x24, 0x9d, 0x1f,
-	0x8e, 0x1d, 0x9b, 0xd1, 0xe7, 0x4f, 0x27, 0xe2, 0x88, 0xa0, 0x53, 0x81, 0xbc, 0x04, 0x74, 0x22,
-	0x94, 0x48, 0x3a, 0xb7, 0xe9, 0x80, 0x5e, 0x06, 0x3a, 0x11, 0x47, 0x04, 0x9d, 0x0a, 0xe4, 0x25,
-	0xa0, 0x13, 0xa1, 0x2c, 0xa4, 0xf3, 0x1b, 0x02, 0xd5, 0xfb, 0x36, 0x3b, 0x3c, 0xca, 0xba, 0xdd,
-	0xb4, 0xe6, 0xa9, 0xbc, 0x16, 0x03, 0x51, 0x60, 0x58, 0xc0, 0x24, 0x0f, 0xb7, 0xc4, 0x97, 0x75,
-	0xcb, 0xe9, 0x84, 0x58, 0x5c, 0x4f, 0x02, 0x31, 0x32, 0x27, 0x5b, 0x53, 0x3a, 0x62, 0xfe, 0xf3,
-	0xcf, 0x49, 0xc4, 0x11, 0x91, 0x93, 0x0a, 0xe4, 0x25, 0xc8, 0x49, 0x84, 0xb2, 0x90, 0xce, 0x2f,
-	0x08, 0xc0, 0x3d, 0xbe, 0xae, 0xcb, 0x6c, 0x46, 0xb5, 0xb7, 0xa1, 0x28, 0xac, 0x24, 0xc6, 0x78,
-	0x29, 0x76, 0xe8, 0x31, 0x75, 0x84, 0x03, 0x0b, 0x2d, 0xb5, 0x37, 0xa0, 0x44, 0x8f, 0xc7, 0x7d,
-	0x8f, 0x4a, 0x70, 0x75, 0x03, 0x47, 0x33, 0x43, 0x8d, 0x66, 0xc6, 0xbe, 0x1a, 0xcd, 0x9a, 0x85,
-	0x47, 0x7f, 0x70, 0x2c, 0xb8, 0x5e, 0xff, 0x2b, 0x2f, 0xb1, 0x08, 0x46, 0xb5, 0x37, 0x21, 0xdf,
-	0xa3, 0x4c, 0x22, 0x49, 0x3a, 0x42, 0x75, 0x72, 0x16, 0xb7, 0xe2, 0xc6, 0x3e, 0x4d, 0x3b, 0x3f,
-	0x70, 0x63, 0x9f, 0x32, 0x6d, 0x1b, 0x4a, 0x7d, 0xd1, 0x64, 0x6a, 0xf9, 0xb4, 0x9d, 0xb3, 0x93,
-	0xb3, 0xa4, 0x2d, 0xf7, 0x32, 0x11, 0xda, 0x5a, 0x2b, 0xa4, 0x6d, 0x18, 0xdc, 0x0b, 0xda, 0x72,
-	0x2f, 0x8e, 0x90, 0x94, 0x5a, 0x31, 0xad, 0x4e, 0x72, 0x2f, 0x68, 0xcb, 0xe3, 0xfa, 0x19, 0x2f,
-	0xa9, 0x5a, 0x29, 0xa5, 0x42, 0x74, 0x72, 0x16, 0x5a, 0x72, 0x20, 0x54, 0xe4, 0x51, 0xad, 0x9c,
-	0xb6, 0x38, 0x38, 0x10, 0xb4, 0x6d, 0x96, 0x65, 0x85, 0xe9, 0x7f, 0xe7, 0xa1, 0x22, 0x82, 0x8d,
-	0x69, 0xa9, 0xdd, 0x0e, 0x46, 0x3b, 0xf1, 0x44, 0xaa, 0xc2, 0x7d, 0x3b, 0x18, 0xee, 0xc4, 0xc3,
-	0x98, 0x8a, 0x77, 0x2b, 0x14, 0xef, 0x34, 0x13, 0x48, 0x20, 0xe0, 0xad, 0x50, 0xc0, 0xd3, 0x74,
-	0xde, 0x40, 0xc4, 0x5b, 0xa1, 0x88, 0xa7, 0xe9, 0x38, 0x81, 0x90, 0x37, 0xe7, 0x43, 0x9e, 0x42,
-	0x71, 0x67, 0x31, 0x6f, 0x85, 0x62, 0x9e, 0x46, 0x68, 0x02, 0x41, 0x5f, 0x56, 0x7a, 0xa5, 0x03,
-	0x2c, 0xab, 0x4a, 0xd5, 0xdf, 0x83, 0x95, 0x8b, 0x38, 0x66, 0x20, 0x3c, 0xfa, 0x47, 0xb0, 0xac,
-	0x0a, 0x59, 0x5b, 0x0d, 0xba, 0xab, 0xce, 0xa4, 0x29, 0xcf, 0xd8, 0x40, 0x66, 0xc9, 0x4b, 0x4f,
-	0xe8, 0xd2, 0xb6, 0xbc, 0x52, 0x36, 0x2b, 0x67, 0xa7, 0x6b, 0xf9, 0xfd, 0xfd, 0x77, 0xbe, 0xe4,
-	0xea, 0xc4, 0x4d, 0xf4, 0x1f, 0x09, 0xac, 0x5c, 0xa4, 0x8d, 0xf6, 0x2e, 0x4f, 0x62, 0x87, 0x1e,
-	0x0b, 0xef, 0x85, 0xe6, 0xeb, 0xff, 0x9c, 0xae, 0xdd, 0xea, 0xf5, 0xd9, 0xd1, 0xe4, 0xc0, 0x38,
-	0x74, 0x87, 0x26, 0x42, 0x57, 0x7f, 0x2e, 0x4e, 0x60, 0x7a, 0xfe, 0xd0, 0xb4, 0xc7, 0x7d, 0x53,
-	0xe1, 0xb7, 0xd0, 0x8b, 0x76, 0x17, 0x60, 0xec, 0xd1, 0xe9, 0xc7, 0x88, 0x78, 0x29, 0x3d, 0x01,
-	0x2b, 0xdc, 0x5c, 0x3c, 0xea, 0x0f, 0xa1, 0x12, 0x50, 0xa3, 0xcc, 0x79, 0x78, 0x08, 0xd5, 0x60,
-	0xf2, 0x67, 0xcc, 0x84, 0xfe, 0x0b, 0x81, 0x4a, 0x40, 0x06, 0x23, 0xe0, 0xdf, 0x93, 0x7c, 0xe1,
-	0xce, 0x4b, 0x4f, 0xb7, 0xb3, 0xe0, 0x4e, 0x3c, 0x2a, 0x5a, 0xf2, 0xe9, 0x69, 0xf9, 0x99, 0x40,
-	0x35, 0x58, 0xcd, 0x59, 0x67, 0xc8, 0xde, 0x53, 0x66, 0x48, 0xb3, 0xf0, 0xf8, 0x74, 0x2d, 0x17,
-	0xcc, 0x13, 0x0a, 0x95, 0x40, 0xa7, 0x08, 0x51, 0x4a, 0xb2, 0xa2, 0x54, 0xbf, 0x0f, 0xd5, 0xa0,
-	0x3c, 0x69, 0xed, 0xff, 0x5f, 0xe6, 0xf2, 0x0c, 0xb2, 0xd8, 0xab, 0x00, 0xb3, 0x26, 0xa5, 0xef,
-	0x41, 0x25, 0xa0, 0x5f, 0x59, 0x88, 0xc9, 0x15, 0xa8, 0x04, 0x1a, 0x98, 0xbe, 0x07, 0xd5, 0xa0,
-	0xb6, 0x69, 0x6f, 0x41, 0x51, 0x68, 0x9b, 0xdc, 0xe1, 0xd5, 0x24, 0xba, 0xa8, 0x0e, 0x20, 0x0c,
-	0x75, 0x9f, 0x57, 0xd2, 0x6c, 0xdf, 0x88, 0x54, 0xbf, 0xc8, 0xa3, 0xa5, 0x4c, 0xea, 0xeb, 0xb7,
-	0x02, 0x14, 0x05, 0x16, 0xad, 0x03, 0xe5, 0x43, 0x8f, 0xda, 0x8c, 0x3a, 0x31, 0x57, 0x8f, 0xb9,
-	0x23, 0x18, 0x77, 0xd0, 0xa6, 0x93, 0xb3, 0x94, 0x39, 0xf7, 0x84, 0x8d, 0xcb, 0x91, 0x89, 0x99,
-	0xcc, 0x13, 0x96, 0x8b, 0xf0, 0x24, 0xcd, 0xb9, 0x27, 0xec, 0x5d, 0x8e, 0xac, 0xc1, 0x64, 0x9e,
-	0x30, 0xc1, 0x84, 0x27, 0x69, 0x5e, 0xb7, 0xa0, 0x2c, 0x91, 0x66, 0x96, 0x71, 0xf5, 0x1f, 0x08,
-	0x94, 0x25, 0xe8, 0xcc, 0x9c, 0x66, 0x5f, 0xd8, 0xf5, 0x01, 0x94, 0x25, 0x21, 0xd9, 0xa1, 0xac,
-	0x41, 0x19, 0x47, 0x74, 0x0c, 0xf1, 0xb2, 0xa5, 0x5e, 0xf9, 0x38, 0x27, 0xd2, 0xf9, 0xe6, 0xaf,
-	0x45, 0x28, 0x62, 0x22, 0x3f, 0x80, 0x7c, 0x97, 0x32, 0xed, 0x5a, 0xfc, 0x10, 0x26, 0x6f, 0x61,
-	0xf5, 0xf5, 0x24, 0x4b, 0xe5, 0x35, 0x8a, 0x42, 0x09, 0x1b, 0x90, 0xb6, 0x91, 0x68, 0x48, 0x53,
-	0x7b, 0xdc, 0x48, 0xb8, 0x7a, 0xb6, 0x0d, 0xc6, 0x3a, 0x76, 0x9b, 0xb9, 0xcf, 0x4d, 0xb1, 0xdb,
-	0x84, 0xbe, 0xfb, 0x3c, 0x80, 0x7c, 0x3b, 0x01, 0x57, 0xed, 0xe4, 0x5c, 0xb5, 0xe7, 0xb9, 0xc2,
-	0x54, 0x88, 0x3d, 0xc4, 0xdc, 0x47, 0x9e, 0xd8, 0x43, 0x84, 0xbe, 0xb6, 0x38, 0x50, 0x14, 0xe2,
-	0xab, 0x5d, 0x4f, 0x32, 0x62, 0xaa, 0x4d, 0x36, 0x92, 0x2d, 0xc6, 0x3d, 0x36, 0x89, 0xd6, 0x83,
-	0x12, 0x2a, 0xb0, 0x96, 0x48, 0x15, 0xfc, 0xa4, 0x87, 0x99, 0xbf, 0xa6, 0x6f, 0x92, 0x66, 0xed,
-	0xf1, 0x59, 0x83, 0x9c, 0x9c, 0x35, 0xc8, 0x9f, 0x67, 0x0d, 0xf2, 0xe8, 0xbc, 0x91, 0x3b, 0x39,
-	0x6f, 0xe4, 0x7e, 0x3f, 0x6f, 0xe4, 0x0e, 0x4a, 0xc2, 0xc5, 0xad, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x8f, 0x6e, 0xc8, 0x58, 0xd3, 0x18, 0x00, 0x00,
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xf8, 0x33, 0x79, 0x76, 0x11, 0x1a, 0xf5, 0xb0, 0x58, 0xc2, 0x2e, 0x2b, 0x24, 0xfa,
+	0x91, 0xec, 0x26, 0x45, 0x42, 0xa8, 0xf4, 0x00, 0x6e, 0x1c, 0x3b, 0x15, 0x02, 0xb3, 0x0e, 0x54,
+	0x48, 0x45, 0x65, 0x63, 0x0f, 0xb6, 0x91, 0xed, 0x35, 0xbb, 0x63, 0x13, 0xfe, 0x04, 0x6e, 0x95,
+	0x90, 0x10, 0x48, 0x80, 0x04, 0x42, 0x42, 0x5c, 0x39, 0xf1, 0x1f, 0xd0, 0x63, 0x8e, 0x9c, 0x02,
+	0x4a, 0x6e, 0x5c, 0xb9, 0x71, 0x42, 0x33, 0x6f, 0x36, 0x5e, 0xbb, 0x36, 0xde, 0xa5, 0x1b, 0x35,
+	0xb7, 0xb5, 0xfd, 0x3e, 0x7e, 0xf3, 0x7b, 0x6f, 0x7e, 0xef, 0xad, 0xe1, 0xf2, 0xc4, 0xee, 0x8f,
+	0x99, 0x39, 0xd9, 0x36, 0xe5, 0x83, 0x31, 0x72, 0x1d, 0xee, 0xd0, 0xe7, 0x6d, 0xee, 0x0c, 0x7a,
+	0x87, 0xf8, 0xa9, 0xe5, 0xf4, 0x3d, 0xc3, 0xf5, 0x06, 0x06, 0x5a, 0x4c, 0xb6, 0x8b, 0xcf, 0x4e,
+	0xb6, 0xcd, 0x2e, 0xb3, 0xdb, 0xcc, 0xf5, 0xd0, 0xa4, 0x58, 0xea, 0x38, 0x4e, 0xa7, 0xcf, 0x4c,
+	0xf9, 0xe9, 0x60, 0xfc, 0x91, 0xd9, 0x1e, 0xbb, 0x36, 0xef, 0x39, 0x43, 0xf5, 0x7b, 0x79, 0xfe,
+	0x77, 0xde, 0x1b, 0x30, 0x8f, 0xdb, 0x83, 0x91, 0x32, 0xb8, 0xdc, 0x71, 0x3a, 0x8e, 0x7c, 0x34,
+	0xc5, 0x13, 0x7e, 0xab, 0x7f, 0x4d, 0x00, 0x6a, 0x8c, 0x5b, 0xec, 0x93, 0x31, 0xf3, 0x38, 0xdd,
+	0x85, 0x9c, 0x4a, 0xab, 0x91, 0x2b, 0xe4, 0x6a, 0xfe, 0xe6, 0x86, 0xb1, 0x18, 0xe8, 0xb6, 0xf1,
+	0xce, 0x98, 0xb9, 0x9f, 0x29, 0xbf, 0x3a, 0xfa, 0x58, 0xbe, 0x33, 0xbd, 0x03, 0x99, 0xde, 0x70,
+	0x34, 0xe6, 0x5a, 0x52, 0x46, 0x79, 0xc9, 0xf8, 0xcf, 0xe3, 0x1a, 0x35, 0xc6, 0xf7, 0x84, 0x79,
+	0x25, 0x7d, 0x74, 0x5c, 0x26, 0x16, 0xfa, 0xea, 0xdf, 0x11, 0xc8, 0x4b, 0x6c, 0xde, 0xc8, 0x19,
+	0x7a, 0x8c, 0xd6, 0xe6, 0xc1, 0x6d, 0xae, 0x02, 0x87, 0x8e, 0x8f, 0xa1, 0xdb, 0x85, 0xac, 0x33,
+	0xe6, 0x53, 0x78, 0x57, 0x57, 0xc3, 0x7b, 0x7b, 0xcc, 0xa7, 0xf8, 0x94, 0xb7, 0xfe, 0x0d, 0x01,
+	0x68, 0x4e, 0xc9, 0xdb, 0x9b, 0xc7, 0x67, 0x2e, 0xc5, 0xd7, 0x70, 0x9d, 0x91, 0xe3, 0xd9, 0xfd,
+	0x98, 0xf8, 0x6b, 0x2e, 0xe4, 0xef, 0x7b, 0x02, 0xf9, 0x66, 0x80, 0xbf, 0xbb, 0xf3, 0xf8, 0xb6,
+	0x42, 0xe0, 0x8b, 0x89, 0xc2, 0xe6, 0x12, 0x0a, 0x7f, 0x20, 0x70, 0x69, 0x6f, 0xe8, 0x31, 0xf7,
+	0x3c, 0x58, 0xdc, 0x9d, 0x65, 0xf1, 0xfa, 0x0a, 0x8c, 0x88, 0x63, 0x01, 0x91, 0x3f, 0x11, 0x78,
+	0xc6, 0x07, 0x79, 0x0e, 0x5c, 0xee, 0xcd, 0x71, 0x79, 0x23, 0x14, 0xce, 0xa5, 0x74, 0xbe, 0x3b,
+	0x6a, 0xdb, 0x9c, 0x3d, 0x7d, 0x3a, 0x11, 0xc7, 0x12, 0x3a, 0x7d, 0x90, 0x17, 0x80, 0x4e, 0x84,
+	0xb2, 0x94, 0xce, 0x1d, 0xd6, 0x67, 0x17, 0x81, 0x4e, 0xc4, 0xb1, 0x84, 0x4e, 0x1f, 0xe4, 0x05,
+	0xa0, 0x13, 0xa1, 0x2c, 0xa4, 0xf3, 0x5b, 0x02, 0x85, 0x7b, 0x36, 0x6f, 0x75, 0xe3, 0x1e, 0x37,
+	0xd5, 0x59, 0x2a, 0xaf, 0xad, 0x80, 0x28, 0x31, 0x2c, 0x60, 0x52, 0x94, 0x5b, 0xe1, 0x8b, 0x7b,
+	0xe4, 0xd4, 0xe7, 0x58, 0xbc, 0x1e, 0x06, 0xe2, 0xd2, 0x9e, 0xac, 0x4e, 0xd8, 0x90, 0x7b, 0x4f,
+	0xbf, 0x27, 0x11, 0xc7, 0x92, 0x9e, 0xf4, 0x41, 0x5e, 0x80, 0x9e, 0x44, 0x28, 0x0b, 0xe9, 0xfc,
+	0x9c, 0x00, 0xbc, 0x27, 0xec, 0x9a, 0xdc, 0xe6, 0x8c, 0xbe, 0x01, 0x19, 0xe9, 0xa5, 0x91, 0x50,
+	0x81, 0xf7, 0x86, 0x6d, 0x76, 0xc8, 0xda, 0x32, 0x80, 0x85, 0x9e, 0xf4, 0x55, 0xc8, 0xb2, 0xc3,
+	0x51, 0xcf, 0x65, 0x0a, 0x5c, 0xd1, 0xc0, 0xd5, 0xcc, 0xf0, 0x57, 0x33, 0x63, 0xdf, 0x5f, 0xcd,
+	0x2a, 0xe9, 0x87, 0x7f, 0x08, 0x2c, 0x68, 0xaf, 0xff, 0x95, 0x52, 0x58, 0x24, 0xa3, 0xf4, 0x35,
+	0x48, 0x75, 0x18, 0xd7, 0x48, 0xa8, 0x15, 0xc0, 0x5f, 0xa1, 0xea, 0x09, 0x4b, 0x78, 0x09, 0x67,
+	0x8f, 0x45, 0xdd, 0x1f, 0x84, 0xb3, 0xc7, 0x38, 0xdd, 0x81, 0x6c, 0x4f, 0x0e, 0x19, 0x2d, 0x15,
+	0x75, 0x72, 0xd6, 0x13, 0x96, 0xf2, 0x15, 0x51, 0xc6, 0x52, 0x5b, 0xb5, 0x74, 0xd4, 0x81, 0x21,
+	0xa2, 0xa0, 0xaf, 0x88, 0xd2, 0x96, 0x92, 0xa2, 0x65, 0xa2, 0xea, 0xa4, 0x88, 0x82, 0xbe, 0xa2,
+	0xae, 0x9f, 0x8a, 0x2b, 0xa5, 0x65, 0x23, 0x2a, 0x44, 0x3d, 0x61, 0xa1, 0xa7, 0x00, 0xc2, 0x64,
+	0x1f, 0x69, 0xb9, 0xa8, 0x97, 0x43, 0x00, 0x41, 0xdf, 0x4a, 0x4e, 0xdd, 0x30, 0xfd, 0xef, 0x14,
+	0xe4, 0x65, 0xb1, 0xb1, 0x2d, 0xe9, 0xed, 0x60, 0xb5, 0x43, 0x6f, 0xa4, 0x7e, 0xb9, 0x6f, 0x07,
+	0xcb, 0x1d, 0x7a, 0x19, 0xf3, 0xeb, 0x5d, 0x9d, 0xab, 0x77, 0x94, 0x0d, 0x24, 0x50, 0xf0, 0xea,
+	0x5c, 0xc1, 0xa3, 0x4c, 0xde, 0x40, 0xc5, 0xab, 0x73, 0x15, 0x8f, 0x32, 0x71, 0x02, 0x25, 0xaf,
+	0xcc, 0x96, 0x3c, 0x82, 0xe2, 0x4e, 0x6b, 0x5e, 0x9d, 0xab, 0x79, 0x14, 0xa1, 0x09, 0x14, 0x7d,
+	0xcd, 0xd7, 0x2b, 0xfd, 0x0a, 0xac, 0xf9, 0x37, 0x95, 0x5e, 0x86, 0xcc, 0xc8, 0xe6, 0x5d, 0xa1,
+	0x87, 0xa9, 0xab, 0xeb, 0x16, 0x7e, 0xd0, 0xdf, 0x82, 0xf5, 0xb3, 0xea, 0xc6, 0x20, 0x47, 0xfa,
+	0x97, 0x04, 0xd6, 0x9a, 0x81, 0x94, 0xd3, 0x78, 0x85, 0xa9, 0x62, 0xa5, 0x38, 0xef, 0xab, 0xe6,
+	0x79, 0xee, 0x31, 0xb9, 0xda, 0x51, 0x6f, 0x9a, 0x95, 0xfc, 0xc9, 0x71, 0x39, 0xb5, 0xbf, 0xff,
+	0xe6, 0x57, 0x42, 0xb4, 0x84, 0x0b, 0xbd, 0x25, 0x8f, 0xd0, 0xea, 0xaa, 0xbe, 0x79, 0x71, 0x05,
+	0xbe, 0x86, 0x1c, 0xae, 0xe8, 0xa2, 0xff, 0x4c, 0x60, 0xfd, 0xac, 0x13, 0x69, 0x43, 0xdc, 0x8b,
+	0x36, 0x3b, 0x94, 0xc8, 0xd2, 0x95, 0x5b, 0xff, 0x1c, 0x97, 0x5f, 0xe9, 0xf4, 0x78, 0x77, 0x7c,
+	0x60, 0xb4, 0x9c, 0x81, 0x39, 0x19, 0xd9, 0x5e, 0xcb, 0xb1, 0xfb, 0xae, 0x89, 0x19, 0xcc, 0xb3,
+	0x0c, 0xa6, 0xeb, 0x0d, 0x4c, 0x7b, 0xd4, 0x33, 0xfd, 0xf3, 0x5b, 0x18, 0x88, 0xde, 0x05, 0x18,
+	0xb9, 0x6c, 0xf2, 0x00, 0x0f, 0x9c, 0x8c, 0x4e, 0xe0, 0xba, 0x70, 0x97, 0x8f, 0xfa, 0x07, 0x90,
+	0x0f, 0x68, 0x5c, 0xdc, 0x34, 0xea, 0x1f, 0x42, 0x21, 0x78, 0xa5, 0xe2, 0x27, 0x43, 0xff, 0x95,
+	0x40, 0x3e, 0xa0, 0xaf, 0x4b, 0x4e, 0xf0, 0xbe, 0xa2, 0x0c, 0x93, 0x27, 0x9f, 0x38, 0xb9, 0x64,
+	0x50, 0x3e, 0xfa, 0xe4, 0xa4, 0xa2, 0x93, 0xf3, 0x0b, 0x81, 0x42, 0x50, 0x29, 0xce, 0xa1, 0x55,
+	0x1a, 0x4f, 0xd8, 0x2a, 0x95, 0xf4, 0xa3, 0xe3, 0x72, 0x22, 0xd8, 0x30, 0x5d, 0xc8, 0x07, 0x06,
+	0xd1, 0x1c, 0xb1, 0x24, 0x46, 0x62, 0xf5, 0x7b, 0x50, 0x08, 0x0a, 0x20, 0xad, 0xfd, 0x7f, 0xc9,
+	0x50, 0xc7, 0x50, 0xc2, 0x51, 0x00, 0x98, 0x8e, 0x41, 0xbd, 0x01, 0xf9, 0x80, 0x42, 0xc6, 0x21,
+	0x4c, 0x97, 0x20, 0x1f, 0x18, 0x91, 0x7a, 0x03, 0x0a, 0x41, 0xf5, 0xa4, 0xaf, 0x43, 0x46, 0xaa,
+	0xa7, 0x46, 0x42, 0x49, 0x8b, 0xf4, 0xf5, 0x0f, 0x20, 0x1d, 0xf5, 0x09, 0x14, 0x82, 0x79, 0x97,
+	0xf4, 0xfc, 0x59, 0x37, 0x25, 0xe3, 0xba, 0x6b, 0x5f, 0x64, 0x20, 0x23, 0xe1, 0xd0, 0x3a, 0xe4,
+	0x5a, 0x2e, 0xb3, 0x39, 0x6b, 0xaf, 0x78, 0xbf, 0x99, 0x39, 0x85, 0x71, 0x07, 0x7d, 0xea, 0x09,
+	0xcb, 0x77, 0x17, 0x91, 0x70, 0x3a, 0xb6, 0xb5, 0x64, 0x84, 0x48, 0x78, 0x6f, 0x64, 0x24, 0xe5,
+	0x2e, 0x22, 0xe1, 0x80, 0x6c, 0x6b, 0xa9, 0x08, 0x91, 0xb0, 0xc7, 0x64, 0x24, 0xe5, 0x3e, 0x15,
+	0xff, 0x74, 0x64, 0xf1, 0x2f, 0x5a, 0x90, 0x53, 0xa7, 0x8c, 0xad, 0x61, 0x8b, 0x3f, 0x12, 0xc8,
+	0xa9, 0x03, 0xc7, 0x16, 0x34, 0x7e, 0x69, 0x28, 0xf6, 0x21, 0xa7, 0xc8, 0x8c, 0x0f, 0xa5, 0x06,
+	0x39, 0x7c, 0x87, 0xc0, 0xf6, 0x58, 0xb3, 0xfc, 0x8f, 0x62, 0xdf, 0xc4, 0xdb, 0xb0, 0x0f, 0x19,
+	0x59, 0x01, 0x5a, 0x06, 0xf8, 0xd8, 0x73, 0x86, 0x0f, 0xb0, 0x76, 0xf2, 0x2e, 0xd4, 0x13, 0xd6,
+	0xba, 0xf8, 0x0e, 0x0d, 0x5e, 0x80, 0xfc, 0x80, 0xb9, 0x1d, 0xa6, 0x2c, 0x92, 0xca, 0x02, 0xe4,
+	0x97, 0xd2, 0x44, 0x44, 0x95, 0x3f, 0xde, 0xfc, 0x2d, 0x03, 0x19, 0xbc, 0x5d, 0xf7, 0x21, 0xd5,
+	0x64, 0x9c, 0x5e, 0x5b, 0xbd, 0x7b, 0xaa, 0x97, 0xcf, 0xe2, 0xf5, 0x30, 0xa6, 0xea, 0xed, 0x91,
+	0x41, 0x16, 0x27, 0x24, 0xdd, 0x08, 0xb5, 0x9b, 0xfa, 0x39, 0x36, 0x43, 0x5a, 0x4f, 0xd3, 0x60,
+	0x07, 0xad, 0x4c, 0x33, 0xf3, 0x2f, 0x5b, 0x71, 0x33, 0xa4, 0xb5, 0x4a, 0x73, 0x1f, 0x52, 0xb5,
+	0x10, 0x5c, 0xd5, 0xc2, 0x73, 0x55, 0x9b, 0xe5, 0x0a, 0x1b, 0x6c, 0xe5, 0x21, 0x66, 0xfe, 0xdb,
+	0x2a, 0x6e, 0x86, 0xb4, 0x56, 0x69, 0xda, 0x90, 0x91, 0x13, 0x81, 0xde, 0x08, 0xb3, 0x59, 0xfb,
+	0x49, 0x36, 0xc2, 0x19, 0x63, 0x8e, 0x2d, 0x42, 0x3b, 0x90, 0xc5, 0xb1, 0x40, 0x43, 0xe9, 0x94,
+	0x17, 0xf6, 0x30, 0xb3, 0xff, 0x4e, 0x6c, 0x91, 0x8a, 0xf6, 0xe8, 0xa4, 0x44, 0x8e, 0x4e, 0x4a,
+	0xe4, 0xcf, 0x93, 0x12, 0x79, 0x78, 0x5a, 0x4a, 0x1c, 0x9d, 0x96, 0x12, 0xbf, 0x9f, 0x96, 0x12,
+	0x07, 0x59, 0x19, 0xe2, 0xe5, 0x7f, 0x07, 0x00, 0xdf, 0x34, 0xeb, 0x9a, 0xca, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3520,6 +3635,15 @@ func (m *GetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintValue(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -3578,13 +3702,25 @@ func (m *SetInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TTL != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err46 != nil {
-			return 0, err46
+		n47, err47 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err47 != nil {
+			return 0, err47
 		}
-		i -= n46
-		i = encodeVarintValue(dAtA, i, uint64(n46))
+		i -= n47
+		i = encodeVarintValue(dAtA, i, uint64(n47))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3659,12 +3795,12 @@ func (m *InsertInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TTL != nil {
-		n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err48 != nil {
-			return 0, err48
+		n49, err49 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err49 != nil {
+			return 0, err49
 		}
-		i -= n48
-		i = encodeVarintValue(dAtA, i, uint64(n48))
+		i -= n49
+		i = encodeVarintValue(dAtA, i, uint64(n49))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3727,12 +3863,12 @@ func (m *UpdateInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TTL != nil {
-		n49, err49 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
-		if err49 != nil {
-			return 0, err49
+		n50, err50 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TTL, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL):])
+		if err50 != nil {
+			return 0, err50
 		}
-		i -= n49
-		i = encodeVarintValue(dAtA, i, uint64(n49))
+		i -= n50
+		i = encodeVarintValue(dAtA, i, uint64(n50))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4019,6 +4155,18 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Patch != nil {
+		{
+			size, err := m.Patch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Event != nil {
 		{
 			size := m.Event.Size()
@@ -4213,6 +4361,70 @@ func (m *Event_Deleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Patch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Patch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Patch != nil {
+		{
+			size := m.Patch.Size()
+			i -= size
+			if _, err := m.Patch.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Patch_JsonPatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_JsonPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonPatch != nil {
+		i -= len(m.JsonPatch)
+		copy(dAtA[i:], m.JsonPatch)
+		i = encodeVarintValue(dAtA, i, uint64(len(m.JsonPatch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Patch_MergePatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Patch_MergePatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MergePatch != nil {
+		i -= len(m.MergePatch)
+		copy(dAtA[i:], m.MergePatch)
+		i = encodeVarintValue(dAtA, i, uint64(len(m.MergePatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintValue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovValue(v)
 	base := offset
@@ -4677,6 +4889,12 @@ func (m *GetInput) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovValue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4707,6 +4925,10 @@ func (m *SetInput) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TTL)
 		n += 1 + l + sovValue(uint64(l))
 	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovValue(uint64(l))
+	}
 	return n
 }
 
@@ -4879,6 +5101,10 @@ func (m *Event) Size() (n int) {
 	if m.Event != nil {
 		n += m.Event.Size()
 	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovValue(uint64(l))
+	}
 	return n
 }
 
@@ -4956,6 +5182,43 @@ func (m *Event_Deleted) Size() (n int) {
 	return n
 }
 
+func (m *Patch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Patch != nil {
+		n += m.Patch.Size()
+	}
+	return n
+}
+
+func (m *Patch_JsonPatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonPatch != nil {
+		l = len(m.JsonPatch)
+		n += 1 + l + sovValue(uint64(l))
+	}
+	return n
+}
+func (m *Patch_MergePatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergePatch != nil {
+		l = len(m.MergePatch)
+		n += 1 + l + sovValue(uint64(l))
+	}
+	return n
+}
+
 func sovValue(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7411,6 +7674,38 @@ func (m *GetInput) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GetInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValue(dAtA[iNdEx:])
@@ -7617,6 +7912,42 @@ func (m *SetInput) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValue(dAtA[iNdEx:])
@@ -8831,6 +9162,42 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &Event_Deleted_{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Patch{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValue(dAtA[iNdEx:])
@@ -9154,6 +9521,122 @@ func (m *Event_Deleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Patch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Patch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Patch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_JsonPatch{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Patch = &Patch_MergePatch{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipValue(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package page
+
+import (
+	"encoding/base64"
+
+	"github.com/atomix/atomix/api/errors"
+)
+
+// Validate validates the page limit of an entries listing
+// Watches never complete, so a watch cannot be limited to a page.
+func Validate(limit uint32, watch bool) error {
+	if watch && limit > 0 {
+		return errors.NewInvalid("limit cannot be used with watch")
+	}
+	return nil
+}
+
+// EncodeContinuation encodes the continuation token used to resume listing after the given key
+func EncodeContinuation(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// DecodeContinuation decodes the key after which to resume listing from the given continuation token
+func DecodeContinuation(continuation string) (string, error) {
+	if continuation == "" {
+		return "", nil
+	}
+	key, err := base64.RawURLEncoding.DecodeString(continuation)
+	if err != nil {
+		return "", errors.NewInvalid("invalid continuation token")
+	}
+	return string(key), nil
+}
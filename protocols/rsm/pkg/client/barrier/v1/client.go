@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	barrierv1 "github.com/atomix/atomix/api/runtime/barrier/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	barrierprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/barrier/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimebarrierv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/barrier/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewBarrier(protocol *client.Protocol, id runtimev1.PrimitiveID) *BarrierSession {
+	return &BarrierSession{
+		Protocol: protocol,
+		id:       id,
+	}
+}
+
+type BarrierSession struct {
+	*client.Protocol
+	id runtimev1.PrimitiveID
+}
+
+func (s *BarrierSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        barrierv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *BarrierSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *BarrierSession) Await(ctx context.Context, request *barrierv1.AwaitRequest) (*barrierv1.AwaitResponse, error) {
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*barrierprotocolv1.AwaitResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*barrierprotocolv1.AwaitResponse, error) {
+		return barrierprotocolv1.NewBarrierClient(conn).Await(ctx, &barrierprotocolv1.AwaitRequest{
+			Headers: headers,
+			AwaitInput: &barrierprotocolv1.AwaitInput{
+				Parties: request.Parties,
+				Timeout: request.Timeout,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierv1.AwaitResponse{
+		Generation: output.Generation,
+		Index:      output.Index,
+	}
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request),
+		logging.Trunc128("AwaitResponse", response))
+	return response, nil
+}
+
+func (s *BarrierSession) Reset(ctx context.Context, request *barrierv1.ResetRequest) (*barrierv1.ResetResponse, error) {
+	log.Debugw("Reset",
+		logging.Trunc128("ResetRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Reset",
+			logging.Trunc128("ResetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Reset",
+			logging.Trunc128("ResetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*barrierprotocolv1.ResetResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*barrierprotocolv1.ResetResponse, error) {
+		return barrierprotocolv1.NewBarrierClient(conn).Reset(ctx, &barrierprotocolv1.ResetRequest{
+			Headers:    headers,
+			ResetInput: &barrierprotocolv1.ResetInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("Reset",
+			logging.Trunc128("ResetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Reset",
+			logging.Trunc128("ResetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierv1.ResetResponse{}
+	log.Debugw("Reset",
+		logging.Trunc128("ResetRequest", request),
+		logging.Trunc128("ResetResponse", response))
+	return response, nil
+}
+
+func (s *BarrierSession) GetBarrier(ctx context.Context, request *barrierv1.GetBarrierRequest) (*barrierv1.GetBarrierResponse, error) {
+	log.Debugw("GetBarrier",
+		logging.Trunc128("GetBarrierRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetBarrier",
+			logging.Trunc128("GetBarrierRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetBarrier",
+			logging.Trunc128("GetBarrierRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*barrierprotocolv1.GetResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*barrierprotocolv1.GetResponse, error) {
+		return barrierprotocolv1.NewBarrierClient(conn).Get(ctx, &barrierprotocolv1.GetRequest{
+			Headers:  headers,
+			GetInput: &barrierprotocolv1.GetInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("GetBarrier",
+			logging.Trunc128("GetBarrierRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetBarrier",
+			logging.Trunc128("GetBarrierRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierv1.GetBarrierResponse{
+		Generation: output.Generation,
+		Parties:    output.Parties,
+		Waiting:    output.Waiting,
+		Broken:     output.Broken,
+	}
+	log.Debugw("GetBarrier",
+		logging.Trunc128("GetBarrierRequest", request),
+		logging.Trunc128("GetBarrierResponse", response))
+	return response, nil
+}
+
+var _ runtimebarrierv1.BarrierProxy = (*BarrierSession)(nil)
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/api/errors"
+	bloomfilterv1 "github.com/atomix/atomix/api/runtime/bloomfilter/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/atomix/atomix/runtime/pkg/utils/async"
+	"github.com/bits-and-blooms/bloom/v3"
+	bloomfilterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/bloomfilter/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimebloomfilterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/bloomfilter/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+const (
+	defaultCapacity  = 10000
+	defaultErrorRate = 0.01
+)
+
+func NewBloomFilter(protocol *client.Protocol, id runtimev1.PrimitiveID, config *bloomfilterv1.Config) *BloomFilterSession {
+	return &BloomFilterSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type BloomFilterSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *bloomfilterv1.Config
+}
+
+func (s *BloomFilterSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	capacity, errorRate := uint64(defaultCapacity), defaultErrorRate
+	if s.config != nil {
+		if s.config.Capacity != 0 {
+			capacity = s.config.Capacity
+		}
+		if s.config.ErrorRate != 0 {
+			errorRate = s.config.ErrorRate
+		}
+	}
+	if errorRate <= 0 || errorRate >= 1 {
+		err := errors.NewInvalid("error rate must be between 0 and 1")
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+
+	// Elements are partitioned, so each partition's filter is sized for its share of the capacity
+	partitions := s.Partitions()
+	n := len(partitions)
+	bits, hashes := bloom.EstimateParameters(uint((capacity+uint64(n)-1)/uint64(n)), errorRate)
+	err := async.IterAsync(len(partitions), func(i int) error {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			return err
+		}
+		meta := runtimev1.PrimitiveMeta{
+			Type:        bloomfilterv1.PrimitiveType,
+			PrimitiveID: s.id,
+		}
+		if err := session.CreatePrimitive(ctx, meta); err != nil {
+			return err
+		}
+		primitive, err := session.GetPrimitive(s.id.Name)
+		if err != nil {
+			return err
+		}
+		command := client.Proposal[*bloomfilterprotocolv1.ConfigureResponse](primitive)
+		_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*bloomfilterprotocolv1.ConfigureResponse, error) {
+			return bloomfilterprotocolv1.NewBloomFilterClient(conn).Configure(ctx, &bloomfilterprotocolv1.ConfigureRequest{
+				Headers: headers,
+				ConfigureInput: &bloomfilterprotocolv1.ConfigureInput{
+					Bits:   uint64(bits),
+					Hashes: uint32(hashes),
+				},
+			})
+		})
+		return err
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *BloomFilterSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partitions := s.Partitions()
+	err := async.IterAsync(len(partitions), func(i int) error {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			return err
+		}
+		return session.ClosePrimitive(ctx, s.id.Name)
+	})
+	if err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *BloomFilterSession) Add(ctx context.Context, request *bloomfilterv1.AddRequest) (*bloomfilterv1.AddResponse, error) {
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request))
+	partition := s.PartitionBy([]byte(request.Element))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Add",
+			logging.Trunc128("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Add",
+			logging.Trunc128("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*bloomfilterprotocolv1.AddResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*bloomfilterprotocolv1.AddResponse, error) {
+		return bloomfilterprotocolv1.NewBloomFilterClient(conn).Add(ctx, &bloomfilterprotocolv1.AddRequest{
+			Headers: headers,
+			AddInput: &bloomfilterprotocolv1.AddInput{
+				Element: request.Element,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Add",
+			logging.Trunc128("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Add",
+			logging.Trunc128("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &bloomfilterv1.AddResponse{
+		Added: output.Added,
+	}
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request),
+		logging.Trunc128("AddResponse", response))
+	return response, nil
+}
+
+func (s *BloomFilterSession) MightContain(ctx context.Context, request *bloomfilterv1.MightContainRequest) (*bloomfilterv1.MightContainResponse, error) {
+	log.Debugw("MightContain",
+		logging.Trunc128("MightContainRequest", request))
+	partition := s.PartitionBy([]byte(request.Element))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("MightContain",
+			logging.Trunc128("MightContainRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("MightContain",
+			logging.Trunc128("MightContainRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*bloomfilterprotocolv1.MightContainResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*bloomfilterprotocolv1.MightContainResponse, error) {
+		return bloomfilterprotocolv1.NewBloomFilterClient(conn).MightContain(ctx, &bloomfilterprotocolv1.MightContainRequest{
+			Headers: headers,
+			MightContainInput: &bloomfilterprotocolv1.MightContainInput{
+				Element: request.Element,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("MightContain",
+			logging.Trunc128("MightContainRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("MightContain",
+			logging.Trunc128("MightContainRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &bloomfilterv1.MightContainResponse{
+		Result: output.Result,
+	}
+	log.Debugw("MightContain",
+		logging.Trunc128("MightContainRequest", request),
+		logging.Trunc128("MightContainResponse", response))
+	return response, nil
+}
+
+var _ runtimebloomfilterv1.BloomFilterProxy = (*BloomFilterSession)(nil)
@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/api/errors"
+	cardinalityv1 "github.com/atomix/atomix/api/runtime/cardinality/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/atomix/atomix/runtime/pkg/utils/async"
+	cardinalityprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/cardinality/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimecardinalityv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/cardinality/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewCardinality(protocol *client.Protocol, id runtimev1.PrimitiveID, config *cardinalityv1.Config) *CardinalitySession {
+	return &CardinalitySession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type CardinalitySession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *cardinalityv1.Config
+}
+
+func (s *CardinalitySession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partitions := s.Partitions()
+	err := async.IterAsync(len(partitions), func(i int) error {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			return err
+		}
+		meta := runtimev1.PrimitiveMeta{
+			Type:        cardinalityv1.PrimitiveType,
+			PrimitiveID: s.id,
+		}
+		if err := session.CreatePrimitive(ctx, meta); err != nil {
+			return err
+		}
+		if s.config == nil {
+			return nil
+		}
+
+		// Every partition must use the same precision for the partition sketches to be merged
+		primitive, err := session.GetPrimitive(s.id.Name)
+		if err != nil {
+			return err
+		}
+		command := client.Proposal[*cardinalityprotocolv1.ConfigureResponse](primitive)
+		_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*cardinalityprotocolv1.ConfigureResponse, error) {
+			return cardinalityprotocolv1.NewCardinalityClient(conn).Configure(ctx, &cardinalityprotocolv1.ConfigureRequest{
+				Headers: headers,
+				ConfigureInput: &cardinalityprotocolv1.ConfigureInput{
+					Precision: s.config.Precision,
+				},
+			})
+		})
+		return err
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *CardinalitySession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partitions := s.Partitions()
+	err := async.IterAsync(len(partitions), func(i int) error {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			return err
+		}
+		return session.ClosePrimitive(ctx, s.id.Name)
+	})
+	if err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *CardinalitySession) Add(ctx context.Context, request *cardinalityv1.AddRequest) (*cardinalityv1.AddResponse, error) {
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request))
+
+	partitions := s.Partitions()
+	indexElements := make(map[int][]string)
+	for _, element := range request.Elements {
+		index := s.PartitionIndex([]byte(element))
+		indexElements[index] = append(indexElements[index], element)
+	}
+
+	partitionIndexes := make([]int, 0, len(indexElements))
+	for index := range indexElements {
+		partitionIndexes = append(partitionIndexes, index)
+	}
+
+	results, err := async.ExecuteAsync[bool](len(partitionIndexes), func(i int) (bool, error) {
+		index := partitionIndexes[i]
+		partition := partitions[index]
+		elements := indexElements[index]
+
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("Add",
+				logging.Trunc128("AddRequest", request),
+				logging.Error("Error", err))
+			return false, err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("Add",
+				logging.Trunc128("AddRequest", request),
+				logging.Error("Error", err))
+			return false, err
+		}
+		command := client.Proposal[*cardinalityprotocolv1.AddResponse](primitive)
+		output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*cardinalityprotocolv1.AddResponse, error) {
+			return cardinalityprotocolv1.NewCardinalityClient(conn).Add(ctx, &cardinalityprotocolv1.AddRequest{
+				Headers: headers,
+				AddInput: &cardinalityprotocolv1.AddInput{
+					Elements: elements,
+				},
+			})
+		})
+		if !ok {
+			log.Warnw("Add",
+				logging.Trunc128("AddRequest", request),
+				logging.Error("Error", err))
+			return false, err
+		} else if err != nil {
+			log.Debugw("Add",
+				logging.Trunc128("AddRequest", request),
+				logging.Error("Error", err))
+			return false, err
+		}
+		return output.Updated, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &cardinalityv1.AddResponse{}
+	for _, updated := range results {
+		if updated {
+			response.Updated = true
+		}
+	}
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request),
+		logging.Trunc128("AddResponse", response))
+	return response, nil
+}
+
+func (s *CardinalitySession) Count(ctx context.Context, request *cardinalityv1.CountRequest) (*cardinalityv1.CountResponse, error) {
+	log.Debugw("Count",
+		logging.Trunc128("CountRequest", request))
+	sketch, err := s.getSketch(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Count",
+			logging.Trunc128("CountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityv1.CountResponse{
+		Count: estimate(sketch.Precision, sketch.Registers),
+	}
+	log.Debugw("Count",
+		logging.Trunc128("CountRequest", request),
+		logging.Trunc128("CountResponse", response))
+	return response, nil
+}
+
+func (s *CardinalitySession) Merge(ctx context.Context, request *cardinalityv1.MergeRequest) (*cardinalityv1.MergeResponse, error) {
+	log.Debugw("Merge",
+		logging.Trunc128("MergeRequest", request))
+	// Sketches are merged by taking the maximum of each register, so the merged sketch can be stored in any
+	// partition and the union is recovered when the partition sketches are combined.
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Merge",
+			logging.Trunc128("MergeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Merge",
+			logging.Trunc128("MergeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*cardinalityprotocolv1.MergeResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*cardinalityprotocolv1.MergeResponse, error) {
+		return cardinalityprotocolv1.NewCardinalityClient(conn).Merge(ctx, &cardinalityprotocolv1.MergeRequest{
+			Headers: headers,
+			MergeInput: &cardinalityprotocolv1.MergeInput{
+				Precision: request.Sketch.Precision,
+				Registers: request.Sketch.Registers,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Merge",
+			logging.Trunc128("MergeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Merge",
+			logging.Trunc128("MergeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityv1.MergeResponse{}
+	log.Debugw("Merge",
+		logging.Trunc128("MergeRequest", request),
+		logging.Trunc128("MergeResponse", response))
+	return response, nil
+}
+
+func (s *CardinalitySession) GetSketch(ctx context.Context, request *cardinalityv1.GetSketchRequest) (*cardinalityv1.GetSketchResponse, error) {
+	log.Debugw("GetSketch",
+		logging.Trunc128("GetSketchRequest", request))
+	sketch, err := s.getSketch(ctx, request.ID)
+	if err != nil {
+		log.Warnw("GetSketch",
+			logging.Trunc128("GetSketchRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityv1.GetSketchResponse{
+		Sketch: *sketch,
+	}
+	log.Debugw("GetSketch",
+		logging.Trunc128("GetSketchRequest", request),
+		logging.Trunc128("GetSketchResponse", response))
+	return response, nil
+}
+
+// getSketch reads the sketch from every partition and merges them into a sketch of the whole primitive
+func (s *CardinalitySession) getSketch(ctx context.Context, id runtimev1.PrimitiveID) (*cardinalityv1.Sketch, error) {
+	partitions := s.Partitions()
+	outputs, err := async.ExecuteAsync[*cardinalityprotocolv1.GetSketchOutput](len(partitions), func(i int) (*cardinalityprotocolv1.GetSketchOutput, error) {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			return nil, err
+		}
+		primitive, err := session.GetPrimitive(id.Name)
+		if err != nil {
+			return nil, err
+		}
+		query := client.Query[*cardinalityprotocolv1.GetSketchResponse](primitive)
+		output, _, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*cardinalityprotocolv1.GetSketchResponse, error) {
+			return cardinalityprotocolv1.NewCardinalityClient(conn).GetSketch(ctx, &cardinalityprotocolv1.GetSketchRequest{
+				Headers:        headers,
+				GetSketchInput: &cardinalityprotocolv1.GetSketchInput{},
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.GetSketchOutput, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeSketches(outputs)
+}
+
+// mergeSketches merges the partition sketches by taking the maximum of each register. The partition sketches
+// must share a precision, and a non-empty sketch must have a register for each index of that precision.
+func mergeSketches(outputs []*cardinalityprotocolv1.GetSketchOutput) (*cardinalityv1.Sketch, error) {
+	sketch := &cardinalityv1.Sketch{}
+	for i, output := range outputs {
+		if i == 0 {
+			sketch.Precision = output.Precision
+		} else if output.Precision != sketch.Precision {
+			return nil, errors.NewConflict("partition sketches have mismatched precisions %d and %d", sketch.Precision, output.Precision)
+		}
+		if len(output.Registers) == 0 {
+			continue
+		}
+		if len(output.Registers) != 1<<output.Precision {
+			return nil, errors.NewFault("sketch with precision %d has %d registers, expected %d", output.Precision, len(output.Registers), 1<<output.Precision)
+		}
+		if sketch.Registers == nil {
+			sketch.Registers = make([]byte, len(output.Registers))
+		}
+		for j, register := range output.Registers {
+			if register > sketch.Registers[j] {
+				sketch.Registers[j] = register
+			}
+		}
+	}
+	return sketch, nil
+}
+
+var _ runtimecardinalityv1.CardinalityProxy = (*CardinalitySession)(nil)
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"math"
+)
+
+// estimate returns the HyperLogLog estimate of the number of distinct elements in the given registers
+func estimate(precision uint32, registers []byte) uint64 {
+	if len(registers) == 0 {
+		return 0
+	}
+	m := float64(uint64(1) << precision)
+	var sum float64
+	var zeros int
+	for _, register := range registers {
+		sum += 1 / float64(uint64(1)<<register)
+		if register == 0 {
+			zeros++
+		}
+	}
+	e := alpha(m) * m * m / sum
+
+	// Small cardinalities are estimated more accurately by linear counting of the empty registers
+	if e <= 2.5*m && zeros > 0 {
+		e = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(e))
+}
+
+// alpha returns the bias correction constant for m registers
+func alpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/m)
+	}
+}
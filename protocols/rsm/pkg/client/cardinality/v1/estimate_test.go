@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func TestEstimate(t *testing.T) {
+	const precision = 14
+	assert.Equal(t, uint64(0), estimate(precision, nil))
+
+	random := rand.New(rand.NewSource(0))
+	registers := make([]byte, 1<<precision)
+	add := func(n int) {
+		for i := 0; i < n; i++ {
+			h := random.Uint64()
+			index := h >> (64 - precision)
+			rank := byte(bits.LeadingZeros64(h<<precision|1<<(precision-1)) + 1)
+			if rank > registers[index] {
+				registers[index] = rank
+			}
+		}
+	}
+
+	add(1000)
+	assert.InEpsilon(t, 1000, estimate(precision, registers), 0.05)
+	add(99000)
+	assert.InEpsilon(t, 100000, estimate(precision, registers), 0.05)
+	add(900000)
+	assert.InEpsilon(t, 1000000, estimate(precision, registers), 0.05)
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/atomix/atomix/api/errors"
+	"github.com/stretchr/testify/assert"
+	cardinalityprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/cardinality/v1"
+)
+
+func TestMergeSketches(t *testing.T) {
+	const precision = 4
+	sketch, err := mergeSketches([]*cardinalityprotocolv1.GetSketchOutput{
+		{Precision: precision},
+		{Precision: precision},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(precision), sketch.Precision)
+	assert.Nil(t, sketch.Registers)
+
+	registers1 := make([]byte, 1<<precision)
+	registers1[0] = 3
+	registers1[1] = 1
+	registers2 := make([]byte, 1<<precision)
+	registers2[1] = 2
+	registers2[15] = 5
+	sketch, err = mergeSketches([]*cardinalityprotocolv1.GetSketchOutput{
+		{Precision: precision, Registers: registers1},
+		{Precision: precision},
+		{Precision: precision, Registers: registers2},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, sketch.Registers, 1<<precision)
+	assert.Equal(t, byte(3), sketch.Registers[0])
+	assert.Equal(t, byte(2), sketch.Registers[1])
+	assert.Equal(t, byte(5), sketch.Registers[15])
+
+	_, err = mergeSketches([]*cardinalityprotocolv1.GetSketchOutput{
+		{Precision: precision, Registers: registers1},
+		{Precision: precision + 1, Registers: make([]byte, 1<<(precision+1))},
+	})
+	assert.True(t, errors.IsConflict(err))
+
+	_, err = mergeSketches([]*cardinalityprotocolv1.GetSketchOutput{
+		{Precision: precision + 1},
+		{Precision: precision, Registers: registers1},
+	})
+	assert.True(t, errors.IsConflict(err))
+
+	_, err = mergeSketches([]*cardinalityprotocolv1.GetSketchOutput{
+		{Precision: precision, Registers: registers1},
+		{Precision: precision, Registers: registers2[:8]},
+	})
+	assert.True(t, errors.IsFault(err))
+}
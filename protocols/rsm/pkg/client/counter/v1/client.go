@@ -10,25 +10,27 @@ import (
 	counterv1 "github.com/atomix/atomix/api/runtime/counter/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
 	"github.com/atomix/atomix/runtime/pkg/logging"
-	runtimecounterv1 "github.com/atomix/atomix/runtime/pkg/runtime/counter/v1"
 	counterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/counter/v1"
 	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimecounterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/counter/v1"
 	"google.golang.org/grpc"
 )
 
 var log = logging.GetLogger()
 
-func NewCounter(protocol *client.Protocol, id runtimev1.PrimitiveID) *CounterSession {
+func NewCounter(protocol *client.Protocol, id runtimev1.PrimitiveID, config *counterv1.Config) *CounterSession {
 	return &CounterSession{
 		Protocol: protocol,
 		id:       id,
+		config:   config,
 	}
 }
 
 type CounterSession struct {
 	*client.Protocol
-	id runtimev1.PrimitiveID
+	id     runtimev1.PrimitiveID
+	config *counterv1.Config
 }
 
 func (s *CounterSession) Open(ctx context.Context) error {
@@ -52,6 +54,34 @@ func (s *CounterSession) Open(ctx context.Context) error {
 			logging.Error("Error", err))
 		return err
 	}
+	if s.config == nil || s.config.Bounds == nil {
+		return nil
+	}
+
+	// Apply the bounds from the primitive configuration unless the counter's bounds have already been set
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*counterprotocolv1.SetBoundsResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*counterprotocolv1.SetBoundsResponse, error) {
+		return counterprotocolv1.NewCounterClient(conn).SetBounds(ctx, &counterprotocolv1.SetBoundsRequest{
+			Headers: headers,
+			SetBoundsInput: &counterprotocolv1.SetBoundsInput{
+				Bounds: newProtocolBounds(s.config.Bounds),
+				Init:   true,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
 	return nil
 }
 
@@ -159,7 +189,8 @@ func (s *CounterSession) Get(ctx context.Context, request *counterv1.GetRequest)
 		return nil, err
 	}
 	response := &counterv1.GetResponse{
-		Value: output.Value,
+		Value:  output.Value,
+		Bounds: newBounds(output.Bounds),
 	}
 	log.Debugw("Get",
 		logging.Trunc128("GetRequest", request),
@@ -190,7 +221,8 @@ func (s *CounterSession) Increment(ctx context.Context, request *counterv1.Incre
 		return counterprotocolv1.NewCounterClient(conn).Increment(ctx, &counterprotocolv1.IncrementRequest{
 			Headers: headers,
 			IncrementInput: &counterprotocolv1.IncrementInput{
-				Delta: request.Delta,
+				Delta:    request.Delta,
+				Saturate: request.Saturate,
 			},
 		})
 	})
@@ -237,7 +269,8 @@ func (s *CounterSession) Decrement(ctx context.Context, request *counterv1.Decre
 		return counterprotocolv1.NewCounterClient(conn).Decrement(ctx, &counterprotocolv1.DecrementRequest{
 			Headers: headers,
 			DecrementInput: &counterprotocolv1.DecrementInput{
-				Delta: request.Delta,
+				Delta:    request.Delta,
+				Saturate: request.Saturate,
 			},
 		})
 	})
@@ -309,4 +342,87 @@ func (s *CounterSession) Update(ctx context.Context, request *counterv1.UpdateRe
 	return response, nil
 }
 
+func (s *CounterSession) SetBounds(ctx context.Context, request *counterv1.SetBoundsRequest) (*counterv1.SetBoundsResponse, error) {
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	proposal := client.Proposal[*counterprotocolv1.SetBoundsResponse](primitive)
+	output, ok, err := proposal.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*counterprotocolv1.SetBoundsResponse, error) {
+		return counterprotocolv1.NewCounterClient(conn).SetBounds(ctx, &counterprotocolv1.SetBoundsRequest{
+			Headers: headers,
+			SetBoundsInput: &counterprotocolv1.SetBoundsInput{
+				Bounds: newProtocolBounds(request.Bounds),
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &counterv1.SetBoundsResponse{
+		Value: output.Value,
+	}
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request),
+		logging.Trunc128("SetBoundsResponse", response))
+	return response, nil
+}
+
+func newProtocolBounds(bounds *counterv1.Bounds) *counterprotocolv1.Bounds {
+	if bounds == nil {
+		return nil
+	}
+	protocolBounds := &counterprotocolv1.Bounds{}
+	if bounds.Min != nil {
+		protocolBounds.Min = &counterprotocolv1.Bound{
+			Value: bounds.Min.Value,
+		}
+	}
+	if bounds.Max != nil {
+		protocolBounds.Max = &counterprotocolv1.Bound{
+			Value: bounds.Max.Value,
+		}
+	}
+	return protocolBounds
+}
+
+func newBounds(protocolBounds *counterprotocolv1.Bounds) *counterv1.Bounds {
+	if protocolBounds == nil {
+		return nil
+	}
+	bounds := &counterv1.Bounds{}
+	if protocolBounds.Min != nil {
+		bounds.Min = &counterv1.Bound{
+			Value: protocolBounds.Min.Value,
+		}
+	}
+	if protocolBounds.Max != nil {
+		bounds.Max = &counterv1.Bound{
+			Value: protocolBounds.Max.Value,
+		}
+	}
+	return bounds
+}
+
 var _ runtimecounterv1.CounterProxy = (*CounterSession)(nil)
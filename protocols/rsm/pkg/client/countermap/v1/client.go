@@ -7,32 +7,35 @@ package v1
 import (
 	"context"
 	"io"
+	"sort"
 	"sync"
 
 	countermapv1 "github.com/atomix/atomix/api/runtime/countermap/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
 	"github.com/atomix/atomix/runtime/pkg/logging"
-	runtimecountermapv1 "github.com/atomix/atomix/runtime/pkg/runtime/countermap/v1"
 	streams "github.com/atomix/atomix/runtime/pkg/stream"
 	"github.com/atomix/atomix/runtime/pkg/utils/async"
 	countermapprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/countermap/v1"
 	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimecountermapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/countermap/v1"
 	"google.golang.org/grpc"
 )
 
 var log = logging.GetLogger()
 
-func NewCounterMap(protocol *client.Protocol, id runtimev1.PrimitiveID) *CounterMapSession {
+func NewCounterMap(protocol *client.Protocol, id runtimev1.PrimitiveID, config *countermapv1.Config) *CounterMapSession {
 	return &CounterMapSession{
 		Protocol: protocol,
 		id:       id,
+		config:   config,
 	}
 }
 
 type CounterMapSession struct {
 	*client.Protocol
-	id runtimev1.PrimitiveID
+	id     runtimev1.PrimitiveID
+	config *countermapv1.Config
 }
 
 func (s *CounterMapSession) Open(ctx context.Context) error {
@@ -45,10 +48,29 @@ func (s *CounterMapSession) Open(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		return session.CreatePrimitive(ctx, runtimev1.PrimitiveMeta{
+		err = session.CreatePrimitive(ctx, runtimev1.PrimitiveMeta{
 			Type:        countermapv1.PrimitiveType,
 			PrimitiveID: s.id,
 		})
+		if err != nil || s.config == nil || s.config.Bounds == nil {
+			return err
+		}
+
+		// Apply the default bounds from the primitive configuration
+		primitive, err := session.GetPrimitive(s.id.Name)
+		if err != nil {
+			return err
+		}
+		command := client.Proposal[*countermapprotocolv1.ConfigureResponse](primitive)
+		_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*countermapprotocolv1.ConfigureResponse, error) {
+			return countermapprotocolv1.NewCounterMapClient(conn).Configure(ctx, &countermapprotocolv1.ConfigureRequest{
+				Headers: headers,
+				ConfigureInput: &countermapprotocolv1.ConfigureInput{
+					Bounds: newProtocolBounds(s.config.Bounds),
+				},
+			})
+		})
+		return err
 	})
 	if err != nil {
 		log.Warnw("Create",
@@ -303,8 +325,9 @@ func (s *CounterMapSession) Increment(ctx context.Context, request *countermapv1
 		input := &countermapprotocolv1.IncrementRequest{
 			Headers: headers,
 			IncrementInput: &countermapprotocolv1.IncrementInput{
-				Key:   request.Key,
-				Delta: request.Delta,
+				Key:      request.Key,
+				Delta:    request.Delta,
+				Saturate: request.Saturate,
 			},
 		}
 		return countermapprotocolv1.NewCounterMapClient(conn).Increment(ctx, input)
@@ -352,8 +375,9 @@ func (s *CounterMapSession) Decrement(ctx context.Context, request *countermapv1
 		input := &countermapprotocolv1.DecrementRequest{
 			Headers: headers,
 			DecrementInput: &countermapprotocolv1.DecrementInput{
-				Key:   request.Key,
-				Delta: request.Delta,
+				Key:      request.Key,
+				Delta:    request.Delta,
+				Saturate: request.Saturate,
 			},
 		}
 		return countermapprotocolv1.NewCounterMapClient(conn).Decrement(ctx, input)
@@ -378,6 +402,52 @@ func (s *CounterMapSession) Decrement(ctx context.Context, request *countermapv1
 	return response, nil
 }
 
+func (s *CounterMapSession) SetBounds(ctx context.Context, request *countermapv1.SetBoundsRequest) (*countermapv1.SetBoundsResponse, error) {
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request))
+	partition := s.PartitionBy([]byte(request.Key))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*countermapprotocolv1.SetBoundsResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*countermapprotocolv1.SetBoundsResponse, error) {
+		return countermapprotocolv1.NewCounterMapClient(conn).SetBounds(ctx, &countermapprotocolv1.SetBoundsRequest{
+			Headers: headers,
+			SetBoundsInput: &countermapprotocolv1.SetBoundsInput{
+				Key:    request.Key,
+				Bounds: newProtocolBounds(request.Bounds),
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &countermapv1.SetBoundsResponse{}
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request),
+		logging.Trunc128("SetBoundsResponse", response))
+	return response, nil
+}
+
 func (s *CounterMapSession) Get(ctx context.Context, request *countermapv1.GetRequest) (*countermapv1.GetResponse, error) {
 	log.Debugw("Get",
 		logging.Trunc128("GetRequest", request))
@@ -417,7 +487,8 @@ func (s *CounterMapSession) Get(ctx context.Context, request *countermapv1.GetRe
 		return nil, err
 	}
 	response := &countermapv1.GetResponse{
-		Value: output.Value,
+		Value:  output.Value,
+		Bounds: newBounds(output.Bounds),
 	}
 	log.Debugw("Get",
 		logging.Trunc128("GetRequest", request),
@@ -425,6 +496,172 @@ func (s *CounterMapSession) Get(ctx context.Context, request *countermapv1.GetRe
 	return response, nil
 }
 
+func (s *CounterMapSession) TopK(ctx context.Context, request *countermapv1.TopKRequest) (*countermapv1.TopKResponse, error) {
+	log.Debugw("TopK",
+		logging.Trunc128("TopKRequest", request))
+	partitions := s.Partitions()
+	results, err := async.ExecuteAsync[[]countermapprotocolv1.Entry](len(partitions), func(i int) ([]countermapprotocolv1.Entry, error) {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("TopK",
+				logging.Trunc128("TopKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("TopK",
+				logging.Trunc128("TopKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		query := client.Query[*countermapprotocolv1.TopKResponse](primitive)
+		output, ok, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*countermapprotocolv1.TopKResponse, error) {
+			return countermapprotocolv1.NewCounterMapClient(conn).TopK(ctx, &countermapprotocolv1.TopKRequest{
+				Headers: headers,
+				TopKInput: &countermapprotocolv1.TopKInput{
+					K: request.K,
+				},
+			})
+		})
+		if !ok {
+			log.Warnw("TopK",
+				logging.Trunc128("TopKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		} else if err != nil {
+			log.Debugw("TopK",
+				logging.Trunc128("TopKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		return output.Entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &countermapv1.TopKResponse{
+		Entries: mergeEntries(results, true, int(request.K)),
+	}
+	log.Debugw("TopK",
+		logging.Trunc128("TopKRequest", request),
+		logging.Trunc128("TopKResponse", response))
+	return response, nil
+}
+
+func (s *CounterMapSession) BottomK(ctx context.Context, request *countermapv1.BottomKRequest) (*countermapv1.BottomKResponse, error) {
+	log.Debugw("BottomK",
+		logging.Trunc128("BottomKRequest", request))
+	partitions := s.Partitions()
+	results, err := async.ExecuteAsync[[]countermapprotocolv1.Entry](len(partitions), func(i int) ([]countermapprotocolv1.Entry, error) {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("BottomK",
+				logging.Trunc128("BottomKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("BottomK",
+				logging.Trunc128("BottomKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		query := client.Query[*countermapprotocolv1.BottomKResponse](primitive)
+		output, ok, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*countermapprotocolv1.BottomKResponse, error) {
+			return countermapprotocolv1.NewCounterMapClient(conn).BottomK(ctx, &countermapprotocolv1.BottomKRequest{
+				Headers: headers,
+				BottomKInput: &countermapprotocolv1.BottomKInput{
+					K: request.K,
+				},
+			})
+		})
+		if !ok {
+			log.Warnw("BottomK",
+				logging.Trunc128("BottomKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		} else if err != nil {
+			log.Debugw("BottomK",
+				logging.Trunc128("BottomKRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		return output.Entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &countermapv1.BottomKResponse{
+		Entries: mergeEntries(results, false, int(request.K)),
+	}
+	log.Debugw("BottomK",
+		logging.Trunc128("BottomKRequest", request),
+		logging.Trunc128("BottomKResponse", response))
+	return response, nil
+}
+
+func (s *CounterMapSession) RangeByValue(ctx context.Context, request *countermapv1.RangeByValueRequest) (*countermapv1.RangeByValueResponse, error) {
+	log.Debugw("RangeByValue",
+		logging.Trunc128("RangeByValueRequest", request))
+	partitions := s.Partitions()
+	results, err := async.ExecuteAsync[[]countermapprotocolv1.Entry](len(partitions), func(i int) ([]countermapprotocolv1.Entry, error) {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("RangeByValue",
+				logging.Trunc128("RangeByValueRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("RangeByValue",
+				logging.Trunc128("RangeByValueRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		query := client.Query[*countermapprotocolv1.RangeByValueResponse](primitive)
+		output, ok, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*countermapprotocolv1.RangeByValueResponse, error) {
+			return countermapprotocolv1.NewCounterMapClient(conn).RangeByValue(ctx, &countermapprotocolv1.RangeByValueRequest{
+				Headers: headers,
+				RangeByValueInput: &countermapprotocolv1.RangeByValueInput{
+					Bounds: newProtocolBounds(request.Bounds),
+					Limit:  request.Limit,
+				},
+			})
+		})
+		if !ok {
+			log.Warnw("RangeByValue",
+				logging.Trunc128("RangeByValueRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		} else if err != nil {
+			log.Debugw("RangeByValue",
+				logging.Trunc128("RangeByValueRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		return output.Entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &countermapv1.RangeByValueResponse{
+		Entries: mergeEntries(results, false, int(request.Limit)),
+	}
+	log.Debugw("RangeByValue",
+		logging.Trunc128("RangeByValueRequest", request),
+		logging.Trunc128("RangeByValueResponse", response))
+	return response, nil
+}
+
 func (s *CounterMapSession) Remove(ctx context.Context, request *countermapv1.RemoveRequest) (*countermapv1.RemoveResponse, error) {
 	log.Debugw("Remove",
 		logging.Trunc128("RemoveRequest", request))
@@ -870,4 +1107,71 @@ func (s *CounterMapSession) Entries(request *countermapv1.EntriesRequest, server
 	return nil
 }
 
+// mergeEntries merges the entries returned by each partition in ascending order of value, or in
+// descending order if descending is true. Entries with equal values are ordered by key, in reverse
+// when descending. A limit of zero returns all the entries.
+func mergeEntries(results [][]countermapprotocolv1.Entry, descending bool, limit int) []countermapv1.Entry {
+	var entries []countermapv1.Entry
+	for _, partitionEntries := range results {
+		for _, entry := range partitionEntries {
+			entries = append(entries, countermapv1.Entry{
+				Key:   entry.Key,
+				Value: entry.Value,
+			})
+		}
+	}
+	less := func(a, b countermapv1.Entry) bool {
+		if a.Value != b.Value {
+			return a.Value < b.Value
+		}
+		return a.Key < b.Key
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if descending {
+			return less(entries[j], entries[i])
+		}
+		return less(entries[i], entries[j])
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+func newProtocolBounds(bounds *countermapv1.Bounds) *countermapprotocolv1.Bounds {
+	if bounds == nil {
+		return nil
+	}
+	protocolBounds := &countermapprotocolv1.Bounds{}
+	if bounds.Min != nil {
+		protocolBounds.Min = &countermapprotocolv1.Bound{
+			Value: bounds.Min.Value,
+		}
+	}
+	if bounds.Max != nil {
+		protocolBounds.Max = &countermapprotocolv1.Bound{
+			Value: bounds.Max.Value,
+		}
+	}
+	return protocolBounds
+}
+
+func newBounds(protocolBounds *countermapprotocolv1.Bounds) *countermapv1.Bounds {
+	if protocolBounds == nil {
+		return nil
+	}
+	bounds := &countermapv1.Bounds{}
+	if protocolBounds.Min != nil {
+		bounds.Min = &countermapv1.Bound{
+			Value: protocolBounds.Min.Value,
+		}
+	}
+	if protocolBounds.Max != nil {
+		bounds.Max = &countermapv1.Bound{
+			Value: protocolBounds.Max.Value,
+		}
+	}
+	return bounds
+}
+
 var _ runtimecountermapv1.CounterMapProxy = (*CounterMapSession)(nil)
@@ -100,6 +100,9 @@ func (s *LeaderElectionSession) Enter(ctx context.Context, request *electionv1.E
 			Headers: headers,
 			EnterInput: &electionprotocolv1.EnterInput{
 				Candidate: request.Candidate,
+				Priority:  request.Priority,
+				Metadata:  request.Metadata,
+				Preempt:   request.Preempt,
 			},
 		})
 	})
@@ -115,11 +118,7 @@ func (s *LeaderElectionSession) Enter(ctx context.Context, request *electionv1.E
 		return nil, err
 	}
 	response := &electionv1.EnterResponse{
-		Term: electionv1.Term{
-			Term:       uint64(output.Term.Index),
-			Leader:     output.Term.Leader,
-			Candidates: output.Term.Candidates,
-		},
+		Term: newTerm(output.Term),
 	}
 	log.Debugw("Enter",
 		logging.Trunc128("EnterRequest", request),
@@ -166,11 +165,7 @@ func (s *LeaderElectionSession) Withdraw(ctx context.Context, request *electionv
 		return nil, err
 	}
 	response := &electionv1.WithdrawResponse{
-		Term: electionv1.Term{
-			Term:       uint64(output.Term.Index),
-			Leader:     output.Term.Leader,
-			Candidates: output.Term.Candidates,
-		},
+		Term: newTerm(output.Term),
 	}
 	log.Debugw("Withdraw",
 		logging.Trunc128("WithdrawRequest", request),
@@ -217,11 +212,7 @@ func (s *LeaderElectionSession) Anoint(ctx context.Context, request *electionv1.
 		return nil, err
 	}
 	response := &electionv1.AnointResponse{
-		Term: electionv1.Term{
-			Term:       uint64(output.Term.Index),
-			Leader:     output.Term.Leader,
-			Candidates: output.Term.Candidates,
-		},
+		Term: newTerm(output.Term),
 	}
 	log.Debugw("Anoint",
 		logging.Trunc128("AnointRequest", request),
@@ -268,11 +259,7 @@ func (s *LeaderElectionSession) Promote(ctx context.Context, request *electionv1
 		return nil, err
 	}
 	response := &electionv1.PromoteResponse{
-		Term: electionv1.Term{
-			Term:       uint64(output.Term.Index),
-			Leader:     output.Term.Leader,
-			Candidates: output.Term.Candidates,
-		},
+		Term: newTerm(output.Term),
 	}
 	log.Debugw("Promote",
 		logging.Trunc128("PromoteRequest", request),
@@ -319,11 +306,7 @@ func (s *LeaderElectionSession) Demote(ctx context.Context, request *electionv1.
 		return nil, err
 	}
 	response := &electionv1.DemoteResponse{
-		Term: electionv1.Term{
-			Term:       uint64(output.Term.Index),
-			Leader:     output.Term.Leader,
-			Candidates: output.Term.Candidates,
-		},
+		Term: newTerm(output.Term),
 	}
 	log.Debugw("Demote",
 		logging.Trunc128("DemoteRequest", request),
@@ -370,11 +353,7 @@ func (s *LeaderElectionSession) Evict(ctx context.Context, request *electionv1.E
 		return nil, err
 	}
 	response := &electionv1.EvictResponse{
-		Term: electionv1.Term{
-			Term:       uint64(output.Term.Index),
-			Leader:     output.Term.Leader,
-			Candidates: output.Term.Candidates,
-		},
+		Term: newTerm(output.Term),
 	}
 	log.Debugw("Evict",
 		logging.Trunc128("EvictRequest", request),
@@ -419,11 +398,7 @@ func (s *LeaderElectionSession) GetTerm(ctx context.Context, request *electionv1
 		return nil, err
 	}
 	response := &electionv1.GetTermResponse{
-		Term: electionv1.Term{
-			Term:       uint64(output.Term.Index),
-			Leader:     output.Term.Leader,
-			Candidates: output.Term.Candidates,
-		},
+		Term: newTerm(output.Term),
 	}
 	log.Debugw("GetTerm",
 		logging.Trunc128("GetTermRequest", request),
@@ -479,11 +454,7 @@ func (s *LeaderElectionSession) Watch(request *electionv1.WatchRequest, server e
 			return err
 		}
 		response := &electionv1.WatchResponse{
-			Term: electionv1.Term{
-				Term:       uint64(output.Term.Index),
-				Leader:     output.Term.Leader,
-				Candidates: output.Term.Candidates,
-			},
+			Term: newTerm(output.Term),
 		}
 		log.Debugw("Watch",
 			logging.Trunc128("WatchRequest", request),
@@ -498,4 +469,21 @@ func (s *LeaderElectionSession) Watch(request *electionv1.WatchRequest, server e
 	}
 }
 
+func newTerm(term electionprotocolv1.Term) electionv1.Term {
+	candidateInfo := make([]electionv1.Candidate, 0, len(term.CandidateInfo))
+	for _, candidate := range term.CandidateInfo {
+		candidateInfo = append(candidateInfo, electionv1.Candidate{
+			Name:     candidate.Name,
+			Priority: candidate.Priority,
+			Metadata: candidate.Metadata,
+		})
+	}
+	return electionv1.Term{
+		Term:          uint64(term.Index),
+		Leader:        term.Leader,
+		Candidates:    term.Candidates,
+		CandidateInfo: candidateInfo,
+	}
+}
+
 var _ runtimeelectionv1.LeaderElectionProxy = (*LeaderElectionSession)(nil)
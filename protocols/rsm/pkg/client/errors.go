@@ -42,6 +42,8 @@ func getErrorFromStatus(status protocol.CallResponseHeaders_Status, message stri
 		return errors.NewFault(message)
 	case protocol.CallResponseHeaders_INTERNAL:
 		return errors.NewInternal(message)
+	case protocol.CallResponseHeaders_OUT_OF_RANGE:
+		return errors.NewOutOfRange(message)
 	default:
 		return errors.NewUnknown(message)
 	}
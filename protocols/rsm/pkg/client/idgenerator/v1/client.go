@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"sync"
+
+	idgeneratorv1 "github.com/atomix/atomix/api/runtime/idgenerator/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	idgeneratorprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/idgenerator/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimeidgeneratorv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/idgenerator/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+const defaultBlockSize = 1000
+
+func NewIDGenerator(protocol *client.Protocol, id runtimev1.PrimitiveID, config *idgeneratorv1.Config) *IDGeneratorSession {
+	blockSize := uint64(defaultBlockSize)
+	if config != nil && config.BlockSize > 0 {
+		blockSize = config.BlockSize
+	}
+	return &IDGeneratorSession{
+		Protocol:  protocol,
+		id:        id,
+		blockSize: blockSize,
+	}
+}
+
+type IDGeneratorSession struct {
+	*client.Protocol
+	id        runtimev1.PrimitiveID
+	blockSize uint64
+	// next and end delimit the block of IDs prefetched from the state machine
+	next uint64
+	end  uint64
+	mu   sync.Mutex
+}
+
+func (s *IDGeneratorSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        idgeneratorv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *IDGeneratorSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *IDGeneratorSession) NextID(ctx context.Context, request *idgeneratorv1.NextIDRequest) (*idgeneratorv1.NextIDResponse, error) {
+	log.Debugw("NextID",
+		logging.Trunc128("NextIDRequest", request))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.next == s.end {
+		if err := s.allocate(ctx, request.ID); err != nil {
+			log.Warnw("NextID",
+				logging.Trunc128("NextIDRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+	}
+	response := &idgeneratorv1.NextIDResponse{
+		ID: s.next,
+	}
+	s.next++
+	log.Debugw("NextID",
+		logging.Trunc128("NextIDRequest", request),
+		logging.Trunc128("NextIDResponse", response))
+	return response, nil
+}
+
+// allocate fetches a new block of IDs from the state machine. Any IDs remaining in
+// the previous block are abandoned; the state machine never reallocates them.
+func (s *IDGeneratorSession) allocate(ctx context.Context, id runtimev1.PrimitiveID) error {
+	partition := s.PartitionBy([]byte(id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		return err
+	}
+	primitive, err := session.GetPrimitive(id.Name)
+	if err != nil {
+		return err
+	}
+	command := client.Proposal[*idgeneratorprotocolv1.AllocateResponse](primitive)
+	output, _, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*idgeneratorprotocolv1.AllocateResponse, error) {
+		return idgeneratorprotocolv1.NewIDGeneratorClient(conn).Allocate(ctx, &idgeneratorprotocolv1.AllocateRequest{
+			Headers: headers,
+			AllocateInput: &idgeneratorprotocolv1.AllocateInput{
+				Size_: s.blockSize,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.next = output.Start
+	s.end = output.End
+	return nil
+}
+
+var _ runtimeidgeneratorv1.IDGeneratorProxy = (*IDGeneratorSession)(nil)
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	latchv1 "github.com/atomix/atomix/api/runtime/latch/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	latchprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/latch/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimelatchv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/latch/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewLatch(protocol *client.Protocol, id runtimev1.PrimitiveID, config *latchv1.Config) *LatchSession {
+	return &LatchSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type LatchSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *latchv1.Config
+}
+
+func (s *LatchSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        latchv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the initial count from the primitive configuration
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*latchprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*latchprotocolv1.ConfigureResponse, error) {
+		return latchprotocolv1.NewLatchClient(conn).Configure(ctx, &latchprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &latchprotocolv1.ConfigureInput{
+				Count: s.config.Count,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *LatchSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *LatchSession) CountDown(ctx context.Context, request *latchv1.CountDownRequest) (*latchv1.CountDownResponse, error) {
+	log.Debugw("CountDown",
+		logging.Trunc128("CountDownRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("CountDown",
+			logging.Trunc128("CountDownRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("CountDown",
+			logging.Trunc128("CountDownRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*latchprotocolv1.CountDownResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*latchprotocolv1.CountDownResponse, error) {
+		return latchprotocolv1.NewLatchClient(conn).CountDown(ctx, &latchprotocolv1.CountDownRequest{
+			Headers:        headers,
+			CountDownInput: &latchprotocolv1.CountDownInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("CountDown",
+			logging.Trunc128("CountDownRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("CountDown",
+			logging.Trunc128("CountDownRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchv1.CountDownResponse{
+		Count: output.Count,
+	}
+	log.Debugw("CountDown",
+		logging.Trunc128("CountDownRequest", request),
+		logging.Trunc128("CountDownResponse", response))
+	return response, nil
+}
+
+func (s *LatchSession) Await(ctx context.Context, request *latchv1.AwaitRequest) (*latchv1.AwaitResponse, error) {
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*latchprotocolv1.AwaitResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*latchprotocolv1.AwaitResponse, error) {
+		return latchprotocolv1.NewLatchClient(conn).Await(ctx, &latchprotocolv1.AwaitRequest{
+			Headers: headers,
+			AwaitInput: &latchprotocolv1.AwaitInput{
+				Timeout: request.Timeout,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchv1.AwaitResponse{}
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request),
+		logging.Trunc128("AwaitResponse", response))
+	return response, nil
+}
+
+func (s *LatchSession) GetCount(ctx context.Context, request *latchv1.GetCountRequest) (*latchv1.GetCountResponse, error) {
+	log.Debugw("GetCount",
+		logging.Trunc128("GetCountRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetCount",
+			logging.Trunc128("GetCountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetCount",
+			logging.Trunc128("GetCountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*latchprotocolv1.GetResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*latchprotocolv1.GetResponse, error) {
+		return latchprotocolv1.NewLatchClient(conn).Get(ctx, &latchprotocolv1.GetRequest{
+			Headers:  headers,
+			GetInput: &latchprotocolv1.GetInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("GetCount",
+			logging.Trunc128("GetCountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetCount",
+			logging.Trunc128("GetCountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchv1.GetCountResponse{
+		Count: output.Count,
+	}
+	log.Debugw("GetCount",
+		logging.Trunc128("GetCountRequest", request),
+		logging.Trunc128("GetCountResponse", response))
+	return response, nil
+}
+
+var _ runtimelatchv1.LatchProxy = (*LatchSession)(nil)
@@ -0,0 +1,592 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+
+	listv1 "github.com/atomix/atomix/api/runtime/list/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	runtimelistv1 "github.com/atomix/atomix/runtime/pkg/runtime/list/v1"
+	listprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/list/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewList(protocol *client.Protocol, id runtimev1.PrimitiveID) *ListSession {
+	return &ListSession{
+		Protocol: protocol,
+		id:       id,
+	}
+}
+
+type ListSession struct {
+	*client.Protocol
+	id runtimev1.PrimitiveID
+}
+
+func (s *ListSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        listv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *ListSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *ListSession) Size(ctx context.Context, request *listv1.SizeRequest) (*listv1.SizeResponse, error) {
+	log.Debugw("Size",
+		logging.Trunc128("SizeRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Size",
+			logging.Trunc128("SizeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Size",
+			logging.Trunc128("SizeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*listprotocolv1.SizeResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*listprotocolv1.SizeResponse, error) {
+		return listprotocolv1.NewListClient(conn).Size(ctx, &listprotocolv1.SizeRequest{
+			Headers:   headers,
+			SizeInput: &listprotocolv1.SizeInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("Size",
+			logging.Trunc128("SizeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Size",
+			logging.Trunc128("SizeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listv1.SizeResponse{
+		Size_: output.Size_,
+	}
+	log.Debugw("Size",
+		logging.Trunc128("SizeRequest", request),
+		logging.Trunc128("SizeResponse", response))
+	return response, nil
+}
+
+func (s *ListSession) Append(ctx context.Context, request *listv1.AppendRequest) (*listv1.AppendResponse, error) {
+	log.Debugw("Append",
+		logging.Trunc128("AppendRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Append",
+			logging.Trunc128("AppendRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Append",
+			logging.Trunc128("AppendRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*listprotocolv1.AppendResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*listprotocolv1.AppendResponse, error) {
+		return listprotocolv1.NewListClient(conn).Append(ctx, &listprotocolv1.AppendRequest{
+			Headers: headers,
+			AppendInput: &listprotocolv1.AppendInput{
+				Value: listprotocolv1.Value{
+					Value: request.Value.Value,
+				},
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Append",
+			logging.Trunc128("AppendRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Append",
+			logging.Trunc128("AppendRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listv1.AppendResponse{}
+	log.Debugw("Append",
+		logging.Trunc128("AppendRequest", request),
+		logging.Trunc128("AppendResponse", response))
+	return response, nil
+}
+
+func (s *ListSession) Insert(ctx context.Context, request *listv1.InsertRequest) (*listv1.InsertResponse, error) {
+	log.Debugw("Insert",
+		logging.Trunc128("InsertRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Insert",
+			logging.Trunc128("InsertRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Insert",
+			logging.Trunc128("InsertRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*listprotocolv1.InsertResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*listprotocolv1.InsertResponse, error) {
+		return listprotocolv1.NewListClient(conn).Insert(ctx, &listprotocolv1.InsertRequest{
+			Headers: headers,
+			InsertInput: &listprotocolv1.InsertInput{
+				Index: request.Index,
+				Value: listprotocolv1.Value{
+					Value: request.Value.Value,
+				},
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Insert",
+			logging.Trunc128("InsertRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Insert",
+			logging.Trunc128("InsertRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listv1.InsertResponse{
+		Item: newItem(output.Item),
+	}
+	log.Debugw("Insert",
+		logging.Trunc128("InsertRequest", request),
+		logging.Trunc128("InsertResponse", response))
+	return response, nil
+}
+
+func (s *ListSession) Get(ctx context.Context, request *listv1.GetRequest) (*listv1.GetResponse, error) {
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*listprotocolv1.GetResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*listprotocolv1.GetResponse, error) {
+		return listprotocolv1.NewListClient(conn).Get(ctx, &listprotocolv1.GetRequest{
+			Headers: headers,
+			GetInput: &listprotocolv1.GetInput{
+				Index: request.Index,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listv1.GetResponse{
+		Item: newItem(output.Item),
+	}
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request),
+		logging.Trunc128("GetResponse", response))
+	return response, nil
+}
+
+func (s *ListSession) Set(ctx context.Context, request *listv1.SetRequest) (*listv1.SetResponse, error) {
+	log.Debugw("Set",
+		logging.Trunc128("SetRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Set",
+			logging.Trunc128("SetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Set",
+			logging.Trunc128("SetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*listprotocolv1.SetResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*listprotocolv1.SetResponse, error) {
+		return listprotocolv1.NewListClient(conn).Set(ctx, &listprotocolv1.SetRequest{
+			Headers: headers,
+			SetInput: &listprotocolv1.SetInput{
+				Index: request.Index,
+				Value: listprotocolv1.Value{
+					Value: request.Value.Value,
+				},
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Set",
+			logging.Trunc128("SetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Set",
+			logging.Trunc128("SetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listv1.SetResponse{
+		Item: newItem(output.Item),
+	}
+	log.Debugw("Set",
+		logging.Trunc128("SetRequest", request),
+		logging.Trunc128("SetResponse", response))
+	return response, nil
+}
+
+func (s *ListSession) Remove(ctx context.Context, request *listv1.RemoveRequest) (*listv1.RemoveResponse, error) {
+	log.Debugw("Remove",
+		logging.Trunc128("RemoveRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Remove",
+			logging.Trunc128("RemoveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Remove",
+			logging.Trunc128("RemoveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*listprotocolv1.RemoveResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*listprotocolv1.RemoveResponse, error) {
+		return listprotocolv1.NewListClient(conn).Remove(ctx, &listprotocolv1.RemoveRequest{
+			Headers: headers,
+			RemoveInput: &listprotocolv1.RemoveInput{
+				Index: request.Index,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Remove",
+			logging.Trunc128("RemoveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Remove",
+			logging.Trunc128("RemoveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listv1.RemoveResponse{
+		Item: newItem(output.Item),
+	}
+	log.Debugw("Remove",
+		logging.Trunc128("RemoveRequest", request),
+		logging.Trunc128("RemoveResponse", response))
+	return response, nil
+}
+
+func (s *ListSession) Clear(ctx context.Context, request *listv1.ClearRequest) (*listv1.ClearResponse, error) {
+	log.Debugw("Clear",
+		logging.Trunc128("ClearRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Clear",
+			logging.Trunc128("ClearRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Clear",
+			logging.Trunc128("ClearRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*listprotocolv1.ClearResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*listprotocolv1.ClearResponse, error) {
+		return listprotocolv1.NewListClient(conn).Clear(ctx, &listprotocolv1.ClearRequest{
+			Headers:    headers,
+			ClearInput: &listprotocolv1.ClearInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("Clear",
+			logging.Trunc128("ClearRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Clear",
+			logging.Trunc128("ClearRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listv1.ClearResponse{}
+	log.Debugw("Clear",
+		logging.Trunc128("ClearRequest", request),
+		logging.Trunc128("ClearResponse", response))
+	return response, nil
+}
+
+func (s *ListSession) Events(request *listv1.EventsRequest, server listv1.List_EventsServer) error {
+	log.Debugw("Events",
+		logging.Trunc128("EventsRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("Events",
+			logging.Trunc128("EventsRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Events",
+			logging.Trunc128("EventsRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.StreamProposal[*listprotocolv1.EventsResponse](primitive)
+	stream, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (client.ProposalStream[*listprotocolv1.EventsResponse], error) {
+		return listprotocolv1.NewListClient(conn).Events(server.Context(), &listprotocolv1.EventsRequest{
+			Headers: headers,
+			EventsInput: &listprotocolv1.EventsInput{
+				Replay: request.Replay,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Events",
+			logging.Trunc128("EventsRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			log.Debugw("Events",
+				logging.Trunc128("EventsRequest", request),
+				logging.String("State", "Done"))
+			return nil
+		}
+		if !ok {
+			log.Warnw("Events",
+				logging.Trunc128("EventsRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("Events",
+				logging.Trunc128("EventsRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &listv1.EventsResponse{
+			Event: listv1.Event{
+				Index: output.Event.Index,
+			},
+		}
+		switch e := output.Event.Event.(type) {
+		case *listprotocolv1.Event_Appended_:
+			response.Event.Event = &listv1.Event_Appended_{
+				Appended: &listv1.Event_Appended{
+					Value: listv1.Value{
+						Value: e.Appended.Value.Value,
+					},
+				},
+			}
+		case *listprotocolv1.Event_Inserted_:
+			response.Event.Event = &listv1.Event_Inserted_{
+				Inserted: &listv1.Event_Inserted{
+					Value: listv1.Value{
+						Value: e.Inserted.Value.Value,
+					},
+				},
+			}
+		case *listprotocolv1.Event_Updated_:
+			response.Event.Event = &listv1.Event_Updated_{
+				Updated: &listv1.Event_Updated{
+					Value: listv1.Value{
+						Value: e.Updated.Value.Value,
+					},
+					PrevValue: listv1.Value{
+						Value: e.Updated.PrevValue.Value,
+					},
+				},
+			}
+		case *listprotocolv1.Event_Removed_:
+			response.Event.Event = &listv1.Event_Removed_{
+				Removed: &listv1.Event_Removed{
+					Value: listv1.Value{
+						Value: e.Removed.Value.Value,
+					},
+				},
+			}
+		}
+		log.Debugw("Events",
+			logging.Trunc128("EventsRequest", request),
+			logging.Trunc128("EventsResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Events",
+				logging.Trunc128("EventsRequest", request),
+				logging.Trunc128("EventsResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func (s *ListSession) Items(request *listv1.ItemsRequest, server listv1.List_ItemsServer) error {
+	log.Debugw("Items",
+		logging.Trunc128("ItemsRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("Items",
+			logging.Trunc128("ItemsRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Items",
+			logging.Trunc128("ItemsRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	query := client.StreamQuery[*listprotocolv1.ItemsResponse](primitive)
+	stream, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (client.QueryStream[*listprotocolv1.ItemsResponse], error) {
+		return listprotocolv1.NewListClient(conn).Items(server.Context(), &listprotocolv1.ItemsRequest{
+			Headers: headers,
+			ItemsInput: &listprotocolv1.ItemsInput{
+				Watch: request.Watch,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Items",
+			logging.Trunc128("ItemsRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			log.Debugw("Items",
+				logging.Trunc128("ItemsRequest", request),
+				logging.String("State", "Done"))
+			return nil
+		}
+		if !ok {
+			log.Warnw("Items",
+				logging.Trunc128("ItemsRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("Items",
+				logging.Trunc128("ItemsRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &listv1.ItemsResponse{
+			Item: newItem(output.Item),
+		}
+		log.Debugw("Items",
+			logging.Trunc128("ItemsRequest", request),
+			logging.Trunc128("ItemsResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Items",
+				logging.Trunc128("ItemsRequest", request),
+				logging.Trunc128("ItemsResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func newItem(item listprotocolv1.Item) listv1.Item {
+	return listv1.Item{
+		Index: item.Index,
+		Value: listv1.Value{
+			Value: item.Value.Value,
+		},
+	}
+}
+
+var _ runtimelistv1.ListProxy = (*ListSession)(nil)
@@ -6,6 +6,7 @@ package v1
 
 import (
 	"context"
+	"strconv"
 
 	lockv1 "github.com/atomix/atomix/api/runtime/lock/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
@@ -98,7 +99,9 @@ func (s *LockSession) Lock(ctx context.Context, request *lockv1.LockRequest) (*l
 		return lockprotocolv1.NewLockClient(conn).Acquire(ctx, &lockprotocolv1.AcquireRequest{
 			Headers: headers,
 			AcquireInput: &lockprotocolv1.AcquireInput{
-				Timeout: request.Timeout,
+				Timeout:   request.Timeout,
+				Label:     request.Label,
+				Reentrant: request.Reentrant,
 			},
 		})
 	})
@@ -115,6 +118,7 @@ func (s *LockSession) Lock(ctx context.Context, request *lockv1.LockRequest) (*l
 	}
 	response := &lockv1.LockResponse{
 		Version: uint64(output.Index),
+		Holds:   output.Holds,
 	}
 	log.Debugw("Lock",
 		logging.Trunc128("LockRequest", request),
@@ -203,6 +207,13 @@ func (s *LockSession) GetLock(ctx context.Context, request *lockv1.GetLockReques
 	}
 	response := &lockv1.GetLockResponse{
 		Version: uint64(output.Index),
+		Holder: lockv1.GetLockResponse_Holder{
+			ID:    strconv.FormatUint(uint64(output.SessionID), 10),
+			Label: output.Label,
+		},
+		Acquired: output.Acquired,
+		Holds:    output.Holds,
+		Waiters:  output.Waiters,
 	}
 	log.Debugw("GetLock",
 		logging.Trunc128("GetLockRequest", request),
@@ -5,34 +5,39 @@
 package v1
 
 import (
+	"bytes"
 	"context"
 	"io"
+	"sort"
 	"sync"
 
 	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
 	"github.com/atomix/atomix/runtime/pkg/logging"
-	runtimemapv1 "github.com/atomix/atomix/runtime/pkg/runtime/map/v1"
 	streams "github.com/atomix/atomix/runtime/pkg/stream"
 	"github.com/atomix/atomix/runtime/pkg/utils/async"
 	mapprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/map/v1"
 	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/internal/page"
 	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimemapv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/map/v1"
 	"google.golang.org/grpc"
 )
 
 var log = logging.GetLogger()
 
-func NewMap(protocol *client.Protocol, id runtimev1.PrimitiveID) *MapSession {
+func NewMap(protocol *client.Protocol, id runtimev1.PrimitiveID, config *mapv1.Config) *MapSession {
 	return &MapSession{
 		Protocol: protocol,
 		id:       id,
+		config:   config,
 	}
 }
 
 type MapSession struct {
 	*client.Protocol
-	id runtimev1.PrimitiveID
+	id     runtimev1.PrimitiveID
+	config *mapv1.Config
 }
 
 func (s *MapSession) Open(ctx context.Context) error {
@@ -45,10 +50,29 @@ func (s *MapSession) Open(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		return session.CreatePrimitive(ctx, runtimev1.PrimitiveMeta{
+		err = session.CreatePrimitive(ctx, runtimev1.PrimitiveMeta{
 			Type:        mapv1.PrimitiveType,
 			PrimitiveID: s.id,
 		})
+		if err != nil || s.config == nil || len(s.config.Indexes) == 0 {
+			return err
+		}
+
+		// Declare the secondary indexes from the primitive configuration
+		primitive, err := session.GetPrimitive(s.id.Name)
+		if err != nil {
+			return err
+		}
+		command := client.Proposal[*mapprotocolv1.ConfigureResponse](primitive)
+		_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*mapprotocolv1.ConfigureResponse, error) {
+			return mapprotocolv1.NewMapClient(conn).Configure(ctx, &mapprotocolv1.ConfigureRequest{
+				Headers: headers,
+				ConfigureInput: &mapprotocolv1.ConfigureInput{
+					Indexes: newIndexes(s.config.Indexes),
+				},
+			})
+		})
+		return err
 	})
 	if err != nil {
 		log.Warnw("Create",
@@ -140,6 +164,12 @@ func (s *MapSession) Put(ctx context.Context, request *mapv1.PutRequest) (*mapv1
 	log.Debugw("Put",
 		logging.Trunc128("PutRequest", request))
 	partition := s.PartitionBy([]byte(request.Key))
+	if err := s.validateFence(ctx, request.ID.Name, request.Fence, partition); err != nil {
+		log.Debugw("Put",
+			logging.Trunc128("PutRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
 	session, err := partition.GetSession(ctx)
 	if err != nil {
 		log.Warnw("Put",
@@ -163,6 +193,9 @@ func (s *MapSession) Put(ctx context.Context, request *mapv1.PutRequest) (*mapv1
 				Value:     request.Value,
 				TTL:       request.TTL,
 				PrevIndex: protocol.Index(request.PrevVersion),
+				Ephemeral: request.Ephemeral,
+				Fence:     newFence(request.Fence),
+				Patch:     newPatch(request.Patch),
 			},
 		}
 		return mapprotocolv1.NewMapClient(conn).Put(ctx, input)
@@ -216,9 +249,10 @@ func (s *MapSession) Insert(ctx context.Context, request *mapv1.InsertRequest) (
 		return mapprotocolv1.NewMapClient(conn).Insert(ctx, &mapprotocolv1.InsertRequest{
 			Headers: headers,
 			InsertInput: &mapprotocolv1.InsertInput{
-				Key:   request.Key,
-				Value: request.Value,
-				TTL:   request.TTL,
+				Key:       request.Key,
+				Value:     request.Value,
+				TTL:       request.TTL,
+				Ephemeral: request.Ephemeral,
 			},
 		})
 	})
@@ -246,6 +280,12 @@ func (s *MapSession) Update(ctx context.Context, request *mapv1.UpdateRequest) (
 	log.Debugw("Update",
 		logging.Trunc128("UpdateRequest", request))
 	partition := s.PartitionBy([]byte(request.Key))
+	if err := s.validateFence(ctx, request.ID.Name, request.Fence, partition); err != nil {
+		log.Debugw("Update",
+			logging.Trunc128("UpdateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
 	session, err := partition.GetSession(ctx)
 	if err != nil {
 		log.Warnw("Update",
@@ -269,6 +309,7 @@ func (s *MapSession) Update(ctx context.Context, request *mapv1.UpdateRequest) (
 				Value:     request.Value,
 				TTL:       request.TTL,
 				PrevIndex: protocol.Index(request.PrevVersion),
+				Fence:     newFence(request.Fence),
 			},
 		}
 		return mapprotocolv1.NewMapClient(conn).Update(ctx, input)
@@ -320,7 +361,8 @@ func (s *MapSession) Get(ctx context.Context, request *mapv1.GetRequest) (*mapv1
 		return mapprotocolv1.NewMapClient(conn).Get(ctx, &mapprotocolv1.GetRequest{
 			Headers: headers,
 			GetInput: &mapprotocolv1.GetInput{
-				Key: request.Key,
+				Key:   request.Key,
+				Paths: request.Paths,
 			},
 		})
 	})
@@ -351,6 +393,12 @@ func (s *MapSession) Remove(ctx context.Context, request *mapv1.RemoveRequest) (
 	log.Debugw("Remove",
 		logging.Trunc128("RemoveRequest", request))
 	partition := s.PartitionBy([]byte(request.Key))
+	if err := s.validateFence(ctx, request.ID.Name, request.Fence, partition); err != nil {
+		log.Debugw("Remove",
+			logging.Trunc128("RemoveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
 	session, err := partition.GetSession(ctx)
 	if err != nil {
 		log.Warnw("Remove",
@@ -372,6 +420,7 @@ func (s *MapSession) Remove(ctx context.Context, request *mapv1.RemoveRequest) (
 			RemoveInput: &mapprotocolv1.RemoveInput{
 				Key:       request.Key,
 				PrevIndex: protocol.Index(request.PrevVersion),
+				Fence:     newFence(request.Fence),
 			},
 		}
 		return mapprotocolv1.NewMapClient(conn).Remove(ctx, input)
@@ -399,6 +448,260 @@ func (s *MapSession) Remove(ctx context.Context, request *mapv1.RemoveRequest) (
 	return response, nil
 }
 
+func (s *MapSession) GetAll(ctx context.Context, request *mapv1.GetAllRequest) (*mapv1.GetAllResponse, error) {
+	log.Debugw("GetAll",
+		logging.Trunc128("GetAllRequest", request))
+
+	partitions := s.Partitions()
+	indexKeys := make(map[int][]string)
+	for _, key := range request.Keys {
+		index := s.PartitionIndex([]byte(key))
+		indexKeys[index] = append(indexKeys[index], key)
+	}
+
+	partitionIndexes := make([]int, 0, len(indexKeys))
+	for index := range indexKeys {
+		partitionIndexes = append(partitionIndexes, index)
+	}
+
+	results, err := async.ExecuteAsync[[]mapprotocolv1.Entry](len(partitionIndexes), func(i int) ([]mapprotocolv1.Entry, error) {
+		index := partitionIndexes[i]
+		partition := partitions[index]
+		keys := indexKeys[index]
+
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("GetAll",
+				logging.Trunc128("GetAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("GetAll",
+				logging.Trunc128("GetAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		command := client.Query[*mapprotocolv1.GetAllResponse](primitive)
+		output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*mapprotocolv1.GetAllResponse, error) {
+			return mapprotocolv1.NewMapClient(conn).GetAll(ctx, &mapprotocolv1.GetAllRequest{
+				Headers: headers,
+				GetAllInput: &mapprotocolv1.GetAllInput{
+					Keys: keys,
+				},
+			})
+		})
+		if !ok {
+			log.Warnw("GetAll",
+				logging.Trunc128("GetAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		} else if err != nil {
+			log.Debugw("GetAll",
+				logging.Trunc128("GetAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		return output.Entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &mapv1.GetAllResponse{}
+	for _, entries := range results {
+		for _, entry := range entries {
+			response.Entries = append(response.Entries, mapv1.Entry{
+				Key: entry.Key,
+				Value: &mapv1.VersionedValue{
+					Value:   entry.Value.Value,
+					Version: uint64(entry.Value.Index),
+				},
+			})
+		}
+	}
+	log.Debugw("GetAll",
+		logging.Trunc128("GetAllRequest", request),
+		logging.Trunc128("GetAllResponse", response))
+	return response, nil
+}
+
+func (s *MapSession) PutAll(ctx context.Context, request *mapv1.PutAllRequest) (*mapv1.PutAllResponse, error) {
+	log.Debugw("PutAll",
+		logging.Trunc128("PutAllRequest", request))
+
+	partitions := s.Partitions()
+	indexEntries := make(map[int][]int)
+	for i, entry := range request.Entries {
+		index := s.PartitionIndex([]byte(entry.Key))
+		indexEntries[index] = append(indexEntries[index], i)
+	}
+
+	partitionIndexes := make([]int, 0, len(indexEntries))
+	for index := range indexEntries {
+		partitionIndexes = append(partitionIndexes, index)
+	}
+
+	results := make([]mapv1.PutAllResponse_Result, len(request.Entries))
+	err := async.IterAsync(len(partitionIndexes), func(i int) error {
+		index := partitionIndexes[i]
+		partition := partitions[index]
+		entries := indexEntries[index]
+
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("PutAll",
+				logging.Trunc128("PutAllRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("PutAll",
+				logging.Trunc128("PutAllRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		inputs := make([]mapprotocolv1.PutInput, 0, len(entries))
+		for _, j := range entries {
+			entry := request.Entries[j]
+			inputs = append(inputs, mapprotocolv1.PutInput{
+				Key:   entry.Key,
+				Value: entry.Value,
+				TTL:   entry.TTL,
+			})
+		}
+		command := client.Proposal[*mapprotocolv1.PutAllResponse](primitive)
+		output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*mapprotocolv1.PutAllResponse, error) {
+			return mapprotocolv1.NewMapClient(conn).PutAll(ctx, &mapprotocolv1.PutAllRequest{
+				Headers: headers,
+				PutAllInput: &mapprotocolv1.PutAllInput{
+					Inputs: inputs,
+				},
+			})
+		})
+		if !ok {
+			log.Warnw("PutAll",
+				logging.Trunc128("PutAllRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("PutAll",
+				logging.Trunc128("PutAllRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+
+		// Outputs are returned in the order of the inputs, so map them back to the position of each entry in the request
+		for k, j := range entries {
+			out := output.Outputs[k]
+			result := mapv1.PutAllResponse_Result{
+				Key:     request.Entries[j].Key,
+				Version: uint64(out.Index),
+			}
+			if out.PrevValue != nil {
+				result.PrevValue = &mapv1.VersionedValue{
+					Value:   out.PrevValue.Value,
+					Version: uint64(out.PrevValue.Index),
+				}
+			}
+			results[j] = result
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &mapv1.PutAllResponse{
+		Results: results,
+	}
+	log.Debugw("PutAll",
+		logging.Trunc128("PutAllRequest", request),
+		logging.Trunc128("PutAllResponse", response))
+	return response, nil
+}
+
+func (s *MapSession) RemoveAll(ctx context.Context, request *mapv1.RemoveAllRequest) (*mapv1.RemoveAllResponse, error) {
+	log.Debugw("RemoveAll",
+		logging.Trunc128("RemoveAllRequest", request))
+
+	partitions := s.Partitions()
+	indexKeys := make(map[int][]string)
+	for _, key := range request.Keys {
+		index := s.PartitionIndex([]byte(key))
+		indexKeys[index] = append(indexKeys[index], key)
+	}
+
+	partitionIndexes := make([]int, 0, len(indexKeys))
+	for index := range indexKeys {
+		partitionIndexes = append(partitionIndexes, index)
+	}
+
+	results, err := async.ExecuteAsync[[]mapprotocolv1.Entry](len(partitionIndexes), func(i int) ([]mapprotocolv1.Entry, error) {
+		index := partitionIndexes[i]
+		partition := partitions[index]
+		keys := indexKeys[index]
+
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("RemoveAll",
+				logging.Trunc128("RemoveAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("RemoveAll",
+				logging.Trunc128("RemoveAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		command := client.Proposal[*mapprotocolv1.RemoveAllResponse](primitive)
+		output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*mapprotocolv1.RemoveAllResponse, error) {
+			return mapprotocolv1.NewMapClient(conn).RemoveAll(ctx, &mapprotocolv1.RemoveAllRequest{
+				Headers: headers,
+				RemoveAllInput: &mapprotocolv1.RemoveAllInput{
+					Keys: keys,
+				},
+			})
+		})
+		if !ok {
+			log.Warnw("RemoveAll",
+				logging.Trunc128("RemoveAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		} else if err != nil {
+			log.Debugw("RemoveAll",
+				logging.Trunc128("RemoveAllRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		return output.Entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &mapv1.RemoveAllResponse{}
+	for _, entries := range results {
+		for _, entry := range entries {
+			response.Entries = append(response.Entries, mapv1.Entry{
+				Key: entry.Key,
+				Value: &mapv1.VersionedValue{
+					Value:   entry.Value.Value,
+					Version: uint64(entry.Value.Index),
+				},
+			})
+		}
+	}
+	log.Debugw("RemoveAll",
+		logging.Trunc128("RemoveAllRequest", request),
+		logging.Trunc128("RemoveAllResponse", response))
+	return response, nil
+}
+
 func (s *MapSession) Clear(ctx context.Context, request *mapv1.ClearRequest) (*mapv1.ClearResponse, error) {
 	log.Debugw("Clear",
 		logging.Trunc128("ClearRequest", request))
@@ -635,6 +938,19 @@ func (s *MapSession) Commit(ctx context.Context, request *mapv1.CommitRequest) (
 		indexes[partition.ID()] = append(indexes[partition.ID()], i)
 	}
 
+	if request.Fence != nil {
+		partitions := make([]*client.PartitionClient, 0, len(clients))
+		for _, partition := range clients {
+			partitions = append(partitions, partition)
+		}
+		if err := s.validateFence(ctx, request.ID.Name, request.Fence, partitions...); err != nil {
+			log.Debugw("Commit",
+				logging.Trunc128("CommitRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+	}
+
 	var outputs []mapprotocolv1.MapOutput
 	if len(indexes) == 1 {
 		for _, partition := range clients {
@@ -659,6 +975,7 @@ func (s *MapSession) Commit(ctx context.Context, request *mapv1.CommitRequest) (
 					Headers: headers,
 					ApplyInput: &mapprotocolv1.ApplyInput{
 						Inputs: inputs,
+						Fence:  newFence(request.Fence),
 					},
 				})
 			})
@@ -714,6 +1031,7 @@ func (s *MapSession) Commit(ctx context.Context, request *mapv1.CommitRequest) (
 					PrepareInput: &mapprotocolv1.PrepareInput{
 						SequenceNum: prepare.Headers.SequenceNum,
 						Inputs:      partitionInputs,
+						Fence:       newFence(request.Fence),
 					},
 				})
 			})
@@ -867,10 +1185,111 @@ func (s *MapSession) Commit(ctx context.Context, request *mapv1.CommitRequest) (
 	return response, nil
 }
 
+func (s *MapSession) Query(ctx context.Context, request *mapv1.QueryRequest) (*mapv1.QueryResponse, error) {
+	log.Debugw("Query",
+		logging.Trunc128("QueryRequest", request))
+	input := &mapprotocolv1.QueryInput{
+		Index: request.Index,
+		Limit: request.Limit,
+	}
+	switch q := request.Query.(type) {
+	case *mapv1.QueryRequest_Equals:
+		input.Query.Query = &mapprotocolv1.IndexQuery_Equals{
+			Equals: q.Equals,
+		}
+	case *mapv1.QueryRequest_Range:
+		input.Query.Query = &mapprotocolv1.IndexQuery_Range{
+			Range: newRange(q.Range),
+		}
+	}
+
+	partitions := s.Partitions()
+	results, err := async.ExecuteAsync[[]mapprotocolv1.QueryResult](len(partitions), func(i int) ([]mapprotocolv1.QueryResult, error) {
+		partition := partitions[i]
+		session, err := partition.GetSession(ctx)
+		if err != nil {
+			log.Warnw("Query",
+				logging.Trunc128("QueryRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		primitive, err := session.GetPrimitive(request.ID.Name)
+		if err != nil {
+			log.Warnw("Query",
+				logging.Trunc128("QueryRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		query := client.Query[*mapprotocolv1.QueryResponse](primitive)
+		output, ok, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*mapprotocolv1.QueryResponse, error) {
+			return mapprotocolv1.NewMapClient(conn).Query(ctx, &mapprotocolv1.QueryRequest{
+				Headers:    headers,
+				QueryInput: input,
+			})
+		})
+		if !ok {
+			log.Warnw("Query",
+				logging.Trunc128("QueryRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		} else if err != nil {
+			log.Debugw("Query",
+				logging.Trunc128("QueryRequest", request),
+				logging.Error("Error", err))
+			return nil, err
+		}
+		return output.Results, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &mapv1.QueryResponse{}
+	for _, result := range mergeQueryResults(results, int(request.Limit)) {
+		response.Entries = append(response.Entries, mapv1.Entry{
+			Key: result.Entry.Key,
+			Value: &mapv1.VersionedValue{
+				Value:   result.Entry.Value.Value,
+				Version: uint64(result.Entry.Value.Index),
+			},
+		})
+	}
+	log.Debugw("Query",
+		logging.Trunc128("QueryRequest", request),
+		logging.Trunc128("QueryResponse", response))
+	return response, nil
+}
+
+// mergeQueryResults merges the ordered results of each partition into a single ordered list of at most limit results
+func mergeQueryResults(partitionResults [][]mapprotocolv1.QueryResult, limit int) []mapprotocolv1.QueryResult {
+	var results []mapprotocolv1.QueryResult
+	for _, partitionResult := range partitionResults {
+		results = append(results, partitionResult...)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if c := bytes.Compare(results[i].IndexKey, results[j].IndexKey); c != 0 {
+			return c < 0
+		}
+		return results[i].Entry.Key < results[j].Entry.Key
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
 func (s *MapSession) Events(request *mapv1.EventsRequest, server mapv1.Map_EventsServer) error {
 	log.Debugw("Events received",
 		logging.Trunc128("EventsRequest", request))
+	types := make([]mapprotocolv1.EventsInput_EventType, 0, len(request.Types))
+	for _, t := range request.Types {
+		types = append(types, mapprotocolv1.EventsInput_EventType(t))
+	}
+	// Events for a single key can only originate in the partition that owns the key
 	partitions := s.Partitions()
+	if request.Key != "" {
+		partitions = []*client.PartitionClient{s.PartitionBy([]byte(request.Key))}
+	}
 	ch := make(chan streams.Result[*mapv1.EventsResponse])
 	wg := &sync.WaitGroup{}
 	for i := 0; i < len(partitions); i++ {
@@ -902,7 +1321,11 @@ func (s *MapSession) Events(request *mapv1.EventsRequest, server mapv1.Map_Event
 				return mapprotocolv1.NewMapClient(conn).Events(server.Context(), &mapprotocolv1.EventsRequest{
 					Headers: headers,
 					EventsInput: &mapprotocolv1.EventsInput{
-						Key: request.Key,
+						Key:     request.Key,
+						Prefix:  request.Prefix,
+						Pattern: request.Pattern,
+						Keys:    request.Keys,
+						Types:   types,
 					},
 				})
 			})
@@ -938,7 +1361,8 @@ func (s *MapSession) Events(request *mapv1.EventsRequest, server mapv1.Map_Event
 				} else {
 					response := &mapv1.EventsResponse{
 						Event: mapv1.Event{
-							Key: output.Event.Key,
+							Key:   output.Event.Key,
+							Patch: newEventPatch(output.Event.Patch),
 						},
 					}
 					switch e := output.Event.Event.(type) {
@@ -1007,110 +1431,340 @@ func (s *MapSession) Events(request *mapv1.EventsRequest, server mapv1.Map_Event
 func (s *MapSession) Entries(request *mapv1.EntriesRequest, server mapv1.Map_EntriesServer) error {
 	log.Debugw("Entries received",
 		logging.Trunc128("EntriesRequest", request))
+	if err := page.Validate(request.Limit, request.Watch); err != nil {
+		log.Warnw("Entries",
+			logging.Trunc128("EntriesRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	after, err := page.DecodeContinuation(request.Continuation)
+	if err != nil {
+		log.Warnw("Entries",
+			logging.Trunc128("EntriesRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+
 	partitions := s.Partitions()
-	ch := make(chan streams.Result[*mapv1.EntriesResponse])
-	wg := &sync.WaitGroup{}
+
+	// Watch streams never complete, so entries are forwarded from all partitions as they arrive
+	if request.Watch {
+		ch := make(chan streams.Result[*mapv1.EntriesResponse])
+		wg := &sync.WaitGroup{}
+		for i := 0; i < len(partitions); i++ {
+			wg.Add(1)
+			go func(partition *client.PartitionClient) {
+				defer wg.Done()
+				s.entries(ctx, partition, request, after, ch)
+			}(partitions[i])
+		}
+
+		go func() {
+			wg.Wait()
+			close(ch)
+		}()
+
+		for result := range ch {
+			if result.Failed() {
+				return result.Error
+			}
+			if err := server.Send(result.Value); err != nil {
+				return err
+			}
+		}
+		log.Debugw("Entries complete",
+			logging.Trunc128("EntriesRequest", request))
+		return nil
+	}
+
+	// Each partition returns its entries in key order, so merge the partition streams
+	// to return the entries for the whole map in key order
+	chs := make([]chan streams.Result[*mapv1.EntriesResponse], len(partitions))
 	for i := 0; i < len(partitions); i++ {
-		wg.Add(1)
-		go func(partition *client.PartitionClient) {
-			defer wg.Done()
-			session, err := partition.GetSession(server.Context())
-			if err != nil {
-				log.Warnw("Entries",
-					logging.Trunc128("EntriesRequest", request),
-					logging.Error("Error", err))
-				ch <- streams.Result[*mapv1.EntriesResponse]{
-					Error: err,
-				}
-				return
+		chs[i] = make(chan streams.Result[*mapv1.EntriesResponse])
+		go func(partition *client.PartitionClient, ch chan streams.Result[*mapv1.EntriesResponse]) {
+			defer close(ch)
+			s.entries(ctx, partition, request, after, ch)
+		}(partitions[i], chs[i])
+	}
+
+	heads := make([]*mapv1.EntriesResponse, len(chs))
+	next := func(i int) error {
+		heads[i] = nil
+		result, ok := <-chs[i]
+		if !ok {
+			return nil
+		}
+		if result.Failed() {
+			return result.Error
+		}
+		heads[i] = result.Value
+		return nil
+	}
+	for i := range chs {
+		if err := next(i); err != nil {
+			return err
+		}
+	}
+
+	var count uint32
+	for request.Limit == 0 || count < request.Limit {
+		min := -1
+		for i, head := range heads {
+			if head != nil && (min == -1 || head.Entry.Key < heads[min].Entry.Key) {
+				min = i
 			}
-			primitive, err := session.GetPrimitive(request.ID.Name)
-			if err != nil {
+		}
+		if min == -1 {
+			break
+		}
+		response := heads[min]
+		response.Continuation = page.EncodeContinuation(response.Entry.Key)
+		if err := server.Send(response); err != nil {
+			return err
+		}
+		count++
+		if err := next(min); err != nil {
+			return err
+		}
+	}
+	log.Debugw("Entries complete",
+		logging.Trunc128("EntriesRequest", request))
+	return nil
+}
+
+// entries streams the entries in the given partition to the given channel
+func (s *MapSession) entries(ctx context.Context, partition *client.PartitionClient, request *mapv1.EntriesRequest, after string, ch chan<- streams.Result[*mapv1.EntriesResponse]) {
+	send := func(result streams.Result[*mapv1.EntriesResponse]) bool {
+		select {
+		case ch <- result:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Entries",
+			logging.Trunc128("EntriesRequest", request),
+			logging.Error("Error", err))
+		send(streams.Result[*mapv1.EntriesResponse]{
+			Error: err,
+		})
+		return
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Entries",
+			logging.Trunc128("EntriesRequest", request),
+			logging.Error("Error", err))
+		send(streams.Result[*mapv1.EntriesResponse]{
+			Error: err,
+		})
+		return
+	}
+	query := client.StreamQuery[*mapprotocolv1.EntriesResponse](primitive)
+	stream, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (client.QueryStream[*mapprotocolv1.EntriesResponse], error) {
+		return mapprotocolv1.NewMapClient(conn).Entries(ctx, &mapprotocolv1.EntriesRequest{
+			Headers: headers,
+			EntriesInput: &mapprotocolv1.EntriesInput{
+				Watch:    request.Watch,
+				Prefix:   request.Prefix,
+				StartKey: request.StartKey,
+				EndKey:   request.EndKey,
+				Limit:    request.Limit,
+				After:    after,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Entries",
+			logging.Trunc128("EntriesRequest", request),
+			logging.Error("Error", err))
+		send(streams.Result[*mapv1.EntriesResponse]{
+			Error: err,
+		})
+		return
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if !ok {
+			if err != io.EOF {
 				log.Warnw("Entries",
 					logging.Trunc128("EntriesRequest", request),
 					logging.Error("Error", err))
-				ch <- streams.Result[*mapv1.EntriesResponse]{
+				send(streams.Result[*mapv1.EntriesResponse]{
 					Error: err,
-				}
-				return
-			}
-			query := client.StreamQuery[*mapprotocolv1.EntriesResponse](primitive)
-			stream, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (client.QueryStream[*mapprotocolv1.EntriesResponse], error) {
-				return mapprotocolv1.NewMapClient(conn).Entries(server.Context(), &mapprotocolv1.EntriesRequest{
-					Headers: headers,
-					EntriesInput: &mapprotocolv1.EntriesInput{
-						Watch: request.Watch,
-					},
 				})
-			})
-			if err != nil {
-				log.Warnw("Entries",
-					logging.Trunc128("EntriesRequest", request),
-					logging.Error("Error", err))
-				ch <- streams.Result[*mapv1.EntriesResponse]{
-					Error: err,
-				}
+			}
+			return
+		}
+		if err != nil {
+			log.Debugw("Entries",
+				logging.Trunc128("EntriesRequest", request),
+				logging.Error("Error", err))
+			if !send(streams.Result[*mapv1.EntriesResponse]{
+				Error: err,
+			}) {
 				return
 			}
-			for {
-				output, ok, err := stream.Recv()
-				if !ok {
-					if err != io.EOF {
-						log.Warnw("Entries",
-							logging.Trunc128("EntriesRequest", request),
-							logging.Error("Error", err))
-						ch <- streams.Result[*mapv1.EntriesResponse]{
-							Error: err,
-						}
-					}
-					return
-				}
-				if err != nil {
-					log.Debugw("Entries",
-						logging.Trunc128("EntriesRequest", request),
-						logging.Error("Error", err))
-					ch <- streams.Result[*mapv1.EntriesResponse]{
-						Error: err,
-					}
-				} else {
-					response := &mapv1.EntriesResponse{
-						Entry: mapv1.Entry{
-							Key: output.Entry.Key,
-						},
-					}
-					if output.Entry.Value != nil {
-						response.Entry.Value = &mapv1.VersionedValue{
-							Value:   output.Entry.Value.Value,
-							Version: uint64(output.Entry.Value.Index),
-						}
-					}
-					log.Debugw("Entries",
-						logging.Trunc128("EntriesRequest", request),
-						logging.Trunc128("EntriesResponse", response))
-					ch <- streams.Result[*mapv1.EntriesResponse]{
-						Value: response,
-					}
+		} else {
+			response := &mapv1.EntriesResponse{
+				Entry: mapv1.Entry{
+					Key: output.Entry.Key,
+				},
+			}
+			if output.Entry.Value != nil {
+				response.Entry.Value = &mapv1.VersionedValue{
+					Value:   output.Entry.Value.Value,
+					Version: uint64(output.Entry.Value.Index),
 				}
 			}
-		}(partitions[i])
+			log.Debugw("Entries",
+				logging.Trunc128("EntriesRequest", request),
+				logging.Trunc128("EntriesResponse", response))
+			if !send(streams.Result[*mapv1.EntriesResponse]{
+				Value: response,
+			}) {
+				return
+			}
+		}
 	}
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+// validateFence validates a fencing token in the partition of the fencing primitive before the token
+// is used to fence writes to other partitions. Each partition rejects tokens superseded by a token it
+// has already accepted, but only the fencing primitive's partition knows the current token.
+func (s *MapSession) validateFence(ctx context.Context, name string, fence *mapv1.Fence, partitions ...*client.PartitionClient) error {
+	if fence == nil {
+		return nil
+	}
+	partition := s.PartitionBy([]byte(fence.Name))
+	local := true
+	for _, p := range partitions {
+		if p.ID() != partition.ID() {
+			local = false
+		}
+	}
+	if local {
+		return nil
+	}
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		return err
+	}
+	primitive, err := session.GetPrimitive(name)
+	if err != nil {
+		return err
+	}
+	command := client.Proposal[*mapprotocolv1.ValidateFenceResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*mapprotocolv1.ValidateFenceResponse, error) {
+		return mapprotocolv1.NewMapClient(conn).ValidateFence(ctx, &mapprotocolv1.ValidateFenceRequest{
+			Headers: headers,
+			ValidateFenceInput: &mapprotocolv1.ValidateFenceInput{
+				Fence: *newFence(fence),
+			},
+		})
+	})
+	return err
+}
 
-	for result := range ch {
-		if result.Failed() {
-			return result.Error
+// newFence converts the fencing token in a request to a protocol fence
+func newFence(fence *mapv1.Fence) *mapprotocolv1.Fence {
+	if fence == nil {
+		return nil
+	}
+	return &mapprotocolv1.Fence{
+		Name:  fence.Name,
+		Token: fence.Token,
+	}
+}
+
+func newPatch(patch *mapv1.Patch) *mapprotocolv1.Patch {
+	if patch == nil {
+		return nil
+	}
+	switch p := patch.Patch.(type) {
+	case *mapv1.Patch_JsonPatch:
+		return &mapprotocolv1.Patch{
+			Patch: &mapprotocolv1.Patch_JsonPatch{
+				JsonPatch: p.JsonPatch,
+			},
 		}
-		if err := server.Send(result.Value); err != nil {
-			return err
+	case *mapv1.Patch_MergePatch:
+		return &mapprotocolv1.Patch{
+			Patch: &mapprotocolv1.Patch_MergePatch{
+				MergePatch: p.MergePatch,
+			},
 		}
+	default:
+		return &mapprotocolv1.Patch{}
 	}
-	log.Debugw("Entries complete",
-		logging.Trunc128("EntriesRequest", request))
-	return nil
+}
+
+func newEventPatch(patch *mapprotocolv1.Patch) *mapv1.Patch {
+	if patch == nil {
+		return nil
+	}
+	switch p := patch.Patch.(type) {
+	case *mapprotocolv1.Patch_JsonPatch:
+		return &mapv1.Patch{
+			Patch: &mapv1.Patch_JsonPatch{
+				JsonPatch: p.JsonPatch,
+			},
+		}
+	case *mapprotocolv1.Patch_MergePatch:
+		return &mapv1.Patch{
+			Patch: &mapv1.Patch_MergePatch{
+				MergePatch: p.MergePatch,
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+func newIndexes(configs []mapv1.IndexConfig) []mapprotocolv1.Index {
+	indexes := make([]mapprotocolv1.Index, 0, len(configs))
+	for _, config := range configs {
+		index := mapprotocolv1.Index{
+			Name: config.Name,
+		}
+		switch extractor := config.Extractor.(type) {
+		case *mapv1.IndexConfig_JsonPath:
+			index.Extractor = &mapprotocolv1.Index_JsonPath{
+				JsonPath: extractor.JsonPath,
+			}
+		case *mapv1.IndexConfig_PrefixLength:
+			index.Extractor = &mapprotocolv1.Index_PrefixLength{
+				PrefixLength: extractor.PrefixLength,
+			}
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+func newRange(r *mapv1.Range) *mapprotocolv1.Range {
+	if r == nil {
+		return nil
+	}
+	protocolRange := &mapprotocolv1.Range{}
+	if r.Min != nil {
+		protocolRange.Min = &mapprotocolv1.Bound{
+			Value: r.Min.Value,
+		}
+	}
+	if r.Max != nil {
+		protocolRange.Max = &mapprotocolv1.Bound{
+			Value: r.Max.Value,
+		}
+	}
+	return protocolRange
 }
 
 var _ runtimemapv1.MapProxy = (*MapSession)(nil)
@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+
+	membershipv1 "github.com/atomix/atomix/api/runtime/membership/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	membershipprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/membership/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimemembershipv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/membership/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewMembership(protocol *client.Protocol, id runtimev1.PrimitiveID) *MembershipSession {
+	return &MembershipSession{
+		Protocol: protocol,
+		id:       id,
+	}
+}
+
+type MembershipSession struct {
+	*client.Protocol
+	id runtimev1.PrimitiveID
+}
+
+func (s *MembershipSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        membershipv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *MembershipSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *MembershipSession) Join(ctx context.Context, request *membershipv1.JoinRequest) (*membershipv1.JoinResponse, error) {
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*membershipprotocolv1.JoinResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*membershipprotocolv1.JoinResponse, error) {
+		return membershipprotocolv1.NewMembershipClient(conn).Join(ctx, &membershipprotocolv1.JoinRequest{
+			Headers: headers,
+			JoinInput: &membershipprotocolv1.JoinInput{
+				Member: membershipprotocolv1.Member{
+					Name:     request.Member.Name,
+					Metadata: request.Member.Metadata,
+				},
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &membershipv1.JoinResponse{}
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request),
+		logging.Trunc128("JoinResponse", response))
+	return response, nil
+}
+
+func (s *MembershipSession) Leave(ctx context.Context, request *membershipv1.LeaveRequest) (*membershipv1.LeaveResponse, error) {
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*membershipprotocolv1.LeaveResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*membershipprotocolv1.LeaveResponse, error) {
+		return membershipprotocolv1.NewMembershipClient(conn).Leave(ctx, &membershipprotocolv1.LeaveRequest{
+			Headers: headers,
+			LeaveInput: &membershipprotocolv1.LeaveInput{
+				Name: request.Name,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &membershipv1.LeaveResponse{}
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request),
+		logging.Trunc128("LeaveResponse", response))
+	return response, nil
+}
+
+func (s *MembershipSession) List(ctx context.Context, request *membershipv1.ListRequest) (*membershipv1.ListResponse, error) {
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*membershipprotocolv1.ListResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*membershipprotocolv1.ListResponse, error) {
+		return membershipprotocolv1.NewMembershipClient(conn).List(ctx, &membershipprotocolv1.ListRequest{
+			Headers:   headers,
+			ListInput: &membershipprotocolv1.ListInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	members := make([]membershipv1.Member, 0, len(output.Members))
+	for _, member := range output.Members {
+		members = append(members, membershipv1.Member{
+			Name:     member.Name,
+			Metadata: member.Metadata,
+		})
+	}
+	response := &membershipv1.ListResponse{
+		Members: members,
+	}
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request),
+		logging.Trunc128("ListResponse", response))
+	return response, nil
+}
+
+func (s *MembershipSession) Watch(request *membershipv1.WatchRequest, server membershipv1.Membership_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc128("WatchRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	query := client.StreamQuery[*membershipprotocolv1.WatchResponse](primitive)
+	stream, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (client.QueryStream[*membershipprotocolv1.WatchResponse], error) {
+		return membershipprotocolv1.NewMembershipClient(conn).Watch(server.Context(), &membershipprotocolv1.WatchRequest{
+			Headers: headers,
+			WatchInput: &membershipprotocolv1.WatchInput{
+				Replay: request.Replay,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if !ok {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &membershipv1.WatchResponse{
+			Event: newEvent(output.Event),
+		}
+		log.Debugw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Trunc128("WatchResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Trunc128("WatchResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func newEvent(event membershipprotocolv1.Event) membershipv1.Event {
+	switch e := event.Event.(type) {
+	case *membershipprotocolv1.Event_Joined_:
+		return membershipv1.Event{
+			Name: event.Name,
+			Event: &membershipv1.Event_Joined_{
+				Joined: &membershipv1.Event_Joined{
+					Metadata: e.Joined.Metadata,
+				},
+			},
+		}
+	case *membershipprotocolv1.Event_Updated_:
+		return membershipv1.Event{
+			Name: event.Name,
+			Event: &membershipv1.Event_Updated_{
+				Updated: &membershipv1.Event_Updated{
+					Metadata:     e.Updated.Metadata,
+					PrevMetadata: e.Updated.PrevMetadata,
+				},
+			},
+		}
+	case *membershipprotocolv1.Event_Left_:
+		return membershipv1.Event{
+			Name: event.Name,
+			Event: &membershipv1.Event_Left_{
+				Left: &membershipv1.Event_Left{
+					Metadata: e.Left.Metadata,
+					Expired:  e.Left.Expired,
+				},
+			},
+		}
+	}
+	return membershipv1.Event{
+		Name: event.Name,
+	}
+}
+
+var _ runtimemembershipv1.MembershipProxy = (*MembershipSession)(nil)
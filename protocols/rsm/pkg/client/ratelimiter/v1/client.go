@@ -0,0 +1,421 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	ratelimiterv1 "github.com/atomix/atomix/api/runtime/ratelimiter/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	ratelimiterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/ratelimiter/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimeratelimiterv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/ratelimiter/v1"
+	"google.golang.org/grpc"
+	"sync"
+	"time"
+)
+
+var log = logging.GetLogger()
+
+const defaultLeaseTTL = time.Second
+
+func NewRateLimiter(protocol *client.Protocol, id runtimev1.PrimitiveID, config *ratelimiterv1.Config) *RateLimiterSession {
+	return &RateLimiterSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+		leases:   make(map[string]*lease),
+	}
+}
+
+type RateLimiterSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *ratelimiterv1.Config
+	leases map[string]*lease
+	mu     sync.Mutex
+}
+
+// lease is a local reservation of tokens from a bucket
+type lease struct {
+	tokens  uint64
+	expires time.Time
+}
+
+// leaseSize returns the number of tokens to reserve at a time, or zero if leases are disabled
+func (s *RateLimiterSession) leaseSize() uint64 {
+	if s.config == nil {
+		return 0
+	}
+	return s.config.Lease.Tokens
+}
+
+// takeLease attempts to take the given number of tokens from the local reservation for the bucket
+func (s *RateLimiterSession) takeLease(bucket string, tokens uint64) (uint64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lease, ok := s.leases[bucket]
+	if !ok {
+		return 0, false
+	}
+	if time.Now().After(lease.expires) {
+		delete(s.leases, bucket)
+		return 0, false
+	}
+	if lease.tokens < tokens {
+		return 0, false
+	}
+	lease.tokens -= tokens
+	if lease.tokens == 0 {
+		delete(s.leases, bucket)
+	}
+	return lease.tokens, true
+}
+
+// addLease adds reserved tokens to the local reservation for the bucket
+func (s *RateLimiterSession) addLease(bucket string, tokens uint64) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for name, lease := range s.leases {
+		if now.After(lease.expires) {
+			delete(s.leases, name)
+		}
+	}
+	ttl := defaultLeaseTTL
+	if s.config.Lease.TTL != nil {
+		ttl = *s.config.Lease.TTL
+	}
+	l, ok := s.leases[bucket]
+	if !ok {
+		l = &lease{}
+		s.leases[bucket] = l
+	}
+	l.tokens += tokens
+	l.expires = now.Add(ttl)
+	return l.tokens
+}
+
+func (s *RateLimiterSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        ratelimiterv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the default limit from the primitive configuration
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*ratelimiterprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*ratelimiterprotocolv1.ConfigureResponse, error) {
+		return ratelimiterprotocolv1.NewRateLimiterClient(conn).Configure(ctx, &ratelimiterprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &ratelimiterprotocolv1.ConfigureInput{
+				Limit: ratelimiterprotocolv1.Limit{
+					Rate:  s.config.Limit.Rate,
+					Burst: s.config.Limit.Burst,
+				},
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *RateLimiterSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *RateLimiterSession) TryAcquire(ctx context.Context, request *ratelimiterv1.TryAcquireRequest) (*ratelimiterv1.TryAcquireResponse, error) {
+	log.Debugw("TryAcquire",
+		logging.Trunc128("TryAcquireRequest", request))
+
+	// When leases are enabled, tokens are served from the local reservation until it's exhausted
+	leaseSize := s.leaseSize()
+	if leaseSize > 0 {
+		tokens := request.Tokens
+		if tokens == 0 {
+			tokens = 1
+		}
+		if remaining, ok := s.takeLease(request.Bucket, tokens); ok {
+			response := &ratelimiterv1.TryAcquireResponse{
+				Acquired:  true,
+				Remaining: remaining,
+			}
+			log.Debugw("TryAcquire",
+				logging.Trunc128("TryAcquireRequest", request),
+				logging.Trunc128("TryAcquireResponse", response))
+			return response, nil
+		}
+	}
+
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("TryAcquire",
+			logging.Trunc128("TryAcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("TryAcquire",
+			logging.Trunc128("TryAcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*ratelimiterprotocolv1.TryAcquireResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*ratelimiterprotocolv1.TryAcquireResponse, error) {
+		return ratelimiterprotocolv1.NewRateLimiterClient(conn).TryAcquire(ctx, &ratelimiterprotocolv1.TryAcquireRequest{
+			Headers: headers,
+			TryAcquireInput: &ratelimiterprotocolv1.TryAcquireInput{
+				Bucket:  request.Bucket,
+				Tokens:  request.Tokens,
+				Reserve: leaseSize,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("TryAcquire",
+			logging.Trunc128("TryAcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("TryAcquire",
+			logging.Trunc128("TryAcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterv1.TryAcquireResponse{
+		Acquired:   output.Acquired,
+		Remaining:  output.Remaining,
+		RetryAfter: output.RetryAfter,
+	}
+	if output.Reserved > 0 {
+		response.Remaining = s.addLease(request.Bucket, output.Reserved)
+	}
+	log.Debugw("TryAcquire",
+		logging.Trunc128("TryAcquireRequest", request),
+		logging.Trunc128("TryAcquireResponse", response))
+	return response, nil
+}
+
+func (s *RateLimiterSession) Acquire(ctx context.Context, request *ratelimiterv1.AcquireRequest) (*ratelimiterv1.AcquireResponse, error) {
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request))
+
+	if s.leaseSize() > 0 {
+		tokens := request.Tokens
+		if tokens == 0 {
+			tokens = 1
+		}
+		if remaining, ok := s.takeLease(request.Bucket, tokens); ok {
+			response := &ratelimiterv1.AcquireResponse{
+				Remaining: remaining,
+			}
+			log.Debugw("Acquire",
+				logging.Trunc128("AcquireRequest", request),
+				logging.Trunc128("AcquireResponse", response))
+			return response, nil
+		}
+	}
+
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*ratelimiterprotocolv1.AcquireResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*ratelimiterprotocolv1.AcquireResponse, error) {
+		return ratelimiterprotocolv1.NewRateLimiterClient(conn).Acquire(ctx, &ratelimiterprotocolv1.AcquireRequest{
+			Headers: headers,
+			AcquireInput: &ratelimiterprotocolv1.AcquireInput{
+				Bucket:  request.Bucket,
+				Tokens:  request.Tokens,
+				Timeout: request.Timeout,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterv1.AcquireResponse{
+		Remaining: output.Remaining,
+	}
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request),
+		logging.Trunc128("AcquireResponse", response))
+	return response, nil
+}
+
+func (s *RateLimiterSession) SetLimit(ctx context.Context, request *ratelimiterv1.SetLimitRequest) (*ratelimiterv1.SetLimitResponse, error) {
+	log.Debugw("SetLimit",
+		logging.Trunc128("SetLimitRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("SetLimit",
+			logging.Trunc128("SetLimitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("SetLimit",
+			logging.Trunc128("SetLimitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	input := &ratelimiterprotocolv1.SetLimitInput{
+		Bucket: request.Bucket,
+	}
+	if request.Limit != nil {
+		input.Limit = &ratelimiterprotocolv1.Limit{
+			Rate:  request.Limit.Rate,
+			Burst: request.Limit.Burst,
+		}
+	}
+	command := client.Proposal[*ratelimiterprotocolv1.SetLimitResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*ratelimiterprotocolv1.SetLimitResponse, error) {
+		return ratelimiterprotocolv1.NewRateLimiterClient(conn).SetLimit(ctx, &ratelimiterprotocolv1.SetLimitRequest{
+			Headers:       headers,
+			SetLimitInput: input,
+		})
+	})
+	if !ok {
+		log.Warnw("SetLimit",
+			logging.Trunc128("SetLimitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("SetLimit",
+			logging.Trunc128("SetLimitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterv1.SetLimitResponse{}
+	log.Debugw("SetLimit",
+		logging.Trunc128("SetLimitRequest", request),
+		logging.Trunc128("SetLimitResponse", response))
+	return response, nil
+}
+
+func (s *RateLimiterSession) GetBucket(ctx context.Context, request *ratelimiterv1.GetBucketRequest) (*ratelimiterv1.GetBucketResponse, error) {
+	log.Debugw("GetBucket",
+		logging.Trunc128("GetBucketRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetBucket",
+			logging.Trunc128("GetBucketRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetBucket",
+			logging.Trunc128("GetBucketRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*ratelimiterprotocolv1.GetBucketResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*ratelimiterprotocolv1.GetBucketResponse, error) {
+		return ratelimiterprotocolv1.NewRateLimiterClient(conn).GetBucket(ctx, &ratelimiterprotocolv1.GetBucketRequest{
+			Headers: headers,
+			GetBucketInput: &ratelimiterprotocolv1.GetBucketInput{
+				Bucket: request.Bucket,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("GetBucket",
+			logging.Trunc128("GetBucketRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetBucket",
+			logging.Trunc128("GetBucketRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterv1.GetBucketResponse{
+		Limit: ratelimiterv1.Limit{
+			Rate:  output.Limit.Rate,
+			Burst: output.Limit.Burst,
+		},
+		Tokens:  output.Tokens,
+		Waiters: output.Waiters,
+	}
+	log.Debugw("GetBucket",
+		logging.Trunc128("GetBucketRequest", request),
+		logging.Trunc128("GetBucketResponse", response))
+	return response, nil
+}
+
+var _ runtimeratelimiterv1.RateLimiterProxy = (*RateLimiterSession)(nil)
@@ -0,0 +1,334 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	rwlockv1 "github.com/atomix/atomix/api/runtime/rwlock/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	rwlockprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/rwlock/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimerwlockv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/rwlock/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewRWLock(protocol *client.Protocol, id runtimev1.PrimitiveID, config *rwlockv1.Config) *RWLockSession {
+	return &RWLockSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type RWLockSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *rwlockv1.Config
+}
+
+func (s *RWLockSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        rwlockv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the queueing policy from the primitive configuration unless the policy has already been configured
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*rwlockprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*rwlockprotocolv1.ConfigureResponse, error) {
+		return rwlockprotocolv1.NewRWLockClient(conn).Configure(ctx, &rwlockprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &rwlockprotocolv1.ConfigureInput{
+				Policy: rwlockprotocolv1.ConfigureInput_Policy(s.config.Policy),
+				Init:   true,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *RWLockSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *RWLockSession) Lock(ctx context.Context, request *rwlockv1.LockRequest) (*rwlockv1.LockResponse, error) {
+	log.Debugw("Lock",
+		logging.Trunc128("LockRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Lock",
+			logging.Trunc128("LockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Lock",
+			logging.Trunc128("LockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*rwlockprotocolv1.LockResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*rwlockprotocolv1.LockResponse, error) {
+		return rwlockprotocolv1.NewRWLockClient(conn).Lock(ctx, &rwlockprotocolv1.LockRequest{
+			Headers: headers,
+			LockInput: &rwlockprotocolv1.LockInput{
+				Timeout: request.Timeout,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Lock",
+			logging.Trunc128("LockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Lock",
+			logging.Trunc128("LockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockv1.LockResponse{
+		Version: uint64(output.Index),
+	}
+	log.Debugw("Lock",
+		logging.Trunc128("LockRequest", request),
+		logging.Trunc128("LockResponse", response))
+	return response, nil
+}
+
+func (s *RWLockSession) Unlock(ctx context.Context, request *rwlockv1.UnlockRequest) (*rwlockv1.UnlockResponse, error) {
+	log.Debugw("Unlock",
+		logging.Trunc128("UnlockRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Unlock",
+			logging.Trunc128("UnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Unlock",
+			logging.Trunc128("UnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*rwlockprotocolv1.UnlockResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*rwlockprotocolv1.UnlockResponse, error) {
+		return rwlockprotocolv1.NewRWLockClient(conn).Unlock(ctx, &rwlockprotocolv1.UnlockRequest{
+			Headers:     headers,
+			UnlockInput: &rwlockprotocolv1.UnlockInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("Unlock",
+			logging.Trunc128("UnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Unlock",
+			logging.Trunc128("UnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockv1.UnlockResponse{}
+	log.Debugw("Unlock",
+		logging.Trunc128("UnlockRequest", request),
+		logging.Trunc128("UnlockResponse", response))
+	return response, nil
+}
+
+func (s *RWLockSession) RLock(ctx context.Context, request *rwlockv1.RLockRequest) (*rwlockv1.RLockResponse, error) {
+	log.Debugw("RLock",
+		logging.Trunc128("RLockRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("RLock",
+			logging.Trunc128("RLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("RLock",
+			logging.Trunc128("RLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*rwlockprotocolv1.RLockResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*rwlockprotocolv1.RLockResponse, error) {
+		return rwlockprotocolv1.NewRWLockClient(conn).RLock(ctx, &rwlockprotocolv1.RLockRequest{
+			Headers: headers,
+			RLockInput: &rwlockprotocolv1.RLockInput{
+				Timeout: request.Timeout,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("RLock",
+			logging.Trunc128("RLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("RLock",
+			logging.Trunc128("RLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockv1.RLockResponse{
+		Version: uint64(output.Index),
+	}
+	log.Debugw("RLock",
+		logging.Trunc128("RLockRequest", request),
+		logging.Trunc128("RLockResponse", response))
+	return response, nil
+}
+
+func (s *RWLockSession) RUnlock(ctx context.Context, request *rwlockv1.RUnlockRequest) (*rwlockv1.RUnlockResponse, error) {
+	log.Debugw("RUnlock",
+		logging.Trunc128("RUnlockRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("RUnlock",
+			logging.Trunc128("RUnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("RUnlock",
+			logging.Trunc128("RUnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*rwlockprotocolv1.RUnlockResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*rwlockprotocolv1.RUnlockResponse, error) {
+		return rwlockprotocolv1.NewRWLockClient(conn).RUnlock(ctx, &rwlockprotocolv1.RUnlockRequest{
+			Headers:      headers,
+			RUnlockInput: &rwlockprotocolv1.RUnlockInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("RUnlock",
+			logging.Trunc128("RUnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("RUnlock",
+			logging.Trunc128("RUnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockv1.RUnlockResponse{}
+	log.Debugw("RUnlock",
+		logging.Trunc128("RUnlockRequest", request),
+		logging.Trunc128("RUnlockResponse", response))
+	return response, nil
+}
+
+func (s *RWLockSession) GetLock(ctx context.Context, request *rwlockv1.GetLockRequest) (*rwlockv1.GetLockResponse, error) {
+	log.Debugw("GetLock",
+		logging.Trunc128("GetLockRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetLock",
+			logging.Trunc128("GetLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetLock",
+			logging.Trunc128("GetLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*rwlockprotocolv1.GetResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*rwlockprotocolv1.GetResponse, error) {
+		return rwlockprotocolv1.NewRWLockClient(conn).Get(ctx, &rwlockprotocolv1.GetRequest{
+			Headers:  headers,
+			GetInput: &rwlockprotocolv1.GetInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("GetLock",
+			logging.Trunc128("GetLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetLock",
+			logging.Trunc128("GetLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockv1.GetLockResponse{
+		Version: uint64(output.Index),
+		Readers: output.Readers,
+	}
+	log.Debugw("GetLock",
+		logging.Trunc128("GetLockRequest", request),
+		logging.Trunc128("GetLockResponse", response))
+	return response, nil
+}
+
+var _ runtimerwlockv1.RWLockProxy = (*RWLockSession)(nil)
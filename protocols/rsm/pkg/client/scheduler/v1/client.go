@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	schedulerv1 "github.com/atomix/atomix/api/runtime/scheduler/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	schedulerprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/scheduler/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimeschedulerv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/scheduler/v1"
+	"google.golang.org/grpc"
+	"io"
+	"time"
+)
+
+var log = logging.GetLogger()
+
+func NewScheduler(protocol *client.Protocol, id runtimev1.PrimitiveID, config *schedulerv1.Config) *SchedulerSession {
+	return &SchedulerSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type SchedulerSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *schedulerv1.Config
+}
+
+func (s *SchedulerSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        schedulerv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the ack timeout from the primitive configuration
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	var ackTimeout time.Duration
+	if s.config.AckTimeout != nil {
+		ackTimeout = *s.config.AckTimeout
+	}
+	command := client.Proposal[*schedulerprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*schedulerprotocolv1.ConfigureResponse, error) {
+		return schedulerprotocolv1.NewSchedulerClient(conn).Configure(ctx, &schedulerprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &schedulerprotocolv1.ConfigureInput{
+				AckTimeout: ackTimeout,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *SchedulerSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *SchedulerSession) Schedule(ctx context.Context, request *schedulerv1.ScheduleRequest) (*schedulerv1.ScheduleResponse, error) {
+	log.Debugw("Schedule",
+		logging.Trunc128("ScheduleRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Schedule",
+			logging.Trunc128("ScheduleRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Schedule",
+			logging.Trunc128("ScheduleRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	var interval time.Duration
+	if request.Interval != nil {
+		interval = *request.Interval
+	}
+	command := client.Proposal[*schedulerprotocolv1.ScheduleResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*schedulerprotocolv1.ScheduleResponse, error) {
+		return schedulerprotocolv1.NewSchedulerClient(conn).Schedule(ctx, &schedulerprotocolv1.ScheduleRequest{
+			Headers: headers,
+			ScheduleInput: &schedulerprotocolv1.ScheduleInput{
+				Name:     request.Name,
+				Payload:  request.Payload,
+				Delay:    request.Delay,
+				Interval: interval,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Schedule",
+			logging.Trunc128("ScheduleRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Schedule",
+			logging.Trunc128("ScheduleRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerv1.ScheduleResponse{
+		Timer: newTimer(output.Timer),
+	}
+	log.Debugw("Schedule",
+		logging.Trunc128("ScheduleRequest", request),
+		logging.Trunc128("ScheduleResponse", response))
+	return response, nil
+}
+
+func (s *SchedulerSession) Cancel(ctx context.Context, request *schedulerv1.CancelRequest) (*schedulerv1.CancelResponse, error) {
+	log.Debugw("Cancel",
+		logging.Trunc128("CancelRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Cancel",
+			logging.Trunc128("CancelRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Cancel",
+			logging.Trunc128("CancelRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*schedulerprotocolv1.CancelResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*schedulerprotocolv1.CancelResponse, error) {
+		return schedulerprotocolv1.NewSchedulerClient(conn).Cancel(ctx, &schedulerprotocolv1.CancelRequest{
+			Headers: headers,
+			CancelInput: &schedulerprotocolv1.CancelInput{
+				Name: request.Name,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Cancel",
+			logging.Trunc128("CancelRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Cancel",
+			logging.Trunc128("CancelRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerv1.CancelResponse{}
+	log.Debugw("Cancel",
+		logging.Trunc128("CancelRequest", request),
+		logging.Trunc128("CancelResponse", response))
+	return response, nil
+}
+
+func (s *SchedulerSession) List(ctx context.Context, request *schedulerv1.ListRequest) (*schedulerv1.ListResponse, error) {
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*schedulerprotocolv1.ListResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*schedulerprotocolv1.ListResponse, error) {
+		return schedulerprotocolv1.NewSchedulerClient(conn).List(ctx, &schedulerprotocolv1.ListRequest{
+			Headers:   headers,
+			ListInput: &schedulerprotocolv1.ListInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	timers := make([]schedulerv1.Timer, 0, len(output.Timers))
+	for _, timer := range output.Timers {
+		timers = append(timers, newTimer(timer))
+	}
+	response := &schedulerv1.ListResponse{
+		Timers: timers,
+	}
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request),
+		logging.Trunc128("ListResponse", response))
+	return response, nil
+}
+
+func (s *SchedulerSession) Subscribe(request *schedulerv1.SubscribeRequest, server schedulerv1.Scheduler_SubscribeServer) error {
+	log.Debugw("Subscribe",
+		logging.Trunc128("SubscribeRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.StreamProposal[*schedulerprotocolv1.SubscribeResponse](primitive)
+	stream, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (client.ProposalStream[*schedulerprotocolv1.SubscribeResponse], error) {
+		return schedulerprotocolv1.NewSchedulerClient(conn).Subscribe(server.Context(), &schedulerprotocolv1.SubscribeRequest{
+			Headers:        headers,
+			SubscribeInput: &schedulerprotocolv1.SubscribeInput{},
+		})
+	})
+	if err != nil {
+		log.Warnw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			log.Debugw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.String("State", "Done"))
+			return nil
+		}
+		if !ok {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &schedulerv1.SubscribeResponse{
+			Firing: schedulerv1.Firing{
+				ID:      output.Firing.ID,
+				Timer:   output.Firing.Timer,
+				Payload: output.Firing.Payload,
+				Time:    output.Firing.Time,
+				Attempt: output.Firing.Attempt,
+			},
+		}
+		log.Debugw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Trunc128("SubscribeResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Trunc128("SubscribeResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func (s *SchedulerSession) Ack(ctx context.Context, request *schedulerv1.AckRequest) (*schedulerv1.AckResponse, error) {
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*schedulerprotocolv1.AckResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*schedulerprotocolv1.AckResponse, error) {
+		return schedulerprotocolv1.NewSchedulerClient(conn).Ack(ctx, &schedulerprotocolv1.AckRequest{
+			Headers: headers,
+			AckInput: &schedulerprotocolv1.AckInput{
+				FiringID: request.FiringID,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerv1.AckResponse{}
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request),
+		logging.Trunc128("AckResponse", response))
+	return response, nil
+}
+
+func newTimer(timer schedulerprotocolv1.Timer) schedulerv1.Timer {
+	return schedulerv1.Timer{
+		Name:     timer.Name,
+		Payload:  timer.Payload,
+		Next:     timer.Next,
+		Interval: timer.Interval,
+	}
+}
+
+var _ runtimeschedulerv1.SchedulerProxy = (*SchedulerSession)(nil)
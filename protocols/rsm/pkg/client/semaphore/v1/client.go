@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	semaphorev1 "github.com/atomix/atomix/api/runtime/semaphore/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	semaphoreprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/semaphore/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimesemaphorev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/semaphore/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewSemaphore(protocol *client.Protocol, id runtimev1.PrimitiveID, config *semaphorev1.Config) *SemaphoreSession {
+	return &SemaphoreSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type SemaphoreSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *semaphorev1.Config
+}
+
+func (s *SemaphoreSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        semaphorev1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the number of permits from the primitive configuration unless the semaphore's permits have already been configured
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*semaphoreprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*semaphoreprotocolv1.ConfigureResponse, error) {
+		return semaphoreprotocolv1.NewSemaphoreClient(conn).Configure(ctx, &semaphoreprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &semaphoreprotocolv1.ConfigureInput{
+				Permits: s.config.Permits,
+				Init:    true,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *SemaphoreSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *SemaphoreSession) Acquire(ctx context.Context, request *semaphorev1.AcquireRequest) (*semaphorev1.AcquireResponse, error) {
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*semaphoreprotocolv1.AcquireResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*semaphoreprotocolv1.AcquireResponse, error) {
+		return semaphoreprotocolv1.NewSemaphoreClient(conn).Acquire(ctx, &semaphoreprotocolv1.AcquireRequest{
+			Headers: headers,
+			AcquireInput: &semaphoreprotocolv1.AcquireInput{
+				Permits: request.Permits,
+				Timeout: request.Timeout,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphorev1.AcquireResponse{}
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request),
+		logging.Trunc128("AcquireResponse", response))
+	return response, nil
+}
+
+func (s *SemaphoreSession) Release(ctx context.Context, request *semaphorev1.ReleaseRequest) (*semaphorev1.ReleaseResponse, error) {
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*semaphoreprotocolv1.ReleaseResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*semaphoreprotocolv1.ReleaseResponse, error) {
+		return semaphoreprotocolv1.NewSemaphoreClient(conn).Release(ctx, &semaphoreprotocolv1.ReleaseRequest{
+			Headers: headers,
+			ReleaseInput: &semaphoreprotocolv1.ReleaseInput{
+				Permits: request.Permits,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphorev1.ReleaseResponse{}
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request),
+		logging.Trunc128("ReleaseResponse", response))
+	return response, nil
+}
+
+func (s *SemaphoreSession) GetSemaphore(ctx context.Context, request *semaphorev1.GetSemaphoreRequest) (*semaphorev1.GetSemaphoreResponse, error) {
+	log.Debugw("GetSemaphore",
+		logging.Trunc128("GetSemaphoreRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetSemaphore",
+			logging.Trunc128("GetSemaphoreRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetSemaphore",
+			logging.Trunc128("GetSemaphoreRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*semaphoreprotocolv1.GetResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*semaphoreprotocolv1.GetResponse, error) {
+		return semaphoreprotocolv1.NewSemaphoreClient(conn).Get(ctx, &semaphoreprotocolv1.GetRequest{
+			Headers:  headers,
+			GetInput: &semaphoreprotocolv1.GetInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("GetSemaphore",
+			logging.Trunc128("GetSemaphoreRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetSemaphore",
+			logging.Trunc128("GetSemaphoreRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphorev1.GetSemaphoreResponse{
+		Permits:   output.Permits,
+		Available: output.Available,
+		Waiters:   output.Waiters,
+	}
+	log.Debugw("GetSemaphore",
+		logging.Trunc128("GetSemaphoreRequest", request),
+		logging.Trunc128("GetSemaphoreResponse", response))
+	return response, nil
+}
+
+var _ runtimesemaphorev1.SemaphoreProxy = (*SemaphoreSession)(nil)
@@ -0,0 +1,374 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+
+	shardassignmentv1 "github.com/atomix/atomix/api/runtime/shardassignment/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	shardassignmentprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/shardassignment/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimeshardassignmentv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/shardassignment/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewShardAssignment(protocol *client.Protocol, id runtimev1.PrimitiveID, config *shardassignmentv1.Config) *ShardAssignmentSession {
+	return &ShardAssignmentSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type ShardAssignmentSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *shardassignmentv1.Config
+}
+
+func (s *ShardAssignmentSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        shardassignmentv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the shards and assignment policy from the primitive configuration unless they have already been configured
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*shardassignmentprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*shardassignmentprotocolv1.ConfigureResponse, error) {
+		return shardassignmentprotocolv1.NewShardAssignmentClient(conn).Configure(ctx, &shardassignmentprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &shardassignmentprotocolv1.ConfigureInput{
+				Shards: s.config.Shards,
+				Policy: shardassignmentprotocolv1.Policy(s.config.Policy),
+				Init:   true,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *ShardAssignmentSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *ShardAssignmentSession) Join(ctx context.Context, request *shardassignmentv1.JoinRequest) (*shardassignmentv1.JoinResponse, error) {
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*shardassignmentprotocolv1.JoinResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*shardassignmentprotocolv1.JoinResponse, error) {
+		return shardassignmentprotocolv1.NewShardAssignmentClient(conn).Join(ctx, &shardassignmentprotocolv1.JoinRequest{
+			Headers: headers,
+			JoinInput: &shardassignmentprotocolv1.JoinInput{
+				Member: request.Member,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentv1.JoinResponse{
+		Shards:   output.Shards,
+		Version:  output.Version,
+		Revoking: output.Revoking,
+	}
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request),
+		logging.Trunc128("JoinResponse", response))
+	return response, nil
+}
+
+func (s *ShardAssignmentSession) Leave(ctx context.Context, request *shardassignmentv1.LeaveRequest) (*shardassignmentv1.LeaveResponse, error) {
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*shardassignmentprotocolv1.LeaveResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*shardassignmentprotocolv1.LeaveResponse, error) {
+		return shardassignmentprotocolv1.NewShardAssignmentClient(conn).Leave(ctx, &shardassignmentprotocolv1.LeaveRequest{
+			Headers: headers,
+			LeaveInput: &shardassignmentprotocolv1.LeaveInput{
+				Member: request.Member,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentv1.LeaveResponse{}
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request),
+		logging.Trunc128("LeaveResponse", response))
+	return response, nil
+}
+
+func (s *ShardAssignmentSession) Release(ctx context.Context, request *shardassignmentv1.ReleaseRequest) (*shardassignmentv1.ReleaseResponse, error) {
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*shardassignmentprotocolv1.ReleaseResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*shardassignmentprotocolv1.ReleaseResponse, error) {
+		return shardassignmentprotocolv1.NewShardAssignmentClient(conn).Release(ctx, &shardassignmentprotocolv1.ReleaseRequest{
+			Headers: headers,
+			ReleaseInput: &shardassignmentprotocolv1.ReleaseInput{
+				Member: request.Member,
+				Shards: request.Shards,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentv1.ReleaseResponse{}
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request),
+		logging.Trunc128("ReleaseResponse", response))
+	return response, nil
+}
+
+func (s *ShardAssignmentSession) GetAssignments(ctx context.Context, request *shardassignmentv1.GetAssignmentsRequest) (*shardassignmentv1.GetAssignmentsResponse, error) {
+	log.Debugw("GetAssignments",
+		logging.Trunc128("GetAssignmentsRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetAssignments",
+			logging.Trunc128("GetAssignmentsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetAssignments",
+			logging.Trunc128("GetAssignmentsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*shardassignmentprotocolv1.GetAssignmentsResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*shardassignmentprotocolv1.GetAssignmentsResponse, error) {
+		return shardassignmentprotocolv1.NewShardAssignmentClient(conn).GetAssignments(ctx, &shardassignmentprotocolv1.GetAssignmentsRequest{
+			Headers:             headers,
+			GetAssignmentsInput: &shardassignmentprotocolv1.GetAssignmentsInput{},
+		})
+	})
+	if !ok {
+		log.Warnw("GetAssignments",
+			logging.Trunc128("GetAssignmentsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetAssignments",
+			logging.Trunc128("GetAssignmentsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	assignments := make([]shardassignmentv1.Assignment, 0, len(output.Assignments))
+	for _, assignment := range output.Assignments {
+		assignments = append(assignments, newAssignment(assignment))
+	}
+	response := &shardassignmentv1.GetAssignmentsResponse{
+		Assignments: assignments,
+		Version:     output.Version,
+	}
+	log.Debugw("GetAssignments",
+		logging.Trunc128("GetAssignmentsRequest", request),
+		logging.Trunc128("GetAssignmentsResponse", response))
+	return response, nil
+}
+
+func (s *ShardAssignmentSession) Watch(request *shardassignmentv1.WatchRequest, server shardassignmentv1.ShardAssignment_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc128("WatchRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	query := client.StreamQuery[*shardassignmentprotocolv1.WatchResponse](primitive)
+	stream, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (client.QueryStream[*shardassignmentprotocolv1.WatchResponse], error) {
+		return shardassignmentprotocolv1.NewShardAssignmentClient(conn).Watch(server.Context(), &shardassignmentprotocolv1.WatchRequest{
+			Headers: headers,
+			WatchInput: &shardassignmentprotocolv1.WatchInput{
+				Member: request.Member,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if !ok {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &shardassignmentv1.WatchResponse{
+			Assignment: newAssignment(output.Assignment),
+			Version:    output.Version,
+		}
+		log.Debugw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Trunc128("WatchResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Trunc128("WatchResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func newAssignment(assignment shardassignmentprotocolv1.Assignment) shardassignmentv1.Assignment {
+	return shardassignmentv1.Assignment{
+		Member:   assignment.Member,
+		Shards:   assignment.Shards,
+		Revoking: assignment.Revoking,
+	}
+}
+
+var _ runtimeshardassignmentv1.ShardAssignmentProxy = (*ShardAssignmentSession)(nil)
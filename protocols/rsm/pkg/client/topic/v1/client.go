@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+
+	topicv1 "github.com/atomix/atomix/api/runtime/topic/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	topicprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/topic/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimetopicv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/topic/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewTopic(protocol *client.Protocol, id runtimev1.PrimitiveID, config *topicv1.Config) *TopicSession {
+	return &TopicSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type TopicSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *topicv1.Config
+}
+
+func (s *TopicSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        topicv1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the retention policy from the primitive configuration
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*topicprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*topicprotocolv1.ConfigureResponse, error) {
+		return topicprotocolv1.NewTopicClient(conn).Configure(ctx, &topicprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &topicprotocolv1.ConfigureInput{
+				Retention: topicprotocolv1.Retention{
+					MaxMessages: s.config.Retention.MaxMessages,
+					MaxBytes:    s.config.Retention.MaxBytes,
+					MaxAge:      s.config.Retention.MaxAge,
+				},
+				Partitions: s.config.Partitions,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *TopicSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *TopicSession) Publish(ctx context.Context, request *topicv1.PublishRequest) (*topicv1.PublishResponse, error) {
+	log.Debugw("Publish",
+		logging.Trunc128("PublishRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Publish",
+			logging.Trunc128("PublishRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Publish",
+			logging.Trunc128("PublishRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*topicprotocolv1.PublishResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*topicprotocolv1.PublishResponse, error) {
+		return topicprotocolv1.NewTopicClient(conn).Publish(ctx, &topicprotocolv1.PublishRequest{
+			Headers: headers,
+			PublishInput: &topicprotocolv1.PublishInput{
+				Payload: request.Payload,
+				Key:     request.Key,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Publish",
+			logging.Trunc128("PublishRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Publish",
+			logging.Trunc128("PublishRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &topicv1.PublishResponse{
+		Offset:    output.Offset,
+		Timestamp: &output.Timestamp,
+		Partition: output.Partition,
+	}
+	log.Debugw("Publish",
+		logging.Trunc128("PublishRequest", request),
+		logging.Trunc128("PublishResponse", response))
+	return response, nil
+}
+
+func (s *TopicSession) Subscribe(request *topicv1.SubscribeRequest, server topicv1.Topic_SubscribeServer) error {
+	log.Debugw("Subscribe",
+		logging.Trunc128("SubscribeRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.StreamProposal[*topicprotocolv1.SubscribeResponse](primitive)
+	stream, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (client.ProposalStream[*topicprotocolv1.SubscribeResponse], error) {
+		return topicprotocolv1.NewTopicClient(conn).Subscribe(server.Context(), &topicprotocolv1.SubscribeRequest{
+			Headers: headers,
+			SubscribeInput: &topicprotocolv1.SubscribeInput{
+				Position:  topicprotocolv1.SubscribeInput_Position(request.Position),
+				Offset:    request.Offset,
+				Timestamp: request.Timestamp,
+				Group:     request.Group,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			log.Debugw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.String("State", "Done"))
+			return nil
+		}
+		if !ok {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &topicv1.SubscribeResponse{
+			Offset:    output.Message.Offset,
+			Timestamp: &output.Message.Timestamp,
+			Payload:   output.Message.Payload,
+			Partition: output.Message.Partition,
+		}
+		log.Debugw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Trunc128("SubscribeResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Trunc128("SubscribeResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func (s *TopicSession) CommitOffset(ctx context.Context, request *topicv1.CommitOffsetRequest) (*topicv1.CommitOffsetResponse, error) {
+	log.Debugw("CommitOffset",
+		logging.Trunc128("CommitOffsetRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("CommitOffset",
+			logging.Trunc128("CommitOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("CommitOffset",
+			logging.Trunc128("CommitOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*topicprotocolv1.CommitOffsetResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*topicprotocolv1.CommitOffsetResponse, error) {
+		return topicprotocolv1.NewTopicClient(conn).CommitOffset(ctx, &topicprotocolv1.CommitOffsetRequest{
+			Headers: headers,
+			CommitOffsetInput: &topicprotocolv1.CommitOffsetInput{
+				Group:  request.Group,
+				Offset: request.Offset,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("CommitOffset",
+			logging.Trunc128("CommitOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("CommitOffset",
+			logging.Trunc128("CommitOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &topicv1.CommitOffsetResponse{}
+	log.Debugw("CommitOffset",
+		logging.Trunc128("CommitOffsetRequest", request),
+		logging.Trunc128("CommitOffsetResponse", response))
+	return response, nil
+}
+
+func (s *TopicSession) FetchOffset(ctx context.Context, request *topicv1.FetchOffsetRequest) (*topicv1.FetchOffsetResponse, error) {
+	log.Debugw("FetchOffset",
+		logging.Trunc128("FetchOffsetRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("FetchOffset",
+			logging.Trunc128("FetchOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("FetchOffset",
+			logging.Trunc128("FetchOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*topicprotocolv1.FetchOffsetResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*topicprotocolv1.FetchOffsetResponse, error) {
+		return topicprotocolv1.NewTopicClient(conn).FetchOffset(ctx, &topicprotocolv1.FetchOffsetRequest{
+			Headers: headers,
+			FetchOffsetInput: &topicprotocolv1.FetchOffsetInput{
+				Group:     request.Group,
+				Partition: request.Partition,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("FetchOffset",
+			logging.Trunc128("FetchOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("FetchOffset",
+			logging.Trunc128("FetchOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &topicv1.FetchOffsetResponse{
+		Offset: output.Offset,
+	}
+	log.Debugw("FetchOffset",
+		logging.Trunc128("FetchOffsetRequest", request),
+		logging.Trunc128("FetchOffsetResponse", response))
+	return response, nil
+}
+
+var _ runtimetopicv1.TopicProxy = (*TopicSession)(nil)
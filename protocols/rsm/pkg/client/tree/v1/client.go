@@ -0,0 +1,434 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+
+	treev1 "github.com/atomix/atomix/api/runtime/tree/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	treeprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/tree/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimetreev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/tree/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewTree(protocol *client.Protocol, id runtimev1.PrimitiveID) *TreeSession {
+	return &TreeSession{
+		Protocol: protocol,
+		id:       id,
+	}
+}
+
+type TreeSession struct {
+	*client.Protocol
+	id runtimev1.PrimitiveID
+}
+
+func (s *TreeSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        treev1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *TreeSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *TreeSession) CreateNode(ctx context.Context, request *treev1.CreateNodeRequest) (*treev1.CreateNodeResponse, error) {
+	log.Debugw("CreateNode",
+		logging.Trunc128("CreateNodeRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("CreateNode",
+			logging.Trunc128("CreateNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("CreateNode",
+			logging.Trunc128("CreateNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*treeprotocolv1.CreateNodeResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*treeprotocolv1.CreateNodeResponse, error) {
+		return treeprotocolv1.NewTreeClient(conn).CreateNode(ctx, &treeprotocolv1.CreateNodeRequest{
+			Headers: headers,
+			CreateNodeInput: &treeprotocolv1.CreateNodeInput{
+				Path:       request.Path,
+				Value:      request.Value,
+				Sequential: request.Sequential,
+				Ephemeral:  request.Ephemeral,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("CreateNode",
+			logging.Trunc128("CreateNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("CreateNode",
+			logging.Trunc128("CreateNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treev1.CreateNodeResponse{
+		Node: newNode(output.Node),
+	}
+	log.Debugw("CreateNode",
+		logging.Trunc128("CreateNodeRequest", request),
+		logging.Trunc128("CreateNodeResponse", response))
+	return response, nil
+}
+
+func (s *TreeSession) GetNode(ctx context.Context, request *treev1.GetNodeRequest) (*treev1.GetNodeResponse, error) {
+	log.Debugw("GetNode",
+		logging.Trunc128("GetNodeRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetNode",
+			logging.Trunc128("GetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetNode",
+			logging.Trunc128("GetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*treeprotocolv1.GetNodeResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*treeprotocolv1.GetNodeResponse, error) {
+		return treeprotocolv1.NewTreeClient(conn).GetNode(ctx, &treeprotocolv1.GetNodeRequest{
+			Headers: headers,
+			GetNodeInput: &treeprotocolv1.GetNodeInput{
+				Path: request.Path,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("GetNode",
+			logging.Trunc128("GetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetNode",
+			logging.Trunc128("GetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treev1.GetNodeResponse{
+		Node: newNode(output.Node),
+	}
+	log.Debugw("GetNode",
+		logging.Trunc128("GetNodeRequest", request),
+		logging.Trunc128("GetNodeResponse", response))
+	return response, nil
+}
+
+func (s *TreeSession) SetNode(ctx context.Context, request *treev1.SetNodeRequest) (*treev1.SetNodeResponse, error) {
+	log.Debugw("SetNode",
+		logging.Trunc128("SetNodeRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("SetNode",
+			logging.Trunc128("SetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("SetNode",
+			logging.Trunc128("SetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*treeprotocolv1.SetNodeResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*treeprotocolv1.SetNodeResponse, error) {
+		return treeprotocolv1.NewTreeClient(conn).SetNode(ctx, &treeprotocolv1.SetNodeRequest{
+			Headers: headers,
+			SetNodeInput: &treeprotocolv1.SetNodeInput{
+				Path:        request.Path,
+				Value:       request.Value,
+				PrevVersion: request.PrevVersion,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("SetNode",
+			logging.Trunc128("SetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("SetNode",
+			logging.Trunc128("SetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treev1.SetNodeResponse{
+		Node: newNode(output.Node),
+	}
+	log.Debugw("SetNode",
+		logging.Trunc128("SetNodeRequest", request),
+		logging.Trunc128("SetNodeResponse", response))
+	return response, nil
+}
+
+func (s *TreeSession) DeleteNode(ctx context.Context, request *treev1.DeleteNodeRequest) (*treev1.DeleteNodeResponse, error) {
+	log.Debugw("DeleteNode",
+		logging.Trunc128("DeleteNodeRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("DeleteNode",
+			logging.Trunc128("DeleteNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("DeleteNode",
+			logging.Trunc128("DeleteNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*treeprotocolv1.DeleteNodeResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*treeprotocolv1.DeleteNodeResponse, error) {
+		return treeprotocolv1.NewTreeClient(conn).DeleteNode(ctx, &treeprotocolv1.DeleteNodeRequest{
+			Headers: headers,
+			DeleteNodeInput: &treeprotocolv1.DeleteNodeInput{
+				Path:        request.Path,
+				PrevVersion: request.PrevVersion,
+				Recursive:   request.Recursive,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("DeleteNode",
+			logging.Trunc128("DeleteNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("DeleteNode",
+			logging.Trunc128("DeleteNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treev1.DeleteNodeResponse{
+		Node: newNode(output.Node),
+	}
+	log.Debugw("DeleteNode",
+		logging.Trunc128("DeleteNodeRequest", request),
+		logging.Trunc128("DeleteNodeResponse", response))
+	return response, nil
+}
+
+func (s *TreeSession) GetChildren(ctx context.Context, request *treev1.GetChildrenRequest) (*treev1.GetChildrenResponse, error) {
+	log.Debugw("GetChildren",
+		logging.Trunc128("GetChildrenRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("GetChildren",
+			logging.Trunc128("GetChildrenRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("GetChildren",
+			logging.Trunc128("GetChildrenRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Query[*treeprotocolv1.GetChildrenResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*treeprotocolv1.GetChildrenResponse, error) {
+		return treeprotocolv1.NewTreeClient(conn).GetChildren(ctx, &treeprotocolv1.GetChildrenRequest{
+			Headers: headers,
+			GetChildrenInput: &treeprotocolv1.GetChildrenInput{
+				Path: request.Path,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("GetChildren",
+			logging.Trunc128("GetChildrenRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("GetChildren",
+			logging.Trunc128("GetChildrenRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treev1.GetChildrenResponse{
+		Children: output.Children,
+	}
+	log.Debugw("GetChildren",
+		logging.Trunc128("GetChildrenRequest", request),
+		logging.Trunc128("GetChildrenResponse", response))
+	return response, nil
+}
+
+func (s *TreeSession) Watch(request *treev1.WatchRequest, server treev1.Tree_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc128("WatchRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	query := client.StreamQuery[*treeprotocolv1.WatchResponse](primitive)
+	stream, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (client.QueryStream[*treeprotocolv1.WatchResponse], error) {
+		return treeprotocolv1.NewTreeClient(conn).Watch(server.Context(), &treeprotocolv1.WatchRequest{
+			Headers: headers,
+			WatchInput: &treeprotocolv1.WatchInput{
+				Path:      request.Path,
+				Recursive: request.Recursive,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if !ok {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &treev1.WatchResponse{
+			Event: newEvent(output.Event),
+		}
+		log.Debugw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Trunc128("WatchResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Trunc128("WatchResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func newNode(node treeprotocolv1.Node) treev1.Node {
+	return treev1.Node{
+		Path:        node.Path,
+		Value:       node.Value,
+		Version:     node.Version,
+		Ephemeral:   node.Ephemeral,
+		NumChildren: node.NumChildren,
+	}
+}
+
+func newEvent(event treeprotocolv1.Event) treev1.Event {
+	switch e := event.Event.(type) {
+	case *treeprotocolv1.Event_Created_:
+		return treev1.Event{
+			Path: event.Path,
+			Event: &treev1.Event_Created_{
+				Created: &treev1.Event_Created{
+					Node: newNode(e.Created.Node),
+				},
+			},
+		}
+	case *treeprotocolv1.Event_Updated_:
+		return treev1.Event{
+			Path: event.Path,
+			Event: &treev1.Event_Updated_{
+				Updated: &treev1.Event_Updated{
+					Node:     newNode(e.Updated.Node),
+					PrevNode: newNode(e.Updated.PrevNode),
+				},
+			},
+		}
+	case *treeprotocolv1.Event_Deleted_:
+		return treev1.Event{
+			Path: event.Path,
+			Event: &treev1.Event_Deleted_{
+				Deleted: &treev1.Event_Deleted{
+					Node:    newNode(e.Deleted.Node),
+					Expired: e.Deleted.Expired,
+				},
+			},
+		}
+	}
+	return treev1.Event{
+		Path: event.Path,
+	}
+}
+
+var _ runtimetreev1.TreeProxy = (*TreeSession)(nil)
@@ -100,6 +100,7 @@ func (s *ValueSession) Set(ctx context.Context, request *valuev1.SetRequest) (*v
 			Headers: headers,
 			SetInput: &valueprotocolv1.SetInput{
 				Value: request.Value,
+				Patch: newPatch(request.Patch),
 			},
 		})
 	})
@@ -191,8 +192,10 @@ func (s *ValueSession) Get(ctx context.Context, request *valuev1.GetRequest) (*v
 	command := client.Query[*valueprotocolv1.GetResponse](primitive)
 	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (*valueprotocolv1.GetResponse, error) {
 		return valueprotocolv1.NewValueClient(conn).Get(ctx, &valueprotocolv1.GetRequest{
-			Headers:  headers,
-			GetInput: &valueprotocolv1.GetInput{},
+			Headers: headers,
+			GetInput: &valueprotocolv1.GetInput{
+				Paths: request.Paths,
+			},
 		})
 	})
 	if !ok {
@@ -372,7 +375,9 @@ func (s *ValueSession) Events(request *valuev1.EventsRequest, server valuev1.Val
 			return err
 		}
 		response := &valuev1.EventsResponse{
-			Event: valuev1.Event{},
+			Event: valuev1.Event{
+				Patch: newEventPatch(output.Event.Patch),
+			},
 		}
 		switch e := output.Event.Event.(type) {
 		case *valueprotocolv1.Event_Created_:
@@ -487,4 +492,48 @@ func (s *ValueSession) Watch(request *valuev1.WatchRequest, server valuev1.Value
 	}
 }
 
+func newPatch(patch *valuev1.Patch) *valueprotocolv1.Patch {
+	if patch == nil {
+		return nil
+	}
+	switch p := patch.Patch.(type) {
+	case *valuev1.Patch_JsonPatch:
+		return &valueprotocolv1.Patch{
+			Patch: &valueprotocolv1.Patch_JsonPatch{
+				JsonPatch: p.JsonPatch,
+			},
+		}
+	case *valuev1.Patch_MergePatch:
+		return &valueprotocolv1.Patch{
+			Patch: &valueprotocolv1.Patch_MergePatch{
+				MergePatch: p.MergePatch,
+			},
+		}
+	default:
+		return &valueprotocolv1.Patch{}
+	}
+}
+
+func newEventPatch(patch *valueprotocolv1.Patch) *valuev1.Patch {
+	if patch == nil {
+		return nil
+	}
+	switch p := patch.Patch.(type) {
+	case *valueprotocolv1.Patch_JsonPatch:
+		return &valuev1.Patch{
+			Patch: &valuev1.Patch_JsonPatch{
+				JsonPatch: p.JsonPatch,
+			},
+		}
+	case *valueprotocolv1.Patch_MergePatch:
+		return &valuev1.Patch{
+			Patch: &valuev1.Patch_MergePatch{
+				MergePatch: p.MergePatch,
+			},
+		}
+	default:
+		return nil
+	}
+}
+
 var _ runtimevaluev1.ValueProxy = (*ValueSession)(nil)
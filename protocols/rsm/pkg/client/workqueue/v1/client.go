@@ -0,0 +1,370 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	workqueuev1 "github.com/atomix/atomix/api/runtime/workqueue/v1"
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	workqueueprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/workqueue/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/client"
+	runtimeworkqueuev1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/workqueue/v1"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func NewWorkQueue(protocol *client.Protocol, id runtimev1.PrimitiveID, config *workqueuev1.Config) *WorkQueueSession {
+	return &WorkQueueSession{
+		Protocol: protocol,
+		id:       id,
+		config:   config,
+	}
+}
+
+type WorkQueueSession struct {
+	*client.Protocol
+	id     runtimev1.PrimitiveID
+	config *workqueuev1.Config
+}
+
+func (s *WorkQueueSession) Open(ctx context.Context) error {
+	log.Debugw("Create",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	meta := runtimev1.PrimitiveMeta{
+		Type:        workqueuev1.PrimitiveType,
+		PrimitiveID: s.id,
+	}
+	if err := session.CreatePrimitive(ctx, meta); err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if s.config == nil {
+		return nil
+	}
+
+	// Apply the delivery policy from the primitive configuration
+	primitive, err := session.GetPrimitive(s.id.Name)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	command := client.Proposal[*workqueueprotocolv1.ConfigureResponse](primitive)
+	_, _, err = command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*workqueueprotocolv1.ConfigureResponse, error) {
+		return workqueueprotocolv1.NewWorkQueueClient(conn).Configure(ctx, &workqueueprotocolv1.ConfigureRequest{
+			Headers: headers,
+			ConfigureInput: &workqueueprotocolv1.ConfigureInput{
+				MaxDeliveries: s.config.MaxDeliveries,
+			},
+		})
+	})
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *WorkQueueSession) Close(ctx context.Context) error {
+	log.Debugw("Close",
+		logging.String("Name", s.id.Name))
+	partition := s.PartitionBy([]byte(s.id.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Create",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	if err := session.ClosePrimitive(ctx, s.id.Name); err != nil {
+		log.Warnw("Close",
+			logging.String("Name", s.id.Name),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *WorkQueueSession) Enqueue(ctx context.Context, request *workqueuev1.EnqueueRequest) (*workqueuev1.EnqueueResponse, error) {
+	log.Debugw("Enqueue",
+		logging.Trunc128("EnqueueRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Enqueue",
+			logging.Trunc128("EnqueueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Enqueue",
+			logging.Trunc128("EnqueueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*workqueueprotocolv1.EnqueueResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*workqueueprotocolv1.EnqueueResponse, error) {
+		return workqueueprotocolv1.NewWorkQueueClient(conn).Enqueue(ctx, &workqueueprotocolv1.EnqueueRequest{
+			Headers: headers,
+			EnqueueInput: &workqueueprotocolv1.EnqueueInput{
+				Payload: request.Payload,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Enqueue",
+			logging.Trunc128("EnqueueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Enqueue",
+			logging.Trunc128("EnqueueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueuev1.EnqueueResponse{
+		TaskID: output.TaskID,
+	}
+	log.Debugw("Enqueue",
+		logging.Trunc128("EnqueueRequest", request),
+		logging.Trunc128("EnqueueResponse", response))
+	return response, nil
+}
+
+func (s *WorkQueueSession) Dequeue(ctx context.Context, request *workqueuev1.DequeueRequest) (*workqueuev1.DequeueResponse, error) {
+	log.Debugw("Dequeue",
+		logging.Trunc128("DequeueRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Dequeue",
+			logging.Trunc128("DequeueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Dequeue",
+			logging.Trunc128("DequeueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*workqueueprotocolv1.DequeueResponse](primitive)
+	output, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*workqueueprotocolv1.DequeueResponse, error) {
+		return workqueueprotocolv1.NewWorkQueueClient(conn).Dequeue(ctx, &workqueueprotocolv1.DequeueRequest{
+			Headers: headers,
+			DequeueInput: &workqueueprotocolv1.DequeueInput{
+				Lease:   request.Lease,
+				Timeout: request.Timeout,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Dequeue",
+			logging.Trunc128("DequeueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Dequeue",
+			logging.Trunc128("DequeueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueuev1.DequeueResponse{
+		Task: newTask(output.Task),
+	}
+	log.Debugw("Dequeue",
+		logging.Trunc128("DequeueRequest", request),
+		logging.Trunc128("DequeueResponse", response))
+	return response, nil
+}
+
+func (s *WorkQueueSession) Ack(ctx context.Context, request *workqueuev1.AckRequest) (*workqueuev1.AckResponse, error) {
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*workqueueprotocolv1.AckResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*workqueueprotocolv1.AckResponse, error) {
+		return workqueueprotocolv1.NewWorkQueueClient(conn).Ack(ctx, &workqueueprotocolv1.AckRequest{
+			Headers: headers,
+			AckInput: &workqueueprotocolv1.AckInput{
+				TaskID:     request.TaskID,
+				DeliveryID: request.DeliveryID,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueuev1.AckResponse{}
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request),
+		logging.Trunc128("AckResponse", response))
+	return response, nil
+}
+
+func (s *WorkQueueSession) Nack(ctx context.Context, request *workqueuev1.NackRequest) (*workqueuev1.NackResponse, error) {
+	log.Debugw("Nack",
+		logging.Trunc128("NackRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(ctx)
+	if err != nil {
+		log.Warnw("Nack",
+			logging.Trunc128("NackRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("Nack",
+			logging.Trunc128("NackRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	command := client.Proposal[*workqueueprotocolv1.NackResponse](primitive)
+	_, ok, err := command.Run(func(conn *grpc.ClientConn, headers *protocol.ProposalRequestHeaders) (*workqueueprotocolv1.NackResponse, error) {
+		return workqueueprotocolv1.NewWorkQueueClient(conn).Nack(ctx, &workqueueprotocolv1.NackRequest{
+			Headers: headers,
+			NackInput: &workqueueprotocolv1.NackInput{
+				TaskID:     request.TaskID,
+				DeliveryID: request.DeliveryID,
+			},
+		})
+	})
+	if !ok {
+		log.Warnw("Nack",
+			logging.Trunc128("NackRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	} else if err != nil {
+		log.Debugw("Nack",
+			logging.Trunc128("NackRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueuev1.NackResponse{}
+	log.Debugw("Nack",
+		logging.Trunc128("NackRequest", request),
+		logging.Trunc128("NackResponse", response))
+	return response, nil
+}
+
+func (s *WorkQueueSession) DeadLetters(request *workqueuev1.DeadLettersRequest, server workqueuev1.WorkQueue_DeadLettersServer) error {
+	log.Debugw("DeadLetters",
+		logging.Trunc128("DeadLettersRequest", request))
+	partition := s.PartitionBy([]byte(request.ID.Name))
+	session, err := partition.GetSession(server.Context())
+	if err != nil {
+		log.Warnw("DeadLetters",
+			logging.Trunc128("DeadLettersRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	primitive, err := session.GetPrimitive(request.ID.Name)
+	if err != nil {
+		log.Warnw("DeadLetters",
+			logging.Trunc128("DeadLettersRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	query := client.StreamQuery[*workqueueprotocolv1.DeadLettersResponse](primitive)
+	stream, err := query.Run(func(conn *grpc.ClientConn, headers *protocol.QueryRequestHeaders) (client.QueryStream[*workqueueprotocolv1.DeadLettersResponse], error) {
+		return workqueueprotocolv1.NewWorkQueueClient(conn).DeadLetters(server.Context(), &workqueueprotocolv1.DeadLettersRequest{
+			Headers:          headers,
+			DeadLettersInput: &workqueueprotocolv1.DeadLettersInput{},
+		})
+	})
+	if err != nil {
+		log.Warnw("DeadLetters",
+			logging.Trunc128("DeadLettersRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	for {
+		output, ok, err := stream.Recv()
+		if err == io.EOF {
+			log.Debugw("DeadLetters",
+				logging.Trunc128("DeadLettersRequest", request),
+				logging.String("State", "Done"))
+			return nil
+		}
+		if !ok {
+			log.Warnw("DeadLetters",
+				logging.Trunc128("DeadLettersRequest", request),
+				logging.Error("Error", err))
+			return err
+		} else if err != nil {
+			log.Debugw("DeadLetters",
+				logging.Trunc128("DeadLettersRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+		response := &workqueuev1.DeadLettersResponse{
+			Task: newTask(output.Task),
+		}
+		log.Debugw("DeadLetters",
+			logging.Trunc128("DeadLettersRequest", request),
+			logging.Trunc128("DeadLettersResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("DeadLetters",
+				logging.Trunc128("DeadLettersRequest", request),
+				logging.Trunc128("DeadLettersResponse", response),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func newTask(task workqueueprotocolv1.Task) workqueuev1.Task {
+	return workqueuev1.Task{
+		ID:         task.ID,
+		Payload:    task.Payload,
+		Deliveries: task.Deliveries,
+		DeliveryID: task.DeliveryID,
+	}
+}
+
+var _ runtimeworkqueuev1.WorkQueueProxy = (*WorkQueueSession)(nil)
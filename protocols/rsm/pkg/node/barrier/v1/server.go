@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	barrierprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/barrier/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		barrierprotocolv1.RegisterBarrierServer(server, NewBarrierServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput](
+	func(input *barrierprotocolv1.BarrierInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*barrierprotocolv1.BarrierOutput, error) {
+		output := &barrierprotocolv1.BarrierOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewBarrierServer(protocol node.Protocol) barrierprotocolv1.BarrierServer {
+	return &barrierServer{
+		handler: node.NewHandler[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput](protocol, serverCodec),
+	}
+}
+
+type barrierServer struct {
+	handler node.Handler[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]
+}
+
+func (s *barrierServer) Await(ctx context.Context, request *barrierprotocolv1.AwaitRequest) (*barrierprotocolv1.AwaitResponse, error) {
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request))
+	input := &barrierprotocolv1.BarrierInput{
+		Input: &barrierprotocolv1.BarrierInput_Await{
+			Await: request.AwaitInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierprotocolv1.AwaitResponse{
+		Headers:     headers,
+		AwaitOutput: output.GetAwait(),
+	}
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request),
+		logging.Trunc128("AwaitResponse", response))
+	return response, nil
+}
+
+func (s *barrierServer) Reset(ctx context.Context, request *barrierprotocolv1.ResetRequest) (*barrierprotocolv1.ResetResponse, error) {
+	log.Debugw("Reset",
+		logging.Trunc128("ResetRequest", request))
+	input := &barrierprotocolv1.BarrierInput{
+		Input: &barrierprotocolv1.BarrierInput_Reset_{
+			Reset_: request.ResetInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Reset",
+			logging.Trunc128("ResetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierprotocolv1.ResetResponse{
+		Headers:     headers,
+		ResetOutput: output.GetReset_(),
+	}
+	log.Debugw("Reset",
+		logging.Trunc128("ResetRequest", request),
+		logging.Trunc128("ResetResponse", response))
+	return response, nil
+}
+
+func (s *barrierServer) Get(ctx context.Context, request *barrierprotocolv1.GetRequest) (*barrierprotocolv1.GetResponse, error) {
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request))
+	input := &barrierprotocolv1.BarrierInput{
+		Input: &barrierprotocolv1.BarrierInput_Get{
+			Get: request.GetInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierprotocolv1.GetResponse{
+		Headers:   headers,
+		GetOutput: output.GetGet(),
+	}
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request),
+		logging.Trunc128("GetResponse", response))
+	return response, nil
+}
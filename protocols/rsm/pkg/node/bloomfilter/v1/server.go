@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	bloomfilterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/bloomfilter/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		bloomfilterprotocolv1.RegisterBloomFilterServer(server, NewBloomFilterServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput](
+	func(input *bloomfilterprotocolv1.BloomFilterInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*bloomfilterprotocolv1.BloomFilterOutput, error) {
+		output := &bloomfilterprotocolv1.BloomFilterOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewBloomFilterServer(protocol node.Protocol) bloomfilterprotocolv1.BloomFilterServer {
+	return &bloomFilterServer{
+		handler: node.NewHandler[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput](protocol, serverCodec),
+	}
+}
+
+type bloomFilterServer struct {
+	handler node.Handler[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput]
+}
+
+func (s *bloomFilterServer) Configure(ctx context.Context, request *bloomfilterprotocolv1.ConfigureRequest) (*bloomfilterprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &bloomfilterprotocolv1.BloomFilterInput{
+		Input: &bloomfilterprotocolv1.BloomFilterInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &bloomfilterprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *bloomFilterServer) Add(ctx context.Context, request *bloomfilterprotocolv1.AddRequest) (*bloomfilterprotocolv1.AddResponse, error) {
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request))
+	input := &bloomfilterprotocolv1.BloomFilterInput{
+		Input: &bloomfilterprotocolv1.BloomFilterInput_Add{
+			Add: request.AddInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Add",
+			logging.Trunc128("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &bloomfilterprotocolv1.AddResponse{
+		Headers:   headers,
+		AddOutput: output.GetAdd(),
+	}
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request),
+		logging.Trunc128("AddResponse", response))
+	return response, nil
+}
+
+func (s *bloomFilterServer) MightContain(ctx context.Context, request *bloomfilterprotocolv1.MightContainRequest) (*bloomfilterprotocolv1.MightContainResponse, error) {
+	log.Debugw("MightContain",
+		logging.Trunc128("MightContainRequest", request))
+	input := &bloomfilterprotocolv1.BloomFilterInput{
+		Input: &bloomfilterprotocolv1.BloomFilterInput_MightContain{
+			MightContain: request.MightContainInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("MightContain",
+			logging.Trunc128("MightContainRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &bloomfilterprotocolv1.MightContainResponse{
+		Headers:            headers,
+		MightContainOutput: output.GetMightContain(),
+	}
+	log.Debugw("MightContain",
+		logging.Trunc128("MightContainRequest", request),
+		logging.Trunc128("MightContainResponse", response))
+	return response, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	cardinalityprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/cardinality/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		cardinalityprotocolv1.RegisterCardinalityServer(server, NewCardinalityServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput](
+	func(input *cardinalityprotocolv1.CardinalityInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*cardinalityprotocolv1.CardinalityOutput, error) {
+		output := &cardinalityprotocolv1.CardinalityOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewCardinalityServer(protocol node.Protocol) cardinalityprotocolv1.CardinalityServer {
+	return &cardinalityServer{
+		handler: node.NewHandler[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput](protocol, serverCodec),
+	}
+}
+
+type cardinalityServer struct {
+	handler node.Handler[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput]
+}
+
+func (s *cardinalityServer) Configure(ctx context.Context, request *cardinalityprotocolv1.ConfigureRequest) (*cardinalityprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &cardinalityprotocolv1.CardinalityInput{
+		Input: &cardinalityprotocolv1.CardinalityInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *cardinalityServer) Add(ctx context.Context, request *cardinalityprotocolv1.AddRequest) (*cardinalityprotocolv1.AddResponse, error) {
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request))
+	input := &cardinalityprotocolv1.CardinalityInput{
+		Input: &cardinalityprotocolv1.CardinalityInput_Add{
+			Add: request.AddInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Add",
+			logging.Trunc128("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityprotocolv1.AddResponse{
+		Headers:   headers,
+		AddOutput: output.GetAdd(),
+	}
+	log.Debugw("Add",
+		logging.Trunc128("AddRequest", request),
+		logging.Trunc128("AddResponse", response))
+	return response, nil
+}
+
+func (s *cardinalityServer) Merge(ctx context.Context, request *cardinalityprotocolv1.MergeRequest) (*cardinalityprotocolv1.MergeResponse, error) {
+	log.Debugw("Merge",
+		logging.Trunc128("MergeRequest", request))
+	input := &cardinalityprotocolv1.CardinalityInput{
+		Input: &cardinalityprotocolv1.CardinalityInput_Merge{
+			Merge: request.MergeInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Merge",
+			logging.Trunc128("MergeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityprotocolv1.MergeResponse{
+		Headers:     headers,
+		MergeOutput: output.GetMerge(),
+	}
+	log.Debugw("Merge",
+		logging.Trunc128("MergeRequest", request),
+		logging.Trunc128("MergeResponse", response))
+	return response, nil
+}
+
+func (s *cardinalityServer) GetSketch(ctx context.Context, request *cardinalityprotocolv1.GetSketchRequest) (*cardinalityprotocolv1.GetSketchResponse, error) {
+	log.Debugw("GetSketch",
+		logging.Trunc128("GetSketchRequest", request))
+	input := &cardinalityprotocolv1.CardinalityInput{
+		Input: &cardinalityprotocolv1.CardinalityInput_GetSketch{
+			GetSketch: request.GetSketchInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("GetSketch",
+			logging.Trunc128("GetSketchRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityprotocolv1.GetSketchResponse{
+		Headers:         headers,
+		GetSketchOutput: output.GetGetSketch(),
+	}
+	log.Debugw("GetSketch",
+		logging.Trunc128("GetSketchRequest", request),
+		logging.Trunc128("GetSketchResponse", response))
+	return response, nil
+}
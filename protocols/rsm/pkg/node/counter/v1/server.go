@@ -168,3 +168,28 @@ func (s *counterServer) Decrement(ctx context.Context, request *counterprotocolv
 		logging.Trunc128("DecrementResponse", response))
 	return response, nil
 }
+
+func (s *counterServer) SetBounds(ctx context.Context, request *counterprotocolv1.SetBoundsRequest) (*counterprotocolv1.SetBoundsResponse, error) {
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request))
+	input := &counterprotocolv1.CounterInput{
+		Input: &counterprotocolv1.CounterInput_SetBounds{
+			SetBounds: request.SetBoundsInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &counterprotocolv1.SetBoundsResponse{
+		Headers:         headers,
+		SetBoundsOutput: output.GetSetBounds(),
+	}
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request),
+		logging.Trunc128("SetBoundsResponse", response))
+	return response, nil
+}
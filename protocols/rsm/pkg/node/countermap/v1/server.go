@@ -195,6 +195,56 @@ func (s *counterMapServer) Decrement(ctx context.Context, request *countermappro
 	return response, nil
 }
 
+func (s *counterMapServer) Configure(ctx context.Context, request *countermapprotocolv1.ConfigureRequest) (*countermapprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &countermapprotocolv1.CounterMapInput{
+		Input: &countermapprotocolv1.CounterMapInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &countermapprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *counterMapServer) SetBounds(ctx context.Context, request *countermapprotocolv1.SetBoundsRequest) (*countermapprotocolv1.SetBoundsResponse, error) {
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request))
+	input := &countermapprotocolv1.CounterMapInput{
+		Input: &countermapprotocolv1.CounterMapInput_SetBounds{
+			SetBounds: request.SetBoundsInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc128("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &countermapprotocolv1.SetBoundsResponse{
+		Headers:         headers,
+		SetBoundsOutput: output.GetSetBounds(),
+	}
+	log.Debugw("SetBounds",
+		logging.Trunc128("SetBoundsRequest", request),
+		logging.Trunc128("SetBoundsResponse", response))
+	return response, nil
+}
+
 func (s *counterMapServer) Get(ctx context.Context, request *countermapprotocolv1.GetRequest) (*countermapprotocolv1.GetResponse, error) {
 	log.Debugw("Get",
 		logging.Trunc128("GetRequest", request))
@@ -220,6 +270,81 @@ func (s *counterMapServer) Get(ctx context.Context, request *countermapprotocolv
 	return response, nil
 }
 
+func (s *counterMapServer) TopK(ctx context.Context, request *countermapprotocolv1.TopKRequest) (*countermapprotocolv1.TopKResponse, error) {
+	log.Debugw("TopK",
+		logging.Trunc128("TopKRequest", request))
+	input := &countermapprotocolv1.CounterMapInput{
+		Input: &countermapprotocolv1.CounterMapInput_TopK{
+			TopK: request.TopKInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("TopK",
+			logging.Trunc128("TopKRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &countermapprotocolv1.TopKResponse{
+		Headers:    headers,
+		TopKOutput: output.GetTopK(),
+	}
+	log.Debugw("TopK",
+		logging.Trunc128("TopKRequest", request),
+		logging.Trunc128("TopKResponse", response))
+	return response, nil
+}
+
+func (s *counterMapServer) BottomK(ctx context.Context, request *countermapprotocolv1.BottomKRequest) (*countermapprotocolv1.BottomKResponse, error) {
+	log.Debugw("BottomK",
+		logging.Trunc128("BottomKRequest", request))
+	input := &countermapprotocolv1.CounterMapInput{
+		Input: &countermapprotocolv1.CounterMapInput_BottomK{
+			BottomK: request.BottomKInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("BottomK",
+			logging.Trunc128("BottomKRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &countermapprotocolv1.BottomKResponse{
+		Headers:       headers,
+		BottomKOutput: output.GetBottomK(),
+	}
+	log.Debugw("BottomK",
+		logging.Trunc128("BottomKRequest", request),
+		logging.Trunc128("BottomKResponse", response))
+	return response, nil
+}
+
+func (s *counterMapServer) RangeByValue(ctx context.Context, request *countermapprotocolv1.RangeByValueRequest) (*countermapprotocolv1.RangeByValueResponse, error) {
+	log.Debugw("RangeByValue",
+		logging.Trunc128("RangeByValueRequest", request))
+	input := &countermapprotocolv1.CounterMapInput{
+		Input: &countermapprotocolv1.CounterMapInput_RangeByValue{
+			RangeByValue: request.RangeByValueInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("RangeByValue",
+			logging.Trunc128("RangeByValueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &countermapprotocolv1.RangeByValueResponse{
+		Headers:            headers,
+		RangeByValueOutput: output.GetRangeByValue(),
+	}
+	log.Debugw("RangeByValue",
+		logging.Trunc128("RangeByValueRequest", request),
+		logging.Trunc128("RangeByValueResponse", response))
+	return response, nil
+}
+
 func (s *counterMapServer) Remove(ctx context.Context, request *countermapprotocolv1.RemoveRequest) (*countermapprotocolv1.RemoveResponse, error) {
 	log.Debugw("Remove",
 		logging.Trunc128("RemoveRequest", request))
@@ -311,6 +311,8 @@ func getHeaderStatus(failure *protocol.Failure) protocol.CallResponseHeaders_Sta
 		return protocol.CallResponseHeaders_INTERNAL
 	case protocol.Failure_FAULT:
 		return protocol.CallResponseHeaders_FAULT
+	case protocol.Failure_OUT_OF_RANGE:
+		return protocol.CallResponseHeaders_OUT_OF_RANGE
 	default:
 		return protocol.CallResponseHeaders_UNKNOWN
 	}
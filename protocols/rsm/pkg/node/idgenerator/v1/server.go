@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	idgeneratorprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/idgenerator/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		idgeneratorprotocolv1.RegisterIDGeneratorServer(server, NewIDGeneratorServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput](
+	func(input *idgeneratorprotocolv1.IDGeneratorInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*idgeneratorprotocolv1.IDGeneratorOutput, error) {
+		output := &idgeneratorprotocolv1.IDGeneratorOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewIDGeneratorServer(protocol node.Protocol) idgeneratorprotocolv1.IDGeneratorServer {
+	return &idGeneratorServer{
+		handler: node.NewHandler[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput](protocol, serverCodec),
+	}
+}
+
+type idGeneratorServer struct {
+	handler node.Handler[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput]
+}
+
+func (s *idGeneratorServer) Allocate(ctx context.Context, request *idgeneratorprotocolv1.AllocateRequest) (*idgeneratorprotocolv1.AllocateResponse, error) {
+	log.Debugw("Allocate",
+		logging.Trunc128("AllocateRequest", request))
+	input := &idgeneratorprotocolv1.IDGeneratorInput{
+		Input: &idgeneratorprotocolv1.IDGeneratorInput_Allocate{
+			Allocate: request.AllocateInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Allocate",
+			logging.Trunc128("AllocateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &idgeneratorprotocolv1.AllocateResponse{
+		Headers:        headers,
+		AllocateOutput: output.GetAllocate(),
+	}
+	log.Debugw("Allocate",
+		logging.Trunc128("AllocateRequest", request),
+		logging.Trunc128("AllocateResponse", response))
+	return response, nil
+}
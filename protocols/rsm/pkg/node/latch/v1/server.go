@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	latchprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/latch/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		latchprotocolv1.RegisterLatchServer(server, NewLatchServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput](
+	func(input *latchprotocolv1.LatchInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*latchprotocolv1.LatchOutput, error) {
+		output := &latchprotocolv1.LatchOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewLatchServer(protocol node.Protocol) latchprotocolv1.LatchServer {
+	return &latchServer{
+		handler: node.NewHandler[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput](protocol, serverCodec),
+	}
+}
+
+type latchServer struct {
+	handler node.Handler[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]
+}
+
+func (s *latchServer) Configure(ctx context.Context, request *latchprotocolv1.ConfigureRequest) (*latchprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &latchprotocolv1.LatchInput{
+		Input: &latchprotocolv1.LatchInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *latchServer) CountDown(ctx context.Context, request *latchprotocolv1.CountDownRequest) (*latchprotocolv1.CountDownResponse, error) {
+	log.Debugw("CountDown",
+		logging.Trunc128("CountDownRequest", request))
+	input := &latchprotocolv1.LatchInput{
+		Input: &latchprotocolv1.LatchInput_CountDown{
+			CountDown: request.CountDownInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("CountDown",
+			logging.Trunc128("CountDownRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchprotocolv1.CountDownResponse{
+		Headers:         headers,
+		CountDownOutput: output.GetCountDown(),
+	}
+	log.Debugw("CountDown",
+		logging.Trunc128("CountDownRequest", request),
+		logging.Trunc128("CountDownResponse", response))
+	return response, nil
+}
+
+func (s *latchServer) Await(ctx context.Context, request *latchprotocolv1.AwaitRequest) (*latchprotocolv1.AwaitResponse, error) {
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request))
+	input := &latchprotocolv1.LatchInput{
+		Input: &latchprotocolv1.LatchInput_Await{
+			Await: request.AwaitInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc128("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchprotocolv1.AwaitResponse{
+		Headers:     headers,
+		AwaitOutput: output.GetAwait(),
+	}
+	log.Debugw("Await",
+		logging.Trunc128("AwaitRequest", request),
+		logging.Trunc128("AwaitResponse", response))
+	return response, nil
+}
+
+func (s *latchServer) Get(ctx context.Context, request *latchprotocolv1.GetRequest) (*latchprotocolv1.GetResponse, error) {
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request))
+	input := &latchprotocolv1.LatchInput{
+		Input: &latchprotocolv1.LatchInput_Get{
+			Get: request.GetInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchprotocolv1.GetResponse{
+		Headers:   headers,
+		GetOutput: output.GetGet(),
+	}
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request),
+		logging.Trunc128("GetResponse", response))
+	return response, nil
+}
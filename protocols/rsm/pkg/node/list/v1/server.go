@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	"github.com/gogo/protobuf/proto"
+	listprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/list/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		listprotocolv1.RegisterListServer(server, NewListServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*listprotocolv1.ListInput, *listprotocolv1.ListOutput](
+	func(input *listprotocolv1.ListInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*listprotocolv1.ListOutput, error) {
+		output := &listprotocolv1.ListOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewListServer(protocol node.Protocol) listprotocolv1.ListServer {
+	return &listServer{
+		handler: node.NewHandler[*listprotocolv1.ListInput, *listprotocolv1.ListOutput](protocol, serverCodec),
+	}
+}
+
+type listServer struct {
+	handler node.Handler[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]
+}
+
+func (s *listServer) Size(ctx context.Context, request *listprotocolv1.SizeRequest) (*listprotocolv1.SizeResponse, error) {
+	log.Debugw("Size",
+		logging.Trunc128("SizeRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Size_{
+			Size_: request.SizeInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Size",
+			logging.Trunc128("SizeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listprotocolv1.SizeResponse{
+		Headers:    headers,
+		SizeOutput: output.GetSize_(),
+	}
+	log.Debugw("Size",
+		logging.Trunc128("SizeRequest", request),
+		logging.Trunc128("SizeResponse", response))
+	return response, nil
+}
+
+func (s *listServer) Append(ctx context.Context, request *listprotocolv1.AppendRequest) (*listprotocolv1.AppendResponse, error) {
+	log.Debugw("Append",
+		logging.Trunc128("AppendRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Append{
+			Append: request.AppendInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Append",
+			logging.Trunc128("AppendRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listprotocolv1.AppendResponse{
+		Headers:      headers,
+		AppendOutput: output.GetAppend(),
+	}
+	log.Debugw("Append",
+		logging.Trunc128("AppendRequest", request),
+		logging.Trunc128("AppendResponse", response))
+	return response, nil
+}
+
+func (s *listServer) Insert(ctx context.Context, request *listprotocolv1.InsertRequest) (*listprotocolv1.InsertResponse, error) {
+	log.Debugw("Insert",
+		logging.Trunc128("InsertRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Insert{
+			Insert: request.InsertInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Insert",
+			logging.Trunc128("InsertRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listprotocolv1.InsertResponse{
+		Headers:      headers,
+		InsertOutput: output.GetInsert(),
+	}
+	log.Debugw("Insert",
+		logging.Trunc128("InsertRequest", request),
+		logging.Trunc128("InsertResponse", response))
+	return response, nil
+}
+
+func (s *listServer) Get(ctx context.Context, request *listprotocolv1.GetRequest) (*listprotocolv1.GetResponse, error) {
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Get{
+			Get: request.GetInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listprotocolv1.GetResponse{
+		Headers:   headers,
+		GetOutput: output.GetGet(),
+	}
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request),
+		logging.Trunc128("GetResponse", response))
+	return response, nil
+}
+
+func (s *listServer) Set(ctx context.Context, request *listprotocolv1.SetRequest) (*listprotocolv1.SetResponse, error) {
+	log.Debugw("Set",
+		logging.Trunc128("SetRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Set{
+			Set: request.SetInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Set",
+			logging.Trunc128("SetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listprotocolv1.SetResponse{
+		Headers:   headers,
+		SetOutput: output.GetSet(),
+	}
+	log.Debugw("Set",
+		logging.Trunc128("SetRequest", request),
+		logging.Trunc128("SetResponse", response))
+	return response, nil
+}
+
+func (s *listServer) Remove(ctx context.Context, request *listprotocolv1.RemoveRequest) (*listprotocolv1.RemoveResponse, error) {
+	log.Debugw("Remove",
+		logging.Trunc128("RemoveRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Remove{
+			Remove: request.RemoveInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Remove",
+			logging.Trunc128("RemoveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listprotocolv1.RemoveResponse{
+		Headers:      headers,
+		RemoveOutput: output.GetRemove(),
+	}
+	log.Debugw("Remove",
+		logging.Trunc128("RemoveRequest", request),
+		logging.Trunc128("RemoveResponse", response))
+	return response, nil
+}
+
+func (s *listServer) Clear(ctx context.Context, request *listprotocolv1.ClearRequest) (*listprotocolv1.ClearResponse, error) {
+	log.Debugw("Clear",
+		logging.Trunc128("ClearRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Clear{
+			Clear: request.ClearInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Clear",
+			logging.Trunc128("ClearRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &listprotocolv1.ClearResponse{
+		Headers:     headers,
+		ClearOutput: output.GetClear(),
+	}
+	log.Debugw("Clear",
+		logging.Trunc128("ClearRequest", request),
+		logging.Trunc128("ClearResponse", response))
+	return response, nil
+}
+
+func (s *listServer) Events(request *listprotocolv1.EventsRequest, server listprotocolv1.List_EventsServer) error {
+	log.Debugw("Events",
+		logging.Trunc128("EventsRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Events{
+			Events: request.EventsInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamProposalResponse[*listprotocolv1.ListOutput]]()
+	go func() {
+		err := s.handler.StreamPropose(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("Events",
+				logging.Trunc128("EventsRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("Events",
+				logging.Trunc128("EventsRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &listprotocolv1.EventsResponse{
+			Headers:      result.Value.Headers,
+			EventsOutput: result.Value.Output.GetEvents(),
+		}
+		log.Debugw("Events",
+			logging.Trunc128("EventsRequest", request),
+			logging.Trunc128("EventsResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Events",
+				logging.Trunc128("EventsRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func (s *listServer) Items(request *listprotocolv1.ItemsRequest, server listprotocolv1.List_ItemsServer) error {
+	log.Debugw("Items",
+		logging.Trunc128("ItemsRequest", request))
+	input := &listprotocolv1.ListInput{
+		Input: &listprotocolv1.ListInput_Items{
+			Items: request.ItemsInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamQueryResponse[*listprotocolv1.ListOutput]]()
+	go func() {
+		err := s.handler.StreamQuery(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("Items",
+				logging.Trunc128("ItemsRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("Items",
+				logging.Trunc128("ItemsRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &listprotocolv1.ItemsResponse{
+			Headers:     result.Value.Headers,
+			ItemsOutput: result.Value.Output.GetItems(),
+		}
+		log.Debugw("Items",
+			logging.Trunc128("ItemsRequest", request),
+			logging.Trunc128("ItemsResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Items",
+				logging.Trunc128("ItemsRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
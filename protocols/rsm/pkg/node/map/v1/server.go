@@ -370,6 +370,156 @@ func (s *mapServer) Apply(ctx context.Context, request *mapprotocolv1.ApplyReque
 	return response, nil
 }
 
+func (s *mapServer) GetAll(ctx context.Context, request *mapprotocolv1.GetAllRequest) (*mapprotocolv1.GetAllResponse, error) {
+	log.Debugw("GetAll",
+		logging.Trunc128("GetAllRequest", request))
+	input := &mapprotocolv1.MapInput{
+		Input: &mapprotocolv1.MapInput_GetAll{
+			GetAll: request.GetAllInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("GetAll",
+			logging.Trunc128("GetAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &mapprotocolv1.GetAllResponse{
+		Headers:      headers,
+		GetAllOutput: output.GetGetAll(),
+	}
+	log.Debugw("GetAll",
+		logging.Trunc128("GetAllRequest", request),
+		logging.Trunc128("GetAllResponse", response))
+	return response, nil
+}
+
+func (s *mapServer) PutAll(ctx context.Context, request *mapprotocolv1.PutAllRequest) (*mapprotocolv1.PutAllResponse, error) {
+	log.Debugw("PutAll",
+		logging.Trunc128("PutAllRequest", request))
+	input := &mapprotocolv1.MapInput{
+		Input: &mapprotocolv1.MapInput_PutAll{
+			PutAll: request.PutAllInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("PutAll",
+			logging.Trunc128("PutAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &mapprotocolv1.PutAllResponse{
+		Headers:      headers,
+		PutAllOutput: output.GetPutAll(),
+	}
+	log.Debugw("PutAll",
+		logging.Trunc128("PutAllRequest", request),
+		logging.Trunc128("PutAllResponse", response))
+	return response, nil
+}
+
+func (s *mapServer) RemoveAll(ctx context.Context, request *mapprotocolv1.RemoveAllRequest) (*mapprotocolv1.RemoveAllResponse, error) {
+	log.Debugw("RemoveAll",
+		logging.Trunc128("RemoveAllRequest", request))
+	input := &mapprotocolv1.MapInput{
+		Input: &mapprotocolv1.MapInput_RemoveAll{
+			RemoveAll: request.RemoveAllInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("RemoveAll",
+			logging.Trunc128("RemoveAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &mapprotocolv1.RemoveAllResponse{
+		Headers:         headers,
+		RemoveAllOutput: output.GetRemoveAll(),
+	}
+	log.Debugw("RemoveAll",
+		logging.Trunc128("RemoveAllRequest", request),
+		logging.Trunc128("RemoveAllResponse", response))
+	return response, nil
+}
+
+func (s *mapServer) Configure(ctx context.Context, request *mapprotocolv1.ConfigureRequest) (*mapprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &mapprotocolv1.MapInput{
+		Input: &mapprotocolv1.MapInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &mapprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *mapServer) ValidateFence(ctx context.Context, request *mapprotocolv1.ValidateFenceRequest) (*mapprotocolv1.ValidateFenceResponse, error) {
+	log.Debugw("ValidateFence",
+		logging.Trunc128("ValidateFenceRequest", request))
+	input := &mapprotocolv1.MapInput{
+		Input: &mapprotocolv1.MapInput_ValidateFence{
+			ValidateFence: request.ValidateFenceInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("ValidateFence",
+			logging.Trunc128("ValidateFenceRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &mapprotocolv1.ValidateFenceResponse{
+		Headers:             headers,
+		ValidateFenceOutput: output.GetValidateFence(),
+	}
+	log.Debugw("ValidateFence",
+		logging.Trunc128("ValidateFenceRequest", request),
+		logging.Trunc128("ValidateFenceResponse", response))
+	return response, nil
+}
+
+func (s *mapServer) Query(ctx context.Context, request *mapprotocolv1.QueryRequest) (*mapprotocolv1.QueryResponse, error) {
+	log.Debugw("Query",
+		logging.Trunc128("QueryRequest", request))
+	input := &mapprotocolv1.MapInput{
+		Input: &mapprotocolv1.MapInput_Query{
+			Query: request.QueryInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Query",
+			logging.Trunc128("QueryRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &mapprotocolv1.QueryResponse{
+		Headers:     headers,
+		QueryOutput: output.GetQuery(),
+	}
+	log.Debugw("Query",
+		logging.Trunc128("QueryRequest", request),
+		logging.Trunc128("QueryResponse", response))
+	return response, nil
+}
+
 func (s *mapServer) Events(request *mapprotocolv1.EventsRequest, server mapprotocolv1.Map_EventsServer) error {
 	log.Debugw("Events",
 		logging.Trunc128("EventsRequest", request))
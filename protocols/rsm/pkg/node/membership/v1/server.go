@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	"github.com/gogo/protobuf/proto"
+	membershipprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/membership/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		membershipprotocolv1.RegisterMembershipServer(server, NewMembershipServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput](
+	func(input *membershipprotocolv1.MembershipInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*membershipprotocolv1.MembershipOutput, error) {
+		output := &membershipprotocolv1.MembershipOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewMembershipServer(protocol node.Protocol) membershipprotocolv1.MembershipServer {
+	return &membershipServer{
+		handler: node.NewHandler[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput](protocol, serverCodec),
+	}
+}
+
+type membershipServer struct {
+	handler node.Handler[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput]
+}
+
+func (s *membershipServer) Join(ctx context.Context, request *membershipprotocolv1.JoinRequest) (*membershipprotocolv1.JoinResponse, error) {
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request))
+	input := &membershipprotocolv1.MembershipInput{
+		Input: &membershipprotocolv1.MembershipInput_Join{
+			Join: request.JoinInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &membershipprotocolv1.JoinResponse{
+		Headers:    headers,
+		JoinOutput: output.GetJoin(),
+	}
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request),
+		logging.Trunc128("JoinResponse", response))
+	return response, nil
+}
+
+func (s *membershipServer) Leave(ctx context.Context, request *membershipprotocolv1.LeaveRequest) (*membershipprotocolv1.LeaveResponse, error) {
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request))
+	input := &membershipprotocolv1.MembershipInput{
+		Input: &membershipprotocolv1.MembershipInput_Leave{
+			Leave: request.LeaveInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &membershipprotocolv1.LeaveResponse{
+		Headers:     headers,
+		LeaveOutput: output.GetLeave(),
+	}
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request),
+		logging.Trunc128("LeaveResponse", response))
+	return response, nil
+}
+
+func (s *membershipServer) List(ctx context.Context, request *membershipprotocolv1.ListRequest) (*membershipprotocolv1.ListResponse, error) {
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request))
+	input := &membershipprotocolv1.MembershipInput{
+		Input: &membershipprotocolv1.MembershipInput_List{
+			List: request.ListInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &membershipprotocolv1.ListResponse{
+		Headers:    headers,
+		ListOutput: output.GetList(),
+	}
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request),
+		logging.Trunc128("ListResponse", response))
+	return response, nil
+}
+
+func (s *membershipServer) Watch(request *membershipprotocolv1.WatchRequest, server membershipprotocolv1.Membership_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc128("WatchRequest", request))
+	input := &membershipprotocolv1.MembershipInput{
+		Input: &membershipprotocolv1.MembershipInput_Watch{
+			Watch: request.WatchInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamQueryResponse[*membershipprotocolv1.MembershipOutput]]()
+	go func() {
+		err := s.handler.StreamQuery(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &membershipprotocolv1.WatchResponse{
+			Headers:     result.Value.Headers,
+			WatchOutput: result.Value.Output.GetWatch(),
+		}
+		log.Debugw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Trunc128("WatchResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
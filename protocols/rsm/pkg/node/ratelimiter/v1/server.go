@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	ratelimiterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/ratelimiter/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		ratelimiterprotocolv1.RegisterRateLimiterServer(server, NewRateLimiterServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput](
+	func(input *ratelimiterprotocolv1.RateLimiterInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*ratelimiterprotocolv1.RateLimiterOutput, error) {
+		output := &ratelimiterprotocolv1.RateLimiterOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewRateLimiterServer(protocol node.Protocol) ratelimiterprotocolv1.RateLimiterServer {
+	return &rateLimiterServer{
+		handler: node.NewHandler[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput](protocol, serverCodec),
+	}
+}
+
+type rateLimiterServer struct {
+	handler node.Handler[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]
+}
+
+func (s *rateLimiterServer) Configure(ctx context.Context, request *ratelimiterprotocolv1.ConfigureRequest) (*ratelimiterprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &ratelimiterprotocolv1.RateLimiterInput{
+		Input: &ratelimiterprotocolv1.RateLimiterInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *rateLimiterServer) TryAcquire(ctx context.Context, request *ratelimiterprotocolv1.TryAcquireRequest) (*ratelimiterprotocolv1.TryAcquireResponse, error) {
+	log.Debugw("TryAcquire",
+		logging.Trunc128("TryAcquireRequest", request))
+	input := &ratelimiterprotocolv1.RateLimiterInput{
+		Input: &ratelimiterprotocolv1.RateLimiterInput_TryAcquire{
+			TryAcquire: request.TryAcquireInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("TryAcquire",
+			logging.Trunc128("TryAcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterprotocolv1.TryAcquireResponse{
+		Headers:          headers,
+		TryAcquireOutput: output.GetTryAcquire(),
+	}
+	log.Debugw("TryAcquire",
+		logging.Trunc128("TryAcquireRequest", request),
+		logging.Trunc128("TryAcquireResponse", response))
+	return response, nil
+}
+
+func (s *rateLimiterServer) Acquire(ctx context.Context, request *ratelimiterprotocolv1.AcquireRequest) (*ratelimiterprotocolv1.AcquireResponse, error) {
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request))
+	input := &ratelimiterprotocolv1.RateLimiterInput{
+		Input: &ratelimiterprotocolv1.RateLimiterInput_Acquire{
+			Acquire: request.AcquireInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterprotocolv1.AcquireResponse{
+		Headers:       headers,
+		AcquireOutput: output.GetAcquire(),
+	}
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request),
+		logging.Trunc128("AcquireResponse", response))
+	return response, nil
+}
+
+func (s *rateLimiterServer) SetLimit(ctx context.Context, request *ratelimiterprotocolv1.SetLimitRequest) (*ratelimiterprotocolv1.SetLimitResponse, error) {
+	log.Debugw("SetLimit",
+		logging.Trunc128("SetLimitRequest", request))
+	input := &ratelimiterprotocolv1.RateLimiterInput{
+		Input: &ratelimiterprotocolv1.RateLimiterInput_SetLimit{
+			SetLimit: request.SetLimitInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("SetLimit",
+			logging.Trunc128("SetLimitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterprotocolv1.SetLimitResponse{
+		Headers:        headers,
+		SetLimitOutput: output.GetSetLimit(),
+	}
+	log.Debugw("SetLimit",
+		logging.Trunc128("SetLimitRequest", request),
+		logging.Trunc128("SetLimitResponse", response))
+	return response, nil
+}
+
+func (s *rateLimiterServer) GetBucket(ctx context.Context, request *ratelimiterprotocolv1.GetBucketRequest) (*ratelimiterprotocolv1.GetBucketResponse, error) {
+	log.Debugw("GetBucket",
+		logging.Trunc128("GetBucketRequest", request))
+	input := &ratelimiterprotocolv1.RateLimiterInput{
+		Input: &ratelimiterprotocolv1.RateLimiterInput_GetBucket{
+			GetBucket: request.GetBucketInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("GetBucket",
+			logging.Trunc128("GetBucketRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterprotocolv1.GetBucketResponse{
+		Headers:         headers,
+		GetBucketOutput: output.GetGetBucket(),
+	}
+	log.Debugw("GetBucket",
+		logging.Trunc128("GetBucketRequest", request),
+		logging.Trunc128("GetBucketResponse", response))
+	return response, nil
+}
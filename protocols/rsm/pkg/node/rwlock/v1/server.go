@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	rwlockprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/rwlock/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		rwlockprotocolv1.RegisterRWLockServer(server, NewRWLockServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput](
+	func(input *rwlockprotocolv1.RWLockInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*rwlockprotocolv1.RWLockOutput, error) {
+		output := &rwlockprotocolv1.RWLockOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewRWLockServer(protocol node.Protocol) rwlockprotocolv1.RWLockServer {
+	return &rwLockServer{
+		handler: node.NewHandler[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput](protocol, serverCodec),
+	}
+}
+
+type rwLockServer struct {
+	handler node.Handler[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]
+}
+
+func (s *rwLockServer) Configure(ctx context.Context, request *rwlockprotocolv1.ConfigureRequest) (*rwlockprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &rwlockprotocolv1.RWLockInput{
+		Input: &rwlockprotocolv1.RWLockInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) Lock(ctx context.Context, request *rwlockprotocolv1.LockRequest) (*rwlockprotocolv1.LockResponse, error) {
+	log.Debugw("Lock",
+		logging.Trunc128("LockRequest", request))
+	input := &rwlockprotocolv1.RWLockInput{
+		Input: &rwlockprotocolv1.RWLockInput_Lock{
+			Lock: request.LockInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Lock",
+			logging.Trunc128("LockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockprotocolv1.LockResponse{
+		Headers:    headers,
+		LockOutput: output.GetLock(),
+	}
+	log.Debugw("Lock",
+		logging.Trunc128("LockRequest", request),
+		logging.Trunc128("LockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) Unlock(ctx context.Context, request *rwlockprotocolv1.UnlockRequest) (*rwlockprotocolv1.UnlockResponse, error) {
+	log.Debugw("Unlock",
+		logging.Trunc128("UnlockRequest", request))
+	input := &rwlockprotocolv1.RWLockInput{
+		Input: &rwlockprotocolv1.RWLockInput_Unlock{
+			Unlock: request.UnlockInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Unlock",
+			logging.Trunc128("UnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockprotocolv1.UnlockResponse{
+		Headers:      headers,
+		UnlockOutput: output.GetUnlock(),
+	}
+	log.Debugw("Unlock",
+		logging.Trunc128("UnlockRequest", request),
+		logging.Trunc128("UnlockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) RLock(ctx context.Context, request *rwlockprotocolv1.RLockRequest) (*rwlockprotocolv1.RLockResponse, error) {
+	log.Debugw("RLock",
+		logging.Trunc128("RLockRequest", request))
+	input := &rwlockprotocolv1.RWLockInput{
+		Input: &rwlockprotocolv1.RWLockInput_RLock{
+			RLock: request.RLockInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("RLock",
+			logging.Trunc128("RLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockprotocolv1.RLockResponse{
+		Headers:     headers,
+		RLockOutput: output.GetRLock(),
+	}
+	log.Debugw("RLock",
+		logging.Trunc128("RLockRequest", request),
+		logging.Trunc128("RLockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) RUnlock(ctx context.Context, request *rwlockprotocolv1.RUnlockRequest) (*rwlockprotocolv1.RUnlockResponse, error) {
+	log.Debugw("RUnlock",
+		logging.Trunc128("RUnlockRequest", request))
+	input := &rwlockprotocolv1.RWLockInput{
+		Input: &rwlockprotocolv1.RWLockInput_RUnlock{
+			RUnlock: request.RUnlockInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("RUnlock",
+			logging.Trunc128("RUnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockprotocolv1.RUnlockResponse{
+		Headers:       headers,
+		RUnlockOutput: output.GetRUnlock(),
+	}
+	log.Debugw("RUnlock",
+		logging.Trunc128("RUnlockRequest", request),
+		logging.Trunc128("RUnlockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) Get(ctx context.Context, request *rwlockprotocolv1.GetRequest) (*rwlockprotocolv1.GetResponse, error) {
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request))
+	input := &rwlockprotocolv1.RWLockInput{
+		Input: &rwlockprotocolv1.RWLockInput_Get{
+			Get: request.GetInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockprotocolv1.GetResponse{
+		Headers:   headers,
+		GetOutput: output.GetGet(),
+	}
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request),
+		logging.Trunc128("GetResponse", response))
+	return response, nil
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	"github.com/gogo/protobuf/proto"
+	schedulerprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/scheduler/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		schedulerprotocolv1.RegisterSchedulerServer(server, NewSchedulerServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput](
+	func(input *schedulerprotocolv1.SchedulerInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*schedulerprotocolv1.SchedulerOutput, error) {
+		output := &schedulerprotocolv1.SchedulerOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewSchedulerServer(protocol node.Protocol) schedulerprotocolv1.SchedulerServer {
+	return &schedulerServer{
+		handler: node.NewHandler[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput](protocol, serverCodec),
+	}
+}
+
+type schedulerServer struct {
+	handler node.Handler[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]
+}
+
+func (s *schedulerServer) Configure(ctx context.Context, request *schedulerprotocolv1.ConfigureRequest) (*schedulerprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &schedulerprotocolv1.SchedulerInput{
+		Input: &schedulerprotocolv1.SchedulerInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *schedulerServer) Schedule(ctx context.Context, request *schedulerprotocolv1.ScheduleRequest) (*schedulerprotocolv1.ScheduleResponse, error) {
+	log.Debugw("Schedule",
+		logging.Trunc128("ScheduleRequest", request))
+	input := &schedulerprotocolv1.SchedulerInput{
+		Input: &schedulerprotocolv1.SchedulerInput_Schedule{
+			Schedule: request.ScheduleInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Schedule",
+			logging.Trunc128("ScheduleRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerprotocolv1.ScheduleResponse{
+		Headers:        headers,
+		ScheduleOutput: output.GetSchedule(),
+	}
+	log.Debugw("Schedule",
+		logging.Trunc128("ScheduleRequest", request),
+		logging.Trunc128("ScheduleResponse", response))
+	return response, nil
+}
+
+func (s *schedulerServer) Cancel(ctx context.Context, request *schedulerprotocolv1.CancelRequest) (*schedulerprotocolv1.CancelResponse, error) {
+	log.Debugw("Cancel",
+		logging.Trunc128("CancelRequest", request))
+	input := &schedulerprotocolv1.SchedulerInput{
+		Input: &schedulerprotocolv1.SchedulerInput_Cancel{
+			Cancel: request.CancelInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Cancel",
+			logging.Trunc128("CancelRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerprotocolv1.CancelResponse{
+		Headers:      headers,
+		CancelOutput: output.GetCancel(),
+	}
+	log.Debugw("Cancel",
+		logging.Trunc128("CancelRequest", request),
+		logging.Trunc128("CancelResponse", response))
+	return response, nil
+}
+
+func (s *schedulerServer) List(ctx context.Context, request *schedulerprotocolv1.ListRequest) (*schedulerprotocolv1.ListResponse, error) {
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request))
+	input := &schedulerprotocolv1.SchedulerInput{
+		Input: &schedulerprotocolv1.SchedulerInput_List{
+			List: request.ListInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc128("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerprotocolv1.ListResponse{
+		Headers:    headers,
+		ListOutput: output.GetList(),
+	}
+	log.Debugw("List",
+		logging.Trunc128("ListRequest", request),
+		logging.Trunc128("ListResponse", response))
+	return response, nil
+}
+
+func (s *schedulerServer) Subscribe(request *schedulerprotocolv1.SubscribeRequest, server schedulerprotocolv1.Scheduler_SubscribeServer) error {
+	log.Debugw("Subscribe",
+		logging.Trunc128("SubscribeRequest", request))
+	input := &schedulerprotocolv1.SchedulerInput{
+		Input: &schedulerprotocolv1.SchedulerInput_Subscribe{
+			Subscribe: request.SubscribeInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamProposalResponse[*schedulerprotocolv1.SchedulerOutput]]()
+	go func() {
+		err := s.handler.StreamPropose(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &schedulerprotocolv1.SubscribeResponse{
+			Headers:         result.Value.Headers,
+			SubscribeOutput: result.Value.Output.GetSubscribe(),
+		}
+		log.Debugw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Trunc128("SubscribeResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func (s *schedulerServer) Ack(ctx context.Context, request *schedulerprotocolv1.AckRequest) (*schedulerprotocolv1.AckResponse, error) {
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request))
+	input := &schedulerprotocolv1.SchedulerInput{
+		Input: &schedulerprotocolv1.SchedulerInput_Ack{
+			Ack: request.AckInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerprotocolv1.AckResponse{
+		Headers:   headers,
+		AckOutput: output.GetAck(),
+	}
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request),
+		logging.Trunc128("AckResponse", response))
+	return response, nil
+}
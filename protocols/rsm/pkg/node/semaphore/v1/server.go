@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	"github.com/gogo/protobuf/proto"
+	semaphoreprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/semaphore/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		semaphoreprotocolv1.RegisterSemaphoreServer(server, NewSemaphoreServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput](
+	func(input *semaphoreprotocolv1.SemaphoreInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*semaphoreprotocolv1.SemaphoreOutput, error) {
+		output := &semaphoreprotocolv1.SemaphoreOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewSemaphoreServer(protocol node.Protocol) semaphoreprotocolv1.SemaphoreServer {
+	return &semaphoreServer{
+		handler: node.NewHandler[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput](protocol, serverCodec),
+	}
+}
+
+type semaphoreServer struct {
+	handler node.Handler[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]
+}
+
+func (s *semaphoreServer) Configure(ctx context.Context, request *semaphoreprotocolv1.ConfigureRequest) (*semaphoreprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &semaphoreprotocolv1.SemaphoreInput{
+		Input: &semaphoreprotocolv1.SemaphoreInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphoreprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *semaphoreServer) Acquire(ctx context.Context, request *semaphoreprotocolv1.AcquireRequest) (*semaphoreprotocolv1.AcquireResponse, error) {
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request))
+	input := &semaphoreprotocolv1.SemaphoreInput{
+		Input: &semaphoreprotocolv1.SemaphoreInput_Acquire{
+			Acquire: request.AcquireInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc128("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphoreprotocolv1.AcquireResponse{
+		Headers:       headers,
+		AcquireOutput: output.GetAcquire(),
+	}
+	log.Debugw("Acquire",
+		logging.Trunc128("AcquireRequest", request),
+		logging.Trunc128("AcquireResponse", response))
+	return response, nil
+}
+
+func (s *semaphoreServer) Release(ctx context.Context, request *semaphoreprotocolv1.ReleaseRequest) (*semaphoreprotocolv1.ReleaseResponse, error) {
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request))
+	input := &semaphoreprotocolv1.SemaphoreInput{
+		Input: &semaphoreprotocolv1.SemaphoreInput_Release{
+			Release: request.ReleaseInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphoreprotocolv1.ReleaseResponse{
+		Headers:       headers,
+		ReleaseOutput: output.GetRelease(),
+	}
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request),
+		logging.Trunc128("ReleaseResponse", response))
+	return response, nil
+}
+
+func (s *semaphoreServer) Get(ctx context.Context, request *semaphoreprotocolv1.GetRequest) (*semaphoreprotocolv1.GetResponse, error) {
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request))
+	input := &semaphoreprotocolv1.SemaphoreInput{
+		Input: &semaphoreprotocolv1.SemaphoreInput_Get{
+			Get: request.GetInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Get",
+			logging.Trunc128("GetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphoreprotocolv1.GetResponse{
+		Headers:   headers,
+		GetOutput: output.GetGet(),
+	}
+	log.Debugw("Get",
+		logging.Trunc128("GetRequest", request),
+		logging.Trunc128("GetResponse", response))
+	return response, nil
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	"github.com/gogo/protobuf/proto"
+	shardassignmentprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/shardassignment/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		shardassignmentprotocolv1.RegisterShardAssignmentServer(server, NewShardAssignmentServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput](
+	func(input *shardassignmentprotocolv1.ShardAssignmentInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*shardassignmentprotocolv1.ShardAssignmentOutput, error) {
+		output := &shardassignmentprotocolv1.ShardAssignmentOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewShardAssignmentServer(protocol node.Protocol) shardassignmentprotocolv1.ShardAssignmentServer {
+	return &shardAssignmentServer{
+		handler: node.NewHandler[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput](protocol, serverCodec),
+	}
+}
+
+type shardAssignmentServer struct {
+	handler node.Handler[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput]
+}
+
+func (s *shardAssignmentServer) Configure(ctx context.Context, request *shardassignmentprotocolv1.ConfigureRequest) (*shardassignmentprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &shardassignmentprotocolv1.ShardAssignmentInput{
+		Input: &shardassignmentprotocolv1.ShardAssignmentInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) Join(ctx context.Context, request *shardassignmentprotocolv1.JoinRequest) (*shardassignmentprotocolv1.JoinResponse, error) {
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request))
+	input := &shardassignmentprotocolv1.ShardAssignmentInput{
+		Input: &shardassignmentprotocolv1.ShardAssignmentInput_Join{
+			Join: request.JoinInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc128("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentprotocolv1.JoinResponse{
+		Headers:    headers,
+		JoinOutput: output.GetJoin(),
+	}
+	log.Debugw("Join",
+		logging.Trunc128("JoinRequest", request),
+		logging.Trunc128("JoinResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) Leave(ctx context.Context, request *shardassignmentprotocolv1.LeaveRequest) (*shardassignmentprotocolv1.LeaveResponse, error) {
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request))
+	input := &shardassignmentprotocolv1.ShardAssignmentInput{
+		Input: &shardassignmentprotocolv1.ShardAssignmentInput_Leave{
+			Leave: request.LeaveInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc128("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentprotocolv1.LeaveResponse{
+		Headers:     headers,
+		LeaveOutput: output.GetLeave(),
+	}
+	log.Debugw("Leave",
+		logging.Trunc128("LeaveRequest", request),
+		logging.Trunc128("LeaveResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) Release(ctx context.Context, request *shardassignmentprotocolv1.ReleaseRequest) (*shardassignmentprotocolv1.ReleaseResponse, error) {
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request))
+	input := &shardassignmentprotocolv1.ShardAssignmentInput{
+		Input: &shardassignmentprotocolv1.ShardAssignmentInput_Release{
+			Release: request.ReleaseInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc128("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentprotocolv1.ReleaseResponse{
+		Headers:       headers,
+		ReleaseOutput: output.GetRelease(),
+	}
+	log.Debugw("Release",
+		logging.Trunc128("ReleaseRequest", request),
+		logging.Trunc128("ReleaseResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) GetAssignments(ctx context.Context, request *shardassignmentprotocolv1.GetAssignmentsRequest) (*shardassignmentprotocolv1.GetAssignmentsResponse, error) {
+	log.Debugw("GetAssignments",
+		logging.Trunc128("GetAssignmentsRequest", request))
+	input := &shardassignmentprotocolv1.ShardAssignmentInput{
+		Input: &shardassignmentprotocolv1.ShardAssignmentInput_GetAssignments{
+			GetAssignments: request.GetAssignmentsInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("GetAssignments",
+			logging.Trunc128("GetAssignmentsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentprotocolv1.GetAssignmentsResponse{
+		Headers:              headers,
+		GetAssignmentsOutput: output.GetGetAssignments(),
+	}
+	log.Debugw("GetAssignments",
+		logging.Trunc128("GetAssignmentsRequest", request),
+		logging.Trunc128("GetAssignmentsResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) Watch(request *shardassignmentprotocolv1.WatchRequest, server shardassignmentprotocolv1.ShardAssignment_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc128("WatchRequest", request))
+	input := &shardassignmentprotocolv1.ShardAssignmentInput{
+		Input: &shardassignmentprotocolv1.ShardAssignmentInput_Watch{
+			Watch: request.WatchInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamQueryResponse[*shardassignmentprotocolv1.ShardAssignmentOutput]]()
+	go func() {
+		err := s.handler.StreamQuery(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &shardassignmentprotocolv1.WatchResponse{
+			Headers:     result.Value.Headers,
+			WatchOutput: result.Value.Output.GetWatch(),
+		}
+		log.Debugw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Trunc128("WatchResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	"github.com/gogo/protobuf/proto"
+	topicprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/topic/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		topicprotocolv1.RegisterTopicServer(server, NewTopicServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput](
+	func(input *topicprotocolv1.TopicInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*topicprotocolv1.TopicOutput, error) {
+		output := &topicprotocolv1.TopicOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewTopicServer(protocol node.Protocol) topicprotocolv1.TopicServer {
+	return &topicServer{
+		handler: node.NewHandler[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput](protocol, serverCodec),
+	}
+}
+
+type topicServer struct {
+	handler node.Handler[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]
+}
+
+func (s *topicServer) Configure(ctx context.Context, request *topicprotocolv1.ConfigureRequest) (*topicprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &topicprotocolv1.TopicInput{
+		Input: &topicprotocolv1.TopicInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &topicprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *topicServer) Publish(ctx context.Context, request *topicprotocolv1.PublishRequest) (*topicprotocolv1.PublishResponse, error) {
+	log.Debugw("Publish",
+		logging.Trunc128("PublishRequest", request))
+	input := &topicprotocolv1.TopicInput{
+		Input: &topicprotocolv1.TopicInput_Publish{
+			Publish: request.PublishInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Publish",
+			logging.Trunc128("PublishRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &topicprotocolv1.PublishResponse{
+		Headers:       headers,
+		PublishOutput: output.GetPublish(),
+	}
+	log.Debugw("Publish",
+		logging.Trunc128("PublishRequest", request),
+		logging.Trunc128("PublishResponse", response))
+	return response, nil
+}
+
+func (s *topicServer) Subscribe(request *topicprotocolv1.SubscribeRequest, server topicprotocolv1.Topic_SubscribeServer) error {
+	log.Debugw("Subscribe",
+		logging.Trunc128("SubscribeRequest", request))
+	input := &topicprotocolv1.TopicInput{
+		Input: &topicprotocolv1.TopicInput_Subscribe{
+			Subscribe: request.SubscribeInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamProposalResponse[*topicprotocolv1.TopicOutput]]()
+	go func() {
+		err := s.handler.StreamPropose(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &topicprotocolv1.SubscribeResponse{
+			Headers:         result.Value.Headers,
+			SubscribeOutput: result.Value.Output.GetSubscribe(),
+		}
+		log.Debugw("Subscribe",
+			logging.Trunc128("SubscribeRequest", request),
+			logging.Trunc128("SubscribeResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Subscribe",
+				logging.Trunc128("SubscribeRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
+
+func (s *topicServer) CommitOffset(ctx context.Context, request *topicprotocolv1.CommitOffsetRequest) (*topicprotocolv1.CommitOffsetResponse, error) {
+	log.Debugw("CommitOffset",
+		logging.Trunc128("CommitOffsetRequest", request))
+	input := &topicprotocolv1.TopicInput{
+		Input: &topicprotocolv1.TopicInput_CommitOffset{
+			CommitOffset: request.CommitOffsetInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("CommitOffset",
+			logging.Trunc128("CommitOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &topicprotocolv1.CommitOffsetResponse{
+		Headers:            headers,
+		CommitOffsetOutput: output.GetCommitOffset(),
+	}
+	log.Debugw("CommitOffset",
+		logging.Trunc128("CommitOffsetRequest", request),
+		logging.Trunc128("CommitOffsetResponse", response))
+	return response, nil
+}
+
+func (s *topicServer) FetchOffset(ctx context.Context, request *topicprotocolv1.FetchOffsetRequest) (*topicprotocolv1.FetchOffsetResponse, error) {
+	log.Debugw("FetchOffset",
+		logging.Trunc128("FetchOffsetRequest", request))
+	input := &topicprotocolv1.TopicInput{
+		Input: &topicprotocolv1.TopicInput_FetchOffset{
+			FetchOffset: request.FetchOffsetInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("FetchOffset",
+			logging.Trunc128("FetchOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &topicprotocolv1.FetchOffsetResponse{
+		Headers:           headers,
+		FetchOffsetOutput: output.GetFetchOffset(),
+	}
+	log.Debugw("FetchOffset",
+		logging.Trunc128("FetchOffsetRequest", request),
+		logging.Trunc128("FetchOffsetResponse", response))
+	return response, nil
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	"github.com/gogo/protobuf/proto"
+	treeprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/tree/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		treeprotocolv1.RegisterTreeServer(server, NewTreeServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput](
+	func(input *treeprotocolv1.TreeInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*treeprotocolv1.TreeOutput, error) {
+		output := &treeprotocolv1.TreeOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewTreeServer(protocol node.Protocol) treeprotocolv1.TreeServer {
+	return &treeServer{
+		handler: node.NewHandler[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput](protocol, serverCodec),
+	}
+}
+
+type treeServer struct {
+	handler node.Handler[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput]
+}
+
+func (s *treeServer) CreateNode(ctx context.Context, request *treeprotocolv1.CreateNodeRequest) (*treeprotocolv1.CreateNodeResponse, error) {
+	log.Debugw("CreateNode",
+		logging.Trunc128("CreateNodeRequest", request))
+	input := &treeprotocolv1.TreeInput{
+		Input: &treeprotocolv1.TreeInput_CreateNode{
+			CreateNode: request.CreateNodeInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("CreateNode",
+			logging.Trunc128("CreateNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treeprotocolv1.CreateNodeResponse{
+		Headers:          headers,
+		CreateNodeOutput: output.GetCreateNode(),
+	}
+	log.Debugw("CreateNode",
+		logging.Trunc128("CreateNodeRequest", request),
+		logging.Trunc128("CreateNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) GetNode(ctx context.Context, request *treeprotocolv1.GetNodeRequest) (*treeprotocolv1.GetNodeResponse, error) {
+	log.Debugw("GetNode",
+		logging.Trunc128("GetNodeRequest", request))
+	input := &treeprotocolv1.TreeInput{
+		Input: &treeprotocolv1.TreeInput_GetNode{
+			GetNode: request.GetNodeInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("GetNode",
+			logging.Trunc128("GetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treeprotocolv1.GetNodeResponse{
+		Headers:       headers,
+		GetNodeOutput: output.GetGetNode(),
+	}
+	log.Debugw("GetNode",
+		logging.Trunc128("GetNodeRequest", request),
+		logging.Trunc128("GetNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) SetNode(ctx context.Context, request *treeprotocolv1.SetNodeRequest) (*treeprotocolv1.SetNodeResponse, error) {
+	log.Debugw("SetNode",
+		logging.Trunc128("SetNodeRequest", request))
+	input := &treeprotocolv1.TreeInput{
+		Input: &treeprotocolv1.TreeInput_SetNode{
+			SetNode: request.SetNodeInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("SetNode",
+			logging.Trunc128("SetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treeprotocolv1.SetNodeResponse{
+		Headers:       headers,
+		SetNodeOutput: output.GetSetNode(),
+	}
+	log.Debugw("SetNode",
+		logging.Trunc128("SetNodeRequest", request),
+		logging.Trunc128("SetNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) DeleteNode(ctx context.Context, request *treeprotocolv1.DeleteNodeRequest) (*treeprotocolv1.DeleteNodeResponse, error) {
+	log.Debugw("DeleteNode",
+		logging.Trunc128("DeleteNodeRequest", request))
+	input := &treeprotocolv1.TreeInput{
+		Input: &treeprotocolv1.TreeInput_DeleteNode{
+			DeleteNode: request.DeleteNodeInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("DeleteNode",
+			logging.Trunc128("DeleteNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treeprotocolv1.DeleteNodeResponse{
+		Headers:          headers,
+		DeleteNodeOutput: output.GetDeleteNode(),
+	}
+	log.Debugw("DeleteNode",
+		logging.Trunc128("DeleteNodeRequest", request),
+		logging.Trunc128("DeleteNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) GetChildren(ctx context.Context, request *treeprotocolv1.GetChildrenRequest) (*treeprotocolv1.GetChildrenResponse, error) {
+	log.Debugw("GetChildren",
+		logging.Trunc128("GetChildrenRequest", request))
+	input := &treeprotocolv1.TreeInput{
+		Input: &treeprotocolv1.TreeInput_GetChildren{
+			GetChildren: request.GetChildrenInput,
+		},
+	}
+	output, headers, err := s.handler.Query(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("GetChildren",
+			logging.Trunc128("GetChildrenRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treeprotocolv1.GetChildrenResponse{
+		Headers:           headers,
+		GetChildrenOutput: output.GetGetChildren(),
+	}
+	log.Debugw("GetChildren",
+		logging.Trunc128("GetChildrenRequest", request),
+		logging.Trunc128("GetChildrenResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) Watch(request *treeprotocolv1.WatchRequest, server treeprotocolv1.Tree_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc128("WatchRequest", request))
+	input := &treeprotocolv1.TreeInput{
+		Input: &treeprotocolv1.TreeInput_Watch{
+			Watch: request.WatchInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamQueryResponse[*treeprotocolv1.TreeOutput]]()
+	go func() {
+		err := s.handler.StreamQuery(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &treeprotocolv1.WatchResponse{
+			Headers:     result.Value.Headers,
+			WatchOutput: result.Value.Output.GetWatch(),
+		}
+		log.Debugw("Watch",
+			logging.Trunc128("WatchRequest", request),
+			logging.Trunc128("WatchResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("Watch",
+				logging.Trunc128("WatchRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
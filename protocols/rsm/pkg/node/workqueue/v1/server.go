@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	"github.com/atomix/atomix/runtime/pkg/logging"
+	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	"github.com/gogo/protobuf/proto"
+	workqueueprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/workqueue/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"google.golang.org/grpc"
+)
+
+var log = logging.GetLogger()
+
+func RegisterServer(node *node.Node) {
+	node.RegisterService(func(server *grpc.Server) {
+		workqueueprotocolv1.RegisterWorkQueueServer(server, NewWorkQueueServer(node))
+	})
+}
+
+var serverCodec = node.NewCodec[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput](
+	func(input *workqueueprotocolv1.WorkQueueInput) ([]byte, error) {
+		return proto.Marshal(input)
+	},
+	func(bytes []byte) (*workqueueprotocolv1.WorkQueueOutput, error) {
+		output := &workqueueprotocolv1.WorkQueueOutput{}
+		if err := proto.Unmarshal(bytes, output); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+func NewWorkQueueServer(protocol node.Protocol) workqueueprotocolv1.WorkQueueServer {
+	return &workQueueServer{
+		handler: node.NewHandler[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput](protocol, serverCodec),
+	}
+}
+
+type workQueueServer struct {
+	handler node.Handler[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]
+}
+
+func (s *workQueueServer) Configure(ctx context.Context, request *workqueueprotocolv1.ConfigureRequest) (*workqueueprotocolv1.ConfigureResponse, error) {
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request))
+	input := &workqueueprotocolv1.WorkQueueInput{
+		Input: &workqueueprotocolv1.WorkQueueInput_Configure{
+			Configure: request.ConfigureInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Configure",
+			logging.Trunc128("ConfigureRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueueprotocolv1.ConfigureResponse{
+		Headers:         headers,
+		ConfigureOutput: output.GetConfigure(),
+	}
+	log.Debugw("Configure",
+		logging.Trunc128("ConfigureRequest", request),
+		logging.Trunc128("ConfigureResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) Enqueue(ctx context.Context, request *workqueueprotocolv1.EnqueueRequest) (*workqueueprotocolv1.EnqueueResponse, error) {
+	log.Debugw("Enqueue",
+		logging.Trunc128("EnqueueRequest", request))
+	input := &workqueueprotocolv1.WorkQueueInput{
+		Input: &workqueueprotocolv1.WorkQueueInput_Enqueue{
+			Enqueue: request.EnqueueInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Enqueue",
+			logging.Trunc128("EnqueueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueueprotocolv1.EnqueueResponse{
+		Headers:       headers,
+		EnqueueOutput: output.GetEnqueue(),
+	}
+	log.Debugw("Enqueue",
+		logging.Trunc128("EnqueueRequest", request),
+		logging.Trunc128("EnqueueResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) Dequeue(ctx context.Context, request *workqueueprotocolv1.DequeueRequest) (*workqueueprotocolv1.DequeueResponse, error) {
+	log.Debugw("Dequeue",
+		logging.Trunc128("DequeueRequest", request))
+	input := &workqueueprotocolv1.WorkQueueInput{
+		Input: &workqueueprotocolv1.WorkQueueInput_Dequeue{
+			Dequeue: request.DequeueInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Dequeue",
+			logging.Trunc128("DequeueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueueprotocolv1.DequeueResponse{
+		Headers:       headers,
+		DequeueOutput: output.GetDequeue(),
+	}
+	log.Debugw("Dequeue",
+		logging.Trunc128("DequeueRequest", request),
+		logging.Trunc128("DequeueResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) Ack(ctx context.Context, request *workqueueprotocolv1.AckRequest) (*workqueueprotocolv1.AckResponse, error) {
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request))
+	input := &workqueueprotocolv1.WorkQueueInput{
+		Input: &workqueueprotocolv1.WorkQueueInput_Ack{
+			Ack: request.AckInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc128("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueueprotocolv1.AckResponse{
+		Headers:   headers,
+		AckOutput: output.GetAck(),
+	}
+	log.Debugw("Ack",
+		logging.Trunc128("AckRequest", request),
+		logging.Trunc128("AckResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) Nack(ctx context.Context, request *workqueueprotocolv1.NackRequest) (*workqueueprotocolv1.NackResponse, error) {
+	log.Debugw("Nack",
+		logging.Trunc128("NackRequest", request))
+	input := &workqueueprotocolv1.WorkQueueInput{
+		Input: &workqueueprotocolv1.WorkQueueInput_Nack{
+			Nack: request.NackInput,
+		},
+	}
+	output, headers, err := s.handler.Propose(ctx, input, request.Headers)
+	if err != nil {
+		log.Warnw("Nack",
+			logging.Trunc128("NackRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueueprotocolv1.NackResponse{
+		Headers:    headers,
+		NackOutput: output.GetNack(),
+	}
+	log.Debugw("Nack",
+		logging.Trunc128("NackRequest", request),
+		logging.Trunc128("NackResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) DeadLetters(request *workqueueprotocolv1.DeadLettersRequest, server workqueueprotocolv1.WorkQueue_DeadLettersServer) error {
+	log.Debugw("DeadLetters",
+		logging.Trunc128("DeadLettersRequest", request))
+	input := &workqueueprotocolv1.WorkQueueInput{
+		Input: &workqueueprotocolv1.WorkQueueInput_DeadLetters{
+			DeadLetters: request.DeadLettersInput,
+		},
+	}
+
+	stream := streams.NewBufferedStream[*node.StreamQueryResponse[*workqueueprotocolv1.WorkQueueOutput]]()
+	go func() {
+		err := s.handler.StreamQuery(server.Context(), input, request.Headers, stream)
+		if err != nil {
+			log.Warnw("DeadLetters",
+				logging.Trunc128("DeadLettersRequest", request),
+				logging.Error("Error", err))
+			stream.Error(err)
+			stream.Close()
+		}
+	}()
+
+	for {
+		result, ok := stream.Receive()
+		if !ok {
+			return nil
+		}
+
+		if result.Failed() {
+			log.Warnw("DeadLetters",
+				logging.Trunc128("DeadLettersRequest", request),
+				logging.Error("Error", result.Error))
+			return result.Error
+		}
+
+		response := &workqueueprotocolv1.DeadLettersResponse{
+			Headers:           result.Value.Headers,
+			DeadLettersOutput: result.Value.Output.GetDeadLetters(),
+		}
+		log.Debugw("DeadLetters",
+			logging.Trunc128("DeadLettersRequest", request),
+			logging.Trunc128("DeadLettersResponse", response))
+		if err := server.Send(response); err != nil {
+			log.Warnw("DeadLetters",
+				logging.Trunc128("DeadLettersRequest", request),
+				logging.Error("Error", err))
+			return err
+		}
+	}
+}
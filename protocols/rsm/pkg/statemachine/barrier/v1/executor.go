@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	barrierprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/barrier/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var barrierCodec = statemachine.NewCodec[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput](
+	func(bytes []byte) (*barrierprotocolv1.BarrierInput, error) {
+		input := &barrierprotocolv1.BarrierInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *barrierprotocolv1.BarrierOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm BarrierStateMachine) statemachine.PrimitiveStateMachine[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput] {
+	executor := &BarrierExecutor{
+		BarrierStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type BarrierExecutor struct {
+	BarrierStateMachine
+	await statemachine.Proposer[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput, *barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput]
+	reset statemachine.Proposer[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput, *barrierprotocolv1.ResetInput, *barrierprotocolv1.ResetOutput]
+	get   statemachine.Querier[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput, *barrierprotocolv1.GetInput, *barrierprotocolv1.GetOutput]
+}
+
+func (s *BarrierExecutor) init() {
+	s.await = statemachine.NewProposer[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput, *barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput]("Await").
+		Decoder(func(input *barrierprotocolv1.BarrierInput) (*barrierprotocolv1.AwaitInput, bool) {
+			if await, ok := input.Input.(*barrierprotocolv1.BarrierInput_Await); ok {
+				return await.Await, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *barrierprotocolv1.AwaitOutput) *barrierprotocolv1.BarrierOutput {
+			return &barrierprotocolv1.BarrierOutput{
+				Output: &barrierprotocolv1.BarrierOutput_Await{
+					Await: output,
+				},
+			}
+		}).
+		Build(s.Await)
+	s.reset = statemachine.NewProposer[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput, *barrierprotocolv1.ResetInput, *barrierprotocolv1.ResetOutput]("Reset").
+		Decoder(func(input *barrierprotocolv1.BarrierInput) (*barrierprotocolv1.ResetInput, bool) {
+			if reset, ok := input.Input.(*barrierprotocolv1.BarrierInput_Reset_); ok {
+				return reset.Reset_, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *barrierprotocolv1.ResetOutput) *barrierprotocolv1.BarrierOutput {
+			return &barrierprotocolv1.BarrierOutput{
+				Output: &barrierprotocolv1.BarrierOutput_Reset_{
+					Reset_: output,
+				},
+			}
+		}).
+		Build(s.Reset)
+	s.get = statemachine.NewQuerier[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput, *barrierprotocolv1.GetInput, *barrierprotocolv1.GetOutput]("Get").
+		Decoder(func(input *barrierprotocolv1.BarrierInput) (*barrierprotocolv1.GetInput, bool) {
+			if get, ok := input.Input.(*barrierprotocolv1.BarrierInput_Get); ok {
+				return get.Get, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *barrierprotocolv1.GetOutput) *barrierprotocolv1.BarrierOutput {
+			return &barrierprotocolv1.BarrierOutput{
+				Output: &barrierprotocolv1.BarrierOutput_Get{
+					Get: output,
+				},
+			}
+		}).
+		Build(s.Get)
+}
+
+func (s *BarrierExecutor) Propose(proposal statemachine.Proposal[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *barrierprotocolv1.BarrierInput_Await:
+		s.await(proposal)
+	case *barrierprotocolv1.BarrierInput_Reset_:
+		s.reset(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *BarrierExecutor) Query(query statemachine.Query[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]) {
+	switch query.Input().Input.(type) {
+	case *barrierprotocolv1.BarrierInput_Get:
+		s.get(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	barrierprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/barrier/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "Barrier"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]) statemachine.PrimitiveStateMachine[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput] {
+			return newExecutor(NewBarrierStateMachine(context))
+		}, barrierCodec)
+}
+
+type BarrierContext interface {
+	statemachine.PrimitiveContext[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]
+	Waiters() statemachine.Proposals[*barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]) BarrierContext {
+	return &barrierContext{
+		PrimitiveContext: context,
+		waiters: statemachine.NewProposals[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput, *barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput](context).
+			Decoder(func(input *barrierprotocolv1.BarrierInput) (*barrierprotocolv1.AwaitInput, bool) {
+				if await, ok := input.Input.(*barrierprotocolv1.BarrierInput_Await); ok {
+					return await.Await, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *barrierprotocolv1.AwaitOutput) *barrierprotocolv1.BarrierOutput {
+				return &barrierprotocolv1.BarrierOutput{
+					Output: &barrierprotocolv1.BarrierOutput_Await{
+						Await: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type barrierContext struct {
+	statemachine.PrimitiveContext[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]
+	waiters statemachine.Proposals[*barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput]
+}
+
+func (c *barrierContext) Waiters() statemachine.Proposals[*barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput] {
+	return c.waiters
+}
+
+type BarrierStateMachine interface {
+	statemachine.Context[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]
+	statemachine.Recoverable
+	Await(statemachine.Proposal[*barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput])
+	Reset(statemachine.Proposal[*barrierprotocolv1.ResetInput, *barrierprotocolv1.ResetOutput])
+	Get(statemachine.Query[*barrierprotocolv1.GetInput, *barrierprotocolv1.GetOutput])
+}
+
+func NewBarrierStateMachine(context statemachine.PrimitiveContext[*barrierprotocolv1.BarrierInput, *barrierprotocolv1.BarrierOutput]) BarrierStateMachine {
+	return &barrierStateMachine{
+		BarrierContext: newContext(context),
+		proposals:      make(map[statemachine.ProposalID]statemachine.CancelFunc),
+		timers:         make(map[statemachine.ProposalID]statemachine.CancelFunc),
+	}
+}
+
+type barrierStateMachine struct {
+	BarrierContext
+	generation uint64
+	parties    uint32
+	broken     bool
+	queue      []statemachine.Proposal[*barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput]
+	proposals  map[statemachine.ProposalID]statemachine.CancelFunc
+	timers     map[statemachine.ProposalID]statemachine.CancelFunc
+}
+
+func (s *barrierStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(s.generation); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint32(s.parties); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(s.broken); err != nil {
+		return err
+	}
+	if err := writer.WriteVarInt(len(s.queue)); err != nil {
+		return err
+	}
+	for _, waiter := range s.queue {
+		if err := writer.WriteVarUint64(uint64(waiter.ID())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *barrierStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		s.generation, err = reader.ReadVarUint64()
+		if err != nil {
+			return err
+		}
+		s.parties, err = reader.ReadVarUint32()
+		if err != nil {
+			return err
+		}
+		s.broken, err = reader.ReadBool()
+		if err != nil {
+			return err
+		}
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			proposal, ok := s.Waiters().Get(statemachine.ProposalID(proposalID))
+			if !ok {
+				return errors.NewFault("proposal not found")
+			}
+			s.enqueueWaiter(proposal)
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+func (s *barrierStateMachine) enqueueWaiter(proposal statemachine.Proposal[*barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput]) {
+	s.queue = append(s.queue, proposal)
+
+	// A waiter that leaves the barrier before it is tripped, e.g. when its session expires, breaks the barrier
+	s.proposals[proposal.ID()] = proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.unwatchWaiter(proposal.ID())
+			s.breakBarrier()
+		}
+	})
+
+	if proposal.Input().Timeout != nil {
+		s.timers[proposal.ID()] = s.Scheduler().Delay(*proposal.Input().Timeout, func() {
+			s.breakBarrier()
+		})
+	}
+}
+
+func (s *barrierStateMachine) unwatchWaiter(proposalID statemachine.ProposalID) {
+	if cancel, ok := s.proposals[proposalID]; ok {
+		cancel()
+		delete(s.proposals, proposalID)
+	}
+	if cancel, ok := s.timers[proposalID]; ok {
+		cancel()
+		delete(s.timers, proposalID)
+	}
+}
+
+// breakBarrier fails all waiters in the current generation and marks the barrier broken until it's reset
+func (s *barrierStateMachine) breakBarrier() {
+	s.broken = true
+	queue := s.queue
+	s.queue = nil
+	s.parties = 0
+	for _, waiter := range queue {
+		s.unwatchWaiter(waiter.ID())
+		waiter.Error(errors.NewConflict("barrier is broken"))
+		waiter.Close()
+	}
+}
+
+func (s *barrierStateMachine) Await(proposal statemachine.Proposal[*barrierprotocolv1.AwaitInput, *barrierprotocolv1.AwaitOutput]) {
+	parties := proposal.Input().Parties
+	if parties == 0 {
+		proposal.Error(errors.NewInvalid("parties must be greater than zero"))
+		proposal.Close()
+		return
+	}
+	if s.broken {
+		proposal.Error(errors.NewConflict("barrier is broken"))
+		proposal.Close()
+		return
+	}
+	if len(s.queue) == 0 {
+		s.parties = parties
+	} else if parties != s.parties {
+		proposal.Error(errors.NewInvalid("barrier is awaiting %d parties", s.parties))
+		proposal.Close()
+		return
+	}
+
+	if uint32(len(s.queue))+1 < s.parties {
+		s.enqueueWaiter(proposal)
+		return
+	}
+
+	// The last party has arrived, so trip the barrier and start a new generation
+	generation := s.generation
+	queue := s.queue
+	s.queue = nil
+	s.parties = 0
+	s.generation++
+	for i, waiter := range queue {
+		s.unwatchWaiter(waiter.ID())
+		waiter.Output(&barrierprotocolv1.AwaitOutput{
+			Generation: generation,
+			Index:      uint32(i),
+		})
+		waiter.Close()
+	}
+	proposal.Output(&barrierprotocolv1.AwaitOutput{
+		Generation: generation,
+		Index:      uint32(len(queue)),
+	})
+	proposal.Close()
+}
+
+func (s *barrierStateMachine) Reset(proposal statemachine.Proposal[*barrierprotocolv1.ResetInput, *barrierprotocolv1.ResetOutput]) {
+	defer proposal.Close()
+	if len(s.queue) > 0 {
+		s.breakBarrier()
+	}
+	s.broken = false
+	s.generation++
+	proposal.Output(&barrierprotocolv1.ResetOutput{})
+}
+
+func (s *barrierStateMachine) Get(query statemachine.Query[*barrierprotocolv1.GetInput, *barrierprotocolv1.GetOutput]) {
+	defer query.Close()
+	query.Output(&barrierprotocolv1.GetOutput{
+		Generation: s.generation,
+		Parties:    s.parties,
+		Waiting:    uint32(len(s.queue)),
+		Broken:     s.broken,
+	})
+}
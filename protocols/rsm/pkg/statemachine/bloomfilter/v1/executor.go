@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	bloomfilterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/bloomfilter/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var bloomFilterCodec = statemachine.NewCodec[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput](
+	func(bytes []byte) (*bloomfilterprotocolv1.BloomFilterInput, error) {
+		input := &bloomfilterprotocolv1.BloomFilterInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *bloomfilterprotocolv1.BloomFilterOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm BloomFilterStateMachine) statemachine.PrimitiveStateMachine[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput] {
+	executor := &BloomFilterExecutor{
+		BloomFilterStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type BloomFilterExecutor struct {
+	BloomFilterStateMachine
+	configure    statemachine.Proposer[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput, *bloomfilterprotocolv1.ConfigureInput, *bloomfilterprotocolv1.ConfigureOutput]
+	add          statemachine.Proposer[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput, *bloomfilterprotocolv1.AddInput, *bloomfilterprotocolv1.AddOutput]
+	mightContain statemachine.Querier[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput, *bloomfilterprotocolv1.MightContainInput, *bloomfilterprotocolv1.MightContainOutput]
+}
+
+func (s *BloomFilterExecutor) init() {
+	s.configure = statemachine.NewProposer[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput, *bloomfilterprotocolv1.ConfigureInput, *bloomfilterprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *bloomfilterprotocolv1.BloomFilterInput) (*bloomfilterprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*bloomfilterprotocolv1.BloomFilterInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *bloomfilterprotocolv1.ConfigureOutput) *bloomfilterprotocolv1.BloomFilterOutput {
+			return &bloomfilterprotocolv1.BloomFilterOutput{
+				Output: &bloomfilterprotocolv1.BloomFilterOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.add = statemachine.NewProposer[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput, *bloomfilterprotocolv1.AddInput, *bloomfilterprotocolv1.AddOutput]("Add").
+		Decoder(func(input *bloomfilterprotocolv1.BloomFilterInput) (*bloomfilterprotocolv1.AddInput, bool) {
+			if add, ok := input.Input.(*bloomfilterprotocolv1.BloomFilterInput_Add); ok {
+				return add.Add, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *bloomfilterprotocolv1.AddOutput) *bloomfilterprotocolv1.BloomFilterOutput {
+			return &bloomfilterprotocolv1.BloomFilterOutput{
+				Output: &bloomfilterprotocolv1.BloomFilterOutput_Add{
+					Add: output,
+				},
+			}
+		}).
+		Build(s.Add)
+	s.mightContain = statemachine.NewQuerier[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput, *bloomfilterprotocolv1.MightContainInput, *bloomfilterprotocolv1.MightContainOutput]("MightContain").
+		Decoder(func(input *bloomfilterprotocolv1.BloomFilterInput) (*bloomfilterprotocolv1.MightContainInput, bool) {
+			if mightContain, ok := input.Input.(*bloomfilterprotocolv1.BloomFilterInput_MightContain); ok {
+				return mightContain.MightContain, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *bloomfilterprotocolv1.MightContainOutput) *bloomfilterprotocolv1.BloomFilterOutput {
+			return &bloomfilterprotocolv1.BloomFilterOutput{
+				Output: &bloomfilterprotocolv1.BloomFilterOutput_MightContain{
+					MightContain: output,
+				},
+			}
+		}).
+		Build(s.MightContain)
+}
+
+func (s *BloomFilterExecutor) Propose(proposal statemachine.Proposal[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *bloomfilterprotocolv1.BloomFilterInput_Configure:
+		s.configure(proposal)
+	case *bloomfilterprotocolv1.BloomFilterInput_Add:
+		s.add(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *BloomFilterExecutor) Query(query statemachine.Query[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput]) {
+	switch query.Input().Input.(type) {
+	case *bloomfilterprotocolv1.BloomFilterInput_MightContain:
+		s.mightContain(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
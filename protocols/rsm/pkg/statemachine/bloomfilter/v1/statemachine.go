@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/bits-and-blooms/bloom/v3"
+	bloomfilterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/bloomfilter/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "BloomFilter"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+// The default filter holds 10000 elements with a 1% false positive rate
+const (
+	defaultBits   = 95851
+	defaultHashes = 7
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput]) statemachine.PrimitiveStateMachine[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput] {
+			return newExecutor(NewBloomFilterStateMachine(context))
+		}, bloomFilterCodec)
+}
+
+type BloomFilterContext interface {
+	statemachine.PrimitiveContext[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput]
+}
+
+type BloomFilterStateMachine interface {
+	statemachine.Context[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput]
+	statemachine.Recoverable
+	Configure(proposal statemachine.Proposal[*bloomfilterprotocolv1.ConfigureInput, *bloomfilterprotocolv1.ConfigureOutput])
+	Add(proposal statemachine.Proposal[*bloomfilterprotocolv1.AddInput, *bloomfilterprotocolv1.AddOutput])
+	MightContain(query statemachine.Query[*bloomfilterprotocolv1.MightContainInput, *bloomfilterprotocolv1.MightContainOutput])
+}
+
+func NewBloomFilterStateMachine(ctx statemachine.PrimitiveContext[*bloomfilterprotocolv1.BloomFilterInput, *bloomfilterprotocolv1.BloomFilterOutput]) BloomFilterStateMachine {
+	return &bloomFilterStateMachine{
+		BloomFilterContext: ctx,
+		bits:               defaultBits,
+		hashes:             defaultHashes,
+	}
+}
+
+type bloomFilterStateMachine struct {
+	BloomFilterContext
+	bits   uint64
+	hashes uint32
+	// filter is allocated when the first element is added to keep empty filters small
+	filter *bloom.BloomFilter
+}
+
+func (s *bloomFilterStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(s.bits); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint32(s.hashes); err != nil {
+		return err
+	}
+	var bytes []byte
+	if s.filter != nil {
+		b, err := s.filter.GobEncode()
+		if err != nil {
+			return err
+		}
+		bytes = b
+	}
+	if err := writer.WriteBytes(bytes); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *bloomFilterStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		bits, err := reader.ReadVarUint64()
+		if err != nil {
+			return err
+		}
+		hashes, err := reader.ReadVarUint32()
+		if err != nil {
+			return err
+		}
+		bytes, err := reader.ReadBytes()
+		if err != nil {
+			return err
+		}
+		s.bits = bits
+		s.hashes = hashes
+		if len(bytes) > 0 {
+			s.filter = &bloom.BloomFilter{}
+			if err := s.filter.GobDecode(bytes); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+func (s *bloomFilterStateMachine) Configure(proposal statemachine.Proposal[*bloomfilterprotocolv1.ConfigureInput, *bloomfilterprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	bits, hashes := proposal.Input().Bits, proposal.Input().Hashes
+	if bits == 0 {
+		bits = defaultBits
+	}
+	if hashes == 0 {
+		hashes = defaultHashes
+	}
+	if (bits != s.bits || hashes != s.hashes) && s.filter != nil {
+		proposal.Error(errors.NewConflict("cannot change the size of a non-empty filter"))
+		return
+	}
+	s.bits = bits
+	s.hashes = hashes
+	proposal.Output(&bloomfilterprotocolv1.ConfigureOutput{})
+}
+
+func (s *bloomFilterStateMachine) Add(proposal statemachine.Proposal[*bloomfilterprotocolv1.AddInput, *bloomfilterprotocolv1.AddOutput]) {
+	defer proposal.Close()
+	if s.filter == nil {
+		s.filter = bloom.New(uint(s.bits), uint(s.hashes))
+	}
+	proposal.Output(&bloomfilterprotocolv1.AddOutput{
+		Added: !s.filter.TestAndAddString(proposal.Input().Element),
+	})
+}
+
+func (s *bloomFilterStateMachine) MightContain(query statemachine.Query[*bloomfilterprotocolv1.MightContainInput, *bloomfilterprotocolv1.MightContainOutput]) {
+	defer query.Close()
+	query.Output(&bloomfilterprotocolv1.MightContainOutput{
+		Result: s.filter != nil && s.filter.TestString(query.Input().Element),
+	})
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	cardinalityprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/cardinality/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var cardinalityCodec = statemachine.NewCodec[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput](
+	func(bytes []byte) (*cardinalityprotocolv1.CardinalityInput, error) {
+		input := &cardinalityprotocolv1.CardinalityInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *cardinalityprotocolv1.CardinalityOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm CardinalityStateMachine) statemachine.PrimitiveStateMachine[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput] {
+	executor := &CardinalityExecutor{
+		CardinalityStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type CardinalityExecutor struct {
+	CardinalityStateMachine
+	configure statemachine.Proposer[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.ConfigureInput, *cardinalityprotocolv1.ConfigureOutput]
+	add       statemachine.Proposer[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.AddInput, *cardinalityprotocolv1.AddOutput]
+	merge     statemachine.Proposer[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.MergeInput, *cardinalityprotocolv1.MergeOutput]
+	getSketch statemachine.Querier[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.GetSketchInput, *cardinalityprotocolv1.GetSketchOutput]
+}
+
+func (s *CardinalityExecutor) init() {
+	s.configure = statemachine.NewProposer[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.ConfigureInput, *cardinalityprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *cardinalityprotocolv1.CardinalityInput) (*cardinalityprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*cardinalityprotocolv1.CardinalityInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *cardinalityprotocolv1.ConfigureOutput) *cardinalityprotocolv1.CardinalityOutput {
+			return &cardinalityprotocolv1.CardinalityOutput{
+				Output: &cardinalityprotocolv1.CardinalityOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.add = statemachine.NewProposer[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.AddInput, *cardinalityprotocolv1.AddOutput]("Add").
+		Decoder(func(input *cardinalityprotocolv1.CardinalityInput) (*cardinalityprotocolv1.AddInput, bool) {
+			if add, ok := input.Input.(*cardinalityprotocolv1.CardinalityInput_Add); ok {
+				return add.Add, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *cardinalityprotocolv1.AddOutput) *cardinalityprotocolv1.CardinalityOutput {
+			return &cardinalityprotocolv1.CardinalityOutput{
+				Output: &cardinalityprotocolv1.CardinalityOutput_Add{
+					Add: output,
+				},
+			}
+		}).
+		Build(s.Add)
+	s.merge = statemachine.NewProposer[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.MergeInput, *cardinalityprotocolv1.MergeOutput]("Merge").
+		Decoder(func(input *cardinalityprotocolv1.CardinalityInput) (*cardinalityprotocolv1.MergeInput, bool) {
+			if merge, ok := input.Input.(*cardinalityprotocolv1.CardinalityInput_Merge); ok {
+				return merge.Merge, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *cardinalityprotocolv1.MergeOutput) *cardinalityprotocolv1.CardinalityOutput {
+			return &cardinalityprotocolv1.CardinalityOutput{
+				Output: &cardinalityprotocolv1.CardinalityOutput_Merge{
+					Merge: output,
+				},
+			}
+		}).
+		Build(s.Merge)
+	s.getSketch = statemachine.NewQuerier[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput, *cardinalityprotocolv1.GetSketchInput, *cardinalityprotocolv1.GetSketchOutput]("GetSketch").
+		Decoder(func(input *cardinalityprotocolv1.CardinalityInput) (*cardinalityprotocolv1.GetSketchInput, bool) {
+			if getSketch, ok := input.Input.(*cardinalityprotocolv1.CardinalityInput_GetSketch); ok {
+				return getSketch.GetSketch, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *cardinalityprotocolv1.GetSketchOutput) *cardinalityprotocolv1.CardinalityOutput {
+			return &cardinalityprotocolv1.CardinalityOutput{
+				Output: &cardinalityprotocolv1.CardinalityOutput_GetSketch{
+					GetSketch: output,
+				},
+			}
+		}).
+		Build(s.GetSketch)
+}
+
+func (s *CardinalityExecutor) Propose(proposal statemachine.Proposal[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *cardinalityprotocolv1.CardinalityInput_Configure:
+		s.configure(proposal)
+	case *cardinalityprotocolv1.CardinalityInput_Add:
+		s.add(proposal)
+	case *cardinalityprotocolv1.CardinalityInput_Merge:
+		s.merge(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *CardinalityExecutor) Query(query statemachine.Query[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput]) {
+	switch query.Input().Input.(type) {
+	case *cardinalityprotocolv1.CardinalityInput_GetSketch:
+		s.getSketch(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
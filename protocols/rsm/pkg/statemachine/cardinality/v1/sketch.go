@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"hash/fnv"
+	"math/bits"
+)
+
+// sketch is a HyperLogLog sketch
+// Each element is hashed to a register selected by the top precision bits of the hash, and the register
+// records the maximum rank (the position of the first set bit) among the remaining bits of the hashes.
+type sketch struct {
+	precision uint32
+	// registers is allocated when the first element is added to keep empty sketches small
+	registers []byte
+}
+
+// add adds the given element to the sketch, returning whether a register was updated
+func (s *sketch) add(element string) bool {
+	if s.registers == nil {
+		s.registers = make([]byte, 1<<s.precision)
+	}
+	h := hash(element)
+	index := h >> (64 - s.precision)
+	// The sentinel bit caps the rank when all the remaining bits are zero
+	rank := byte(bits.LeadingZeros64(h<<s.precision|1<<(s.precision-1)) + 1)
+	if rank > s.registers[index] {
+		s.registers[index] = rank
+		return true
+	}
+	return false
+}
+
+// merge merges the registers of another sketch with the same precision into the sketch
+func (s *sketch) merge(registers []byte) {
+	if s.registers == nil {
+		s.registers = make([]byte, 1<<s.precision)
+	}
+	for i, rank := range registers {
+		if rank > s.registers[i] {
+			s.registers[i] = rank
+		}
+	}
+}
+
+// hash returns a well-distributed 64-bit hash of the given element
+func hash(element string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(element))
+	return fmix64(h.Sum64())
+}
+
+// fmix64 is the MurmurHash3 finalizer, which spreads the entropy of FNV hashes across all the bits
+func fmix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb93fe53a87cd
+	h ^= h >> 33
+	return h
+}
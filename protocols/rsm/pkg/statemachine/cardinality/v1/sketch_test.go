@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSketch(t *testing.T) {
+	s := &sketch{precision: 4}
+	assert.Nil(t, s.registers)
+	assert.True(t, s.add("foo"))
+	assert.Len(t, s.registers, 16)
+	assert.False(t, s.add("foo"))
+
+	for i := 0; i < 1000; i++ {
+		s.add(fmt.Sprintf("element-%d", i))
+	}
+	for _, rank := range s.registers {
+		assert.Greater(t, rank, byte(0))
+		assert.LessOrEqual(t, rank, byte(64-4+1))
+	}
+
+	other := &sketch{precision: 4}
+	other.merge(s.registers)
+	assert.Equal(t, s.registers, other.registers)
+
+	registers := make([]byte, 16)
+	registers[0] = 61
+	other.merge(registers)
+	assert.Equal(t, byte(61), other.registers[0])
+	assert.Equal(t, s.registers[1:], other.registers[1:])
+}
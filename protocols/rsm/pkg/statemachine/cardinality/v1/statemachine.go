@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	cardinalityprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/cardinality/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "Cardinality"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+const (
+	defaultPrecision = 14
+	minPrecision     = 4
+	maxPrecision     = 18
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput]) statemachine.PrimitiveStateMachine[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput] {
+			return newExecutor(NewCardinalityStateMachine(context))
+		}, cardinalityCodec)
+}
+
+type CardinalityContext interface {
+	statemachine.PrimitiveContext[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput]
+}
+
+type CardinalityStateMachine interface {
+	statemachine.Context[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput]
+	statemachine.Recoverable
+	Configure(proposal statemachine.Proposal[*cardinalityprotocolv1.ConfigureInput, *cardinalityprotocolv1.ConfigureOutput])
+	Add(proposal statemachine.Proposal[*cardinalityprotocolv1.AddInput, *cardinalityprotocolv1.AddOutput])
+	Merge(proposal statemachine.Proposal[*cardinalityprotocolv1.MergeInput, *cardinalityprotocolv1.MergeOutput])
+	GetSketch(query statemachine.Query[*cardinalityprotocolv1.GetSketchInput, *cardinalityprotocolv1.GetSketchOutput])
+}
+
+func NewCardinalityStateMachine(ctx statemachine.PrimitiveContext[*cardinalityprotocolv1.CardinalityInput, *cardinalityprotocolv1.CardinalityOutput]) CardinalityStateMachine {
+	return &cardinalityStateMachine{
+		CardinalityContext: ctx,
+		sketch: sketch{
+			precision: defaultPrecision,
+		},
+	}
+}
+
+type cardinalityStateMachine struct {
+	CardinalityContext
+	sketch sketch
+}
+
+func (s *cardinalityStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint32(s.sketch.precision); err != nil {
+		return err
+	}
+	if err := writer.WriteBytes(s.sketch.registers); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *cardinalityStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		precision, err := reader.ReadVarUint32()
+		if err != nil {
+			return err
+		}
+		registers, err := reader.ReadBytes()
+		if err != nil {
+			return err
+		}
+		s.sketch.precision = precision
+		if len(registers) > 0 {
+			s.sketch.registers = registers
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+func (s *cardinalityStateMachine) Configure(proposal statemachine.Proposal[*cardinalityprotocolv1.ConfigureInput, *cardinalityprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	precision := proposal.Input().Precision
+	if precision == 0 {
+		precision = defaultPrecision
+	}
+	if precision < minPrecision || precision > maxPrecision {
+		proposal.Error(errors.NewInvalid("precision must be between %d and %d", minPrecision, maxPrecision))
+		return
+	}
+	if precision != s.sketch.precision && s.sketch.registers != nil {
+		proposal.Error(errors.NewConflict("cannot change the precision of a non-empty sketch from %d to %d", s.sketch.precision, precision))
+		return
+	}
+	s.sketch.precision = precision
+	proposal.Output(&cardinalityprotocolv1.ConfigureOutput{})
+}
+
+func (s *cardinalityStateMachine) Add(proposal statemachine.Proposal[*cardinalityprotocolv1.AddInput, *cardinalityprotocolv1.AddOutput]) {
+	defer proposal.Close()
+	var updated bool
+	for _, element := range proposal.Input().Elements {
+		if s.sketch.add(element) {
+			updated = true
+		}
+	}
+	proposal.Output(&cardinalityprotocolv1.AddOutput{
+		Updated: updated,
+	})
+}
+
+func (s *cardinalityStateMachine) Merge(proposal statemachine.Proposal[*cardinalityprotocolv1.MergeInput, *cardinalityprotocolv1.MergeOutput]) {
+	defer proposal.Close()
+	input := proposal.Input()
+	if input.Precision != s.sketch.precision {
+		proposal.Error(errors.NewInvalid("cannot merge sketch with precision %d into sketch with precision %d", input.Precision, s.sketch.precision))
+		return
+	}
+	if len(input.Registers) != 1<<s.sketch.precision {
+		proposal.Error(errors.NewInvalid("sketch with precision %d must have %d registers", input.Precision, 1<<s.sketch.precision))
+		return
+	}
+	s.sketch.merge(input.Registers)
+	proposal.Output(&cardinalityprotocolv1.MergeOutput{})
+}
+
+func (s *cardinalityStateMachine) GetSketch(query statemachine.Query[*cardinalityprotocolv1.GetSketchInput, *cardinalityprotocolv1.GetSketchOutput]) {
+	defer query.Close()
+	query.Output(&cardinalityprotocolv1.GetSketchOutput{
+		Precision: s.sketch.precision,
+		Registers: s.sketch.registers,
+	})
+}
@@ -37,6 +37,7 @@ type counterExecutor struct {
 	update    statemachine.Proposer[*counterprotocolv1.CounterInput, *counterprotocolv1.CounterOutput, *counterprotocolv1.UpdateInput, *counterprotocolv1.UpdateOutput]
 	increment statemachine.Proposer[*counterprotocolv1.CounterInput, *counterprotocolv1.CounterOutput, *counterprotocolv1.IncrementInput, *counterprotocolv1.IncrementOutput]
 	decrement statemachine.Proposer[*counterprotocolv1.CounterInput, *counterprotocolv1.CounterOutput, *counterprotocolv1.DecrementInput, *counterprotocolv1.DecrementOutput]
+	setBounds statemachine.Proposer[*counterprotocolv1.CounterInput, *counterprotocolv1.CounterOutput, *counterprotocolv1.SetBoundsInput, *counterprotocolv1.SetBoundsOutput]
 	get       statemachine.Querier[*counterprotocolv1.CounterInput, *counterprotocolv1.CounterOutput, *counterprotocolv1.GetInput, *counterprotocolv1.GetOutput]
 }
 
@@ -101,6 +102,21 @@ func (s *counterExecutor) init() {
 			}
 		}).
 		Build(s.Decrement)
+	s.setBounds = statemachine.NewProposer[*counterprotocolv1.CounterInput, *counterprotocolv1.CounterOutput, *counterprotocolv1.SetBoundsInput, *counterprotocolv1.SetBoundsOutput]("SetBounds").
+		Decoder(func(input *counterprotocolv1.CounterInput) (*counterprotocolv1.SetBoundsInput, bool) {
+			if set, ok := input.Input.(*counterprotocolv1.CounterInput_SetBounds); ok {
+				return set.SetBounds, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *counterprotocolv1.SetBoundsOutput) *counterprotocolv1.CounterOutput {
+			return &counterprotocolv1.CounterOutput{
+				Output: &counterprotocolv1.CounterOutput_SetBounds{
+					SetBounds: output,
+				},
+			}
+		}).
+		Build(s.SetBounds)
 	s.get = statemachine.NewQuerier[*counterprotocolv1.CounterInput, *counterprotocolv1.CounterOutput, *counterprotocolv1.GetInput, *counterprotocolv1.GetOutput]("Get").
 		Decoder(func(input *counterprotocolv1.CounterInput) (*counterprotocolv1.GetInput, bool) {
 			if set, ok := input.Input.(*counterprotocolv1.CounterInput_Get); ok {
@@ -128,6 +144,8 @@ func (s *counterExecutor) Propose(proposal statemachine.Proposal[*counterprotoco
 		s.increment(proposal)
 	case *counterprotocolv1.CounterInput_Decrement:
 		s.decrement(proposal)
+	case *counterprotocolv1.CounterInput_SetBounds:
+		s.setBounds(proposal)
 	default:
 		proposal.Error(errors.NewNotSupported("proposal not supported"))
 	}
@@ -23,6 +23,7 @@ var PrimitiveType = protocol.PrimitiveType{
 
 const (
 	version1 uint32 = 1
+	version2 uint32 = 2
 )
 
 func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
@@ -43,6 +44,7 @@ type CounterStateMachine interface {
 	Update(proposal statemachine.Proposal[*counterprotocolv1.UpdateInput, *counterprotocolv1.UpdateOutput])
 	Increment(proposal statemachine.Proposal[*counterprotocolv1.IncrementInput, *counterprotocolv1.IncrementOutput])
 	Decrement(proposal statemachine.Proposal[*counterprotocolv1.DecrementInput, *counterprotocolv1.DecrementOutput])
+	SetBounds(proposal statemachine.Proposal[*counterprotocolv1.SetBoundsInput, *counterprotocolv1.SetBoundsOutput])
 	Get(query statemachine.Query[*counterprotocolv1.GetInput, *counterprotocolv1.GetOutput])
 }
 
@@ -54,16 +56,28 @@ func NewCounterStateMachine(ctx statemachine.PrimitiveContext[*counterprotocolv1
 
 type counterStateMachine struct {
 	CounterContext
-	value int64
+	value  int64
+	bounds *counterprotocolv1.Bounds
+	// configured indicates whether the bounds have ever been set
+	configured bool
 }
 
 func (s *counterStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
-	if err := writer.WriteVarUint32(version1); err != nil {
+	if err := writer.WriteVarUint32(version2); err != nil {
 		return err
 	}
 	if err := writer.WriteVarInt64(s.value); err != nil {
 		return err
 	}
+	if err := writeBound(writer, s.bounds.GetMin()); err != nil {
+		return err
+	}
+	if err := writeBound(writer, s.bounds.GetMax()); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(s.configured); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -73,21 +87,69 @@ func (s *counterStateMachine) Recover(reader *statemachine.SnapshotReader) error
 		return err
 	}
 	switch version {
-	case version1:
+	case version1, version2:
 		i, err := reader.ReadVarInt64()
 		if err != nil {
 			return err
 		}
 		s.value = i
+		if version == version1 {
+			return nil
+		}
+		lower, err := readBound(reader)
+		if err != nil {
+			return err
+		}
+		upper, err := readBound(reader)
+		if err != nil {
+			return err
+		}
+		if lower != nil || upper != nil {
+			s.bounds = &counterprotocolv1.Bounds{
+				Min: lower,
+				Max: upper,
+			}
+		}
+		s.configured, err = reader.ReadBool()
+		if err != nil {
+			return err
+		}
 	default:
 		return errors.NewInvalid("unknown snapshot version %d", version)
 	}
 	return nil
 }
 
+func writeBound(writer *statemachine.SnapshotWriter, bound *counterprotocolv1.Bound) error {
+	if err := writer.WriteBool(bound != nil); err != nil {
+		return err
+	}
+	if bound == nil {
+		return nil
+	}
+	return writer.WriteVarInt64(bound.Value)
+}
+
+func readBound(reader *statemachine.SnapshotReader) (*counterprotocolv1.Bound, error) {
+	ok, err := reader.ReadBool()
+	if err != nil || !ok {
+		return nil, err
+	}
+	value, err := reader.ReadVarInt64()
+	if err != nil {
+		return nil, err
+	}
+	return &counterprotocolv1.Bound{Value: value}, nil
+}
+
 func (s *counterStateMachine) Set(proposal statemachine.Proposal[*counterprotocolv1.SetInput, *counterprotocolv1.SetOutput]) {
 	defer proposal.Close()
-	s.value = proposal.Input().Value
+	value, err := s.checkBounds(proposal.Input().Value, false)
+	if err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.value = value
 	proposal.Output(&counterprotocolv1.SetOutput{
 		Value: s.value,
 	})
@@ -97,8 +159,10 @@ func (s *counterStateMachine) Update(proposal statemachine.Proposal[*counterprot
 	defer proposal.Close()
 	if s.value != proposal.Input().Compare {
 		proposal.Error(errors.NewConflict("optimistic lock failure"))
+	} else if value, err := s.checkBounds(proposal.Input().Update, false); err != nil {
+		proposal.Error(err)
 	} else {
-		s.value = proposal.Input().Update
+		s.value = value
 		proposal.Output(&counterprotocolv1.UpdateOutput{
 			Value: s.value,
 		})
@@ -107,7 +171,14 @@ func (s *counterStateMachine) Update(proposal statemachine.Proposal[*counterprot
 
 func (s *counterStateMachine) Increment(proposal statemachine.Proposal[*counterprotocolv1.IncrementInput, *counterprotocolv1.IncrementOutput]) {
 	defer proposal.Close()
-	s.value += proposal.Input().Delta
+	delta := proposal.Input().Delta
+	value, overflow := add(s.value, delta)
+	value, err := s.checkOverflow(value, overflow, delta > 0, proposal.Input().Saturate)
+	if err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.value = value
 	proposal.Output(&counterprotocolv1.IncrementOutput{
 		Value: s.value,
 	})
@@ -115,7 +186,14 @@ func (s *counterStateMachine) Increment(proposal statemachine.Proposal[*counterp
 
 func (s *counterStateMachine) Decrement(proposal statemachine.Proposal[*counterprotocolv1.DecrementInput, *counterprotocolv1.DecrementOutput]) {
 	defer proposal.Close()
-	s.value -= proposal.Input().Delta
+	delta := proposal.Input().Delta
+	value, overflow := subtract(s.value, delta)
+	value, err := s.checkOverflow(value, overflow, delta < 0, proposal.Input().Saturate)
+	if err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.value = value
 	proposal.Output(&counterprotocolv1.DecrementOutput{
 		Value: s.value,
 	})
@@ -124,6 +202,80 @@ func (s *counterStateMachine) Decrement(proposal statemachine.Proposal[*counterp
 func (s *counterStateMachine) Get(query statemachine.Query[*counterprotocolv1.GetInput, *counterprotocolv1.GetOutput]) {
 	defer query.Close()
 	query.Output(&counterprotocolv1.GetOutput{
+		Value:  s.value,
+		Bounds: s.bounds,
+	})
+}
+
+func (s *counterStateMachine) SetBounds(proposal statemachine.Proposal[*counterprotocolv1.SetBoundsInput, *counterprotocolv1.SetBoundsOutput]) {
+	defer proposal.Close()
+	if proposal.Input().Init && s.configured {
+		proposal.Output(&counterprotocolv1.SetBoundsOutput{
+			Value: s.value,
+		})
+		return
+	}
+	bounds := proposal.Input().Bounds
+	if bounds.GetMin() != nil && bounds.GetMax() != nil && bounds.Min.Value > bounds.Max.Value {
+		proposal.Error(errors.NewInvalid("lower bound %d is greater than upper bound %d", bounds.Min.Value, bounds.Max.Value))
+		return
+	}
+	if bounds.GetMin() == nil && bounds.GetMax() == nil {
+		s.bounds = nil
+	} else {
+		s.bounds = bounds
+	}
+	s.configured = true
+
+	// Move the current value to the nearest bound if it's outside the new bounds
+	s.value, _ = s.checkBounds(s.value, true)
+	proposal.Output(&counterprotocolv1.SetBoundsOutput{
 		Value: s.value,
 	})
 }
+
+// checkOverflow checks the result of adding a delta to the counter value against the counter bounds.
+// A result that overflows an int64 crosses the upper bound if up is true and the lower bound otherwise,
+// and returns an OutOfRange error if there's no bound to saturate to.
+func (s *counterStateMachine) checkOverflow(value int64, overflow, up, saturate bool) (int64, error) {
+	if !overflow {
+		return s.checkBounds(value, saturate)
+	}
+	if upper := s.bounds.GetMax(); saturate && up && upper != nil {
+		return upper.Value, nil
+	}
+	if lower := s.bounds.GetMin(); saturate && !up && lower != nil {
+		return lower.Value, nil
+	}
+	return 0, errors.NewOutOfRange("counter value %d overflows", s.value)
+}
+
+// checkBounds returns the given value if it's within the counter bounds. If the value crosses a bound,
+// checkBounds returns the crossed bound when saturate is true and an OutOfRange error otherwise.
+func (s *counterStateMachine) checkBounds(value int64, saturate bool) (int64, error) {
+	if lower := s.bounds.GetMin(); lower != nil && value < lower.Value {
+		if !saturate {
+			return 0, errors.NewOutOfRange("value %d is less than the lower bound %d", value, lower.Value)
+		}
+		return lower.Value, nil
+	}
+	if upper := s.bounds.GetMax(); upper != nil && value > upper.Value {
+		if !saturate {
+			return 0, errors.NewOutOfRange("value %d is greater than the upper bound %d", value, upper.Value)
+		}
+		return upper.Value, nil
+	}
+	return value, nil
+}
+
+// add returns the sum of the value and delta and whether the sum overflows an int64
+func add(value, delta int64) (int64, bool) {
+	sum := value + delta
+	return sum, (delta > 0 && sum < value) || (delta < 0 && sum > value)
+}
+
+// subtract returns the difference of the value and delta and whether the difference overflows an int64
+func subtract(value, delta int64) (int64, bool) {
+	difference := value - delta
+	return difference, (delta > 0 && difference > value) || (delta < 0 && difference < value)
+}
@@ -33,17 +33,22 @@ func newExecutor(sm CounterMapStateMachine) statemachine.PrimitiveStateMachine[*
 
 type CounterMapExecutor struct {
 	CounterMapStateMachine
-	set       statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.SetInput, *countermapprotocolv1.SetOutput]
-	insert    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.InsertInput, *countermapprotocolv1.InsertOutput]
-	update    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.UpdateInput, *countermapprotocolv1.UpdateOutput]
-	remove    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.RemoveInput, *countermapprotocolv1.RemoveOutput]
-	increment statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.IncrementInput, *countermapprotocolv1.IncrementOutput]
-	decrement statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.DecrementInput, *countermapprotocolv1.DecrementOutput]
-	clear     statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.ClearInput, *countermapprotocolv1.ClearOutput]
-	events    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.EventsInput, *countermapprotocolv1.EventsOutput]
-	size      statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.SizeInput, *countermapprotocolv1.SizeOutput]
-	get       statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.GetInput, *countermapprotocolv1.GetOutput]
-	list      statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.EntriesInput, *countermapprotocolv1.EntriesOutput]
+	set          statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.SetInput, *countermapprotocolv1.SetOutput]
+	insert       statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.InsertInput, *countermapprotocolv1.InsertOutput]
+	update       statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.UpdateInput, *countermapprotocolv1.UpdateOutput]
+	remove       statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.RemoveInput, *countermapprotocolv1.RemoveOutput]
+	increment    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.IncrementInput, *countermapprotocolv1.IncrementOutput]
+	decrement    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.DecrementInput, *countermapprotocolv1.DecrementOutput]
+	configure    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.ConfigureInput, *countermapprotocolv1.ConfigureOutput]
+	setBounds    statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.SetBoundsInput, *countermapprotocolv1.SetBoundsOutput]
+	clear        statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.ClearInput, *countermapprotocolv1.ClearOutput]
+	events       statemachine.Proposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.EventsInput, *countermapprotocolv1.EventsOutput]
+	size         statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.SizeInput, *countermapprotocolv1.SizeOutput]
+	get          statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.GetInput, *countermapprotocolv1.GetOutput]
+	list         statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.EntriesInput, *countermapprotocolv1.EntriesOutput]
+	topK         statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.TopKInput, *countermapprotocolv1.TopKOutput]
+	bottomK      statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.BottomKInput, *countermapprotocolv1.BottomKOutput]
+	rangeByValue statemachine.Querier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.RangeByValueInput, *countermapprotocolv1.RangeByValueOutput]
 }
 
 func (s *CounterMapExecutor) init() {
@@ -137,6 +142,36 @@ func (s *CounterMapExecutor) init() {
 			}
 		}).
 		Build(s.Decrement)
+	s.configure = statemachine.NewProposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.ConfigureInput, *countermapprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *countermapprotocolv1.CounterMapInput) (*countermapprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*countermapprotocolv1.CounterMapInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *countermapprotocolv1.ConfigureOutput) *countermapprotocolv1.CounterMapOutput {
+			return &countermapprotocolv1.CounterMapOutput{
+				Output: &countermapprotocolv1.CounterMapOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.setBounds = statemachine.NewProposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.SetBoundsInput, *countermapprotocolv1.SetBoundsOutput]("SetBounds").
+		Decoder(func(input *countermapprotocolv1.CounterMapInput) (*countermapprotocolv1.SetBoundsInput, bool) {
+			if setBounds, ok := input.Input.(*countermapprotocolv1.CounterMapInput_SetBounds); ok {
+				return setBounds.SetBounds, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *countermapprotocolv1.SetBoundsOutput) *countermapprotocolv1.CounterMapOutput {
+			return &countermapprotocolv1.CounterMapOutput{
+				Output: &countermapprotocolv1.CounterMapOutput_SetBounds{
+					SetBounds: output,
+				},
+			}
+		}).
+		Build(s.SetBounds)
 	s.clear = statemachine.NewProposer[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.ClearInput, *countermapprotocolv1.ClearOutput]("Clear").
 		Decoder(func(input *countermapprotocolv1.CounterMapInput) (*countermapprotocolv1.ClearInput, bool) {
 			if clear, ok := input.Input.(*countermapprotocolv1.CounterMapInput_Clear); ok {
@@ -212,6 +247,51 @@ func (s *CounterMapExecutor) init() {
 			}
 		}).
 		Build(s.Entries)
+	s.topK = statemachine.NewQuerier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.TopKInput, *countermapprotocolv1.TopKOutput]("TopK").
+		Decoder(func(input *countermapprotocolv1.CounterMapInput) (*countermapprotocolv1.TopKInput, bool) {
+			if topK, ok := input.Input.(*countermapprotocolv1.CounterMapInput_TopK); ok {
+				return topK.TopK, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *countermapprotocolv1.TopKOutput) *countermapprotocolv1.CounterMapOutput {
+			return &countermapprotocolv1.CounterMapOutput{
+				Output: &countermapprotocolv1.CounterMapOutput_TopK{
+					TopK: output,
+				},
+			}
+		}).
+		Build(s.TopK)
+	s.bottomK = statemachine.NewQuerier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.BottomKInput, *countermapprotocolv1.BottomKOutput]("BottomK").
+		Decoder(func(input *countermapprotocolv1.CounterMapInput) (*countermapprotocolv1.BottomKInput, bool) {
+			if bottomK, ok := input.Input.(*countermapprotocolv1.CounterMapInput_BottomK); ok {
+				return bottomK.BottomK, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *countermapprotocolv1.BottomKOutput) *countermapprotocolv1.CounterMapOutput {
+			return &countermapprotocolv1.CounterMapOutput{
+				Output: &countermapprotocolv1.CounterMapOutput_BottomK{
+					BottomK: output,
+				},
+			}
+		}).
+		Build(s.BottomK)
+	s.rangeByValue = statemachine.NewQuerier[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput, *countermapprotocolv1.RangeByValueInput, *countermapprotocolv1.RangeByValueOutput]("RangeByValue").
+		Decoder(func(input *countermapprotocolv1.CounterMapInput) (*countermapprotocolv1.RangeByValueInput, bool) {
+			if rangeByValue, ok := input.Input.(*countermapprotocolv1.CounterMapInput_RangeByValue); ok {
+				return rangeByValue.RangeByValue, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *countermapprotocolv1.RangeByValueOutput) *countermapprotocolv1.CounterMapOutput {
+			return &countermapprotocolv1.CounterMapOutput{
+				Output: &countermapprotocolv1.CounterMapOutput_RangeByValue{
+					RangeByValue: output,
+				},
+			}
+		}).
+		Build(s.RangeByValue)
 }
 
 func (s *CounterMapExecutor) Propose(proposal statemachine.Proposal[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput]) {
@@ -226,6 +306,10 @@ func (s *CounterMapExecutor) Propose(proposal statemachine.Proposal[*countermapp
 		s.increment(proposal)
 	case *countermapprotocolv1.CounterMapInput_Decrement:
 		s.decrement(proposal)
+	case *countermapprotocolv1.CounterMapInput_Configure:
+		s.configure(proposal)
+	case *countermapprotocolv1.CounterMapInput_SetBounds:
+		s.setBounds(proposal)
 	case *countermapprotocolv1.CounterMapInput_Remove:
 		s.remove(proposal)
 	case *countermapprotocolv1.CounterMapInput_Clear:
@@ -246,6 +330,12 @@ func (s *CounterMapExecutor) Query(query statemachine.Query[*countermapprotocolv
 		s.get(query)
 	case *countermapprotocolv1.CounterMapInput_Entries:
 		s.list(query)
+	case *countermapprotocolv1.CounterMapInput_TopK:
+		s.topK(query)
+	case *countermapprotocolv1.CounterMapInput_BottomK:
+		s.bottomK(query)
+	case *countermapprotocolv1.CounterMapInput_RangeByValue:
+		s.rangeByValue(query)
 	default:
 		query.Error(errors.NewNotSupported("query not supported"))
 	}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/google/btree"
+)
+
+// indexDegree is the degree of the B-tree backing the value index
+const indexDegree = 32
+
+// valueIndex is an index of the entries in the map ordered by value
+// Entries with equal values are ordered by key.
+type valueIndex struct {
+	entries *btree.BTree
+}
+
+type indexEntry struct {
+	key   string
+	value int64
+}
+
+func (e indexEntry) Less(than btree.Item) bool {
+	other := than.(indexEntry)
+	if e.value != other.value {
+		return e.value < other.value
+	}
+	return e.key < other.key
+}
+
+func newValueIndex() *valueIndex {
+	return &valueIndex{
+		entries: btree.New(indexDegree),
+	}
+}
+
+// add adds the given entry to the index
+func (i *valueIndex) add(key string, value int64) {
+	i.entries.ReplaceOrInsert(indexEntry{key: key, value: value})
+}
+
+// remove removes the given entry from the index if present
+func (i *valueIndex) remove(key string, value int64) {
+	i.entries.Delete(indexEntry{key: key, value: value})
+}
+
+// ascend calls f with each entry with a value of at least from in ascending order until f returns false
+func (i *valueIndex) ascend(from int64, f func(key string, value int64) bool) {
+	// An entry with an empty key orders before all entries with the same value
+	i.entries.AscendGreaterOrEqual(indexEntry{value: from}, func(item btree.Item) bool {
+		entry := item.(indexEntry)
+		return f(entry.key, entry.value)
+	})
+}
+
+// descend calls f with each entry in descending order until f returns false
+func (i *valueIndex) descend(f func(key string, value int64) bool) {
+	i.entries.Descend(func(item btree.Item) bool {
+		entry := item.(indexEntry)
+		return f(entry.key, entry.value)
+	})
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestValueIndex(t *testing.T) {
+	index := newValueIndex()
+	index.add("c", 3)
+	index.add("a", 1)
+	index.add("d", 3)
+	index.add("b", -2)
+	index.add("a", 1)
+	assert.Equal(t, 4, index.entries.Len())
+
+	ascend := func(from int64, limit int) []string {
+		var keys []string
+		index.ascend(from, func(key string, value int64) bool {
+			keys = append(keys, key)
+			return len(keys) < limit
+		})
+		return keys
+	}
+	descend := func(limit int) []string {
+		var keys []string
+		index.descend(func(key string, value int64) bool {
+			keys = append(keys, key)
+			return len(keys) < limit
+		})
+		return keys
+	}
+
+	assert.Equal(t, []string{"b", "a", "c", "d"}, ascend(math.MinInt64, 10))
+	assert.Equal(t, []string{"b", "a"}, ascend(math.MinInt64, 2))
+	assert.Equal(t, []string{"a", "c"}, ascend(0, 2))
+	assert.Equal(t, []string{"c", "d"}, ascend(2, 10))
+	assert.Empty(t, ascend(4, 10))
+	assert.Equal(t, []string{"d", "c", "a"}, descend(3))
+
+	index.remove("c", 3)
+	index.remove("a", 2)
+	index.remove("a", 1)
+	index.add("a", 4)
+	assert.Equal(t, []string{"b", "d", "a"}, ascend(math.MinInt64, 10))
+}
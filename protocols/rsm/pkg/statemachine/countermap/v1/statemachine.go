@@ -5,6 +5,7 @@
 package v1
 
 import (
+	"math"
 	"sync"
 
 	"github.com/atomix/atomix/api/errors"
@@ -25,6 +26,8 @@ var PrimitiveType = protocol.PrimitiveType{
 
 const (
 	version1 uint32 = 1
+	version2 uint32 = 2
+	version3 uint32 = 3
 )
 
 func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
@@ -78,11 +81,16 @@ type CounterMapStateMachine interface {
 	Remove(statemachine.Proposal[*countermapprotocolv1.RemoveInput, *countermapprotocolv1.RemoveOutput])
 	Increment(statemachine.Proposal[*countermapprotocolv1.IncrementInput, *countermapprotocolv1.IncrementOutput])
 	Decrement(statemachine.Proposal[*countermapprotocolv1.DecrementInput, *countermapprotocolv1.DecrementOutput])
+	Configure(statemachine.Proposal[*countermapprotocolv1.ConfigureInput, *countermapprotocolv1.ConfigureOutput])
+	SetBounds(statemachine.Proposal[*countermapprotocolv1.SetBoundsInput, *countermapprotocolv1.SetBoundsOutput])
 	Clear(statemachine.Proposal[*countermapprotocolv1.ClearInput, *countermapprotocolv1.ClearOutput])
 	Events(statemachine.Proposal[*countermapprotocolv1.EventsInput, *countermapprotocolv1.EventsOutput])
 	Size(statemachine.Query[*countermapprotocolv1.SizeInput, *countermapprotocolv1.SizeOutput])
 	Get(statemachine.Query[*countermapprotocolv1.GetInput, *countermapprotocolv1.GetOutput])
 	Entries(statemachine.Query[*countermapprotocolv1.EntriesInput, *countermapprotocolv1.EntriesOutput])
+	TopK(statemachine.Query[*countermapprotocolv1.TopKInput, *countermapprotocolv1.TopKOutput])
+	BottomK(statemachine.Query[*countermapprotocolv1.BottomKInput, *countermapprotocolv1.BottomKOutput])
+	RangeByValue(statemachine.Query[*countermapprotocolv1.RangeByValueInput, *countermapprotocolv1.RangeByValueOutput])
 }
 
 func NewCounterMapStateMachine(context statemachine.PrimitiveContext[*countermapprotocolv1.CounterMapInput, *countermapprotocolv1.CounterMapOutput]) CounterMapStateMachine {
@@ -90,6 +98,8 @@ func NewCounterMapStateMachine(context statemachine.PrimitiveContext[*countermap
 		CounterMapContext: newContext(context),
 		listeners:         make(map[statemachine.ProposalID]*countermapprotocolv1.CounterMapListener),
 		entries:           make(map[string]int64),
+		values:            newValueIndex(),
+		bounds:            make(map[string]*countermapprotocolv1.Bounds),
 		watchers:          make(map[statemachine.QueryID]statemachine.Query[*countermapprotocolv1.EntriesInput, *countermapprotocolv1.EntriesOutput]),
 	}
 }
@@ -98,13 +108,19 @@ type counterMapStateMachine struct {
 	CounterMapContext
 	listeners map[statemachine.ProposalID]*countermapprotocolv1.CounterMapListener
 	entries   map[string]int64
-	watchers  map[statemachine.QueryID]statemachine.Query[*countermapprotocolv1.EntriesInput, *countermapprotocolv1.EntriesOutput]
-	mu        sync.RWMutex
+	values    *valueIndex
+	// defaultBounds are the bounds of keys without bounds of their own
+	defaultBounds *countermapprotocolv1.Bounds
+	// configured indicates whether the map has been configured
+	configured bool
+	bounds     map[string]*countermapprotocolv1.Bounds
+	watchers   map[statemachine.QueryID]statemachine.Query[*countermapprotocolv1.EntriesInput, *countermapprotocolv1.EntriesOutput]
+	mu         sync.RWMutex
 }
 
 func (s *counterMapStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
 	s.Log().Infow("Persisting CounterMap to snapshot")
-	if err := writer.WriteVarUint32(version1); err != nil {
+	if err := writer.WriteVarUint32(version3); err != nil {
 		return err
 	}
 	if err := writer.WriteVarInt(len(s.listeners)); err != nil {
@@ -130,6 +146,24 @@ func (s *counterMapStateMachine) Snapshot(writer *statemachine.SnapshotWriter) e
 			return err
 		}
 	}
+
+	if err := writeBounds(writer, s.defaultBounds); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(s.configured); err != nil {
+		return err
+	}
+	if err := writer.WriteVarInt(len(s.bounds)); err != nil {
+		return err
+	}
+	for key, bounds := range s.bounds {
+		if err := writer.WriteString(key); err != nil {
+			return err
+		}
+		if err := writeBounds(writer, bounds); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -140,7 +174,7 @@ func (s *counterMapStateMachine) Recover(reader *statemachine.SnapshotReader) er
 		return err
 	}
 	switch version {
-	case version1:
+	case version1, version2, version3:
 		n, err := reader.ReadVarInt()
 		if err != nil {
 			return err
@@ -179,7 +213,38 @@ func (s *counterMapStateMachine) Recover(reader *statemachine.SnapshotReader) er
 			if err != nil {
 				return err
 			}
-			s.entries[key] = value
+			s.setEntry(key, value)
+		}
+		if version == version1 {
+			return nil
+		}
+
+		s.defaultBounds, err = readBounds(reader)
+		if err != nil {
+			return err
+		}
+		if version == version2 {
+			s.configured = s.defaultBounds != nil
+		} else {
+			s.configured, err = reader.ReadBool()
+			if err != nil {
+				return err
+			}
+		}
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			key, err := reader.ReadString()
+			if err != nil {
+				return err
+			}
+			bounds, err := readBounds(reader)
+			if err != nil {
+				return err
+			}
+			s.bounds[key] = bounds
 		}
 	default:
 		return errors.NewInvalid("unknown snapshot version %d", version)
@@ -191,9 +256,13 @@ func (s *counterMapStateMachine) Set(proposal statemachine.Proposal[*countermapp
 	defer proposal.Close()
 
 	key := proposal.Input().Key
-	newValue := proposal.Input().Value
+	newValue, err := s.checkBounds(key, proposal.Input().Value, false)
+	if err != nil {
+		proposal.Error(err)
+		return
+	}
 	oldValue, updated := s.entries[key]
-	s.entries[key] = newValue
+	s.setEntry(key, newValue)
 
 	// Publish an event to listener streams.
 	if updated {
@@ -239,7 +308,12 @@ func (s *counterMapStateMachine) Insert(proposal statemachine.Proposal[*counterm
 		return
 	}
 
-	s.entries[key] = value
+	if _, err := s.checkBounds(key, value, false); err != nil {
+		proposal.Error(err)
+		return
+	}
+
+	s.setEntry(key, value)
 
 	s.notify(key, value, &countermapprotocolv1.EventsOutput{
 		Event: countermapprotocolv1.Event{
@@ -271,7 +345,12 @@ func (s *counterMapStateMachine) Update(proposal statemachine.Proposal[*counterm
 		return
 	}
 
-	s.entries[key] = newValue
+	if _, err := s.checkBounds(key, newValue, false); err != nil {
+		proposal.Error(err)
+		return
+	}
+
+	s.setEntry(key, newValue)
 
 	// Publish an event to listener streams.
 	s.notify(key, newValue, &countermapprotocolv1.EventsOutput{
@@ -300,8 +379,13 @@ func (s *counterMapStateMachine) Increment(proposal statemachine.Proposal[*count
 	}
 
 	oldValue, updated := s.entries[key]
-	newValue := oldValue + delta
-	s.entries[key] = newValue
+	newValue, overflow := add(oldValue, delta)
+	newValue, err := s.checkOverflow(key, newValue, overflow, delta > 0, proposal.Input().Saturate)
+	if err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.setEntry(key, newValue)
 
 	// Publish an event to listener streams.
 	if updated {
@@ -343,8 +427,13 @@ func (s *counterMapStateMachine) Decrement(proposal statemachine.Proposal[*count
 	}
 
 	oldValue, updated := s.entries[key]
-	newValue := oldValue - delta
-	s.entries[key] = newValue
+	newValue, overflow := subtract(oldValue, delta)
+	newValue, err := s.checkOverflow(key, newValue, overflow, delta < 0, proposal.Input().Saturate)
+	if err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.setEntry(key, newValue)
 
 	// Publish an event to listener streams.
 	if updated {
@@ -376,6 +465,43 @@ func (s *counterMapStateMachine) Decrement(proposal statemachine.Proposal[*count
 	})
 }
 
+func (s *counterMapStateMachine) Configure(proposal statemachine.Proposal[*countermapprotocolv1.ConfigureInput, *countermapprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	if s.configured {
+		proposal.Output(&countermapprotocolv1.ConfigureOutput{})
+		return
+	}
+	if err := validateBounds(proposal.Input().Bounds); err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.defaultBounds = proposal.Input().Bounds
+	s.configured = true
+	for key := range s.entries {
+		if _, ok := s.bounds[key]; !ok {
+			s.clamp(key)
+		}
+	}
+	proposal.Output(&countermapprotocolv1.ConfigureOutput{})
+}
+
+func (s *counterMapStateMachine) SetBounds(proposal statemachine.Proposal[*countermapprotocolv1.SetBoundsInput, *countermapprotocolv1.SetBoundsOutput]) {
+	defer proposal.Close()
+	key := proposal.Input().Key
+	bounds := proposal.Input().Bounds
+	if err := validateBounds(bounds); err != nil {
+		proposal.Error(err)
+		return
+	}
+	if bounds == nil {
+		delete(s.bounds, key)
+	} else {
+		s.bounds[key] = bounds
+	}
+	s.clamp(key)
+	proposal.Output(&countermapprotocolv1.SetBoundsOutput{})
+}
+
 func (s *counterMapStateMachine) Remove(proposal statemachine.Proposal[*countermapprotocolv1.RemoveInput, *countermapprotocolv1.RemoveOutput]) {
 	defer proposal.Close()
 
@@ -391,7 +517,7 @@ func (s *counterMapStateMachine) Remove(proposal statemachine.Proposal[*counterm
 		return
 	}
 
-	delete(s.entries, key)
+	s.removeEntry(key)
 
 	// Publish an event to listener streams.
 	s.notify(key, value, &countermapprotocolv1.EventsOutput{
@@ -422,7 +548,7 @@ func (s *counterMapStateMachine) Clear(proposal statemachine.Proposal[*counterma
 				},
 			},
 		})
-		delete(s.entries, key)
+		s.removeEntry(key)
 	}
 	proposal.Output(&countermapprotocolv1.ClearOutput{})
 }
@@ -458,9 +584,83 @@ func (s *counterMapStateMachine) Get(query statemachine.Query[*countermapprotoco
 		query.Error(errors.NewNotFound("key %s not found", query.Input().Key))
 	} else {
 		query.Output(&countermapprotocolv1.GetOutput{
+			Value:  value,
+			Bounds: s.getBounds(query.Input().Key),
+		})
+	}
+}
+
+func (s *counterMapStateMachine) TopK(query statemachine.Query[*countermapprotocolv1.TopKInput, *countermapprotocolv1.TopKOutput]) {
+	defer query.Close()
+	if query.Input().K == 0 {
+		query.Error(errors.NewInvalid("k must be positive"))
+		return
+	}
+	k := int(query.Input().K)
+	var entries []countermapprotocolv1.Entry
+	s.values.descend(func(key string, value int64) bool {
+		if len(entries) == k {
+			return false
+		}
+		entries = append(entries, countermapprotocolv1.Entry{
+			Key:   key,
 			Value: value,
 		})
+		return true
+	})
+	query.Output(&countermapprotocolv1.TopKOutput{
+		Entries: entries,
+	})
+}
+
+func (s *counterMapStateMachine) BottomK(query statemachine.Query[*countermapprotocolv1.BottomKInput, *countermapprotocolv1.BottomKOutput]) {
+	defer query.Close()
+	if query.Input().K == 0 {
+		query.Error(errors.NewInvalid("k must be positive"))
+		return
 	}
+	k := int(query.Input().K)
+	var entries []countermapprotocolv1.Entry
+	s.values.ascend(math.MinInt64, func(key string, value int64) bool {
+		if len(entries) == k {
+			return false
+		}
+		entries = append(entries, countermapprotocolv1.Entry{
+			Key:   key,
+			Value: value,
+		})
+		return true
+	})
+	query.Output(&countermapprotocolv1.BottomKOutput{
+		Entries: entries,
+	})
+}
+
+func (s *counterMapStateMachine) RangeByValue(query statemachine.Query[*countermapprotocolv1.RangeByValueInput, *countermapprotocolv1.RangeByValueOutput]) {
+	defer query.Close()
+	bounds := query.Input().Bounds
+	lower := int64(math.MinInt64)
+	if bounds.GetMin() != nil {
+		lower = bounds.Min.Value
+	}
+	limit := int(query.Input().Limit)
+	var entries []countermapprotocolv1.Entry
+	s.values.ascend(lower, func(key string, value int64) bool {
+		if bounds.GetMax() != nil && value > bounds.Max.Value {
+			return false
+		}
+		if limit > 0 && len(entries) == limit {
+			return false
+		}
+		entries = append(entries, countermapprotocolv1.Entry{
+			Key:   key,
+			Value: value,
+		})
+		return true
+	})
+	query.Output(&countermapprotocolv1.RangeByValueOutput{
+		Entries: entries,
+	})
 }
 
 func (s *counterMapStateMachine) Entries(query statemachine.Query[*countermapprotocolv1.EntriesInput, *countermapprotocolv1.EntriesOutput]) {
@@ -512,3 +712,162 @@ func (s *counterMapStateMachine) notify(key string, value int64, event *counterm
 		})
 	}
 }
+
+// setEntry sets the value of the given key in the map and the value index
+func (s *counterMapStateMachine) setEntry(key string, value int64) {
+	if oldValue, ok := s.entries[key]; ok {
+		s.values.remove(key, oldValue)
+	}
+	s.entries[key] = value
+	s.values.add(key, value)
+}
+
+// removeEntry removes the given key from the map and the value index along with the bounds of the key
+func (s *counterMapStateMachine) removeEntry(key string) {
+	if value, ok := s.entries[key]; ok {
+		s.values.remove(key, value)
+		delete(s.entries, key)
+	}
+	delete(s.bounds, key)
+}
+
+// getBounds returns the bounds of the given key
+func (s *counterMapStateMachine) getBounds(key string) *countermapprotocolv1.Bounds {
+	if bounds, ok := s.bounds[key]; ok {
+		return bounds
+	}
+	return s.defaultBounds
+}
+
+// checkOverflow checks the result of adding a delta to the value of the key against the bounds of the key.
+// A result that overflows an int64 crosses the upper bound if up is true and the lower bound otherwise,
+// and returns a Conflict error if there's no bound to saturate to.
+func (s *counterMapStateMachine) checkOverflow(key string, value int64, overflow, up, saturate bool) (int64, error) {
+	if !overflow {
+		return s.checkBounds(key, value, saturate)
+	}
+	bounds := s.getBounds(key)
+	if upper := bounds.GetMax(); saturate && up && upper != nil {
+		return upper.Value, nil
+	}
+	if lower := bounds.GetMin(); saturate && !up && lower != nil {
+		return lower.Value, nil
+	}
+	return 0, errors.NewConflict("value for key '%s' overflows", key)
+}
+
+// checkBounds returns the given value if it's within the bounds of the key. If the value crosses a bound,
+// checkBounds returns the crossed bound when saturate is true and a Conflict error otherwise.
+func (s *counterMapStateMachine) checkBounds(key string, value int64, saturate bool) (int64, error) {
+	bounds := s.getBounds(key)
+	if lower := bounds.GetMin(); lower != nil && value < lower.Value {
+		if !saturate {
+			return 0, errors.NewConflict("value %d for key '%s' is less than the lower bound %d", value, key, lower.Value)
+		}
+		return lower.Value, nil
+	}
+	if upper := bounds.GetMax(); upper != nil && value > upper.Value {
+		if !saturate {
+			return 0, errors.NewConflict("value %d for key '%s' is greater than the upper bound %d", value, key, upper.Value)
+		}
+		return upper.Value, nil
+	}
+	return value, nil
+}
+
+// clamp moves the value of the given key to the nearest bound if it's outside the bounds of the key
+func (s *counterMapStateMachine) clamp(key string) {
+	oldValue, ok := s.entries[key]
+	if !ok {
+		return
+	}
+	newValue, _ := s.checkBounds(key, oldValue, true)
+	if newValue == oldValue {
+		return
+	}
+	s.setEntry(key, newValue)
+	s.notify(key, newValue, &countermapprotocolv1.EventsOutput{
+		Event: countermapprotocolv1.Event{
+			Key: key,
+			Event: &countermapprotocolv1.Event_Updated_{
+				Updated: &countermapprotocolv1.Event_Updated{
+					Value:     newValue,
+					PrevValue: oldValue,
+				},
+			},
+		},
+	})
+}
+
+// add returns the sum of the value and delta and whether the sum overflows an int64
+func add(value, delta int64) (int64, bool) {
+	sum := value + delta
+	return sum, (delta > 0 && sum < value) || (delta < 0 && sum > value)
+}
+
+// subtract returns the difference of the value and delta and whether the difference overflows an int64
+func subtract(value, delta int64) (int64, bool) {
+	difference := value - delta
+	return difference, (delta > 0 && difference > value) || (delta < 0 && difference < value)
+}
+
+func validateBounds(bounds *countermapprotocolv1.Bounds) error {
+	if bounds.GetMin() != nil && bounds.GetMax() != nil && bounds.Min.Value > bounds.Max.Value {
+		return errors.NewInvalid("lower bound %d is greater than upper bound %d", bounds.Min.Value, bounds.Max.Value)
+	}
+	return nil
+}
+
+func writeBounds(writer *statemachine.SnapshotWriter, bounds *countermapprotocolv1.Bounds) error {
+	if err := writer.WriteBool(bounds != nil); err != nil {
+		return err
+	}
+	if bounds == nil {
+		return nil
+	}
+	if err := writeBound(writer, bounds.Min); err != nil {
+		return err
+	}
+	return writeBound(writer, bounds.Max)
+}
+
+func readBounds(reader *statemachine.SnapshotReader) (*countermapprotocolv1.Bounds, error) {
+	ok, err := reader.ReadBool()
+	if err != nil || !ok {
+		return nil, err
+	}
+	lower, err := readBound(reader)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := readBound(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &countermapprotocolv1.Bounds{
+		Min: lower,
+		Max: upper,
+	}, nil
+}
+
+func writeBound(writer *statemachine.SnapshotWriter, bound *countermapprotocolv1.Bound) error {
+	if err := writer.WriteBool(bound != nil); err != nil {
+		return err
+	}
+	if bound == nil {
+		return nil
+	}
+	return writer.WriteVarInt64(bound.Value)
+}
+
+func readBound(reader *statemachine.SnapshotReader) (*countermapprotocolv1.Bound, error) {
+	ok, err := reader.ReadBool()
+	if err != nil || !ok {
+		return nil, err
+	}
+	value, err := reader.ReadVarInt64()
+	if err != nil {
+		return nil, err
+	}
+	return &countermapprotocolv1.Bound{Value: value}, nil
+}
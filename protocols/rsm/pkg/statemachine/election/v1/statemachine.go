@@ -41,6 +41,7 @@ type LeaderElectionContext interface {
 type LeaderElectionStateMachine interface {
 	statemachine.Context[*electionprotocolv1.LeaderElectionInput, *electionprotocolv1.LeaderElectionOutput]
 	statemachine.Recoverable
+	statemachine.Fence
 	Enter(statemachine.Proposal[*electionprotocolv1.EnterInput, *electionprotocolv1.EnterOutput])
 	Withdraw(statemachine.Proposal[*electionprotocolv1.WithdrawInput, *electionprotocolv1.WithdrawOutput])
 	Anoint(statemachine.Proposal[*electionprotocolv1.AnointInput, *electionprotocolv1.AnointOutput])
@@ -139,19 +140,34 @@ func (s *leaderElectionStateMachine) watchSession(sessionID statemachine.Session
 	return nil
 }
 
+// Token returns the current term, which is incremented each time a new leader is elected
+func (s *leaderElectionStateMachine) Token() uint64 {
+	return uint64(s.Term)
+}
+
 func (s *leaderElectionStateMachine) term() electionprotocolv1.Term {
 	term := electionprotocolv1.Term{
 		Index: s.Term,
 	}
 	if s.Leader != nil {
 		term.Leader = s.Leader.Name
+		term.CandidateInfo = append(term.CandidateInfo, newCandidateInfo(*s.Leader))
 	}
 	for _, candidate := range s.Candidates {
 		term.Candidates = append(term.Candidates, candidate.Name)
+		term.CandidateInfo = append(term.CandidateInfo, newCandidateInfo(candidate))
 	}
 	return term
 }
 
+func newCandidateInfo(candidate electionprotocolv1.LeaderElectionCandidate) electionprotocolv1.Candidate {
+	return electionprotocolv1.Candidate{
+		Name:     candidate.Name,
+		Priority: candidate.Priority,
+		Metadata: candidate.Metadata,
+	}
+}
+
 func (s *leaderElectionStateMachine) candidateExists(name string) bool {
 	for _, candidate := range s.Candidates {
 		if candidate.Name == name {
@@ -161,27 +177,65 @@ func (s *leaderElectionStateMachine) candidateExists(name string) bool {
 	return false
 }
 
+// insertCandidate adds the given candidate after all candidates with an equal or higher priority
+func (s *leaderElectionStateMachine) insertCandidate(candidate electionprotocolv1.LeaderElectionCandidate) {
+	index := len(s.Candidates)
+	for i, c := range s.Candidates {
+		if c.Priority < candidate.Priority {
+			index = i
+			break
+		}
+	}
+	s.insertCandidateAt(index, candidate)
+}
+
+// restoreCandidate returns a preempted leader to the candidates ahead of all candidates with an equal priority,
+// since the leader entered the election before them
+func (s *leaderElectionStateMachine) restoreCandidate(candidate electionprotocolv1.LeaderElectionCandidate) {
+	index := len(s.Candidates)
+	for i, c := range s.Candidates {
+		if c.Priority <= candidate.Priority {
+			index = i
+			break
+		}
+	}
+	s.insertCandidateAt(index, candidate)
+}
+
+func (s *leaderElectionStateMachine) insertCandidateAt(index int, candidate electionprotocolv1.LeaderElectionCandidate) {
+	s.Candidates = append(s.Candidates, electionprotocolv1.LeaderElectionCandidate{})
+	copy(s.Candidates[index+1:], s.Candidates[index:])
+	s.Candidates[index] = candidate
+}
+
 func (s *leaderElectionStateMachine) Enter(proposal statemachine.Proposal[*electionprotocolv1.EnterInput, *electionprotocolv1.EnterOutput]) {
 	defer proposal.Close()
 	if err := s.watchSession(proposal.Session().ID()); err != nil {
 		panic(err)
 	}
+	candidate := electionprotocolv1.LeaderElectionCandidate{
+		Name:      proposal.Input().Candidate,
+		SessionID: protocol.SessionID(proposal.Session().ID()),
+		Priority:  proposal.Input().Priority,
+		Metadata:  proposal.Input().Metadata,
+	}
 	if s.Leader == nil {
 		s.Term++
-		s.Leader = &electionprotocolv1.LeaderElectionCandidate{
-			Name:      proposal.Input().Candidate,
-			SessionID: protocol.SessionID(proposal.Session().ID()),
-		}
+		s.Leader = &candidate
 		term := s.term()
 		s.notify(term)
 		proposal.Output(&electionprotocolv1.EnterOutput{
 			Term: term,
 		})
-	} else if !s.candidateExists(proposal.Input().Candidate) {
-		s.Candidates = append(s.Candidates, electionprotocolv1.LeaderElectionCandidate{
-			Name:      proposal.Input().Candidate,
-			SessionID: protocol.SessionID(proposal.Session().ID()),
-		})
+	} else if s.Leader.Name != candidate.Name && !s.candidateExists(candidate.Name) {
+		// If the candidate preempts the leader, the leader is returned to the candidates in priority order
+		if proposal.Input().Preempt && candidate.Priority > s.Leader.Priority {
+			s.restoreCandidate(*s.Leader)
+			s.Leader = &candidate
+			s.Term++
+		} else {
+			s.insertCandidate(candidate)
+		}
 		term := s.term()
 		s.notify(term)
 		proposal.Output(&electionprotocolv1.EnterOutput{
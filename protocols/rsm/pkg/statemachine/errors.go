@@ -51,6 +51,8 @@ func getStatus(err error) protocol.Failure_Status {
 		return protocol.Failure_TIMEOUT
 	case errors.Fault:
 		return protocol.Failure_FAULT
+	case errors.OutOfRange:
+		return protocol.Failure_OUT_OF_RANGE
 	case errors.Internal:
 		return protocol.Failure_INTERNAL
 	default:
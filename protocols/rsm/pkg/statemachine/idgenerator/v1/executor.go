@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	idgeneratorprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/idgenerator/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var idGeneratorCodec = statemachine.NewCodec[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput](
+	func(bytes []byte) (*idgeneratorprotocolv1.IDGeneratorInput, error) {
+		input := &idgeneratorprotocolv1.IDGeneratorInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *idgeneratorprotocolv1.IDGeneratorOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm IDGeneratorStateMachine) statemachine.PrimitiveStateMachine[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput] {
+	executor := &IDGeneratorExecutor{
+		IDGeneratorStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type IDGeneratorExecutor struct {
+	IDGeneratorStateMachine
+	allocate statemachine.Proposer[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput, *idgeneratorprotocolv1.AllocateInput, *idgeneratorprotocolv1.AllocateOutput]
+}
+
+func (s *IDGeneratorExecutor) init() {
+	s.allocate = statemachine.NewProposer[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput, *idgeneratorprotocolv1.AllocateInput, *idgeneratorprotocolv1.AllocateOutput]("Allocate").
+		Decoder(func(input *idgeneratorprotocolv1.IDGeneratorInput) (*idgeneratorprotocolv1.AllocateInput, bool) {
+			if allocate, ok := input.Input.(*idgeneratorprotocolv1.IDGeneratorInput_Allocate); ok {
+				return allocate.Allocate, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *idgeneratorprotocolv1.AllocateOutput) *idgeneratorprotocolv1.IDGeneratorOutput {
+			return &idgeneratorprotocolv1.IDGeneratorOutput{
+				Output: &idgeneratorprotocolv1.IDGeneratorOutput_Allocate{
+					Allocate: output,
+				},
+			}
+		}).
+		Build(s.Allocate)
+}
+
+func (s *IDGeneratorExecutor) Propose(proposal statemachine.Proposal[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *idgeneratorprotocolv1.IDGeneratorInput_Allocate:
+		s.allocate(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *IDGeneratorExecutor) Query(query statemachine.Query[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput]) {
+	query.Error(errors.NewNotSupported("query not supported"))
+	query.Close()
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"math"
+
+	"github.com/atomix/atomix/api/errors"
+	idgeneratorprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/idgenerator/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "IDGenerator"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+// firstID is the first ID allocated by the generator; zero is reserved to indicate the absence of an ID
+const firstID uint64 = 1
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput]) statemachine.PrimitiveStateMachine[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput] {
+			return newExecutor(NewIDGeneratorStateMachine(context))
+		}, idGeneratorCodec)
+}
+
+type IDGeneratorContext interface {
+	statemachine.PrimitiveContext[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput]
+}
+
+type IDGeneratorStateMachine interface {
+	statemachine.Context[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput]
+	statemachine.Recoverable
+	Allocate(proposal statemachine.Proposal[*idgeneratorprotocolv1.AllocateInput, *idgeneratorprotocolv1.AllocateOutput])
+}
+
+func NewIDGeneratorStateMachine(ctx statemachine.PrimitiveContext[*idgeneratorprotocolv1.IDGeneratorInput, *idgeneratorprotocolv1.IDGeneratorOutput]) IDGeneratorStateMachine {
+	return &idGeneratorStateMachine{
+		IDGeneratorContext: ctx,
+		next:               firstID,
+	}
+}
+
+type idGeneratorStateMachine struct {
+	IDGeneratorContext
+	// next is the high-water mark of allocated IDs; it only ever moves forward so that
+	// IDs in blocks abandoned by clients are never handed out again
+	next uint64
+}
+
+func (s *idGeneratorStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(s.next); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *idGeneratorStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		next, err := reader.ReadVarUint64()
+		if err != nil {
+			return err
+		}
+		s.next = next
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+func (s *idGeneratorStateMachine) Allocate(proposal statemachine.Proposal[*idgeneratorprotocolv1.AllocateInput, *idgeneratorprotocolv1.AllocateOutput]) {
+	defer proposal.Close()
+	size := proposal.Input().Size_
+	if size == 0 {
+		proposal.Error(errors.NewInvalid("block size must be greater than zero"))
+		return
+	}
+	if size > math.MaxUint64-s.next {
+		proposal.Error(errors.NewFault("ID space exhausted"))
+		return
+	}
+	start := s.next
+	s.next += size
+	proposal.Output(&idgeneratorprotocolv1.AllocateOutput{
+		Start: start,
+		End:   s.next,
+	})
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	latchprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/latch/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var latchCodec = statemachine.NewCodec[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput](
+	func(bytes []byte) (*latchprotocolv1.LatchInput, error) {
+		input := &latchprotocolv1.LatchInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *latchprotocolv1.LatchOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm LatchStateMachine) statemachine.PrimitiveStateMachine[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput] {
+	executor := &LatchExecutor{
+		LatchStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type LatchExecutor struct {
+	LatchStateMachine
+	configure statemachine.Proposer[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.ConfigureInput, *latchprotocolv1.ConfigureOutput]
+	countDown statemachine.Proposer[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.CountDownInput, *latchprotocolv1.CountDownOutput]
+	await     statemachine.Proposer[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput]
+	get       statemachine.Querier[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.GetInput, *latchprotocolv1.GetOutput]
+}
+
+func (s *LatchExecutor) init() {
+	s.configure = statemachine.NewProposer[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.ConfigureInput, *latchprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *latchprotocolv1.LatchInput) (*latchprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*latchprotocolv1.LatchInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *latchprotocolv1.ConfigureOutput) *latchprotocolv1.LatchOutput {
+			return &latchprotocolv1.LatchOutput{
+				Output: &latchprotocolv1.LatchOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.countDown = statemachine.NewProposer[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.CountDownInput, *latchprotocolv1.CountDownOutput]("CountDown").
+		Decoder(func(input *latchprotocolv1.LatchInput) (*latchprotocolv1.CountDownInput, bool) {
+			if countDown, ok := input.Input.(*latchprotocolv1.LatchInput_CountDown); ok {
+				return countDown.CountDown, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *latchprotocolv1.CountDownOutput) *latchprotocolv1.LatchOutput {
+			return &latchprotocolv1.LatchOutput{
+				Output: &latchprotocolv1.LatchOutput_CountDown{
+					CountDown: output,
+				},
+			}
+		}).
+		Build(s.CountDown)
+	s.await = statemachine.NewProposer[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput]("Await").
+		Decoder(func(input *latchprotocolv1.LatchInput) (*latchprotocolv1.AwaitInput, bool) {
+			if await, ok := input.Input.(*latchprotocolv1.LatchInput_Await); ok {
+				return await.Await, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *latchprotocolv1.AwaitOutput) *latchprotocolv1.LatchOutput {
+			return &latchprotocolv1.LatchOutput{
+				Output: &latchprotocolv1.LatchOutput_Await{
+					Await: output,
+				},
+			}
+		}).
+		Build(s.Await)
+	s.get = statemachine.NewQuerier[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.GetInput, *latchprotocolv1.GetOutput]("Get").
+		Decoder(func(input *latchprotocolv1.LatchInput) (*latchprotocolv1.GetInput, bool) {
+			if get, ok := input.Input.(*latchprotocolv1.LatchInput_Get); ok {
+				return get.Get, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *latchprotocolv1.GetOutput) *latchprotocolv1.LatchOutput {
+			return &latchprotocolv1.LatchOutput{
+				Output: &latchprotocolv1.LatchOutput_Get{
+					Get: output,
+				},
+			}
+		}).
+		Build(s.Get)
+}
+
+func (s *LatchExecutor) Propose(proposal statemachine.Proposal[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *latchprotocolv1.LatchInput_Configure:
+		s.configure(proposal)
+	case *latchprotocolv1.LatchInput_CountDown:
+		s.countDown(proposal)
+	case *latchprotocolv1.LatchInput_Await:
+		s.await(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *LatchExecutor) Query(query statemachine.Query[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]) {
+	switch query.Input().Input.(type) {
+	case *latchprotocolv1.LatchInput_Get:
+		s.get(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
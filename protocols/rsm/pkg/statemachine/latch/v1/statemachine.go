@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	latchprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/latch/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "Latch"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+const defaultCount = 1
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]) statemachine.PrimitiveStateMachine[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput] {
+			return newExecutor(NewLatchStateMachine(context))
+		}, latchCodec)
+}
+
+type LatchContext interface {
+	statemachine.PrimitiveContext[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]
+	Waiters() statemachine.Proposals[*latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]) LatchContext {
+	return &latchContext{
+		PrimitiveContext: context,
+		waiters: statemachine.NewProposals[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput, *latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput](context).
+			Decoder(func(input *latchprotocolv1.LatchInput) (*latchprotocolv1.AwaitInput, bool) {
+				if await, ok := input.Input.(*latchprotocolv1.LatchInput_Await); ok {
+					return await.Await, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *latchprotocolv1.AwaitOutput) *latchprotocolv1.LatchOutput {
+				return &latchprotocolv1.LatchOutput{
+					Output: &latchprotocolv1.LatchOutput_Await{
+						Await: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type latchContext struct {
+	statemachine.PrimitiveContext[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]
+	waiters statemachine.Proposals[*latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput]
+}
+
+func (c *latchContext) Waiters() statemachine.Proposals[*latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput] {
+	return c.waiters
+}
+
+type LatchStateMachine interface {
+	statemachine.Context[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*latchprotocolv1.ConfigureInput, *latchprotocolv1.ConfigureOutput])
+	CountDown(statemachine.Proposal[*latchprotocolv1.CountDownInput, *latchprotocolv1.CountDownOutput])
+	Await(statemachine.Proposal[*latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput])
+	Get(statemachine.Query[*latchprotocolv1.GetInput, *latchprotocolv1.GetOutput])
+}
+
+func NewLatchStateMachine(context statemachine.PrimitiveContext[*latchprotocolv1.LatchInput, *latchprotocolv1.LatchOutput]) LatchStateMachine {
+	return &latchStateMachine{
+		LatchContext: newContext(context),
+		count:        defaultCount,
+		waiters:      make(map[statemachine.ProposalID]statemachine.CancelFunc),
+		timers:       make(map[statemachine.ProposalID]statemachine.CancelFunc),
+	}
+}
+
+type latchStateMachine struct {
+	LatchContext
+	count      uint64
+	configured bool
+	waiters    map[statemachine.ProposalID]statemachine.CancelFunc
+	timers     map[statemachine.ProposalID]statemachine.CancelFunc
+}
+
+func (s *latchStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(s.count); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(s.configured); err != nil {
+		return err
+	}
+	if err := writer.WriteVarInt(len(s.waiters)); err != nil {
+		return err
+	}
+	for proposalID := range s.waiters {
+		if err := writer.WriteVarUint64(uint64(proposalID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *latchStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		s.count, err = reader.ReadVarUint64()
+		if err != nil {
+			return err
+		}
+		s.configured, err = reader.ReadBool()
+		if err != nil {
+			return err
+		}
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			proposal, ok := s.Waiters().Get(statemachine.ProposalID(proposalID))
+			if !ok {
+				return errors.NewFault("proposal not found")
+			}
+			s.watchWaiter(proposal)
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+func (s *latchStateMachine) watchWaiter(proposal statemachine.Proposal[*latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput]) {
+	s.waiters[proposal.ID()] = proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.unwatchWaiter(proposal.ID())
+		}
+	})
+
+	if proposal.Input().Timeout != nil {
+		s.timers[proposal.ID()] = s.Scheduler().Delay(*proposal.Input().Timeout, func() {
+			s.unwatchWaiter(proposal.ID())
+			proposal.Error(errors.NewTimeout("latch count has not reached zero"))
+			proposal.Close()
+		})
+	}
+}
+
+func (s *latchStateMachine) unwatchWaiter(proposalID statemachine.ProposalID) {
+	if cancel, ok := s.waiters[proposalID]; ok {
+		cancel()
+		delete(s.waiters, proposalID)
+	}
+	if cancel, ok := s.timers[proposalID]; ok {
+		cancel()
+		delete(s.timers, proposalID)
+	}
+}
+
+func (s *latchStateMachine) Configure(proposal statemachine.Proposal[*latchprotocolv1.ConfigureInput, *latchprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+
+	// The initial count is only applied once to avoid resetting a latch that has already been counted down
+	if !s.configured {
+		s.configured = true
+		if proposal.Input().Count > 0 {
+			s.count = proposal.Input().Count
+		}
+	}
+	proposal.Output(&latchprotocolv1.ConfigureOutput{})
+}
+
+func (s *latchStateMachine) CountDown(proposal statemachine.Proposal[*latchprotocolv1.CountDownInput, *latchprotocolv1.CountDownOutput]) {
+	defer proposal.Close()
+	s.configured = true
+	if s.count > 0 {
+		s.count--
+		if s.count == 0 {
+			for proposalID := range s.waiters {
+				waiter, ok := s.Waiters().Get(proposalID)
+				s.unwatchWaiter(proposalID)
+				if ok {
+					waiter.Output(&latchprotocolv1.AwaitOutput{})
+					waiter.Close()
+				}
+			}
+		}
+	}
+	proposal.Output(&latchprotocolv1.CountDownOutput{
+		Count: s.count,
+	})
+}
+
+func (s *latchStateMachine) Await(proposal statemachine.Proposal[*latchprotocolv1.AwaitInput, *latchprotocolv1.AwaitOutput]) {
+	if s.count == 0 {
+		proposal.Output(&latchprotocolv1.AwaitOutput{})
+		proposal.Close()
+		return
+	}
+	s.watchWaiter(proposal)
+}
+
+func (s *latchStateMachine) Get(query statemachine.Query[*latchprotocolv1.GetInput, *latchprotocolv1.GetOutput]) {
+	defer query.Close()
+	query.Output(&latchprotocolv1.GetOutput{
+		Count: s.count,
+	})
+}
@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	listprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/list/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var listCodec = statemachine.NewCodec[*listprotocolv1.ListInput, *listprotocolv1.ListOutput](
+	func(bytes []byte) (*listprotocolv1.ListInput, error) {
+		input := &listprotocolv1.ListInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *listprotocolv1.ListOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm ListStateMachine) statemachine.PrimitiveStateMachine[*listprotocolv1.ListInput, *listprotocolv1.ListOutput] {
+	executor := &ListExecutor{
+		ListStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type ListExecutor struct {
+	ListStateMachine
+	append statemachine.Proposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.AppendInput, *listprotocolv1.AppendOutput]
+	insert statemachine.Proposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.InsertInput, *listprotocolv1.InsertOutput]
+	set    statemachine.Proposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.SetInput, *listprotocolv1.SetOutput]
+	remove statemachine.Proposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.RemoveInput, *listprotocolv1.RemoveOutput]
+	clear  statemachine.Proposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.ClearInput, *listprotocolv1.ClearOutput]
+	events statemachine.Proposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.EventsInput, *listprotocolv1.EventsOutput]
+	size   statemachine.Querier[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.SizeInput, *listprotocolv1.SizeOutput]
+	get    statemachine.Querier[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.GetInput, *listprotocolv1.GetOutput]
+	items  statemachine.Querier[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.ItemsInput, *listprotocolv1.ItemsOutput]
+}
+
+func (s *ListExecutor) init() {
+	s.append = statemachine.NewProposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.AppendInput, *listprotocolv1.AppendOutput]("Append").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.AppendInput, bool) {
+			if appendInput, ok := input.Input.(*listprotocolv1.ListInput_Append); ok {
+				return appendInput.Append, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.AppendOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Append{
+					Append: output,
+				},
+			}
+		}).
+		Build(s.Append)
+	s.insert = statemachine.NewProposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.InsertInput, *listprotocolv1.InsertOutput]("Insert").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.InsertInput, bool) {
+			if insert, ok := input.Input.(*listprotocolv1.ListInput_Insert); ok {
+				return insert.Insert, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.InsertOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Insert{
+					Insert: output,
+				},
+			}
+		}).
+		Build(s.Insert)
+	s.set = statemachine.NewProposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.SetInput, *listprotocolv1.SetOutput]("Set").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.SetInput, bool) {
+			if set, ok := input.Input.(*listprotocolv1.ListInput_Set); ok {
+				return set.Set, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.SetOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Set{
+					Set: output,
+				},
+			}
+		}).
+		Build(s.Set)
+	s.remove = statemachine.NewProposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.RemoveInput, *listprotocolv1.RemoveOutput]("Remove").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.RemoveInput, bool) {
+			if remove, ok := input.Input.(*listprotocolv1.ListInput_Remove); ok {
+				return remove.Remove, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.RemoveOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Remove{
+					Remove: output,
+				},
+			}
+		}).
+		Build(s.Remove)
+	s.clear = statemachine.NewProposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.ClearInput, *listprotocolv1.ClearOutput]("Clear").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.ClearInput, bool) {
+			if clearInput, ok := input.Input.(*listprotocolv1.ListInput_Clear); ok {
+				return clearInput.Clear, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.ClearOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Clear{
+					Clear: output,
+				},
+			}
+		}).
+		Build(s.Clear)
+	s.events = statemachine.NewProposer[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.EventsInput, *listprotocolv1.EventsOutput]("Events").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.EventsInput, bool) {
+			if events, ok := input.Input.(*listprotocolv1.ListInput_Events); ok {
+				return events.Events, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.EventsOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Events{
+					Events: output,
+				},
+			}
+		}).
+		Build(s.Events)
+	s.size = statemachine.NewQuerier[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.SizeInput, *listprotocolv1.SizeOutput]("Size").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.SizeInput, bool) {
+			if size, ok := input.Input.(*listprotocolv1.ListInput_Size_); ok {
+				return size.Size_, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.SizeOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Size_{
+					Size_: output,
+				},
+			}
+		}).
+		Build(s.Size)
+	s.get = statemachine.NewQuerier[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.GetInput, *listprotocolv1.GetOutput]("Get").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.GetInput, bool) {
+			if get, ok := input.Input.(*listprotocolv1.ListInput_Get); ok {
+				return get.Get, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.GetOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Get{
+					Get: output,
+				},
+			}
+		}).
+		Build(s.Get)
+	s.items = statemachine.NewQuerier[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.ItemsInput, *listprotocolv1.ItemsOutput]("Items").
+		Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.ItemsInput, bool) {
+			if items, ok := input.Input.(*listprotocolv1.ListInput_Items); ok {
+				return items.Items, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *listprotocolv1.ItemsOutput) *listprotocolv1.ListOutput {
+			return &listprotocolv1.ListOutput{
+				Output: &listprotocolv1.ListOutput_Items{
+					Items: output,
+				},
+			}
+		}).
+		Build(s.Items)
+}
+
+func (s *ListExecutor) Propose(proposal statemachine.Proposal[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *listprotocolv1.ListInput_Append:
+		s.append(proposal)
+	case *listprotocolv1.ListInput_Insert:
+		s.insert(proposal)
+	case *listprotocolv1.ListInput_Set:
+		s.set(proposal)
+	case *listprotocolv1.ListInput_Remove:
+		s.remove(proposal)
+	case *listprotocolv1.ListInput_Clear:
+		s.clear(proposal)
+	case *listprotocolv1.ListInput_Events:
+		s.events(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *ListExecutor) Query(query statemachine.Query[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]) {
+	switch query.Input().Input.(type) {
+	case *listprotocolv1.ListInput_Size_:
+		s.size(query)
+	case *listprotocolv1.ListInput_Get:
+		s.get(query)
+	case *listprotocolv1.ListInput_Items:
+		s.items(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
@@ -0,0 +1,410 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"sync"
+
+	"github.com/atomix/atomix/api/errors"
+	listprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/list/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "List"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*listprotocolv1.ListInput, *listprotocolv1.ListOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]) statemachine.PrimitiveStateMachine[*listprotocolv1.ListInput, *listprotocolv1.ListOutput] {
+			return newExecutor(NewListStateMachine(context))
+		}, listCodec)
+}
+
+type ListContext interface {
+	statemachine.PrimitiveContext[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]
+	Events() statemachine.Proposals[*listprotocolv1.EventsInput, *listprotocolv1.EventsOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]) ListContext {
+	return &listContext{
+		PrimitiveContext: context,
+		events: statemachine.NewProposals[*listprotocolv1.ListInput, *listprotocolv1.ListOutput, *listprotocolv1.EventsInput, *listprotocolv1.EventsOutput](context).
+			Decoder(func(input *listprotocolv1.ListInput) (*listprotocolv1.EventsInput, bool) {
+				if events, ok := input.Input.(*listprotocolv1.ListInput_Events); ok {
+					return events.Events, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *listprotocolv1.EventsOutput) *listprotocolv1.ListOutput {
+				return &listprotocolv1.ListOutput{
+					Output: &listprotocolv1.ListOutput_Events{
+						Events: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type listContext struct {
+	statemachine.PrimitiveContext[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]
+	events statemachine.Proposals[*listprotocolv1.EventsInput, *listprotocolv1.EventsOutput]
+}
+
+func (c *listContext) Events() statemachine.Proposals[*listprotocolv1.EventsInput, *listprotocolv1.EventsOutput] {
+	return c.events
+}
+
+type ListStateMachine interface {
+	statemachine.Context[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]
+	statemachine.Recoverable
+	Append(statemachine.Proposal[*listprotocolv1.AppendInput, *listprotocolv1.AppendOutput])
+	Insert(statemachine.Proposal[*listprotocolv1.InsertInput, *listprotocolv1.InsertOutput])
+	Set(statemachine.Proposal[*listprotocolv1.SetInput, *listprotocolv1.SetOutput])
+	Remove(statemachine.Proposal[*listprotocolv1.RemoveInput, *listprotocolv1.RemoveOutput])
+	Clear(statemachine.Proposal[*listprotocolv1.ClearInput, *listprotocolv1.ClearOutput])
+	Events(statemachine.Proposal[*listprotocolv1.EventsInput, *listprotocolv1.EventsOutput])
+	Size(statemachine.Query[*listprotocolv1.SizeInput, *listprotocolv1.SizeOutput])
+	Get(statemachine.Query[*listprotocolv1.GetInput, *listprotocolv1.GetOutput])
+	Items(statemachine.Query[*listprotocolv1.ItemsInput, *listprotocolv1.ItemsOutput])
+}
+
+func NewListStateMachine(context statemachine.PrimitiveContext[*listprotocolv1.ListInput, *listprotocolv1.ListOutput]) ListStateMachine {
+	return &listStateMachine{
+		ListContext: newContext(context),
+		listeners:   make(map[statemachine.ProposalID]bool),
+		watchers:    make(map[statemachine.QueryID]statemachine.Query[*listprotocolv1.ItemsInput, *listprotocolv1.ItemsOutput]),
+	}
+}
+
+type listStateMachine struct {
+	ListContext
+	items     [][]byte
+	listeners map[statemachine.ProposalID]bool
+	watchers  map[statemachine.QueryID]statemachine.Query[*listprotocolv1.ItemsInput, *listprotocolv1.ItemsOutput]
+	mu        sync.RWMutex
+}
+
+func (s *listStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarInt(len(s.listeners)); err != nil {
+		return err
+	}
+	for proposalID := range s.listeners {
+		if err := writer.WriteVarUint64(uint64(proposalID)); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.WriteVarInt(len(s.items)); err != nil {
+		return err
+	}
+	for _, value := range s.items {
+		if err := writer.WriteBytes(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *listStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			proposal, ok := s.ListContext.Events().Get(statemachine.ProposalID(proposalID))
+			if !ok {
+				return errors.NewFault("cannot find proposal %d", proposalID)
+			}
+			s.listeners[proposal.ID()] = true
+			proposal.Watch(func(state statemachine.ProposalState) {
+				if state != statemachine.Running {
+					delete(s.listeners, proposal.ID())
+				}
+			})
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		s.items = make([][]byte, 0, n)
+		for i := 0; i < n; i++ {
+			value, err := reader.ReadBytes()
+			if err != nil {
+				return err
+			}
+			s.items = append(s.items, value)
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+func (s *listStateMachine) Append(proposal statemachine.Proposal[*listprotocolv1.AppendInput, *listprotocolv1.AppendOutput]) {
+	defer proposal.Close()
+
+	index := uint32(len(s.items))
+	value := proposal.Input().Value.Value
+	s.items = append(s.items, value)
+
+	s.notify(index, value, &listprotocolv1.EventsOutput{
+		Event: listprotocolv1.Event{
+			Index: index,
+			Event: &listprotocolv1.Event_Appended_{
+				Appended: &listprotocolv1.Event_Appended{
+					Value: listprotocolv1.Value{
+						Value: value,
+					},
+				},
+			},
+		},
+	})
+	proposal.Output(&listprotocolv1.AppendOutput{
+		Item: newItem(index, value),
+	})
+}
+
+func (s *listStateMachine) Insert(proposal statemachine.Proposal[*listprotocolv1.InsertInput, *listprotocolv1.InsertOutput]) {
+	defer proposal.Close()
+
+	index := proposal.Input().Index
+	if int(index) > len(s.items) {
+		proposal.Error(errors.NewInvalid("index %d out of bounds", index))
+		return
+	}
+
+	value := proposal.Input().Value.Value
+	s.items = append(s.items, nil)
+	copy(s.items[index+1:], s.items[index:])
+	s.items[index] = value
+
+	s.notify(index, value, &listprotocolv1.EventsOutput{
+		Event: listprotocolv1.Event{
+			Index: index,
+			Event: &listprotocolv1.Event_Inserted_{
+				Inserted: &listprotocolv1.Event_Inserted{
+					Value: listprotocolv1.Value{
+						Value: value,
+					},
+				},
+			},
+		},
+	})
+	proposal.Output(&listprotocolv1.InsertOutput{
+		Item: newItem(index, value),
+	})
+}
+
+func (s *listStateMachine) Set(proposal statemachine.Proposal[*listprotocolv1.SetInput, *listprotocolv1.SetOutput]) {
+	defer proposal.Close()
+
+	index := proposal.Input().Index
+	if int(index) >= len(s.items) {
+		proposal.Error(errors.NewNotFound("index %d out of bounds", index))
+		return
+	}
+
+	prevValue := s.items[index]
+	value := proposal.Input().Value.Value
+	s.items[index] = value
+
+	s.notify(index, value, &listprotocolv1.EventsOutput{
+		Event: listprotocolv1.Event{
+			Index: index,
+			Event: &listprotocolv1.Event_Updated_{
+				Updated: &listprotocolv1.Event_Updated{
+					Value: listprotocolv1.Value{
+						Value: value,
+					},
+					PrevValue: listprotocolv1.Value{
+						Value: prevValue,
+					},
+				},
+			},
+		},
+	})
+	proposal.Output(&listprotocolv1.SetOutput{
+		Item:     newItem(index, value),
+		PrevItem: newItem(index, prevValue),
+	})
+}
+
+func (s *listStateMachine) Remove(proposal statemachine.Proposal[*listprotocolv1.RemoveInput, *listprotocolv1.RemoveOutput]) {
+	defer proposal.Close()
+
+	index := proposal.Input().Index
+	if int(index) >= len(s.items) {
+		proposal.Error(errors.NewNotFound("index %d out of bounds", index))
+		return
+	}
+
+	value := s.items[index]
+	s.items = append(s.items[:index], s.items[index+1:]...)
+
+	s.notify(index, value, &listprotocolv1.EventsOutput{
+		Event: listprotocolv1.Event{
+			Index: index,
+			Event: &listprotocolv1.Event_Removed_{
+				Removed: &listprotocolv1.Event_Removed{
+					Value: listprotocolv1.Value{
+						Value: value,
+					},
+				},
+			},
+		},
+	})
+	proposal.Output(&listprotocolv1.RemoveOutput{
+		Item: newItem(index, value),
+	})
+}
+
+func (s *listStateMachine) Clear(proposal statemachine.Proposal[*listprotocolv1.ClearInput, *listprotocolv1.ClearOutput]) {
+	defer proposal.Close()
+	// Remove items from the tail of the list so the indexes in events remain valid
+	for i := len(s.items) - 1; i >= 0; i-- {
+		index := uint32(i)
+		value := s.items[i]
+		s.items = s.items[:i]
+		s.notify(index, value, &listprotocolv1.EventsOutput{
+			Event: listprotocolv1.Event{
+				Index: index,
+				Event: &listprotocolv1.Event_Removed_{
+					Removed: &listprotocolv1.Event_Removed{
+						Value: listprotocolv1.Value{
+							Value: value,
+						},
+					},
+				},
+			},
+		})
+	}
+	s.items = nil
+	proposal.Output(&listprotocolv1.ClearOutput{})
+}
+
+func (s *listStateMachine) Events(proposal statemachine.Proposal[*listprotocolv1.EventsInput, *listprotocolv1.EventsOutput]) {
+	s.listeners[proposal.ID()] = true
+	proposal.Output(&listprotocolv1.EventsOutput{
+		Event: listprotocolv1.Event{},
+	})
+	if proposal.Input().Replay {
+		for i, value := range s.items {
+			proposal.Output(&listprotocolv1.EventsOutput{
+				Event: listprotocolv1.Event{
+					Index: uint32(i),
+					Event: &listprotocolv1.Event_Appended_{
+						Appended: &listprotocolv1.Event_Appended{
+							Value: listprotocolv1.Value{
+								Value: value,
+							},
+						},
+					},
+				},
+			})
+		}
+	}
+	proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			delete(s.listeners, proposal.ID())
+		}
+	})
+}
+
+func (s *listStateMachine) Size(query statemachine.Query[*listprotocolv1.SizeInput, *listprotocolv1.SizeOutput]) {
+	defer query.Close()
+	query.Output(&listprotocolv1.SizeOutput{
+		Size_: uint32(len(s.items)),
+	})
+}
+
+func (s *listStateMachine) Get(query statemachine.Query[*listprotocolv1.GetInput, *listprotocolv1.GetOutput]) {
+	defer query.Close()
+	index := query.Input().Index
+	if int(index) >= len(s.items) {
+		query.Error(errors.NewNotFound("index %d out of bounds", index))
+		return
+	}
+	query.Output(&listprotocolv1.GetOutput{
+		Item: newItem(index, s.items[index]),
+	})
+}
+
+func (s *listStateMachine) Items(query statemachine.Query[*listprotocolv1.ItemsInput, *listprotocolv1.ItemsOutput]) {
+	for i, value := range s.items {
+		query.Output(&listprotocolv1.ItemsOutput{
+			Item: newItem(uint32(i), value),
+		})
+	}
+
+	if query.Input().Watch {
+		s.mu.Lock()
+		s.watchers[query.ID()] = query
+		s.mu.Unlock()
+		query.Watch(func(state statemachine.QueryState) {
+			if state != statemachine.Running {
+				s.mu.Lock()
+				delete(s.watchers, query.ID())
+				s.mu.Unlock()
+			}
+		})
+	} else {
+		query.Close()
+	}
+}
+
+func (s *listStateMachine) notify(index uint32, value []byte, event *listprotocolv1.EventsOutput) {
+	for proposalID := range s.listeners {
+		proposal, ok := s.ListContext.Events().Get(proposalID)
+		if ok {
+			proposal.Output(event)
+		} else {
+			delete(s.listeners, proposalID)
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, watcher := range s.watchers {
+		watcher.Output(&listprotocolv1.ItemsOutput{
+			Item: newItem(index, value),
+		})
+	}
+}
+
+func newItem(index uint32, value []byte) listprotocolv1.Item {
+	return listprotocolv1.Item{
+		Index: index,
+		Value: listprotocolv1.Value{
+			Value: value,
+		},
+	}
+}
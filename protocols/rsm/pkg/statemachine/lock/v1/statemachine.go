@@ -5,6 +5,8 @@
 package v1
 
 import (
+	"time"
+
 	"github.com/atomix/atomix/api/errors"
 	lockprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/lock/v1"
 	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
@@ -23,6 +25,8 @@ var PrimitiveType = protocol.PrimitiveType{
 
 const (
 	version1 uint32 = 1
+	version2 uint32 = 2
+	version3 uint32 = 3
 )
 
 func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
@@ -70,6 +74,7 @@ func (c *lockContext) Requests() statemachine.Proposals[*lockprotocolv1.AcquireI
 type LockStateMachine interface {
 	statemachine.Context[*lockprotocolv1.LockInput, *lockprotocolv1.LockOutput]
 	statemachine.Recoverable
+	statemachine.Fence
 	Acquire(statemachine.Proposal[*lockprotocolv1.AcquireInput, *lockprotocolv1.AcquireOutput])
 	Release(statemachine.Proposal[*lockprotocolv1.ReleaseInput, *lockprotocolv1.ReleaseOutput])
 	Get(statemachine.Query[*lockprotocolv1.GetInput, *lockprotocolv1.GetOutput])
@@ -86,6 +91,9 @@ func NewLockStateMachine(context statemachine.PrimitiveContext[*lockprotocolv1.L
 type lock struct {
 	proposalID statemachine.ProposalID
 	sessionID  statemachine.SessionID
+	label      string
+	acquired   time.Time
+	holds      uint32
 	watcher    statemachine.CancelFunc
 }
 
@@ -95,10 +103,16 @@ type lockStateMachine struct {
 	queue     []statemachine.Proposal[*lockprotocolv1.AcquireInput, *lockprotocolv1.AcquireOutput]
 	proposals map[statemachine.ProposalID]statemachine.CancelFunc
 	timers    map[statemachine.ProposalID]statemachine.CancelFunc
+	// token is the most recently issued fencing token; it's retained after the lock is released
+	// so writes fenced by a stale token continue to be rejected once the lock is reacquired
+	token protocol.Index
 }
 
 func (s *lockStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
-	if err := writer.WriteVarUint32(version1); err != nil {
+	if err := writer.WriteVarUint32(version3); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(uint64(s.token)); err != nil {
 		return err
 	}
 	if s.lock != nil {
@@ -111,6 +125,15 @@ func (s *lockStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
 		if err := writer.WriteVarUint64(uint64(s.lock.sessionID)); err != nil {
 			return err
 		}
+		if err := writer.WriteString(s.lock.label); err != nil {
+			return err
+		}
+		if err := writer.WriteVarInt64(s.lock.acquired.UnixNano()); err != nil {
+			return err
+		}
+		if err := writer.WriteVarUint32(s.lock.holds); err != nil {
+			return err
+		}
 		if err := writer.WriteVarInt(len(s.queue)); err != nil {
 			return err
 		}
@@ -133,7 +156,15 @@ func (s *lockStateMachine) Recover(reader *statemachine.SnapshotReader) error {
 		return err
 	}
 	switch version {
-	case version1:
+	case version1, version2, version3:
+		if version != version1 {
+			token, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			s.token = protocol.Index(token)
+		}
+
 		locked, err := reader.ReadBool()
 		if err != nil {
 			return err
@@ -154,15 +185,36 @@ func (s *lockStateMachine) Recover(reader *statemachine.SnapshotReader) error {
 				return errors.NewFault("session not found")
 			}
 
+			if version == version1 {
+				s.token = protocol.Index(proposalID)
+			}
 			s.lock = &lock{
 				proposalID: statemachine.ProposalID(proposalID),
 				sessionID:  statemachine.SessionID(sessionID),
+				holds:      1,
 				watcher: session.Watch(func(state statemachine.State) {
 					if state == statemachine.Closed {
 						s.nextRequest()
 					}
 				}),
 			}
+			if version == version3 {
+				label, err := reader.ReadString()
+				if err != nil {
+					return err
+				}
+				acquired, err := reader.ReadVarInt64()
+				if err != nil {
+					return err
+				}
+				holds, err := reader.ReadVarUint32()
+				if err != nil {
+					return err
+				}
+				s.lock.label = label
+				s.lock.acquired = time.Unix(0, acquired)
+				s.lock.holds = holds
+			}
 
 			n, err := reader.ReadVarInt()
 			if err != nil {
@@ -203,24 +255,37 @@ func (s *lockStateMachine) dequeueRequest(proposal statemachine.Proposal[*lockpr
 }
 
 func (s *lockStateMachine) nextRequest() {
-	s.lock = nil
+	if s.lock != nil {
+		s.lock.watcher()
+		s.lock = nil
+	}
 	if len(s.queue) == 0 {
 		return
 	}
 	proposal := s.queue[0]
+	s.queue = s.queue[1:]
+	s.unwatchRequest(proposal.ID())
+	s.grant(proposal)
+}
+
+// grant grants the lock to the given request
+func (s *lockStateMachine) grant(proposal statemachine.Proposal[*lockprotocolv1.AcquireInput, *lockprotocolv1.AcquireOutput]) {
 	s.lock = &lock{
 		proposalID: proposal.ID(),
 		sessionID:  proposal.Session().ID(),
+		label:      proposal.Input().Label,
+		acquired:   s.Scheduler().Time(),
+		holds:      1,
 		watcher: proposal.Session().Watch(func(state statemachine.State) {
 			if state == statemachine.Closed {
 				s.nextRequest()
 			}
 		}),
 	}
-	s.queue = s.queue[1:]
-	s.unwatchRequest(proposal.ID())
+	s.token = protocol.Index(proposal.ID())
 	proposal.Output(&lockprotocolv1.AcquireOutput{
 		Index: protocol.Index(proposal.ID()),
+		Holds: s.lock.holds,
 	})
 	proposal.Close()
 }
@@ -254,17 +319,12 @@ func (s *lockStateMachine) unwatchRequest(proposalID statemachine.ProposalID) {
 
 func (s *lockStateMachine) Acquire(proposal statemachine.Proposal[*lockprotocolv1.AcquireInput, *lockprotocolv1.AcquireOutput]) {
 	if s.lock == nil {
-		s.lock = &lock{
-			proposalID: proposal.ID(),
-			sessionID:  proposal.Session().ID(),
-			watcher: proposal.Session().Watch(func(state statemachine.State) {
-				if state == statemachine.Closed {
-					s.nextRequest()
-				}
-			}),
-		}
+		s.grant(proposal)
+	} else if proposal.Input().Reentrant && s.lock.sessionID == proposal.Session().ID() {
+		s.lock.holds++
 		proposal.Output(&lockprotocolv1.AcquireOutput{
-			Index: protocol.Index(proposal.ID()),
+			Index: protocol.Index(s.lock.proposalID),
+			Holds: s.lock.holds,
 		})
 		proposal.Close()
 	} else {
@@ -276,18 +336,34 @@ func (s *lockStateMachine) Release(proposal statemachine.Proposal[*lockprotocolv
 	defer proposal.Close()
 	if s.lock == nil || s.lock.sessionID != proposal.Session().ID() {
 		proposal.Error(errors.NewConflict("lock not held by client"))
+	} else if s.lock.holds > 1 {
+		s.lock.holds--
+		proposal.Output(&lockprotocolv1.ReleaseOutput{})
 	} else {
 		s.nextRequest()
 		proposal.Output(&lockprotocolv1.ReleaseOutput{})
 	}
 }
 
+func (s *lockStateMachine) Token() uint64 {
+	return uint64(s.token)
+}
+
 func (s *lockStateMachine) Get(query statemachine.Query[*lockprotocolv1.GetInput, *lockprotocolv1.GetOutput]) {
 	defer query.Close()
 	if s.lock != nil {
-		query.Output(&lockprotocolv1.GetOutput{
-			Index: protocol.Index(s.lock.proposalID),
-		})
+		output := &lockprotocolv1.GetOutput{
+			Index:     protocol.Index(s.lock.proposalID),
+			SessionID: protocol.SessionID(s.lock.sessionID),
+			Label:     s.lock.label,
+			Holds:     s.lock.holds,
+			Waiters:   uint32(len(s.queue)),
+		}
+		if !s.lock.acquired.IsZero() {
+			acquired := s.lock.acquired
+			output.Acquired = &acquired
+		}
+		query.Output(output)
 	} else {
 		query.Error(errors.NewNotFound("local not held"))
 	}
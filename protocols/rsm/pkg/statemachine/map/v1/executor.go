@@ -32,20 +32,26 @@ func newExecutor(sm MapStateMachine) *mapExecutor {
 }
 
 type mapExecutor struct {
-	sm      MapStateMachine
-	put     statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.PutInput, *mapprotocolv1.PutOutput]
-	insert  statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.InsertInput, *mapprotocolv1.InsertOutput]
-	update  statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.UpdateInput, *mapprotocolv1.UpdateOutput]
-	remove  statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.RemoveInput, *mapprotocolv1.RemoveOutput]
-	clear   statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ClearInput, *mapprotocolv1.ClearOutput]
-	prepare statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.PrepareInput, *mapprotocolv1.PrepareOutput]
-	commit  statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.CommitInput, *mapprotocolv1.CommitOutput]
-	abort   statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.AbortInput, *mapprotocolv1.AbortOutput]
-	apply   statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ApplyInput, *mapprotocolv1.ApplyOutput]
-	events  statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.EventsInput, *mapprotocolv1.EventsOutput]
-	size    statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.SizeInput, *mapprotocolv1.SizeOutput]
-	get     statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.GetInput, *mapprotocolv1.GetOutput]
-	list    statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.EntriesInput, *mapprotocolv1.EntriesOutput]
+	sm        MapStateMachine
+	put       statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.PutInput, *mapprotocolv1.PutOutput]
+	insert    statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.InsertInput, *mapprotocolv1.InsertOutput]
+	update    statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.UpdateInput, *mapprotocolv1.UpdateOutput]
+	remove    statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.RemoveInput, *mapprotocolv1.RemoveOutput]
+	clear     statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ClearInput, *mapprotocolv1.ClearOutput]
+	prepare   statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.PrepareInput, *mapprotocolv1.PrepareOutput]
+	commit    statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.CommitInput, *mapprotocolv1.CommitOutput]
+	abort     statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.AbortInput, *mapprotocolv1.AbortOutput]
+	apply     statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ApplyInput, *mapprotocolv1.ApplyOutput]
+	putAll    statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.PutAllInput, *mapprotocolv1.PutAllOutput]
+	removeAll statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.RemoveAllInput, *mapprotocolv1.RemoveAllOutput]
+	configure statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ConfigureInput, *mapprotocolv1.ConfigureOutput]
+	fence     statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ValidateFenceInput, *mapprotocolv1.ValidateFenceOutput]
+	events    statemachine.Proposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.EventsInput, *mapprotocolv1.EventsOutput]
+	size      statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.SizeInput, *mapprotocolv1.SizeOutput]
+	get       statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.GetInput, *mapprotocolv1.GetOutput]
+	getAll    statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.GetAllInput, *mapprotocolv1.GetAllOutput]
+	list      statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.EntriesInput, *mapprotocolv1.EntriesOutput]
+	query     statemachine.Querier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.QueryInput, *mapprotocolv1.QueryOutput]
 }
 
 func (s *mapExecutor) init() {
@@ -184,6 +190,66 @@ func (s *mapExecutor) init() {
 			}
 		}).
 		Build(s.sm.Apply)
+	s.putAll = statemachine.NewProposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.PutAllInput, *mapprotocolv1.PutAllOutput]("PutAll").
+		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.PutAllInput, bool) {
+			if putAll, ok := input.Input.(*mapprotocolv1.MapInput_PutAll); ok {
+				return putAll.PutAll, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *mapprotocolv1.PutAllOutput) *mapprotocolv1.MapOutput {
+			return &mapprotocolv1.MapOutput{
+				Output: &mapprotocolv1.MapOutput_PutAll{
+					PutAll: output,
+				},
+			}
+		}).
+		Build(s.sm.PutAll)
+	s.removeAll = statemachine.NewProposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.RemoveAllInput, *mapprotocolv1.RemoveAllOutput]("RemoveAll").
+		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.RemoveAllInput, bool) {
+			if removeAll, ok := input.Input.(*mapprotocolv1.MapInput_RemoveAll); ok {
+				return removeAll.RemoveAll, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *mapprotocolv1.RemoveAllOutput) *mapprotocolv1.MapOutput {
+			return &mapprotocolv1.MapOutput{
+				Output: &mapprotocolv1.MapOutput_RemoveAll{
+					RemoveAll: output,
+				},
+			}
+		}).
+		Build(s.sm.RemoveAll)
+	s.configure = statemachine.NewProposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ConfigureInput, *mapprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*mapprotocolv1.MapInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *mapprotocolv1.ConfigureOutput) *mapprotocolv1.MapOutput {
+			return &mapprotocolv1.MapOutput{
+				Output: &mapprotocolv1.MapOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.sm.Configure)
+	s.fence = statemachine.NewProposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.ValidateFenceInput, *mapprotocolv1.ValidateFenceOutput]("ValidateFence").
+		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.ValidateFenceInput, bool) {
+			if fence, ok := input.Input.(*mapprotocolv1.MapInput_ValidateFence); ok {
+				return fence.ValidateFence, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *mapprotocolv1.ValidateFenceOutput) *mapprotocolv1.MapOutput {
+			return &mapprotocolv1.MapOutput{
+				Output: &mapprotocolv1.MapOutput_ValidateFence{
+					ValidateFence: output,
+				},
+			}
+		}).
+		Build(s.sm.ValidateFence)
 	s.events = statemachine.NewProposer[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.EventsInput, *mapprotocolv1.EventsOutput]("Events").
 		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.EventsInput, bool) {
 			if events, ok := input.Input.(*mapprotocolv1.MapInput_Events); ok {
@@ -229,6 +295,21 @@ func (s *mapExecutor) init() {
 			}
 		}).
 		Build(s.sm.Get)
+	s.getAll = statemachine.NewQuerier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.GetAllInput, *mapprotocolv1.GetAllOutput]("GetAll").
+		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.GetAllInput, bool) {
+			if getAll, ok := input.Input.(*mapprotocolv1.MapInput_GetAll); ok {
+				return getAll.GetAll, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *mapprotocolv1.GetAllOutput) *mapprotocolv1.MapOutput {
+			return &mapprotocolv1.MapOutput{
+				Output: &mapprotocolv1.MapOutput_GetAll{
+					GetAll: output,
+				},
+			}
+		}).
+		Build(s.sm.GetAll)
 	s.list = statemachine.NewQuerier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.EntriesInput, *mapprotocolv1.EntriesOutput]("Entries").
 		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.EntriesInput, bool) {
 			if entries, ok := input.Input.(*mapprotocolv1.MapInput_Entries); ok {
@@ -244,6 +325,21 @@ func (s *mapExecutor) init() {
 			}
 		}).
 		Build(s.sm.Entries)
+	s.query = statemachine.NewQuerier[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput, *mapprotocolv1.QueryInput, *mapprotocolv1.QueryOutput]("Query").
+		Decoder(func(input *mapprotocolv1.MapInput) (*mapprotocolv1.QueryInput, bool) {
+			if query, ok := input.Input.(*mapprotocolv1.MapInput_Query); ok {
+				return query.Query, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *mapprotocolv1.QueryOutput) *mapprotocolv1.MapOutput {
+			return &mapprotocolv1.MapOutput{
+				Output: &mapprotocolv1.MapOutput_Query{
+					Query: output,
+				},
+			}
+		}).
+		Build(s.sm.Query)
 }
 
 func (s *mapExecutor) Snapshot(writer *statemachine.SnapshotWriter) error {
@@ -274,6 +370,14 @@ func (s *mapExecutor) Propose(proposal statemachine.Proposal[*mapprotocolv1.MapI
 		s.abort(proposal)
 	case *mapprotocolv1.MapInput_Apply:
 		s.apply(proposal)
+	case *mapprotocolv1.MapInput_PutAll:
+		s.putAll(proposal)
+	case *mapprotocolv1.MapInput_RemoveAll:
+		s.removeAll(proposal)
+	case *mapprotocolv1.MapInput_Configure:
+		s.configure(proposal)
+	case *mapprotocolv1.MapInput_ValidateFence:
+		s.fence(proposal)
 	case *mapprotocolv1.MapInput_Events:
 		s.events(proposal)
 	default:
@@ -288,8 +392,12 @@ func (s *mapExecutor) Query(query statemachine.Query[*mapprotocolv1.MapInput, *m
 		s.size(query)
 	case *mapprotocolv1.MapInput_Get:
 		s.get(query)
+	case *mapprotocolv1.MapInput_GetAll:
+		s.getAll(query)
 	case *mapprotocolv1.MapInput_Entries:
 		s.list(query)
+	case *mapprotocolv1.MapInput_Query:
+		s.query(query)
 	default:
 		query.Error(errors.NewNotSupported("query not supported"))
 	}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/atomix/atomix/api/errors"
+	"github.com/google/btree"
+	mapprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/map/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/utils/document"
+)
+
+// indexDegree is the degree of the B-trees backing the map indexes
+const indexDegree = 32
+
+// keyIndex is an ordered index of the keys in the map
+type keyIndex struct {
+	keys *btree.BTree
+}
+
+// keyItem is a key stored in the key index
+type keyItem string
+
+func (k keyItem) Less(than btree.Item) bool {
+	return k < than.(keyItem)
+}
+
+func newKeyIndex() *keyIndex {
+	return &keyIndex{
+		keys: btree.New(indexDegree),
+	}
+}
+
+// add adds the given key to the index if it's not already present
+func (i *keyIndex) add(key string) {
+	i.keys.ReplaceOrInsert(keyItem(key))
+}
+
+// remove removes the given key from the index if present
+func (i *keyIndex) remove(key string) {
+	i.keys.Delete(keyItem(key))
+}
+
+// clear removes all keys from the index
+func (i *keyIndex) clear() {
+	i.keys.Clear(false)
+}
+
+// keyRange is a range of keys in the index
+type keyRange struct {
+	// prefix limits the range to keys beginning with the prefix
+	prefix string
+	// start is the inclusive lower bound of the range
+	start string
+	// end is the exclusive upper bound of the range; an empty end is unbounded
+	end string
+	// after is the exclusive lower bound of the range; an empty after is unbounded
+	after string
+}
+
+// contains returns whether the given key falls within the range
+func (r keyRange) contains(key string) bool {
+	if !strings.HasPrefix(key, r.prefix) {
+		return false
+	}
+	if key < r.start {
+		return false
+	}
+	if r.end != "" && key >= r.end {
+		return false
+	}
+	if r.after != "" && key <= r.after {
+		return false
+	}
+	return true
+}
+
+// scan calls f with each key in the given range in order until f returns false
+func (i *keyIndex) scan(r keyRange, f func(key string) bool) {
+	start := r.start
+	if r.prefix > start {
+		start = r.prefix
+	}
+	if r.after != "" && r.after > start {
+		start = r.after
+	}
+	i.keys.AscendGreaterOrEqual(keyItem(start), func(item btree.Item) bool {
+		key := string(item.(keyItem))
+		if r.after != "" && key == r.after {
+			return true
+		}
+		// Keys sharing the prefix are contiguous, so the scan is complete once a key without it is reached
+		if !strings.HasPrefix(key, r.prefix) {
+			return false
+		}
+		if r.end != "" && key >= r.end {
+			return false
+		}
+		return f(key)
+	})
+}
+
+// valueIndex is a secondary index of the entries in the map ordered by a key extracted from their values
+// Entries with equal index keys are ordered by key.
+type valueIndex struct {
+	config  mapprotocolv1.Index
+	extract func(value []byte) ([]byte, bool)
+	encode  func(value []byte) ([]byte, error)
+	entries *btree.BTree
+}
+
+type valueIndexEntry struct {
+	indexKey []byte
+	key      string
+}
+
+func (e valueIndexEntry) compare(other valueIndexEntry) int {
+	if c := bytes.Compare(e.indexKey, other.indexKey); c != 0 {
+		return c
+	}
+	return strings.Compare(e.key, other.key)
+}
+
+func (e valueIndexEntry) Less(than btree.Item) bool {
+	return e.compare(than.(valueIndexEntry)) < 0
+}
+
+func newValueIndex(config mapprotocolv1.Index) (*valueIndex, error) {
+	if config.Name == "" {
+		return nil, errors.NewInvalid("index name cannot be empty")
+	}
+	index := &valueIndex{
+		config:  config,
+		entries: btree.New(indexDegree),
+	}
+	switch extractor := config.Extractor.(type) {
+	case *mapprotocolv1.Index_JsonPath:
+		if err := document.ValidatePointer(extractor.JsonPath); err != nil {
+			return nil, err
+		}
+		index.extract = func(value []byte) ([]byte, bool) {
+			field, ok, err := document.Lookup(value, extractor.JsonPath)
+			if err != nil || !ok {
+				return nil, false
+			}
+			return encodeJSONKey(field)
+		}
+		index.encode = func(value []byte) ([]byte, error) {
+			field, _, err := document.Lookup(value, "")
+			if err != nil {
+				return nil, err
+			}
+			indexKey, ok := encodeJSONKey(field)
+			if !ok {
+				return nil, errors.NewInvalid("index %s can only be queried with JSON scalar values", config.Name)
+			}
+			return indexKey, nil
+		}
+	case *mapprotocolv1.Index_PrefixLength:
+		if extractor.PrefixLength == 0 {
+			return nil, errors.NewInvalid("index %s prefix length must be positive", config.Name)
+		}
+		index.extract = func(value []byte) ([]byte, bool) {
+			if len(value) > int(extractor.PrefixLength) {
+				return value[:extractor.PrefixLength], true
+			}
+			return value, true
+		}
+		// Query values are truncated like indexed values so values sharing a prefix match the same entries
+		index.encode = func(value []byte) ([]byte, error) {
+			indexKey, _ := index.extract(value)
+			return indexKey, nil
+		}
+	default:
+		return nil, errors.NewInvalid("index %s has no extractor", config.Name)
+	}
+	return index, nil
+}
+
+// add indexes the given key by its value if a key can be extracted from the value
+func (i *valueIndex) add(key string, value []byte) {
+	if indexKey, ok := i.extract(value); ok {
+		i.entries.ReplaceOrInsert(valueIndexEntry{indexKey: indexKey, key: key})
+	}
+}
+
+// remove removes the given key indexed by its value from the index if present
+func (i *valueIndex) remove(key string, value []byte) {
+	if indexKey, ok := i.extract(value); ok {
+		i.entries.Delete(valueIndexEntry{indexKey: indexKey, key: key})
+	}
+}
+
+// clear removes all entries from the index
+func (i *valueIndex) clear() {
+	i.entries.Clear(false)
+}
+
+// scan calls f with each entry with an index key between the inclusive min and max keys in order until f returns false
+// A nil min or max leaves the scan unbounded in that direction.
+func (i *valueIndex) scan(min, max []byte, f func(indexKey []byte, key string) bool) {
+	// An entry with an empty key orders before all entries with the same index key
+	i.entries.AscendGreaterOrEqual(valueIndexEntry{indexKey: min}, func(item btree.Item) bool {
+		entry := item.(valueIndexEntry)
+		if max != nil && bytes.Compare(entry.indexKey, max) > 0 {
+			return false
+		}
+		return f(entry.indexKey, entry.key)
+	})
+}
+
+// Index keys of JSON values are prefixed with their type so values order null, false, true, numbers and then strings
+const (
+	jsonNullKey byte = iota
+	jsonFalseKey
+	jsonTrueKey
+	jsonNumberKey
+	jsonStringKey
+)
+
+// encodeJSONKey encodes a JSON scalar value as an index key that preserves the order of values
+// Numbers are ordered as 64-bit floating point values. Objects and arrays are not indexed.
+func encodeJSONKey(value interface{}) ([]byte, bool) {
+	switch v := value.(type) {
+	case nil:
+		return []byte{jsonNullKey}, true
+	case bool:
+		if v {
+			return []byte{jsonTrueKey}, true
+		}
+		return []byte{jsonFalseKey}, true
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, false
+		}
+		// Flip the sign bit of positive numbers and all the bits of negative numbers so the encoded
+		// numbers sort in numeric order. Negative zero is normalized to zero.
+		if f == 0 {
+			f = 0
+		}
+		bits := math.Float64bits(f)
+		if f < 0 {
+			bits = ^bits
+		} else {
+			bits |= 1 << 63
+		}
+		key := make([]byte, 9)
+		key[0] = jsonNumberKey
+		binary.BigEndian.PutUint64(key[1:], bits)
+		return key, true
+	case string:
+		return append([]byte{jsonStringKey}, v...), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/stretchr/testify/assert"
+	mapprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/map/v1"
+	"testing"
+)
+
+func TestKeyIndex(t *testing.T) {
+	index := newKeyIndex()
+	for _, key := range []string{"user/42/b", "a", "user/42/a", "user/43", "user/42/c", "z", "user/42/a"} {
+		index.add(key)
+	}
+
+	scan := func(r keyRange, limit int) []string {
+		var keys []string
+		index.scan(r, func(key string) bool {
+			keys = append(keys, key)
+			return limit == 0 || len(keys) < limit
+		})
+		return keys
+	}
+
+	assert.Equal(t, []string{"a", "user/42/a", "user/42/b", "user/42/c", "user/43", "z"}, scan(keyRange{}, 0))
+	assert.Equal(t, []string{"user/42/a", "user/42/b", "user/42/c"}, scan(keyRange{prefix: "user/42/"}, 0))
+	assert.Equal(t, []string{"user/42/b", "user/42/c"}, scan(keyRange{prefix: "user/42/", start: "user/42/b"}, 0))
+	assert.Equal(t, []string{"user/42/a", "user/42/b"}, scan(keyRange{prefix: "user/42/", end: "user/42/c"}, 0))
+	assert.Equal(t, []string{"user/42/c", "user/43"}, scan(keyRange{start: "user/42/bb", end: "z"}, 0))
+	assert.Equal(t, []string{"a", "user/42/a"}, scan(keyRange{}, 2))
+	assert.Equal(t, []string{"user/42/b", "user/42/c"}, scan(keyRange{after: "user/42/a"}, 2))
+	assert.Equal(t, []string{"user/42/c"}, scan(keyRange{prefix: "user/42/", after: "user/42/b"}, 0))
+	assert.Equal(t, []string{"user/42/b", "user/42/c"}, scan(keyRange{prefix: "user/42/", start: "user/42/b", after: "user/42/a"}, 0))
+	assert.Equal(t, []string{"user/43"}, scan(keyRange{prefix: "user/4", after: "user/42/c"}, 0))
+	assert.Empty(t, scan(keyRange{prefix: "user/44"}, 0))
+
+	assert.True(t, keyRange{prefix: "user/42/"}.contains("user/42/x"))
+	assert.False(t, keyRange{prefix: "user/42/"}.contains("user/43"))
+	assert.False(t, keyRange{start: "b"}.contains("a"))
+	assert.False(t, keyRange{end: "b"}.contains("b"))
+	assert.False(t, keyRange{after: "b"}.contains("b"))
+
+	index.remove("user/42/b")
+	index.remove("missing")
+	assert.Equal(t, []string{"a", "user/42/a", "user/42/c", "user/43", "z"}, scan(keyRange{}, 0))
+	index.clear()
+	assert.Empty(t, scan(keyRange{}, 0))
+}
+
+func TestJSONValueIndex(t *testing.T) {
+	index, err := newValueIndex(mapprotocolv1.Index{
+		Name: "priority",
+		Extractor: &mapprotocolv1.Index_JsonPath{
+			JsonPath: "/priority",
+		},
+	})
+	assert.NoError(t, err)
+
+	values := map[string]string{
+		"a": `{"priority": 10}`,
+		"b": `{"priority": -2.5}`,
+		"c": `{"priority": "high"}`,
+		"d": `{"priority": null}`,
+		"e": `{"priority": 10.0}`,
+		"f": `{"priority": true}`,
+		"g": `{"priority": 0}`,
+		"h": `{"priority": {"level": 1}}`,
+		"i": `{"status": "pending"}`,
+		"j": `not json`,
+		"k": `{"priority": -0}`,
+		"l": `{"priority": 1e3}`,
+	}
+	for key, value := range values {
+		index.add(key, []byte(value))
+	}
+
+	scan := func(min, max string) []string {
+		var minKey, maxKey []byte
+		if min != "" {
+			minKey, err = index.encode([]byte(min))
+			assert.NoError(t, err)
+		}
+		if max != "" {
+			maxKey, err = index.encode([]byte(max))
+			assert.NoError(t, err)
+		}
+		var keys []string
+		index.scan(minKey, maxKey, func(indexKey []byte, key string) bool {
+			keys = append(keys, key)
+			return true
+		})
+		return keys
+	}
+
+	assert.Equal(t, []string{"d", "f", "b", "g", "k", "a", "e", "l", "c"}, scan("", ""))
+	assert.Equal(t, []string{"a", "e"}, scan("10", "10"))
+	assert.Equal(t, []string{"b", "g", "k"}, scan("-3", "0"))
+	assert.Equal(t, []string{"a", "e", "l", "c"}, scan("1", ""))
+	assert.Equal(t, []string{"d", "f", "b", "g", "k"}, scan("", "0"))
+	assert.Equal(t, []string{"c"}, scan(`"high"`, `"high"`))
+	assert.Empty(t, scan(`"low"`, ""))
+
+	index.remove("a", []byte(values["a"]))
+	index.remove("i", []byte(values["i"]))
+	index.remove("e", []byte(`{"priority": 11}`))
+	assert.Equal(t, []string{"e"}, scan("10", "10"))
+
+	_, err = index.encode([]byte(`{"level": 1}`))
+	assert.True(t, errors.IsInvalid(err))
+	_, err = index.encode([]byte(`not json`))
+	assert.True(t, errors.IsInvalid(err))
+
+	index.clear()
+	assert.Empty(t, scan("", ""))
+}
+
+func TestPrefixValueIndex(t *testing.T) {
+	index, err := newValueIndex(mapprotocolv1.Index{
+		Name: "type",
+		Extractor: &mapprotocolv1.Index_PrefixLength{
+			PrefixLength: 2,
+		},
+	})
+	assert.NoError(t, err)
+
+	index.add("a", []byte("b1-foo"))
+	index.add("b", []byte("a2-bar"))
+	index.add("c", []byte("b1-baz"))
+	index.add("d", []byte("b"))
+	index.add("e", []byte("c3"))
+
+	scan := func(min, max []byte) []string {
+		var keys []string
+		index.scan(min, max, func(indexKey []byte, key string) bool {
+			keys = append(keys, key)
+			return true
+		})
+		return keys
+	}
+	assert.Equal(t, []string{"b", "d", "a", "c", "e"}, scan(nil, nil))
+	assert.Equal(t, []string{"a", "c"}, scan([]byte("b1"), []byte("b1")))
+	assert.Equal(t, []string{"d", "a", "c"}, scan([]byte("b"), []byte("b9")))
+
+	// Query values are truncated to the prefix length
+	indexKey, err := index.encode([]byte("b1-qux"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("b1"), indexKey)
+	assert.Equal(t, []string{"a", "c"}, scan(indexKey, indexKey))
+	indexKey, err = index.encode([]byte("b"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("b"), indexKey)
+
+	index.remove("a", []byte("b1-foo"))
+	assert.Equal(t, []string{"c"}, scan([]byte("b1"), []byte("b1")))
+}
+
+func TestInvalidValueIndex(t *testing.T) {
+	_, err := newValueIndex(mapprotocolv1.Index{
+		Extractor: &mapprotocolv1.Index_PrefixLength{
+			PrefixLength: 2,
+		},
+	})
+	assert.True(t, errors.IsInvalid(err))
+	_, err = newValueIndex(mapprotocolv1.Index{
+		Name: "status",
+		Extractor: &mapprotocolv1.Index_JsonPath{
+			JsonPath: "status",
+		},
+	})
+	assert.True(t, errors.IsInvalid(err))
+	_, err = newValueIndex(mapprotocolv1.Index{
+		Name:      "status",
+		Extractor: &mapprotocolv1.Index_PrefixLength{},
+	})
+	assert.True(t, errors.IsInvalid(err))
+	_, err = newValueIndex(mapprotocolv1.Index{
+		Name: "status",
+	})
+	assert.True(t, errors.IsInvalid(err))
+}
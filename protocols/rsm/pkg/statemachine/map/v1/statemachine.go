@@ -6,12 +6,17 @@ package v1
 
 import (
 	"bytes"
+	"path"
+	"strings"
 	"sync"
 
 	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
 	mapprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/map/v1"
 	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/internal/page"
 	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+	"github.com/vpascoalr/atomix/runtime/pkg/utils/document"
 )
 
 const (
@@ -27,6 +32,7 @@ var PrimitiveType = protocol.PrimitiveType{
 const (
 	version1 uint32 = 1
 	version2 uint32 = 2
+	version3 uint32 = 3
 )
 
 func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
@@ -79,6 +85,10 @@ type MapStateMachine interface {
 	Update(proposal statemachine.Proposal[*mapprotocolv1.UpdateInput, *mapprotocolv1.UpdateOutput])
 	Remove(proposal statemachine.Proposal[*mapprotocolv1.RemoveInput, *mapprotocolv1.RemoveOutput])
 	Clear(proposal statemachine.Proposal[*mapprotocolv1.ClearInput, *mapprotocolv1.ClearOutput])
+	PutAll(proposal statemachine.Proposal[*mapprotocolv1.PutAllInput, *mapprotocolv1.PutAllOutput])
+	RemoveAll(proposal statemachine.Proposal[*mapprotocolv1.RemoveAllInput, *mapprotocolv1.RemoveAllOutput])
+	Configure(proposal statemachine.Proposal[*mapprotocolv1.ConfigureInput, *mapprotocolv1.ConfigureOutput])
+	ValidateFence(proposal statemachine.Proposal[*mapprotocolv1.ValidateFenceInput, *mapprotocolv1.ValidateFenceOutput])
 	Prepare(proposal statemachine.Proposal[*mapprotocolv1.PrepareInput, *mapprotocolv1.PrepareOutput])
 	Commit(proposal statemachine.Proposal[*mapprotocolv1.CommitInput, *mapprotocolv1.CommitOutput])
 	Abort(proposal statemachine.Proposal[*mapprotocolv1.AbortInput, *mapprotocolv1.AbortOutput])
@@ -86,7 +96,9 @@ type MapStateMachine interface {
 	Events(proposal statemachine.Proposal[*mapprotocolv1.EventsInput, *mapprotocolv1.EventsOutput])
 	Size(query statemachine.Query[*mapprotocolv1.SizeInput, *mapprotocolv1.SizeOutput])
 	Get(query statemachine.Query[*mapprotocolv1.GetInput, *mapprotocolv1.GetOutput])
+	GetAll(query statemachine.Query[*mapprotocolv1.GetAllInput, *mapprotocolv1.GetAllOutput])
 	Entries(query statemachine.Query[*mapprotocolv1.EntriesInput, *mapprotocolv1.EntriesOutput])
+	Query(query statemachine.Query[*mapprotocolv1.QueryInput, *mapprotocolv1.QueryOutput])
 }
 
 func NewMapStateMachine(context statemachine.PrimitiveContext[*mapprotocolv1.MapInput, *mapprotocolv1.MapOutput]) MapStateMachine {
@@ -98,6 +110,9 @@ func NewMapStateMachine(context statemachine.PrimitiveContext[*mapprotocolv1.Map
 		locks:        make(map[string]lockID),
 		sessions:     make(map[statemachine.SessionID]statemachine.CancelFunc),
 		timers:       make(map[string]statemachine.CancelFunc),
+		index:        newKeyIndex(),
+		indexes:      make(map[string]*valueIndex),
+		fences:       make(map[string]uint64),
 	}
 }
 
@@ -110,16 +125,19 @@ type mapStateMachine struct {
 	MapContext
 	listeners    map[statemachine.ProposalID]*mapprotocolv1.MapListener
 	entries      map[string]*mapprotocolv1.MapEntry
+	index        *keyIndex
+	indexes      map[string]*valueIndex
 	transactions map[lockID][]mapprotocolv1.MapInput
 	locks        map[string]lockID
 	sessions     map[statemachine.SessionID]statemachine.CancelFunc
 	timers       map[string]statemachine.CancelFunc
+	fences       map[string]uint64
 	watchers     sync.Map
 }
 
 func (s *mapStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
 	s.Log().Infow("Persisting Map to snapshot")
-	if err := writer.WriteVarUint32(version2); err != nil {
+	if err := writer.WriteVarUint32(version3); err != nil {
 		return err
 	}
 	if err := writer.WriteVarInt(len(s.listeners)); err != nil {
@@ -177,6 +195,28 @@ func (s *mapStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
 			return err
 		}
 	}
+
+	// Only the index configurations are persisted; the indexes are rebuilt from the entries on recovery
+	if err := writer.WriteVarInt(len(s.indexes)); err != nil {
+		return err
+	}
+	for _, index := range s.indexes {
+		if err := writer.WriteMessage(&index.config); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.WriteVarInt(len(s.fences)); err != nil {
+		return err
+	}
+	for name, token := range s.fences {
+		if err := writer.WriteString(name); err != nil {
+			return err
+		}
+		if err := writer.WriteVarUint64(token); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -187,7 +227,7 @@ func (s *mapStateMachine) Recover(reader *statemachine.SnapshotReader) error {
 		return err
 	}
 	switch version {
-	case version1, version2:
+	case version1, version2, version3:
 		n, err := reader.ReadVarInt()
 		if err != nil {
 			return err
@@ -223,12 +263,20 @@ func (s *mapStateMachine) Recover(reader *statemachine.SnapshotReader) error {
 				return err
 			}
 			s.entries[entry.Key] = entry
+			s.index.add(entry.Key)
 			s.scheduleTTL(entry.Key, entry)
+			if entry.Value.SessionID != 0 {
+				session, ok := s.Sessions().Get(statemachine.SessionID(entry.Value.SessionID))
+				if !ok {
+					return errors.NewInvalid("session %d not found", entry.Value.SessionID)
+				}
+				s.watchSession(session)
+			}
 		}
 	}
 
 	switch version {
-	case version2:
+	case version2, version3:
 		n, err := reader.ReadVarInt()
 		if err != nil {
 			return err
@@ -297,6 +345,44 @@ func (s *mapStateMachine) Recover(reader *statemachine.SnapshotReader) error {
 			}
 		}
 	}
+
+	switch version {
+	case version3:
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			config := mapprotocolv1.Index{}
+			if err := reader.ReadMessage(&config); err != nil {
+				return err
+			}
+			index, err := newValueIndex(config)
+			if err != nil {
+				return err
+			}
+			for key, entry := range s.entries {
+				index.add(key, entry.Value.Value)
+			}
+			s.indexes[config.Name] = index
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			name, err := reader.ReadString()
+			if err != nil {
+				return err
+			}
+			token, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			s.fences[name] = token
+		}
+	}
 	return nil
 }
 
@@ -326,6 +412,20 @@ func (s *mapStateMachine) watchSession(session statemachine.Session) {
 						delete(s.locks, key)
 					}
 				}
+
+				// Remove ephemeral entries owned by the session in key order
+				var keys []string
+				s.index.scan(keyRange{}, func(key string) bool {
+					if s.entries[key].Value.SessionID == protocol.SessionID(session.ID()) {
+						keys = append(keys, key)
+					}
+					return true
+				})
+				for _, key := range keys {
+					s.applyRemove(&mapprotocolv1.RemoveInput{
+						Key: key,
+					})
+				}
 				delete(s.sessions, session.ID())
 			}
 		})
@@ -335,6 +435,11 @@ func (s *mapStateMachine) watchSession(session statemachine.Session) {
 func (s *mapStateMachine) Prepare(proposal statemachine.Proposal[*mapprotocolv1.PrepareInput, *mapprotocolv1.PrepareOutput]) {
 	defer proposal.Close()
 
+	if err := s.validateFence(proposal.Input().Fence); err != nil {
+		proposal.Error(err)
+		return
+	}
+
 	keys := make(map[string]bool)
 	for _, input := range proposal.Input().Inputs {
 		switch i := input.Input.(type) {
@@ -343,10 +448,14 @@ func (s *mapStateMachine) Prepare(proposal statemachine.Proposal[*mapprotocolv1.
 				proposal.Error(errors.NewConflict("multiple modifications of key %s in same transaction", i.Put.Key))
 				return
 			}
-			if err := s.validatePut(i.Put); err != nil {
+			value, err := s.validatePut(i.Put)
+			if err != nil {
 				proposal.Error(err)
 				return
 			}
+			// Keys are locked until the transaction is committed, so patches are applied to the values
+			// read when the transaction is prepared and the resulting values are stored with the transaction
+			i.Put.Value = value
 			keys[i.Put.Key] = true
 		case *mapprotocolv1.MapInput_Insert:
 			if keys[i.Insert.Key] {
@@ -385,6 +494,7 @@ func (s *mapStateMachine) Prepare(proposal statemachine.Proposal[*mapprotocolv1.
 		sessionID:   proposal.Session().ID(),
 		sequenceNum: proposal.Input().SequenceNum,
 	}
+	s.recordFence(proposal.Input().Fence)
 	s.transactions[transactionID] = proposal.Input().Inputs
 	for key := range keys {
 		s.locks[key] = transactionID
@@ -412,14 +522,14 @@ func (s *mapStateMachine) Commit(proposal statemachine.Proposal[*mapprotocolv1.C
 		case *mapprotocolv1.MapInput_Put:
 			outputs = append(outputs, mapprotocolv1.MapOutput{
 				Output: &mapprotocolv1.MapOutput_Put{
-					Put: s.applyPut(i.Put),
+					Put: s.applyPut(i.Put, i.Put.Value, proposal.Session()),
 				},
 			})
 			delete(s.locks, i.Put.Key)
 		case *mapprotocolv1.MapInput_Insert:
 			outputs = append(outputs, mapprotocolv1.MapOutput{
 				Output: &mapprotocolv1.MapOutput_Insert{
-					Insert: s.applyInsert(i.Insert),
+					Insert: s.applyInsert(i.Insert, proposal.Session()),
 				},
 			})
 			delete(s.locks, i.Insert.Key)
@@ -474,7 +584,13 @@ func (s *mapStateMachine) Abort(proposal statemachine.Proposal[*mapprotocolv1.Ab
 func (s *mapStateMachine) Apply(proposal statemachine.Proposal[*mapprotocolv1.ApplyInput, *mapprotocolv1.ApplyOutput]) {
 	defer proposal.Close()
 
+	if err := s.validateFence(proposal.Input().Fence); err != nil {
+		proposal.Error(err)
+		return
+	}
+
 	keys := make(map[string]bool)
+	values := make(map[string][]byte)
 	for _, input := range proposal.Input().Inputs {
 		switch i := input.Input.(type) {
 		case *mapprotocolv1.MapInput_Put:
@@ -482,10 +598,12 @@ func (s *mapStateMachine) Apply(proposal statemachine.Proposal[*mapprotocolv1.Ap
 				proposal.Error(errors.NewConflict("multiple modifications of key %s in same transaction", i.Put.Key))
 				return
 			}
-			if err := s.validatePut(i.Put); err != nil {
+			value, err := s.validatePut(i.Put)
+			if err != nil {
 				proposal.Error(err)
 				return
 			}
+			values[i.Put.Key] = value
 			keys[i.Put.Key] = true
 		case *mapprotocolv1.MapInput_Insert:
 			if keys[i.Insert.Key] {
@@ -520,20 +638,21 @@ func (s *mapStateMachine) Apply(proposal statemachine.Proposal[*mapprotocolv1.Ap
 		}
 	}
 
+	s.recordFence(proposal.Input().Fence)
 	outputs := make([]mapprotocolv1.MapOutput, 0, len(proposal.Input().Inputs))
 	for _, input := range proposal.Input().Inputs {
 		switch i := input.Input.(type) {
 		case *mapprotocolv1.MapInput_Put:
 			outputs = append(outputs, mapprotocolv1.MapOutput{
 				Output: &mapprotocolv1.MapOutput_Put{
-					Put: s.applyPut(i.Put),
+					Put: s.applyPut(i.Put, values[i.Put.Key], proposal.Session()),
 				},
 			})
 			delete(s.locks, i.Put.Key)
 		case *mapprotocolv1.MapInput_Insert:
 			outputs = append(outputs, mapprotocolv1.MapOutput{
 				Output: &mapprotocolv1.MapOutput_Insert{
-					Insert: s.applyInsert(i.Insert),
+					Insert: s.applyInsert(i.Insert, proposal.Session()),
 				},
 			})
 			delete(s.locks, i.Insert.Key)
@@ -559,31 +678,124 @@ func (s *mapStateMachine) Apply(proposal statemachine.Proposal[*mapprotocolv1.Ap
 	})
 }
 
+// validateFence checks that the fencing token has not been superseded by a newer token.
+// The fencing primitive may be stored in another partition, in which case the client validates the
+// token in the primitive's partition and this partition only rejects tokens older than the newest
+// token it has accepted. Validation does not modify the state; tokens are recorded by recordFence
+// once the write they fence is applied.
+func (s *mapStateMachine) validateFence(fence *mapprotocolv1.Fence) error {
+	if fence == nil {
+		return nil
+	}
+	if token, ok := s.fences[fence.Name]; ok && fence.Token < token {
+		return errors.NewConflict("fencing token %d has been superseded by token %d", fence.Token, token)
+	}
+	if primitive, ok := s.Primitives().Fence(s.Spec().Namespace, fence.Name); ok {
+		if err := checkFence(fence, primitive); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordFence records the token of an applied write for validation of subsequent writes
+func (s *mapStateMachine) recordFence(fence *mapprotocolv1.Fence) {
+	if fence == nil {
+		return
+	}
+	if token, ok := s.fences[fence.Name]; !ok || fence.Token > token {
+		s.fences[fence.Name] = fence.Token
+	}
+}
+
+// checkFence checks the fencing token against the current token issued by the fencing primitive
+func checkFence(fence *mapprotocolv1.Fence, primitive statemachine.Fence) error {
+	token := primitive.Token()
+	if fence.Token < token {
+		return errors.NewConflict("fencing token %d has been superseded by token %d", fence.Token, token)
+	}
+	if fence.Token > token {
+		return errors.NewInvalid("fencing token %d has not been issued", fence.Token)
+	}
+	return nil
+}
+
+// ValidateFence validates a fencing token in the partition in which the fencing primitive is stored
+func (s *mapStateMachine) ValidateFence(proposal statemachine.Proposal[*mapprotocolv1.ValidateFenceInput, *mapprotocolv1.ValidateFenceOutput]) {
+	defer proposal.Close()
+	fence := &proposal.Input().Fence
+	if _, ok := s.Primitives().Fence(s.Spec().Namespace, fence.Name); !ok {
+		proposal.Error(errors.NewInvalid("fencing primitive %s not found", fence.Name))
+		return
+	}
+	if err := s.validateFence(fence); err != nil {
+		proposal.Error(err)
+		return
+	}
+	proposal.Output(&mapprotocolv1.ValidateFenceOutput{})
+}
+
 func (s *mapStateMachine) Put(proposal statemachine.Proposal[*mapprotocolv1.PutInput, *mapprotocolv1.PutOutput]) {
 	defer proposal.Close()
-	if err := s.validatePut(proposal.Input()); err != nil {
+	if value, err := s.validatePut(proposal.Input()); err != nil {
 		proposal.Error(err)
 	} else {
-		proposal.Output(s.applyPut(proposal.Input()))
+		proposal.Output(s.applyPut(proposal.Input(), value, proposal.Session()))
 	}
 }
 
-func (s *mapStateMachine) validatePut(input *mapprotocolv1.PutInput) error {
+// validatePut validates the given put and returns the value to be stored by the put
+func (s *mapStateMachine) validatePut(input *mapprotocolv1.PutInput) ([]byte, error) {
+	if input.Ephemeral && input.TTL != nil {
+		return nil, errors.NewInvalid("ephemeral entries cannot have a TTL")
+	}
+	if err := s.validateFence(input.Fence); err != nil {
+		return nil, err
+	}
 	if _, ok := s.locks[input.Key]; ok {
-		return errors.NewConflict("key %s is locked by another transaction", input.Key)
+		return nil, errors.NewConflict("key %s is locked by another transaction", input.Key)
 	}
 	oldEntry := s.entries[input.Key]
-	if input.PrevIndex > 0 && (oldEntry == nil || oldEntry.Value.Index != input.PrevIndex) {
-		return errors.NewConflict("entry index %d does not match update index %d", oldEntry.Value.Index, input.PrevIndex)
+	if input.PrevIndex > 0 {
+		if oldEntry == nil {
+			return nil, errors.NewConflict("key '%s' not found for update index %d", input.Key, input.PrevIndex)
+		}
+		if oldEntry.Value.Index != input.PrevIndex {
+			return nil, errors.NewConflict("entry index %d does not match update index %d", oldEntry.Value.Index, input.PrevIndex)
+		}
+	}
+	return s.putValue(input)
+}
+
+// putValue returns the value to be stored by the given put, applying the put's patch to the current value if set
+func (s *mapStateMachine) putValue(input *mapprotocolv1.PutInput) ([]byte, error) {
+	if input.Patch == nil {
+		return input.Value, nil
+	}
+	if len(input.Value) > 0 {
+		return nil, errors.NewInvalid("a value cannot be set with a patch")
+	}
+	var doc []byte
+	if entry, ok := s.entries[input.Key]; ok {
+		doc = entry.Value.Value
+	}
+	switch p := input.Patch.Patch.(type) {
+	case *mapprotocolv1.Patch_JsonPatch:
+		return document.Patch(doc, p.JsonPatch)
+	case *mapprotocolv1.Patch_MergePatch:
+		return document.MergePatch(doc, p.MergePatch)
+	default:
+		return nil, errors.NewInvalid("empty patch")
 	}
-	return nil
 }
 
-func (s *mapStateMachine) applyPut(input *mapprotocolv1.PutInput) *mapprotocolv1.PutOutput {
+// applyPut stores the value returned by validatePut for the given put
+func (s *mapStateMachine) applyPut(input *mapprotocolv1.PutInput, value []byte, session statemachine.Session) *mapprotocolv1.PutOutput {
+	s.recordFence(input.Fence)
 	oldEntry := s.entries[input.Key]
 
 	// If the value is equal to the current value, return a no-op.
-	if oldEntry != nil && bytes.Equal(oldEntry.Value.Value, input.Value) {
+	if oldEntry != nil && bytes.Equal(oldEntry.Value.Value, value) {
 		return &mapprotocolv1.PutOutput{
 			Index: oldEntry.Value.Index,
 		}
@@ -593,7 +805,7 @@ func (s *mapStateMachine) applyPut(input *mapprotocolv1.PutInput) *mapprotocolv1
 	newEntry := &mapprotocolv1.MapEntry{
 		Key: input.Key,
 		Value: &mapprotocolv1.MapValue{
-			Value: input.Value,
+			Value: value,
 			Index: s.Index(),
 		},
 	}
@@ -601,9 +813,15 @@ func (s *mapStateMachine) applyPut(input *mapprotocolv1.PutInput) *mapprotocolv1
 		expire := s.Scheduler().Time().Add(*input.TTL)
 		newEntry.Value.Expire = &expire
 	}
+	if input.Ephemeral {
+		newEntry.Value.SessionID = protocol.SessionID(session.ID())
+		s.watchSession(session)
+	}
 
 	// Create a new entry value and set it in the map.
 	s.entries[input.Key] = newEntry
+	s.index.add(input.Key)
+	s.reindex(input.Key, oldEntry, newEntry)
 
 	// Schedule the timeout for the value if necessary.
 	s.scheduleTTL(input.Key, newEntry)
@@ -612,7 +830,8 @@ func (s *mapStateMachine) applyPut(input *mapprotocolv1.PutInput) *mapprotocolv1
 	if oldEntry != nil {
 		s.notify(newEntry, &mapprotocolv1.EventsOutput{
 			Event: mapprotocolv1.Event{
-				Key: newEntry.Key,
+				Key:   newEntry.Key,
+				Patch: input.Patch,
 				Event: &mapprotocolv1.Event_Updated_{
 					Updated: &mapprotocolv1.Event_Updated{
 						Value: mapprotocolv1.IndexedValue{
@@ -637,7 +856,8 @@ func (s *mapStateMachine) applyPut(input *mapprotocolv1.PutInput) *mapprotocolv1
 	} else {
 		s.notify(newEntry, &mapprotocolv1.EventsOutput{
 			Event: mapprotocolv1.Event{
-				Key: newEntry.Key,
+				Key:   newEntry.Key,
+				Patch: input.Patch,
 				Event: &mapprotocolv1.Event_Inserted_{
 					Inserted: &mapprotocolv1.Event_Inserted{
 						Value: mapprotocolv1.IndexedValue{
@@ -659,11 +879,14 @@ func (s *mapStateMachine) Insert(proposal statemachine.Proposal[*mapprotocolv1.I
 	if err := s.validateInsert(proposal.Input()); err != nil {
 		proposal.Error(err)
 	} else {
-		proposal.Output(s.applyInsert(proposal.Input()))
+		proposal.Output(s.applyInsert(proposal.Input(), proposal.Session()))
 	}
 }
 
 func (s *mapStateMachine) validateInsert(input *mapprotocolv1.InsertInput) error {
+	if input.Ephemeral && input.TTL != nil {
+		return errors.NewInvalid("ephemeral entries cannot have a TTL")
+	}
 	if _, ok := s.locks[input.Key]; ok {
 		return errors.NewConflict("key %s is locked by another transaction", input.Key)
 	}
@@ -673,7 +896,7 @@ func (s *mapStateMachine) validateInsert(input *mapprotocolv1.InsertInput) error
 	return nil
 }
 
-func (s *mapStateMachine) applyInsert(input *mapprotocolv1.InsertInput) *mapprotocolv1.InsertOutput {
+func (s *mapStateMachine) applyInsert(input *mapprotocolv1.InsertInput, session statemachine.Session) *mapprotocolv1.InsertOutput {
 	// Create a new entry and increment the revision number
 	newEntry := &mapprotocolv1.MapEntry{
 		Key: input.Key,
@@ -686,9 +909,15 @@ func (s *mapStateMachine) applyInsert(input *mapprotocolv1.InsertInput) *mapprot
 		expire := s.Scheduler().Time().Add(*input.TTL)
 		newEntry.Value.Expire = &expire
 	}
+	if input.Ephemeral {
+		newEntry.Value.SessionID = protocol.SessionID(session.ID())
+		s.watchSession(session)
+	}
 
 	// Create a new entry value and set it in the map.
 	s.entries[input.Key] = newEntry
+	s.index.add(input.Key)
+	s.reindex(input.Key, nil, newEntry)
 
 	// Schedule the timeout for the value if necessary.
 	s.scheduleTTL(input.Key, newEntry)
@@ -723,6 +952,9 @@ func (s *mapStateMachine) Update(proposal statemachine.Proposal[*mapprotocolv1.U
 }
 
 func (s *mapStateMachine) validateUpdate(input *mapprotocolv1.UpdateInput) error {
+	if err := s.validateFence(input.Fence); err != nil {
+		return err
+	}
 	if _, ok := s.locks[input.Key]; ok {
 		return errors.NewConflict("key %s is locked by another transaction", input.Key)
 	}
@@ -737,6 +969,7 @@ func (s *mapStateMachine) validateUpdate(input *mapprotocolv1.UpdateInput) error
 }
 
 func (s *mapStateMachine) applyUpdate(input *mapprotocolv1.UpdateInput) *mapprotocolv1.UpdateOutput {
+	s.recordFence(input.Fence)
 	oldEntry := s.entries[input.Key]
 
 	// Create a new entry and increment the revision number
@@ -752,8 +985,13 @@ func (s *mapStateMachine) applyUpdate(input *mapprotocolv1.UpdateInput) *mapprot
 		newEntry.Value.Expire = &expire
 	}
 
+	// Updates do not change the ownership of ephemeral entries
+	newEntry.Value.SessionID = oldEntry.Value.SessionID
+
 	// Create a new entry value and set it in the map.
 	s.entries[input.Key] = newEntry
+	s.index.add(input.Key)
+	s.reindex(input.Key, oldEntry, newEntry)
 
 	// Schedule the timeout for the value if necessary.
 	s.scheduleTTL(input.Key, newEntry)
@@ -796,6 +1034,9 @@ func (s *mapStateMachine) Remove(proposal statemachine.Proposal[*mapprotocolv1.R
 }
 
 func (s *mapStateMachine) validateRemove(input *mapprotocolv1.RemoveInput) error {
+	if err := s.validateFence(input.Fence); err != nil {
+		return err
+	}
 	if _, ok := s.locks[input.Key]; ok {
 		return errors.NewConflict("key %s is locked by another transaction", input.Key)
 	}
@@ -810,8 +1051,11 @@ func (s *mapStateMachine) validateRemove(input *mapprotocolv1.RemoveInput) error
 }
 
 func (s *mapStateMachine) applyRemove(input *mapprotocolv1.RemoveInput) *mapprotocolv1.RemoveOutput {
+	s.recordFence(input.Fence)
 	entry := s.entries[input.Key]
 	delete(s.entries, input.Key)
+	s.index.remove(input.Key)
+	s.reindex(input.Key, entry, nil)
 
 	// Schedule the timeout for the value if necessary.
 	s.cancelTTL(entry.Key)
@@ -862,12 +1106,114 @@ func (s *mapStateMachine) Clear(proposal statemachine.Proposal[*mapprotocolv1.Cl
 		s.cancelTTL(key)
 		delete(s.entries, key)
 	}
+	s.index.clear()
+	for _, index := range s.indexes {
+		index.clear()
+	}
 	proposal.Output(&mapprotocolv1.ClearOutput{})
 }
 
+func (s *mapStateMachine) PutAll(proposal statemachine.Proposal[*mapprotocolv1.PutAllInput, *mapprotocolv1.PutAllOutput]) {
+	defer proposal.Close()
+	keys := make(map[string]bool)
+	values := make([][]byte, 0, len(proposal.Input().Inputs))
+	for i := range proposal.Input().Inputs {
+		// Each put is validated against the state before the batch, so a key can only be put once
+		key := proposal.Input().Inputs[i].Key
+		if keys[key] {
+			proposal.Error(errors.NewConflict("multiple modifications of key %s in same request", key))
+			return
+		}
+		keys[key] = true
+		value, err := s.validatePut(&proposal.Input().Inputs[i])
+		if err != nil {
+			proposal.Error(err)
+			return
+		}
+		values = append(values, value)
+	}
+	outputs := make([]mapprotocolv1.PutOutput, 0, len(proposal.Input().Inputs))
+	for i := range proposal.Input().Inputs {
+		outputs = append(outputs, *s.applyPut(&proposal.Input().Inputs[i], values[i], proposal.Session()))
+	}
+	proposal.Output(&mapprotocolv1.PutAllOutput{
+		Outputs: outputs,
+	})
+}
+
+func (s *mapStateMachine) RemoveAll(proposal statemachine.Proposal[*mapprotocolv1.RemoveAllInput, *mapprotocolv1.RemoveAllOutput]) {
+	defer proposal.Close()
+	for _, key := range proposal.Input().Keys {
+		if _, ok := s.locks[key]; ok {
+			proposal.Error(errors.NewConflict("key %s is locked by another transaction", key))
+			return
+		}
+	}
+	entries := make([]mapprotocolv1.Entry, 0, len(proposal.Input().Keys))
+	for _, key := range proposal.Input().Keys {
+		// Keys that are not present in the map are ignored
+		if _, ok := s.entries[key]; !ok {
+			continue
+		}
+		output := s.applyRemove(&mapprotocolv1.RemoveInput{
+			Key: key,
+		})
+		entries = append(entries, mapprotocolv1.Entry{
+			Key: key,
+			Value: &mapprotocolv1.IndexedValue{
+				Value: output.Value.Value,
+				Index: output.Value.Index,
+			},
+		})
+	}
+	proposal.Output(&mapprotocolv1.RemoveAllOutput{
+		Entries: entries,
+	})
+}
+
+func (s *mapStateMachine) Configure(proposal statemachine.Proposal[*mapprotocolv1.ConfigureInput, *mapprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	indexes := make(map[string]*valueIndex)
+	for _, config := range proposal.Input().Indexes {
+		if _, ok := indexes[config.Name]; ok {
+			proposal.Error(errors.NewInvalid("duplicate index %s", config.Name))
+			return
+		}
+
+		// Indexes that are unchanged by the configuration are not rebuilt
+		if index, ok := s.indexes[config.Name]; ok && proto.Equal(&index.config, &config) {
+			indexes[config.Name] = index
+			continue
+		}
+
+		index, err := newValueIndex(config)
+		if err != nil {
+			proposal.Error(err)
+			return
+		}
+		for key, entry := range s.entries {
+			index.add(key, entry.Value.Value)
+		}
+		indexes[config.Name] = index
+	}
+	s.indexes = indexes
+	proposal.Output(&mapprotocolv1.ConfigureOutput{})
+}
+
 func (s *mapStateMachine) Events(proposal statemachine.Proposal[*mapprotocolv1.EventsInput, *mapprotocolv1.EventsOutput]) {
+	if proposal.Input().Pattern != "" {
+		if _, err := path.Match(proposal.Input().Pattern, ""); err != nil {
+			proposal.Error(errors.NewInvalid("invalid key pattern '%s'", proposal.Input().Pattern))
+			proposal.Close()
+			return
+		}
+	}
 	listener := &mapprotocolv1.MapListener{
-		Key: proposal.Input().Key,
+		Key:     proposal.Input().Key,
+		Prefix:  proposal.Input().Prefix,
+		Pattern: proposal.Input().Pattern,
+		Keys:    proposal.Input().Keys,
+		Types:   proposal.Input().Types,
 	}
 	s.listeners[proposal.ID()] = listener
 	proposal.Output(&mapprotocolv1.EventsOutput{
@@ -894,18 +1240,57 @@ func (s *mapStateMachine) Get(query statemachine.Query[*mapprotocolv1.GetInput,
 	entry, ok := s.entries[query.Input().Key]
 	if !ok {
 		query.Error(errors.NewNotFound("key %s not found", query.Input().Key))
-	} else {
-		query.Output(&mapprotocolv1.GetOutput{
-			Value: mapprotocolv1.IndexedValue{
+		return
+	}
+	value := entry.Value.Value
+	if len(query.Input().Paths) > 0 {
+		projection, err := document.Project(value, query.Input().Paths)
+		if err != nil {
+			query.Error(err)
+			return
+		}
+		value = projection
+	}
+	query.Output(&mapprotocolv1.GetOutput{
+		Value: mapprotocolv1.IndexedValue{
+			Value: value,
+			Index: entry.Value.Index,
+		},
+	})
+}
+
+func (s *mapStateMachine) GetAll(query statemachine.Query[*mapprotocolv1.GetAllInput, *mapprotocolv1.GetAllOutput]) {
+	defer query.Close()
+	entries := make([]mapprotocolv1.Entry, 0, len(query.Input().Keys))
+	for _, key := range query.Input().Keys {
+		// Keys that are not present in the map are omitted from the output
+		entry, ok := s.entries[key]
+		if !ok {
+			continue
+		}
+		entries = append(entries, mapprotocolv1.Entry{
+			Key: entry.Key,
+			Value: &mapprotocolv1.IndexedValue{
 				Value: entry.Value.Value,
 				Index: entry.Value.Index,
 			},
 		})
 	}
+	query.Output(&mapprotocolv1.GetAllOutput{
+		Entries: entries,
+	})
 }
 
 func (s *mapStateMachine) Entries(query statemachine.Query[*mapprotocolv1.EntriesInput, *mapprotocolv1.EntriesOutput]) {
-	for _, entry := range s.entries {
+	if err := page.Validate(query.Input().Limit, query.Input().Watch); err != nil {
+		query.Error(err)
+		query.Close()
+		return
+	}
+
+	var count uint32
+	s.index.scan(newKeyRange(query.Input()), func(key string) bool {
+		entry := s.entries[key]
 		query.Output(&mapprotocolv1.EntriesOutput{
 			Entry: mapprotocolv1.Entry{
 				Key: entry.Key,
@@ -915,7 +1300,9 @@ func (s *mapStateMachine) Entries(query statemachine.Query[*mapprotocolv1.Entrie
 				},
 			},
 		})
-	}
+		count++
+		return query.Input().Limit == 0 || count < query.Input().Limit
+	})
 
 	if query.Input().Watch {
 		s.watchers.Store(query.ID(), query)
@@ -929,9 +1316,69 @@ func (s *mapStateMachine) Entries(query statemachine.Query[*mapprotocolv1.Entrie
 	}
 }
 
+func (s *mapStateMachine) Query(query statemachine.Query[*mapprotocolv1.QueryInput, *mapprotocolv1.QueryOutput]) {
+	defer query.Close()
+	index, ok := s.indexes[query.Input().Index]
+	if !ok {
+		query.Error(errors.NewNotFound("index %s not found", query.Input().Index))
+		return
+	}
+
+	var min, max []byte
+	switch q := query.Input().Query.Query.(type) {
+	case *mapprotocolv1.IndexQuery_Equals:
+		indexKey, err := index.encode(q.Equals)
+		if err != nil {
+			query.Error(err)
+			return
+		}
+		min, max = indexKey, indexKey
+	case *mapprotocolv1.IndexQuery_Range:
+		if q.Range.GetMin() != nil {
+			indexKey, err := index.encode(q.Range.Min.Value)
+			if err != nil {
+				query.Error(err)
+				return
+			}
+			min = indexKey
+		}
+		if q.Range.GetMax() != nil {
+			indexKey, err := index.encode(q.Range.Max.Value)
+			if err != nil {
+				query.Error(err)
+				return
+			}
+			max = indexKey
+		}
+	}
+
+	limit := int(query.Input().Limit)
+	var results []mapprotocolv1.QueryResult
+	index.scan(min, max, func(indexKey []byte, key string) bool {
+		if limit > 0 && len(results) == limit {
+			return false
+		}
+		entry := s.entries[key]
+		results = append(results, mapprotocolv1.QueryResult{
+			Entry: mapprotocolv1.Entry{
+				Key: key,
+				Value: &mapprotocolv1.IndexedValue{
+					Value: entry.Value.Value,
+					Index: entry.Value.Index,
+				},
+			},
+			IndexKey: indexKey,
+		})
+		return true
+	})
+	query.Output(&mapprotocolv1.QueryOutput{
+		Results: results,
+	})
+}
+
 func (s *mapStateMachine) notify(entry *mapprotocolv1.MapEntry, event *mapprotocolv1.EventsOutput) {
 	for proposalID, listener := range s.listeners {
-		if listener.Key == "" || listener.Key == event.Event.Key {
+		if matches(listener, &event.Event) {
 			proposal, ok := s.MapContext.Events().Get(proposalID)
 			if ok {
 				proposal.Output(event)
@@ -943,6 +1390,9 @@ func (s *mapStateMachine) notify(entry *mapprotocolv1.MapEntry, event *mapprotoc
 
 	s.watchers.Range(func(key, value any) bool {
 		watcher := value.(statemachine.Query[*mapprotocolv1.EntriesInput, *mapprotocolv1.EntriesOutput])
+		if !newKeyRange(watcher.Input()).contains(entry.Key) {
+			return true
+		}
 		if entry.Value != nil {
 			watcher.Output(&mapprotocolv1.EntriesOutput{
 				Entry: mapprotocolv1.Entry{
@@ -969,6 +1419,8 @@ func (s *mapStateMachine) scheduleTTL(key string, entry *mapprotocolv1.MapEntry)
 	if entry.Value.Expire != nil {
 		s.timers[key] = s.Scheduler().Schedule(*entry.Value.Expire, func() {
 			delete(s.entries, key)
+			s.index.remove(key)
+			s.reindex(key, entry, nil)
 			s.notify(entry, &mapprotocolv1.EventsOutput{
 				Event: mapprotocolv1.Event{
 					Key: key,
@@ -987,9 +1439,80 @@ func (s *mapStateMachine) scheduleTTL(key string, entry *mapprotocolv1.MapEntry)
 	}
 }
 
+// reindex updates the secondary indexes for a change of the entry for the given key
+// A nil old entry indicates the key was inserted, and a nil new entry indicates the key was removed.
+func (s *mapStateMachine) reindex(key string, oldEntry, newEntry *mapprotocolv1.MapEntry) {
+	for _, index := range s.indexes {
+		if oldEntry != nil {
+			index.remove(key, oldEntry.Value.Value)
+		}
+		if newEntry != nil {
+			index.add(key, newEntry.Value.Value)
+		}
+	}
+}
+
 func (s *mapStateMachine) cancelTTL(key string) {
 	ttlCancelFunc, ok := s.timers[key]
 	if ok {
 		ttlCancelFunc()
 	}
 }
+
+// matches returns whether the given event passes all the listener's filters
+func matches(listener *mapprotocolv1.MapListener, event *mapprotocolv1.Event) bool {
+	if listener.Key != "" && listener.Key != event.Key {
+		return false
+	}
+	if !strings.HasPrefix(event.Key, listener.Prefix) {
+		return false
+	}
+	if listener.Pattern != "" {
+		if ok, err := path.Match(listener.Pattern, event.Key); !ok || err != nil {
+			return false
+		}
+	}
+	if len(listener.Keys) > 0 {
+		found := false
+		for _, key := range listener.Keys {
+			if key == event.Key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(listener.Types) > 0 {
+		var eventType mapprotocolv1.EventsInput_EventType
+		switch event.Event.(type) {
+		case *mapprotocolv1.Event_Inserted_:
+			eventType = mapprotocolv1.EventsInput_INSERTED
+		case *mapprotocolv1.Event_Updated_:
+			eventType = mapprotocolv1.EventsInput_UPDATED
+		case *mapprotocolv1.Event_Removed_:
+			eventType = mapprotocolv1.EventsInput_REMOVED
+		}
+		found := false
+		for _, t := range listener.Types {
+			if t == eventType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func newKeyRange(input *mapprotocolv1.EntriesInput) keyRange {
+	return keyRange{
+		prefix: input.Prefix,
+		start:  input.StartKey,
+		end:    input.EndKey,
+		after:  input.After,
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	membershipprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/membership/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var membershipCodec = statemachine.NewCodec[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput](
+	func(bytes []byte) (*membershipprotocolv1.MembershipInput, error) {
+		input := &membershipprotocolv1.MembershipInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *membershipprotocolv1.MembershipOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm MembershipStateMachine) statemachine.PrimitiveStateMachine[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput] {
+	executor := &MembershipExecutor{
+		MembershipStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type MembershipExecutor struct {
+	MembershipStateMachine
+	join  statemachine.Proposer[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.JoinInput, *membershipprotocolv1.JoinOutput]
+	leave statemachine.Proposer[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.LeaveInput, *membershipprotocolv1.LeaveOutput]
+	list  statemachine.Querier[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.ListInput, *membershipprotocolv1.ListOutput]
+	watch statemachine.Querier[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.WatchInput, *membershipprotocolv1.WatchOutput]
+}
+
+func (s *MembershipExecutor) init() {
+	s.join = statemachine.NewProposer[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.JoinInput, *membershipprotocolv1.JoinOutput]("Join").
+		Decoder(func(input *membershipprotocolv1.MembershipInput) (*membershipprotocolv1.JoinInput, bool) {
+			if join, ok := input.Input.(*membershipprotocolv1.MembershipInput_Join); ok {
+				return join.Join, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *membershipprotocolv1.JoinOutput) *membershipprotocolv1.MembershipOutput {
+			return &membershipprotocolv1.MembershipOutput{
+				Output: &membershipprotocolv1.MembershipOutput_Join{
+					Join: output,
+				},
+			}
+		}).
+		Build(s.Join)
+	s.leave = statemachine.NewProposer[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.LeaveInput, *membershipprotocolv1.LeaveOutput]("Leave").
+		Decoder(func(input *membershipprotocolv1.MembershipInput) (*membershipprotocolv1.LeaveInput, bool) {
+			if leave, ok := input.Input.(*membershipprotocolv1.MembershipInput_Leave); ok {
+				return leave.Leave, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *membershipprotocolv1.LeaveOutput) *membershipprotocolv1.MembershipOutput {
+			return &membershipprotocolv1.MembershipOutput{
+				Output: &membershipprotocolv1.MembershipOutput_Leave{
+					Leave: output,
+				},
+			}
+		}).
+		Build(s.Leave)
+	s.list = statemachine.NewQuerier[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.ListInput, *membershipprotocolv1.ListOutput]("List").
+		Decoder(func(input *membershipprotocolv1.MembershipInput) (*membershipprotocolv1.ListInput, bool) {
+			if list, ok := input.Input.(*membershipprotocolv1.MembershipInput_List); ok {
+				return list.List, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *membershipprotocolv1.ListOutput) *membershipprotocolv1.MembershipOutput {
+			return &membershipprotocolv1.MembershipOutput{
+				Output: &membershipprotocolv1.MembershipOutput_List{
+					List: output,
+				},
+			}
+		}).
+		Build(s.List)
+	s.watch = statemachine.NewQuerier[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput, *membershipprotocolv1.WatchInput, *membershipprotocolv1.WatchOutput]("Watch").
+		Decoder(func(input *membershipprotocolv1.MembershipInput) (*membershipprotocolv1.WatchInput, bool) {
+			if watch, ok := input.Input.(*membershipprotocolv1.MembershipInput_Watch); ok {
+				return watch.Watch, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *membershipprotocolv1.WatchOutput) *membershipprotocolv1.MembershipOutput {
+			return &membershipprotocolv1.MembershipOutput{
+				Output: &membershipprotocolv1.MembershipOutput_Watch{
+					Watch: output,
+				},
+			}
+		}).
+		Build(s.Watch)
+}
+
+func (s *MembershipExecutor) Propose(proposal statemachine.Proposal[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *membershipprotocolv1.MembershipInput_Join:
+		s.join(proposal)
+	case *membershipprotocolv1.MembershipInput_Leave:
+		s.leave(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *MembershipExecutor) Query(query statemachine.Query[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput]) {
+	switch query.Input().Input.(type) {
+	case *membershipprotocolv1.MembershipInput_List:
+		s.list(query)
+	case *membershipprotocolv1.MembershipInput_Watch:
+		s.watch(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"sync"
+
+	"github.com/atomix/atomix/api/errors"
+	membershipprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/membership/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "Membership"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput]) statemachine.PrimitiveStateMachine[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput] {
+			return newExecutor(NewMembershipStateMachine(context))
+		}, membershipCodec)
+}
+
+type MembershipContext interface {
+	statemachine.PrimitiveContext[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput]
+}
+
+type MembershipStateMachine interface {
+	statemachine.Context[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput]
+	statemachine.Recoverable
+	Join(statemachine.Proposal[*membershipprotocolv1.JoinInput, *membershipprotocolv1.JoinOutput])
+	Leave(statemachine.Proposal[*membershipprotocolv1.LeaveInput, *membershipprotocolv1.LeaveOutput])
+	List(statemachine.Query[*membershipprotocolv1.ListInput, *membershipprotocolv1.ListOutput])
+	Watch(statemachine.Query[*membershipprotocolv1.WatchInput, *membershipprotocolv1.WatchOutput])
+}
+
+func NewMembershipStateMachine(context statemachine.PrimitiveContext[*membershipprotocolv1.MembershipInput, *membershipprotocolv1.MembershipOutput]) MembershipStateMachine {
+	return &membershipStateMachine{
+		MembershipContext: context,
+		sessions:          make(map[statemachine.SessionID]statemachine.CancelFunc),
+		watchers:          make(map[statemachine.QueryID]statemachine.Query[*membershipprotocolv1.WatchInput, *membershipprotocolv1.WatchOutput]),
+	}
+}
+
+type membershipStateMachine struct {
+	MembershipContext
+	membershipprotocolv1.MembershipSnapshot
+	sessions map[statemachine.SessionID]statemachine.CancelFunc
+	watchers map[statemachine.QueryID]statemachine.Query[*membershipprotocolv1.WatchInput, *membershipprotocolv1.WatchOutput]
+	mu       sync.RWMutex
+}
+
+func (s *membershipStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteMessage(&s.MembershipSnapshot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *membershipStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		if err := reader.ReadMessage(&s.MembershipSnapshot); err != nil {
+			return err
+		}
+		for _, member := range s.Members {
+			if err := s.watchSession(statemachine.SessionID(member.SessionID)); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// watchSession removes the members joined through the given session when the session expires
+// Sessions are kept open by the keep-alives sent by the client, so members of crashed clients are
+// removed once their session times out.
+func (s *membershipStateMachine) watchSession(sessionID statemachine.SessionID) error {
+	if _, ok := s.sessions[sessionID]; ok {
+		return nil
+	}
+	session, ok := s.Sessions().Get(sessionID)
+	if !ok {
+		return errors.NewFault("unknown session")
+	}
+	s.sessions[sessionID] = session.Watch(func(state statemachine.State) {
+		if state == statemachine.Closed {
+			delete(s.sessions, sessionID)
+			members := make([]membershipprotocolv1.MembershipMember, 0, len(s.Members))
+			var expired []membershipprotocolv1.MembershipMember
+			for _, member := range s.Members {
+				if statemachine.SessionID(member.SessionID) == sessionID {
+					expired = append(expired, member)
+				} else {
+					members = append(members, member)
+				}
+			}
+			s.Members = members
+			for _, member := range expired {
+				s.notify(membershipprotocolv1.Event{
+					Name: member.Name,
+					Event: &membershipprotocolv1.Event_Left_{
+						Left: &membershipprotocolv1.Event_Left{
+							Metadata: member.Metadata,
+							Expired:  true,
+						},
+					},
+				})
+			}
+		}
+	})
+	return nil
+}
+
+// unwatchSession stops watching the given session if it no longer has any members
+func (s *membershipStateMachine) unwatchSession(sessionID statemachine.SessionID) {
+	for _, member := range s.Members {
+		if statemachine.SessionID(member.SessionID) == sessionID {
+			return
+		}
+	}
+	if cancel, ok := s.sessions[sessionID]; ok {
+		cancel()
+		delete(s.sessions, sessionID)
+	}
+}
+
+func (s *membershipStateMachine) getMember(name string) (*membershipprotocolv1.MembershipMember, bool) {
+	for i := range s.Members {
+		if s.Members[i].Name == name {
+			return &s.Members[i], true
+		}
+	}
+	return nil, false
+}
+
+func (s *membershipStateMachine) Join(proposal statemachine.Proposal[*membershipprotocolv1.JoinInput, *membershipprotocolv1.JoinOutput]) {
+	defer proposal.Close()
+	name := proposal.Input().Member.Name
+	metadata := proposal.Input().Member.Metadata
+	if name == "" {
+		proposal.Error(errors.NewInvalid("member name cannot be empty"))
+		return
+	}
+
+	if member, ok := s.getMember(name); ok {
+		if statemachine.SessionID(member.SessionID) != proposal.Session().ID() {
+			proposal.Error(errors.NewConflict("member '%s' already joined by another session", name))
+			return
+		}
+		if !equalMetadata(member.Metadata, metadata) {
+			prevMetadata := member.Metadata
+			member.Metadata = metadata
+			s.notify(membershipprotocolv1.Event{
+				Name: name,
+				Event: &membershipprotocolv1.Event_Updated_{
+					Updated: &membershipprotocolv1.Event_Updated{
+						Metadata:     metadata,
+						PrevMetadata: prevMetadata,
+					},
+				},
+			})
+		}
+		proposal.Output(&membershipprotocolv1.JoinOutput{})
+		return
+	}
+
+	if err := s.watchSession(proposal.Session().ID()); err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.Members = append(s.Members, membershipprotocolv1.MembershipMember{
+		Name:      name,
+		Metadata:  metadata,
+		SessionID: protocol.SessionID(proposal.Session().ID()),
+	})
+	s.notify(membershipprotocolv1.Event{
+		Name: name,
+		Event: &membershipprotocolv1.Event_Joined_{
+			Joined: &membershipprotocolv1.Event_Joined{
+				Metadata: metadata,
+			},
+		},
+	})
+	proposal.Output(&membershipprotocolv1.JoinOutput{})
+}
+
+func (s *membershipStateMachine) Leave(proposal statemachine.Proposal[*membershipprotocolv1.LeaveInput, *membershipprotocolv1.LeaveOutput]) {
+	defer proposal.Close()
+	name := proposal.Input().Name
+	member, ok := s.getMember(name)
+	if !ok {
+		proposal.Error(errors.NewNotFound("member '%s' not found", name))
+		return
+	}
+	if statemachine.SessionID(member.SessionID) != proposal.Session().ID() {
+		proposal.Error(errors.NewConflict("member '%s' joined by another session", name))
+		return
+	}
+
+	metadata := member.Metadata
+	members := make([]membershipprotocolv1.MembershipMember, 0, len(s.Members))
+	for _, member := range s.Members {
+		if member.Name != name {
+			members = append(members, member)
+		}
+	}
+	s.Members = members
+	s.unwatchSession(proposal.Session().ID())
+	s.notify(membershipprotocolv1.Event{
+		Name: name,
+		Event: &membershipprotocolv1.Event_Left_{
+			Left: &membershipprotocolv1.Event_Left{
+				Metadata: metadata,
+			},
+		},
+	})
+	proposal.Output(&membershipprotocolv1.LeaveOutput{})
+}
+
+func (s *membershipStateMachine) List(query statemachine.Query[*membershipprotocolv1.ListInput, *membershipprotocolv1.ListOutput]) {
+	defer query.Close()
+	members := make([]membershipprotocolv1.Member, 0, len(s.Members))
+	for _, member := range s.Members {
+		members = append(members, membershipprotocolv1.Member{
+			Name:     member.Name,
+			Metadata: member.Metadata,
+		})
+	}
+	query.Output(&membershipprotocolv1.ListOutput{
+		Members: members,
+	})
+}
+
+func (s *membershipStateMachine) Watch(query statemachine.Query[*membershipprotocolv1.WatchInput, *membershipprotocolv1.WatchOutput]) {
+	if query.Input().Replay {
+		for _, member := range s.Members {
+			query.Output(&membershipprotocolv1.WatchOutput{
+				Event: membershipprotocolv1.Event{
+					Name: member.Name,
+					Event: &membershipprotocolv1.Event_Joined_{
+						Joined: &membershipprotocolv1.Event_Joined{
+							Metadata: member.Metadata,
+						},
+					},
+				},
+			})
+		}
+	}
+
+	s.mu.Lock()
+	s.watchers[query.ID()] = query
+	s.mu.Unlock()
+	query.Watch(func(state statemachine.QueryState) {
+		if state != statemachine.Running {
+			s.mu.Lock()
+			delete(s.watchers, query.ID())
+			s.mu.Unlock()
+		}
+	})
+}
+
+func (s *membershipStateMachine) notify(event membershipprotocolv1.Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, watcher := range s.watchers {
+		watcher.Output(&membershipprotocolv1.WatchOutput{
+			Event: event,
+		})
+	}
+}
+
+func equalMetadata(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
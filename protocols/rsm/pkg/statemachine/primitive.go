@@ -60,30 +60,30 @@ func (t *primitiveType[I, O]) NewStateMachine(context PrimitiveContext[I, O]) Pr
 
 func RegisterPrimitiveType[I, O any](registry *PrimitiveTypeRegistry) func(primitiveType protocol.PrimitiveType, factory NewStateMachineFunc[I, O], codec Codec[I, O]) {
 	return func(primitiveType protocol.PrimitiveType, factory NewStateMachineFunc[I, O], codec Codec[I, O]) {
-		registry.register(primitiveType, func(context SessionContext, id protocol.PrimitiveID, spec protocol.PrimitiveSpec) managedPrimitive {
-			return newPrimitive[I, O](context, id, spec, factory, codec)
+		registry.register(primitiveType, func(context SessionContext, primitives Primitives, id protocol.PrimitiveID, spec protocol.PrimitiveSpec) managedPrimitive {
+			return newPrimitive[I, O](context, primitives, id, spec, factory, codec)
 		})
 	}
 }
 
 func NewPrimitiveTypeRegistry() *PrimitiveTypeRegistry {
 	return &PrimitiveTypeRegistry{
-		types: make(map[protocol.PrimitiveType]func(SessionContext, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive),
+		types: make(map[protocol.PrimitiveType]func(SessionContext, Primitives, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive),
 	}
 }
 
 type PrimitiveTypeRegistry struct {
-	types map[protocol.PrimitiveType]func(SessionContext, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive
+	types map[protocol.PrimitiveType]func(SessionContext, Primitives, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive
 	mu    sync.RWMutex
 }
 
-func (r *PrimitiveTypeRegistry) register(primitiveType protocol.PrimitiveType, factory func(SessionContext, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive) {
+func (r *PrimitiveTypeRegistry) register(primitiveType protocol.PrimitiveType, factory func(SessionContext, Primitives, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.types[primitiveType] = factory
 }
 
-func (r *PrimitiveTypeRegistry) lookup(primitiveType protocol.PrimitiveType) (func(SessionContext, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive, bool) {
+func (r *PrimitiveTypeRegistry) lookup(primitiveType protocol.PrimitiveType) (func(SessionContext, Primitives, protocol.PrimitiveID, protocol.PrimitiveSpec) managedPrimitive, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	factory, ok := r.types[primitiveType]
@@ -93,6 +93,8 @@ func (r *PrimitiveTypeRegistry) lookup(primitiveType protocol.PrimitiveType) (fu
 type PrimitiveContext[I, O any] interface {
 	Context[I, O]
 	Info
+	// Primitives returns the primitives in the partition
+	Primitives() Primitives
 }
 
 type PrimitiveStateMachine[I, O any] interface {
@@ -109,6 +111,18 @@ type PrimitiveManager interface {
 	Query(query PrimitiveQuery)
 }
 
+// Primitives provides access to the other primitives in a partition
+type Primitives interface {
+	// Fence returns the fence for the named primitive if it exists and issues fencing tokens
+	Fence(namespace, name string) (Fence, bool)
+}
+
+// Fence is implemented by primitive state machines that issue fencing tokens, e.g. locks and elections
+type Fence interface {
+	// Token returns the most recently issued fencing token
+	Token() uint64
+}
+
 type CreatePrimitiveProposal Proposal[*protocol.CreatePrimitiveInput, *protocol.CreatePrimitiveOutput]
 type ClosePrimitiveProposal Proposal[*protocol.ClosePrimitiveInput, *protocol.ClosePrimitiveOutput]
 type PrimitiveProposal Proposal[*protocol.PrimitiveProposalInput, *protocol.PrimitiveProposalOutput]
@@ -162,7 +176,7 @@ func (m *primitiveManager) Recover(reader *SnapshotReader) error {
 			return errors.NewFault("primitive type not found")
 		}
 		primitiveID := snapshot.PrimitiveID
-		primitive := factory(m.SessionContext, primitiveID, snapshot.Spec)
+		primitive := factory(m.SessionContext, m, primitiveID, snapshot.Spec)
 		m.primitives[primitiveID] = primitive
 		if err := primitive.Recover(reader); err != nil {
 			return err
@@ -209,7 +223,7 @@ func (m *primitiveManager) CreatePrimitive(proposal CreatePrimitiveProposal) {
 			return
 		} else {
 			primitiveID := protocol.PrimitiveID(proposal.ID())
-			primitive = factory(m.SessionContext, primitiveID, proposal.Input().PrimitiveSpec)
+			primitive = factory(m.SessionContext, m, primitiveID, proposal.Input().PrimitiveSpec)
 			m.primitives[primitiveID] = primitive
 		}
 	}
@@ -227,6 +241,18 @@ func (m *primitiveManager) ClosePrimitive(proposal ClosePrimitiveProposal) {
 	}
 }
 
+func (m *primitiveManager) Fence(namespace, name string) (Fence, bool) {
+	for _, primitive := range m.primitives {
+		if primitive.Spec().Namespace == namespace && primitive.Spec().Name == name {
+			// Only primitives that issue fencing tokens can fence writes
+			if fence, ok := primitive.fence(); ok {
+				return fence, true
+			}
+		}
+	}
+	return nil, false
+}
+
 func (m *primitiveManager) Query(query PrimitiveQuery) {
 	primitive, ok := m.primitives[query.Input().PrimitiveID]
 	if !ok {
@@ -253,11 +279,13 @@ type managedPrimitive interface {
 	close(proposal Proposal[*protocol.ClosePrimitiveInput, *protocol.ClosePrimitiveOutput])
 	propose(proposal Proposal[*protocol.PrimitiveProposalInput, *protocol.PrimitiveProposalOutput])
 	query(query Query[*protocol.PrimitiveQueryInput, *protocol.PrimitiveQueryOutput])
+	fence() (Fence, bool)
 }
 
-func newPrimitiveContext[I, O any](parent SessionContext, id protocol.PrimitiveID, spec protocol.PrimitiveSpec, codec Codec[I, O]) *primitiveContext[I, O] {
+func newPrimitiveContext[I, O any](parent SessionContext, primitives Primitives, id protocol.PrimitiveID, spec protocol.PrimitiveSpec, codec Codec[I, O]) *primitiveContext[I, O] {
 	return &primitiveContext[I, O]{
 		SessionContext: parent,
+		primitives:     primitives,
 		id:             id,
 		spec:           spec,
 		sessions:       newPrimitiveSessions[I, O](),
@@ -274,12 +302,13 @@ func newPrimitiveContext[I, O any](parent SessionContext, id protocol.PrimitiveI
 
 type primitiveContext[I, O any] struct {
 	SessionContext
-	id        protocol.PrimitiveID
-	spec      protocol.PrimitiveSpec
-	codec     Codec[I, O]
-	sessions  *primitiveSessions[I, O]
-	proposals *primitiveProposals[I, O]
-	log       logging.Logger
+	primitives Primitives
+	id         protocol.PrimitiveID
+	spec       protocol.PrimitiveSpec
+	codec      Codec[I, O]
+	sessions   *primitiveSessions[I, O]
+	proposals  *primitiveProposals[I, O]
+	log        logging.Logger
 }
 
 func (c *primitiveContext[I, O]) Log() logging.Logger {
@@ -302,8 +331,12 @@ func (c *primitiveContext[I, O]) Proposals() Proposals[I, O] {
 	return c.proposals
 }
 
-func newPrimitive[I, O any](parent SessionContext, id protocol.PrimitiveID, spec protocol.PrimitiveSpec, factory NewStateMachineFunc[I, O], codec Codec[I, O]) managedPrimitive {
-	context := newPrimitiveContext[I, O](parent, id, spec, codec)
+func (c *primitiveContext[I, O]) Primitives() Primitives {
+	return c.primitives
+}
+
+func newPrimitive[I, O any](parent SessionContext, primitives Primitives, id protocol.PrimitiveID, spec protocol.PrimitiveSpec, factory NewStateMachineFunc[I, O], codec Codec[I, O]) managedPrimitive {
+	context := newPrimitiveContext[I, O](parent, primitives, id, spec, codec)
 	return &primitiveExecutor[I, O]{
 		primitiveContext: context,
 		sm:               factory(context),
@@ -382,6 +415,11 @@ func (p *primitiveExecutor[I, O]) query(query Query[*protocol.PrimitiveQueryInpu
 	}
 }
 
+func (p *primitiveExecutor[I, O]) fence() (Fence, bool) {
+	fence, ok := p.sm.(Fence)
+	return fence, ok
+}
+
 func newPrimitiveSession[I, O any](primitive *primitiveExecutor[I, O]) *primitiveSession[I, O] {
 	s := &primitiveSession[I, O]{
 		primitive: primitive,
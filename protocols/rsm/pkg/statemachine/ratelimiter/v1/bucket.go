@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"time"
+)
+
+const second = uint64(time.Second)
+
+// tokenBucket is a token bucket refilled from the state machine's logical time
+// Refills are computed with integer arithmetic so all replicas arrive at the same state.
+type tokenBucket struct {
+	tokens  uint64
+	updated time.Time
+}
+
+// refill adds the tokens produced at the given rate since the bucket was last updated, up to the burst
+// The update time only advances by the time needed to produce the added tokens, so progress toward the
+// next token is preserved across refills. The time is rounded down, erring on the side of producing tokens.
+func (b *tokenBucket) refill(rate, burst uint64, now time.Time) {
+	// Buckets are not rate limited at a zero rate, so no tokens are produced
+	if rate == 0 {
+		b.updated = now
+		return
+	}
+	if b.tokens >= burst {
+		b.tokens = burst
+		b.updated = now
+		return
+	}
+	tokens := tokensIn(rate, now.Sub(b.updated))
+	if b.tokens+tokens >= burst {
+		b.tokens = burst
+		b.updated = now
+		return
+	}
+	b.tokens += tokens
+	b.updated = b.updated.Add(durationOf(rate, tokens))
+}
+
+// availableAt returns the time at which the bucket will hold the given number of tokens
+func (b *tokenBucket) availableAt(rate uint64, tokens uint64) time.Time {
+	if b.tokens >= tokens {
+		return b.updated
+	}
+	d := durationOf(rate, tokens-b.tokens)
+	if tokensIn(rate, d) < tokens-b.tokens {
+		d++
+	}
+	return b.updated.Add(d)
+}
+
+// tokensIn returns the number of whole tokens produced at the given rate in the given duration
+func tokensIn(rate uint64, d time.Duration) uint64 {
+	if d <= 0 || rate == 0 {
+		return 0
+	}
+	n := uint64(d)
+	return n/second*rate + n%second*rate/second
+}
+
+// durationOf returns the time needed to produce the given number of tokens at the given rate, rounded down
+func durationOf(rate uint64, tokens uint64) time.Duration {
+	if rate == 0 {
+		return 0
+	}
+	return time.Duration(tokens/rate*second + tokens%rate*second/rate)
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/stretchr/testify/assert"
+	ratelimiterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/ratelimiter/v1"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	start := time.Unix(0, 0)
+	bucket := &tokenBucket{tokens: 0, updated: start}
+
+	// 3 tokens per second produces a token every 333.33ms
+	bucket.refill(3, 10, start.Add(300*time.Millisecond))
+	assert.Equal(t, uint64(0), bucket.tokens)
+	assert.Equal(t, start, bucket.updated)
+
+	bucket.refill(3, 10, start.Add(700*time.Millisecond))
+	assert.Equal(t, uint64(2), bucket.tokens)
+	assert.Equal(t, start.Add(666666666*time.Nanosecond), bucket.updated)
+
+	// Progress toward the next token is preserved across refills
+	bucket.refill(3, 10, start.Add(time.Second))
+	assert.Equal(t, uint64(3), bucket.tokens)
+	assert.Equal(t, start.Add(999999999*time.Nanosecond), bucket.updated)
+
+	assert.Equal(t, bucket.updated, bucket.availableAt(3, 2))
+	assert.Equal(t, start.Add(time.Second+666666666*time.Nanosecond), bucket.availableAt(3, 5))
+	bucket.refill(3, 10, bucket.availableAt(3, 5))
+	assert.Equal(t, uint64(5), bucket.tokens)
+
+	// The bucket never holds more than the burst
+	bucket.refill(3, 10, start.Add(time.Hour))
+	assert.Equal(t, uint64(10), bucket.tokens)
+	assert.Equal(t, start.Add(time.Hour), bucket.updated)
+	bucket.refill(3, 4, start.Add(time.Hour))
+	assert.Equal(t, uint64(4), bucket.tokens)
+
+	assert.Equal(t, uint64(0), tokensIn(0, time.Hour))
+	assert.Equal(t, uint64(0), tokensIn(10, -time.Second))
+	assert.Equal(t, uint64(36000000), tokensIn(10000, time.Hour))
+	assert.Equal(t, time.Millisecond, durationOf(1000, 1))
+	assert.Equal(t, 2500*time.Millisecond, durationOf(2, 5))
+	assert.Equal(t, 333333333*time.Nanosecond, durationOf(3, 1))
+	assert.Equal(t, time.Duration(0), durationOf(0, 1))
+
+	// A zero rate never refills the bucket
+	bucket = &tokenBucket{tokens: 2, updated: start}
+	bucket.refill(0, 10, start.Add(time.Hour))
+	assert.Equal(t, uint64(2), bucket.tokens)
+	assert.Equal(t, start.Add(time.Hour), bucket.updated)
+}
+
+func TestValidateLimit(t *testing.T) {
+	assert.NoError(t, validateLimit(ratelimiterprotocolv1.Limit{}))
+	assert.NoError(t, validateLimit(ratelimiterprotocolv1.Limit{Rate: 10}))
+	assert.NoError(t, validateLimit(ratelimiterprotocolv1.Limit{Rate: 10, Burst: 20}))
+	assert.True(t, errors.IsInvalid(validateLimit(ratelimiterprotocolv1.Limit{Burst: 20})))
+}
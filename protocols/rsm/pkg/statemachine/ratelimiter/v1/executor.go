@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	ratelimiterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/ratelimiter/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var rateLimiterCodec = statemachine.NewCodec[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput](
+	func(bytes []byte) (*ratelimiterprotocolv1.RateLimiterInput, error) {
+		input := &ratelimiterprotocolv1.RateLimiterInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *ratelimiterprotocolv1.RateLimiterOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm RateLimiterStateMachine) statemachine.PrimitiveStateMachine[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput] {
+	executor := &RateLimiterExecutor{
+		RateLimiterStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type RateLimiterExecutor struct {
+	RateLimiterStateMachine
+	configure  statemachine.Proposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.ConfigureInput, *ratelimiterprotocolv1.ConfigureOutput]
+	tryAcquire statemachine.Proposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.TryAcquireInput, *ratelimiterprotocolv1.TryAcquireOutput]
+	acquire    statemachine.Proposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]
+	setLimit   statemachine.Proposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.SetLimitInput, *ratelimiterprotocolv1.SetLimitOutput]
+	getBucket  statemachine.Querier[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.GetBucketInput, *ratelimiterprotocolv1.GetBucketOutput]
+}
+
+func (s *RateLimiterExecutor) init() {
+	s.configure = statemachine.NewProposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.ConfigureInput, *ratelimiterprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *ratelimiterprotocolv1.RateLimiterInput) (*ratelimiterprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*ratelimiterprotocolv1.RateLimiterInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *ratelimiterprotocolv1.ConfigureOutput) *ratelimiterprotocolv1.RateLimiterOutput {
+			return &ratelimiterprotocolv1.RateLimiterOutput{
+				Output: &ratelimiterprotocolv1.RateLimiterOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.tryAcquire = statemachine.NewProposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.TryAcquireInput, *ratelimiterprotocolv1.TryAcquireOutput]("TryAcquire").
+		Decoder(func(input *ratelimiterprotocolv1.RateLimiterInput) (*ratelimiterprotocolv1.TryAcquireInput, bool) {
+			if tryAcquire, ok := input.Input.(*ratelimiterprotocolv1.RateLimiterInput_TryAcquire); ok {
+				return tryAcquire.TryAcquire, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *ratelimiterprotocolv1.TryAcquireOutput) *ratelimiterprotocolv1.RateLimiterOutput {
+			return &ratelimiterprotocolv1.RateLimiterOutput{
+				Output: &ratelimiterprotocolv1.RateLimiterOutput_TryAcquire{
+					TryAcquire: output,
+				},
+			}
+		}).
+		Build(s.TryAcquire)
+	s.acquire = statemachine.NewProposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]("Acquire").
+		Decoder(func(input *ratelimiterprotocolv1.RateLimiterInput) (*ratelimiterprotocolv1.AcquireInput, bool) {
+			if acquire, ok := input.Input.(*ratelimiterprotocolv1.RateLimiterInput_Acquire); ok {
+				return acquire.Acquire, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *ratelimiterprotocolv1.AcquireOutput) *ratelimiterprotocolv1.RateLimiterOutput {
+			return &ratelimiterprotocolv1.RateLimiterOutput{
+				Output: &ratelimiterprotocolv1.RateLimiterOutput_Acquire{
+					Acquire: output,
+				},
+			}
+		}).
+		Build(s.Acquire)
+	s.setLimit = statemachine.NewProposer[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.SetLimitInput, *ratelimiterprotocolv1.SetLimitOutput]("SetLimit").
+		Decoder(func(input *ratelimiterprotocolv1.RateLimiterInput) (*ratelimiterprotocolv1.SetLimitInput, bool) {
+			if setLimit, ok := input.Input.(*ratelimiterprotocolv1.RateLimiterInput_SetLimit); ok {
+				return setLimit.SetLimit, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *ratelimiterprotocolv1.SetLimitOutput) *ratelimiterprotocolv1.RateLimiterOutput {
+			return &ratelimiterprotocolv1.RateLimiterOutput{
+				Output: &ratelimiterprotocolv1.RateLimiterOutput_SetLimit{
+					SetLimit: output,
+				},
+			}
+		}).
+		Build(s.SetLimit)
+	s.getBucket = statemachine.NewQuerier[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.GetBucketInput, *ratelimiterprotocolv1.GetBucketOutput]("GetBucket").
+		Decoder(func(input *ratelimiterprotocolv1.RateLimiterInput) (*ratelimiterprotocolv1.GetBucketInput, bool) {
+			if getBucket, ok := input.Input.(*ratelimiterprotocolv1.RateLimiterInput_GetBucket); ok {
+				return getBucket.GetBucket, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *ratelimiterprotocolv1.GetBucketOutput) *ratelimiterprotocolv1.RateLimiterOutput {
+			return &ratelimiterprotocolv1.RateLimiterOutput{
+				Output: &ratelimiterprotocolv1.RateLimiterOutput_GetBucket{
+					GetBucket: output,
+				},
+			}
+		}).
+		Build(s.GetBucket)
+}
+
+func (s *RateLimiterExecutor) Propose(proposal statemachine.Proposal[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *ratelimiterprotocolv1.RateLimiterInput_Configure:
+		s.configure(proposal)
+	case *ratelimiterprotocolv1.RateLimiterInput_TryAcquire:
+		s.tryAcquire(proposal)
+	case *ratelimiterprotocolv1.RateLimiterInput_Acquire:
+		s.acquire(proposal)
+	case *ratelimiterprotocolv1.RateLimiterInput_SetLimit:
+		s.setLimit(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *RateLimiterExecutor) Query(query statemachine.Query[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]) {
+	switch query.Input().Input.(type) {
+	case *ratelimiterprotocolv1.RateLimiterInput_GetBucket:
+		s.getBucket(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
@@ -0,0 +1,470 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	ratelimiterprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/ratelimiter/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+	"time"
+)
+
+const (
+	Name       = "RateLimiter"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+const defaultTokens = 1
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]) statemachine.PrimitiveStateMachine[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput] {
+			return newExecutor(NewRateLimiterStateMachine(context))
+		}, rateLimiterCodec)
+}
+
+type RateLimiterContext interface {
+	statemachine.PrimitiveContext[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]
+	Requests() statemachine.Proposals[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]) RateLimiterContext {
+	return &rateLimiterContext{
+		PrimitiveContext: context,
+		requests: statemachine.NewProposals[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput, *ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput](context).
+			Decoder(func(input *ratelimiterprotocolv1.RateLimiterInput) (*ratelimiterprotocolv1.AcquireInput, bool) {
+				if acquire, ok := input.Input.(*ratelimiterprotocolv1.RateLimiterInput_Acquire); ok {
+					return acquire.Acquire, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *ratelimiterprotocolv1.AcquireOutput) *ratelimiterprotocolv1.RateLimiterOutput {
+				return &ratelimiterprotocolv1.RateLimiterOutput{
+					Output: &ratelimiterprotocolv1.RateLimiterOutput_Acquire{
+						Acquire: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type rateLimiterContext struct {
+	statemachine.PrimitiveContext[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]
+	requests statemachine.Proposals[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]
+}
+
+func (c *rateLimiterContext) Requests() statemachine.Proposals[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput] {
+	return c.requests
+}
+
+type RateLimiterStateMachine interface {
+	statemachine.Context[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*ratelimiterprotocolv1.ConfigureInput, *ratelimiterprotocolv1.ConfigureOutput])
+	TryAcquire(statemachine.Proposal[*ratelimiterprotocolv1.TryAcquireInput, *ratelimiterprotocolv1.TryAcquireOutput])
+	Acquire(statemachine.Proposal[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput])
+	SetLimit(statemachine.Proposal[*ratelimiterprotocolv1.SetLimitInput, *ratelimiterprotocolv1.SetLimitOutput])
+	GetBucket(statemachine.Query[*ratelimiterprotocolv1.GetBucketInput, *ratelimiterprotocolv1.GetBucketOutput])
+}
+
+func NewRateLimiterStateMachine(context statemachine.PrimitiveContext[*ratelimiterprotocolv1.RateLimiterInput, *ratelimiterprotocolv1.RateLimiterOutput]) RateLimiterStateMachine {
+	return &rateLimiterStateMachine{
+		RateLimiterContext: newContext(context),
+		buckets:            make(map[string]*bucket),
+		proposals:          make(map[statemachine.ProposalID]statemachine.CancelFunc),
+		timers:             make(map[statemachine.ProposalID]statemachine.CancelFunc),
+	}
+}
+
+type bucket struct {
+	tokenBucket
+	// limit is the limit of the bucket if it overrides the default limit
+	limit *ratelimiterprotocolv1.Limit
+	queue []statemachine.Proposal[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]
+	timer statemachine.CancelFunc
+}
+
+// rateLimiterStateMachine tracks only buckets that are not full, have their own limit, or have waiters
+// A full bucket is indistinguishable from a new one, so buckets are dropped once they refill.
+type rateLimiterStateMachine struct {
+	RateLimiterContext
+	limit     ratelimiterprotocolv1.Limit
+	buckets   map[string]*bucket
+	proposals map[statemachine.ProposalID]statemachine.CancelFunc
+	timers    map[statemachine.ProposalID]statemachine.CancelFunc
+}
+
+func (s *rateLimiterStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	snapshot := &ratelimiterprotocolv1.RateLimiterSnapshot{
+		Limit: s.limit,
+	}
+	for name, bucket := range s.buckets {
+		waiters := make([]uint64, 0, len(bucket.queue))
+		for _, waiter := range bucket.queue {
+			waiters = append(waiters, uint64(waiter.ID()))
+		}
+		snapshot.Buckets = append(snapshot.Buckets, &ratelimiterprotocolv1.RateLimiterBucket{
+			Name:    name,
+			Limit:   bucket.limit,
+			Tokens:  bucket.tokens,
+			Updated: bucket.updated,
+			Waiters: waiters,
+		})
+	}
+	return writer.WriteMessage(snapshot)
+}
+
+func (s *rateLimiterStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		snapshot := &ratelimiterprotocolv1.RateLimiterSnapshot{}
+		if err := reader.ReadMessage(snapshot); err != nil {
+			return err
+		}
+		s.limit = snapshot.Limit
+		for _, snapshotBucket := range snapshot.Buckets {
+			bucket := &bucket{
+				tokenBucket: tokenBucket{
+					tokens:  snapshotBucket.Tokens,
+					updated: snapshotBucket.Updated,
+				},
+				limit: snapshotBucket.Limit,
+			}
+			s.buckets[snapshotBucket.Name] = bucket
+			for _, proposalID := range snapshotBucket.Waiters {
+				proposal, ok := s.Requests().Get(statemachine.ProposalID(proposalID))
+				if !ok {
+					return errors.NewFault("proposal not found")
+				}
+				bucket.queue = append(bucket.queue, proposal)
+				s.watchRequest(proposal)
+			}
+			s.schedule(snapshotBucket.Name, bucket)
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// getLimit returns the limit of the given bucket
+func (s *rateLimiterStateMachine) getLimit(bucket *bucket) ratelimiterprotocolv1.Limit {
+	if bucket.limit != nil {
+		return *bucket.limit
+	}
+	return s.limit
+}
+
+// getBucket returns the named bucket refilled to the current time, creating a full bucket if it's not tracked
+func (s *rateLimiterStateMachine) getBucket(name string) *bucket {
+	b, ok := s.buckets[name]
+	if !ok {
+		b = &bucket{
+			tokenBucket: tokenBucket{
+				tokens:  getBurst(s.limit),
+				updated: s.Time(),
+			},
+		}
+	}
+	limit := s.getLimit(b)
+	b.refill(limit.Rate, getBurst(limit), s.Time())
+	return b
+}
+
+// putBucket stores the named bucket if it differs from a new bucket
+func (s *rateLimiterStateMachine) putBucket(name string, bucket *bucket) {
+	if bucket.limit == nil && len(bucket.queue) == 0 && bucket.tokens >= getBurst(s.limit) {
+		delete(s.buckets, name)
+	} else {
+		s.buckets[name] = bucket
+	}
+}
+
+// nextRequests grants tokens to queued requests in FIFO order until the head of the queue cannot be satisfied
+func (s *rateLimiterStateMachine) nextRequests(name string) {
+	bucket, ok := s.buckets[name]
+	if !ok {
+		return
+	}
+	if bucket.timer != nil {
+		bucket.timer()
+		bucket.timer = nil
+	}
+
+	bucket = s.getBucket(name)
+	limit := s.getLimit(bucket)
+	for len(bucket.queue) > 0 {
+		proposal := bucket.queue[0]
+		tokens := getTokens(proposal.Input().Tokens)
+		if limit.Rate > 0 {
+			if tokens > getBurst(limit) {
+				// The limit was lowered below the number of requested tokens since the request was enqueued
+				bucket.queue = bucket.queue[1:]
+				s.unwatchRequest(proposal.ID())
+				proposal.Error(errors.NewInvalid("cannot acquire %d tokens from bucket with burst %d", tokens, getBurst(limit)))
+				proposal.Close()
+				continue
+			}
+			if bucket.tokens < tokens {
+				break
+			}
+			bucket.tokens -= tokens
+		}
+		bucket.queue = bucket.queue[1:]
+		s.unwatchRequest(proposal.ID())
+		proposal.Output(&ratelimiterprotocolv1.AcquireOutput{
+			Remaining: bucket.tokens,
+		})
+		proposal.Close()
+	}
+	s.schedule(name, bucket)
+	s.putBucket(name, bucket)
+}
+
+// schedule schedules the queued requests for the given bucket to be granted once enough tokens are available
+// Requests are scheduled at an absolute time computed from the bucket state so the schedule is deterministic.
+func (s *rateLimiterStateMachine) schedule(name string, bucket *bucket) {
+	if len(bucket.queue) == 0 {
+		return
+	}
+	limit := s.getLimit(bucket)
+	tokens := getTokens(bucket.queue[0].Input().Tokens)
+	if limit.Rate == 0 || tokens > getBurst(limit) {
+		bucket.timer = s.Scheduler().Delay(0, func() {
+			s.nextRequests(name)
+		})
+		return
+	}
+	bucket.timer = s.Scheduler().Schedule(bucket.availableAt(limit.Rate, tokens), func() {
+		s.nextRequests(name)
+	})
+}
+
+func (s *rateLimiterStateMachine) dequeueRequest(proposal statemachine.Proposal[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]) {
+	s.unwatchRequest(proposal.ID())
+	name := proposal.Input().Bucket
+	bucket, ok := s.buckets[name]
+	if !ok {
+		return
+	}
+	queue := make([]statemachine.Proposal[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput], 0, len(bucket.queue))
+	for _, waiter := range bucket.queue {
+		if waiter.ID() != proposal.ID() {
+			queue = append(queue, waiter)
+		}
+	}
+	bucket.queue = queue
+
+	// Removing the head of the queue may unblock smaller requests behind it
+	s.nextRequests(name)
+}
+
+func (s *rateLimiterStateMachine) watchRequest(proposal statemachine.Proposal[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]) {
+	s.proposals[proposal.ID()] = proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.dequeueRequest(proposal)
+		}
+	})
+
+	if proposal.Input().Timeout != nil {
+		s.timers[proposal.ID()] = s.Scheduler().Delay(*proposal.Input().Timeout, func() {
+			s.dequeueRequest(proposal)
+			proposal.Error(errors.NewTimeout("tokens not available"))
+			proposal.Close()
+		})
+	}
+}
+
+func (s *rateLimiterStateMachine) unwatchRequest(proposalID statemachine.ProposalID) {
+	if cancel, ok := s.proposals[proposalID]; ok {
+		cancel()
+		delete(s.proposals, proposalID)
+	}
+	if cancel, ok := s.timers[proposalID]; ok {
+		cancel()
+		delete(s.timers, proposalID)
+	}
+}
+
+func (s *rateLimiterStateMachine) Configure(proposal statemachine.Proposal[*ratelimiterprotocolv1.ConfigureInput, *ratelimiterprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	if err := validateLimit(proposal.Input().Limit); err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.limit = proposal.Input().Limit
+	for name := range s.buckets {
+		s.nextRequests(name)
+	}
+	proposal.Output(&ratelimiterprotocolv1.ConfigureOutput{})
+}
+
+func (s *rateLimiterStateMachine) TryAcquire(proposal statemachine.Proposal[*ratelimiterprotocolv1.TryAcquireInput, *ratelimiterprotocolv1.TryAcquireOutput]) {
+	defer proposal.Close()
+	name := proposal.Input().Bucket
+	tokens := getTokens(proposal.Input().Tokens)
+	bucket := s.getBucket(name)
+	limit := s.getLimit(bucket)
+	if limit.Rate == 0 {
+		proposal.Output(&ratelimiterprotocolv1.TryAcquireOutput{
+			Acquired: true,
+			Reserved: proposal.Input().Reserve,
+		})
+		return
+	}
+	if tokens > getBurst(limit) {
+		proposal.Error(errors.NewInvalid("cannot acquire %d tokens from bucket with burst %d", tokens, getBurst(limit)))
+		return
+	}
+
+	// Tokens are only granted if no Acquire requests are waiting to preserve FIFO ordering
+	if len(bucket.queue) > 0 || bucket.tokens < tokens {
+		// Waiting requests must be satisfied before the requested tokens can be acquired
+		for _, waiter := range bucket.queue {
+			tokens += getTokens(waiter.Input().Tokens)
+		}
+		var retryAfter time.Duration
+		if retryAt := bucket.availableAt(limit.Rate, tokens); retryAt.After(s.Time()) {
+			retryAfter = retryAt.Sub(s.Time())
+		}
+		proposal.Output(&ratelimiterprotocolv1.TryAcquireOutput{
+			Remaining:  bucket.tokens,
+			RetryAfter: retryAfter,
+		})
+		s.putBucket(name, bucket)
+		return
+	}
+
+	bucket.tokens -= tokens
+	reserved := proposal.Input().Reserve
+	if reserved > bucket.tokens {
+		reserved = bucket.tokens
+	}
+	bucket.tokens -= reserved
+	s.putBucket(name, bucket)
+	proposal.Output(&ratelimiterprotocolv1.TryAcquireOutput{
+		Acquired:  true,
+		Reserved:  reserved,
+		Remaining: bucket.tokens,
+	})
+}
+
+func (s *rateLimiterStateMachine) Acquire(proposal statemachine.Proposal[*ratelimiterprotocolv1.AcquireInput, *ratelimiterprotocolv1.AcquireOutput]) {
+	name := proposal.Input().Bucket
+	tokens := getTokens(proposal.Input().Tokens)
+	bucket := s.getBucket(name)
+	limit := s.getLimit(bucket)
+	if limit.Rate > 0 && tokens > getBurst(limit) {
+		proposal.Error(errors.NewInvalid("cannot acquire %d tokens from bucket with burst %d", tokens, getBurst(limit)))
+		proposal.Close()
+		return
+	}
+
+	// Grant the tokens immediately only if no other requests are waiting to preserve FIFO ordering
+	if limit.Rate == 0 || (len(bucket.queue) == 0 && bucket.tokens >= tokens) {
+		if limit.Rate > 0 {
+			bucket.tokens -= tokens
+		}
+		s.putBucket(name, bucket)
+		proposal.Output(&ratelimiterprotocolv1.AcquireOutput{
+			Remaining: bucket.tokens,
+		})
+		proposal.Close()
+		return
+	}
+
+	bucket.queue = append(bucket.queue, proposal)
+	s.watchRequest(proposal)
+	if len(bucket.queue) == 1 {
+		s.schedule(name, bucket)
+	}
+	s.putBucket(name, bucket)
+}
+
+func (s *rateLimiterStateMachine) SetLimit(proposal statemachine.Proposal[*ratelimiterprotocolv1.SetLimitInput, *ratelimiterprotocolv1.SetLimitOutput]) {
+	defer proposal.Close()
+	if proposal.Input().Limit != nil {
+		if err := validateLimit(*proposal.Input().Limit); err != nil {
+			proposal.Error(err)
+			return
+		}
+	}
+	name := proposal.Input().Bucket
+	bucket := s.getBucket(name)
+	limited := s.getLimit(bucket).Rate > 0
+	bucket.limit = proposal.Input().Limit
+	// Buckets that were not rate limited hold no tokens, so they start full under the new limit
+	if limit := s.getLimit(bucket); !limited || bucket.tokens > getBurst(limit) {
+		bucket.tokens = getBurst(limit)
+	}
+	s.buckets[name] = bucket
+	s.nextRequests(name)
+	proposal.Output(&ratelimiterprotocolv1.SetLimitOutput{})
+}
+
+func (s *rateLimiterStateMachine) GetBucket(query statemachine.Query[*ratelimiterprotocolv1.GetBucketInput, *ratelimiterprotocolv1.GetBucketOutput]) {
+	defer query.Close()
+	// Queries must not modify the state, so the refill is computed on a copy of the bucket
+	var bucket bucket
+	if b, ok := s.buckets[query.Input().Bucket]; ok {
+		bucket = *b
+	} else {
+		bucket.tokens = getBurst(s.limit)
+		bucket.updated = query.Time()
+	}
+	limit := s.getLimit(&bucket)
+	bucket.refill(limit.Rate, getBurst(limit), query.Time())
+	query.Output(&ratelimiterprotocolv1.GetBucketOutput{
+		Limit: ratelimiterprotocolv1.Limit{
+			Rate:  limit.Rate,
+			Burst: getBurst(limit),
+		},
+		Tokens:  bucket.tokens,
+		Waiters: uint32(len(bucket.queue)),
+	})
+}
+
+// getTokens returns the requested number of tokens, defaulting to a single token
+func getTokens(tokens uint64) uint64 {
+	if tokens == 0 {
+		return defaultTokens
+	}
+	return tokens
+}
+
+// getBurst returns the burst of the given limit, defaulting to the rate
+func getBurst(limit ratelimiterprotocolv1.Limit) uint64 {
+	if limit.Burst == 0 {
+		return limit.Rate
+	}
+	return limit.Burst
+}
+
+// validateLimit returns an Invalid error if the given limit sets a burst without a rate
+// A zero rate disables rate limiting, so a bucket without a rate cannot be refilled to a burst.
+func validateLimit(limit ratelimiterprotocolv1.Limit) error {
+	if limit.Rate == 0 && limit.Burst != 0 {
+		return errors.NewInvalid("limit with burst %d must have a rate", limit.Burst)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	rwlockprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/rwlock/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var rwLockCodec = statemachine.NewCodec[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput](
+	func(bytes []byte) (*rwlockprotocolv1.RWLockInput, error) {
+		input := &rwlockprotocolv1.RWLockInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *rwlockprotocolv1.RWLockOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm RWLockStateMachine) statemachine.PrimitiveStateMachine[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput] {
+	executor := &RWLockExecutor{
+		RWLockStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type RWLockExecutor struct {
+	RWLockStateMachine
+	configure statemachine.Proposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.ConfigureInput, *rwlockprotocolv1.ConfigureOutput]
+	lock      statemachine.Proposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput]
+	unlock    statemachine.Proposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.UnlockInput, *rwlockprotocolv1.UnlockOutput]
+	rLock     statemachine.Proposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput]
+	rUnlock   statemachine.Proposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.RUnlockInput, *rwlockprotocolv1.RUnlockOutput]
+	get       statemachine.Querier[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.GetInput, *rwlockprotocolv1.GetOutput]
+}
+
+func (s *RWLockExecutor) init() {
+	s.configure = statemachine.NewProposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.ConfigureInput, *rwlockprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*rwlockprotocolv1.RWLockInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *rwlockprotocolv1.ConfigureOutput) *rwlockprotocolv1.RWLockOutput {
+			return &rwlockprotocolv1.RWLockOutput{
+				Output: &rwlockprotocolv1.RWLockOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.lock = statemachine.NewProposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput]("Lock").
+		Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.LockInput, bool) {
+			if lock, ok := input.Input.(*rwlockprotocolv1.RWLockInput_Lock); ok {
+				return lock.Lock, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *rwlockprotocolv1.LockOutput) *rwlockprotocolv1.RWLockOutput {
+			return &rwlockprotocolv1.RWLockOutput{
+				Output: &rwlockprotocolv1.RWLockOutput_Lock{
+					Lock: output,
+				},
+			}
+		}).
+		Build(s.Lock)
+	s.unlock = statemachine.NewProposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.UnlockInput, *rwlockprotocolv1.UnlockOutput]("Unlock").
+		Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.UnlockInput, bool) {
+			if unlock, ok := input.Input.(*rwlockprotocolv1.RWLockInput_Unlock); ok {
+				return unlock.Unlock, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *rwlockprotocolv1.UnlockOutput) *rwlockprotocolv1.RWLockOutput {
+			return &rwlockprotocolv1.RWLockOutput{
+				Output: &rwlockprotocolv1.RWLockOutput_Unlock{
+					Unlock: output,
+				},
+			}
+		}).
+		Build(s.Unlock)
+	s.rLock = statemachine.NewProposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput]("RLock").
+		Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.RLockInput, bool) {
+			if rLock, ok := input.Input.(*rwlockprotocolv1.RWLockInput_RLock); ok {
+				return rLock.RLock, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *rwlockprotocolv1.RLockOutput) *rwlockprotocolv1.RWLockOutput {
+			return &rwlockprotocolv1.RWLockOutput{
+				Output: &rwlockprotocolv1.RWLockOutput_RLock{
+					RLock: output,
+				},
+			}
+		}).
+		Build(s.RLock)
+	s.rUnlock = statemachine.NewProposer[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.RUnlockInput, *rwlockprotocolv1.RUnlockOutput]("RUnlock").
+		Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.RUnlockInput, bool) {
+			if rUnlock, ok := input.Input.(*rwlockprotocolv1.RWLockInput_RUnlock); ok {
+				return rUnlock.RUnlock, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *rwlockprotocolv1.RUnlockOutput) *rwlockprotocolv1.RWLockOutput {
+			return &rwlockprotocolv1.RWLockOutput{
+				Output: &rwlockprotocolv1.RWLockOutput_RUnlock{
+					RUnlock: output,
+				},
+			}
+		}).
+		Build(s.RUnlock)
+	s.get = statemachine.NewQuerier[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.GetInput, *rwlockprotocolv1.GetOutput]("Get").
+		Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.GetInput, bool) {
+			if get, ok := input.Input.(*rwlockprotocolv1.RWLockInput_Get); ok {
+				return get.Get, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *rwlockprotocolv1.GetOutput) *rwlockprotocolv1.RWLockOutput {
+			return &rwlockprotocolv1.RWLockOutput{
+				Output: &rwlockprotocolv1.RWLockOutput_Get{
+					Get: output,
+				},
+			}
+		}).
+		Build(s.Get)
+}
+
+func (s *RWLockExecutor) Propose(proposal statemachine.Proposal[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *rwlockprotocolv1.RWLockInput_Configure:
+		s.configure(proposal)
+	case *rwlockprotocolv1.RWLockInput_Lock:
+		s.lock(proposal)
+	case *rwlockprotocolv1.RWLockInput_Unlock:
+		s.unlock(proposal)
+	case *rwlockprotocolv1.RWLockInput_RLock:
+		s.rLock(proposal)
+	case *rwlockprotocolv1.RWLockInput_RUnlock:
+		s.rUnlock(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *RWLockExecutor) Query(query statemachine.Query[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]) {
+	switch query.Input().Input.(type) {
+	case *rwlockprotocolv1.RWLockInput_Get:
+		s.get(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
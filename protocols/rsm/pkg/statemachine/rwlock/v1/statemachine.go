@@ -0,0 +1,527 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	rwlockprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/rwlock/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "RWLock"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]) statemachine.PrimitiveStateMachine[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput] {
+			return newExecutor(NewRWLockStateMachine(context))
+		}, rwLockCodec)
+}
+
+type RWLockContext interface {
+	statemachine.PrimitiveContext[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]
+	Writers() statemachine.Proposals[*rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput]
+	Readers() statemachine.Proposals[*rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]) RWLockContext {
+	return &rwLockContext{
+		PrimitiveContext: context,
+		writers: statemachine.NewProposals[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput](context).
+			Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.LockInput, bool) {
+				if lock, ok := input.Input.(*rwlockprotocolv1.RWLockInput_Lock); ok {
+					return lock.Lock, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *rwlockprotocolv1.LockOutput) *rwlockprotocolv1.RWLockOutput {
+				return &rwlockprotocolv1.RWLockOutput{
+					Output: &rwlockprotocolv1.RWLockOutput_Lock{
+						Lock: output,
+					},
+				}
+			}).
+			Build(),
+		readers: statemachine.NewProposals[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput, *rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput](context).
+			Decoder(func(input *rwlockprotocolv1.RWLockInput) (*rwlockprotocolv1.RLockInput, bool) {
+				if rLock, ok := input.Input.(*rwlockprotocolv1.RWLockInput_RLock); ok {
+					return rLock.RLock, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *rwlockprotocolv1.RLockOutput) *rwlockprotocolv1.RWLockOutput {
+				return &rwlockprotocolv1.RWLockOutput{
+					Output: &rwlockprotocolv1.RWLockOutput_RLock{
+						RLock: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type rwLockContext struct {
+	statemachine.PrimitiveContext[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]
+	writers statemachine.Proposals[*rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput]
+	readers statemachine.Proposals[*rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput]
+}
+
+func (c *rwLockContext) Writers() statemachine.Proposals[*rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput] {
+	return c.writers
+}
+
+func (c *rwLockContext) Readers() statemachine.Proposals[*rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput] {
+	return c.readers
+}
+
+type RWLockStateMachine interface {
+	statemachine.Context[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*rwlockprotocolv1.ConfigureInput, *rwlockprotocolv1.ConfigureOutput])
+	Lock(statemachine.Proposal[*rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput])
+	Unlock(statemachine.Proposal[*rwlockprotocolv1.UnlockInput, *rwlockprotocolv1.UnlockOutput])
+	RLock(statemachine.Proposal[*rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput])
+	RUnlock(statemachine.Proposal[*rwlockprotocolv1.RUnlockInput, *rwlockprotocolv1.RUnlockOutput])
+	Get(statemachine.Query[*rwlockprotocolv1.GetInput, *rwlockprotocolv1.GetOutput])
+}
+
+func NewRWLockStateMachine(context statemachine.PrimitiveContext[*rwlockprotocolv1.RWLockInput, *rwlockprotocolv1.RWLockOutput]) RWLockStateMachine {
+	return &rwLockStateMachine{
+		RWLockContext: newContext(context),
+		readers:       make(map[statemachine.SessionID]*readLock),
+		proposals:     make(map[statemachine.ProposalID]statemachine.CancelFunc),
+		timers:        make(map[statemachine.ProposalID]statemachine.CancelFunc),
+	}
+}
+
+type writeLock struct {
+	proposalID statemachine.ProposalID
+	sessionID  statemachine.SessionID
+	watcher    statemachine.CancelFunc
+}
+
+// readLock tracks the read locks held by a session; a session may hold the read lock more than once
+type readLock struct {
+	count   uint32
+	watcher statemachine.CancelFunc
+}
+
+// waiter is a queued request for either the write lock or a read lock
+type waiter struct {
+	proposalID statemachine.ProposalID
+	write      bool
+}
+
+type rwLockStateMachine struct {
+	RWLockContext
+	policy rwlockprotocolv1.ConfigureInput_Policy
+	// configured indicates whether the policy has been configured
+	configured bool
+	writer     *writeLock
+	readers    map[statemachine.SessionID]*readLock
+	queue      []waiter
+	proposals  map[statemachine.ProposalID]statemachine.CancelFunc
+	timers     map[statemachine.ProposalID]statemachine.CancelFunc
+}
+
+func (s *rwLockStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarInt32(int32(s.policy)); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(s.configured); err != nil {
+		return err
+	}
+	if s.writer != nil {
+		if err := writer.WriteBool(true); err != nil {
+			return err
+		}
+		if err := writer.WriteVarUint64(uint64(s.writer.proposalID)); err != nil {
+			return err
+		}
+		if err := writer.WriteVarUint64(uint64(s.writer.sessionID)); err != nil {
+			return err
+		}
+	} else {
+		if err := writer.WriteBool(false); err != nil {
+			return err
+		}
+	}
+	if err := writer.WriteVarInt(len(s.readers)); err != nil {
+		return err
+	}
+	for sessionID, reader := range s.readers {
+		if err := writer.WriteVarUint64(uint64(sessionID)); err != nil {
+			return err
+		}
+		if err := writer.WriteVarUint32(reader.count); err != nil {
+			return err
+		}
+	}
+	if err := writer.WriteVarInt(len(s.queue)); err != nil {
+		return err
+	}
+	for _, waiter := range s.queue {
+		if err := writer.WriteBool(waiter.write); err != nil {
+			return err
+		}
+		if err := writer.WriteVarUint64(uint64(waiter.proposalID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *rwLockStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		policy, err := reader.ReadVarInt32()
+		if err != nil {
+			return err
+		}
+		s.policy = rwlockprotocolv1.ConfigureInput_Policy(policy)
+		s.configured, err = reader.ReadBool()
+		if err != nil {
+			return err
+		}
+
+		locked, err := reader.ReadBool()
+		if err != nil {
+			return err
+		}
+		if locked {
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			sessionID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			session, ok := s.Sessions().Get(statemachine.SessionID(sessionID))
+			if !ok {
+				return errors.NewFault("session not found")
+			}
+			s.lock(statemachine.ProposalID(proposalID), session)
+		}
+
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			sessionID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			count, err := reader.ReadVarUint32()
+			if err != nil {
+				return err
+			}
+			session, ok := s.Sessions().Get(statemachine.SessionID(sessionID))
+			if !ok {
+				return errors.NewFault("session not found")
+			}
+			s.rlock(session)
+			s.readers[session.ID()].count = count
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			write, err := reader.ReadBool()
+			if err != nil {
+				return err
+			}
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			if write {
+				proposal, ok := s.Writers().Get(statemachine.ProposalID(proposalID))
+				if !ok {
+					return errors.NewFault("proposal not found")
+				}
+				s.enqueueWriter(proposal)
+			} else {
+				proposal, ok := s.Readers().Get(statemachine.ProposalID(proposalID))
+				if !ok {
+					return errors.NewFault("proposal not found")
+				}
+				s.enqueueReader(proposal)
+			}
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// lock assigns the write lock to the given session, releasing it if the session expires
+func (s *rwLockStateMachine) lock(proposalID statemachine.ProposalID, session statemachine.Session) {
+	s.writer = &writeLock{
+		proposalID: proposalID,
+		sessionID:  session.ID(),
+		watcher: session.Watch(func(state statemachine.State) {
+			if state == statemachine.Closed {
+				s.unlock()
+			}
+		}),
+	}
+}
+
+func (s *rwLockStateMachine) unlock() {
+	s.writer.watcher()
+	s.writer = nil
+	s.next()
+}
+
+// rlock assigns a read lock to the given session, releasing all the session's read locks if it expires
+func (s *rwLockStateMachine) rlock(session statemachine.Session) {
+	if reader, ok := s.readers[session.ID()]; ok {
+		reader.count++
+		return
+	}
+	sessionID := session.ID()
+	s.readers[sessionID] = &readLock{
+		count: 1,
+		watcher: session.Watch(func(state statemachine.State) {
+			if state == statemachine.Closed {
+				if reader, ok := s.readers[sessionID]; ok {
+					reader.watcher()
+					delete(s.readers, sessionID)
+					s.next()
+				}
+			}
+		}),
+	}
+}
+
+func (s *rwLockStateMachine) runlock(sessionID statemachine.SessionID) {
+	reader := s.readers[sessionID]
+	reader.count--
+	if reader.count == 0 {
+		reader.watcher()
+		delete(s.readers, sessionID)
+		s.next()
+	}
+}
+
+// writerWaiting returns whether a request for the write lock is queued
+func (s *rwLockStateMachine) writerWaiting() bool {
+	for _, waiter := range s.queue {
+		if waiter.write {
+			return true
+		}
+	}
+	return false
+}
+
+// next grants the lock to queued requests according to the configured policy
+func (s *rwLockStateMachine) next() {
+	for s.writer == nil && len(s.queue) > 0 {
+		i := 0
+		if s.policy == rwlockprotocolv1.ConfigureInput_WRITER_PREFERRING {
+			for j, waiter := range s.queue {
+				if waiter.write {
+					i = j
+					break
+				}
+			}
+		}
+
+		waiter := s.queue[i]
+		if waiter.write {
+			if len(s.readers) > 0 {
+				return
+			}
+			proposal, ok := s.Writers().Get(waiter.proposalID)
+			s.dequeue(waiter.proposalID)
+			if ok {
+				s.lock(proposal.ID(), proposal.Session())
+				proposal.Output(&rwlockprotocolv1.LockOutput{
+					Index: protocol.Index(proposal.ID()),
+				})
+				proposal.Close()
+			}
+		} else {
+			proposal, ok := s.Readers().Get(waiter.proposalID)
+			s.dequeue(waiter.proposalID)
+			if ok {
+				s.rlock(proposal.Session())
+				proposal.Output(&rwlockprotocolv1.RLockOutput{
+					Index: protocol.Index(proposal.ID()),
+				})
+				proposal.Close()
+			}
+		}
+	}
+}
+
+func (s *rwLockStateMachine) enqueueWriter(proposal statemachine.Proposal[*rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput]) {
+	s.queue = append(s.queue, waiter{
+		proposalID: proposal.ID(),
+		write:      true,
+	})
+	s.proposals[proposal.ID()] = proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.dequeue(proposal.ID())
+			s.next()
+		}
+	})
+	if proposal.Input().Timeout != nil {
+		s.timers[proposal.ID()] = s.Scheduler().Delay(*proposal.Input().Timeout, func() {
+			s.dequeue(proposal.ID())
+			proposal.Error(errors.NewTimeout("write lock not available"))
+			proposal.Close()
+			s.next()
+		})
+	}
+}
+
+func (s *rwLockStateMachine) enqueueReader(proposal statemachine.Proposal[*rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput]) {
+	s.queue = append(s.queue, waiter{
+		proposalID: proposal.ID(),
+	})
+	s.proposals[proposal.ID()] = proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.dequeue(proposal.ID())
+		}
+	})
+	if proposal.Input().Timeout != nil {
+		s.timers[proposal.ID()] = s.Scheduler().Delay(*proposal.Input().Timeout, func() {
+			s.dequeue(proposal.ID())
+			proposal.Error(errors.NewTimeout("read lock not available"))
+			proposal.Close()
+		})
+	}
+}
+
+func (s *rwLockStateMachine) dequeue(proposalID statemachine.ProposalID) {
+	if cancel, ok := s.proposals[proposalID]; ok {
+		cancel()
+		delete(s.proposals, proposalID)
+	}
+	if cancel, ok := s.timers[proposalID]; ok {
+		cancel()
+		delete(s.timers, proposalID)
+	}
+	queue := make([]waiter, 0, len(s.queue))
+	for _, waiter := range s.queue {
+		if waiter.proposalID != proposalID {
+			queue = append(queue, waiter)
+		}
+	}
+	s.queue = queue
+}
+
+func (s *rwLockStateMachine) Configure(proposal statemachine.Proposal[*rwlockprotocolv1.ConfigureInput, *rwlockprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	if proposal.Input().Init && s.configured {
+		proposal.Output(&rwlockprotocolv1.ConfigureOutput{})
+		return
+	}
+	s.policy = proposal.Input().Policy
+	s.configured = true
+	s.next()
+	proposal.Output(&rwlockprotocolv1.ConfigureOutput{})
+}
+
+func (s *rwLockStateMachine) Lock(proposal statemachine.Proposal[*rwlockprotocolv1.LockInput, *rwlockprotocolv1.LockOutput]) {
+	// The write lock would never be granted to a session waiting on its own read locks
+	if _, ok := s.readers[proposal.Session().ID()]; ok {
+		proposal.Error(errors.NewConflict("read lock held by client cannot be upgraded"))
+		proposal.Close()
+		return
+	}
+	if s.writer == nil && len(s.readers) == 0 && len(s.queue) == 0 {
+		s.lock(proposal.ID(), proposal.Session())
+		proposal.Output(&rwlockprotocolv1.LockOutput{
+			Index: protocol.Index(proposal.ID()),
+		})
+		proposal.Close()
+	} else {
+		s.enqueueWriter(proposal)
+	}
+}
+
+func (s *rwLockStateMachine) Unlock(proposal statemachine.Proposal[*rwlockprotocolv1.UnlockInput, *rwlockprotocolv1.UnlockOutput]) {
+	defer proposal.Close()
+	if s.writer == nil || s.writer.sessionID != proposal.Session().ID() {
+		proposal.Error(errors.NewConflict("write lock not held by client"))
+	} else {
+		s.unlock()
+		proposal.Output(&rwlockprotocolv1.UnlockOutput{})
+	}
+}
+
+func (s *rwLockStateMachine) RLock(proposal statemachine.Proposal[*rwlockprotocolv1.RLockInput, *rwlockprotocolv1.RLockOutput]) {
+	// A session that already holds a read lock may always reacquire it while no writer holds the lock,
+	// otherwise a waiting writer would deadlock with the reader it's waiting on.
+	_, reentrant := s.readers[proposal.Session().ID()]
+	var available bool
+	if s.writer == nil {
+		switch {
+		case reentrant:
+			available = true
+		case s.policy == rwlockprotocolv1.ConfigureInput_FAIR:
+			available = len(s.queue) == 0
+		default:
+			available = !s.writerWaiting()
+		}
+	}
+
+	if available {
+		s.rlock(proposal.Session())
+		proposal.Output(&rwlockprotocolv1.RLockOutput{
+			Index: protocol.Index(proposal.ID()),
+		})
+		proposal.Close()
+	} else {
+		s.enqueueReader(proposal)
+	}
+}
+
+func (s *rwLockStateMachine) RUnlock(proposal statemachine.Proposal[*rwlockprotocolv1.RUnlockInput, *rwlockprotocolv1.RUnlockOutput]) {
+	defer proposal.Close()
+	if _, ok := s.readers[proposal.Session().ID()]; !ok {
+		proposal.Error(errors.NewConflict("read lock not held by client"))
+	} else {
+		s.runlock(proposal.Session().ID())
+		proposal.Output(&rwlockprotocolv1.RUnlockOutput{})
+	}
+}
+
+func (s *rwLockStateMachine) Get(query statemachine.Query[*rwlockprotocolv1.GetInput, *rwlockprotocolv1.GetOutput]) {
+	defer query.Close()
+	output := &rwlockprotocolv1.GetOutput{}
+	if s.writer != nil {
+		output.Index = protocol.Index(s.writer.proposalID)
+	}
+	for _, reader := range s.readers {
+		output.Readers += reader.count
+	}
+	query.Output(output)
+}
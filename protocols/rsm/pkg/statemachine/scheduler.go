@@ -52,20 +52,17 @@ func (s *stateMachineScheduler) Schedule(t time.Time, f func()) CancelFunc {
 // tick runs the scheduled time-based tasks
 func (s *stateMachineScheduler) tick(time time.Time) {
 	if time.After(s.Time()) {
-		element := s.scheduledTasks.Front()
-		if element != nil {
-			for element != nil {
-				task := element.Value.(*timeTask)
-				if task.isRunnable(time) {
-					next := element.Next()
-					s.scheduledTasks.Remove(element)
-					s.time.Store(task.time)
-					task.run()
-					element = next
-				} else {
-					break
-				}
+		// Tasks may schedule or cancel other tasks when run, so the next task is always taken from the front of the list
+		for element := s.scheduledTasks.Front(); element != nil; element = s.scheduledTasks.Front() {
+			task := element.Value.(*timeTask)
+			if !task.isRunnable(time) {
+				break
 			}
+			s.scheduledTasks.Remove(element)
+			if task.time.After(s.Time()) {
+				s.time.Store(task.time)
+			}
+			task.run()
 		}
 		s.time.Store(time)
 	}
@@ -83,10 +80,10 @@ func (t *timeTask) schedule() {
 	if t.scheduler.scheduledTasks.Len() == 0 {
 		t.elem = t.scheduler.scheduledTasks.PushBack(t)
 	} else {
+		// Tasks are ordered by time, with tasks scheduled for the same time run in the order in which they were scheduled
 		element := t.scheduler.scheduledTasks.Back()
 		for element != nil {
-			time := element.Value.(*timeTask).time
-			if element.Value.(*timeTask).time.UnixNano() < time.UnixNano() {
+			if element.Value.(*timeTask).time.UnixNano() <= t.time.UnixNano() {
 				t.elem = t.scheduler.scheduledTasks.InsertAfter(t, element)
 				return
 			}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	schedulerprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/scheduler/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var schedulerCodec = statemachine.NewCodec[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput](
+	func(bytes []byte) (*schedulerprotocolv1.SchedulerInput, error) {
+		input := &schedulerprotocolv1.SchedulerInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *schedulerprotocolv1.SchedulerOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm SchedulerStateMachine) statemachine.PrimitiveStateMachine[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput] {
+	executor := &SchedulerExecutor{
+		SchedulerStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type SchedulerExecutor struct {
+	SchedulerStateMachine
+	configure statemachine.Proposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.ConfigureInput, *schedulerprotocolv1.ConfigureOutput]
+	schedule  statemachine.Proposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.ScheduleInput, *schedulerprotocolv1.ScheduleOutput]
+	cancel    statemachine.Proposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.CancelInput, *schedulerprotocolv1.CancelOutput]
+	subscribe statemachine.Proposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput]
+	ack       statemachine.Proposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.AckInput, *schedulerprotocolv1.AckOutput]
+	list      statemachine.Querier[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.ListInput, *schedulerprotocolv1.ListOutput]
+}
+
+func (s *SchedulerExecutor) init() {
+	s.configure = statemachine.NewProposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.ConfigureInput, *schedulerprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *schedulerprotocolv1.SchedulerInput) (*schedulerprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*schedulerprotocolv1.SchedulerInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *schedulerprotocolv1.ConfigureOutput) *schedulerprotocolv1.SchedulerOutput {
+			return &schedulerprotocolv1.SchedulerOutput{
+				Output: &schedulerprotocolv1.SchedulerOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.schedule = statemachine.NewProposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.ScheduleInput, *schedulerprotocolv1.ScheduleOutput]("Schedule").
+		Decoder(func(input *schedulerprotocolv1.SchedulerInput) (*schedulerprotocolv1.ScheduleInput, bool) {
+			if schedule, ok := input.Input.(*schedulerprotocolv1.SchedulerInput_Schedule); ok {
+				return schedule.Schedule, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *schedulerprotocolv1.ScheduleOutput) *schedulerprotocolv1.SchedulerOutput {
+			return &schedulerprotocolv1.SchedulerOutput{
+				Output: &schedulerprotocolv1.SchedulerOutput_Schedule{
+					Schedule: output,
+				},
+			}
+		}).
+		Build(s.Schedule)
+	s.cancel = statemachine.NewProposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.CancelInput, *schedulerprotocolv1.CancelOutput]("Cancel").
+		Decoder(func(input *schedulerprotocolv1.SchedulerInput) (*schedulerprotocolv1.CancelInput, bool) {
+			if cancel, ok := input.Input.(*schedulerprotocolv1.SchedulerInput_Cancel); ok {
+				return cancel.Cancel, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *schedulerprotocolv1.CancelOutput) *schedulerprotocolv1.SchedulerOutput {
+			return &schedulerprotocolv1.SchedulerOutput{
+				Output: &schedulerprotocolv1.SchedulerOutput_Cancel{
+					Cancel: output,
+				},
+			}
+		}).
+		Build(s.Cancel)
+	s.subscribe = statemachine.NewProposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput]("Subscribe").
+		Decoder(func(input *schedulerprotocolv1.SchedulerInput) (*schedulerprotocolv1.SubscribeInput, bool) {
+			if subscribe, ok := input.Input.(*schedulerprotocolv1.SchedulerInput_Subscribe); ok {
+				return subscribe.Subscribe, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *schedulerprotocolv1.SubscribeOutput) *schedulerprotocolv1.SchedulerOutput {
+			return &schedulerprotocolv1.SchedulerOutput{
+				Output: &schedulerprotocolv1.SchedulerOutput_Subscribe{
+					Subscribe: output,
+				},
+			}
+		}).
+		Build(s.Subscribe)
+	s.ack = statemachine.NewProposer[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.AckInput, *schedulerprotocolv1.AckOutput]("Ack").
+		Decoder(func(input *schedulerprotocolv1.SchedulerInput) (*schedulerprotocolv1.AckInput, bool) {
+			if ack, ok := input.Input.(*schedulerprotocolv1.SchedulerInput_Ack); ok {
+				return ack.Ack, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *schedulerprotocolv1.AckOutput) *schedulerprotocolv1.SchedulerOutput {
+			return &schedulerprotocolv1.SchedulerOutput{
+				Output: &schedulerprotocolv1.SchedulerOutput_Ack{
+					Ack: output,
+				},
+			}
+		}).
+		Build(s.Ack)
+	s.list = statemachine.NewQuerier[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.ListInput, *schedulerprotocolv1.ListOutput]("List").
+		Decoder(func(input *schedulerprotocolv1.SchedulerInput) (*schedulerprotocolv1.ListInput, bool) {
+			if list, ok := input.Input.(*schedulerprotocolv1.SchedulerInput_List); ok {
+				return list.List, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *schedulerprotocolv1.ListOutput) *schedulerprotocolv1.SchedulerOutput {
+			return &schedulerprotocolv1.SchedulerOutput{
+				Output: &schedulerprotocolv1.SchedulerOutput_List{
+					List: output,
+				},
+			}
+		}).
+		Build(s.List)
+}
+
+func (s *SchedulerExecutor) Propose(proposal statemachine.Proposal[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *schedulerprotocolv1.SchedulerInput_Configure:
+		s.configure(proposal)
+	case *schedulerprotocolv1.SchedulerInput_Schedule:
+		s.schedule(proposal)
+	case *schedulerprotocolv1.SchedulerInput_Cancel:
+		s.cancel(proposal)
+	case *schedulerprotocolv1.SchedulerInput_Subscribe:
+		s.subscribe(proposal)
+	case *schedulerprotocolv1.SchedulerInput_Ack:
+		s.ack(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *SchedulerExecutor) Query(query statemachine.Query[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]) {
+	switch query.Input().Input.(type) {
+	case *schedulerprotocolv1.SchedulerInput_List:
+		s.list(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
@@ -0,0 +1,407 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	schedulerprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/scheduler/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+	"sort"
+	"time"
+)
+
+const (
+	Name       = "Scheduler"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+const defaultAckTimeout = 30 * time.Second
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]) statemachine.PrimitiveStateMachine[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput] {
+			return newExecutor(NewSchedulerStateMachine(context))
+		}, schedulerCodec)
+}
+
+type SchedulerContext interface {
+	statemachine.PrimitiveContext[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]
+	Workers() statemachine.Proposals[*schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]) SchedulerContext {
+	return &schedulerContext{
+		PrimitiveContext: context,
+		workers: statemachine.NewProposals[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput, *schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput](context).
+			Decoder(func(input *schedulerprotocolv1.SchedulerInput) (*schedulerprotocolv1.SubscribeInput, bool) {
+				if subscribe, ok := input.Input.(*schedulerprotocolv1.SchedulerInput_Subscribe); ok {
+					return subscribe.Subscribe, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *schedulerprotocolv1.SubscribeOutput) *schedulerprotocolv1.SchedulerOutput {
+				return &schedulerprotocolv1.SchedulerOutput{
+					Output: &schedulerprotocolv1.SchedulerOutput_Subscribe{
+						Subscribe: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type schedulerContext struct {
+	statemachine.PrimitiveContext[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]
+	workers statemachine.Proposals[*schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput]
+}
+
+func (c *schedulerContext) Workers() statemachine.Proposals[*schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput] {
+	return c.workers
+}
+
+type SchedulerStateMachine interface {
+	statemachine.Context[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*schedulerprotocolv1.ConfigureInput, *schedulerprotocolv1.ConfigureOutput])
+	Schedule(statemachine.Proposal[*schedulerprotocolv1.ScheduleInput, *schedulerprotocolv1.ScheduleOutput])
+	Cancel(statemachine.Proposal[*schedulerprotocolv1.CancelInput, *schedulerprotocolv1.CancelOutput])
+	List(statemachine.Query[*schedulerprotocolv1.ListInput, *schedulerprotocolv1.ListOutput])
+	Subscribe(statemachine.Proposal[*schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput])
+	Ack(statemachine.Proposal[*schedulerprotocolv1.AckInput, *schedulerprotocolv1.AckOutput])
+}
+
+func NewSchedulerStateMachine(context statemachine.PrimitiveContext[*schedulerprotocolv1.SchedulerInput, *schedulerprotocolv1.SchedulerOutput]) SchedulerStateMachine {
+	return &schedulerStateMachine{
+		SchedulerContext: newContext(context),
+		ackTimeout:       defaultAckTimeout,
+		timers:           make(map[string]*timer),
+		delivered:        make(map[uint64]*delivery),
+	}
+}
+
+type timer struct {
+	*schedulerprotocolv1.Timer
+	cancel statemachine.CancelFunc
+}
+
+// delivery is a firing delivered to a worker and awaiting acknowledgement
+type delivery struct {
+	*schedulerprotocolv1.Firing
+	cancel statemachine.CancelFunc
+}
+
+type schedulerStateMachine struct {
+	SchedulerContext
+	ackTimeout   time.Duration
+	lastFiringID uint64
+	timers       map[string]*timer
+	pending      []*schedulerprotocolv1.Firing
+	delivered    map[uint64]*delivery
+	workers      []statemachine.Proposal[*schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput]
+	nextWorker   int
+}
+
+func (s *schedulerStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	snapshot := &schedulerprotocolv1.SchedulerSnapshot{
+		AckTimeout:   s.ackTimeout,
+		LastFiringID: s.lastFiringID,
+		NextWorker:   uint32(s.nextWorker),
+	}
+	for _, timer := range s.timers {
+		snapshot.Timers = append(snapshot.Timers, timer.Timer)
+	}
+	snapshot.Firings = append(snapshot.Firings, s.pending...)
+	for _, delivery := range s.delivered {
+		snapshot.Firings = append(snapshot.Firings, delivery.Firing)
+	}
+	for _, worker := range s.workers {
+		snapshot.Workers = append(snapshot.Workers, uint64(worker.ID()))
+	}
+	return writer.WriteMessage(snapshot)
+}
+
+func (s *schedulerStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		snapshot := &schedulerprotocolv1.SchedulerSnapshot{}
+		if err := reader.ReadMessage(snapshot); err != nil {
+			return err
+		}
+		s.ackTimeout = snapshot.AckTimeout
+		s.lastFiringID = snapshot.LastFiringID
+		for _, t := range snapshot.Timers {
+			s.schedule(t)
+		}
+		for _, firing := range snapshot.Firings {
+			if firing.Worker == 0 {
+				s.pending = append(s.pending, firing)
+			} else {
+				s.await(firing)
+			}
+		}
+		for _, proposalID := range snapshot.Workers {
+			proposal, ok := s.Workers().Get(statemachine.ProposalID(proposalID))
+			if !ok {
+				return errors.NewFault("cannot find proposal %d", proposalID)
+			}
+			s.workers = append(s.workers, proposal)
+			s.watchWorker(proposal)
+		}
+		s.nextWorker = int(snapshot.NextWorker)
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// schedule schedules the next firing of the given timer
+func (s *schedulerStateMachine) schedule(t *schedulerprotocolv1.Timer) {
+	timer := &timer{
+		Timer: t,
+	}
+	timer.cancel = s.Scheduler().Schedule(t.Next, func() {
+		s.fire(timer)
+	})
+	s.timers[t.Name] = timer
+}
+
+// fire queues a firing of the given timer for delivery and schedules the timer's next firing
+func (s *schedulerStateMachine) fire(timer *timer) {
+	// A timer that fires while its previous firing is still waiting for a worker is coalesced into that firing
+	if !s.isPending(timer.Name) {
+		s.lastFiringID++
+		s.pending = append(s.pending, &schedulerprotocolv1.Firing{
+			ID:      s.lastFiringID,
+			Timer:   timer.Name,
+			Payload: timer.Payload,
+			Time:    timer.Next,
+		})
+	}
+
+	if timer.Interval > 0 {
+		// Skip any intervals that elapsed before the timer could fire
+		next := timer.Next.Add(timer.Interval)
+		if now := s.Scheduler().Time(); !next.After(now) {
+			next = next.Add((now.Sub(next)/timer.Interval + 1) * timer.Interval)
+		}
+		timer.Next = next
+		s.schedule(timer.Timer)
+	} else {
+		delete(s.timers, timer.Name)
+	}
+	s.dispatch()
+}
+
+func (s *schedulerStateMachine) isPending(name string) bool {
+	for _, firing := range s.pending {
+		if firing.Timer == name {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatch delivers pending firings to workers in round-robin order
+func (s *schedulerStateMachine) dispatch() {
+	for len(s.pending) > 0 && len(s.workers) > 0 {
+		firing := s.pending[0]
+		s.pending = s.pending[1:]
+		if s.nextWorker >= len(s.workers) {
+			s.nextWorker = 0
+		}
+		worker := s.workers[s.nextWorker]
+		s.nextWorker = (s.nextWorker + 1) % len(s.workers)
+		firing.Attempt++
+		firing.Worker = uint64(worker.ID())
+		firing.SessionID = protocol.SessionID(worker.Session().ID())
+		firing.Deadline = s.Scheduler().Time().Add(s.ackTimeout)
+		s.await(firing)
+		worker.Output(&schedulerprotocolv1.SubscribeOutput{
+			Firing: *firing,
+		})
+	}
+}
+
+// await waits for a delivered firing to be acknowledged, redelivering it once the deadline passes
+func (s *schedulerStateMachine) await(firing *schedulerprotocolv1.Firing) {
+	s.delivered[firing.ID] = &delivery{
+		Firing: firing,
+		cancel: s.Scheduler().Schedule(firing.Deadline, func() {
+			delete(s.delivered, firing.ID)
+			s.redeliver(firing)
+			s.dispatch()
+		}),
+	}
+}
+
+// redeliver returns a delivered firing to the pending firings in order of its ID
+func (s *schedulerStateMachine) redeliver(firing *schedulerprotocolv1.Firing) {
+	firing.Worker = 0
+	firing.SessionID = 0
+	i := sort.Search(len(s.pending), func(i int) bool {
+		return s.pending[i].ID > firing.ID
+	})
+	s.pending = append(s.pending, nil)
+	copy(s.pending[i+1:], s.pending[i:])
+	s.pending[i] = firing
+}
+
+func (s *schedulerStateMachine) watchWorker(proposal statemachine.Proposal[*schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput]) {
+	proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.removeWorker(proposal.ID())
+		}
+	})
+}
+
+// removeWorker removes a worker, redelivering the firings it has not acknowledged to the remaining workers
+func (s *schedulerStateMachine) removeWorker(proposalID statemachine.ProposalID) {
+	for i, worker := range s.workers {
+		if worker.ID() == proposalID {
+			s.workers = append(s.workers[:i], s.workers[i+1:]...)
+			if i < s.nextWorker {
+				s.nextWorker--
+			}
+			break
+		}
+	}
+
+	var firings []*schedulerprotocolv1.Firing
+	for _, delivery := range s.delivered {
+		if delivery.Worker == uint64(proposalID) {
+			firings = append(firings, delivery.Firing)
+		}
+	}
+	sort.Slice(firings, func(i, j int) bool {
+		return firings[i].ID < firings[j].ID
+	})
+	for _, firing := range firings {
+		s.delivered[firing.ID].cancel()
+		delete(s.delivered, firing.ID)
+		s.redeliver(firing)
+	}
+	s.dispatch()
+}
+
+func (s *schedulerStateMachine) Configure(proposal statemachine.Proposal[*schedulerprotocolv1.ConfigureInput, *schedulerprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	s.ackTimeout = proposal.Input().AckTimeout
+	if s.ackTimeout <= 0 {
+		s.ackTimeout = defaultAckTimeout
+	}
+	proposal.Output(&schedulerprotocolv1.ConfigureOutput{})
+}
+
+func (s *schedulerStateMachine) Schedule(proposal statemachine.Proposal[*schedulerprotocolv1.ScheduleInput, *schedulerprotocolv1.ScheduleOutput]) {
+	defer proposal.Close()
+	input := proposal.Input()
+	if input.Name == "" {
+		proposal.Error(errors.NewInvalid("timer name cannot be empty"))
+		return
+	}
+	if _, ok := s.timers[input.Name]; ok {
+		proposal.Error(errors.NewAlreadyExists("timer %s already exists", input.Name))
+		return
+	}
+	if input.Interval < 0 {
+		proposal.Error(errors.NewInvalid("timer interval cannot be negative"))
+		return
+	}
+	delay := input.Interval
+	if input.Delay != nil {
+		delay = *input.Delay
+	}
+	if delay < 0 {
+		proposal.Error(errors.NewInvalid("timer delay cannot be negative"))
+		return
+	}
+
+	t := &schedulerprotocolv1.Timer{
+		Name:     input.Name,
+		Payload:  input.Payload,
+		Next:     s.Scheduler().Time().Add(delay),
+		Interval: input.Interval,
+	}
+	s.schedule(t)
+	proposal.Output(&schedulerprotocolv1.ScheduleOutput{
+		Timer: *t,
+	})
+}
+
+func (s *schedulerStateMachine) Cancel(proposal statemachine.Proposal[*schedulerprotocolv1.CancelInput, *schedulerprotocolv1.CancelOutput]) {
+	defer proposal.Close()
+	name := proposal.Input().Name
+	timer, ok := s.timers[name]
+	if !ok {
+		proposal.Error(errors.NewNotFound("timer %s not found", name))
+		return
+	}
+	timer.cancel()
+	delete(s.timers, name)
+
+	// Firings that have not yet been delivered are discarded with the timer
+	pending := make([]*schedulerprotocolv1.Firing, 0, len(s.pending))
+	for _, firing := range s.pending {
+		if firing.Timer != name {
+			pending = append(pending, firing)
+		}
+	}
+	s.pending = pending
+	proposal.Output(&schedulerprotocolv1.CancelOutput{})
+}
+
+func (s *schedulerStateMachine) List(query statemachine.Query[*schedulerprotocolv1.ListInput, *schedulerprotocolv1.ListOutput]) {
+	defer query.Close()
+	timers := make([]schedulerprotocolv1.Timer, 0, len(s.timers))
+	for _, timer := range s.timers {
+		timers = append(timers, *timer.Timer)
+	}
+	sort.Slice(timers, func(i, j int) bool {
+		return timers[i].Name < timers[j].Name
+	})
+	query.Output(&schedulerprotocolv1.ListOutput{
+		Timers: timers,
+	})
+}
+
+func (s *schedulerStateMachine) Subscribe(proposal statemachine.Proposal[*schedulerprotocolv1.SubscribeInput, *schedulerprotocolv1.SubscribeOutput]) {
+	s.workers = append(s.workers, proposal)
+	s.watchWorker(proposal)
+	s.dispatch()
+}
+
+func (s *schedulerStateMachine) Ack(proposal statemachine.Proposal[*schedulerprotocolv1.AckInput, *schedulerprotocolv1.AckOutput]) {
+	defer proposal.Close()
+	firingID := proposal.Input().FiringID
+	delivery, ok := s.delivered[firingID]
+	if !ok {
+		proposal.Error(errors.NewNotFound("firing %d is not awaiting acknowledgement", firingID))
+		return
+	}
+	if delivery.SessionID != protocol.SessionID(proposal.Session().ID()) {
+		proposal.Error(errors.NewConflict("firing %d was delivered to another worker", firingID))
+		return
+	}
+	delivery.cancel()
+	delete(s.delivered, firingID)
+	proposal.Output(&schedulerprotocolv1.AckOutput{})
+}
@@ -45,3 +45,29 @@ func TestScheduler(t *testing.T) {
 	scheduler.tick(time.UnixMilli(5))
 	assert.False(t, ran)
 }
+
+func TestSchedulerOrder(t *testing.T) {
+	scheduler := newScheduler()
+
+	var ran []int
+	scheduler.Schedule(time.UnixMilli(3), func() {
+		ran = append(ran, 3)
+	})
+	scheduler.Schedule(time.UnixMilli(1), func() {
+		ran = append(ran, 1)
+		assert.Equal(t, time.UnixMilli(1), scheduler.Time())
+		scheduler.Delay(0, func() {
+			ran = append(ran, 4)
+		})
+	})
+	scheduler.Schedule(time.UnixMilli(2), func() {
+		ran = append(ran, 2)
+	})
+	cancel := scheduler.Schedule(time.UnixMilli(2), func() {
+		ran = append(ran, 5)
+	})
+	cancel()
+	scheduler.tick(time.UnixMilli(3))
+	assert.Equal(t, []int{1, 4, 2, 3}, ran)
+	assert.Equal(t, time.UnixMilli(3), scheduler.Time())
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	semaphoreprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/semaphore/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var semaphoreCodec = statemachine.NewCodec[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput](
+	func(bytes []byte) (*semaphoreprotocolv1.SemaphoreInput, error) {
+		input := &semaphoreprotocolv1.SemaphoreInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *semaphoreprotocolv1.SemaphoreOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm SemaphoreStateMachine) statemachine.PrimitiveStateMachine[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput] {
+	executor := &SemaphoreExecutor{
+		SemaphoreStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type SemaphoreExecutor struct {
+	SemaphoreStateMachine
+	configure statemachine.Proposer[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.ConfigureInput, *semaphoreprotocolv1.ConfigureOutput]
+	acquire   statemachine.Proposer[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]
+	release   statemachine.Proposer[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.ReleaseInput, *semaphoreprotocolv1.ReleaseOutput]
+	get       statemachine.Querier[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.GetInput, *semaphoreprotocolv1.GetOutput]
+}
+
+func (s *SemaphoreExecutor) init() {
+	s.configure = statemachine.NewProposer[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.ConfigureInput, *semaphoreprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *semaphoreprotocolv1.SemaphoreInput) (*semaphoreprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*semaphoreprotocolv1.SemaphoreInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *semaphoreprotocolv1.ConfigureOutput) *semaphoreprotocolv1.SemaphoreOutput {
+			return &semaphoreprotocolv1.SemaphoreOutput{
+				Output: &semaphoreprotocolv1.SemaphoreOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.acquire = statemachine.NewProposer[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]("Acquire").
+		Decoder(func(input *semaphoreprotocolv1.SemaphoreInput) (*semaphoreprotocolv1.AcquireInput, bool) {
+			if acquire, ok := input.Input.(*semaphoreprotocolv1.SemaphoreInput_Acquire); ok {
+				return acquire.Acquire, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *semaphoreprotocolv1.AcquireOutput) *semaphoreprotocolv1.SemaphoreOutput {
+			return &semaphoreprotocolv1.SemaphoreOutput{
+				Output: &semaphoreprotocolv1.SemaphoreOutput_Acquire{
+					Acquire: output,
+				},
+			}
+		}).
+		Build(s.Acquire)
+	s.release = statemachine.NewProposer[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.ReleaseInput, *semaphoreprotocolv1.ReleaseOutput]("Release").
+		Decoder(func(input *semaphoreprotocolv1.SemaphoreInput) (*semaphoreprotocolv1.ReleaseInput, bool) {
+			if release, ok := input.Input.(*semaphoreprotocolv1.SemaphoreInput_Release); ok {
+				return release.Release, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *semaphoreprotocolv1.ReleaseOutput) *semaphoreprotocolv1.SemaphoreOutput {
+			return &semaphoreprotocolv1.SemaphoreOutput{
+				Output: &semaphoreprotocolv1.SemaphoreOutput_Release{
+					Release: output,
+				},
+			}
+		}).
+		Build(s.Release)
+	s.get = statemachine.NewQuerier[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.GetInput, *semaphoreprotocolv1.GetOutput]("Get").
+		Decoder(func(input *semaphoreprotocolv1.SemaphoreInput) (*semaphoreprotocolv1.GetInput, bool) {
+			if get, ok := input.Input.(*semaphoreprotocolv1.SemaphoreInput_Get); ok {
+				return get.Get, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *semaphoreprotocolv1.GetOutput) *semaphoreprotocolv1.SemaphoreOutput {
+			return &semaphoreprotocolv1.SemaphoreOutput{
+				Output: &semaphoreprotocolv1.SemaphoreOutput_Get{
+					Get: output,
+				},
+			}
+		}).
+		Build(s.Get)
+}
+
+func (s *SemaphoreExecutor) Propose(proposal statemachine.Proposal[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *semaphoreprotocolv1.SemaphoreInput_Configure:
+		s.configure(proposal)
+	case *semaphoreprotocolv1.SemaphoreInput_Acquire:
+		s.acquire(proposal)
+	case *semaphoreprotocolv1.SemaphoreInput_Release:
+		s.release(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *SemaphoreExecutor) Query(query statemachine.Query[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]) {
+	switch query.Input().Input.(type) {
+	case *semaphoreprotocolv1.SemaphoreInput_Get:
+		s.get(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
@@ -0,0 +1,360 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	semaphoreprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/semaphore/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "Semaphore"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+const defaultPermits = 1
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]) statemachine.PrimitiveStateMachine[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput] {
+			return newExecutor(NewSemaphoreStateMachine(context))
+		}, semaphoreCodec)
+}
+
+type SemaphoreContext interface {
+	statemachine.PrimitiveContext[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]
+	Requests() statemachine.Proposals[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]) SemaphoreContext {
+	return &semaphoreContext{
+		PrimitiveContext: context,
+		requests: statemachine.NewProposals[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput, *semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput](context).
+			Decoder(func(input *semaphoreprotocolv1.SemaphoreInput) (*semaphoreprotocolv1.AcquireInput, bool) {
+				if acquire, ok := input.Input.(*semaphoreprotocolv1.SemaphoreInput_Acquire); ok {
+					return acquire.Acquire, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *semaphoreprotocolv1.AcquireOutput) *semaphoreprotocolv1.SemaphoreOutput {
+				return &semaphoreprotocolv1.SemaphoreOutput{
+					Output: &semaphoreprotocolv1.SemaphoreOutput_Acquire{
+						Acquire: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type semaphoreContext struct {
+	statemachine.PrimitiveContext[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]
+	requests statemachine.Proposals[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]
+}
+
+func (c *semaphoreContext) Requests() statemachine.Proposals[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput] {
+	return c.requests
+}
+
+type SemaphoreStateMachine interface {
+	statemachine.Context[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*semaphoreprotocolv1.ConfigureInput, *semaphoreprotocolv1.ConfigureOutput])
+	Acquire(statemachine.Proposal[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput])
+	Release(statemachine.Proposal[*semaphoreprotocolv1.ReleaseInput, *semaphoreprotocolv1.ReleaseOutput])
+	Get(statemachine.Query[*semaphoreprotocolv1.GetInput, *semaphoreprotocolv1.GetOutput])
+}
+
+func NewSemaphoreStateMachine(context statemachine.PrimitiveContext[*semaphoreprotocolv1.SemaphoreInput, *semaphoreprotocolv1.SemaphoreOutput]) SemaphoreStateMachine {
+	return &semaphoreStateMachine{
+		SemaphoreContext: newContext(context),
+		permits:          defaultPermits,
+		holders:          make(map[statemachine.SessionID]*holder),
+		proposals:        make(map[statemachine.ProposalID]statemachine.CancelFunc),
+		timers:           make(map[statemachine.ProposalID]statemachine.CancelFunc),
+	}
+}
+
+type holder struct {
+	permits uint64
+	watcher statemachine.CancelFunc
+}
+
+type semaphoreStateMachine struct {
+	SemaphoreContext
+	permits uint64
+	// configured indicates whether the permits have ever been configured
+	configured bool
+	acquired   uint64
+	holders    map[statemachine.SessionID]*holder
+	queue      []statemachine.Proposal[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]
+	proposals  map[statemachine.ProposalID]statemachine.CancelFunc
+	timers     map[statemachine.ProposalID]statemachine.CancelFunc
+}
+
+func (s *semaphoreStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(s.permits); err != nil {
+		return err
+	}
+	if err := writer.WriteBool(s.configured); err != nil {
+		return err
+	}
+	if err := writer.WriteVarInt(len(s.holders)); err != nil {
+		return err
+	}
+	for sessionID, holder := range s.holders {
+		if err := writer.WriteVarUint64(uint64(sessionID)); err != nil {
+			return err
+		}
+		if err := writer.WriteVarUint64(holder.permits); err != nil {
+			return err
+		}
+	}
+	if err := writer.WriteVarInt(len(s.queue)); err != nil {
+		return err
+	}
+	for _, waiter := range s.queue {
+		if err := writer.WriteVarUint64(uint64(waiter.ID())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *semaphoreStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		s.permits, err = reader.ReadVarUint64()
+		if err != nil {
+			return err
+		}
+		s.configured, err = reader.ReadBool()
+		if err != nil {
+			return err
+		}
+
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			sessionID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			permits, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			session, ok := s.Sessions().Get(statemachine.SessionID(sessionID))
+			if !ok {
+				return errors.NewFault("session not found")
+			}
+			s.hold(session, permits)
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			proposal, ok := s.Requests().Get(statemachine.ProposalID(proposalID))
+			if !ok {
+				return errors.NewFault("proposal not found")
+			}
+			s.enqueueRequest(proposal)
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// hold records permits acquired by the given session, releasing them when the session expires
+func (s *semaphoreStateMachine) hold(session statemachine.Session, permits uint64) {
+	s.acquired += permits
+	if holder, ok := s.holders[session.ID()]; ok {
+		holder.permits += permits
+		return
+	}
+	sessionID := session.ID()
+	s.holders[sessionID] = &holder{
+		permits: permits,
+		watcher: session.Watch(func(state statemachine.State) {
+			if state == statemachine.Closed {
+				if holder, ok := s.holders[sessionID]; ok {
+					s.acquired -= holder.permits
+					delete(s.holders, sessionID)
+					s.nextRequests()
+				}
+			}
+		}),
+	}
+}
+
+func (s *semaphoreStateMachine) enqueueRequest(proposal statemachine.Proposal[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]) {
+	s.queue = append(s.queue, proposal)
+	s.watchRequest(proposal)
+}
+
+func (s *semaphoreStateMachine) dequeueRequest(proposal statemachine.Proposal[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]) {
+	s.unwatchRequest(proposal.ID())
+	queue := make([]statemachine.Proposal[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput], 0, len(s.queue))
+	for _, waiter := range s.queue {
+		if waiter.ID() != proposal.ID() {
+			queue = append(queue, waiter)
+		}
+	}
+	s.queue = queue
+
+	// Removing the head of the queue may unblock smaller requests behind it
+	s.nextRequests()
+}
+
+// nextRequests grants permits to queued requests in FIFO order until the head of the queue cannot be satisfied.
+// Requests for more permits than the semaphore holds are failed.
+func (s *semaphoreStateMachine) nextRequests() {
+	for len(s.queue) > 0 {
+		proposal := s.queue[0]
+		permits := getPermits(proposal.Input().Permits)
+		if permits > s.permits {
+			// The permits were lowered below the number of requested permits since the request was enqueued
+			s.queue = s.queue[1:]
+			s.unwatchRequest(proposal.ID())
+			proposal.Error(errors.NewInvalid("cannot acquire %d permits from semaphore with %d permits", permits, s.permits))
+			proposal.Close()
+			continue
+		}
+		if s.available() < permits {
+			return
+		}
+		s.queue = s.queue[1:]
+		s.unwatchRequest(proposal.ID())
+		s.hold(proposal.Session(), permits)
+		proposal.Output(&semaphoreprotocolv1.AcquireOutput{})
+		proposal.Close()
+	}
+}
+
+func (s *semaphoreStateMachine) watchRequest(proposal statemachine.Proposal[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]) {
+	s.proposals[proposal.ID()] = proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.dequeueRequest(proposal)
+		}
+	})
+
+	if proposal.Input().Timeout != nil {
+		s.timers[proposal.ID()] = s.Scheduler().Delay(*proposal.Input().Timeout, func() {
+			s.dequeueRequest(proposal)
+			proposal.Error(errors.NewTimeout("permits not available"))
+			proposal.Close()
+		})
+	}
+}
+
+func (s *semaphoreStateMachine) unwatchRequest(proposalID statemachine.ProposalID) {
+	if cancel, ok := s.proposals[proposalID]; ok {
+		cancel()
+		delete(s.proposals, proposalID)
+	}
+	if cancel, ok := s.timers[proposalID]; ok {
+		cancel()
+		delete(s.timers, proposalID)
+	}
+}
+
+func (s *semaphoreStateMachine) available() uint64 {
+	if s.acquired >= s.permits {
+		return 0
+	}
+	return s.permits - s.acquired
+}
+
+func (s *semaphoreStateMachine) Configure(proposal statemachine.Proposal[*semaphoreprotocolv1.ConfigureInput, *semaphoreprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	if proposal.Input().Init && s.configured {
+		proposal.Output(&semaphoreprotocolv1.ConfigureOutput{})
+		return
+	}
+	s.permits = getPermits(proposal.Input().Permits)
+	s.configured = true
+	s.nextRequests()
+	proposal.Output(&semaphoreprotocolv1.ConfigureOutput{})
+}
+
+func (s *semaphoreStateMachine) Acquire(proposal statemachine.Proposal[*semaphoreprotocolv1.AcquireInput, *semaphoreprotocolv1.AcquireOutput]) {
+	permits := getPermits(proposal.Input().Permits)
+	if permits > s.permits {
+		proposal.Error(errors.NewInvalid("cannot acquire %d permits from semaphore with %d permits", permits, s.permits))
+		proposal.Close()
+		return
+	}
+
+	// Grant the permits immediately only if no other requests are waiting to preserve FIFO ordering
+	if len(s.queue) == 0 && s.available() >= permits {
+		s.hold(proposal.Session(), permits)
+		proposal.Output(&semaphoreprotocolv1.AcquireOutput{})
+		proposal.Close()
+	} else {
+		s.enqueueRequest(proposal)
+	}
+}
+
+func (s *semaphoreStateMachine) Release(proposal statemachine.Proposal[*semaphoreprotocolv1.ReleaseInput, *semaphoreprotocolv1.ReleaseOutput]) {
+	defer proposal.Close()
+	permits := getPermits(proposal.Input().Permits)
+	holder, ok := s.holders[proposal.Session().ID()]
+	if !ok || holder.permits < permits {
+		proposal.Error(errors.NewConflict("permits not held by client"))
+		return
+	}
+	holder.permits -= permits
+	s.acquired -= permits
+	if holder.permits == 0 {
+		holder.watcher()
+		delete(s.holders, proposal.Session().ID())
+	}
+	s.nextRequests()
+	proposal.Output(&semaphoreprotocolv1.ReleaseOutput{})
+}
+
+func (s *semaphoreStateMachine) Get(query statemachine.Query[*semaphoreprotocolv1.GetInput, *semaphoreprotocolv1.GetOutput]) {
+	defer query.Close()
+	query.Output(&semaphoreprotocolv1.GetOutput{
+		Permits:   s.permits,
+		Available: s.available(),
+		Waiters:   uint32(len(s.queue)),
+	})
+}
+
+// getPermits returns the requested number of permits, defaulting to a single permit when the number is 0
+func getPermits(permits uint64) uint64 {
+	if permits == 0 {
+		return defaultPermits
+	}
+	return permits
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	shardassignmentprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/shardassignment/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var shardAssignmentCodec = statemachine.NewCodec[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput](
+	func(bytes []byte) (*shardassignmentprotocolv1.ShardAssignmentInput, error) {
+		input := &shardassignmentprotocolv1.ShardAssignmentInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *shardassignmentprotocolv1.ShardAssignmentOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm ShardAssignmentStateMachine) statemachine.PrimitiveStateMachine[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput] {
+	executor := &ShardAssignmentExecutor{
+		ShardAssignmentStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type ShardAssignmentExecutor struct {
+	ShardAssignmentStateMachine
+	configure      statemachine.Proposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.ConfigureInput, *shardassignmentprotocolv1.ConfigureOutput]
+	join           statemachine.Proposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.JoinInput, *shardassignmentprotocolv1.JoinOutput]
+	leave          statemachine.Proposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.LeaveInput, *shardassignmentprotocolv1.LeaveOutput]
+	release        statemachine.Proposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.ReleaseInput, *shardassignmentprotocolv1.ReleaseOutput]
+	getAssignments statemachine.Querier[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.GetAssignmentsInput, *shardassignmentprotocolv1.GetAssignmentsOutput]
+	watch          statemachine.Querier[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.WatchInput, *shardassignmentprotocolv1.WatchOutput]
+}
+
+func (s *ShardAssignmentExecutor) init() {
+	s.configure = statemachine.NewProposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.ConfigureInput, *shardassignmentprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *shardassignmentprotocolv1.ShardAssignmentInput) (*shardassignmentprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*shardassignmentprotocolv1.ShardAssignmentInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *shardassignmentprotocolv1.ConfigureOutput) *shardassignmentprotocolv1.ShardAssignmentOutput {
+			return &shardassignmentprotocolv1.ShardAssignmentOutput{
+				Output: &shardassignmentprotocolv1.ShardAssignmentOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.join = statemachine.NewProposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.JoinInput, *shardassignmentprotocolv1.JoinOutput]("Join").
+		Decoder(func(input *shardassignmentprotocolv1.ShardAssignmentInput) (*shardassignmentprotocolv1.JoinInput, bool) {
+			if join, ok := input.Input.(*shardassignmentprotocolv1.ShardAssignmentInput_Join); ok {
+				return join.Join, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *shardassignmentprotocolv1.JoinOutput) *shardassignmentprotocolv1.ShardAssignmentOutput {
+			return &shardassignmentprotocolv1.ShardAssignmentOutput{
+				Output: &shardassignmentprotocolv1.ShardAssignmentOutput_Join{
+					Join: output,
+				},
+			}
+		}).
+		Build(s.Join)
+	s.leave = statemachine.NewProposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.LeaveInput, *shardassignmentprotocolv1.LeaveOutput]("Leave").
+		Decoder(func(input *shardassignmentprotocolv1.ShardAssignmentInput) (*shardassignmentprotocolv1.LeaveInput, bool) {
+			if leave, ok := input.Input.(*shardassignmentprotocolv1.ShardAssignmentInput_Leave); ok {
+				return leave.Leave, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *shardassignmentprotocolv1.LeaveOutput) *shardassignmentprotocolv1.ShardAssignmentOutput {
+			return &shardassignmentprotocolv1.ShardAssignmentOutput{
+				Output: &shardassignmentprotocolv1.ShardAssignmentOutput_Leave{
+					Leave: output,
+				},
+			}
+		}).
+		Build(s.Leave)
+	s.release = statemachine.NewProposer[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.ReleaseInput, *shardassignmentprotocolv1.ReleaseOutput]("Release").
+		Decoder(func(input *shardassignmentprotocolv1.ShardAssignmentInput) (*shardassignmentprotocolv1.ReleaseInput, bool) {
+			if release, ok := input.Input.(*shardassignmentprotocolv1.ShardAssignmentInput_Release); ok {
+				return release.Release, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *shardassignmentprotocolv1.ReleaseOutput) *shardassignmentprotocolv1.ShardAssignmentOutput {
+			return &shardassignmentprotocolv1.ShardAssignmentOutput{
+				Output: &shardassignmentprotocolv1.ShardAssignmentOutput_Release{
+					Release: output,
+				},
+			}
+		}).
+		Build(s.Release)
+	s.getAssignments = statemachine.NewQuerier[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.GetAssignmentsInput, *shardassignmentprotocolv1.GetAssignmentsOutput]("GetAssignments").
+		Decoder(func(input *shardassignmentprotocolv1.ShardAssignmentInput) (*shardassignmentprotocolv1.GetAssignmentsInput, bool) {
+			if getAssignments, ok := input.Input.(*shardassignmentprotocolv1.ShardAssignmentInput_GetAssignments); ok {
+				return getAssignments.GetAssignments, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *shardassignmentprotocolv1.GetAssignmentsOutput) *shardassignmentprotocolv1.ShardAssignmentOutput {
+			return &shardassignmentprotocolv1.ShardAssignmentOutput{
+				Output: &shardassignmentprotocolv1.ShardAssignmentOutput_GetAssignments{
+					GetAssignments: output,
+				},
+			}
+		}).
+		Build(s.GetAssignments)
+	s.watch = statemachine.NewQuerier[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput, *shardassignmentprotocolv1.WatchInput, *shardassignmentprotocolv1.WatchOutput]("Watch").
+		Decoder(func(input *shardassignmentprotocolv1.ShardAssignmentInput) (*shardassignmentprotocolv1.WatchInput, bool) {
+			if watch, ok := input.Input.(*shardassignmentprotocolv1.ShardAssignmentInput_Watch); ok {
+				return watch.Watch, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *shardassignmentprotocolv1.WatchOutput) *shardassignmentprotocolv1.ShardAssignmentOutput {
+			return &shardassignmentprotocolv1.ShardAssignmentOutput{
+				Output: &shardassignmentprotocolv1.ShardAssignmentOutput_Watch{
+					Watch: output,
+				},
+			}
+		}).
+		Build(s.Watch)
+}
+
+func (s *ShardAssignmentExecutor) Propose(proposal statemachine.Proposal[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *shardassignmentprotocolv1.ShardAssignmentInput_Configure:
+		s.configure(proposal)
+	case *shardassignmentprotocolv1.ShardAssignmentInput_Join:
+		s.join(proposal)
+	case *shardassignmentprotocolv1.ShardAssignmentInput_Leave:
+		s.leave(proposal)
+	case *shardassignmentprotocolv1.ShardAssignmentInput_Release:
+		s.release(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *ShardAssignmentExecutor) Query(query statemachine.Query[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput]) {
+	switch query.Input().Input.(type) {
+	case *shardassignmentprotocolv1.ShardAssignmentInput_GetAssignments:
+		s.getAssignments(query)
+	case *shardassignmentprotocolv1.ShardAssignmentInput_Watch:
+		s.watch(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	shardassignmentprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/shardassignment/v1"
+)
+
+// virtualNodes is the number of points each member occupies on the consistent hash ring
+const virtualNodes = 64
+
+// assign computes the shards assigned to each member using the given policy
+// The returned assignments are ordered like the members, and the shards in each assignment are sorted.
+func assign(policy shardassignmentprotocolv1.Policy, shards uint32, members []shardassignmentprotocolv1.ShardAssignmentMember) [][]uint32 {
+	switch policy {
+	case shardassignmentprotocolv1.Policy_CONSISTENT_HASH:
+		names := make([]string, len(members))
+		for i, member := range members {
+			names[i] = member.Name
+		}
+		return assignConsistentHash(shards, names)
+	default:
+		current := make([][]uint32, len(members))
+		for i, member := range members {
+			current[i] = member.Shards
+		}
+		return assignSticky(shards, current)
+	}
+}
+
+// assignSticky balances the shards among the members, keeping each member's current shards where possible
+func assignSticky(shards uint32, current [][]uint32) [][]uint32 {
+	assignments := make([][]uint32, len(current))
+	if len(current) == 0 {
+		return assignments
+	}
+
+	// When the shards cannot be divided evenly, the members holding the most shards
+	// are given the remainder so as few shards as possible are moved
+	order := make([]int, len(current))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(current[order[i]]) > len(current[order[j]])
+	})
+	targets := make([]int, len(current))
+	for rank, i := range order {
+		targets[i] = int(shards) / len(current)
+		if rank < int(shards)%len(current) {
+			targets[i]++
+		}
+	}
+
+	assigned := make([]bool, shards)
+	for i, held := range current {
+		for _, shard := range held {
+			if shard < shards && !assigned[shard] && len(assignments[i]) < targets[i] {
+				assignments[i] = append(assignments[i], shard)
+				assigned[shard] = true
+			}
+		}
+	}
+
+	// Distribute the remaining shards to the members below their targets in join order
+	i := 0
+	for shard := uint32(0); shard < shards; shard++ {
+		if assigned[shard] {
+			continue
+		}
+		for len(assignments[i]) >= targets[i] {
+			i++
+		}
+		assignments[i] = append(assignments[i], shard)
+	}
+
+	for _, assignment := range assignments {
+		sort.Slice(assignment, func(i, j int) bool {
+			return assignment[i] < assignment[j]
+		})
+	}
+	return assignments
+}
+
+// reconcile moves the shards held by each member toward its target shards without assigning a shard to two members
+// Shards a member holds outside its target are revoked from it, and shards in a member's target are only granted
+// once no other member holds them. The returned shards and revocations are sorted.
+func reconcile(held, targets [][]uint32) ([][]uint32, [][]uint32) {
+	holders := make(map[uint32]bool)
+	for _, shards := range held {
+		for _, shard := range shards {
+			holders[shard] = true
+		}
+	}
+
+	shards := make([][]uint32, len(held))
+	revoking := make([][]uint32, len(held))
+	for i := range held {
+		for _, shard := range held[i] {
+			shards[i] = append(shards[i], shard)
+			if !containsShard(targets[i], shard) {
+				revoking[i] = append(revoking[i], shard)
+			}
+		}
+		for _, shard := range targets[i] {
+			if !holders[shard] {
+				shards[i] = append(shards[i], shard)
+				holders[shard] = true
+			}
+		}
+		sort.Slice(shards[i], func(j, k int) bool {
+			return shards[i][j] < shards[i][k]
+		})
+	}
+	return shards, revoking
+}
+
+func containsShard(shards []uint32, shard uint32) bool {
+	for _, s := range shards {
+		if s == shard {
+			return true
+		}
+	}
+	return false
+}
+
+type ringNode struct {
+	hash   uint64
+	member int
+}
+
+// assignConsistentHash assigns each shard to the first member following the shard's hash on a ring of members
+func assignConsistentHash(shards uint32, members []string) [][]uint32 {
+	assignments := make([][]uint32, len(members))
+	if len(members) == 0 {
+		return assignments
+	}
+
+	ring := make([]ringNode, 0, len(members)*virtualNodes)
+	for i, member := range members {
+		for n := 0; n < virtualNodes; n++ {
+			ring = append(ring, ringNode{
+				hash:   hash(fmt.Sprintf("%s/%d", member, n)),
+				member: i,
+			})
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool {
+		if ring[i].hash != ring[j].hash {
+			return ring[i].hash < ring[j].hash
+		}
+		return ring[i].member < ring[j].member
+	})
+
+	for shard := uint32(0); shard < shards; shard++ {
+		key := hash(fmt.Sprintf("shard/%d", shard))
+		n := sort.Search(len(ring), func(n int) bool {
+			return ring[n].hash >= key
+		})
+		if n == len(ring) {
+			n = 0
+		}
+		assignments[ring[n].member] = append(assignments[ring[n].member], shard)
+	}
+	return assignments
+}
+
+func hash(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
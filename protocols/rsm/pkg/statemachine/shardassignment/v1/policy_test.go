@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAssignSticky(t *testing.T) {
+	assert.Empty(t, assignSticky(8, nil))
+
+	assignments := assignSticky(8, [][]uint32{nil})
+	assert.Equal(t, [][]uint32{{0, 1, 2, 3, 4, 5, 6, 7}}, assignments)
+
+	// A joining member takes shards from the existing member without moving any others
+	assignments = assignSticky(8, [][]uint32{assignments[0], nil})
+	assert.Equal(t, [][]uint32{{0, 1, 2, 3}, {4, 5, 6, 7}}, assignments)
+
+	// The remainder goes to the members already holding the most shards
+	assignments = assignSticky(8, [][]uint32{assignments[0], assignments[1], nil})
+	assert.Equal(t, [][]uint32{{0, 1, 2}, {4, 5, 6}, {3, 7}}, assignments)
+
+	// The shards of a leaving member are spread among the remaining members
+	assignments = assignSticky(8, [][]uint32{assignments[0], assignments[2]})
+	assert.Equal(t, [][]uint32{{0, 1, 2, 4}, {3, 5, 6, 7}}, assignments)
+
+	// Shards beyond the configured count are dropped
+	assignments = assignSticky(4, assignments)
+	assert.Equal(t, [][]uint32{{0, 1}, {2, 3}}, assignments)
+
+	// Members may be assigned no shards when there are more members than shards
+	assignments = assignSticky(1, [][]uint32{nil, {0}})
+	assert.Equal(t, [][]uint32{nil, {0}}, assignments)
+}
+
+func TestAssignConsistentHash(t *testing.T) {
+	assert.Empty(t, assignConsistentHash(8, nil))
+
+	members := []string{"a", "b", "c"}
+	assignments := assignConsistentHash(64, members)
+	assert.Len(t, assignments, 3)
+	total := 0
+	for _, shards := range assignments {
+		total += len(shards)
+	}
+	assert.Equal(t, 64, total)
+	assert.Equal(t, assignments, assignConsistentHash(64, members))
+
+	// Removing a member only moves the shards assigned to it
+	remaining := assignConsistentHash(64, []string{"a", "c"})
+	for _, shard := range assignments[0] {
+		assert.Contains(t, remaining[0], shard)
+	}
+	for _, shard := range assignments[2] {
+		assert.Contains(t, remaining[1], shard)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	// A joining member is only granted shards nobody holds
+	shards, revoking := reconcile([][]uint32{{0, 1, 2, 3}, nil}, [][]uint32{{0, 1}, {2, 3}})
+	assert.Equal(t, [][]uint32{{0, 1, 2, 3}, nil}, shards)
+	assert.Equal(t, [][]uint32{{2, 3}, nil}, revoking)
+
+	// Released shards are granted to their new owner
+	shards, revoking = reconcile([][]uint32{{0, 1, 3}, nil}, [][]uint32{{0, 1}, {2, 3}})
+	assert.Equal(t, [][]uint32{{0, 1, 3}, {2}}, shards)
+	assert.Equal(t, [][]uint32{{3}, nil}, revoking)
+
+	// A revocation is cancelled when the shard is reassigned to its holder
+	shards, revoking = reconcile([][]uint32{{0, 1, 3}, {2}}, [][]uint32{{0, 1, 3}, {2}})
+	assert.Equal(t, [][]uint32{{0, 1, 3}, {2}}, shards)
+	assert.Equal(t, [][]uint32{nil, nil}, revoking)
+
+	// Shards beyond the configured count are revoked without being reassigned
+	shards, revoking = reconcile([][]uint32{{0, 1}, {2, 3}}, [][]uint32{{0}, {1}})
+	assert.Equal(t, [][]uint32{{0, 1}, {2, 3}}, shards)
+	assert.Equal(t, [][]uint32{{1}, {2, 3}}, revoking)
+}
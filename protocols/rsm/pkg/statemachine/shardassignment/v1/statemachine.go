@@ -0,0 +1,384 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"sync"
+
+	"github.com/atomix/atomix/api/errors"
+	shardassignmentprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/shardassignment/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "ShardAssignment"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+const defaultShards = 1
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput]) statemachine.PrimitiveStateMachine[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput] {
+			return newExecutor(NewShardAssignmentStateMachine(context))
+		}, shardAssignmentCodec)
+}
+
+type ShardAssignmentContext interface {
+	statemachine.PrimitiveContext[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput]
+}
+
+type ShardAssignmentStateMachine interface {
+	statemachine.Context[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*shardassignmentprotocolv1.ConfigureInput, *shardassignmentprotocolv1.ConfigureOutput])
+	Join(statemachine.Proposal[*shardassignmentprotocolv1.JoinInput, *shardassignmentprotocolv1.JoinOutput])
+	Leave(statemachine.Proposal[*shardassignmentprotocolv1.LeaveInput, *shardassignmentprotocolv1.LeaveOutput])
+	Release(statemachine.Proposal[*shardassignmentprotocolv1.ReleaseInput, *shardassignmentprotocolv1.ReleaseOutput])
+	GetAssignments(statemachine.Query[*shardassignmentprotocolv1.GetAssignmentsInput, *shardassignmentprotocolv1.GetAssignmentsOutput])
+	Watch(statemachine.Query[*shardassignmentprotocolv1.WatchInput, *shardassignmentprotocolv1.WatchOutput])
+}
+
+func NewShardAssignmentStateMachine(context statemachine.PrimitiveContext[*shardassignmentprotocolv1.ShardAssignmentInput, *shardassignmentprotocolv1.ShardAssignmentOutput]) ShardAssignmentStateMachine {
+	return &shardAssignmentStateMachine{
+		ShardAssignmentContext: context,
+		ShardAssignmentSnapshot: shardassignmentprotocolv1.ShardAssignmentSnapshot{
+			Shards: defaultShards,
+		},
+		sessions: make(map[statemachine.SessionID]statemachine.CancelFunc),
+		watchers: make(map[statemachine.QueryID]statemachine.Query[*shardassignmentprotocolv1.WatchInput, *shardassignmentprotocolv1.WatchOutput]),
+	}
+}
+
+type shardAssignmentStateMachine struct {
+	ShardAssignmentContext
+	shardassignmentprotocolv1.ShardAssignmentSnapshot
+	sessions map[statemachine.SessionID]statemachine.CancelFunc
+	watchers map[statemachine.QueryID]statemachine.Query[*shardassignmentprotocolv1.WatchInput, *shardassignmentprotocolv1.WatchOutput]
+	mu       sync.RWMutex
+}
+
+func (s *shardAssignmentStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteMessage(&s.ShardAssignmentSnapshot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *shardAssignmentStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		if err := reader.ReadMessage(&s.ShardAssignmentSnapshot); err != nil {
+			return err
+		}
+		for _, member := range s.Members {
+			if err := s.watchSession(statemachine.SessionID(member.SessionID)); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// watchSession removes the members joined by the given session when the session expires
+func (s *shardAssignmentStateMachine) watchSession(sessionID statemachine.SessionID) error {
+	if _, ok := s.sessions[sessionID]; ok {
+		return nil
+	}
+	session, ok := s.Sessions().Get(sessionID)
+	if !ok {
+		return errors.NewFault("unknown session")
+	}
+	s.sessions[sessionID] = session.Watch(func(state statemachine.State) {
+		if state == statemachine.Closed {
+			delete(s.sessions, sessionID)
+			members := make([]shardassignmentprotocolv1.ShardAssignmentMember, 0, len(s.Members))
+			var removed []shardassignmentprotocolv1.ShardAssignmentMember
+			for _, member := range s.Members {
+				if statemachine.SessionID(member.SessionID) == sessionID {
+					removed = append(removed, member)
+				} else {
+					members = append(members, member)
+				}
+			}
+			if len(removed) > 0 {
+				s.Members = members
+				s.rebalance(revoked(removed...)...)
+			}
+		}
+	})
+	return nil
+}
+
+// unwatchSession stops watching the given session if it no longer has any members
+func (s *shardAssignmentStateMachine) unwatchSession(sessionID statemachine.SessionID) {
+	for _, member := range s.Members {
+		if statemachine.SessionID(member.SessionID) == sessionID {
+			return
+		}
+	}
+	if cancel, ok := s.sessions[sessionID]; ok {
+		cancel()
+		delete(s.sessions, sessionID)
+	}
+}
+
+// rebalance reassigns the shards among the current members and notifies watchers of changed assignments
+// A shard moving from one member to another is first revoked from its holder and is only assigned to the new
+// member once the holder releases it, leaves the group, or its session expires. The given changes are
+// included in the notification for assignments changed by the caller.
+func (s *shardAssignmentStateMachine) rebalance(changes ...shardassignmentprotocolv1.Assignment) {
+	held := make([][]uint32, len(s.Members))
+	for i, member := range s.Members {
+		held[i] = member.Shards
+	}
+	shards, revoking := reconcile(held, assign(s.Policy, s.Shards, s.Members))
+	for i := range s.Members {
+		member := &s.Members[i]
+		if !equalShards(member.Shards, shards[i]) || !equalShards(member.Revoking, revoking[i]) {
+			member.Shards = shards[i]
+			member.Revoking = revoking[i]
+			changes = append(changes, newAssignment(member))
+		}
+	}
+
+	if len(changes) > 0 {
+		s.Version++
+		s.notify(changes)
+	}
+}
+
+// revoked returns the changes notifying members removed from the group that their shards have been revoked
+func revoked(members ...shardassignmentprotocolv1.ShardAssignmentMember) []shardassignmentprotocolv1.Assignment {
+	var changes []shardassignmentprotocolv1.Assignment
+	for _, member := range members {
+		if len(member.Shards) > 0 {
+			changes = append(changes, shardassignmentprotocolv1.Assignment{
+				Member: member.Name,
+			})
+		}
+	}
+	return changes
+}
+
+func (s *shardAssignmentStateMachine) getMember(name string) (*shardassignmentprotocolv1.ShardAssignmentMember, bool) {
+	for i := range s.Members {
+		if s.Members[i].Name == name {
+			return &s.Members[i], true
+		}
+	}
+	return nil, false
+}
+
+func (s *shardAssignmentStateMachine) Configure(proposal statemachine.Proposal[*shardassignmentprotocolv1.ConfigureInput, *shardassignmentprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	if proposal.Input().Init && s.Configured {
+		proposal.Output(&shardassignmentprotocolv1.ConfigureOutput{})
+		return
+	}
+	shards := proposal.Input().Shards
+	if shards == 0 {
+		shards = defaultShards
+	}
+	s.Configured = true
+	if shards != s.Shards || proposal.Input().Policy != s.Policy {
+		s.Shards = shards
+		s.Policy = proposal.Input().Policy
+		s.rebalance()
+	}
+	proposal.Output(&shardassignmentprotocolv1.ConfigureOutput{})
+}
+
+func (s *shardAssignmentStateMachine) Join(proposal statemachine.Proposal[*shardassignmentprotocolv1.JoinInput, *shardassignmentprotocolv1.JoinOutput]) {
+	defer proposal.Close()
+	name := proposal.Input().Member
+	if name == "" {
+		proposal.Error(errors.NewInvalid("member name cannot be empty"))
+		return
+	}
+
+	if member, ok := s.getMember(name); ok {
+		if statemachine.SessionID(member.SessionID) != proposal.Session().ID() {
+			proposal.Error(errors.NewConflict("member '%s' already joined by another session", name))
+			return
+		}
+		proposal.Output(&shardassignmentprotocolv1.JoinOutput{
+			Shards:   member.Shards,
+			Version:  s.Version,
+			Revoking: member.Revoking,
+		})
+		return
+	}
+
+	if err := s.watchSession(proposal.Session().ID()); err != nil {
+		proposal.Error(err)
+		return
+	}
+	s.Members = append(s.Members, shardassignmentprotocolv1.ShardAssignmentMember{
+		Name:      name,
+		SessionID: protocol.SessionID(proposal.Session().ID()),
+	})
+	s.rebalance()
+
+	member, _ := s.getMember(name)
+	proposal.Output(&shardassignmentprotocolv1.JoinOutput{
+		Shards:   member.Shards,
+		Version:  s.Version,
+		Revoking: member.Revoking,
+	})
+}
+
+func (s *shardAssignmentStateMachine) Leave(proposal statemachine.Proposal[*shardassignmentprotocolv1.LeaveInput, *shardassignmentprotocolv1.LeaveOutput]) {
+	defer proposal.Close()
+	name := proposal.Input().Member
+	member, ok := s.getMember(name)
+	if !ok {
+		proposal.Error(errors.NewNotFound("member '%s' not found", name))
+		return
+	}
+	if statemachine.SessionID(member.SessionID) != proposal.Session().ID() {
+		proposal.Error(errors.NewConflict("member '%s' joined by another session", name))
+		return
+	}
+
+	removed := *member
+	members := make([]shardassignmentprotocolv1.ShardAssignmentMember, 0, len(s.Members))
+	for _, member := range s.Members {
+		if member.Name != name {
+			members = append(members, member)
+		}
+	}
+	s.Members = members
+	s.unwatchSession(proposal.Session().ID())
+	s.rebalance(revoked(removed)...)
+	proposal.Output(&shardassignmentprotocolv1.LeaveOutput{})
+}
+
+func (s *shardAssignmentStateMachine) Release(proposal statemachine.Proposal[*shardassignmentprotocolv1.ReleaseInput, *shardassignmentprotocolv1.ReleaseOutput]) {
+	defer proposal.Close()
+	name := proposal.Input().Member
+	member, ok := s.getMember(name)
+	if !ok {
+		proposal.Error(errors.NewNotFound("member '%s' not found", name))
+		return
+	}
+	if statemachine.SessionID(member.SessionID) != proposal.Session().ID() {
+		proposal.Error(errors.NewConflict("member '%s' joined by another session", name))
+		return
+	}
+	for _, shard := range proposal.Input().Shards {
+		if !containsShard(member.Revoking, shard) {
+			proposal.Error(errors.NewConflict("shard %d is not being revoked from member '%s'", shard, name))
+			return
+		}
+	}
+
+	if len(proposal.Input().Shards) > 0 {
+		member.Shards = removeShards(member.Shards, proposal.Input().Shards)
+		member.Revoking = removeShards(member.Revoking, proposal.Input().Shards)
+		s.rebalance(newAssignment(member))
+	}
+	proposal.Output(&shardassignmentprotocolv1.ReleaseOutput{})
+}
+
+func (s *shardAssignmentStateMachine) GetAssignments(query statemachine.Query[*shardassignmentprotocolv1.GetAssignmentsInput, *shardassignmentprotocolv1.GetAssignmentsOutput]) {
+	defer query.Close()
+	assignments := make([]shardassignmentprotocolv1.Assignment, 0, len(s.Members))
+	for i := range s.Members {
+		assignments = append(assignments, newAssignment(&s.Members[i]))
+	}
+	query.Output(&shardassignmentprotocolv1.GetAssignmentsOutput{
+		Assignments: assignments,
+		Version:     s.Version,
+	})
+}
+
+func (s *shardAssignmentStateMachine) Watch(query statemachine.Query[*shardassignmentprotocolv1.WatchInput, *shardassignmentprotocolv1.WatchOutput]) {
+	for i := range s.Members {
+		member := &s.Members[i]
+		if query.Input().Member == "" || query.Input().Member == member.Name {
+			query.Output(&shardassignmentprotocolv1.WatchOutput{
+				Assignment: newAssignment(member),
+				Version:    s.Version,
+			})
+		}
+	}
+
+	s.mu.Lock()
+	s.watchers[query.ID()] = query
+	s.mu.Unlock()
+	query.Watch(func(state statemachine.QueryState) {
+		if state != statemachine.Running {
+			s.mu.Lock()
+			delete(s.watchers, query.ID())
+			s.mu.Unlock()
+		}
+	})
+}
+
+func (s *shardAssignmentStateMachine) notify(assignments []shardassignmentprotocolv1.Assignment) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, watcher := range s.watchers {
+		for _, assignment := range assignments {
+			if watcher.Input().Member == "" || watcher.Input().Member == assignment.Member {
+				watcher.Output(&shardassignmentprotocolv1.WatchOutput{
+					Assignment: assignment,
+					Version:    s.Version,
+				})
+			}
+		}
+	}
+}
+
+func newAssignment(member *shardassignmentprotocolv1.ShardAssignmentMember) shardassignmentprotocolv1.Assignment {
+	return shardassignmentprotocolv1.Assignment{
+		Member:   member.Name,
+		Shards:   member.Shards,
+		Revoking: member.Revoking,
+	}
+}
+
+// removeShards returns the given shards without the removed shards
+func removeShards(shards, removed []uint32) []uint32 {
+	var remaining []uint32
+	for _, shard := range shards {
+		if !containsShard(removed, shard) {
+			remaining = append(remaining, shard)
+		}
+	}
+	return remaining
+}
+
+func equalShards(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	topicprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/topic/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var topicCodec = statemachine.NewCodec[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput](
+	func(bytes []byte) (*topicprotocolv1.TopicInput, error) {
+		input := &topicprotocolv1.TopicInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *topicprotocolv1.TopicOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm TopicStateMachine) statemachine.PrimitiveStateMachine[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput] {
+	executor := &TopicExecutor{
+		TopicStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type TopicExecutor struct {
+	TopicStateMachine
+	configure    statemachine.Proposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.ConfigureInput, *topicprotocolv1.ConfigureOutput]
+	publish      statemachine.Proposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.PublishInput, *topicprotocolv1.PublishOutput]
+	subscribe    statemachine.Proposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput]
+	commitOffset statemachine.Proposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.CommitOffsetInput, *topicprotocolv1.CommitOffsetOutput]
+	fetchOffset  statemachine.Querier[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.FetchOffsetInput, *topicprotocolv1.FetchOffsetOutput]
+}
+
+func (s *TopicExecutor) init() {
+	s.configure = statemachine.NewProposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.ConfigureInput, *topicprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *topicprotocolv1.TopicInput) (*topicprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*topicprotocolv1.TopicInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *topicprotocolv1.ConfigureOutput) *topicprotocolv1.TopicOutput {
+			return &topicprotocolv1.TopicOutput{
+				Output: &topicprotocolv1.TopicOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.publish = statemachine.NewProposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.PublishInput, *topicprotocolv1.PublishOutput]("Publish").
+		Decoder(func(input *topicprotocolv1.TopicInput) (*topicprotocolv1.PublishInput, bool) {
+			if publish, ok := input.Input.(*topicprotocolv1.TopicInput_Publish); ok {
+				return publish.Publish, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *topicprotocolv1.PublishOutput) *topicprotocolv1.TopicOutput {
+			return &topicprotocolv1.TopicOutput{
+				Output: &topicprotocolv1.TopicOutput_Publish{
+					Publish: output,
+				},
+			}
+		}).
+		Build(s.Publish)
+	s.subscribe = statemachine.NewProposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput]("Subscribe").
+		Decoder(func(input *topicprotocolv1.TopicInput) (*topicprotocolv1.SubscribeInput, bool) {
+			if subscribe, ok := input.Input.(*topicprotocolv1.TopicInput_Subscribe); ok {
+				return subscribe.Subscribe, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *topicprotocolv1.SubscribeOutput) *topicprotocolv1.TopicOutput {
+			return &topicprotocolv1.TopicOutput{
+				Output: &topicprotocolv1.TopicOutput_Subscribe{
+					Subscribe: output,
+				},
+			}
+		}).
+		Build(s.Subscribe)
+	s.commitOffset = statemachine.NewProposer[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.CommitOffsetInput, *topicprotocolv1.CommitOffsetOutput]("CommitOffset").
+		Decoder(func(input *topicprotocolv1.TopicInput) (*topicprotocolv1.CommitOffsetInput, bool) {
+			if commitOffset, ok := input.Input.(*topicprotocolv1.TopicInput_CommitOffset); ok {
+				return commitOffset.CommitOffset, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *topicprotocolv1.CommitOffsetOutput) *topicprotocolv1.TopicOutput {
+			return &topicprotocolv1.TopicOutput{
+				Output: &topicprotocolv1.TopicOutput_CommitOffset{
+					CommitOffset: output,
+				},
+			}
+		}).
+		Build(s.CommitOffset)
+	s.fetchOffset = statemachine.NewQuerier[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.FetchOffsetInput, *topicprotocolv1.FetchOffsetOutput]("FetchOffset").
+		Decoder(func(input *topicprotocolv1.TopicInput) (*topicprotocolv1.FetchOffsetInput, bool) {
+			if fetchOffset, ok := input.Input.(*topicprotocolv1.TopicInput_FetchOffset); ok {
+				return fetchOffset.FetchOffset, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *topicprotocolv1.FetchOffsetOutput) *topicprotocolv1.TopicOutput {
+			return &topicprotocolv1.TopicOutput{
+				Output: &topicprotocolv1.TopicOutput_FetchOffset{
+					FetchOffset: output,
+				},
+			}
+		}).
+		Build(s.FetchOffset)
+}
+
+func (s *TopicExecutor) Propose(proposal statemachine.Proposal[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *topicprotocolv1.TopicInput_Configure:
+		s.configure(proposal)
+	case *topicprotocolv1.TopicInput_Publish:
+		s.publish(proposal)
+	case *topicprotocolv1.TopicInput_Subscribe:
+		s.subscribe(proposal)
+	case *topicprotocolv1.TopicInput_CommitOffset:
+		s.commitOffset(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *TopicExecutor) Query(query statemachine.Query[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]) {
+	switch query.Input().Input.(type) {
+	case *topicprotocolv1.TopicInput_FetchOffset:
+		s.fetchOffset(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
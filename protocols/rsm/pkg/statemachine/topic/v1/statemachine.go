@@ -0,0 +1,657 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"hash/fnv"
+	"sort"
+
+	"github.com/atomix/atomix/api/errors"
+	topicprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/topic/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "Topic"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]) statemachine.PrimitiveStateMachine[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput] {
+			return newExecutor(NewTopicStateMachine(context))
+		}, topicCodec)
+}
+
+type TopicContext interface {
+	statemachine.PrimitiveContext[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]
+	Subscribers() statemachine.Proposals[*topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]) TopicContext {
+	return &topicContext{
+		PrimitiveContext: context,
+		subscribers: statemachine.NewProposals[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput, *topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput](context).
+			Decoder(func(input *topicprotocolv1.TopicInput) (*topicprotocolv1.SubscribeInput, bool) {
+				if subscribe, ok := input.Input.(*topicprotocolv1.TopicInput_Subscribe); ok {
+					return subscribe.Subscribe, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *topicprotocolv1.SubscribeOutput) *topicprotocolv1.TopicOutput {
+				return &topicprotocolv1.TopicOutput{
+					Output: &topicprotocolv1.TopicOutput_Subscribe{
+						Subscribe: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type topicContext struct {
+	statemachine.PrimitiveContext[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]
+	subscribers statemachine.Proposals[*topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput]
+}
+
+func (c *topicContext) Subscribers() statemachine.Proposals[*topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput] {
+	return c.subscribers
+}
+
+type TopicStateMachine interface {
+	statemachine.Context[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*topicprotocolv1.ConfigureInput, *topicprotocolv1.ConfigureOutput])
+	Publish(statemachine.Proposal[*topicprotocolv1.PublishInput, *topicprotocolv1.PublishOutput])
+	Subscribe(statemachine.Proposal[*topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput])
+	CommitOffset(statemachine.Proposal[*topicprotocolv1.CommitOffsetInput, *topicprotocolv1.CommitOffsetOutput])
+	FetchOffset(statemachine.Query[*topicprotocolv1.FetchOffsetInput, *topicprotocolv1.FetchOffsetOutput])
+}
+
+func NewTopicStateMachine(context statemachine.PrimitiveContext[*topicprotocolv1.TopicInput, *topicprotocolv1.TopicOutput]) TopicStateMachine {
+	return &topicStateMachine{
+		TopicContext: newContext(context),
+		subscribers:  make(map[statemachine.ProposalID]bool),
+		groups:       make(map[string]*topicprotocolv1.ConsumerGroup),
+	}
+}
+
+type topicStateMachine struct {
+	TopicContext
+	retention     topicprotocolv1.Retention
+	partitions    uint32
+	nextPartition uint32
+	messages      []*topicprotocolv1.Message
+	size          uint64
+	nextOffset    uint64
+	subscribers   map[statemachine.ProposalID]bool
+	groups        map[string]*topicprotocolv1.ConsumerGroup
+	timer         statemachine.CancelFunc
+}
+
+func (s *topicStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteMessage(&s.retention); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint32(s.partitions); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint32(s.nextPartition); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(s.nextOffset); err != nil {
+		return err
+	}
+
+	if err := writer.WriteVarInt(len(s.subscribers)); err != nil {
+		return err
+	}
+	for proposalID := range s.subscribers {
+		if err := writer.WriteVarUint64(uint64(proposalID)); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.WriteVarInt(len(s.messages)); err != nil {
+		return err
+	}
+	for _, message := range s.messages {
+		if err := writer.WriteMessage(message); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.WriteVarInt(len(s.groups)); err != nil {
+		return err
+	}
+	for _, group := range s.groups {
+		if err := writer.WriteMessage(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *topicStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		if err := reader.ReadMessage(&s.retention); err != nil {
+			return err
+		}
+		s.partitions, err = reader.ReadVarUint32()
+		if err != nil {
+			return err
+		}
+		s.nextPartition, err = reader.ReadVarUint32()
+		if err != nil {
+			return err
+		}
+		s.nextOffset, err = reader.ReadVarUint64()
+		if err != nil {
+			return err
+		}
+
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			proposal, ok := s.TopicContext.Subscribers().Get(statemachine.ProposalID(proposalID))
+			if !ok {
+				return errors.NewFault("cannot find proposal %d", proposalID)
+			}
+			s.subscribers[proposal.ID()] = true
+			proposal.Watch(func(state statemachine.ProposalState) {
+				if state != statemachine.Running {
+					delete(s.subscribers, proposal.ID())
+				}
+			})
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		s.messages = make([]*topicprotocolv1.Message, 0, n)
+		s.size = 0
+		for i := 0; i < n; i++ {
+			message := &topicprotocolv1.Message{}
+			if err := reader.ReadMessage(message); err != nil {
+				return err
+			}
+			s.messages = append(s.messages, message)
+			s.size += uint64(len(message.Payload))
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			group := &topicprotocolv1.ConsumerGroup{}
+			if err := reader.ReadMessage(group); err != nil {
+				return err
+			}
+			s.groups[group.Name] = group
+			for _, member := range group.Members {
+				proposal, ok := s.TopicContext.Subscribers().Get(statemachine.ProposalID(member.ProposalID))
+				if !ok {
+					return errors.NewFault("cannot find proposal %d", member.ProposalID)
+				}
+				s.watchMember(group, proposal)
+			}
+		}
+		s.scheduleExpiration()
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+func (s *topicStateMachine) Configure(proposal statemachine.Proposal[*topicprotocolv1.ConfigureInput, *topicprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	s.retention = proposal.Input().Retention
+	// The number of partitions cannot be changed once it has been set
+	if s.partitions == 0 {
+		s.partitions = proposal.Input().Partitions
+	}
+	s.trim()
+	s.scheduleExpiration()
+	proposal.Output(&topicprotocolv1.ConfigureOutput{})
+}
+
+func (s *topicStateMachine) Publish(proposal statemachine.Proposal[*topicprotocolv1.PublishInput, *topicprotocolv1.PublishOutput]) {
+	defer proposal.Close()
+
+	message := &topicprotocolv1.Message{
+		Offset:    s.nextOffset,
+		Timestamp: s.Scheduler().Time(),
+		Payload:   proposal.Input().Payload,
+		Partition: s.partition(proposal.Input().Key),
+	}
+	s.nextOffset++
+	s.messages = append(s.messages, message)
+	s.size += uint64(len(message.Payload))
+
+	for proposalID := range s.subscribers {
+		subscriber, ok := s.TopicContext.Subscribers().Get(proposalID)
+		if ok {
+			subscriber.Output(&topicprotocolv1.SubscribeOutput{
+				Message: *message,
+			})
+		} else {
+			delete(s.subscribers, proposalID)
+		}
+	}
+
+	for _, group := range s.groups {
+		s.dispatch(group)
+	}
+
+	// Trim the retained messages after delivery so live subscribers always receive the message
+	s.trim()
+	s.scheduleExpiration()
+
+	proposal.Output(&topicprotocolv1.PublishOutput{
+		Offset:    message.Offset,
+		Timestamp: message.Timestamp,
+		Partition: message.Partition,
+	})
+}
+
+func (s *topicStateMachine) Subscribe(proposal statemachine.Proposal[*topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput]) {
+	input := proposal.Input()
+	offset, err := s.startOffset(input)
+	if err != nil {
+		proposal.Error(err)
+		proposal.Close()
+		return
+	}
+
+	if input.Group != "" {
+		group, ok := s.groups[input.Group]
+		if !ok {
+			group = &topicprotocolv1.ConsumerGroup{
+				Name: input.Group,
+			}
+			for partition := uint32(0); partition < s.numPartitions(); partition++ {
+				group.Partitions = append(group.Partitions, &topicprotocolv1.ConsumerGroupPartition{
+					Partition:  partition,
+					NextOffset: offset,
+				})
+			}
+			s.groups[input.Group] = group
+		}
+		group.Members = append(group.Members, &topicprotocolv1.ConsumerGroupMember{
+			ProposalID: uint64(proposal.ID()),
+		})
+		s.watchMember(group, proposal)
+		s.rebalance(group)
+		s.dispatch(group)
+		return
+	}
+
+	for _, message := range s.messages {
+		if message.Offset >= offset {
+			proposal.Output(&topicprotocolv1.SubscribeOutput{
+				Message: *message,
+			})
+		}
+	}
+
+	s.subscribers[proposal.ID()] = true
+	proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			delete(s.subscribers, proposal.ID())
+		}
+	})
+}
+
+func (s *topicStateMachine) CommitOffset(proposal statemachine.Proposal[*topicprotocolv1.CommitOffsetInput, *topicprotocolv1.CommitOffsetOutput]) {
+	defer proposal.Close()
+	input := proposal.Input()
+	group, ok := s.groups[input.Group]
+	if !ok {
+		proposal.Error(errors.NewNotFound("consumer group %s not found", input.Group))
+		return
+	}
+	if !s.isMember(group, proposal.Session()) {
+		proposal.Error(errors.NewForbidden("session is not a member of consumer group %s", input.Group))
+		return
+	}
+
+	for _, partition := range group.Partitions {
+		if !containsOffset(partition.Pending, input.Offset) {
+			continue
+		}
+		// Only the member to which the partition is assigned may commit its messages
+		member, ok := s.TopicContext.Subscribers().Get(statemachine.ProposalID(partition.Member))
+		if !ok || member.Session().ID() != proposal.Session().ID() {
+			proposal.Error(errors.NewConflict("partition %d of consumer group %s is assigned to another member", partition.Partition, input.Group))
+			return
+		}
+		partition.Pending = removeOffset(partition.Pending, input.Offset)
+		proposal.Output(&topicprotocolv1.CommitOffsetOutput{})
+		return
+	}
+
+	// Offsets that are not pending must have already been committed by the group
+	if message, ok := s.message(input.Offset); ok {
+		partition := group.Partitions[message.Partition]
+		if input.Offset >= partition.NextOffset || containsOffset(partition.Redeliver, input.Offset) {
+			proposal.Error(errors.NewInvalid("offset %d has not been delivered to consumer group %s", input.Offset, input.Group))
+			return
+		}
+	} else if input.Offset >= s.nextOffset {
+		proposal.Error(errors.NewInvalid("offset %d has not been delivered to consumer group %s", input.Offset, input.Group))
+		return
+	}
+	proposal.Output(&topicprotocolv1.CommitOffsetOutput{})
+}
+
+func (s *topicStateMachine) FetchOffset(query statemachine.Query[*topicprotocolv1.FetchOffsetInput, *topicprotocolv1.FetchOffsetOutput]) {
+	defer query.Close()
+	group, ok := s.groups[query.Input().Group]
+	if !ok {
+		query.Error(errors.NewNotFound("consumer group %s not found", query.Input().Group))
+		return
+	}
+	if query.Input().Partition >= uint32(len(group.Partitions)) {
+		query.Error(errors.NewInvalid("partition %d not found", query.Input().Partition))
+		return
+	}
+	partition := group.Partitions[query.Input().Partition]
+
+	// The committed offset is the lowest offset in the partition that has not yet been committed by the group
+	offset := partition.NextOffset
+	for _, redeliver := range partition.Redeliver {
+		if redeliver < offset {
+			offset = redeliver
+		}
+	}
+	for _, pending := range partition.Pending {
+		if pending < offset {
+			offset = pending
+		}
+	}
+	query.Output(&topicprotocolv1.FetchOffsetOutput{
+		Offset: offset,
+	})
+}
+
+// numPartitions returns the number of partitions in the topic, defaulting to a single partition if the
+// number of partitions has not been configured by the time the topic is first used
+func (s *topicStateMachine) numPartitions() uint32 {
+	if s.partitions == 0 {
+		s.partitions = 1
+	}
+	return s.partitions
+}
+
+// partition returns the partition to which to publish a message with the given key
+// Messages without a key are spread across partitions in round-robin order.
+func (s *topicStateMachine) partition(key []byte) uint32 {
+	partitions := s.numPartitions()
+	if len(key) > 0 {
+		hash := fnv.New32a()
+		_, _ = hash.Write(key)
+		return hash.Sum32() % partitions
+	}
+	partition := s.nextPartition % partitions
+	s.nextPartition = (partition + 1) % partitions
+	return partition
+}
+
+// startOffset returns the offset of the first message to deliver for the given subscription position
+func (s *topicStateMachine) startOffset(input *topicprotocolv1.SubscribeInput) (uint64, error) {
+	switch input.Position {
+	case topicprotocolv1.SubscribeInput_LATEST:
+		return s.nextOffset, nil
+	case topicprotocolv1.SubscribeInput_EARLIEST:
+		return s.nextOffset - uint64(len(s.messages)), nil
+	case topicprotocolv1.SubscribeInput_OFFSET:
+		return input.Offset, nil
+	case topicprotocolv1.SubscribeInput_TIMESTAMP:
+		if input.Timestamp == nil {
+			return 0, errors.NewInvalid("timestamp is required")
+		}
+		for _, message := range s.messages {
+			if !message.Timestamp.Before(*input.Timestamp) {
+				return message.Offset, nil
+			}
+		}
+		return s.nextOffset, nil
+	default:
+		return 0, errors.NewInvalid("unknown position %s", input.Position)
+	}
+}
+
+// message returns the retained message at the given offset
+func (s *topicStateMachine) message(offset uint64) (*topicprotocolv1.Message, bool) {
+	first := s.nextOffset - uint64(len(s.messages))
+	if offset < first || offset >= s.nextOffset {
+		return nil, false
+	}
+	return s.messages[offset-first], true
+}
+
+// isMember returns whether the given session has subscribed as a member of the consumer group
+func (s *topicStateMachine) isMember(group *topicprotocolv1.ConsumerGroup, session statemachine.Session) bool {
+	for _, member := range group.Members {
+		subscriber, ok := s.TopicContext.Subscribers().Get(statemachine.ProposalID(member.ProposalID))
+		if ok && subscriber.Session().ID() == session.ID() {
+			return true
+		}
+	}
+	return false
+}
+
+// watchMember removes the consumer group member when its subscription or session is closed
+func (s *topicStateMachine) watchMember(group *topicprotocolv1.ConsumerGroup, proposal statemachine.Proposal[*topicprotocolv1.SubscribeInput, *topicprotocolv1.SubscribeOutput]) {
+	proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			for i, member := range group.Members {
+				if statemachine.ProposalID(member.ProposalID) == proposal.ID() {
+					s.removeMember(group, i)
+					s.rebalance(group)
+					s.dispatch(group)
+					return
+				}
+			}
+		}
+	})
+}
+
+// removeMember removes a member from the consumer group, revoking the partitions assigned to it
+func (s *topicStateMachine) removeMember(group *topicprotocolv1.ConsumerGroup, index int) {
+	member := group.Members[index]
+	group.Members = append(group.Members[:index], group.Members[index+1:]...)
+	for _, partition := range group.Partitions {
+		if partition.Member == member.ProposalID {
+			revoke(partition)
+		}
+	}
+}
+
+// rebalance assigns the consumer group's partitions to its members so that each member is assigned
+// an equal share of the partitions, keeping partitions with the members to which they're assigned where possible
+func (s *topicStateMachine) rebalance(group *topicprotocolv1.ConsumerGroup) {
+	if len(group.Members) == 0 {
+		return
+	}
+
+	limits := make(map[uint64]int)
+	for i, member := range group.Members {
+		limit := len(group.Partitions) / len(group.Members)
+		if i < len(group.Partitions)%len(group.Members) {
+			limit++
+		}
+		limits[member.ProposalID] = limit
+	}
+
+	counts := make(map[uint64]int)
+	for _, partition := range group.Partitions {
+		if partition.Member == 0 {
+			continue
+		}
+		if counts[partition.Member] < limits[partition.Member] {
+			counts[partition.Member]++
+		} else {
+			revoke(partition)
+		}
+	}
+
+	for _, partition := range group.Partitions {
+		if partition.Member != 0 {
+			continue
+		}
+		for _, member := range group.Members {
+			if counts[member.ProposalID] < limits[member.ProposalID] {
+				partition.Member = member.ProposalID
+				counts[member.ProposalID]++
+				break
+			}
+		}
+	}
+}
+
+// dispatch delivers redelivered and undelivered messages in each partition of the consumer group
+// to the member to which the partition is assigned
+func (s *topicStateMachine) dispatch(group *topicprotocolv1.ConsumerGroup) {
+	first := s.nextOffset - uint64(len(s.messages))
+	for _, partition := range group.Partitions {
+		if partition.Member == 0 {
+			continue
+		}
+
+		member, ok := s.TopicContext.Subscribers().Get(statemachine.ProposalID(partition.Member))
+		if !ok {
+			// The member's subscription has been closed: reassign its partitions to the remaining members
+			for i, member := range group.Members {
+				if member.ProposalID == partition.Member {
+					s.removeMember(group, i)
+					break
+				}
+			}
+			revoke(partition)
+			s.rebalance(group)
+			s.dispatch(group)
+			return
+		}
+
+		for _, offset := range partition.Redeliver {
+			// Skip messages that have been discarded by the retention policy
+			if message, ok := s.message(offset); ok {
+				partition.Pending = append(partition.Pending, offset)
+				member.Output(&topicprotocolv1.SubscribeOutput{
+					Message: *message,
+				})
+			}
+		}
+		partition.Redeliver = nil
+
+		offset := partition.NextOffset
+		if offset < first {
+			offset = first
+		}
+		for ; offset < s.nextOffset; offset++ {
+			message := s.messages[offset-first]
+			if message.Partition == partition.Partition {
+				partition.Pending = append(partition.Pending, offset)
+				member.Output(&topicprotocolv1.SubscribeOutput{
+					Message: *message,
+				})
+			}
+		}
+		if partition.NextOffset < s.nextOffset {
+			partition.NextOffset = s.nextOffset
+		}
+	}
+}
+
+// trim discards the oldest messages until the topic is within its retention limits
+func (s *topicStateMachine) trim() {
+	var expired int
+	for _, message := range s.messages {
+		retained := uint64(len(s.messages) - expired)
+		if s.retention.MaxMessages > 0 && retained > s.retention.MaxMessages {
+			expired++
+		} else if s.retention.MaxBytes > 0 && s.size > s.retention.MaxBytes {
+			expired++
+		} else if s.retention.MaxAge > 0 && !message.Timestamp.Add(s.retention.MaxAge).After(s.Scheduler().Time()) {
+			expired++
+		} else {
+			break
+		}
+		s.size -= uint64(len(message.Payload))
+	}
+	if expired > 0 {
+		s.messages = s.messages[expired:]
+	}
+}
+
+// scheduleExpiration schedules a timer to trim the oldest message when it exceeds the retention age
+func (s *topicStateMachine) scheduleExpiration() {
+	if s.timer != nil {
+		s.timer()
+		s.timer = nil
+	}
+	if s.retention.MaxAge > 0 && len(s.messages) > 0 {
+		s.timer = s.Scheduler().Schedule(s.messages[0].Timestamp.Add(s.retention.MaxAge), func() {
+			s.timer = nil
+			s.trim()
+			s.scheduleExpiration()
+		})
+	}
+}
+
+// revoke unassigns the partition from its member, queueing the member's uncommitted messages for redelivery
+func revoke(partition *topicprotocolv1.ConsumerGroupPartition) {
+	partition.Redeliver = append(partition.Redeliver, partition.Pending...)
+	sort.Slice(partition.Redeliver, func(i, j int) bool {
+		return partition.Redeliver[i] < partition.Redeliver[j]
+	})
+	partition.Pending = nil
+	partition.Member = 0
+}
+
+func containsOffset(offsets []uint64, offset uint64) bool {
+	for _, o := range offsets {
+		if o == offset {
+			return true
+		}
+	}
+	return false
+}
+
+func removeOffset(offsets []uint64, offset uint64) []uint64 {
+	for i, o := range offsets {
+		if o == offset {
+			return append(offsets[:i], offsets[i+1:]...)
+		}
+	}
+	return offsets
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	treeprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/tree/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var treeCodec = statemachine.NewCodec[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput](
+	func(bytes []byte) (*treeprotocolv1.TreeInput, error) {
+		input := &treeprotocolv1.TreeInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *treeprotocolv1.TreeOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm TreeStateMachine) statemachine.PrimitiveStateMachine[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput] {
+	executor := &TreeExecutor{
+		TreeStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type TreeExecutor struct {
+	TreeStateMachine
+	createNode  statemachine.Proposer[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.CreateNodeInput, *treeprotocolv1.CreateNodeOutput]
+	setNode     statemachine.Proposer[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.SetNodeInput, *treeprotocolv1.SetNodeOutput]
+	deleteNode  statemachine.Proposer[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.DeleteNodeInput, *treeprotocolv1.DeleteNodeOutput]
+	getNode     statemachine.Querier[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.GetNodeInput, *treeprotocolv1.GetNodeOutput]
+	getChildren statemachine.Querier[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.GetChildrenInput, *treeprotocolv1.GetChildrenOutput]
+	watch       statemachine.Querier[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.WatchInput, *treeprotocolv1.WatchOutput]
+}
+
+func (s *TreeExecutor) init() {
+	s.createNode = statemachine.NewProposer[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.CreateNodeInput, *treeprotocolv1.CreateNodeOutput]("CreateNode").
+		Decoder(func(input *treeprotocolv1.TreeInput) (*treeprotocolv1.CreateNodeInput, bool) {
+			if createNode, ok := input.Input.(*treeprotocolv1.TreeInput_CreateNode); ok {
+				return createNode.CreateNode, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *treeprotocolv1.CreateNodeOutput) *treeprotocolv1.TreeOutput {
+			return &treeprotocolv1.TreeOutput{
+				Output: &treeprotocolv1.TreeOutput_CreateNode{
+					CreateNode: output,
+				},
+			}
+		}).
+		Build(s.CreateNode)
+	s.setNode = statemachine.NewProposer[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.SetNodeInput, *treeprotocolv1.SetNodeOutput]("SetNode").
+		Decoder(func(input *treeprotocolv1.TreeInput) (*treeprotocolv1.SetNodeInput, bool) {
+			if setNode, ok := input.Input.(*treeprotocolv1.TreeInput_SetNode); ok {
+				return setNode.SetNode, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *treeprotocolv1.SetNodeOutput) *treeprotocolv1.TreeOutput {
+			return &treeprotocolv1.TreeOutput{
+				Output: &treeprotocolv1.TreeOutput_SetNode{
+					SetNode: output,
+				},
+			}
+		}).
+		Build(s.SetNode)
+	s.deleteNode = statemachine.NewProposer[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.DeleteNodeInput, *treeprotocolv1.DeleteNodeOutput]("DeleteNode").
+		Decoder(func(input *treeprotocolv1.TreeInput) (*treeprotocolv1.DeleteNodeInput, bool) {
+			if deleteNode, ok := input.Input.(*treeprotocolv1.TreeInput_DeleteNode); ok {
+				return deleteNode.DeleteNode, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *treeprotocolv1.DeleteNodeOutput) *treeprotocolv1.TreeOutput {
+			return &treeprotocolv1.TreeOutput{
+				Output: &treeprotocolv1.TreeOutput_DeleteNode{
+					DeleteNode: output,
+				},
+			}
+		}).
+		Build(s.DeleteNode)
+	s.getNode = statemachine.NewQuerier[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.GetNodeInput, *treeprotocolv1.GetNodeOutput]("GetNode").
+		Decoder(func(input *treeprotocolv1.TreeInput) (*treeprotocolv1.GetNodeInput, bool) {
+			if getNode, ok := input.Input.(*treeprotocolv1.TreeInput_GetNode); ok {
+				return getNode.GetNode, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *treeprotocolv1.GetNodeOutput) *treeprotocolv1.TreeOutput {
+			return &treeprotocolv1.TreeOutput{
+				Output: &treeprotocolv1.TreeOutput_GetNode{
+					GetNode: output,
+				},
+			}
+		}).
+		Build(s.GetNode)
+	s.getChildren = statemachine.NewQuerier[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.GetChildrenInput, *treeprotocolv1.GetChildrenOutput]("GetChildren").
+		Decoder(func(input *treeprotocolv1.TreeInput) (*treeprotocolv1.GetChildrenInput, bool) {
+			if getChildren, ok := input.Input.(*treeprotocolv1.TreeInput_GetChildren); ok {
+				return getChildren.GetChildren, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *treeprotocolv1.GetChildrenOutput) *treeprotocolv1.TreeOutput {
+			return &treeprotocolv1.TreeOutput{
+				Output: &treeprotocolv1.TreeOutput_GetChildren{
+					GetChildren: output,
+				},
+			}
+		}).
+		Build(s.GetChildren)
+	s.watch = statemachine.NewQuerier[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput, *treeprotocolv1.WatchInput, *treeprotocolv1.WatchOutput]("Watch").
+		Decoder(func(input *treeprotocolv1.TreeInput) (*treeprotocolv1.WatchInput, bool) {
+			if watch, ok := input.Input.(*treeprotocolv1.TreeInput_Watch); ok {
+				return watch.Watch, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *treeprotocolv1.WatchOutput) *treeprotocolv1.TreeOutput {
+			return &treeprotocolv1.TreeOutput{
+				Output: &treeprotocolv1.TreeOutput_Watch{
+					Watch: output,
+				},
+			}
+		}).
+		Build(s.Watch)
+}
+
+func (s *TreeExecutor) Propose(proposal statemachine.Proposal[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *treeprotocolv1.TreeInput_CreateNode:
+		s.createNode(proposal)
+	case *treeprotocolv1.TreeInput_SetNode:
+		s.setNode(proposal)
+	case *treeprotocolv1.TreeInput_DeleteNode:
+		s.deleteNode(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *TreeExecutor) Query(query statemachine.Query[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput]) {
+	switch query.Input().Input.(type) {
+	case *treeprotocolv1.TreeInput_GetNode:
+		s.getNode(query)
+	case *treeprotocolv1.TreeInput_GetChildren:
+		s.getChildren(query)
+	case *treeprotocolv1.TreeInput_Watch:
+		s.watch(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
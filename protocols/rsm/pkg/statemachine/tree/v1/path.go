@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"strings"
+)
+
+const rootPath = "/"
+
+// validatePath returns an error if the given path is not an absolute, normalized path
+func validatePath(path string) error {
+	if !strings.HasPrefix(path, rootPath) {
+		return errors.NewInvalid("path '%s' must begin with '/'", path)
+	}
+	if path == rootPath {
+		return nil
+	}
+	for _, name := range strings.Split(path[1:], "/") {
+		if name == "" || name == "." || name == ".." {
+			return errors.NewInvalid("invalid path '%s'", path)
+		}
+	}
+	return nil
+}
+
+// parentPath returns the path of the parent of the node at the given path
+func parentPath(path string) string {
+	i := strings.LastIndex(path, "/")
+	if i <= 0 {
+		return rootPath
+	}
+	return path[:i]
+}
+
+// baseName returns the name of the node at the given path relative to its parent
+func baseName(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// joinPath returns the path of the child with the given name of the node at the given path
+func joinPath(path, name string) string {
+	if path == rootPath {
+		return rootPath + name
+	}
+	return path + "/" + name
+}
+
+// isDescendant returns whether the node at the given path is a descendant of the given ancestor
+func isDescendant(path, ancestor string) bool {
+	if ancestor == rootPath {
+		return path != rootPath
+	}
+	return strings.HasPrefix(path, ancestor+"/")
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPath(t *testing.T) {
+	assert.NoError(t, validatePath("/"))
+	assert.NoError(t, validatePath("/a"))
+	assert.NoError(t, validatePath("/a/b.c"))
+	assert.Error(t, validatePath(""))
+	assert.Error(t, validatePath("a/b"))
+	assert.Error(t, validatePath("/a/"))
+	assert.Error(t, validatePath("/a//b"))
+	assert.Error(t, validatePath("/a/../b"))
+
+	assert.Equal(t, "/", parentPath("/"))
+	assert.Equal(t, "/", parentPath("/a"))
+	assert.Equal(t, "/a", parentPath("/a/b"))
+	assert.Equal(t, "a", baseName("/a"))
+	assert.Equal(t, "b", baseName("/a/b"))
+	assert.Equal(t, "/a", joinPath("/", "a"))
+	assert.Equal(t, "/a/b", joinPath("/a", "b"))
+
+	assert.True(t, isDescendant("/a", "/"))
+	assert.True(t, isDescendant("/a/b/c", "/a"))
+	assert.False(t, isDescendant("/", "/"))
+	assert.False(t, isDescendant("/a", "/a"))
+	assert.False(t, isDescendant("/ab", "/a"))
+}
@@ -0,0 +1,421 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/atomix/atomix/api/errors"
+	treeprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/tree/v1"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "Tree"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput]) statemachine.PrimitiveStateMachine[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput] {
+			return newExecutor(NewTreeStateMachine(context))
+		}, treeCodec)
+}
+
+type TreeContext interface {
+	statemachine.PrimitiveContext[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput]
+}
+
+type TreeStateMachine interface {
+	statemachine.Context[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput]
+	statemachine.Recoverable
+	CreateNode(statemachine.Proposal[*treeprotocolv1.CreateNodeInput, *treeprotocolv1.CreateNodeOutput])
+	GetNode(statemachine.Query[*treeprotocolv1.GetNodeInput, *treeprotocolv1.GetNodeOutput])
+	SetNode(statemachine.Proposal[*treeprotocolv1.SetNodeInput, *treeprotocolv1.SetNodeOutput])
+	DeleteNode(statemachine.Proposal[*treeprotocolv1.DeleteNodeInput, *treeprotocolv1.DeleteNodeOutput])
+	GetChildren(statemachine.Query[*treeprotocolv1.GetChildrenInput, *treeprotocolv1.GetChildrenOutput])
+	Watch(statemachine.Query[*treeprotocolv1.WatchInput, *treeprotocolv1.WatchOutput])
+}
+
+func NewTreeStateMachine(context statemachine.PrimitiveContext[*treeprotocolv1.TreeInput, *treeprotocolv1.TreeOutput]) TreeStateMachine {
+	return &treeStateMachine{
+		TreeContext: context,
+		nodes:       newNodes(),
+		sessions:    make(map[statemachine.SessionID]statemachine.CancelFunc),
+		watchers:    make(map[statemachine.QueryID]statemachine.Query[*treeprotocolv1.WatchInput, *treeprotocolv1.WatchOutput]),
+	}
+}
+
+// newNodes returns a tree containing only the root node
+func newNodes() map[string]*treeprotocolv1.TreeNode {
+	return map[string]*treeprotocolv1.TreeNode{
+		rootPath: {
+			Path:    rootPath,
+			Version: 1,
+		},
+	}
+}
+
+type treeStateMachine struct {
+	TreeContext
+	nodes    map[string]*treeprotocolv1.TreeNode
+	sessions map[statemachine.SessionID]statemachine.CancelFunc
+	watchers map[statemachine.QueryID]statemachine.Query[*treeprotocolv1.WatchInput, *treeprotocolv1.WatchOutput]
+	mu       sync.RWMutex
+}
+
+func (s *treeStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	s.Log().Infow("Persisting Tree to snapshot")
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarInt(len(s.nodes)); err != nil {
+		return err
+	}
+	for _, node := range s.nodes {
+		if err := writer.WriteMessage(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *treeStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	s.Log().Infow("Recovering Tree from snapshot")
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		s.nodes = make(map[string]*treeprotocolv1.TreeNode)
+		for i := 0; i < n; i++ {
+			node := &treeprotocolv1.TreeNode{}
+			if err := reader.ReadMessage(node); err != nil {
+				return err
+			}
+			s.nodes[node.Path] = node
+			if node.SessionID != 0 {
+				if err := s.watchSession(statemachine.SessionID(node.SessionID)); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// watchSession deletes the ephemeral nodes owned by the given session when the session expires
+func (s *treeStateMachine) watchSession(sessionID statemachine.SessionID) error {
+	if _, ok := s.sessions[sessionID]; ok {
+		return nil
+	}
+	session, ok := s.Sessions().Get(sessionID)
+	if !ok {
+		return errors.NewFault("unknown session")
+	}
+	s.sessions[sessionID] = session.Watch(func(state statemachine.State) {
+		if state == statemachine.Closed {
+			delete(s.sessions, sessionID)
+			// Ephemeral nodes are deleted in path order so events are delivered in the same order on every replica
+			var paths []string
+			for path, node := range s.nodes {
+				if statemachine.SessionID(node.SessionID) == sessionID {
+					paths = append(paths, path)
+				}
+			}
+			sort.Strings(paths)
+			for _, path := range paths {
+				if node, ok := s.nodes[path]; ok {
+					s.delete(node, true)
+				}
+			}
+		}
+	})
+	return nil
+}
+
+// delete deletes the given node and its descendants, notifying watchers of each deleted node
+func (s *treeStateMachine) delete(node *treeprotocolv1.TreeNode, expired bool) {
+	// Children remove themselves from the node as they're deleted, so iterate over a copy
+	children := make([]string, len(node.Children))
+	copy(children, node.Children)
+	for _, name := range children {
+		if child, ok := s.nodes[joinPath(node.Path, name)]; ok {
+			s.delete(child, expired)
+		}
+	}
+	deleted := newNode(node)
+	delete(s.nodes, node.Path)
+	if parent, ok := s.nodes[parentPath(node.Path)]; ok {
+		removeChild(parent, baseName(node.Path))
+	}
+	s.notify(treeprotocolv1.Event{
+		Path: node.Path,
+		Event: &treeprotocolv1.Event_Deleted_{
+			Deleted: &treeprotocolv1.Event_Deleted{
+				Node:    deleted,
+				Expired: expired,
+			},
+		},
+	})
+}
+
+func (s *treeStateMachine) CreateNode(proposal statemachine.Proposal[*treeprotocolv1.CreateNodeInput, *treeprotocolv1.CreateNodeOutput]) {
+	defer proposal.Close()
+	path := proposal.Input().Path
+
+	// Sequential paths may end with a separator, in which case the sequence number is the node's name
+	validate := path
+	if proposal.Input().Sequential {
+		validate = path + "0"
+	}
+	if err := validatePath(validate); err != nil {
+		proposal.Error(err)
+		return
+	}
+
+	parent, ok := s.nodes[parentPath(validate)]
+	if !ok {
+		proposal.Error(errors.NewNotFound("parent of node '%s' not found", path))
+		return
+	}
+	if parent.SessionID != 0 {
+		proposal.Error(errors.NewInvalid("ephemeral node '%s' cannot have children", parent.Path))
+		return
+	}
+
+	if proposal.Input().Sequential {
+		path = fmt.Sprintf("%s%010d", path, parent.Sequence)
+		parent.Sequence++
+	}
+	if _, ok := s.nodes[path]; ok {
+		proposal.Error(errors.NewAlreadyExists("node '%s' already exists", path))
+		return
+	}
+
+	node := &treeprotocolv1.TreeNode{
+		Path:    path,
+		Value:   proposal.Input().Value,
+		Version: 1,
+	}
+	if proposal.Input().Ephemeral {
+		if err := s.watchSession(proposal.Session().ID()); err != nil {
+			proposal.Error(err)
+			return
+		}
+		node.SessionID = protocol.SessionID(proposal.Session().ID())
+	}
+	s.nodes[path] = node
+	addChild(parent, baseName(path))
+
+	s.notify(treeprotocolv1.Event{
+		Path: path,
+		Event: &treeprotocolv1.Event_Created_{
+			Created: &treeprotocolv1.Event_Created{
+				Node: newNode(node),
+			},
+		},
+	})
+	proposal.Output(&treeprotocolv1.CreateNodeOutput{
+		Node: newNode(node),
+	})
+}
+
+func (s *treeStateMachine) GetNode(query statemachine.Query[*treeprotocolv1.GetNodeInput, *treeprotocolv1.GetNodeOutput]) {
+	defer query.Close()
+	if err := validatePath(query.Input().Path); err != nil {
+		query.Error(err)
+		return
+	}
+	node, ok := s.nodes[query.Input().Path]
+	if !ok {
+		query.Error(errors.NewNotFound("node '%s' not found", query.Input().Path))
+		return
+	}
+	query.Output(&treeprotocolv1.GetNodeOutput{
+		Node: newNode(node),
+	})
+}
+
+func (s *treeStateMachine) SetNode(proposal statemachine.Proposal[*treeprotocolv1.SetNodeInput, *treeprotocolv1.SetNodeOutput]) {
+	defer proposal.Close()
+	path := proposal.Input().Path
+	if err := validatePath(path); err != nil {
+		proposal.Error(err)
+		return
+	}
+	node, ok := s.nodes[path]
+	if !ok {
+		proposal.Error(errors.NewNotFound("node '%s' not found", path))
+		return
+	}
+	if proposal.Input().PrevVersion != 0 && proposal.Input().PrevVersion != node.Version {
+		proposal.Error(errors.NewConflict("node '%s' version %d does not match expected version %d", path, node.Version, proposal.Input().PrevVersion))
+		return
+	}
+
+	prevNode := newNode(node)
+	node.Value = proposal.Input().Value
+	node.Version++
+
+	s.notify(treeprotocolv1.Event{
+		Path: path,
+		Event: &treeprotocolv1.Event_Updated_{
+			Updated: &treeprotocolv1.Event_Updated{
+				Node:     newNode(node),
+				PrevNode: prevNode,
+			},
+		},
+	})
+	proposal.Output(&treeprotocolv1.SetNodeOutput{
+		Node: newNode(node),
+	})
+}
+
+func (s *treeStateMachine) DeleteNode(proposal statemachine.Proposal[*treeprotocolv1.DeleteNodeInput, *treeprotocolv1.DeleteNodeOutput]) {
+	defer proposal.Close()
+	path := proposal.Input().Path
+	if err := validatePath(path); err != nil {
+		proposal.Error(err)
+		return
+	}
+	if path == rootPath {
+		proposal.Error(errors.NewInvalid("the root node cannot be deleted"))
+		return
+	}
+	node, ok := s.nodes[path]
+	if !ok {
+		proposal.Error(errors.NewNotFound("node '%s' not found", path))
+		return
+	}
+	if proposal.Input().PrevVersion != 0 && proposal.Input().PrevVersion != node.Version {
+		proposal.Error(errors.NewConflict("node '%s' version %d does not match expected version %d", path, node.Version, proposal.Input().PrevVersion))
+		return
+	}
+	if len(node.Children) > 0 && !proposal.Input().Recursive {
+		proposal.Error(errors.NewConflict("node '%s' has children", path))
+		return
+	}
+
+	deleted := newNode(node)
+	s.delete(node, false)
+	proposal.Output(&treeprotocolv1.DeleteNodeOutput{
+		Node: deleted,
+	})
+}
+
+func (s *treeStateMachine) GetChildren(query statemachine.Query[*treeprotocolv1.GetChildrenInput, *treeprotocolv1.GetChildrenOutput]) {
+	defer query.Close()
+	if err := validatePath(query.Input().Path); err != nil {
+		query.Error(err)
+		return
+	}
+	node, ok := s.nodes[query.Input().Path]
+	if !ok {
+		query.Error(errors.NewNotFound("node '%s' not found", query.Input().Path))
+		return
+	}
+	query.Output(&treeprotocolv1.GetChildrenOutput{
+		Children: node.Children,
+	})
+}
+
+func (s *treeStateMachine) Watch(query statemachine.Query[*treeprotocolv1.WatchInput, *treeprotocolv1.WatchOutput]) {
+	if err := validatePath(query.Input().Path); err != nil {
+		query.Error(err)
+		query.Close()
+		return
+	}
+
+	s.mu.Lock()
+	s.watchers[query.ID()] = query
+	s.mu.Unlock()
+
+	// Acknowledge the watch with an empty event once the watcher is registered
+	query.Output(&treeprotocolv1.WatchOutput{
+		Event: treeprotocolv1.Event{
+			Path: query.Input().Path,
+		},
+	})
+	query.Watch(func(state statemachine.QueryState) {
+		if state != statemachine.Running {
+			s.mu.Lock()
+			delete(s.watchers, query.ID())
+			s.mu.Unlock()
+		}
+	})
+}
+
+func (s *treeStateMachine) notify(event treeprotocolv1.Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, watcher := range s.watchers {
+		if watches(watcher.Input(), event.Path) {
+			watcher.Output(&treeprotocolv1.WatchOutput{
+				Event: event,
+			})
+		}
+	}
+}
+
+// watches returns whether a watch with the given input receives events for the node at the given path
+// A watch receives events for the watched node and its children, or for all its descendants if recursive.
+func watches(input *treeprotocolv1.WatchInput, path string) bool {
+	if path == input.Path {
+		return true
+	}
+	if input.Recursive {
+		return isDescendant(path, input.Path)
+	}
+	return path != rootPath && parentPath(path) == input.Path
+}
+
+func newNode(node *treeprotocolv1.TreeNode) treeprotocolv1.Node {
+	return treeprotocolv1.Node{
+		Path:        node.Path,
+		Value:       node.Value,
+		Version:     node.Version,
+		Ephemeral:   node.SessionID != 0,
+		NumChildren: uint32(len(node.Children)),
+	}
+}
+
+// addChild adds the given name to the sorted children of the node
+func addChild(node *treeprotocolv1.TreeNode, name string) {
+	n := sort.SearchStrings(node.Children, name)
+	if n < len(node.Children) && node.Children[n] == name {
+		return
+	}
+	node.Children = append(node.Children, "")
+	copy(node.Children[n+1:], node.Children[n:])
+	node.Children[n] = name
+}
+
+// removeChild removes the given name from the sorted children of the node
+func removeChild(node *treeprotocolv1.TreeNode, name string) {
+	n := sort.SearchStrings(node.Children, name)
+	if n < len(node.Children) && node.Children[n] == name {
+		node.Children = append(node.Children[:n], node.Children[n+1:]...)
+	}
+}
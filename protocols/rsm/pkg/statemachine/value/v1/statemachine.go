@@ -11,6 +11,7 @@ import (
 	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	valueprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/value/v1"
 	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+	"github.com/vpascoalr/atomix/runtime/pkg/utils/document"
 )
 
 const (
@@ -179,10 +180,16 @@ func (s *valueStateMachine) Recover(reader *statemachine.SnapshotReader) error {
 func (s *valueStateMachine) Set(proposal statemachine.Proposal[*valueprotocolv1.SetInput, *valueprotocolv1.SetOutput]) {
 	defer proposal.Close()
 
+	value, err := s.setValue(proposal.Input())
+	if err != nil {
+		proposal.Error(err)
+		return
+	}
+
 	oldValue := s.value
 	newValue := &valueprotocolv1.ValueState{
 		Value: &valueprotocolv1.IndexedValue{
-			Value: proposal.Input().Value,
+			Value: value,
 			Index: s.Index(),
 		},
 	}
@@ -204,6 +211,7 @@ func (s *valueStateMachine) Set(proposal statemachine.Proposal[*valueprotocolv1.
 						Value: *newValue.Value,
 					},
 				},
+				Patch: proposal.Input().Patch,
 			},
 		})
 		proposal.Output(&valueprotocolv1.SetOutput{
@@ -218,6 +226,7 @@ func (s *valueStateMachine) Set(proposal statemachine.Proposal[*valueprotocolv1.
 						PrevValue: *oldValue.Value,
 					},
 				},
+				Patch: proposal.Input().Patch,
 			},
 		})
 		proposal.Output(&valueprotocolv1.SetOutput{
@@ -227,6 +236,28 @@ func (s *valueStateMachine) Set(proposal statemachine.Proposal[*valueprotocolv1.
 	}
 }
 
+// setValue returns the value to be stored by the given set, applying the set's patch to the current value if set
+func (s *valueStateMachine) setValue(input *valueprotocolv1.SetInput) ([]byte, error) {
+	if input.Patch == nil {
+		return input.Value, nil
+	}
+	if len(input.Value) > 0 {
+		return nil, errors.NewInvalid("a value cannot be set with a patch")
+	}
+	var doc []byte
+	if s.value != nil {
+		doc = s.value.Value.Value
+	}
+	switch p := input.Patch.Patch.(type) {
+	case *valueprotocolv1.Patch_JsonPatch:
+		return document.Patch(doc, p.JsonPatch)
+	case *valueprotocolv1.Patch_MergePatch:
+		return document.MergePatch(doc, p.MergePatch)
+	default:
+		return nil, errors.NewInvalid("empty patch")
+	}
+}
+
 func (s *valueStateMachine) Insert(proposal statemachine.Proposal[*valueprotocolv1.InsertInput, *valueprotocolv1.InsertOutput]) {
 	defer proposal.Close()
 
@@ -360,11 +391,25 @@ func (s *valueStateMachine) Get(query statemachine.Query[*valueprotocolv1.GetInp
 	defer query.Close()
 	if s.value == nil {
 		query.Error(errors.NewNotFound("value not set"))
-	} else {
+		return
+	}
+	if len(query.Input().Paths) == 0 {
 		query.Output(&valueprotocolv1.GetOutput{
 			Value: s.value.Value,
 		})
+		return
 	}
+	projection, err := document.Project(s.value.Value.Value, query.Input().Paths)
+	if err != nil {
+		query.Error(err)
+		return
+	}
+	query.Output(&valueprotocolv1.GetOutput{
+		Value: &valueprotocolv1.IndexedValue{
+			Value: projection,
+			Index: s.value.Value.Index,
+		},
+	})
 }
 
 func (s *valueStateMachine) Watch(query statemachine.Query[*valueprotocolv1.WatchInput, *valueprotocolv1.WatchOutput]) {
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"github.com/atomix/atomix/api/errors"
+	"github.com/gogo/protobuf/proto"
+	workqueueprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/workqueue/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+var workQueueCodec = statemachine.NewCodec[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput](
+	func(bytes []byte) (*workqueueprotocolv1.WorkQueueInput, error) {
+		input := &workqueueprotocolv1.WorkQueueInput{}
+		if err := proto.Unmarshal(bytes, input); err != nil {
+			return nil, err
+		}
+		return input, nil
+	},
+	func(output *workqueueprotocolv1.WorkQueueOutput) ([]byte, error) {
+		return proto.Marshal(output)
+	})
+
+func newExecutor(sm WorkQueueStateMachine) statemachine.PrimitiveStateMachine[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput] {
+	executor := &WorkQueueExecutor{
+		WorkQueueStateMachine: sm,
+	}
+	executor.init()
+	return executor
+}
+
+type WorkQueueExecutor struct {
+	WorkQueueStateMachine
+	configure   statemachine.Proposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.ConfigureInput, *workqueueprotocolv1.ConfigureOutput]
+	enqueue     statemachine.Proposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.EnqueueInput, *workqueueprotocolv1.EnqueueOutput]
+	dequeue     statemachine.Proposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]
+	ack         statemachine.Proposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.AckInput, *workqueueprotocolv1.AckOutput]
+	nack        statemachine.Proposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.NackInput, *workqueueprotocolv1.NackOutput]
+	deadLetters statemachine.Querier[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.DeadLettersInput, *workqueueprotocolv1.DeadLettersOutput]
+}
+
+func (s *WorkQueueExecutor) init() {
+	s.configure = statemachine.NewProposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.ConfigureInput, *workqueueprotocolv1.ConfigureOutput]("Configure").
+		Decoder(func(input *workqueueprotocolv1.WorkQueueInput) (*workqueueprotocolv1.ConfigureInput, bool) {
+			if configure, ok := input.Input.(*workqueueprotocolv1.WorkQueueInput_Configure); ok {
+				return configure.Configure, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *workqueueprotocolv1.ConfigureOutput) *workqueueprotocolv1.WorkQueueOutput {
+			return &workqueueprotocolv1.WorkQueueOutput{
+				Output: &workqueueprotocolv1.WorkQueueOutput_Configure{
+					Configure: output,
+				},
+			}
+		}).
+		Build(s.Configure)
+	s.enqueue = statemachine.NewProposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.EnqueueInput, *workqueueprotocolv1.EnqueueOutput]("Enqueue").
+		Decoder(func(input *workqueueprotocolv1.WorkQueueInput) (*workqueueprotocolv1.EnqueueInput, bool) {
+			if enqueue, ok := input.Input.(*workqueueprotocolv1.WorkQueueInput_Enqueue); ok {
+				return enqueue.Enqueue, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *workqueueprotocolv1.EnqueueOutput) *workqueueprotocolv1.WorkQueueOutput {
+			return &workqueueprotocolv1.WorkQueueOutput{
+				Output: &workqueueprotocolv1.WorkQueueOutput_Enqueue{
+					Enqueue: output,
+				},
+			}
+		}).
+		Build(s.Enqueue)
+	s.dequeue = statemachine.NewProposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]("Dequeue").
+		Decoder(func(input *workqueueprotocolv1.WorkQueueInput) (*workqueueprotocolv1.DequeueInput, bool) {
+			if dequeue, ok := input.Input.(*workqueueprotocolv1.WorkQueueInput_Dequeue); ok {
+				return dequeue.Dequeue, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *workqueueprotocolv1.DequeueOutput) *workqueueprotocolv1.WorkQueueOutput {
+			return &workqueueprotocolv1.WorkQueueOutput{
+				Output: &workqueueprotocolv1.WorkQueueOutput_Dequeue{
+					Dequeue: output,
+				},
+			}
+		}).
+		Build(s.Dequeue)
+	s.ack = statemachine.NewProposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.AckInput, *workqueueprotocolv1.AckOutput]("Ack").
+		Decoder(func(input *workqueueprotocolv1.WorkQueueInput) (*workqueueprotocolv1.AckInput, bool) {
+			if ack, ok := input.Input.(*workqueueprotocolv1.WorkQueueInput_Ack); ok {
+				return ack.Ack, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *workqueueprotocolv1.AckOutput) *workqueueprotocolv1.WorkQueueOutput {
+			return &workqueueprotocolv1.WorkQueueOutput{
+				Output: &workqueueprotocolv1.WorkQueueOutput_Ack{
+					Ack: output,
+				},
+			}
+		}).
+		Build(s.Ack)
+	s.nack = statemachine.NewProposer[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.NackInput, *workqueueprotocolv1.NackOutput]("Nack").
+		Decoder(func(input *workqueueprotocolv1.WorkQueueInput) (*workqueueprotocolv1.NackInput, bool) {
+			if nack, ok := input.Input.(*workqueueprotocolv1.WorkQueueInput_Nack); ok {
+				return nack.Nack, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *workqueueprotocolv1.NackOutput) *workqueueprotocolv1.WorkQueueOutput {
+			return &workqueueprotocolv1.WorkQueueOutput{
+				Output: &workqueueprotocolv1.WorkQueueOutput_Nack{
+					Nack: output,
+				},
+			}
+		}).
+		Build(s.Nack)
+	s.deadLetters = statemachine.NewQuerier[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.DeadLettersInput, *workqueueprotocolv1.DeadLettersOutput]("DeadLetters").
+		Decoder(func(input *workqueueprotocolv1.WorkQueueInput) (*workqueueprotocolv1.DeadLettersInput, bool) {
+			if deadLetters, ok := input.Input.(*workqueueprotocolv1.WorkQueueInput_DeadLetters); ok {
+				return deadLetters.DeadLetters, true
+			}
+			return nil, false
+		}).
+		Encoder(func(output *workqueueprotocolv1.DeadLettersOutput) *workqueueprotocolv1.WorkQueueOutput {
+			return &workqueueprotocolv1.WorkQueueOutput{
+				Output: &workqueueprotocolv1.WorkQueueOutput_DeadLetters{
+					DeadLetters: output,
+				},
+			}
+		}).
+		Build(s.DeadLetters)
+}
+
+func (s *WorkQueueExecutor) Propose(proposal statemachine.Proposal[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]) {
+	switch proposal.Input().Input.(type) {
+	case *workqueueprotocolv1.WorkQueueInput_Configure:
+		s.configure(proposal)
+	case *workqueueprotocolv1.WorkQueueInput_Enqueue:
+		s.enqueue(proposal)
+	case *workqueueprotocolv1.WorkQueueInput_Dequeue:
+		s.dequeue(proposal)
+	case *workqueueprotocolv1.WorkQueueInput_Ack:
+		s.ack(proposal)
+	case *workqueueprotocolv1.WorkQueueInput_Nack:
+		s.nack(proposal)
+	default:
+		proposal.Error(errors.NewNotSupported("proposal not supported"))
+		proposal.Close()
+	}
+}
+
+func (s *WorkQueueExecutor) Query(query statemachine.Query[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]) {
+	switch query.Input().Input.(type) {
+	case *workqueueprotocolv1.WorkQueueInput_DeadLetters:
+		s.deadLetters(query)
+	default:
+		query.Error(errors.NewNotSupported("query not supported"))
+		query.Close()
+	}
+}
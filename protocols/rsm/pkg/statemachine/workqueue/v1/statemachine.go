@@ -0,0 +1,464 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"time"
+
+	"github.com/atomix/atomix/api/errors"
+	protocol "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	workqueueprotocolv1 "github.com/vpascoalr/atomix/protocols/rsm/api/workqueue/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+)
+
+const (
+	Name       = "WorkQueue"
+	APIVersion = "v1"
+)
+
+var PrimitiveType = protocol.PrimitiveType{
+	Name:       Name,
+	APIVersion: APIVersion,
+}
+
+const (
+	version1 uint32 = 1
+)
+
+const defaultLease = 30 * time.Second
+
+func RegisterStateMachine(registry *statemachine.PrimitiveTypeRegistry) {
+	statemachine.RegisterPrimitiveType[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput](registry)(PrimitiveType,
+		func(context statemachine.PrimitiveContext[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]) statemachine.PrimitiveStateMachine[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput] {
+			return newExecutor(NewWorkQueueStateMachine(context))
+		}, workQueueCodec)
+}
+
+type WorkQueueContext interface {
+	statemachine.PrimitiveContext[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]
+	Consumers() statemachine.Proposals[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]
+}
+
+func newContext(context statemachine.PrimitiveContext[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]) WorkQueueContext {
+	return &workQueueContext{
+		PrimitiveContext: context,
+		consumers: statemachine.NewProposals[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput, *workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput](context).
+			Decoder(func(input *workqueueprotocolv1.WorkQueueInput) (*workqueueprotocolv1.DequeueInput, bool) {
+				if dequeue, ok := input.Input.(*workqueueprotocolv1.WorkQueueInput_Dequeue); ok {
+					return dequeue.Dequeue, true
+				}
+				return nil, false
+			}).
+			Encoder(func(output *workqueueprotocolv1.DequeueOutput) *workqueueprotocolv1.WorkQueueOutput {
+				return &workqueueprotocolv1.WorkQueueOutput{
+					Output: &workqueueprotocolv1.WorkQueueOutput_Dequeue{
+						Dequeue: output,
+					},
+				}
+			}).
+			Build(),
+	}
+}
+
+type workQueueContext struct {
+	statemachine.PrimitiveContext[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]
+	consumers statemachine.Proposals[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]
+}
+
+func (c *workQueueContext) Consumers() statemachine.Proposals[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput] {
+	return c.consumers
+}
+
+type WorkQueueStateMachine interface {
+	statemachine.Context[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]
+	statemachine.Recoverable
+	Configure(statemachine.Proposal[*workqueueprotocolv1.ConfigureInput, *workqueueprotocolv1.ConfigureOutput])
+	Enqueue(statemachine.Proposal[*workqueueprotocolv1.EnqueueInput, *workqueueprotocolv1.EnqueueOutput])
+	Dequeue(statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput])
+	Ack(statemachine.Proposal[*workqueueprotocolv1.AckInput, *workqueueprotocolv1.AckOutput])
+	Nack(statemachine.Proposal[*workqueueprotocolv1.NackInput, *workqueueprotocolv1.NackOutput])
+	DeadLetters(statemachine.Query[*workqueueprotocolv1.DeadLettersInput, *workqueueprotocolv1.DeadLettersOutput])
+}
+
+func NewWorkQueueStateMachine(context statemachine.PrimitiveContext[*workqueueprotocolv1.WorkQueueInput, *workqueueprotocolv1.WorkQueueOutput]) WorkQueueStateMachine {
+	return &workQueueStateMachine{
+		WorkQueueContext: newContext(context),
+		leases:           make(map[uint64]*lease),
+		proposals:        make(map[statemachine.ProposalID]statemachine.CancelFunc),
+		timers:           make(map[statemachine.ProposalID]statemachine.CancelFunc),
+	}
+}
+
+type lease struct {
+	task       *workqueueprotocolv1.Task
+	sessionID  protocol.SessionID
+	expiration time.Time
+	timer      statemachine.CancelFunc
+}
+
+type workQueueStateMachine struct {
+	WorkQueueContext
+	maxDeliveries uint32
+	lastID        uint64
+	queue         []*workqueueprotocolv1.Task
+	leases        map[uint64]*lease
+	dead          []*workqueueprotocolv1.Task
+	consumers     []statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]
+	proposals     map[statemachine.ProposalID]statemachine.CancelFunc
+	timers        map[statemachine.ProposalID]statemachine.CancelFunc
+}
+
+func (s *workQueueStateMachine) Snapshot(writer *statemachine.SnapshotWriter) error {
+	if err := writer.WriteVarUint32(version1); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint32(s.maxDeliveries); err != nil {
+		return err
+	}
+	if err := writer.WriteVarUint64(s.lastID); err != nil {
+		return err
+	}
+
+	if err := writer.WriteVarInt(len(s.queue)); err != nil {
+		return err
+	}
+	for _, task := range s.queue {
+		if err := writer.WriteMessage(task); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.WriteVarInt(len(s.leases)); err != nil {
+		return err
+	}
+	for _, lease := range s.leases {
+		if err := writer.WriteMessage(&workqueueprotocolv1.Lease{
+			Task:       *lease.task,
+			Expiration: lease.expiration,
+			SessionID:  lease.sessionID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.WriteVarInt(len(s.dead)); err != nil {
+		return err
+	}
+	for _, task := range s.dead {
+		if err := writer.WriteMessage(task); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.WriteVarInt(len(s.consumers)); err != nil {
+		return err
+	}
+	for _, consumer := range s.consumers {
+		if err := writer.WriteVarUint64(uint64(consumer.ID())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *workQueueStateMachine) Recover(reader *statemachine.SnapshotReader) error {
+	version, err := reader.ReadVarUint32()
+	if err != nil {
+		return err
+	}
+	switch version {
+	case version1:
+		s.maxDeliveries, err = reader.ReadVarUint32()
+		if err != nil {
+			return err
+		}
+		s.lastID, err = reader.ReadVarUint64()
+		if err != nil {
+			return err
+		}
+
+		n, err := reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			task := &workqueueprotocolv1.Task{}
+			if err := reader.ReadMessage(task); err != nil {
+				return err
+			}
+			s.queue = append(s.queue, task)
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			lease := &workqueueprotocolv1.Lease{}
+			if err := reader.ReadMessage(lease); err != nil {
+				return err
+			}
+			s.lease(&lease.Task, lease.SessionID, lease.Expiration)
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			task := &workqueueprotocolv1.Task{}
+			if err := reader.ReadMessage(task); err != nil {
+				return err
+			}
+			s.dead = append(s.dead, task)
+		}
+
+		n, err = reader.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			proposalID, err := reader.ReadVarUint64()
+			if err != nil {
+				return err
+			}
+			proposal, ok := s.Consumers().Get(statemachine.ProposalID(proposalID))
+			if !ok {
+				return errors.NewFault("proposal not found")
+			}
+			s.enqueueConsumer(proposal)
+		}
+	default:
+		return errors.NewInvalid("unknown snapshot version %d", version)
+	}
+	return nil
+}
+
+// lease hides the task from consumers until the lease expires, after which the task is redelivered
+func (s *workQueueStateMachine) lease(task *workqueueprotocolv1.Task, sessionID protocol.SessionID, expiration time.Time) {
+	s.leases[task.ID] = &lease{
+		task:       task,
+		sessionID:  sessionID,
+		expiration: expiration,
+		timer: s.Scheduler().Schedule(expiration, func() {
+			delete(s.leases, task.ID)
+			s.requeue(task)
+		}),
+	}
+}
+
+// deliver leases the task to the given consumer
+// A task is delivered at most once per index, so the index identifies the delivery of the task.
+func (s *workQueueStateMachine) deliver(proposal statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput], task *workqueueprotocolv1.Task) {
+	duration := defaultLease
+	if proposal.Input().Lease != nil {
+		duration = *proposal.Input().Lease
+	}
+	task.Deliveries++
+	task.DeliveryID = uint64(s.Index())
+	task.SessionID = protocol.SessionID(proposal.Session().ID())
+	s.lease(task, task.SessionID, s.Scheduler().Time().Add(duration))
+	proposal.Output(&workqueueprotocolv1.DequeueOutput{
+		Task: *task,
+	})
+	proposal.Close()
+}
+
+// requeue returns the task to the queue, or moves it to the dead-letter queue once it has reached the delivery limit
+func (s *workQueueStateMachine) requeue(task *workqueueprotocolv1.Task) {
+	if s.maxDeliveries > 0 && task.Deliveries >= s.maxDeliveries {
+		s.dead = append(s.dead, task)
+		return
+	}
+	s.queue = append(s.queue, task)
+	s.nextConsumers()
+}
+
+// nextConsumers delivers queued tasks to waiting consumers in FIFO order
+func (s *workQueueStateMachine) nextConsumers() {
+	for len(s.consumers) > 0 && len(s.queue) > 0 {
+		var proposal statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]
+		var task *workqueueprotocolv1.Task
+		proposal, s.consumers = popFront(s.consumers)
+		task, s.queue = popFront(s.queue)
+		s.unwatchConsumer(proposal.ID())
+		s.deliver(proposal, task)
+	}
+}
+
+func (s *workQueueStateMachine) enqueueConsumer(proposal statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]) {
+	s.consumers = append(s.consumers, proposal)
+	s.proposals[proposal.ID()] = proposal.Watch(func(state statemachine.ProposalState) {
+		if state != statemachine.Running {
+			s.dequeueConsumer(proposal)
+		}
+	})
+
+	if proposal.Input().Timeout != nil {
+		s.timers[proposal.ID()] = s.Scheduler().Delay(*proposal.Input().Timeout, func() {
+			s.dequeueConsumer(proposal)
+			proposal.Error(errors.NewTimeout("no tasks available"))
+			proposal.Close()
+		})
+	}
+}
+
+func (s *workQueueStateMachine) dequeueConsumer(proposal statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]) {
+	s.unwatchConsumer(proposal.ID())
+	consumers := make([]statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput], 0, len(s.consumers))
+	for _, consumer := range s.consumers {
+		if consumer.ID() != proposal.ID() {
+			consumers = append(consumers, consumer)
+		}
+	}
+	s.consumers = consumers
+}
+
+func (s *workQueueStateMachine) unwatchConsumer(proposalID statemachine.ProposalID) {
+	if cancel, ok := s.proposals[proposalID]; ok {
+		cancel()
+		delete(s.proposals, proposalID)
+	}
+	if cancel, ok := s.timers[proposalID]; ok {
+		cancel()
+		delete(s.timers, proposalID)
+	}
+}
+
+func (s *workQueueStateMachine) Configure(proposal statemachine.Proposal[*workqueueprotocolv1.ConfigureInput, *workqueueprotocolv1.ConfigureOutput]) {
+	defer proposal.Close()
+	s.maxDeliveries = proposal.Input().MaxDeliveries
+	proposal.Output(&workqueueprotocolv1.ConfigureOutput{})
+}
+
+func (s *workQueueStateMachine) Enqueue(proposal statemachine.Proposal[*workqueueprotocolv1.EnqueueInput, *workqueueprotocolv1.EnqueueOutput]) {
+	defer proposal.Close()
+	s.lastID++
+	task := &workqueueprotocolv1.Task{
+		ID:      s.lastID,
+		Payload: proposal.Input().Payload,
+	}
+	s.queue = append(s.queue, task)
+	s.nextConsumers()
+	proposal.Output(&workqueueprotocolv1.EnqueueOutput{
+		TaskID: task.ID,
+	})
+}
+
+func (s *workQueueStateMachine) Dequeue(proposal statemachine.Proposal[*workqueueprotocolv1.DequeueInput, *workqueueprotocolv1.DequeueOutput]) {
+	if len(s.consumers) == 0 && len(s.queue) > 0 {
+		var task *workqueueprotocolv1.Task
+		task, s.queue = popFront(s.queue)
+		s.deliver(proposal, task)
+	} else {
+		s.enqueueConsumer(proposal)
+	}
+}
+
+func (s *workQueueStateMachine) Ack(proposal statemachine.Proposal[*workqueueprotocolv1.AckInput, *workqueueprotocolv1.AckOutput]) {
+	defer proposal.Close()
+	taskID := proposal.Input().TaskID
+	if lease, ok := s.leases[taskID]; ok {
+		if err := checkLease(lease, proposal.Session(), proposal.Input().DeliveryID); err != nil {
+			proposal.Error(err)
+			return
+		}
+		lease.timer()
+		delete(s.leases, taskID)
+		proposal.Output(&workqueueprotocolv1.AckOutput{})
+		return
+	}
+
+	// A task may be acknowledged by the consumer it was last delivered to after its lease expired,
+	// or discarded from the dead-letter queue, until it's delivered again
+	var ok bool
+	var err error
+	if s.queue, ok, err = removeTask(s.queue, taskID, proposal.Session(), proposal.Input().DeliveryID); ok {
+		proposal.Output(&workqueueprotocolv1.AckOutput{})
+		return
+	} else if err != nil {
+		proposal.Error(err)
+		return
+	}
+	if s.dead, ok, err = removeTask(s.dead, taskID, proposal.Session(), proposal.Input().DeliveryID); ok {
+		proposal.Output(&workqueueprotocolv1.AckOutput{})
+		return
+	} else if err != nil {
+		proposal.Error(err)
+		return
+	}
+	proposal.Error(errors.NewNotFound("task %d not found", taskID))
+}
+
+func (s *workQueueStateMachine) Nack(proposal statemachine.Proposal[*workqueueprotocolv1.NackInput, *workqueueprotocolv1.NackOutput]) {
+	defer proposal.Close()
+	taskID := proposal.Input().TaskID
+	lease, ok := s.leases[taskID]
+	if !ok {
+		proposal.Error(errors.NewNotFound("task %d is not leased", taskID))
+		return
+	}
+	if err := checkLease(lease, proposal.Session(), proposal.Input().DeliveryID); err != nil {
+		proposal.Error(err)
+		return
+	}
+	lease.timer()
+	delete(s.leases, taskID)
+	s.requeue(lease.task)
+	proposal.Output(&workqueueprotocolv1.NackOutput{})
+}
+
+func (s *workQueueStateMachine) DeadLetters(query statemachine.Query[*workqueueprotocolv1.DeadLettersInput, *workqueueprotocolv1.DeadLettersOutput]) {
+	defer query.Close()
+	for _, task := range s.dead {
+		query.Output(&workqueueprotocolv1.DeadLettersOutput{
+			Task: *task,
+		})
+	}
+}
+
+// checkLease checks that the leased task was delivered to the given session in the given delivery
+func checkLease(lease *lease, session statemachine.Session, deliveryID uint64) error {
+	if lease.sessionID != protocol.SessionID(session.ID()) {
+		return errors.NewConflict("task %d is leased to another consumer", lease.task.ID)
+	}
+	return checkDelivery(lease.task, session, deliveryID)
+}
+
+// checkDelivery checks that the given delivery is the most recent delivery of the task and was made to the given session
+func checkDelivery(task *workqueueprotocolv1.Task, session statemachine.Session, deliveryID uint64) error {
+	if task.SessionID != protocol.SessionID(session.ID()) {
+		return errors.NewConflict("task %d was delivered to another consumer", task.ID)
+	}
+	if task.DeliveryID != deliveryID {
+		return errors.NewConflict("delivery %d of task %d has been superseded by delivery %d", deliveryID, task.ID, task.DeliveryID)
+	}
+	return nil
+}
+
+// removeTask removes the given delivery of the task from the tasks
+func removeTask(tasks []*workqueueprotocolv1.Task, taskID uint64, session statemachine.Session, deliveryID uint64) ([]*workqueueprotocolv1.Task, bool, error) {
+	for i, task := range tasks {
+		if task.ID == taskID {
+			if err := checkDelivery(task, session, deliveryID); err != nil {
+				return tasks, false, err
+			}
+			n := copy(tasks[i:], tasks[i+1:])
+			tasks[i+n] = nil
+			return tasks[:i+n], true, nil
+		}
+	}
+	return tasks, false, nil
+}
+
+// popFront removes the first item, shifting the remaining items down so the backing array
+// does not keep removed items reachable
+func popFront[T any](items []T) (T, []T) {
+	item := items[0]
+	n := copy(items, items[1:])
+	var zero T
+	items[n] = zero
+	return item, items[:n]
+}
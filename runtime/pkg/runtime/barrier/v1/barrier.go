@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	barrierv1 "github.com/atomix/atomix/api/runtime/barrier/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type BarrierProxy interface {
+	runtime.PrimitiveProxy
+	// Await waits for all parties to arrive at the barrier
+	Await(context.Context, *barrierv1.AwaitRequest) (*barrierv1.AwaitResponse, error)
+	// Reset resets a broken barrier
+	Reset(context.Context, *barrierv1.ResetRequest) (*barrierv1.ResetResponse, error)
+	// GetBarrier gets the barrier state
+	GetBarrier(context.Context, *barrierv1.GetBarrierRequest) (*barrierv1.GetBarrierResponse, error)
+}
+
+func NewBarrierServer(rt *runtime.Runtime) barrierv1.BarrierServer {
+	return &barrierServer{
+		BarriersServer: NewBarriersServer(rt),
+		primitives:     runtime.NewPrimitiveRegistry[BarrierProxy](barrierv1.PrimitiveType, rt),
+	}
+}
+
+type barrierServer struct {
+	barrierv1.BarriersServer
+	primitives runtime.PrimitiveRegistry[BarrierProxy]
+}
+
+func (s *barrierServer) Await(ctx context.Context, request *barrierv1.AwaitRequest) (*barrierv1.AwaitResponse, error) {
+	log.Debugw("Await",
+		logging.Trunc64("AwaitRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc64("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Await(ctx, request)
+	if err != nil {
+		log.Debugw("Await",
+			logging.Trunc64("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Await",
+		logging.Trunc64("AwaitResponse", response))
+	return response, nil
+}
+
+func (s *barrierServer) Reset(ctx context.Context, request *barrierv1.ResetRequest) (*barrierv1.ResetResponse, error) {
+	log.Debugw("Reset",
+		logging.Trunc64("ResetRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Reset",
+			logging.Trunc64("ResetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Reset(ctx, request)
+	if err != nil {
+		log.Debugw("Reset",
+			logging.Trunc64("ResetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Reset",
+		logging.Trunc64("ResetResponse", response))
+	return response, nil
+}
+
+func (s *barrierServer) GetBarrier(ctx context.Context, request *barrierv1.GetBarrierRequest) (*barrierv1.GetBarrierResponse, error) {
+	log.Debugw("GetBarrier",
+		logging.Trunc64("GetBarrierRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetBarrier",
+			logging.Trunc64("GetBarrierRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetBarrier(ctx, request)
+	if err != nil {
+		log.Debugw("GetBarrier",
+			logging.Trunc64("GetBarrierRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetBarrier",
+		logging.Trunc64("GetBarrierResponse", response))
+	return response, nil
+}
+
+var _ barrierv1.BarrierServer = (*barrierServer)(nil)
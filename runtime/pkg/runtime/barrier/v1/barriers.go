@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	barrierv1 "github.com/atomix/atomix/api/runtime/barrier/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type BarrierProvider interface {
+	NewBarrierV1(ctx context.Context, id runtimev1.PrimitiveID) (BarrierProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *barrierv1.Config) (BarrierProxy, bool, error) {
+	if provider, ok := conn.(BarrierProvider); ok {
+		barrier, err := provider.NewBarrierV1(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		return barrier, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewBarriersServer(rt *runtime.Runtime) barrierv1.BarriersServer {
+	return &barriersServer{
+		manager: runtime.NewPrimitiveManager[BarrierProxy, *barrierv1.Config](barrierv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type barriersServer struct {
+	manager runtime.PrimitiveManager[*barrierv1.Config]
+}
+
+func (s *barriersServer) Create(ctx context.Context, request *barrierv1.CreateRequest) (*barrierv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *barriersServer) Close(ctx context.Context, request *barrierv1.CloseRequest) (*barrierv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &barrierv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ barrierv1.BarriersServer = (*barriersServer)(nil)
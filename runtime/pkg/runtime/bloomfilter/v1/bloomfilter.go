@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	bloomfilterv1 "github.com/atomix/atomix/api/runtime/bloomfilter/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type BloomFilterProxy interface {
+	runtime.PrimitiveProxy
+	// Add adds an element to the filter
+	Add(context.Context, *bloomfilterv1.AddRequest) (*bloomfilterv1.AddResponse, error)
+	// MightContain checks whether an element might have been added to the filter
+	MightContain(context.Context, *bloomfilterv1.MightContainRequest) (*bloomfilterv1.MightContainResponse, error)
+}
+
+func NewBloomFilterServer(rt *runtime.Runtime) bloomfilterv1.BloomFilterServer {
+	return &bloomFilterServer{
+		BloomFiltersServer: NewBloomFiltersServer(rt),
+		primitives:         runtime.NewPrimitiveRegistry[BloomFilterProxy](bloomfilterv1.PrimitiveType, rt),
+	}
+}
+
+type bloomFilterServer struct {
+	bloomfilterv1.BloomFiltersServer
+	primitives runtime.PrimitiveRegistry[BloomFilterProxy]
+}
+
+func (s *bloomFilterServer) Add(ctx context.Context, request *bloomfilterv1.AddRequest) (*bloomfilterv1.AddResponse, error) {
+	log.Debugw("Add",
+		logging.Trunc64("AddRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Add",
+			logging.Trunc64("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Add(ctx, request)
+	if err != nil {
+		log.Debugw("Add",
+			logging.Trunc64("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Add",
+		logging.Trunc64("AddResponse", response))
+	return response, nil
+}
+
+func (s *bloomFilterServer) MightContain(ctx context.Context, request *bloomfilterv1.MightContainRequest) (*bloomfilterv1.MightContainResponse, error) {
+	log.Debugw("MightContain",
+		logging.Trunc64("MightContainRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("MightContain",
+			logging.Trunc64("MightContainRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.MightContain(ctx, request)
+	if err != nil {
+		log.Debugw("MightContain",
+			logging.Trunc64("MightContainRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("MightContain",
+		logging.Trunc64("MightContainResponse", response))
+	return response, nil
+}
+
+var _ bloomfilterv1.BloomFilterServer = (*bloomFilterServer)(nil)
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	bloomfilterv1 "github.com/atomix/atomix/api/runtime/bloomfilter/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type BloomFilterProvider interface {
+	NewBloomFilterV1(ctx context.Context, id runtimev1.PrimitiveID, config *bloomfilterv1.Config) (BloomFilterProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *bloomfilterv1.Config) (BloomFilterProxy, bool, error) {
+	if provider, ok := conn.(BloomFilterProvider); ok {
+		bloomFilter, err := provider.NewBloomFilterV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return bloomFilter, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewBloomFiltersServer(rt *runtime.Runtime) bloomfilterv1.BloomFiltersServer {
+	return &bloomFiltersServer{
+		manager: runtime.NewPrimitiveManager[BloomFilterProxy, *bloomfilterv1.Config](bloomfilterv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type bloomFiltersServer struct {
+	manager runtime.PrimitiveManager[*bloomfilterv1.Config]
+}
+
+func (s *bloomFiltersServer) Create(ctx context.Context, request *bloomfilterv1.CreateRequest) (*bloomfilterv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &bloomfilterv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *bloomFiltersServer) Close(ctx context.Context, request *bloomfilterv1.CloseRequest) (*bloomfilterv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &bloomfilterv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ bloomfilterv1.BloomFiltersServer = (*bloomFiltersServer)(nil)
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	cardinalityv1 "github.com/atomix/atomix/api/runtime/cardinality/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type CardinalityProvider interface {
+	NewCardinalityV1(ctx context.Context, id runtimev1.PrimitiveID, config *cardinalityv1.Config) (CardinalityProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *cardinalityv1.Config) (CardinalityProxy, bool, error) {
+	if provider, ok := conn.(CardinalityProvider); ok {
+		cardinality, err := provider.NewCardinalityV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return cardinality, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewCardinalitiesServer(rt *runtime.Runtime) cardinalityv1.CardinalitiesServer {
+	return &cardinalitiesServer{
+		manager: runtime.NewPrimitiveManager[CardinalityProxy, *cardinalityv1.Config](cardinalityv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type cardinalitiesServer struct {
+	manager runtime.PrimitiveManager[*cardinalityv1.Config]
+}
+
+func (s *cardinalitiesServer) Create(ctx context.Context, request *cardinalityv1.CreateRequest) (*cardinalityv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *cardinalitiesServer) Close(ctx context.Context, request *cardinalityv1.CloseRequest) (*cardinalityv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &cardinalityv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ cardinalityv1.CardinalitiesServer = (*cardinalitiesServer)(nil)
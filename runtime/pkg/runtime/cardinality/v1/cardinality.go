@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	cardinalityv1 "github.com/atomix/atomix/api/runtime/cardinality/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type CardinalityProxy interface {
+	runtime.PrimitiveProxy
+	// Add adds elements to the estimator
+	Add(context.Context, *cardinalityv1.AddRequest) (*cardinalityv1.AddResponse, error)
+	// Count estimates the number of distinct elements added to the estimator
+	Count(context.Context, *cardinalityv1.CountRequest) (*cardinalityv1.CountResponse, error)
+	// Merge merges a sketch into the estimator
+	Merge(context.Context, *cardinalityv1.MergeRequest) (*cardinalityv1.MergeResponse, error)
+	// GetSketch gets the sketch of the estimator
+	GetSketch(context.Context, *cardinalityv1.GetSketchRequest) (*cardinalityv1.GetSketchResponse, error)
+}
+
+func NewCardinalityServer(rt *runtime.Runtime) cardinalityv1.CardinalityServer {
+	return &cardinalityServer{
+		CardinalitiesServer: NewCardinalitiesServer(rt),
+		primitives:          runtime.NewPrimitiveRegistry[CardinalityProxy](cardinalityv1.PrimitiveType, rt),
+	}
+}
+
+type cardinalityServer struct {
+	cardinalityv1.CardinalitiesServer
+	primitives runtime.PrimitiveRegistry[CardinalityProxy]
+}
+
+func (s *cardinalityServer) Add(ctx context.Context, request *cardinalityv1.AddRequest) (*cardinalityv1.AddResponse, error) {
+	log.Debugw("Add",
+		logging.Trunc64("AddRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Add",
+			logging.Trunc64("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Add(ctx, request)
+	if err != nil {
+		log.Debugw("Add",
+			logging.Trunc64("AddRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Add",
+		logging.Trunc64("AddResponse", response))
+	return response, nil
+}
+
+func (s *cardinalityServer) Count(ctx context.Context, request *cardinalityv1.CountRequest) (*cardinalityv1.CountResponse, error) {
+	log.Debugw("Count",
+		logging.Trunc64("CountRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Count",
+			logging.Trunc64("CountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Count(ctx, request)
+	if err != nil {
+		log.Debugw("Count",
+			logging.Trunc64("CountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Count",
+		logging.Trunc64("CountResponse", response))
+	return response, nil
+}
+
+func (s *cardinalityServer) Merge(ctx context.Context, request *cardinalityv1.MergeRequest) (*cardinalityv1.MergeResponse, error) {
+	log.Debugw("Merge",
+		logging.Trunc64("MergeRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Merge",
+			logging.Trunc64("MergeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Merge(ctx, request)
+	if err != nil {
+		log.Debugw("Merge",
+			logging.Trunc64("MergeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Merge",
+		logging.Trunc64("MergeResponse", response))
+	return response, nil
+}
+
+func (s *cardinalityServer) GetSketch(ctx context.Context, request *cardinalityv1.GetSketchRequest) (*cardinalityv1.GetSketchResponse, error) {
+	log.Debugw("GetSketch",
+		logging.Trunc64("GetSketchRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetSketch",
+			logging.Trunc64("GetSketchRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetSketch(ctx, request)
+	if err != nil {
+		log.Debugw("GetSketch",
+			logging.Trunc64("GetSketchRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetSketch",
+		logging.Trunc64("GetSketchResponse", response))
+	return response, nil
+}
+
+var _ cardinalityv1.CardinalityServer = (*cardinalityServer)(nil)
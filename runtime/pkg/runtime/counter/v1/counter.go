@@ -26,6 +26,8 @@ type CounterProxy interface {
 	Increment(context.Context, *counterv1.IncrementRequest) (*counterv1.IncrementResponse, error)
 	// Decrement decrements the counter value
 	Decrement(context.Context, *counterv1.DecrementRequest) (*counterv1.DecrementResponse, error)
+	// SetBounds sets the lower and upper bounds of the counter value
+	SetBounds(context.Context, *counterv1.SetBoundsRequest) (*counterv1.SetBoundsResponse, error)
 }
 
 func NewCounterServer(rt *runtime.Runtime) counterv1.CounterServer {
@@ -150,4 +152,26 @@ func (s *counterServer) Decrement(ctx context.Context, request *counterv1.Decrem
 	return response, nil
 }
 
+func (s *counterServer) SetBounds(ctx context.Context, request *counterv1.SetBoundsRequest) (*counterv1.SetBoundsResponse, error) {
+	log.Debugw("SetBounds",
+		logging.Trunc64("SetBoundsRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc64("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.SetBounds(ctx, request)
+	if err != nil {
+		log.Debugw("SetBounds",
+			logging.Trunc64("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("SetBounds",
+		logging.Trunc64("SetBoundsResponse", response))
+	return response, nil
+}
+
 var _ counterv1.CounterServer = (*counterServer)(nil)
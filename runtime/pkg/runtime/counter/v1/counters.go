@@ -15,12 +15,12 @@ import (
 )
 
 type CounterProvider interface {
-	NewCounterV1(ctx context.Context, id runtimev1.PrimitiveID) (CounterProxy, error)
+	NewCounterV1(ctx context.Context, id runtimev1.PrimitiveID, config *counterv1.Config) (CounterProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (CounterProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *counterv1.Config) (CounterProxy, bool, error) {
 	if provider, ok := conn.(CounterProvider); ok {
-		counter, err := provider.NewCounterV1(ctx, id)
+		counter, err := provider.NewCounterV1(ctx, id, config)
 		if err != nil {
 			return nil, false, err
 		}
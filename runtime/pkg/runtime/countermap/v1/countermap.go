@@ -28,8 +28,16 @@ type CounterMapProxy interface {
 	Increment(context.Context, *countermapv1.IncrementRequest) (*countermapv1.IncrementResponse, error)
 	// Decrement decrements a counter in the map
 	Decrement(context.Context, *countermapv1.DecrementRequest) (*countermapv1.DecrementResponse, error)
+	// SetBounds sets the lower and upper bounds of a counter in the map
+	SetBounds(context.Context, *countermapv1.SetBoundsRequest) (*countermapv1.SetBoundsResponse, error)
 	// Get gets the entry for a key
 	Get(context.Context, *countermapv1.GetRequest) (*countermapv1.GetResponse, error)
+	// TopK gets the k entries with the highest values in descending order of value
+	TopK(context.Context, *countermapv1.TopKRequest) (*countermapv1.TopKResponse, error)
+	// BottomK gets the k entries with the lowest values in ascending order of value
+	BottomK(context.Context, *countermapv1.BottomKRequest) (*countermapv1.BottomKResponse, error)
+	// RangeByValue gets the entries with values within a range in ascending order of value
+	RangeByValue(context.Context, *countermapv1.RangeByValueRequest) (*countermapv1.RangeByValueResponse, error)
 	// Remove removes an entry from the map
 	Remove(context.Context, *countermapv1.RemoveRequest) (*countermapv1.RemoveResponse, error)
 	// Clear removes all entries from the map
@@ -144,6 +152,28 @@ func (s *counterMapServer) Decrement(ctx context.Context, request *countermapv1.
 	return response, nil
 }
 
+func (s *counterMapServer) SetBounds(ctx context.Context, request *countermapv1.SetBoundsRequest) (*countermapv1.SetBoundsResponse, error) {
+	log.Debugw("SetBounds",
+		logging.Trunc64("SetBoundsRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("SetBounds",
+			logging.Trunc64("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.SetBounds(ctx, request)
+	if err != nil {
+		log.Debugw("SetBounds",
+			logging.Trunc64("SetBoundsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("SetBounds",
+		logging.Trunc64("SetBoundsResponse", response))
+	return response, nil
+}
+
 func (s *counterMapServer) Insert(ctx context.Context, request *countermapv1.InsertRequest) (*countermapv1.InsertResponse, error) {
 	log.Debugw("Insert",
 		logging.Trunc64("InsertRequest", request))
@@ -210,6 +240,72 @@ func (s *counterMapServer) Get(ctx context.Context, request *countermapv1.GetReq
 	return response, nil
 }
 
+func (s *counterMapServer) TopK(ctx context.Context, request *countermapv1.TopKRequest) (*countermapv1.TopKResponse, error) {
+	log.Debugw("TopK",
+		logging.Trunc64("TopKRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("TopK",
+			logging.Trunc64("TopKRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.TopK(ctx, request)
+	if err != nil {
+		log.Debugw("TopK",
+			logging.Trunc64("TopKRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("TopK",
+		logging.Trunc64("TopKResponse", response))
+	return response, nil
+}
+
+func (s *counterMapServer) BottomK(ctx context.Context, request *countermapv1.BottomKRequest) (*countermapv1.BottomKResponse, error) {
+	log.Debugw("BottomK",
+		logging.Trunc64("BottomKRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("BottomK",
+			logging.Trunc64("BottomKRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.BottomK(ctx, request)
+	if err != nil {
+		log.Debugw("BottomK",
+			logging.Trunc64("BottomKRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("BottomK",
+		logging.Trunc64("BottomKResponse", response))
+	return response, nil
+}
+
+func (s *counterMapServer) RangeByValue(ctx context.Context, request *countermapv1.RangeByValueRequest) (*countermapv1.RangeByValueResponse, error) {
+	log.Debugw("RangeByValue",
+		logging.Trunc64("RangeByValueRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("RangeByValue",
+			logging.Trunc64("RangeByValueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.RangeByValue(ctx, request)
+	if err != nil {
+		log.Debugw("RangeByValue",
+			logging.Trunc64("RangeByValueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("RangeByValue",
+		logging.Trunc64("RangeByValueResponse", response))
+	return response, nil
+}
+
 func (s *counterMapServer) Remove(ctx context.Context, request *countermapv1.RemoveRequest) (*countermapv1.RemoveResponse, error) {
 	log.Debugw("Remove",
 		logging.Trunc64("RemoveRequest", request))
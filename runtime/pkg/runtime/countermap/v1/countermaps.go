@@ -15,12 +15,12 @@ import (
 )
 
 type CounterMapProvider interface {
-	NewCounterMapV1(ctx context.Context, id runtimev1.PrimitiveID) (CounterMapProxy, error)
+	NewCounterMapV1(ctx context.Context, id runtimev1.PrimitiveID, config *countermapv1.Config) (CounterMapProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (CounterMapProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *countermapv1.Config) (CounterMapProxy, bool, error) {
 	if provider, ok := conn.(CounterMapProvider); ok {
-		counterMap, err := provider.NewCounterMapV1(ctx, id)
+		counterMap, err := provider.NewCounterMapV1(ctx, id, config)
 		if err != nil {
 			return nil, false, err
 		}
@@ -18,7 +18,7 @@ type LeaderElectionProvider interface {
 	NewLeaderElectionV1(ctx context.Context, id runtimev1.PrimitiveID) (LeaderElectionProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (LeaderElectionProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *electionv1.Config) (LeaderElectionProxy, bool, error) {
 	if provider, ok := conn.(LeaderElectionProvider); ok {
 		counter, err := provider.NewLeaderElectionV1(ctx, id)
 		if err != nil {
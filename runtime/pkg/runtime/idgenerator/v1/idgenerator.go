@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	idgeneratorv1 "github.com/atomix/atomix/api/runtime/idgenerator/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type IDGeneratorProxy interface {
+	runtime.PrimitiveProxy
+	// NextID gets the next unique ID
+	NextID(context.Context, *idgeneratorv1.NextIDRequest) (*idgeneratorv1.NextIDResponse, error)
+}
+
+func NewIDGeneratorServer(rt *runtime.Runtime) idgeneratorv1.IDGeneratorServer {
+	return &idGeneratorServer{
+		IDGeneratorsServer: NewIDGeneratorsServer(rt),
+		primitives:         runtime.NewPrimitiveRegistry[IDGeneratorProxy](idgeneratorv1.PrimitiveType, rt),
+	}
+}
+
+type idGeneratorServer struct {
+	idgeneratorv1.IDGeneratorsServer
+	primitives runtime.PrimitiveRegistry[IDGeneratorProxy]
+}
+
+func (s *idGeneratorServer) NextID(ctx context.Context, request *idgeneratorv1.NextIDRequest) (*idgeneratorv1.NextIDResponse, error) {
+	log.Debugw("NextID",
+		logging.Trunc64("NextIDRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("NextID",
+			logging.Trunc64("NextIDRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.NextID(ctx, request)
+	if err != nil {
+		log.Debugw("NextID",
+			logging.Trunc64("NextIDRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("NextID",
+		logging.Trunc64("NextIDResponse", response))
+	return response, nil
+}
+
+var _ idgeneratorv1.IDGeneratorServer = (*idGeneratorServer)(nil)
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	idgeneratorv1 "github.com/atomix/atomix/api/runtime/idgenerator/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type IDGeneratorProvider interface {
+	NewIDGeneratorV1(ctx context.Context, id runtimev1.PrimitiveID, config *idgeneratorv1.Config) (IDGeneratorProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *idgeneratorv1.Config) (IDGeneratorProxy, bool, error) {
+	if provider, ok := conn.(IDGeneratorProvider); ok {
+		idGenerator, err := provider.NewIDGeneratorV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return idGenerator, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewIDGeneratorsServer(rt *runtime.Runtime) idgeneratorv1.IDGeneratorsServer {
+	return &idGeneratorsServer{
+		manager: runtime.NewPrimitiveManager[IDGeneratorProxy, *idgeneratorv1.Config](idgeneratorv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type idGeneratorsServer struct {
+	manager runtime.PrimitiveManager[*idgeneratorv1.Config]
+}
+
+func (s *idGeneratorsServer) Create(ctx context.Context, request *idgeneratorv1.CreateRequest) (*idgeneratorv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &idgeneratorv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *idGeneratorsServer) Close(ctx context.Context, request *idgeneratorv1.CloseRequest) (*idgeneratorv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &idgeneratorv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ idgeneratorv1.IDGeneratorsServer = (*idGeneratorsServer)(nil)
@@ -18,7 +18,7 @@ type IndexedMapProvider interface {
 	NewIndexedMapV1(ctx context.Context, id runtimev1.PrimitiveID) (IndexedMapProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (IndexedMapProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *indexedmapv1.Config) (IndexedMapProxy, bool, error) {
 	if provider, ok := conn.(IndexedMapProvider); ok {
 		indexedMap, err := provider.NewIndexedMapV1(ctx, id)
 		if err != nil {
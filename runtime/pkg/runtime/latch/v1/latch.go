@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	latchv1 "github.com/atomix/atomix/api/runtime/latch/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type LatchProxy interface {
+	runtime.PrimitiveProxy
+	// CountDown decrements the latch count
+	CountDown(context.Context, *latchv1.CountDownRequest) (*latchv1.CountDownResponse, error)
+	// Await waits for the latch count to reach zero
+	Await(context.Context, *latchv1.AwaitRequest) (*latchv1.AwaitResponse, error)
+	// GetCount gets the current latch count
+	GetCount(context.Context, *latchv1.GetCountRequest) (*latchv1.GetCountResponse, error)
+}
+
+func NewLatchServer(rt *runtime.Runtime) latchv1.LatchServer {
+	return &latchServer{
+		LatchesServer: NewLatchesServer(rt),
+		primitives:    runtime.NewPrimitiveRegistry[LatchProxy](latchv1.PrimitiveType, rt),
+	}
+}
+
+type latchServer struct {
+	latchv1.LatchesServer
+	primitives runtime.PrimitiveRegistry[LatchProxy]
+}
+
+func (s *latchServer) CountDown(ctx context.Context, request *latchv1.CountDownRequest) (*latchv1.CountDownResponse, error) {
+	log.Debugw("CountDown",
+		logging.Trunc64("CountDownRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("CountDown",
+			logging.Trunc64("CountDownRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.CountDown(ctx, request)
+	if err != nil {
+		log.Debugw("CountDown",
+			logging.Trunc64("CountDownRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("CountDown",
+		logging.Trunc64("CountDownResponse", response))
+	return response, nil
+}
+
+func (s *latchServer) Await(ctx context.Context, request *latchv1.AwaitRequest) (*latchv1.AwaitResponse, error) {
+	log.Debugw("Await",
+		logging.Trunc64("AwaitRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Await",
+			logging.Trunc64("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Await(ctx, request)
+	if err != nil {
+		log.Debugw("Await",
+			logging.Trunc64("AwaitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Await",
+		logging.Trunc64("AwaitResponse", response))
+	return response, nil
+}
+
+func (s *latchServer) GetCount(ctx context.Context, request *latchv1.GetCountRequest) (*latchv1.GetCountResponse, error) {
+	log.Debugw("GetCount",
+		logging.Trunc64("GetCountRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetCount",
+			logging.Trunc64("GetCountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetCount(ctx, request)
+	if err != nil {
+		log.Debugw("GetCount",
+			logging.Trunc64("GetCountRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetCount",
+		logging.Trunc64("GetCountResponse", response))
+	return response, nil
+}
+
+var _ latchv1.LatchServer = (*latchServer)(nil)
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	latchv1 "github.com/atomix/atomix/api/runtime/latch/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type LatchProvider interface {
+	NewLatchV1(ctx context.Context, id runtimev1.PrimitiveID, config *latchv1.Config) (LatchProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *latchv1.Config) (LatchProxy, bool, error) {
+	if provider, ok := conn.(LatchProvider); ok {
+		latch, err := provider.NewLatchV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return latch, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewLatchesServer(rt *runtime.Runtime) latchv1.LatchesServer {
+	return &latchesServer{
+		manager: runtime.NewPrimitiveManager[LatchProxy, *latchv1.Config](latchv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type latchesServer struct {
+	manager runtime.PrimitiveManager[*latchv1.Config]
+}
+
+func (s *latchesServer) Create(ctx context.Context, request *latchv1.CreateRequest) (*latchv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *latchesServer) Close(ctx context.Context, request *latchv1.CloseRequest) (*latchv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &latchv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ latchv1.LatchesServer = (*latchesServer)(nil)
@@ -18,7 +18,7 @@ type ListProvider interface {
 	NewListV1(ctx context.Context, id runtimev1.PrimitiveID) (ListProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (ListProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *listv1.Config) (ListProxy, bool, error) {
 	if provider, ok := conn.(ListProvider); ok {
 		list, err := provider.NewListV1(ctx, id)
 		if err != nil {
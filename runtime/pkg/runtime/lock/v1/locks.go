@@ -18,7 +18,7 @@ type LockProvider interface {
 	NewLockV1(ctx context.Context, id runtimev1.PrimitiveID) (LockProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (LockProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *lockv1.Config) (LockProxy, bool, error) {
 	if provider, ok := conn.(LockProvider); ok {
 		lock, err := provider.NewLockV1(ctx, id)
 		if err != nil {
@@ -28,6 +28,12 @@ type MapProxy interface {
 	Get(context.Context, *mapv1.GetRequest) (*mapv1.GetResponse, error)
 	// Remove removes an entry from the map
 	Remove(context.Context, *mapv1.RemoveRequest) (*mapv1.RemoveResponse, error)
+	// GetAll gets the entries for a set of keys
+	GetAll(context.Context, *mapv1.GetAllRequest) (*mapv1.GetAllResponse, error)
+	// PutAll puts a set of entries into the map
+	PutAll(context.Context, *mapv1.PutAllRequest) (*mapv1.PutAllResponse, error)
+	// RemoveAll removes a set of keys from the map
+	RemoveAll(context.Context, *mapv1.RemoveAllRequest) (*mapv1.RemoveAllResponse, error)
 	// Clear removes all entries from the map
 	Clear(context.Context, *mapv1.ClearRequest) (*mapv1.ClearResponse, error)
 	// Lock locks a key in the map
@@ -40,6 +46,8 @@ type MapProxy interface {
 	Entries(*mapv1.EntriesRequest, mapv1.Map_EntriesServer) error
 	// Commit commits a transactional change to the map
 	Commit(context.Context, *mapv1.CommitRequest) (*mapv1.CommitResponse, error)
+	// Query gets the entries whose values match a query on a secondary index
+	Query(context.Context, *mapv1.QueryRequest) (*mapv1.QueryResponse, error)
 }
 
 func NewMapServer(rt *runtime.Runtime) mapv1.MapServer {
@@ -186,6 +194,72 @@ func (s *mapServer) Remove(ctx context.Context, request *mapv1.RemoveRequest) (*
 	return response, nil
 }
 
+func (s *mapServer) GetAll(ctx context.Context, request *mapv1.GetAllRequest) (*mapv1.GetAllResponse, error) {
+	log.Debugw("GetAll",
+		logging.Trunc64("GetAllRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetAll",
+			logging.Trunc64("GetAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetAll(ctx, request)
+	if err != nil {
+		log.Debugw("GetAll",
+			logging.Trunc64("GetAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetAll",
+		logging.Trunc64("GetAllResponse", response))
+	return response, nil
+}
+
+func (s *mapServer) PutAll(ctx context.Context, request *mapv1.PutAllRequest) (*mapv1.PutAllResponse, error) {
+	log.Debugw("PutAll",
+		logging.Trunc64("PutAllRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("PutAll",
+			logging.Trunc64("PutAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.PutAll(ctx, request)
+	if err != nil {
+		log.Debugw("PutAll",
+			logging.Trunc64("PutAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("PutAll",
+		logging.Trunc64("PutAllResponse", response))
+	return response, nil
+}
+
+func (s *mapServer) RemoveAll(ctx context.Context, request *mapv1.RemoveAllRequest) (*mapv1.RemoveAllResponse, error) {
+	log.Debugw("RemoveAll",
+		logging.Trunc64("RemoveAllRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("RemoveAll",
+			logging.Trunc64("RemoveAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.RemoveAll(ctx, request)
+	if err != nil {
+		log.Debugw("RemoveAll",
+			logging.Trunc64("RemoveAllRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("RemoveAll",
+		logging.Trunc64("RemoveAllResponse", response))
+	return response, nil
+}
+
 func (s *mapServer) Clear(ctx context.Context, request *mapv1.ClearRequest) (*mapv1.ClearResponse, error) {
 	log.Debugw("Clear",
 		logging.Trunc64("ClearRequest", request))
@@ -274,6 +348,28 @@ func (s *mapServer) Commit(ctx context.Context, request *mapv1.CommitRequest) (*
 	return response, nil
 }
 
+func (s *mapServer) Query(ctx context.Context, request *mapv1.QueryRequest) (*mapv1.QueryResponse, error) {
+	log.Debugw("Query",
+		logging.Trunc64("QueryRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Query",
+			logging.Trunc64("QueryRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Query(ctx, request)
+	if err != nil {
+		log.Debugw("Query",
+			logging.Trunc64("QueryRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Query",
+		logging.Trunc64("QueryResponse", response))
+	return response, nil
+}
+
 func (s *mapServer) Events(request *mapv1.EventsRequest, server mapv1.Map_EventsServer) error {
 	log.Debugw("Events",
 		logging.Trunc64("EventsRequest", request),
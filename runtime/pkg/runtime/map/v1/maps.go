@@ -15,12 +15,12 @@ import (
 )
 
 type MapProvider interface {
-	NewMapV1(ctx context.Context, id runtimev1.PrimitiveID) (MapProxy, error)
+	NewMapV1(ctx context.Context, id runtimev1.PrimitiveID, config *mapv1.Config) (MapProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (MapProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *mapv1.Config) (MapProxy, bool, error) {
 	if provider, ok := conn.(MapProvider); ok {
-		_map, err := provider.NewMapV1(ctx, id)
+		_map, err := provider.NewMapV1(ctx, id, config)
 		if err != nil {
 			return nil, false, err
 		}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	membershipv1 "github.com/atomix/atomix/api/runtime/membership/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type MembershipProxy interface {
+	runtime.PrimitiveProxy
+	// Join adds a member to the group or updates its metadata
+	Join(context.Context, *membershipv1.JoinRequest) (*membershipv1.JoinResponse, error)
+	// Leave removes a member from the group
+	Leave(context.Context, *membershipv1.LeaveRequest) (*membershipv1.LeaveResponse, error)
+	// List lists the members of the group
+	List(context.Context, *membershipv1.ListRequest) (*membershipv1.ListResponse, error)
+	// Watch watches the group for membership changes
+	Watch(*membershipv1.WatchRequest, membershipv1.Membership_WatchServer) error
+}
+
+func NewMembershipServer(rt *runtime.Runtime) membershipv1.MembershipServer {
+	return &membershipServer{
+		MembershipsServer: NewMembershipsServer(rt),
+		primitives:        runtime.NewPrimitiveRegistry[MembershipProxy](membershipv1.PrimitiveType, rt),
+	}
+}
+
+type membershipServer struct {
+	membershipv1.MembershipsServer
+	primitives runtime.PrimitiveRegistry[MembershipProxy]
+}
+
+func (s *membershipServer) Join(ctx context.Context, request *membershipv1.JoinRequest) (*membershipv1.JoinResponse, error) {
+	log.Debugw("Join",
+		logging.Trunc64("JoinRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc64("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Join(ctx, request)
+	if err != nil {
+		log.Debugw("Join",
+			logging.Trunc64("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Join",
+		logging.Trunc64("JoinResponse", response))
+	return response, nil
+}
+
+func (s *membershipServer) Leave(ctx context.Context, request *membershipv1.LeaveRequest) (*membershipv1.LeaveResponse, error) {
+	log.Debugw("Leave",
+		logging.Trunc64("LeaveRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc64("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Leave(ctx, request)
+	if err != nil {
+		log.Debugw("Leave",
+			logging.Trunc64("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Leave",
+		logging.Trunc64("LeaveResponse", response))
+	return response, nil
+}
+
+func (s *membershipServer) List(ctx context.Context, request *membershipv1.ListRequest) (*membershipv1.ListResponse, error) {
+	log.Debugw("List",
+		logging.Trunc64("ListRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc64("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.List(ctx, request)
+	if err != nil {
+		log.Debugw("List",
+			logging.Trunc64("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("List",
+		logging.Trunc64("ListResponse", response))
+	return response, nil
+}
+
+func (s *membershipServer) Watch(request *membershipv1.WatchRequest, server membershipv1.Membership_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc64("WatchRequest", request),
+		logging.String("State", "started"))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc64("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	err = client.Watch(request, server)
+	if err != nil {
+		log.Debugw("Watch",
+			logging.Trunc64("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+var _ membershipv1.MembershipServer = (*membershipServer)(nil)
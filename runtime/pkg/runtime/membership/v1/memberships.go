@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	membershipv1 "github.com/atomix/atomix/api/runtime/membership/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type MembershipProvider interface {
+	NewMembershipV1(ctx context.Context, id runtimev1.PrimitiveID) (MembershipProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *membershipv1.Config) (MembershipProxy, bool, error) {
+	if provider, ok := conn.(MembershipProvider); ok {
+		membership, err := provider.NewMembershipV1(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		return membership, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewMembershipsServer(rt *runtime.Runtime) membershipv1.MembershipsServer {
+	return &membershipsServer{
+		manager: runtime.NewPrimitiveManager[MembershipProxy, *membershipv1.Config](membershipv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type membershipsServer struct {
+	manager runtime.PrimitiveManager[*membershipv1.Config]
+}
+
+func (s *membershipsServer) Create(ctx context.Context, request *membershipv1.CreateRequest) (*membershipv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &membershipv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *membershipsServer) Close(ctx context.Context, request *membershipv1.CloseRequest) (*membershipv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &membershipv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ membershipv1.MembershipsServer = (*membershipsServer)(nil)
@@ -18,7 +18,7 @@ type MultiMapProvider interface {
 	NewMultiMapV1(ctx context.Context, id runtimev1.PrimitiveID) (MultiMapProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (MultiMapProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *multimapv1.Config) (MultiMapProxy, bool, error) {
 	if provider, ok := conn.(MultiMapProvider); ok {
 		multiMap, err := provider.NewMultiMapV1(ctx, id)
 		if err != nil {
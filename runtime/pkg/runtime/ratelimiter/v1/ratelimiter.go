@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	ratelimiterv1 "github.com/atomix/atomix/api/runtime/ratelimiter/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type RateLimiterProxy interface {
+	runtime.PrimitiveProxy
+	// TryAcquire attempts to acquire tokens from a bucket without waiting
+	TryAcquire(context.Context, *ratelimiterv1.TryAcquireRequest) (*ratelimiterv1.TryAcquireResponse, error)
+	// Acquire acquires tokens from a bucket, waiting until the tokens are available
+	Acquire(context.Context, *ratelimiterv1.AcquireRequest) (*ratelimiterv1.AcquireResponse, error)
+	// SetLimit sets the limit of a bucket
+	SetLimit(context.Context, *ratelimiterv1.SetLimitRequest) (*ratelimiterv1.SetLimitResponse, error)
+	// GetBucket gets the state of a bucket
+	GetBucket(context.Context, *ratelimiterv1.GetBucketRequest) (*ratelimiterv1.GetBucketResponse, error)
+}
+
+func NewRateLimiterServer(rt *runtime.Runtime) ratelimiterv1.RateLimiterServer {
+	return &rateLimiterServer{
+		RateLimitersServer: NewRateLimitersServer(rt),
+		primitives:         runtime.NewPrimitiveRegistry[RateLimiterProxy](ratelimiterv1.PrimitiveType, rt),
+	}
+}
+
+type rateLimiterServer struct {
+	ratelimiterv1.RateLimitersServer
+	primitives runtime.PrimitiveRegistry[RateLimiterProxy]
+}
+
+func (s *rateLimiterServer) TryAcquire(ctx context.Context, request *ratelimiterv1.TryAcquireRequest) (*ratelimiterv1.TryAcquireResponse, error) {
+	log.Debugw("TryAcquire",
+		logging.Trunc64("TryAcquireRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("TryAcquire",
+			logging.Trunc64("TryAcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.TryAcquire(ctx, request)
+	if err != nil {
+		log.Debugw("TryAcquire",
+			logging.Trunc64("TryAcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("TryAcquire",
+		logging.Trunc64("TryAcquireResponse", response))
+	return response, nil
+}
+
+func (s *rateLimiterServer) Acquire(ctx context.Context, request *ratelimiterv1.AcquireRequest) (*ratelimiterv1.AcquireResponse, error) {
+	log.Debugw("Acquire",
+		logging.Trunc64("AcquireRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc64("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Acquire(ctx, request)
+	if err != nil {
+		log.Debugw("Acquire",
+			logging.Trunc64("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Acquire",
+		logging.Trunc64("AcquireResponse", response))
+	return response, nil
+}
+
+func (s *rateLimiterServer) SetLimit(ctx context.Context, request *ratelimiterv1.SetLimitRequest) (*ratelimiterv1.SetLimitResponse, error) {
+	log.Debugw("SetLimit",
+		logging.Trunc64("SetLimitRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("SetLimit",
+			logging.Trunc64("SetLimitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.SetLimit(ctx, request)
+	if err != nil {
+		log.Debugw("SetLimit",
+			logging.Trunc64("SetLimitRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("SetLimit",
+		logging.Trunc64("SetLimitResponse", response))
+	return response, nil
+}
+
+func (s *rateLimiterServer) GetBucket(ctx context.Context, request *ratelimiterv1.GetBucketRequest) (*ratelimiterv1.GetBucketResponse, error) {
+	log.Debugw("GetBucket",
+		logging.Trunc64("GetBucketRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetBucket",
+			logging.Trunc64("GetBucketRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetBucket(ctx, request)
+	if err != nil {
+		log.Debugw("GetBucket",
+			logging.Trunc64("GetBucketRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetBucket",
+		logging.Trunc64("GetBucketResponse", response))
+	return response, nil
+}
+
+var _ ratelimiterv1.RateLimiterServer = (*rateLimiterServer)(nil)
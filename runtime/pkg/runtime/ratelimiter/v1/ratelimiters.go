@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	ratelimiterv1 "github.com/atomix/atomix/api/runtime/ratelimiter/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type RateLimiterProvider interface {
+	NewRateLimiterV1(ctx context.Context, id runtimev1.PrimitiveID, config *ratelimiterv1.Config) (RateLimiterProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *ratelimiterv1.Config) (RateLimiterProxy, bool, error) {
+	if provider, ok := conn.(RateLimiterProvider); ok {
+		rateLimiter, err := provider.NewRateLimiterV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return rateLimiter, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewRateLimitersServer(rt *runtime.Runtime) ratelimiterv1.RateLimitersServer {
+	return &rateLimitersServer{
+		manager: runtime.NewPrimitiveManager[RateLimiterProxy, *ratelimiterv1.Config](ratelimiterv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type rateLimitersServer struct {
+	manager runtime.PrimitiveManager[*ratelimiterv1.Config]
+}
+
+func (s *rateLimitersServer) Create(ctx context.Context, request *ratelimiterv1.CreateRequest) (*ratelimiterv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *rateLimitersServer) Close(ctx context.Context, request *ratelimiterv1.CloseRequest) (*ratelimiterv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &ratelimiterv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ ratelimiterv1.RateLimitersServer = (*rateLimitersServer)(nil)
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	rwlockv1 "github.com/atomix/atomix/api/runtime/rwlock/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type RWLockProxy interface {
+	runtime.PrimitiveProxy
+	// Lock attempts to acquire the write lock
+	Lock(context.Context, *rwlockv1.LockRequest) (*rwlockv1.LockResponse, error)
+	// Unlock releases the write lock
+	Unlock(context.Context, *rwlockv1.UnlockRequest) (*rwlockv1.UnlockResponse, error)
+	// RLock attempts to acquire a read lock
+	RLock(context.Context, *rwlockv1.RLockRequest) (*rwlockv1.RLockResponse, error)
+	// RUnlock releases a read lock
+	RUnlock(context.Context, *rwlockv1.RUnlockRequest) (*rwlockv1.RUnlockResponse, error)
+	// GetLock gets the lock state
+	GetLock(context.Context, *rwlockv1.GetLockRequest) (*rwlockv1.GetLockResponse, error)
+}
+
+func NewRWLockServer(rt *runtime.Runtime) rwlockv1.RWLockServer {
+	return &rwLockServer{
+		RWLocksServer: NewRWLocksServer(rt),
+		primitives:    runtime.NewPrimitiveRegistry[RWLockProxy](rwlockv1.PrimitiveType, rt),
+	}
+}
+
+type rwLockServer struct {
+	rwlockv1.RWLocksServer
+	primitives runtime.PrimitiveRegistry[RWLockProxy]
+}
+
+func (s *rwLockServer) Lock(ctx context.Context, request *rwlockv1.LockRequest) (*rwlockv1.LockResponse, error) {
+	log.Debugw("Lock",
+		logging.Trunc64("LockRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Lock",
+			logging.Trunc64("LockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Lock(ctx, request)
+	if err != nil {
+		log.Debugw("Lock",
+			logging.Trunc64("LockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Lock",
+		logging.Trunc64("LockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) Unlock(ctx context.Context, request *rwlockv1.UnlockRequest) (*rwlockv1.UnlockResponse, error) {
+	log.Debugw("Unlock",
+		logging.Trunc64("UnlockRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Unlock",
+			logging.Trunc64("UnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Unlock(ctx, request)
+	if err != nil {
+		log.Debugw("Unlock",
+			logging.Trunc64("UnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Unlock",
+		logging.Trunc64("UnlockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) RLock(ctx context.Context, request *rwlockv1.RLockRequest) (*rwlockv1.RLockResponse, error) {
+	log.Debugw("RLock",
+		logging.Trunc64("RLockRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("RLock",
+			logging.Trunc64("RLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.RLock(ctx, request)
+	if err != nil {
+		log.Debugw("RLock",
+			logging.Trunc64("RLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("RLock",
+		logging.Trunc64("RLockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) RUnlock(ctx context.Context, request *rwlockv1.RUnlockRequest) (*rwlockv1.RUnlockResponse, error) {
+	log.Debugw("RUnlock",
+		logging.Trunc64("RUnlockRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("RUnlock",
+			logging.Trunc64("RUnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.RUnlock(ctx, request)
+	if err != nil {
+		log.Debugw("RUnlock",
+			logging.Trunc64("RUnlockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("RUnlock",
+		logging.Trunc64("RUnlockResponse", response))
+	return response, nil
+}
+
+func (s *rwLockServer) GetLock(ctx context.Context, request *rwlockv1.GetLockRequest) (*rwlockv1.GetLockResponse, error) {
+	log.Debugw("GetLock",
+		logging.Trunc64("GetLockRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetLock",
+			logging.Trunc64("GetLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetLock(ctx, request)
+	if err != nil {
+		log.Debugw("GetLock",
+			logging.Trunc64("GetLockRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetLock",
+		logging.Trunc64("GetLockResponse", response))
+	return response, nil
+}
+
+var _ rwlockv1.RWLockServer = (*rwLockServer)(nil)
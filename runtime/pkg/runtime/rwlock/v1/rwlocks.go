@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	rwlockv1 "github.com/atomix/atomix/api/runtime/rwlock/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type RWLockProvider interface {
+	NewRWLockV1(ctx context.Context, id runtimev1.PrimitiveID, config *rwlockv1.Config) (RWLockProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *rwlockv1.Config) (RWLockProxy, bool, error) {
+	if provider, ok := conn.(RWLockProvider); ok {
+		rwLock, err := provider.NewRWLockV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return rwLock, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewRWLocksServer(rt *runtime.Runtime) rwlockv1.RWLocksServer {
+	return &rwLocksServer{
+		manager: runtime.NewPrimitiveManager[RWLockProxy, *rwlockv1.Config](rwlockv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type rwLocksServer struct {
+	manager runtime.PrimitiveManager[*rwlockv1.Config]
+}
+
+func (s *rwLocksServer) Create(ctx context.Context, request *rwlockv1.CreateRequest) (*rwlockv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *rwLocksServer) Close(ctx context.Context, request *rwlockv1.CloseRequest) (*rwlockv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &rwlockv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ rwlockv1.RWLocksServer = (*rwLocksServer)(nil)
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	schedulerv1 "github.com/atomix/atomix/api/runtime/scheduler/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type SchedulerProxy interface {
+	runtime.PrimitiveProxy
+	// Schedule schedules a named timer
+	Schedule(context.Context, *schedulerv1.ScheduleRequest) (*schedulerv1.ScheduleResponse, error)
+	// Cancel cancels a named timer
+	Cancel(context.Context, *schedulerv1.CancelRequest) (*schedulerv1.CancelResponse, error)
+	// List lists the scheduled timers
+	List(context.Context, *schedulerv1.ListRequest) (*schedulerv1.ListResponse, error)
+	// Subscribe subscribes a worker to receive timer firings
+	Subscribe(*schedulerv1.SubscribeRequest, schedulerv1.Scheduler_SubscribeServer) error
+	// Ack acknowledges a timer firing
+	Ack(context.Context, *schedulerv1.AckRequest) (*schedulerv1.AckResponse, error)
+}
+
+func NewSchedulerServer(rt *runtime.Runtime) schedulerv1.SchedulerServer {
+	return &schedulerServer{
+		SchedulersServer: NewSchedulersServer(rt),
+		primitives:       runtime.NewPrimitiveRegistry[SchedulerProxy](schedulerv1.PrimitiveType, rt),
+	}
+}
+
+type schedulerServer struct {
+	schedulerv1.SchedulersServer
+	primitives runtime.PrimitiveRegistry[SchedulerProxy]
+}
+
+func (s *schedulerServer) Schedule(ctx context.Context, request *schedulerv1.ScheduleRequest) (*schedulerv1.ScheduleResponse, error) {
+	log.Debugw("Schedule",
+		logging.Trunc64("ScheduleRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Schedule",
+			logging.Trunc64("ScheduleRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Schedule(ctx, request)
+	if err != nil {
+		log.Debugw("Schedule",
+			logging.Trunc64("ScheduleRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Schedule",
+		logging.Trunc64("ScheduleResponse", response))
+	return response, nil
+}
+
+func (s *schedulerServer) Cancel(ctx context.Context, request *schedulerv1.CancelRequest) (*schedulerv1.CancelResponse, error) {
+	log.Debugw("Cancel",
+		logging.Trunc64("CancelRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Cancel",
+			logging.Trunc64("CancelRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Cancel(ctx, request)
+	if err != nil {
+		log.Debugw("Cancel",
+			logging.Trunc64("CancelRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Cancel",
+		logging.Trunc64("CancelResponse", response))
+	return response, nil
+}
+
+func (s *schedulerServer) List(ctx context.Context, request *schedulerv1.ListRequest) (*schedulerv1.ListResponse, error) {
+	log.Debugw("List",
+		logging.Trunc64("ListRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("List",
+			logging.Trunc64("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.List(ctx, request)
+	if err != nil {
+		log.Debugw("List",
+			logging.Trunc64("ListRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("List",
+		logging.Trunc64("ListResponse", response))
+	return response, nil
+}
+
+func (s *schedulerServer) Subscribe(request *schedulerv1.SubscribeRequest, server schedulerv1.Scheduler_SubscribeServer) error {
+	log.Debugw("Subscribe",
+		logging.Trunc64("SubscribeRequest", request),
+		logging.String("State", "started"))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Subscribe",
+			logging.Trunc64("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	err = client.Subscribe(request, server)
+	if err != nil {
+		log.Debugw("Subscribe",
+			logging.Trunc64("SubscribeRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+func (s *schedulerServer) Ack(ctx context.Context, request *schedulerv1.AckRequest) (*schedulerv1.AckResponse, error) {
+	log.Debugw("Ack",
+		logging.Trunc64("AckRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc64("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Ack(ctx, request)
+	if err != nil {
+		log.Debugw("Ack",
+			logging.Trunc64("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Ack",
+		logging.Trunc64("AckResponse", response))
+	return response, nil
+}
+
+var _ schedulerv1.SchedulerServer = (*schedulerServer)(nil)
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	schedulerv1 "github.com/atomix/atomix/api/runtime/scheduler/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type SchedulerProvider interface {
+	NewSchedulerV1(ctx context.Context, id runtimev1.PrimitiveID, config *schedulerv1.Config) (SchedulerProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *schedulerv1.Config) (SchedulerProxy, bool, error) {
+	if provider, ok := conn.(SchedulerProvider); ok {
+		scheduler, err := provider.NewSchedulerV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return scheduler, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewSchedulersServer(rt *runtime.Runtime) schedulerv1.SchedulersServer {
+	return &schedulersServer{
+		manager: runtime.NewPrimitiveManager[SchedulerProxy, *schedulerv1.Config](schedulerv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type schedulersServer struct {
+	manager runtime.PrimitiveManager[*schedulerv1.Config]
+}
+
+func (s *schedulersServer) Create(ctx context.Context, request *schedulerv1.CreateRequest) (*schedulerv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *schedulersServer) Close(ctx context.Context, request *schedulerv1.CloseRequest) (*schedulerv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &schedulerv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ schedulerv1.SchedulersServer = (*schedulersServer)(nil)
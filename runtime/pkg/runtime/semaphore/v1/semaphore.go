@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	semaphorev1 "github.com/atomix/atomix/api/runtime/semaphore/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type SemaphoreProxy interface {
+	runtime.PrimitiveProxy
+	// Acquire attempts to acquire permits from the semaphore
+	Acquire(context.Context, *semaphorev1.AcquireRequest) (*semaphorev1.AcquireResponse, error)
+	// Release releases permits to the semaphore
+	Release(context.Context, *semaphorev1.ReleaseRequest) (*semaphorev1.ReleaseResponse, error)
+	// GetSemaphore gets the semaphore state
+	GetSemaphore(context.Context, *semaphorev1.GetSemaphoreRequest) (*semaphorev1.GetSemaphoreResponse, error)
+}
+
+func NewSemaphoreServer(rt *runtime.Runtime) semaphorev1.SemaphoreServer {
+	return &semaphoreServer{
+		SemaphoresServer: NewSemaphoresServer(rt),
+		primitives:       runtime.NewPrimitiveRegistry[SemaphoreProxy](semaphorev1.PrimitiveType, rt),
+	}
+}
+
+type semaphoreServer struct {
+	semaphorev1.SemaphoresServer
+	primitives runtime.PrimitiveRegistry[SemaphoreProxy]
+}
+
+func (s *semaphoreServer) Acquire(ctx context.Context, request *semaphorev1.AcquireRequest) (*semaphorev1.AcquireResponse, error) {
+	log.Debugw("Acquire",
+		logging.Trunc64("AcquireRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Acquire",
+			logging.Trunc64("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Acquire(ctx, request)
+	if err != nil {
+		log.Debugw("Acquire",
+			logging.Trunc64("AcquireRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Acquire",
+		logging.Trunc64("AcquireResponse", response))
+	return response, nil
+}
+
+func (s *semaphoreServer) Release(ctx context.Context, request *semaphorev1.ReleaseRequest) (*semaphorev1.ReleaseResponse, error) {
+	log.Debugw("Release",
+		logging.Trunc64("ReleaseRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc64("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Release(ctx, request)
+	if err != nil {
+		log.Debugw("Release",
+			logging.Trunc64("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Release",
+		logging.Trunc64("ReleaseResponse", response))
+	return response, nil
+}
+
+func (s *semaphoreServer) GetSemaphore(ctx context.Context, request *semaphorev1.GetSemaphoreRequest) (*semaphorev1.GetSemaphoreResponse, error) {
+	log.Debugw("GetSemaphore",
+		logging.Trunc64("GetSemaphoreRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetSemaphore",
+			logging.Trunc64("GetSemaphoreRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetSemaphore(ctx, request)
+	if err != nil {
+		log.Debugw("GetSemaphore",
+			logging.Trunc64("GetSemaphoreRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetSemaphore",
+		logging.Trunc64("GetSemaphoreResponse", response))
+	return response, nil
+}
+
+var _ semaphorev1.SemaphoreServer = (*semaphoreServer)(nil)
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	semaphorev1 "github.com/atomix/atomix/api/runtime/semaphore/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type SemaphoreProvider interface {
+	NewSemaphoreV1(ctx context.Context, id runtimev1.PrimitiveID, config *semaphorev1.Config) (SemaphoreProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *semaphorev1.Config) (SemaphoreProxy, bool, error) {
+	if provider, ok := conn.(SemaphoreProvider); ok {
+		semaphore, err := provider.NewSemaphoreV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return semaphore, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewSemaphoresServer(rt *runtime.Runtime) semaphorev1.SemaphoresServer {
+	return &semaphoresServer{
+		manager: runtime.NewPrimitiveManager[SemaphoreProxy, *semaphorev1.Config](semaphorev1.PrimitiveType, resolve, rt),
+	}
+}
+
+type semaphoresServer struct {
+	manager runtime.PrimitiveManager[*semaphorev1.Config]
+}
+
+func (s *semaphoresServer) Create(ctx context.Context, request *semaphorev1.CreateRequest) (*semaphorev1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphorev1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *semaphoresServer) Close(ctx context.Context, request *semaphorev1.CloseRequest) (*semaphorev1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &semaphorev1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ semaphorev1.SemaphoresServer = (*semaphoresServer)(nil)
@@ -18,7 +18,7 @@ type SetProvider interface {
 	NewSetV1(ctx context.Context, id runtimev1.PrimitiveID) (SetProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (SetProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *setv1.Config) (SetProxy, bool, error) {
 	if provider, ok := conn.(SetProvider); ok {
 		set, err := provider.NewSetV1(ctx, id)
 		if err != nil {
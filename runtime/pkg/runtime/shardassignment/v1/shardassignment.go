@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	shardassignmentv1 "github.com/atomix/atomix/api/runtime/shardassignment/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type ShardAssignmentProxy interface {
+	runtime.PrimitiveProxy
+	// Join adds a member to the group and returns the shards assigned to it
+	Join(context.Context, *shardassignmentv1.JoinRequest) (*shardassignmentv1.JoinResponse, error)
+	// Leave removes a member from the group
+	Leave(context.Context, *shardassignmentv1.LeaveRequest) (*shardassignmentv1.LeaveResponse, error)
+	// Release releases shards revoked from a member
+	Release(context.Context, *shardassignmentv1.ReleaseRequest) (*shardassignmentv1.ReleaseResponse, error)
+	// GetAssignments gets the shards assigned to each member
+	GetAssignments(context.Context, *shardassignmentv1.GetAssignmentsRequest) (*shardassignmentv1.GetAssignmentsResponse, error)
+	// Watch watches for changes to shard assignments
+	Watch(*shardassignmentv1.WatchRequest, shardassignmentv1.ShardAssignment_WatchServer) error
+}
+
+func NewShardAssignmentServer(rt *runtime.Runtime) shardassignmentv1.ShardAssignmentServer {
+	return &shardAssignmentServer{
+		ShardAssignmentsServer: NewShardAssignmentsServer(rt),
+		primitives:             runtime.NewPrimitiveRegistry[ShardAssignmentProxy](shardassignmentv1.PrimitiveType, rt),
+	}
+}
+
+type shardAssignmentServer struct {
+	shardassignmentv1.ShardAssignmentsServer
+	primitives runtime.PrimitiveRegistry[ShardAssignmentProxy]
+}
+
+func (s *shardAssignmentServer) Join(ctx context.Context, request *shardassignmentv1.JoinRequest) (*shardassignmentv1.JoinResponse, error) {
+	log.Debugw("Join",
+		logging.Trunc64("JoinRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Join",
+			logging.Trunc64("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Join(ctx, request)
+	if err != nil {
+		log.Debugw("Join",
+			logging.Trunc64("JoinRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Join",
+		logging.Trunc64("JoinResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) Leave(ctx context.Context, request *shardassignmentv1.LeaveRequest) (*shardassignmentv1.LeaveResponse, error) {
+	log.Debugw("Leave",
+		logging.Trunc64("LeaveRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Leave",
+			logging.Trunc64("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Leave(ctx, request)
+	if err != nil {
+		log.Debugw("Leave",
+			logging.Trunc64("LeaveRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Leave",
+		logging.Trunc64("LeaveResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) Release(ctx context.Context, request *shardassignmentv1.ReleaseRequest) (*shardassignmentv1.ReleaseResponse, error) {
+	log.Debugw("Release",
+		logging.Trunc64("ReleaseRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Release",
+			logging.Trunc64("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Release(ctx, request)
+	if err != nil {
+		log.Debugw("Release",
+			logging.Trunc64("ReleaseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Release",
+		logging.Trunc64("ReleaseResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) GetAssignments(ctx context.Context, request *shardassignmentv1.GetAssignmentsRequest) (*shardassignmentv1.GetAssignmentsResponse, error) {
+	log.Debugw("GetAssignments",
+		logging.Trunc64("GetAssignmentsRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetAssignments",
+			logging.Trunc64("GetAssignmentsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetAssignments(ctx, request)
+	if err != nil {
+		log.Debugw("GetAssignments",
+			logging.Trunc64("GetAssignmentsRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetAssignments",
+		logging.Trunc64("GetAssignmentsResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentServer) Watch(request *shardassignmentv1.WatchRequest, server shardassignmentv1.ShardAssignment_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc64("WatchRequest", request),
+		logging.String("State", "started"))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc64("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	err = client.Watch(request, server)
+	if err != nil {
+		log.Debugw("Watch",
+			logging.Trunc64("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+var _ shardassignmentv1.ShardAssignmentServer = (*shardAssignmentServer)(nil)
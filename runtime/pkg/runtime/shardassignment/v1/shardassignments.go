@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	shardassignmentv1 "github.com/atomix/atomix/api/runtime/shardassignment/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type ShardAssignmentProvider interface {
+	NewShardAssignmentV1(ctx context.Context, id runtimev1.PrimitiveID, config *shardassignmentv1.Config) (ShardAssignmentProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *shardassignmentv1.Config) (ShardAssignmentProxy, bool, error) {
+	if provider, ok := conn.(ShardAssignmentProvider); ok {
+		shardAssignment, err := provider.NewShardAssignmentV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return shardAssignment, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewShardAssignmentsServer(rt *runtime.Runtime) shardassignmentv1.ShardAssignmentsServer {
+	return &shardAssignmentsServer{
+		manager: runtime.NewPrimitiveManager[ShardAssignmentProxy, *shardassignmentv1.Config](shardassignmentv1.PrimitiveType, resolve, rt),
+	}
+}
+
+type shardAssignmentsServer struct {
+	manager runtime.PrimitiveManager[*shardassignmentv1.Config]
+}
+
+func (s *shardAssignmentsServer) Create(ctx context.Context, request *shardassignmentv1.CreateRequest) (*shardassignmentv1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentv1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *shardAssignmentsServer) Close(ctx context.Context, request *shardassignmentv1.CloseRequest) (*shardassignmentv1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &shardassignmentv1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ shardassignmentv1.ShardAssignmentsServer = (*shardAssignmentsServer)(nil)
@@ -20,6 +20,10 @@ type TopicProxy interface {
 	Publish(context.Context, *topicv1.PublishRequest) (*topicv1.PublishResponse, error)
 	// Subscribe subscribes to receive messages from the topic
 	Subscribe(*topicv1.SubscribeRequest, topicv1.Topic_SubscribeServer) error
+	// CommitOffset commits a message offset consumed by a consumer group
+	CommitOffset(context.Context, *topicv1.CommitOffsetRequest) (*topicv1.CommitOffsetResponse, error)
+	// FetchOffset gets the offset from which a consumer group resumes consumption
+	FetchOffset(context.Context, *topicv1.FetchOffsetRequest) (*topicv1.FetchOffsetResponse, error)
 }
 
 func NewTopicServer(rt *runtime.Runtime) topicv1.TopicServer {
@@ -77,4 +81,48 @@ func (s *topicServer) Subscribe(request *topicv1.SubscribeRequest, server topicv
 	return nil
 }
 
+func (s *topicServer) CommitOffset(ctx context.Context, request *topicv1.CommitOffsetRequest) (*topicv1.CommitOffsetResponse, error) {
+	log.Debugw("CommitOffset",
+		logging.Trunc64("CommitOffsetRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("CommitOffset",
+			logging.Trunc64("CommitOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.CommitOffset(ctx, request)
+	if err != nil {
+		log.Debugw("CommitOffset",
+			logging.Trunc64("CommitOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("CommitOffset",
+		logging.Trunc64("CommitOffsetResponse", response))
+	return response, nil
+}
+
+func (s *topicServer) FetchOffset(ctx context.Context, request *topicv1.FetchOffsetRequest) (*topicv1.FetchOffsetResponse, error) {
+	log.Debugw("FetchOffset",
+		logging.Trunc64("FetchOffsetRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("FetchOffset",
+			logging.Trunc64("FetchOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.FetchOffset(ctx, request)
+	if err != nil {
+		log.Debugw("FetchOffset",
+			logging.Trunc64("FetchOffsetRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("FetchOffset",
+		logging.Trunc64("FetchOffsetResponse", response))
+	return response, nil
+}
+
 var _ topicv1.TopicServer = (*topicServer)(nil)
@@ -15,12 +15,12 @@ import (
 )
 
 type TopicProvider interface {
-	NewTopicV1(ctx context.Context, id runtimev1.PrimitiveID) (TopicProxy, error)
+	NewTopicV1(ctx context.Context, id runtimev1.PrimitiveID, config *topicv1.Config) (TopicProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (TopicProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *topicv1.Config) (TopicProxy, bool, error) {
 	if provider, ok := conn.(TopicProvider); ok {
-		topic, err := provider.NewTopicV1(ctx, id)
+		topic, err := provider.NewTopicV1(ctx, id, config)
 		if err != nil {
 			return nil, false, err
 		}
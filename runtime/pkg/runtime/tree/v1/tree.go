@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	treev1 "github.com/atomix/atomix/api/runtime/tree/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type TreeProxy interface {
+	runtime.PrimitiveProxy
+	// CreateNode creates a node in the tree
+	CreateNode(context.Context, *treev1.CreateNodeRequest) (*treev1.CreateNodeResponse, error)
+	// GetNode gets a node in the tree
+	GetNode(context.Context, *treev1.GetNodeRequest) (*treev1.GetNodeResponse, error)
+	// SetNode sets the value of a node in the tree
+	SetNode(context.Context, *treev1.SetNodeRequest) (*treev1.SetNodeResponse, error)
+	// DeleteNode deletes a node from the tree
+	DeleteNode(context.Context, *treev1.DeleteNodeRequest) (*treev1.DeleteNodeResponse, error)
+	// GetChildren lists the children of a node
+	GetChildren(context.Context, *treev1.GetChildrenRequest) (*treev1.GetChildrenResponse, error)
+	// Watch watches a node and its descendants for changes
+	Watch(*treev1.WatchRequest, treev1.Tree_WatchServer) error
+}
+
+func NewTreeServer(rt *runtime.Runtime) treev1.TreeServer {
+	return &treeServer{
+		TreesServer: NewTreesServer(rt),
+		primitives:  runtime.NewPrimitiveRegistry[TreeProxy](treev1.PrimitiveType, rt),
+	}
+}
+
+type treeServer struct {
+	treev1.TreesServer
+	primitives runtime.PrimitiveRegistry[TreeProxy]
+}
+
+func (s *treeServer) CreateNode(ctx context.Context, request *treev1.CreateNodeRequest) (*treev1.CreateNodeResponse, error) {
+	log.Debugw("CreateNode",
+		logging.Trunc64("CreateNodeRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("CreateNode",
+			logging.Trunc64("CreateNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.CreateNode(ctx, request)
+	if err != nil {
+		log.Debugw("CreateNode",
+			logging.Trunc64("CreateNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("CreateNode",
+		logging.Trunc64("CreateNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) GetNode(ctx context.Context, request *treev1.GetNodeRequest) (*treev1.GetNodeResponse, error) {
+	log.Debugw("GetNode",
+		logging.Trunc64("GetNodeRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetNode",
+			logging.Trunc64("GetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetNode(ctx, request)
+	if err != nil {
+		log.Debugw("GetNode",
+			logging.Trunc64("GetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetNode",
+		logging.Trunc64("GetNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) SetNode(ctx context.Context, request *treev1.SetNodeRequest) (*treev1.SetNodeResponse, error) {
+	log.Debugw("SetNode",
+		logging.Trunc64("SetNodeRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("SetNode",
+			logging.Trunc64("SetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.SetNode(ctx, request)
+	if err != nil {
+		log.Debugw("SetNode",
+			logging.Trunc64("SetNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("SetNode",
+		logging.Trunc64("SetNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) DeleteNode(ctx context.Context, request *treev1.DeleteNodeRequest) (*treev1.DeleteNodeResponse, error) {
+	log.Debugw("DeleteNode",
+		logging.Trunc64("DeleteNodeRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("DeleteNode",
+			logging.Trunc64("DeleteNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.DeleteNode(ctx, request)
+	if err != nil {
+		log.Debugw("DeleteNode",
+			logging.Trunc64("DeleteNodeRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("DeleteNode",
+		logging.Trunc64("DeleteNodeResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) GetChildren(ctx context.Context, request *treev1.GetChildrenRequest) (*treev1.GetChildrenResponse, error) {
+	log.Debugw("GetChildren",
+		logging.Trunc64("GetChildrenRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("GetChildren",
+			logging.Trunc64("GetChildrenRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.GetChildren(ctx, request)
+	if err != nil {
+		log.Debugw("GetChildren",
+			logging.Trunc64("GetChildrenRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("GetChildren",
+		logging.Trunc64("GetChildrenResponse", response))
+	return response, nil
+}
+
+func (s *treeServer) Watch(request *treev1.WatchRequest, server treev1.Tree_WatchServer) error {
+	log.Debugw("Watch",
+		logging.Trunc64("WatchRequest", request),
+		logging.String("State", "started"))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Watch",
+			logging.Trunc64("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	err = client.Watch(request, server)
+	if err != nil {
+		log.Debugw("Watch",
+			logging.Trunc64("WatchRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+var _ treev1.TreeServer = (*treeServer)(nil)
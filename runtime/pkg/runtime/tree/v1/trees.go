@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	treev1 "github.com/atomix/atomix/api/runtime/tree/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type TreeProvider interface {
+	NewTreeV1(ctx context.Context, id runtimev1.PrimitiveID) (TreeProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *treev1.Config) (TreeProxy, bool, error) {
+	if provider, ok := conn.(TreeProvider); ok {
+		tree, err := provider.NewTreeV1(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		return tree, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewTreesServer(rt *runtime.Runtime) treev1.TreesServer {
+	return &treesServer{
+		manager: runtime.NewPrimitiveManager[TreeProxy, *treev1.Config](treev1.PrimitiveType, resolve, rt),
+	}
+}
+
+type treesServer struct {
+	manager runtime.PrimitiveManager[*treev1.Config]
+}
+
+func (s *treesServer) Create(ctx context.Context, request *treev1.CreateRequest) (*treev1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treev1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *treesServer) Close(ctx context.Context, request *treev1.CloseRequest) (*treev1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &treev1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ treev1.TreesServer = (*treesServer)(nil)
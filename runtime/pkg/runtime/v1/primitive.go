@@ -19,7 +19,7 @@ type PrimitiveProxy interface {
 	Close(ctx context.Context) error
 }
 
-type Resolver[P PrimitiveProxy] func(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (P, bool, error)
+type Resolver[P PrimitiveProxy, C proto.Message] func(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config C) (P, bool, error)
 
 type PrimitiveManager[C proto.Message] interface {
 	Create(ctx context.Context, primitiveID runtimev1.PrimitiveID, tags []string) (C, error)
@@ -30,7 +30,7 @@ type PrimitiveRegistry[P PrimitiveProxy] interface {
 	Get(primitiveID runtimev1.PrimitiveID) (P, error)
 }
 
-func NewPrimitiveManager[P PrimitiveProxy, C proto.Message](primitiveType runtimev1.PrimitiveType, resolver Resolver[P], runtime *Runtime) PrimitiveManager[C] {
+func NewPrimitiveManager[P PrimitiveProxy, C proto.Message](primitiveType runtimev1.PrimitiveType, resolver Resolver[P, C], runtime *Runtime) PrimitiveManager[C] {
 	return &primitiveManager[P, C]{
 		primitiveType: primitiveType,
 		resolver:      resolver,
@@ -40,7 +40,7 @@ func NewPrimitiveManager[P PrimitiveProxy, C proto.Message](primitiveType runtim
 
 type primitiveManager[P PrimitiveProxy, C proto.Message] struct {
 	primitiveType runtimev1.PrimitiveType
-	resolver      Resolver[P]
+	resolver      Resolver[P, C]
 	runtime       *Runtime
 }
 
@@ -85,7 +85,7 @@ func (c *primitiveManager[P, C]) Create(ctx context.Context, primitiveID runtime
 	}
 
 	// Attempt to create the primitive via the driver connection
-	primitive, ok, err := c.resolver(ctx, conn, primitiveID)
+	primitive, ok, err := c.resolver(ctx, conn, primitiveID, config)
 	if !ok {
 		return config, errors.NewNotSupported("primitive type '%s/%s' not supported by configured driver", c.primitiveType.Name, c.primitiveType.APIVersion)
 	}
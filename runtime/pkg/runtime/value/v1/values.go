@@ -18,7 +18,7 @@ type ValueProvider interface {
 	NewValueV1(ctx context.Context, id runtimev1.PrimitiveID) (ValueProxy, error)
 }
 
-func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID) (ValueProxy, bool, error) {
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *valuev1.Config) (ValueProxy, bool, error) {
 	if provider, ok := conn.(ValueProvider); ok {
 		value, err := provider.NewValueV1(ctx, id)
 		if err != nil {
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	workqueuev1 "github.com/atomix/atomix/api/runtime/workqueue/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+var log = logging.GetLogger()
+
+type WorkQueueProxy interface {
+	runtime.PrimitiveProxy
+	// Enqueue adds a task to the queue
+	Enqueue(context.Context, *workqueuev1.EnqueueRequest) (*workqueuev1.EnqueueResponse, error)
+	// Dequeue leases the next task from the queue
+	Dequeue(context.Context, *workqueuev1.DequeueRequest) (*workqueuev1.DequeueResponse, error)
+	// Ack acknowledges completion of a leased task
+	Ack(context.Context, *workqueuev1.AckRequest) (*workqueuev1.AckResponse, error)
+	// Nack returns a leased task to the queue for redelivery
+	Nack(context.Context, *workqueuev1.NackRequest) (*workqueuev1.NackResponse, error)
+	// DeadLetters lists the tasks in the dead-letter queue
+	DeadLetters(*workqueuev1.DeadLettersRequest, workqueuev1.WorkQueue_DeadLettersServer) error
+}
+
+func NewWorkQueueServer(rt *runtime.Runtime) workqueuev1.WorkQueueServer {
+	return &workQueueServer{
+		WorkQueuesServer: NewWorkQueuesServer(rt),
+		primitives:       runtime.NewPrimitiveRegistry[WorkQueueProxy](workqueuev1.PrimitiveType, rt),
+	}
+}
+
+type workQueueServer struct {
+	workqueuev1.WorkQueuesServer
+	primitives runtime.PrimitiveRegistry[WorkQueueProxy]
+}
+
+func (s *workQueueServer) Enqueue(ctx context.Context, request *workqueuev1.EnqueueRequest) (*workqueuev1.EnqueueResponse, error) {
+	log.Debugw("Enqueue",
+		logging.Trunc64("EnqueueRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Enqueue",
+			logging.Trunc64("EnqueueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Enqueue(ctx, request)
+	if err != nil {
+		log.Debugw("Enqueue",
+			logging.Trunc64("EnqueueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Enqueue",
+		logging.Trunc64("EnqueueResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) Dequeue(ctx context.Context, request *workqueuev1.DequeueRequest) (*workqueuev1.DequeueResponse, error) {
+	log.Debugw("Dequeue",
+		logging.Trunc64("DequeueRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Dequeue",
+			logging.Trunc64("DequeueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Dequeue(ctx, request)
+	if err != nil {
+		log.Debugw("Dequeue",
+			logging.Trunc64("DequeueRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Dequeue",
+		logging.Trunc64("DequeueResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) Ack(ctx context.Context, request *workqueuev1.AckRequest) (*workqueuev1.AckResponse, error) {
+	log.Debugw("Ack",
+		logging.Trunc64("AckRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Ack",
+			logging.Trunc64("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Ack(ctx, request)
+	if err != nil {
+		log.Debugw("Ack",
+			logging.Trunc64("AckRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Ack",
+		logging.Trunc64("AckResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) Nack(ctx context.Context, request *workqueuev1.NackRequest) (*workqueuev1.NackResponse, error) {
+	log.Debugw("Nack",
+		logging.Trunc64("NackRequest", request))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("Nack",
+			logging.Trunc64("NackRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response, err := client.Nack(ctx, request)
+	if err != nil {
+		log.Debugw("Nack",
+			logging.Trunc64("NackRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	log.Debugw("Nack",
+		logging.Trunc64("NackResponse", response))
+	return response, nil
+}
+
+func (s *workQueueServer) DeadLetters(request *workqueuev1.DeadLettersRequest, server workqueuev1.WorkQueue_DeadLettersServer) error {
+	log.Debugw("DeadLetters",
+		logging.Trunc64("DeadLettersRequest", request),
+		logging.String("State", "started"))
+	client, err := s.primitives.Get(request.ID)
+	if err != nil {
+		log.Warnw("DeadLetters",
+			logging.Trunc64("DeadLettersRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	err = client.DeadLetters(request, server)
+	if err != nil {
+		log.Debugw("DeadLetters",
+			logging.Trunc64("DeadLettersRequest", request),
+			logging.Error("Error", err))
+		return err
+	}
+	return nil
+}
+
+var _ workqueuev1.WorkQueueServer = (*workQueueServer)(nil)
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+	workqueuev1 "github.com/atomix/atomix/api/runtime/workqueue/v1"
+	"github.com/vpascoalr/atomix/runtime/pkg/driver"
+	"github.com/vpascoalr/atomix/runtime/pkg/logging"
+	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
+)
+
+type WorkQueueProvider interface {
+	NewWorkQueueV1(ctx context.Context, id runtimev1.PrimitiveID, config *workqueuev1.Config) (WorkQueueProxy, error)
+}
+
+func resolve(ctx context.Context, conn driver.Conn, id runtimev1.PrimitiveID, config *workqueuev1.Config) (WorkQueueProxy, bool, error) {
+	if provider, ok := conn.(WorkQueueProvider); ok {
+		workQueue, err := provider.NewWorkQueueV1(ctx, id, config)
+		if err != nil {
+			return nil, false, err
+		}
+		return workQueue, true, nil
+	}
+	return nil, false, nil
+}
+
+func NewWorkQueuesServer(rt *runtime.Runtime) workqueuev1.WorkQueuesServer {
+	return &workQueuesServer{
+		manager: runtime.NewPrimitiveManager[WorkQueueProxy, *workqueuev1.Config](workqueuev1.PrimitiveType, resolve, rt),
+	}
+}
+
+type workQueuesServer struct {
+	manager runtime.PrimitiveManager[*workqueuev1.Config]
+}
+
+func (s *workQueuesServer) Create(ctx context.Context, request *workqueuev1.CreateRequest) (*workqueuev1.CreateResponse, error) {
+	log.Debugw("Create",
+		logging.Trunc64("CreateRequest", request))
+	config, err := s.manager.Create(ctx, request.ID, request.Tags)
+	if err != nil {
+		log.Warnw("Create",
+			logging.Trunc64("CreateRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueuev1.CreateResponse{
+		Config: *config,
+	}
+	log.Debugw("Create",
+		logging.Trunc64("CreateResponse", response))
+	return response, nil
+}
+
+func (s *workQueuesServer) Close(ctx context.Context, request *workqueuev1.CloseRequest) (*workqueuev1.CloseResponse, error) {
+	log.Debugw("Close",
+		logging.Trunc64("CloseRequest", request))
+	err := s.manager.Close(ctx, request.ID)
+	if err != nil {
+		log.Warnw("Close",
+			logging.Trunc64("CloseRequest", request),
+			logging.Error("Error", err))
+		return nil, err
+	}
+	response := &workqueuev1.CloseResponse{}
+	log.Debugw("Close",
+		logging.Trunc64("CloseResponse", response))
+	return response, nil
+}
+
+var _ workqueuev1.WorkQueuesServer = (*workQueuesServer)(nil)
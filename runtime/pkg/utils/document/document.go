@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package document implements partial updates and projections of JSON document values.
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/atomix/atomix/api/errors"
+	jsonpatch "github.com/evanphx/json-patch"
+)
+
+// emptyDocument is the document to which patches are applied when no value is present
+var emptyDocument = []byte("{}")
+
+// Patch applies a JSON Patch (RFC 6902) to the given document
+// A nil document is patched as an empty object. A failed test operation returns a Conflict error.
+func Patch(doc []byte, patch []byte) ([]byte, error) {
+	if doc == nil {
+		doc = emptyDocument
+	} else if !json.Valid(doc) {
+		return nil, errors.NewInvalid("value is not a JSON document")
+	}
+	operations, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, errors.NewInvalid("invalid JSON patch: %s", err.Error())
+	}
+	result, err := operations.Apply(doc)
+	if err != nil {
+		if stderrors.Is(err, jsonpatch.ErrTestFailed) {
+			return nil, errors.NewConflict("JSON patch test failed: %s", err.Error())
+		}
+		return nil, errors.NewInvalid("failed to apply JSON patch: %s", err.Error())
+	}
+	return result, nil
+}
+
+// MergePatch applies a JSON Merge Patch (RFC 7386) to the given document
+// A nil document is patched as an empty object.
+func MergePatch(doc []byte, patch []byte) ([]byte, error) {
+	if doc == nil {
+		doc = emptyDocument
+	} else if !json.Valid(doc) {
+		return nil, errors.NewInvalid("value is not a JSON document")
+	}
+	if !json.Valid(patch) {
+		return nil, errors.NewInvalid("invalid JSON merge patch")
+	}
+	result, err := jsonpatch.MergePatch(doc, patch)
+	if err != nil {
+		return nil, errors.NewInvalid("failed to apply JSON merge patch: %s", err.Error())
+	}
+	return result, nil
+}
+
+// Project returns a document containing only the values at the given JSON Pointers (RFC 6901)
+// The projection preserves the structure of the document. Pointers to values that are not present
+// are ignored, and array elements selected by index are returned in order without the unselected elements.
+func Project(doc []byte, paths []string) ([]byte, error) {
+	root := &selection{}
+	for _, path := range paths {
+		tokens, err := parsePointer(path)
+		if err != nil {
+			return nil, err
+		}
+		root.add(tokens)
+	}
+
+	value, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := root.project(value)
+	if !ok {
+		switch value.(type) {
+		case []interface{}:
+			result = []interface{}{}
+		default:
+			result = map[string]interface{}{}
+		}
+	}
+	return json.Marshal(result)
+}
+
+// Lookup returns the value at the given JSON Pointer (RFC 6901) in the document and whether the value is present
+// Values are decoded as by encoding/json, with numbers decoded as json.Number.
+func Lookup(doc []byte, path string) (interface{}, bool, error) {
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return nil, false, err
+	}
+	value, err := decode(doc)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, token := range tokens {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			member, ok := v[token]
+			if !ok {
+				return nil, false, nil
+			}
+			value = member
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) || strconv.Itoa(index) != token {
+				return nil, false, nil
+			}
+			value = v[index]
+		default:
+			return nil, false, nil
+		}
+	}
+	return value, true, nil
+}
+
+// ValidatePointer returns an Invalid error if the given path is not a JSON Pointer (RFC 6901)
+func ValidatePointer(path string) error {
+	_, err := parsePointer(path)
+	return err
+}
+
+// decode decodes a JSON document, decoding numbers as json.Number to preserve their precision
+func decode(doc []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return nil, errors.NewInvalid("value is not a JSON document")
+	}
+	return value, nil
+}
+
+// selection is a tree of the tokens selected by a set of JSON Pointers
+type selection struct {
+	// all indicates the entire value is selected
+	all      bool
+	children map[string]*selection
+}
+
+func (s *selection) add(tokens []string) {
+	if s.all {
+		return
+	}
+	if len(tokens) == 0 {
+		s.all = true
+		s.children = nil
+		return
+	}
+	if s.children == nil {
+		s.children = make(map[string]*selection)
+	}
+	child, ok := s.children[tokens[0]]
+	if !ok {
+		child = &selection{}
+		s.children[tokens[0]] = child
+	}
+	child.add(tokens[1:])
+}
+
+// project returns the selected parts of the given value and whether any part of the value was selected
+func (s *selection) project(value interface{}) (interface{}, bool) {
+	if s.all {
+		return value, true
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{})
+		for token, child := range s.children {
+			if member, ok := v[token]; ok {
+				if projected, ok := child.project(member); ok {
+					result[token] = projected
+				}
+			}
+		}
+		return result, len(result) > 0
+	case []interface{}:
+		indexes := make([]int, 0, len(s.children))
+		for token := range s.children {
+			if index, err := strconv.Atoi(token); err == nil && index >= 0 && index < len(v) && strconv.Itoa(index) == token {
+				indexes = append(indexes, index)
+			}
+		}
+		sort.Ints(indexes)
+		result := make([]interface{}, 0, len(indexes))
+		for _, index := range indexes {
+			if projected, ok := s.children[strconv.Itoa(index)].project(v[index]); ok {
+				result = append(result, projected)
+			}
+		}
+		return result, len(result) > 0
+	default:
+		return nil, false
+	}
+}
+
+// parsePointer splits a JSON Pointer into its unescaped reference tokens
+func parsePointer(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.NewInvalid("invalid JSON pointer %s", path)
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package document
+
+import (
+	"encoding/json"
+	"github.com/atomix/atomix/api/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPatch(t *testing.T) {
+	doc, err := Patch(nil, []byte(`[{"op": "add", "path": "/name", "value": "foo"}]`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "foo"}`, string(doc))
+
+	doc, err = Patch(doc, []byte(`[{"op": "test", "path": "/name", "value": "foo"}, {"op": "add", "path": "/tags", "value": ["a"]}, {"op": "add", "path": "/tags/-", "value": "b"}]`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "foo", "tags": ["a", "b"]}`, string(doc))
+
+	_, err = Patch(doc, []byte(`[{"op": "test", "path": "/name", "value": "bar"}]`))
+	assert.True(t, errors.IsConflict(err))
+	_, err = Patch(doc, []byte(`[{"op": "remove", "path": "/missing"}]`))
+	assert.True(t, errors.IsInvalid(err))
+	_, err = Patch(doc, []byte(`{"name": "bar"}`))
+	assert.True(t, errors.IsInvalid(err))
+	_, err = Patch([]byte("not json"), []byte(`[]`))
+	assert.True(t, errors.IsInvalid(err))
+}
+
+func TestMergePatch(t *testing.T) {
+	doc, err := MergePatch(nil, []byte(`{"name": "foo", "address": {"city": "Rome", "zip": "00100"}}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "foo", "address": {"city": "Rome", "zip": "00100"}}`, string(doc))
+
+	doc, err = MergePatch(doc, []byte(`{"address": {"zip": null}, "age": 42}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "foo", "address": {"city": "Rome"}, "age": 42}`, string(doc))
+
+	_, err = MergePatch(doc, []byte(`{`))
+	assert.True(t, errors.IsInvalid(err))
+	_, err = MergePatch([]byte("not json"), []byte(`{}`))
+	assert.True(t, errors.IsInvalid(err))
+}
+
+func TestProject(t *testing.T) {
+	doc := []byte(`{"name": "foo", "a/b": 1, "address": {"city": "Rome", "zip": "00100"}, "tags": [{"id": 1, "x": true}, {"id": 2}, {"id": 3}], "big": 12345678901234567890}`)
+
+	project := func(paths ...string) string {
+		result, err := Project(doc, paths)
+		assert.NoError(t, err)
+		return string(result)
+	}
+
+	assert.JSONEq(t, string(doc), project(""))
+	assert.JSONEq(t, `{}`, project())
+	assert.JSONEq(t, `{"name": "foo"}`, project("/name"))
+	assert.JSONEq(t, `{"name": "foo", "address": {"city": "Rome"}}`, project("/name", "/address/city"))
+	assert.JSONEq(t, `{"address": {"city": "Rome", "zip": "00100"}}`, project("/address/city", "/address"))
+	assert.JSONEq(t, `{"a/b": 1}`, project("/a~1b"))
+	assert.JSONEq(t, `{"tags": [{"id": 1}, {"id": 3}]}`, project("/tags/2/id", "/tags/0/id", "/tags/5", "/tags/01"))
+	assert.JSONEq(t, `{}`, project("/missing", "/name/foo", "/address/country"))
+	assert.Equal(t, `{"big":12345678901234567890}`, project("/big"))
+
+	_, err := Project(doc, []string{"name"})
+	assert.True(t, errors.IsInvalid(err))
+	_, err = Project([]byte("not json"), []string{"/name"})
+	assert.True(t, errors.IsInvalid(err))
+}
+
+func TestLookup(t *testing.T) {
+	doc := []byte(`{"status": "pending", "a/b": null, "tags": ["x", {"id": 2}], "count": 1.50}`)
+
+	value, ok, err := Lookup(doc, "/status")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "pending", value)
+
+	value, ok, err = Lookup(doc, "/a~1b")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Nil(t, value)
+
+	value, ok, err = Lookup(doc, "/tags/1/id")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, json.Number("2"), value)
+
+	value, ok, err = Lookup(doc, "/count")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, json.Number("1.50"), value)
+
+	value, ok, err = Lookup([]byte(`"pending"`), "")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "pending", value)
+
+	for _, path := range []string{"/missing", "/status/0", "/tags/2", "/tags/01", "/tags/-"} {
+		_, ok, err = Lookup(doc, path)
+		assert.NoError(t, err)
+		assert.False(t, ok, path)
+	}
+
+	_, _, err = Lookup(doc, "status")
+	assert.True(t, errors.IsInvalid(err))
+	_, _, err = Lookup([]byte("not json"), "/status")
+	assert.True(t, errors.IsInvalid(err))
+	_, _, err = Lookup([]byte(`"a" "b"`), "")
+	assert.True(t, errors.IsInvalid(err))
+	assert.NoError(t, ValidatePointer("/a/b"))
+	assert.True(t, errors.IsInvalid(ValidatePointer("a/b")))
+}
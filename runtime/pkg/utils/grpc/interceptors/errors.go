@@ -99,6 +99,8 @@ func getAtomixError(err error) error {
 		return errors.NewInternal(status.Message())
 	case codes.DataLoss:
 		return errors.NewFault(status.Message())
+	case codes.OutOfRange:
+		return errors.NewOutOfRange(status.Message())
 	default:
 		return err
 	}
@@ -142,6 +144,8 @@ func getGRPCError(err error) error {
 		return status.Error(codes.Internal, typed.Message)
 	case errors.Fault:
 		return status.Error(codes.DataLoss, typed.Message)
+	case errors.OutOfRange:
+		return status.Error(codes.OutOfRange, typed.Message)
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
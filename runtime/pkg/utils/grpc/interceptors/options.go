@@ -58,11 +58,11 @@ type retryingCallOptions struct {
 	codes          []codes.Code
 }
 
-func newCallContext(ctx context.Context, opts *retryingCallOptions) context.Context {
+func newCallContext(ctx context.Context, opts *retryingCallOptions) (context.Context, context.CancelFunc) {
 	if opts.perCallTimeout != nil {
-		ctx, _ = context.WithTimeout(ctx, *opts.perCallTimeout) //nolint:govet
+		return context.WithTimeout(ctx, *opts.perCallTimeout)
 	}
-	return ctx
+	return ctx, func() {}
 }
 
 func newCallOptions(opts *retryingCallOptions, options []RetryingCallOption) *retryingCallOptions {
@@ -41,7 +41,8 @@ func RetryingUnaryClientInterceptor(callOpts ...RetryingCallOption) func(ctx con
 		}
 		return backoff.Retry(func() error {
 			log.Debugf("SendMsg %.250s", req)
-			callCtx := newCallContext(ctx, callOpts)
+			callCtx, cancel := newCallContext(ctx, callOpts)
+			defer cancel()
 			if err := invoker(callCtx, method, req, reply, cc, grpcOpts...); err != nil {
 				if isContextError(err) {
 					if ctx.Err() != nil {
@@ -195,6 +196,7 @@ type retryingClientStream struct {
 	mu        sync.RWMutex
 	buffer    retryingStreamBuffer
 	newStream func(ctx context.Context) (grpc.ClientStream, error)
+	cancel    context.CancelFunc
 	closed    bool
 }
 
@@ -336,8 +338,10 @@ func (s *retryingClientStream) tryStream() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	stream, err := s.newStream(newCallContext(s.ctx, s.opts))
+	ctx, cancel := newCallContext(s.ctx, s.opts)
+	stream, err := s.newStream(ctx)
 	if err != nil {
+		cancel()
 		if isContextError(err) {
 			if s.ctx.Err() != nil {
 				log.Debug("Stream: error", err)
@@ -359,6 +363,7 @@ func (s *retryingClientStream) tryStream() error {
 	for _, m := range msgs {
 		log.Debugf("SendMsg %.250s", m)
 		if err := stream.SendMsg(m); err != nil {
+			cancel()
 			if isContextError(err) {
 				if s.ctx.Err() != nil {
 					log.Debugf("SendMsg %.250s: error", m, err)
@@ -380,6 +385,7 @@ func (s *retryingClientStream) tryStream() error {
 	if s.closed {
 		log.Debug("CloseSend")
 		if err := stream.CloseSend(); err != nil {
+			cancel()
 			if isContextError(err) {
 				if s.ctx.Err() != nil {
 					log.Debug("CloseSend: error", err)
@@ -397,7 +403,12 @@ func (s *retryingClientStream) tryStream() error {
 			return backoff.Permanent(err)
 		}
 	}
+	// Release the context of the stream being replaced
+	if s.cancel != nil {
+		s.cancel()
+	}
 	s.stream = stream
+	s.cancel = cancel
 	return nil
 }
 
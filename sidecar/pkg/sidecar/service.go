@@ -5,57 +5,109 @@
 package sidecar
 
 import (
+	barrierv1 "github.com/atomix/atomix/api/runtime/barrier/v1"
+	bloomfilterv1 "github.com/atomix/atomix/api/runtime/bloomfilter/v1"
+	cardinalityv1 "github.com/atomix/atomix/api/runtime/cardinality/v1"
 	counterv1 "github.com/atomix/atomix/api/runtime/counter/v1"
 	countermapv1 "github.com/atomix/atomix/api/runtime/countermap/v1"
 	electionv1 "github.com/atomix/atomix/api/runtime/election/v1"
+	idgeneratorv1 "github.com/atomix/atomix/api/runtime/idgenerator/v1"
 	indexedmapv1 "github.com/atomix/atomix/api/runtime/indexedmap/v1"
+	latchv1 "github.com/atomix/atomix/api/runtime/latch/v1"
 	listv1 "github.com/atomix/atomix/api/runtime/list/v1"
 	lockv1 "github.com/atomix/atomix/api/runtime/lock/v1"
 	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
+	membershipv1 "github.com/atomix/atomix/api/runtime/membership/v1"
 	multimapv1 "github.com/atomix/atomix/api/runtime/multimap/v1"
+	ratelimiterv1 "github.com/atomix/atomix/api/runtime/ratelimiter/v1"
+	rwlockv1 "github.com/atomix/atomix/api/runtime/rwlock/v1"
+	schedulerv1 "github.com/atomix/atomix/api/runtime/scheduler/v1"
+	semaphorev1 "github.com/atomix/atomix/api/runtime/semaphore/v1"
 	setv1 "github.com/atomix/atomix/api/runtime/set/v1"
+	shardassignmentv1 "github.com/atomix/atomix/api/runtime/shardassignment/v1"
 	topicv1 "github.com/atomix/atomix/api/runtime/topic/v1"
+	treev1 "github.com/atomix/atomix/api/runtime/tree/v1"
 	valuev1 "github.com/atomix/atomix/api/runtime/value/v1"
+	workqueuev1 "github.com/atomix/atomix/api/runtime/workqueue/v1"
 	"github.com/vpascoalr/atomix/runtime/pkg/network"
+	barrierproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/barrier/v1"
+	bloomfilterproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/bloomfilter/v1"
+	cardinalityproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/cardinality/v1"
 	counterproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/counter/v1"
 	countermapproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/countermap/v1"
 	electionproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/election/v1"
+	idgeneratorproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/idgenerator/v1"
 	indexedmapproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/indexedmap/v1"
+	latchproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/latch/v1"
 	listproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/list/v1"
 	lockproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/lock/v1"
 	mapproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/map/v1"
+	membershipproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/membership/v1"
 	multimapproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/multimap/v1"
+	ratelimiterproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/ratelimiter/v1"
+	rwlockproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/rwlock/v1"
+	schedulerproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/scheduler/v1"
+	semaphoreproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/semaphore/v1"
 	setproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/set/v1"
+	shardassignmentproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/shardassignment/v1"
 	topicproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/topic/v1"
+	treeproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/tree/v1"
 	runtime "github.com/vpascoalr/atomix/runtime/pkg/runtime/v1"
 	valueproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/value/v1"
+	workqueueproxyv1 "github.com/vpascoalr/atomix/runtime/pkg/runtime/workqueue/v1"
 	"github.com/vpascoalr/atomix/runtime/pkg/utils/grpc/interceptors"
 	"google.golang.org/grpc"
 )
 
 func register(server *grpc.Server, runtime *runtime.Runtime) {
+	barrierv1.RegisterBarrierServer(server, barrierproxyv1.NewBarrierServer(runtime))
+	barrierv1.RegisterBarriersServer(server, barrierproxyv1.NewBarriersServer(runtime))
 	counterv1.RegisterCounterServer(server, counterproxyv1.NewCounterServer(runtime))
 	counterv1.RegisterCountersServer(server, counterproxyv1.NewCountersServer(runtime))
 	countermapv1.RegisterCounterMapServer(server, countermapproxyv1.NewCounterMapServer(runtime))
 	countermapv1.RegisterCounterMapsServer(server, countermapproxyv1.NewCounterMapsServer(runtime))
 	electionv1.RegisterLeaderElectionServer(server, electionproxyv1.NewLeaderElectionServer(runtime))
 	electionv1.RegisterLeaderElectionsServer(server, electionproxyv1.NewLeaderElectionsServer(runtime))
+	idgeneratorv1.RegisterIDGeneratorServer(server, idgeneratorproxyv1.NewIDGeneratorServer(runtime))
+	idgeneratorv1.RegisterIDGeneratorsServer(server, idgeneratorproxyv1.NewIDGeneratorsServer(runtime))
 	indexedmapv1.RegisterIndexedMapServer(server, indexedmapproxyv1.NewIndexedMapServer(runtime))
 	indexedmapv1.RegisterIndexedMapsServer(server, indexedmapproxyv1.NewIndexedMapsServer(runtime))
+	latchv1.RegisterLatchServer(server, latchproxyv1.NewLatchServer(runtime))
+	latchv1.RegisterLatchesServer(server, latchproxyv1.NewLatchesServer(runtime))
 	listv1.RegisterListServer(server, listproxyv1.NewListServer(runtime))
 	listv1.RegisterListsServer(server, listproxyv1.NewListsServer(runtime))
 	lockv1.RegisterLockServer(server, lockproxyv1.NewLockServer(runtime))
+	membershipv1.RegisterMembershipServer(server, membershipproxyv1.NewMembershipServer(runtime))
 	lockv1.RegisterLocksServer(server, lockproxyv1.NewLocksServer(runtime))
+	membershipv1.RegisterMembershipsServer(server, membershipproxyv1.NewMembershipsServer(runtime))
 	mapv1.RegisterMapServer(server, mapproxyv1.NewMapServer(runtime))
 	mapv1.RegisterMapsServer(server, mapproxyv1.NewMapsServer(runtime))
 	multimapv1.RegisterMultiMapServer(server, multimapproxyv1.NewMultiMapServer(runtime))
 	multimapv1.RegisterMultiMapsServer(server, multimapproxyv1.NewMultiMapsServer(runtime))
+	rwlockv1.RegisterRWLockServer(server, rwlockproxyv1.NewRWLockServer(runtime))
+	shardassignmentv1.RegisterShardAssignmentServer(server, shardassignmentproxyv1.NewShardAssignmentServer(runtime))
+	rwlockv1.RegisterRWLocksServer(server, rwlockproxyv1.NewRWLocksServer(runtime))
+	shardassignmentv1.RegisterShardAssignmentsServer(server, shardassignmentproxyv1.NewShardAssignmentsServer(runtime))
+	ratelimiterv1.RegisterRateLimiterServer(server, ratelimiterproxyv1.NewRateLimiterServer(runtime))
+	schedulerv1.RegisterSchedulerServer(server, schedulerproxyv1.NewSchedulerServer(runtime))
+	cardinalityv1.RegisterCardinalityServer(server, cardinalityproxyv1.NewCardinalityServer(runtime))
+	bloomfilterv1.RegisterBloomFilterServer(server, bloomfilterproxyv1.NewBloomFilterServer(runtime))
+	semaphorev1.RegisterSemaphoreServer(server, semaphoreproxyv1.NewSemaphoreServer(runtime))
+	ratelimiterv1.RegisterRateLimitersServer(server, ratelimiterproxyv1.NewRateLimitersServer(runtime))
+	schedulerv1.RegisterSchedulersServer(server, schedulerproxyv1.NewSchedulersServer(runtime))
+	cardinalityv1.RegisterCardinalitiesServer(server, cardinalityproxyv1.NewCardinalitiesServer(runtime))
+	bloomfilterv1.RegisterBloomFiltersServer(server, bloomfilterproxyv1.NewBloomFiltersServer(runtime))
+	semaphorev1.RegisterSemaphoresServer(server, semaphoreproxyv1.NewSemaphoresServer(runtime))
 	setv1.RegisterSetServer(server, setproxyv1.NewSetServer(runtime))
 	setv1.RegisterSetsServer(server, setproxyv1.NewSetsServer(runtime))
 	topicv1.RegisterTopicServer(server, topicproxyv1.NewTopicServer(runtime))
+	treev1.RegisterTreeServer(server, treeproxyv1.NewTreeServer(runtime))
 	topicv1.RegisterTopicsServer(server, topicproxyv1.NewTopicsServer(runtime))
+	treev1.RegisterTreesServer(server, treeproxyv1.NewTreesServer(runtime))
 	valuev1.RegisterValueServer(server, valueproxyv1.NewValueServer(runtime))
 	valuev1.RegisterValuesServer(server, valueproxyv1.NewValuesServer(runtime))
+	workqueuev1.RegisterWorkQueueServer(server, workqueueproxyv1.NewWorkQueueServer(runtime))
+	workqueuev1.RegisterWorkQueuesServer(server, workqueueproxyv1.NewWorkQueuesServer(runtime))
 }
 
 type Service struct {
@@ -15,25 +15,55 @@ import (
 	"github.com/atomix/atomix/stores/raft/pkg/raft"
 	"github.com/spf13/cobra"
 	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	barriernodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/barrier/v1"
+	bloomfilternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/bloomfilter/v1"
+	cardinalitynodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/cardinality/v1"
 	counternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/counter/v1"
 	countermapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/countermap/v1"
 	electionnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/election/v1"
+	idgeneratornodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/idgenerator/v1"
 	indexedmapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/indexedmap/v1"
+	latchnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/latch/v1"
+	listnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/list/v1"
 	locknodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/lock/v1"
 	mapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/map/v1"
+	membershipnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/membership/v1"
 	multimapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/multimap/v1"
+	ratelimiternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/ratelimiter/v1"
+	rwlocknodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/rwlock/v1"
+	schedulernodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/scheduler/v1"
+	semaphorenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/semaphore/v1"
 	setnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/set/v1"
+	shardassignmentnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/shardassignment/v1"
+	topicnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/topic/v1"
+	treenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/tree/v1"
 	valuenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/value/v1"
+	workqueuenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/workqueue/v1"
 	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+	barrierv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/barrier/v1"
+	bloomfilterv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/bloomfilter/v1"
+	cardinalityv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/cardinality/v1"
 	counterv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/counter/v1"
 	countermapv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/countermap/v1"
 	electionv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/election/v1"
+	idgeneratorv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/idgenerator/v1"
 	indexedmapv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/indexedmap/v1"
+	latchv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/latch/v1"
+	listv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/list/v1"
 	lockv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/lock/v1"
 	mapv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/map/v1"
+	membershipv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/membership/v1"
 	multimapv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/multimap/v1"
+	ratelimiterv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/ratelimiter/v1"
+	rwlockv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/rwlock/v1"
+	schedulerv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/scheduler/v1"
+	semaphorev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/semaphore/v1"
 	setv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/set/v1"
+	shardassignmentv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/shardassignment/v1"
+	topicv1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/topic/v1"
+	treev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/tree/v1"
 	valuev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/value/v1"
+	workqueuev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/workqueue/v1"
 	"google.golang.org/grpc"
 	"gopkg.in/yaml.v3"
 )
@@ -80,15 +110,30 @@ func main() {
 			}
 
 			registry := statemachine.NewPrimitiveTypeRegistry()
+			barrierv1.RegisterStateMachine(registry)
 			counterv1.RegisterStateMachine(registry)
 			countermapv1.RegisterStateMachine(registry)
 			electionv1.RegisterStateMachine(registry)
+			idgeneratorv1.RegisterStateMachine(registry)
 			indexedmapv1.RegisterStateMachine(registry)
+			latchv1.RegisterStateMachine(registry)
+			listv1.RegisterStateMachine(registry)
 			lockv1.RegisterStateMachine(registry)
+			membershipv1.RegisterStateMachine(registry)
 			mapv1.RegisterStateMachine(registry)
 			multimapv1.RegisterStateMachine(registry)
+			rwlockv1.RegisterStateMachine(registry)
+			shardassignmentv1.RegisterStateMachine(registry)
+			ratelimiterv1.RegisterStateMachine(registry)
+			schedulerv1.RegisterStateMachine(registry)
+			cardinalityv1.RegisterStateMachine(registry)
+			bloomfilterv1.RegisterStateMachine(registry)
+			semaphorev1.RegisterStateMachine(registry)
 			setv1.RegisterStateMachine(registry)
+			topicv1.RegisterStateMachine(registry)
+			treev1.RegisterStateMachine(registry)
 			valuev1.RegisterStateMachine(registry)
+			workqueuev1.RegisterStateMachine(registry)
 
 			protocol := raft.NewProtocol(
 				config.Node,
@@ -102,15 +147,30 @@ func main() {
 				node.WithHost(apiHost),
 				node.WithPort(apiPort))
 
+			barriernodev1.RegisterServer(node)
 			counternodev1.RegisterServer(node)
 			countermapnodev1.RegisterServer(node)
 			electionnodev1.RegisterServer(node)
+			idgeneratornodev1.RegisterServer(node)
 			indexedmapnodev1.RegisterServer(node)
+			latchnodev1.RegisterServer(node)
+			listnodev1.RegisterServer(node)
 			locknodev1.RegisterServer(node)
+			membershipnodev1.RegisterServer(node)
 			mapnodev1.RegisterServer(node)
 			multimapnodev1.RegisterServer(node)
+			rwlocknodev1.RegisterServer(node)
+			shardassignmentnodev1.RegisterServer(node)
+			ratelimiternodev1.RegisterServer(node)
+			schedulernodev1.RegisterServer(node)
+			cardinalitynodev1.RegisterServer(node)
+			bloomfilternodev1.RegisterServer(node)
+			semaphorenodev1.RegisterServer(node)
 			setnodev1.RegisterServer(node)
+			topicnodev1.RegisterServer(node)
+			treenodev1.RegisterServer(node)
 			valuenodev1.RegisterServer(node)
+			workqueuenodev1.RegisterServer(node)
 			node.RegisterService(func(server *grpc.Server) {
 				raftv1.RegisterNodeServer(server, raft.NewNodeServer(protocol))
 			})
@@ -5,13 +5,13 @@ go 1.19
 require (
 	github.com/atomix/atomix/api v1.1.0
 	github.com/atomix/atomix/controller v1.0.1-0.20230301233247-275080a3c6af
-	github.com/atomix/atomix/runtime v1.1.2-0.20230331005836-16ae30b766af
+	github.com/atomix/atomix/runtime v1.1.0
 	github.com/cenkalti/backoff v2.2.1+incompatible
 	github.com/go-logr/logr v1.2.0
 	github.com/gogo/protobuf v1.3.2
 	github.com/lni/dragonboat/v3 v3.3.5
 	github.com/spf13/cobra v1.4.0
-	github.com/vpascoalr/atomix/protocols/rsm v0.0.0-20230912233300-3ba5593ae2b6
+	github.com/vpascoalr/atomix/protocols/rsm v0.0.0-00010101000000-000000000000
 	google.golang.org/grpc v1.46.0
 	gopkg.in/yaml.v3 v3.0.1
 	k8s.io/api v0.24.0
@@ -37,7 +37,7 @@ require (
 	github.com/cockroachdb/sentry-go v0.6.1-cockroachdb.2 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/emicklei/go-restful v2.9.5+incompatible // indirect
-	github.com/evanphx/json-patch v4.12.0+incompatible // indirect
+	github.com/evanphx/json-patch v5.6.0+incompatible // indirect
 	github.com/fsnotify/fsnotify v1.5.1 // indirect
 	github.com/go-openapi/jsonpointer v0.19.5 // indirect
 	github.com/go-openapi/jsonreference v0.19.5 // indirect
@@ -82,6 +82,7 @@ require (
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/valyala/fastrand v1.0.0 // indirect
 	github.com/valyala/histogram v1.0.1 // indirect
+	github.com/vpascoalr/atomix/runtime v0.0.0-00010101000000-000000000000 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
 	go.uber.org/zap v1.24.0 // indirect
@@ -107,3 +108,11 @@ require (
 	sigs.k8s.io/structured-merge-diff/v4 v4.2.1 // indirect
 	sigs.k8s.io/yaml v1.3.0 // indirect
 )
+
+replace github.com/atomix/atomix/api => ../../api
+
+replace github.com/atomix/atomix/controller => ../../controller
+
+replace github.com/vpascoalr/atomix/protocols/rsm => ../../protocols/rsm
+
+replace github.com/vpascoalr/atomix/runtime => ../../runtime
@@ -6,29 +6,59 @@ package main
 
 import (
 	"fmt"
-	"github.com/atomix/atomix/protocols/rsm/pkg/node"
-	counternodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/counter/v1"
-	countermapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/countermap/v1"
-	electionnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/election/v1"
-	indexedmapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/indexedmap/v1"
-	locknodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/lock/v1"
-	mapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/map/v1"
-	multimapnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/multimap/v1"
-	setnodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/set/v1"
-	valuenodev1 "github.com/atomix/atomix/protocols/rsm/pkg/node/value/v1"
-	"github.com/atomix/atomix/protocols/rsm/pkg/statemachine"
-	counterstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/counter/v1"
-	countermapstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/countermap/v1"
-	electionstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/election/v1"
-	indexedmapstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/indexedmap/v1"
-	lockstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/lock/v1"
-	mapstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/map/v1"
-	multimapstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/multimap/v1"
-	setstatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/set/v1"
-	valuestatemachinev1 "github.com/atomix/atomix/protocols/rsm/pkg/statemachine/value/v1"
 	"github.com/atomix/atomix/runtime/pkg/network"
 	sharedmemory "github.com/atomix/atomix/stores/shared-memory/pkg/node"
 	"github.com/spf13/cobra"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	barriernodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/barrier/v1"
+	bloomfilternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/bloomfilter/v1"
+	cardinalitynodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/cardinality/v1"
+	counternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/counter/v1"
+	countermapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/countermap/v1"
+	electionnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/election/v1"
+	idgeneratornodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/idgenerator/v1"
+	indexedmapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/indexedmap/v1"
+	latchnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/latch/v1"
+	listnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/list/v1"
+	locknodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/lock/v1"
+	mapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/map/v1"
+	membershipnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/membership/v1"
+	multimapnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/multimap/v1"
+	ratelimiternodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/ratelimiter/v1"
+	rwlocknodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/rwlock/v1"
+	schedulernodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/scheduler/v1"
+	semaphorenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/semaphore/v1"
+	setnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/set/v1"
+	shardassignmentnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/shardassignment/v1"
+	topicnodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/topic/v1"
+	treenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/tree/v1"
+	valuenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/value/v1"
+	workqueuenodev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/node/workqueue/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
+	barrierstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/barrier/v1"
+	bloomfilterstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/bloomfilter/v1"
+	cardinalitystatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/cardinality/v1"
+	counterstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/counter/v1"
+	countermapstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/countermap/v1"
+	electionstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/election/v1"
+	idgeneratorstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/idgenerator/v1"
+	indexedmapstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/indexedmap/v1"
+	latchstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/latch/v1"
+	liststatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/list/v1"
+	lockstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/lock/v1"
+	mapstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/map/v1"
+	membershipstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/membership/v1"
+	multimapstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/multimap/v1"
+	ratelimiterstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/ratelimiter/v1"
+	rwlockstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/rwlock/v1"
+	schedulerstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/scheduler/v1"
+	semaphorestatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/semaphore/v1"
+	setstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/set/v1"
+	shardassignmentstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/shardassignment/v1"
+	topicstatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/topic/v1"
+	treestatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/tree/v1"
+	valuestatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/value/v1"
+	workqueuestatemachinev1 "github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine/workqueue/v1"
 	"gopkg.in/yaml.v3"
 	"os"
 	"os/signal"
@@ -67,15 +97,30 @@ func main() {
 			}
 
 			registry := statemachine.NewPrimitiveTypeRegistry()
+			barrierstatemachinev1.RegisterStateMachine(registry)
 			counterstatemachinev1.RegisterStateMachine(registry)
 			countermapstatemachinev1.RegisterStateMachine(registry)
 			electionstatemachinev1.RegisterStateMachine(registry)
+			idgeneratorstatemachinev1.RegisterStateMachine(registry)
 			indexedmapstatemachinev1.RegisterStateMachine(registry)
+			latchstatemachinev1.RegisterStateMachine(registry)
+			liststatemachinev1.RegisterStateMachine(registry)
 			lockstatemachinev1.RegisterStateMachine(registry)
+			membershipstatemachinev1.RegisterStateMachine(registry)
 			mapstatemachinev1.RegisterStateMachine(registry)
 			multimapstatemachinev1.RegisterStateMachine(registry)
+			rwlockstatemachinev1.RegisterStateMachine(registry)
+			shardassignmentstatemachinev1.RegisterStateMachine(registry)
+			ratelimiterstatemachinev1.RegisterStateMachine(registry)
+			schedulerstatemachinev1.RegisterStateMachine(registry)
+			cardinalitystatemachinev1.RegisterStateMachine(registry)
+			bloomfilterstatemachinev1.RegisterStateMachine(registry)
+			semaphorestatemachinev1.RegisterStateMachine(registry)
 			setstatemachinev1.RegisterStateMachine(registry)
+			topicstatemachinev1.RegisterStateMachine(registry)
+			treestatemachinev1.RegisterStateMachine(registry)
 			valuestatemachinev1.RegisterStateMachine(registry)
+			workqueuestatemachinev1.RegisterStateMachine(registry)
 
 			node := node.NewNode(
 				network.NewDefaultDriver(),
@@ -83,15 +128,30 @@ func main() {
 				node.WithHost(host),
 				node.WithPort(port))
 
+			barriernodev1.RegisterServer(node)
 			counternodev1.RegisterServer(node)
 			countermapnodev1.RegisterServer(node)
 			electionnodev1.RegisterServer(node)
+			idgeneratornodev1.RegisterServer(node)
 			indexedmapnodev1.RegisterServer(node)
+			latchnodev1.RegisterServer(node)
+			listnodev1.RegisterServer(node)
 			locknodev1.RegisterServer(node)
+			membershipnodev1.RegisterServer(node)
 			mapnodev1.RegisterServer(node)
 			multimapnodev1.RegisterServer(node)
+			rwlocknodev1.RegisterServer(node)
+			shardassignmentnodev1.RegisterServer(node)
+			ratelimiternodev1.RegisterServer(node)
+			schedulernodev1.RegisterServer(node)
+			cardinalitynodev1.RegisterServer(node)
+			bloomfilternodev1.RegisterServer(node)
+			semaphorenodev1.RegisterServer(node)
 			setnodev1.RegisterServer(node)
+			topicnodev1.RegisterServer(node)
+			treenodev1.RegisterServer(node)
 			valuenodev1.RegisterServer(node)
+			workqueuenodev1.RegisterServer(node)
 
 			// Start the node
 			if err := node.Start(); err != nil {
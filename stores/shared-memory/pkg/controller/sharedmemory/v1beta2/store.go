@@ -8,10 +8,10 @@ import (
 	"context"
 	"fmt"
 	atomixv3beta4 "github.com/atomix/atomix/controller/pkg/apis/atomix/v3beta4"
-	rsmv1 "github.com/atomix/atomix/protocols/rsm/api/v1"
 	"github.com/atomix/atomix/runtime/pkg/logging"
 	"github.com/atomix/atomix/stores/shared-memory/pkg/node"
 	"github.com/gogo/protobuf/jsonpb"
+	rsmv1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
 	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
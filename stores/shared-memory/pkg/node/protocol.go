@@ -6,10 +6,10 @@ package node
 
 import (
 	"context"
-	rsmv1 "github.com/atomix/atomix/protocols/rsm/api/v1"
-	"github.com/atomix/atomix/protocols/rsm/pkg/node"
-	"github.com/atomix/atomix/protocols/rsm/pkg/statemachine"
 	streams "github.com/atomix/atomix/runtime/pkg/stream"
+	rsmv1 "github.com/vpascoalr/atomix/protocols/rsm/api/v1"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/node"
+	"github.com/vpascoalr/atomix/protocols/rsm/pkg/statemachine"
 	"sync"
 )
 
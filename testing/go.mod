@@ -3,10 +3,10 @@ module github.com/atomix/atomix/testing
 go 1.19
 
 require (
-	github.com/atomix/atomix/api v1.0.0
-	github.com/atomix/atomix/runtime v1.0.0
+	github.com/atomix/atomix/api v1.1.0
 	github.com/dustinkirkland/golang-petname v0.0.0-20191129215211-8e5a1ed0cff0
 	github.com/onosproject/helmit v1.1.3
+	github.com/vpascoalr/atomix/runtime v0.0.0-20230912233300-3ba5593ae2b6
 	google.golang.org/grpc v1.49.0
 )
 
@@ -143,3 +143,7 @@ require (
 	sigs.k8s.io/structured-merge-diff/v4 v4.2.3 // indirect
 	sigs.k8s.io/yaml v1.3.0 // indirect
 )
+
+replace github.com/atomix/atomix/api => ../api
+
+replace github.com/vpascoalr/atomix/runtime => ../runtime
@@ -7,9 +7,9 @@ package benchmarks
 import (
 	"context"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
-	"github.com/atomix/atomix/runtime/pkg/utils/grpc/interceptors"
 	petname "github.com/dustinkirkland/golang-petname"
 	"github.com/onosproject/helmit/pkg/benchmark"
+	"github.com/vpascoalr/atomix/runtime/pkg/utils/grpc/interceptors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"time"
+
+	barrierv1 "github.com/atomix/atomix/api/runtime/barrier/v1"
+)
+
+type BarrierTestSuite struct {
+	PrimitiveTestSuite
+	barrierv1.BarrierClient
+}
+
+func (s *BarrierTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.BarrierClient = barrierv1.NewBarrierClient(s.conn)
+}
+
+func (s *BarrierTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &barrierv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *BarrierTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &barrierv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *BarrierTestSuite) TestAwait() {
+	responseCh := make(chan *barrierv1.AwaitResponse, 1)
+	go func() {
+		response, err := s.Await(s.Context(), &barrierv1.AwaitRequest{
+			ID:      s.ID,
+			Parties: 2,
+		})
+		s.NoError(err)
+		responseCh <- response
+	}()
+
+	// Wait for the first party to arrive before tripping the barrier
+	for {
+		response, err := s.GetBarrier(s.Context(), &barrierv1.GetBarrierRequest{
+			ID: s.ID,
+		})
+		s.NoError(err)
+		if response.Waiting == 1 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	response, err := s.Await(s.Context(), &barrierv1.AwaitRequest{
+		ID:      s.ID,
+		Parties: 2,
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), response.Generation)
+	s.Equal(uint32(1), response.Index)
+
+	response = <-responseCh
+	s.Equal(uint64(0), response.Generation)
+	s.Equal(uint32(0), response.Index)
+
+	getResponse, err := s.GetBarrier(s.Context(), &barrierv1.GetBarrierRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), getResponse.Generation)
+	s.Equal(uint32(0), getResponse.Waiting)
+}
+
+func (s *BarrierTestSuite) TestBroken() {
+	timeout := time.Second
+	_, err := s.Await(s.Context(), &barrierv1.AwaitRequest{
+		ID:      s.ID,
+		Parties: 2,
+		Timeout: &timeout,
+	})
+	s.ErrorConflict(err)
+
+	getResponse, err := s.GetBarrier(s.Context(), &barrierv1.GetBarrierRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.True(getResponse.Broken)
+
+	_, err = s.Await(s.Context(), &barrierv1.AwaitRequest{
+		ID:      s.ID,
+		Parties: 1,
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.Reset(s.Context(), &barrierv1.ResetRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	response, err := s.Await(s.Context(), &barrierv1.AwaitRequest{
+		ID:      s.ID,
+		Parties: 1,
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), response.Generation)
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"fmt"
+
+	bloomfilterv1 "github.com/atomix/atomix/api/runtime/bloomfilter/v1"
+)
+
+type BloomFilterTestSuite struct {
+	PrimitiveTestSuite
+	bloomfilterv1.BloomFilterClient
+}
+
+func (s *BloomFilterTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.BloomFilterClient = bloomfilterv1.NewBloomFilterClient(s.conn)
+}
+
+func (s *BloomFilterTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &bloomfilterv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *BloomFilterTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &bloomfilterv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *BloomFilterTestSuite) TestAdd() {
+	containsResponse, err := s.MightContain(s.Context(), &bloomfilterv1.MightContainRequest{
+		ID:      s.ID,
+		Element: "foo",
+	})
+	s.NoError(err)
+	s.False(containsResponse.Result)
+
+	addResponse, err := s.Add(s.Context(), &bloomfilterv1.AddRequest{
+		ID:      s.ID,
+		Element: "foo",
+	})
+	s.NoError(err)
+	s.True(addResponse.Added)
+
+	addResponse, err = s.Add(s.Context(), &bloomfilterv1.AddRequest{
+		ID:      s.ID,
+		Element: "foo",
+	})
+	s.NoError(err)
+	s.False(addResponse.Added)
+
+	containsResponse, err = s.MightContain(s.Context(), &bloomfilterv1.MightContainRequest{
+		ID:      s.ID,
+		Element: "foo",
+	})
+	s.NoError(err)
+	s.True(containsResponse.Result)
+}
+
+func (s *BloomFilterTestSuite) TestFalsePositives() {
+	for i := 0; i < 1000; i++ {
+		_, err := s.Add(s.Context(), &bloomfilterv1.AddRequest{
+			ID:      s.ID,
+			Element: fmt.Sprintf("added-%d", i),
+		})
+		s.NoError(err)
+	}
+
+	// Added elements are always reported
+	for i := 0; i < 1000; i++ {
+		response, err := s.MightContain(s.Context(), &bloomfilterv1.MightContainRequest{
+			ID:      s.ID,
+			Element: fmt.Sprintf("added-%d", i),
+		})
+		s.NoError(err)
+		s.True(response.Result)
+	}
+
+	// The filter is sized for 10000 elements with a 1% false positive rate, so few other elements are reported
+	var positives int
+	for i := 0; i < 1000; i++ {
+		response, err := s.MightContain(s.Context(), &bloomfilterv1.MightContainRequest{
+			ID:      s.ID,
+			Element: fmt.Sprintf("missing-%d", i),
+		})
+		s.NoError(err)
+		if response.Result {
+			positives++
+		}
+	}
+	s.Less(positives, 10)
+}
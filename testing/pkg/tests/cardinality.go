@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"fmt"
+
+	cardinalityv1 "github.com/atomix/atomix/api/runtime/cardinality/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
+)
+
+type CardinalityTestSuite struct {
+	PrimitiveTestSuite
+	cardinalityv1.CardinalityClient
+}
+
+func (s *CardinalityTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.CardinalityClient = cardinalityv1.NewCardinalityClient(s.conn)
+}
+
+func (s *CardinalityTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &cardinalityv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *CardinalityTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &cardinalityv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *CardinalityTestSuite) TestEmpty() {
+	response, err := s.Count(s.Context(), &cardinalityv1.CountRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), response.Count)
+}
+
+func (s *CardinalityTestSuite) TestAdd() {
+	addResponse, err := s.Add(s.Context(), &cardinalityv1.AddRequest{
+		ID:       s.ID,
+		Elements: []string{"foo", "bar"},
+	})
+	s.NoError(err)
+	s.True(addResponse.Updated)
+
+	addResponse, err = s.Add(s.Context(), &cardinalityv1.AddRequest{
+		ID:       s.ID,
+		Elements: []string{"foo", "bar", "foo"},
+	})
+	s.NoError(err)
+	s.False(addResponse.Updated)
+
+	countResponse, err := s.Count(s.Context(), &cardinalityv1.CountRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(2), countResponse.Count)
+}
+
+func (s *CardinalityTestSuite) TestCount() {
+	for i := 0; i < 10; i++ {
+		elements := make([]string, 0, 1000)
+		for j := 0; j < 1000; j++ {
+			elements = append(elements, fmt.Sprintf("element-%d", i*1000+j))
+		}
+		_, err := s.Add(s.Context(), &cardinalityv1.AddRequest{
+			ID:       s.ID,
+			Elements: elements,
+		})
+		s.NoError(err)
+	}
+
+	response, err := s.Count(s.Context(), &cardinalityv1.CountRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.InEpsilon(10000, response.Count, 0.05)
+}
+
+func (s *CardinalityTestSuite) TestMerge() {
+	elements := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		elements = append(elements, fmt.Sprintf("element-%d", i))
+	}
+	_, err := s.Add(s.Context(), &cardinalityv1.AddRequest{
+		ID:       s.ID,
+		Elements: elements,
+	})
+	s.NoError(err)
+
+	sketchResponse, err := s.GetSketch(s.Context(), &cardinalityv1.GetSketchRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(14), sketchResponse.Sketch.Precision)
+	s.Len(sketchResponse.Sketch.Registers, 1<<14)
+
+	countResponse, err := s.Count(s.Context(), &cardinalityv1.CountRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	count := countResponse.Count
+
+	// Merge the sketch into another primitive
+	other := runtimev1.PrimitiveID{
+		Name: s.ID.Name + "-other",
+	}
+	_, err = s.Create(s.Context(), &cardinalityv1.CreateRequest{
+		ID: other,
+	})
+	s.NoError(err)
+	defer func() {
+		_, err := s.Close(s.Context(), &cardinalityv1.CloseRequest{
+			ID: other,
+		})
+		s.NoError(err)
+	}()
+
+	_, err = s.Merge(s.Context(), &cardinalityv1.MergeRequest{
+		ID:     other,
+		Sketch: sketchResponse.Sketch,
+	})
+	s.NoError(err)
+
+	countResponse, err = s.Count(s.Context(), &cardinalityv1.CountRequest{
+		ID: other,
+	})
+	s.NoError(err)
+	s.Equal(count, countResponse.Count)
+
+	// Merging the same sketch again doesn't change the count
+	_, err = s.Merge(s.Context(), &cardinalityv1.MergeRequest{
+		ID:     other,
+		Sketch: sketchResponse.Sketch,
+	})
+	s.NoError(err)
+
+	countResponse, err = s.Count(s.Context(), &cardinalityv1.CountRequest{
+		ID: other,
+	})
+	s.NoError(err)
+	s.Equal(count, countResponse.Count)
+
+	_, err = s.Merge(s.Context(), &cardinalityv1.MergeRequest{
+		ID: other,
+		Sketch: cardinalityv1.Sketch{
+			Precision: 10,
+			Registers: make([]byte, 1<<10),
+		},
+	})
+	s.ErrorInvalid(err)
+}
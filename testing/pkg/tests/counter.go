@@ -5,6 +5,8 @@
 package tests
 
 import (
+	"math"
+
 	counterv1 "github.com/atomix/atomix/api/runtime/counter/v1"
 )
 
@@ -180,3 +182,141 @@ func (s *CounterTestSuite) TestDecrementDelta() {
 	s.NoError(err)
 	s.Equal(int64(10), getResponse.Value)
 }
+
+func (s *CounterTestSuite) TestBounds() {
+	_, err := s.SetBounds(s.Context(), &counterv1.SetBoundsRequest{
+		ID: s.ID,
+		Bounds: &counterv1.Bounds{
+			Min: &counterv1.Bound{Value: 10},
+			Max: &counterv1.Bound{Value: 0},
+		},
+	})
+	s.ErrorInvalid(err)
+
+	// The value is moved within the new bounds
+	setBoundsResponse, err := s.SetBounds(s.Context(), &counterv1.SetBoundsRequest{
+		ID: s.ID,
+		Bounds: &counterv1.Bounds{
+			Min: &counterv1.Bound{Value: 1},
+			Max: &counterv1.Bound{Value: 10},
+		},
+	})
+	s.NoError(err)
+	s.Equal(int64(1), setBoundsResponse.Value)
+
+	getResponse, err := s.Get(s.Context(), &counterv1.GetRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(int64(1), getResponse.Value)
+	s.NotNil(getResponse.Bounds)
+	s.Equal(int64(1), getResponse.Bounds.Min.Value)
+	s.Equal(int64(10), getResponse.Bounds.Max.Value)
+
+	_, err = s.Decrement(s.Context(), &counterv1.DecrementRequest{
+		ID:    s.ID,
+		Delta: 1,
+	})
+	s.ErrorOutOfRange(err)
+
+	_, err = s.Set(s.Context(), &counterv1.SetRequest{
+		ID:    s.ID,
+		Value: 11,
+	})
+	s.ErrorOutOfRange(err)
+
+	incResponse, err := s.Increment(s.Context(), &counterv1.IncrementRequest{
+		ID:    s.ID,
+		Delta: 9,
+	})
+	s.NoError(err)
+	s.Equal(int64(10), incResponse.Value)
+
+	_, err = s.Increment(s.Context(), &counterv1.IncrementRequest{
+		ID:    s.ID,
+		Delta: 1,
+	})
+	s.ErrorOutOfRange(err)
+
+	// Removing the bounds allows the counter to grow again
+	_, err = s.SetBounds(s.Context(), &counterv1.SetBoundsRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	incResponse, err = s.Increment(s.Context(), &counterv1.IncrementRequest{
+		ID:    s.ID,
+		Delta: 1,
+	})
+	s.NoError(err)
+	s.Equal(int64(11), incResponse.Value)
+}
+
+func (s *CounterTestSuite) TestSaturate() {
+	_, err := s.SetBounds(s.Context(), &counterv1.SetBoundsRequest{
+		ID: s.ID,
+		Bounds: &counterv1.Bounds{
+			Min: &counterv1.Bound{Value: -5},
+			Max: &counterv1.Bound{Value: 5},
+		},
+	})
+	s.NoError(err)
+
+	incResponse, err := s.Increment(s.Context(), &counterv1.IncrementRequest{
+		ID:       s.ID,
+		Delta:    10,
+		Saturate: true,
+	})
+	s.NoError(err)
+	s.Equal(int64(5), incResponse.Value)
+
+	decResponse, err := s.Decrement(s.Context(), &counterv1.DecrementRequest{
+		ID:       s.ID,
+		Delta:    20,
+		Saturate: true,
+	})
+	s.NoError(err)
+	s.Equal(int64(-5), decResponse.Value)
+
+	getResponse, err := s.Get(s.Context(), &counterv1.GetRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(int64(-5), getResponse.Value)
+}
+
+func (s *CounterTestSuite) TestOverflow() {
+	_, err := s.Set(s.Context(), &counterv1.SetRequest{
+		ID:    s.ID,
+		Value: math.MaxInt64,
+	})
+	s.NoError(err)
+
+	_, err = s.Increment(s.Context(), &counterv1.IncrementRequest{
+		ID:    s.ID,
+		Delta: 1,
+	})
+	s.ErrorOutOfRange(err)
+
+	getResponse, err := s.Get(s.Context(), &counterv1.GetRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(int64(math.MaxInt64), getResponse.Value)
+
+	_, err = s.SetBounds(s.Context(), &counterv1.SetBoundsRequest{
+		ID: s.ID,
+		Bounds: &counterv1.Bounds{
+			Max: &counterv1.Bound{Value: math.MaxInt64},
+		},
+	})
+	s.NoError(err)
+
+	incResponse, err := s.Increment(s.Context(), &counterv1.IncrementRequest{
+		ID:       s.ID,
+		Delta:    1,
+		Saturate: true,
+	})
+	s.NoError(err)
+	s.Equal(int64(math.MaxInt64), incResponse.Value)
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	electionv1 "github.com/atomix/atomix/api/runtime/election/v1"
+)
+
+type LeaderElectionTestSuite struct {
+	PrimitiveTestSuite
+	electionv1.LeaderElectionClient
+}
+
+func (s *LeaderElectionTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.LeaderElectionClient = electionv1.NewLeaderElectionClient(s.conn)
+}
+
+func (s *LeaderElectionTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &electionv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *LeaderElectionTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &electionv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *LeaderElectionTestSuite) TestPriority() {
+	response, err := s.Enter(s.Context(), &electionv1.EnterRequest{
+		ID:        s.ID,
+		Candidate: "foo",
+		Priority:  1,
+		Metadata:  map[string]string{"zone": "a"},
+	})
+	s.NoError(err)
+	s.Equal("foo", response.Term.Leader)
+
+	_, err = s.Enter(s.Context(), &electionv1.EnterRequest{
+		ID:        s.ID,
+		Candidate: "bar",
+		Priority:  1,
+	})
+	s.NoError(err)
+
+	// Higher-priority candidates are ordered ahead of lower-priority candidates but don't replace the leader
+	response, err = s.Enter(s.Context(), &electionv1.EnterRequest{
+		ID:        s.ID,
+		Candidate: "baz",
+		Priority:  2,
+		Metadata:  map[string]string{"zone": "b"},
+	})
+	s.NoError(err)
+	s.Equal("foo", response.Term.Leader)
+	s.Equal([]string{"baz", "bar"}, response.Term.Candidates)
+	s.Len(response.Term.CandidateInfo, 3)
+	s.Equal("foo", response.Term.CandidateInfo[0].Name)
+	s.Equal("a", response.Term.CandidateInfo[0].Metadata["zone"])
+	s.Equal("baz", response.Term.CandidateInfo[1].Name)
+	s.Equal(int32(2), response.Term.CandidateInfo[1].Priority)
+	s.Equal("b", response.Term.CandidateInfo[1].Metadata["zone"])
+
+	// The highest-priority candidate is elected when the leader is evicted
+	evictResponse, err := s.Evict(s.Context(), &electionv1.EvictRequest{
+		ID:        s.ID,
+		Candidate: "foo",
+	})
+	s.NoError(err)
+	s.Equal("baz", evictResponse.Term.Leader)
+	s.Equal([]string{"bar"}, evictResponse.Term.Candidates)
+}
+
+func (s *LeaderElectionTestSuite) TestPreempt() {
+	response, err := s.Enter(s.Context(), &electionv1.EnterRequest{
+		ID:        s.ID,
+		Candidate: "foo",
+		Priority:  1,
+	})
+	s.NoError(err)
+	s.Equal("foo", response.Term.Leader)
+	term := response.Term.Term
+
+	// Candidates with a priority no higher than the leader's cannot preempt the leader
+	response, err = s.Enter(s.Context(), &electionv1.EnterRequest{
+		ID:        s.ID,
+		Candidate: "bar",
+		Priority:  1,
+		Preempt:   true,
+	})
+	s.NoError(err)
+	s.Equal("foo", response.Term.Leader)
+	s.Equal(term, response.Term.Term)
+
+	response, err = s.Enter(s.Context(), &electionv1.EnterRequest{
+		ID:        s.ID,
+		Candidate: "baz",
+		Priority:  2,
+		Preempt:   true,
+	})
+	s.NoError(err)
+	s.Equal("baz", response.Term.Leader)
+	s.Equal([]string{"foo", "bar"}, response.Term.Candidates)
+	s.Greater(response.Term.Term, term)
+}
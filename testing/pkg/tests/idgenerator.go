@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	idgeneratorv1 "github.com/atomix/atomix/api/runtime/idgenerator/v1"
+)
+
+type IDGeneratorTestSuite struct {
+	PrimitiveTestSuite
+	idgeneratorv1.IDGeneratorClient
+}
+
+func (s *IDGeneratorTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.IDGeneratorClient = idgeneratorv1.NewIDGeneratorClient(s.conn)
+}
+
+func (s *IDGeneratorTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &idgeneratorv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *IDGeneratorTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &idgeneratorv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *IDGeneratorTestSuite) TestNextID() {
+	var last uint64
+	for i := 0; i < 100; i++ {
+		response, err := s.NextID(s.Context(), &idgeneratorv1.NextIDRequest{
+			ID: s.ID,
+		})
+		s.NoError(err)
+		s.Greater(response.ID, last)
+		last = response.ID
+	}
+}
+
+func (s *IDGeneratorTestSuite) TestReopen() {
+	response, err := s.NextID(s.Context(), &idgeneratorv1.NextIDRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	last := response.ID
+
+	_, err = s.Close(s.Context(), &idgeneratorv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	_, err = s.Create(s.Context(), &idgeneratorv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	// IDs remaining in the block held by the closed generator must not be reused
+	response, err = s.NextID(s.Context(), &idgeneratorv1.NextIDRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Greater(response.ID, last+1)
+}
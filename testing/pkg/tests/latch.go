@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"time"
+
+	latchv1 "github.com/atomix/atomix/api/runtime/latch/v1"
+)
+
+type LatchTestSuite struct {
+	PrimitiveTestSuite
+	latchv1.LatchClient
+}
+
+func (s *LatchTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.LatchClient = latchv1.NewLatchClient(s.conn)
+}
+
+func (s *LatchTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &latchv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *LatchTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &latchv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *LatchTestSuite) TestCountDown() {
+	getResponse, err := s.GetCount(s.Context(), &latchv1.GetCountRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), getResponse.Count)
+
+	countDownResponse, err := s.CountDown(s.Context(), &latchv1.CountDownRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), countDownResponse.Count)
+
+	countDownResponse, err = s.CountDown(s.Context(), &latchv1.CountDownRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), countDownResponse.Count)
+
+	_, err = s.Await(s.Context(), &latchv1.AwaitRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *LatchTestSuite) TestAwait() {
+	timeout := time.Second
+	_, err := s.Await(s.Context(), &latchv1.AwaitRequest{
+		ID:      s.ID,
+		Timeout: &timeout,
+	})
+	s.ErrorTimeout(err)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.Await(s.Context(), &latchv1.AwaitRequest{
+			ID: s.ID,
+		})
+		errCh <- err
+	}()
+
+	_, err = s.CountDown(s.Context(), &latchv1.CountDownRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.NoError(<-errCh)
+}
@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	listv1 "github.com/atomix/atomix/api/runtime/list/v1"
+)
+
+type ListTestSuite struct {
+	PrimitiveTestSuite
+	listv1.ListClient
+}
+
+func (s *ListTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.ListClient = listv1.NewListClient(s.conn)
+}
+
+func (s *ListTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &listv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *ListTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &listv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *ListTestSuite) TestDefault() {
+	response, err := s.Size(s.Context(), &listv1.SizeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(0), response.Size_)
+
+	_, err = s.Get(s.Context(), &listv1.GetRequest{
+		ID:    s.ID,
+		Index: 0,
+	})
+	s.ErrorNotFound(err)
+}
+
+func (s *ListTestSuite) TestAppend() {
+	_, err := s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("foo"),
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("bar"),
+		},
+	})
+	s.NoError(err)
+
+	sizeResponse, err := s.Size(s.Context(), &listv1.SizeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(2), sizeResponse.Size_)
+
+	getResponse, err := s.Get(s.Context(), &listv1.GetRequest{
+		ID:    s.ID,
+		Index: 0,
+	})
+	s.NoError(err)
+	s.Equal(uint32(0), getResponse.Item.Index)
+	s.Equal("foo", string(getResponse.Item.Value.Value))
+
+	getResponse, err = s.Get(s.Context(), &listv1.GetRequest{
+		ID:    s.ID,
+		Index: 1,
+	})
+	s.NoError(err)
+	s.Equal(uint32(1), getResponse.Item.Index)
+	s.Equal("bar", string(getResponse.Item.Value.Value))
+}
+
+func (s *ListTestSuite) TestInsert() {
+	_, err := s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("foo"),
+		},
+	})
+	s.NoError(err)
+
+	insertResponse, err := s.Insert(s.Context(), &listv1.InsertRequest{
+		ID:    s.ID,
+		Index: 0,
+		Value: listv1.Value{
+			Value: []byte("bar"),
+		},
+	})
+	s.NoError(err)
+	s.Equal(uint32(0), insertResponse.Item.Index)
+	s.Equal("bar", string(insertResponse.Item.Value.Value))
+
+	getResponse, err := s.Get(s.Context(), &listv1.GetRequest{
+		ID:    s.ID,
+		Index: 0,
+	})
+	s.NoError(err)
+	s.Equal("bar", string(getResponse.Item.Value.Value))
+
+	getResponse, err = s.Get(s.Context(), &listv1.GetRequest{
+		ID:    s.ID,
+		Index: 1,
+	})
+	s.NoError(err)
+	s.Equal("foo", string(getResponse.Item.Value.Value))
+}
+
+func (s *ListTestSuite) TestSet() {
+	_, err := s.Set(s.Context(), &listv1.SetRequest{
+		ID:    s.ID,
+		Index: 0,
+		Value: listv1.Value{
+			Value: []byte("foo"),
+		},
+	})
+	s.ErrorNotFound(err)
+
+	_, err = s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("foo"),
+		},
+	})
+	s.NoError(err)
+
+	setResponse, err := s.Set(s.Context(), &listv1.SetRequest{
+		ID:    s.ID,
+		Index: 0,
+		Value: listv1.Value{
+			Value: []byte("bar"),
+		},
+	})
+	s.NoError(err)
+	s.Equal(uint32(0), setResponse.Item.Index)
+	s.Equal("bar", string(setResponse.Item.Value.Value))
+
+	getResponse, err := s.Get(s.Context(), &listv1.GetRequest{
+		ID:    s.ID,
+		Index: 0,
+	})
+	s.NoError(err)
+	s.Equal("bar", string(getResponse.Item.Value.Value))
+}
+
+func (s *ListTestSuite) TestRemove() {
+	_, err := s.Remove(s.Context(), &listv1.RemoveRequest{
+		ID:    s.ID,
+		Index: 0,
+	})
+	s.ErrorNotFound(err)
+
+	_, err = s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("foo"),
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("bar"),
+		},
+	})
+	s.NoError(err)
+
+	removeResponse, err := s.Remove(s.Context(), &listv1.RemoveRequest{
+		ID:    s.ID,
+		Index: 0,
+	})
+	s.NoError(err)
+	s.Equal("foo", string(removeResponse.Item.Value.Value))
+
+	getResponse, err := s.Get(s.Context(), &listv1.GetRequest{
+		ID:    s.ID,
+		Index: 0,
+	})
+	s.NoError(err)
+	s.Equal("bar", string(getResponse.Item.Value.Value))
+
+	sizeResponse, err := s.Size(s.Context(), &listv1.SizeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(1), sizeResponse.Size_)
+}
+
+func (s *ListTestSuite) TestClear() {
+	_, err := s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("foo"),
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("bar"),
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Clear(s.Context(), &listv1.ClearRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	sizeResponse, err := s.Size(s.Context(), &listv1.SizeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(0), sizeResponse.Size_)
+}
+
+func (s *ListTestSuite) TestItems() {
+	_, err := s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("foo"),
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Append(s.Context(), &listv1.AppendRequest{
+		ID: s.ID,
+		Value: listv1.Value{
+			Value: []byte("bar"),
+		},
+	})
+	s.NoError(err)
+
+	stream, err := s.Items(s.Context(), &listv1.ItemsRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(uint32(0), response.Item.Index)
+	s.Equal("foo", string(response.Item.Value.Value))
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal(uint32(1), response.Item.Index)
+	s.Equal("bar", string(response.Item.Value.Value))
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	lockv1 "github.com/atomix/atomix/api/runtime/lock/v1"
+)
+
+type LockTestSuite struct {
+	PrimitiveTestSuite
+	lockv1.LockClient
+}
+
+func (s *LockTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.LockClient = lockv1.NewLockClient(s.conn)
+}
+
+func (s *LockTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &lockv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *LockTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &lockv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *LockTestSuite) TestGetLock() {
+	_, err := s.GetLock(s.Context(), &lockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.ErrorNotFound(err)
+
+	lockResponse, err := s.Lock(s.Context(), &lockv1.LockRequest{
+		ID:    s.ID,
+		Label: "foo",
+	})
+	s.NoError(err)
+	s.NotEqual(uint64(0), lockResponse.Version)
+
+	getResponse, err := s.GetLock(s.Context(), &lockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(lockResponse.Version, getResponse.Version)
+	s.NotEmpty(getResponse.Holder.ID)
+	s.Equal("foo", getResponse.Holder.Label)
+	s.Equal(uint32(1), getResponse.Holds)
+	s.Equal(uint32(0), getResponse.Waiters)
+
+	_, err = s.Unlock(s.Context(), &lockv1.UnlockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	_, err = s.GetLock(s.Context(), &lockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.ErrorNotFound(err)
+}
+
+func (s *LockTestSuite) TestReentrantLock() {
+	lock1, err := s.Lock(s.Context(), &lockv1.LockRequest{
+		ID:        s.ID,
+		Reentrant: true,
+	})
+	s.NoError(err)
+	s.Equal(uint32(1), lock1.Holds)
+
+	lock2, err := s.Lock(s.Context(), &lockv1.LockRequest{
+		ID:        s.ID,
+		Reentrant: true,
+	})
+	s.NoError(err)
+	s.Equal(lock1.Version, lock2.Version)
+	s.Equal(uint32(2), lock2.Holds)
+
+	getResponse, err := s.GetLock(s.Context(), &lockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(2), getResponse.Holds)
+
+	// The lock is released once it has been unlocked as many times as it was acquired
+	_, err = s.Unlock(s.Context(), &lockv1.UnlockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	getResponse, err = s.GetLock(s.Context(), &lockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(1), getResponse.Holds)
+
+	_, err = s.Unlock(s.Context(), &lockv1.UnlockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	_, err = s.GetLock(s.Context(), &lockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.ErrorNotFound(err)
+}
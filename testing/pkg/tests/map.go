@@ -5,7 +5,13 @@
 package tests
 
 import (
+	"fmt"
+	"io"
+	"time"
+
+	lockv1 "github.com/atomix/atomix/api/runtime/lock/v1"
 	mapv1 "github.com/atomix/atomix/api/runtime/map/v1"
+	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
 	"github.com/onosproject/helmit/pkg/test"
 )
 
@@ -55,6 +61,207 @@ func (s *MapTestSuite) TestPutTTL() {
 	// TODO
 }
 
+func (s *MapTestSuite) TestPutEphemeral() {
+	_, err := s.Put(s.Context(), &mapv1.PutRequest{
+		ID:        s.ID,
+		Key:       "foo",
+		Value:     []byte("bar"),
+		Ephemeral: true,
+	})
+	if !s.NoError(err) {
+		return
+	}
+
+	getResponse, err := s.Get(s.Context(), &mapv1.GetRequest{
+		ID:  s.ID,
+		Key: "foo",
+	})
+	s.NoError(err)
+	s.Equal([]byte("bar"), getResponse.Value.Value)
+
+	ttl := time.Minute
+	_, err = s.Insert(s.Context(), &mapv1.InsertRequest{
+		ID:        s.ID,
+		Key:       "baz",
+		Value:     []byte("bar"),
+		TTL:       &ttl,
+		Ephemeral: true,
+	})
+	s.ErrorInvalid(err)
+
+	// Closing the map closes the session that owns the ephemeral entry
+	_, err = s.Close(s.Context(), &mapv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	_, err = s.Create(s.Context(), &mapv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	_, err = s.Get(s.Context(), &mapv1.GetRequest{
+		ID:  s.ID,
+		Key: "foo",
+	})
+	s.ErrorNotFound(err)
+
+	sizeResponse, err := s.Size(s.Context(), &mapv1.SizeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(0), sizeResponse.Size_)
+}
+
+func (s *MapTestSuite) TestFence() {
+	// The key is named after the lock to ensure the lock and the key are stored in the same partition
+	lockID := runtimev1.PrimitiveID{
+		Name: s.ID.Name + "-lock",
+	}
+	locks := lockv1.NewLockClient(s.conn)
+	_, err := locks.Create(s.Context(), &lockv1.CreateRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+	defer locks.Close(s.Context(), &lockv1.CloseRequest{
+		ID: lockID,
+	})
+
+	lock1, err := locks.Lock(s.Context(), &lockv1.LockRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   lockID.Name,
+		Value: []byte("foo"),
+		Fence: &mapv1.Fence{
+			Name:  lockID.Name,
+			Token: lock1.Version,
+		},
+	})
+	if !s.NoError(err) {
+		return
+	}
+
+	_, err = locks.Unlock(s.Context(), &lockv1.UnlockRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+	lock2, err := locks.Lock(s.Context(), &lockv1.LockRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+	s.Greater(lock2.Version, lock1.Version)
+
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   lockID.Name,
+		Value: []byte("bar"),
+		Fence: &mapv1.Fence{
+			Name:  lockID.Name,
+			Token: lock1.Version,
+		},
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.Remove(s.Context(), &mapv1.RemoveRequest{
+		ID:  s.ID,
+		Key: lockID.Name,
+		Fence: &mapv1.Fence{
+			Name:  lockID.Name,
+			Token: lock2.Version,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *MapTestSuite) TestFenceKeys() {
+	// Writes to many keys are fenced to ensure the keys are spread across partitions other than the lock's
+	lockID := runtimev1.PrimitiveID{
+		Name: s.ID.Name + "-lock",
+	}
+	locks := lockv1.NewLockClient(s.conn)
+	_, err := locks.Create(s.Context(), &lockv1.CreateRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+	defer locks.Close(s.Context(), &lockv1.CloseRequest{
+		ID: lockID,
+	})
+
+	lock1, err := locks.Lock(s.Context(), &lockv1.LockRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+
+	keys := make([]string, 16)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%d", i)
+	}
+
+	for _, key := range keys {
+		_, err = s.Put(s.Context(), &mapv1.PutRequest{
+			ID:    s.ID,
+			Key:   key,
+			Value: []byte("foo"),
+			Fence: &mapv1.Fence{
+				Name:  lockID.Name,
+				Token: lock1.Version,
+			},
+		})
+		if !s.NoError(err) {
+			return
+		}
+	}
+
+	_, err = locks.Unlock(s.Context(), &lockv1.UnlockRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+	lock2, err := locks.Lock(s.Context(), &lockv1.LockRequest{
+		ID: lockID,
+	})
+	s.NoError(err)
+	s.Greater(lock2.Version, lock1.Version)
+
+	// The stale token is rejected even for keys that have not been written with the new token
+	for _, key := range keys {
+		_, err = s.Put(s.Context(), &mapv1.PutRequest{
+			ID:    s.ID,
+			Key:   key,
+			Value: []byte("bar"),
+			Fence: &mapv1.Fence{
+				Name:  lockID.Name,
+				Token: lock1.Version,
+			},
+		})
+		s.ErrorConflict(err)
+	}
+
+	for _, key := range keys {
+		_, err = s.Update(s.Context(), &mapv1.UpdateRequest{
+			ID:    s.ID,
+			Key:   key,
+			Value: []byte("baz"),
+			Fence: &mapv1.Fence{
+				Name:  lockID.Name,
+				Token: lock2.Version,
+			},
+		})
+		s.NoError(err)
+	}
+
+	for _, key := range keys {
+		getResponse, err := s.Get(s.Context(), &mapv1.GetRequest{
+			ID:  s.ID,
+			Key: key,
+		})
+		s.NoError(err)
+		s.Equal("baz", string(getResponse.Value.Value))
+	}
+}
+
 func (s *MapTestSuite) TestPutVersion() {
 	putResponse, err := s.Put(s.Context(), &mapv1.PutRequest{
 		ID:    s.ID,
@@ -511,5 +718,335 @@ func (s *MapTestSuite) TestInsertClear() {
 	s.ErrorNotFound(err)
 }
 
+func (s *MapTestSuite) TestEntries() {
+	keys := []string{"user/42/b", "user/43", "a", "user/42/a", "z", "user/42/c"}
+	for _, key := range keys {
+		_, err := s.Put(s.Context(), &mapv1.PutRequest{
+			ID:    s.ID,
+			Key:   key,
+			Value: []byte(key),
+		})
+		s.NoError(err)
+	}
+
+	entries := func(request *mapv1.EntriesRequest) ([]string, string) {
+		request.ID = s.ID
+		stream, err := s.MapClient.Entries(s.Context(), request)
+		s.NoError(err)
+		var keys []string
+		var continuation string
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return keys, continuation
+			}
+			if !s.NoError(err) {
+				return keys, continuation
+			}
+			keys = append(keys, response.Entry.Key)
+			continuation = response.Continuation
+		}
+	}
+
+	keys, _ = entries(&mapv1.EntriesRequest{})
+	s.Equal([]string{"a", "user/42/a", "user/42/b", "user/42/c", "user/43", "z"}, keys)
+
+	keys, _ = entries(&mapv1.EntriesRequest{
+		Prefix: "user/42/",
+	})
+	s.Equal([]string{"user/42/a", "user/42/b", "user/42/c"}, keys)
+
+	keys, _ = entries(&mapv1.EntriesRequest{
+		StartKey: "user/42/b",
+		EndKey:   "z",
+	})
+	s.Equal([]string{"user/42/b", "user/42/c", "user/43"}, keys)
+
+	keys, continuation := entries(&mapv1.EntriesRequest{
+		Limit: 4,
+	})
+	s.Equal([]string{"a", "user/42/a", "user/42/b", "user/42/c"}, keys)
+
+	keys, _ = entries(&mapv1.EntriesRequest{
+		Limit:        4,
+		Continuation: continuation,
+	})
+	s.Equal([]string{"user/43", "z"}, keys)
+}
+
+func (s *MapTestSuite) TestEventFilters() {
+	stream, err := s.Events(s.Context(), &mapv1.EventsRequest{
+		ID:     s.ID,
+		Prefix: "foo/",
+		Types:  []mapv1.EventsRequest_EventType{mapv1.EventsRequest_UPDATED},
+	})
+	s.NoError(err)
+
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "foo/bar",
+		Value: []byte("a"),
+	})
+	s.NoError(err)
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "bar",
+		Value: []byte("a"),
+	})
+	s.NoError(err)
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "bar",
+		Value: []byte("b"),
+	})
+	s.NoError(err)
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "foo/bar",
+		Value: []byte("b"),
+	})
+	s.NoError(err)
+
+	// Skip the empty events acknowledging the subscription
+	for {
+		response, err := stream.Recv()
+		if !s.NoError(err) {
+			return
+		}
+		if response.Event.Event == nil {
+			continue
+		}
+		s.Equal("foo/bar", response.Event.Key)
+		updated, ok := response.Event.Event.(*mapv1.Event_Updated_)
+		s.True(ok)
+		if ok {
+			s.Equal([]byte("b"), updated.Updated.Value.Value)
+			s.Equal([]byte("a"), updated.Updated.PrevValue.Value)
+		}
+		return
+	}
+}
+
+func (s *MapTestSuite) TestPutAllGetAll() {
+	_, err := s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "foo",
+		Value: []byte("a"),
+	})
+	s.NoError(err)
+
+	putAll, err := s.PutAll(s.Context(), &mapv1.PutAllRequest{
+		ID: s.ID,
+		Entries: []mapv1.PutAllRequest_Entry{
+			{Key: "foo", Value: []byte("b")},
+			{Key: "bar", Value: []byte("c")},
+			{Key: "baz", Value: []byte("d")},
+		},
+	})
+	if !s.NoError(err) {
+		return
+	}
+	s.Len(putAll.Results, 3)
+	s.Equal("foo", putAll.Results[0].Key)
+	s.NotNil(putAll.Results[0].PrevValue)
+	s.Equal([]byte("a"), putAll.Results[0].PrevValue.Value)
+	s.Equal("bar", putAll.Results[1].Key)
+	s.Nil(putAll.Results[1].PrevValue)
+
+	getAll, err := s.GetAll(s.Context(), &mapv1.GetAllRequest{
+		ID:   s.ID,
+		Keys: []string{"foo", "bar", "baz", "qux"},
+	})
+	s.NoError(err)
+	values := make(map[string][]byte)
+	for _, entry := range getAll.Entries {
+		values[entry.Key] = entry.Value.Value
+	}
+	s.Equal(map[string][]byte{
+		"foo": []byte("b"),
+		"bar": []byte("c"),
+		"baz": []byte("d"),
+	}, values)
+}
+
+func (s *MapTestSuite) TestPutAllDuplicateKeys() {
+	_, err := s.PutAll(s.Context(), &mapv1.PutAllRequest{
+		ID: s.ID,
+		Entries: []mapv1.PutAllRequest_Entry{
+			{Key: "foo", Value: []byte("a")},
+			{Key: "foo", Value: []byte("b")},
+		},
+	})
+	s.ErrorConflict(err)
+
+	// The rejected request does not modify the map
+	_, err = s.Get(s.Context(), &mapv1.GetRequest{
+		ID:  s.ID,
+		Key: "foo",
+	})
+	s.ErrorNotFound(err)
+}
+
+func (s *MapTestSuite) TestRemoveAll() {
+	_, err := s.PutAll(s.Context(), &mapv1.PutAllRequest{
+		ID: s.ID,
+		Entries: []mapv1.PutAllRequest_Entry{
+			{Key: "foo", Value: []byte("a")},
+			{Key: "bar", Value: []byte("b")},
+			{Key: "baz", Value: []byte("c")},
+		},
+	})
+	if !s.NoError(err) {
+		return
+	}
+
+	removeAll, err := s.RemoveAll(s.Context(), &mapv1.RemoveAllRequest{
+		ID:   s.ID,
+		Keys: []string{"foo", "bar", "qux"},
+	})
+	s.NoError(err)
+	values := make(map[string][]byte)
+	for _, entry := range removeAll.Entries {
+		values[entry.Key] = entry.Value.Value
+	}
+	s.Equal(map[string][]byte{
+		"foo": []byte("a"),
+		"bar": []byte("b"),
+	}, values)
+
+	size, err := s.Size(s.Context(), &mapv1.SizeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(1), size.Size_)
+}
+
+func (s *MapTestSuite) TestPatch() {
+	_, err := s.Put(s.Context(), &mapv1.PutRequest{
+		ID:  s.ID,
+		Key: "foo",
+		Patch: &mapv1.Patch{
+			Patch: &mapv1.Patch_MergePatch{
+				MergePatch: []byte(`{"name": "foo", "address": {"city": "Rome", "zip": "00100"}}`),
+			},
+		},
+	})
+	if !s.NoError(err) {
+		return
+	}
+
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:  s.ID,
+		Key: "foo",
+		Patch: &mapv1.Patch{
+			Patch: &mapv1.Patch_JsonPatch{
+				JsonPatch: []byte(`[{"op": "test", "path": "/name", "value": "foo"}, {"op": "add", "path": "/age", "value": 42}]`),
+			},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:  s.ID,
+		Key: "foo",
+		Patch: &mapv1.Patch{
+			Patch: &mapv1.Patch_JsonPatch{
+				JsonPatch: []byte(`[{"op": "test", "path": "/name", "value": "bar"}]`),
+			},
+		},
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "foo",
+		Value: []byte(`{}`),
+		Patch: &mapv1.Patch{
+			Patch: &mapv1.Patch_MergePatch{
+				MergePatch: []byte(`{}`),
+			},
+		},
+	})
+	s.ErrorInvalid(err)
+
+	get, err := s.Get(s.Context(), &mapv1.GetRequest{
+		ID:  s.ID,
+		Key: "foo",
+	})
+	s.NoError(err)
+	s.JSONEq(`{"name": "foo", "address": {"city": "Rome", "zip": "00100"}, "age": 42}`, string(get.Value.Value))
+
+	get, err = s.Get(s.Context(), &mapv1.GetRequest{
+		ID:    s.ID,
+		Key:   "foo",
+		Paths: []string{"/name", "/address/city"},
+	})
+	s.NoError(err)
+	s.JSONEq(`{"name": "foo", "address": {"city": "Rome"}}`, string(get.Value.Value))
+}
+
+func (s *MapTestSuite) TestPatchEvents() {
+	_, err := s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "foo",
+		Value: []byte(`{"name": "foo"}`),
+	})
+	s.NoError(err)
+
+	stream, err := s.Events(s.Context(), &mapv1.EventsRequest{
+		ID:  s.ID,
+		Key: "foo",
+	})
+	if !s.NoError(err) {
+		return
+	}
+
+	// Wait for the empty event acknowledging the subscription
+	ack, err := stream.Recv()
+	if !s.NoError(err) {
+		return
+	}
+	s.Nil(ack.Event.Event)
+
+	patch := &mapv1.Patch{
+		Patch: &mapv1.Patch_MergePatch{
+			MergePatch: []byte(`{"age": 42}`),
+		},
+	}
+	_, err = s.Put(s.Context(), &mapv1.PutRequest{
+		ID:    s.ID,
+		Key:   "foo",
+		Patch: patch,
+	})
+	if !s.NoError(err) {
+		return
+	}
+
+	response, err := stream.Recv()
+	if !s.NoError(err) {
+		return
+	}
+	s.Equal(patch, response.Event.Patch)
+	updated, ok := response.Event.Event.(*mapv1.Event_Updated_)
+	s.True(ok)
+	if ok {
+		s.JSONEq(`{"name": "foo", "age": 42}`, string(updated.Updated.Value.Value))
+	}
+}
+
+func (s *MapTestSuite) TestQueryUnknownIndex() {
+	// Indexes are declared by the primitive configuration, so the test map has none
+	_, err := s.Query(s.Context(), &mapv1.QueryRequest{
+		ID:    s.ID,
+		Index: "status",
+		Query: &mapv1.QueryRequest_Equals{
+			Equals: []byte(`"pending"`),
+		},
+	})
+	if s.Supported(err) {
+		s.ErrorNotFound(err)
+	}
+}
+
 var _ test.SetupSuite = (*MapTestSuite)(nil)
 var _ test.TearDownSuite = (*MapTestSuite)(nil)
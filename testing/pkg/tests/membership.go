@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	membershipv1 "github.com/atomix/atomix/api/runtime/membership/v1"
+)
+
+type MembershipTestSuite struct {
+	PrimitiveTestSuite
+	membershipv1.MembershipClient
+}
+
+func (s *MembershipTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.MembershipClient = membershipv1.NewMembershipClient(s.conn)
+}
+
+func (s *MembershipTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &membershipv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *MembershipTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &membershipv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *MembershipTestSuite) TestJoinLeave() {
+	_, err := s.Join(s.Context(), &membershipv1.JoinRequest{
+		ID: s.ID,
+		Member: membershipv1.Member{
+			Name:     "foo",
+			Metadata: map[string]string{"address": "10.0.0.1:8080"},
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Join(s.Context(), &membershipv1.JoinRequest{
+		ID: s.ID,
+		Member: membershipv1.Member{
+			Name: "bar",
+		},
+	})
+	s.NoError(err)
+
+	listResponse, err := s.List(s.Context(), &membershipv1.ListRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(listResponse.Members, 2)
+	s.Equal("foo", listResponse.Members[0].Name)
+	s.Equal("10.0.0.1:8080", listResponse.Members[0].Metadata["address"])
+	s.Equal("bar", listResponse.Members[1].Name)
+
+	_, err = s.Leave(s.Context(), &membershipv1.LeaveRequest{
+		ID:   s.ID,
+		Name: "foo",
+	})
+	s.NoError(err)
+
+	listResponse, err = s.List(s.Context(), &membershipv1.ListRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(listResponse.Members, 1)
+	s.Equal("bar", listResponse.Members[0].Name)
+
+	_, err = s.Leave(s.Context(), &membershipv1.LeaveRequest{
+		ID:   s.ID,
+		Name: "foo",
+	})
+	s.ErrorNotFound(err)
+
+	_, err = s.Join(s.Context(), &membershipv1.JoinRequest{
+		ID: s.ID,
+	})
+	s.ErrorInvalid(err)
+}
+
+func (s *MembershipTestSuite) TestWatch() {
+	_, err := s.Join(s.Context(), &membershipv1.JoinRequest{
+		ID: s.ID,
+		Member: membershipv1.Member{
+			Name: "foo",
+		},
+	})
+	s.NoError(err)
+
+	stream, err := s.Watch(s.Context(), &membershipv1.WatchRequest{
+		ID:     s.ID,
+		Replay: true,
+	})
+	s.NoError(err)
+
+	// Replayed events describe the existing members
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal("foo", response.Event.Name)
+	s.NotNil(response.Event.GetJoined())
+
+	_, err = s.Join(s.Context(), &membershipv1.JoinRequest{
+		ID: s.ID,
+		Member: membershipv1.Member{
+			Name: "bar",
+		},
+	})
+	s.NoError(err)
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal("bar", response.Event.Name)
+	s.NotNil(response.Event.GetJoined())
+
+	// Joining again with different metadata updates the member
+	_, err = s.Join(s.Context(), &membershipv1.JoinRequest{
+		ID: s.ID,
+		Member: membershipv1.Member{
+			Name:     "foo",
+			Metadata: map[string]string{"zone": "a"},
+		},
+	})
+	s.NoError(err)
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal("foo", response.Event.Name)
+	s.Equal("a", response.Event.GetUpdated().Metadata["zone"])
+
+	_, err = s.Leave(s.Context(), &membershipv1.LeaveRequest{
+		ID:   s.ID,
+		Name: "bar",
+	})
+	s.NoError(err)
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal("bar", response.Event.Name)
+	s.NotNil(response.Event.GetLeft())
+	s.False(response.Event.GetLeft().Expired)
+}
@@ -7,9 +7,9 @@ package tests
 import (
 	"github.com/atomix/atomix/api/errors"
 	runtimev1 "github.com/atomix/atomix/api/runtime/v1"
-	"github.com/atomix/atomix/runtime/pkg/utils/grpc/interceptors"
 	petname "github.com/dustinkirkland/golang-petname"
 	"github.com/onosproject/helmit/pkg/test"
+	"github.com/vpascoalr/atomix/runtime/pkg/utils/grpc/interceptors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -100,3 +100,24 @@ func (s *PrimitiveTestSuite) ErrorConflict(err error, args ...interface{}) bool
 	}
 	return true
 }
+
+func (s *PrimitiveTestSuite) ErrorOutOfRange(err error, args ...interface{}) bool {
+	if err == nil || !errors.IsOutOfRange(err) {
+		return s.Error(nil, args...)
+	}
+	return true
+}
+
+func (s *PrimitiveTestSuite) ErrorInvalid(err error, args ...interface{}) bool {
+	if err == nil || !errors.IsInvalid(err) {
+		return s.Error(nil, args...)
+	}
+	return true
+}
+
+func (s *PrimitiveTestSuite) ErrorTimeout(err error, args ...interface{}) bool {
+	if err == nil || !errors.IsTimeout(err) {
+		return s.Error(nil, args...)
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"time"
+
+	ratelimiterv1 "github.com/atomix/atomix/api/runtime/ratelimiter/v1"
+)
+
+type RateLimiterTestSuite struct {
+	PrimitiveTestSuite
+	ratelimiterv1.RateLimiterClient
+}
+
+func (s *RateLimiterTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.RateLimiterClient = ratelimiterv1.NewRateLimiterClient(s.conn)
+}
+
+func (s *RateLimiterTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &ratelimiterv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *RateLimiterTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &ratelimiterv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *RateLimiterTestSuite) TestUnlimited() {
+	for i := 0; i < 10; i++ {
+		response, err := s.TryAcquire(s.Context(), &ratelimiterv1.TryAcquireRequest{
+			ID:     s.ID,
+			Bucket: "foo",
+			Tokens: 100,
+		})
+		s.NoError(err)
+		s.True(response.Acquired)
+	}
+}
+
+func (s *RateLimiterTestSuite) TestTryAcquire() {
+	_, err := s.SetLimit(s.Context(), &ratelimiterv1.SetLimitRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Limit: &ratelimiterv1.Limit{
+			Rate:  1,
+			Burst: 2,
+		},
+	})
+	s.NoError(err)
+
+	getResponse, err := s.GetBucket(s.Context(), &ratelimiterv1.GetBucketRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), getResponse.Limit.Rate)
+	s.Equal(uint64(2), getResponse.Limit.Burst)
+
+	response, err := s.TryAcquire(s.Context(), &ratelimiterv1.TryAcquireRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Tokens: 2,
+	})
+	s.NoError(err)
+	s.True(response.Acquired)
+
+	response, err = s.TryAcquire(s.Context(), &ratelimiterv1.TryAcquireRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+	})
+	s.NoError(err)
+	s.False(response.Acquired)
+	s.True(response.RetryAfter > 0)
+	s.True(response.RetryAfter <= time.Second)
+
+	// Other buckets are not limited
+	response, err = s.TryAcquire(s.Context(), &ratelimiterv1.TryAcquireRequest{
+		ID:     s.ID,
+		Bucket: "bar",
+	})
+	s.NoError(err)
+	s.True(response.Acquired)
+
+	_, err = s.TryAcquire(s.Context(), &ratelimiterv1.TryAcquireRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Tokens: 3,
+	})
+	s.ErrorInvalid(err)
+}
+
+func (s *RateLimiterTestSuite) TestAcquire() {
+	_, err := s.SetLimit(s.Context(), &ratelimiterv1.SetLimitRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Limit: &ratelimiterv1.Limit{
+			Rate: 5,
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Acquire(s.Context(), &ratelimiterv1.AcquireRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Tokens: 5,
+	})
+	s.NoError(err)
+
+	// The bucket refills at a rate of 5 tokens per second
+	_, err = s.Acquire(s.Context(), &ratelimiterv1.AcquireRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Tokens: 2,
+	})
+	s.NoError(err)
+
+	_, err = s.Acquire(s.Context(), &ratelimiterv1.AcquireRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Tokens: 6,
+	})
+	s.ErrorInvalid(err)
+}
+
+func (s *RateLimiterTestSuite) TestAcquireTimeout() {
+	_, err := s.SetLimit(s.Context(), &ratelimiterv1.SetLimitRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+		Limit: &ratelimiterv1.Limit{
+			Rate: 1,
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.Acquire(s.Context(), &ratelimiterv1.AcquireRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+	})
+	s.NoError(err)
+
+	timeout := 100 * time.Millisecond
+	_, err = s.Acquire(s.Context(), &ratelimiterv1.AcquireRequest{
+		ID:      s.ID,
+		Bucket:  "foo",
+		Timeout: &timeout,
+	})
+	s.ErrorTimeout(err)
+
+	// Resetting the bucket to the default limit removes the limit
+	_, err = s.SetLimit(s.Context(), &ratelimiterv1.SetLimitRequest{
+		ID:     s.ID,
+		Bucket: "foo",
+	})
+	s.NoError(err)
+
+	_, err = s.Acquire(s.Context(), &ratelimiterv1.AcquireRequest{
+		ID:      s.ID,
+		Bucket:  "foo",
+		Timeout: &timeout,
+	})
+	s.NoError(err)
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"time"
+
+	rwlockv1 "github.com/atomix/atomix/api/runtime/rwlock/v1"
+)
+
+type RWLockTestSuite struct {
+	PrimitiveTestSuite
+	rwlockv1.RWLockClient
+}
+
+func (s *RWLockTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.RWLockClient = rwlockv1.NewRWLockClient(s.conn)
+}
+
+func (s *RWLockTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &rwlockv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *RWLockTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &rwlockv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *RWLockTestSuite) TestReadLock() {
+	_, err := s.RLock(s.Context(), &rwlockv1.RLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	_, err = s.RLock(s.Context(), &rwlockv1.RLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	getResponse, err := s.GetLock(s.Context(), &rwlockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), getResponse.Version)
+	s.Equal(uint32(2), getResponse.Readers)
+
+	// A read lock cannot be upgraded to the write lock
+	_, err = s.Lock(s.Context(), &rwlockv1.LockRequest{
+		ID: s.ID,
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.RUnlock(s.Context(), &rwlockv1.RUnlockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	_, err = s.RUnlock(s.Context(), &rwlockv1.RUnlockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	_, err = s.RUnlock(s.Context(), &rwlockv1.RUnlockRequest{
+		ID: s.ID,
+	})
+	s.ErrorConflict(err)
+
+	getResponse, err = s.GetLock(s.Context(), &rwlockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint32(0), getResponse.Readers)
+}
+
+func (s *RWLockTestSuite) TestWriteLock() {
+	lockResponse, err := s.Lock(s.Context(), &rwlockv1.LockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.NotEqual(uint64(0), lockResponse.Version)
+
+	getResponse, err := s.GetLock(s.Context(), &rwlockv1.GetLockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(lockResponse.Version, getResponse.Version)
+	s.Equal(uint32(0), getResponse.Readers)
+
+	timeout := time.Second
+	_, err = s.RLock(s.Context(), &rwlockv1.RLockRequest{
+		ID:      s.ID,
+		Timeout: &timeout,
+	})
+	s.ErrorTimeout(err)
+
+	_, err = s.Unlock(s.Context(), &rwlockv1.UnlockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	_, err = s.Unlock(s.Context(), &rwlockv1.UnlockRequest{
+		ID: s.ID,
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.RLock(s.Context(), &rwlockv1.RLockRequest{
+		ID:      s.ID,
+		Timeout: &timeout,
+	})
+	s.NoError(err)
+	_, err = s.RUnlock(s.Context(), &rwlockv1.RUnlockRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
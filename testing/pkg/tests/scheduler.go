@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"time"
+
+	schedulerv1 "github.com/atomix/atomix/api/runtime/scheduler/v1"
+)
+
+type SchedulerTestSuite struct {
+	PrimitiveTestSuite
+	schedulerv1.SchedulerClient
+}
+
+func (s *SchedulerTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.SchedulerClient = schedulerv1.NewSchedulerClient(s.conn)
+}
+
+func (s *SchedulerTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &schedulerv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *SchedulerTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &schedulerv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *SchedulerTestSuite) TestScheduleCancel() {
+	interval := time.Hour
+	scheduleResponse, err := s.Schedule(s.Context(), &schedulerv1.ScheduleRequest{
+		ID:       s.ID,
+		Name:     "foo",
+		Payload:  []byte("bar"),
+		Interval: &interval,
+	})
+	s.NoError(err)
+	s.Equal("foo", scheduleResponse.Timer.Name)
+	s.Equal(interval, scheduleResponse.Timer.Interval)
+
+	_, err = s.Schedule(s.Context(), &schedulerv1.ScheduleRequest{
+		ID:       s.ID,
+		Name:     "foo",
+		Interval: &interval,
+	})
+	s.ErrorAlreadyExists(err)
+
+	listResponse, err := s.List(s.Context(), &schedulerv1.ListRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(listResponse.Timers, 1)
+	s.Equal("foo", listResponse.Timers[0].Name)
+	s.Equal("bar", string(listResponse.Timers[0].Payload))
+
+	_, err = s.Cancel(s.Context(), &schedulerv1.CancelRequest{
+		ID:   s.ID,
+		Name: "foo",
+	})
+	s.NoError(err)
+
+	_, err = s.Cancel(s.Context(), &schedulerv1.CancelRequest{
+		ID:   s.ID,
+		Name: "foo",
+	})
+	s.ErrorNotFound(err)
+
+	listResponse, err = s.List(s.Context(), &schedulerv1.ListRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(listResponse.Timers, 0)
+}
+
+func (s *SchedulerTestSuite) TestFire() {
+	stream, err := s.Subscribe(s.Context(), &schedulerv1.SubscribeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	delay := 100 * time.Millisecond
+	_, err = s.Schedule(s.Context(), &schedulerv1.ScheduleRequest{
+		ID:      s.ID,
+		Name:    "foo",
+		Payload: []byte("bar"),
+		Delay:   &delay,
+	})
+	s.NoError(err)
+
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal("foo", response.Firing.Timer)
+	s.Equal("bar", string(response.Firing.Payload))
+	s.Equal(uint32(1), response.Firing.Attempt)
+
+	_, err = s.Ack(s.Context(), &schedulerv1.AckRequest{
+		ID:       s.ID,
+		FiringID: response.Firing.ID,
+	})
+	s.NoError(err)
+
+	_, err = s.Ack(s.Context(), &schedulerv1.AckRequest{
+		ID:       s.ID,
+		FiringID: response.Firing.ID,
+	})
+	s.ErrorNotFound(err)
+
+	// One-shot timers are removed once they fire
+	listResponse, err := s.List(s.Context(), &schedulerv1.ListRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(listResponse.Timers, 0)
+}
+
+func (s *SchedulerTestSuite) TestInterval() {
+	stream, err := s.Subscribe(s.Context(), &schedulerv1.SubscribeRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	interval := 100 * time.Millisecond
+	_, err = s.Schedule(s.Context(), &schedulerv1.ScheduleRequest{
+		ID:       s.ID,
+		Name:     "foo",
+		Interval: &interval,
+	})
+	s.NoError(err)
+
+	var lastID uint64
+	for i := 0; i < 3; i++ {
+		response, err := stream.Recv()
+		s.NoError(err)
+		s.Equal("foo", response.Firing.Timer)
+		s.Greater(response.Firing.ID, lastID)
+		lastID = response.Firing.ID
+		_, err = s.Ack(s.Context(), &schedulerv1.AckRequest{
+			ID:       s.ID,
+			FiringID: response.Firing.ID,
+		})
+		s.NoError(err)
+	}
+
+	_, err = s.Cancel(s.Context(), &schedulerv1.CancelRequest{
+		ID:   s.ID,
+		Name: "foo",
+	})
+	s.NoError(err)
+}
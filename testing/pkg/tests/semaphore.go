@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"time"
+
+	semaphorev1 "github.com/atomix/atomix/api/runtime/semaphore/v1"
+)
+
+type SemaphoreTestSuite struct {
+	PrimitiveTestSuite
+	semaphorev1.SemaphoreClient
+}
+
+func (s *SemaphoreTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.SemaphoreClient = semaphorev1.NewSemaphoreClient(s.conn)
+}
+
+func (s *SemaphoreTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &semaphorev1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *SemaphoreTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &semaphorev1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *SemaphoreTestSuite) TestAcquireRelease() {
+	getResponse, err := s.GetSemaphore(s.Context(), &semaphorev1.GetSemaphoreRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), getResponse.Permits)
+	s.Equal(uint64(1), getResponse.Available)
+
+	_, err = s.Acquire(s.Context(), &semaphorev1.AcquireRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	getResponse, err = s.GetSemaphore(s.Context(), &semaphorev1.GetSemaphoreRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), getResponse.Available)
+
+	_, err = s.Release(s.Context(), &semaphorev1.ReleaseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	getResponse, err = s.GetSemaphore(s.Context(), &semaphorev1.GetSemaphoreRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), getResponse.Available)
+}
+
+func (s *SemaphoreTestSuite) TestAcquireTimeout() {
+	_, err := s.Acquire(s.Context(), &semaphorev1.AcquireRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	timeout := time.Second
+	_, err = s.Acquire(s.Context(), &semaphorev1.AcquireRequest{
+		ID:      s.ID,
+		Timeout: &timeout,
+	})
+	s.ErrorTimeout(err)
+
+	_, err = s.Release(s.Context(), &semaphorev1.ReleaseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *SemaphoreTestSuite) TestInvalidPermits() {
+	_, err := s.Acquire(s.Context(), &semaphorev1.AcquireRequest{
+		ID:      s.ID,
+		Permits: 2,
+	})
+	s.ErrorInvalid(err)
+
+	_, err = s.Release(s.Context(), &semaphorev1.ReleaseRequest{
+		ID: s.ID,
+	})
+	s.ErrorConflict(err)
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	shardassignmentv1 "github.com/atomix/atomix/api/runtime/shardassignment/v1"
+)
+
+type ShardAssignmentTestSuite struct {
+	PrimitiveTestSuite
+	shardassignmentv1.ShardAssignmentClient
+}
+
+func (s *ShardAssignmentTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.ShardAssignmentClient = shardassignmentv1.NewShardAssignmentClient(s.conn)
+}
+
+func (s *ShardAssignmentTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &shardassignmentv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *ShardAssignmentTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &shardassignmentv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *ShardAssignmentTestSuite) TestJoinLeave() {
+	// The primitive is configured with a single shard by default
+	joinResponse, err := s.Join(s.Context(), &shardassignmentv1.JoinRequest{
+		ID:     s.ID,
+		Member: "foo",
+	})
+	s.NoError(err)
+	s.Equal([]uint32{0}, joinResponse.Shards)
+
+	joinResponse, err = s.Join(s.Context(), &shardassignmentv1.JoinRequest{
+		ID:     s.ID,
+		Member: "bar",
+	})
+	s.NoError(err)
+	s.Empty(joinResponse.Shards)
+
+	// Joining is idempotent for a member that has already joined
+	joinResponse, err = s.Join(s.Context(), &shardassignmentv1.JoinRequest{
+		ID:     s.ID,
+		Member: "foo",
+	})
+	s.NoError(err)
+	s.Equal([]uint32{0}, joinResponse.Shards)
+
+	getResponse, err := s.GetAssignments(s.Context(), &shardassignmentv1.GetAssignmentsRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(getResponse.Assignments, 2)
+	s.Equal("foo", getResponse.Assignments[0].Member)
+	s.Equal([]uint32{0}, getResponse.Assignments[0].Shards)
+	s.Equal("bar", getResponse.Assignments[1].Member)
+	s.Empty(getResponse.Assignments[1].Shards)
+	s.Empty(getResponse.Assignments[0].Revoking)
+	version := getResponse.Version
+
+	// The shards of a leaving member are reassigned to the remaining members
+	_, err = s.Leave(s.Context(), &shardassignmentv1.LeaveRequest{
+		ID:     s.ID,
+		Member: "foo",
+	})
+	s.NoError(err)
+
+	getResponse, err = s.GetAssignments(s.Context(), &shardassignmentv1.GetAssignmentsRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(getResponse.Assignments, 1)
+	s.Equal("bar", getResponse.Assignments[0].Member)
+	s.Equal([]uint32{0}, getResponse.Assignments[0].Shards)
+	s.Greater(getResponse.Version, version)
+
+	_, err = s.Leave(s.Context(), &shardassignmentv1.LeaveRequest{
+		ID:     s.ID,
+		Member: "foo",
+	})
+	s.ErrorNotFound(err)
+
+	_, err = s.Join(s.Context(), &shardassignmentv1.JoinRequest{
+		ID: s.ID,
+	})
+	s.ErrorInvalid(err)
+}
+
+func (s *ShardAssignmentTestSuite) TestWatch() {
+	_, err := s.Join(s.Context(), &shardassignmentv1.JoinRequest{
+		ID:     s.ID,
+		Member: "foo",
+	})
+	s.NoError(err)
+
+	_, err = s.Join(s.Context(), &shardassignmentv1.JoinRequest{
+		ID:     s.ID,
+		Member: "bar",
+	})
+	s.NoError(err)
+
+	stream, err := s.Watch(s.Context(), &shardassignmentv1.WatchRequest{
+		ID:     s.ID,
+		Member: "bar",
+	})
+	s.NoError(err)
+
+	// The watch begins with the member's current assignment
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal("bar", response.Assignment.Member)
+	s.Empty(response.Assignment.Shards)
+
+	_, err = s.Leave(s.Context(), &shardassignmentv1.LeaveRequest{
+		ID:     s.ID,
+		Member: "foo",
+	})
+	s.NoError(err)
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal("bar", response.Assignment.Member)
+	s.Equal([]uint32{0}, response.Assignment.Shards)
+}
+
+func (s *ShardAssignmentTestSuite) TestRelease() {
+	joinResponse, err := s.Join(s.Context(), &shardassignmentv1.JoinRequest{
+		ID:     s.ID,
+		Member: "foo",
+	})
+	s.NoError(err)
+	s.Equal([]uint32{0}, joinResponse.Shards)
+	s.Empty(joinResponse.Revoking)
+
+	// Only shards being revoked from a member can be released
+	_, err = s.Release(s.Context(), &shardassignmentv1.ReleaseRequest{
+		ID:     s.ID,
+		Member: "foo",
+		Shards: []uint32{0},
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.Release(s.Context(), &shardassignmentv1.ReleaseRequest{
+		ID:     s.ID,
+		Member: "bar",
+		Shards: []uint32{0},
+	})
+	s.ErrorNotFound(err)
+
+	getResponse, err := s.GetAssignments(s.Context(), &shardassignmentv1.GetAssignmentsRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Len(getResponse.Assignments, 1)
+	s.Equal([]uint32{0}, getResponse.Assignments[0].Shards)
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	topicv1 "github.com/atomix/atomix/api/runtime/topic/v1"
+)
+
+type TopicTestSuite struct {
+	PrimitiveTestSuite
+	topicv1.TopicClient
+}
+
+func (s *TopicTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.TopicClient = topicv1.NewTopicClient(s.conn)
+}
+
+func (s *TopicTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &topicv1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *TopicTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &topicv1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *TopicTestSuite) TestPublish() {
+	response, err := s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Payload: []byte("foo"),
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), response.Offset)
+	s.NotNil(response.Timestamp)
+
+	response, err = s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Payload: []byte("bar"),
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), response.Offset)
+}
+
+func (s *TopicTestSuite) TestSubscribeEarliest() {
+	_, err := s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Payload: []byte("foo"),
+	})
+	s.NoError(err)
+
+	stream, err := s.Subscribe(s.Context(), &topicv1.SubscribeRequest{
+		ID:       s.ID,
+		Position: topicv1.SubscribeRequest_EARLIEST,
+	})
+	s.NoError(err)
+
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(uint64(0), response.Offset)
+	s.Equal("foo", string(response.Payload))
+
+	_, err = s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Payload: []byte("bar"),
+	})
+	s.NoError(err)
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal(uint64(1), response.Offset)
+	s.Equal("bar", string(response.Payload))
+}
+
+func (s *TopicTestSuite) TestSubscribeOffset() {
+	for _, payload := range []string{"foo", "bar", "baz"} {
+		_, err := s.Publish(s.Context(), &topicv1.PublishRequest{
+			ID:      s.ID,
+			Payload: []byte(payload),
+		})
+		s.NoError(err)
+	}
+
+	stream, err := s.Subscribe(s.Context(), &topicv1.SubscribeRequest{
+		ID:       s.ID,
+		Position: topicv1.SubscribeRequest_OFFSET,
+		Offset:   1,
+	})
+	s.NoError(err)
+
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(uint64(1), response.Offset)
+	s.Equal("bar", string(response.Payload))
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal(uint64(2), response.Offset)
+	s.Equal("baz", string(response.Payload))
+}
+
+func (s *TopicTestSuite) TestSubscribeTimestamp() {
+	publishResponse, err := s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Payload: []byte("foo"),
+	})
+	s.NoError(err)
+
+	stream, err := s.Subscribe(s.Context(), &topicv1.SubscribeRequest{
+		ID:        s.ID,
+		Position:  topicv1.SubscribeRequest_TIMESTAMP,
+		Timestamp: publishResponse.Timestamp,
+	})
+	s.NoError(err)
+
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(publishResponse.Offset, response.Offset)
+	s.Equal("foo", string(response.Payload))
+}
+
+func (s *TopicTestSuite) TestConsumerGroup() {
+	_, err := s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Payload: []byte("foo"),
+	})
+	s.NoError(err)
+
+	stream, err := s.Subscribe(s.Context(), &topicv1.SubscribeRequest{
+		ID:       s.ID,
+		Position: topicv1.SubscribeRequest_EARLIEST,
+		Group:    "test",
+	})
+	s.NoError(err)
+
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(uint64(0), response.Offset)
+	s.Equal("foo", string(response.Payload))
+
+	fetchResponse, err := s.FetchOffset(s.Context(), &topicv1.FetchOffsetRequest{
+		ID:    s.ID,
+		Group: "test",
+	})
+	s.NoError(err)
+	s.Equal(uint64(0), fetchResponse.Offset)
+
+	_, err = s.CommitOffset(s.Context(), &topicv1.CommitOffsetRequest{
+		ID:     s.ID,
+		Group:  "test",
+		Offset: response.Offset,
+	})
+	s.NoError(err)
+
+	fetchResponse, err = s.FetchOffset(s.Context(), &topicv1.FetchOffsetRequest{
+		ID:    s.ID,
+		Group: "test",
+	})
+	s.NoError(err)
+	s.Equal(uint64(1), fetchResponse.Offset)
+
+	_, err = s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Payload: []byte("bar"),
+	})
+	s.NoError(err)
+
+	response, err = stream.Recv()
+	s.NoError(err)
+	s.Equal(uint64(1), response.Offset)
+	s.Equal("bar", string(response.Payload))
+}
+
+func (s *TopicTestSuite) TestPublishKey() {
+	publishResponse, err := s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Key:     []byte("foo"),
+		Payload: []byte("bar"),
+	})
+	s.NoError(err)
+
+	stream, err := s.Subscribe(s.Context(), &topicv1.SubscribeRequest{
+		ID:       s.ID,
+		Position: topicv1.SubscribeRequest_EARLIEST,
+		Group:    "test",
+	})
+	s.NoError(err)
+
+	response, err := stream.Recv()
+	s.NoError(err)
+	s.Equal(publishResponse.Offset, response.Offset)
+	s.Equal(publishResponse.Partition, response.Partition)
+
+	// Messages with the same key are published to the same partition
+	publishResponse, err = s.Publish(s.Context(), &topicv1.PublishRequest{
+		ID:      s.ID,
+		Key:     []byte("foo"),
+		Payload: []byte("baz"),
+	})
+	s.NoError(err)
+	s.Equal(response.Partition, publishResponse.Partition)
+
+	fetchResponse, err := s.FetchOffset(s.Context(), &topicv1.FetchOffsetRequest{
+		ID:        s.ID,
+		Group:     "test",
+		Partition: response.Partition,
+	})
+	s.NoError(err)
+	s.Equal(response.Offset, fetchResponse.Offset)
+
+	_, err = s.FetchOffset(s.Context(), &topicv1.FetchOffsetRequest{
+		ID:        s.ID,
+		Group:     "test",
+		Partition: 1 << 16,
+	})
+	s.ErrorInvalid(err)
+}
+
+func (s *TopicTestSuite) TestCommitOffset() {
+	_, err := s.FetchOffset(s.Context(), &topicv1.FetchOffsetRequest{
+		ID:    s.ID,
+		Group: "test",
+	})
+	s.ErrorNotFound(err)
+
+	_, err = s.CommitOffset(s.Context(), &topicv1.CommitOffsetRequest{
+		ID:     s.ID,
+		Group:  "test",
+		Offset: 0,
+	})
+	s.ErrorNotFound(err)
+}
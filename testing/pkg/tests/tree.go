@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	treev1 "github.com/atomix/atomix/api/runtime/tree/v1"
+)
+
+type TreeTestSuite struct {
+	PrimitiveTestSuite
+	treev1.TreeClient
+}
+
+func (s *TreeTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.TreeClient = treev1.NewTreeClient(s.conn)
+}
+
+func (s *TreeTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &treev1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *TreeTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &treev1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *TreeTestSuite) TestCRUD() {
+	_, err := s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/config/db",
+	})
+	s.ErrorNotFound(err)
+
+	createResponse, err := s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/config",
+	})
+	s.NoError(err)
+	s.Equal("/config", createResponse.Node.Path)
+	s.Equal(uint64(1), createResponse.Node.Version)
+
+	_, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:    s.ID,
+		Path:  "/config/db",
+		Value: []byte("foo"),
+	})
+	s.NoError(err)
+
+	_, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/config/db",
+	})
+	s.ErrorAlreadyExists(err)
+
+	_, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/config/cache",
+	})
+	s.NoError(err)
+
+	getResponse, err := s.GetNode(s.Context(), &treev1.GetNodeRequest{
+		ID:   s.ID,
+		Path: "/config/db",
+	})
+	s.NoError(err)
+	s.Equal("foo", string(getResponse.Node.Value))
+
+	childrenResponse, err := s.GetChildren(s.Context(), &treev1.GetChildrenRequest{
+		ID:   s.ID,
+		Path: "/config",
+	})
+	s.NoError(err)
+	s.Equal([]string{"cache", "db"}, childrenResponse.Children)
+
+	setResponse, err := s.SetNode(s.Context(), &treev1.SetNodeRequest{
+		ID:          s.ID,
+		Path:        "/config/db",
+		Value:       []byte("bar"),
+		PrevVersion: 1,
+	})
+	s.NoError(err)
+	s.Equal(uint64(2), setResponse.Node.Version)
+
+	_, err = s.SetNode(s.Context(), &treev1.SetNodeRequest{
+		ID:          s.ID,
+		Path:        "/config/db",
+		Value:       []byte("baz"),
+		PrevVersion: 1,
+	})
+	s.ErrorConflict(err)
+
+	// Conditional deletes fail if the version does not match
+	_, err = s.DeleteNode(s.Context(), &treev1.DeleteNodeRequest{
+		ID:          s.ID,
+		Path:        "/config/db",
+		PrevVersion: 1,
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.DeleteNode(s.Context(), &treev1.DeleteNodeRequest{
+		ID:          s.ID,
+		Path:        "/config/db",
+		PrevVersion: 2,
+	})
+	s.NoError(err)
+
+	// Nodes with children can only be deleted recursively
+	_, err = s.DeleteNode(s.Context(), &treev1.DeleteNodeRequest{
+		ID:   s.ID,
+		Path: "/config",
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.DeleteNode(s.Context(), &treev1.DeleteNodeRequest{
+		ID:        s.ID,
+		Path:      "/config",
+		Recursive: true,
+	})
+	s.NoError(err)
+
+	_, err = s.GetNode(s.Context(), &treev1.GetNodeRequest{
+		ID:   s.ID,
+		Path: "/config/cache",
+	})
+	s.ErrorNotFound(err)
+}
+
+func (s *TreeTestSuite) TestSequentialEphemeral() {
+	_, err := s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/locks",
+	})
+	s.NoError(err)
+
+	createResponse, err := s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:         s.ID,
+		Path:       "/locks/lock-",
+		Sequential: true,
+		Ephemeral:  true,
+	})
+	s.NoError(err)
+	s.Equal("/locks/lock-0000000000", createResponse.Node.Path)
+	s.True(createResponse.Node.Ephemeral)
+
+	createResponse, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:         s.ID,
+		Path:       "/locks/lock-",
+		Sequential: true,
+	})
+	s.NoError(err)
+	s.Equal("/locks/lock-0000000001", createResponse.Node.Path)
+	s.False(createResponse.Node.Ephemeral)
+
+	// Ephemeral nodes cannot have children
+	_, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/locks/lock-0000000000/child",
+	})
+	s.ErrorInvalid(err)
+}
+
+func (s *TreeTestSuite) TestWatch() {
+	_, err := s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/a",
+	})
+	s.NoError(err)
+
+	stream, err := s.Watch(s.Context(), &treev1.WatchRequest{
+		ID:        s.ID,
+		Path:      "/a",
+		Recursive: true,
+	})
+	if !s.NoError(err) {
+		return
+	}
+
+	// Wait for the empty event acknowledging the watch
+	response, err := stream.Recv()
+	if !s.NoError(err) {
+		return
+	}
+	s.Nil(response.Event.Event)
+
+	_, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/a/b",
+	})
+	s.NoError(err)
+
+	_, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:    s.ID,
+		Path:  "/a/b/c",
+		Value: []byte("foo"),
+	})
+	s.NoError(err)
+
+	// Nodes outside the watched subtree do not produce events
+	_, err = s.CreateNode(s.Context(), &treev1.CreateNodeRequest{
+		ID:   s.ID,
+		Path: "/ab",
+	})
+	s.NoError(err)
+
+	_, err = s.SetNode(s.Context(), &treev1.SetNodeRequest{
+		ID:    s.ID,
+		Path:  "/a/b/c",
+		Value: []byte("bar"),
+	})
+	s.NoError(err)
+
+	response, err = stream.Recv()
+	if !s.NoError(err) {
+		return
+	}
+	s.Equal("/a/b", response.Event.Path)
+	s.NotNil(response.Event.GetCreated())
+
+	response, err = stream.Recv()
+	if !s.NoError(err) {
+		return
+	}
+	s.Equal("/a/b/c", response.Event.Path)
+	s.NotNil(response.Event.GetCreated())
+
+	response, err = stream.Recv()
+	if !s.NoError(err) {
+		return
+	}
+	s.Equal("/a/b/c", response.Event.Path)
+	s.Equal("bar", string(response.Event.GetUpdated().Node.Value))
+	s.Equal("foo", string(response.Event.GetUpdated().PrevNode.Value))
+}
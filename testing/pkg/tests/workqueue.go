@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2023-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"time"
+
+	workqueuev1 "github.com/atomix/atomix/api/runtime/workqueue/v1"
+)
+
+type WorkQueueTestSuite struct {
+	PrimitiveTestSuite
+	workqueuev1.WorkQueueClient
+}
+
+func (s *WorkQueueTestSuite) SetupSuite() {
+	s.PrimitiveTestSuite.SetupSuite()
+	s.WorkQueueClient = workqueuev1.NewWorkQueueClient(s.conn)
+}
+
+func (s *WorkQueueTestSuite) SetupTest() {
+	s.PrimitiveTestSuite.SetupTest()
+	_, err := s.Create(s.Context(), &workqueuev1.CreateRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *WorkQueueTestSuite) TearDownTest() {
+	_, err := s.Close(s.Context(), &workqueuev1.CloseRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+}
+
+func (s *WorkQueueTestSuite) TestAck() {
+	enqueueResponse, err := s.Enqueue(s.Context(), &workqueuev1.EnqueueRequest{
+		ID:      s.ID,
+		Payload: []byte("foo"),
+	})
+	s.NoError(err)
+
+	dequeueResponse, err := s.Dequeue(s.Context(), &workqueuev1.DequeueRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(enqueueResponse.TaskID, dequeueResponse.Task.ID)
+	s.Equal("foo", string(dequeueResponse.Task.Payload))
+	s.Equal(uint32(1), dequeueResponse.Task.Deliveries)
+
+	_, err = s.Ack(s.Context(), &workqueuev1.AckRequest{
+		ID:         s.ID,
+		TaskID:     dequeueResponse.Task.ID,
+		DeliveryID: dequeueResponse.Task.DeliveryID + 1,
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.Ack(s.Context(), &workqueuev1.AckRequest{
+		ID:         s.ID,
+		TaskID:     dequeueResponse.Task.ID,
+		DeliveryID: dequeueResponse.Task.DeliveryID,
+	})
+	s.NoError(err)
+
+	_, err = s.Ack(s.Context(), &workqueuev1.AckRequest{
+		ID:         s.ID,
+		TaskID:     dequeueResponse.Task.ID,
+		DeliveryID: dequeueResponse.Task.DeliveryID,
+	})
+	s.ErrorNotFound(err)
+
+	timeout := time.Second
+	_, err = s.Dequeue(s.Context(), &workqueuev1.DequeueRequest{
+		ID:      s.ID,
+		Timeout: &timeout,
+	})
+	s.ErrorTimeout(err)
+}
+
+func (s *WorkQueueTestSuite) TestNack() {
+	_, err := s.Enqueue(s.Context(), &workqueuev1.EnqueueRequest{
+		ID:      s.ID,
+		Payload: []byte("foo"),
+	})
+	s.NoError(err)
+
+	dequeueResponse, err := s.Dequeue(s.Context(), &workqueuev1.DequeueRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+
+	_, err = s.Nack(s.Context(), &workqueuev1.NackRequest{
+		ID:         s.ID,
+		TaskID:     dequeueResponse.Task.ID,
+		DeliveryID: dequeueResponse.Task.DeliveryID,
+	})
+	s.NoError(err)
+
+	redeliverResponse, err := s.Dequeue(s.Context(), &workqueuev1.DequeueRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(dequeueResponse.Task.ID, redeliverResponse.Task.ID)
+	s.Equal(uint32(2), redeliverResponse.Task.Deliveries)
+	s.NotEqual(dequeueResponse.Task.DeliveryID, redeliverResponse.Task.DeliveryID)
+}
+
+func (s *WorkQueueTestSuite) TestLeaseExpiration() {
+	_, err := s.Enqueue(s.Context(), &workqueuev1.EnqueueRequest{
+		ID:      s.ID,
+		Payload: []byte("foo"),
+	})
+	s.NoError(err)
+
+	lease := time.Second
+	dequeueResponse, err := s.Dequeue(s.Context(), &workqueuev1.DequeueRequest{
+		ID:    s.ID,
+		Lease: &lease,
+	})
+	s.NoError(err)
+
+	// The task is redelivered once its lease expires without an acknowledgement
+	redeliverResponse, err := s.Dequeue(s.Context(), &workqueuev1.DequeueRequest{
+		ID: s.ID,
+	})
+	s.NoError(err)
+	s.Equal(dequeueResponse.Task.ID, redeliverResponse.Task.ID)
+	s.Equal(uint32(2), redeliverResponse.Task.Deliveries)
+
+	// The expired delivery can no longer acknowledge the task
+	_, err = s.Ack(s.Context(), &workqueuev1.AckRequest{
+		ID:         s.ID,
+		TaskID:     dequeueResponse.Task.ID,
+		DeliveryID: dequeueResponse.Task.DeliveryID,
+	})
+	s.ErrorConflict(err)
+
+	_, err = s.Ack(s.Context(), &workqueuev1.AckRequest{
+		ID:         s.ID,
+		TaskID:     redeliverResponse.Task.ID,
+		DeliveryID: redeliverResponse.Task.DeliveryID,
+	})
+	s.NoError(err)
+}